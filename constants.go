@@ -2,157 +2,456 @@ package winx
 
 // System Information Classes for NtQuerySystemInformation
 const (
-	SystemBasicInformation                   = 0x00
-	SystemProcessorInformation               = 0x01
-	SystemPerformanceInformation             = 0x02
-	SystemTimeOfDayInformation               = 0x03
-	SystemPathInformation                    = 0x04
-	SystemProcessInformation                 = 0x05
-	SystemCallCountInformation               = 0x06
-	SystemDeviceInformation                  = 0x07
-	SystemProcessorPerformanceInformation    = 0x08
-	SystemFlagsInformation                   = 0x09
-	SystemCallTimeInformation                = 0x0A
-	SystemModuleInformation                  = 0x0B
-	SystemLocksInformation                   = 0x0C
-	SystemStackTraceInformation              = 0x0D
-	SystemPagedPoolInformation               = 0x0E
-	SystemNonPagedPoolInformation            = 0x0F
-	SystemHandleInformation                  = 0x10
-	SystemObjectInformation                  = 0x11
-	SystemPageFileInformation                = 0x12
-	SystemVdmInstemulInformation             = 0x13
-	SystemVdmBopInformation                  = 0x14
-	SystemFileCacheInformation               = 0x15
-	SystemPoolTagInformation                 = 0x16
-	SystemInterruptInformation               = 0x17
-	SystemDpcBehaviorInformation             = 0x18
-	SystemFullMemoryInformation              = 0x19
-	SystemLoadGdiDriverInformation           = 0x1A
-	SystemUnloadGdiDriverInformation         = 0x1B
-	SystemTimeAdjustmentInformation          = 0x1C
-	SystemSummaryMemoryInformation           = 0x1D
-	SystemMirrorMemoryInformation            = 0x1E
-	SystemPerformanceTraceInformation        = 0x1F
-	SystemObsolete0                          = 0x20
-	SystemExceptionInformation               = 0x21
-	SystemCrashDumpStateInformation          = 0x22
-	SystemKernelDebuggerInformation          = 0x23
-	SystemContextSwitchInformation           = 0x24
-	SystemRegistryQuotaInformation           = 0x25
-	SystemExtendServiceTableInformation      = 0x26
-	SystemPrioritySeparation                 = 0x27
-	SystemVerifierAddDriverInformation       = 0x28
-	SystemVerifierRemoveDriverInformation    = 0x29
-	SystemProcessorIdleInformation           = 0x2A
-	SystemLegacyDriverInformation            = 0x2B
-	SystemCurrentTimeZoneInformation         = 0x2C
-	SystemLookasideInformation               = 0x2D
-	SystemTimeSlipNotification               = 0x2E
-	SystemSessionCreate                      = 0x2F
-	SystemSessionDetach                      = 0x30
-	SystemSessionInformation                 = 0x31
-	SystemRangeStartInformation              = 0x32
-	SystemVerifierInformation                = 0x33
-	SystemVerifierThunkExtend                = 0x34
-	SystemSessionProcessInformation          = 0x35
-	SystemLoadGdiDriverInSystemSpace         = 0x36
-	SystemNumaProcessorMap                   = 0x37
-	SystemPrefetcherInformation              = 0x38
-	SystemExtendedProcessInformation         = 0x39
-	SystemRecommendedSharedDataAlignment     = 0x3A
-	SystemComPlusPackage                     = 0x3B
-	SystemNumaAvailableMemory                = 0x3C
-	SystemProcessorPowerInformation          = 0x3D
-	SystemEmulationBasicInformation          = 0x3E
-	SystemEmulationProcessorInformation      = 0x3F
-	SystemExtendedHandleInformation          = 0x40
-	SystemLostDelayedWriteInformation        = 0x41
-	SystemBigPoolInformation                 = 0x42
-	SystemSessionPoolTagInformation          = 0x43
-	SystemSessionMappedViewInformation       = 0x44
-	SystemHotpatchInformation                = 0x45
-	SystemObjectSecurityMode                 = 0x46
-	SystemWatchdogTimerHandler               = 0x47
-	SystemWatchdogTimerInformation           = 0x48
-	SystemLogicalProcessorInformation        = 0x49
-	SystemWow64SharedInformationObsolete     = 0x4A
-	SystemRegisterFirmwareTableInformationHandler = 0x4B
-	SystemFirmwareTableInformation           = 0x4C
-	SystemModuleInformationEx                = 0x4D
-	SystemVerifierTriageInformation          = 0x4E
-	SystemSuperfetchInformation              = 0x4F
-	SystemMemoryListInformation              = 0x50
-	SystemFileCacheInformationEx             = 0x51
-	SystemThreadPriorityClientIdInformation  = 0x52
-	SystemProcessorIdleCycleTimeInformation  = 0x53
-	SystemVerifierCancellationInformation    = 0x54
-	SystemProcessorPowerInformationEx        = 0x55
-	SystemRefTraceInformation                = 0x56
-	SystemSpecialPoolInformation             = 0x57
-	SystemProcessIdInformation               = 0x58
-	SystemErrorPortInformation               = 0x59
-	SystemBootEnvironmentInformation         = 0x5A
-	SystemHypervisorInformation              = 0x5B
-	SystemVerifierInformationEx              = 0x5C
-	SystemTimeZoneInformation                = 0x5D
-	SystemImageFileExecutionOptionsInformation = 0x5E
-	SystemCoverageInformation                = 0x5F
-	SystemPrefetchPatchInformation           = 0x60
-	SystemVerifierFaultsInformation          = 0x61
-	SystemSystemPartitionInformation         = 0x62
-	SystemSystemDiskInformation              = 0x63
-	SystemProcessorPerformanceDistribution   = 0x64
-	SystemNumaProximityNodeInformation       = 0x65
-	SystemDynamicTimeZoneInformation         = 0x66
-	SystemCodeIntegrityInformation           = 0x67
-	SystemProcessorMicrocodeUpdateInformation = 0x68
-	SystemProcessorBrandString               = 0x69
-	SystemVirtualAddressInformation          = 0x6A
-	SystemLogicalProcessorAndGroupInformation = 0x6B
-	SystemProcessorCycleTimeInformation      = 0x6C
-	SystemStoreInformation                   = 0x6D
-	SystemRegistryAppendString               = 0x6E
-	SystemAitSamplingValue                   = 0x6F
-	SystemVhdBootInformation                 = 0x70
-	SystemCpuQuotaInformation                = 0x71
-	SystemNativeBasicInformation             = 0x72
-	SystemErrorPortTimeouts                  = 0x73
-	SystemLowPriorityIoInformation           = 0x74
-	SystemBootEntropyInformation             = 0x75
-	SystemVerifierCountersInformation        = 0x76
-	SystemPagedPoolInformationEx             = 0x77
-	SystemSystemPtesInformationEx            = 0x78
-	SystemNodeDistanceInformation            = 0x79
-	SystemAcpiAuditInformation               = 0x7A
-	SystemBasicPerformanceInformation        = 0x7B
-	SystemQueryPerformanceCounterInformation = 0x7C
-	SystemSessionBigPoolInformation          = 0x7D
-	SystemBootGraphicsInformation            = 0x7E
-	SystemScrubPhysicalMemoryInformation     = 0x7F
-	SystemBadPageInformation                 = 0x80
-	SystemProcessorProfileControlArea        = 0x81
-	SystemCombinePhysicalMemoryInformation   = 0x82
-	SystemEntropyInterruptTimingInformation  = 0x83
-	SystemConsoleInformation                 = 0x84
-	SystemPlatformBinaryInformation          = 0x85
-	SystemPolicyInformation                  = 0x86
-	SystemHypervisorProcessorCountInformation = 0x87
-	SystemDeviceDataInformation              = 0x88
-	SystemDeviceDataEnumerationInformation   = 0x89
-	SystemMemoryTopologyInformation          = 0x8A
-	SystemMemoryChannelInformation           = 0x8B
-	SystemBootLogoInformation                = 0x8C
-	SystemProcessorPerformanceInformationEx  = 0x8D
-	SystemCriticalProcessErrorLogInformation = 0x8E
-	SystemSecureBootPolicyInformation        = 0x8F
-	SystemPageFileInformationEx              = 0x90
-	SystemSecureBootInformation              = 0x91
-	SystemEntropyInterruptTimingRawInformation = 0x92
-	SystemPortableWorkspaceEfiLauncherInformation = 0x93
-	SystemFullProcessInformation             = 0x94
+	SystemBasicInformation                        SystemInformationClass = 0x00
+	SystemProcessorInformation                    SystemInformationClass = 0x01
+	SystemPerformanceInformation                  SystemInformationClass = 0x02
+	SystemTimeOfDayInformation                    SystemInformationClass = 0x03
+	SystemPathInformation                         SystemInformationClass = 0x04
+	SystemProcessInformation                      SystemInformationClass = 0x05
+	SystemCallCountInformation                    SystemInformationClass = 0x06
+	SystemDeviceInformation                       SystemInformationClass = 0x07
+	SystemProcessorPerformanceInformation         SystemInformationClass = 0x08
+	SystemFlagsInformation                        SystemInformationClass = 0x09
+	SystemCallTimeInformation                     SystemInformationClass = 0x0A
+	SystemModuleInformation                       SystemInformationClass = 0x0B
+	SystemLocksInformation                        SystemInformationClass = 0x0C
+	SystemStackTraceInformation                   SystemInformationClass = 0x0D
+	SystemPagedPoolInformation                    SystemInformationClass = 0x0E
+	SystemNonPagedPoolInformation                 SystemInformationClass = 0x0F
+	SystemHandleInformation                       SystemInformationClass = 0x10
+	SystemObjectInformation                       SystemInformationClass = 0x11
+	SystemPageFileInformation                     SystemInformationClass = 0x12
+	SystemVdmInstemulInformation                  SystemInformationClass = 0x13
+	SystemVdmBopInformation                       SystemInformationClass = 0x14
+	SystemFileCacheInformation                    SystemInformationClass = 0x15
+	SystemPoolTagInformation                      SystemInformationClass = 0x16
+	SystemInterruptInformation                    SystemInformationClass = 0x17
+	SystemDpcBehaviorInformation                  SystemInformationClass = 0x18
+	SystemFullMemoryInformation                   SystemInformationClass = 0x19
+	SystemLoadGdiDriverInformation                SystemInformationClass = 0x1A
+	SystemUnloadGdiDriverInformation              SystemInformationClass = 0x1B
+	SystemTimeAdjustmentInformation               SystemInformationClass = 0x1C
+	SystemSummaryMemoryInformation                SystemInformationClass = 0x1D
+	SystemMirrorMemoryInformation                 SystemInformationClass = 0x1E
+	SystemPerformanceTraceInformation             SystemInformationClass = 0x1F
+	SystemObsolete0                               SystemInformationClass = 0x20
+	SystemExceptionInformation                    SystemInformationClass = 0x21
+	SystemCrashDumpStateInformation               SystemInformationClass = 0x22
+	SystemKernelDebuggerInformation               SystemInformationClass = 0x23
+	SystemContextSwitchInformation                SystemInformationClass = 0x24
+	SystemRegistryQuotaInformation                SystemInformationClass = 0x25
+	SystemExtendServiceTableInformation           SystemInformationClass = 0x26
+	SystemPrioritySeparation                      SystemInformationClass = 0x27
+	SystemVerifierAddDriverInformation            SystemInformationClass = 0x28
+	SystemVerifierRemoveDriverInformation         SystemInformationClass = 0x29
+	SystemProcessorIdleInformation                SystemInformationClass = 0x2A
+	SystemLegacyDriverInformation                 SystemInformationClass = 0x2B
+	SystemCurrentTimeZoneInformation              SystemInformationClass = 0x2C
+	SystemLookasideInformation                    SystemInformationClass = 0x2D
+	SystemTimeSlipNotification                    SystemInformationClass = 0x2E
+	SystemSessionCreate                           SystemInformationClass = 0x2F
+	SystemSessionDetach                           SystemInformationClass = 0x30
+	SystemSessionInformation                      SystemInformationClass = 0x31
+	SystemRangeStartInformation                   SystemInformationClass = 0x32
+	SystemVerifierInformation                     SystemInformationClass = 0x33
+	SystemVerifierThunkExtend                     SystemInformationClass = 0x34
+	SystemSessionProcessInformation               SystemInformationClass = 0x35
+	SystemLoadGdiDriverInSystemSpace              SystemInformationClass = 0x36
+	SystemNumaProcessorMap                        SystemInformationClass = 0x37
+	SystemPrefetcherInformation                   SystemInformationClass = 0x38
+	SystemExtendedProcessInformation              SystemInformationClass = 0x39
+	SystemRecommendedSharedDataAlignment          SystemInformationClass = 0x3A
+	SystemComPlusPackage                          SystemInformationClass = 0x3B
+	SystemNumaAvailableMemory                     SystemInformationClass = 0x3C
+	SystemProcessorPowerInformation               SystemInformationClass = 0x3D
+	SystemEmulationBasicInformation               SystemInformationClass = 0x3E
+	SystemEmulationProcessorInformation           SystemInformationClass = 0x3F
+	SystemExtendedHandleInformation               SystemInformationClass = 0x40
+	SystemLostDelayedWriteInformation             SystemInformationClass = 0x41
+	SystemBigPoolInformation                      SystemInformationClass = 0x42
+	SystemSessionPoolTagInformation               SystemInformationClass = 0x43
+	SystemSessionMappedViewInformation            SystemInformationClass = 0x44
+	SystemHotpatchInformation                     SystemInformationClass = 0x45
+	SystemObjectSecurityMode                      SystemInformationClass = 0x46
+	SystemWatchdogTimerHandler                    SystemInformationClass = 0x47
+	SystemWatchdogTimerInformation                SystemInformationClass = 0x48
+	SystemLogicalProcessorInformation             SystemInformationClass = 0x49
+	SystemWow64SharedInformationObsolete          SystemInformationClass = 0x4A
+	SystemRegisterFirmwareTableInformationHandler SystemInformationClass = 0x4B
+	SystemFirmwareTableInformation                SystemInformationClass = 0x4C
+	SystemModuleInformationEx                     SystemInformationClass = 0x4D
+	SystemVerifierTriageInformation               SystemInformationClass = 0x4E
+	SystemSuperfetchInformation                   SystemInformationClass = 0x4F
+	SystemMemoryListInformation                   SystemInformationClass = 0x50
+	SystemFileCacheInformationEx                  SystemInformationClass = 0x51
+	SystemThreadPriorityClientIdInformation       SystemInformationClass = 0x52
+	SystemProcessorIdleCycleTimeInformation       SystemInformationClass = 0x53
+	SystemVerifierCancellationInformation         SystemInformationClass = 0x54
+	SystemProcessorPowerInformationEx             SystemInformationClass = 0x55
+	SystemRefTraceInformation                     SystemInformationClass = 0x56
+	SystemSpecialPoolInformation                  SystemInformationClass = 0x57
+	SystemProcessIdInformation                    SystemInformationClass = 0x58
+	SystemErrorPortInformation                    SystemInformationClass = 0x59
+	SystemBootEnvironmentInformation              SystemInformationClass = 0x5A
+	SystemHypervisorInformation                   SystemInformationClass = 0x5B
+	SystemVerifierInformationEx                   SystemInformationClass = 0x5C
+	SystemTimeZoneInformation                     SystemInformationClass = 0x5D
+	SystemImageFileExecutionOptionsInformation    SystemInformationClass = 0x5E
+	SystemCoverageInformation                     SystemInformationClass = 0x5F
+	SystemPrefetchPatchInformation                SystemInformationClass = 0x60
+	SystemVerifierFaultsInformation               SystemInformationClass = 0x61
+	SystemSystemPartitionInformation              SystemInformationClass = 0x62
+	SystemSystemDiskInformation                   SystemInformationClass = 0x63
+	SystemProcessorPerformanceDistribution        SystemInformationClass = 0x64
+	SystemNumaProximityNodeInformation            SystemInformationClass = 0x65
+	SystemDynamicTimeZoneInformation              SystemInformationClass = 0x66
+	SystemCodeIntegrityInformation                SystemInformationClass = 0x67
+	SystemProcessorMicrocodeUpdateInformation     SystemInformationClass = 0x68
+	SystemProcessorBrandString                    SystemInformationClass = 0x69
+	SystemVirtualAddressInformation               SystemInformationClass = 0x6A
+	SystemLogicalProcessorAndGroupInformation     SystemInformationClass = 0x6B
+	SystemProcessorCycleTimeInformation           SystemInformationClass = 0x6C
+	SystemStoreInformation                        SystemInformationClass = 0x6D
+	SystemRegistryAppendString                    SystemInformationClass = 0x6E
+	SystemAitSamplingValue                        SystemInformationClass = 0x6F
+	SystemVhdBootInformation                      SystemInformationClass = 0x70
+	SystemCpuQuotaInformation                     SystemInformationClass = 0x71
+	SystemNativeBasicInformation                  SystemInformationClass = 0x72
+	SystemErrorPortTimeouts                       SystemInformationClass = 0x73
+	SystemLowPriorityIoInformation                SystemInformationClass = 0x74
+	SystemBootEntropyInformation                  SystemInformationClass = 0x75
+	SystemVerifierCountersInformation             SystemInformationClass = 0x76
+	SystemPagedPoolInformationEx                  SystemInformationClass = 0x77
+	SystemSystemPtesInformationEx                 SystemInformationClass = 0x78
+	SystemNodeDistanceInformation                 SystemInformationClass = 0x79
+	SystemAcpiAuditInformation                    SystemInformationClass = 0x7A
+	SystemBasicPerformanceInformation             SystemInformationClass = 0x7B
+	SystemQueryPerformanceCounterInformation      SystemInformationClass = 0x7C
+	SystemSessionBigPoolInformation               SystemInformationClass = 0x7D
+	SystemBootGraphicsInformation                 SystemInformationClass = 0x7E
+	SystemScrubPhysicalMemoryInformation          SystemInformationClass = 0x7F
+	SystemBadPageInformation                      SystemInformationClass = 0x80
+	SystemProcessorProfileControlArea             SystemInformationClass = 0x81
+	SystemCombinePhysicalMemoryInformation        SystemInformationClass = 0x82
+	SystemEntropyInterruptTimingInformation       SystemInformationClass = 0x83
+	SystemConsoleInformation                      SystemInformationClass = 0x84
+	SystemPlatformBinaryInformation               SystemInformationClass = 0x85
+	SystemPolicyInformation                       SystemInformationClass = 0x86
+	SystemHypervisorProcessorCountInformation     SystemInformationClass = 0x87
+	SystemDeviceDataInformation                   SystemInformationClass = 0x88
+	SystemDeviceDataEnumerationInformation        SystemInformationClass = 0x89
+	SystemMemoryTopologyInformation               SystemInformationClass = 0x8A
+	SystemMemoryChannelInformation                SystemInformationClass = 0x8B
+	SystemBootLogoInformation                     SystemInformationClass = 0x8C
+	SystemProcessorPerformanceInformationEx       SystemInformationClass = 0x8D
+	SystemCriticalProcessErrorLogInformation      SystemInformationClass = 0x8E
+	SystemSecureBootPolicyInformation             SystemInformationClass = 0x8F
+	SystemPageFileInformationEx                   SystemInformationClass = 0x90
+	SystemSecureBootInformation                   SystemInformationClass = 0x91
+	SystemEntropyInterruptTimingRawInformation    SystemInformationClass = 0x92
+	SystemPortableWorkspaceEfiLauncherInformation SystemInformationClass = 0x93
+	SystemFullProcessInformation                  SystemInformationClass = 0x94
 )
 
+// Process Information Classes for NtQueryInformationProcess
+const (
+	ProcessBasicInformation                     ProcessInfoClass = 0x00
+	ProcessQuotaLimits                          ProcessInfoClass = 0x01
+	ProcessIoCounters                           ProcessInfoClass = 0x02
+	ProcessVmCounters                           ProcessInfoClass = 0x03
+	ProcessTimes                                ProcessInfoClass = 0x04
+	ProcessBasePriority                         ProcessInfoClass = 0x05
+	ProcessRaisePriority                        ProcessInfoClass = 0x06
+	ProcessDebugPort                            ProcessInfoClass = 0x07
+	ProcessExceptionPort                        ProcessInfoClass = 0x08
+	ProcessAccessToken                          ProcessInfoClass = 0x09
+	ProcessLdtInformation                       ProcessInfoClass = 0x0A
+	ProcessLdtSize                              ProcessInfoClass = 0x0B
+	ProcessDefaultHardErrorMode                 ProcessInfoClass = 0x0C
+	ProcessIoPortHandlers                       ProcessInfoClass = 0x0D
+	ProcessPooledUsageAndLimits                 ProcessInfoClass = 0x0E
+	ProcessWorkingSetWatch                      ProcessInfoClass = 0x0F
+	ProcessUserModeIOPL                         ProcessInfoClass = 0x10
+	ProcessEnableAlignmentFaultFixup            ProcessInfoClass = 0x11
+	ProcessPriorityClass                        ProcessInfoClass = 0x12
+	ProcessWx86Information                      ProcessInfoClass = 0x13
+	ProcessHandleCount                          ProcessInfoClass = 0x14
+	ProcessAffinityMask                         ProcessInfoClass = 0x15
+	ProcessPriorityBoost                        ProcessInfoClass = 0x16
+	ProcessDeviceMap                            ProcessInfoClass = 0x17
+	ProcessSessionInformation                   ProcessInfoClass = 0x18
+	ProcessForegroundInformation                ProcessInfoClass = 0x19
+	ProcessWow64Information                     ProcessInfoClass = 0x1A
+	ProcessImageFileName                        ProcessInfoClass = 0x1B
+	ProcessLUIDDeviceMapsEnabled                ProcessInfoClass = 0x1C
+	ProcessBreakOnTermination                   ProcessInfoClass = 0x1D
+	ProcessDebugObjectHandle                    ProcessInfoClass = 0x1E
+	ProcessDebugFlags                           ProcessInfoClass = 0x1F
+	ProcessHandleTracing                        ProcessInfoClass = 0x20
+	ProcessIoPriority                           ProcessInfoClass = 0x21
+	ProcessExecuteFlags                         ProcessInfoClass = 0x22
+	ProcessTlsInformation                       ProcessInfoClass = 0x23
+	ProcessCookie                               ProcessInfoClass = 0x24
+	ProcessImageInformation                     ProcessInfoClass = 0x25
+	ProcessCycleTime                            ProcessInfoClass = 0x26
+	ProcessPagePriority                         ProcessInfoClass = 0x27
+	ProcessInstrumentationCallback              ProcessInfoClass = 0x28
+	ProcessThreadStackAllocation                ProcessInfoClass = 0x29
+	ProcessWorkingSetWatchEx                    ProcessInfoClass = 0x2A
+	ProcessImageFileNameWin32                   ProcessInfoClass = 0x2B
+	ProcessImageFileMapping                     ProcessInfoClass = 0x2C
+	ProcessAffinityUpdateMode                   ProcessInfoClass = 0x2D
+	ProcessMemoryAllocationMode                 ProcessInfoClass = 0x2E
+	ProcessGroupInformation                     ProcessInfoClass = 0x2F
+	ProcessTokenVirtualizationEnabled           ProcessInfoClass = 0x30
+	ProcessConsoleHostProcess                   ProcessInfoClass = 0x31
+	ProcessWindowInformation                    ProcessInfoClass = 0x32
+	ProcessHandleInformation                    ProcessInfoClass = 0x33
+	ProcessMitigationPolicy                     ProcessInfoClass = 0x34
+	ProcessDynamicFunctionTableInformation      ProcessInfoClass = 0x35
+	ProcessHandleCheckingMode                   ProcessInfoClass = 0x36
+	ProcessKeepAliveCount                       ProcessInfoClass = 0x37
+	ProcessRevokeFileHandles                    ProcessInfoClass = 0x38
+	ProcessWorkingSetControl                    ProcessInfoClass = 0x39
+	ProcessHandleTable                          ProcessInfoClass = 0x3A
+	ProcessCheckStackExtentsMode                ProcessInfoClass = 0x3B
+	ProcessCommandLineInformation               ProcessInfoClass = 0x3C
+	ProcessProtectionInformation                ProcessInfoClass = 0x3D
+	ProcessMemoryExhaustion                     ProcessInfoClass = 0x3E
+	ProcessFaultInformation                     ProcessInfoClass = 0x3F
+	ProcessTelemetryIdInformation               ProcessInfoClass = 0x40
+	ProcessCommitReleaseInformation             ProcessInfoClass = 0x41
+	ProcessDefaultCpuSetsInformation            ProcessInfoClass = 0x42
+	ProcessAllowedCpuSetsInformation            ProcessInfoClass = 0x43
+	ProcessSubsystemProcess                     ProcessInfoClass = 0x44
+	ProcessJobMemoryInformation                 ProcessInfoClass = 0x45
+	ProcessInPrivate                            ProcessInfoClass = 0x46
+	ProcessRaiseUMExceptionOnInvalidHandleClose ProcessInfoClass = 0x47
+	ProcessIumChallengeResponse                 ProcessInfoClass = 0x48
+	ProcessChildProcessInformation              ProcessInfoClass = 0x49
+	ProcessHighGraphicsPriorityInformation      ProcessInfoClass = 0x4A
+	ProcessSubsystemInformation                 ProcessInfoClass = 0x4B
+	ProcessEnergyValues                         ProcessInfoClass = 0x4C
+	ProcessPowerThrottlingState                 ProcessInfoClass = 0x4D
+	ProcessReserved3Information                 ProcessInfoClass = 0x4E
+	ProcessWin32kSyscallFilterInformation       ProcessInfoClass = 0x4F
+	ProcessDisableSystemAllowedCpuSets          ProcessInfoClass = 0x50
+	ProcessWakeInformation                      ProcessInfoClass = 0x51
+	ProcessEnergyTrackingState                  ProcessInfoClass = 0x52
+	ProcessManageWritesToExecutableMemory       ProcessInfoClass = 0x53
+	ProcessCaptureTrustletLiveDump              ProcessInfoClass = 0x54
+	ProcessTelemetryCoverage                    ProcessInfoClass = 0x55
+	ProcessEnclaveInformation                   ProcessInfoClass = 0x56
+	ProcessEnableReadWriteVmLogging             ProcessInfoClass = 0x57
+	ProcessUptimeInformation                    ProcessInfoClass = 0x58
+	ProcessImageSection                         ProcessInfoClass = 0x59
+	ProcessDebugAuthInformation                 ProcessInfoClass = 0x5A
+	ProcessSystemResourceManagement             ProcessInfoClass = 0x5B
+	ProcessSequenceNumber                       ProcessInfoClass = 0x5C
+	ProcessLoaderDetour                         ProcessInfoClass = 0x5D
+	ProcessSecurityDomainInformation            ProcessInfoClass = 0x5E
+	ProcessCombineSecurityDomainsInformation    ProcessInfoClass = 0x5F
+	ProcessEnableLoggingInformation             ProcessInfoClass = 0x60
+	ProcessLeapSecondInformation                ProcessInfoClass = 0x61
+	ProcessFiberShadowStackAllocation           ProcessInfoClass = 0x62
+	ProcessFreeFiberShadowStackAllocation       ProcessInfoClass = 0x63
+	ProcessAltSystemCallInformation             ProcessInfoClass = 0x64
+	ProcessDynamicEHContinuationTargets         ProcessInfoClass = 0x65
+	ProcessDynamicEnforcedCetCompatibleRanges   ProcessInfoClass = 0x66
+	ProcessCreateStateChange                    ProcessInfoClass = 0x67
+	ProcessApplyStateChange                     ProcessInfoClass = 0x68
+	ProcessEnableOptionalXStateFeatures         ProcessInfoClass = 0x69
+	ProcessAltPrefetchParam                     ProcessInfoClass = 0x6A
+	ProcessAssignCpuPartitions                  ProcessInfoClass = 0x6B
+	ProcessPriorityClassEx                      ProcessInfoClass = 0x6C
+	ProcessMembershipInformation                ProcessInfoClass = 0x6D
+	ProcessEffectiveIoPriority                  ProcessInfoClass = 0x6E
+	ProcessEffectivePagePriority                ProcessInfoClass = 0x6F
+	ProcessSchedulerSharedData                  ProcessInfoClass = 0x70
+	ProcessSlistRollbackInformation             ProcessInfoClass = 0x71
+	ProcessNetworkIoCounters                    ProcessInfoClass = 0x72
+	ProcessFindFirstThreadByTebValue            ProcessInfoClass = 0x73
+)
+
+// Thread Information Classes for NtQueryInformationThread
+const (
+	ThreadBasicInformation               ThreadInfoClass = 0x00
+	ThreadTimes                          ThreadInfoClass = 0x01
+	ThreadPriority                       ThreadInfoClass = 0x02
+	ThreadBasePriority                   ThreadInfoClass = 0x03
+	ThreadAffinityMask                   ThreadInfoClass = 0x04
+	ThreadImpersonationToken             ThreadInfoClass = 0x05
+	ThreadDescriptorTableEntry           ThreadInfoClass = 0x06
+	ThreadEnableAlignmentFaultFixup      ThreadInfoClass = 0x07
+	ThreadEventPair                      ThreadInfoClass = 0x08
+	ThreadQuerySetWin32StartAddress      ThreadInfoClass = 0x09
+	ThreadZeroTlsCell                    ThreadInfoClass = 0x0A
+	ThreadPerformanceCount               ThreadInfoClass = 0x0B
+	ThreadAmILastThread                  ThreadInfoClass = 0x0C
+	ThreadIdealProcessor                 ThreadInfoClass = 0x0D
+	ThreadPriorityBoost                  ThreadInfoClass = 0x0E
+	ThreadSetTlsArrayAddress             ThreadInfoClass = 0x0F
+	ThreadIsIoPending                    ThreadInfoClass = 0x10
+	ThreadHideFromDebugger               ThreadInfoClass = 0x11
+	ThreadBreakOnTermination             ThreadInfoClass = 0x12
+	ThreadSwitchLegacyState              ThreadInfoClass = 0x13
+	ThreadIsTerminated                   ThreadInfoClass = 0x14
+	ThreadLastSystemCall                 ThreadInfoClass = 0x15
+	ThreadIoPriority                     ThreadInfoClass = 0x16
+	ThreadCycleTime                      ThreadInfoClass = 0x17
+	ThreadPagePriority                   ThreadInfoClass = 0x18
+	ThreadActualBasePriority             ThreadInfoClass = 0x19
+	ThreadTebInformation                 ThreadInfoClass = 0x1A
+	ThreadCSwitchMon                     ThreadInfoClass = 0x1B
+	ThreadCSwitchPmu                     ThreadInfoClass = 0x1C
+	ThreadWow64Context                   ThreadInfoClass = 0x1D
+	ThreadGroupInformation               ThreadInfoClass = 0x1E
+	ThreadUmsInformation                 ThreadInfoClass = 0x1F
+	ThreadCounterProfiling               ThreadInfoClass = 0x20
+	ThreadIdealProcessorEx               ThreadInfoClass = 0x21
+	ThreadCpuAccountingInformation       ThreadInfoClass = 0x22
+	ThreadSuspendCount                   ThreadInfoClass = 0x23
+	ThreadHeterogeneousCpuPolicy         ThreadInfoClass = 0x24
+	ThreadContainerId                    ThreadInfoClass = 0x25
+	ThreadNameInformation                ThreadInfoClass = 0x26
+	ThreadSelectedCpuSets                ThreadInfoClass = 0x27
+	ThreadSystemThreadInformation        ThreadInfoClass = 0x28
+	ThreadActualGroupAffinity            ThreadInfoClass = 0x29
+	ThreadDynamicCodePolicyInfo          ThreadInfoClass = 0x2A
+	ThreadExplicitCaseSensitivity        ThreadInfoClass = 0x2B
+	ThreadWorkOnBehalfTicket             ThreadInfoClass = 0x2C
+	ThreadSubsystemInformation           ThreadInfoClass = 0x2D
+	ThreadDbgkWerReportActive            ThreadInfoClass = 0x2E
+	ThreadAttachContainer                ThreadInfoClass = 0x2F
+	ThreadManageWritesToExecutableMemory ThreadInfoClass = 0x30
+	ThreadPowerThrottlingState           ThreadInfoClass = 0x31
+	ThreadWorkloadClass                  ThreadInfoClass = 0x32
+	ThreadCreateStateChange              ThreadInfoClass = 0x33
+	ThreadApplyStateChange               ThreadInfoClass = 0x34
+	ThreadStrongerBadHandleChecks        ThreadInfoClass = 0x35
+	ThreadEffectiveIoPriority            ThreadInfoClass = 0x36
+	ThreadEffectivePagePriority          ThreadInfoClass = 0x37
+	ThreadUpdateLockOwnership            ThreadInfoClass = 0x38
+	ThreadSchedulerSharedDataSlot        ThreadInfoClass = 0x39
+	ThreadTebInformationAtomic           ThreadInfoClass = 0x3A
+	ThreadIndexInformation               ThreadInfoClass = 0x3B
+)
+
+// Object Information Classes for NtQueryObject
+const (
+	ObjectBasicInformation         ObjectInformationClass = 0x00
+	ObjectNameInformation          ObjectInformationClass = 0x01
+	ObjectTypeInformation          ObjectInformationClass = 0x02
+	ObjectTypesInformation         ObjectInformationClass = 0x03
+	ObjectHandleFlagInformation    ObjectInformationClass = 0x04
+	ObjectSessionInformation       ObjectInformationClass = 0x05
+	ObjectSessionObjectInformation ObjectInformationClass = 0x06
+)
+
+// Memory Information Classes for NtQueryVirtualMemory
+const (
+	MemoryBasicInformation              MemoryInformationClass = 0x00
+	MemoryWorkingSetInformation         MemoryInformationClass = 0x01
+	MemoryMappedFilenameInformation     MemoryInformationClass = 0x02
+	MemoryRegionInformation             MemoryInformationClass = 0x03
+	MemoryWorkingSetExInformation       MemoryInformationClass = 0x04
+	MemorySharedCommitInformation       MemoryInformationClass = 0x05
+	MemoryImageInformation              MemoryInformationClass = 0x06
+	MemoryRegionInformationEx           MemoryInformationClass = 0x07
+	MemoryPrivilegedBasicInformation    MemoryInformationClass = 0x08
+	MemoryEnclaveImageInformation       MemoryInformationClass = 0x09
+	MemoryBasicInformationCapped        MemoryInformationClass = 0x0A
+	MemoryPhysicalContiguityInformation MemoryInformationClass = 0x0B
+	MemoryBadInformation                MemoryInformationClass = 0x0C
+	MemoryBadInformationAllProcesses    MemoryInformationClass = 0x0D
+)
+
+// Access rights for object directories and symbolic links
+const (
+	DIRECTORY_QUERY               = 0x0001
+	DIRECTORY_TRAVERSE            = 0x0002
+	DIRECTORY_CREATE_OBJECT       = 0x0004
+	DIRECTORY_CREATE_SUBDIRECTORY = 0x0008
+	DIRECTORY_ALL_ACCESS          = 0xF000F
+	SYMBOLIC_LINK_QUERY           = 0x0001
+	SYMBOLIC_LINK_ALL_ACCESS      = 0xF0001
+)
+
+// Options for NtDuplicateObject
+const (
+	DUPLICATE_CLOSE_SOURCE    = 0x00000001
+	DUPLICATE_SAME_ACCESS     = 0x00000002
+	DUPLICATE_SAME_ATTRIBUTES = 0x00000004
+)
+
+// Standard access right for waiting on an object, needed with
+// FILE_SYNCHRONOUS_IO_ALERT and FILE_SYNCHRONOUS_IO_NONALERT
+const SYNCHRONIZE = 0x00100000
+
+// Access rights for file objects
+const (
+	FILE_READ_DATA        = 0x00000001
+	FILE_WRITE_DATA       = 0x00000002
+	FILE_APPEND_DATA      = 0x00000004
+	FILE_READ_EA          = 0x00000008
+	FILE_WRITE_EA         = 0x00000010
+	FILE_EXECUTE          = 0x00000020
+	FILE_READ_ATTRIBUTES  = 0x00000080
+	FILE_WRITE_ATTRIBUTES = 0x00000100
+	FILE_GENERIC_READ     = 0x00120089
+	FILE_GENERIC_WRITE    = 0x00120116
+	FILE_GENERIC_EXECUTE  = 0x001200A0
+	FILE_ALL_ACCESS       = 0x001F01FF
+)
+
+// Share access for NtCreateFile and NtOpenFile
+const (
+	FILE_SHARE_READ   = 0x00000001
+	FILE_SHARE_WRITE  = 0x00000002
+	FILE_SHARE_DELETE = 0x00000004
+)
+
+// Create dispositions for NtCreateFile
+const (
+	FILE_SUPERSEDE    = 0x00000000
+	FILE_OPEN         = 0x00000001
+	FILE_CREATE       = 0x00000002
+	FILE_OPEN_IF      = 0x00000003
+	FILE_OVERWRITE    = 0x00000004
+	FILE_OVERWRITE_IF = 0x00000005
+)
+
+// Create and open options for NtCreateFile and NtOpenFile
+const (
+	FILE_DIRECTORY_FILE            = 0x00000001
+	FILE_WRITE_THROUGH             = 0x00000002
+	FILE_SEQUENTIAL_ONLY           = 0x00000004
+	FILE_NO_INTERMEDIATE_BUFFERING = 0x00000008
+	FILE_SYNCHRONOUS_IO_ALERT      = 0x00000010
+	FILE_SYNCHRONOUS_IO_NONALERT   = 0x00000020
+	FILE_NON_DIRECTORY_FILE        = 0x00000040
+	FILE_COMPLETE_IF_OPLOCKED      = 0x00000100
+	FILE_NO_EA_KNOWLEDGE           = 0x00000200
+	FILE_RANDOM_ACCESS             = 0x00000800
+	FILE_DELETE_ON_CLOSE           = 0x00001000
+	FILE_OPEN_BY_FILE_ID           = 0x00002000
+	FILE_OPEN_FOR_BACKUP_INTENT    = 0x00004000
+	FILE_OPEN_REPARSE_POINT        = 0x00200000
+)
+
+// IO_STATUS_BLOCK.Information values returned by NtCreateFile and NtOpenFile
+const (
+	FILE_SUPERSEDED     = 0x00000000
+	FILE_OPENED         = 0x00000001
+	FILE_CREATED        = 0x00000002
+	FILE_OVERWRITTEN    = 0x00000003
+	FILE_EXISTS         = 0x00000004
+	FILE_DOES_NOT_EXIST = 0x00000005
+)
+
+// Flags of FILE_FULL_EA_INFORMATION
+const FILE_NEED_EA = 0x80
+
 // Access rights for process objects
 const (
 	PROCESS_TERMINATE                 = 0x0001
@@ -217,6 +516,7 @@ const (
 	MEM_TOP_DOWN    = 0x100000
 	MEM_WRITE_WATCH = 0x200000
 	MEM_PHYSICAL    = 0x400000
+	MEM_IMAGE       = 0x1000000
 	MEM_LARGE_PAGES = 0x20000000
 	MEM_4MB_PAGES   = 0x80000000
 )
@@ -1,8 +1,11 @@
 package device
 
 import (
+	"errors"
 	"strings"
 	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
 )
 
 // TestCTL_CODE tests the IOCTL code construction macro
@@ -891,3 +894,47 @@ func BenchmarkFormatIOCTLHex(b *testing.B) {
 		_ = FormatIOCTLHex(code)
 	}
 }
+
+// TestDiscoverDriverDevices tests device discovery through the object namespace
+func TestDiscoverDriverDevices(t *testing.T) {
+	devices, err := DiscoverDriverDevices("Null")
+	if err != nil {
+		t.Fatalf("DiscoverDriverDevices() error = %v", err)
+	}
+	if len(devices) == 0 || devices[0].Path != `\Device\Null` {
+		t.Fatalf("DiscoverDriverDevices(Null) = %+v", devices)
+	}
+
+	h, err := OpenDeviceReadOnly(devices[0].Win32Path())
+	if err != nil {
+		t.Fatalf("OpenDeviceReadOnly(%s) error = %v", devices[0].Win32Path(), err)
+	}
+	CloseHandle(h)
+
+	if loaded, err := DriverLoaded("Null"); err != nil || !loaded {
+		t.Errorf("DriverLoaded(Null) = %v, %v", loaded, err)
+	}
+	if loaded, err := DriverLoaded("NoSuchDriver"); err != nil || loaded {
+		t.Errorf("DriverLoaded(NoSuchDriver) = %v, %v", loaded, err)
+	}
+}
+
+// TestOpenDeviceNTPath tests opening devices by NT path
+func TestOpenDeviceNTPath(t *testing.T) {
+	for _, path := range []string{`\Device\Null`, `\??\NUL`} {
+		h, err := OpenDeviceReadWrite(path)
+		if err != nil {
+			t.Errorf("OpenDeviceReadWrite(%s) error = %v", path, err)
+			continue
+		}
+		var written uint32
+		if ok, err := WriteFile(h, []byte("winx"), 4, &written, nil); !ok || written != 4 {
+			t.Errorf("WriteFile(%s) = %v, %v, %d bytes written", path, ok, err, written)
+		}
+		CloseHandle(h)
+	}
+
+	if _, err := OpenDevice(`\Device\NoSuchDevice`, GENERIC_READ); !errors.Is(err, winx.STATUS_OBJECT_NAME_NOT_FOUND) {
+		t.Errorf("OpenDevice(NoSuchDevice) error = %v", err)
+	}
+}
@@ -6,19 +6,22 @@ import (
 	"syscall"
 	"unsafe"
 
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/exitcodes"
 	"github.com/ArkaprabhaChakraborty/winx/handle"
+	"github.com/ArkaprabhaChakraborty/winx/internal/proc"
+	"github.com/ArkaprabhaChakraborty/winx/ntdll"
 )
 
 var (
-	kernel32              = syscall.NewLazyDLL("kernel32.dll")
-	procCreateFileW       = kernel32.NewProc("CreateFileW")
-	procCloseHandle       = kernel32.NewProc("CloseHandle")
-	procDeviceIoControl   = kernel32.NewProc("DeviceIoControl")
-	procGetLastError      = kernel32.NewProc("GetLastError")
-	procReadFile          = kernel32.NewProc("ReadFile")
-	procWriteFile         = kernel32.NewProc("WriteFile")
-	procGetFileSizeEx     = kernel32.NewProc("GetFileSizeEx")
-	procQueryDosDeviceW   = kernel32.NewProc("QueryDosDeviceW")
+	procCreateFileW       = proc.Kernel32.Proc("CreateFileW")
+	procCloseHandle       = proc.Kernel32.Proc("CloseHandle")
+	procDeviceIoControl   = proc.Kernel32.Proc("DeviceIoControl")
+	procGetLastError      = proc.Kernel32.Proc("GetLastError")
+	procReadFile          = proc.Kernel32.Proc("ReadFile")
+	procWriteFile         = proc.Kernel32.Proc("WriteFile")
+	procGetFileSizeEx     = proc.Kernel32.Proc("GetFileSizeEx")
+	procQueryDosDeviceW   = proc.Kernel32.Proc("QueryDosDeviceW")
 )
 
 // CreateFile opens or creates a file or I/O device.
@@ -55,8 +58,9 @@ func CreateFile(
 		secAttrPtr = uintptr(unsafe.Pointer(securityAttributes))
 	}
 
-	ret, _, err := syscall.SyscallN(
-		procCreateFileW.Addr(),
+	ret, _, err := procCreateFileW.Call(
+		winx.Call{API: "CreateFileW", Detail: fileName},
+		winx.ReturnsHANDLE,
 		uintptr(unsafe.Pointer(fileNamePtr)),
 		uintptr(desiredAccess),
 		uintptr(shareMode),
@@ -84,8 +88,9 @@ func CreateFile(
 // Returns:
 //   - true if successful, false otherwise
 func CloseHandle(hObject handle.HANDLE) bool {
-	ret, _, _ := syscall.SyscallN(
-		procCloseHandle.Addr(),
+	ret, _, _ := procCloseHandle.Call(
+		winx.Call{API: "CloseHandle"},
+		winx.ReturnsBOOL,
 		uintptr(hObject),
 	)
 	return ret != 0
@@ -122,8 +127,9 @@ func DeviceIoControl(
 		overlappedPtr = uintptr(unsafe.Pointer(overlapped))
 	}
 
-	ret, _, _ := syscall.SyscallN(
-		procDeviceIoControl.Addr(),
+	ret, _, err := procDeviceIoControl.Call(
+		winx.Call{API: "DeviceIoControl", Detail: winx.TraceDetail("IOCTL 0x%08X", ioControlCode), InputSize: int(inBufferSize), OutputSize: int(outBufferSize)},
+		winx.ReturnsBOOL,
 		uintptr(hDevice),
 		uintptr(ioControlCode),
 		uintptr(inBuffer),
@@ -135,7 +141,7 @@ func DeviceIoControl(
 	)
 
 	if ret == 0 {
-		return false, syscall.GetLastError()
+		return false, err
 	}
 
 	return true, nil
@@ -220,8 +226,9 @@ func ReadFile(
 		overlappedPtr = uintptr(unsafe.Pointer(overlapped))
 	}
 
-	ret, _, _ := syscall.SyscallN(
-		procReadFile.Addr(),
+	ret, _, err := procReadFile.Call(
+		winx.Call{API: "ReadFile", OutputSize: int(numberOfBytesToRead)},
+		winx.ReturnsBOOL,
 		uintptr(hFile),
 		uintptr(bufferPtr),
 		uintptr(numberOfBytesToRead),
@@ -230,7 +237,7 @@ func ReadFile(
 	)
 
 	if ret == 0 {
-		return false, syscall.GetLastError()
+		return false, err
 	}
 
 	return true, nil
@@ -265,8 +272,9 @@ func WriteFile(
 		overlappedPtr = uintptr(unsafe.Pointer(overlapped))
 	}
 
-	ret, _, _ := syscall.SyscallN(
-		procWriteFile.Addr(),
+	ret, _, err := procWriteFile.Call(
+		winx.Call{API: "WriteFile", InputSize: int(numberOfBytesToWrite)},
+		winx.ReturnsBOOL,
 		uintptr(hFile),
 		uintptr(bufferPtr),
 		uintptr(numberOfBytesToWrite),
@@ -275,7 +283,7 @@ func WriteFile(
 	)
 
 	if ret == 0 {
-		return false, syscall.GetLastError()
+		return false, err
 	}
 
 	return true, nil
@@ -291,28 +299,35 @@ func WriteFile(
 func GetFileSize(hFile handle.HANDLE) (int64, error) {
 	var fileSize int64
 
-	ret, _, _ := syscall.SyscallN(
-		procGetFileSizeEx.Addr(),
+	ret, _, err := procGetFileSizeEx.Call(
+		winx.Call{API: "GetFileSizeEx"},
+		winx.ReturnsBOOL,
 		uintptr(hFile),
 		uintptr(unsafe.Pointer(&fileSize)),
 	)
 
 	if ret == 0 {
-		return 0, syscall.GetLastError()
+		return 0, err
 	}
 
 	return fileSize, nil
 }
 
 // OpenDevice is a convenience function to open a device for IOCTL operations.
+// NT paths are opened with NtCreateFile, so devices without a DOS device
+// link can be opened by their object name.
 //
 // Parameters:
-//   - devicePath: The device path (e.g., "\\\\.\\PhysicalDrive0")
+//   - devicePath: The Win32 device path (e.g., "\\\\.\\PhysicalDrive0") or the
+//     NT path (e.g., "\\Device\\Afd")
 //   - desiredAccess: The requested access (typically GENERIC_READ | GENERIC_WRITE)
 //
 // Returns:
 //   - A handle to the device and any error
 func OpenDevice(devicePath string, desiredAccess uint32) (handle.HANDLE, error) {
+	if ntdll.IsNTPath(devicePath) {
+		return OpenNTDevice(devicePath, desiredAccess, nil)
+	}
 	return CreateFile(
 		devicePath,
 		desiredAccess,
@@ -324,6 +339,29 @@ func OpenDevice(devicePath string, desiredAccess uint32) (handle.HANDLE, error)
 	)
 }
 
+// OpenNTDevice opens a device by NT path with NtCreateFile, for synchronous
+// I/O as CreateFile does. Extended attributes are passed to the driver, which
+// some drivers read as their open parameters.
+//
+// Parameters:
+//   - devicePath: The NT path of the device (e.g., "\\Device\\Afd\\Endpoint")
+//   - desiredAccess: The requested access (typically GENERIC_READ | GENERIC_WRITE)
+//   - extendedAttributes: The extended attributes to pass (can be nil)
+//
+// Returns:
+//   - A handle to the device and any error, an NTStatusError on failure
+func OpenNTDevice(devicePath string, desiredAccess uint32, extendedAttributes []ntdll.ExtendedAttribute) (handle.HANDLE, error) {
+	h, _, err := ntdll.NtCreateFile(devicePath, desiredAccess|winx.SYNCHRONIZE|winx.FILE_READ_ATTRIBUTES, winx.FILE_OPEN, &ntdll.CreateFileOptions{
+		ShareAccess:        FILE_SHARE_READ | FILE_SHARE_WRITE,
+		CreateOptions:      winx.FILE_SYNCHRONOUS_IO_NONALERT,
+		ExtendedAttributes: extendedAttributes,
+	})
+	if err != nil {
+		return handle.HANDLE(INVALID_HANDLE_VALUE), err
+	}
+	return h, nil
+}
+
 // OpenDeviceReadOnly is a convenience function to open a device for read-only access.
 //
 // Parameters:
@@ -369,8 +407,9 @@ func QueryDosDevice(deviceName string) ([]string, error) {
 	bufferSize := uint32(65536) // 64KB should be enough for most cases
 	buffer := make([]uint16, bufferSize)
 
-	ret, _, err := syscall.SyscallN(
-		procQueryDosDeviceW.Addr(),
+	ret, _, err := procQueryDosDeviceW.Call(
+		winx.Call{API: "QueryDosDeviceW", Detail: deviceName, OutputSize: int(bufferSize) * 2},
+		winx.ReturnsBOOL,
 		uintptr(unsafe.Pointer(deviceNamePtr)),
 		uintptr(unsafe.Pointer(&buffer[0])),
 		uintptr(bufferSize),
@@ -889,7 +928,8 @@ type IOCTLProbeResult struct {
 //
 // A valid IOCTL may still return an error (e.g., buffer too small), but
 // the error code will indicate the IOCTL was recognized. Invalid IOCTLs
-// typically return ERROR_INVALID_FUNCTION or ERROR_NOT_SUPPORTED.
+// typically return ERROR_INVALID_FUNCTION or ERROR_NOT_SUPPORTED; the
+// classification uses exitcodes.IsNotSupported.
 //
 // Parameters:
 //   - hDevice: Handle to the device
@@ -922,34 +962,13 @@ func ProbeIOCTL(hDevice handle.HANDLE, ioctlCode uint32) IOCTLProbeResult {
 	result.BytesReturned = bytesReturned
 	result.ErrorCode = err
 
-	// Determine if the IOCTL is valid based on the error
-	if err == nil {
-		// Success means the IOCTL is definitely valid
-		result.Valid = true
-	} else {
-		// Check the error code
-		errno, ok := err.(syscall.Errno)
-		if ok {
-			// These errors indicate the IOCTL was recognized but failed for other reasons
-			switch errno {
-			case syscall.ERROR_INSUFFICIENT_BUFFER,
-				syscall.ERROR_MORE_DATA,
-				ERROR_INVALID_PARAMETER,
-				ERROR_BAD_LENGTH,
-				ERROR_INVALID_USER_BUFFER,
-				syscall.ERROR_ACCESS_DENIED:
-				result.Valid = true
-			// These errors indicate the IOCTL is not recognized
-			case ERROR_INVALID_FUNCTION,
-				ERROR_NOT_SUPPORTED,
-				ERROR_CALL_NOT_IMPLEMENTED:
-				result.Valid = false
-			default:
-				// For other errors, consider it potentially valid
-				result.Valid = true
-			}
-		}
-	}
+	// Determine if the IOCTL is valid based on the error. Errors that mean the
+	// driver does not implement the code (ERROR_INVALID_FUNCTION,
+	// ERROR_NOT_SUPPORTED, ...) mark it invalid; any other categorized error
+	// (buffer too small, invalid parameter, access denied) means the IOCTL was
+	// recognized. Uncategorized errors, such as a missing DeviceIoControl,
+	// say nothing about the code and leave it invalid.
+	result.Valid = err == nil || (exitcodes.CategoryOf(err) != exitcodes.CategoryNone && !exitcodes.IsNotSupported(err))
 
 	return result
 }
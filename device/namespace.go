@@ -0,0 +1,51 @@
+package device
+
+import (
+	"strings"
+
+	"github.com/ArkaprabhaChakraborty/winx/ntdll"
+)
+
+// DiscoverDriverDevices finds the device objects of a driver in the object
+// manager namespace: devices under \Device whose name, or the name of a
+// \GLOBAL?? link to them, starts with the service name.
+//
+// Parameters:
+//   - serviceName: The service/driver name (e.g., "CLFS", "AFD")
+//
+// Returns:
+//   - The matching devices with their links; use Win32Path to open them
+//   - An error if \Device or \GLOBAL?? cannot be listed
+func DiscoverDriverDevices(serviceName string) ([]ntdll.DeviceObject, error) {
+	devices, err := ntdll.QueryDeviceObjects()
+	if err != nil {
+		return nil, err
+	}
+	return ntdll.FindDriverDevices(devices, serviceName), nil
+}
+
+// DriverLoaded reports whether a driver object exists for the service, in
+// \Driver or, for file system drivers, in \FileSystem
+//
+// Parameters:
+//   - serviceName: The service/driver name (e.g., "CLFS", "AFD")
+//
+// Returns:
+//   - true if the driver object exists, and any error listing \Driver
+func DriverLoaded(serviceName string) (bool, error) {
+	for _, directory := range []string{`\Driver`, `\FileSystem`} {
+		entries, err := ntdll.SystemNamespace.ListDirectory(directory)
+		if err != nil {
+			if directory == `\Driver` {
+				return false, err
+			}
+			continue
+		}
+		for _, entry := range entries {
+			if strings.EqualFold(entry.Name, serviceName) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -5,17 +5,18 @@ import (
 	"syscall"
 	"unsafe"
 
+	"github.com/ArkaprabhaChakraborty/winx"
 	"github.com/ArkaprabhaChakraborty/winx/handle"
+	"github.com/ArkaprabhaChakraborty/winx/internal/proc"
 )
 
 var (
-	setupapi                               = syscall.NewLazyDLL("setupapi.dll")
-	procSetupDiGetClassDevsW               = setupapi.NewProc("SetupDiGetClassDevsW")
-	procSetupDiEnumDeviceInterfaces        = setupapi.NewProc("SetupDiEnumDeviceInterfaces")
-	procSetupDiGetDeviceInterfaceDetailW   = setupapi.NewProc("SetupDiGetDeviceInterfaceDetailW")
-	procSetupDiDestroyDeviceInfoList       = setupapi.NewProc("SetupDiDestroyDeviceInfoList")
-	procSetupDiGetDeviceRegistryPropertyW  = setupapi.NewProc("SetupDiGetDeviceRegistryPropertyW")
-	procSetupDiEnumDeviceInfo              = setupapi.NewProc("SetupDiEnumDeviceInfo")
+	procSetupDiGetClassDevsW               = proc.SetupAPI.Proc("SetupDiGetClassDevsW")
+	procSetupDiEnumDeviceInterfaces        = proc.SetupAPI.Proc("SetupDiEnumDeviceInterfaces")
+	procSetupDiGetDeviceInterfaceDetailW   = proc.SetupAPI.Proc("SetupDiGetDeviceInterfaceDetailW")
+	procSetupDiDestroyDeviceInfoList       = proc.SetupAPI.Proc("SetupDiDestroyDeviceInfoList")
+	procSetupDiGetDeviceRegistryPropertyW  = proc.SetupAPI.Proc("SetupDiGetDeviceRegistryPropertyW")
+	procSetupDiEnumDeviceInfo              = proc.SetupAPI.Proc("SetupDiEnumDeviceInfo")
 )
 
 // SetupDi flags
@@ -115,8 +116,9 @@ func SetupDiGetClassDevs(
 		enumeratorPtr = uintptr(unsafe.Pointer(enumPtr))
 	}
 
-	ret, _, _ := syscall.SyscallN(
-		procSetupDiGetClassDevsW.Addr(),
+	ret, _, err := procSetupDiGetClassDevsW.Call(
+		winx.Call{API: "SetupDiGetClassDevsW"},
+		winx.ReturnsHANDLE,
 		classGuidPtr,
 		enumeratorPtr,
 		hwndParent,
@@ -124,7 +126,7 @@ func SetupDiGetClassDevs(
 	)
 
 	if ret == INVALID_HANDLE_VALUE {
-		return handle.HANDLE(INVALID_HANDLE_VALUE), syscall.GetLastError()
+		return handle.HANDLE(INVALID_HANDLE_VALUE), err
 	}
 
 	return handle.HANDLE(ret), nil
@@ -158,8 +160,9 @@ func SetupDiEnumDeviceInterfaces(
 	// Initialize cbSize field
 	deviceInterfaceData.CbSize = uint32(unsafe.Sizeof(*deviceInterfaceData))
 
-	ret, _, _ := syscall.SyscallN(
-		procSetupDiEnumDeviceInterfaces.Addr(),
+	ret, _, err := procSetupDiEnumDeviceInterfaces.Call(
+		winx.Call{API: "SetupDiEnumDeviceInterfaces"},
+		winx.ReturnsBOOL,
 		uintptr(deviceInfoSet),
 		deviceInfoDataPtr,
 		uintptr(unsafe.Pointer(interfaceClassGuid)),
@@ -168,7 +171,6 @@ func SetupDiEnumDeviceInterfaces(
 	)
 
 	if ret == 0 {
-		err := syscall.GetLastError()
 		if err == ERROR_NO_MORE_ITEMS {
 			return false, nil
 		}
@@ -195,8 +197,9 @@ func SetupDiGetDeviceInterfaceDetail(
 
 	// First call to get required size
 	var requiredSize uint32
-	syscall.SyscallN(
-		procSetupDiGetDeviceInterfaceDetailW.Addr(),
+	procSetupDiGetDeviceInterfaceDetailW.Call(
+		winx.Call{API: "SetupDiGetDeviceInterfaceDetailW", OutputSize: int(requiredSize)},
+		winx.ReturnsBOOL,
 		uintptr(deviceInfoSet),
 		uintptr(unsafe.Pointer(deviceInterfaceData)),
 		0,
@@ -217,8 +220,9 @@ func SetupDiGetDeviceInterfaceDetail(
 	}
 
 	// Second call to get actual data
-	ret, _, _ := syscall.SyscallN(
-		procSetupDiGetDeviceInterfaceDetailW.Addr(),
+	ret, _, err := procSetupDiGetDeviceInterfaceDetailW.Call(
+		winx.Call{API: "SetupDiGetDeviceInterfaceDetailW", OutputSize: int(requiredSize)},
+		winx.ReturnsBOOL,
 		uintptr(deviceInfoSet),
 		uintptr(unsafe.Pointer(deviceInterfaceData)),
 		uintptr(unsafe.Pointer(detailDataPtr)),
@@ -228,7 +232,7 @@ func SetupDiGetDeviceInterfaceDetail(
 	)
 
 	if ret == 0 {
-		return "", syscall.GetLastError()
+		return "", err
 	}
 
 	// Extract device path from the structure
@@ -246,8 +250,9 @@ func SetupDiGetDeviceInterfaceDetail(
 // Returns:
 //   - true if successful, false otherwise
 func SetupDiDestroyDeviceInfoList(deviceInfoSet handle.HANDLE) bool {
-	ret, _, _ := syscall.SyscallN(
-		procSetupDiDestroyDeviceInfoList.Addr(),
+	ret, _, _ := procSetupDiDestroyDeviceInfoList.Call(
+		winx.Call{API: "SetupDiDestroyDeviceInfoList"},
+		winx.ReturnsBOOL,
 		uintptr(deviceInfoSet),
 	)
 	return ret != 0
@@ -272,8 +277,9 @@ func SetupDiGetDeviceRegistryProperty(
 	var requiredSize uint32
 	var regDataType uint32
 
-	syscall.SyscallN(
-		procSetupDiGetDeviceRegistryPropertyW.Addr(),
+	_, _, err := procSetupDiGetDeviceRegistryPropertyW.Call(
+		winx.Call{API: "SetupDiGetDeviceRegistryPropertyW", Detail: winx.TraceDetail("property %d", property), OutputSize: int(requiredSize)},
+		winx.ReturnsBOOL,
 		uintptr(deviceInfoSet),
 		uintptr(unsafe.Pointer(deviceInfoData)),
 		uintptr(property),
@@ -285,15 +291,16 @@ func SetupDiGetDeviceRegistryProperty(
 
 	// Check if buffer size is reasonable
 	if requiredSize == 0 || requiredSize > 65536 {
-		return "", syscall.GetLastError()
+		return "", err
 	}
 
 	// Allocate buffer
 	buffer := make([]byte, requiredSize)
 
 	// Second call to get actual data
-	ret, _, _ := syscall.SyscallN(
-		procSetupDiGetDeviceRegistryPropertyW.Addr(),
+	ret, _, err := procSetupDiGetDeviceRegistryPropertyW.Call(
+		winx.Call{API: "SetupDiGetDeviceRegistryPropertyW", Detail: winx.TraceDetail("property %d", property), OutputSize: int(requiredSize)},
+		winx.ReturnsBOOL,
 		uintptr(deviceInfoSet),
 		uintptr(unsafe.Pointer(deviceInfoData)),
 		uintptr(property),
@@ -304,7 +311,7 @@ func SetupDiGetDeviceRegistryProperty(
 	)
 
 	if ret == 0 {
-		return "", syscall.GetLastError()
+		return "", err
 	}
 
 	// Convert to string
@@ -332,15 +339,15 @@ func SetupDiEnumDeviceInfo(
 
 	deviceInfoData.CbSize = uint32(unsafe.Sizeof(*deviceInfoData))
 
-	ret, _, _ := syscall.SyscallN(
-		procSetupDiEnumDeviceInfo.Addr(),
+	ret, _, err := procSetupDiEnumDeviceInfo.Call(
+		winx.Call{API: "SetupDiEnumDeviceInfo"},
+		winx.ReturnsBOOL,
 		uintptr(deviceInfoSet),
 		uintptr(memberIndex),
 		uintptr(unsafe.Pointer(deviceInfoData)),
 	)
 
 	if ret == 0 {
-		err := syscall.GetLastError()
 		if err == ERROR_NO_MORE_ITEMS {
 			return false, nil
 		}
@@ -499,17 +506,29 @@ func FindDevicesByService(serviceName string) ([]DeviceInfo, error) {
 	return matchingDevices, nil
 }
 
-// GetDriverDevicePaths generates common device path patterns for a driver service name
+// GetDriverDevicePaths returns the Win32 paths of the devices a driver
+// service exposes, as found by DiscoverDriverDevices. If the object manager
+// namespace cannot be read it falls back to common path patterns built from
+// the name, Win32 and NT paths, both of which OpenDevice accepts.
 //
 // Parameters:
 //   - serviceName: The service/driver name (e.g., "CLFS", "AFD")
 //
 // Returns:
-//   - A slice of possible device paths to try
+//   - A slice of device paths to try
 func GetDriverDevicePaths(serviceName string) []string {
+	devices, err := DiscoverDriverDevices(serviceName)
+	if err == nil {
+		paths := make([]string, 0, len(devices))
+		for _, device := range devices {
+			paths = append(paths, device.Win32Path())
+		}
+		return paths
+	}
+
 	paths := []string{
 		`\\.\` + serviceName,
-		`\\Device\` + serviceName,
+		`\Device\` + serviceName,
 	}
 
 	// Add lowercase variant (use simple inline conversion)
@@ -524,7 +543,7 @@ func GetDriverDevicePaths(serviceName string) []string {
 
 	if lowerName != serviceName {
 		paths = append(paths, `\\.\`+lowerName)
-		paths = append(paths, `\\Device\`+lowerName)
+		paths = append(paths, `\Device\`+lowerName)
 	}
 
 	return paths
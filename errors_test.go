@@ -0,0 +1,189 @@
+package winx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx/exitcodes"
+)
+
+// TestNTSTATUS_Fields tests the severity/customer/facility/code decomposition
+func TestNTSTATUS_Fields(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       NTSTATUS
+		wantSeverity uint32
+		wantCustomer bool
+		wantFacility Facility
+		wantCode     uint16
+	}{
+		{"STATUS_SUCCESS", STATUS_SUCCESS, STATUS_SEVERITY_SUCCESS, false, 0, 0x0000},
+		{"STATUS_ACCESS_DENIED", STATUS_ACCESS_DENIED, STATUS_SEVERITY_ERROR, false, 0, 0x0022},
+		{"NTWIN32 wrapped error", NTSTATUS(0xC0070005), STATUS_SEVERITY_ERROR, false, FACILITY_NTWIN32, 0x0005},
+		{"customer warning", NTSTATUS(0xA0230001), STATUS_SEVERITY_WARNING, true, FACILITY_NDIS_ERROR_CODE, 0x0001},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.status.Severity(); got != tt.wantSeverity {
+				t.Errorf("Severity() = %d, want %d", got, tt.wantSeverity)
+			}
+			if got := tt.status.IsCustomer(); got != tt.wantCustomer {
+				t.Errorf("IsCustomer() = %v, want %v", got, tt.wantCustomer)
+			}
+			if got := tt.status.Facility(); got != tt.wantFacility {
+				t.Errorf("Facility() = %v, want %v", got, tt.wantFacility)
+			}
+			if got := tt.status.Code(); got != tt.wantCode {
+				t.Errorf("Code() = 0x%04X, want 0x%04X", got, tt.wantCode)
+			}
+		})
+	}
+}
+
+// TestNTSTATUS_String tests symbolic name resolution
+func TestNTSTATUS_String(t *testing.T) {
+	if got := STATUS_ACCESS_DENIED.String(); got != "STATUS_ACCESS_DENIED" {
+		t.Errorf("STATUS_ACCESS_DENIED.String() = %q", got)
+	}
+	if got := STATUS_DLL_NOT_FOUND.String(); got != "STATUS_DLL_NOT_FOUND" {
+		t.Errorf("STATUS_DLL_NOT_FOUND.String() = %q", got)
+	}
+	if got := NTSTATUS(0xE0FFFFFF).String(); got != "0xE0FFFFFF" {
+		t.Errorf("unknown NTSTATUS String() = %q, want %q", got, "0xE0FFFFFF")
+	}
+	if got := FACILITY_NTWIN32.String(); got != "FACILITY_NTWIN32" {
+		t.Errorf("FACILITY_NTWIN32.String() = %q", got)
+	}
+}
+
+// TestNTSTATUS_Error tests that NTSTATUS can be used as an error value
+func TestNTSTATUS_Error(t *testing.T) {
+	var err error = STATUS_ACCESS_DENIED
+	if !strings.Contains(err.Error(), "STATUS_ACCESS_DENIED") {
+		t.Errorf("Error() = %q, should contain the status name", err.Error())
+	}
+}
+
+// TestNTStatusError_Is tests errors.Is matching against NTSTATUS values
+func TestNTStatusError_Is(t *testing.T) {
+	err := NewNTStatusError(STATUS_ACCESS_DENIED, "opening \\Device\\Foo")
+	wrapped := fmt.Errorf("probe failed: %w", err)
+
+	if !errors.Is(wrapped, STATUS_ACCESS_DENIED) {
+		t.Error("errors.Is(wrapped, STATUS_ACCESS_DENIED) = false, want true")
+	}
+	if errors.Is(wrapped, STATUS_INVALID_HANDLE) {
+		t.Error("errors.Is(wrapped, STATUS_INVALID_HANDLE) = true, want false")
+	}
+	if !errors.Is(wrapped, &NTStatusError{Status: STATUS_ACCESS_DENIED}) {
+		t.Error("errors.Is(wrapped, &NTStatusError{STATUS_ACCESS_DENIED}) = false, want true")
+	}
+
+	var statusErr *NTStatusError
+	if !errors.As(wrapped, &statusErr) || statusErr.Status != STATUS_ACCESS_DENIED {
+		t.Errorf("errors.As did not recover the NTStatusError")
+	}
+}
+
+// TestNTStatusError_Unwrap tests unwrapping to the equivalent syscall.Errno
+func TestNTStatusError_Unwrap(t *testing.T) {
+	tests := []struct {
+		status NTSTATUS
+		want   syscall.Errno
+	}{
+		{STATUS_ACCESS_DENIED, syscall.Errno(5)},
+		{STATUS_BUFFER_TOO_SMALL, syscall.Errno(122)},
+		{STATUS_OBJECT_NAME_NOT_FOUND, syscall.Errno(2)},
+		{NTSTATUS(0xC0070020), syscall.Errno(32)},
+	}
+
+	for _, tt := range tests {
+		err := NewNTStatusError(tt.status, "")
+		if !errors.Is(err, tt.want) {
+			t.Errorf("errors.Is(%v, Errno(%d)) = false, want true", tt.status, uint32(tt.want))
+		}
+	}
+
+	if unwrapped := (&NTStatusError{Status: NTSTATUS(0xE0FFFFFF)}).Unwrap(); unwrapped != nil {
+		t.Errorf("Unwrap() of unknown status = %v, want nil", unwrapped)
+	}
+}
+
+// TestNTStatusError_Category tests classification through the exitcodes taxonomy
+func TestNTStatusError_Category(t *testing.T) {
+	err := fmt.Errorf("query: %w", NewNTStatusError(STATUS_INFO_LENGTH_MISMATCH, ""))
+	if !exitcodes.IsBufferTooSmall(err) {
+		t.Error("IsBufferTooSmall(STATUS_INFO_LENGTH_MISMATCH) = false, want true")
+	}
+	if !exitcodes.IsNotSupported(STATUS_INVALID_INFO_CLASS) {
+		t.Error("IsNotSupported(STATUS_INVALID_INFO_CLASS) = false, want true")
+	}
+	if !exitcodes.IsNotFound(STATUS_OBJECT_NAME_NOT_FOUND) {
+		t.Error("IsNotFound(STATUS_OBJECT_NAME_NOT_FOUND) = false, want true")
+	}
+}
+
+// TestNewNTStatusError tests that success yields a nil error
+func TestNewNTStatusError(t *testing.T) {
+	if err := NewNTStatusError(STATUS_SUCCESS, "ignored"); err != nil {
+		t.Errorf("NewNTStatusError(STATUS_SUCCESS) = %v, want nil", err)
+	}
+	err := NewNTStatusError(STATUS_INVALID_PARAMETER, "bad class")
+	want := "NTSTATUS 0xC000000D (STATUS_INVALID_PARAMETER): bad class"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+// TestProcNotFoundError tests matching a missing export or DLL by status and loader error
+func TestProcNotFoundError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     *ProcNotFoundError
+		status  NTSTATUS
+		errno   syscall.Errno
+		message string
+	}{
+		{
+			name:    "missing export",
+			err:     &ProcNotFoundError{DLL: "ntdll.dll", Proc: "NtQuerySystemInformationEx", Err: syscall.Errno(127)},
+			status:  STATUS_PROCEDURE_NOT_FOUND,
+			errno:   syscall.Errno(127),
+			message: "ntdll.dll!NtQuerySystemInformationEx is not available",
+		},
+		{
+			name:    "missing DLL",
+			err:     &ProcNotFoundError{DLL: "example.dll", Err: syscall.Errno(126)},
+			status:  STATUS_DLL_NOT_FOUND,
+			errno:   syscall.Errno(126),
+			message: "example.dll could not be loaded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapped := fmt.Errorf("query failed: %w", tt.err)
+			if !errors.Is(wrapped, tt.status) {
+				t.Errorf("errors.Is(%v, %v) = false, want true", wrapped, tt.status)
+			}
+			if errors.Is(wrapped, STATUS_ACCESS_DENIED) {
+				t.Errorf("errors.Is(%v, STATUS_ACCESS_DENIED) = true, want false", wrapped)
+			}
+			if !errors.Is(wrapped, tt.errno) {
+				t.Errorf("errors.Is(%v, Errno(%d)) = false, want true", wrapped, uint32(tt.errno))
+			}
+			if !strings.Contains(wrapped.Error(), tt.message) {
+				t.Errorf("Error() = %q, want it to contain %q", wrapped.Error(), tt.message)
+			}
+
+			var procErr *ProcNotFoundError
+			if !errors.As(wrapped, &procErr) || procErr.DLL != tt.err.DLL || procErr.Proc != tt.err.Proc {
+				t.Errorf("errors.As did not recover the ProcNotFoundError")
+			}
+		})
+	}
+}
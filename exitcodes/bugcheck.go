@@ -0,0 +1,584 @@
+package exitcodes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BugCheckParameterKind describes how a bug check parameter is decoded
+type BugCheckParameterKind uint8
+
+const (
+	// BugCheckParamValue is an opaque value printed in hexadecimal
+	BugCheckParamValue BugCheckParameterKind = iota
+	// BugCheckParamAddress is a kernel address or pointer
+	BugCheckParamAddress
+	// BugCheckParamNTStatus is an NTSTATUS code, resolved through NTStatusCodeMap
+	BugCheckParamNTStatus
+	// BugCheckParamIRQL is an interrupt request level
+	BugCheckParamIRQL
+	// BugCheckParamCount is a count or index printed in decimal
+	BugCheckParamCount
+	// BugCheckParamReserved is not used by the bug check
+	BugCheckParamReserved
+)
+
+// BugCheckParameter describes the meaning of one of the four bug check parameters
+type BugCheckParameter struct {
+	Description string
+	Kind        BugCheckParameterKind
+	// Values names well-known values of the parameter, such as the subtype
+	// codes many bug checks carry in their first parameter
+	Values map[uint64]string
+}
+
+// BugCheckCode represents a bug check (stop code) with its name, description
+// and the meaning of its four parameters
+type BugCheckCode struct {
+	Code        uint32
+	Name        string
+	Description string
+	Parameters  [4]BugCheckParameter
+}
+
+// bugCheckExtendedBit marks the 0x1000xxxx variants (e.g. 0x1000007E) that
+// have the same meaning and parameters as the code without the bit
+const bugCheckExtendedBit = 0x10000000
+
+var (
+	bugCheckReserved = BugCheckParameter{Description: "Reserved", Kind: BugCheckParamReserved}
+
+	bugCheckAccessTypes = map[uint64]string{
+		0x0:  "read",
+		0x1:  "write",
+		0x2:  "write",
+		0x8:  "execute",
+		0x10: "execute",
+	}
+
+	bugCheckIRQLNames = map[uint64]string{
+		0: "PASSIVE_LEVEL",
+		1: "APC_LEVEL",
+		2: "DISPATCH_LEVEL",
+	}
+)
+
+// BugCheckCodeMap contains the bug check codes most often seen on crashed systems
+var BugCheckCodeMap = map[uint32]BugCheckCode{
+	0x00000001: {0x00000001, "APC_INDEX_MISMATCH", "There is a mismatch in thread APC state, usually a driver that did not leave a critical or guarded region it entered.", [4]BugCheckParameter{
+		{Description: "Address of the system function or worker routine", Kind: BugCheckParamAddress},
+		{Description: "Thread's ApcStateIndex", Kind: BugCheckParamValue},
+		{Description: "Thread's CombinedApcDisable", Kind: BugCheckParamValue},
+		{Description: "Call type", Kind: BugCheckParamValue, Values: map[uint64]string{0: "system call", 1: "worker routine"}},
+	}},
+	0x0000000A: {0x0000000A, "IRQL_NOT_LESS_OR_EQUAL", "Kernel code accessed paged memory at DISPATCH_LEVEL or above, or accessed an invalid address.", [4]BugCheckParameter{
+		{Description: "Memory referenced", Kind: BugCheckParamAddress},
+		{Description: "IRQL at time of reference", Kind: BugCheckParamIRQL},
+		{Description: "Operation", Kind: BugCheckParamValue, Values: bugCheckAccessTypes},
+		{Description: "Address that referenced memory", Kind: BugCheckParamAddress},
+	}},
+	0x00000019: {0x00000019, "BAD_POOL_HEADER", "A pool header is corrupt.", [4]BugCheckParameter{
+		{Description: "Type of violation", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0x3:  "the pool freelist is corrupt",
+			0x5:  "a pair of adjacent pool entries have headers that contradict each other",
+			0x20: "a pool block header size is corrupt",
+		}},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+	}},
+	0x0000001A: {0x0000001A, "MEMORY_MANAGEMENT", "A severe memory management error occurred.", [4]BugCheckParameter{
+		{Description: "Type of violation", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0x1:     "the fork clone block reference count is corrupt",
+			0x31:    "the image relocation fix-up table or code stream has been corrupted",
+			0x403:   "the page table and PFNs are out of sync",
+			0x404:   "a PTE or PFN is corrupt",
+			0x41284: "a PTE or the working set list is corrupt",
+			0x41790: "a page table page has been corrupted",
+			0x61940: "a PDE has been unexpectedly invalidated",
+		}},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+	}},
+	0x0000001E: {0x0000001E, "KMODE_EXCEPTION_NOT_HANDLED", "A kernel-mode program generated an exception that the error handler did not catch.", [4]BugCheckParameter{
+		{Description: "Exception code that was not handled", Kind: BugCheckParamNTStatus},
+		{Description: "Address where the exception occurred", Kind: BugCheckParamAddress},
+		{Description: "Exception parameter 0", Kind: BugCheckParamValue},
+		{Description: "Exception parameter 1", Kind: BugCheckParamValue},
+	}},
+	0x00000024: {0x00000024, "NTFS_FILE_SYSTEM", "A problem occurred in ntfs.sys, the driver that reads and writes NTFS drives.", [4]BugCheckParameter{
+		{Description: "Source file and line number", Kind: BugCheckParamValue},
+		{Description: "Exception record, if any", Kind: BugCheckParamAddress},
+		{Description: "Context record, if any", Kind: BugCheckParamAddress},
+		{Description: "Address where the original exception occurred", Kind: BugCheckParamAddress},
+	}},
+	0x0000003B: {0x0000003B, "SYSTEM_SERVICE_EXCEPTION", "An exception happened while executing a routine that transitions from non-privileged code to privileged code.", [4]BugCheckParameter{
+		{Description: "Exception that caused the bug check", Kind: BugCheckParamNTStatus},
+		{Description: "Address of the instruction that caused the bug check", Kind: BugCheckParamAddress},
+		{Description: "Address of the context record", Kind: BugCheckParamAddress},
+		bugCheckReserved,
+	}},
+	0x00000044: {0x00000044, "MULTIPLE_IRP_COMPLETE_REQUESTS", "A driver tried to request an IRP be completed that is already complete.", [4]BugCheckParameter{
+		{Description: "Address of the IRP", Kind: BugCheckParamAddress},
+		bugCheckReserved,
+		bugCheckReserved,
+		bugCheckReserved,
+	}},
+	0x0000004A: {0x0000004A, "IRQL_GT_ZERO_AT_SYSTEM_SERVICE", "A thread returned to user mode from a system call when its IRQL was still above PASSIVE_LEVEL.", [4]BugCheckParameter{
+		{Description: "Address of the system function", Kind: BugCheckParamAddress},
+		{Description: "IRQL at the time of the system call", Kind: BugCheckParamIRQL},
+		bugCheckReserved,
+		bugCheckReserved,
+	}},
+	0x0000004E: {0x0000004E, "PFN_LIST_CORRUPT", "The memory manager's page frame number list is corrupt.", [4]BugCheckParameter{
+		{Description: "Type of violation", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0x1:  "the list head was corrupt",
+			0x2:  "the list entry being removed was corrupt",
+			0x7:  "a driver has unlocked a page more times than it locked it",
+			0x8F: "the free or zeroed page listhead is corrupt",
+			0x99: "a PTE or PFN is corrupt",
+			0x9A: "a driver attempted to free a page that is still locked for I/O",
+		}},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+	}},
+	0x00000050: {0x00000050, "PAGE_FAULT_IN_NONPAGED_AREA", "Invalid system memory has been referenced.", [4]BugCheckParameter{
+		{Description: "Memory referenced", Kind: BugCheckParamAddress},
+		{Description: "Operation", Kind: BugCheckParamValue, Values: bugCheckAccessTypes},
+		{Description: "Address that referenced memory, if known", Kind: BugCheckParamAddress},
+		{Description: "Type of page fault", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0x0: "non-paged pool page",
+			0x2: "session page or kernel stack",
+			0x3: "paged pool page",
+		}},
+	}},
+	0x0000007A: {0x0000007A, "KERNEL_DATA_INPAGE_ERROR", "The requested page of kernel data from the paging file could not be read into memory.", [4]BugCheckParameter{
+		{Description: "Lock type that was held, or the PTE address", Kind: BugCheckParamValue},
+		{Description: "I/O status code", Kind: BugCheckParamNTStatus},
+		{Description: "Current process, or the virtual address", Kind: BugCheckParamAddress},
+		{Description: "Virtual address that could not be paged in, or the offset in the paging file", Kind: BugCheckParamAddress},
+	}},
+	0x0000007B: {0x0000007B, "INACCESSIBLE_BOOT_DEVICE", "Windows lost access to the system partition during startup.", [4]BugCheckParameter{
+		{Description: "Address of a UNICODE_STRING or of the device object that could not be mounted", Kind: BugCheckParamAddress},
+		{Description: "Status code", Kind: BugCheckParamNTStatus},
+		bugCheckReserved,
+		bugCheckReserved,
+	}},
+	0x0000007E: {0x0000007E, "SYSTEM_THREAD_EXCEPTION_NOT_HANDLED", "A system thread generated an exception that the error handler did not catch.", [4]BugCheckParameter{
+		{Description: "Exception code that was not handled", Kind: BugCheckParamNTStatus},
+		{Description: "Address where the exception occurred", Kind: BugCheckParamAddress},
+		{Description: "Exception record address", Kind: BugCheckParamAddress},
+		{Description: "Context record address", Kind: BugCheckParamAddress},
+	}},
+	0x0000007F: {0x0000007F, "UNEXPECTED_KERNEL_MODE_TRAP", "The CPU generated a trap that the kernel failed to catch.", [4]BugCheckParameter{
+		{Description: "Trap number", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0x0: "divide by zero",
+			0x4: "overflow",
+			0x5: "bound check fault",
+			0x6: "invalid opcode",
+			0x8: "double fault",
+		}},
+		bugCheckReserved,
+		bugCheckReserved,
+		bugCheckReserved,
+	}},
+	0x0000008E: {0x0000008E, "KERNEL_MODE_EXCEPTION_NOT_HANDLED", "A kernel-mode application generated an exception that the error handler did not catch.", [4]BugCheckParameter{
+		{Description: "Exception code that was not handled", Kind: BugCheckParamNTStatus},
+		{Description: "Address where the exception occurred", Kind: BugCheckParamAddress},
+		{Description: "Trap frame", Kind: BugCheckParamAddress},
+		bugCheckReserved,
+	}},
+	0x0000009F: {0x0000009F, "DRIVER_POWER_STATE_FAILURE", "A driver is in an inconsistent or invalid power state.", [4]BugCheckParameter{
+		{Description: "Type of violation", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0x1: "the device object being freed still has an outstanding power request",
+			0x2: "the device object completed the IRP for the system power state request but did not call PoStartNextPowerIrp",
+			0x3: "a device object has been blocking an IRP for too long a time",
+			0x4: "the power state transition timed out waiting to synchronize with the PnP subsystem",
+		}},
+		{Description: "Device object, or the physical device object of the stack", Kind: BugCheckParamAddress},
+		{Description: "Driver object, or nt!TRIAGE_9F_POWER", Kind: BugCheckParamAddress},
+		{Description: "Pending or blocked IRP", Kind: BugCheckParamAddress},
+	}},
+	0x000000A0: {0x000000A0, "INTERNAL_POWER_ERROR", "The power policy manager experienced a fatal error.", [4]BugCheckParameter{
+		{Description: "Type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+	}},
+	0x000000BE: {0x000000BE, "ATTEMPTED_WRITE_TO_READONLY_MEMORY", "A driver attempted to write to read-only memory.", [4]BugCheckParameter{
+		{Description: "Virtual address of the attempted write", Kind: BugCheckParamAddress},
+		{Description: "PTE contents", Kind: BugCheckParamValue},
+		bugCheckReserved,
+		bugCheckReserved,
+	}},
+	0x000000C2: {0x000000C2, "BAD_POOL_CALLER", "The current thread is making a bad pool request.", [4]BugCheckParameter{
+		{Description: "Type of violation", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0x0:  "the caller is requesting a zero byte pool allocation",
+			0x1:  "the pool header has been corrupted",
+			0x2:  "the pool header has been corrupted",
+			0x4:  "the pool header has been corrupted",
+			0x6:  "the caller is trying to free a memory pool that was already freed",
+			0x7:  "the caller is trying to free a memory pool that was already freed",
+			0x8:  "the caller is trying to allocate pool at an invalid IRQL",
+			0x9:  "the caller is trying to free pool at an invalid IRQL",
+			0x99: "the caller is trying to free pool with an invalid address",
+		}},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+	}},
+	0x000000C4: {0x000000C4, "DRIVER_VERIFIER_DETECTED_VIOLATION", "Driver Verifier detected a fatal error in the driver being verified.", [4]BugCheckParameter{
+		{Description: "Type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+	}},
+	0x000000C5: {0x000000C5, "DRIVER_CORRUPTED_EXPOOL", "The system attempted to access invalid memory at a process IRQL that was too high, usually because a driver corrupted the system pool.", [4]BugCheckParameter{
+		{Description: "Memory referenced", Kind: BugCheckParamAddress},
+		{Description: "IRQL at time of reference", Kind: BugCheckParamIRQL},
+		{Description: "Operation", Kind: BugCheckParamValue, Values: bugCheckAccessTypes},
+		{Description: "Address that referenced memory", Kind: BugCheckParamAddress},
+	}},
+	0x000000C7: {0x000000C7, "TIMER_OR_DPC_INVALID", "A kernel timer or DPC was found in memory where it is not permitted, such as memory being freed or unloaded driver code.", [4]BugCheckParameter{
+		{Description: "Type of object", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0x0: "timer object",
+			0x1: "DPC object",
+			0x2: "DPC routine",
+		}},
+		{Description: "Address of the object", Kind: BugCheckParamAddress},
+		{Description: "Beginning of the memory range checked", Kind: BugCheckParamAddress},
+		{Description: "End of the memory range checked", Kind: BugCheckParamAddress},
+	}},
+	0x000000CA: {0x000000CA, "PNP_DETECTED_FATAL_ERROR", "The Plug and Play Manager encountered a severe error, probably as a result of a problematic Plug and Play driver.", [4]BugCheckParameter{
+		{Description: "Type of violation", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0x1: "duplicate PDO",
+			0x2: "invalid PDO",
+			0x3: "invalid ID",
+			0x4: "invalid enumeration of deleted PDO",
+			0x5: "PDO freed while linked in devnode tree",
+		}},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+		{Description: "Depends on the type of violation", Kind: BugCheckParamValue},
+	}},
+	0x000000D1: {0x000000D1, "DRIVER_IRQL_NOT_LESS_OR_EQUAL", "A kernel-mode driver attempted to access pageable memory at a process IRQL that was too high.", [4]BugCheckParameter{
+		{Description: "Memory referenced", Kind: BugCheckParamAddress},
+		{Description: "IRQL at time of reference", Kind: BugCheckParamIRQL},
+		{Description: "Operation", Kind: BugCheckParamValue, Values: bugCheckAccessTypes},
+		{Description: "Address that referenced memory", Kind: BugCheckParamAddress},
+	}},
+	0x000000D5: {0x000000D5, "DRIVER_PAGE_FAULT_IN_FREED_SPECIAL_POOL", "A driver has referenced memory that was earlier freed.", [4]BugCheckParameter{
+		{Description: "Memory referenced", Kind: BugCheckParamAddress},
+		{Description: "Operation", Kind: BugCheckParamValue, Values: bugCheckAccessTypes},
+		{Description: "Address that referenced memory, if known", Kind: BugCheckParamAddress},
+		bugCheckReserved,
+	}},
+	0x000000D6: {0x000000D6, "DRIVER_PAGE_FAULT_BEYOND_END_OF_ALLOCATION", "A driver accessed memory beyond the end of its pool allocation.", [4]BugCheckParameter{
+		{Description: "Memory referenced", Kind: BugCheckParamAddress},
+		{Description: "Operation", Kind: BugCheckParamValue, Values: bugCheckAccessTypes},
+		{Description: "Address that referenced memory, if known", Kind: BugCheckParamAddress},
+		bugCheckReserved,
+	}},
+	0x000000E2: {0x000000E2, "MANUALLY_INITIATED_CRASH", "The user deliberately initiated a crash dump from either the kernel debugger or the keyboard.", [4]BugCheckParameter{
+		bugCheckReserved,
+		bugCheckReserved,
+		bugCheckReserved,
+		bugCheckReserved,
+	}},
+	0x000000EA: {0x000000EA, "THREAD_STUCK_IN_DEVICE_DRIVER", "A thread in a device driver is endlessly spinning, usually waiting for graphics hardware to become idle.", [4]BugCheckParameter{
+		{Description: "Pointer to the stuck thread object", Kind: BugCheckParamAddress},
+		{Description: "Pointer to the DEFERRED_WATCHDOG object", Kind: BugCheckParamAddress},
+		{Description: "Pointer to the offending driver name", Kind: BugCheckParamAddress},
+		{Description: "Number of times the stall was intercepted", Kind: BugCheckParamCount},
+	}},
+	0x000000EF: {0x000000EF, "CRITICAL_PROCESS_DIED", "A critical system process died.", [4]BugCheckParameter{
+		{Description: "Process object", Kind: BugCheckParamAddress},
+		{Description: "What terminated", Kind: BugCheckParamValue, Values: map[uint64]string{0: "a process", 1: "a thread"}},
+		bugCheckReserved,
+		bugCheckReserved,
+	}},
+	0x000000F4: {0x000000F4, "CRITICAL_OBJECT_TERMINATION", "A process or thread crucial to system operation has unexpectedly exited or been terminated.", [4]BugCheckParameter{
+		{Description: "Type of terminating object", Kind: BugCheckParamValue, Values: map[uint64]string{0x3: "process", 0x6: "thread"}},
+		{Description: "Terminating object", Kind: BugCheckParamAddress},
+		{Description: "Process image file name", Kind: BugCheckParamAddress},
+		{Description: "Pointer to an ASCII string containing an explanatory message", Kind: BugCheckParamAddress},
+	}},
+	0x000000F7: {0x000000F7, "DRIVER_OVERRAN_STACK_BUFFER", "A driver has overrun a stack-based buffer.", [4]BugCheckParameter{
+		{Description: "Actual security check cookie from the stack", Kind: BugCheckParamValue},
+		{Description: "Expected security check cookie", Kind: BugCheckParamValue},
+		{Description: "Complement of the expected security check cookie", Kind: BugCheckParamValue},
+		bugCheckReserved,
+	}},
+	0x000000FC: {0x000000FC, "ATTEMPTED_EXECUTE_OF_NOEXECUTE_MEMORY", "An attempt was made to execute non-executable memory.", [4]BugCheckParameter{
+		{Description: "Virtual address of the attempted execute", Kind: BugCheckParamAddress},
+		{Description: "PTE contents", Kind: BugCheckParamValue},
+		bugCheckReserved,
+		bugCheckReserved,
+	}},
+	0x00000101: {0x00000101, "CLOCK_WATCHDOG_TIMEOUT", "An expected clock interrupt on a secondary processor was not received within the allocated interval.", [4]BugCheckParameter{
+		{Description: "Clock interrupt time-out interval, in nominal clock ticks", Kind: BugCheckParamCount},
+		bugCheckReserved,
+		{Description: "Address of the PRCB of the unresponsive processor", Kind: BugCheckParamAddress},
+		{Description: "Index of the hung processor", Kind: BugCheckParamCount},
+	}},
+	0x00000109: {0x00000109, "CRITICAL_STRUCTURE_CORRUPTION", "The kernel detected critical kernel code or data corruption.", [4]BugCheckParameter{
+		bugCheckReserved,
+		bugCheckReserved,
+		bugCheckReserved,
+		{Description: "Type of corrupted region", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0x0: "a generic data region",
+			0x1: "modification of a function or .pdata",
+			0x2: "a processor IDT",
+			0x3: "a processor GDT",
+			0x4: "type 1 process list corruption",
+			0x5: "type 2 process list corruption",
+			0x6: "debug routine modification",
+			0x7: "critical MSR modification",
+			0x8: "object type",
+			0x9: "a processor IVT",
+		}},
+	}},
+	0x00000116: {0x00000116, "VIDEO_TDR_FAILURE", "An attempt to reset the display driver and recover from a timeout failed.", [4]BugCheckParameter{
+		{Description: "Pointer to the internal TDR recovery context", Kind: BugCheckParamAddress},
+		{Description: "Pointer into the responsible device driver module", Kind: BugCheckParamAddress},
+		{Description: "Error code of the last failed operation", Kind: BugCheckParamNTStatus},
+		{Description: "Internal context dependent data", Kind: BugCheckParamValue},
+	}},
+	0x00000124: {0x00000124, "WHEA_UNCORRECTABLE_ERROR", "A fatal hardware error has occurred.", [4]BugCheckParameter{
+		{Description: "Type of error source", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0x0: "machine check exception",
+			0x1: "corrected machine check",
+			0x2: "corrected platform error",
+			0x3: "nonmaskable interrupt (NMI)",
+			0x4: "PCI Express error",
+			0x5: "generic error",
+			0x6: "initialization error",
+			0x7: "BOOT error",
+			0x8: "scalable coherent interface (SCI) generic error",
+		}},
+		{Description: "Address of the WHEA_ERROR_RECORD structure", Kind: BugCheckParamAddress},
+		{Description: "High 32 bits of the MCi_STATUS MSR for a machine check exception, or zero", Kind: BugCheckParamValue},
+		{Description: "Low 32 bits of the MCi_STATUS MSR for a machine check exception, or zero", Kind: BugCheckParamValue},
+	}},
+	0x0000012B: {0x0000012B, "FAULTY_HARDWARE_CORRUPTED_PAGE", "A single-bit or multi-bit error was found in a physical memory page.", [4]BugCheckParameter{
+		{Description: "Virtual address that maps to the corrupted page", Kind: BugCheckParamAddress},
+		{Description: "Physical page number", Kind: BugCheckParamValue},
+		{Description: "Zero page contents that should have been present", Kind: BugCheckParamValue},
+		{Description: "Address of the corrupted data", Kind: BugCheckParamAddress},
+	}},
+	0x00000133: {0x00000133, "DPC_WATCHDOG_VIOLATION", "The DPC watchdog executed, either because a single long-running DPC or the processor spent an extended time at DISPATCH_LEVEL or above.", [4]BugCheckParameter{
+		{Description: "Type of violation", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0x0: "a single DPC or ISR exceeded its time allotment",
+			0x1: "the system cumulatively spent an extended period of time at DISPATCH_LEVEL or above",
+		}},
+		{Description: "DPC time count, in ticks", Kind: BugCheckParamCount},
+		{Description: "DPC time allotment, in ticks", Kind: BugCheckParamCount},
+		{Description: "nt!DPC_WATCHDOG_GLOBAL_TRIAGE_BLOCK", Kind: BugCheckParamAddress},
+	}},
+	0x00000139: {0x00000139, "KERNEL_SECURITY_CHECK_FAILURE", "The kernel has detected the corruption of a critical data structure.", [4]BugCheckParameter{
+		{Description: "Type of corruption", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0:  "a stack-based buffer has been overrun (legacy /GS violation)",
+			1:  "VTGuard instrumentation code detected an attempt to use an illegal virtual function table",
+			2:  "stack cookie instrumentation code detected a stack-based buffer overrun (/GS violation)",
+			3:  "a LIST_ENTRY has been corrupted (i.e. double remove)",
+			4:  "the stack pointer was outside the current thread's stack",
+			5:  "an invalid parameter was passed to a function that considers invalid parameters fatal",
+			6:  "the stack cookie security cookie was not properly initialized by the loader",
+			7:  "a fatal program exit was requested",
+			8:  "an array bounds check inserted by the compiler detected an illegal array indexing operation",
+			9:  "a call to RtlQueryRegistryValues was made specifying RTL_QUERY_REGISTRY_DIRECT without RTL_QUERY_REGISTRY_TYPECHECK, and the target value was not in a trusted system hive",
+			10: "indirect call guard check detected invalid control transfer",
+			11: "write guard check detected invalid memory write",
+			12: "an attempt was made to switch to an invalid fiber context",
+			13: "an attempt was made to assign an invalid register context",
+			14: "the reference count for an object is invalid",
+			18: "an attempt was made to switch to an invalid jmp_buf context",
+			19: "an unsafe modification was made to read-only data",
+			20: "a cryptographic self-test failed",
+			21: "an invalid exception chain was detected",
+			22: "a cryptographic library error occurred",
+			23: "an invalid call was made from within DllMain",
+			24: "an invalid image base address was detected",
+			25: "an unrecoverable failure was encountered while protecting a delay load import",
+			26: "a call was made to an unsafe extension",
+			27: "a deprecated service was invoked",
+			28: "an out of bounds buffer access was detected",
+			29: "an RTL_BALANCED_NODE RBTree entry has been corrupted",
+			37: "an out of range switch jumptable entry was invoked",
+			38: "a longjmp was attempted to an invalid target",
+		}},
+		{Description: "Address of the trap frame for the exception that caused the bug check", Kind: BugCheckParamAddress},
+		{Description: "Address of the exception record for the exception that caused the bug check", Kind: BugCheckParamAddress},
+		bugCheckReserved,
+	}},
+	0x0000013A: {0x0000013A, "KERNEL_MODE_HEAP_CORRUPTION", "The kernel mode heap manager has detected corruption in a heap.", [4]BugCheckParameter{
+		{Description: "Type of corruption", Kind: BugCheckParamValue, Values: map[uint64]string{
+			0x3: "a corrupt entry header was detected",
+			0x4: "multiple corrupt entry headers were detected",
+			0x5: "a corrupt entry header in a large allocation was detected",
+			0x6: "a corruption was detected with features consistent with a buffer overrun",
+			0x7: "a corruption was detected with features consistent with a buffer underrun",
+			0x8: "a free block was passed to an operation that is only valid for busy blocks",
+			0x9: "an invalid argument was specified for the current operation",
+		}},
+		{Description: "Address of the heap that reported the corruption", Kind: BugCheckParamAddress},
+		{Description: "Address at which the corruption was detected", Kind: BugCheckParamAddress},
+		bugCheckReserved,
+	}},
+	0x00000141: {0x00000141, "VIDEO_ENGINE_TIMEOUT_DETECTED", "One of the display engines failed to respond in a timely fashion.", [4]BugCheckParameter{
+		{Description: "Optional pointer to the internal TDR recovery context", Kind: BugCheckParamAddress},
+		{Description: "Pointer into the responsible device driver module", Kind: BugCheckParamAddress},
+		{Description: "ID of the node that timed out", Kind: BugCheckParamValue},
+		{Description: "Optional error code of the last failed operation", Kind: BugCheckParamNTStatus},
+	}},
+	0x00000154: {0x00000154, "UNEXPECTED_STORE_EXCEPTION", "The kernel memory store component caught an unexpected exception.", [4]BugCheckParameter{
+		{Description: "Pointer to the store context or data manager", Kind: BugCheckParamAddress},
+		{Description: "Exception information", Kind: BugCheckParamAddress},
+		bugCheckReserved,
+		bugCheckReserved,
+	}},
+	0xC000021A: {0xC000021A, "WINLOGON_FATAL_ERROR", "The Winlogon or Client Server Run-Time Subsystem (CSRSS) process has failed.", [4]BugCheckParameter{
+		{Description: "Pointer to a string that identifies the problem", Kind: BugCheckParamAddress},
+		{Description: "Error code", Kind: BugCheckParamNTStatus},
+		bugCheckReserved,
+		bugCheckReserved,
+	}},
+	0xC0000221: {0xC0000221, "STATUS_IMAGE_CHECKSUM_MISMATCH", "A driver or a system DLL has been corrupted.", [4]BugCheckParameter{
+		{Description: "Pointer to the name of the driver or DLL", Kind: BugCheckParamAddress},
+		bugCheckReserved,
+		bugCheckReserved,
+		bugCheckReserved,
+	}},
+	0xDEADDEAD: {0xDEADDEAD, "MANUALLY_INITIATED_CRASH1", "The user deliberately initiated a crash dump from either the kernel debugger or the keyboard.", [4]BugCheckParameter{
+		bugCheckReserved,
+		bugCheckReserved,
+		bugCheckReserved,
+		bugCheckReserved,
+	}},
+}
+
+// lookupBugCheck finds a bug check code, falling back to the code without
+// bugCheckExtendedBit for the 0x1000xxxx variants
+func lookupBugCheck(code uint32) (BugCheckCode, bool) {
+	if bugCheck, exists := BugCheckCodeMap[code]; exists {
+		return bugCheck, true
+	}
+	if code&0xFFFF0000 == bugCheckExtendedBit {
+		if bugCheck, exists := BugCheckCodeMap[code&^bugCheckExtendedBit]; exists {
+			bugCheck.Code = code
+			return bugCheck, true
+		}
+	}
+	return BugCheckCode{}, false
+}
+
+// GetBugCheckName returns the symbolic name for a given bug check code
+func GetBugCheckName(code uint32) (string, error) {
+	if bugCheck, exists := lookupBugCheck(code); exists {
+		return bugCheck.Name, nil
+	}
+	return "", fmt.Errorf("bug check code 0x%08X not found", code)
+}
+
+// GetBugCheckDescription returns the description for a given bug check code
+func GetBugCheckDescription(code uint32) (string, error) {
+	if bugCheck, exists := lookupBugCheck(code); exists {
+		return bugCheck.Description, nil
+	}
+	return "", fmt.Errorf("bug check code 0x%08X not found", code)
+}
+
+// GetBugCheckCode returns the full BugCheckCode struct for a given code
+func GetBugCheckCode(code uint32) (BugCheckCode, error) {
+	if bugCheck, exists := lookupBugCheck(code); exists {
+		return bugCheck, nil
+	}
+	return BugCheckCode{}, fmt.Errorf("bug check code 0x%08X not found", code)
+}
+
+// Decode renders a parameter value according to the parameter's kind,
+// e.g. "STATUS_ACCESS_VIOLATION" for an NTSTATUS or "DISPATCH_LEVEL" for an IRQL.
+// It returns an empty string if there is nothing to add to the raw value.
+func (p BugCheckParameter) Decode(value uint64) string {
+	if name, exists := p.Values[value]; exists {
+		return name
+	}
+	switch p.Kind {
+	case BugCheckParamNTStatus:
+		// Parameters are pointer sized, so NTSTATUS values are often sign-extended
+		if name, err := GetNTStatusName(uint32(value)); err == nil {
+			return name
+		}
+	case BugCheckParamIRQL:
+		if name, exists := bugCheckIRQLNames[value]; exists {
+			return name
+		}
+		return "IRQL " + strconv.FormatUint(value, 10)
+	case BugCheckParamCount:
+		return strconv.FormatUint(value, 10)
+	}
+	return ""
+}
+
+// FormatBugCheck returns a formatted string containing the bug check name,
+// description and each parameter with its meaning and decoded value
+//
+// Example:
+//
+//	fmt.Println(FormatBugCheck(0x7E, [4]uint64{0xFFFFFFFFC0000005, 0xFFFFF8025E4D5A1B, 0xFFFFDD0BD2A06A88, 0xFFFFDD0BD2A062D0}))
+//	// [BugCheck: 0x0000007E] SYSTEM_THREAD_EXCEPTION_NOT_HANDLED: A system thread generated ...
+//	//   Arg1: 0xFFFFFFFFC0000005 Exception code that was not handled (STATUS_ACCESS_VIOLATION)
+//	//   ...
+func FormatBugCheck(code uint32, params [4]uint64) string {
+	bugCheck, exists := lookupBugCheck(code)
+	if !exists {
+		return fmt.Sprintf("Unknown bug check code: 0x%08X (0x%016X, 0x%016X, 0x%016X, 0x%016X)",
+			code, params[0], params[1], params[2], params[3])
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[BugCheck: 0x%08X] %s: %s", bugCheck.Code, bugCheck.Name, bugCheck.Description)
+	for i, param := range bugCheck.Parameters {
+		fmt.Fprintf(&b, "\n  Arg%d: 0x%016X %s", i+1, params[i], param.Description)
+		if decoded := param.Decode(params[i]); decoded != "" {
+			fmt.Fprintf(&b, " (%s)", decoded)
+		}
+	}
+	return b.String()
+}
+
+// ParseBugCheck parses a bug check as written to the System event log and by
+// WER, e.g. "0x0000007e (0xffffffffc0000005, 0xfffff8025e4d5a1b, 0x0, 0x0)".
+// The bug check code may also be given without parameters.
+func ParseBugCheck(s string) (uint32, [4]uint64, error) {
+	var params [4]uint64
+
+	s = strings.TrimSpace(s)
+	codeText, rest, hasParams := strings.Cut(s, "(")
+	code, err := strconv.ParseUint(strings.TrimSpace(codeText), 0, 32)
+	if err != nil {
+		return 0, params, fmt.Errorf("invalid bug check code %q: %v", strings.TrimSpace(codeText), err)
+	}
+	if !hasParams {
+		return uint32(code), params, nil
+	}
+
+	rest, closed := strings.CutSuffix(strings.TrimSpace(rest), ")")
+	if !closed {
+		return 0, params, fmt.Errorf("missing closing parenthesis in %q", s)
+	}
+	fields := strings.Split(rest, ",")
+	if len(fields) != len(params) {
+		return 0, params, fmt.Errorf("expected %d bug check parameters, got %d", len(params), len(fields))
+	}
+	for i, field := range fields {
+		params[i], err = strconv.ParseUint(strings.TrimSpace(field), 0, 64)
+		if err != nil {
+			return 0, params, fmt.Errorf("invalid bug check parameter %d: %v", i+1, err)
+		}
+	}
+	return uint32(code), params, nil
+}
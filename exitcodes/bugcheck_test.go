@@ -0,0 +1,126 @@
+package exitcodes
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetBugCheckName(t *testing.T) {
+	tests := []struct {
+		code    uint32
+		want    string
+		wantErr bool
+	}{
+		{0x0000000A, "IRQL_NOT_LESS_OR_EQUAL", false},
+		{0x000000D1, "DRIVER_IRQL_NOT_LESS_OR_EQUAL", false},
+		{0x00000139, "KERNEL_SECURITY_CHECK_FAILURE", false},
+		{0x1000007E, "SYSTEM_THREAD_EXCEPTION_NOT_HANDLED", false}, // extended variant of 0x7E
+		{0xC000021A, "WINLOGON_FATAL_ERROR", false},
+		{0x0000FFFF, "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := GetBugCheckName(tt.code)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("GetBugCheckName(0x%08X) error = %v, wantErr %v", tt.code, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("GetBugCheckName(0x%08X) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestBugCheckParameterDecode(t *testing.T) {
+	tests := []struct {
+		name  string
+		param BugCheckParameter
+		value uint64
+		want  string
+	}{
+		{"sign-extended NTSTATUS", BugCheckParameter{Kind: BugCheckParamNTStatus}, 0xFFFFFFFFC0000005, "STATUS_ACCESS_VIOLATION"},
+		{"unknown NTSTATUS", BugCheckParameter{Kind: BugCheckParamNTStatus}, 0xE0FFFFFF, ""},
+		{"IRQL", BugCheckParameter{Kind: BugCheckParamIRQL}, 2, "DISPATCH_LEVEL"},
+		{"device IRQL", BugCheckParameter{Kind: BugCheckParamIRQL}, 11, "IRQL 11"},
+		{"count", BugCheckParameter{Kind: BugCheckParamCount}, 30, "30"},
+		{"address", BugCheckParameter{Kind: BugCheckParamAddress}, 0xFFFFF80212345678, ""},
+		{"named value", BugCheckParameter{Values: map[uint64]string{3: "three"}}, 3, "three"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.param.Decode(tt.value); got != tt.want {
+				t.Errorf("Decode(0x%X) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatBugCheck(t *testing.T) {
+	got := FormatBugCheck(0xD1, [4]uint64{0x28, 0x2, 0x0, 0xFFFFF80212345678})
+	want := "[BugCheck: 0x000000D1] DRIVER_IRQL_NOT_LESS_OR_EQUAL: A kernel-mode driver attempted to access pageable memory at a process IRQL that was too high." +
+		"\n  Arg1: 0x0000000000000028 Memory referenced" +
+		"\n  Arg2: 0x0000000000000002 IRQL at time of reference (DISPATCH_LEVEL)" +
+		"\n  Arg3: 0x0000000000000000 Operation (read)" +
+		"\n  Arg4: 0xFFFFF80212345678 Address that referenced memory"
+	if got != want {
+		t.Errorf("FormatBugCheck(0xD1) =\n%s\nwant\n%s", got, want)
+	}
+
+	got = FormatBugCheck(0x1000007E, [4]uint64{0xFFFFFFFFC0000005, 0, 0, 0})
+	if !strings.HasPrefix(got, "[BugCheck: 0x1000007E] SYSTEM_THREAD_EXCEPTION_NOT_HANDLED") ||
+		!strings.Contains(got, "Arg1: 0xFFFFFFFFC0000005 Exception code that was not handled (STATUS_ACCESS_VIOLATION)") {
+		t.Errorf("FormatBugCheck(0x1000007E) = %s", got)
+	}
+
+	got = FormatBugCheck(0x0000FFFF, [4]uint64{1, 2, 3, 4})
+	want = "Unknown bug check code: 0x0000FFFF (0x0000000000000001, 0x0000000000000002, 0x0000000000000003, 0x0000000000000004)"
+	if got != want {
+		t.Errorf("FormatBugCheck(0xFFFF) = %q, want %q", got, want)
+	}
+}
+
+func TestParseBugCheck(t *testing.T) {
+	tests := []struct {
+		input      string
+		wantCode   uint32
+		wantParams [4]uint64
+		wantErr    bool
+	}{
+		{
+			"0x0000007e (0xffffffffc0000005, 0xfffff8025e4d5a1b, 0xffffdd0bd2a06a88, 0xffffdd0bd2a062d0)",
+			0x7E, [4]uint64{0xFFFFFFFFC0000005, 0xFFFFF8025E4D5A1B, 0xFFFFDD0BD2A06A88, 0xFFFFDD0BD2A062D0}, false,
+		},
+		{"0x00000133", 0x133, [4]uint64{}, false},
+		{"0x133 (0x1, 0x1e00)", 0, [4]uint64{}, true},
+		{"0x133 (0x1, 0x2, 0x3, 0x4", 0, [4]uint64{}, true},
+		{"stop", 0, [4]uint64{}, true},
+	}
+
+	for _, tt := range tests {
+		code, params, err := ParseBugCheck(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseBugCheck(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if err == nil && (code != tt.wantCode || params != tt.wantParams) {
+			t.Errorf("ParseBugCheck(%q) = 0x%X, %X, want 0x%X, %X", tt.input, code, params, tt.wantCode, tt.wantParams)
+		}
+	}
+}
+
+func TestBugCheckCodeStructure(t *testing.T) {
+	for code, bugCheck := range BugCheckCodeMap {
+		if bugCheck.Code != code {
+			t.Errorf("BugCheckCodeMap[0x%08X].Code = 0x%08X, want 0x%08X", code, bugCheck.Code, code)
+		}
+		if bugCheck.Name == "" || bugCheck.Description == "" {
+			t.Errorf("BugCheckCodeMap[0x%08X] has an empty name or description", code)
+		}
+		for i, param := range bugCheck.Parameters {
+			if param.Description == "" {
+				t.Errorf("BugCheckCodeMap[0x%08X].Parameters[%d] has no description", code, i)
+			}
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package exitcodes
+
+import (
+	"errors"
+	"strings"
+	"syscall"
+)
+
+// Category classifies an error code by how callers usually react to it.
+// A code can belong to more than one category.
+type Category uint32
+
+const (
+	// CategoryRetryable marks transient failures that may succeed if retried
+	CategoryRetryable Category = 1 << iota
+	// CategoryNotFound marks missing files, objects, services and devices
+	CategoryNotFound
+	// CategoryAccessDenied marks failures caused by missing rights or privileges
+	CategoryAccessDenied
+	// CategoryBufferTooSmall marks failures where a larger buffer is required
+	CategoryBufferTooSmall
+	// CategoryNotSupported marks requests the target does not implement
+	CategoryNotSupported
+	// CategoryInvalidParameter marks requests that were recognized but rejected
+	// because of their arguments
+	CategoryInvalidParameter
+)
+
+// CategoryNone is returned for codes that do not fall into any category
+const CategoryNone Category = 0
+
+var categoryNames = []struct {
+	category Category
+	name     string
+}{
+	{CategoryRetryable, "Retryable"},
+	{CategoryNotFound, "NotFound"},
+	{CategoryAccessDenied, "AccessDenied"},
+	{CategoryBufferTooSmall, "BufferTooSmall"},
+	{CategoryNotSupported, "NotSupported"},
+	{CategoryInvalidParameter, "InvalidParameter"},
+}
+
+// win32Categories maps Win32 error codes to their categories
+var win32Categories = map[syscall.Errno]Category{
+	// Retryable
+	ERROR_NOT_READY:                  CategoryRetryable,
+	ERROR_SHARING_VIOLATION:          CategoryRetryable,
+	ERROR_LOCK_VIOLATION:             CategoryRetryable,
+	ERROR_NETWORK_BUSY:               CategoryRetryable,
+	ERROR_REQ_NOT_ACCEP:              CategoryRetryable,
+	ERROR_NO_PROC_SLOTS:              CategoryRetryable,
+	ERROR_TOO_MANY_SEM_REQUESTS:      CategoryRetryable,
+	ERROR_DRIVE_LOCKED:               CategoryRetryable,
+	ERROR_SEM_TIMEOUT:                CategoryRetryable,
+	ERROR_MAX_THRDS_REACHED:          CategoryRetryable,
+	ERROR_BUSY:                       CategoryRetryable,
+	ERROR_PIPE_BUSY:                  CategoryRetryable,
+	WAIT_TIMEOUT:                     CategoryRetryable,
+	ERROR_DELETE_PENDING:             CategoryRetryable,
+	ERROR_CANT_WAIT:                  CategoryRetryable,
+	ERROR_IO_INCOMPLETE:              CategoryRetryable,
+	ERROR_IO_PENDING:                 CategoryRetryable,
+	ERROR_SERVICE_REQUEST_TIMEOUT:    CategoryRetryable,
+	ERROR_SERVICE_DATABASE_LOCKED:    CategoryRetryable,
+	ERROR_SERVICE_CANNOT_ACCEPT_CTRL: CategoryRetryable,
+	ERROR_RETRY:                      CategoryRetryable,
+	ERROR_NO_SYSTEM_RESOURCES:        CategoryRetryable,
+	ERROR_WORKING_SET_QUOTA:          CategoryRetryable,
+	ERROR_PAGEFILE_QUOTA:             CategoryRetryable,
+	ERROR_COMMITMENT_LIMIT:           CategoryRetryable,
+	ERROR_TIMEOUT:                    CategoryRetryable,
+	ERROR_DEVICE_IN_USE:              CategoryRetryable,
+
+	// Not found
+	ERROR_FILE_NOT_FOUND:          CategoryNotFound,
+	ERROR_PATH_NOT_FOUND:          CategoryNotFound,
+	ERROR_INVALID_DRIVE:           CategoryNotFound,
+	ERROR_BAD_NETPATH:             CategoryNotFound,
+	ERROR_DEV_NOT_EXIST:           CategoryNotFound,
+	ERROR_BAD_NET_NAME:            CategoryNotFound,
+	ERROR_MOD_NOT_FOUND:           CategoryNotFound,
+	ERROR_PROC_NOT_FOUND:          CategoryNotFound,
+	ERROR_ENVVAR_NOT_FOUND:        CategoryNotFound,
+	ERROR_NO_SUCH_DEVICE:          CategoryNotFound,
+	ERROR_SERVICE_DOES_NOT_EXIST:  CategoryNotFound,
+	ERROR_DEVICE_NOT_CONNECTED:    CategoryNotFound,
+	ERROR_NOT_FOUND:               CategoryNotFound,
+	ERROR_NO_MATCH:                CategoryNotFound,
+	ERROR_SET_NOT_FOUND:           CategoryNotFound,
+	ERROR_BAD_DEVICE:              CategoryNotFound,
+	ERROR_SERVICE_NOT_FOUND:       CategoryNotFound,
+	ERROR_NO_SUCH_LOGON_SESSION:   CategoryNotFound,
+	ERROR_NO_SUCH_PRIVILEGE:       CategoryNotFound,
+	ERROR_NO_SUCH_USER:            CategoryNotFound,
+	ERROR_NO_SUCH_GROUP:           CategoryNotFound,
+	ERROR_NONE_MAPPED:             CategoryNotFound,
+	ERROR_NO_SUCH_DOMAIN:          CategoryNotFound,
+	ERROR_NO_SUCH_PACKAGE:         CategoryNotFound,
+	ERROR_NO_SUCH_ALIAS:           CategoryNotFound,
+	ERROR_CLASS_DOES_NOT_EXIST:    CategoryNotFound,
+	ERROR_RESOURCE_DATA_NOT_FOUND: CategoryNotFound,
+	ERROR_RESOURCE_TYPE_NOT_FOUND: CategoryNotFound,
+	ERROR_RESOURCE_NAME_NOT_FOUND: CategoryNotFound,
+	ERROR_RESOURCE_LANG_NOT_FOUND: CategoryNotFound,
+	ERROR_NOT_CONNECTED:           CategoryNotFound,
+	ERROR_OBJECT_NOT_FOUND:        CategoryNotFound,
+
+	// Access denied
+	ERROR_ACCESS_DENIED:                         CategoryAccessDenied,
+	ERROR_WRITE_PROTECT:                         CategoryAccessDenied,
+	ERROR_NETWORK_ACCESS_DENIED:                 CategoryAccessDenied,
+	ERROR_NOT_OWNER:                             CategoryAccessDenied,
+	ERROR_ELEVATION_REQUIRED:                    CategoryAccessDenied,
+	ERROR_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY: CategoryAccessDenied,
+	ERROR_ACCESS_DISABLED_BY_POLICY:             CategoryAccessDenied,
+	ERROR_PRIVILEGE_NOT_HELD:                    CategoryAccessDenied,
+	ERROR_LOGON_TYPE_NOT_GRANTED:                CategoryAccessDenied,
+	ERROR_CANT_ACCESS_FILE:                      CategoryAccessDenied,
+	ERROR_NOT_SUPPORTED_IN_APPCONTAINER:         CategoryAccessDenied | CategoryNotSupported,
+	ERROR_SYSTEM_INTEGRITY_POLICY_VIOLATION:     CategoryAccessDenied,
+
+	// Buffer too small
+	ERROR_BAD_LENGTH:          CategoryBufferTooSmall,
+	ERROR_INSUFFICIENT_BUFFER: CategoryBufferTooSmall,
+	ERROR_MORE_DATA:           CategoryBufferTooSmall,
+
+	// Not supported
+	ERROR_INVALID_FUNCTION:             CategoryNotSupported,
+	ERROR_NOT_SUPPORTED:                CategoryNotSupported,
+	ERROR_CALL_NOT_IMPLEMENTED:         CategoryNotSupported,
+	ERROR_DEVICE_FEATURE_NOT_SUPPORTED: CategoryNotSupported,
+	ERROR_UNSUPPORTED_COMPRESSION:      CategoryNotSupported,
+	ERROR_NOT_CAPABLE:                  CategoryNotSupported,
+	ERROR_INVALID_SERVICE_CONTROL:      CategoryNotSupported,
+	ERROR_NOT_SUPPORTED_ON_SBS:         CategoryNotSupported,
+	ERROR_UNSUPPORTED_TYPE:             CategoryNotSupported,
+
+	// Invalid parameter
+	ERROR_INVALID_DATA:        CategoryInvalidParameter,
+	ERROR_INVALID_PARAMETER:   CategoryInvalidParameter,
+	ERROR_INVALID_NAME:        CategoryInvalidParameter,
+	ERROR_INVALID_LEVEL:       CategoryInvalidParameter,
+	ERROR_BAD_ARGUMENTS:       CategoryInvalidParameter,
+	ERROR_BAD_PATHNAME:        CategoryInvalidParameter,
+	ERROR_INVALID_ADDRESS:     CategoryInvalidParameter,
+	ERROR_INVALID_FLAGS:       CategoryInvalidParameter,
+	ERROR_INVALID_INDEX:       CategoryInvalidParameter,
+	ERROR_INVALID_USER_BUFFER: CategoryInvalidParameter,
+}
+
+// ntStatusCategories holds categories for NTSTATUS codes whose Win32
+// translation loses information. Every other NTSTATUS code is classified
+// through its Win32 equivalent.
+var ntStatusCategories = map[uint32]Category{
+	0xC0000003: CategoryInvalidParameter | CategoryNotSupported, // STATUS_INVALID_INFO_CLASS
+}
+
+// hresultCategories holds categories for HRESULTs that do not wrap a Win32 or
+// NTSTATUS code
+var hresultCategories = map[uint32]Category{
+	0x8000000A: CategoryRetryable,        // E_PENDING
+	0x8000000B: CategoryInvalidParameter, // E_BOUNDS
+	0x80004001: CategoryNotSupported,     // E_NOTIMPL
+	0x80004002: CategoryNotSupported,     // E_NOINTERFACE
+	0x80004003: CategoryInvalidParameter, // E_POINTER
+	0x80010001: CategoryRetryable,        // RPC_E_CALL_REJECTED
+	0x8001010A: CategoryRetryable,        // RPC_E_SERVERCALL_RETRYLATER
+	0x8001011F: CategoryRetryable,        // RPC_E_TIMEOUT
+	0x80020001: CategoryNotSupported,     // DISP_E_UNKNOWNINTERFACE
+	0x80020003: CategoryNotFound,         // DISP_E_MEMBERNOTFOUND
+	0x80020004: CategoryNotFound,         // DISP_E_PARAMNOTFOUND
+	0x80020006: CategoryNotFound,         // DISP_E_UNKNOWNNAME
+	0x8002000B: CategoryInvalidParameter, // DISP_E_BADINDEX
+	0x8002000E: CategoryInvalidParameter, // DISP_E_BADPARAMCOUNT
+	0x8002801D: CategoryNotFound,         // TYPE_E_LIBNOTREGISTERED
+	0x8002802B: CategoryNotFound,         // TYPE_E_ELEMENTNOTFOUND
+	0x80030002: CategoryNotFound,         // STG_E_FILENOTFOUND
+	0x80030003: CategoryNotFound,         // STG_E_PATHNOTFOUND
+	0x80030005: CategoryAccessDenied,     // STG_E_ACCESSDENIED
+	0x80030057: CategoryInvalidParameter, // STG_E_INVALIDPARAMETER
+	0x80040110: CategoryNotSupported,     // CLASS_E_NOAGGREGATION
+	0x80040111: CategoryNotFound,         // CLASS_E_CLASSNOTAVAILABLE
+	0x80040154: CategoryNotFound,         // REGDB_E_CLASSNOTREG
+	0x80041002: CategoryNotFound,         // WBEM_E_NOT_FOUND
+	0x80041003: CategoryAccessDenied,     // WBEM_E_ACCESS_DENIED
+	0x80041008: CategoryInvalidParameter, // WBEM_E_INVALID_PARAMETER
+	0x80041009: CategoryRetryable,        // WBEM_E_NOT_AVAILABLE
+	0x8004100C: CategoryNotSupported,     // WBEM_E_NOT_SUPPORTED
+	0x8004100E: CategoryNotFound,         // WBEM_E_INVALID_NAMESPACE
+	0x80041010: CategoryNotFound,         // WBEM_E_INVALID_CLASS
+	0x80041033: CategoryRetryable,        // WBEM_E_SHUTTING_DOWN
+	0x800F0102: CategoryNotFound,         // SPAPI_E_LINE_NOT_FOUND
+	0x800F0206: CategoryNotFound,         // SPAPI_E_INVALID_CLASS
+	0x800F020B: CategoryNotFound,         // SPAPI_E_NO_SUCH_DEVINST
+	0x800F0225: CategoryNotFound,         // SPAPI_E_NO_SUCH_DEVICE_INTERFACE
+	0x800F1000: CategoryNotFound,         // SPAPI_E_ERROR_NOT_INSTALLED
+}
+
+// String returns the category names joined with "|" (e.g. "NotFound"), or
+// "None" for CategoryNone
+func (c Category) String() string {
+	if c == CategoryNone {
+		return "None"
+	}
+	var names []string
+	for _, entry := range categoryNames {
+		if c&entry.category != 0 {
+			names = append(names, entry.name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// Has reports whether c includes every category in other
+func (c Category) Has(other Category) bool {
+	return other != CategoryNone && c&other == other
+}
+
+// Win32Category returns the categories of a Win32 error code.
+// SetupAPI errors (0xE000xxxx) are classified through their HRESULT form.
+func Win32Category(code uint32) Category {
+	if code&0xE0000000 == 0xE0000000 {
+		return HRESULTCategory(HRESULT(0x800F0000 | code&0xFFFF))
+	}
+	return win32Categories[syscall.Errno(code)]
+}
+
+// NTStatusCategory returns the categories of an NTSTATUS code
+func NTStatusCategory(code uint32) Category {
+	if category, exists := ntStatusCategories[code]; exists {
+		return category
+	}
+	if win32, ok := NTStatusToWin32(code); ok {
+		return Win32Category(win32)
+	}
+	return CategoryNone
+}
+
+// HRESULTCategory returns the categories of an HRESULT
+func HRESULTCategory(hr HRESULT) Category {
+	if status, ok := NTStatusFromHRESULT(hr); ok {
+		return NTStatusCategory(status)
+	}
+	if category, exists := hresultCategories[uint32(hr)]; exists {
+		return category
+	}
+	if win32, ok := Win32FromHRESULT(hr); ok {
+		return Win32Category(win32)
+	}
+	return CategoryNone
+}
+
+// ntStatusCarrier is implemented by errors that carry an NTSTATUS code, such as
+// winx.NTSTATUS and *winx.NTStatusError
+type ntStatusCarrier interface {
+	NTStatus() uint32
+}
+
+// CategoryOf returns the categories of any error produced by winx: NTSTATUS
+// values and NTStatusError, HRESULT, and syscall.Errno as returned by the
+// device and service packages. Wrapped errors are unwrapped. It returns
+// CategoryNone for nil and unrecognized errors.
+func CategoryOf(err error) Category {
+	if err == nil {
+		return CategoryNone
+	}
+
+	var status ntStatusCarrier
+	if errors.As(err, &status) {
+		return NTStatusCategory(status.NTStatus())
+	}
+	var hr HRESULT
+	if errors.As(err, &hr) {
+		return HRESULTCategory(hr)
+	}
+	var errno syscall.Errno
+	if errors.As(err, &errno) {
+		return Win32Category(uint32(errno))
+	}
+	return CategoryNone
+}
+
+// IsRetryable reports whether err is a transient failure that may succeed if retried
+func IsRetryable(err error) bool {
+	return CategoryOf(err).Has(CategoryRetryable)
+}
+
+// IsNotFound reports whether err indicates a missing file, object, service or device
+func IsNotFound(err error) bool {
+	return CategoryOf(err).Has(CategoryNotFound)
+}
+
+// IsAccessDenied reports whether err was caused by missing rights or privileges
+func IsAccessDenied(err error) bool {
+	return CategoryOf(err).Has(CategoryAccessDenied)
+}
+
+// IsBufferTooSmall reports whether err asks for a larger buffer
+func IsBufferTooSmall(err error) bool {
+	return CategoryOf(err).Has(CategoryBufferTooSmall)
+}
+
+// IsNotSupported reports whether err indicates an unimplemented request
+func IsNotSupported(err error) bool {
+	return CategoryOf(err).Has(CategoryNotSupported)
+}
+
+// IsInvalidParameter reports whether err indicates a request rejected because
+// of its arguments
+func IsInvalidParameter(err error) bool {
+	return CategoryOf(err).Has(CategoryInvalidParameter)
+}
@@ -0,0 +1,150 @@
+package exitcodes
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+	"testing"
+)
+
+func TestWin32Category(t *testing.T) {
+	tests := []struct {
+		code uint32
+		want Category
+	}{
+		{0, CategoryNone},
+		{2, CategoryNotFound},          // ERROR_FILE_NOT_FOUND
+		{5, CategoryAccessDenied},      // ERROR_ACCESS_DENIED
+		{32, CategoryRetryable},        // ERROR_SHARING_VIOLATION
+		{50, CategoryNotSupported},     // ERROR_NOT_SUPPORTED
+		{87, CategoryInvalidParameter}, // ERROR_INVALID_PARAMETER
+		{122, CategoryBufferTooSmall},  // ERROR_INSUFFICIENT_BUFFER
+		{1060, CategoryNotFound},       // ERROR_SERVICE_DOES_NOT_EXIST
+		{1314, CategoryAccessDenied},   // ERROR_PRIVILEGE_NOT_HELD
+		{0xE000020B, CategoryNotFound}, // ERROR_NO_SUCH_DEVINST (SetupAPI)
+	}
+
+	for _, tt := range tests {
+		if got := Win32Category(tt.code); got != tt.want {
+			t.Errorf("Win32Category(%d) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestNTStatusCategory(t *testing.T) {
+	tests := []struct {
+		code uint32
+		want Category
+	}{
+		{0x00000000, CategoryNone},
+		{0xC0000003, CategoryInvalidParameter | CategoryNotSupported}, // STATUS_INVALID_INFO_CLASS
+		{0xC0000004, CategoryBufferTooSmall},                          // STATUS_INFO_LENGTH_MISMATCH
+		{0x80000005, CategoryBufferTooSmall},                          // STATUS_BUFFER_OVERFLOW
+		{0xC0000022, CategoryAccessDenied},                            // STATUS_ACCESS_DENIED
+		{0xC0000034, CategoryNotFound},                                // STATUS_OBJECT_NAME_NOT_FOUND
+		{0xC0000010, CategoryNotSupported},                            // STATUS_INVALID_DEVICE_REQUEST
+		{0x80000011, CategoryRetryable},                               // STATUS_DEVICE_BUSY
+		{0xC0070020, CategoryRetryable},                               // FACILITY_NTWIN32 wrapping ERROR_SHARING_VIOLATION
+		{0xC0FF0001, CategoryNone},
+	}
+
+	for _, tt := range tests {
+		if got := NTStatusCategory(tt.code); got != tt.want {
+			t.Errorf("NTStatusCategory(0x%08X) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestHRESULTCategory(t *testing.T) {
+	tests := []struct {
+		hr   HRESULT
+		want Category
+	}{
+		{0x00000000, CategoryNone},
+		{0x80004001, CategoryNotSupported},   // E_NOTIMPL
+		{0x80070005, CategoryAccessDenied},   // E_ACCESSDENIED
+		{0x8007007A, CategoryBufferTooSmall}, // E_NOT_SUFFICIENT_BUFFER
+		{0x8001010A, CategoryRetryable},      // RPC_E_SERVERCALL_RETRYLATER
+		{0x80040154, CategoryNotFound},       // REGDB_E_CLASSNOTREG
+		{0xD0000034, CategoryNotFound},       // HRESULT_FROM_NT(STATUS_OBJECT_NAME_NOT_FOUND)
+		{0x80004005, CategoryNone},           // E_FAIL
+	}
+
+	for _, tt := range tests {
+		if got := HRESULTCategory(tt.hr); got != tt.want {
+			t.Errorf("HRESULTCategory(0x%08X) = %v, want %v", uint32(tt.hr), got, tt.want)
+		}
+	}
+}
+
+// fakeNTStatusError stands in for winx.NTStatusError, which cannot be
+// imported here
+type fakeNTStatusError uint32
+
+func (e fakeNTStatusError) Error() string    { return "fake" }
+func (e fakeNTStatusError) NTStatus() uint32 { return uint32(e) }
+
+func TestCategoryOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want Category
+	}{
+		{"nil", nil, CategoryNone},
+		{"errno", syscall.Errno(5), CategoryAccessDenied},
+		{"wrapped errno", fmt.Errorf("open: %w", syscall.Errno(2)), CategoryNotFound},
+		{"hresult", HRESULT(0x80004001), CategoryNotSupported},
+		{"ntstatus", fakeNTStatusError(0xC0000023), CategoryBufferTooSmall},
+		{"wrapped ntstatus", fmt.Errorf("query: %w", fakeNTStatusError(0xC0000004)), CategoryBufferTooSmall},
+		{"unrelated", errors.New("boom"), CategoryNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CategoryOf(tt.err); got != tt.want {
+				t.Errorf("CategoryOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	if !IsRetryable(syscall.Errno(170)) {
+		t.Error("IsRetryable(ERROR_BUSY) = false, want true")
+	}
+	if !IsNotFound(ERROR_FILE_NOT_FOUND) {
+		t.Error("IsNotFound(ERROR_FILE_NOT_FOUND) = false, want true")
+	}
+	if !IsAccessDenied(HRESULT(0x80070005)) {
+		t.Error("IsAccessDenied(E_ACCESSDENIED) = false, want true")
+	}
+	if !IsBufferTooSmall(fakeNTStatusError(0x80000005)) {
+		t.Error("IsBufferTooSmall(STATUS_BUFFER_OVERFLOW) = false, want true")
+	}
+	if !IsNotSupported(ERROR_INVALID_FUNCTION) {
+		t.Error("IsNotSupported(ERROR_INVALID_FUNCTION) = false, want true")
+	}
+	if !IsInvalidParameter(ERROR_INVALID_USER_BUFFER) {
+		t.Error("IsInvalidParameter(ERROR_INVALID_USER_BUFFER) = false, want true")
+	}
+	if IsNotFound(ERROR_ACCESS_DENIED) {
+		t.Error("IsNotFound(ERROR_ACCESS_DENIED) = true, want false")
+	}
+}
+
+func TestCategoryString(t *testing.T) {
+	tests := []struct {
+		category Category
+		want     string
+	}{
+		{CategoryNone, "None"},
+		{CategoryNotFound, "NotFound"},
+		{CategoryAccessDenied | CategoryNotSupported, "AccessDenied|NotSupported"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.category.String(); got != tt.want {
+			t.Errorf("Category(%d).String() = %q, want %q", uint32(tt.category), got, tt.want)
+		}
+	}
+}
@@ -110,6 +110,7 @@ func TestFormatError(t *testing.T) {
 	}{
 		{0, "[Return Value: 0] SUCCESS: The operation completed successfully."},
 		{2, "[Return Value: 2] ERROR_FILE_NOT_FOUND: The system cannot find the file specified."},
+		{1314, "[Return Value: 1314] ERROR_PRIVILEGE_NOT_HELD: A required privilege is not held by the client."},
 		{9999, "Unknown error code: 9999"},
 	}
 
@@ -125,6 +126,7 @@ func TestErrorCodeMapCompleteness(t *testing.T) {
 	requiredCodes := []uint32{
 		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10,
 		50, 87, 109, 122, 183, 258, 487,
+		1053, 1060, 1168, 1223, 1314, 1326, 1450, 2250,
 	}
 
 	for _, code := range requiredCodes {
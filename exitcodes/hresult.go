@@ -0,0 +1,558 @@
+package exitcodes
+
+import "fmt"
+
+// HRESULT represents a COM/OLE result code.
+// HRESULTs are 32-bit values with the following structure:
+//
+//	Bit 31:     Severity (0=success, 1=failure)
+//	Bit 30:     R, reserved
+//	Bit 29:     Customer (set for third-party codes)
+//	Bit 28:     N (set when the HRESULT wraps an NTSTATUS)
+//	Bit 27:     X, reserved
+//	Bits 26-16: Facility
+//	Bits 15-0:  Code
+//
+// Like the HRESULT_FACILITY macro, Facility masks bits 28-16 (0x1FFF) rather
+// than 26-16, because some SDK facilities such as FACILITY_XBOX (2339) are
+// larger than 11 bits and spill into X.
+type HRESULT uint32
+
+// HRESULTFacility identifies the subsystem that defined an HRESULT code.
+type HRESULTFacility uint16
+
+// FACILITY_NT_BIT is set in HRESULTs created with HRESULT_FROM_NT
+const FACILITY_NT_BIT = 0x10000000
+
+// HRESULT facility codes
+const (
+	FACILITY_NULL                                     HRESULTFacility = 0
+	FACILITY_RPC                                      HRESULTFacility = 1
+	FACILITY_DISPATCH                                 HRESULTFacility = 2
+	FACILITY_STORAGE                                  HRESULTFacility = 3
+	FACILITY_ITF                                      HRESULTFacility = 4
+	FACILITY_WIN32                                    HRESULTFacility = 7
+	FACILITY_WINDOWS                                  HRESULTFacility = 8
+	FACILITY_SECURITY                                 HRESULTFacility = 9
+	FACILITY_CONTROL                                  HRESULTFacility = 10
+	FACILITY_CERT                                     HRESULTFacility = 11
+	FACILITY_INTERNET                                 HRESULTFacility = 12
+	FACILITY_MEDIASERVER                              HRESULTFacility = 13
+	FACILITY_MSMQ                                     HRESULTFacility = 14
+	FACILITY_SETUPAPI                                 HRESULTFacility = 15
+	FACILITY_SCARD                                    HRESULTFacility = 16
+	FACILITY_COMPLUS                                  HRESULTFacility = 17
+	FACILITY_AAF                                      HRESULTFacility = 18
+	FACILITY_URT                                      HRESULTFacility = 19
+	FACILITY_ACS                                      HRESULTFacility = 20
+	FACILITY_DPLAY                                    HRESULTFacility = 21
+	FACILITY_UMI                                      HRESULTFacility = 22
+	FACILITY_SXS                                      HRESULTFacility = 23
+	FACILITY_WINDOWS_CE                               HRESULTFacility = 24
+	FACILITY_HTTP                                     HRESULTFacility = 25
+	FACILITY_USERMODE_COMMONLOG                       HRESULTFacility = 26
+	FACILITY_WER                                      HRESULTFacility = 27
+	FACILITY_USERMODE_FILTER_MANAGER                  HRESULTFacility = 31
+	FACILITY_BACKGROUNDCOPY                           HRESULTFacility = 32
+	FACILITY_CONFIGURATION                            HRESULTFacility = 33
+	FACILITY_STATE_MANAGEMENT                         HRESULTFacility = 34
+	FACILITY_METADIRECTORY                            HRESULTFacility = 35
+	FACILITY_WINDOWSUPDATE                            HRESULTFacility = 36
+	FACILITY_DIRECTORYSERVICE                         HRESULTFacility = 37
+	FACILITY_GRAPHICS                                 HRESULTFacility = 38
+	FACILITY_SHELL                                    HRESULTFacility = 39
+	FACILITY_TPM_SERVICES                             HRESULTFacility = 40
+	FACILITY_TPM_SOFTWARE                             HRESULTFacility = 41
+	FACILITY_UI                                       HRESULTFacility = 42
+	FACILITY_XAML                                     HRESULTFacility = 43
+	FACILITY_ACTION_QUEUE                             HRESULTFacility = 44
+	FACILITY_PLA                                      HRESULTFacility = 48
+	FACILITY_FVE                                      HRESULTFacility = 49
+	FACILITY_FWP                                      HRESULTFacility = 50
+	FACILITY_WINRM                                    HRESULTFacility = 51
+	FACILITY_NDIS                                     HRESULTFacility = 52
+	FACILITY_USERMODE_HYPERVISOR                      HRESULTFacility = 53
+	FACILITY_CMI                                      HRESULTFacility = 54
+	FACILITY_USERMODE_VIRTUALIZATION                  HRESULTFacility = 55
+	FACILITY_USERMODE_VOLMGR                          HRESULTFacility = 56
+	FACILITY_BCD                                      HRESULTFacility = 57
+	FACILITY_USERMODE_VHD                             HRESULTFacility = 58
+	FACILITY_USERMODE_HNS                             HRESULTFacility = 59
+	FACILITY_SDIAG                                    HRESULTFacility = 60
+	FACILITY_WEBSERVICES                              HRESULTFacility = 61
+	FACILITY_WPN                                      HRESULTFacility = 62
+	FACILITY_WINDOWS_STORE                            HRESULTFacility = 63
+	FACILITY_INPUT                                    HRESULTFacility = 64
+	FACILITY_EAP                                      HRESULTFacility = 66
+	FACILITY_WINDOWS_DEFENDER                         HRESULTFacility = 80
+	FACILITY_OPC                                      HRESULTFacility = 81
+	FACILITY_XPS                                      HRESULTFacility = 82
+	FACILITY_RAS                                      HRESULTFacility = 83
+	FACILITY_POWERSHELL                               HRESULTFacility = 84
+	FACILITY_EAS                                      HRESULTFacility = 85
+	FACILITY_P2P_INT                                  HRESULTFacility = 98
+	FACILITY_P2P                                      HRESULTFacility = 99
+	FACILITY_DAF                                      HRESULTFacility = 100
+	FACILITY_BLUETOOTH_ATT                            HRESULTFacility = 101
+	FACILITY_AUDIO                                    HRESULTFacility = 102
+	FACILITY_STATEREPOSITORY                          HRESULTFacility = 103
+	FACILITY_VISUALCPP                                HRESULTFacility = 109
+	FACILITY_SCRIPT                                   HRESULTFacility = 112
+	FACILITY_PARSE                                    HRESULTFacility = 113
+	FACILITY_BLB                                      HRESULTFacility = 120
+	FACILITY_BLB_CLI                                  HRESULTFacility = 121
+	FACILITY_WSBAPP                                   HRESULTFacility = 122
+	FACILITY_BLBUI                                    HRESULTFacility = 128
+	FACILITY_USN                                      HRESULTFacility = 129
+	FACILITY_USERMODE_VOLSNAP                         HRESULTFacility = 130
+	FACILITY_TIERING                                  HRESULTFacility = 131
+	FACILITY_WSB_ONLINE                               HRESULTFacility = 133
+	FACILITY_ONLINE_ID                                HRESULTFacility = 134
+	FACILITY_DEVICE_UPDATE_AGENT                      HRESULTFacility = 135
+	FACILITY_DLS                                      HRESULTFacility = 153
+	FACILITY_SOS                                      HRESULTFacility = 160
+	FACILITY_DEBUGGERS                                HRESULTFacility = 176
+	FACILITY_DELIVERY_OPTIMIZATION                    HRESULTFacility = 208
+	FACILITY_USERMODE_SPACES                          HRESULTFacility = 231
+	FACILITY_USER_MODE_SECURITY_CORE                  HRESULTFacility = 232
+	FACILITY_USERMODE_LICENSING                       HRESULTFacility = 234
+	FACILITY_SPP                                      HRESULTFacility = 256
+	FACILITY_DEPLOYMENT_SERVICES_SERVER               HRESULTFacility = 257
+	FACILITY_DEPLOYMENT_SERVICES_IMAGING              HRESULTFacility = 258
+	FACILITY_DEPLOYMENT_SERVICES_MANAGEMENT           HRESULTFacility = 259
+	FACILITY_DEPLOYMENT_SERVICES_UTIL                 HRESULTFacility = 260
+	FACILITY_DEPLOYMENT_SERVICES_BINLSVC              HRESULTFacility = 261
+	FACILITY_DEPLOYMENT_SERVICES_PXE                  HRESULTFacility = 263
+	FACILITY_DEPLOYMENT_SERVICES_TFTP                 HRESULTFacility = 264
+	FACILITY_DEPLOYMENT_SERVICES_TRANSPORT_MANAGEMENT HRESULTFacility = 272
+	FACILITY_DEPLOYMENT_SERVICES_DRIVER_PROVISIONING  HRESULTFacility = 278
+	FACILITY_DEPLOYMENT_SERVICES_MULTICAST_SERVER     HRESULTFacility = 289
+	FACILITY_DEPLOYMENT_SERVICES_MULTICAST_CLIENT     HRESULTFacility = 290
+	FACILITY_DEPLOYMENT_SERVICES_CONTENT_PROVIDER     HRESULTFacility = 293
+	FACILITY_LINGUISTIC_SERVICES                      HRESULTFacility = 305
+	FACILITY_WEB                                      HRESULTFacility = 885
+	FACILITY_WEB_SOCKET                               HRESULTFacility = 886
+	FACILITY_MOBILE                                   HRESULTFacility = 1793
+	FACILITY_SQLITE                                   HRESULTFacility = 1967
+	FACILITY_UTC                                      HRESULTFacility = 1989
+	FACILITY_WEP                                      HRESULTFacility = 2049
+	FACILITY_SYNCENGINE                               HRESULTFacility = 2050
+	FACILITY_DIRECTMUSIC                              HRESULTFacility = 2168
+	FACILITY_DIRECT3D10                               HRESULTFacility = 2169
+	FACILITY_DXGI                                     HRESULTFacility = 2170
+	FACILITY_DXGI_DDI                                 HRESULTFacility = 2171
+	FACILITY_DIRECT3D11                               HRESULTFacility = 2172
+	FACILITY_DIRECT3D11_DEBUG                         HRESULTFacility = 2173
+	FACILITY_DIRECT3D12                               HRESULTFacility = 2174
+	FACILITY_DIRECT3D12_DEBUG                         HRESULTFacility = 2175
+	FACILITY_LEAP                                     HRESULTFacility = 2184
+	FACILITY_AUDCLNT                                  HRESULTFacility = 2185
+	FACILITY_WINML                                    HRESULTFacility = 2192
+	FACILITY_WINCODEC_DWRITE_DWM                      HRESULTFacility = 2200
+	FACILITY_DIRECT2D                                 HRESULTFacility = 2201
+	FACILITY_DEFRAG                                   HRESULTFacility = 2304
+	FACILITY_USERMODE_SDBUS                           HRESULTFacility = 2305
+	FACILITY_JSCRIPT                                  HRESULTFacility = 2306
+	FACILITY_XBOX                                     HRESULTFacility = 2339
+	FACILITY_PIDGENX                                  HRESULTFacility = 2561
+	FACILITY_PIX                                      HRESULTFacility = 2748
+)
+
+// hresultFacilityNames maps HRESULT facility codes to their symbolic names
+var hresultFacilityNames = map[HRESULTFacility]string{
+	FACILITY_NULL:                           "FACILITY_NULL",
+	FACILITY_RPC:                            "FACILITY_RPC",
+	FACILITY_DISPATCH:                       "FACILITY_DISPATCH",
+	FACILITY_STORAGE:                        "FACILITY_STORAGE",
+	FACILITY_ITF:                            "FACILITY_ITF",
+	FACILITY_WIN32:                          "FACILITY_WIN32",
+	FACILITY_WINDOWS:                        "FACILITY_WINDOWS",
+	FACILITY_SECURITY:                       "FACILITY_SECURITY",
+	FACILITY_CONTROL:                        "FACILITY_CONTROL",
+	FACILITY_CERT:                           "FACILITY_CERT",
+	FACILITY_INTERNET:                       "FACILITY_INTERNET",
+	FACILITY_MEDIASERVER:                    "FACILITY_MEDIASERVER",
+	FACILITY_MSMQ:                           "FACILITY_MSMQ",
+	FACILITY_SETUPAPI:                       "FACILITY_SETUPAPI",
+	FACILITY_SCARD:                          "FACILITY_SCARD",
+	FACILITY_COMPLUS:                        "FACILITY_COMPLUS",
+	FACILITY_AAF:                            "FACILITY_AAF",
+	FACILITY_URT:                            "FACILITY_URT",
+	FACILITY_ACS:                            "FACILITY_ACS",
+	FACILITY_DPLAY:                          "FACILITY_DPLAY",
+	FACILITY_UMI:                            "FACILITY_UMI",
+	FACILITY_SXS:                            "FACILITY_SXS",
+	FACILITY_WINDOWS_CE:                     "FACILITY_WINDOWS_CE",
+	FACILITY_HTTP:                           "FACILITY_HTTP",
+	FACILITY_USERMODE_COMMONLOG:             "FACILITY_USERMODE_COMMONLOG",
+	FACILITY_WER:                            "FACILITY_WER",
+	FACILITY_USERMODE_FILTER_MANAGER:        "FACILITY_USERMODE_FILTER_MANAGER",
+	FACILITY_BACKGROUNDCOPY:                 "FACILITY_BACKGROUNDCOPY",
+	FACILITY_CONFIGURATION:                  "FACILITY_CONFIGURATION",
+	FACILITY_STATE_MANAGEMENT:               "FACILITY_STATE_MANAGEMENT",
+	FACILITY_METADIRECTORY:                  "FACILITY_METADIRECTORY",
+	FACILITY_WINDOWSUPDATE:                  "FACILITY_WINDOWSUPDATE",
+	FACILITY_DIRECTORYSERVICE:               "FACILITY_DIRECTORYSERVICE",
+	FACILITY_GRAPHICS:                       "FACILITY_GRAPHICS",
+	FACILITY_SHELL:                          "FACILITY_SHELL",
+	FACILITY_TPM_SERVICES:                   "FACILITY_TPM_SERVICES",
+	FACILITY_TPM_SOFTWARE:                   "FACILITY_TPM_SOFTWARE",
+	FACILITY_UI:                             "FACILITY_UI",
+	FACILITY_XAML:                           "FACILITY_XAML",
+	FACILITY_ACTION_QUEUE:                   "FACILITY_ACTION_QUEUE",
+	FACILITY_PLA:                            "FACILITY_PLA",
+	FACILITY_FVE:                            "FACILITY_FVE",
+	FACILITY_FWP:                            "FACILITY_FWP",
+	FACILITY_WINRM:                          "FACILITY_WINRM",
+	FACILITY_NDIS:                           "FACILITY_NDIS",
+	FACILITY_USERMODE_HYPERVISOR:            "FACILITY_USERMODE_HYPERVISOR",
+	FACILITY_CMI:                            "FACILITY_CMI",
+	FACILITY_USERMODE_VIRTUALIZATION:        "FACILITY_USERMODE_VIRTUALIZATION",
+	FACILITY_USERMODE_VOLMGR:                "FACILITY_USERMODE_VOLMGR",
+	FACILITY_BCD:                            "FACILITY_BCD",
+	FACILITY_USERMODE_VHD:                   "FACILITY_USERMODE_VHD",
+	FACILITY_USERMODE_HNS:                   "FACILITY_USERMODE_HNS",
+	FACILITY_SDIAG:                          "FACILITY_SDIAG",
+	FACILITY_WEBSERVICES:                    "FACILITY_WEBSERVICES",
+	FACILITY_WPN:                            "FACILITY_WPN",
+	FACILITY_WINDOWS_STORE:                  "FACILITY_WINDOWS_STORE",
+	FACILITY_INPUT:                          "FACILITY_INPUT",
+	FACILITY_EAP:                            "FACILITY_EAP",
+	FACILITY_WINDOWS_DEFENDER:               "FACILITY_WINDOWS_DEFENDER",
+	FACILITY_OPC:                            "FACILITY_OPC",
+	FACILITY_XPS:                            "FACILITY_XPS",
+	FACILITY_RAS:                            "FACILITY_RAS",
+	FACILITY_POWERSHELL:                     "FACILITY_POWERSHELL",
+	FACILITY_EAS:                            "FACILITY_EAS",
+	FACILITY_P2P_INT:                        "FACILITY_P2P_INT",
+	FACILITY_P2P:                            "FACILITY_P2P",
+	FACILITY_DAF:                            "FACILITY_DAF",
+	FACILITY_BLUETOOTH_ATT:                  "FACILITY_BLUETOOTH_ATT",
+	FACILITY_AUDIO:                          "FACILITY_AUDIO",
+	FACILITY_STATEREPOSITORY:                "FACILITY_STATEREPOSITORY",
+	FACILITY_VISUALCPP:                      "FACILITY_VISUALCPP",
+	FACILITY_SCRIPT:                         "FACILITY_SCRIPT",
+	FACILITY_PARSE:                          "FACILITY_PARSE",
+	FACILITY_BLB:                            "FACILITY_BLB",
+	FACILITY_BLB_CLI:                        "FACILITY_BLB_CLI",
+	FACILITY_WSBAPP:                         "FACILITY_WSBAPP",
+	FACILITY_BLBUI:                          "FACILITY_BLBUI",
+	FACILITY_USN:                            "FACILITY_USN",
+	FACILITY_USERMODE_VOLSNAP:               "FACILITY_USERMODE_VOLSNAP",
+	FACILITY_TIERING:                        "FACILITY_TIERING",
+	FACILITY_WSB_ONLINE:                     "FACILITY_WSB_ONLINE",
+	FACILITY_ONLINE_ID:                      "FACILITY_ONLINE_ID",
+	FACILITY_DEVICE_UPDATE_AGENT:            "FACILITY_DEVICE_UPDATE_AGENT",
+	FACILITY_DLS:                            "FACILITY_DLS",
+	FACILITY_SOS:                            "FACILITY_SOS",
+	FACILITY_DEBUGGERS:                      "FACILITY_DEBUGGERS",
+	FACILITY_DELIVERY_OPTIMIZATION:          "FACILITY_DELIVERY_OPTIMIZATION",
+	FACILITY_USERMODE_SPACES:                "FACILITY_USERMODE_SPACES",
+	FACILITY_USER_MODE_SECURITY_CORE:        "FACILITY_USER_MODE_SECURITY_CORE",
+	FACILITY_USERMODE_LICENSING:             "FACILITY_USERMODE_LICENSING",
+	FACILITY_SPP:                            "FACILITY_SPP",
+	FACILITY_DEPLOYMENT_SERVICES_SERVER:     "FACILITY_DEPLOYMENT_SERVICES_SERVER",
+	FACILITY_DEPLOYMENT_SERVICES_IMAGING:    "FACILITY_DEPLOYMENT_SERVICES_IMAGING",
+	FACILITY_DEPLOYMENT_SERVICES_MANAGEMENT: "FACILITY_DEPLOYMENT_SERVICES_MANAGEMENT",
+	FACILITY_DEPLOYMENT_SERVICES_UTIL:       "FACILITY_DEPLOYMENT_SERVICES_UTIL",
+	FACILITY_DEPLOYMENT_SERVICES_BINLSVC:    "FACILITY_DEPLOYMENT_SERVICES_BINLSVC",
+	FACILITY_DEPLOYMENT_SERVICES_PXE:        "FACILITY_DEPLOYMENT_SERVICES_PXE",
+	FACILITY_DEPLOYMENT_SERVICES_TFTP:       "FACILITY_DEPLOYMENT_SERVICES_TFTP",
+	FACILITY_DEPLOYMENT_SERVICES_TRANSPORT_MANAGEMENT: "FACILITY_DEPLOYMENT_SERVICES_TRANSPORT_MANAGEMENT",
+	FACILITY_DEPLOYMENT_SERVICES_DRIVER_PROVISIONING:  "FACILITY_DEPLOYMENT_SERVICES_DRIVER_PROVISIONING",
+	FACILITY_DEPLOYMENT_SERVICES_MULTICAST_SERVER:     "FACILITY_DEPLOYMENT_SERVICES_MULTICAST_SERVER",
+	FACILITY_DEPLOYMENT_SERVICES_MULTICAST_CLIENT:     "FACILITY_DEPLOYMENT_SERVICES_MULTICAST_CLIENT",
+	FACILITY_DEPLOYMENT_SERVICES_CONTENT_PROVIDER:     "FACILITY_DEPLOYMENT_SERVICES_CONTENT_PROVIDER",
+	FACILITY_LINGUISTIC_SERVICES:                      "FACILITY_LINGUISTIC_SERVICES",
+	FACILITY_WEB:                                      "FACILITY_WEB",
+	FACILITY_WEB_SOCKET:                               "FACILITY_WEB_SOCKET",
+	FACILITY_MOBILE:                                   "FACILITY_MOBILE",
+	FACILITY_SQLITE:                                   "FACILITY_SQLITE",
+	FACILITY_UTC:                                      "FACILITY_UTC",
+	FACILITY_WEP:                                      "FACILITY_WEP",
+	FACILITY_SYNCENGINE:                               "FACILITY_SYNCENGINE",
+	FACILITY_DIRECTMUSIC:                              "FACILITY_DIRECTMUSIC",
+	FACILITY_DIRECT3D10:                               "FACILITY_DIRECT3D10",
+	FACILITY_DXGI:                                     "FACILITY_DXGI",
+	FACILITY_DXGI_DDI:                                 "FACILITY_DXGI_DDI",
+	FACILITY_DIRECT3D11:                               "FACILITY_DIRECT3D11",
+	FACILITY_DIRECT3D11_DEBUG:                         "FACILITY_DIRECT3D11_DEBUG",
+	FACILITY_DIRECT3D12:                               "FACILITY_DIRECT3D12",
+	FACILITY_DIRECT3D12_DEBUG:                         "FACILITY_DIRECT3D12_DEBUG",
+	FACILITY_LEAP:                                     "FACILITY_LEAP",
+	FACILITY_AUDCLNT:                                  "FACILITY_AUDCLNT",
+	FACILITY_WINML:                                    "FACILITY_WINML",
+	FACILITY_WINCODEC_DWRITE_DWM:                      "FACILITY_WINCODEC_DWRITE_DWM",
+	FACILITY_DIRECT2D:                                 "FACILITY_DIRECT2D",
+	FACILITY_DEFRAG:                                   "FACILITY_DEFRAG",
+	FACILITY_USERMODE_SDBUS:                           "FACILITY_USERMODE_SDBUS",
+	FACILITY_JSCRIPT:                                  "FACILITY_JSCRIPT",
+	FACILITY_XBOX:                                     "FACILITY_XBOX",
+	FACILITY_PIDGENX:                                  "FACILITY_PIDGENX",
+	FACILITY_PIX:                                      "FACILITY_PIX",
+}
+
+// HRESULTCode represents an HRESULT code with its symbolic name and description
+type HRESULTCode struct {
+	Code        uint32
+	Name        string
+	Description string
+}
+
+// HRESULTCodeMap contains common HRESULT codes returned by COM, WMI, SetupAPI and WinTrust
+var HRESULTCodeMap = map[uint32]HRESULTCode{
+	// Success codes
+	0x00000000: {0x00000000, "S_OK", "The operation completed successfully."},
+	0x00000001: {0x00000001, "S_FALSE", "The operation completed successfully but returned false."},
+
+	// Generic failure codes
+	0x80000018: {0x80000018, "E_ILLEGAL_DELEGATE_ASSIGNMENT", "A delegate was assigned when not allowed."},
+	0x8000000A: {0x8000000A, "E_PENDING", "The data necessary to complete this operation is not yet available."},
+	0x8000000B: {0x8000000B, "E_BOUNDS", "The operation attempted to access data outside the valid range."},
+	0x8000000C: {0x8000000C, "E_CHANGED_STATE", "A concurrent or interleaved operation changed the state of the object, invalidating this operation."},
+	0x8000000D: {0x8000000D, "E_ILLEGAL_STATE_CHANGE", "An illegal state change was requested."},
+	0x8000000E: {0x8000000E, "E_ILLEGAL_METHOD_CALL", "A method was called at an unexpected time."},
+	0x8000FFFF: {0x8000FFFF, "E_UNEXPECTED", "Catastrophic failure."},
+	0x80004001: {0x80004001, "E_NOTIMPL", "Not implemented."},
+	0x80004002: {0x80004002, "E_NOINTERFACE", "No such interface supported."},
+	0x80004003: {0x80004003, "E_POINTER", "Invalid pointer."},
+	0x80004004: {0x80004004, "E_ABORT", "Operation aborted."},
+	0x80004005: {0x80004005, "E_FAIL", "Unspecified error."},
+	0x80070005: {0x80070005, "E_ACCESSDENIED", "General access denied error."},
+	0x80070006: {0x80070006, "E_HANDLE", "Invalid handle."},
+	0x8007000E: {0x8007000E, "E_OUTOFMEMORY", "Failed to allocate necessary memory."},
+	0x80070057: {0x80070057, "E_INVALIDARG", "One or more arguments are invalid."},
+	0x8007007A: {0x8007007A, "E_NOT_SUFFICIENT_BUFFER", "The data area passed to a system call is too small."},
+	0x80070490: {0x80070490, "E_NOT_SET", "Element not found."},
+	0x8007139F: {0x8007139F, "E_NOT_VALID_STATE", "The group or resource is not in the correct state to perform the requested operation."},
+
+	// RPC codes
+	0x80010001: {0x80010001, "RPC_E_CALL_REJECTED", "Call was rejected by callee."},
+	0x80010105: {0x80010105, "RPC_E_SERVERFAULT", "The server threw an exception."},
+	0x80010106: {0x80010106, "RPC_E_CHANGED_MODE", "Cannot change thread mode after it is set."},
+	0x80010108: {0x80010108, "RPC_E_DISCONNECTED", "The object invoked has disconnected from its clients."},
+	0x8001010A: {0x8001010A, "RPC_E_SERVERCALL_RETRYLATER", "The message filter indicated that the application is busy."},
+	0x8001010E: {0x8001010E, "RPC_E_WRONG_THREAD", "The application called an interface that was marshalled for a different thread."},
+	0x8001011F: {0x8001011F, "RPC_E_TIMEOUT", "This operation returned because the timeout period expired."},
+
+	// Dispatch codes
+	0x80020001: {0x80020001, "DISP_E_UNKNOWNINTERFACE", "Unknown interface."},
+	0x80020003: {0x80020003, "DISP_E_MEMBERNOTFOUND", "Member not found."},
+	0x80020004: {0x80020004, "DISP_E_PARAMNOTFOUND", "Parameter not found."},
+	0x80020005: {0x80020005, "DISP_E_TYPEMISMATCH", "Type mismatch."},
+	0x80020006: {0x80020006, "DISP_E_UNKNOWNNAME", "Unknown name."},
+	0x80020007: {0x80020007, "DISP_E_NONAMEDARGS", "No named arguments."},
+	0x80020008: {0x80020008, "DISP_E_BADVARTYPE", "Bad variable type."},
+	0x80020009: {0x80020009, "DISP_E_EXCEPTION", "Exception occurred."},
+	0x8002000A: {0x8002000A, "DISP_E_OVERFLOW", "Out of present range."},
+	0x8002000B: {0x8002000B, "DISP_E_BADINDEX", "Invalid index."},
+	0x8002000E: {0x8002000E, "DISP_E_BADPARAMCOUNT", "Invalid number of parameters."},
+	0x8002000F: {0x8002000F, "DISP_E_PARAMNOTOPTIONAL", "Parameter not optional."},
+	0x8002801D: {0x8002801D, "TYPE_E_LIBNOTREGISTERED", "Library not registered."},
+	0x8002802B: {0x8002802B, "TYPE_E_ELEMENTNOTFOUND", "Element not found."},
+
+	// Structured storage codes
+	0x80030002: {0x80030002, "STG_E_FILENOTFOUND", "%1 could not be found."},
+	0x80030003: {0x80030003, "STG_E_PATHNOTFOUND", "The path %1 could not be found."},
+	0x80030005: {0x80030005, "STG_E_ACCESSDENIED", "Access Denied."},
+	0x80030006: {0x80030006, "STG_E_INVALIDHANDLE", "Attempted an operation on an invalid object."},
+	0x80030008: {0x80030008, "STG_E_INSUFFICIENTMEMORY", "There is insufficient memory available to complete operation."},
+	0x80030050: {0x80030050, "STG_E_FILEALREADYEXISTS", "%1 already exists."},
+	0x80030057: {0x80030057, "STG_E_INVALIDPARAMETER", "Invalid parameter error."},
+
+	// COM activation codes
+	0x80040110: {0x80040110, "CLASS_E_NOAGGREGATION", "Class does not support aggregation (or class object is remote)."},
+	0x80040111: {0x80040111, "CLASS_E_CLASSNOTAVAILABLE", "ClassFactory cannot supply requested class."},
+	0x80040154: {0x80040154, "REGDB_E_CLASSNOTREG", "Class not registered."},
+	0x800401F0: {0x800401F0, "CO_E_NOTINITIALIZED", "CoInitialize has not been called."},
+	0x800401F1: {0x800401F1, "CO_E_ALREADYINITIALIZED", "CoInitialize has already been called."},
+	0x80080005: {0x80080005, "CO_E_SERVER_EXEC_FAILURE", "Server execution failed."},
+
+	// WMI codes
+	0x80041001: {0x80041001, "WBEM_E_FAILED", "Generic failure."},
+	0x80041002: {0x80041002, "WBEM_E_NOT_FOUND", "Object cannot be found."},
+	0x80041003: {0x80041003, "WBEM_E_ACCESS_DENIED", "Current user does not have permission to perform the action."},
+	0x80041004: {0x80041004, "WBEM_E_PROVIDER_FAILURE", "Provider has failed at some time other than during initialization."},
+	0x80041005: {0x80041005, "WBEM_E_TYPE_MISMATCH", "Type mismatch occurred."},
+	0x80041006: {0x80041006, "WBEM_E_OUT_OF_MEMORY", "Not enough memory for the operation."},
+	0x80041007: {0x80041007, "WBEM_E_INVALID_CONTEXT", "The IWbemContext object is not valid."},
+	0x80041008: {0x80041008, "WBEM_E_INVALID_PARAMETER", "One of the parameters to the call is not correct."},
+	0x80041009: {0x80041009, "WBEM_E_NOT_AVAILABLE", "Resource, typically a remote server, is not currently available."},
+	0x8004100A: {0x8004100A, "WBEM_E_CRITICAL_ERROR", "Internal, critical, and unexpected error occurred."},
+	0x8004100C: {0x8004100C, "WBEM_E_NOT_SUPPORTED", "Feature or operation is not supported."},
+	0x8004100E: {0x8004100E, "WBEM_E_INVALID_NAMESPACE", "Namespace specified cannot be found."},
+	0x80041010: {0x80041010, "WBEM_E_INVALID_CLASS", "Specified class is not valid."},
+	0x80041017: {0x80041017, "WBEM_E_INVALID_QUERY", "Query was not syntactically valid."},
+	0x80041033: {0x80041033, "WBEM_E_SHUTTING_DOWN", "The service is being shut down."},
+
+	// WinTrust and certificate codes
+	0x800B0001: {0x800B0001, "TRUST_E_PROVIDER_UNKNOWN", "Unknown trust provider."},
+	0x800B0004: {0x800B0004, "TRUST_E_SUBJECT_NOT_TRUSTED", "The subject is not trusted for the specified action."},
+	0x800B0100: {0x800B0100, "TRUST_E_NOSIGNATURE", "No signature was present in the subject."},
+	0x800B0101: {0x800B0101, "CERT_E_EXPIRED", "A required certificate is not within its validity period."},
+	0x800B0109: {0x800B0109, "CERT_E_UNTRUSTEDROOT", "A certificate chain processed, but terminated in a root certificate which is not trusted by the trust provider."},
+	0x800B010A: {0x800B010A, "CERT_E_CHAINING", "A certificate chain could not be built to a trusted root authority."},
+	0x800B010C: {0x800B010C, "CERT_E_REVOKED", "A certificate was explicitly revoked by its issuer."},
+	0x800B0111: {0x800B0111, "TRUST_E_EXPLICIT_DISTRUST", "The certificate was explicitly marked as untrusted by the user."},
+	0x80096010: {0x80096010, "TRUST_E_BAD_DIGEST", "The digital signature of the object did not verify."},
+
+	// SetupAPI codes
+	0x800F0000: {0x800F0000, "SPAPI_E_EXPECTED_SECTION_NAME", "A non-empty line was encountered in the INF before the start of a section."},
+	0x800F0001: {0x800F0001, "SPAPI_E_BAD_SECTION_NAME_LINE", "A section name marker in the INF is not complete, or does not exist on a line by itself."},
+	0x800F0102: {0x800F0102, "SPAPI_E_LINE_NOT_FOUND", "Cannot find a line in the INF."},
+	0x800F0103: {0x800F0103, "SPAPI_E_NO_BACKUP", "The files affected by the installation of this file queue have not been backed up for uninstall."},
+	0x800F0203: {0x800F0203, "SPAPI_E_NO_DRIVER_SELECTED", "There is no driver selected for the device information set or element."},
+	0x800F0205: {0x800F0205, "SPAPI_E_INVALID_DEVINST_NAME", "The device instance name is invalid."},
+	0x800F0206: {0x800F0206, "SPAPI_E_INVALID_CLASS", "The class is invalid."},
+	0x800F0207: {0x800F0207, "SPAPI_E_DEVINST_ALREADY_EXISTS", "The device instance cannot be created because it already exists."},
+	0x800F020B: {0x800F020B, "SPAPI_E_NO_SUCH_DEVINST", "The device instance does not exist in the hardware tree."},
+	0x800F0211: {0x800F0211, "SPAPI_E_NO_DEVICE_SELECTED", "There is no device information element currently selected for this device information set."},
+	0x800F0219: {0x800F0219, "SPAPI_E_NO_ASSOCIATED_SERVICE", "The installation failed because a function driver was not specified for this device instance."},
+	0x800F0225: {0x800F0225, "SPAPI_E_NO_SUCH_DEVICE_INTERFACE", "The requested device interface is not present in the system."},
+	0x800F024F: {0x800F024F, "SPAPI_E_NO_CATALOG_FOR_OEM_INF", "The third-party INF does not contain digital signature information."},
+	0x800F1000: {0x800F1000, "SPAPI_E_ERROR_NOT_INSTALLED", "No installed components were detected."},
+}
+
+// String returns the symbolic name of the facility (e.g. "FACILITY_WIN32"),
+// or its decimal value if the facility is not known.
+func (facility HRESULTFacility) String() string {
+	if name, ok := hresultFacilityNames[facility]; ok {
+		return name
+	}
+	return fmt.Sprintf("FACILITY_%d", uint16(facility))
+}
+
+// Failed returns true if the severity bit is set (FAILED macro)
+func (hr HRESULT) Failed() bool {
+	return hr&0x80000000 != 0
+}
+
+// Succeeded returns true if the severity bit is clear (SUCCEEDED macro)
+func (hr HRESULT) Succeeded() bool {
+	return !hr.Failed()
+}
+
+// Severity returns the severity bit (31) of the HRESULT: 0 for success, 1 for failure
+func (hr HRESULT) Severity() uint32 {
+	return uint32(hr >> 31)
+}
+
+// IsCustomer returns true if the customer bit (29) is set
+func (hr HRESULT) IsCustomer() bool {
+	return hr&0x20000000 != 0
+}
+
+// IsNTStatus returns true if the N bit (28) is set, meaning the HRESULT wraps an NTSTATUS
+func (hr HRESULT) IsNTStatus() bool {
+	return hr&FACILITY_NT_BIT != 0
+}
+
+// Facility returns the facility of the HRESULT (HRESULT_FACILITY macro), bits
+// 28-16. For HRESULTs that wrap an NTSTATUS this includes the N bit.
+func (hr HRESULT) Facility() HRESULTFacility {
+	return HRESULTFacility((hr >> 16) & 0x1FFF)
+}
+
+// Code returns the facility-specific code of the HRESULT (HRESULT_CODE macro)
+func (hr HRESULT) Code() uint16 {
+	return uint16(hr & 0xFFFF)
+}
+
+// String returns the symbolic name of the HRESULT, or its hexadecimal value if unknown
+func (hr HRESULT) String() string {
+	if name, err := GetHRESULTName(uint32(hr)); err == nil {
+		return name
+	}
+	return fmt.Sprintf("0x%08X", uint32(hr))
+}
+
+// Error implements the error interface
+func (hr HRESULT) Error() string {
+	return FormatHRESULT(uint32(hr))
+}
+
+// HRESULT_FROM_WIN32 converts a Win32 error code into an HRESULT in FACILITY_WIN32.
+// Values that are already HRESULTs (zero or with the high bit set) are returned unchanged.
+func HRESULT_FROM_WIN32(code uint32) HRESULT {
+	if int32(code) <= 0 {
+		return HRESULT(code)
+	}
+	return HRESULT((code & 0xFFFF) | (uint32(FACILITY_WIN32) << 16) | 0x80000000)
+}
+
+// HRESULT_FROM_NT converts an NTSTATUS code into an HRESULT by setting FACILITY_NT_BIT
+func HRESULT_FROM_NT(code uint32) HRESULT {
+	return HRESULT(code | FACILITY_NT_BIT)
+}
+
+// Win32FromHRESULT extracts the Win32 error code from an HRESULT.
+// It succeeds for S_OK, HRESULTs in FACILITY_WIN32, and HRESULTs wrapping an
+// NTSTATUS that has a Win32 equivalent. The boolean result is false otherwise.
+func Win32FromHRESULT(hr HRESULT) (uint32, bool) {
+	if hr == 0 {
+		return 0, true
+	}
+	if hr.IsNTStatus() {
+		status, _ := NTStatusFromHRESULT(hr)
+		return NTStatusToWin32(status)
+	}
+	if hr.Failed() && hr.Facility() == FACILITY_WIN32 {
+		return uint32(hr.Code()), true
+	}
+	return 0, false
+}
+
+// NTStatusFromHRESULT extracts the NTSTATUS code from an HRESULT created with
+// HRESULT_FROM_NT. The boolean result is false if the N bit is not set.
+func NTStatusFromHRESULT(hr HRESULT) (uint32, bool) {
+	if !hr.IsNTStatus() {
+		return 0, false
+	}
+	return uint32(hr) &^ FACILITY_NT_BIT, true
+}
+
+// GetHRESULTName returns the symbolic name for a given HRESULT code
+func GetHRESULTName(code uint32) (string, error) {
+	if hrCode, exists := HRESULTCodeMap[code]; exists {
+		return hrCode.Name, nil
+	}
+	return "", fmt.Errorf("HRESULT code 0x%08X not found", code)
+}
+
+// GetHRESULTDescription returns the description for a given HRESULT code
+func GetHRESULTDescription(code uint32) (string, error) {
+	if hrCode, exists := HRESULTCodeMap[code]; exists {
+		return hrCode.Description, nil
+	}
+	return "", fmt.Errorf("HRESULT code 0x%08X not found", code)
+}
+
+// GetHRESULTCode returns the full HRESULTCode struct for a given code
+func GetHRESULTCode(code uint32) (HRESULTCode, error) {
+	if hrCode, exists := HRESULTCodeMap[code]; exists {
+		return hrCode, nil
+	}
+	return HRESULTCode{}, fmt.Errorf("HRESULT code 0x%08X not found", code)
+}
+
+// FormatHRESULT returns a formatted string containing all information about an HRESULT code.
+// HRESULTs wrapping Win32 errors or NTSTATUS codes are resolved through the
+// Win32 and NTSTATUS tables when they are not listed themselves.
+func FormatHRESULT(code uint32) string {
+	if hrCode, exists := HRESULTCodeMap[code]; exists {
+		return fmt.Sprintf("[HRESULT: 0x%08X] %s: %s", hrCode.Code, hrCode.Name, hrCode.Description)
+	}
+
+	hr := HRESULT(code)
+	if status, ok := NTStatusFromHRESULT(hr); ok {
+		if statusCode, exists := NTStatusCodeMap[status]; exists {
+			return fmt.Sprintf("[HRESULT: 0x%08X] HRESULT_FROM_NT(%s): %s", code, statusCode.Name, statusCode.Description)
+		}
+	} else if hr.Failed() && hr.Facility() == FACILITY_WIN32 {
+		if errCode, exists := ErrorCodeMap[uint32(hr.Code())]; exists {
+			return fmt.Sprintf("[HRESULT: 0x%08X] HRESULT_FROM_WIN32(%s): %s", code, errCode.Name, errCode.Message)
+		}
+	}
+
+	return fmt.Sprintf("Unknown HRESULT code: 0x%08X (%s, code 0x%04X)", code, hr.Facility(), hr.Code())
+}
@@ -0,0 +1,191 @@
+package exitcodes
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHRESULTFields(t *testing.T) {
+	tests := []struct {
+		hr           HRESULT
+		wantFailed   bool
+		wantFacility HRESULTFacility
+		wantCode     uint16
+		wantNT       bool
+	}{
+		{0x00000000, false, FACILITY_NULL, 0x0000, false},
+		{0x00000001, false, FACILITY_NULL, 0x0001, false},
+		{0x80004005, true, FACILITY_NULL, 0x4005, false},
+		{0x80070005, true, FACILITY_WIN32, 0x0005, false},
+		{0x80041002, true, FACILITY_ITF, 0x1002, false},
+		{0x800F0203, true, FACILITY_SETUPAPI, 0x0203, false},
+		{0xD0000022, true, HRESULTFacility(0x1000), 0x0022, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.hr.Failed(); got != tt.wantFailed {
+			t.Errorf("HRESULT(0x%08X).Failed() = %v, want %v", uint32(tt.hr), got, tt.wantFailed)
+		}
+		if got := tt.hr.Succeeded(); got == tt.wantFailed {
+			t.Errorf("HRESULT(0x%08X).Succeeded() = %v, want %v", uint32(tt.hr), got, !tt.wantFailed)
+		}
+		if got := tt.hr.Facility(); got != tt.wantFacility {
+			t.Errorf("HRESULT(0x%08X).Facility() = %v, want %v", uint32(tt.hr), got, tt.wantFacility)
+		}
+		if got := tt.hr.Code(); got != tt.wantCode {
+			t.Errorf("HRESULT(0x%08X).Code() = 0x%04X, want 0x%04X", uint32(tt.hr), got, tt.wantCode)
+		}
+		if got := tt.hr.IsNTStatus(); got != tt.wantNT {
+			t.Errorf("HRESULT(0x%08X).IsNTStatus() = %v, want %v", uint32(tt.hr), got, tt.wantNT)
+		}
+	}
+}
+
+func TestHRESULTFacilityString(t *testing.T) {
+	tests := []struct {
+		facility HRESULTFacility
+		want     string
+	}{
+		{FACILITY_WIN32, "FACILITY_WIN32"},
+		{FACILITY_SETUPAPI, "FACILITY_SETUPAPI"},
+		{FACILITY_ITF, "FACILITY_ITF"},
+		{HRESULTFacility(4000), "FACILITY_4000"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.facility.String(); got != tt.want {
+			t.Errorf("HRESULTFacility(%d).String() = %v, want %v", uint16(tt.facility), got, tt.want)
+		}
+	}
+}
+
+func TestHRESULT_FROM_WIN32(t *testing.T) {
+	tests := []struct {
+		code uint32
+		want HRESULT
+	}{
+		{0, 0x00000000},
+		{5, 0x80070005},
+		{87, 0x80070057},
+		{122, 0x8007007A},
+		{1168, 0x80070490},
+		{0x80004005, 0x80004005}, // Already an HRESULT
+	}
+
+	for _, tt := range tests {
+		if got := HRESULT_FROM_WIN32(tt.code); got != tt.want {
+			t.Errorf("HRESULT_FROM_WIN32(%d) = 0x%08X, want 0x%08X", tt.code, uint32(got), uint32(tt.want))
+		}
+	}
+}
+
+func TestWin32FromHRESULT(t *testing.T) {
+	tests := []struct {
+		hr     HRESULT
+		want   uint32
+		wantOK bool
+	}{
+		{0x00000000, 0, true},
+		{0x80070005, 5, true},
+		{0x8007007A, 122, true},
+		{HRESULT_FROM_NT(0xC0000022), 5, true},
+		{0x80004005, 0, false},
+		{0x00070005, 0, false}, // Success severity is not a Win32 error
+	}
+
+	for _, tt := range tests {
+		got, ok := Win32FromHRESULT(tt.hr)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("Win32FromHRESULT(0x%08X) = (%d, %v), want (%d, %v)", uint32(tt.hr), got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestHRESULT_FROM_NT(t *testing.T) {
+	tests := []struct {
+		status uint32
+		want   HRESULT
+	}{
+		{0x00000000, 0x10000000},
+		{0xC0000022, 0xD0000022},
+		{0x80000005, 0x90000005},
+	}
+
+	for _, tt := range tests {
+		hr := HRESULT_FROM_NT(tt.status)
+		if hr != tt.want {
+			t.Errorf("HRESULT_FROM_NT(0x%08X) = 0x%08X, want 0x%08X", tt.status, uint32(hr), uint32(tt.want))
+		}
+		status, ok := NTStatusFromHRESULT(hr)
+		if !ok || status != tt.status {
+			t.Errorf("NTStatusFromHRESULT(0x%08X) = (0x%08X, %v), want (0x%08X, true)", uint32(hr), status, ok, tt.status)
+		}
+	}
+
+	if _, ok := NTStatusFromHRESULT(0x80070005); ok {
+		t.Error("NTStatusFromHRESULT(0x80070005) should fail without the N bit")
+	}
+}
+
+func TestGetHRESULTName(t *testing.T) {
+	tests := []struct {
+		code     uint32
+		wantName string
+		wantErr  bool
+	}{
+		{0x00000000, "S_OK", false},
+		{0x80004005, "E_FAIL", false},
+		{0x80070005, "E_ACCESSDENIED", false},
+		{0x80040154, "REGDB_E_CLASSNOTREG", false},
+		{0x80041002, "WBEM_E_NOT_FOUND", false},
+		{0x8FFFFFFF, "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := GetHRESULTName(tt.code)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("GetHRESULTName(0x%08X) error = %v, wantErr %v", tt.code, err, tt.wantErr)
+			continue
+		}
+		if got != tt.wantName {
+			t.Errorf("GetHRESULTName(0x%08X) = %v, want %v", tt.code, got, tt.wantName)
+		}
+	}
+}
+
+func TestFormatHRESULT(t *testing.T) {
+	tests := []struct {
+		code         uint32
+		wantContains []string
+	}{
+		{0x80004005, []string{"[HRESULT: 0x80004005]", "E_FAIL", "Unspecified error."}},
+		{0x80070002, []string{"[HRESULT: 0x80070002]", "HRESULT_FROM_WIN32(ERROR_FILE_NOT_FOUND)"}},
+		{0xD0000034, []string{"[HRESULT: 0xD0000034]", "HRESULT_FROM_NT(STATUS_OBJECT_NAME_NOT_FOUND)"}},
+		{0x8FFF1234, []string{"Unknown HRESULT code: 0x8FFF1234"}},
+	}
+
+	for _, tt := range tests {
+		got := FormatHRESULT(tt.code)
+		for _, want := range tt.wantContains {
+			if !strings.Contains(got, want) {
+				t.Errorf("FormatHRESULT(0x%08X) = %v, should contain %v", tt.code, got, want)
+			}
+		}
+	}
+
+	var err error = HRESULT(0x80070005)
+	if err.Error() != FormatHRESULT(0x80070005) {
+		t.Errorf("HRESULT.Error() = %v, want %v", err.Error(), FormatHRESULT(0x80070005))
+	}
+}
+
+func TestHRESULTCodeMapConsistency(t *testing.T) {
+	for code, hrCode := range HRESULTCodeMap {
+		if code != hrCode.Code {
+			t.Errorf("HRESULTCodeMap key 0x%08X does not match Code field 0x%08X", code, hrCode.Code)
+		}
+		if hrCode.Name == "" || hrCode.Description == "" {
+			t.Errorf("HRESULTCodeMap[0x%08X] has an empty name or description", code)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Command mkcodes generates the Win32 error and NTSTATUS tables of the
+// exitcodes package, and the STATUS_* constants of the winx package, from
+// winerror.h and ntstatus.h style headers.
+//
+// It is run through go generate from the exitcodes directory:
+//
+//	go run ./internal/mkcodes -winerror testdata/winerror.h -ntstatus testdata/ntstatus.h
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+)
+
+// nameOverrides replaces header names in ErrorCodeMap with the names the map
+// used before it was generated, so existing callers keep matching
+var nameOverrides = map[string]string{
+	"ERROR_SUCCESS": "SUCCESS",
+}
+
+func main() {
+	winerrorPath := flag.String("winerror", "testdata/winerror.h", "winerror.h style input")
+	ntstatusPath := flag.String("ntstatus", "testdata/ntstatus.h", "ntstatus.h style input")
+	errorsOut := flag.String("errors-out", "zerrors.go", "output for the Win32 error table")
+	ntstatusOut := flag.String("ntstatus-out", "zntstatus.go", "output for the NTSTATUS table")
+	winxOut := flag.String("winx-out", "../zntstatus.go", "output for the winx STATUS_* constants")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("mkcodes: ")
+
+	win32, err := readHeader(*winerrorPath, "ERROR_", "WAIT_")
+	if err != nil {
+		log.Fatal(err)
+	}
+	ntstatus, err := readHeader(*ntstatusPath, "STATUS_")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := writeSource(*errorsOut, genErrors(*winerrorPath, win32)); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeSource(*ntstatusOut, genNTStatusMap(*ntstatusPath, ntstatus)); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeSource(*winxOut, genNTStatusConsts(*ntstatusPath, ntstatus)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readHeader(path string, prefixes ...string) ([]Definition, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	defs, err := parseHeader(f, prefixes...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return defs, nil
+}
+
+// genErrors emits the typed Win32 error constants and ErrorCodeMap
+func genErrors(source string, defs []Definition) []byte {
+	var buf bytes.Buffer
+	header(&buf, source, "exitcodes")
+	buf.WriteString("import \"syscall\"\n\n")
+
+	buf.WriteString("// Win32 error codes\nconst (\n")
+	for _, def := range defs {
+		fmt.Fprintf(&buf, "\t%s syscall.Errno = %d\n", def.Name, def.Value)
+	}
+	buf.WriteString(")\n\n")
+
+	buf.WriteString("// ErrorCodeMap contains every Windows system error code\n")
+	buf.WriteString("var ErrorCodeMap = map[uint32]WindowsErrorCode{\n")
+	for _, def := range firstDefinitions(defs) {
+		name := def.Name
+		if override, ok := nameOverrides[name]; ok {
+			name = override
+		}
+		fmt.Fprintf(&buf, "\t%d: {%d, %q, %q},\n", def.Value, def.Value, name, def.description())
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+// genNTStatusMap emits NTStatusCodeMap
+func genNTStatusMap(source string, defs []Definition) []byte {
+	var buf bytes.Buffer
+	header(&buf, source, "exitcodes")
+
+	buf.WriteString("// NTStatusCodeMap contains every NT status code\n")
+	buf.WriteString("var NTStatusCodeMap = map[uint32]NTStatusCode{\n")
+	for _, def := range firstDefinitions(defs) {
+		fmt.Fprintf(&buf, "\t0x%08X: {0x%08X, %q, %q},\n", def.Value, def.Value, def.Name, def.description())
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+// genNTStatusConsts emits the typed STATUS_* constants of the winx package
+func genNTStatusConsts(source string, defs []Definition) []byte {
+	var buf bytes.Buffer
+	header(&buf, source, "winx")
+
+	buf.WriteString("// NTSTATUS values\nconst (\n")
+	for _, def := range defs {
+		fmt.Fprintf(&buf, "\t%s NTSTATUS = 0x%08X\n", def.Name, def.Value)
+	}
+	buf.WriteString(")\n")
+	return buf.Bytes()
+}
+
+// firstDefinitions keeps the first definition of each value, so values that
+// are defined more than once get a single map entry
+func firstDefinitions(defs []Definition) []Definition {
+	seen := make(map[uint32]bool)
+	var result []Definition
+	for _, def := range defs {
+		if seen[def.Value] {
+			continue
+		}
+		seen[def.Value] = true
+		result = append(result, def)
+	}
+	return result
+}
+
+func header(buf *bytes.Buffer, source, pkg string) {
+	fmt.Fprintf(buf, "// Code generated by mkcodes from %s; DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(buf, "package %s\n\n", pkg)
+}
+
+func writeSource(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return os.WriteFile(path, formatted, 0644)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Definition is a single #define taken from a winerror.h or ntstatus.h style header
+type Definition struct {
+	Name    string
+	Value   uint32
+	Message string
+}
+
+// description returns the message text of the definition, or its name for
+// defines that the header documents without a MessageText block
+func (def Definition) description() string {
+	if def.Message == "" {
+		return def.Name
+	}
+	return def.Message
+}
+
+// parseHeader reads the #define lines of a message-compiler generated header
+// along with the MessageText block that precedes each of them. Only defines
+// whose name starts with one of the given prefixes are returned, in the order
+// they appear in the header. Defines without a MessageText block are returned
+// with an empty Message.
+func parseHeader(r io.Reader, prefixes ...string) ([]Definition, error) {
+	var (
+		defs      []Definition
+		messageID string
+		text      []string
+		inText    bool
+	)
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case strings.HasPrefix(line, "// MessageId:"):
+			messageID = strings.TrimSpace(strings.TrimPrefix(line, "// MessageId:"))
+			text = nil
+			inText = false
+
+		case strings.HasPrefix(line, "// MessageText:"):
+			inText = true
+
+		case strings.HasPrefix(line, "//"):
+			if inText {
+				if t := strings.TrimSpace(strings.TrimPrefix(line, "//")); t != "" {
+					text = append(text, t)
+				}
+			}
+
+		case strings.HasPrefix(line, "#define"):
+			fields := strings.Fields(line)
+			if len(fields) < 3 || !hasAnyPrefix(fields[1], prefixes) {
+				messageID, text, inText = "", nil, false
+				continue
+			}
+			value, err := parseValue(fields[2])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s: %v", lineNumber, fields[1], err)
+			}
+
+			def := Definition{Name: fields[1], Value: value}
+			if messageID == def.Name {
+				def.Message = strings.Join(text, " ")
+			}
+			defs = append(defs, def)
+			messageID, text, inText = "", nil, false
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return defs, nil
+}
+
+// parseValue parses the value of a #define such as 1314L or ((NTSTATUS)0xC0000022L)
+func parseValue(s string) (uint32, error) {
+	s = strings.TrimPrefix(s, "((NTSTATUS)")
+	s = strings.TrimRight(s, ")")
+	s = strings.TrimSuffix(s, "L")
+	v, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(v), nil
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
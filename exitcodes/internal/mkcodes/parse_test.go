@@ -0,0 +1,103 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const sampleHeader = `
+//
+// MessageId: ERROR_ACCESS_DENIED
+//
+// MessageText:
+//
+// Access is denied.
+//
+#define ERROR_ACCESS_DENIED              5L
+
+//
+// MessageId: ERROR_PRIVILEGE_NOT_HELD
+//
+// MessageText:
+//
+// A required privilege is not held
+// by the client.
+//
+#define ERROR_PRIVILEGE_NOT_HELD         1314L
+
+#define ERROR_NO_TEXT                    1315L
+#define SEVERITY_ERROR                   1
+
+//
+// MessageId: STATUS_ACCESS_DENIED
+//
+// MessageText:
+//
+// {Access Denied}
+//
+#define STATUS_ACCESS_DENIED             ((NTSTATUS)0xC0000022L)
+`
+
+// TestParseHeader tests extraction of defines and their message text
+func TestParseHeader(t *testing.T) {
+	defs, err := parseHeader(strings.NewReader(sampleHeader), "ERROR_", "STATUS_")
+	if err != nil {
+		t.Fatalf("parseHeader() error = %v", err)
+	}
+
+	want := []Definition{
+		{"ERROR_ACCESS_DENIED", 5, "Access is denied."},
+		{"ERROR_PRIVILEGE_NOT_HELD", 1314, "A required privilege is not held by the client."},
+		{"ERROR_NO_TEXT", 1315, ""},
+		{"STATUS_ACCESS_DENIED", 0xC0000022, "{Access Denied}"},
+	}
+	if len(defs) != len(want) {
+		t.Fatalf("parseHeader() returned %d definitions, want %d: %+v", len(defs), len(want), defs)
+	}
+	for i := range want {
+		if defs[i] != want[i] {
+			t.Errorf("definition %d = %+v, want %+v", i, defs[i], want[i])
+		}
+	}
+}
+
+// TestParseHeader_BadValue tests that malformed values are reported
+func TestParseHeader_BadValue(t *testing.T) {
+	_, err := parseHeader(strings.NewReader("#define ERROR_BAD oops\n"), "ERROR_")
+	if err == nil {
+		t.Error("parseHeader() error = nil, want error for malformed value")
+	}
+}
+
+// TestFirstDefinitions tests that duplicates are dropped and untexted
+// definitions are kept
+func TestFirstDefinitions(t *testing.T) {
+	defs := []Definition{
+		{"STATUS_SUCCESS", 0, "The operation completed successfully."},
+		{"STATUS_WAIT_0", 0, "Wait."},
+		{"STATUS_NO_TEXT", 1, ""},
+		{"STATUS_PENDING", 0x103, "Pending."},
+	}
+	got := firstDefinitions(defs)
+	if len(got) != 3 || got[0].Name != "STATUS_SUCCESS" || got[1].Name != "STATUS_NO_TEXT" || got[2].Name != "STATUS_PENDING" {
+		t.Errorf("firstDefinitions() = %+v", got)
+	}
+}
+
+// TestGenNTStatusMap tests that defines without message text get a map entry
+// described by their name
+func TestGenNTStatusMap(t *testing.T) {
+	defs := []Definition{
+		{"STATUS_ACCESS_DENIED", 0xC0000022, "{Access Denied}"},
+		{"STATUS_FILE_SYSTEM_LIMITATION", 0xC0000427, ""},
+	}
+	src := string(genNTStatusMap("ntstatus.h", defs))
+	for _, want := range []string{
+		`0xC0000022: {0xC0000022, "STATUS_ACCESS_DENIED", "{Access Denied}"}`,
+		`0xC0000427: {0xC0000427, "STATUS_FILE_SYSTEM_LIMITATION", "STATUS_FILE_SYSTEM_LIMITATION"}`,
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("genNTStatusMap() is missing %s", want)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package exitcodes
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGetNTStatusName(t *testing.T) {
+	tests := []struct {
+		code    uint32
+		want    string
+		wantErr bool
+	}{
+		{0x00000000, "STATUS_SUCCESS", false},
+		{0x80000006, "STATUS_NO_MORE_FILES", false},
+		{0x8000001A, "STATUS_NO_MORE_ENTRIES", false},
+		{0xC0000022, "STATUS_ACCESS_DENIED", false},
+		{0xC00000D4, "STATUS_NOT_SAME_DEVICE", false},
+		{0xC00001A1, "STATUS_INVALID_LOCK_RANGE", false},
+		{0xC0000354, "STATUS_DEBUGGER_INACTIVE", false},
+		{0xC0000409, "STATUS_STACK_BUFFER_OVERRUN", false},
+		{0xE0FFFFFF, "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := GetNTStatusName(tt.code)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("GetNTStatusName(0x%08X) error = %v, wantErr %v", tt.code, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("GetNTStatusName(0x%08X) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestFormatNTStatus(t *testing.T) {
+	tests := []struct {
+		code uint32
+		want string
+	}{
+		{0xC0000061, "[NTSTATUS: 0xC0000061] STATUS_PRIVILEGE_NOT_HELD: A required privilege is not held by the client."},
+		{0xE0FFFFFF, "Unknown NTSTATUS code: 0xE0FFFFFF"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatNTStatus(tt.code); got != tt.want {
+			t.Errorf("FormatNTStatus(0x%08X) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestNTStatusCodeStructure(t *testing.T) {
+	for code, statusCode := range NTStatusCodeMap {
+		if statusCode.Code != code {
+			t.Errorf("NTStatusCodeMap[0x%08X].Code = 0x%08X, want 0x%08X", code, statusCode.Code, code)
+		}
+		if !strings.HasPrefix(statusCode.Name, "STATUS_") {
+			t.Errorf("NTStatusCodeMap[0x%08X].Name = %s, should start with STATUS_", code, statusCode.Name)
+		}
+		if statusCode.Description == "" {
+			t.Errorf("NTStatusCodeMap[0x%08X].Description is empty", code)
+		}
+	}
+}
+
+func TestGeneratedConstants(t *testing.T) {
+	if ERROR_PRIVILEGE_NOT_HELD != 1314 {
+		t.Errorf("ERROR_PRIVILEGE_NOT_HELD = %d, want 1314", ERROR_PRIVILEGE_NOT_HELD)
+	}
+	if WAIT_TIMEOUT != 258 {
+		t.Errorf("WAIT_TIMEOUT = %d, want 258", WAIT_TIMEOUT)
+	}
+	if name, _ := GetErrorName(uint32(ERROR_SERVICE_DOES_NOT_EXIST)); name != "ERROR_SERVICE_DOES_NOT_EXIST" {
+		t.Errorf("GetErrorName(ERROR_SERVICE_DOES_NOT_EXIST) = %q", name)
+	}
+}
+
+// headerDefines returns the value of every define in a testdata header whose
+// name starts with one of prefixes
+func headerDefines(t *testing.T, path string, prefixes ...string) map[string]uint32 {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	defines := make(map[string]uint32)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || fields[0] != "#define" {
+			continue
+		}
+		for _, prefix := range prefixes {
+			if !strings.HasPrefix(fields[1], prefix) {
+				continue
+			}
+			value := strings.TrimSuffix(strings.TrimRight(strings.TrimPrefix(fields[2], "((NTSTATUS)"), ")"), "L")
+			v, err := strconv.ParseUint(value, 0, 32)
+			if err != nil {
+				t.Fatalf("%s: %s: %v", path, fields[1], err)
+			}
+			defines[fields[1]] = uint32(v)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return defines
+}
+
+// TestGeneratedMapsCompleteness tests that every generated constant has a map
+// entry, including those the headers document without message text
+func TestGeneratedMapsCompleteness(t *testing.T) {
+	statuses := headerDefines(t, "testdata/ntstatus.h", "STATUS_")
+	if len(statuses) == 0 {
+		t.Fatal("no STATUS_ defines in testdata/ntstatus.h")
+	}
+	for name, code := range statuses {
+		if _, exists := NTStatusCodeMap[code]; !exists {
+			t.Errorf("%s (0x%08X) is missing from NTStatusCodeMap", name, code)
+		}
+	}
+
+	errors := headerDefines(t, "testdata/winerror.h", "ERROR_", "WAIT_")
+	if len(errors) == 0 {
+		t.Fatal("no ERROR_ defines in testdata/winerror.h")
+	}
+	for name, code := range errors {
+		if _, exists := ErrorCodeMap[code]; !exists {
+			t.Errorf("%s (%d) is missing from ErrorCodeMap", name, code)
+		}
+	}
+
+	if got := FormatNTStatus(0xC0000427); got != "[NTSTATUS: 0xC0000427] STATUS_FILE_SYSTEM_LIMITATION: STATUS_FILE_SYSTEM_LIMITATION" {
+		t.Errorf("FormatNTStatus(0xC0000427) = %q", got)
+	}
+}
@@ -0,0 +1,8014 @@
+/*++ BUILD Version: 0005    // Increment this if a change has global effects
+
+Module Name:
+
+    ntstatus.h
+
+Abstract:
+
+    Constant definitions for the NTSTATUS values. Input for
+    internal/mkcodes; regenerate with go generate.
+
+--*/
+
+
+//
+// MessageId: STATUS_SUCCESS
+//
+// MessageText:
+//
+// The operation completed successfully.
+//
+#define STATUS_SUCCESS                           ((NTSTATUS)0x00000000L)
+
+#define STATUS_WAIT_0                            ((NTSTATUS)0x00000000L)
+
+//
+// MessageId: STATUS_WAIT_1
+//
+// MessageText:
+//
+// The caller specified WaitAny for WaitType and one of the dispatcher objects
+// in the Object array has been set to the signaled state.
+//
+#define STATUS_WAIT_1                            ((NTSTATUS)0x00000001L)
+
+//
+// MessageId: STATUS_WAIT_2
+//
+// MessageText:
+//
+// The caller specified WaitAny for WaitType and one of the dispatcher objects
+// in the Object array has been set to the signaled state.
+//
+#define STATUS_WAIT_2                            ((NTSTATUS)0x00000002L)
+
+//
+// MessageId: STATUS_WAIT_3
+//
+// MessageText:
+//
+// The caller specified WaitAny for WaitType and one of the dispatcher objects
+// in the Object array has been set to the signaled state.
+//
+#define STATUS_WAIT_3                            ((NTSTATUS)0x00000003L)
+
+//
+// MessageId: STATUS_WAIT_63
+//
+// MessageText:
+//
+// The caller specified WaitAny for WaitType and one of the dispatcher objects
+// in the Object array has been set to the signaled state.
+//
+#define STATUS_WAIT_63                           ((NTSTATUS)0x0000003FL)
+
+//
+// MessageId: STATUS_ABANDONED
+//
+// MessageText:
+//
+// The caller attempted to wait for a mutex that has been abandoned.
+//
+#define STATUS_ABANDONED                         ((NTSTATUS)0x00000080L)
+
+#define STATUS_ABANDONED_WAIT_0                  ((NTSTATUS)0x00000080L)
+
+//
+// MessageId: STATUS_ABANDONED_WAIT_63
+//
+// MessageText:
+//
+// The caller attempted to wait for a mutex that has been abandoned.
+//
+#define STATUS_ABANDONED_WAIT_63                 ((NTSTATUS)0x000000BFL)
+
+//
+// MessageId: STATUS_USER_APC
+//
+// MessageText:
+//
+// A user-mode APC was delivered before the given Interval expired.
+//
+#define STATUS_USER_APC                          ((NTSTATUS)0x000000C0L)
+
+#define STATUS_ALREADY_COMPLETE                  ((NTSTATUS)0x000000FFL)
+
+//
+// MessageId: STATUS_KERNEL_APC
+//
+// MessageText:
+//
+// The delay completed because the thread was alerted.
+//
+#define STATUS_KERNEL_APC                        ((NTSTATUS)0x00000100L)
+
+//
+// MessageId: STATUS_ALERTED
+//
+// MessageText:
+//
+// The delay completed because the thread was alerted.
+//
+#define STATUS_ALERTED                           ((NTSTATUS)0x00000101L)
+
+//
+// MessageId: STATUS_TIMEOUT
+//
+// MessageText:
+//
+// The given Timeout interval expired.
+//
+#define STATUS_TIMEOUT                           ((NTSTATUS)0x00000102L)
+
+//
+// MessageId: STATUS_PENDING
+//
+// MessageText:
+//
+// The operation that was requested is pending completion.
+//
+#define STATUS_PENDING                           ((NTSTATUS)0x00000103L)
+
+//
+// MessageId: STATUS_REPARSE
+//
+// MessageText:
+//
+// A reparse should be performed by the Object Manager because the name of the
+// file resulted in a symbolic link.
+//
+#define STATUS_REPARSE                           ((NTSTATUS)0x00000104L)
+
+//
+// MessageId: STATUS_MORE_ENTRIES
+//
+// MessageText:
+//
+// Returned by enumeration APIs to indicate more information is available to
+// successive calls.
+//
+#define STATUS_MORE_ENTRIES                      ((NTSTATUS)0x00000105L)
+
+//
+// MessageId: STATUS_NOT_ALL_ASSIGNED
+//
+// MessageText:
+//
+// Indicates not all privileges or groups that are referenced are assigned to
+// the caller. This allows, for example, all privileges to be disabled without
+// having to know exactly which privileges are assigned.
+//
+#define STATUS_NOT_ALL_ASSIGNED                  ((NTSTATUS)0x00000106L)
+
+//
+// MessageId: STATUS_SOME_NOT_MAPPED
+//
+// MessageText:
+//
+// Some of the information to be translated has not been translated.
+//
+#define STATUS_SOME_NOT_MAPPED                   ((NTSTATUS)0x00000107L)
+
+//
+// MessageId: STATUS_OPLOCK_BREAK_IN_PROGRESS
+//
+// MessageText:
+//
+// An open/create operation completed while an opportunistic lock (oplock)
+// break is underway.
+//
+#define STATUS_OPLOCK_BREAK_IN_PROGRESS          ((NTSTATUS)0x00000108L)
+
+//
+// MessageId: STATUS_VOLUME_MOUNTED
+//
+// MessageText:
+//
+// A new volume has been mounted by a file system.
+//
+#define STATUS_VOLUME_MOUNTED                    ((NTSTATUS)0x00000109L)
+
+//
+// MessageId: STATUS_RXACT_COMMITTED
+//
+// MessageText:
+//
+// This success level status indicates that the transaction state already
+// exists for the registry subtree but that a transaction commit was previously
+// aborted. The commit has now been completed.
+//
+#define STATUS_RXACT_COMMITTED                   ((NTSTATUS)0x0000010AL)
+
+//
+// MessageId: STATUS_NOTIFY_CLEANUP
+//
+// MessageText:
+//
+// Indicates that a notify change request has been completed due to closing the
+// handle that made the notify change request.
+//
+#define STATUS_NOTIFY_CLEANUP                    ((NTSTATUS)0x0000010BL)
+
+//
+// MessageId: STATUS_NOTIFY_ENUM_DIR
+//
+// MessageText:
+//
+// Indicates that a notify change request is being completed and that the
+// information is not being returned in the caller's buffer. The caller now
+// needs to enumerate the files to find the changes.
+//
+#define STATUS_NOTIFY_ENUM_DIR                   ((NTSTATUS)0x0000010CL)
+
+//
+// MessageId: STATUS_NO_QUOTAS_FOR_ACCOUNT
+//
+// MessageText:
+//
+// {No Quotas} No system quota limits are specifically set for this account.
+//
+#define STATUS_NO_QUOTAS_FOR_ACCOUNT             ((NTSTATUS)0x0000010DL)
+
+//
+// MessageId: STATUS_PRIMARY_TRANSPORT_CONNECT_FAILED
+//
+// MessageText:
+//
+// {Connect Failure on Primary Transport} An attempt was made to connect to the
+// remote server %hs on the primary transport, but the connection failed. The
+// computer WAS able to connect on a secondary transport.
+//
+#define STATUS_PRIMARY_TRANSPORT_CONNECT_FAILED  ((NTSTATUS)0x0000010EL)
+
+//
+// MessageId: STATUS_PAGE_FAULT_TRANSITION
+//
+// MessageText:
+//
+// The page fault was a transition fault.
+//
+#define STATUS_PAGE_FAULT_TRANSITION             ((NTSTATUS)0x00000110L)
+
+//
+// MessageId: STATUS_PAGE_FAULT_DEMAND_ZERO
+//
+// MessageText:
+//
+// The page fault was a demand zero fault.
+//
+#define STATUS_PAGE_FAULT_DEMAND_ZERO            ((NTSTATUS)0x00000111L)
+
+//
+// MessageId: STATUS_PAGE_FAULT_COPY_ON_WRITE
+//
+// MessageText:
+//
+// The page fault was a demand zero fault.
+//
+#define STATUS_PAGE_FAULT_COPY_ON_WRITE          ((NTSTATUS)0x00000112L)
+
+//
+// MessageId: STATUS_PAGE_FAULT_GUARD_PAGE
+//
+// MessageText:
+//
+// The page fault was a demand zero fault.
+//
+#define STATUS_PAGE_FAULT_GUARD_PAGE             ((NTSTATUS)0x00000113L)
+
+//
+// MessageId: STATUS_PAGE_FAULT_PAGING_FILE
+//
+// MessageText:
+//
+// The page fault was satisfied by reading from a secondary storage device.
+//
+#define STATUS_PAGE_FAULT_PAGING_FILE            ((NTSTATUS)0x00000114L)
+
+//
+// MessageId: STATUS_CACHE_PAGE_LOCKED
+//
+// MessageText:
+//
+// The cached page was locked during operation.
+//
+#define STATUS_CACHE_PAGE_LOCKED                 ((NTSTATUS)0x00000115L)
+
+//
+// MessageId: STATUS_CRASH_DUMP
+//
+// MessageText:
+//
+// The crash dump exists in a paging file.
+//
+#define STATUS_CRASH_DUMP                        ((NTSTATUS)0x00000116L)
+
+//
+// MessageId: STATUS_BUFFER_ALL_ZEROS
+//
+// MessageText:
+//
+// The specified buffer contains all zeros.
+//
+#define STATUS_BUFFER_ALL_ZEROS                  ((NTSTATUS)0x00000117L)
+
+//
+// MessageId: STATUS_REPARSE_OBJECT
+//
+// MessageText:
+//
+// A reparse should be performed by the Object Manager because the name of the
+// file resulted in a symbolic link.
+//
+#define STATUS_REPARSE_OBJECT                    ((NTSTATUS)0x00000118L)
+
+//
+// MessageId: STATUS_RESOURCE_REQUIREMENTS_CHANGED
+//
+// MessageText:
+//
+// The device has succeeded a query-stop and its resource requirements have
+// changed.
+//
+#define STATUS_RESOURCE_REQUIREMENTS_CHANGED     ((NTSTATUS)0x00000119L)
+
+//
+// MessageId: STATUS_TRANSLATION_COMPLETE
+//
+// MessageText:
+//
+// The translator has translated these resources into the global space and no
+// additional translations should be performed.
+//
+#define STATUS_TRANSLATION_COMPLETE              ((NTSTATUS)0x00000120L)
+
+//
+// MessageId: STATUS_DS_MEMBERSHIP_EVALUATED_LOCALLY
+//
+// MessageText:
+//
+// The directory service evaluated group memberships locally, because it was
+// unable to contact a global catalog server.
+//
+#define STATUS_DS_MEMBERSHIP_EVALUATED_LOCALLY   ((NTSTATUS)0x00000121L)
+
+//
+// MessageId: STATUS_NOTHING_TO_TERMINATE
+//
+// MessageText:
+//
+// A process being terminated has no threads to terminate.
+//
+#define STATUS_NOTHING_TO_TERMINATE              ((NTSTATUS)0x00000122L)
+
+//
+// MessageId: STATUS_PROCESS_NOT_IN_JOB
+//
+// MessageText:
+//
+// The specified process is not part of a job.
+//
+#define STATUS_PROCESS_NOT_IN_JOB                ((NTSTATUS)0x00000123L)
+
+//
+// MessageId: STATUS_PROCESS_IN_JOB
+//
+// MessageText:
+//
+// The specified process is part of a job.
+//
+#define STATUS_PROCESS_IN_JOB                    ((NTSTATUS)0x00000124L)
+
+//
+// MessageId: STATUS_VOLSNAP_HIBERNATE_READY
+//
+// MessageText:
+//
+// {Volume Shadow Copy Service} The system is now ready for hibernation.
+//
+#define STATUS_VOLSNAP_HIBERNATE_READY           ((NTSTATUS)0x00000125L)
+
+//
+// MessageId: STATUS_FSFILTER_OP_COMPLETED_SUCCESSFULLY
+//
+// MessageText:
+//
+// A file system or file system filter driver has successfully completed an
+// FsFilter operation.
+//
+#define STATUS_FSFILTER_OP_COMPLETED_SUCCESSFULLY ((NTSTATUS)0x00000126L)
+
+//
+// MessageId: STATUS_INTERRUPT_VECTOR_ALREADY_CONNECTED
+//
+// MessageText:
+//
+// The specified interrupt vector was already connected.
+//
+#define STATUS_INTERRUPT_VECTOR_ALREADY_CONNECTED ((NTSTATUS)0x00000127L)
+
+//
+// MessageId: STATUS_INTERRUPT_STILL_CONNECTED
+//
+// MessageText:
+//
+// The specified interrupt vector is still connected.
+//
+#define STATUS_INTERRUPT_STILL_CONNECTED         ((NTSTATUS)0x00000128L)
+
+//
+// MessageId: STATUS_PROCESS_CLONED
+//
+// MessageText:
+//
+// The current process is a cloned process.
+//
+#define STATUS_PROCESS_CLONED                    ((NTSTATUS)0x00000129L)
+
+//
+// MessageId: STATUS_FILE_LOCKED_WITH_ONLY_READERS
+//
+// MessageText:
+//
+// The file was locked and all users of the file can only read.
+//
+#define STATUS_FILE_LOCKED_WITH_ONLY_READERS     ((NTSTATUS)0x0000012AL)
+
+//
+// MessageId: STATUS_FILE_LOCKED_WITH_WRITERS
+//
+// MessageText:
+//
+// The file was locked and at least one user of the file can write.
+//
+#define STATUS_FILE_LOCKED_WITH_WRITERS          ((NTSTATUS)0x0000012BL)
+
+#define STATUS_VALID_IMAGE_HASH                  ((NTSTATUS)0x0000012CL)
+
+#define STATUS_VALID_CATALOG_HASH                ((NTSTATUS)0x0000012DL)
+
+#define STATUS_VALID_STRONG_CODE_HASH            ((NTSTATUS)0x0000012EL)
+
+#define STATUS_GHOSTED                           ((NTSTATUS)0x0000012FL)
+
+#define STATUS_DATA_OVERWRITTEN                  ((NTSTATUS)0x00000130L)
+
+#define STATUS_RESOURCEMANAGER_READ_ONLY         ((NTSTATUS)0x00000202L)
+
+#define STATUS_RING_PREVIOUSLY_EMPTY             ((NTSTATUS)0x00000210L)
+
+#define STATUS_RING_PREVIOUSLY_FULL              ((NTSTATUS)0x00000211L)
+
+#define STATUS_RING_PREVIOUSLY_ABOVE_QUOTA       ((NTSTATUS)0x00000212L)
+
+#define STATUS_RING_NEWLY_EMPTY                  ((NTSTATUS)0x00000213L)
+
+#define STATUS_RING_SIGNAL_OPPOSITE_ENDPOINT     ((NTSTATUS)0x00000214L)
+
+#define STATUS_OPLOCK_SWITCHED_TO_NEW_HANDLE     ((NTSTATUS)0x00000215L)
+
+#define STATUS_OPLOCK_HANDLE_CLOSED              ((NTSTATUS)0x00000216L)
+
+//
+// MessageId: STATUS_WAIT_FOR_OPLOCK
+//
+// MessageText:
+//
+// The operation is blocked waiting for an oplock.
+//
+#define STATUS_WAIT_FOR_OPLOCK                   ((NTSTATUS)0x00000367L)
+
+#define STATUS_REPARSE_GLOBAL                    ((NTSTATUS)0x00000368L)
+
+#define STATUS_FLT_IO_COMPLETE                   ((NTSTATUS)0x001C0001L)
+
+//
+// MessageId: STATUS_OBJECT_NAME_EXISTS
+//
+// MessageText:
+//
+// {Object Exists} An attempt was made to create an object but the object name
+// already exists.
+//
+#define STATUS_OBJECT_NAME_EXISTS                ((NTSTATUS)0x40000000L)
+
+//
+// MessageId: STATUS_THREAD_WAS_SUSPENDED
+//
+// MessageText:
+//
+// {Thread Suspended} A thread termination occurred while the thread was
+// suspended. The thread resumed, and termination proceeded.
+//
+#define STATUS_THREAD_WAS_SUSPENDED              ((NTSTATUS)0x40000001L)
+
+//
+// MessageId: STATUS_WORKING_SET_LIMIT_RANGE
+//
+// MessageText:
+//
+// {Working Set Range Error} An attempt was made to set the working set minimum
+// or maximum to values that are outside the allowable range.
+//
+#define STATUS_WORKING_SET_LIMIT_RANGE           ((NTSTATUS)0x40000002L)
+
+//
+// MessageId: STATUS_IMAGE_NOT_AT_BASE
+//
+// MessageText:
+//
+// {Image Relocated} An image file could not be mapped at the address that is
+// specified in the image file. Local fixes must be performed on this image.
+//
+#define STATUS_IMAGE_NOT_AT_BASE                 ((NTSTATUS)0x40000003L)
+
+//
+// MessageId: STATUS_RXACT_STATE_CREATED
+//
+// MessageText:
+//
+// This informational level status indicates that a specified registry subtree
+// transaction state did not yet exist and had to be created.
+//
+#define STATUS_RXACT_STATE_CREATED               ((NTSTATUS)0x40000004L)
+
+#define STATUS_SEGMENT_NOTIFICATION              ((NTSTATUS)0x40000005L)
+
+//
+// MessageId: STATUS_LOCAL_USER_SESSION_KEY
+//
+// MessageText:
+//
+// {Local Session Key} A user session key was requested for a local remote
+// procedure call (RPC) connection. The session key that is returned is a
+// constant value and not unique to this connection.
+//
+#define STATUS_LOCAL_USER_SESSION_KEY            ((NTSTATUS)0x40000006L)
+
+#define STATUS_BAD_CURRENT_DIRECTORY             ((NTSTATUS)0x40000007L)
+
+//
+// MessageId: STATUS_SERIAL_MORE_WRITES
+//
+// MessageText:
+//
+// {Invalid Current Directory} The process cannot switch to the startup current
+// directory %hs. Select OK to set the current directory to %hs, or select
+// CANCEL to exit.
+//
+#define STATUS_SERIAL_MORE_WRITES                ((NTSTATUS)0x40000008L)
+
+#define STATUS_REGISTRY_RECOVERED                ((NTSTATUS)0x40000009L)
+
+#define STATUS_FT_READ_RECOVERY_FROM_BACKUP      ((NTSTATUS)0x4000000AL)
+
+#define STATUS_FT_WRITE_RECOVERY                 ((NTSTATUS)0x4000000BL)
+
+#define STATUS_SERIAL_COUNTER_TIMEOUT            ((NTSTATUS)0x4000000CL)
+
+//
+// MessageId: STATUS_NULL_LM_PASSWORD
+//
+// MessageText:
+//
+// {Page Unlocked} The page protection of a locked page was changed to 'No
+// Access' and the page was unlocked from memory and from the process.
+//
+#define STATUS_NULL_LM_PASSWORD                  ((NTSTATUS)0x4000000DL)
+
+#define STATUS_IMAGE_MACHINE_TYPE_MISMATCH       ((NTSTATUS)0x4000000EL)
+
+//
+// MessageId: STATUS_RECEIVE_PARTIAL
+//
+// MessageText:
+//
+// {Page Locked} One of the pages to lock was already locked.
+//
+#define STATUS_RECEIVE_PARTIAL                   ((NTSTATUS)0x4000000FL)
+
+#define STATUS_RECEIVE_EXPEDITED                 ((NTSTATUS)0x40000010L)
+
+#define STATUS_RECEIVE_PARTIAL_EXPEDITED         ((NTSTATUS)0x40000011L)
+
+//
+// MessageId: STATUS_EVENT_DONE
+//
+// MessageText:
+//
+// {Kernel Debugger Awakened} The system debugger was awakened by an interrupt.
+//
+#define STATUS_EVENT_DONE                        ((NTSTATUS)0x40000012L)
+
+#define STATUS_EVENT_PENDING                     ((NTSTATUS)0x40000013L)
+
+#define STATUS_CHECKING_FILE_SYSTEM              ((NTSTATUS)0x40000014L)
+
+#define STATUS_FATAL_APP_EXIT                    ((NTSTATUS)0x40000015L)
+
+//
+// MessageId: STATUS_PREDEFINED_HANDLE
+//
+// MessageText:
+//
+// A yield execution was performed and no thread was available to run.
+//
+#define STATUS_PREDEFINED_HANDLE                 ((NTSTATUS)0x40000016L)
+
+#define STATUS_WAS_UNLOCKED                      ((NTSTATUS)0x40000017L)
+
+#define STATUS_SERVICE_NOTIFICATION              ((NTSTATUS)0x40000018L)
+
+#define STATUS_WAS_LOCKED                        ((NTSTATUS)0x40000019L)
+
+#define STATUS_LOG_HARD_ERROR                    ((NTSTATUS)0x4000001AL)
+
+#define STATUS_ALREADY_WIN32                     ((NTSTATUS)0x4000001BL)
+
+#define STATUS_WX86_UNSIMULATE                   ((NTSTATUS)0x4000001CL)
+
+#define STATUS_WX86_CONTINUE                     ((NTSTATUS)0x4000001DL)
+
+//
+// MessageId: STATUS_WX86_SINGLE_STEP
+//
+// MessageText:
+//
+// The system was put into hibernation.
+//
+#define STATUS_WX86_SINGLE_STEP                  ((NTSTATUS)0x4000001EL)
+
+#define STATUS_WX86_BREAKPOINT                   ((NTSTATUS)0x4000001FL)
+
+#define STATUS_WX86_EXCEPTION_CONTINUE           ((NTSTATUS)0x40000020L)
+
+#define STATUS_WX86_EXCEPTION_LASTCHANCE         ((NTSTATUS)0x40000021L)
+
+#define STATUS_WX86_EXCEPTION_CHAIN              ((NTSTATUS)0x40000022L)
+
+#define STATUS_IMAGE_MACHINE_TYPE_MISMATCH_EXE   ((NTSTATUS)0x40000023L)
+
+//
+// MessageId: STATUS_NO_YIELD_PERFORMED
+//
+// MessageText:
+//
+// An operation is blocked and waiting for an oplock.
+//
+#define STATUS_NO_YIELD_PERFORMED                ((NTSTATUS)0x40000024L)
+
+#define STATUS_TIMER_RESUME_IGNORED              ((NTSTATUS)0x40000025L)
+
+#define STATUS_ARBITRATION_UNHANDLED             ((NTSTATUS)0x40000026L)
+
+#define STATUS_CARDBUS_NOT_SUPPORTED             ((NTSTATUS)0x40000027L)
+
+#define STATUS_WX86_CREATEWX86TIB                ((NTSTATUS)0x40000028L)
+
+#define STATUS_MP_PROCESSOR_MISMATCH             ((NTSTATUS)0x40000029L)
+
+#define STATUS_HIBERNATED                        ((NTSTATUS)0x4000002AL)
+
+#define STATUS_RESUME_HIBERNATION                ((NTSTATUS)0x4000002BL)
+
+#define STATUS_FIRMWARE_UPDATED                  ((NTSTATUS)0x4000002CL)
+
+#define STATUS_DRIVERS_LEAKING_LOCKED_PAGES      ((NTSTATUS)0x4000002DL)
+
+#define STATUS_MESSAGE_RETRIEVED                 ((NTSTATUS)0x4000002EL)
+
+#define STATUS_SYSTEM_POWERSTATE_TRANSITION      ((NTSTATUS)0x4000002FL)
+
+#define STATUS_ALPC_CHECK_COMPLETION_LIST        ((NTSTATUS)0x40000030L)
+
+#define STATUS_SYSTEM_POWERSTATE_COMPLEX_TRANSITION ((NTSTATUS)0x40000031L)
+
+#define STATUS_ACCESS_AUDIT_BY_POLICY            ((NTSTATUS)0x40000032L)
+
+#define STATUS_ABANDON_HIBERFILE                 ((NTSTATUS)0x40000033L)
+
+#define STATUS_BIZRULES_NOT_ENABLED              ((NTSTATUS)0x40000034L)
+
+#define STATUS_FT_READ_FROM_COPY                 ((NTSTATUS)0x40000035L)
+
+#define STATUS_IMAGE_AT_DIFFERENT_BASE           ((NTSTATUS)0x40000036L)
+
+#define STATUS_PATCH_DEFERRED                    ((NTSTATUS)0x40000037L)
+
+#define STATUS_HEURISTIC_DAMAGE_POSSIBLE         ((NTSTATUS)0x40190001L)
+
+//
+// MessageId: STATUS_GUARD_PAGE_VIOLATION
+//
+// MessageText:
+//
+// {EXCEPTION} Guard Page Exception A page of memory that marks the end of a
+// data structure, such as a stack or an array, has been accessed.
+//
+#define STATUS_GUARD_PAGE_VIOLATION              ((NTSTATUS)0x80000001L)
+
+//
+// MessageId: STATUS_DATATYPE_MISALIGNMENT
+//
+// MessageText:
+//
+// {EXCEPTION} Alignment Fault A data type misalignment was detected in a load
+// or store instruction.
+//
+#define STATUS_DATATYPE_MISALIGNMENT             ((NTSTATUS)0x80000002L)
+
+//
+// MessageId: STATUS_BREAKPOINT
+//
+// MessageText:
+//
+// {EXCEPTION} Breakpoint A breakpoint has been reached.
+//
+#define STATUS_BREAKPOINT                        ((NTSTATUS)0x80000003L)
+
+//
+// MessageId: STATUS_SINGLE_STEP
+//
+// MessageText:
+//
+// {EXCEPTION} Single Step A single step or trace operation has just been
+// completed.
+//
+#define STATUS_SINGLE_STEP                       ((NTSTATUS)0x80000004L)
+
+//
+// MessageId: STATUS_BUFFER_OVERFLOW
+//
+// MessageText:
+//
+// {Buffer Overflow} The data was too large to fit into the specified buffer.
+//
+#define STATUS_BUFFER_OVERFLOW                   ((NTSTATUS)0x80000005L)
+
+//
+// MessageId: STATUS_NO_MORE_FILES
+//
+// MessageText:
+//
+// {No More Files} No more files were found which match the file specification.
+//
+#define STATUS_NO_MORE_FILES                     ((NTSTATUS)0x80000006L)
+
+//
+// MessageId: STATUS_WAKE_SYSTEM_DEBUGGER
+//
+// MessageText:
+//
+// {Kernel Debugger Awakened} The system debugger was awakened by an interrupt.
+//
+#define STATUS_WAKE_SYSTEM_DEBUGGER              ((NTSTATUS)0x80000007L)
+
+//
+// MessageId: STATUS_HANDLES_CLOSED
+//
+// MessageText:
+//
+// {Handles Closed} Handles to objects have been automatically closed because
+// of the requested operation.
+//
+#define STATUS_HANDLES_CLOSED                    ((NTSTATUS)0x8000000AL)
+
+//
+// MessageId: STATUS_NO_INHERITANCE
+//
+// MessageText:
+//
+// {Non-Inheritable ACL} An access control list (ACL) contains no components
+// that can be inherited.
+//
+#define STATUS_NO_INHERITANCE                    ((NTSTATUS)0x8000000BL)
+
+//
+// MessageId: STATUS_GUID_SUBSTITUTION_MADE
+//
+// MessageText:
+//
+// {GUID Substitution} During the translation of a globally unique identifier
+// (GUID) to a Windows security ID (SID), no administratively defined GUID
+// prefix was found. A substitute prefix was used, which will not compromise
+// system security. However, this might provide a more restrictive access than
+// intended.
+//
+#define STATUS_GUID_SUBSTITUTION_MADE            ((NTSTATUS)0x8000000CL)
+
+//
+// MessageId: STATUS_PARTIAL_COPY
+//
+// MessageText:
+//
+// Because of protection conflicts, not all the requested bytes could be
+// copied.
+//
+#define STATUS_PARTIAL_COPY                      ((NTSTATUS)0x8000000DL)
+
+//
+// MessageId: STATUS_DEVICE_PAPER_EMPTY
+//
+// MessageText:
+//
+// {Out of Paper} The printer is out of paper.
+//
+#define STATUS_DEVICE_PAPER_EMPTY                ((NTSTATUS)0x8000000EL)
+
+//
+// MessageId: STATUS_DEVICE_POWERED_OFF
+//
+// MessageText:
+//
+// {Device Power Is Off} The printer power has been turned off.
+//
+#define STATUS_DEVICE_POWERED_OFF                ((NTSTATUS)0x8000000FL)
+
+//
+// MessageId: STATUS_DEVICE_OFF_LINE
+//
+// MessageText:
+//
+// {Device Offline} The printer has been taken offline.
+//
+#define STATUS_DEVICE_OFF_LINE                   ((NTSTATUS)0x80000010L)
+
+//
+// MessageId: STATUS_DEVICE_BUSY
+//
+// MessageText:
+//
+// {Device Busy} The device is currently busy.
+//
+#define STATUS_DEVICE_BUSY                       ((NTSTATUS)0x80000011L)
+
+//
+// MessageId: STATUS_NO_MORE_EAS
+//
+// MessageText:
+//
+// {No More EAs} No more extended attributes (EAs) were found for the file.
+//
+#define STATUS_NO_MORE_EAS                       ((NTSTATUS)0x80000012L)
+
+//
+// MessageId: STATUS_INVALID_EA_NAME
+//
+// MessageText:
+//
+// {Illegal EA} The specified extended attribute (EA) name contains at least
+// one illegal character.
+//
+#define STATUS_INVALID_EA_NAME                   ((NTSTATUS)0x80000013L)
+
+//
+// MessageId: STATUS_EA_LIST_INCONSISTENT
+//
+// MessageText:
+//
+// {Inconsistent EA List} The extended attribute (EA) list is inconsistent.
+//
+#define STATUS_EA_LIST_INCONSISTENT              ((NTSTATUS)0x80000014L)
+
+//
+// MessageId: STATUS_INVALID_EA_FLAG
+//
+// MessageText:
+//
+// {Invalid EA Flag} An invalid extended attribute (EA) flag was set.
+//
+#define STATUS_INVALID_EA_FLAG                   ((NTSTATUS)0x80000015L)
+
+//
+// MessageId: STATUS_VERIFY_REQUIRED
+//
+// MessageText:
+//
+// {Verifying Disk} The media has changed and a verify operation is in
+// progress; therefore, no reads or writes can be performed to the device,
+// except those that are used in the verify operation.
+//
+#define STATUS_VERIFY_REQUIRED                   ((NTSTATUS)0x80000016L)
+
+//
+// MessageId: STATUS_EXTRANEOUS_INFORMATION
+//
+// MessageText:
+//
+// {Too Much Information} The specified access control list (ACL) contained
+// more information than was expected.
+//
+#define STATUS_EXTRANEOUS_INFORMATION            ((NTSTATUS)0x80000017L)
+
+//
+// MessageId: STATUS_RXACT_COMMIT_NECESSARY
+//
+// MessageText:
+//
+// This warning level status indicates that the transaction state already
+// exists for the registry subtree, but that a transaction commit was
+// previously aborted. The commit has NOT been completed but has not been
+// rolled back either; therefore, it can still be committed, if needed.
+//
+#define STATUS_RXACT_COMMIT_NECESSARY            ((NTSTATUS)0x80000018L)
+
+//
+// MessageId: STATUS_NO_MORE_ENTRIES
+//
+// MessageText:
+//
+// {No More Entries} No more entries are available from an enumeration
+// operation.
+//
+#define STATUS_NO_MORE_ENTRIES                   ((NTSTATUS)0x8000001AL)
+
+//
+// MessageId: STATUS_FILEMARK_DETECTED
+//
+// MessageText:
+//
+// {Filemark Found} A filemark was detected.
+//
+#define STATUS_FILEMARK_DETECTED                 ((NTSTATUS)0x8000001BL)
+
+//
+// MessageId: STATUS_MEDIA_CHANGED
+//
+// MessageText:
+//
+// {Media Changed} The media has changed.
+//
+#define STATUS_MEDIA_CHANGED                     ((NTSTATUS)0x8000001CL)
+
+//
+// MessageId: STATUS_BUS_RESET
+//
+// MessageText:
+//
+// {I/O Bus Reset} An I/O bus reset was detected.
+//
+#define STATUS_BUS_RESET                         ((NTSTATUS)0x8000001DL)
+
+//
+// MessageId: STATUS_END_OF_MEDIA
+//
+// MessageText:
+//
+// {End of Media} The end of the media was encountered.
+//
+#define STATUS_END_OF_MEDIA                      ((NTSTATUS)0x8000001EL)
+
+//
+// MessageId: STATUS_BEGINNING_OF_MEDIA
+//
+// MessageText:
+//
+// The beginning of a tape or partition has been detected.
+//
+#define STATUS_BEGINNING_OF_MEDIA                ((NTSTATUS)0x8000001FL)
+
+//
+// MessageId: STATUS_MEDIA_CHECK
+//
+// MessageText:
+//
+// {Media Changed} The media might have changed.
+//
+#define STATUS_MEDIA_CHECK                       ((NTSTATUS)0x80000020L)
+
+//
+// MessageId: STATUS_SETMARK_DETECTED
+//
+// MessageText:
+//
+// A tape access reached a set mark.
+//
+#define STATUS_SETMARK_DETECTED                  ((NTSTATUS)0x80000021L)
+
+//
+// MessageId: STATUS_NO_DATA_DETECTED
+//
+// MessageText:
+//
+// During a tape access, the end of the data written is reached.
+//
+#define STATUS_NO_DATA_DETECTED                  ((NTSTATUS)0x80000022L)
+
+//
+// MessageId: STATUS_REDIRECTOR_HAS_OPEN_HANDLES
+//
+// MessageText:
+//
+// The redirector is in use and cannot be unloaded.
+//
+#define STATUS_REDIRECTOR_HAS_OPEN_HANDLES       ((NTSTATUS)0x80000023L)
+
+//
+// MessageId: STATUS_SERVER_HAS_OPEN_HANDLES
+//
+// MessageText:
+//
+// The server is in use and cannot be unloaded.
+//
+#define STATUS_SERVER_HAS_OPEN_HANDLES           ((NTSTATUS)0x80000024L)
+
+//
+// MessageId: STATUS_ALREADY_DISCONNECTED
+//
+// MessageText:
+//
+// The specified connection has already been disconnected.
+//
+#define STATUS_ALREADY_DISCONNECTED              ((NTSTATUS)0x80000025L)
+
+//
+// MessageId: STATUS_LONGJUMP
+//
+// MessageText:
+//
+// A long jump has been executed.
+//
+#define STATUS_LONGJUMP                          ((NTSTATUS)0x80000026L)
+
+//
+// MessageId: STATUS_CLEANER_CARTRIDGE_INSTALLED
+//
+// MessageText:
+//
+// A cleaner cartridge is present in the tape library.
+//
+#define STATUS_CLEANER_CARTRIDGE_INSTALLED       ((NTSTATUS)0x80000027L)
+
+//
+// MessageId: STATUS_PLUGPLAY_QUERY_VETOED
+//
+// MessageText:
+//
+// The Plug and Play query operation was not successful.
+//
+#define STATUS_PLUGPLAY_QUERY_VETOED             ((NTSTATUS)0x80000028L)
+
+//
+// MessageId: STATUS_UNWIND_CONSOLIDATE
+//
+// MessageText:
+//
+// A frame consolidation has been executed.
+//
+#define STATUS_UNWIND_CONSOLIDATE                ((NTSTATUS)0x80000029L)
+
+//
+// MessageId: STATUS_REGISTRY_HIVE_RECOVERED
+//
+// MessageText:
+//
+// {Registry Hive Recovered} The registry hive (file): %hs was corrupted and it
+// has been recovered. Some data might have been lost.
+//
+#define STATUS_REGISTRY_HIVE_RECOVERED           ((NTSTATUS)0x8000002AL)
+
+#define STATUS_DLL_MIGHT_BE_INSECURE             ((NTSTATUS)0x8000002BL)
+
+#define STATUS_DLL_MIGHT_BE_INCOMPATIBLE         ((NTSTATUS)0x8000002CL)
+
+//
+// MessageId: STATUS_STOPPED_ON_SYMLINK
+//
+// MessageText:
+//
+// The stopped-on-symbolic-link operation was executed.
+//
+#define STATUS_STOPPED_ON_SYMLINK                ((NTSTATUS)0x8000002DL)
+
+#define STATUS_CANNOT_GRANT_REQUESTED_OPLOCK     ((NTSTATUS)0x8000002EL)
+
+#define STATUS_NO_ACE_CONDITION                  ((NTSTATUS)0x8000002FL)
+
+#define STATUS_DEVICE_SUPPORT_IN_PROGRESS        ((NTSTATUS)0x80000030L)
+
+#define STATUS_DEVICE_POWER_CYCLE_REQUIRED       ((NTSTATUS)0x80000031L)
+
+#define STATUS_NO_WORK_DONE                      ((NTSTATUS)0x80000032L)
+
+#define STATUS_CLUSTER_NODE_ALREADY_UP           ((NTSTATUS)0x80130001L)
+
+#define STATUS_CLUSTER_NODE_ALREADY_DOWN         ((NTSTATUS)0x80130002L)
+
+#define STATUS_CLUSTER_NETWORK_ALREADY_ONLINE    ((NTSTATUS)0x80130003L)
+
+#define STATUS_CLUSTER_NETWORK_ALREADY_OFFLINE   ((NTSTATUS)0x80130004L)
+
+#define STATUS_CLUSTER_NODE_ALREADY_MEMBER       ((NTSTATUS)0x80130005L)
+
+#define STATUS_FLT_BUFFER_TOO_SMALL              ((NTSTATUS)0x801C0001L)
+
+#define STATUS_FVE_PARTIAL_METADATA              ((NTSTATUS)0x80210001L)
+
+#define STATUS_FVE_TRANSIENT_STATE               ((NTSTATUS)0x80210002L)
+
+#define STATUS_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH ((NTSTATUS)0x8000CF00L)
+
+//
+// MessageId: STATUS_UNSUCCESSFUL
+//
+// MessageText:
+//
+// {Operation Failed} The requested operation was unsuccessful.
+//
+#define STATUS_UNSUCCESSFUL                      ((NTSTATUS)0xC0000001L)
+
+//
+// MessageId: STATUS_NOT_IMPLEMENTED
+//
+// MessageText:
+//
+// {Not Implemented} The requested operation is not implemented.
+//
+#define STATUS_NOT_IMPLEMENTED                   ((NTSTATUS)0xC0000002L)
+
+//
+// MessageId: STATUS_INVALID_INFO_CLASS
+//
+// MessageText:
+//
+// {Invalid Parameter} The specified information class is not a valid
+// information class for the specified object.
+//
+#define STATUS_INVALID_INFO_CLASS                ((NTSTATUS)0xC0000003L)
+
+//
+// MessageId: STATUS_INFO_LENGTH_MISMATCH
+//
+// MessageText:
+//
+// The specified information record length does not match the length that is
+// required for the specified information class.
+//
+#define STATUS_INFO_LENGTH_MISMATCH              ((NTSTATUS)0xC0000004L)
+
+//
+// MessageId: STATUS_ACCESS_VIOLATION
+//
+// MessageText:
+//
+// The instruction at 0x%08lx referenced memory at 0x%08lx. The memory could
+// not be %s.
+//
+#define STATUS_ACCESS_VIOLATION                  ((NTSTATUS)0xC0000005L)
+
+//
+// MessageId: STATUS_IN_PAGE_ERROR
+//
+// MessageText:
+//
+// The instruction at 0x%08lx referenced memory at 0x%08lx. The required data
+// was not placed into memory because of an I/O error status of 0x%08lx.
+//
+#define STATUS_IN_PAGE_ERROR                     ((NTSTATUS)0xC0000006L)
+
+//
+// MessageId: STATUS_PAGEFILE_QUOTA
+//
+// MessageText:
+//
+// The page file quota for the process has been exhausted.
+//
+#define STATUS_PAGEFILE_QUOTA                    ((NTSTATUS)0xC0000007L)
+
+//
+// MessageId: STATUS_INVALID_HANDLE
+//
+// MessageText:
+//
+// An invalid HANDLE was specified.
+//
+#define STATUS_INVALID_HANDLE                    ((NTSTATUS)0xC0000008L)
+
+//
+// MessageId: STATUS_BAD_INITIAL_STACK
+//
+// MessageText:
+//
+// An invalid initial stack was specified in a call to NtCreateThread.
+//
+#define STATUS_BAD_INITIAL_STACK                 ((NTSTATUS)0xC0000009L)
+
+//
+// MessageId: STATUS_BAD_INITIAL_PC
+//
+// MessageText:
+//
+// An invalid initial start address was specified in a call to NtCreateThread.
+//
+#define STATUS_BAD_INITIAL_PC                    ((NTSTATUS)0xC000000AL)
+
+//
+// MessageId: STATUS_INVALID_CID
+//
+// MessageText:
+//
+// An invalid client ID was specified.
+//
+#define STATUS_INVALID_CID                       ((NTSTATUS)0xC000000BL)
+
+//
+// MessageId: STATUS_TIMER_NOT_CANCELED
+//
+// MessageText:
+//
+// An attempt was made to cancel or set a timer that has an associated APC and
+// the specified thread is not the thread that originally set the timer with an
+// associated APC routine.
+//
+#define STATUS_TIMER_NOT_CANCELED                ((NTSTATUS)0xC000000CL)
+
+//
+// MessageId: STATUS_INVALID_PARAMETER
+//
+// MessageText:
+//
+// An invalid parameter was passed to a service or function.
+//
+#define STATUS_INVALID_PARAMETER                 ((NTSTATUS)0xC000000DL)
+
+//
+// MessageId: STATUS_NO_SUCH_DEVICE
+//
+// MessageText:
+//
+// A device that does not exist was specified.
+//
+#define STATUS_NO_SUCH_DEVICE                    ((NTSTATUS)0xC000000EL)
+
+//
+// MessageId: STATUS_NO_SUCH_FILE
+//
+// MessageText:
+//
+// {File Not Found} The file %hs does not exist.
+//
+#define STATUS_NO_SUCH_FILE                      ((NTSTATUS)0xC000000FL)
+
+//
+// MessageId: STATUS_INVALID_DEVICE_REQUEST
+//
+// MessageText:
+//
+// The specified request is not a valid operation for the target device.
+//
+#define STATUS_INVALID_DEVICE_REQUEST            ((NTSTATUS)0xC0000010L)
+
+//
+// MessageId: STATUS_END_OF_FILE
+//
+// MessageText:
+//
+// The end-of-file marker has been reached. There is no valid data in the file
+// beyond this marker.
+//
+#define STATUS_END_OF_FILE                       ((NTSTATUS)0xC0000011L)
+
+//
+// MessageId: STATUS_WRONG_VOLUME
+//
+// MessageText:
+//
+// {Wrong Volume} The wrong volume is in the drive. Insert volume %hs into
+// drive %hs.
+//
+#define STATUS_WRONG_VOLUME                      ((NTSTATUS)0xC0000012L)
+
+//
+// MessageId: STATUS_NO_MEDIA_IN_DEVICE
+//
+// MessageText:
+//
+// {No Disk} There is no disk in the drive. Insert a disk into drive %hs.
+//
+#define STATUS_NO_MEDIA_IN_DEVICE                ((NTSTATUS)0xC0000013L)
+
+//
+// MessageId: STATUS_UNRECOGNIZED_MEDIA
+//
+// MessageText:
+//
+// {Unknown Disk Format} The disk in drive %hs is not formatted properly. Check
+// the disk, and reformat it, if needed.
+//
+#define STATUS_UNRECOGNIZED_MEDIA                ((NTSTATUS)0xC0000014L)
+
+//
+// MessageId: STATUS_NONEXISTENT_SECTOR
+//
+// MessageText:
+//
+// {Sector Not Found} The specified sector does not exist.
+//
+#define STATUS_NONEXISTENT_SECTOR                ((NTSTATUS)0xC0000015L)
+
+//
+// MessageId: STATUS_MORE_PROCESSING_REQUIRED
+//
+// MessageText:
+//
+// {Still Busy} The specified I/O request packet (IRP) cannot be disposed of
+// because the I/O operation is not complete.
+//
+#define STATUS_MORE_PROCESSING_REQUIRED          ((NTSTATUS)0xC0000016L)
+
+//
+// MessageId: STATUS_NO_MEMORY
+//
+// MessageText:
+//
+// {Not Enough Quota} Not enough virtual memory or paging file quota is
+// available to complete the specified operation.
+//
+#define STATUS_NO_MEMORY                         ((NTSTATUS)0xC0000017L)
+
+//
+// MessageId: STATUS_CONFLICTING_ADDRESSES
+//
+// MessageText:
+//
+// {Conflicting Address Range} The specified address range conflicts with the
+// address space.
+//
+#define STATUS_CONFLICTING_ADDRESSES             ((NTSTATUS)0xC0000018L)
+
+//
+// MessageId: STATUS_NOT_MAPPED_VIEW
+//
+// MessageText:
+//
+// The address range to unmap is not a mapped view.
+//
+#define STATUS_NOT_MAPPED_VIEW                   ((NTSTATUS)0xC0000019L)
+
+//
+// MessageId: STATUS_UNABLE_TO_FREE_VM
+//
+// MessageText:
+//
+// The virtual memory cannot be freed.
+//
+#define STATUS_UNABLE_TO_FREE_VM                 ((NTSTATUS)0xC000001AL)
+
+//
+// MessageId: STATUS_UNABLE_TO_DELETE_SECTION
+//
+// MessageText:
+//
+// The specified section cannot be deleted.
+//
+#define STATUS_UNABLE_TO_DELETE_SECTION          ((NTSTATUS)0xC000001BL)
+
+//
+// MessageId: STATUS_INVALID_SYSTEM_SERVICE
+//
+// MessageText:
+//
+// An invalid system service was specified in a system service call.
+//
+#define STATUS_INVALID_SYSTEM_SERVICE            ((NTSTATUS)0xC000001CL)
+
+//
+// MessageId: STATUS_ILLEGAL_INSTRUCTION
+//
+// MessageText:
+//
+// {EXCEPTION} Illegal Instruction An attempt was made to execute an illegal
+// instruction.
+//
+#define STATUS_ILLEGAL_INSTRUCTION               ((NTSTATUS)0xC000001DL)
+
+//
+// MessageId: STATUS_INVALID_LOCK_SEQUENCE
+//
+// MessageText:
+//
+// {Invalid Lock Sequence} An attempt was made to execute an invalid lock
+// sequence.
+//
+#define STATUS_INVALID_LOCK_SEQUENCE             ((NTSTATUS)0xC000001EL)
+
+//
+// MessageId: STATUS_INVALID_VIEW_SIZE
+//
+// MessageText:
+//
+// {Invalid Mapping} An attempt was made to create a view for a section that is
+// bigger than the section.
+//
+#define STATUS_INVALID_VIEW_SIZE                 ((NTSTATUS)0xC000001FL)
+
+//
+// MessageId: STATUS_INVALID_FILE_FOR_SECTION
+//
+// MessageText:
+//
+// {Bad File} The attributes of the specified mapping file for a section of
+// memory cannot be read.
+//
+#define STATUS_INVALID_FILE_FOR_SECTION          ((NTSTATUS)0xC0000020L)
+
+//
+// MessageId: STATUS_ALREADY_COMMITTED
+//
+// MessageText:
+//
+// {Already Committed} The specified address range is already committed.
+//
+#define STATUS_ALREADY_COMMITTED                 ((NTSTATUS)0xC0000021L)
+
+//
+// MessageId: STATUS_ACCESS_DENIED
+//
+// MessageText:
+//
+// {Access Denied} A process has requested access to an object but has not been
+// granted those access rights.
+//
+#define STATUS_ACCESS_DENIED                     ((NTSTATUS)0xC0000022L)
+
+//
+// MessageId: STATUS_BUFFER_TOO_SMALL
+//
+// MessageText:
+//
+// {Buffer Too Small} The buffer is too small to contain the entry. No
+// information has been written to the buffer.
+//
+#define STATUS_BUFFER_TOO_SMALL                  ((NTSTATUS)0xC0000023L)
+
+//
+// MessageId: STATUS_OBJECT_TYPE_MISMATCH
+//
+// MessageText:
+//
+// {Wrong Type} There is a mismatch between the type of object that is required
+// by the requested operation and the type of object that is specified in the
+// request.
+//
+#define STATUS_OBJECT_TYPE_MISMATCH              ((NTSTATUS)0xC0000024L)
+
+//
+// MessageId: STATUS_NONCONTINUABLE_EXCEPTION
+//
+// MessageText:
+//
+// {EXCEPTION} Cannot Continue Windows cannot continue from this exception.
+//
+#define STATUS_NONCONTINUABLE_EXCEPTION          ((NTSTATUS)0xC0000025L)
+
+//
+// MessageId: STATUS_INVALID_DISPOSITION
+//
+// MessageText:
+//
+// An invalid exception disposition was returned by an exception handler.
+//
+#define STATUS_INVALID_DISPOSITION               ((NTSTATUS)0xC0000026L)
+
+//
+// MessageId: STATUS_UNWIND
+//
+// MessageText:
+//
+// Unwind exception code.
+//
+#define STATUS_UNWIND                            ((NTSTATUS)0xC0000027L)
+
+//
+// MessageId: STATUS_BAD_STACK
+//
+// MessageText:
+//
+// An invalid or unaligned stack was encountered during an unwind operation.
+//
+#define STATUS_BAD_STACK                         ((NTSTATUS)0xC0000028L)
+
+//
+// MessageId: STATUS_INVALID_UNWIND_TARGET
+//
+// MessageText:
+//
+// An invalid unwind target was encountered during an unwind operation.
+//
+#define STATUS_INVALID_UNWIND_TARGET             ((NTSTATUS)0xC0000029L)
+
+//
+// MessageId: STATUS_NOT_LOCKED
+//
+// MessageText:
+//
+// An attempt was made to unlock a page of memory that was not locked.
+//
+#define STATUS_NOT_LOCKED                        ((NTSTATUS)0xC000002AL)
+
+//
+// MessageId: STATUS_PARITY_ERROR
+//
+// MessageText:
+//
+// A device parity error on an I/O operation.
+//
+#define STATUS_PARITY_ERROR                      ((NTSTATUS)0xC000002BL)
+
+//
+// MessageId: STATUS_UNABLE_TO_DECOMMIT_VM
+//
+// MessageText:
+//
+// An attempt was made to decommit uncommitted virtual memory.
+//
+#define STATUS_UNABLE_TO_DECOMMIT_VM             ((NTSTATUS)0xC000002CL)
+
+//
+// MessageId: STATUS_NOT_COMMITTED
+//
+// MessageText:
+//
+// An attempt was made to change the attributes on memory that has not been
+// committed.
+//
+#define STATUS_NOT_COMMITTED                     ((NTSTATUS)0xC000002DL)
+
+//
+// MessageId: STATUS_INVALID_PORT_ATTRIBUTES
+//
+// MessageText:
+//
+// Invalid object attributes specified to NtCreatePort or invalid port
+// attributes specified to NtConnectPort.
+//
+#define STATUS_INVALID_PORT_ATTRIBUTES           ((NTSTATUS)0xC000002EL)
+
+//
+// MessageId: STATUS_PORT_MESSAGE_TOO_LONG
+//
+// MessageText:
+//
+// The length of the message that was passed to NtRequestPort or
+// NtRequestWaitReplyPort is longer than the maximum message that is allowed by
+// the port.
+//
+#define STATUS_PORT_MESSAGE_TOO_LONG             ((NTSTATUS)0xC000002FL)
+
+//
+// MessageId: STATUS_INVALID_PARAMETER_MIX
+//
+// MessageText:
+//
+// An invalid combination of parameters was specified.
+//
+#define STATUS_INVALID_PARAMETER_MIX             ((NTSTATUS)0xC0000030L)
+
+//
+// MessageId: STATUS_INVALID_QUOTA_LOWER
+//
+// MessageText:
+//
+// An attempt was made to lower a quota limit below the current usage.
+//
+#define STATUS_INVALID_QUOTA_LOWER               ((NTSTATUS)0xC0000031L)
+
+//
+// MessageId: STATUS_DISK_CORRUPT_ERROR
+//
+// MessageText:
+//
+// {Corrupt Disk} The file system structure on the disk is corrupt and
+// unusable. Run the Chkdsk utility on the volume %hs.
+//
+#define STATUS_DISK_CORRUPT_ERROR                ((NTSTATUS)0xC0000032L)
+
+//
+// MessageId: STATUS_OBJECT_NAME_INVALID
+//
+// MessageText:
+//
+// The object name is invalid.
+//
+#define STATUS_OBJECT_NAME_INVALID               ((NTSTATUS)0xC0000033L)
+
+//
+// MessageId: STATUS_OBJECT_NAME_NOT_FOUND
+//
+// MessageText:
+//
+// The object name is not found.
+//
+#define STATUS_OBJECT_NAME_NOT_FOUND             ((NTSTATUS)0xC0000034L)
+
+//
+// MessageId: STATUS_OBJECT_NAME_COLLISION
+//
+// MessageText:
+//
+// The object name already exists.
+//
+#define STATUS_OBJECT_NAME_COLLISION             ((NTSTATUS)0xC0000035L)
+
+#define STATUS_PORT_DO_NOT_DISTURB               ((NTSTATUS)0xC0000036L)
+
+//
+// MessageId: STATUS_PORT_DISCONNECTED
+//
+// MessageText:
+//
+// An attempt was made to send a message to a disconnected communication port.
+//
+#define STATUS_PORT_DISCONNECTED                 ((NTSTATUS)0xC0000037L)
+
+//
+// MessageId: STATUS_DEVICE_ALREADY_ATTACHED
+//
+// MessageText:
+//
+// An attempt was made to attach to a device that was already attached to
+// another device.
+//
+#define STATUS_DEVICE_ALREADY_ATTACHED           ((NTSTATUS)0xC0000038L)
+
+//
+// MessageId: STATUS_OBJECT_PATH_INVALID
+//
+// MessageText:
+//
+// The object path component was not a directory object.
+//
+#define STATUS_OBJECT_PATH_INVALID               ((NTSTATUS)0xC0000039L)
+
+//
+// MessageId: STATUS_OBJECT_PATH_NOT_FOUND
+//
+// MessageText:
+//
+// {Path Not Found} The path %hs does not exist.
+//
+#define STATUS_OBJECT_PATH_NOT_FOUND             ((NTSTATUS)0xC000003AL)
+
+//
+// MessageId: STATUS_OBJECT_PATH_SYNTAX_BAD
+//
+// MessageText:
+//
+// The object path component was not a directory object.
+//
+#define STATUS_OBJECT_PATH_SYNTAX_BAD            ((NTSTATUS)0xC000003BL)
+
+//
+// MessageId: STATUS_DATA_OVERRUN
+//
+// MessageText:
+//
+// {Data Overrun} A data overrun error occurred.
+//
+#define STATUS_DATA_OVERRUN                      ((NTSTATUS)0xC000003CL)
+
+//
+// MessageId: STATUS_DATA_LATE_ERROR
+//
+// MessageText:
+//
+// {Data Late} A data late error occurred.
+//
+#define STATUS_DATA_LATE_ERROR                   ((NTSTATUS)0xC000003DL)
+
+//
+// MessageId: STATUS_DATA_ERROR
+//
+// MessageText:
+//
+// {Data Error} An error occurred in reading or writing data.
+//
+#define STATUS_DATA_ERROR                        ((NTSTATUS)0xC000003EL)
+
+//
+// MessageId: STATUS_CRC_ERROR
+//
+// MessageText:
+//
+// {Bad CRC} A cyclic redundancy check (CRC) checksum error occurred.
+//
+#define STATUS_CRC_ERROR                         ((NTSTATUS)0xC000003FL)
+
+//
+// MessageId: STATUS_SECTION_TOO_BIG
+//
+// MessageText:
+//
+// {Section Too Large} The specified section is too big to map the file.
+//
+#define STATUS_SECTION_TOO_BIG                   ((NTSTATUS)0xC0000040L)
+
+//
+// MessageId: STATUS_PORT_CONNECTION_REFUSED
+//
+// MessageText:
+//
+// The NtConnectPort request is refused.
+//
+#define STATUS_PORT_CONNECTION_REFUSED           ((NTSTATUS)0xC0000041L)
+
+//
+// MessageId: STATUS_INVALID_PORT_HANDLE
+//
+// MessageText:
+//
+// The type of port handle is invalid for the operation that is requested.
+//
+#define STATUS_INVALID_PORT_HANDLE               ((NTSTATUS)0xC0000042L)
+
+//
+// MessageId: STATUS_SHARING_VIOLATION
+//
+// MessageText:
+//
+// A file cannot be opened because the share access flags are incompatible.
+//
+#define STATUS_SHARING_VIOLATION                 ((NTSTATUS)0xC0000043L)
+
+//
+// MessageId: STATUS_QUOTA_EXCEEDED
+//
+// MessageText:
+//
+// Insufficient quota exists to complete the operation.
+//
+#define STATUS_QUOTA_EXCEEDED                    ((NTSTATUS)0xC0000044L)
+
+//
+// MessageId: STATUS_INVALID_PAGE_PROTECTION
+//
+// MessageText:
+//
+// The specified page protection was not valid.
+//
+#define STATUS_INVALID_PAGE_PROTECTION           ((NTSTATUS)0xC0000045L)
+
+//
+// MessageId: STATUS_MUTANT_NOT_OWNED
+//
+// MessageText:
+//
+// An attempt to release a mutant object was made by a thread that was not the
+// owner of the mutant object.
+//
+#define STATUS_MUTANT_NOT_OWNED                  ((NTSTATUS)0xC0000046L)
+
+//
+// MessageId: STATUS_SEMAPHORE_LIMIT_EXCEEDED
+//
+// MessageText:
+//
+// An attempt was made to release a semaphore such that its maximum count would
+// have been exceeded.
+//
+#define STATUS_SEMAPHORE_LIMIT_EXCEEDED          ((NTSTATUS)0xC0000047L)
+
+//
+// MessageId: STATUS_PORT_ALREADY_SET
+//
+// MessageText:
+//
+// An attempt was made to set the DebugPort or ExceptionPort of a process, but
+// a port already exists in the process, or an attempt was made to set the
+// CompletionPort of a file but a port was already set in the file, or an
+// attempt was made to set the associated completion port of an ALPC port but
+// it is already set.
+//
+#define STATUS_PORT_ALREADY_SET                  ((NTSTATUS)0xC0000048L)
+
+//
+// MessageId: STATUS_SECTION_NOT_IMAGE
+//
+// MessageText:
+//
+// An attempt was made to query image information on a section that does not
+// map an image.
+//
+#define STATUS_SECTION_NOT_IMAGE                 ((NTSTATUS)0xC0000049L)
+
+//
+// MessageId: STATUS_SUSPEND_COUNT_EXCEEDED
+//
+// MessageText:
+//
+// An attempt was made to suspend a thread whose suspend count was at its
+// maximum.
+//
+#define STATUS_SUSPEND_COUNT_EXCEEDED            ((NTSTATUS)0xC000004AL)
+
+//
+// MessageId: STATUS_THREAD_IS_TERMINATING
+//
+// MessageText:
+//
+// An attempt was made to suspend a thread that has begun termination.
+//
+#define STATUS_THREAD_IS_TERMINATING             ((NTSTATUS)0xC000004BL)
+
+//
+// MessageId: STATUS_BAD_WORKING_SET_LIMIT
+//
+// MessageText:
+//
+// An attempt was made to set the working set limit to an invalid value (for
+// example, the minimum greater than maximum).
+//
+#define STATUS_BAD_WORKING_SET_LIMIT             ((NTSTATUS)0xC000004CL)
+
+//
+// MessageId: STATUS_INCOMPATIBLE_FILE_MAP
+//
+// MessageText:
+//
+// A section was created to map a file that is not compatible with an already
+// existing section that maps the same file.
+//
+#define STATUS_INCOMPATIBLE_FILE_MAP             ((NTSTATUS)0xC000004DL)
+
+//
+// MessageId: STATUS_SECTION_PROTECTION
+//
+// MessageText:
+//
+// A view to a section specifies a protection that is incompatible with the
+// protection of the initial view.
+//
+#define STATUS_SECTION_PROTECTION                ((NTSTATUS)0xC000004EL)
+
+//
+// MessageId: STATUS_EAS_NOT_SUPPORTED
+//
+// MessageText:
+//
+// An operation involving EAs failed because the file system does not support
+// EAs.
+//
+#define STATUS_EAS_NOT_SUPPORTED                 ((NTSTATUS)0xC000004FL)
+
+//
+// MessageId: STATUS_EA_TOO_LARGE
+//
+// MessageText:
+//
+// An EA operation failed because the EA set is too large.
+//
+#define STATUS_EA_TOO_LARGE                      ((NTSTATUS)0xC0000050L)
+
+//
+// MessageId: STATUS_NONEXISTENT_EA_ENTRY
+//
+// MessageText:
+//
+// An EA operation failed because the name or EA index is invalid.
+//
+#define STATUS_NONEXISTENT_EA_ENTRY              ((NTSTATUS)0xC0000051L)
+
+//
+// MessageId: STATUS_NO_EAS_ON_FILE
+//
+// MessageText:
+//
+// The file for which EAs were requested has no EAs.
+//
+#define STATUS_NO_EAS_ON_FILE                    ((NTSTATUS)0xC0000052L)
+
+//
+// MessageId: STATUS_EA_CORRUPT_ERROR
+//
+// MessageText:
+//
+// The EA is corrupt and cannot be read.
+//
+#define STATUS_EA_CORRUPT_ERROR                  ((NTSTATUS)0xC0000053L)
+
+//
+// MessageId: STATUS_FILE_LOCK_CONFLICT
+//
+// MessageText:
+//
+// A requested read/write cannot be granted due to a conflicting file lock.
+//
+#define STATUS_FILE_LOCK_CONFLICT                ((NTSTATUS)0xC0000054L)
+
+//
+// MessageId: STATUS_LOCK_NOT_GRANTED
+//
+// MessageText:
+//
+// A requested file lock cannot be granted due to other existing locks.
+//
+#define STATUS_LOCK_NOT_GRANTED                  ((NTSTATUS)0xC0000055L)
+
+//
+// MessageId: STATUS_DELETE_PENDING
+//
+// MessageText:
+//
+// A non-close operation has been requested of a file object that has a delete
+// pending.
+//
+#define STATUS_DELETE_PENDING                    ((NTSTATUS)0xC0000056L)
+
+//
+// MessageId: STATUS_CTL_FILE_NOT_SUPPORTED
+//
+// MessageText:
+//
+// An attempt was made to set the control attribute on a file. This attribute
+// is not supported in the destination file system.
+//
+#define STATUS_CTL_FILE_NOT_SUPPORTED            ((NTSTATUS)0xC0000057L)
+
+//
+// MessageId: STATUS_UNKNOWN_REVISION
+//
+// MessageText:
+//
+// Indicates a revision number that was encountered or specified is not one
+// that is known by the service. It might be a more recent revision than the
+// service is aware of.
+//
+#define STATUS_UNKNOWN_REVISION                  ((NTSTATUS)0xC0000058L)
+
+//
+// MessageId: STATUS_REVISION_MISMATCH
+//
+// MessageText:
+//
+// Indicates that two revision levels are incompatible.
+//
+#define STATUS_REVISION_MISMATCH                 ((NTSTATUS)0xC0000059L)
+
+//
+// MessageId: STATUS_INVALID_OWNER
+//
+// MessageText:
+//
+// Indicates a particular security ID cannot be assigned as the owner of an
+// object.
+//
+#define STATUS_INVALID_OWNER                     ((NTSTATUS)0xC000005AL)
+
+//
+// MessageId: STATUS_INVALID_PRIMARY_GROUP
+//
+// MessageText:
+//
+// Indicates a particular security ID cannot be assigned as the primary group
+// of an object.
+//
+#define STATUS_INVALID_PRIMARY_GROUP             ((NTSTATUS)0xC000005BL)
+
+//
+// MessageId: STATUS_NO_IMPERSONATION_TOKEN
+//
+// MessageText:
+//
+// An attempt has been made to operate on an impersonation token by a thread
+// that is not currently impersonating a client.
+//
+#define STATUS_NO_IMPERSONATION_TOKEN            ((NTSTATUS)0xC000005CL)
+
+//
+// MessageId: STATUS_CANT_DISABLE_MANDATORY
+//
+// MessageText:
+//
+// A mandatory group cannot be disabled.
+//
+#define STATUS_CANT_DISABLE_MANDATORY            ((NTSTATUS)0xC000005DL)
+
+//
+// MessageId: STATUS_NO_LOGON_SERVERS
+//
+// MessageText:
+//
+// No logon servers are currently available to service the logon request.
+//
+#define STATUS_NO_LOGON_SERVERS                  ((NTSTATUS)0xC000005EL)
+
+//
+// MessageId: STATUS_NO_SUCH_LOGON_SESSION
+//
+// MessageText:
+//
+// A specified logon session does not exist. It might already have been
+// terminated.
+//
+#define STATUS_NO_SUCH_LOGON_SESSION             ((NTSTATUS)0xC000005FL)
+
+//
+// MessageId: STATUS_NO_SUCH_PRIVILEGE
+//
+// MessageText:
+//
+// A specified privilege does not exist.
+//
+#define STATUS_NO_SUCH_PRIVILEGE                 ((NTSTATUS)0xC0000060L)
+
+//
+// MessageId: STATUS_PRIVILEGE_NOT_HELD
+//
+// MessageText:
+//
+// A required privilege is not held by the client.
+//
+#define STATUS_PRIVILEGE_NOT_HELD                ((NTSTATUS)0xC0000061L)
+
+//
+// MessageId: STATUS_INVALID_ACCOUNT_NAME
+//
+// MessageText:
+//
+// The name provided is not a properly formed account name.
+//
+#define STATUS_INVALID_ACCOUNT_NAME              ((NTSTATUS)0xC0000062L)
+
+//
+// MessageId: STATUS_USER_EXISTS
+//
+// MessageText:
+//
+// The specified account already exists.
+//
+#define STATUS_USER_EXISTS                       ((NTSTATUS)0xC0000063L)
+
+//
+// MessageId: STATUS_NO_SUCH_USER
+//
+// MessageText:
+//
+// The specified account does not exist.
+//
+#define STATUS_NO_SUCH_USER                      ((NTSTATUS)0xC0000064L)
+
+//
+// MessageId: STATUS_GROUP_EXISTS
+//
+// MessageText:
+//
+// The specified group already exists.
+//
+#define STATUS_GROUP_EXISTS                      ((NTSTATUS)0xC0000065L)
+
+//
+// MessageId: STATUS_NO_SUCH_GROUP
+//
+// MessageText:
+//
+// The specified group does not exist.
+//
+#define STATUS_NO_SUCH_GROUP                     ((NTSTATUS)0xC0000066L)
+
+//
+// MessageId: STATUS_MEMBER_IN_GROUP
+//
+// MessageText:
+//
+// The specified user account is already in the specified group account. Also
+// used to indicate a group cannot be deleted because it contains a member.
+//
+#define STATUS_MEMBER_IN_GROUP                   ((NTSTATUS)0xC0000067L)
+
+//
+// MessageId: STATUS_MEMBER_NOT_IN_GROUP
+//
+// MessageText:
+//
+// The specified user account is not a member of the specified group account.
+//
+#define STATUS_MEMBER_NOT_IN_GROUP               ((NTSTATUS)0xC0000068L)
+
+//
+// MessageId: STATUS_LAST_ADMIN
+//
+// MessageText:
+//
+// Indicates the requested operation would disable or delete the last remaining
+// administration account. This is not allowed to prevent creating a situation
+// in which the system cannot be administrated.
+//
+#define STATUS_LAST_ADMIN                        ((NTSTATUS)0xC0000069L)
+
+//
+// MessageId: STATUS_WRONG_PASSWORD
+//
+// MessageText:
+//
+// When trying to update a password, this return status indicates that the
+// value provided as the current password is not correct.
+//
+#define STATUS_WRONG_PASSWORD                    ((NTSTATUS)0xC000006AL)
+
+//
+// MessageId: STATUS_ILL_FORMED_PASSWORD
+//
+// MessageText:
+//
+// When trying to update a password, this return status indicates that the
+// value provided for the new password contains values that are not allowed in
+// passwords.
+//
+#define STATUS_ILL_FORMED_PASSWORD               ((NTSTATUS)0xC000006BL)
+
+//
+// MessageId: STATUS_PASSWORD_RESTRICTION
+//
+// MessageText:
+//
+// When trying to update a password, this status indicates that some password
+// update rule has been violated. For example, the password might not meet
+// length criteria.
+//
+#define STATUS_PASSWORD_RESTRICTION              ((NTSTATUS)0xC000006CL)
+
+//
+// MessageId: STATUS_LOGON_FAILURE
+//
+// MessageText:
+//
+// The attempted logon is invalid. This is either due to a bad username or
+// authentication information.
+//
+#define STATUS_LOGON_FAILURE                     ((NTSTATUS)0xC000006DL)
+
+//
+// MessageId: STATUS_ACCOUNT_RESTRICTION
+//
+// MessageText:
+//
+// Indicates a referenced user name and authentication information are valid,
+// but some user account restriction has prevented successful authentication
+// (such as time-of-day restrictions).
+//
+#define STATUS_ACCOUNT_RESTRICTION               ((NTSTATUS)0xC000006EL)
+
+//
+// MessageId: STATUS_INVALID_LOGON_HOURS
+//
+// MessageText:
+//
+// The user account has time restrictions and cannot be logged onto at this
+// time.
+//
+#define STATUS_INVALID_LOGON_HOURS               ((NTSTATUS)0xC000006FL)
+
+//
+// MessageId: STATUS_INVALID_WORKSTATION
+//
+// MessageText:
+//
+// The user account is restricted so that it cannot be used to log on from the
+// source workstation.
+//
+#define STATUS_INVALID_WORKSTATION               ((NTSTATUS)0xC0000070L)
+
+//
+// MessageId: STATUS_PASSWORD_EXPIRED
+//
+// MessageText:
+//
+// The user account password has expired.
+//
+#define STATUS_PASSWORD_EXPIRED                  ((NTSTATUS)0xC0000071L)
+
+//
+// MessageId: STATUS_ACCOUNT_DISABLED
+//
+// MessageText:
+//
+// The referenced account is currently disabled and cannot be logged on to.
+//
+#define STATUS_ACCOUNT_DISABLED                  ((NTSTATUS)0xC0000072L)
+
+//
+// MessageId: STATUS_NONE_MAPPED
+//
+// MessageText:
+//
+// None of the information to be translated has been translated.
+//
+#define STATUS_NONE_MAPPED                       ((NTSTATUS)0xC0000073L)
+
+//
+// MessageId: STATUS_TOO_MANY_LUIDS_REQUESTED
+//
+// MessageText:
+//
+// The number of LUIDs requested cannot be allocated with a single allocation.
+//
+#define STATUS_TOO_MANY_LUIDS_REQUESTED          ((NTSTATUS)0xC0000074L)
+
+//
+// MessageId: STATUS_LUIDS_EXHAUSTED
+//
+// MessageText:
+//
+// Indicates there are no more LUIDs to allocate.
+//
+#define STATUS_LUIDS_EXHAUSTED                   ((NTSTATUS)0xC0000075L)
+
+//
+// MessageId: STATUS_INVALID_SUB_AUTHORITY
+//
+// MessageText:
+//
+// Indicates the sub-authority value is invalid for the particular use.
+//
+#define STATUS_INVALID_SUB_AUTHORITY             ((NTSTATUS)0xC0000076L)
+
+//
+// MessageId: STATUS_INVALID_ACL
+//
+// MessageText:
+//
+// Indicates the ACL structure is not valid.
+//
+#define STATUS_INVALID_ACL                       ((NTSTATUS)0xC0000077L)
+
+//
+// MessageId: STATUS_INVALID_SID
+//
+// MessageText:
+//
+// Indicates the SID structure is not valid.
+//
+#define STATUS_INVALID_SID                       ((NTSTATUS)0xC0000078L)
+
+//
+// MessageId: STATUS_INVALID_SECURITY_DESCR
+//
+// MessageText:
+//
+// Indicates the SECURITY_DESCRIPTOR structure is not valid.
+//
+#define STATUS_INVALID_SECURITY_DESCR            ((NTSTATUS)0xC0000079L)
+
+//
+// MessageId: STATUS_PROCEDURE_NOT_FOUND
+//
+// MessageText:
+//
+// Indicates the specified procedure address cannot be found in the DLL.
+//
+#define STATUS_PROCEDURE_NOT_FOUND               ((NTSTATUS)0xC000007AL)
+
+//
+// MessageId: STATUS_INVALID_IMAGE_FORMAT
+//
+// MessageText:
+//
+// {Bad Image} %hs is either not designed to run on Windows or it contains an
+// error. Try installing the program again using the original installation
+// media or contact your system administrator or the software vendor for
+// support.
+//
+#define STATUS_INVALID_IMAGE_FORMAT              ((NTSTATUS)0xC000007BL)
+
+//
+// MessageId: STATUS_NO_TOKEN
+//
+// MessageText:
+//
+// An attempt was made to reference a token that does not exist. This is
+// typically done by referencing the token that is associated with a thread
+// when the thread is not impersonating a client.
+//
+#define STATUS_NO_TOKEN                          ((NTSTATUS)0xC000007CL)
+
+//
+// MessageId: STATUS_BAD_INHERITANCE_ACL
+//
+// MessageText:
+//
+// Indicates that an attempt to build either an inherited ACL or ACE was not
+// successful. This can be caused by a number of things. One of the more
+// probable causes is the replacement of a CreatorId with a SID that did not
+// fit into the ACE or ACL.
+//
+#define STATUS_BAD_INHERITANCE_ACL               ((NTSTATUS)0xC000007DL)
+
+//
+// MessageId: STATUS_RANGE_NOT_LOCKED
+//
+// MessageText:
+//
+// The range specified in NtUnlockFile was not locked.
+//
+#define STATUS_RANGE_NOT_LOCKED                  ((NTSTATUS)0xC000007EL)
+
+//
+// MessageId: STATUS_DISK_FULL
+//
+// MessageText:
+//
+// An operation failed because the disk was full.
+//
+#define STATUS_DISK_FULL                         ((NTSTATUS)0xC000007FL)
+
+//
+// MessageId: STATUS_SERVER_DISABLED
+//
+// MessageText:
+//
+// The GUID allocation server is disabled at the moment.
+//
+#define STATUS_SERVER_DISABLED                   ((NTSTATUS)0xC0000080L)
+
+//
+// MessageId: STATUS_SERVER_NOT_DISABLED
+//
+// MessageText:
+//
+// The GUID allocation server is enabled at the moment.
+//
+#define STATUS_SERVER_NOT_DISABLED               ((NTSTATUS)0xC0000081L)
+
+//
+// MessageId: STATUS_TOO_MANY_GUIDS_REQUESTED
+//
+// MessageText:
+//
+// Too many GUIDs were requested from the allocation server at once.
+//
+#define STATUS_TOO_MANY_GUIDS_REQUESTED          ((NTSTATUS)0xC0000082L)
+
+//
+// MessageId: STATUS_GUIDS_EXHAUSTED
+//
+// MessageText:
+//
+// The GUIDs could not be allocated because the Authority Agent was exhausted.
+//
+#define STATUS_GUIDS_EXHAUSTED                   ((NTSTATUS)0xC0000083L)
+
+//
+// MessageId: STATUS_INVALID_ID_AUTHORITY
+//
+// MessageText:
+//
+// The value provided was an invalid value for an identifier authority.
+//
+#define STATUS_INVALID_ID_AUTHORITY              ((NTSTATUS)0xC0000084L)
+
+//
+// MessageId: STATUS_AGENTS_EXHAUSTED
+//
+// MessageText:
+//
+// No more authority agent values are available for the particular identifier
+// authority value.
+//
+#define STATUS_AGENTS_EXHAUSTED                  ((NTSTATUS)0xC0000085L)
+
+//
+// MessageId: STATUS_INVALID_VOLUME_LABEL
+//
+// MessageText:
+//
+// An invalid volume label has been specified.
+//
+#define STATUS_INVALID_VOLUME_LABEL              ((NTSTATUS)0xC0000086L)
+
+//
+// MessageId: STATUS_SECTION_NOT_EXTENDED
+//
+// MessageText:
+//
+// A mapped section could not be extended.
+//
+#define STATUS_SECTION_NOT_EXTENDED              ((NTSTATUS)0xC0000087L)
+
+//
+// MessageId: STATUS_NOT_MAPPED_DATA
+//
+// MessageText:
+//
+// Specified section to flush does not map a data file.
+//
+#define STATUS_NOT_MAPPED_DATA                   ((NTSTATUS)0xC0000088L)
+
+//
+// MessageId: STATUS_RESOURCE_DATA_NOT_FOUND
+//
+// MessageText:
+//
+// Indicates the specified image file did not contain a resource section.
+//
+#define STATUS_RESOURCE_DATA_NOT_FOUND           ((NTSTATUS)0xC0000089L)
+
+//
+// MessageId: STATUS_RESOURCE_TYPE_NOT_FOUND
+//
+// MessageText:
+//
+// Indicates the specified resource type cannot be found in the image file.
+//
+#define STATUS_RESOURCE_TYPE_NOT_FOUND           ((NTSTATUS)0xC000008AL)
+
+//
+// MessageId: STATUS_RESOURCE_NAME_NOT_FOUND
+//
+// MessageText:
+//
+// Indicates the specified resource name cannot be found in the image file.
+//
+#define STATUS_RESOURCE_NAME_NOT_FOUND           ((NTSTATUS)0xC000008BL)
+
+//
+// MessageId: STATUS_ARRAY_BOUNDS_EXCEEDED
+//
+// MessageText:
+//
+// {EXCEPTION} Array bounds exceeded.
+//
+#define STATUS_ARRAY_BOUNDS_EXCEEDED             ((NTSTATUS)0xC000008CL)
+
+//
+// MessageId: STATUS_FLOAT_DENORMAL_OPERAND
+//
+// MessageText:
+//
+// {EXCEPTION} Floating-point denormal operand.
+//
+#define STATUS_FLOAT_DENORMAL_OPERAND            ((NTSTATUS)0xC000008DL)
+
+//
+// MessageId: STATUS_FLOAT_DIVIDE_BY_ZERO
+//
+// MessageText:
+//
+// {EXCEPTION} Floating-point division by zero.
+//
+#define STATUS_FLOAT_DIVIDE_BY_ZERO              ((NTSTATUS)0xC000008EL)
+
+//
+// MessageId: STATUS_FLOAT_INEXACT_RESULT
+//
+// MessageText:
+//
+// {EXCEPTION} Floating-point inexact result.
+//
+#define STATUS_FLOAT_INEXACT_RESULT              ((NTSTATUS)0xC000008FL)
+
+//
+// MessageId: STATUS_FLOAT_INVALID_OPERATION
+//
+// MessageText:
+//
+// {EXCEPTION} Floating-point invalid operation.
+//
+#define STATUS_FLOAT_INVALID_OPERATION           ((NTSTATUS)0xC0000090L)
+
+//
+// MessageId: STATUS_FLOAT_OVERFLOW
+//
+// MessageText:
+//
+// {EXCEPTION} Floating-point overflow.
+//
+#define STATUS_FLOAT_OVERFLOW                    ((NTSTATUS)0xC0000091L)
+
+//
+// MessageId: STATUS_FLOAT_STACK_CHECK
+//
+// MessageText:
+//
+// {EXCEPTION} Floating-point stack check.
+//
+#define STATUS_FLOAT_STACK_CHECK                 ((NTSTATUS)0xC0000092L)
+
+//
+// MessageId: STATUS_FLOAT_UNDERFLOW
+//
+// MessageText:
+//
+// {EXCEPTION} Floating-point underflow.
+//
+#define STATUS_FLOAT_UNDERFLOW                   ((NTSTATUS)0xC0000093L)
+
+//
+// MessageId: STATUS_INTEGER_DIVIDE_BY_ZERO
+//
+// MessageText:
+//
+// {EXCEPTION} Integer division by zero.
+//
+#define STATUS_INTEGER_DIVIDE_BY_ZERO            ((NTSTATUS)0xC0000094L)
+
+//
+// MessageId: STATUS_INTEGER_OVERFLOW
+//
+// MessageText:
+//
+// {EXCEPTION} Integer overflow.
+//
+#define STATUS_INTEGER_OVERFLOW                  ((NTSTATUS)0xC0000095L)
+
+//
+// MessageId: STATUS_PRIVILEGED_INSTRUCTION
+//
+// MessageText:
+//
+// {EXCEPTION} Privileged instruction.
+//
+#define STATUS_PRIVILEGED_INSTRUCTION            ((NTSTATUS)0xC0000096L)
+
+//
+// MessageId: STATUS_TOO_MANY_PAGING_FILES
+//
+// MessageText:
+//
+// An attempt was made to install more paging files than the system supports.
+//
+#define STATUS_TOO_MANY_PAGING_FILES             ((NTSTATUS)0xC0000097L)
+
+//
+// MessageId: STATUS_FILE_INVALID
+//
+// MessageText:
+//
+// The volume for a file has been externally altered such that the opened file
+// is no longer valid.
+//
+#define STATUS_FILE_INVALID                      ((NTSTATUS)0xC0000098L)
+
+//
+// MessageId: STATUS_ALLOTTED_SPACE_EXCEEDED
+//
+// MessageText:
+//
+// When a block of memory is allotted for future updates, such as the memory
+// allocated to hold discretionary access control and primary group
+// information, successive updates might exceed the amount of memory originally
+// allotted.
+//
+#define STATUS_ALLOTTED_SPACE_EXCEEDED           ((NTSTATUS)0xC0000099L)
+
+//
+// MessageId: STATUS_INSUFFICIENT_RESOURCES
+//
+// MessageText:
+//
+// Insufficient system resources exist to complete the API.
+//
+#define STATUS_INSUFFICIENT_RESOURCES            ((NTSTATUS)0xC000009AL)
+
+//
+// MessageId: STATUS_DFS_EXIT_PATH_FOUND
+//
+// MessageText:
+//
+// An attempt has been made to open a DFS exit path control file.
+//
+#define STATUS_DFS_EXIT_PATH_FOUND               ((NTSTATUS)0xC000009BL)
+
+//
+// MessageId: STATUS_DEVICE_DATA_ERROR
+//
+// MessageText:
+//
+// There are bad blocks (sectors) on the hard disk.
+//
+#define STATUS_DEVICE_DATA_ERROR                 ((NTSTATUS)0xC000009CL)
+
+//
+// MessageId: STATUS_DEVICE_NOT_CONNECTED
+//
+// MessageText:
+//
+// There is bad cabling, non-termination, or the controller is not able to
+// obtain access to the hard disk.
+//
+#define STATUS_DEVICE_NOT_CONNECTED              ((NTSTATUS)0xC000009DL)
+
+#define STATUS_DEVICE_POWER_FAILURE              ((NTSTATUS)0xC000009EL)
+
+//
+// MessageId: STATUS_FREE_VM_NOT_AT_BASE
+//
+// MessageText:
+//
+// Virtual memory cannot be freed because the base address is not the base of
+// the region and a region size of zero was specified.
+//
+#define STATUS_FREE_VM_NOT_AT_BASE               ((NTSTATUS)0xC000009FL)
+
+//
+// MessageId: STATUS_MEMORY_NOT_ALLOCATED
+//
+// MessageText:
+//
+// An attempt was made to free virtual memory that is not allocated.
+//
+#define STATUS_MEMORY_NOT_ALLOCATED              ((NTSTATUS)0xC00000A0L)
+
+//
+// MessageId: STATUS_WORKING_SET_QUOTA
+//
+// MessageText:
+//
+// The working set is not big enough to allow the requested pages to be locked.
+//
+#define STATUS_WORKING_SET_QUOTA                 ((NTSTATUS)0xC00000A1L)
+
+//
+// MessageId: STATUS_MEDIA_WRITE_PROTECTED
+//
+// MessageText:
+//
+// {Write Protect Error} The disk cannot be written to because it is
+// write-protected. Remove the write protection from the volume %hs in drive
+// %hs.
+//
+#define STATUS_MEDIA_WRITE_PROTECTED             ((NTSTATUS)0xC00000A2L)
+
+//
+// MessageId: STATUS_DEVICE_NOT_READY
+//
+// MessageText:
+//
+// {Drive Not Ready} The drive is not ready for use; its door might be open.
+// Check drive %hs and make sure that a disk is inserted and that the drive
+// door is closed.
+//
+#define STATUS_DEVICE_NOT_READY                  ((NTSTATUS)0xC00000A3L)
+
+//
+// MessageId: STATUS_INVALID_GROUP_ATTRIBUTES
+//
+// MessageText:
+//
+// The specified attributes are invalid or are incompatible with the attributes
+// for the group as a whole.
+//
+#define STATUS_INVALID_GROUP_ATTRIBUTES          ((NTSTATUS)0xC00000A4L)
+
+//
+// MessageId: STATUS_BAD_IMPERSONATION_LEVEL
+//
+// MessageText:
+//
+// A specified impersonation level is invalid. Also used to indicate that a
+// required impersonation level was not provided.
+//
+#define STATUS_BAD_IMPERSONATION_LEVEL           ((NTSTATUS)0xC00000A5L)
+
+//
+// MessageId: STATUS_CANT_OPEN_ANONYMOUS
+//
+// MessageText:
+//
+// An attempt was made to open an anonymous-level token. Anonymous tokens
+// cannot be opened.
+//
+#define STATUS_CANT_OPEN_ANONYMOUS               ((NTSTATUS)0xC00000A6L)
+
+//
+// MessageId: STATUS_BAD_VALIDATION_CLASS
+//
+// MessageText:
+//
+// The validation information class requested was invalid.
+//
+#define STATUS_BAD_VALIDATION_CLASS              ((NTSTATUS)0xC00000A7L)
+
+//
+// MessageId: STATUS_BAD_TOKEN_TYPE
+//
+// MessageText:
+//
+// The type of a token object is inappropriate for its attempted use.
+//
+#define STATUS_BAD_TOKEN_TYPE                    ((NTSTATUS)0xC00000A8L)
+
+//
+// MessageId: STATUS_BAD_MASTER_BOOT_RECORD
+//
+// MessageText:
+//
+// The type of a token object is inappropriate for its attempted use.
+//
+#define STATUS_BAD_MASTER_BOOT_RECORD            ((NTSTATUS)0xC00000A9L)
+
+//
+// MessageId: STATUS_INSTRUCTION_MISALIGNMENT
+//
+// MessageText:
+//
+// An attempt was made to execute an instruction at an unaligned address and
+// the host system does not support unaligned instruction references.
+//
+#define STATUS_INSTRUCTION_MISALIGNMENT          ((NTSTATUS)0xC00000AAL)
+
+//
+// MessageId: STATUS_INSTANCE_NOT_AVAILABLE
+//
+// MessageText:
+//
+// The maximum named pipe instance count has been reached.
+//
+#define STATUS_INSTANCE_NOT_AVAILABLE            ((NTSTATUS)0xC00000ABL)
+
+//
+// MessageId: STATUS_PIPE_NOT_AVAILABLE
+//
+// MessageText:
+//
+// An instance of a named pipe cannot be found in the listening state.
+//
+#define STATUS_PIPE_NOT_AVAILABLE                ((NTSTATUS)0xC00000ACL)
+
+//
+// MessageId: STATUS_INVALID_PIPE_STATE
+//
+// MessageText:
+//
+// The named pipe is not in the connected or closing state.
+//
+#define STATUS_INVALID_PIPE_STATE                ((NTSTATUS)0xC00000ADL)
+
+//
+// MessageId: STATUS_PIPE_BUSY
+//
+// MessageText:
+//
+// The specified pipe is set to complete operations and there are current I/O
+// operations queued so that it cannot be changed to queue operations.
+//
+#define STATUS_PIPE_BUSY                         ((NTSTATUS)0xC00000AEL)
+
+//
+// MessageId: STATUS_ILLEGAL_FUNCTION
+//
+// MessageText:
+//
+// The specified handle is not open to the server end of the named pipe.
+//
+#define STATUS_ILLEGAL_FUNCTION                  ((NTSTATUS)0xC00000AFL)
+
+//
+// MessageId: STATUS_PIPE_DISCONNECTED
+//
+// MessageText:
+//
+// The specified named pipe is in the disconnected state.
+//
+#define STATUS_PIPE_DISCONNECTED                 ((NTSTATUS)0xC00000B0L)
+
+//
+// MessageId: STATUS_PIPE_CLOSING
+//
+// MessageText:
+//
+// The specified named pipe is in the closing state.
+//
+#define STATUS_PIPE_CLOSING                      ((NTSTATUS)0xC00000B1L)
+
+//
+// MessageId: STATUS_PIPE_CONNECTED
+//
+// MessageText:
+//
+// The specified named pipe is in the connected state.
+//
+#define STATUS_PIPE_CONNECTED                    ((NTSTATUS)0xC00000B2L)
+
+//
+// MessageId: STATUS_PIPE_LISTENING
+//
+// MessageText:
+//
+// The specified named pipe is in the listening state.
+//
+#define STATUS_PIPE_LISTENING                    ((NTSTATUS)0xC00000B3L)
+
+//
+// MessageId: STATUS_INVALID_READ_MODE
+//
+// MessageText:
+//
+// The specified named pipe is not in message mode.
+//
+#define STATUS_INVALID_READ_MODE                 ((NTSTATUS)0xC00000B4L)
+
+//
+// MessageId: STATUS_IO_TIMEOUT
+//
+// MessageText:
+//
+// {Device Timeout} The specified I/O operation on %hs was not completed before
+// the time-out period expired.
+//
+#define STATUS_IO_TIMEOUT                        ((NTSTATUS)0xC00000B5L)
+
+//
+// MessageId: STATUS_FILE_FORCED_CLOSED
+//
+// MessageText:
+//
+// The specified file has been closed by another process.
+//
+#define STATUS_FILE_FORCED_CLOSED                ((NTSTATUS)0xC00000B6L)
+
+//
+// MessageId: STATUS_PROFILING_NOT_STARTED
+//
+// MessageText:
+//
+// Profiling is not started.
+//
+#define STATUS_PROFILING_NOT_STARTED             ((NTSTATUS)0xC00000B7L)
+
+//
+// MessageId: STATUS_PROFILING_NOT_STOPPED
+//
+// MessageText:
+//
+// Profiling is not stopped.
+//
+#define STATUS_PROFILING_NOT_STOPPED             ((NTSTATUS)0xC00000B8L)
+
+//
+// MessageId: STATUS_COULD_NOT_INTERPRET
+//
+// MessageText:
+//
+// The passed ACL did not contain the minimum required information.
+//
+#define STATUS_COULD_NOT_INTERPRET               ((NTSTATUS)0xC00000B9L)
+
+//
+// MessageId: STATUS_FILE_IS_A_DIRECTORY
+//
+// MessageText:
+//
+// The file that was specified as a target is a directory, and the caller
+// specified that it could be anything but a directory.
+//
+#define STATUS_FILE_IS_A_DIRECTORY               ((NTSTATUS)0xC00000BAL)
+
+//
+// MessageId: STATUS_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The request is not supported.
+//
+#define STATUS_NOT_SUPPORTED                     ((NTSTATUS)0xC00000BBL)
+
+//
+// MessageId: STATUS_REMOTE_NOT_LISTENING
+//
+// MessageText:
+//
+// This remote computer is not listening.
+//
+#define STATUS_REMOTE_NOT_LISTENING              ((NTSTATUS)0xC00000BCL)
+
+//
+// MessageId: STATUS_DUPLICATE_NAME
+//
+// MessageText:
+//
+// A duplicate name exists on the network.
+//
+#define STATUS_DUPLICATE_NAME                    ((NTSTATUS)0xC00000BDL)
+
+//
+// MessageId: STATUS_BAD_NETWORK_PATH
+//
+// MessageText:
+//
+// The network path cannot be located.
+//
+#define STATUS_BAD_NETWORK_PATH                  ((NTSTATUS)0xC00000BEL)
+
+//
+// MessageId: STATUS_NETWORK_BUSY
+//
+// MessageText:
+//
+// The network is busy.
+//
+#define STATUS_NETWORK_BUSY                      ((NTSTATUS)0xC00000BFL)
+
+//
+// MessageId: STATUS_DEVICE_DOES_NOT_EXIST
+//
+// MessageText:
+//
+// This device does not exist.
+//
+#define STATUS_DEVICE_DOES_NOT_EXIST             ((NTSTATUS)0xC00000C0L)
+
+#define STATUS_TOO_MANY_COMMANDS                 ((NTSTATUS)0xC00000C1L)
+
+#define STATUS_ADAPTER_HARDWARE_ERROR            ((NTSTATUS)0xC00000C2L)
+
+//
+// MessageId: STATUS_INVALID_NETWORK_RESPONSE
+//
+// MessageText:
+//
+// The network responded incorrectly.
+//
+#define STATUS_INVALID_NETWORK_RESPONSE          ((NTSTATUS)0xC00000C3L)
+
+#define STATUS_UNEXPECTED_NETWORK_ERROR          ((NTSTATUS)0xC00000C4L)
+
+#define STATUS_BAD_REMOTE_ADAPTER                ((NTSTATUS)0xC00000C5L)
+
+#define STATUS_PRINT_QUEUE_FULL                  ((NTSTATUS)0xC00000C6L)
+
+#define STATUS_NO_SPOOL_SPACE                    ((NTSTATUS)0xC00000C7L)
+
+#define STATUS_PRINT_CANCELLED                   ((NTSTATUS)0xC00000C8L)
+
+#define STATUS_NETWORK_NAME_DELETED              ((NTSTATUS)0xC00000C9L)
+
+#define STATUS_NETWORK_ACCESS_DENIED             ((NTSTATUS)0xC00000CAL)
+
+#define STATUS_BAD_DEVICE_TYPE                   ((NTSTATUS)0xC00000CBL)
+
+//
+// MessageId: STATUS_BAD_NETWORK_NAME
+//
+// MessageText:
+//
+// {Network Name Not Found} The specified share name cannot be found on the
+// remote server.
+//
+#define STATUS_BAD_NETWORK_NAME                  ((NTSTATUS)0xC00000CCL)
+
+#define STATUS_TOO_MANY_NAMES                    ((NTSTATUS)0xC00000CDL)
+
+#define STATUS_TOO_MANY_SESSIONS                 ((NTSTATUS)0xC00000CEL)
+
+#define STATUS_SHARING_PAUSED                    ((NTSTATUS)0xC00000CFL)
+
+//
+// MessageId: STATUS_REQUEST_NOT_ACCEPTED
+//
+// MessageText:
+//
+// No more connections can be made to this remote computer at this time because
+// the computer has already accepted the maximum number of connections.
+//
+#define STATUS_REQUEST_NOT_ACCEPTED              ((NTSTATUS)0xC00000D0L)
+
+#define STATUS_REDIRECTOR_PAUSED                 ((NTSTATUS)0xC00000D1L)
+
+#define STATUS_NET_WRITE_FAULT                   ((NTSTATUS)0xC00000D2L)
+
+#define STATUS_PROFILING_AT_LIMIT                ((NTSTATUS)0xC00000D3L)
+
+//
+// MessageId: STATUS_NOT_SAME_DEVICE
+//
+// MessageText:
+//
+// {Incorrect Volume} The destination file of a rename request is located on a
+// different device than the source of the rename request.
+//
+#define STATUS_NOT_SAME_DEVICE                   ((NTSTATUS)0xC00000D4L)
+
+#define STATUS_FILE_RENAMED                      ((NTSTATUS)0xC00000D5L)
+
+#define STATUS_VIRTUAL_CIRCUIT_CLOSED            ((NTSTATUS)0xC00000D6L)
+
+#define STATUS_NO_SECURITY_ON_OBJECT             ((NTSTATUS)0xC00000D7L)
+
+#define STATUS_CANT_WAIT                         ((NTSTATUS)0xC00000D8L)
+
+#define STATUS_PIPE_EMPTY                        ((NTSTATUS)0xC00000D9L)
+
+#define STATUS_CANT_ACCESS_DOMAIN_INFO           ((NTSTATUS)0xC00000DAL)
+
+#define STATUS_CANT_TERMINATE_SELF               ((NTSTATUS)0xC00000DBL)
+
+#define STATUS_INVALID_SERVER_STATE              ((NTSTATUS)0xC00000DCL)
+
+#define STATUS_INVALID_DOMAIN_STATE              ((NTSTATUS)0xC00000DDL)
+
+#define STATUS_INVALID_DOMAIN_ROLE               ((NTSTATUS)0xC00000DEL)
+
+#define STATUS_NO_SUCH_DOMAIN                    ((NTSTATUS)0xC00000DFL)
+
+#define STATUS_DOMAIN_EXISTS                     ((NTSTATUS)0xC00000E0L)
+
+#define STATUS_DOMAIN_LIMIT_EXCEEDED             ((NTSTATUS)0xC00000E1L)
+
+#define STATUS_OPLOCK_NOT_GRANTED                ((NTSTATUS)0xC00000E2L)
+
+//
+// MessageId: STATUS_INVALID_OPLOCK_PROTOCOL
+//
+// MessageText:
+//
+// An error status returned when an invalid opportunistic lock (oplock)
+// acknowledgment is received by a file system.
+//
+#define STATUS_INVALID_OPLOCK_PROTOCOL           ((NTSTATUS)0xC00000E3L)
+
+#define STATUS_INTERNAL_DB_CORRUPTION            ((NTSTATUS)0xC00000E4L)
+
+//
+// MessageId: STATUS_INTERNAL_ERROR
+//
+// MessageText:
+//
+// An internal error occurred.
+//
+#define STATUS_INTERNAL_ERROR                    ((NTSTATUS)0xC00000E5L)
+
+#define STATUS_GENERIC_NOT_MAPPED                ((NTSTATUS)0xC00000E6L)
+
+#define STATUS_BAD_DESCRIPTOR_FORMAT             ((NTSTATUS)0xC00000E7L)
+
+#define STATUS_INVALID_USER_BUFFER               ((NTSTATUS)0xC00000E8L)
+
+#define STATUS_UNEXPECTED_IO_ERROR               ((NTSTATUS)0xC00000E9L)
+
+#define STATUS_UNEXPECTED_MM_CREATE_ERR          ((NTSTATUS)0xC00000EAL)
+
+#define STATUS_UNEXPECTED_MM_MAP_ERROR           ((NTSTATUS)0xC00000EBL)
+
+#define STATUS_UNEXPECTED_MM_EXTEND_ERR          ((NTSTATUS)0xC00000ECL)
+
+#define STATUS_NOT_LOGON_PROCESS                 ((NTSTATUS)0xC00000EDL)
+
+#define STATUS_LOGON_SESSION_EXISTS              ((NTSTATUS)0xC00000EEL)
+
+#define STATUS_INVALID_PARAMETER_1               ((NTSTATUS)0xC00000EFL)
+
+#define STATUS_INVALID_PARAMETER_2               ((NTSTATUS)0xC00000F0L)
+
+#define STATUS_INVALID_PARAMETER_3               ((NTSTATUS)0xC00000F1L)
+
+#define STATUS_INVALID_PARAMETER_4               ((NTSTATUS)0xC00000F2L)
+
+#define STATUS_INVALID_PARAMETER_5               ((NTSTATUS)0xC00000F3L)
+
+#define STATUS_INVALID_PARAMETER_6               ((NTSTATUS)0xC00000F4L)
+
+#define STATUS_INVALID_PARAMETER_7               ((NTSTATUS)0xC00000F5L)
+
+#define STATUS_INVALID_PARAMETER_8               ((NTSTATUS)0xC00000F6L)
+
+#define STATUS_INVALID_PARAMETER_9               ((NTSTATUS)0xC00000F7L)
+
+#define STATUS_INVALID_PARAMETER_10              ((NTSTATUS)0xC00000F8L)
+
+#define STATUS_INVALID_PARAMETER_11              ((NTSTATUS)0xC00000F9L)
+
+#define STATUS_INVALID_PARAMETER_12              ((NTSTATUS)0xC00000FAL)
+
+#define STATUS_REDIRECTOR_NOT_STARTED            ((NTSTATUS)0xC00000FBL)
+
+#define STATUS_REDIRECTOR_STARTED                ((NTSTATUS)0xC00000FCL)
+
+#define STATUS_STACK_OVERFLOW                    ((NTSTATUS)0xC00000FDL)
+
+#define STATUS_NO_SUCH_PACKAGE                   ((NTSTATUS)0xC00000FEL)
+
+#define STATUS_BAD_FUNCTION_TABLE                ((NTSTATUS)0xC00000FFL)
+
+#define STATUS_VARIABLE_NOT_FOUND                ((NTSTATUS)0xC0000100L)
+
+//
+// MessageId: STATUS_DIRECTORY_NOT_EMPTY
+//
+// MessageText:
+//
+// Indicates that the directory trying to be deleted is not empty.
+//
+#define STATUS_DIRECTORY_NOT_EMPTY               ((NTSTATUS)0xC0000101L)
+
+//
+// MessageId: STATUS_FILE_CORRUPT_ERROR
+//
+// MessageText:
+//
+// {Corrupt File} The file or directory %hs is corrupt and unreadable. Run the
+// Chkdsk utility.
+//
+#define STATUS_FILE_CORRUPT_ERROR                ((NTSTATUS)0xC0000102L)
+
+//
+// MessageId: STATUS_NOT_A_DIRECTORY
+//
+// MessageText:
+//
+// A requested opened file is not a directory.
+//
+#define STATUS_NOT_A_DIRECTORY                   ((NTSTATUS)0xC0000103L)
+
+//
+// MessageId: STATUS_BAD_LOGON_SESSION_STATE
+//
+// MessageText:
+//
+// The logon session is not in a state that is consistent with the requested
+// operation.
+//
+#define STATUS_BAD_LOGON_SESSION_STATE           ((NTSTATUS)0xC0000104L)
+
+#define STATUS_LOGON_SESSION_COLLISION           ((NTSTATUS)0xC0000105L)
+
+//
+// MessageId: STATUS_NAME_TOO_LONG
+//
+// MessageText:
+//
+// A specified name string is too long for its intended use.
+//
+#define STATUS_NAME_TOO_LONG                     ((NTSTATUS)0xC0000106L)
+
+//
+// MessageId: STATUS_FILES_OPEN
+//
+// MessageText:
+//
+// The user attempted to force close the files on a redirected drive, but there
+// were opened files on the drive, and the user did not specify a sufficient
+// level of force.
+//
+#define STATUS_FILES_OPEN                        ((NTSTATUS)0xC0000107L)
+
+#define STATUS_CONNECTION_IN_USE                 ((NTSTATUS)0xC0000108L)
+
+#define STATUS_MESSAGE_NOT_FOUND                 ((NTSTATUS)0xC0000109L)
+
+//
+// MessageId: STATUS_PROCESS_IS_TERMINATING
+//
+// MessageText:
+//
+// An attempt was made to duplicate an object handle into or out of an exiting
+// process.
+//
+#define STATUS_PROCESS_IS_TERMINATING            ((NTSTATUS)0xC000010AL)
+
+//
+// MessageId: STATUS_INVALID_LOGON_TYPE
+//
+// MessageText:
+//
+// Indicates an invalid value has been provided for the LogonType requested.
+//
+#define STATUS_INVALID_LOGON_TYPE                ((NTSTATUS)0xC000010BL)
+
+#define STATUS_NO_GUID_TRANSLATION               ((NTSTATUS)0xC000010CL)
+
+#define STATUS_CANNOT_IMPERSONATE                ((NTSTATUS)0xC000010DL)
+
+#define STATUS_IMAGE_ALREADY_LOADED              ((NTSTATUS)0xC000010EL)
+
+#define STATUS_ABIOS_NOT_PRESENT                 ((NTSTATUS)0xC000010FL)
+
+#define STATUS_ABIOS_LID_NOT_EXIST               ((NTSTATUS)0xC0000110L)
+
+#define STATUS_ABIOS_LID_ALREADY_OWNED           ((NTSTATUS)0xC0000111L)
+
+#define STATUS_ABIOS_NOT_LID_OWNER               ((NTSTATUS)0xC0000112L)
+
+#define STATUS_ABIOS_INVALID_COMMAND             ((NTSTATUS)0xC0000113L)
+
+#define STATUS_ABIOS_INVALID_LID                 ((NTSTATUS)0xC0000114L)
+
+#define STATUS_ABIOS_SELECTOR_NOT_AVAILABLE      ((NTSTATUS)0xC0000115L)
+
+#define STATUS_ABIOS_INVALID_SELECTOR            ((NTSTATUS)0xC0000116L)
+
+#define STATUS_NO_LDT                            ((NTSTATUS)0xC0000117L)
+
+#define STATUS_INVALID_LDT_SIZE                  ((NTSTATUS)0xC0000118L)
+
+#define STATUS_INVALID_LDT_OFFSET                ((NTSTATUS)0xC0000119L)
+
+#define STATUS_INVALID_LDT_DESCRIPTOR            ((NTSTATUS)0xC000011AL)
+
+#define STATUS_INVALID_IMAGE_NE_FORMAT           ((NTSTATUS)0xC000011BL)
+
+#define STATUS_RXACT_INVALID_STATE               ((NTSTATUS)0xC000011CL)
+
+#define STATUS_RXACT_COMMIT_FAILURE              ((NTSTATUS)0xC000011DL)
+
+#define STATUS_MAPPED_FILE_SIZE_ZERO             ((NTSTATUS)0xC000011EL)
+
+//
+// MessageId: STATUS_TOO_MANY_OPENED_FILES
+//
+// MessageText:
+//
+// Too many files are opened on a remote server. This error should only be
+// returned by the Windows redirector on a remote drive.
+//
+#define STATUS_TOO_MANY_OPENED_FILES             ((NTSTATUS)0xC000011FL)
+
+//
+// MessageId: STATUS_CANCELLED
+//
+// MessageText:
+//
+// The I/O request was canceled.
+//
+#define STATUS_CANCELLED                         ((NTSTATUS)0xC0000120L)
+
+//
+// MessageId: STATUS_CANNOT_DELETE
+//
+// MessageText:
+//
+// An attempt has been made to remove a file or directory that cannot be
+// deleted.
+//
+#define STATUS_CANNOT_DELETE                     ((NTSTATUS)0xC0000121L)
+
+#define STATUS_INVALID_COMPUTER_NAME             ((NTSTATUS)0xC0000122L)
+
+//
+// MessageId: STATUS_FILE_DELETED
+//
+// MessageText:
+//
+// An I/O request other than close and several other special case operations
+// was attempted using a file object that had already been deleted.
+//
+#define STATUS_FILE_DELETED                      ((NTSTATUS)0xC0000123L)
+
+#define STATUS_SPECIAL_ACCOUNT                   ((NTSTATUS)0xC0000124L)
+
+#define STATUS_SPECIAL_GROUP                     ((NTSTATUS)0xC0000125L)
+
+#define STATUS_SPECIAL_USER                      ((NTSTATUS)0xC0000126L)
+
+#define STATUS_MEMBERS_PRIMARY_GROUP             ((NTSTATUS)0xC0000127L)
+
+//
+// MessageId: STATUS_FILE_CLOSED
+//
+// MessageText:
+//
+// An I/O request other than close was performed on a file after it was
+// returned from a cleanup operation.
+//
+#define STATUS_FILE_CLOSED                       ((NTSTATUS)0xC0000128L)
+
+#define STATUS_TOO_MANY_THREADS                  ((NTSTATUS)0xC0000129L)
+
+#define STATUS_THREAD_NOT_IN_PROCESS             ((NTSTATUS)0xC000012AL)
+
+//
+// MessageId: STATUS_TOKEN_ALREADY_IN_USE
+//
+// MessageText:
+//
+// An attempt was made to establish a token for use as a primary token but the
+// token is already in use. A token can only be the primary token of one
+// process at a time.
+//
+#define STATUS_TOKEN_ALREADY_IN_USE              ((NTSTATUS)0xC000012BL)
+
+//
+// MessageId: STATUS_PAGEFILE_QUOTA_EXCEEDED
+//
+// MessageText:
+//
+// The page file quota was exceeded.
+//
+#define STATUS_PAGEFILE_QUOTA_EXCEEDED           ((NTSTATUS)0xC000012CL)
+
+//
+// MessageId: STATUS_COMMITMENT_LIMIT
+//
+// MessageText:
+//
+// {Out of Virtual Memory} Your system is low on virtual memory. To ensure that
+// Windows runs correctly, increase the size of your virtual memory paging
+// file.
+//
+#define STATUS_COMMITMENT_LIMIT                  ((NTSTATUS)0xC000012DL)
+
+#define STATUS_INVALID_IMAGE_LE_FORMAT           ((NTSTATUS)0xC000012EL)
+
+#define STATUS_INVALID_IMAGE_NOT_MZ              ((NTSTATUS)0xC000012FL)
+
+//
+// MessageId: STATUS_INVALID_IMAGE_PROTECT
+//
+// MessageText:
+//
+// The specified image file did not have the correct format: it did not have a
+// proper extension.
+//
+#define STATUS_INVALID_IMAGE_PROTECT             ((NTSTATUS)0xC0000130L)
+
+//
+// MessageId: STATUS_INVALID_IMAGE_WIN_16
+//
+// MessageText:
+//
+// The specified image file did not have the correct format: it appears to be a
+// 16-bit Windows image.
+//
+#define STATUS_INVALID_IMAGE_WIN_16              ((NTSTATUS)0xC0000131L)
+
+#define STATUS_LOGON_SERVER_CONFLICT             ((NTSTATUS)0xC0000132L)
+
+#define STATUS_TIME_DIFFERENCE_AT_DC             ((NTSTATUS)0xC0000133L)
+
+#define STATUS_SYNCHRONIZATION_REQUIRED          ((NTSTATUS)0xC0000134L)
+
+//
+// MessageId: STATUS_DLL_NOT_FOUND
+//
+// MessageText:
+//
+// {Unable To Locate Component} This application has failed to start because
+// %hs was not found. Reinstalling the application might fix this problem.
+//
+#define STATUS_DLL_NOT_FOUND                     ((NTSTATUS)0xC0000135L)
+
+//
+// MessageId: STATUS_OPEN_FAILED
+//
+// MessageText:
+//
+// The NtCreateFile API failed. This error should never be returned to an
+// application; it is a place holder that the Windows LAN Manager Redirector
+// uses in its internal error-mapping routines.
+//
+#define STATUS_OPEN_FAILED                       ((NTSTATUS)0xC0000136L)
+
+#define STATUS_IO_PRIVILEGE_FAILED               ((NTSTATUS)0xC0000137L)
+
+//
+// MessageId: STATUS_ORDINAL_NOT_FOUND
+//
+// MessageText:
+//
+// {Ordinal Not Found} The ordinal %ld could not be located in the dynamic link
+// library %hs.
+//
+#define STATUS_ORDINAL_NOT_FOUND                 ((NTSTATUS)0xC0000138L)
+
+//
+// MessageId: STATUS_ENTRYPOINT_NOT_FOUND
+//
+// MessageText:
+//
+// {Entry Point Not Found} The procedure entry point %hs could not be located
+// in the dynamic link library %hs.
+//
+#define STATUS_ENTRYPOINT_NOT_FOUND              ((NTSTATUS)0xC0000139L)
+
+//
+// MessageId: STATUS_CONTROL_C_EXIT
+//
+// MessageText:
+//
+// {Application Exit by CTRL+C} The application terminated as a result of a
+// CTRL+C.
+//
+#define STATUS_CONTROL_C_EXIT                    ((NTSTATUS)0xC000013AL)
+
+//
+// MessageId: STATUS_LOCAL_DISCONNECT
+//
+// MessageText:
+//
+// {Virtual Circuit Closed} The network transport on your computer has closed a
+// network connection. There might or might not be I/O requests outstanding.
+//
+#define STATUS_LOCAL_DISCONNECT                  ((NTSTATUS)0xC000013BL)
+
+//
+// MessageId: STATUS_REMOTE_DISCONNECT
+//
+// MessageText:
+//
+// {Virtual Circuit Closed} The network transport on a remote computer has
+// closed a network connection. There might or might not be I/O requests
+// outstanding.
+//
+#define STATUS_REMOTE_DISCONNECT                 ((NTSTATUS)0xC000013CL)
+
+#define STATUS_REMOTE_RESOURCES                  ((NTSTATUS)0xC000013DL)
+
+#define STATUS_LINK_FAILED                       ((NTSTATUS)0xC000013EL)
+
+#define STATUS_LINK_TIMEOUT                      ((NTSTATUS)0xC000013FL)
+
+#define STATUS_INVALID_CONNECTION                ((NTSTATUS)0xC0000140L)
+
+#define STATUS_INVALID_ADDRESS                   ((NTSTATUS)0xC0000141L)
+
+//
+// MessageId: STATUS_DLL_INIT_FAILED
+//
+// MessageText:
+//
+// {DLL Initialization Failed} Initialization of the dynamic link library %hs
+// failed. The process is terminating abnormally.
+//
+#define STATUS_DLL_INIT_FAILED                   ((NTSTATUS)0xC0000142L)
+
+#define STATUS_MISSING_SYSTEMFILE                ((NTSTATUS)0xC0000143L)
+
+#define STATUS_UNHANDLED_EXCEPTION               ((NTSTATUS)0xC0000144L)
+
+//
+// MessageId: STATUS_APP_INIT_FAILURE
+//
+// MessageText:
+//
+// {Application Error} The application was unable to start correctly (0x%lx).
+// Click OK to close the application.
+//
+#define STATUS_APP_INIT_FAILURE                  ((NTSTATUS)0xC0000145L)
+
+#define STATUS_PAGEFILE_CREATE_FAILED            ((NTSTATUS)0xC0000146L)
+
+#define STATUS_NO_PAGEFILE                       ((NTSTATUS)0xC0000147L)
+
+#define STATUS_INVALID_LEVEL                     ((NTSTATUS)0xC0000148L)
+
+#define STATUS_WRONG_PASSWORD_CORE               ((NTSTATUS)0xC0000149L)
+
+#define STATUS_ILLEGAL_FLOAT_CONTEXT             ((NTSTATUS)0xC000014AL)
+
+//
+// MessageId: STATUS_PIPE_BROKEN
+//
+// MessageText:
+//
+// The pipe operation has failed because the other end of the pipe has been
+// closed.
+//
+#define STATUS_PIPE_BROKEN                       ((NTSTATUS)0xC000014BL)
+
+#define STATUS_REGISTRY_CORRUPT                  ((NTSTATUS)0xC000014CL)
+
+#define STATUS_REGISTRY_IO_FAILED                ((NTSTATUS)0xC000014DL)
+
+#define STATUS_NO_EVENT_PAIR                     ((NTSTATUS)0xC000014EL)
+
+#define STATUS_UNRECOGNIZED_VOLUME               ((NTSTATUS)0xC000014FL)
+
+#define STATUS_SERIAL_NO_DEVICE_INITED           ((NTSTATUS)0xC0000150L)
+
+#define STATUS_NO_SUCH_ALIAS                     ((NTSTATUS)0xC0000151L)
+
+#define STATUS_MEMBER_NOT_IN_ALIAS               ((NTSTATUS)0xC0000152L)
+
+#define STATUS_MEMBER_IN_ALIAS                   ((NTSTATUS)0xC0000153L)
+
+#define STATUS_ALIAS_EXISTS                      ((NTSTATUS)0xC0000154L)
+
+#define STATUS_LOGON_NOT_GRANTED                 ((NTSTATUS)0xC0000155L)
+
+//
+// MessageId: STATUS_TOO_MANY_SECRETS
+//
+// MessageText:
+//
+// The maximum number of secrets that can be stored in a single system was
+// exceeded.
+//
+#define STATUS_TOO_MANY_SECRETS                  ((NTSTATUS)0xC0000156L)
+
+#define STATUS_SECRET_TOO_LONG                   ((NTSTATUS)0xC0000157L)
+
+#define STATUS_INTERNAL_DB_ERROR                 ((NTSTATUS)0xC0000158L)
+
+#define STATUS_FULLSCREEN_MODE                   ((NTSTATUS)0xC0000159L)
+
+#define STATUS_TOO_MANY_CONTEXT_IDS              ((NTSTATUS)0xC000015AL)
+
+//
+// MessageId: STATUS_LOGON_TYPE_NOT_GRANTED
+//
+// MessageText:
+//
+// A user has requested a type of logon (for example, interactive or network)
+// that has not been granted.
+//
+#define STATUS_LOGON_TYPE_NOT_GRANTED            ((NTSTATUS)0xC000015BL)
+
+#define STATUS_NOT_REGISTRY_FILE                 ((NTSTATUS)0xC000015CL)
+
+#define STATUS_NT_CROSS_ENCRYPTION_REQUIRED      ((NTSTATUS)0xC000015DL)
+
+#define STATUS_DOMAIN_CTRLR_CONFIG_ERROR         ((NTSTATUS)0xC000015EL)
+
+#define STATUS_FT_MISSING_MEMBER                 ((NTSTATUS)0xC000015FL)
+
+#define STATUS_ILL_FORMED_SERVICE_ENTRY          ((NTSTATUS)0xC0000160L)
+
+//
+// MessageId: STATUS_ILLEGAL_CHARACTER
+//
+// MessageText:
+//
+// An illegal character was encountered. For a multibyte character set, this
+// includes a lead byte without a succeeding trail byte.
+//
+#define STATUS_ILLEGAL_CHARACTER                 ((NTSTATUS)0xC0000161L)
+
+#define STATUS_UNMAPPABLE_CHARACTER              ((NTSTATUS)0xC0000162L)
+
+#define STATUS_UNDEFINED_CHARACTER               ((NTSTATUS)0xC0000163L)
+
+#define STATUS_FLOPPY_VOLUME                     ((NTSTATUS)0xC0000164L)
+
+#define STATUS_FLOPPY_ID_MARK_NOT_FOUND          ((NTSTATUS)0xC0000165L)
+
+#define STATUS_FLOPPY_WRONG_CYLINDER             ((NTSTATUS)0xC0000166L)
+
+#define STATUS_FLOPPY_UNKNOWN_ERROR              ((NTSTATUS)0xC0000167L)
+
+#define STATUS_FLOPPY_BAD_REGISTERS              ((NTSTATUS)0xC0000168L)
+
+#define STATUS_DISK_RECALIBRATE_FAILED           ((NTSTATUS)0xC0000169L)
+
+#define STATUS_DISK_OPERATION_FAILED             ((NTSTATUS)0xC000016AL)
+
+#define STATUS_DISK_RESET_FAILED                 ((NTSTATUS)0xC000016BL)
+
+#define STATUS_SHARED_IRQ_BUSY                   ((NTSTATUS)0xC000016CL)
+
+#define STATUS_FT_ORPHANING                      ((NTSTATUS)0xC000016DL)
+
+#define STATUS_BIOS_FAILED_TO_CONNECT_INTERRUPT  ((NTSTATUS)0xC000016EL)
+
+#define STATUS_PARTITION_FAILURE                 ((NTSTATUS)0xC0000172L)
+
+#define STATUS_INVALID_BLOCK_LENGTH              ((NTSTATUS)0xC0000173L)
+
+#define STATUS_DEVICE_NOT_PARTITIONED            ((NTSTATUS)0xC0000174L)
+
+#define STATUS_UNABLE_TO_LOCK_MEDIA              ((NTSTATUS)0xC0000175L)
+
+#define STATUS_UNABLE_TO_UNLOAD_MEDIA            ((NTSTATUS)0xC0000176L)
+
+#define STATUS_EOM_OVERFLOW                      ((NTSTATUS)0xC0000177L)
+
+#define STATUS_NO_MEDIA                          ((NTSTATUS)0xC0000178L)
+
+#define STATUS_NO_SUCH_MEMBER                    ((NTSTATUS)0xC000017AL)
+
+#define STATUS_INVALID_MEMBER                    ((NTSTATUS)0xC000017BL)
+
+#define STATUS_KEY_DELETED                       ((NTSTATUS)0xC000017CL)
+
+#define STATUS_NO_LOG_SPACE                      ((NTSTATUS)0xC000017DL)
+
+#define STATUS_TOO_MANY_SIDS                     ((NTSTATUS)0xC000017EL)
+
+#define STATUS_LM_CROSS_ENCRYPTION_REQUIRED      ((NTSTATUS)0xC000017FL)
+
+#define STATUS_KEY_HAS_CHILDREN                  ((NTSTATUS)0xC0000180L)
+
+#define STATUS_CHILD_MUST_BE_VOLATILE            ((NTSTATUS)0xC0000181L)
+
+#define STATUS_DEVICE_CONFIGURATION_ERROR        ((NTSTATUS)0xC0000182L)
+
+#define STATUS_DRIVER_INTERNAL_ERROR             ((NTSTATUS)0xC0000183L)
+
+//
+// MessageId: STATUS_INVALID_DEVICE_STATE
+//
+// MessageText:
+//
+// The device is not in a valid state to perform this request.
+//
+#define STATUS_INVALID_DEVICE_STATE              ((NTSTATUS)0xC0000184L)
+
+//
+// MessageId: STATUS_IO_DEVICE_ERROR
+//
+// MessageText:
+//
+// The I/O device reported an I/O error.
+//
+#define STATUS_IO_DEVICE_ERROR                   ((NTSTATUS)0xC0000185L)
+
+#define STATUS_DEVICE_PROTOCOL_ERROR             ((NTSTATUS)0xC0000186L)
+
+#define STATUS_BACKUP_CONTROLLER                 ((NTSTATUS)0xC0000187L)
+
+//
+// MessageId: STATUS_LOG_FILE_FULL
+//
+// MessageText:
+//
+// The log file space is insufficient to support this operation.
+//
+#define STATUS_LOG_FILE_FULL                     ((NTSTATUS)0xC0000188L)
+
+//
+// MessageId: STATUS_TOO_LATE
+//
+// MessageText:
+//
+// A write operation was attempted to a volume after it was dismounted.
+//
+#define STATUS_TOO_LATE                          ((NTSTATUS)0xC0000189L)
+
+#define STATUS_NO_TRUST_LSA_SECRET               ((NTSTATUS)0xC000018AL)
+
+#define STATUS_NO_TRUST_SAM_ACCOUNT              ((NTSTATUS)0xC000018BL)
+
+#define STATUS_TRUSTED_DOMAIN_FAILURE            ((NTSTATUS)0xC000018CL)
+
+#define STATUS_TRUSTED_RELATIONSHIP_FAILURE      ((NTSTATUS)0xC000018DL)
+
+#define STATUS_EVENTLOG_FILE_CORRUPT             ((NTSTATUS)0xC000018EL)
+
+#define STATUS_EVENTLOG_CANT_START               ((NTSTATUS)0xC000018FL)
+
+#define STATUS_TRUST_FAILURE                     ((NTSTATUS)0xC0000190L)
+
+#define STATUS_MUTANT_LIMIT_EXCEEDED             ((NTSTATUS)0xC0000191L)
+
+#define STATUS_NETLOGON_NOT_STARTED              ((NTSTATUS)0xC0000192L)
+
+#define STATUS_ACCOUNT_EXPIRED                   ((NTSTATUS)0xC0000193L)
+
+//
+// MessageId: STATUS_POSSIBLE_DEADLOCK
+//
+// MessageText:
+//
+// {EXCEPTION} Possible deadlock condition.
+//
+#define STATUS_POSSIBLE_DEADLOCK                 ((NTSTATUS)0xC0000194L)
+
+//
+// MessageId: STATUS_NETWORK_CREDENTIAL_CONFLICT
+//
+// MessageText:
+//
+// The user attempted to make multiple connections to a server or shared
+// resource using more than one user name. Disconnect all previous connections
+// to the server or shared resource and try again.
+//
+#define STATUS_NETWORK_CREDENTIAL_CONFLICT       ((NTSTATUS)0xC0000195L)
+
+#define STATUS_REMOTE_SESSION_LIMIT              ((NTSTATUS)0xC0000196L)
+
+#define STATUS_EVENTLOG_FILE_CHANGED             ((NTSTATUS)0xC0000197L)
+
+#define STATUS_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT ((NTSTATUS)0xC0000198L)
+
+#define STATUS_NOLOGON_WORKSTATION_TRUST_ACCOUNT ((NTSTATUS)0xC0000199L)
+
+#define STATUS_NOLOGON_SERVER_TRUST_ACCOUNT      ((NTSTATUS)0xC000019AL)
+
+//
+// MessageId: STATUS_DOMAIN_TRUST_INCONSISTENT
+//
+// MessageText:
+//
+// The name of the primary domain changed while the trust relationship was
+// being verified.
+//
+#define STATUS_DOMAIN_TRUST_INCONSISTENT         ((NTSTATUS)0xC000019BL)
+
+#define STATUS_FS_DRIVER_REQUIRED                ((NTSTATUS)0xC000019CL)
+
+#define STATUS_IMAGE_ALREADY_LOADED_AS_DLL       ((NTSTATUS)0xC000019DL)
+
+#define STATUS_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING ((NTSTATUS)0xC000019EL)
+
+#define STATUS_SHORT_NAMES_NOT_ENABLED_ON_VOLUME ((NTSTATUS)0xC000019FL)
+
+#define STATUS_SECURITY_STREAM_IS_INCONSISTENT   ((NTSTATUS)0xC00001A0L)
+
+//
+// MessageId: STATUS_INVALID_LOCK_RANGE
+//
+// MessageText:
+//
+// A requested file lock operation cannot be processed due to an invalid byte
+// range.
+//
+#define STATUS_INVALID_LOCK_RANGE                ((NTSTATUS)0xC00001A1L)
+
+#define STATUS_INVALID_ACE_CONDITION             ((NTSTATUS)0xC00001A2L)
+
+#define STATUS_IMAGE_SUBSYSTEM_NOT_PRESENT       ((NTSTATUS)0xC00001A3L)
+
+#define STATUS_NOTIFICATION_GUID_ALREADY_DEFINED ((NTSTATUS)0xC00001A4L)
+
+#define STATUS_INVALID_EXCEPTION_HANDLER         ((NTSTATUS)0xC00001A5L)
+
+#define STATUS_DUPLICATE_PRIVILEGES              ((NTSTATUS)0xC00001A6L)
+
+#define STATUS_NOT_ALLOWED_ON_SYSTEM_FILE        ((NTSTATUS)0xC00001A7L)
+
+#define STATUS_REPAIR_NEEDED                     ((NTSTATUS)0xC00001A8L)
+
+#define STATUS_QUOTA_NOT_ENABLED                 ((NTSTATUS)0xC00001A9L)
+
+#define STATUS_NO_APPLICATION_PACKAGE            ((NTSTATUS)0xC00001AAL)
+
+#define STATUS_FILE_METADATA_OPTIMIZATION_IN_PROGRESS ((NTSTATUS)0xC00001ABL)
+
+#define STATUS_NOT_SAME_OBJECT                   ((NTSTATUS)0xC00001ACL)
+
+//
+// MessageId: STATUS_FATAL_MEMORY_EXHAUSTION
+//
+// MessageText:
+//
+// There is insufficient memory to perform this operation.
+//
+#define STATUS_FATAL_MEMORY_EXHAUSTION           ((NTSTATUS)0xC00001ADL)
+
+#define STATUS_ERROR_PROCESS_NOT_IN_JOB          ((NTSTATUS)0xC00001AEL)
+
+#define STATUS_CPU_SET_INVALID                   ((NTSTATUS)0xC00001AFL)
+
+#define STATUS_IO_DEVICE_INVALID_DATA            ((NTSTATUS)0xC00001B0L)
+
+#define STATUS_IO_UNALIGNED_WRITE                ((NTSTATUS)0xC00001B1L)
+
+#define STATUS_NETWORK_OPEN_RESTRICTION          ((NTSTATUS)0xC0000201L)
+
+#define STATUS_NO_USER_SESSION_KEY               ((NTSTATUS)0xC0000202L)
+
+//
+// MessageId: STATUS_USER_SESSION_DELETED
+//
+// MessageText:
+//
+// The remote user session has been deleted.
+//
+#define STATUS_USER_SESSION_DELETED              ((NTSTATUS)0xC0000203L)
+
+#define STATUS_RESOURCE_LANG_NOT_FOUND           ((NTSTATUS)0xC0000204L)
+
+//
+// MessageId: STATUS_INSUFF_SERVER_RESOURCES
+//
+// MessageText:
+//
+// Insufficient server resources exist to complete the request.
+//
+#define STATUS_INSUFF_SERVER_RESOURCES           ((NTSTATUS)0xC0000205L)
+
+//
+// MessageId: STATUS_INVALID_BUFFER_SIZE
+//
+// MessageText:
+//
+// The size of the buffer is invalid for the specified operation.
+//
+#define STATUS_INVALID_BUFFER_SIZE               ((NTSTATUS)0xC0000206L)
+
+#define STATUS_INVALID_ADDRESS_COMPONENT         ((NTSTATUS)0xC0000207L)
+
+#define STATUS_INVALID_ADDRESS_WILDCARD          ((NTSTATUS)0xC0000208L)
+
+#define STATUS_TOO_MANY_ADDRESSES                ((NTSTATUS)0xC0000209L)
+
+#define STATUS_ADDRESS_ALREADY_EXISTS            ((NTSTATUS)0xC000020AL)
+
+#define STATUS_ADDRESS_CLOSED                    ((NTSTATUS)0xC000020BL)
+
+//
+// MessageId: STATUS_CONNECTION_DISCONNECTED
+//
+// MessageText:
+//
+// The transport connection is now disconnected.
+//
+#define STATUS_CONNECTION_DISCONNECTED           ((NTSTATUS)0xC000020CL)
+
+#define STATUS_CONNECTION_RESET                  ((NTSTATUS)0xC000020DL)
+
+#define STATUS_TOO_MANY_NODES                    ((NTSTATUS)0xC000020EL)
+
+#define STATUS_TRANSACTION_ABORTED               ((NTSTATUS)0xC000020FL)
+
+#define STATUS_TRANSACTION_TIMED_OUT             ((NTSTATUS)0xC0000210L)
+
+#define STATUS_TRANSACTION_NO_RELEASE            ((NTSTATUS)0xC0000211L)
+
+#define STATUS_TRANSACTION_NO_MATCH              ((NTSTATUS)0xC0000212L)
+
+#define STATUS_TRANSACTION_RESPONDED             ((NTSTATUS)0xC0000213L)
+
+#define STATUS_TRANSACTION_INVALID_ID            ((NTSTATUS)0xC0000214L)
+
+#define STATUS_TRANSACTION_INVALID_TYPE          ((NTSTATUS)0xC0000215L)
+
+#define STATUS_NOT_SERVER_SESSION                ((NTSTATUS)0xC0000216L)
+
+#define STATUS_NOT_CLIENT_SESSION                ((NTSTATUS)0xC0000217L)
+
+#define STATUS_CANNOT_LOAD_REGISTRY_FILE         ((NTSTATUS)0xC0000218L)
+
+#define STATUS_DEBUG_ATTACH_FAILED               ((NTSTATUS)0xC0000219L)
+
+#define STATUS_SYSTEM_PROCESS_TERMINATED         ((NTSTATUS)0xC000021AL)
+
+#define STATUS_DATA_NOT_ACCEPTED                 ((NTSTATUS)0xC000021BL)
+
+#define STATUS_NO_BROWSER_SERVERS_FOUND          ((NTSTATUS)0xC000021CL)
+
+#define STATUS_VDM_HARD_ERROR                    ((NTSTATUS)0xC000021DL)
+
+#define STATUS_DRIVER_CANCEL_TIMEOUT             ((NTSTATUS)0xC000021EL)
+
+#define STATUS_REPLY_MESSAGE_MISMATCH            ((NTSTATUS)0xC000021FL)
+
+#define STATUS_MAPPED_ALIGNMENT                  ((NTSTATUS)0xC0000220L)
+
+#define STATUS_IMAGE_CHECKSUM_MISMATCH           ((NTSTATUS)0xC0000221L)
+
+#define STATUS_LOST_WRITEBEHIND_DATA             ((NTSTATUS)0xC0000222L)
+
+#define STATUS_CLIENT_SERVER_PARAMETERS_INVALID  ((NTSTATUS)0xC0000223L)
+
+#define STATUS_PASSWORD_MUST_CHANGE              ((NTSTATUS)0xC0000224L)
+
+//
+// MessageId: STATUS_NOT_FOUND
+//
+// MessageText:
+//
+// The object was not found.
+//
+#define STATUS_NOT_FOUND                         ((NTSTATUS)0xC0000225L)
+
+#define STATUS_NOT_TINY_STREAM                   ((NTSTATUS)0xC0000226L)
+
+#define STATUS_RECOVERY_FAILURE                  ((NTSTATUS)0xC0000227L)
+
+#define STATUS_STACK_OVERFLOW_READ               ((NTSTATUS)0xC0000228L)
+
+#define STATUS_FAIL_CHECK                        ((NTSTATUS)0xC0000229L)
+
+#define STATUS_DUPLICATE_OBJECTID                ((NTSTATUS)0xC000022AL)
+
+#define STATUS_OBJECTID_EXISTS                   ((NTSTATUS)0xC000022BL)
+
+#define STATUS_CONVERT_TO_LARGE                  ((NTSTATUS)0xC000022CL)
+
+#define STATUS_RETRY                             ((NTSTATUS)0xC000022DL)
+
+#define STATUS_FOUND_OUT_OF_SCOPE                ((NTSTATUS)0xC000022EL)
+
+#define STATUS_ALLOCATE_BUCKET                   ((NTSTATUS)0xC000022FL)
+
+#define STATUS_PROPSET_NOT_FOUND                 ((NTSTATUS)0xC0000230L)
+
+#define STATUS_MARSHALL_OVERFLOW                 ((NTSTATUS)0xC0000231L)
+
+#define STATUS_INVALID_VARIANT                   ((NTSTATUS)0xC0000232L)
+
+#define STATUS_DOMAIN_CONTROLLER_NOT_FOUND       ((NTSTATUS)0xC0000233L)
+
+#define STATUS_ACCOUNT_LOCKED_OUT                ((NTSTATUS)0xC0000234L)
+
+//
+// MessageId: STATUS_HANDLE_NOT_CLOSABLE
+//
+// MessageText:
+//
+// NtClose was called on a handle that was protected from close via
+// NtSetInformationObject.
+//
+#define STATUS_HANDLE_NOT_CLOSABLE               ((NTSTATUS)0xC0000235L)
+
+//
+// MessageId: STATUS_CONNECTION_REFUSED
+//
+// MessageText:
+//
+// The transport connection attempt was refused by the remote system.
+//
+#define STATUS_CONNECTION_REFUSED                ((NTSTATUS)0xC0000236L)
+
+#define STATUS_GRACEFUL_DISCONNECT               ((NTSTATUS)0xC0000237L)
+
+#define STATUS_ADDRESS_ALREADY_ASSOCIATED        ((NTSTATUS)0xC0000238L)
+
+#define STATUS_ADDRESS_NOT_ASSOCIATED            ((NTSTATUS)0xC0000239L)
+
+#define STATUS_CONNECTION_INVALID                ((NTSTATUS)0xC000023AL)
+
+#define STATUS_CONNECTION_ACTIVE                 ((NTSTATUS)0xC000023BL)
+
+#define STATUS_NETWORK_UNREACHABLE               ((NTSTATUS)0xC000023CL)
+
+#define STATUS_HOST_UNREACHABLE                  ((NTSTATUS)0xC000023DL)
+
+#define STATUS_PROTOCOL_UNREACHABLE              ((NTSTATUS)0xC000023EL)
+
+#define STATUS_PORT_UNREACHABLE                  ((NTSTATUS)0xC000023FL)
+
+//
+// MessageId: STATUS_REQUEST_ABORTED
+//
+// MessageText:
+//
+// The request was aborted.
+//
+#define STATUS_REQUEST_ABORTED                   ((NTSTATUS)0xC0000240L)
+
+//
+// MessageId: STATUS_CONNECTION_ABORTED
+//
+// MessageText:
+//
+// The transport connection was aborted by the local system.
+//
+#define STATUS_CONNECTION_ABORTED                ((NTSTATUS)0xC0000241L)
+
+#define STATUS_BAD_COMPRESSION_BUFFER            ((NTSTATUS)0xC0000242L)
+
+//
+// MessageId: STATUS_USER_MAPPED_FILE
+//
+// MessageText:
+//
+// The requested operation cannot be performed on a file with a user mapped
+// section open.
+//
+#define STATUS_USER_MAPPED_FILE                  ((NTSTATUS)0xC0000243L)
+
+#define STATUS_AUDIT_FAILED                      ((NTSTATUS)0xC0000244L)
+
+#define STATUS_TIMER_RESOLUTION_NOT_SET          ((NTSTATUS)0xC0000245L)
+
+#define STATUS_CONNECTION_COUNT_LIMIT            ((NTSTATUS)0xC0000246L)
+
+#define STATUS_LOGIN_TIME_RESTRICTION            ((NTSTATUS)0xC0000247L)
+
+#define STATUS_LOGIN_WKSTA_RESTRICTION           ((NTSTATUS)0xC0000248L)
+
+#define STATUS_IMAGE_MP_UP_MISMATCH              ((NTSTATUS)0xC0000249L)
+
+#define STATUS_INSUFFICIENT_LOGON_INFO           ((NTSTATUS)0xC0000250L)
+
+#define STATUS_BAD_DLL_ENTRYPOINT                ((NTSTATUS)0xC0000251L)
+
+#define STATUS_BAD_SERVICE_ENTRYPOINT            ((NTSTATUS)0xC0000252L)
+
+#define STATUS_LPC_REPLY_LOST                    ((NTSTATUS)0xC0000253L)
+
+#define STATUS_IP_ADDRESS_CONFLICT1              ((NTSTATUS)0xC0000254L)
+
+#define STATUS_IP_ADDRESS_CONFLICT2              ((NTSTATUS)0xC0000255L)
+
+#define STATUS_REGISTRY_QUOTA_LIMIT              ((NTSTATUS)0xC0000256L)
+
+#define STATUS_PATH_NOT_COVERED                  ((NTSTATUS)0xC0000257L)
+
+#define STATUS_NO_CALLBACK_ACTIVE                ((NTSTATUS)0xC0000258L)
+
+#define STATUS_LICENSE_QUOTA_EXCEEDED            ((NTSTATUS)0xC0000259L)
+
+#define STATUS_PWD_TOO_SHORT                     ((NTSTATUS)0xC000025AL)
+
+#define STATUS_PWD_TOO_RECENT                    ((NTSTATUS)0xC000025BL)
+
+#define STATUS_PWD_HISTORY_CONFLICT              ((NTSTATUS)0xC000025CL)
+
+#define STATUS_PLUGPLAY_NO_DEVICE                ((NTSTATUS)0xC000025EL)
+
+#define STATUS_UNSUPPORTED_COMPRESSION           ((NTSTATUS)0xC000025FL)
+
+#define STATUS_INVALID_HW_PROFILE                ((NTSTATUS)0xC0000260L)
+
+#define STATUS_INVALID_PLUGPLAY_DEVICE_PATH      ((NTSTATUS)0xC0000261L)
+
+#define STATUS_DRIVER_ORDINAL_NOT_FOUND          ((NTSTATUS)0xC0000262L)
+
+//
+// MessageId: STATUS_DRIVER_ENTRYPOINT_NOT_FOUND
+//
+// MessageText:
+//
+// {Driver Entry Point Not Found} The %hs device driver could not locate the
+// entry point %hs in driver %hs.
+//
+#define STATUS_DRIVER_ENTRYPOINT_NOT_FOUND       ((NTSTATUS)0xC0000263L)
+
+//
+// MessageId: STATUS_RESOURCE_NOT_OWNED
+//
+// MessageText:
+//
+// {Insufficient Rights} The caller attempted to release a resource that it
+// does not own.
+//
+#define STATUS_RESOURCE_NOT_OWNED                ((NTSTATUS)0xC0000264L)
+
+#define STATUS_TOO_MANY_LINKS                    ((NTSTATUS)0xC0000265L)
+
+#define STATUS_QUOTA_LIST_INCONSISTENT           ((NTSTATUS)0xC0000266L)
+
+#define STATUS_FILE_IS_OFFLINE                   ((NTSTATUS)0xC0000267L)
+
+#define STATUS_EVALUATION_EXPIRATION             ((NTSTATUS)0xC0000268L)
+
+#define STATUS_ILLEGAL_DLL_RELOCATION            ((NTSTATUS)0xC0000269L)
+
+#define STATUS_LICENSE_VIOLATION                 ((NTSTATUS)0xC000026AL)
+
+#define STATUS_DLL_INIT_FAILED_LOGOFF            ((NTSTATUS)0xC000026BL)
+
+//
+// MessageId: STATUS_DRIVER_UNABLE_TO_LOAD
+//
+// MessageText:
+//
+// The driver could not be loaded.
+//
+#define STATUS_DRIVER_UNABLE_TO_LOAD             ((NTSTATUS)0xC000026CL)
+
+#define STATUS_DFS_UNAVAILABLE                   ((NTSTATUS)0xC000026DL)
+
+//
+// MessageId: STATUS_VOLUME_DISMOUNTED
+//
+// MessageText:
+//
+// An operation was attempted to a volume after it was dismounted.
+//
+#define STATUS_VOLUME_DISMOUNTED                 ((NTSTATUS)0xC000026EL)
+
+#define STATUS_WX86_INTERNAL_ERROR               ((NTSTATUS)0xC000026FL)
+
+#define STATUS_WX86_FLOAT_STACK_CHECK            ((NTSTATUS)0xC0000270L)
+
+#define STATUS_VALIDATE_CONTINUE                 ((NTSTATUS)0xC0000271L)
+
+#define STATUS_NO_MATCH                          ((NTSTATUS)0xC0000272L)
+
+#define STATUS_NO_MORE_MATCHES                   ((NTSTATUS)0xC0000273L)
+
+//
+// MessageId: STATUS_NOT_A_REPARSE_POINT
+//
+// MessageText:
+//
+// The NTFS file or directory is not a reparse point.
+//
+#define STATUS_NOT_A_REPARSE_POINT               ((NTSTATUS)0xC0000275L)
+
+#define STATUS_IO_REPARSE_TAG_INVALID            ((NTSTATUS)0xC0000276L)
+
+#define STATUS_IO_REPARSE_TAG_MISMATCH           ((NTSTATUS)0xC0000277L)
+
+#define STATUS_IO_REPARSE_DATA_INVALID           ((NTSTATUS)0xC0000278L)
+
+#define STATUS_IO_REPARSE_TAG_NOT_HANDLED        ((NTSTATUS)0xC0000279L)
+
+#define STATUS_PWD_TOO_LONG                      ((NTSTATUS)0xC000027AL)
+
+#define STATUS_STOWED_EXCEPTION                  ((NTSTATUS)0xC000027BL)
+
+#define STATUS_CONTEXT_STOWED_EXCEPTION          ((NTSTATUS)0xC000027CL)
+
+//
+// MessageId: STATUS_REPARSE_POINT_NOT_RESOLVED
+//
+// MessageText:
+//
+// The reparse point could not be resolved.
+//
+#define STATUS_REPARSE_POINT_NOT_RESOLVED        ((NTSTATUS)0xC0000280L)
+
+#define STATUS_DIRECTORY_IS_A_REPARSE_POINT      ((NTSTATUS)0xC0000281L)
+
+#define STATUS_RANGE_LIST_CONFLICT               ((NTSTATUS)0xC0000282L)
+
+#define STATUS_SOURCE_ELEMENT_EMPTY              ((NTSTATUS)0xC0000283L)
+
+#define STATUS_DESTINATION_ELEMENT_FULL          ((NTSTATUS)0xC0000284L)
+
+#define STATUS_ILLEGAL_ELEMENT_ADDRESS           ((NTSTATUS)0xC0000285L)
+
+#define STATUS_MAGAZINE_NOT_PRESENT              ((NTSTATUS)0xC0000286L)
+
+#define STATUS_REINITIALIZATION_NEEDED           ((NTSTATUS)0xC0000287L)
+
+//
+// MessageId: STATUS_DEVICE_REQUIRES_CLEANING
+//
+// MessageText:
+//
+// The device has indicated that cleaning is necessary.
+//
+#define STATUS_DEVICE_REQUIRES_CLEANING          ((NTSTATUS)0x80000288L)
+
+//
+// MessageId: STATUS_DEVICE_DOOR_OPEN
+//
+// MessageText:
+//
+// The device has indicated that its door is open. Further operations require
+// it closed and secured.
+//
+#define STATUS_DEVICE_DOOR_OPEN                  ((NTSTATUS)0x80000289L)
+
+//
+// MessageId: STATUS_ENCRYPTION_FAILED
+//
+// MessageText:
+//
+// The requested encryption operation failed.
+//
+#define STATUS_ENCRYPTION_FAILED                 ((NTSTATUS)0xC000028AL)
+
+#define STATUS_DECRYPTION_FAILED                 ((NTSTATUS)0xC000028BL)
+
+#define STATUS_RANGE_NOT_FOUND                   ((NTSTATUS)0xC000028CL)
+
+#define STATUS_NO_RECOVERY_POLICY                ((NTSTATUS)0xC000028DL)
+
+#define STATUS_NO_EFS                            ((NTSTATUS)0xC000028EL)
+
+#define STATUS_WRONG_EFS                         ((NTSTATUS)0xC000028FL)
+
+#define STATUS_NO_USER_KEYS                      ((NTSTATUS)0xC0000290L)
+
+#define STATUS_FILE_NOT_ENCRYPTED                ((NTSTATUS)0xC0000291L)
+
+#define STATUS_NOT_EXPORT_FORMAT                 ((NTSTATUS)0xC0000292L)
+
+#define STATUS_FILE_ENCRYPTED                    ((NTSTATUS)0xC0000293L)
+
+//
+// MessageId: STATUS_WAKE_SYSTEM
+//
+// MessageText:
+//
+// The system has awoken.
+//
+#define STATUS_WAKE_SYSTEM                       ((NTSTATUS)0x40000294L)
+
+#define STATUS_WMI_GUID_NOT_FOUND                ((NTSTATUS)0xC0000295L)
+
+#define STATUS_WMI_INSTANCE_NOT_FOUND            ((NTSTATUS)0xC0000296L)
+
+#define STATUS_WMI_ITEMID_NOT_FOUND              ((NTSTATUS)0xC0000297L)
+
+#define STATUS_WMI_TRY_AGAIN                     ((NTSTATUS)0xC0000298L)
+
+#define STATUS_SHARED_POLICY                     ((NTSTATUS)0xC0000299L)
+
+#define STATUS_POLICY_OBJECT_NOT_FOUND           ((NTSTATUS)0xC000029AL)
+
+#define STATUS_POLICY_ONLY_IN_DS                 ((NTSTATUS)0xC000029BL)
+
+#define STATUS_VOLUME_NOT_UPGRADED               ((NTSTATUS)0xC000029CL)
+
+#define STATUS_REMOTE_STORAGE_NOT_ACTIVE         ((NTSTATUS)0xC000029DL)
+
+#define STATUS_REMOTE_STORAGE_MEDIA_ERROR        ((NTSTATUS)0xC000029EL)
+
+#define STATUS_NO_TRACKING_SERVICE               ((NTSTATUS)0xC000029FL)
+
+#define STATUS_SERVER_SID_MISMATCH               ((NTSTATUS)0xC00002A0L)
+
+#define STATUS_DS_NO_ATTRIBUTE_OR_VALUE          ((NTSTATUS)0xC00002A1L)
+
+#define STATUS_DS_INVALID_ATTRIBUTE_SYNTAX       ((NTSTATUS)0xC00002A2L)
+
+#define STATUS_DS_ATTRIBUTE_TYPE_UNDEFINED       ((NTSTATUS)0xC00002A3L)
+
+#define STATUS_DS_ATTRIBUTE_OR_VALUE_EXISTS      ((NTSTATUS)0xC00002A4L)
+
+#define STATUS_DS_BUSY                           ((NTSTATUS)0xC00002A5L)
+
+#define STATUS_DS_UNAVAILABLE                    ((NTSTATUS)0xC00002A6L)
+
+#define STATUS_DS_NO_RIDS_ALLOCATED              ((NTSTATUS)0xC00002A7L)
+
+#define STATUS_DS_NO_MORE_RIDS                   ((NTSTATUS)0xC00002A8L)
+
+#define STATUS_DS_INCORRECT_ROLE_OWNER           ((NTSTATUS)0xC00002A9L)
+
+#define STATUS_DS_RIDMGR_INIT_ERROR              ((NTSTATUS)0xC00002AAL)
+
+#define STATUS_DS_OBJ_CLASS_VIOLATION            ((NTSTATUS)0xC00002ABL)
+
+#define STATUS_DS_CANT_ON_NON_LEAF               ((NTSTATUS)0xC00002ACL)
+
+#define STATUS_DS_CANT_ON_RDN                    ((NTSTATUS)0xC00002ADL)
+
+#define STATUS_DS_CANT_MOD_OBJ_CLASS             ((NTSTATUS)0xC00002AEL)
+
+#define STATUS_DS_CROSS_DOM_MOVE_FAILED          ((NTSTATUS)0xC00002AFL)
+
+#define STATUS_DS_GC_NOT_AVAILABLE               ((NTSTATUS)0xC00002B0L)
+
+#define STATUS_DIRECTORY_SERVICE_REQUIRED        ((NTSTATUS)0xC00002B1L)
+
+#define STATUS_REPARSE_ATTRIBUTE_CONFLICT        ((NTSTATUS)0xC00002B2L)
+
+#define STATUS_CANT_ENABLE_DENY_ONLY             ((NTSTATUS)0xC00002B3L)
+
+//
+// MessageId: STATUS_FLOAT_MULTIPLE_FAULTS
+//
+// MessageText:
+//
+// {EXCEPTION} Multiple floating-point faults.
+//
+#define STATUS_FLOAT_MULTIPLE_FAULTS             ((NTSTATUS)0xC00002B4L)
+
+//
+// MessageId: STATUS_FLOAT_MULTIPLE_TRAPS
+//
+// MessageText:
+//
+// {EXCEPTION} Multiple floating-point traps.
+//
+#define STATUS_FLOAT_MULTIPLE_TRAPS              ((NTSTATUS)0xC00002B5L)
+
+#define STATUS_DEVICE_REMOVED                    ((NTSTATUS)0xC00002B6L)
+
+#define STATUS_JOURNAL_DELETE_IN_PROGRESS        ((NTSTATUS)0xC00002B7L)
+
+#define STATUS_JOURNAL_NOT_ACTIVE                ((NTSTATUS)0xC00002B8L)
+
+#define STATUS_NOINTERFACE                       ((NTSTATUS)0xC00002B9L)
+
+#define STATUS_DS_RIDMGR_DISABLED                ((NTSTATUS)0xC00002BAL)
+
+#define STATUS_DS_ADMIN_LIMIT_EXCEEDED           ((NTSTATUS)0xC00002C1L)
+
+#define STATUS_DRIVER_FAILED_SLEEP               ((NTSTATUS)0xC00002C2L)
+
+#define STATUS_MUTUAL_AUTHENTICATION_FAILED      ((NTSTATUS)0xC00002C3L)
+
+#define STATUS_CORRUPT_SYSTEM_FILE               ((NTSTATUS)0xC00002C4L)
+
+//
+// MessageId: STATUS_DATATYPE_MISALIGNMENT_ERROR
+//
+// MessageText:
+//
+// {EXCEPTION} Alignment Error A data type misalignment error was detected in a
+// load or store instruction.
+//
+#define STATUS_DATATYPE_MISALIGNMENT_ERROR       ((NTSTATUS)0xC00002C5L)
+
+#define STATUS_WMI_READ_ONLY                     ((NTSTATUS)0xC00002C6L)
+
+#define STATUS_WMI_SET_FAILURE                   ((NTSTATUS)0xC00002C7L)
+
+#define STATUS_COMMITMENT_MINIMUM                ((NTSTATUS)0xC00002C8L)
+
+//
+// MessageId: STATUS_REG_NAT_CONSUMPTION
+//
+// MessageText:
+//
+// {EXCEPTION} Register NaT consumption faults. A NaT value is consumed on a
+// non-speculative instruction.
+//
+#define STATUS_REG_NAT_CONSUMPTION               ((NTSTATUS)0xC00002C9L)
+
+#define STATUS_TRANSPORT_FULL                    ((NTSTATUS)0xC00002CAL)
+
+#define STATUS_DS_SAM_INIT_FAILURE               ((NTSTATUS)0xC00002CBL)
+
+#define STATUS_ONLY_IF_CONNECTED                 ((NTSTATUS)0xC00002CCL)
+
+#define STATUS_DS_SENSITIVE_GROUP_VIOLATION      ((NTSTATUS)0xC00002CDL)
+
+#define STATUS_PNP_RESTART_ENUMERATION           ((NTSTATUS)0xC00002CEL)
+
+#define STATUS_JOURNAL_ENTRY_DELETED             ((NTSTATUS)0xC00002CFL)
+
+#define STATUS_DS_CANT_MOD_PRIMARYGROUPID        ((NTSTATUS)0xC00002D0L)
+
+#define STATUS_SYSTEM_IMAGE_BAD_SIGNATURE        ((NTSTATUS)0xC00002D1L)
+
+#define STATUS_PNP_REBOOT_REQUIRED               ((NTSTATUS)0xC00002D2L)
+
+#define STATUS_POWER_STATE_INVALID               ((NTSTATUS)0xC00002D3L)
+
+#define STATUS_DS_INVALID_GROUP_TYPE             ((NTSTATUS)0xC00002D4L)
+
+#define STATUS_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN ((NTSTATUS)0xC00002D5L)
+
+#define STATUS_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN ((NTSTATUS)0xC00002D6L)
+
+#define STATUS_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER  ((NTSTATUS)0xC00002D7L)
+
+#define STATUS_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER ((NTSTATUS)0xC00002D8L)
+
+#define STATUS_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER ((NTSTATUS)0xC00002D9L)
+
+#define STATUS_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER ((NTSTATUS)0xC00002DAL)
+
+#define STATUS_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER ((NTSTATUS)0xC00002DBL)
+
+#define STATUS_DS_HAVE_PRIMARY_MEMBERS           ((NTSTATUS)0xC00002DCL)
+
+#define STATUS_WMI_NOT_SUPPORTED                 ((NTSTATUS)0xC00002DDL)
+
+#define STATUS_INSUFFICIENT_POWER                ((NTSTATUS)0xC00002DEL)
+
+#define STATUS_SAM_NEED_BOOTKEY_PASSWORD         ((NTSTATUS)0xC00002DFL)
+
+#define STATUS_SAM_NEED_BOOTKEY_FLOPPY           ((NTSTATUS)0xC00002E0L)
+
+#define STATUS_DS_CANT_START                     ((NTSTATUS)0xC00002E1L)
+
+#define STATUS_DS_INIT_FAILURE                   ((NTSTATUS)0xC00002E2L)
+
+#define STATUS_SAM_INIT_FAILURE                  ((NTSTATUS)0xC00002E3L)
+
+#define STATUS_DS_GC_REQUIRED                    ((NTSTATUS)0xC00002E4L)
+
+#define STATUS_DS_LOCAL_MEMBER_OF_LOCAL_ONLY     ((NTSTATUS)0xC00002E5L)
+
+#define STATUS_DS_NO_FPO_IN_UNIVERSAL_GROUPS     ((NTSTATUS)0xC00002E6L)
+
+#define STATUS_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED ((NTSTATUS)0xC00002E7L)
+
+#define STATUS_MULTIPLE_FAULT_VIOLATION          ((NTSTATUS)0xC00002E8L)
+
+#define STATUS_CURRENT_DOMAIN_NOT_ALLOWED        ((NTSTATUS)0xC00002E9L)
+
+#define STATUS_CANNOT_MAKE                       ((NTSTATUS)0xC00002EAL)
+
+#define STATUS_SYSTEM_SHUTDOWN                   ((NTSTATUS)0xC00002EBL)
+
+#define STATUS_DS_INIT_FAILURE_CONSOLE           ((NTSTATUS)0xC00002ECL)
+
+#define STATUS_DS_SAM_INIT_FAILURE_CONSOLE       ((NTSTATUS)0xC00002EDL)
+
+#define STATUS_UNFINISHED_CONTEXT_DELETED        ((NTSTATUS)0xC00002EEL)
+
+#define STATUS_NO_TGT_REPLY                      ((NTSTATUS)0xC00002EFL)
+
+#define STATUS_OBJECTID_NOT_FOUND                ((NTSTATUS)0xC00002F0L)
+
+#define STATUS_NO_IP_ADDRESSES                   ((NTSTATUS)0xC00002F1L)
+
+#define STATUS_WRONG_CREDENTIAL_HANDLE           ((NTSTATUS)0xC00002F2L)
+
+#define STATUS_CRYPTO_SYSTEM_INVALID             ((NTSTATUS)0xC00002F3L)
+
+#define STATUS_MAX_REFERRALS_EXCEEDED            ((NTSTATUS)0xC00002F4L)
+
+#define STATUS_MUST_BE_KDC                       ((NTSTATUS)0xC00002F5L)
+
+#define STATUS_STRONG_CRYPTO_NOT_SUPPORTED       ((NTSTATUS)0xC00002F6L)
+
+#define STATUS_TOO_MANY_PRINCIPALS               ((NTSTATUS)0xC00002F7L)
+
+#define STATUS_NO_PA_DATA                        ((NTSTATUS)0xC00002F8L)
+
+#define STATUS_PKINIT_NAME_MISMATCH              ((NTSTATUS)0xC00002F9L)
+
+#define STATUS_SMARTCARD_LOGON_REQUIRED          ((NTSTATUS)0xC00002FAL)
+
+#define STATUS_KDC_INVALID_REQUEST               ((NTSTATUS)0xC00002FBL)
+
+#define STATUS_KDC_UNABLE_TO_REFER               ((NTSTATUS)0xC00002FCL)
+
+#define STATUS_KDC_UNKNOWN_ETYPE                 ((NTSTATUS)0xC00002FDL)
+
+#define STATUS_SHUTDOWN_IN_PROGRESS              ((NTSTATUS)0xC00002FEL)
+
+#define STATUS_SERVER_SHUTDOWN_IN_PROGRESS       ((NTSTATUS)0xC00002FFL)
+
+#define STATUS_NOT_SUPPORTED_ON_SBS              ((NTSTATUS)0xC0000300L)
+
+#define STATUS_WMI_GUID_DISCONNECTED             ((NTSTATUS)0xC0000301L)
+
+#define STATUS_WMI_ALREADY_DISABLED              ((NTSTATUS)0xC0000302L)
+
+#define STATUS_WMI_ALREADY_ENABLED               ((NTSTATUS)0xC0000303L)
+
+//
+// MessageId: STATUS_MFT_TOO_FRAGMENTED
+//
+// MessageText:
+//
+// The file could not be opened because the file's MFT is too fragmented.
+//
+#define STATUS_MFT_TOO_FRAGMENTED                ((NTSTATUS)0xC0000304L)
+
+#define STATUS_COPY_PROTECTION_FAILURE           ((NTSTATUS)0xC0000305L)
+
+#define STATUS_CSS_AUTHENTICATION_FAILURE        ((NTSTATUS)0xC0000306L)
+
+#define STATUS_CSS_KEY_NOT_PRESENT               ((NTSTATUS)0xC0000307L)
+
+#define STATUS_CSS_KEY_NOT_ESTABLISHED           ((NTSTATUS)0xC0000308L)
+
+#define STATUS_CSS_SCRAMBLED_SECTOR              ((NTSTATUS)0xC0000309L)
+
+#define STATUS_CSS_REGION_MISMATCH               ((NTSTATUS)0xC000030AL)
+
+#define STATUS_CSS_RESETS_EXHAUSTED              ((NTSTATUS)0xC000030BL)
+
+#define STATUS_PASSWORD_CHANGE_REQUIRED          ((NTSTATUS)0xC000030CL)
+
+#define STATUS_LOST_MODE_LOGON_RESTRICTION       ((NTSTATUS)0xC000030DL)
+
+#define STATUS_PKINIT_FAILURE                    ((NTSTATUS)0xC0000320L)
+
+#define STATUS_SMARTCARD_SUBSYSTEM_FAILURE       ((NTSTATUS)0xC0000321L)
+
+#define STATUS_NO_KERB_KEY                       ((NTSTATUS)0xC0000322L)
+
+#define STATUS_HOST_DOWN                         ((NTSTATUS)0xC0000350L)
+
+#define STATUS_UNSUPPORTED_PREAUTH               ((NTSTATUS)0xC0000351L)
+
+#define STATUS_EFS_ALG_BLOB_TOO_BIG              ((NTSTATUS)0xC0000352L)
+
+#define STATUS_PORT_NOT_SET                      ((NTSTATUS)0xC0000353L)
+
+//
+// MessageId: STATUS_DEBUGGER_INACTIVE
+//
+// MessageText:
+//
+// An attempt to do an operation on a debug port failed because the port is in
+// the process of being deleted.
+//
+#define STATUS_DEBUGGER_INACTIVE                 ((NTSTATUS)0xC0000354L)
+
+#define STATUS_DS_VERSION_CHECK_FAILURE          ((NTSTATUS)0xC0000355L)
+
+#define STATUS_AUDITING_DISABLED                 ((NTSTATUS)0xC0000356L)
+
+#define STATUS_PRENT4_MACHINE_ACCOUNT            ((NTSTATUS)0xC0000357L)
+
+#define STATUS_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER  ((NTSTATUS)0xC0000358L)
+
+#define STATUS_INVALID_IMAGE_WIN_32              ((NTSTATUS)0xC0000359L)
+
+#define STATUS_INVALID_IMAGE_WIN_64              ((NTSTATUS)0xC000035AL)
+
+#define STATUS_BAD_BINDINGS                      ((NTSTATUS)0xC000035BL)
+
+//
+// MessageId: STATUS_NETWORK_SESSION_EXPIRED
+//
+// MessageText:
+//
+// The client session has expired; so the client must re-authenticate to
+// continue accessing the remote resources.
+//
+#define STATUS_NETWORK_SESSION_EXPIRED           ((NTSTATUS)0xC000035CL)
+
+#define STATUS_APPHELP_BLOCK                     ((NTSTATUS)0xC000035DL)
+
+#define STATUS_ALL_SIDS_FILTERED                 ((NTSTATUS)0xC000035EL)
+
+#define STATUS_NOT_SAFE_MODE_DRIVER              ((NTSTATUS)0xC000035FL)
+
+//
+// MessageId: STATUS_ACCESS_DISABLED_BY_POLICY_DEFAULT
+//
+// MessageText:
+//
+// Access to %1 has been restricted by your Administrator by the default
+// software restriction policy level.
+//
+#define STATUS_ACCESS_DISABLED_BY_POLICY_DEFAULT ((NTSTATUS)0xC0000361L)
+
+//
+// MessageId: STATUS_ACCESS_DISABLED_BY_POLICY_PATH
+//
+// MessageText:
+//
+// Access to %1 has been restricted by your Administrator by location with
+// policy rule %2 placed on path %3.
+//
+#define STATUS_ACCESS_DISABLED_BY_POLICY_PATH    ((NTSTATUS)0xC0000362L)
+
+#define STATUS_ACCESS_DISABLED_BY_POLICY_PUBLISHER ((NTSTATUS)0xC0000363L)
+
+#define STATUS_ACCESS_DISABLED_BY_POLICY_OTHER   ((NTSTATUS)0xC0000364L)
+
+#define STATUS_FAILED_DRIVER_ENTRY               ((NTSTATUS)0xC0000365L)
+
+#define STATUS_DEVICE_ENUMERATION_ERROR          ((NTSTATUS)0xC0000366L)
+
+#define STATUS_MOUNT_POINT_NOT_RESOLVED          ((NTSTATUS)0xC0000368L)
+
+#define STATUS_INVALID_DEVICE_OBJECT_PARAMETER   ((NTSTATUS)0xC0000369L)
+
+#define STATUS_MCA_OCCURED                       ((NTSTATUS)0xC000036AL)
+
+#define STATUS_DRIVER_BLOCKED_CRITICAL           ((NTSTATUS)0xC000036BL)
+
+#define STATUS_DRIVER_BLOCKED                    ((NTSTATUS)0xC000036CL)
+
+#define STATUS_DRIVER_DATABASE_ERROR             ((NTSTATUS)0xC000036DL)
+
+#define STATUS_SYSTEM_HIVE_TOO_LARGE             ((NTSTATUS)0xC000036EL)
+
+//
+// MessageId: STATUS_INVALID_IMPORT_OF_NON_DLL
+//
+// MessageText:
+//
+// An attempt was made to load a non-DLL image as an import.
+//
+#define STATUS_INVALID_IMPORT_OF_NON_DLL         ((NTSTATUS)0xC000036FL)
+
+#define STATUS_DS_SHUTTING_DOWN                  ((NTSTATUS)0x40000370L)
+
+#define STATUS_NO_SECRETS                        ((NTSTATUS)0xC0000371L)
+
+#define STATUS_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY ((NTSTATUS)0xC0000372L)
+
+#define STATUS_FAILED_STACK_SWITCH               ((NTSTATUS)0xC0000373L)
+
+//
+// MessageId: STATUS_HEAP_CORRUPTION
+//
+// MessageText:
+//
+// A heap has been corrupted.
+//
+#define STATUS_HEAP_CORRUPTION                   ((NTSTATUS)0xC0000374L)
+
+#define STATUS_SMARTCARD_WRONG_PIN               ((NTSTATUS)0xC0000380L)
+
+#define STATUS_SMARTCARD_CARD_BLOCKED            ((NTSTATUS)0xC0000381L)
+
+#define STATUS_SMARTCARD_CARD_NOT_AUTHENTICATED  ((NTSTATUS)0xC0000382L)
+
+#define STATUS_SMARTCARD_NO_CARD                 ((NTSTATUS)0xC0000383L)
+
+#define STATUS_SMARTCARD_NO_KEY_CONTAINER        ((NTSTATUS)0xC0000384L)
+
+#define STATUS_SMARTCARD_NO_CERTIFICATE          ((NTSTATUS)0xC0000385L)
+
+#define STATUS_SMARTCARD_NO_KEYSET               ((NTSTATUS)0xC0000386L)
+
+#define STATUS_SMARTCARD_IO_ERROR                ((NTSTATUS)0xC0000387L)
+
+//
+// MessageId: STATUS_DOWNGRADE_DETECTED
+//
+// MessageText:
+//
+// The system detected a possible attempt to compromise security. Ensure that
+// you can contact the server that authenticated you.
+//
+#define STATUS_DOWNGRADE_DETECTED                ((NTSTATUS)0xC0000388L)
+
+#define STATUS_SMARTCARD_CERT_REVOKED            ((NTSTATUS)0xC0000389L)
+
+#define STATUS_ISSUING_CA_UNTRUSTED              ((NTSTATUS)0xC000038AL)
+
+#define STATUS_REVOCATION_OFFLINE_C              ((NTSTATUS)0xC000038BL)
+
+#define STATUS_PKINIT_CLIENT_FAILURE             ((NTSTATUS)0xC000038CL)
+
+#define STATUS_SMARTCARD_CERT_EXPIRED            ((NTSTATUS)0xC000038DL)
+
+#define STATUS_DRIVER_FAILED_PRIOR_UNLOAD        ((NTSTATUS)0xC000038EL)
+
+#define STATUS_SMARTCARD_SILENT_CONTEXT          ((NTSTATUS)0xC000038FL)
+
+#define STATUS_PER_USER_TRUST_QUOTA_EXCEEDED     ((NTSTATUS)0xC0000401L)
+
+#define STATUS_ALL_USER_TRUST_QUOTA_EXCEEDED     ((NTSTATUS)0xC0000402L)
+
+#define STATUS_USER_DELETE_TRUST_QUOTA_EXCEEDED  ((NTSTATUS)0xC0000403L)
+
+#define STATUS_DS_NAME_NOT_UNIQUE                ((NTSTATUS)0xC0000404L)
+
+#define STATUS_DS_DUPLICATE_ID_FOUND             ((NTSTATUS)0xC0000405L)
+
+#define STATUS_DS_GROUP_CONVERSION_ERROR         ((NTSTATUS)0xC0000406L)
+
+#define STATUS_VOLSNAP_PREPARE_HIBERNATE         ((NTSTATUS)0xC0000407L)
+
+#define STATUS_USER2USER_REQUIRED                ((NTSTATUS)0xC0000408L)
+
+//
+// MessageId: STATUS_STACK_BUFFER_OVERRUN
+//
+// MessageText:
+//
+// The system detected an overrun of a stack-based buffer in this application.
+// This overrun could potentially allow a malicious user to gain control of
+// this application.
+//
+#define STATUS_STACK_BUFFER_OVERRUN              ((NTSTATUS)0xC0000409L)
+
+#define STATUS_NO_S4U_PROT_SUPPORT               ((NTSTATUS)0xC000040AL)
+
+#define STATUS_CROSSREALM_DELEGATION_FAILURE     ((NTSTATUS)0xC000040BL)
+
+#define STATUS_REVOCATION_OFFLINE_KDC            ((NTSTATUS)0xC000040CL)
+
+#define STATUS_ISSUING_CA_UNTRUSTED_KDC          ((NTSTATUS)0xC000040DL)
+
+#define STATUS_KDC_CERT_EXPIRED                  ((NTSTATUS)0xC000040EL)
+
+#define STATUS_KDC_CERT_REVOKED                  ((NTSTATUS)0xC000040FL)
+
+#define STATUS_PARAMETER_QUOTA_EXCEEDED          ((NTSTATUS)0xC0000410L)
+
+#define STATUS_HIBERNATION_FAILURE               ((NTSTATUS)0xC0000411L)
+
+#define STATUS_DELAY_LOAD_FAILED                 ((NTSTATUS)0xC0000412L)
+
+#define STATUS_AUTHENTICATION_FIREWALL_FAILED    ((NTSTATUS)0xC0000413L)
+
+#define STATUS_VDM_DISALLOWED                    ((NTSTATUS)0xC0000414L)
+
+#define STATUS_HUNG_DISPLAY_DRIVER_THREAD        ((NTSTATUS)0xC0000415L)
+
+#define STATUS_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE ((NTSTATUS)0xC0000416L)
+
+//
+// MessageId: STATUS_INVALID_CRUNTIME_PARAMETER
+//
+// MessageText:
+//
+// An invalid parameter was passed to a C runtime function.
+//
+#define STATUS_INVALID_CRUNTIME_PARAMETER        ((NTSTATUS)0xC0000417L)
+
+#define STATUS_NTLM_BLOCKED                      ((NTSTATUS)0xC0000418L)
+
+#define STATUS_DS_SRC_SID_EXISTS_IN_FOREST       ((NTSTATUS)0xC0000419L)
+
+#define STATUS_DS_DOMAIN_NAME_EXISTS_IN_FOREST   ((NTSTATUS)0xC000041AL)
+
+#define STATUS_DS_FLAT_NAME_EXISTS_IN_FOREST     ((NTSTATUS)0xC000041BL)
+
+#define STATUS_INVALID_USER_PRINCIPAL_NAME       ((NTSTATUS)0xC000041CL)
+
+#define STATUS_FATAL_USER_CALLBACK_EXCEPTION     ((NTSTATUS)0xC000041DL)
+
+#define STATUS_ASSERTION_FAILURE                 ((NTSTATUS)0xC0000420L)
+
+#define STATUS_VERIFIER_STOP                     ((NTSTATUS)0xC0000421L)
+
+#define STATUS_CALLBACK_POP_STACK                ((NTSTATUS)0xC0000423L)
+
+#define STATUS_INCOMPATIBLE_DRIVER_BLOCKED       ((NTSTATUS)0xC0000424L)
+
+#define STATUS_HIVE_UNLOADED                     ((NTSTATUS)0xC0000425L)
+
+#define STATUS_COMPRESSION_DISABLED              ((NTSTATUS)0xC0000426L)
+
+#define STATUS_FILE_SYSTEM_LIMITATION            ((NTSTATUS)0xC0000427L)
+
+//
+// MessageId: STATUS_INVALID_IMAGE_HASH
+//
+// MessageText:
+//
+// Windows cannot verify the digital signature for this file. The signing
+// certificate for this file has been revoked.
+//
+#define STATUS_INVALID_IMAGE_HASH                ((NTSTATUS)0xC0000428L)
+
+#define STATUS_NOT_CAPABLE                       ((NTSTATUS)0xC0000429L)
+
+#define STATUS_REQUEST_OUT_OF_SEQUENCE           ((NTSTATUS)0xC000042AL)
+
+//
+// MessageId: STATUS_IMPLEMENTATION_LIMIT
+//
+// MessageText:
+//
+// An operation attempted to exceed an implementation-defined limit.
+//
+#define STATUS_IMPLEMENTATION_LIMIT              ((NTSTATUS)0xC000042BL)
+
+#define STATUS_ELEVATION_REQUIRED                ((NTSTATUS)0xC000042CL)
+
+#define STATUS_NO_SECURITY_CONTEXT               ((NTSTATUS)0xC000042DL)
+
+#define STATUS_PKU2U_CERT_FAILURE                ((NTSTATUS)0xC000042FL)
+
+#define STATUS_BEYOND_VDL                        ((NTSTATUS)0xC0000432L)
+
+#define STATUS_ENCOUNTERED_WRITE_IN_PROGRESS     ((NTSTATUS)0xC0000433L)
+
+#define STATUS_PTE_CHANGED                       ((NTSTATUS)0xC0000434L)
+
+#define STATUS_PURGE_FAILED                      ((NTSTATUS)0xC0000435L)
+
+#define STATUS_CRED_REQUIRES_CONFIRMATION        ((NTSTATUS)0xC0000440L)
+
+#define STATUS_CS_ENCRYPTION_INVALID_SERVER_RESPONSE ((NTSTATUS)0xC0000441L)
+
+#define STATUS_CS_ENCRYPTION_UNSUPPORTED_SERVER  ((NTSTATUS)0xC0000442L)
+
+#define STATUS_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE ((NTSTATUS)0xC0000443L)
+
+#define STATUS_CS_ENCRYPTION_NEW_ENCRYPTED_FILE  ((NTSTATUS)0xC0000444L)
+
+#define STATUS_CS_ENCRYPTION_FILE_NOT_CSE        ((NTSTATUS)0xC0000445L)
+
+#define STATUS_INVALID_LABEL                     ((NTSTATUS)0xC0000446L)
+
+#define STATUS_DRIVER_PROCESS_TERMINATED         ((NTSTATUS)0xC0000450L)
+
+#define STATUS_AMBIGUOUS_SYSTEM_DEVICE           ((NTSTATUS)0xC0000451L)
+
+#define STATUS_SYSTEM_DEVICE_NOT_FOUND           ((NTSTATUS)0xC0000452L)
+
+#define STATUS_RESTART_BOOT_APPLICATION          ((NTSTATUS)0xC0000453L)
+
+#define STATUS_INSUFFICIENT_NVRAM_RESOURCES      ((NTSTATUS)0xC0000454L)
+
+#define STATUS_INVALID_SESSION                   ((NTSTATUS)0xC0000455L)
+
+//
+// MessageId: STATUS_THREAD_ALREADY_IN_SESSION
+//
+// MessageText:
+//
+// The thread is already in a session.
+//
+#define STATUS_THREAD_ALREADY_IN_SESSION         ((NTSTATUS)0xC0000456L)
+
+#define STATUS_THREAD_NOT_IN_SESSION             ((NTSTATUS)0xC0000457L)
+
+#define STATUS_INVALID_WEIGHT                    ((NTSTATUS)0xC0000458L)
+
+#define STATUS_REQUEST_PAUSED                    ((NTSTATUS)0xC0000459L)
+
+#define STATUS_NO_RANGES_PROCESSED               ((NTSTATUS)0xC0000460L)
+
+#define STATUS_DISK_RESOURCES_EXHAUSTED          ((NTSTATUS)0xC0000461L)
+
+#define STATUS_NEEDS_REMEDIATION                 ((NTSTATUS)0xC0000462L)
+
+#define STATUS_DEVICE_FEATURE_NOT_SUPPORTED      ((NTSTATUS)0xC0000463L)
+
+#define STATUS_DEVICE_UNREACHABLE                ((NTSTATUS)0xC0000464L)
+
+#define STATUS_INVALID_TOKEN                     ((NTSTATUS)0xC0000465L)
+
+#define STATUS_SERVER_UNAVAILABLE                ((NTSTATUS)0xC0000466L)
+
+//
+// MessageId: STATUS_FILE_NOT_AVAILABLE
+//
+// MessageText:
+//
+// The file is temporarily unavailable.
+//
+#define STATUS_FILE_NOT_AVAILABLE                ((NTSTATUS)0xC0000467L)
+
+#define STATUS_DEVICE_INSUFFICIENT_RESOURCES     ((NTSTATUS)0xC0000468L)
+
+#define STATUS_PACKAGE_UPDATING                  ((NTSTATUS)0xC0000469L)
+
+#define STATUS_NOT_READ_FROM_COPY                ((NTSTATUS)0xC000046AL)
+
+#define STATUS_FT_WRITE_FAILURE                  ((NTSTATUS)0xC000046BL)
+
+#define STATUS_FT_DI_SCAN_REQUIRED               ((NTSTATUS)0xC000046CL)
+
+#define STATUS_OBJECT_NOT_EXTERNALLY_BACKED      ((NTSTATUS)0xC000046DL)
+
+#define STATUS_EXTERNAL_BACKING_PROVIDER_UNKNOWN ((NTSTATUS)0xC000046EL)
+
+#define STATUS_COMPRESSION_NOT_BENEFICIAL        ((NTSTATUS)0xC000046FL)
+
+#define STATUS_DATA_CHECKSUM_ERROR               ((NTSTATUS)0xC0000470L)
+
+#define STATUS_INTERMIXED_KERNEL_EA_OPERATION    ((NTSTATUS)0xC0000471L)
+
+#define STATUS_TRIM_READ_ZERO_NOT_SUPPORTED      ((NTSTATUS)0xC0000472L)
+
+#define STATUS_TOO_MANY_SEGMENT_DESCRIPTORS      ((NTSTATUS)0xC0000473L)
+
+#define STATUS_INVALID_OFFSET_ALIGNMENT          ((NTSTATUS)0xC0000474L)
+
+#define STATUS_INVALID_FIELD_IN_PARAMETER_LIST   ((NTSTATUS)0xC0000475L)
+
+#define STATUS_OPERATION_IN_PROGRESS             ((NTSTATUS)0xC0000476L)
+
+#define STATUS_INVALID_INITIATOR_TARGET_PATH     ((NTSTATUS)0xC0000477L)
+
+#define STATUS_SCRUB_DATA_DISABLED               ((NTSTATUS)0xC0000478L)
+
+#define STATUS_NOT_REDUNDANT_STORAGE             ((NTSTATUS)0xC0000479L)
+
+#define STATUS_RESIDENT_FILE_NOT_SUPPORTED       ((NTSTATUS)0xC000047AL)
+
+#define STATUS_COMPRESSED_FILE_NOT_SUPPORTED     ((NTSTATUS)0xC000047BL)
+
+#define STATUS_DIRECTORY_NOT_SUPPORTED           ((NTSTATUS)0xC000047CL)
+
+#define STATUS_IO_OPERATION_TIMEOUT              ((NTSTATUS)0xC000047DL)
+
+#define STATUS_SYSTEM_NEEDS_REMEDIATION          ((NTSTATUS)0xC000047EL)
+
+#define STATUS_APPX_INTEGRITY_FAILURE_CLR_NGEN   ((NTSTATUS)0xC000047FL)
+
+#define STATUS_SHARE_UNAVAILABLE                 ((NTSTATUS)0xC0000480L)
+
+#define STATUS_APISET_NOT_HOSTED                 ((NTSTATUS)0xC0000481L)
+
+#define STATUS_APISET_NOT_PRESENT                ((NTSTATUS)0xC0000482L)
+
+#define STATUS_DEVICE_HARDWARE_ERROR             ((NTSTATUS)0xC0000483L)
+
+#define STATUS_FIRMWARE_SLOT_INVALID             ((NTSTATUS)0xC0000484L)
+
+#define STATUS_FIRMWARE_IMAGE_INVALID            ((NTSTATUS)0xC0000485L)
+
+#define STATUS_STORAGE_TOPOLOGY_ID_MISMATCH      ((NTSTATUS)0xC0000486L)
+
+#define STATUS_WIM_NOT_BOOTABLE                  ((NTSTATUS)0xC0000487L)
+
+#define STATUS_BLOCKED_BY_PARENTAL_CONTROLS      ((NTSTATUS)0xC0000488L)
+
+#define STATUS_NEEDS_REGISTRATION                ((NTSTATUS)0xC0000489L)
+
+#define STATUS_QUOTA_ACTIVITY                    ((NTSTATUS)0xC000048AL)
+
+#define STATUS_CALLBACK_INVOKE_INLINE            ((NTSTATUS)0xC000048BL)
+
+#define STATUS_BLOCK_TOO_MANY_REFERENCES         ((NTSTATUS)0xC000048CL)
+
+#define STATUS_MARKED_TO_DISALLOW_WRITES         ((NTSTATUS)0xC000048DL)
+
+#define STATUS_NETWORK_ACCESS_DENIED_EDP         ((NTSTATUS)0xC000048EL)
+
+#define STATUS_ENCLAVE_FAILURE                   ((NTSTATUS)0xC000048FL)
+
+#define STATUS_PNP_NO_COMPAT_DRIVERS             ((NTSTATUS)0xC0000490L)
+
+#define STATUS_PNP_DRIVER_PACKAGE_NOT_FOUND      ((NTSTATUS)0xC0000491L)
+
+#define STATUS_PNP_DRIVER_CONFIGURATION_NOT_FOUND ((NTSTATUS)0xC0000492L)
+
+#define STATUS_PNP_DRIVER_CONFIGURATION_INCOMPLETE ((NTSTATUS)0xC0000493L)
+
+#define STATUS_PNP_FUNCTION_DRIVER_REQUIRED      ((NTSTATUS)0xC0000494L)
+
+#define STATUS_PNP_DEVICE_CONFIGURATION_PENDING  ((NTSTATUS)0xC0000495L)
+
+#define STATUS_DEVICE_HINT_NAME_BUFFER_TOO_SMALL ((NTSTATUS)0xC0000496L)
+
+#define STATUS_PACKAGE_NOT_AVAILABLE             ((NTSTATUS)0xC0000497L)
+
+#define STATUS_DEVICE_IN_MAINTENANCE             ((NTSTATUS)0xC0000499L)
+
+#define STATUS_NOT_SUPPORTED_ON_DAX              ((NTSTATUS)0xC000049AL)
+
+#define STATUS_FREE_SPACE_TOO_FRAGMENTED         ((NTSTATUS)0xC000049BL)
+
+#define STATUS_DAX_MAPPING_EXISTS                ((NTSTATUS)0xC000049CL)
+
+#define STATUS_CHILD_PROCESS_BLOCKED             ((NTSTATUS)0xC000049DL)
+
+#define STATUS_STORAGE_LOST_DATA_PERSISTENCE     ((NTSTATUS)0xC000049EL)
+
+#define STATUS_VRF_CFG_ENABLED                   ((NTSTATUS)0xC000049FL)
+
+#define STATUS_PARTITION_TERMINATING             ((NTSTATUS)0xC00004A0L)
+
+#define STATUS_EXTERNAL_SYSKEY_NOT_SUPPORTED     ((NTSTATUS)0xC00004A1L)
+
+#define STATUS_ENCLAVE_VIOLATION                 ((NTSTATUS)0xC00004A2L)
+
+#define STATUS_FILE_PROTECTED_UNDER_DPL          ((NTSTATUS)0xC00004A3L)
+
+#define STATUS_VOLUME_NOT_CLUSTER_ALIGNED        ((NTSTATUS)0xC00004A4L)
+
+#define STATUS_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND ((NTSTATUS)0xC00004A5L)
+
+#define STATUS_APPX_FILE_NOT_ENCRYPTED           ((NTSTATUS)0xC00004A6L)
+
+#define STATUS_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED ((NTSTATUS)0xC00004A7L)
+
+#define STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET ((NTSTATUS)0xC00004A8L)
+
+#define STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE ((NTSTATUS)0xC00004A9L)
+
+#define STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER ((NTSTATUS)0xC00004AAL)
+
+#define STATUS_FT_READ_FAILURE                   ((NTSTATUS)0xC00004ABL)
+
+#define STATUS_PATCH_CONFLICT                    ((NTSTATUS)0xC00004ACL)
+
+#define STATUS_STORAGE_RESERVE_ID_INVALID        ((NTSTATUS)0xC00004ADL)
+
+#define STATUS_STORAGE_RESERVE_DOES_NOT_EXIST    ((NTSTATUS)0xC00004AEL)
+
+#define STATUS_STORAGE_RESERVE_ALREADY_EXISTS    ((NTSTATUS)0xC00004AFL)
+
+#define STATUS_STORAGE_RESERVE_NOT_EMPTY         ((NTSTATUS)0xC00004B0L)
+
+#define STATUS_NOT_A_DAX_VOLUME                  ((NTSTATUS)0xC00004B1L)
+
+#define STATUS_NOT_DAX_MAPPABLE                  ((NTSTATUS)0xC00004B2L)
+
+#define STATUS_CASE_DIFFERING_NAMES_IN_DIR       ((NTSTATUS)0xC00004B3L)
+
+#define STATUS_FILE_NOT_SUPPORTED                ((NTSTATUS)0xC00004B4L)
+
+#define STATUS_NOT_SUPPORTED_WITH_BTT            ((NTSTATUS)0xC00004B5L)
+
+#define STATUS_ENCRYPTION_DISABLED               ((NTSTATUS)0xC00004B6L)
+
+#define STATUS_ENCRYPTING_METADATA_DISALLOWED    ((NTSTATUS)0xC00004B7L)
+
+#define STATUS_CANT_CLEAR_ENCRYPTION_FLAG        ((NTSTATUS)0xC00004B8L)
+
+#define STATUS_INVALID_TASK_NAME                 ((NTSTATUS)0xC0000500L)
+
+#define STATUS_INVALID_TASK_INDEX                ((NTSTATUS)0xC0000501L)
+
+#define STATUS_THREAD_ALREADY_IN_TASK            ((NTSTATUS)0xC0000502L)
+
+#define STATUS_CALLBACK_BYPASS                   ((NTSTATUS)0xC0000503L)
+
+#define STATUS_UNDEFINED_SCOPE                   ((NTSTATUS)0xC0000504L)
+
+#define STATUS_INVALID_CAP                       ((NTSTATUS)0xC0000505L)
+
+#define STATUS_NOT_GUI_PROCESS                   ((NTSTATUS)0xC0000506L)
+
+#define STATUS_DEVICE_HUNG                       ((NTSTATUS)0xC0000507L)
+
+#define STATUS_CONTAINER_ASSIGNED                ((NTSTATUS)0xC0000508L)
+
+#define STATUS_JOB_NO_CONTAINER                  ((NTSTATUS)0xC0000509L)
+
+#define STATUS_DEVICE_UNRESPONSIVE               ((NTSTATUS)0xC000050AL)
+
+#define STATUS_REPARSE_POINT_ENCOUNTERED         ((NTSTATUS)0xC000050BL)
+
+#define STATUS_ATTRIBUTE_NOT_PRESENT             ((NTSTATUS)0xC000050CL)
+
+#define STATUS_NOT_A_TIERED_VOLUME               ((NTSTATUS)0xC000050DL)
+
+#define STATUS_ALREADY_HAS_STREAM_ID             ((NTSTATUS)0xC000050EL)
+
+#define STATUS_JOB_NOT_EMPTY                     ((NTSTATUS)0xC000050FL)
+
+#define STATUS_ALREADY_INITIALIZED               ((NTSTATUS)0xC0000510L)
+
+#define STATUS_ENCLAVE_NOT_TERMINATED            ((NTSTATUS)0xC0000511L)
+
+#define STATUS_ENCLAVE_IS_TERMINATING            ((NTSTATUS)0xC0000512L)
+
+#define STATUS_SMB1_NOT_AVAILABLE                ((NTSTATUS)0xC0000513L)
+
+#define STATUS_SMR_GARBAGE_COLLECTION_REQUIRED   ((NTSTATUS)0xC0000514L)
+
+#define STATUS_INTERRUPTED                       ((NTSTATUS)0xC0000515L)
+
+#define STATUS_THREAD_NOT_RUNNING                ((NTSTATUS)0xC0000516L)
+
+//
+// MessageId: STATUS_FAIL_FAST_EXCEPTION
+//
+// MessageText:
+//
+// {Fail Fast Exception} A fail fast exception occurred. Exception handlers
+// will not be invoked and the process will be terminated immediately.
+//
+#define STATUS_FAIL_FAST_EXCEPTION               ((NTSTATUS)0xC0000602L)
+
+#define STATUS_IMAGE_CERT_REVOKED                ((NTSTATUS)0xC0000603L)
+
+#define STATUS_DYNAMIC_CODE_BLOCKED              ((NTSTATUS)0xC0000604L)
+
+#define STATUS_IMAGE_CERT_EXPIRED                ((NTSTATUS)0xC0000605L)
+
+#define STATUS_STRICT_CFG_VIOLATION              ((NTSTATUS)0xC0000606L)
+
+#define STATUS_SET_CONTEXT_DENIED                ((NTSTATUS)0xC000060AL)
+
+#define STATUS_CROSS_PARTITION_VIOLATION         ((NTSTATUS)0xC000060BL)
+
+//
+// MessageId: STATUS_PORT_CLOSED
+//
+// MessageText:
+//
+// The ALPC port is closed.
+//
+#define STATUS_PORT_CLOSED                       ((NTSTATUS)0xC0000700L)
+
+#define STATUS_MESSAGE_LOST                      ((NTSTATUS)0xC0000701L)
+
+#define STATUS_INVALID_MESSAGE                   ((NTSTATUS)0xC0000702L)
+
+#define STATUS_REQUEST_CANCELED                  ((NTSTATUS)0xC0000703L)
+
+#define STATUS_RECURSIVE_DISPATCH                ((NTSTATUS)0xC0000704L)
+
+#define STATUS_LPC_RECEIVE_BUFFER_EXPECTED       ((NTSTATUS)0xC0000705L)
+
+#define STATUS_LPC_INVALID_CONNECTION_USAGE      ((NTSTATUS)0xC0000706L)
+
+#define STATUS_LPC_REQUESTS_NOT_ALLOWED          ((NTSTATUS)0xC0000707L)
+
+#define STATUS_RESOURCE_IN_USE                   ((NTSTATUS)0xC0000708L)
+
+#define STATUS_HARDWARE_MEMORY_ERROR             ((NTSTATUS)0xC0000709L)
+
+//
+// MessageId: STATUS_THREADPOOL_HANDLE_EXCEPTION
+//
+// MessageText:
+//
+// The thread pool callback exited while holding a handle that was not
+// released.
+//
+#define STATUS_THREADPOOL_HANDLE_EXCEPTION       ((NTSTATUS)0xC000070AL)
+
+#define STATUS_THREADPOOL_SET_EVENT_ON_COMPLETION_FAILED ((NTSTATUS)0xC000070BL)
+
+#define STATUS_THREADPOOL_RELEASE_SEMAPHORE_ON_COMPLETION_FAILED ((NTSTATUS)0xC000070CL)
+
+#define STATUS_THREADPOOL_RELEASE_MUTEX_ON_COMPLETION_FAILED ((NTSTATUS)0xC000070DL)
+
+#define STATUS_THREADPOOL_FREE_LIBRARY_ON_COMPLETION_FAILED ((NTSTATUS)0xC000070EL)
+
+#define STATUS_THREADPOOL_RELEASED_DURING_OPERATION ((NTSTATUS)0xC000070FL)
+
+//
+// MessageId: STATUS_CALLBACK_RETURNED_WHILE_IMPERSONATING
+//
+// MessageText:
+//
+// A threadpool worker thread entered a callback, which left with a thread that
+// was impersonating a client.
+//
+#define STATUS_CALLBACK_RETURNED_WHILE_IMPERSONATING ((NTSTATUS)0xC0000710L)
+
+//
+// MessageId: STATUS_APC_RETURNED_WHILE_IMPERSONATING
+//
+// MessageText:
+//
+// A thread that was impersonating a client returned from an asynchronous
+// procedure call (APC).
+//
+#define STATUS_APC_RETURNED_WHILE_IMPERSONATING  ((NTSTATUS)0xC0000711L)
+
+#define STATUS_PROCESS_IS_PROTECTED              ((NTSTATUS)0xC0000712L)
+
+#define STATUS_MCA_EXCEPTION                     ((NTSTATUS)0xC0000713L)
+
+#define STATUS_CERTIFICATE_MAPPING_NOT_UNIQUE    ((NTSTATUS)0xC0000714L)
+
+#define STATUS_SYMLINK_CLASS_DISABLED            ((NTSTATUS)0xC0000715L)
+
+#define STATUS_INVALID_IDN_NORMALIZATION         ((NTSTATUS)0xC0000716L)
+
+#define STATUS_NO_UNICODE_TRANSLATION            ((NTSTATUS)0xC0000717L)
+
+#define STATUS_ALREADY_REGISTERED                ((NTSTATUS)0xC0000718L)
+
+#define STATUS_CONTEXT_MISMATCH                  ((NTSTATUS)0xC0000719L)
+
+#define STATUS_PORT_ALREADY_HAS_COMPLETION_LIST  ((NTSTATUS)0xC000071AL)
+
+#define STATUS_CALLBACK_RETURNED_THREAD_PRIORITY ((NTSTATUS)0xC000071BL)
+
+#define STATUS_INVALID_THREAD                    ((NTSTATUS)0xC000071CL)
+
+#define STATUS_CALLBACK_RETURNED_TRANSACTION     ((NTSTATUS)0xC000071DL)
+
+#define STATUS_CALLBACK_RETURNED_LDR_LOCK        ((NTSTATUS)0xC000071EL)
+
+#define STATUS_CALLBACK_RETURNED_LANG            ((NTSTATUS)0xC000071FL)
+
+#define STATUS_CALLBACK_RETURNED_PRI_BACK        ((NTSTATUS)0xC0000720L)
+
+#define STATUS_CALLBACK_RETURNED_THREAD_AFFINITY ((NTSTATUS)0xC0000721L)
+
+#define STATUS_LPC_HANDLE_COUNT_EXCEEDED         ((NTSTATUS)0xC0000722L)
+
+#define STATUS_EXECUTABLE_MEMORY_WRITE           ((NTSTATUS)0xC0000723L)
+
+#define STATUS_KERNEL_EXECUTABLE_MEMORY_WRITE    ((NTSTATUS)0xC0000724L)
+
+#define STATUS_ATTACHED_EXECUTABLE_MEMORY_WRITE  ((NTSTATUS)0xC0000725L)
+
+#define STATUS_TRIGGERED_EXECUTABLE_MEMORY_WRITE ((NTSTATUS)0xC0000726L)
+
+#define STATUS_DISK_REPAIR_DISABLED              ((NTSTATUS)0xC0000800L)
+
+#define STATUS_DS_DOMAIN_RENAME_IN_PROGRESS      ((NTSTATUS)0xC0000801L)
+
+#define STATUS_DISK_QUOTA_EXCEEDED               ((NTSTATUS)0xC0000802L)
+
+#define STATUS_DATA_LOST_REPAIR                  ((NTSTATUS)0x80000803L)
+
+#define STATUS_CONTENT_BLOCKED                   ((NTSTATUS)0xC0000804L)
+
+#define STATUS_BAD_CLUSTERS                      ((NTSTATUS)0xC0000805L)
+
+#define STATUS_VOLUME_DIRTY                      ((NTSTATUS)0xC0000806L)
+
+#define STATUS_DISK_REPAIR_REDIRECTED            ((NTSTATUS)0x40000807L)
+
+#define STATUS_DISK_REPAIR_UNSUCCESSFUL          ((NTSTATUS)0xC0000808L)
+
+#define STATUS_CORRUPT_LOG_OVERFULL              ((NTSTATUS)0xC0000809L)
+
+#define STATUS_CORRUPT_LOG_CORRUPTED             ((NTSTATUS)0xC000080AL)
+
+#define STATUS_CORRUPT_LOG_UNAVAILABLE           ((NTSTATUS)0xC000080BL)
+
+#define STATUS_CORRUPT_LOG_DELETED_FULL          ((NTSTATUS)0xC000080CL)
+
+#define STATUS_CORRUPT_LOG_CLEARED               ((NTSTATUS)0xC000080DL)
+
+#define STATUS_ORPHAN_NAME_EXHAUSTED             ((NTSTATUS)0xC000080EL)
+
+#define STATUS_PROACTIVE_SCAN_IN_PROGRESS        ((NTSTATUS)0xC000080FL)
+
+#define STATUS_ENCRYPTED_IO_NOT_POSSIBLE         ((NTSTATUS)0xC0000810L)
+
+#define STATUS_CORRUPT_LOG_UPLEVEL_RECORDS       ((NTSTATUS)0xC0000811L)
+
+#define STATUS_FILE_CHECKED_OUT                  ((NTSTATUS)0xC0000901L)
+
+#define STATUS_CHECKOUT_REQUIRED                 ((NTSTATUS)0xC0000902L)
+
+#define STATUS_BAD_FILE_TYPE                     ((NTSTATUS)0xC0000903L)
+
+#define STATUS_FILE_TOO_LARGE                    ((NTSTATUS)0xC0000904L)
+
+#define STATUS_FORMS_AUTH_REQUIRED               ((NTSTATUS)0xC0000905L)
+
+//
+// MessageId: STATUS_VIRUS_INFECTED
+//
+// MessageText:
+//
+// The operation did not complete successfully because the file contains a
+// virus.
+//
+#define STATUS_VIRUS_INFECTED                    ((NTSTATUS)0xC0000906L)
+
+//
+// MessageId: STATUS_VIRUS_DELETED
+//
+// MessageText:
+//
+// This file contains a virus and was deleted.
+//
+#define STATUS_VIRUS_DELETED                     ((NTSTATUS)0xC0000907L)
+
+#define STATUS_BAD_MCFG_TABLE                    ((NTSTATUS)0xC0000908L)
+
+#define STATUS_CANNOT_BREAK_OPLOCK               ((NTSTATUS)0xC0000909L)
+
+#define STATUS_BAD_KEY                           ((NTSTATUS)0xC000090AL)
+
+#define STATUS_BAD_DATA                          ((NTSTATUS)0xC000090BL)
+
+#define STATUS_NO_KEY                            ((NTSTATUS)0xC000090CL)
+
+#define STATUS_FILE_HANDLE_REVOKED               ((NTSTATUS)0xC0000910L)
+
+#define STATUS_WOW_ASSERTION                     ((NTSTATUS)0xC0009898L)
+
+#define STATUS_INVALID_SIGNATURE                 ((NTSTATUS)0xC000A000L)
+
+#define STATUS_HMAC_NOT_SUPPORTED                ((NTSTATUS)0xC000A001L)
+
+#define STATUS_AUTH_TAG_MISMATCH                 ((NTSTATUS)0xC000A002L)
+
+#define STATUS_INVALID_STATE_TRANSITION          ((NTSTATUS)0xC000A003L)
+
+#define STATUS_INVALID_KERNEL_INFO_VERSION       ((NTSTATUS)0xC000A004L)
+
+#define STATUS_INVALID_PEP_INFO_VERSION          ((NTSTATUS)0xC000A005L)
+
+#define STATUS_HANDLE_REVOKED                    ((NTSTATUS)0xC000A006L)
+
+#define STATUS_EOF_ON_GHOSTED_RANGE              ((NTSTATUS)0xC000A007L)
+
+#define STATUS_IPSEC_QUEUE_OVERFLOW              ((NTSTATUS)0xC000A010L)
+
+#define STATUS_ND_QUEUE_OVERFLOW                 ((NTSTATUS)0xC000A011L)
+
+#define STATUS_HOPLIMIT_EXCEEDED                 ((NTSTATUS)0xC000A012L)
+
+#define STATUS_PROTOCOL_NOT_SUPPORTED            ((NTSTATUS)0xC000A013L)
+
+#define STATUS_FASTPATH_REJECTED                 ((NTSTATUS)0xC000A014L)
+
+#define STATUS_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED ((NTSTATUS)0xC000A080L)
+
+#define STATUS_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR ((NTSTATUS)0xC000A081L)
+
+#define STATUS_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR ((NTSTATUS)0xC000A082L)
+
+#define STATUS_XML_PARSE_ERROR                   ((NTSTATUS)0xC000A083L)
+
+#define STATUS_XMLDSIG_ERROR                     ((NTSTATUS)0xC000A084L)
+
+#define STATUS_WRONG_COMPARTMENT                 ((NTSTATUS)0xC000A085L)
+
+#define STATUS_AUTHIP_FAILURE                    ((NTSTATUS)0xC000A086L)
+
+#define STATUS_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS ((NTSTATUS)0xC000A087L)
+
+#define STATUS_DS_OID_NOT_FOUND                  ((NTSTATUS)0xC000A088L)
+
+#define STATUS_INCORRECT_ACCOUNT_TYPE            ((NTSTATUS)0xC000A089L)
+
+#define STATUS_HASH_NOT_SUPPORTED                ((NTSTATUS)0xC000A100L)
+
+#define STATUS_HASH_NOT_PRESENT                  ((NTSTATUS)0xC000A101L)
+
+#define STATUS_SECONDARY_IC_PROVIDER_NOT_REGISTERED ((NTSTATUS)0xC000A121L)
+
+#define STATUS_GPIO_CLIENT_INFORMATION_INVALID   ((NTSTATUS)0xC000A122L)
+
+#define STATUS_GPIO_VERSION_NOT_SUPPORTED        ((NTSTATUS)0xC000A123L)
+
+#define STATUS_GPIO_INVALID_REGISTRATION_PACKET  ((NTSTATUS)0xC000A124L)
+
+#define STATUS_GPIO_OPERATION_DENIED             ((NTSTATUS)0xC000A125L)
+
+#define STATUS_GPIO_INCOMPATIBLE_CONNECT_MODE    ((NTSTATUS)0xC000A126L)
+
+#define STATUS_GPIO_INTERRUPT_ALREADY_UNMASKED   ((NTSTATUS)0x8000A127L)
+
+#define STATUS_CANNOT_SWITCH_RUNLEVEL            ((NTSTATUS)0xC000A141L)
+
+#define STATUS_INVALID_RUNLEVEL_SETTING          ((NTSTATUS)0xC000A142L)
+
+#define STATUS_RUNLEVEL_SWITCH_TIMEOUT           ((NTSTATUS)0xC000A143L)
+
+#define STATUS_SERVICES_FAILED_AUTOSTART         ((NTSTATUS)0x4000A144L)
+
+#define STATUS_RUNLEVEL_SWITCH_AGENT_TIMEOUT     ((NTSTATUS)0xC000A145L)
+
+#define STATUS_RUNLEVEL_SWITCH_IN_PROGRESS       ((NTSTATUS)0xC000A146L)
+
+#define STATUS_NOT_APPCONTAINER                  ((NTSTATUS)0xC000A200L)
+
+#define STATUS_NOT_SUPPORTED_IN_APPCONTAINER     ((NTSTATUS)0xC000A201L)
+
+#define STATUS_INVALID_PACKAGE_SID_LENGTH        ((NTSTATUS)0xC000A202L)
+
+//
+// MessageId: STATUS_LPAC_ACCESS_DENIED
+//
+// MessageText:
+//
+// The process has been denied access because it is a less privileged
+// AppContainer.
+//
+#define STATUS_LPAC_ACCESS_DENIED                ((NTSTATUS)0xC000A203L)
+
+#define STATUS_ADMINLESS_ACCESS_DENIED           ((NTSTATUS)0xC000A204L)
+
+#define STATUS_APP_DATA_NOT_FOUND                ((NTSTATUS)0xC000A281L)
+
+#define STATUS_APP_DATA_EXPIRED                  ((NTSTATUS)0xC000A282L)
+
+#define STATUS_APP_DATA_CORRUPT                  ((NTSTATUS)0xC000A283L)
+
+#define STATUS_APP_DATA_LIMIT_EXCEEDED           ((NTSTATUS)0xC000A284L)
+
+#define STATUS_APP_DATA_REBOOT_REQUIRED          ((NTSTATUS)0xC000A285L)
+
+#define STATUS_OFFLOAD_READ_FLT_NOT_SUPPORTED    ((NTSTATUS)0xC000A2A1L)
+
+#define STATUS_OFFLOAD_WRITE_FLT_NOT_SUPPORTED   ((NTSTATUS)0xC000A2A2L)
+
+#define STATUS_OFFLOAD_READ_FILE_NOT_SUPPORTED   ((NTSTATUS)0xC000A2A3L)
+
+#define STATUS_OFFLOAD_WRITE_FILE_NOT_SUPPORTED  ((NTSTATUS)0xC000A2A4L)
+
+#define STATUS_WOF_WIM_HEADER_CORRUPT            ((NTSTATUS)0xC000A2A5L)
+
+#define STATUS_WOF_WIM_RESOURCE_TABLE_CORRUPT    ((NTSTATUS)0xC000A2A6L)
+
+#define STATUS_WOF_FILE_RESOURCE_TABLE_CORRUPT   ((NTSTATUS)0xC000A2A7L)
+
+#define STATUS_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE ((NTSTATUS)0xC000CE01L)
+
+#define STATUS_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT ((NTSTATUS)0xC000CE02L)
+
+#define STATUS_FILE_SYSTEM_VIRTUALIZATION_BUSY   ((NTSTATUS)0xC000CE03L)
+
+#define STATUS_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN ((NTSTATUS)0xC000CE04L)
+
+#define STATUS_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION ((NTSTATUS)0xC000CE05L)
+
+#define STATUS_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT ((NTSTATUS)0xC000CF00L)
+
+#define STATUS_CLOUD_FILE_PROVIDER_NOT_RUNNING   ((NTSTATUS)0xC000CF01L)
+
+#define STATUS_CLOUD_FILE_METADATA_CORRUPT       ((NTSTATUS)0xC000CF02L)
+
+#define STATUS_CLOUD_FILE_METADATA_TOO_LARGE     ((NTSTATUS)0xC000CF03L)
+
+#define STATUS_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE ((NTSTATUS)0x8000CF04L)
+
+#define STATUS_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS ((NTSTATUS)0x8000CF05L)
+
+#define STATUS_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED ((NTSTATUS)0xC000CF06L)
+
+#define STATUS_NOT_A_CLOUD_FILE                  ((NTSTATUS)0xC000CF07L)
+
+#define STATUS_CLOUD_FILE_NOT_IN_SYNC            ((NTSTATUS)0xC000CF08L)
+
+#define STATUS_CLOUD_FILE_ALREADY_CONNECTED      ((NTSTATUS)0xC000CF09L)
+
+#define STATUS_CLOUD_FILE_NOT_SUPPORTED          ((NTSTATUS)0xC000CF0AL)
+
+#define STATUS_CLOUD_FILE_INVALID_REQUEST        ((NTSTATUS)0xC000CF0BL)
+
+#define STATUS_CLOUD_FILE_READ_ONLY_VOLUME       ((NTSTATUS)0xC000CF0CL)
+
+#define STATUS_CLOUD_FILE_CONNECTED_PROVIDER_ONLY ((NTSTATUS)0xC000CF0DL)
+
+#define STATUS_CLOUD_FILE_VALIDATION_FAILED      ((NTSTATUS)0xC000CF0EL)
+
+#define STATUS_CLOUD_FILE_AUTHENTICATION_FAILED  ((NTSTATUS)0xC000CF0FL)
+
+#define STATUS_CLOUD_FILE_INSUFFICIENT_RESOURCES ((NTSTATUS)0xC000CF10L)
+
+#define STATUS_CLOUD_FILE_NETWORK_UNAVAILABLE    ((NTSTATUS)0xC000CF11L)
+
+#define STATUS_CLOUD_FILE_UNSUCCESSFUL           ((NTSTATUS)0xC000CF12L)
+
+#define STATUS_CLOUD_FILE_NOT_UNDER_SYNC_ROOT    ((NTSTATUS)0xC000CF13L)
+
+#define STATUS_CLOUD_FILE_IN_USE                 ((NTSTATUS)0xC000CF14L)
+
+#define STATUS_CLOUD_FILE_PINNED                 ((NTSTATUS)0xC000CF15L)
+
+#define STATUS_CLOUD_FILE_REQUEST_ABORTED        ((NTSTATUS)0xC000CF16L)
+
+#define STATUS_CLOUD_FILE_PROPERTY_CORRUPT       ((NTSTATUS)0xC000CF17L)
+
+#define STATUS_CLOUD_FILE_ACCESS_DENIED          ((NTSTATUS)0xC000CF18L)
+
+#define STATUS_CLOUD_FILE_INCOMPATIBLE_HARDLINKS ((NTSTATUS)0xC000CF19L)
+
+#define STATUS_CLOUD_FILE_PROPERTY_LOCK_CONFLICT ((NTSTATUS)0xC000CF1AL)
+
+#define STATUS_CLOUD_FILE_REQUEST_CANCELED       ((NTSTATUS)0xC000CF1BL)
+
+#define STATUS_CLOUD_FILE_PROVIDER_TERMINATED    ((NTSTATUS)0xC000CF1DL)
+
+#define STATUS_NOT_A_CLOUD_SYNC_ROOT             ((NTSTATUS)0xC000CF1EL)
+
+#define STATUS_CLOUD_FILE_REQUEST_TIMEOUT        ((NTSTATUS)0xC000CF1FL)
+
+#define STATUS_ACPI_INVALID_OPCODE               ((NTSTATUS)0xC0140001L)
+
+#define STATUS_ACPI_STACK_OVERFLOW               ((NTSTATUS)0xC0140002L)
+
+#define STATUS_ACPI_ASSERT_FAILED                ((NTSTATUS)0xC0140003L)
+
+#define STATUS_ACPI_INVALID_INDEX                ((NTSTATUS)0xC0140004L)
+
+#define STATUS_ACPI_INVALID_ARGUMENT             ((NTSTATUS)0xC0140005L)
+
+#define STATUS_ACPI_FATAL                        ((NTSTATUS)0xC0140006L)
+
+#define STATUS_ACPI_INVALID_SUPERNAME            ((NTSTATUS)0xC0140007L)
+
+#define STATUS_ACPI_INVALID_ARGTYPE              ((NTSTATUS)0xC0140008L)
+
+#define STATUS_ACPI_INVALID_OBJTYPE              ((NTSTATUS)0xC0140009L)
+
+#define STATUS_ACPI_INVALID_TARGETTYPE           ((NTSTATUS)0xC014000AL)
+
+#define STATUS_ACPI_INCORRECT_ARGUMENT_COUNT     ((NTSTATUS)0xC014000BL)
+
+#define STATUS_ACPI_ADDRESS_NOT_MAPPED           ((NTSTATUS)0xC014000CL)
+
+#define STATUS_ACPI_INVALID_EVENTTYPE            ((NTSTATUS)0xC014000DL)
+
+#define STATUS_ACPI_HANDLER_COLLISION            ((NTSTATUS)0xC014000EL)
+
+#define STATUS_ACPI_INVALID_DATA                 ((NTSTATUS)0xC014000FL)
+
+#define STATUS_ACPI_INVALID_REGION               ((NTSTATUS)0xC0140010L)
+
+#define STATUS_ACPI_INVALID_ACCESS_SIZE          ((NTSTATUS)0xC0140011L)
+
+#define STATUS_ACPI_ACQUIRE_GLOBAL_LOCK          ((NTSTATUS)0xC0140012L)
+
+#define STATUS_ACPI_ALREADY_INITIALIZED          ((NTSTATUS)0xC0140013L)
+
+#define STATUS_ACPI_NOT_INITIALIZED              ((NTSTATUS)0xC0140014L)
+
+#define STATUS_ACPI_INVALID_MUTEX_LEVEL          ((NTSTATUS)0xC0140015L)
+
+#define STATUS_ACPI_MUTEX_NOT_OWNED              ((NTSTATUS)0xC0140016L)
+
+#define STATUS_ACPI_MUTEX_NOT_OWNER              ((NTSTATUS)0xC0140017L)
+
+#define STATUS_ACPI_RS_ACCESS                    ((NTSTATUS)0xC0140018L)
+
+#define STATUS_ACPI_INVALID_TABLE                ((NTSTATUS)0xC0140019L)
+
+#define STATUS_ACPI_REG_HANDLER_FAILED           ((NTSTATUS)0xC0140020L)
+
+#define STATUS_ACPI_POWER_REQUEST_FAILED         ((NTSTATUS)0xC0140021L)
+
+#define STATUS_CTX_WINSTATION_NAME_INVALID       ((NTSTATUS)0xC00A0001L)
+
+#define STATUS_CTX_INVALID_PD                    ((NTSTATUS)0xC00A0002L)
+
+#define STATUS_CTX_PD_NOT_FOUND                  ((NTSTATUS)0xC00A0003L)
+
+#define STATUS_CTX_CDM_CONNECT                   ((NTSTATUS)0x400A0004L)
+
+#define STATUS_CTX_CDM_DISCONNECT                ((NTSTATUS)0x400A0005L)
+
+#define STATUS_CTX_CLOSE_PENDING                 ((NTSTATUS)0xC00A0006L)
+
+#define STATUS_CTX_NO_OUTBUF                     ((NTSTATUS)0xC00A0007L)
+
+#define STATUS_CTX_MODEM_INF_NOT_FOUND           ((NTSTATUS)0xC00A0008L)
+
+#define STATUS_CTX_INVALID_MODEMNAME             ((NTSTATUS)0xC00A0009L)
+
+#define STATUS_CTX_RESPONSE_ERROR                ((NTSTATUS)0xC00A000AL)
+
+#define STATUS_CTX_MODEM_RESPONSE_TIMEOUT        ((NTSTATUS)0xC00A000BL)
+
+#define STATUS_CTX_MODEM_RESPONSE_NO_CARRIER     ((NTSTATUS)0xC00A000CL)
+
+#define STATUS_CTX_MODEM_RESPONSE_NO_DIALTONE    ((NTSTATUS)0xC00A000DL)
+
+#define STATUS_CTX_MODEM_RESPONSE_BUSY           ((NTSTATUS)0xC00A000EL)
+
+#define STATUS_CTX_MODEM_RESPONSE_VOICE          ((NTSTATUS)0xC00A000FL)
+
+#define STATUS_CTX_TD_ERROR                      ((NTSTATUS)0xC00A0010L)
+
+#define STATUS_CTX_LICENSE_CLIENT_INVALID        ((NTSTATUS)0xC00A0012L)
+
+#define STATUS_CTX_LICENSE_NOT_AVAILABLE         ((NTSTATUS)0xC00A0013L)
+
+#define STATUS_CTX_LICENSE_EXPIRED               ((NTSTATUS)0xC00A0014L)
+
+#define STATUS_CTX_WINSTATION_NOT_FOUND          ((NTSTATUS)0xC00A0015L)
+
+#define STATUS_CTX_WINSTATION_NAME_COLLISION     ((NTSTATUS)0xC00A0016L)
+
+#define STATUS_CTX_WINSTATION_BUSY               ((NTSTATUS)0xC00A0017L)
+
+#define STATUS_CTX_BAD_VIDEO_MODE                ((NTSTATUS)0xC00A0018L)
+
+#define STATUS_CTX_GRAPHICS_INVALID              ((NTSTATUS)0xC00A0022L)
+
+#define STATUS_CTX_NOT_CONSOLE                   ((NTSTATUS)0xC00A0024L)
+
+#define STATUS_CTX_CLIENT_QUERY_TIMEOUT          ((NTSTATUS)0xC00A0026L)
+
+#define STATUS_CTX_CONSOLE_DISCONNECT            ((NTSTATUS)0xC00A0027L)
+
+#define STATUS_CTX_CONSOLE_CONNECT               ((NTSTATUS)0xC00A0028L)
+
+#define STATUS_CTX_SHADOW_DENIED                 ((NTSTATUS)0xC00A002AL)
+
+#define STATUS_CTX_WINSTATION_ACCESS_DENIED      ((NTSTATUS)0xC00A002BL)
+
+#define STATUS_CTX_INVALID_WD                    ((NTSTATUS)0xC00A002EL)
+
+#define STATUS_CTX_WD_NOT_FOUND                  ((NTSTATUS)0xC00A002FL)
+
+#define STATUS_CTX_SHADOW_INVALID                ((NTSTATUS)0xC00A0030L)
+
+#define STATUS_CTX_SHADOW_DISABLED               ((NTSTATUS)0xC00A0031L)
+
+#define STATUS_RDP_PROTOCOL_ERROR                ((NTSTATUS)0xC00A0032L)
+
+#define STATUS_CTX_CLIENT_LICENSE_NOT_SET        ((NTSTATUS)0xC00A0033L)
+
+#define STATUS_CTX_CLIENT_LICENSE_IN_USE         ((NTSTATUS)0xC00A0034L)
+
+#define STATUS_CTX_SHADOW_ENDED_BY_MODE_CHANGE   ((NTSTATUS)0xC00A0035L)
+
+#define STATUS_CTX_SHADOW_NOT_RUNNING            ((NTSTATUS)0xC00A0036L)
+
+#define STATUS_CTX_LOGON_DISABLED                ((NTSTATUS)0xC00A0037L)
+
+#define STATUS_CTX_SECURITY_LAYER_ERROR          ((NTSTATUS)0xC00A0038L)
+
+#define STATUS_TS_INCOMPATIBLE_SESSIONS          ((NTSTATUS)0xC00A0039L)
+
+#define STATUS_TS_VIDEO_SUBSYSTEM_ERROR          ((NTSTATUS)0xC00A003AL)
+
+#define STATUS_PNP_BAD_MPS_TABLE                 ((NTSTATUS)0xC0040035L)
+
+#define STATUS_PNP_TRANSLATION_FAILED            ((NTSTATUS)0xC0040036L)
+
+#define STATUS_PNP_IRQ_TRANSLATION_FAILED        ((NTSTATUS)0xC0040037L)
+
+#define STATUS_PNP_INVALID_ID                    ((NTSTATUS)0xC0040038L)
+
+#define STATUS_IO_REISSUE_AS_CACHED              ((NTSTATUS)0xC0040039L)
+
+#define STATUS_MUI_FILE_NOT_FOUND                ((NTSTATUS)0xC00B0001L)
+
+#define STATUS_MUI_INVALID_FILE                  ((NTSTATUS)0xC00B0002L)
+
+#define STATUS_MUI_INVALID_RC_CONFIG             ((NTSTATUS)0xC00B0003L)
+
+#define STATUS_MUI_INVALID_LOCALE_NAME           ((NTSTATUS)0xC00B0004L)
+
+#define STATUS_MUI_INVALID_ULTIMATEFALLBACK_NAME ((NTSTATUS)0xC00B0005L)
+
+#define STATUS_MUI_FILE_NOT_LOADED               ((NTSTATUS)0xC00B0006L)
+
+#define STATUS_RESOURCE_ENUM_USER_STOP           ((NTSTATUS)0xC00B0007L)
+
+#define STATUS_FLT_NO_HANDLER_DEFINED            ((NTSTATUS)0xC01C0001L)
+
+#define STATUS_FLT_CONTEXT_ALREADY_DEFINED       ((NTSTATUS)0xC01C0002L)
+
+#define STATUS_FLT_INVALID_ASYNCHRONOUS_REQUEST  ((NTSTATUS)0xC01C0003L)
+
+#define STATUS_FLT_DISALLOW_FAST_IO              ((NTSTATUS)0xC01C0004L)
+
+#define STATUS_FLT_INVALID_NAME_REQUEST          ((NTSTATUS)0xC01C0005L)
+
+#define STATUS_FLT_NOT_SAFE_TO_POST_OPERATION    ((NTSTATUS)0xC01C0006L)
+
+#define STATUS_FLT_NOT_INITIALIZED               ((NTSTATUS)0xC01C0007L)
+
+#define STATUS_FLT_FILTER_NOT_READY              ((NTSTATUS)0xC01C0008L)
+
+#define STATUS_FLT_POST_OPERATION_CLEANUP        ((NTSTATUS)0xC01C0009L)
+
+#define STATUS_FLT_INTERNAL_ERROR                ((NTSTATUS)0xC01C000AL)
+
+#define STATUS_FLT_DELETING_OBJECT               ((NTSTATUS)0xC01C000BL)
+
+#define STATUS_FLT_MUST_BE_NONPAGED_POOL         ((NTSTATUS)0xC01C000CL)
+
+#define STATUS_FLT_DUPLICATE_ENTRY               ((NTSTATUS)0xC01C000DL)
+
+#define STATUS_FLT_CBDQ_DISABLED                 ((NTSTATUS)0xC01C000EL)
+
+#define STATUS_FLT_DO_NOT_ATTACH                 ((NTSTATUS)0xC01C000FL)
+
+#define STATUS_FLT_DO_NOT_DETACH                 ((NTSTATUS)0xC01C0010L)
+
+#define STATUS_FLT_INSTANCE_ALTITUDE_COLLISION   ((NTSTATUS)0xC01C0011L)
+
+#define STATUS_FLT_INSTANCE_NAME_COLLISION       ((NTSTATUS)0xC01C0012L)
+
+#define STATUS_FLT_FILTER_NOT_FOUND              ((NTSTATUS)0xC01C0013L)
+
+#define STATUS_FLT_VOLUME_NOT_FOUND              ((NTSTATUS)0xC01C0014L)
+
+#define STATUS_FLT_INSTANCE_NOT_FOUND            ((NTSTATUS)0xC01C0015L)
+
+#define STATUS_FLT_CONTEXT_ALLOCATION_NOT_FOUND  ((NTSTATUS)0xC01C0016L)
+
+#define STATUS_FLT_INVALID_CONTEXT_REGISTRATION  ((NTSTATUS)0xC01C0017L)
+
+#define STATUS_FLT_NAME_CACHE_MISS               ((NTSTATUS)0xC01C0018L)
+
+#define STATUS_FLT_NO_DEVICE_OBJECT              ((NTSTATUS)0xC01C0019L)
+
+#define STATUS_FLT_VOLUME_ALREADY_MOUNTED        ((NTSTATUS)0xC01C001AL)
+
+#define STATUS_FLT_ALREADY_ENLISTED              ((NTSTATUS)0xC01C001BL)
+
+#define STATUS_FLT_CONTEXT_ALREADY_LINKED        ((NTSTATUS)0xC01C001CL)
+
+#define STATUS_FLT_NO_WAITER_FOR_REPLY           ((NTSTATUS)0xC01C0020L)
+
+#define STATUS_FLT_REGISTRATION_BUSY             ((NTSTATUS)0xC01C0023L)
+
+#define STATUS_SXS_SECTION_NOT_FOUND             ((NTSTATUS)0xC0150001L)
+
+#define STATUS_SXS_CANT_GEN_ACTCTX               ((NTSTATUS)0xC0150002L)
+
+#define STATUS_SXS_INVALID_ACTCTXDATA_FORMAT     ((NTSTATUS)0xC0150003L)
+
+#define STATUS_SXS_ASSEMBLY_NOT_FOUND            ((NTSTATUS)0xC0150004L)
+
+#define STATUS_SXS_MANIFEST_FORMAT_ERROR         ((NTSTATUS)0xC0150005L)
+
+#define STATUS_SXS_MANIFEST_PARSE_ERROR          ((NTSTATUS)0xC0150006L)
+
+#define STATUS_SXS_ACTIVATION_CONTEXT_DISABLED   ((NTSTATUS)0xC0150007L)
+
+#define STATUS_SXS_KEY_NOT_FOUND                 ((NTSTATUS)0xC0150008L)
+
+#define STATUS_SXS_VERSION_CONFLICT              ((NTSTATUS)0xC0150009L)
+
+#define STATUS_SXS_WRONG_SECTION_TYPE            ((NTSTATUS)0xC015000AL)
+
+#define STATUS_SXS_THREAD_QUERIES_DISABLED       ((NTSTATUS)0xC015000BL)
+
+#define STATUS_SXS_ASSEMBLY_MISSING              ((NTSTATUS)0xC015000CL)
+
+#define STATUS_SXS_RELEASE_ACTIVATION_CONTEXT    ((NTSTATUS)0x4015000DL)
+
+#define STATUS_SXS_PROCESS_DEFAULT_ALREADY_SET   ((NTSTATUS)0xC015000EL)
+
+#define STATUS_SXS_EARLY_DEACTIVATION            ((NTSTATUS)0xC015000FL)
+
+#define STATUS_SXS_INVALID_DEACTIVATION          ((NTSTATUS)0xC0150010L)
+
+#define STATUS_SXS_MULTIPLE_DEACTIVATION         ((NTSTATUS)0xC0150011L)
+
+#define STATUS_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY ((NTSTATUS)0xC0150012L)
+
+#define STATUS_SXS_PROCESS_TERMINATION_REQUESTED ((NTSTATUS)0xC0150013L)
+
+#define STATUS_SXS_CORRUPT_ACTIVATION_STACK      ((NTSTATUS)0xC0150014L)
+
+#define STATUS_SXS_CORRUPTION                    ((NTSTATUS)0xC0150015L)
+
+#define STATUS_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE ((NTSTATUS)0xC0150016L)
+
+#define STATUS_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME ((NTSTATUS)0xC0150017L)
+
+#define STATUS_SXS_IDENTITY_DUPLICATE_ATTRIBUTE  ((NTSTATUS)0xC0150018L)
+
+#define STATUS_SXS_IDENTITY_PARSE_ERROR          ((NTSTATUS)0xC0150019L)
+
+#define STATUS_SXS_COMPONENT_STORE_CORRUPT       ((NTSTATUS)0xC015001AL)
+
+#define STATUS_SXS_FILE_HASH_MISMATCH            ((NTSTATUS)0xC015001BL)
+
+#define STATUS_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT ((NTSTATUS)0xC015001CL)
+
+#define STATUS_SXS_IDENTITIES_DIFFERENT          ((NTSTATUS)0xC015001DL)
+
+#define STATUS_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT  ((NTSTATUS)0xC015001EL)
+
+#define STATUS_SXS_FILE_NOT_PART_OF_ASSEMBLY     ((NTSTATUS)0xC015001FL)
+
+#define STATUS_ADVANCED_INSTALLER_FAILED         ((NTSTATUS)0xC0150020L)
+
+#define STATUS_XML_ENCODING_MISMATCH             ((NTSTATUS)0xC0150021L)
+
+#define STATUS_SXS_MANIFEST_TOO_BIG              ((NTSTATUS)0xC0150022L)
+
+#define STATUS_SXS_SETTING_NOT_REGISTERED        ((NTSTATUS)0xC0150023L)
+
+#define STATUS_SXS_TRANSACTION_CLOSURE_INCOMPLETE ((NTSTATUS)0xC0150024L)
+
+#define STATUS_SMI_PRIMITIVE_INSTALLER_FAILED    ((NTSTATUS)0xC0150025L)
+
+#define STATUS_GENERIC_COMMAND_FAILED            ((NTSTATUS)0xC0150026L)
+
+#define STATUS_SXS_FILE_HASH_MISSING             ((NTSTATUS)0xC0150027L)
+
+#define STATUS_CLUSTER_INVALID_NODE              ((NTSTATUS)0xC0130001L)
+
+#define STATUS_CLUSTER_NODE_EXISTS               ((NTSTATUS)0xC0130002L)
+
+#define STATUS_CLUSTER_JOIN_IN_PROGRESS          ((NTSTATUS)0xC0130003L)
+
+#define STATUS_CLUSTER_NODE_NOT_FOUND            ((NTSTATUS)0xC0130004L)
+
+#define STATUS_CLUSTER_LOCAL_NODE_NOT_FOUND      ((NTSTATUS)0xC0130005L)
+
+#define STATUS_CLUSTER_NETWORK_EXISTS            ((NTSTATUS)0xC0130006L)
+
+#define STATUS_CLUSTER_NETWORK_NOT_FOUND         ((NTSTATUS)0xC0130007L)
+
+#define STATUS_CLUSTER_NETINTERFACE_EXISTS       ((NTSTATUS)0xC0130008L)
+
+#define STATUS_CLUSTER_NETINTERFACE_NOT_FOUND    ((NTSTATUS)0xC0130009L)
+
+#define STATUS_CLUSTER_INVALID_REQUEST           ((NTSTATUS)0xC013000AL)
+
+#define STATUS_CLUSTER_INVALID_NETWORK_PROVIDER  ((NTSTATUS)0xC013000BL)
+
+#define STATUS_CLUSTER_NODE_DOWN                 ((NTSTATUS)0xC013000CL)
+
+#define STATUS_CLUSTER_NODE_UNREACHABLE          ((NTSTATUS)0xC013000DL)
+
+#define STATUS_CLUSTER_NODE_NOT_MEMBER           ((NTSTATUS)0xC013000EL)
+
+#define STATUS_CLUSTER_JOIN_NOT_IN_PROGRESS      ((NTSTATUS)0xC013000FL)
+
+#define STATUS_CLUSTER_INVALID_NETWORK           ((NTSTATUS)0xC0130010L)
+
+#define STATUS_CLUSTER_NO_NET_ADAPTERS           ((NTSTATUS)0xC0130011L)
+
+#define STATUS_CLUSTER_NODE_UP                   ((NTSTATUS)0xC0130012L)
+
+#define STATUS_CLUSTER_NODE_PAUSED               ((NTSTATUS)0xC0130013L)
+
+#define STATUS_CLUSTER_NODE_NOT_PAUSED           ((NTSTATUS)0xC0130014L)
+
+#define STATUS_CLUSTER_NO_SECURITY_CONTEXT       ((NTSTATUS)0xC0130015L)
+
+#define STATUS_CLUSTER_NETWORK_NOT_INTERNAL      ((NTSTATUS)0xC0130016L)
+
+#define STATUS_CLUSTER_POISONED                  ((NTSTATUS)0xC0130017L)
+
+#define STATUS_CLUSTER_NON_CSV_PATH              ((NTSTATUS)0xC0130018L)
+
+#define STATUS_CLUSTER_CSV_VOLUME_NOT_LOCAL      ((NTSTATUS)0xC0130019L)
+
+#define STATUS_CLUSTER_CSV_READ_OPLOCK_BREAK_IN_PROGRESS ((NTSTATUS)0xC0130020L)
+
+#define STATUS_CLUSTER_CSV_AUTO_PAUSE_ERROR      ((NTSTATUS)0xC0130021L)
+
+#define STATUS_CLUSTER_CSV_REDIRECTED            ((NTSTATUS)0xC0130022L)
+
+#define STATUS_CLUSTER_CSV_NOT_REDIRECTED        ((NTSTATUS)0xC0130023L)
+
+#define STATUS_CLUSTER_CSV_VOLUME_DRAINING       ((NTSTATUS)0xC0130024L)
+
+#define STATUS_CLUSTER_CSV_SNAPSHOT_CREATION_IN_PROGRESS ((NTSTATUS)0xC0130025L)
+
+#define STATUS_CLUSTER_CSV_VOLUME_DRAINING_SUCCEEDED_DOWNLEVEL ((NTSTATUS)0xC0130026L)
+
+#define STATUS_CLUSTER_CSV_NO_SNAPSHOTS          ((NTSTATUS)0xC0130027L)
+
+#define STATUS_CSV_IO_PAUSE_TIMEOUT              ((NTSTATUS)0xC0130028L)
+
+#define STATUS_CLUSTER_CSV_INVALID_HANDLE        ((NTSTATUS)0xC0130029L)
+
+#define STATUS_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR ((NTSTATUS)0xC0130030L)
+
+#define STATUS_CLUSTER_CAM_TICKET_REPLAY_DETECTED ((NTSTATUS)0xC0130031L)
+
+#define STATUS_TRANSACTIONAL_CONFLICT            ((NTSTATUS)0xC0190001L)
+
+#define STATUS_INVALID_TRANSACTION               ((NTSTATUS)0xC0190002L)
+
+#define STATUS_TRANSACTION_NOT_ACTIVE            ((NTSTATUS)0xC0190003L)
+
+#define STATUS_TM_INITIALIZATION_FAILED          ((NTSTATUS)0xC0190004L)
+
+#define STATUS_RM_NOT_ACTIVE                     ((NTSTATUS)0xC0190005L)
+
+#define STATUS_RM_METADATA_CORRUPT               ((NTSTATUS)0xC0190006L)
+
+#define STATUS_TRANSACTION_NOT_JOINED            ((NTSTATUS)0xC0190007L)
+
+#define STATUS_DIRECTORY_NOT_RM                  ((NTSTATUS)0xC0190008L)
+
+#define STATUS_COULD_NOT_RESIZE_LOG              ((NTSTATUS)0x80190009L)
+
+#define STATUS_TRANSACTIONS_UNSUPPORTED_REMOTE   ((NTSTATUS)0xC019000AL)
+
+#define STATUS_LOG_RESIZE_INVALID_SIZE           ((NTSTATUS)0xC019000BL)
+
+#define STATUS_REMOTE_FILE_VERSION_MISMATCH      ((NTSTATUS)0xC019000CL)
+
+#define STATUS_CRM_PROTOCOL_ALREADY_EXISTS       ((NTSTATUS)0xC019000FL)
+
+#define STATUS_TRANSACTION_PROPAGATION_FAILED    ((NTSTATUS)0xC0190010L)
+
+#define STATUS_CRM_PROTOCOL_NOT_FOUND            ((NTSTATUS)0xC0190011L)
+
+#define STATUS_TRANSACTION_SUPERIOR_EXISTS       ((NTSTATUS)0xC0190012L)
+
+#define STATUS_TRANSACTION_REQUEST_NOT_VALID     ((NTSTATUS)0xC0190013L)
+
+#define STATUS_TRANSACTION_NOT_REQUESTED         ((NTSTATUS)0xC0190014L)
+
+#define STATUS_TRANSACTION_ALREADY_ABORTED       ((NTSTATUS)0xC0190015L)
+
+#define STATUS_TRANSACTION_ALREADY_COMMITTED     ((NTSTATUS)0xC0190016L)
+
+#define STATUS_TRANSACTION_INVALID_MARSHALL_BUFFER ((NTSTATUS)0xC0190017L)
+
+#define STATUS_CURRENT_TRANSACTION_NOT_VALID     ((NTSTATUS)0xC0190018L)
+
+#define STATUS_LOG_GROWTH_FAILED                 ((NTSTATUS)0xC0190019L)
+
+#define STATUS_OBJECT_NO_LONGER_EXISTS           ((NTSTATUS)0xC0190021L)
+
+#define STATUS_STREAM_MINIVERSION_NOT_FOUND      ((NTSTATUS)0xC0190022L)
+
+#define STATUS_STREAM_MINIVERSION_NOT_VALID      ((NTSTATUS)0xC0190023L)
+
+#define STATUS_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION ((NTSTATUS)0xC0190024L)
+
+#define STATUS_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT ((NTSTATUS)0xC0190025L)
+
+#define STATUS_CANT_CREATE_MORE_STREAM_MINIVERSIONS ((NTSTATUS)0xC0190026L)
+
+#define STATUS_HANDLE_NO_LONGER_VALID            ((NTSTATUS)0xC0190028L)
+
+#define STATUS_NO_TXF_METADATA                   ((NTSTATUS)0x80190029L)
+
+#define STATUS_LOG_CORRUPTION_DETECTED           ((NTSTATUS)0xC0190030L)
+
+#define STATUS_CANT_RECOVER_WITH_HANDLE_OPEN     ((NTSTATUS)0x80190031L)
+
+#define STATUS_RM_DISCONNECTED                   ((NTSTATUS)0xC0190032L)
+
+#define STATUS_ENLISTMENT_NOT_SUPERIOR           ((NTSTATUS)0xC0190033L)
+
+#define STATUS_RECOVERY_NOT_NEEDED               ((NTSTATUS)0x40190034L)
+
+#define STATUS_RM_ALREADY_STARTED                ((NTSTATUS)0x40190035L)
+
+#define STATUS_FILE_IDENTITY_NOT_PERSISTENT      ((NTSTATUS)0xC0190036L)
+
+#define STATUS_CANT_BREAK_TRANSACTIONAL_DEPENDENCY ((NTSTATUS)0xC0190037L)
+
+#define STATUS_CANT_CROSS_RM_BOUNDARY            ((NTSTATUS)0xC0190038L)
+
+#define STATUS_TXF_DIR_NOT_EMPTY                 ((NTSTATUS)0xC0190039L)
+
+#define STATUS_INDOUBT_TRANSACTIONS_EXIST        ((NTSTATUS)0xC019003AL)
+
+#define STATUS_TM_VOLATILE                       ((NTSTATUS)0xC019003BL)
+
+#define STATUS_ROLLBACK_TIMER_EXPIRED            ((NTSTATUS)0xC019003CL)
+
+#define STATUS_TXF_ATTRIBUTE_CORRUPT             ((NTSTATUS)0xC019003DL)
+
+#define STATUS_EFS_NOT_ALLOWED_IN_TRANSACTION    ((NTSTATUS)0xC019003EL)
+
+#define STATUS_TRANSACTIONAL_OPEN_NOT_ALLOWED    ((NTSTATUS)0xC019003FL)
+
+#define STATUS_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE ((NTSTATUS)0xC0190040L)
+
+#define STATUS_TXF_METADATA_ALREADY_PRESENT      ((NTSTATUS)0x80190041L)
+
+#define STATUS_TRANSACTION_SCOPE_CALLBACKS_NOT_SET ((NTSTATUS)0x80190042L)
+
+#define STATUS_TRANSACTION_REQUIRED_PROMOTION    ((NTSTATUS)0xC0190043L)
+
+#define STATUS_CANNOT_EXECUTE_FILE_IN_TRANSACTION ((NTSTATUS)0xC0190044L)
+
+#define STATUS_TRANSACTIONS_NOT_FROZEN           ((NTSTATUS)0xC0190045L)
+
+#define STATUS_TRANSACTION_FREEZE_IN_PROGRESS    ((NTSTATUS)0xC0190046L)
+
+#define STATUS_NOT_SNAPSHOT_VOLUME               ((NTSTATUS)0xC0190047L)
+
+#define STATUS_NO_SAVEPOINT_WITH_OPEN_FILES      ((NTSTATUS)0xC0190048L)
+
+#define STATUS_SPARSE_NOT_ALLOWED_IN_TRANSACTION ((NTSTATUS)0xC0190049L)
+
+#define STATUS_TM_IDENTITY_MISMATCH              ((NTSTATUS)0xC019004AL)
+
+#define STATUS_FLOATED_SECTION                   ((NTSTATUS)0xC019004BL)
+
+#define STATUS_CANNOT_ACCEPT_TRANSACTED_WORK     ((NTSTATUS)0xC019004CL)
+
+#define STATUS_CANNOT_ABORT_TRANSACTIONS         ((NTSTATUS)0xC019004DL)
+
+#define STATUS_TRANSACTION_NOT_FOUND             ((NTSTATUS)0xC019004EL)
+
+#define STATUS_RESOURCEMANAGER_NOT_FOUND         ((NTSTATUS)0xC019004FL)
+
+#define STATUS_ENLISTMENT_NOT_FOUND              ((NTSTATUS)0xC0190050L)
+
+#define STATUS_TRANSACTIONMANAGER_NOT_FOUND      ((NTSTATUS)0xC0190051L)
+
+#define STATUS_TRANSACTIONMANAGER_NOT_ONLINE     ((NTSTATUS)0xC0190052L)
+
+#define STATUS_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION ((NTSTATUS)0xC0190053L)
+
+#define STATUS_TRANSACTION_NOT_ROOT              ((NTSTATUS)0xC0190054L)
+
+#define STATUS_TRANSACTION_OBJECT_EXPIRED        ((NTSTATUS)0xC0190055L)
+
+#define STATUS_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION ((NTSTATUS)0xC0190056L)
+
+#define STATUS_TRANSACTION_RESPONSE_NOT_ENLISTED ((NTSTATUS)0xC0190057L)
+
+#define STATUS_TRANSACTION_RECORD_TOO_LONG       ((NTSTATUS)0xC0190058L)
+
+#define STATUS_NO_LINK_TRACKING_IN_TRANSACTION   ((NTSTATUS)0xC0190059L)
+
+#define STATUS_OPERATION_NOT_SUPPORTED_IN_TRANSACTION ((NTSTATUS)0xC019005AL)
+
+#define STATUS_TRANSACTION_INTEGRITY_VIOLATED    ((NTSTATUS)0xC019005BL)
+
+#define STATUS_TRANSACTIONMANAGER_IDENTITY_MISMATCH ((NTSTATUS)0xC019005CL)
+
+#define STATUS_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT  ((NTSTATUS)0xC019005DL)
+
+#define STATUS_TRANSACTION_MUST_WRITETHROUGH     ((NTSTATUS)0xC019005EL)
+
+#define STATUS_TRANSACTION_NO_SUPERIOR           ((NTSTATUS)0xC019005FL)
+
+#define STATUS_EXPIRED_HANDLE                    ((NTSTATUS)0xC0190060L)
+
+#define STATUS_TRANSACTION_NOT_ENLISTED          ((NTSTATUS)0xC0190061L)
+
+#define STATUS_LOG_SECTOR_INVALID                ((NTSTATUS)0xC01A0001L)
+
+#define STATUS_LOG_SECTOR_PARITY_INVALID         ((NTSTATUS)0xC01A0002L)
+
+#define STATUS_LOG_SECTOR_REMAPPED               ((NTSTATUS)0xC01A0003L)
+
+#define STATUS_LOG_BLOCK_INCOMPLETE              ((NTSTATUS)0xC01A0004L)
+
+#define STATUS_LOG_INVALID_RANGE                 ((NTSTATUS)0xC01A0005L)
+
+#define STATUS_LOG_BLOCKS_EXHAUSTED              ((NTSTATUS)0xC01A0006L)
+
+#define STATUS_LOG_READ_CONTEXT_INVALID          ((NTSTATUS)0xC01A0007L)
+
+#define STATUS_LOG_RESTART_INVALID               ((NTSTATUS)0xC01A0008L)
+
+#define STATUS_LOG_BLOCK_VERSION                 ((NTSTATUS)0xC01A0009L)
+
+#define STATUS_LOG_BLOCK_INVALID                 ((NTSTATUS)0xC01A000AL)
+
+#define STATUS_LOG_READ_MODE_INVALID             ((NTSTATUS)0xC01A000BL)
+
+#define STATUS_LOG_NO_RESTART                    ((NTSTATUS)0x401A000CL)
+
+#define STATUS_LOG_METADATA_CORRUPT              ((NTSTATUS)0xC01A000DL)
+
+#define STATUS_LOG_METADATA_INVALID              ((NTSTATUS)0xC01A000EL)
+
+#define STATUS_LOG_METADATA_INCONSISTENT         ((NTSTATUS)0xC01A000FL)
+
+#define STATUS_LOG_RESERVATION_INVALID           ((NTSTATUS)0xC01A0010L)
+
+#define STATUS_LOG_CANT_DELETE                   ((NTSTATUS)0xC01A0011L)
+
+#define STATUS_LOG_CONTAINER_LIMIT_EXCEEDED      ((NTSTATUS)0xC01A0012L)
+
+#define STATUS_LOG_START_OF_LOG                  ((NTSTATUS)0xC01A0013L)
+
+#define STATUS_LOG_POLICY_ALREADY_INSTALLED      ((NTSTATUS)0xC01A0014L)
+
+#define STATUS_LOG_POLICY_NOT_INSTALLED          ((NTSTATUS)0xC01A0015L)
+
+#define STATUS_LOG_POLICY_INVALID                ((NTSTATUS)0xC01A0016L)
+
+#define STATUS_LOG_POLICY_CONFLICT               ((NTSTATUS)0xC01A0017L)
+
+#define STATUS_LOG_PINNED_ARCHIVE_TAIL           ((NTSTATUS)0xC01A0018L)
+
+#define STATUS_LOG_RECORD_NONEXISTENT            ((NTSTATUS)0xC01A0019L)
+
+#define STATUS_LOG_RECORDS_RESERVED_INVALID      ((NTSTATUS)0xC01A001AL)
+
+#define STATUS_LOG_SPACE_RESERVED_INVALID        ((NTSTATUS)0xC01A001BL)
+
+#define STATUS_LOG_TAIL_INVALID                  ((NTSTATUS)0xC01A001CL)
+
+#define STATUS_LOG_FULL                          ((NTSTATUS)0xC01A001DL)
+
+#define STATUS_LOG_MULTIPLEXED                   ((NTSTATUS)0xC01A001EL)
+
+#define STATUS_LOG_DEDICATED                     ((NTSTATUS)0xC01A001FL)
+
+#define STATUS_LOG_ARCHIVE_NOT_IN_PROGRESS       ((NTSTATUS)0xC01A0020L)
+
+#define STATUS_LOG_ARCHIVE_IN_PROGRESS           ((NTSTATUS)0xC01A0021L)
+
+#define STATUS_LOG_EPHEMERAL                     ((NTSTATUS)0xC01A0022L)
+
+#define STATUS_LOG_NOT_ENOUGH_CONTAINERS         ((NTSTATUS)0xC01A0023L)
+
+#define STATUS_LOG_CLIENT_ALREADY_REGISTERED     ((NTSTATUS)0xC01A0024L)
+
+#define STATUS_LOG_CLIENT_NOT_REGISTERED         ((NTSTATUS)0xC01A0025L)
+
+#define STATUS_LOG_FULL_HANDLER_IN_PROGRESS      ((NTSTATUS)0xC01A0026L)
+
+#define STATUS_LOG_CONTAINER_READ_FAILED         ((NTSTATUS)0xC01A0027L)
+
+#define STATUS_LOG_CONTAINER_WRITE_FAILED        ((NTSTATUS)0xC01A0028L)
+
+#define STATUS_LOG_CONTAINER_OPEN_FAILED         ((NTSTATUS)0xC01A0029L)
+
+#define STATUS_LOG_CONTAINER_STATE_INVALID       ((NTSTATUS)0xC01A002AL)
+
+#define STATUS_LOG_STATE_INVALID                 ((NTSTATUS)0xC01A002BL)
+
+#define STATUS_LOG_PINNED                        ((NTSTATUS)0xC01A002CL)
+
+#define STATUS_LOG_METADATA_FLUSH_FAILED         ((NTSTATUS)0xC01A002DL)
+
+#define STATUS_LOG_INCONSISTENT_SECURITY         ((NTSTATUS)0xC01A002EL)
+
+#define STATUS_LOG_APPENDED_FLUSH_FAILED         ((NTSTATUS)0xC01A002FL)
+
+#define STATUS_LOG_PINNED_RESERVATION            ((NTSTATUS)0xC01A0030L)
+
+#define STATUS_VIDEO_HUNG_DISPLAY_DRIVER_THREAD  ((NTSTATUS)0xC01B00EAL)
+
+#define STATUS_VIDEO_HUNG_DISPLAY_DRIVER_THREAD_RECOVERED ((NTSTATUS)0x801B00EBL)
+
+#define STATUS_VIDEO_DRIVER_DEBUG_REPORT_REQUEST ((NTSTATUS)0x401B00ECL)
+
+#define STATUS_MONITOR_NO_DESCRIPTOR             ((NTSTATUS)0xC01D0001L)
+
+#define STATUS_MONITOR_UNKNOWN_DESCRIPTOR_FORMAT ((NTSTATUS)0xC01D0002L)
+
+#define STATUS_MONITOR_INVALID_DESCRIPTOR_CHECKSUM ((NTSTATUS)0xC01D0003L)
+
+#define STATUS_MONITOR_INVALID_STANDARD_TIMING_BLOCK ((NTSTATUS)0xC01D0004L)
+
+#define STATUS_MONITOR_WMI_DATABLOCK_REGISTRATION_FAILED ((NTSTATUS)0xC01D0005L)
+
+#define STATUS_MONITOR_INVALID_SERIAL_NUMBER_MONDSC_BLOCK ((NTSTATUS)0xC01D0006L)
+
+#define STATUS_MONITOR_INVALID_USER_FRIENDLY_MONDSC_BLOCK ((NTSTATUS)0xC01D0007L)
+
+#define STATUS_MONITOR_NO_MORE_DESCRIPTOR_DATA   ((NTSTATUS)0xC01D0008L)
+
+#define STATUS_MONITOR_INVALID_DETAILED_TIMING_BLOCK ((NTSTATUS)0xC01D0009L)
+
+#define STATUS_MONITOR_INVALID_MANUFACTURE_DATE  ((NTSTATUS)0xC01D000AL)
+
+#define STATUS_GRAPHICS_NOT_EXCLUSIVE_MODE_OWNER ((NTSTATUS)0xC01E0000L)
+
+#define STATUS_GRAPHICS_INSUFFICIENT_DMA_BUFFER  ((NTSTATUS)0xC01E0001L)
+
+#define STATUS_GRAPHICS_INVALID_DISPLAY_ADAPTER  ((NTSTATUS)0xC01E0002L)
+
+#define STATUS_GRAPHICS_ADAPTER_WAS_RESET        ((NTSTATUS)0xC01E0003L)
+
+#define STATUS_GRAPHICS_INVALID_DRIVER_MODEL     ((NTSTATUS)0xC01E0004L)
+
+#define STATUS_GRAPHICS_PRESENT_MODE_CHANGED     ((NTSTATUS)0xC01E0005L)
+
+#define STATUS_GRAPHICS_PRESENT_OCCLUDED         ((NTSTATUS)0xC01E0006L)
+
+#define STATUS_GRAPHICS_PRESENT_DENIED           ((NTSTATUS)0xC01E0007L)
+
+#define STATUS_GRAPHICS_CANNOTCOLORCONVERT       ((NTSTATUS)0xC01E0008L)
+
+#define STATUS_GRAPHICS_DRIVER_MISMATCH          ((NTSTATUS)0xC01E0009L)
+
+#define STATUS_GRAPHICS_PARTIAL_DATA_POPULATED   ((NTSTATUS)0x401E000AL)
+
+#define STATUS_GRAPHICS_PRESENT_REDIRECTION_DISABLED ((NTSTATUS)0xC01E000BL)
+
+#define STATUS_GRAPHICS_PRESENT_UNOCCLUDED       ((NTSTATUS)0xC01E000CL)
+
+#define STATUS_GRAPHICS_WINDOWDC_NOT_AVAILABLE   ((NTSTATUS)0xC01E000DL)
+
+#define STATUS_GRAPHICS_WINDOWLESS_PRESENT_DISABLED ((NTSTATUS)0xC01E000EL)
+
+#define STATUS_GRAPHICS_PRESENT_INVALID_WINDOW   ((NTSTATUS)0xC01E000FL)
+
+#define STATUS_GRAPHICS_PRESENT_BUFFER_NOT_BOUND ((NTSTATUS)0xC01E0010L)
+
+#define STATUS_GRAPHICS_VAIL_STATE_CHANGED       ((NTSTATUS)0xC01E0011L)
+
+#define STATUS_GRAPHICS_INDIRECT_DISPLAY_ABANDON_SWAPCHAIN ((NTSTATUS)0xC01E0012L)
+
+#define STATUS_GRAPHICS_INDIRECT_DISPLAY_DEVICE_STOPPED ((NTSTATUS)0xC01E0013L)
+
+#define STATUS_GRAPHICS_NO_VIDEO_MEMORY          ((NTSTATUS)0xC01E0100L)
+
+#define STATUS_GRAPHICS_CANT_LOCK_MEMORY         ((NTSTATUS)0xC01E0101L)
+
+#define STATUS_GRAPHICS_ALLOCATION_BUSY          ((NTSTATUS)0xC01E0102L)
+
+#define STATUS_GRAPHICS_TOO_MANY_REFERENCES      ((NTSTATUS)0xC01E0103L)
+
+#define STATUS_GRAPHICS_TRY_AGAIN_LATER          ((NTSTATUS)0xC01E0104L)
+
+#define STATUS_GRAPHICS_TRY_AGAIN_NOW            ((NTSTATUS)0xC01E0105L)
+
+#define STATUS_GRAPHICS_ALLOCATION_INVALID       ((NTSTATUS)0xC01E0106L)
+
+#define STATUS_GRAPHICS_UNSWIZZLING_APERTURE_UNAVAILABLE ((NTSTATUS)0xC01E0107L)
+
+#define STATUS_GRAPHICS_UNSWIZZLING_APERTURE_UNSUPPORTED ((NTSTATUS)0xC01E0108L)
+
+#define STATUS_GRAPHICS_CANT_EVICT_PINNED_ALLOCATION ((NTSTATUS)0xC01E0109L)
+
+#define STATUS_GRAPHICS_INVALID_ALLOCATION_USAGE ((NTSTATUS)0xC01E0110L)
+
+#define STATUS_GRAPHICS_CANT_RENDER_LOCKED_ALLOCATION ((NTSTATUS)0xC01E0111L)
+
+#define STATUS_GRAPHICS_ALLOCATION_CLOSED        ((NTSTATUS)0xC01E0112L)
+
+#define STATUS_GRAPHICS_INVALID_ALLOCATION_INSTANCE ((NTSTATUS)0xC01E0113L)
+
+#define STATUS_GRAPHICS_INVALID_ALLOCATION_HANDLE ((NTSTATUS)0xC01E0114L)
+
+#define STATUS_GRAPHICS_WRONG_ALLOCATION_DEVICE  ((NTSTATUS)0xC01E0115L)
+
+#define STATUS_GRAPHICS_ALLOCATION_CONTENT_LOST  ((NTSTATUS)0xC01E0116L)
+
+#define STATUS_GRAPHICS_GPU_EXCEPTION_ON_DEVICE  ((NTSTATUS)0xC01E0200L)
+
+#define STATUS_GRAPHICS_SKIP_ALLOCATION_PREPARATION ((NTSTATUS)0x401E0201L)
+
+#define STATUS_GRAPHICS_INVALID_VIDPN_TOPOLOGY   ((NTSTATUS)0xC01E0300L)
+
+#define STATUS_GRAPHICS_VIDPN_TOPOLOGY_NOT_SUPPORTED ((NTSTATUS)0xC01E0301L)
+
+#define STATUS_GRAPHICS_VIDPN_TOPOLOGY_CURRENTLY_NOT_SUPPORTED ((NTSTATUS)0xC01E0302L)
+
+#define STATUS_GRAPHICS_INVALID_VIDPN            ((NTSTATUS)0xC01E0303L)
+
+#define STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE ((NTSTATUS)0xC01E0304L)
+
+#define STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET ((NTSTATUS)0xC01E0305L)
+
+#define STATUS_GRAPHICS_VIDPN_MODALITY_NOT_SUPPORTED ((NTSTATUS)0xC01E0306L)
+
+#define STATUS_GRAPHICS_MODE_NOT_PINNED          ((NTSTATUS)0x401E0307L)
+
+#define STATUS_GRAPHICS_INVALID_VIDPN_SOURCEMODESET ((NTSTATUS)0xC01E0308L)
+
+#define STATUS_GRAPHICS_INVALID_VIDPN_TARGETMODESET ((NTSTATUS)0xC01E0309L)
+
+#define STATUS_GRAPHICS_INVALID_FREQUENCY        ((NTSTATUS)0xC01E030AL)
+
+#define STATUS_GRAPHICS_INVALID_ACTIVE_REGION    ((NTSTATUS)0xC01E030BL)
+
+#define STATUS_GRAPHICS_INVALID_TOTAL_REGION     ((NTSTATUS)0xC01E030CL)
+
+#define STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE_MODE ((NTSTATUS)0xC01E0310L)
+
+#define STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET_MODE ((NTSTATUS)0xC01E0311L)
+
+#define STATUS_GRAPHICS_PINNED_MODE_MUST_REMAIN_IN_SET ((NTSTATUS)0xC01E0312L)
+
+#define STATUS_GRAPHICS_PATH_ALREADY_IN_TOPOLOGY ((NTSTATUS)0xC01E0313L)
+
+#define STATUS_GRAPHICS_MODE_ALREADY_IN_MODESET  ((NTSTATUS)0xC01E0314L)
+
+#define STATUS_GRAPHICS_INVALID_VIDEOPRESENTSOURCESET ((NTSTATUS)0xC01E0315L)
+
+#define STATUS_GRAPHICS_INVALID_VIDEOPRESENTTARGETSET ((NTSTATUS)0xC01E0316L)
+
+#define STATUS_GRAPHICS_SOURCE_ALREADY_IN_SET    ((NTSTATUS)0xC01E0317L)
+
+#define STATUS_GRAPHICS_TARGET_ALREADY_IN_SET    ((NTSTATUS)0xC01E0318L)
+
+#define STATUS_GRAPHICS_INVALID_VIDPN_PRESENT_PATH ((NTSTATUS)0xC01E0319L)
+
+#define STATUS_GRAPHICS_NO_RECOMMENDED_VIDPN_TOPOLOGY ((NTSTATUS)0xC01E031AL)
+
+#define STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGESET ((NTSTATUS)0xC01E031BL)
+
+#define STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE ((NTSTATUS)0xC01E031CL)
+
+#define STATUS_GRAPHICS_FREQUENCYRANGE_NOT_IN_SET ((NTSTATUS)0xC01E031DL)
+
+#define STATUS_GRAPHICS_NO_PREFERRED_MODE        ((NTSTATUS)0x401E031EL)
+
+#define STATUS_GRAPHICS_FREQUENCYRANGE_ALREADY_IN_SET ((NTSTATUS)0xC01E031FL)
+
+#define STATUS_GRAPHICS_STALE_MODESET            ((NTSTATUS)0xC01E0320L)
+
+#define STATUS_GRAPHICS_INVALID_MONITOR_SOURCEMODESET ((NTSTATUS)0xC01E0321L)
+
+#define STATUS_GRAPHICS_INVALID_MONITOR_SOURCE_MODE ((NTSTATUS)0xC01E0322L)
+
+#define STATUS_GRAPHICS_NO_RECOMMENDED_FUNCTIONAL_VIDPN ((NTSTATUS)0xC01E0323L)
+
+#define STATUS_GRAPHICS_MODE_ID_MUST_BE_UNIQUE   ((NTSTATUS)0xC01E0324L)
+
+#define STATUS_GRAPHICS_EMPTY_ADAPTER_MONITOR_MODE_SUPPORT_INTERSECTION ((NTSTATUS)0xC01E0325L)
+
+#define STATUS_GRAPHICS_VIDEO_PRESENT_TARGETS_LESS_THAN_SOURCES ((NTSTATUS)0xC01E0326L)
+
+#define STATUS_GRAPHICS_PATH_NOT_IN_TOPOLOGY     ((NTSTATUS)0xC01E0327L)
+
+#define STATUS_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_SOURCE ((NTSTATUS)0xC01E0328L)
+
+#define STATUS_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_TARGET ((NTSTATUS)0xC01E0329L)
+
+#define STATUS_GRAPHICS_INVALID_MONITORDESCRIPTORSET ((NTSTATUS)0xC01E032AL)
+
+#define STATUS_GRAPHICS_INVALID_MONITORDESCRIPTOR ((NTSTATUS)0xC01E032BL)
+
+#define STATUS_GRAPHICS_MONITORDESCRIPTOR_NOT_IN_SET ((NTSTATUS)0xC01E032CL)
+
+#define STATUS_GRAPHICS_MONITORDESCRIPTOR_ALREADY_IN_SET ((NTSTATUS)0xC01E032DL)
+
+#define STATUS_GRAPHICS_MONITORDESCRIPTOR_ID_MUST_BE_UNIQUE ((NTSTATUS)0xC01E032EL)
+
+#define STATUS_GRAPHICS_INVALID_VIDPN_TARGET_SUBSET_TYPE ((NTSTATUS)0xC01E032FL)
+
+#define STATUS_GRAPHICS_RESOURCES_NOT_RELATED    ((NTSTATUS)0xC01E0330L)
+
+#define STATUS_GRAPHICS_SOURCE_ID_MUST_BE_UNIQUE ((NTSTATUS)0xC01E0331L)
+
+#define STATUS_GRAPHICS_TARGET_ID_MUST_BE_UNIQUE ((NTSTATUS)0xC01E0332L)
+
+#define STATUS_GRAPHICS_NO_AVAILABLE_VIDPN_TARGET ((NTSTATUS)0xC01E0333L)
+
+#define STATUS_GRAPHICS_MONITOR_COULD_NOT_BE_ASSOCIATED_WITH_ADAPTER ((NTSTATUS)0xC01E0334L)
+
+#define STATUS_GRAPHICS_NO_VIDPNMGR              ((NTSTATUS)0xC01E0335L)
+
+#define STATUS_GRAPHICS_NO_ACTIVE_VIDPN          ((NTSTATUS)0xC01E0336L)
+
+#define STATUS_GRAPHICS_STALE_VIDPN_TOPOLOGY     ((NTSTATUS)0xC01E0337L)
+
+#define STATUS_GRAPHICS_MONITOR_NOT_CONNECTED    ((NTSTATUS)0xC01E0338L)
+
+#define STATUS_GRAPHICS_SOURCE_NOT_IN_TOPOLOGY   ((NTSTATUS)0xC01E0339L)
+
+#define STATUS_GRAPHICS_INVALID_PRIMARYSURFACE_SIZE ((NTSTATUS)0xC01E033AL)
+
+#define STATUS_GRAPHICS_INVALID_VISIBLEREGION_SIZE ((NTSTATUS)0xC01E033BL)
+
+#define STATUS_GRAPHICS_INVALID_STRIDE           ((NTSTATUS)0xC01E033CL)
+
+#define STATUS_GRAPHICS_INVALID_PIXELFORMAT      ((NTSTATUS)0xC01E033DL)
+
+#define STATUS_GRAPHICS_INVALID_COLORBASIS       ((NTSTATUS)0xC01E033EL)
+
+#define STATUS_GRAPHICS_INVALID_PIXELVALUEACCESSMODE ((NTSTATUS)0xC01E033FL)
+
+#define STATUS_GRAPHICS_TARGET_NOT_IN_TOPOLOGY   ((NTSTATUS)0xC01E0340L)
+
+#define STATUS_GRAPHICS_NO_DISPLAY_MODE_MANAGEMENT_SUPPORT ((NTSTATUS)0xC01E0341L)
+
+#define STATUS_GRAPHICS_VIDPN_SOURCE_IN_USE      ((NTSTATUS)0xC01E0342L)
+
+#define STATUS_GRAPHICS_CANT_ACCESS_ACTIVE_VIDPN ((NTSTATUS)0xC01E0343L)
+
+#define STATUS_GRAPHICS_INVALID_PATH_IMPORTANCE_ORDINAL ((NTSTATUS)0xC01E0344L)
+
+#define STATUS_GRAPHICS_INVALID_PATH_CONTENT_GEOMETRY_TRANSFORMATION ((NTSTATUS)0xC01E0345L)
+
+#define STATUS_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_SUPPORTED ((NTSTATUS)0xC01E0346L)
+
+#define STATUS_GRAPHICS_INVALID_GAMMA_RAMP       ((NTSTATUS)0xC01E0347L)
+
+#define STATUS_GRAPHICS_GAMMA_RAMP_NOT_SUPPORTED ((NTSTATUS)0xC01E0348L)
+
+#define STATUS_GRAPHICS_MULTISAMPLING_NOT_SUPPORTED ((NTSTATUS)0xC01E0349L)
+
+#define STATUS_GRAPHICS_MODE_NOT_IN_MODESET      ((NTSTATUS)0xC01E034AL)
+
+#define STATUS_GRAPHICS_DATASET_IS_EMPTY         ((NTSTATUS)0x401E034BL)
+
+#define STATUS_GRAPHICS_NO_MORE_ELEMENTS_IN_DATASET ((NTSTATUS)0x401E034CL)
+
+#define STATUS_GRAPHICS_INVALID_VIDPN_TOPOLOGY_RECOMMENDATION_REASON ((NTSTATUS)0xC01E034DL)
+
+#define STATUS_GRAPHICS_INVALID_PATH_CONTENT_TYPE ((NTSTATUS)0xC01E034EL)
+
+#define STATUS_GRAPHICS_INVALID_COPYPROTECTION_TYPE ((NTSTATUS)0xC01E034FL)
+
+#define STATUS_GRAPHICS_UNASSIGNED_MODESET_ALREADY_EXISTS ((NTSTATUS)0xC01E0350L)
+
+#define STATUS_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_PINNED ((NTSTATUS)0x401E0351L)
+
+#define STATUS_GRAPHICS_INVALID_SCANLINE_ORDERING ((NTSTATUS)0xC01E0352L)
+
+#define STATUS_GRAPHICS_TOPOLOGY_CHANGES_NOT_ALLOWED ((NTSTATUS)0xC01E0353L)
+
+#define STATUS_GRAPHICS_NO_AVAILABLE_IMPORTANCE_ORDINALS ((NTSTATUS)0xC01E0354L)
+
+#define STATUS_GRAPHICS_INCOMPATIBLE_PRIVATE_FORMAT ((NTSTATUS)0xC01E0355L)
+
+#define STATUS_GRAPHICS_INVALID_MODE_PRUNING_ALGORITHM ((NTSTATUS)0xC01E0356L)
+
+#define STATUS_GRAPHICS_INVALID_MONITOR_CAPABILITY_ORIGIN ((NTSTATUS)0xC01E0357L)
+
+#define STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE_CONSTRAINT ((NTSTATUS)0xC01E0358L)
+
+#define STATUS_GRAPHICS_MAX_NUM_PATHS_REACHED    ((NTSTATUS)0xC01E0359L)
+
+#define STATUS_GRAPHICS_CANCEL_VIDPN_TOPOLOGY_AUGMENTATION ((NTSTATUS)0xC01E035AL)
+
+#define STATUS_GRAPHICS_INVALID_CLIENT_TYPE      ((NTSTATUS)0xC01E035BL)
+
+#define STATUS_GRAPHICS_CLIENTVIDPN_NOT_SET      ((NTSTATUS)0xC01E035CL)
+
+#define STATUS_GRAPHICS_SPECIFIED_CHILD_ALREADY_CONNECTED ((NTSTATUS)0xC01E0400L)
+
+#define STATUS_GRAPHICS_CHILD_DESCRIPTOR_NOT_SUPPORTED ((NTSTATUS)0xC01E0401L)
+
+#define STATUS_GRAPHICS_UNKNOWN_CHILD_STATUS     ((NTSTATUS)0x401E042FL)
+
+#define STATUS_GRAPHICS_NOT_A_LINKED_ADAPTER     ((NTSTATUS)0xC01E0430L)
+
+#define STATUS_GRAPHICS_LEADLINK_NOT_ENUMERATED  ((NTSTATUS)0xC01E0431L)
+
+#define STATUS_GRAPHICS_CHAINLINKS_NOT_ENUMERATED ((NTSTATUS)0xC01E0432L)
+
+#define STATUS_GRAPHICS_ADAPTER_CHAIN_NOT_READY  ((NTSTATUS)0xC01E0433L)
+
+#define STATUS_GRAPHICS_CHAINLINKS_NOT_STARTED   ((NTSTATUS)0xC01E0434L)
+
+#define STATUS_GRAPHICS_CHAINLINKS_NOT_POWERED_ON ((NTSTATUS)0xC01E0435L)
+
+#define STATUS_GRAPHICS_INCONSISTENT_DEVICE_LINK_STATE ((NTSTATUS)0xC01E0436L)
+
+#define STATUS_GRAPHICS_LEADLINK_START_DEFERRED  ((NTSTATUS)0x401E0437L)
+
+#define STATUS_GRAPHICS_NOT_POST_DEVICE_DRIVER   ((NTSTATUS)0xC01E0438L)
+
+#define STATUS_GRAPHICS_POLLING_TOO_FREQUENTLY   ((NTSTATUS)0x401E0439L)
+
+#define STATUS_GRAPHICS_START_DEFERRED           ((NTSTATUS)0x401E043AL)
+
+#define STATUS_GRAPHICS_ADAPTER_ACCESS_NOT_EXCLUDED ((NTSTATUS)0xC01E043BL)
+
+#define STATUS_GRAPHICS_DEPENDABLE_CHILD_STATUS  ((NTSTATUS)0x401E043CL)
+
+#define STATUS_GRAPHICS_OPM_NOT_SUPPORTED        ((NTSTATUS)0xC01E0500L)
+
+#define STATUS_GRAPHICS_COPP_NOT_SUPPORTED       ((NTSTATUS)0xC01E0501L)
+
+#define STATUS_GRAPHICS_UAB_NOT_SUPPORTED        ((NTSTATUS)0xC01E0502L)
+
+#define STATUS_GRAPHICS_OPM_INVALID_ENCRYPTED_PARAMETERS ((NTSTATUS)0xC01E0503L)
+
+#define STATUS_GRAPHICS_OPM_NO_PROTECTED_OUTPUTS_EXIST ((NTSTATUS)0xC01E0505L)
+
+#define STATUS_GRAPHICS_OPM_INTERNAL_ERROR       ((NTSTATUS)0xC01E050BL)
+
+#define STATUS_GRAPHICS_OPM_INVALID_HANDLE       ((NTSTATUS)0xC01E050CL)
+
+#define STATUS_GRAPHICS_PVP_INVALID_CERTIFICATE_LENGTH ((NTSTATUS)0xC01E050EL)
+
+#define STATUS_GRAPHICS_OPM_SPANNING_MODE_ENABLED ((NTSTATUS)0xC01E050FL)
+
+#define STATUS_GRAPHICS_OPM_THEATER_MODE_ENABLED ((NTSTATUS)0xC01E0510L)
+
+#define STATUS_GRAPHICS_PVP_HFS_FAILED           ((NTSTATUS)0xC01E0511L)
+
+#define STATUS_GRAPHICS_OPM_INVALID_SRM          ((NTSTATUS)0xC01E0512L)
+
+#define STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_HDCP ((NTSTATUS)0xC01E0513L)
+
+#define STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_ACP ((NTSTATUS)0xC01E0514L)
+
+#define STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_CGMSA ((NTSTATUS)0xC01E0515L)
+
+#define STATUS_GRAPHICS_OPM_HDCP_SRM_NEVER_SET   ((NTSTATUS)0xC01E0516L)
+
+#define STATUS_GRAPHICS_OPM_RESOLUTION_TOO_HIGH  ((NTSTATUS)0xC01E0517L)
+
+#define STATUS_GRAPHICS_OPM_ALL_HDCP_HARDWARE_ALREADY_IN_USE ((NTSTATUS)0xC01E0518L)
+
+#define STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_NO_LONGER_EXISTS ((NTSTATUS)0xC01E051AL)
+
+#define STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_DOES_NOT_HAVE_COPP_SEMANTICS ((NTSTATUS)0xC01E051CL)
+
+#define STATUS_GRAPHICS_OPM_INVALID_INFORMATION_REQUEST ((NTSTATUS)0xC01E051DL)
+
+#define STATUS_GRAPHICS_OPM_DRIVER_INTERNAL_ERROR ((NTSTATUS)0xC01E051EL)
+
+#define STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_DOES_NOT_HAVE_OPM_SEMANTICS ((NTSTATUS)0xC01E051FL)
+
+#define STATUS_GRAPHICS_OPM_SIGNALING_NOT_SUPPORTED ((NTSTATUS)0xC01E0520L)
+
+#define STATUS_GRAPHICS_OPM_INVALID_CONFIGURATION_REQUEST ((NTSTATUS)0xC01E0521L)
+
+#define STATUS_GRAPHICS_I2C_NOT_SUPPORTED        ((NTSTATUS)0xC01E0580L)
+
+#define STATUS_GRAPHICS_I2C_DEVICE_DOES_NOT_EXIST ((NTSTATUS)0xC01E0581L)
+
+#define STATUS_GRAPHICS_I2C_ERROR_TRANSMITTING_DATA ((NTSTATUS)0xC01E0582L)
+
+#define STATUS_GRAPHICS_I2C_ERROR_RECEIVING_DATA ((NTSTATUS)0xC01E0583L)
+
+#define STATUS_GRAPHICS_DDCCI_VCP_NOT_SUPPORTED  ((NTSTATUS)0xC01E0584L)
+
+#define STATUS_GRAPHICS_DDCCI_INVALID_DATA       ((NTSTATUS)0xC01E0585L)
+
+#define STATUS_GRAPHICS_DDCCI_MONITOR_RETURNED_INVALID_TIMING_STATUS_BYTE ((NTSTATUS)0xC01E0586L)
+
+#define STATUS_GRAPHICS_DDCCI_INVALID_CAPABILITIES_STRING ((NTSTATUS)0xC01E0587L)
+
+#define STATUS_GRAPHICS_MCA_INTERNAL_ERROR       ((NTSTATUS)0xC01E0588L)
+
+#define STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_COMMAND ((NTSTATUS)0xC01E0589L)
+
+#define STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_LENGTH ((NTSTATUS)0xC01E058AL)
+
+#define STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_CHECKSUM ((NTSTATUS)0xC01E058BL)
+
+#define STATUS_GRAPHICS_INVALID_PHYSICAL_MONITOR_HANDLE ((NTSTATUS)0xC01E058CL)
+
+#define STATUS_GRAPHICS_MONITOR_NO_LONGER_EXISTS ((NTSTATUS)0xC01E058DL)
+
+#define STATUS_GRAPHICS_ONLY_CONSOLE_SESSION_SUPPORTED ((NTSTATUS)0xC01E05E0L)
+
+#define STATUS_GRAPHICS_NO_DISPLAY_DEVICE_CORRESPONDS_TO_NAME ((NTSTATUS)0xC01E05E1L)
+
+#define STATUS_GRAPHICS_DISPLAY_DEVICE_NOT_ATTACHED_TO_DESKTOP ((NTSTATUS)0xC01E05E2L)
+
+#define STATUS_GRAPHICS_MIRRORING_DEVICES_NOT_SUPPORTED ((NTSTATUS)0xC01E05E3L)
+
+#define STATUS_GRAPHICS_INVALID_POINTER          ((NTSTATUS)0xC01E05E4L)
+
+#define STATUS_GRAPHICS_NO_MONITORS_CORRESPOND_TO_DISPLAY_DEVICE ((NTSTATUS)0xC01E05E5L)
+
+#define STATUS_GRAPHICS_PARAMETER_ARRAY_TOO_SMALL ((NTSTATUS)0xC01E05E6L)
+
+#define STATUS_GRAPHICS_INTERNAL_ERROR           ((NTSTATUS)0xC01E05E7L)
+
+#define STATUS_GRAPHICS_SESSION_TYPE_CHANGE_IN_PROGRESS ((NTSTATUS)0xC01E05E8L)
+
+#define STATUS_FVE_LOCKED_VOLUME                 ((NTSTATUS)0xC0210000L)
+
+#define STATUS_FVE_NOT_ENCRYPTED                 ((NTSTATUS)0xC0210001L)
+
+#define STATUS_FVE_BAD_INFORMATION               ((NTSTATUS)0xC0210002L)
+
+#define STATUS_FVE_TOO_SMALL                     ((NTSTATUS)0xC0210003L)
+
+#define STATUS_FVE_FAILED_WRONG_FS               ((NTSTATUS)0xC0210004L)
+
+#define STATUS_FVE_BAD_PARTITION_SIZE            ((NTSTATUS)0xC0210005L)
+
+#define STATUS_FVE_FS_NOT_EXTENDED               ((NTSTATUS)0xC0210006L)
+
+#define STATUS_FVE_FS_MOUNTED                    ((NTSTATUS)0xC0210007L)
+
+#define STATUS_FVE_NO_LICENSE                    ((NTSTATUS)0xC0210008L)
+
+#define STATUS_FVE_ACTION_NOT_ALLOWED            ((NTSTATUS)0xC0210009L)
+
+#define STATUS_FVE_BAD_DATA                      ((NTSTATUS)0xC021000AL)
+
+#define STATUS_FVE_VOLUME_NOT_BOUND              ((NTSTATUS)0xC021000BL)
+
+#define STATUS_FVE_NOT_DATA_VOLUME               ((NTSTATUS)0xC021000CL)
+
+#define STATUS_FVE_CONV_READ_ERROR               ((NTSTATUS)0xC021000DL)
+
+#define STATUS_FVE_CONV_WRITE_ERROR              ((NTSTATUS)0xC021000EL)
+
+#define STATUS_FVE_OVERLAPPED_UPDATE             ((NTSTATUS)0xC021000FL)
+
+#define STATUS_FVE_FAILED_SECTOR_SIZE            ((NTSTATUS)0xC0210010L)
+
+#define STATUS_FVE_FAILED_AUTHENTICATION         ((NTSTATUS)0xC0210011L)
+
+#define STATUS_FVE_NOT_OS_VOLUME                 ((NTSTATUS)0xC0210012L)
+
+#define STATUS_FVE_KEYFILE_NOT_FOUND             ((NTSTATUS)0xC0210013L)
+
+#define STATUS_FVE_KEYFILE_INVALID               ((NTSTATUS)0xC0210014L)
+
+#define STATUS_FVE_KEYFILE_NO_VMK                ((NTSTATUS)0xC0210015L)
+
+#define STATUS_FVE_TPM_DISABLED                  ((NTSTATUS)0xC0210016L)
+
+#define STATUS_FVE_TPM_SRK_AUTH_NOT_ZERO         ((NTSTATUS)0xC0210017L)
+
+#define STATUS_FVE_TPM_INVALID_PCR               ((NTSTATUS)0xC0210018L)
+
+#define STATUS_FVE_TPM_NO_VMK                    ((NTSTATUS)0xC0210019L)
+
+#define STATUS_FVE_PIN_INVALID                   ((NTSTATUS)0xC021001AL)
+
+#define STATUS_FVE_AUTH_INVALID_APPLICATION      ((NTSTATUS)0xC021001BL)
+
+#define STATUS_FVE_AUTH_INVALID_CONFIG           ((NTSTATUS)0xC021001CL)
+
+#define STATUS_FVE_DEBUGGER_ENABLED              ((NTSTATUS)0xC021001DL)
+
+#define STATUS_FVE_DRY_RUN_FAILED                ((NTSTATUS)0xC021001EL)
+
+#define STATUS_FVE_BAD_METADATA_POINTER          ((NTSTATUS)0xC021001FL)
+
+#define STATUS_FVE_OLD_METADATA_COPY             ((NTSTATUS)0xC0210020L)
+
+#define STATUS_FVE_REBOOT_REQUIRED               ((NTSTATUS)0xC0210021L)
+
+#define STATUS_FVE_RAW_ACCESS                    ((NTSTATUS)0xC0210022L)
+
+#define STATUS_FVE_RAW_BLOCKED                   ((NTSTATUS)0xC0210023L)
+
+#define STATUS_FVE_NO_AUTOUNLOCK_MASTER_KEY      ((NTSTATUS)0xC0210024L)
+
+#define STATUS_FVE_MOR_FAILED                    ((NTSTATUS)0xC0210025L)
+
+#define STATUS_FVE_NO_FEATURE_LICENSE            ((NTSTATUS)0xC0210026L)
+
+#define STATUS_FVE_POLICY_USER_DISABLE_RDV_NOT_ALLOWED ((NTSTATUS)0xC0210027L)
+
+#define STATUS_FVE_CONV_RECOVERY_FAILED          ((NTSTATUS)0xC0210028L)
+
+#define STATUS_FVE_VIRTUALIZED_SPACE_TOO_BIG     ((NTSTATUS)0xC0210029L)
+
+#define STATUS_FVE_INVALID_DATUM_TYPE            ((NTSTATUS)0xC021002AL)
+
+#define STATUS_FVE_VOLUME_TOO_SMALL              ((NTSTATUS)0xC0210030L)
+
+#define STATUS_FVE_ENH_PIN_INVALID               ((NTSTATUS)0xC0210031L)
+
+#define STATUS_FVE_FULL_ENCRYPTION_NOT_ALLOWED_ON_TP_STORAGE ((NTSTATUS)0xC0210032L)
+
+#define STATUS_FVE_WIPE_NOT_ALLOWED_ON_TP_STORAGE ((NTSTATUS)0xC0210033L)
+
+#define STATUS_FVE_NOT_ALLOWED_ON_CSV_STACK      ((NTSTATUS)0xC0210034L)
+
+#define STATUS_FVE_NOT_ALLOWED_ON_CLUSTER        ((NTSTATUS)0xC0210035L)
+
+#define STATUS_FVE_NOT_ALLOWED_TO_UPGRADE_WHILE_CONVERTING ((NTSTATUS)0xC0210036L)
+
+#define STATUS_FVE_WIPE_CANCEL_NOT_APPLICABLE    ((NTSTATUS)0xC0210037L)
+
+#define STATUS_FVE_EDRIVE_DRY_RUN_FAILED         ((NTSTATUS)0xC0210038L)
+
+#define STATUS_FVE_SECUREBOOT_DISABLED           ((NTSTATUS)0xC0210039L)
+
+#define STATUS_FVE_SECUREBOOT_CONFIG_CHANGE      ((NTSTATUS)0xC021003AL)
+
+#define STATUS_FVE_DEVICE_LOCKEDOUT              ((NTSTATUS)0xC021003BL)
+
+#define STATUS_FVE_VOLUME_EXTEND_PREVENTS_EOW_DECRYPT ((NTSTATUS)0xC021003CL)
+
+#define STATUS_FVE_NOT_DE_VOLUME                 ((NTSTATUS)0xC021003DL)
+
+#define STATUS_FVE_PROTECTION_DISABLED           ((NTSTATUS)0xC021003EL)
+
+#define STATUS_FVE_PROTECTION_CANNOT_BE_DISABLED ((NTSTATUS)0xC021003FL)
+
+#define STATUS_FVE_OSV_KSR_NOT_ALLOWED           ((NTSTATUS)0xC0210040L)
+
+#define STATUS_FWP_CALLOUT_NOT_FOUND             ((NTSTATUS)0xC0220001L)
+
+#define STATUS_FWP_CONDITION_NOT_FOUND           ((NTSTATUS)0xC0220002L)
+
+#define STATUS_FWP_FILTER_NOT_FOUND              ((NTSTATUS)0xC0220003L)
+
+#define STATUS_FWP_LAYER_NOT_FOUND               ((NTSTATUS)0xC0220004L)
+
+#define STATUS_FWP_PROVIDER_NOT_FOUND            ((NTSTATUS)0xC0220005L)
+
+#define STATUS_FWP_PROVIDER_CONTEXT_NOT_FOUND    ((NTSTATUS)0xC0220006L)
+
+#define STATUS_FWP_SUBLAYER_NOT_FOUND            ((NTSTATUS)0xC0220007L)
+
+#define STATUS_FWP_NOT_FOUND                     ((NTSTATUS)0xC0220008L)
+
+#define STATUS_FWP_ALREADY_EXISTS                ((NTSTATUS)0xC0220009L)
+
+#define STATUS_FWP_IN_USE                        ((NTSTATUS)0xC022000AL)
+
+#define STATUS_FWP_DYNAMIC_SESSION_IN_PROGRESS   ((NTSTATUS)0xC022000BL)
+
+#define STATUS_FWP_WRONG_SESSION                 ((NTSTATUS)0xC022000CL)
+
+#define STATUS_FWP_NO_TXN_IN_PROGRESS            ((NTSTATUS)0xC022000DL)
+
+#define STATUS_FWP_TXN_IN_PROGRESS               ((NTSTATUS)0xC022000EL)
+
+#define STATUS_FWP_TXN_ABORTED                   ((NTSTATUS)0xC022000FL)
+
+#define STATUS_FWP_SESSION_ABORTED               ((NTSTATUS)0xC0220010L)
+
+#define STATUS_FWP_INCOMPATIBLE_TXN              ((NTSTATUS)0xC0220011L)
+
+#define STATUS_FWP_TIMEOUT                       ((NTSTATUS)0xC0220012L)
+
+#define STATUS_FWP_NET_EVENTS_DISABLED           ((NTSTATUS)0xC0220013L)
+
+#define STATUS_FWP_INCOMPATIBLE_LAYER            ((NTSTATUS)0xC0220014L)
+
+#define STATUS_FWP_KM_CLIENTS_ONLY               ((NTSTATUS)0xC0220015L)
+
+#define STATUS_FWP_LIFETIME_MISMATCH             ((NTSTATUS)0xC0220016L)
+
+#define STATUS_FWP_BUILTIN_OBJECT                ((NTSTATUS)0xC0220017L)
+
+#define STATUS_FWP_TOO_MANY_CALLOUTS             ((NTSTATUS)0xC0220018L)
+
+#define STATUS_FWP_NOTIFICATION_DROPPED          ((NTSTATUS)0xC0220019L)
+
+#define STATUS_FWP_TRAFFIC_MISMATCH              ((NTSTATUS)0xC022001AL)
+
+#define STATUS_FWP_INCOMPATIBLE_SA_STATE         ((NTSTATUS)0xC022001BL)
+
+#define STATUS_FWP_NULL_POINTER                  ((NTSTATUS)0xC022001CL)
+
+#define STATUS_FWP_INVALID_ENUMERATOR            ((NTSTATUS)0xC022001DL)
+
+#define STATUS_FWP_INVALID_FLAGS                 ((NTSTATUS)0xC022001EL)
+
+#define STATUS_FWP_INVALID_NET_MASK              ((NTSTATUS)0xC022001FL)
+
+#define STATUS_FWP_INVALID_RANGE                 ((NTSTATUS)0xC0220020L)
+
+#define STATUS_FWP_INVALID_INTERVAL              ((NTSTATUS)0xC0220021L)
+
+#define STATUS_FWP_ZERO_LENGTH_ARRAY             ((NTSTATUS)0xC0220022L)
+
+#define STATUS_FWP_NULL_DISPLAY_NAME             ((NTSTATUS)0xC0220023L)
+
+#define STATUS_FWP_INVALID_ACTION_TYPE           ((NTSTATUS)0xC0220024L)
+
+#define STATUS_FWP_INVALID_WEIGHT                ((NTSTATUS)0xC0220025L)
+
+#define STATUS_FWP_MATCH_TYPE_MISMATCH           ((NTSTATUS)0xC0220026L)
+
+#define STATUS_FWP_TYPE_MISMATCH                 ((NTSTATUS)0xC0220027L)
+
+#define STATUS_FWP_OUT_OF_BOUNDS                 ((NTSTATUS)0xC0220028L)
+
+#define STATUS_FWP_RESERVED                      ((NTSTATUS)0xC0220029L)
+
+#define STATUS_FWP_DUPLICATE_CONDITION           ((NTSTATUS)0xC022002AL)
+
+#define STATUS_FWP_DUPLICATE_KEYMOD              ((NTSTATUS)0xC022002BL)
+
+#define STATUS_FWP_ACTION_INCOMPATIBLE_WITH_LAYER ((NTSTATUS)0xC022002CL)
+
+#define STATUS_FWP_ACTION_INCOMPATIBLE_WITH_SUBLAYER ((NTSTATUS)0xC022002DL)
+
+#define STATUS_FWP_CONTEXT_INCOMPATIBLE_WITH_LAYER ((NTSTATUS)0xC022002EL)
+
+#define STATUS_FWP_CONTEXT_INCOMPATIBLE_WITH_CALLOUT ((NTSTATUS)0xC022002FL)
+
+#define STATUS_FWP_INCOMPATIBLE_AUTH_METHOD      ((NTSTATUS)0xC0220030L)
+
+#define STATUS_FWP_INCOMPATIBLE_DH_GROUP         ((NTSTATUS)0xC0220031L)
+
+#define STATUS_FWP_EM_NOT_SUPPORTED              ((NTSTATUS)0xC0220032L)
+
+#define STATUS_FWP_NEVER_MATCH                   ((NTSTATUS)0xC0220033L)
+
+#define STATUS_FWP_PROVIDER_CONTEXT_MISMATCH     ((NTSTATUS)0xC0220034L)
+
+#define STATUS_FWP_INVALID_PARAMETER             ((NTSTATUS)0xC0220035L)
+
+#define STATUS_FWP_TOO_MANY_SUBLAYERS            ((NTSTATUS)0xC0220036L)
+
+#define STATUS_FWP_CALLOUT_NOTIFICATION_FAILED   ((NTSTATUS)0xC0220037L)
+
+#define STATUS_FWP_INVALID_AUTH_TRANSFORM        ((NTSTATUS)0xC0220038L)
+
+#define STATUS_FWP_INVALID_CIPHER_TRANSFORM      ((NTSTATUS)0xC0220039L)
+
+#define STATUS_FWP_INCOMPATIBLE_CIPHER_TRANSFORM ((NTSTATUS)0xC022003AL)
+
+#define STATUS_FWP_INVALID_TRANSFORM_COMBINATION ((NTSTATUS)0xC022003BL)
+
+#define STATUS_FWP_DUPLICATE_AUTH_METHOD         ((NTSTATUS)0xC022003CL)
+
+#define STATUS_FWP_INVALID_TUNNEL_ENDPOINT       ((NTSTATUS)0xC022003DL)
+
+#define STATUS_FWP_L2_DRIVER_NOT_READY           ((NTSTATUS)0xC022003EL)
+
+#define STATUS_FWP_KEY_DICTATOR_ALREADY_REGISTERED ((NTSTATUS)0xC022003FL)
+
+#define STATUS_FWP_KEY_DICTATION_INVALID_KEYING_MATERIAL ((NTSTATUS)0xC0220040L)
+
+#define STATUS_FWP_CONNECTIONS_DISABLED          ((NTSTATUS)0xC0220041L)
+
+#define STATUS_FWP_INVALID_DNS_NAME              ((NTSTATUS)0xC0220042L)
+
+#define STATUS_FWP_STILL_ON                      ((NTSTATUS)0xC0220043L)
+
+#define STATUS_FWP_IKEEXT_NOT_RUNNING            ((NTSTATUS)0xC0220044L)
+
+#define STATUS_FWP_TCPIP_NOT_READY               ((NTSTATUS)0xC0220100L)
+
+#define STATUS_FWP_INJECT_HANDLE_CLOSING         ((NTSTATUS)0xC0220101L)
+
+#define STATUS_FWP_INJECT_HANDLE_STALE           ((NTSTATUS)0xC0220102L)
+
+#define STATUS_FWP_CANNOT_PEND                   ((NTSTATUS)0xC0220103L)
+
+#define STATUS_FWP_DROP_NOICMP                   ((NTSTATUS)0xC0220104L)
+
+#define STATUS_NDIS_CLOSING                      ((NTSTATUS)0xC0230002L)
+
+#define STATUS_NDIS_BAD_VERSION                  ((NTSTATUS)0xC0230004L)
+
+#define STATUS_NDIS_BAD_CHARACTERISTICS          ((NTSTATUS)0xC0230005L)
+
+#define STATUS_NDIS_ADAPTER_NOT_FOUND            ((NTSTATUS)0xC0230006L)
+
+#define STATUS_NDIS_OPEN_FAILED                  ((NTSTATUS)0xC0230007L)
+
+#define STATUS_NDIS_DEVICE_FAILED                ((NTSTATUS)0xC0230008L)
+
+#define STATUS_NDIS_MULTICAST_FULL               ((NTSTATUS)0xC0230009L)
+
+#define STATUS_NDIS_MULTICAST_EXISTS             ((NTSTATUS)0xC023000AL)
+
+#define STATUS_NDIS_MULTICAST_NOT_FOUND          ((NTSTATUS)0xC023000BL)
+
+#define STATUS_NDIS_REQUEST_ABORTED              ((NTSTATUS)0xC023000CL)
+
+#define STATUS_NDIS_RESET_IN_PROGRESS            ((NTSTATUS)0xC023000DL)
+
+#define STATUS_NDIS_NOT_SUPPORTED                ((NTSTATUS)0xC02300BBL)
+
+#define STATUS_NDIS_INVALID_PACKET               ((NTSTATUS)0xC023000FL)
+
+#define STATUS_NDIS_ADAPTER_NOT_READY            ((NTSTATUS)0xC0230011L)
+
+#define STATUS_NDIS_INVALID_LENGTH               ((NTSTATUS)0xC0230014L)
+
+#define STATUS_NDIS_INVALID_DATA                 ((NTSTATUS)0xC0230015L)
+
+#define STATUS_NDIS_BUFFER_TOO_SHORT             ((NTSTATUS)0xC0230016L)
+
+#define STATUS_NDIS_INVALID_OID                  ((NTSTATUS)0xC0230017L)
+
+#define STATUS_NDIS_ADAPTER_REMOVED              ((NTSTATUS)0xC0230018L)
+
+#define STATUS_NDIS_UNSUPPORTED_MEDIA            ((NTSTATUS)0xC0230019L)
+
+#define STATUS_NDIS_GROUP_ADDRESS_IN_USE         ((NTSTATUS)0xC023001AL)
+
+#define STATUS_NDIS_FILE_NOT_FOUND               ((NTSTATUS)0xC023001BL)
+
+#define STATUS_NDIS_ERROR_READING_FILE           ((NTSTATUS)0xC023001CL)
+
+#define STATUS_NDIS_ALREADY_MAPPED               ((NTSTATUS)0xC023001DL)
+
+#define STATUS_NDIS_RESOURCE_CONFLICT            ((NTSTATUS)0xC023001EL)
+
+#define STATUS_NDIS_MEDIA_DISCONNECTED           ((NTSTATUS)0xC023001FL)
+
+#define STATUS_NDIS_INVALID_ADDRESS              ((NTSTATUS)0xC0230022L)
+
+#define STATUS_NDIS_INVALID_DEVICE_REQUEST       ((NTSTATUS)0xC0230010L)
+
+#define STATUS_NDIS_PAUSED                       ((NTSTATUS)0xC023002AL)
+
+#define STATUS_NDIS_INTERFACE_NOT_FOUND          ((NTSTATUS)0xC023002BL)
+
+#define STATUS_NDIS_UNSUPPORTED_REVISION         ((NTSTATUS)0xC023002CL)
+
+#define STATUS_NDIS_INVALID_PORT                 ((NTSTATUS)0xC023002DL)
+
+#define STATUS_NDIS_INVALID_PORT_STATE           ((NTSTATUS)0xC023002EL)
+
+#define STATUS_NDIS_LOW_POWER_STATE              ((NTSTATUS)0xC023002FL)
+
+#define STATUS_NDIS_REINIT_REQUIRED              ((NTSTATUS)0xC0230030L)
+
+#define STATUS_NDIS_NO_QUEUES                    ((NTSTATUS)0xC0230031L)
+
+#define STATUS_NDIS_DOT11_AUTO_CONFIG_ENABLED    ((NTSTATUS)0xC0232000L)
+
+#define STATUS_NDIS_DOT11_MEDIA_IN_USE           ((NTSTATUS)0xC0232001L)
+
+#define STATUS_NDIS_DOT11_POWER_STATE_INVALID    ((NTSTATUS)0xC0232002L)
+
+#define STATUS_NDIS_PM_WOL_PATTERN_LIST_FULL     ((NTSTATUS)0xC0232003L)
+
+#define STATUS_NDIS_PM_PROTOCOL_OFFLOAD_LIST_FULL ((NTSTATUS)0xC0232004L)
+
+#define STATUS_NDIS_DOT11_AP_CHANNEL_CURRENTLY_NOT_AVAILABLE ((NTSTATUS)0xC0232005L)
+
+#define STATUS_NDIS_DOT11_AP_BAND_CURRENTLY_NOT_AVAILABLE ((NTSTATUS)0xC0232006L)
+
+#define STATUS_NDIS_DOT11_AP_CHANNEL_NOT_ALLOWED ((NTSTATUS)0xC0232007L)
+
+#define STATUS_NDIS_DOT11_AP_BAND_NOT_ALLOWED    ((NTSTATUS)0xC0232008L)
+
+#define STATUS_NDIS_INDICATION_REQUIRED          ((NTSTATUS)0x40230001L)
+
+#define STATUS_NDIS_OFFLOAD_POLICY               ((NTSTATUS)0xC023100FL)
+
+#define STATUS_NDIS_OFFLOAD_CONNECTION_REJECTED  ((NTSTATUS)0xC0231012L)
+
+#define STATUS_NDIS_OFFLOAD_PATH_REJECTED        ((NTSTATUS)0xC0231013L)
+
+#define STATUS_TPM_ERROR_MASK                    ((NTSTATUS)0xC0290000L)
+
+#define STATUS_TPM_AUTHFAIL                      ((NTSTATUS)0xC0290001L)
+
+#define STATUS_TPM_BADINDEX                      ((NTSTATUS)0xC0290002L)
+
+#define STATUS_TPM_BAD_PARAMETER                 ((NTSTATUS)0xC0290003L)
+
+#define STATUS_TPM_AUDITFAILURE                  ((NTSTATUS)0xC0290004L)
+
+#define STATUS_TPM_CLEAR_DISABLED                ((NTSTATUS)0xC0290005L)
+
+#define STATUS_TPM_DEACTIVATED                   ((NTSTATUS)0xC0290006L)
+
+#define STATUS_TPM_DISABLED                      ((NTSTATUS)0xC0290007L)
+
+#define STATUS_TPM_DISABLED_CMD                  ((NTSTATUS)0xC0290008L)
+
+#define STATUS_TPM_FAIL                          ((NTSTATUS)0xC0290009L)
+
+#define STATUS_TPM_BAD_ORDINAL                   ((NTSTATUS)0xC029000AL)
+
+#define STATUS_TPM_INSTALL_DISABLED              ((NTSTATUS)0xC029000BL)
+
+#define STATUS_TPM_INVALID_KEYHANDLE             ((NTSTATUS)0xC029000CL)
+
+#define STATUS_TPM_KEYNOTFOUND                   ((NTSTATUS)0xC029000DL)
+
+#define STATUS_TPM_INAPPROPRIATE_ENC             ((NTSTATUS)0xC029000EL)
+
+#define STATUS_TPM_MIGRATEFAIL                   ((NTSTATUS)0xC029000FL)
+
+#define STATUS_TPM_INVALID_PCR_INFO              ((NTSTATUS)0xC0290010L)
+
+#define STATUS_TPM_NOSPACE                       ((NTSTATUS)0xC0290011L)
+
+#define STATUS_TPM_NOSRK                         ((NTSTATUS)0xC0290012L)
+
+#define STATUS_TPM_NOTSEALED_BLOB                ((NTSTATUS)0xC0290013L)
+
+#define STATUS_TPM_OWNER_SET                     ((NTSTATUS)0xC0290014L)
+
+#define STATUS_TPM_RESOURCES                     ((NTSTATUS)0xC0290015L)
+
+#define STATUS_TPM_SHORTRANDOM                   ((NTSTATUS)0xC0290016L)
+
+#define STATUS_TPM_SIZE                          ((NTSTATUS)0xC0290017L)
+
+#define STATUS_TPM_WRONGPCRVAL                   ((NTSTATUS)0xC0290018L)
+
+#define STATUS_TPM_BAD_PARAM_SIZE                ((NTSTATUS)0xC0290019L)
+
+#define STATUS_TPM_SHA_THREAD                    ((NTSTATUS)0xC029001AL)
+
+#define STATUS_TPM_SHA_ERROR                     ((NTSTATUS)0xC029001BL)
+
+#define STATUS_TPM_FAILEDSELFTEST                ((NTSTATUS)0xC029001CL)
+
+#define STATUS_TPM_AUTH2FAIL                     ((NTSTATUS)0xC029001DL)
+
+#define STATUS_TPM_BADTAG                        ((NTSTATUS)0xC029001EL)
+
+#define STATUS_TPM_IOERROR                       ((NTSTATUS)0xC029001FL)
+
+#define STATUS_TPM_ENCRYPT_ERROR                 ((NTSTATUS)0xC0290020L)
+
+#define STATUS_TPM_DECRYPT_ERROR                 ((NTSTATUS)0xC0290021L)
+
+#define STATUS_TPM_INVALID_AUTHHANDLE            ((NTSTATUS)0xC0290022L)
+
+#define STATUS_TPM_NO_ENDORSEMENT                ((NTSTATUS)0xC0290023L)
+
+#define STATUS_TPM_INVALID_KEYUSAGE              ((NTSTATUS)0xC0290024L)
+
+#define STATUS_TPM_WRONG_ENTITYTYPE              ((NTSTATUS)0xC0290025L)
+
+#define STATUS_TPM_INVALID_POSTINIT              ((NTSTATUS)0xC0290026L)
+
+#define STATUS_TPM_INAPPROPRIATE_SIG             ((NTSTATUS)0xC0290027L)
+
+#define STATUS_TPM_BAD_KEY_PROPERTY              ((NTSTATUS)0xC0290028L)
+
+#define STATUS_TPM_BAD_MIGRATION                 ((NTSTATUS)0xC0290029L)
+
+#define STATUS_TPM_BAD_SCHEME                    ((NTSTATUS)0xC029002AL)
+
+#define STATUS_TPM_BAD_DATASIZE                  ((NTSTATUS)0xC029002BL)
+
+#define STATUS_TPM_BAD_MODE                      ((NTSTATUS)0xC029002CL)
+
+#define STATUS_TPM_BAD_PRESENCE                  ((NTSTATUS)0xC029002DL)
+
+#define STATUS_TPM_BAD_VERSION                   ((NTSTATUS)0xC029002EL)
+
+#define STATUS_TPM_NO_WRAP_TRANSPORT             ((NTSTATUS)0xC029002FL)
+
+#define STATUS_TPM_AUDITFAIL_UNSUCCESSFUL        ((NTSTATUS)0xC0290030L)
+
+#define STATUS_TPM_AUDITFAIL_SUCCESSFUL          ((NTSTATUS)0xC0290031L)
+
+#define STATUS_TPM_NOTRESETABLE                  ((NTSTATUS)0xC0290032L)
+
+#define STATUS_TPM_NOTLOCAL                      ((NTSTATUS)0xC0290033L)
+
+#define STATUS_TPM_BAD_TYPE                      ((NTSTATUS)0xC0290034L)
+
+#define STATUS_TPM_INVALID_RESOURCE              ((NTSTATUS)0xC0290035L)
+
+#define STATUS_TPM_NOTFIPS                       ((NTSTATUS)0xC0290036L)
+
+#define STATUS_TPM_INVALID_FAMILY                ((NTSTATUS)0xC0290037L)
+
+#define STATUS_TPM_NO_NV_PERMISSION              ((NTSTATUS)0xC0290038L)
+
+#define STATUS_TPM_REQUIRES_SIGN                 ((NTSTATUS)0xC0290039L)
+
+#define STATUS_TPM_KEY_NOTSUPPORTED              ((NTSTATUS)0xC029003AL)
+
+#define STATUS_TPM_AUTH_CONFLICT                 ((NTSTATUS)0xC029003BL)
+
+#define STATUS_TPM_AREA_LOCKED                   ((NTSTATUS)0xC029003CL)
+
+#define STATUS_TPM_BAD_LOCALITY                  ((NTSTATUS)0xC029003DL)
+
+#define STATUS_TPM_READ_ONLY                     ((NTSTATUS)0xC029003EL)
+
+#define STATUS_TPM_PER_NOWRITE                   ((NTSTATUS)0xC029003FL)
+
+#define STATUS_TPM_FAMILYCOUNT                   ((NTSTATUS)0xC0290040L)
+
+#define STATUS_TPM_WRITE_LOCKED                  ((NTSTATUS)0xC0290041L)
+
+#define STATUS_TPM_BAD_ATTRIBUTES                ((NTSTATUS)0xC0290042L)
+
+#define STATUS_TPM_INVALID_STRUCTURE             ((NTSTATUS)0xC0290043L)
+
+#define STATUS_TPM_KEY_OWNER_CONTROL             ((NTSTATUS)0xC0290044L)
+
+#define STATUS_TPM_BAD_COUNTER                   ((NTSTATUS)0xC0290045L)
+
+#define STATUS_TPM_NOT_FULLWRITE                 ((NTSTATUS)0xC0290046L)
+
+#define STATUS_TPM_CONTEXT_GAP                   ((NTSTATUS)0xC0290047L)
+
+#define STATUS_TPM_MAXNVWRITES                   ((NTSTATUS)0xC0290048L)
+
+#define STATUS_TPM_NOOPERATOR                    ((NTSTATUS)0xC0290049L)
+
+#define STATUS_TPM_RESOURCEMISSING               ((NTSTATUS)0xC029004AL)
+
+#define STATUS_TPM_DELEGATE_LOCK                 ((NTSTATUS)0xC029004BL)
+
+#define STATUS_TPM_DELEGATE_FAMILY               ((NTSTATUS)0xC029004CL)
+
+#define STATUS_TPM_DELEGATE_ADMIN                ((NTSTATUS)0xC029004DL)
+
+#define STATUS_TPM_TRANSPORT_NOTEXCLUSIVE        ((NTSTATUS)0xC029004EL)
+
+#define STATUS_TPM_OWNER_CONTROL                 ((NTSTATUS)0xC029004FL)
+
+#define STATUS_TPM_DAA_RESOURCES                 ((NTSTATUS)0xC0290050L)
+
+#define STATUS_TPM_DAA_INPUT_DATA0               ((NTSTATUS)0xC0290051L)
+
+#define STATUS_TPM_DAA_INPUT_DATA1               ((NTSTATUS)0xC0290052L)
+
+#define STATUS_TPM_DAA_ISSUER_SETTINGS           ((NTSTATUS)0xC0290053L)
+
+#define STATUS_TPM_DAA_TPM_SETTINGS              ((NTSTATUS)0xC0290054L)
+
+#define STATUS_TPM_DAA_STAGE                     ((NTSTATUS)0xC0290055L)
+
+#define STATUS_TPM_DAA_ISSUER_VALIDITY           ((NTSTATUS)0xC0290056L)
+
+#define STATUS_TPM_DAA_WRONG_W                   ((NTSTATUS)0xC0290057L)
+
+#define STATUS_TPM_BAD_HANDLE                    ((NTSTATUS)0xC0290058L)
+
+#define STATUS_TPM_BAD_DELEGATE                  ((NTSTATUS)0xC0290059L)
+
+#define STATUS_TPM_BADCONTEXT                    ((NTSTATUS)0xC029005AL)
+
+#define STATUS_TPM_TOOMANYCONTEXTS               ((NTSTATUS)0xC029005BL)
+
+#define STATUS_TPM_MA_TICKET_SIGNATURE           ((NTSTATUS)0xC029005CL)
+
+#define STATUS_TPM_MA_DESTINATION                ((NTSTATUS)0xC029005DL)
+
+#define STATUS_TPM_MA_SOURCE                     ((NTSTATUS)0xC029005EL)
+
+#define STATUS_TPM_MA_AUTHORITY                  ((NTSTATUS)0xC029005FL)
+
+#define STATUS_TPM_PERMANENTEK                   ((NTSTATUS)0xC0290061L)
+
+#define STATUS_TPM_BAD_SIGNATURE                 ((NTSTATUS)0xC0290062L)
+
+#define STATUS_TPM_NOCONTEXTSPACE                ((NTSTATUS)0xC0290063L)
+
+#define STATUS_TPM_20_E_ASYMMETRIC               ((NTSTATUS)0xC0290081L)
+
+#define STATUS_TPM_20_E_ATTRIBUTES               ((NTSTATUS)0xC0290082L)
+
+#define STATUS_TPM_20_E_HASH                     ((NTSTATUS)0xC0290083L)
+
+#define STATUS_TPM_20_E_VALUE                    ((NTSTATUS)0xC0290084L)
+
+#define STATUS_TPM_20_E_HIERARCHY                ((NTSTATUS)0xC0290085L)
+
+#define STATUS_TPM_20_E_KEY_SIZE                 ((NTSTATUS)0xC0290087L)
+
+#define STATUS_TPM_20_E_MGF                      ((NTSTATUS)0xC0290088L)
+
+#define STATUS_TPM_20_E_MODE                     ((NTSTATUS)0xC0290089L)
+
+#define STATUS_TPM_20_E_TYPE                     ((NTSTATUS)0xC029008AL)
+
+#define STATUS_TPM_20_E_HANDLE                   ((NTSTATUS)0xC029008BL)
+
+#define STATUS_TPM_20_E_KDF                      ((NTSTATUS)0xC029008CL)
+
+#define STATUS_TPM_20_E_RANGE                    ((NTSTATUS)0xC029008DL)
+
+#define STATUS_TPM_20_E_AUTH_FAIL                ((NTSTATUS)0xC029008EL)
+
+#define STATUS_TPM_20_E_NONCE                    ((NTSTATUS)0xC029008FL)
+
+#define STATUS_TPM_20_E_PP                       ((NTSTATUS)0xC0290090L)
+
+#define STATUS_TPM_20_E_SCHEME                   ((NTSTATUS)0xC0290092L)
+
+#define STATUS_TPM_20_E_SIZE                     ((NTSTATUS)0xC0290095L)
+
+#define STATUS_TPM_20_E_SYMMETRIC                ((NTSTATUS)0xC0290096L)
+
+#define STATUS_TPM_20_E_TAG                      ((NTSTATUS)0xC0290097L)
+
+#define STATUS_TPM_20_E_SELECTOR                 ((NTSTATUS)0xC0290098L)
+
+#define STATUS_TPM_20_E_INSUFFICIENT             ((NTSTATUS)0xC029009AL)
+
+#define STATUS_TPM_20_E_SIGNATURE                ((NTSTATUS)0xC029009BL)
+
+#define STATUS_TPM_20_E_KEY                      ((NTSTATUS)0xC029009CL)
+
+#define STATUS_TPM_20_E_POLICY_FAIL              ((NTSTATUS)0xC029009DL)
+
+#define STATUS_TPM_20_E_INTEGRITY                ((NTSTATUS)0xC029009FL)
+
+#define STATUS_TPM_20_E_TICKET                   ((NTSTATUS)0xC02900A0L)
+
+#define STATUS_TPM_20_E_RESERVED_BITS            ((NTSTATUS)0xC02900A1L)
+
+#define STATUS_TPM_20_E_BAD_AUTH                 ((NTSTATUS)0xC02900A2L)
+
+#define STATUS_TPM_20_E_EXPIRED                  ((NTSTATUS)0xC02900A3L)
+
+#define STATUS_TPM_20_E_POLICY_CC                ((NTSTATUS)0xC02900A4L)
+
+#define STATUS_TPM_20_E_BINDING                  ((NTSTATUS)0xC02900A5L)
+
+#define STATUS_TPM_20_E_CURVE                    ((NTSTATUS)0xC02900A6L)
+
+#define STATUS_TPM_20_E_ECC_POINT                ((NTSTATUS)0xC02900A7L)
+
+#define STATUS_TPM_20_E_INITIALIZE               ((NTSTATUS)0xC0290100L)
+
+#define STATUS_TPM_20_E_FAILURE                  ((NTSTATUS)0xC0290101L)
+
+#define STATUS_TPM_20_E_SEQUENCE                 ((NTSTATUS)0xC0290103L)
+
+#define STATUS_TPM_20_E_PRIVATE                  ((NTSTATUS)0xC029010BL)
+
+#define STATUS_TPM_20_E_HMAC                     ((NTSTATUS)0xC0290119L)
+
+#define STATUS_TPM_20_E_DISABLED                 ((NTSTATUS)0xC0290120L)
+
+#define STATUS_TPM_20_E_EXCLUSIVE                ((NTSTATUS)0xC0290121L)
+
+#define STATUS_TPM_20_E_ECC_CURVE                ((NTSTATUS)0xC0290123L)
+
+#define STATUS_TPM_20_E_AUTH_TYPE                ((NTSTATUS)0xC0290124L)
+
+#define STATUS_TPM_20_E_AUTH_MISSING             ((NTSTATUS)0xC0290125L)
+
+#define STATUS_TPM_20_E_POLICY                   ((NTSTATUS)0xC0290126L)
+
+#define STATUS_TPM_20_E_PCR                      ((NTSTATUS)0xC0290127L)
+
+#define STATUS_TPM_20_E_PCR_CHANGED              ((NTSTATUS)0xC0290128L)
+
+#define STATUS_TPM_20_E_UPGRADE                  ((NTSTATUS)0xC029012DL)
+
+#define STATUS_TPM_20_E_TOO_MANY_CONTEXTS        ((NTSTATUS)0xC029012EL)
+
+#define STATUS_TPM_20_E_AUTH_UNAVAILABLE         ((NTSTATUS)0xC029012FL)
+
+#define STATUS_TPM_20_E_REBOOT                   ((NTSTATUS)0xC0290130L)
+
+#define STATUS_TPM_20_E_UNBALANCED               ((NTSTATUS)0xC0290131L)
+
+#define STATUS_TPM_20_E_COMMAND_SIZE             ((NTSTATUS)0xC0290142L)
+
+#define STATUS_TPM_20_E_COMMAND_CODE             ((NTSTATUS)0xC0290143L)
+
+#define STATUS_TPM_20_E_AUTHSIZE                 ((NTSTATUS)0xC0290144L)
+
+#define STATUS_TPM_20_E_AUTH_CONTEXT             ((NTSTATUS)0xC0290145L)
+
+#define STATUS_TPM_20_E_NV_RANGE                 ((NTSTATUS)0xC0290146L)
+
+#define STATUS_TPM_20_E_NV_SIZE                  ((NTSTATUS)0xC0290147L)
+
+#define STATUS_TPM_20_E_NV_LOCKED                ((NTSTATUS)0xC0290148L)
+
+#define STATUS_TPM_20_E_NV_AUTHORIZATION         ((NTSTATUS)0xC0290149L)
+
+#define STATUS_TPM_20_E_NV_UNINITIALIZED         ((NTSTATUS)0xC029014AL)
+
+#define STATUS_TPM_20_E_NV_SPACE                 ((NTSTATUS)0xC029014BL)
+
+#define STATUS_TPM_20_E_NV_DEFINED               ((NTSTATUS)0xC029014CL)
+
+#define STATUS_TPM_20_E_BAD_CONTEXT              ((NTSTATUS)0xC0290150L)
+
+#define STATUS_TPM_20_E_CPHASH                   ((NTSTATUS)0xC0290151L)
+
+#define STATUS_TPM_20_E_PARENT                   ((NTSTATUS)0xC0290152L)
+
+#define STATUS_TPM_20_E_NEEDS_TEST               ((NTSTATUS)0xC0290153L)
+
+#define STATUS_TPM_20_E_NO_RESULT                ((NTSTATUS)0xC0290154L)
+
+#define STATUS_TPM_20_E_SENSITIVE                ((NTSTATUS)0xC0290155L)
+
+#define STATUS_TPM_COMMAND_BLOCKED               ((NTSTATUS)0xC0290400L)
+
+#define STATUS_TPM_INVALID_HANDLE                ((NTSTATUS)0xC0290401L)
+
+#define STATUS_TPM_DUPLICATE_VHANDLE             ((NTSTATUS)0xC0290402L)
+
+#define STATUS_TPM_EMBEDDED_COMMAND_BLOCKED      ((NTSTATUS)0xC0290403L)
+
+#define STATUS_TPM_EMBEDDED_COMMAND_UNSUPPORTED  ((NTSTATUS)0xC0290404L)
+
+#define STATUS_TPM_RETRY                         ((NTSTATUS)0xC0290800L)
+
+#define STATUS_TPM_NEEDS_SELFTEST                ((NTSTATUS)0xC0290801L)
+
+#define STATUS_TPM_DOING_SELFTEST                ((NTSTATUS)0xC0290802L)
+
+#define STATUS_TPM_DEFEND_LOCK_RUNNING           ((NTSTATUS)0xC0290803L)
+
+#define STATUS_TPM_COMMAND_CANCELED              ((NTSTATUS)0xC0291001L)
+
+#define STATUS_TPM_TOO_MANY_CONTEXTS             ((NTSTATUS)0xC0291002L)
+
+#define STATUS_TPM_NOT_FOUND                     ((NTSTATUS)0xC0291003L)
+
+#define STATUS_TPM_ACCESS_DENIED                 ((NTSTATUS)0xC0291004L)
+
+#define STATUS_TPM_INSUFFICIENT_BUFFER           ((NTSTATUS)0xC0291005L)
+
+#define STATUS_TPM_PPI_FUNCTION_UNSUPPORTED      ((NTSTATUS)0xC0291006L)
+
+#define STATUS_PCP_ERROR_MASK                    ((NTSTATUS)0xC0292000L)
+
+#define STATUS_PCP_DEVICE_NOT_READY              ((NTSTATUS)0xC0292001L)
+
+#define STATUS_PCP_INVALID_HANDLE                ((NTSTATUS)0xC0292002L)
+
+#define STATUS_PCP_INVALID_PARAMETER             ((NTSTATUS)0xC0292003L)
+
+#define STATUS_PCP_FLAG_NOT_SUPPORTED            ((NTSTATUS)0xC0292004L)
+
+#define STATUS_PCP_NOT_SUPPORTED                 ((NTSTATUS)0xC0292005L)
+
+#define STATUS_PCP_BUFFER_TOO_SMALL              ((NTSTATUS)0xC0292006L)
+
+#define STATUS_PCP_INTERNAL_ERROR                ((NTSTATUS)0xC0292007L)
+
+#define STATUS_PCP_AUTHENTICATION_FAILED         ((NTSTATUS)0xC0292008L)
+
+#define STATUS_PCP_AUTHENTICATION_IGNORED        ((NTSTATUS)0xC0292009L)
+
+#define STATUS_PCP_POLICY_NOT_FOUND              ((NTSTATUS)0xC029200AL)
+
+#define STATUS_PCP_PROFILE_NOT_FOUND             ((NTSTATUS)0xC029200BL)
+
+#define STATUS_PCP_VALIDATION_FAILED             ((NTSTATUS)0xC029200CL)
+
+#define STATUS_PCP_DEVICE_NOT_FOUND              ((NTSTATUS)0xC029200DL)
+
+#define STATUS_PCP_WRONG_PARENT                  ((NTSTATUS)0xC029200EL)
+
+#define STATUS_PCP_KEY_NOT_LOADED                ((NTSTATUS)0xC029200FL)
+
+#define STATUS_PCP_NO_KEY_CERTIFICATION          ((NTSTATUS)0xC0292010L)
+
+#define STATUS_PCP_KEY_NOT_FINALIZED             ((NTSTATUS)0xC0292011L)
+
+#define STATUS_PCP_ATTESTATION_CHALLENGE_NOT_SET ((NTSTATUS)0xC0292012L)
+
+#define STATUS_PCP_NOT_PCR_BOUND                 ((NTSTATUS)0xC0292013L)
+
+#define STATUS_PCP_KEY_ALREADY_FINALIZED         ((NTSTATUS)0xC0292014L)
+
+#define STATUS_PCP_KEY_USAGE_POLICY_NOT_SUPPORTED ((NTSTATUS)0xC0292015L)
+
+#define STATUS_PCP_KEY_USAGE_POLICY_INVALID      ((NTSTATUS)0xC0292016L)
+
+#define STATUS_PCP_SOFT_KEY_ERROR                ((NTSTATUS)0xC0292017L)
+
+#define STATUS_PCP_KEY_NOT_AUTHENTICATED         ((NTSTATUS)0xC0292018L)
+
+#define STATUS_PCP_KEY_NOT_AIK                   ((NTSTATUS)0xC0292019L)
+
+#define STATUS_PCP_KEY_NOT_SIGNING_KEY           ((NTSTATUS)0xC029201AL)
+
+#define STATUS_PCP_LOCKED_OUT                    ((NTSTATUS)0xC029201BL)
+
+#define STATUS_PCP_CLAIM_TYPE_NOT_SUPPORTED      ((NTSTATUS)0xC029201CL)
+
+#define STATUS_PCP_TPM_VERSION_NOT_SUPPORTED     ((NTSTATUS)0xC029201DL)
+
+#define STATUS_PCP_BUFFER_LENGTH_MISMATCH        ((NTSTATUS)0xC029201EL)
+
+#define STATUS_PCP_IFX_RSA_KEY_CREATION_BLOCKED  ((NTSTATUS)0xC029201FL)
+
+#define STATUS_PCP_TICKET_MISSING                ((NTSTATUS)0xC0292020L)
+
+#define STATUS_PCP_RAW_POLICY_NOT_SUPPORTED      ((NTSTATUS)0xC0292021L)
+
+#define STATUS_PCP_KEY_HANDLE_INVALIDATED        ((NTSTATUS)0xC0292022L)
+
+#define STATUS_PCP_UNSUPPORTED_PSS_SALT          ((NTSTATUS)0x40292023L)
+
+#define STATUS_RTPM_CONTEXT_CONTINUE             ((NTSTATUS)0x00293000L)
+
+#define STATUS_RTPM_CONTEXT_COMPLETE             ((NTSTATUS)0x00293001L)
+
+#define STATUS_RTPM_NO_RESULT                    ((NTSTATUS)0xC0293002L)
+
+#define STATUS_RTPM_PCR_READ_INCOMPLETE          ((NTSTATUS)0xC0293003L)
+
+#define STATUS_RTPM_INVALID_CONTEXT              ((NTSTATUS)0xC0293004L)
+
+#define STATUS_RTPM_UNSUPPORTED_CMD              ((NTSTATUS)0xC0293005L)
+
+#define STATUS_TPM_ZERO_EXHAUST_ENABLED          ((NTSTATUS)0xC0294000L)
+
+#define STATUS_HV_INVALID_HYPERCALL_CODE         ((NTSTATUS)0xC0350002L)
+
+#define STATUS_HV_INVALID_HYPERCALL_INPUT        ((NTSTATUS)0xC0350003L)
+
+#define STATUS_HV_INVALID_ALIGNMENT              ((NTSTATUS)0xC0350004L)
+
+#define STATUS_HV_INVALID_PARAMETER              ((NTSTATUS)0xC0350005L)
+
+#define STATUS_HV_ACCESS_DENIED                  ((NTSTATUS)0xC0350006L)
+
+#define STATUS_HV_INVALID_PARTITION_STATE        ((NTSTATUS)0xC0350007L)
+
+#define STATUS_HV_OPERATION_DENIED               ((NTSTATUS)0xC0350008L)
+
+#define STATUS_HV_UNKNOWN_PROPERTY               ((NTSTATUS)0xC0350009L)
+
+#define STATUS_HV_PROPERTY_VALUE_OUT_OF_RANGE    ((NTSTATUS)0xC035000AL)
+
+#define STATUS_HV_INSUFFICIENT_MEMORY            ((NTSTATUS)0xC035000BL)
+
+#define STATUS_HV_PARTITION_TOO_DEEP             ((NTSTATUS)0xC035000CL)
+
+#define STATUS_HV_INVALID_PARTITION_ID           ((NTSTATUS)0xC035000DL)
+
+#define STATUS_HV_INVALID_VP_INDEX               ((NTSTATUS)0xC035000EL)
+
+#define STATUS_HV_INVALID_PORT_ID                ((NTSTATUS)0xC0350011L)
+
+#define STATUS_HV_INVALID_CONNECTION_ID          ((NTSTATUS)0xC0350012L)
+
+#define STATUS_HV_INSUFFICIENT_BUFFERS           ((NTSTATUS)0xC0350013L)
+
+#define STATUS_HV_NOT_ACKNOWLEDGED               ((NTSTATUS)0xC0350014L)
+
+#define STATUS_HV_INVALID_VP_STATE               ((NTSTATUS)0xC0350015L)
+
+#define STATUS_HV_ACKNOWLEDGED                   ((NTSTATUS)0xC0350016L)
+
+#define STATUS_HV_INVALID_SAVE_RESTORE_STATE     ((NTSTATUS)0xC0350017L)
+
+#define STATUS_HV_INVALID_SYNIC_STATE            ((NTSTATUS)0xC0350018L)
+
+#define STATUS_HV_OBJECT_IN_USE                  ((NTSTATUS)0xC0350019L)
+
+#define STATUS_HV_INVALID_PROXIMITY_DOMAIN_INFO  ((NTSTATUS)0xC035001AL)
+
+#define STATUS_HV_NO_DATA                        ((NTSTATUS)0xC035001BL)
+
+#define STATUS_HV_INACTIVE                       ((NTSTATUS)0xC035001CL)
+
+#define STATUS_HV_NO_RESOURCES                   ((NTSTATUS)0xC035001DL)
+
+#define STATUS_HV_FEATURE_UNAVAILABLE            ((NTSTATUS)0xC035001EL)
+
+#define STATUS_HV_INSUFFICIENT_BUFFER            ((NTSTATUS)0xC0350033L)
+
+#define STATUS_HV_INSUFFICIENT_DEVICE_DOMAINS    ((NTSTATUS)0xC0350038L)
+
+#define STATUS_HV_CPUID_FEATURE_VALIDATION_ERROR ((NTSTATUS)0xC035003CL)
+
+#define STATUS_HV_CPUID_XSAVE_FEATURE_VALIDATION_ERROR ((NTSTATUS)0xC035003DL)
+
+#define STATUS_HV_PROCESSOR_STARTUP_TIMEOUT      ((NTSTATUS)0xC035003EL)
+
+#define STATUS_HV_SMX_ENABLED                    ((NTSTATUS)0xC035003FL)
+
+#define STATUS_HV_INVALID_LP_INDEX               ((NTSTATUS)0xC0350041L)
+
+#define STATUS_HV_INVALID_REGISTER_VALUE         ((NTSTATUS)0xC0350050L)
+
+#define STATUS_HV_INVALID_VTL_STATE              ((NTSTATUS)0xC0350051L)
+
+#define STATUS_HV_NX_NOT_DETECTED                ((NTSTATUS)0xC0350055L)
+
+#define STATUS_HV_INVALID_DEVICE_ID              ((NTSTATUS)0xC0350057L)
+
+#define STATUS_HV_INVALID_DEVICE_STATE           ((NTSTATUS)0xC0350058L)
+
+#define STATUS_HV_PENDING_PAGE_REQUESTS          ((NTSTATUS)0x00350059L)
+
+#define STATUS_HV_PAGE_REQUEST_INVALID           ((NTSTATUS)0xC0350060L)
+
+#define STATUS_HV_INVALID_CPU_GROUP_ID           ((NTSTATUS)0xC035006FL)
+
+#define STATUS_HV_INVALID_CPU_GROUP_STATE        ((NTSTATUS)0xC0350070L)
+
+#define STATUS_HV_OPERATION_FAILED               ((NTSTATUS)0xC0350071L)
+
+#define STATUS_HV_NOT_ALLOWED_WITH_NESTED_VIRT_ACTIVE ((NTSTATUS)0xC0350072L)
+
+#define STATUS_HV_INSUFFICIENT_ROOT_MEMORY       ((NTSTATUS)0xC0350073L)
+
+#define STATUS_HV_NOT_PRESENT                    ((NTSTATUS)0xC0351000L)
+
+#define STATUS_VID_DUPLICATE_HANDLER             ((NTSTATUS)0xC0370001L)
+
+#define STATUS_VID_TOO_MANY_HANDLERS             ((NTSTATUS)0xC0370002L)
+
+#define STATUS_VID_QUEUE_FULL                    ((NTSTATUS)0xC0370003L)
+
+#define STATUS_VID_HANDLER_NOT_PRESENT           ((NTSTATUS)0xC0370004L)
+
+#define STATUS_VID_INVALID_OBJECT_NAME           ((NTSTATUS)0xC0370005L)
+
+#define STATUS_VID_PARTITION_NAME_TOO_LONG       ((NTSTATUS)0xC0370006L)
+
+#define STATUS_VID_MESSAGE_QUEUE_NAME_TOO_LONG   ((NTSTATUS)0xC0370007L)
+
+#define STATUS_VID_PARTITION_ALREADY_EXISTS      ((NTSTATUS)0xC0370008L)
+
+#define STATUS_VID_PARTITION_DOES_NOT_EXIST      ((NTSTATUS)0xC0370009L)
+
+#define STATUS_VID_PARTITION_NAME_NOT_FOUND      ((NTSTATUS)0xC037000AL)
+
+#define STATUS_VID_MESSAGE_QUEUE_ALREADY_EXISTS  ((NTSTATUS)0xC037000BL)
+
+#define STATUS_VID_EXCEEDED_MBP_ENTRY_MAP_LIMIT  ((NTSTATUS)0xC037000CL)
+
+#define STATUS_VID_MB_STILL_REFERENCED           ((NTSTATUS)0xC037000DL)
+
+#define STATUS_VID_CHILD_GPA_PAGE_SET_CORRUPTED  ((NTSTATUS)0xC037000EL)
+
+#define STATUS_VID_INVALID_NUMA_SETTINGS         ((NTSTATUS)0xC037000FL)
+
+#define STATUS_VID_INVALID_NUMA_NODE_INDEX       ((NTSTATUS)0xC0370010L)
+
+#define STATUS_VID_NOTIFICATION_QUEUE_ALREADY_ASSOCIATED ((NTSTATUS)0xC0370011L)
+
+#define STATUS_VID_INVALID_MEMORY_BLOCK_HANDLE   ((NTSTATUS)0xC0370012L)
+
+#define STATUS_VID_PAGE_RANGE_OVERFLOW           ((NTSTATUS)0xC0370013L)
+
+#define STATUS_VID_INVALID_MESSAGE_QUEUE_HANDLE  ((NTSTATUS)0xC0370014L)
+
+#define STATUS_VID_INVALID_GPA_RANGE_HANDLE      ((NTSTATUS)0xC0370015L)
+
+#define STATUS_VID_NO_MEMORY_BLOCK_NOTIFICATION_QUEUE ((NTSTATUS)0xC0370016L)
+
+#define STATUS_VID_MEMORY_BLOCK_LOCK_COUNT_EXCEEDED ((NTSTATUS)0xC0370017L)
+
+#define STATUS_VID_INVALID_PPM_HANDLE            ((NTSTATUS)0xC0370018L)
+
+#define STATUS_VID_MBPS_ARE_LOCKED               ((NTSTATUS)0xC0370019L)
+
+#define STATUS_VID_MESSAGE_QUEUE_CLOSED          ((NTSTATUS)0xC037001AL)
+
+#define STATUS_VID_VIRTUAL_PROCESSOR_LIMIT_EXCEEDED ((NTSTATUS)0xC037001BL)
+
+#define STATUS_VID_STOP_PENDING                  ((NTSTATUS)0xC037001CL)
+
+#define STATUS_VID_INVALID_PROCESSOR_STATE       ((NTSTATUS)0xC037001DL)
+
+#define STATUS_VID_EXCEEDED_KM_CONTEXT_COUNT_LIMIT ((NTSTATUS)0xC037001EL)
+
+#define STATUS_VID_KM_INTERFACE_ALREADY_INITIALIZED ((NTSTATUS)0xC037001FL)
+
+#define STATUS_VID_MB_PROPERTY_ALREADY_SET_RESET ((NTSTATUS)0xC0370020L)
+
+#define STATUS_VID_MMIO_RANGE_DESTROYED          ((NTSTATUS)0xC0370021L)
+
+#define STATUS_VID_INVALID_CHILD_GPA_PAGE_SET    ((NTSTATUS)0xC0370022L)
+
+#define STATUS_VID_RESERVE_PAGE_SET_IS_BEING_USED ((NTSTATUS)0xC0370023L)
+
+#define STATUS_VID_RESERVE_PAGE_SET_TOO_SMALL    ((NTSTATUS)0xC0370024L)
+
+#define STATUS_VID_MBP_ALREADY_LOCKED_USING_RESERVED_PAGE ((NTSTATUS)0xC0370025L)
+
+#define STATUS_VID_MBP_COUNT_EXCEEDED_LIMIT      ((NTSTATUS)0xC0370026L)
+
+#define STATUS_VID_SAVED_STATE_CORRUPT           ((NTSTATUS)0xC0370027L)
+
+#define STATUS_VID_SAVED_STATE_UNRECOGNIZED_ITEM ((NTSTATUS)0xC0370028L)
+
+#define STATUS_VID_SAVED_STATE_INCOMPATIBLE      ((NTSTATUS)0xC0370029L)
+
+#define STATUS_VID_VTL_ACCESS_DENIED             ((NTSTATUS)0xC037002AL)
+
+#define STATUS_VID_REMOTE_NODE_PARENT_GPA_PAGES_USED ((NTSTATUS)0x80370001L)
+
+#define STATUS_IPSEC_BAD_SPI                     ((NTSTATUS)0xC0360001L)
+
+#define STATUS_IPSEC_SA_LIFETIME_EXPIRED         ((NTSTATUS)0xC0360002L)
+
+#define STATUS_IPSEC_WRONG_SA                    ((NTSTATUS)0xC0360003L)
+
+#define STATUS_IPSEC_REPLAY_CHECK_FAILED         ((NTSTATUS)0xC0360004L)
+
+#define STATUS_IPSEC_INVALID_PACKET              ((NTSTATUS)0xC0360005L)
+
+#define STATUS_IPSEC_INTEGRITY_CHECK_FAILED      ((NTSTATUS)0xC0360006L)
+
+#define STATUS_IPSEC_CLEAR_TEXT_DROP             ((NTSTATUS)0xC0360007L)
+
+#define STATUS_IPSEC_AUTH_FIREWALL_DROP          ((NTSTATUS)0xC0360008L)
+
+#define STATUS_IPSEC_THROTTLE_DROP               ((NTSTATUS)0xC0360009L)
+
+#define STATUS_IPSEC_DOSP_BLOCK                  ((NTSTATUS)0xC0368000L)
+
+#define STATUS_IPSEC_DOSP_RECEIVED_MULTICAST     ((NTSTATUS)0xC0368001L)
+
+#define STATUS_IPSEC_DOSP_INVALID_PACKET         ((NTSTATUS)0xC0368002L)
+
+#define STATUS_IPSEC_DOSP_STATE_LOOKUP_FAILED    ((NTSTATUS)0xC0368003L)
+
+#define STATUS_IPSEC_DOSP_MAX_ENTRIES            ((NTSTATUS)0xC0368004L)
+
+#define STATUS_IPSEC_DOSP_KEYMOD_NOT_ALLOWED     ((NTSTATUS)0xC0368005L)
+
+#define STATUS_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES ((NTSTATUS)0xC0368006L)
+
+#define STATUS_VOLMGR_INCOMPLETE_REGENERATION    ((NTSTATUS)0x80380001L)
+
+#define STATUS_VOLMGR_INCOMPLETE_DISK_MIGRATION  ((NTSTATUS)0x80380002L)
+
+#define STATUS_VOLMGR_DATABASE_FULL              ((NTSTATUS)0xC0380001L)
+
+#define STATUS_VOLMGR_DISK_CONFIGURATION_CORRUPTED ((NTSTATUS)0xC0380002L)
+
+#define STATUS_VOLMGR_DISK_CONFIGURATION_NOT_IN_SYNC ((NTSTATUS)0xC0380003L)
+
+#define STATUS_VOLMGR_PACK_CONFIG_UPDATE_FAILED  ((NTSTATUS)0xC0380004L)
+
+#define STATUS_VOLMGR_DISK_CONTAINS_NON_SIMPLE_VOLUME ((NTSTATUS)0xC0380005L)
+
+#define STATUS_VOLMGR_DISK_DUPLICATE             ((NTSTATUS)0xC0380006L)
+
+#define STATUS_VOLMGR_DISK_DYNAMIC               ((NTSTATUS)0xC0380007L)
+
+#define STATUS_VOLMGR_DISK_ID_INVALID            ((NTSTATUS)0xC0380008L)
+
+#define STATUS_VOLMGR_DISK_INVALID               ((NTSTATUS)0xC0380009L)
+
+#define STATUS_VOLMGR_DISK_LAST_VOTER            ((NTSTATUS)0xC038000AL)
+
+#define STATUS_VOLMGR_DISK_LAYOUT_INVALID        ((NTSTATUS)0xC038000BL)
+
+#define STATUS_VOLMGR_DISK_LAYOUT_NON_BASIC_BETWEEN_BASIC_PARTITIONS ((NTSTATUS)0xC038000CL)
+
+#define STATUS_VOLMGR_DISK_LAYOUT_NOT_CYLINDER_ALIGNED ((NTSTATUS)0xC038000DL)
+
+#define STATUS_VOLMGR_DISK_LAYOUT_PARTITIONS_TOO_SMALL ((NTSTATUS)0xC038000EL)
+
+#define STATUS_VOLMGR_DISK_LAYOUT_PRIMARY_BETWEEN_LOGICAL_PARTITIONS ((NTSTATUS)0xC038000FL)
+
+#define STATUS_VOLMGR_DISK_LAYOUT_TOO_MANY_PARTITIONS ((NTSTATUS)0xC0380010L)
+
+#define STATUS_VOLMGR_DISK_MISSING               ((NTSTATUS)0xC0380011L)
+
+#define STATUS_VOLMGR_DISK_NOT_EMPTY             ((NTSTATUS)0xC0380012L)
+
+#define STATUS_VOLMGR_DISK_NOT_ENOUGH_SPACE      ((NTSTATUS)0xC0380013L)
+
+#define STATUS_VOLMGR_DISK_REVECTORING_FAILED    ((NTSTATUS)0xC0380014L)
+
+#define STATUS_VOLMGR_DISK_SECTOR_SIZE_INVALID   ((NTSTATUS)0xC0380015L)
+
+#define STATUS_VOLMGR_DISK_SET_NOT_CONTAINED     ((NTSTATUS)0xC0380016L)
+
+#define STATUS_VOLMGR_DISK_USED_BY_MULTIPLE_MEMBERS ((NTSTATUS)0xC0380017L)
+
+#define STATUS_VOLMGR_DISK_USED_BY_MULTIPLE_PLEXES ((NTSTATUS)0xC0380018L)
+
+#define STATUS_VOLMGR_DYNAMIC_DISK_NOT_SUPPORTED ((NTSTATUS)0xC0380019L)
+
+#define STATUS_VOLMGR_EXTENT_ALREADY_USED        ((NTSTATUS)0xC038001AL)
+
+#define STATUS_VOLMGR_EXTENT_NOT_CONTIGUOUS      ((NTSTATUS)0xC038001BL)
+
+#define STATUS_VOLMGR_EXTENT_NOT_IN_PUBLIC_REGION ((NTSTATUS)0xC038001CL)
+
+#define STATUS_VOLMGR_EXTENT_NOT_SECTOR_ALIGNED  ((NTSTATUS)0xC038001DL)
+
+#define STATUS_VOLMGR_EXTENT_OVERLAPS_EBR_PARTITION ((NTSTATUS)0xC038001EL)
+
+#define STATUS_VOLMGR_EXTENT_VOLUME_LENGTHS_DO_NOT_MATCH ((NTSTATUS)0xC038001FL)
+
+#define STATUS_VOLMGR_FAULT_TOLERANT_NOT_SUPPORTED ((NTSTATUS)0xC0380020L)
+
+#define STATUS_VOLMGR_INTERLEAVE_LENGTH_INVALID  ((NTSTATUS)0xC0380021L)
+
+#define STATUS_VOLMGR_MAXIMUM_REGISTERED_USERS   ((NTSTATUS)0xC0380022L)
+
+#define STATUS_VOLMGR_MEMBER_IN_SYNC             ((NTSTATUS)0xC0380023L)
+
+#define STATUS_VOLMGR_MEMBER_INDEX_DUPLICATE     ((NTSTATUS)0xC0380024L)
+
+#define STATUS_VOLMGR_MEMBER_INDEX_INVALID       ((NTSTATUS)0xC0380025L)
+
+#define STATUS_VOLMGR_MEMBER_MISSING             ((NTSTATUS)0xC0380026L)
+
+#define STATUS_VOLMGR_MEMBER_NOT_DETACHED        ((NTSTATUS)0xC0380027L)
+
+#define STATUS_VOLMGR_MEMBER_REGENERATING        ((NTSTATUS)0xC0380028L)
+
+#define STATUS_VOLMGR_ALL_DISKS_FAILED           ((NTSTATUS)0xC0380029L)
+
+#define STATUS_VOLMGR_NO_REGISTERED_USERS        ((NTSTATUS)0xC038002AL)
+
+#define STATUS_VOLMGR_NO_SUCH_USER               ((NTSTATUS)0xC038002BL)
+
+#define STATUS_VOLMGR_NOTIFICATION_RESET         ((NTSTATUS)0xC038002CL)
+
+#define STATUS_VOLMGR_NUMBER_OF_MEMBERS_INVALID  ((NTSTATUS)0xC038002DL)
+
+#define STATUS_VOLMGR_NUMBER_OF_PLEXES_INVALID   ((NTSTATUS)0xC038002EL)
+
+#define STATUS_VOLMGR_PACK_DUPLICATE             ((NTSTATUS)0xC038002FL)
+
+#define STATUS_VOLMGR_PACK_ID_INVALID            ((NTSTATUS)0xC0380030L)
+
+#define STATUS_VOLMGR_PACK_INVALID               ((NTSTATUS)0xC0380031L)
+
+#define STATUS_VOLMGR_PACK_NAME_INVALID          ((NTSTATUS)0xC0380032L)
+
+#define STATUS_VOLMGR_PACK_OFFLINE               ((NTSTATUS)0xC0380033L)
+
+#define STATUS_VOLMGR_PACK_HAS_QUORUM            ((NTSTATUS)0xC0380034L)
+
+#define STATUS_VOLMGR_PACK_WITHOUT_QUORUM        ((NTSTATUS)0xC0380035L)
+
+#define STATUS_VOLMGR_PARTITION_STYLE_INVALID    ((NTSTATUS)0xC0380036L)
+
+#define STATUS_VOLMGR_PARTITION_UPDATE_FAILED    ((NTSTATUS)0xC0380037L)
+
+#define STATUS_VOLMGR_PLEX_IN_SYNC               ((NTSTATUS)0xC0380038L)
+
+#define STATUS_VOLMGR_PLEX_INDEX_DUPLICATE       ((NTSTATUS)0xC0380039L)
+
+#define STATUS_VOLMGR_PLEX_INDEX_INVALID         ((NTSTATUS)0xC038003AL)
+
+#define STATUS_VOLMGR_PLEX_LAST_ACTIVE           ((NTSTATUS)0xC038003BL)
+
+#define STATUS_VOLMGR_PLEX_MISSING               ((NTSTATUS)0xC038003CL)
+
+#define STATUS_VOLMGR_PLEX_REGENERATING          ((NTSTATUS)0xC038003DL)
+
+#define STATUS_VOLMGR_PLEX_TYPE_INVALID          ((NTSTATUS)0xC038003EL)
+
+#define STATUS_VOLMGR_PLEX_NOT_RAID5             ((NTSTATUS)0xC038003FL)
+
+#define STATUS_VOLMGR_PLEX_NOT_SIMPLE            ((NTSTATUS)0xC0380040L)
+
+#define STATUS_VOLMGR_STRUCTURE_SIZE_INVALID     ((NTSTATUS)0xC0380041L)
+
+#define STATUS_VOLMGR_TOO_MANY_NOTIFICATION_REQUESTS ((NTSTATUS)0xC0380042L)
+
+#define STATUS_VOLMGR_TRANSACTION_IN_PROGRESS    ((NTSTATUS)0xC0380043L)
+
+#define STATUS_VOLMGR_UNEXPECTED_DISK_LAYOUT_CHANGE ((NTSTATUS)0xC0380044L)
+
+#define STATUS_VOLMGR_VOLUME_CONTAINS_MISSING_DISK ((NTSTATUS)0xC0380045L)
+
+#define STATUS_VOLMGR_VOLUME_ID_INVALID          ((NTSTATUS)0xC0380046L)
+
+#define STATUS_VOLMGR_VOLUME_LENGTH_INVALID      ((NTSTATUS)0xC0380047L)
+
+#define STATUS_VOLMGR_VOLUME_LENGTH_NOT_SECTOR_SIZE_MULTIPLE ((NTSTATUS)0xC0380048L)
+
+#define STATUS_VOLMGR_VOLUME_NOT_MIRRORED        ((NTSTATUS)0xC0380049L)
+
+#define STATUS_VOLMGR_VOLUME_NOT_RETAINED        ((NTSTATUS)0xC038004AL)
+
+#define STATUS_VOLMGR_VOLUME_OFFLINE             ((NTSTATUS)0xC038004BL)
+
+#define STATUS_VOLMGR_VOLUME_RETAINED            ((NTSTATUS)0xC038004CL)
+
+#define STATUS_VOLMGR_NUMBER_OF_EXTENTS_INVALID  ((NTSTATUS)0xC038004DL)
+
+#define STATUS_VOLMGR_DIFFERENT_SECTOR_SIZE      ((NTSTATUS)0xC038004EL)
+
+#define STATUS_VOLMGR_BAD_BOOT_DISK              ((NTSTATUS)0xC038004FL)
+
+#define STATUS_VOLMGR_PACK_CONFIG_OFFLINE        ((NTSTATUS)0xC0380050L)
+
+#define STATUS_VOLMGR_PACK_CONFIG_ONLINE         ((NTSTATUS)0xC0380051L)
+
+#define STATUS_VOLMGR_NOT_PRIMARY_PACK           ((NTSTATUS)0xC0380052L)
+
+#define STATUS_VOLMGR_PACK_LOG_UPDATE_FAILED     ((NTSTATUS)0xC0380053L)
+
+#define STATUS_VOLMGR_NUMBER_OF_DISKS_IN_PLEX_INVALID ((NTSTATUS)0xC0380054L)
+
+#define STATUS_VOLMGR_NUMBER_OF_DISKS_IN_MEMBER_INVALID ((NTSTATUS)0xC0380055L)
+
+#define STATUS_VOLMGR_VOLUME_MIRRORED            ((NTSTATUS)0xC0380056L)
+
+#define STATUS_VOLMGR_PLEX_NOT_SIMPLE_SPANNED    ((NTSTATUS)0xC0380057L)
+
+#define STATUS_VOLMGR_NO_VALID_LOG_COPIES        ((NTSTATUS)0xC0380058L)
+
+#define STATUS_VOLMGR_PRIMARY_PACK_PRESENT       ((NTSTATUS)0xC0380059L)
+
+#define STATUS_VOLMGR_NUMBER_OF_DISKS_INVALID    ((NTSTATUS)0xC038005AL)
+
+#define STATUS_VOLMGR_MIRROR_NOT_SUPPORTED       ((NTSTATUS)0xC038005BL)
+
+#define STATUS_VOLMGR_RAID5_NOT_SUPPORTED        ((NTSTATUS)0xC038005CL)
+
+#define STATUS_BCD_NOT_ALL_ENTRIES_IMPORTED      ((NTSTATUS)0x80390001L)
+
+#define STATUS_BCD_TOO_MANY_ELEMENTS             ((NTSTATUS)0xC0390002L)
+
+#define STATUS_BCD_NOT_ALL_ENTRIES_SYNCHRONIZED  ((NTSTATUS)0x80390003L)
+
+#define STATUS_VHD_DRIVE_FOOTER_MISSING          ((NTSTATUS)0xC03A0001L)
+
+#define STATUS_VHD_DRIVE_FOOTER_CHECKSUM_MISMATCH ((NTSTATUS)0xC03A0002L)
+
+#define STATUS_VHD_DRIVE_FOOTER_CORRUPT          ((NTSTATUS)0xC03A0003L)
+
+#define STATUS_VHD_FORMAT_UNKNOWN                ((NTSTATUS)0xC03A0004L)
+
+#define STATUS_VHD_FORMAT_UNSUPPORTED_VERSION    ((NTSTATUS)0xC03A0005L)
+
+#define STATUS_VHD_SPARSE_HEADER_CHECKSUM_MISMATCH ((NTSTATUS)0xC03A0006L)
+
+#define STATUS_VHD_SPARSE_HEADER_UNSUPPORTED_VERSION ((NTSTATUS)0xC03A0007L)
+
+#define STATUS_VHD_SPARSE_HEADER_CORRUPT         ((NTSTATUS)0xC03A0008L)
+
+#define STATUS_VHD_BLOCK_ALLOCATION_FAILURE      ((NTSTATUS)0xC03A0009L)
+
+#define STATUS_VHD_BLOCK_ALLOCATION_TABLE_CORRUPT ((NTSTATUS)0xC03A000AL)
+
+#define STATUS_VHD_INVALID_BLOCK_SIZE            ((NTSTATUS)0xC03A000BL)
+
+#define STATUS_VHD_BITMAP_MISMATCH               ((NTSTATUS)0xC03A000CL)
+
+#define STATUS_VHD_PARENT_VHD_NOT_FOUND          ((NTSTATUS)0xC03A000DL)
+
+#define STATUS_VHD_CHILD_PARENT_ID_MISMATCH      ((NTSTATUS)0xC03A000EL)
+
+#define STATUS_VHD_CHILD_PARENT_TIMESTAMP_MISMATCH ((NTSTATUS)0xC03A000FL)
+
+#define STATUS_VHD_METADATA_READ_FAILURE         ((NTSTATUS)0xC03A0010L)
+
+#define STATUS_VHD_METADATA_WRITE_FAILURE        ((NTSTATUS)0xC03A0011L)
+
+#define STATUS_VHD_INVALID_SIZE                  ((NTSTATUS)0xC03A0012L)
+
+#define STATUS_VHD_INVALID_FILE_SIZE             ((NTSTATUS)0xC03A0013L)
+
+#define STATUS_VIRTDISK_PROVIDER_NOT_FOUND       ((NTSTATUS)0xC03A0014L)
+
+#define STATUS_VIRTDISK_NOT_VIRTUAL_DISK         ((NTSTATUS)0xC03A0015L)
+
+#define STATUS_VHD_PARENT_VHD_ACCESS_DENIED      ((NTSTATUS)0xC03A0016L)
+
+#define STATUS_VHD_CHILD_PARENT_SIZE_MISMATCH    ((NTSTATUS)0xC03A0017L)
+
+#define STATUS_VHD_DIFFERENCING_CHAIN_CYCLE_DETECTED ((NTSTATUS)0xC03A0018L)
+
+#define STATUS_VHD_DIFFERENCING_CHAIN_ERROR_IN_PARENT ((NTSTATUS)0xC03A0019L)
+
+#define STATUS_VIRTUAL_DISK_LIMITATION           ((NTSTATUS)0xC03A001AL)
+
+#define STATUS_VHD_INVALID_TYPE                  ((NTSTATUS)0xC03A001BL)
+
+#define STATUS_VHD_INVALID_STATE                 ((NTSTATUS)0xC03A001CL)
+
+#define STATUS_VIRTDISK_UNSUPPORTED_DISK_SECTOR_SIZE ((NTSTATUS)0xC03A001DL)
+
+#define STATUS_VIRTDISK_DISK_ALREADY_OWNED       ((NTSTATUS)0xC03A001EL)
+
+#define STATUS_VIRTDISK_DISK_ONLINE_AND_WRITABLE ((NTSTATUS)0xC03A001FL)
+
+#define STATUS_CTLOG_TRACKING_NOT_INITIALIZED    ((NTSTATUS)0xC03A0020L)
+
+#define STATUS_CTLOG_LOGFILE_SIZE_EXCEEDED_MAXSIZE ((NTSTATUS)0xC03A0021L)
+
+#define STATUS_CTLOG_VHD_CHANGED_OFFLINE         ((NTSTATUS)0xC03A0022L)
+
+#define STATUS_CTLOG_INVALID_TRACKING_STATE      ((NTSTATUS)0xC03A0023L)
+
+#define STATUS_CTLOG_INCONSISTENT_TRACKING_FILE  ((NTSTATUS)0xC03A0024L)
+
+#define STATUS_VHD_METADATA_FULL                 ((NTSTATUS)0xC03A0028L)
+
+#define STATUS_VHD_INVALID_CHANGE_TRACKING_ID    ((NTSTATUS)0xC03A0029L)
+
+#define STATUS_VHD_CHANGE_TRACKING_DISABLED      ((NTSTATUS)0xC03A002AL)
+
+#define STATUS_VHD_MISSING_CHANGE_TRACKING_INFORMATION ((NTSTATUS)0xC03A0030L)
+
+#define STATUS_VHD_RESIZE_WOULD_TRUNCATE_DATA    ((NTSTATUS)0xC03A0031L)
+
+#define STATUS_VHD_COULD_NOT_COMPUTE_MINIMUM_VIRTUAL_SIZE ((NTSTATUS)0xC03A0032L)
+
+#define STATUS_VHD_ALREADY_AT_OR_BELOW_MINIMUM_VIRTUAL_SIZE ((NTSTATUS)0xC03A0033L)
+
+#define STATUS_QUERY_STORAGE_ERROR               ((NTSTATUS)0x803A0001L)
+
+#define STATUS_GDI_HANDLE_LEAK                   ((NTSTATUS)0x803F0001L)
+
+#define STATUS_RKF_KEY_NOT_FOUND                 ((NTSTATUS)0xC0400001L)
+
+#define STATUS_RKF_DUPLICATE_KEY                 ((NTSTATUS)0xC0400002L)
+
+#define STATUS_RKF_BLOB_FULL                     ((NTSTATUS)0xC0400003L)
+
+#define STATUS_RKF_STORE_FULL                    ((NTSTATUS)0xC0400004L)
+
+#define STATUS_RKF_FILE_BLOCKED                  ((NTSTATUS)0xC0400005L)
+
+#define STATUS_RKF_ACTIVE_KEY                    ((NTSTATUS)0xC0400006L)
+
+#define STATUS_RDBSS_RESTART_OPERATION           ((NTSTATUS)0xC0410001L)
+
+#define STATUS_RDBSS_CONTINUE_OPERATION          ((NTSTATUS)0xC0410002L)
+
+#define STATUS_RDBSS_POST_OPERATION              ((NTSTATUS)0xC0410003L)
+
+#define STATUS_RDBSS_RETRY_LOOKUP                ((NTSTATUS)0xC0410004L)
+
+#define STATUS_BTH_ATT_INVALID_HANDLE            ((NTSTATUS)0xC0420001L)
+
+#define STATUS_BTH_ATT_READ_NOT_PERMITTED        ((NTSTATUS)0xC0420002L)
+
+#define STATUS_BTH_ATT_WRITE_NOT_PERMITTED       ((NTSTATUS)0xC0420003L)
+
+#define STATUS_BTH_ATT_INVALID_PDU               ((NTSTATUS)0xC0420004L)
+
+#define STATUS_BTH_ATT_INSUFFICIENT_AUTHENTICATION ((NTSTATUS)0xC0420005L)
+
+#define STATUS_BTH_ATT_REQUEST_NOT_SUPPORTED     ((NTSTATUS)0xC0420006L)
+
+#define STATUS_BTH_ATT_INVALID_OFFSET            ((NTSTATUS)0xC0420007L)
+
+#define STATUS_BTH_ATT_INSUFFICIENT_AUTHORIZATION ((NTSTATUS)0xC0420008L)
+
+#define STATUS_BTH_ATT_PREPARE_QUEUE_FULL        ((NTSTATUS)0xC0420009L)
+
+#define STATUS_BTH_ATT_ATTRIBUTE_NOT_FOUND       ((NTSTATUS)0xC042000AL)
+
+#define STATUS_BTH_ATT_ATTRIBUTE_NOT_LONG        ((NTSTATUS)0xC042000BL)
+
+#define STATUS_BTH_ATT_INSUFFICIENT_ENCRYPTION_KEY_SIZE ((NTSTATUS)0xC042000CL)
+
+#define STATUS_BTH_ATT_INVALID_ATTRIBUTE_VALUE_LENGTH ((NTSTATUS)0xC042000DL)
+
+#define STATUS_BTH_ATT_UNLIKELY                  ((NTSTATUS)0xC042000EL)
+
+#define STATUS_BTH_ATT_INSUFFICIENT_ENCRYPTION   ((NTSTATUS)0xC042000FL)
+
+#define STATUS_BTH_ATT_UNSUPPORTED_GROUP_TYPE    ((NTSTATUS)0xC0420010L)
+
+#define STATUS_BTH_ATT_INSUFFICIENT_RESOURCES    ((NTSTATUS)0xC0420011L)
+
+#define STATUS_BTH_ATT_UNKNOWN_ERROR             ((NTSTATUS)0xC0421000L)
+
+#define STATUS_SECUREBOOT_ROLLBACK_DETECTED      ((NTSTATUS)0xC0430001L)
+
+#define STATUS_SECUREBOOT_POLICY_VIOLATION       ((NTSTATUS)0xC0430002L)
+
+#define STATUS_SECUREBOOT_INVALID_POLICY         ((NTSTATUS)0xC0430003L)
+
+#define STATUS_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND ((NTSTATUS)0xC0430004L)
+
+#define STATUS_SECUREBOOT_POLICY_NOT_SIGNED      ((NTSTATUS)0xC0430005L)
+
+#define STATUS_SECUREBOOT_NOT_ENABLED            ((NTSTATUS)0x80430006L)
+
+#define STATUS_SECUREBOOT_FILE_REPLACED          ((NTSTATUS)0xC0430007L)
+
+#define STATUS_SECUREBOOT_POLICY_NOT_AUTHORIZED  ((NTSTATUS)0xC0430008L)
+
+#define STATUS_SECUREBOOT_POLICY_UNKNOWN         ((NTSTATUS)0xC0430009L)
+
+#define STATUS_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION ((NTSTATUS)0xC043000AL)
+
+#define STATUS_SECUREBOOT_PLATFORM_ID_MISMATCH   ((NTSTATUS)0xC043000BL)
+
+#define STATUS_SECUREBOOT_POLICY_ROLLBACK_DETECTED ((NTSTATUS)0xC043000CL)
+
+#define STATUS_SECUREBOOT_POLICY_UPGRADE_MISMATCH ((NTSTATUS)0xC043000DL)
+
+#define STATUS_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING ((NTSTATUS)0xC043000EL)
+
+#define STATUS_SECUREBOOT_NOT_BASE_POLICY        ((NTSTATUS)0xC043000FL)
+
+#define STATUS_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY ((NTSTATUS)0xC0430010L)
+
+#define STATUS_PLATFORM_MANIFEST_NOT_AUTHORIZED  ((NTSTATUS)0xC0EB0001L)
+
+#define STATUS_PLATFORM_MANIFEST_INVALID         ((NTSTATUS)0xC0EB0002L)
+
+#define STATUS_PLATFORM_MANIFEST_FILE_NOT_AUTHORIZED ((NTSTATUS)0xC0EB0003L)
+
+#define STATUS_PLATFORM_MANIFEST_CATALOG_NOT_AUTHORIZED ((NTSTATUS)0xC0EB0004L)
+
+#define STATUS_PLATFORM_MANIFEST_BINARY_ID_NOT_FOUND ((NTSTATUS)0xC0EB0005L)
+
+#define STATUS_PLATFORM_MANIFEST_NOT_ACTIVE      ((NTSTATUS)0xC0EB0006L)
+
+#define STATUS_PLATFORM_MANIFEST_NOT_SIGNED      ((NTSTATUS)0xC0EB0007L)
+
+#define STATUS_SYSTEM_INTEGRITY_ROLLBACK_DETECTED ((NTSTATUS)0xC0E90001L)
+
+#define STATUS_SYSTEM_INTEGRITY_POLICY_VIOLATION ((NTSTATUS)0xC0E90002L)
+
+#define STATUS_SYSTEM_INTEGRITY_INVALID_POLICY   ((NTSTATUS)0xC0E90003L)
+
+#define STATUS_SYSTEM_INTEGRITY_POLICY_NOT_SIGNED ((NTSTATUS)0xC0E90004L)
+
+#define STATUS_SYSTEM_INTEGRITY_TOO_MANY_POLICIES ((NTSTATUS)0xC0E90005L)
+
+#define STATUS_SYSTEM_INTEGRITY_SUPPLEMENTAL_POLICY_NOT_AUTHORIZED ((NTSTATUS)0xC0E90006L)
+
+#define STATUS_NO_APPLICABLE_APP_LICENSES_FOUND  ((NTSTATUS)0xC0EA0001L)
+
+#define STATUS_CLIP_LICENSE_NOT_FOUND            ((NTSTATUS)0xC0EA0002L)
+
+#define STATUS_CLIP_DEVICE_LICENSE_MISSING       ((NTSTATUS)0xC0EA0003L)
+
+#define STATUS_CLIP_LICENSE_INVALID_SIGNATURE    ((NTSTATUS)0xC0EA0004L)
+
+#define STATUS_CLIP_KEYHOLDER_LICENSE_MISSING_OR_INVALID ((NTSTATUS)0xC0EA0005L)
+
+#define STATUS_CLIP_LICENSE_EXPIRED              ((NTSTATUS)0xC0EA0006L)
+
+#define STATUS_CLIP_LICENSE_SIGNED_BY_UNKNOWN_SOURCE ((NTSTATUS)0xC0EA0007L)
+
+#define STATUS_CLIP_LICENSE_NOT_SIGNED           ((NTSTATUS)0xC0EA0008L)
+
+#define STATUS_CLIP_LICENSE_HARDWARE_ID_OUT_OF_TOLERANCE ((NTSTATUS)0xC0EA0009L)
+
+#define STATUS_CLIP_LICENSE_DEVICE_ID_MISMATCH   ((NTSTATUS)0xC0EA000AL)
+
+#define STATUS_AUDIO_ENGINE_NODE_NOT_FOUND       ((NTSTATUS)0xC0440001L)
+
+#define STATUS_HDAUDIO_EMPTY_CONNECTION_LIST     ((NTSTATUS)0xC0440002L)
+
+#define STATUS_HDAUDIO_CONNECTION_LIST_NOT_SUPPORTED ((NTSTATUS)0xC0440003L)
+
+#define STATUS_HDAUDIO_NO_LOGICAL_DEVICES_CREATED ((NTSTATUS)0xC0440004L)
+
+#define STATUS_HDAUDIO_NULL_LINKED_LIST_ENTRY    ((NTSTATUS)0xC0440005L)
+
+#define STATUS_SPACES_REPAIRED                   ((NTSTATUS)0x00E70000L)
+
+#define STATUS_SPACES_PAUSE                      ((NTSTATUS)0x00E70001L)
+
+#define STATUS_SPACES_COMPLETE                   ((NTSTATUS)0x00E70002L)
+
+#define STATUS_SPACES_REDIRECT                   ((NTSTATUS)0x00E70003L)
+
+#define STATUS_SPACES_FAULT_DOMAIN_TYPE_INVALID  ((NTSTATUS)0xC0E70001L)
+
+#define STATUS_SPACES_RESILIENCY_TYPE_INVALID    ((NTSTATUS)0xC0E70003L)
+
+#define STATUS_SPACES_DRIVE_SECTOR_SIZE_INVALID  ((NTSTATUS)0xC0E70004L)
+
+#define STATUS_SPACES_DRIVE_REDUNDANCY_INVALID   ((NTSTATUS)0xC0E70006L)
+
+#define STATUS_SPACES_NUMBER_OF_DATA_COPIES_INVALID ((NTSTATUS)0xC0E70007L)
+
+#define STATUS_SPACES_INTERLEAVE_LENGTH_INVALID  ((NTSTATUS)0xC0E70009L)
+
+#define STATUS_SPACES_NUMBER_OF_COLUMNS_INVALID  ((NTSTATUS)0xC0E7000AL)
+
+#define STATUS_SPACES_NOT_ENOUGH_DRIVES          ((NTSTATUS)0xC0E7000BL)
+
+#define STATUS_SPACES_EXTENDED_ERROR             ((NTSTATUS)0xC0E7000CL)
+
+#define STATUS_SPACES_PROVISIONING_TYPE_INVALID  ((NTSTATUS)0xC0E7000DL)
+
+#define STATUS_SPACES_ALLOCATION_SIZE_INVALID    ((NTSTATUS)0xC0E7000EL)
+
+#define STATUS_SPACES_ENCLOSURE_AWARE_INVALID    ((NTSTATUS)0xC0E7000FL)
+
+#define STATUS_SPACES_WRITE_CACHE_SIZE_INVALID   ((NTSTATUS)0xC0E70010L)
+
+#define STATUS_SPACES_NUMBER_OF_GROUPS_INVALID   ((NTSTATUS)0xC0E70011L)
+
+#define STATUS_SPACES_DRIVE_OPERATIONAL_STATE_INVALID ((NTSTATUS)0xC0E70012L)
+
+#define STATUS_SPACES_UPDATE_COLUMN_STATE        ((NTSTATUS)0xC0E70013L)
+
+#define STATUS_SPACES_MAP_REQUIRED               ((NTSTATUS)0xC0E70014L)
+
+#define STATUS_SPACES_UNSUPPORTED_VERSION        ((NTSTATUS)0xC0E70015L)
+
+#define STATUS_SPACES_CORRUPT_METADATA           ((NTSTATUS)0xC0E70016L)
+
+#define STATUS_SPACES_DRT_FULL                   ((NTSTATUS)0xC0E70017L)
+
+#define STATUS_SPACES_INCONSISTENCY              ((NTSTATUS)0xC0E70018L)
+
+#define STATUS_SPACES_LOG_NOT_READY              ((NTSTATUS)0xC0E70019L)
+
+#define STATUS_SPACES_NO_REDUNDANCY              ((NTSTATUS)0xC0E7001AL)
+
+#define STATUS_SPACES_DRIVE_NOT_READY            ((NTSTATUS)0xC0E7001BL)
+
+#define STATUS_SPACES_DRIVE_SPLIT                ((NTSTATUS)0xC0E7001CL)
+
+#define STATUS_SPACES_DRIVE_LOST_DATA            ((NTSTATUS)0xC0E7001DL)
+
+#define STATUS_SPACES_ENTRY_INCOMPLETE           ((NTSTATUS)0xC0E7001EL)
+
+#define STATUS_SPACES_ENTRY_INVALID              ((NTSTATUS)0xC0E7001FL)
+
+#define STATUS_SPACES_MARK_DIRTY                 ((NTSTATUS)0xC0E70020L)
+
+#define STATUS_VOLSNAP_BOOTFILE_NOT_VALID        ((NTSTATUS)0xC0500003L)
+
+#define STATUS_VOLSNAP_ACTIVATION_TIMEOUT        ((NTSTATUS)0xC0500004L)
+
+#define STATUS_IO_PREEMPTED                      ((NTSTATUS)0xC0510001L)
+
+#define STATUS_SVHDX_ERROR_STORED                ((NTSTATUS)0xC05C0000L)
+
+#define STATUS_SVHDX_ERROR_NOT_AVAILABLE         ((NTSTATUS)0xC05CFF00L)
+
+#define STATUS_SVHDX_UNIT_ATTENTION_AVAILABLE    ((NTSTATUS)0xC05CFF01L)
+
+#define STATUS_SVHDX_UNIT_ATTENTION_CAPACITY_DATA_CHANGED ((NTSTATUS)0xC05CFF02L)
+
+#define STATUS_SVHDX_UNIT_ATTENTION_RESERVATIONS_PREEMPTED ((NTSTATUS)0xC05CFF03L)
+
+#define STATUS_SVHDX_UNIT_ATTENTION_RESERVATIONS_RELEASED ((NTSTATUS)0xC05CFF04L)
+
+#define STATUS_SVHDX_UNIT_ATTENTION_REGISTRATIONS_PREEMPTED ((NTSTATUS)0xC05CFF05L)
+
+#define STATUS_SVHDX_UNIT_ATTENTION_OPERATING_DEFINITION_CHANGED ((NTSTATUS)0xC05CFF06L)
+
+#define STATUS_SVHDX_RESERVATION_CONFLICT        ((NTSTATUS)0xC05CFF07L)
+
+#define STATUS_SVHDX_WRONG_FILE_TYPE             ((NTSTATUS)0xC05CFF08L)
+
+#define STATUS_SVHDX_VERSION_MISMATCH            ((NTSTATUS)0xC05CFF09L)
+
+#define STATUS_VHD_SHARED                        ((NTSTATUS)0xC05CFF0AL)
+
+#define STATUS_SVHDX_NO_INITIATOR                ((NTSTATUS)0xC05CFF0BL)
+
+#define STATUS_VHDSET_BACKING_STORAGE_NOT_FOUND  ((NTSTATUS)0xC05CFF0CL)
+
+#define STATUS_SMB_NO_PREAUTH_INTEGRITY_HASH_OVERLAP ((NTSTATUS)0xC05D0000L)
+
+#define STATUS_SMB_BAD_CLUSTER_DIALECT           ((NTSTATUS)0xC05D0001L)
+
+#define STATUS_SMB_GUEST_LOGON_BLOCKED           ((NTSTATUS)0xC05D0002L)
+
+#define STATUS_SECCORE_INVALID_COMMAND           ((NTSTATUS)0xC0E80000L)
+
+#define STATUS_VSM_NOT_INITIALIZED               ((NTSTATUS)0xC0450000L)
+
+#define STATUS_VSM_DMA_PROTECTION_NOT_IN_USE     ((NTSTATUS)0xC0450001L)
+
+#define STATUS_APPEXEC_CONDITION_NOT_SATISFIED   ((NTSTATUS)0xC0EC0000L)
+
+#define STATUS_APPEXEC_HANDLE_INVALIDATED        ((NTSTATUS)0xC0EC0001L)
+
+#define STATUS_APPEXEC_INVALID_HOST_GENERATION   ((NTSTATUS)0xC0EC0002L)
+
+#define STATUS_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION ((NTSTATUS)0xC0EC0003L)
+
+#define STATUS_APPEXEC_INVALID_HOST_STATE        ((NTSTATUS)0xC0EC0004L)
+
+#define STATUS_APPEXEC_NO_DONOR                  ((NTSTATUS)0xC0EC0005L)
+
+#define STATUS_APPEXEC_HOST_ID_MISMATCH          ((NTSTATUS)0xC0EC0006L)
+
+#define STATUS_APPEXEC_UNKNOWN_USER              ((NTSTATUS)0xC0EC0007L)
@@ -0,0 +1,15505 @@
+/************************************************************************
+*                                                                       *
+*   winerror.h --  error code definitions for the Win32 API functions   *
+*                                                                       *
+*   Trimmed to the ERROR_ and WAIT_ codes used by exitcodes. Input for  *
+*   internal/mkcodes; regenerate with go generate.                      *
+*                                                                       *
+************************************************************************/
+
+//
+// MessageId: ERROR_SUCCESS
+//
+// MessageText:
+//
+// The operation completed successfully.
+//
+#define ERROR_SUCCESS                            0L
+
+//
+// MessageId: ERROR_INVALID_FUNCTION
+//
+// MessageText:
+//
+// Incorrect function.
+//
+#define ERROR_INVALID_FUNCTION                   1L
+
+//
+// MessageId: ERROR_FILE_NOT_FOUND
+//
+// MessageText:
+//
+// The system cannot find the file specified.
+//
+#define ERROR_FILE_NOT_FOUND                     2L
+
+//
+// MessageId: ERROR_PATH_NOT_FOUND
+//
+// MessageText:
+//
+// The system cannot find the path specified.
+//
+#define ERROR_PATH_NOT_FOUND                     3L
+
+//
+// MessageId: ERROR_TOO_MANY_OPEN_FILES
+//
+// MessageText:
+//
+// The system cannot open the file.
+//
+#define ERROR_TOO_MANY_OPEN_FILES                4L
+
+//
+// MessageId: ERROR_ACCESS_DENIED
+//
+// MessageText:
+//
+// Access is denied.
+//
+#define ERROR_ACCESS_DENIED                      5L
+
+//
+// MessageId: ERROR_INVALID_HANDLE
+//
+// MessageText:
+//
+// The handle is invalid.
+//
+#define ERROR_INVALID_HANDLE                     6L
+
+//
+// MessageId: ERROR_ARENA_TRASHED
+//
+// MessageText:
+//
+// The storage control blocks were destroyed.
+//
+#define ERROR_ARENA_TRASHED                      7L
+
+//
+// MessageId: ERROR_NOT_ENOUGH_MEMORY
+//
+// MessageText:
+//
+// Not enough memory resources are available to process this command.
+//
+#define ERROR_NOT_ENOUGH_MEMORY                  8L
+
+//
+// MessageId: ERROR_INVALID_BLOCK
+//
+// MessageText:
+//
+// The storage control block address is invalid.
+//
+#define ERROR_INVALID_BLOCK                      9L
+
+//
+// MessageId: ERROR_BAD_ENVIRONMENT
+//
+// MessageText:
+//
+// The environment is incorrect.
+//
+#define ERROR_BAD_ENVIRONMENT                    10L
+
+//
+// MessageId: ERROR_BAD_FORMAT
+//
+// MessageText:
+//
+// An attempt was made to load a program with an incorrect format.
+//
+#define ERROR_BAD_FORMAT                         11L
+
+//
+// MessageId: ERROR_INVALID_ACCESS
+//
+// MessageText:
+//
+// The access code is invalid.
+//
+#define ERROR_INVALID_ACCESS                     12L
+
+//
+// MessageId: ERROR_INVALID_DATA
+//
+// MessageText:
+//
+// The data is invalid.
+//
+#define ERROR_INVALID_DATA                       13L
+
+//
+// MessageId: ERROR_OUTOFMEMORY
+//
+// MessageText:
+//
+// Not enough storage is available to complete this operation.
+//
+#define ERROR_OUTOFMEMORY                        14L
+
+//
+// MessageId: ERROR_INVALID_DRIVE
+//
+// MessageText:
+//
+// The system cannot find the drive specified.
+//
+#define ERROR_INVALID_DRIVE                      15L
+
+//
+// MessageId: ERROR_CURRENT_DIRECTORY
+//
+// MessageText:
+//
+// The directory cannot be removed.
+//
+#define ERROR_CURRENT_DIRECTORY                  16L
+
+//
+// MessageId: ERROR_NOT_SAME_DEVICE
+//
+// MessageText:
+//
+// The system cannot move the file to a different disk drive.
+//
+#define ERROR_NOT_SAME_DEVICE                    17L
+
+//
+// MessageId: ERROR_NO_MORE_FILES
+//
+// MessageText:
+//
+// There are no more files.
+//
+#define ERROR_NO_MORE_FILES                      18L
+
+//
+// MessageId: ERROR_WRITE_PROTECT
+//
+// MessageText:
+//
+// The media is write protected.
+//
+#define ERROR_WRITE_PROTECT                      19L
+
+//
+// MessageId: ERROR_BAD_UNIT
+//
+// MessageText:
+//
+// The system cannot find the device specified.
+//
+#define ERROR_BAD_UNIT                           20L
+
+//
+// MessageId: ERROR_NOT_READY
+//
+// MessageText:
+//
+// The device is not ready.
+//
+#define ERROR_NOT_READY                          21L
+
+//
+// MessageId: ERROR_BAD_COMMAND
+//
+// MessageText:
+//
+// The device does not recognize the command.
+//
+#define ERROR_BAD_COMMAND                        22L
+
+//
+// MessageId: ERROR_CRC
+//
+// MessageText:
+//
+// Data error (cyclic redundancy check).
+//
+#define ERROR_CRC                                23L
+
+//
+// MessageId: ERROR_BAD_LENGTH
+//
+// MessageText:
+//
+// The program issued a command but the command length is incorrect.
+//
+#define ERROR_BAD_LENGTH                         24L
+
+//
+// MessageId: ERROR_SEEK
+//
+// MessageText:
+//
+// The drive cannot locate a specific area or track on the disk.
+//
+#define ERROR_SEEK                               25L
+
+//
+// MessageId: ERROR_NOT_DOS_DISK
+//
+// MessageText:
+//
+// The specified disk or diskette cannot be accessed.
+//
+#define ERROR_NOT_DOS_DISK                       26L
+
+//
+// MessageId: ERROR_SECTOR_NOT_FOUND
+//
+// MessageText:
+//
+// The drive cannot find the sector requested.
+//
+#define ERROR_SECTOR_NOT_FOUND                   27L
+
+//
+// MessageId: ERROR_OUT_OF_PAPER
+//
+// MessageText:
+//
+// The printer is out of paper.
+//
+#define ERROR_OUT_OF_PAPER                       28L
+
+//
+// MessageId: ERROR_WRITE_FAULT
+//
+// MessageText:
+//
+// The system cannot write to the specified device.
+//
+#define ERROR_WRITE_FAULT                        29L
+
+//
+// MessageId: ERROR_READ_FAULT
+//
+// MessageText:
+//
+// The system cannot read from the specified device.
+//
+#define ERROR_READ_FAULT                         30L
+
+//
+// MessageId: ERROR_GEN_FAILURE
+//
+// MessageText:
+//
+// A device attached to the system is not functioning.
+//
+#define ERROR_GEN_FAILURE                        31L
+
+//
+// MessageId: ERROR_SHARING_VIOLATION
+//
+// MessageText:
+//
+// The process cannot access the file because it is being used by another
+// process.
+//
+#define ERROR_SHARING_VIOLATION                  32L
+
+//
+// MessageId: ERROR_LOCK_VIOLATION
+//
+// MessageText:
+//
+// The process cannot access the file because another process has locked a
+// portion of the file.
+//
+#define ERROR_LOCK_VIOLATION                     33L
+
+//
+// MessageId: ERROR_WRONG_DISK
+//
+// MessageText:
+//
+// The wrong diskette is in the drive. Insert %2 (Volume Serial Number: %3)
+// into drive %1.
+//
+#define ERROR_WRONG_DISK                         34L
+
+//
+// MessageId: ERROR_SHARING_BUFFER_EXCEEDED
+//
+// MessageText:
+//
+// Too many files opened for sharing.
+//
+#define ERROR_SHARING_BUFFER_EXCEEDED            36L
+
+//
+// MessageId: ERROR_HANDLE_EOF
+//
+// MessageText:
+//
+// Reached the end of the file.
+//
+#define ERROR_HANDLE_EOF                         38L
+
+//
+// MessageId: ERROR_HANDLE_DISK_FULL
+//
+// MessageText:
+//
+// The disk is full.
+//
+#define ERROR_HANDLE_DISK_FULL                   39L
+
+//
+// MessageId: ERROR_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The request is not supported.
+//
+#define ERROR_NOT_SUPPORTED                      50L
+
+//
+// MessageId: ERROR_REM_NOT_LIST
+//
+// MessageText:
+//
+// Windows cannot find the network path. Verify that the network path is
+// correct and the destination computer is not busy or turned off.
+//
+#define ERROR_REM_NOT_LIST                       51L
+
+//
+// MessageId: ERROR_DUP_NAME
+//
+// MessageText:
+//
+// You were not connected because a duplicate name exists on the network.
+//
+#define ERROR_DUP_NAME                           52L
+
+//
+// MessageId: ERROR_BAD_NETPATH
+//
+// MessageText:
+//
+// The network path was not found.
+//
+#define ERROR_BAD_NETPATH                        53L
+
+//
+// MessageId: ERROR_NETWORK_BUSY
+//
+// MessageText:
+//
+// The network is busy.
+//
+#define ERROR_NETWORK_BUSY                       54L
+
+//
+// MessageId: ERROR_DEV_NOT_EXIST
+//
+// MessageText:
+//
+// The specified network resource or device is no longer available.
+//
+#define ERROR_DEV_NOT_EXIST                      55L
+
+//
+// MessageId: ERROR_TOO_MANY_CMDS
+//
+// MessageText:
+//
+// The network BIOS command limit has been reached.
+//
+#define ERROR_TOO_MANY_CMDS                      56L
+
+//
+// MessageId: ERROR_ADAP_HDW_ERR
+//
+// MessageText:
+//
+// A network adapter hardware error occurred.
+//
+#define ERROR_ADAP_HDW_ERR                       57L
+
+//
+// MessageId: ERROR_BAD_NET_RESP
+//
+// MessageText:
+//
+// The specified server cannot perform the requested operation.
+//
+#define ERROR_BAD_NET_RESP                       58L
+
+//
+// MessageId: ERROR_UNEXP_NET_ERR
+//
+// MessageText:
+//
+// An unexpected network error occurred.
+//
+#define ERROR_UNEXP_NET_ERR                      59L
+
+//
+// MessageId: ERROR_BAD_REM_ADAP
+//
+// MessageText:
+//
+// The remote adapter is not compatible.
+//
+#define ERROR_BAD_REM_ADAP                       60L
+
+//
+// MessageId: ERROR_PRINTQ_FULL
+//
+// MessageText:
+//
+// The printer queue is full.
+//
+#define ERROR_PRINTQ_FULL                        61L
+
+//
+// MessageId: ERROR_NO_SPOOL_SPACE
+//
+// MessageText:
+//
+// Space to store the file waiting to be printed is not available on the
+// server.
+//
+#define ERROR_NO_SPOOL_SPACE                     62L
+
+//
+// MessageId: ERROR_PRINT_CANCELLED
+//
+// MessageText:
+//
+// Your file waiting to be printed was deleted.
+//
+#define ERROR_PRINT_CANCELLED                    63L
+
+//
+// MessageId: ERROR_NETNAME_DELETED
+//
+// MessageText:
+//
+// The specified network name is no longer available.
+//
+#define ERROR_NETNAME_DELETED                    64L
+
+//
+// MessageId: ERROR_NETWORK_ACCESS_DENIED
+//
+// MessageText:
+//
+// Network access is denied.
+//
+#define ERROR_NETWORK_ACCESS_DENIED              65L
+
+//
+// MessageId: ERROR_BAD_DEV_TYPE
+//
+// MessageText:
+//
+// The network resource type is not correct.
+//
+#define ERROR_BAD_DEV_TYPE                       66L
+
+//
+// MessageId: ERROR_BAD_NET_NAME
+//
+// MessageText:
+//
+// The network name cannot be found.
+//
+#define ERROR_BAD_NET_NAME                       67L
+
+//
+// MessageId: ERROR_TOO_MANY_NAMES
+//
+// MessageText:
+//
+// The name limit for the local computer network adapter card was exceeded.
+//
+#define ERROR_TOO_MANY_NAMES                     68L
+
+//
+// MessageId: ERROR_TOO_MANY_SESS
+//
+// MessageText:
+//
+// The network BIOS session limit was exceeded.
+//
+#define ERROR_TOO_MANY_SESS                      69L
+
+//
+// MessageId: ERROR_SHARING_PAUSED
+//
+// MessageText:
+//
+// The remote server has been paused or is in the process of being started.
+//
+#define ERROR_SHARING_PAUSED                     70L
+
+//
+// MessageId: ERROR_REQ_NOT_ACCEP
+//
+// MessageText:
+//
+// No more connections can be made to this remote computer at this time.
+//
+#define ERROR_REQ_NOT_ACCEP                      71L
+
+//
+// MessageId: ERROR_REDIR_PAUSED
+//
+// MessageText:
+//
+// The specified printer or disk device has been paused.
+//
+#define ERROR_REDIR_PAUSED                       72L
+
+//
+// MessageId: ERROR_FILE_EXISTS
+//
+// MessageText:
+//
+// The file exists.
+//
+#define ERROR_FILE_EXISTS                        80L
+
+//
+// MessageId: ERROR_CANNOT_MAKE
+//
+// MessageText:
+//
+// The directory or file cannot be created.
+//
+#define ERROR_CANNOT_MAKE                        82L
+
+//
+// MessageId: ERROR_FAIL_I24
+//
+// MessageText:
+//
+// Fail on INT 24.
+//
+#define ERROR_FAIL_I24                           83L
+
+//
+// MessageId: ERROR_OUT_OF_STRUCTURES
+//
+// MessageText:
+//
+// Storage to process this request is not available.
+//
+#define ERROR_OUT_OF_STRUCTURES                  84L
+
+//
+// MessageId: ERROR_ALREADY_ASSIGNED
+//
+// MessageText:
+//
+// The local device name is already in use.
+//
+#define ERROR_ALREADY_ASSIGNED                   85L
+
+//
+// MessageId: ERROR_INVALID_PASSWORD
+//
+// MessageText:
+//
+// The specified network password is not correct.
+//
+#define ERROR_INVALID_PASSWORD                   86L
+
+//
+// MessageId: ERROR_INVALID_PARAMETER
+//
+// MessageText:
+//
+// The parameter is incorrect.
+//
+#define ERROR_INVALID_PARAMETER                  87L
+
+//
+// MessageId: ERROR_NET_WRITE_FAULT
+//
+// MessageText:
+//
+// A write fault occurred on the network.
+//
+#define ERROR_NET_WRITE_FAULT                    88L
+
+//
+// MessageId: ERROR_NO_PROC_SLOTS
+//
+// MessageText:
+//
+// The system cannot start another process at this time.
+//
+#define ERROR_NO_PROC_SLOTS                      89L
+
+//
+// MessageId: ERROR_TOO_MANY_SEMAPHORES
+//
+// MessageText:
+//
+// Cannot create another system semaphore.
+//
+#define ERROR_TOO_MANY_SEMAPHORES                100L
+
+//
+// MessageId: ERROR_EXCL_SEM_ALREADY_OWNED
+//
+// MessageText:
+//
+// The exclusive semaphore is owned by another process.
+//
+#define ERROR_EXCL_SEM_ALREADY_OWNED             101L
+
+//
+// MessageId: ERROR_SEM_IS_SET
+//
+// MessageText:
+//
+// The semaphore is set and cannot be closed.
+//
+#define ERROR_SEM_IS_SET                         102L
+
+//
+// MessageId: ERROR_TOO_MANY_SEM_REQUESTS
+//
+// MessageText:
+//
+// The semaphore cannot be set again.
+//
+#define ERROR_TOO_MANY_SEM_REQUESTS              103L
+
+//
+// MessageId: ERROR_INVALID_AT_INTERRUPT_TIME
+//
+// MessageText:
+//
+// Cannot request exclusive semaphores at interrupt time.
+//
+#define ERROR_INVALID_AT_INTERRUPT_TIME          104L
+
+//
+// MessageId: ERROR_SEM_OWNER_DIED
+//
+// MessageText:
+//
+// The previous ownership of this semaphore has ended.
+//
+#define ERROR_SEM_OWNER_DIED                     105L
+
+//
+// MessageId: ERROR_SEM_USER_LIMIT
+//
+// MessageText:
+//
+// Insert the diskette for drive %1.
+//
+#define ERROR_SEM_USER_LIMIT                     106L
+
+//
+// MessageId: ERROR_DISK_CHANGE
+//
+// MessageText:
+//
+// The program stopped because an alternate diskette was not inserted.
+//
+#define ERROR_DISK_CHANGE                        107L
+
+//
+// MessageId: ERROR_DRIVE_LOCKED
+//
+// MessageText:
+//
+// The disk is in use or locked by another process.
+//
+#define ERROR_DRIVE_LOCKED                       108L
+
+//
+// MessageId: ERROR_BROKEN_PIPE
+//
+// MessageText:
+//
+// The pipe has been ended.
+//
+#define ERROR_BROKEN_PIPE                        109L
+
+//
+// MessageId: ERROR_OPEN_FAILED
+//
+// MessageText:
+//
+// The system cannot open the device or file specified.
+//
+#define ERROR_OPEN_FAILED                        110L
+
+//
+// MessageId: ERROR_BUFFER_OVERFLOW
+//
+// MessageText:
+//
+// The file name is too long.
+//
+#define ERROR_BUFFER_OVERFLOW                    111L
+
+//
+// MessageId: ERROR_DISK_FULL
+//
+// MessageText:
+//
+// There is not enough space on the disk.
+//
+#define ERROR_DISK_FULL                          112L
+
+//
+// MessageId: ERROR_NO_MORE_SEARCH_HANDLES
+//
+// MessageText:
+//
+// No more internal file identifiers available.
+//
+#define ERROR_NO_MORE_SEARCH_HANDLES             113L
+
+//
+// MessageId: ERROR_INVALID_TARGET_HANDLE
+//
+// MessageText:
+//
+// The target internal file identifier is incorrect.
+//
+#define ERROR_INVALID_TARGET_HANDLE              114L
+
+//
+// MessageId: ERROR_INVALID_CATEGORY
+//
+// MessageText:
+//
+// The IOCTL call made by the application program is not correct.
+//
+#define ERROR_INVALID_CATEGORY                   117L
+
+//
+// MessageId: ERROR_INVALID_VERIFY_SWITCH
+//
+// MessageText:
+//
+// The verify-on-write switch parameter value is not correct.
+//
+#define ERROR_INVALID_VERIFY_SWITCH              118L
+
+//
+// MessageId: ERROR_BAD_DRIVER_LEVEL
+//
+// MessageText:
+//
+// The system does not support the command requested.
+//
+#define ERROR_BAD_DRIVER_LEVEL                   119L
+
+//
+// MessageId: ERROR_CALL_NOT_IMPLEMENTED
+//
+// MessageText:
+//
+// This function is not supported on this system.
+//
+#define ERROR_CALL_NOT_IMPLEMENTED               120L
+
+//
+// MessageId: ERROR_SEM_TIMEOUT
+//
+// MessageText:
+//
+// The semaphore timeout period has expired.
+//
+#define ERROR_SEM_TIMEOUT                        121L
+
+//
+// MessageId: ERROR_INSUFFICIENT_BUFFER
+//
+// MessageText:
+//
+// The data area passed to a system call is too small.
+//
+#define ERROR_INSUFFICIENT_BUFFER                122L
+
+//
+// MessageId: ERROR_INVALID_NAME
+//
+// MessageText:
+//
+// The filename, directory name, or volume label syntax is incorrect.
+//
+#define ERROR_INVALID_NAME                       123L
+
+//
+// MessageId: ERROR_INVALID_LEVEL
+//
+// MessageText:
+//
+// The system call level is not correct.
+//
+#define ERROR_INVALID_LEVEL                      124L
+
+//
+// MessageId: ERROR_NO_VOLUME_LABEL
+//
+// MessageText:
+//
+// The disk has no volume label.
+//
+#define ERROR_NO_VOLUME_LABEL                    125L
+
+//
+// MessageId: ERROR_MOD_NOT_FOUND
+//
+// MessageText:
+//
+// The specified module could not be found.
+//
+#define ERROR_MOD_NOT_FOUND                      126L
+
+//
+// MessageId: ERROR_PROC_NOT_FOUND
+//
+// MessageText:
+//
+// The specified procedure could not be found.
+//
+#define ERROR_PROC_NOT_FOUND                     127L
+
+//
+// MessageId: ERROR_WAIT_NO_CHILDREN
+//
+// MessageText:
+//
+// There are no child processes to wait for.
+//
+#define ERROR_WAIT_NO_CHILDREN                   128L
+
+//
+// MessageId: ERROR_CHILD_NOT_COMPLETE
+//
+// MessageText:
+//
+// The %1 application cannot be run in Win32 mode.
+//
+#define ERROR_CHILD_NOT_COMPLETE                 129L
+
+//
+// MessageId: ERROR_DIRECT_ACCESS_HANDLE
+//
+// MessageText:
+//
+// Attempt to use a file handle to an open disk partition for an operation
+// other than raw disk I/O.
+//
+#define ERROR_DIRECT_ACCESS_HANDLE               130L
+
+//
+// MessageId: ERROR_NEGATIVE_SEEK
+//
+// MessageText:
+//
+// An attempt was made to move the file pointer before the beginning of the
+// file.
+//
+#define ERROR_NEGATIVE_SEEK                      131L
+
+//
+// MessageId: ERROR_SEEK_ON_DEVICE
+//
+// MessageText:
+//
+// The file pointer cannot be set on the specified device or file.
+//
+#define ERROR_SEEK_ON_DEVICE                     132L
+
+//
+// MessageId: ERROR_IS_JOIN_TARGET
+//
+// MessageText:
+//
+// A JOIN or SUBST command cannot be used for a drive that contains previously
+// joined drives.
+//
+#define ERROR_IS_JOIN_TARGET                     133L
+
+//
+// MessageId: ERROR_IS_JOINED
+//
+// MessageText:
+//
+// An attempt was made to use a JOIN or SUBST command on a drive that has
+// already been joined.
+//
+#define ERROR_IS_JOINED                          134L
+
+//
+// MessageId: ERROR_IS_SUBSTED
+//
+// MessageText:
+//
+// An attempt was made to use a JOIN or SUBST command on a drive that has
+// already been substituted.
+//
+#define ERROR_IS_SUBSTED                         135L
+
+//
+// MessageId: ERROR_NOT_JOINED
+//
+// MessageText:
+//
+// The system tried to delete the JOIN of a drive that is not joined.
+//
+#define ERROR_NOT_JOINED                         136L
+
+//
+// MessageId: ERROR_NOT_SUBSTED
+//
+// MessageText:
+//
+// The system tried to delete the substitution of a drive that is not
+// substituted.
+//
+#define ERROR_NOT_SUBSTED                        137L
+
+//
+// MessageId: ERROR_JOIN_TO_JOIN
+//
+// MessageText:
+//
+// The system tried to join a drive to a directory on a joined drive.
+//
+#define ERROR_JOIN_TO_JOIN                       138L
+
+//
+// MessageId: ERROR_SUBST_TO_SUBST
+//
+// MessageText:
+//
+// The system tried to substitute a drive to a directory on a substituted
+// drive.
+//
+#define ERROR_SUBST_TO_SUBST                     139L
+
+//
+// MessageId: ERROR_JOIN_TO_SUBST
+//
+// MessageText:
+//
+// The system tried to join a drive to a directory on a substituted drive.
+//
+#define ERROR_JOIN_TO_SUBST                      140L
+
+//
+// MessageId: ERROR_SUBST_TO_JOIN
+//
+// MessageText:
+//
+// The system tried to SUBST a drive to a directory on a joined drive.
+//
+#define ERROR_SUBST_TO_JOIN                      141L
+
+//
+// MessageId: ERROR_BUSY_DRIVE
+//
+// MessageText:
+//
+// The system cannot perform a JOIN or SUBST at this time.
+//
+#define ERROR_BUSY_DRIVE                         142L
+
+//
+// MessageId: ERROR_SAME_DRIVE
+//
+// MessageText:
+//
+// The system cannot join or substitute a drive to or for a directory on the
+// same drive.
+//
+#define ERROR_SAME_DRIVE                         143L
+
+//
+// MessageId: ERROR_DIR_NOT_ROOT
+//
+// MessageText:
+//
+// The directory is not a subdirectory of the root directory.
+//
+#define ERROR_DIR_NOT_ROOT                       144L
+
+//
+// MessageId: ERROR_DIR_NOT_EMPTY
+//
+// MessageText:
+//
+// The directory is not empty.
+//
+#define ERROR_DIR_NOT_EMPTY                      145L
+
+//
+// MessageId: ERROR_IS_SUBST_PATH
+//
+// MessageText:
+//
+// The path specified is being used in a substitute.
+//
+#define ERROR_IS_SUBST_PATH                      146L
+
+//
+// MessageId: ERROR_IS_JOIN_PATH
+//
+// MessageText:
+//
+// Not enough resources are available to process this command.
+//
+#define ERROR_IS_JOIN_PATH                       147L
+
+//
+// MessageId: ERROR_PATH_BUSY
+//
+// MessageText:
+//
+// The path specified cannot be used at this time.
+//
+#define ERROR_PATH_BUSY                          148L
+
+//
+// MessageId: ERROR_IS_SUBST_TARGET
+//
+// MessageText:
+//
+// An attempt was made to join or substitute a drive for which a directory on
+// the drive is the target of a previous substitute.
+//
+#define ERROR_IS_SUBST_TARGET                    149L
+
+//
+// MessageId: ERROR_SYSTEM_TRACE
+//
+// MessageText:
+//
+// System trace information was not specified in your CONFIG.SYS file, or
+// tracing is disallowed.
+//
+#define ERROR_SYSTEM_TRACE                       150L
+
+//
+// MessageId: ERROR_INVALID_EVENT_COUNT
+//
+// MessageText:
+//
+// The number of specified semaphore events for DosMuxSemWait is not correct.
+//
+#define ERROR_INVALID_EVENT_COUNT                151L
+
+//
+// MessageId: ERROR_TOO_MANY_MUXWAITERS
+//
+// MessageText:
+//
+// DosMuxSemWait did not execute; too many semaphores are already set.
+//
+#define ERROR_TOO_MANY_MUXWAITERS                152L
+
+//
+// MessageId: ERROR_INVALID_LIST_FORMAT
+//
+// MessageText:
+//
+// The DosMuxSemWait list is not correct.
+//
+#define ERROR_INVALID_LIST_FORMAT                153L
+
+//
+// MessageId: ERROR_LABEL_TOO_LONG
+//
+// MessageText:
+//
+// The volume label you entered exceeds the label character limit of the target
+// file system.
+//
+#define ERROR_LABEL_TOO_LONG                     154L
+
+//
+// MessageId: ERROR_TOO_MANY_TCBS
+//
+// MessageText:
+//
+// Cannot create another thread.
+//
+#define ERROR_TOO_MANY_TCBS                      155L
+
+//
+// MessageId: ERROR_SIGNAL_REFUSED
+//
+// MessageText:
+//
+// The recipient process has refused the signal.
+//
+#define ERROR_SIGNAL_REFUSED                     156L
+
+//
+// MessageId: ERROR_DISCARDED
+//
+// MessageText:
+//
+// The segment is already discarded and cannot be locked.
+//
+#define ERROR_DISCARDED                          157L
+
+//
+// MessageId: ERROR_NOT_LOCKED
+//
+// MessageText:
+//
+// The segment is already unlocked.
+//
+#define ERROR_NOT_LOCKED                         158L
+
+//
+// MessageId: ERROR_BAD_THREADID_ADDR
+//
+// MessageText:
+//
+// The address for the thread ID is not correct.
+//
+#define ERROR_BAD_THREADID_ADDR                  159L
+
+//
+// MessageId: ERROR_BAD_ARGUMENTS
+//
+// MessageText:
+//
+// One or more arguments are not correct.
+//
+#define ERROR_BAD_ARGUMENTS                      160L
+
+//
+// MessageId: ERROR_BAD_PATHNAME
+//
+// MessageText:
+//
+// The specified path is invalid.
+//
+#define ERROR_BAD_PATHNAME                       161L
+
+//
+// MessageId: ERROR_SIGNAL_PENDING
+//
+// MessageText:
+//
+// A signal is already pending.
+//
+#define ERROR_SIGNAL_PENDING                     162L
+
+//
+// MessageId: ERROR_MAX_THRDS_REACHED
+//
+// MessageText:
+//
+// No more threads can be created in the system.
+//
+#define ERROR_MAX_THRDS_REACHED                  164L
+
+//
+// MessageId: ERROR_LOCK_FAILED
+//
+// MessageText:
+//
+// Unable to lock a region of a file.
+//
+#define ERROR_LOCK_FAILED                        167L
+
+//
+// MessageId: ERROR_BUSY
+//
+// MessageText:
+//
+// The requested resource is in use.
+//
+#define ERROR_BUSY                               170L
+
+//
+// MessageId: ERROR_DEVICE_SUPPORT_IN_PROGRESS
+//
+// MessageText:
+//
+// Device's command support detection is in progress.
+//
+#define ERROR_DEVICE_SUPPORT_IN_PROGRESS         171L
+
+//
+// MessageId: ERROR_CANCEL_VIOLATION
+//
+// MessageText:
+//
+// A lock request was not outstanding for the supplied cancel region.
+//
+#define ERROR_CANCEL_VIOLATION                   173L
+
+//
+// MessageId: ERROR_ATOMIC_LOCKS_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The file system does not support atomic changes to the lock type.
+//
+#define ERROR_ATOMIC_LOCKS_NOT_SUPPORTED         174L
+
+//
+// MessageId: ERROR_INVALID_SEGMENT_NUMBER
+//
+// MessageText:
+//
+// The system detected a segment number that was not correct.
+//
+#define ERROR_INVALID_SEGMENT_NUMBER             180L
+
+//
+// MessageId: ERROR_INVALID_ORDINAL
+//
+// MessageText:
+//
+// The operating system cannot run %1.
+//
+#define ERROR_INVALID_ORDINAL                    182L
+
+//
+// MessageId: ERROR_ALREADY_EXISTS
+//
+// MessageText:
+//
+// Cannot create a file when that file already exists.
+//
+#define ERROR_ALREADY_EXISTS                     183L
+
+//
+// MessageId: ERROR_INVALID_FLAG_NUMBER
+//
+// MessageText:
+//
+// The flag passed is not correct.
+//
+#define ERROR_INVALID_FLAG_NUMBER                186L
+
+//
+// MessageId: ERROR_SEM_NOT_FOUND
+//
+// MessageText:
+//
+// The specified system semaphore name was not found.
+//
+#define ERROR_SEM_NOT_FOUND                      187L
+
+//
+// MessageId: ERROR_INVALID_STARTING_CODESEG
+//
+// MessageText:
+//
+// The operating system cannot run %1.
+//
+#define ERROR_INVALID_STARTING_CODESEG           188L
+
+//
+// MessageId: ERROR_INVALID_STACKSEG
+//
+// MessageText:
+//
+// The operating system cannot run %1.
+//
+#define ERROR_INVALID_STACKSEG                   189L
+
+//
+// MessageId: ERROR_INVALID_MODULETYPE
+//
+// MessageText:
+//
+// The operating system cannot run %1.
+//
+#define ERROR_INVALID_MODULETYPE                 190L
+
+//
+// MessageId: ERROR_INVALID_EXE_SIGNATURE
+//
+// MessageText:
+//
+// Cannot run %1 in Win32 mode.
+//
+#define ERROR_INVALID_EXE_SIGNATURE              191L
+
+//
+// MessageId: ERROR_EXE_MARKED_INVALID
+//
+// MessageText:
+//
+// The operating system cannot run %1.
+//
+#define ERROR_EXE_MARKED_INVALID                 192L
+
+//
+// MessageId: ERROR_BAD_EXE_FORMAT
+//
+// MessageText:
+//
+// %1 is not a valid Win32 application.
+//
+#define ERROR_BAD_EXE_FORMAT                     193L
+
+//
+// MessageId: ERROR_ITERATED_DATA_EXCEEDS_64k
+//
+// MessageText:
+//
+// The operating system cannot run %1.
+//
+#define ERROR_ITERATED_DATA_EXCEEDS_64k          194L
+
+//
+// MessageId: ERROR_INVALID_MINALLOCSIZE
+//
+// MessageText:
+//
+// The operating system cannot run %1.
+//
+#define ERROR_INVALID_MINALLOCSIZE               195L
+
+//
+// MessageId: ERROR_DYNLINK_FROM_INVALID_RING
+//
+// MessageText:
+//
+// The operating system cannot run this application program.
+//
+#define ERROR_DYNLINK_FROM_INVALID_RING          196L
+
+//
+// MessageId: ERROR_IOPL_NOT_ENABLED
+//
+// MessageText:
+//
+// The operating system is not presently configured to run this application.
+//
+#define ERROR_IOPL_NOT_ENABLED                   197L
+
+//
+// MessageId: ERROR_INVALID_SEGDPL
+//
+// MessageText:
+//
+// The operating system cannot run %1.
+//
+#define ERROR_INVALID_SEGDPL                     198L
+
+//
+// MessageId: ERROR_AUTODATASEG_EXCEEDS_64k
+//
+// MessageText:
+//
+// The operating system cannot run this application program.
+//
+#define ERROR_AUTODATASEG_EXCEEDS_64k            199L
+
+//
+// MessageId: ERROR_RING2SEG_MUST_BE_MOVABLE
+//
+// MessageText:
+//
+// The code segment cannot be greater than or equal to 64K.
+//
+#define ERROR_RING2SEG_MUST_BE_MOVABLE           200L
+
+//
+// MessageId: ERROR_RELOC_CHAIN_XEEDS_SEGLIM
+//
+// MessageText:
+//
+// The operating system cannot run %1.
+//
+#define ERROR_RELOC_CHAIN_XEEDS_SEGLIM           201L
+
+//
+// MessageId: ERROR_INFLOOP_IN_RELOC_CHAIN
+//
+// MessageText:
+//
+// The operating system cannot run %1.
+//
+#define ERROR_INFLOOP_IN_RELOC_CHAIN             202L
+
+//
+// MessageId: ERROR_ENVVAR_NOT_FOUND
+//
+// MessageText:
+//
+// The system could not find the environment option that was entered.
+//
+#define ERROR_ENVVAR_NOT_FOUND                   203L
+
+//
+// MessageId: ERROR_NO_SIGNAL_SENT
+//
+// MessageText:
+//
+// No process in the command subtree has a signal handler.
+//
+#define ERROR_NO_SIGNAL_SENT                     205L
+
+//
+// MessageId: ERROR_FILENAME_EXCED_RANGE
+//
+// MessageText:
+//
+// The filename or extension is too long.
+//
+#define ERROR_FILENAME_EXCED_RANGE               206L
+
+//
+// MessageId: ERROR_RING2_STACK_IN_USE
+//
+// MessageText:
+//
+// The ring 2 stack is in use.
+//
+#define ERROR_RING2_STACK_IN_USE                 207L
+
+//
+// MessageId: ERROR_META_EXPANSION_TOO_LONG
+//
+// MessageText:
+//
+// The global filename characters, * or ?, are entered incorrectly or too many
+// global filename characters are specified.
+//
+#define ERROR_META_EXPANSION_TOO_LONG            208L
+
+//
+// MessageId: ERROR_INVALID_SIGNAL_NUMBER
+//
+// MessageText:
+//
+// The signal being posted is not correct.
+//
+#define ERROR_INVALID_SIGNAL_NUMBER              209L
+
+//
+// MessageId: ERROR_THREAD_1_INACTIVE
+//
+// MessageText:
+//
+// The signal handler cannot be set.
+//
+#define ERROR_THREAD_1_INACTIVE                  210L
+
+//
+// MessageId: ERROR_LOCKED
+//
+// MessageText:
+//
+// The segment is locked and cannot be reallocated.
+//
+#define ERROR_LOCKED                             212L
+
+//
+// MessageId: ERROR_TOO_MANY_MODULES
+//
+// MessageText:
+//
+// Too many dynamic-link modules are attached to this program or dynamic-link
+// module.
+//
+#define ERROR_TOO_MANY_MODULES                   214L
+
+//
+// MessageId: ERROR_NESTING_NOT_ALLOWED
+//
+// MessageText:
+//
+// Cannot nest calls to LoadModule.
+//
+#define ERROR_NESTING_NOT_ALLOWED                215L
+
+//
+// MessageId: ERROR_EXE_MACHINE_TYPE_MISMATCH
+//
+// MessageText:
+//
+// This version of %1 is not compatible with the version of Windows you're
+// running.
+//
+#define ERROR_EXE_MACHINE_TYPE_MISMATCH          216L
+
+//
+// MessageId: ERROR_EXE_CANNOT_MODIFY_SIGNED_BINARY
+//
+// MessageText:
+//
+// The image file %1 is signed, unable to modify.
+//
+#define ERROR_EXE_CANNOT_MODIFY_SIGNED_BINARY    217L
+
+//
+// MessageId: ERROR_EXE_CANNOT_MODIFY_STRONG_SIGNED_BINARY
+//
+// MessageText:
+//
+// The image file %1 is strong signed, unable to modify.
+//
+#define ERROR_EXE_CANNOT_MODIFY_STRONG_SIGNED_BINARY 218L
+
+//
+// MessageId: ERROR_FILE_CHECKED_OUT
+//
+// MessageText:
+//
+// This file is checked out or locked for editing by another user.
+//
+#define ERROR_FILE_CHECKED_OUT                   220L
+
+//
+// MessageId: ERROR_CHECKOUT_REQUIRED
+//
+// MessageText:
+//
+// The file must be checked out before saving changes.
+//
+#define ERROR_CHECKOUT_REQUIRED                  221L
+
+//
+// MessageId: ERROR_BAD_FILE_TYPE
+//
+// MessageText:
+//
+// The file type being saved or retrieved has been blocked.
+//
+#define ERROR_BAD_FILE_TYPE                      222L
+
+//
+// MessageId: ERROR_FILE_TOO_LARGE
+//
+// MessageText:
+//
+// The file size exceeds the limit allowed and cannot be saved.
+//
+#define ERROR_FILE_TOO_LARGE                     223L
+
+//
+// MessageId: ERROR_FORMS_AUTH_REQUIRED
+//
+// MessageText:
+//
+// Access Denied. Before opening files in this location, you must first add the
+// web site to your trusted sites list.
+//
+#define ERROR_FORMS_AUTH_REQUIRED                224L
+
+//
+// MessageId: ERROR_VIRUS_INFECTED
+//
+// MessageText:
+//
+// Operation did not complete successfully because the file contains a virus or
+// potentially unwanted software.
+//
+#define ERROR_VIRUS_INFECTED                     225L
+
+//
+// MessageId: ERROR_VIRUS_DELETED
+//
+// MessageText:
+//
+// This file contains a virus or potentially unwanted software and cannot be
+// opened.
+//
+#define ERROR_VIRUS_DELETED                      226L
+
+//
+// MessageId: ERROR_PIPE_LOCAL
+//
+// MessageText:
+//
+// The pipe is local.
+//
+#define ERROR_PIPE_LOCAL                         229L
+
+//
+// MessageId: ERROR_BAD_PIPE
+//
+// MessageText:
+//
+// The pipe state is invalid.
+//
+#define ERROR_BAD_PIPE                           230L
+
+//
+// MessageId: ERROR_PIPE_BUSY
+//
+// MessageText:
+//
+// All pipe instances are busy.
+//
+#define ERROR_PIPE_BUSY                          231L
+
+//
+// MessageId: ERROR_NO_DATA
+//
+// MessageText:
+//
+// The pipe is being closed.
+//
+#define ERROR_NO_DATA                            232L
+
+//
+// MessageId: ERROR_PIPE_NOT_CONNECTED
+//
+// MessageText:
+//
+// No process is on the other end of the pipe.
+//
+#define ERROR_PIPE_NOT_CONNECTED                 233L
+
+//
+// MessageId: ERROR_MORE_DATA
+//
+// MessageText:
+//
+// More data is available.
+//
+#define ERROR_MORE_DATA                          234L
+
+//
+// MessageId: ERROR_NO_WORK_DONE
+//
+// MessageText:
+//
+// Not all the requested work was done.
+//
+#define ERROR_NO_WORK_DONE                       235L
+
+//
+// MessageId: ERROR_VC_DISCONNECTED
+//
+// MessageText:
+//
+// The session was canceled.
+//
+#define ERROR_VC_DISCONNECTED                    240L
+
+//
+// MessageId: ERROR_INVALID_EA_NAME
+//
+// MessageText:
+//
+// The specified extended attribute name was invalid.
+//
+#define ERROR_INVALID_EA_NAME                    254L
+
+//
+// MessageId: ERROR_EA_LIST_INCONSISTENT
+//
+// MessageText:
+//
+// The extended attributes are inconsistent.
+//
+#define ERROR_EA_LIST_INCONSISTENT               255L
+
+//
+// MessageId: WAIT_TIMEOUT
+//
+// MessageText:
+//
+// The wait operation timed out.
+//
+#define WAIT_TIMEOUT                             258L
+
+//
+// MessageId: ERROR_NO_MORE_ITEMS
+//
+// MessageText:
+//
+// No more data is available.
+//
+#define ERROR_NO_MORE_ITEMS                      259L
+
+//
+// MessageId: ERROR_CANNOT_COPY
+//
+// MessageText:
+//
+// The copy functions cannot be used.
+//
+#define ERROR_CANNOT_COPY                        266L
+
+//
+// MessageId: ERROR_DIRECTORY
+//
+// MessageText:
+//
+// The directory name is invalid.
+//
+#define ERROR_DIRECTORY                          267L
+
+//
+// MessageId: ERROR_EAS_DIDNT_FIT
+//
+// MessageText:
+//
+// The extended attributes did not fit in the buffer.
+//
+#define ERROR_EAS_DIDNT_FIT                      275L
+
+//
+// MessageId: ERROR_EA_FILE_CORRUPT
+//
+// MessageText:
+//
+// The extended attribute file on the mounted file system is corrupt.
+//
+#define ERROR_EA_FILE_CORRUPT                    276L
+
+//
+// MessageId: ERROR_EA_TABLE_FULL
+//
+// MessageText:
+//
+// The extended attribute table file is full.
+//
+#define ERROR_EA_TABLE_FULL                      277L
+
+//
+// MessageId: ERROR_INVALID_EA_HANDLE
+//
+// MessageText:
+//
+// The specified extended attribute handle is invalid.
+//
+#define ERROR_INVALID_EA_HANDLE                  278L
+
+//
+// MessageId: ERROR_EAS_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The mounted file system does not support extended attributes.
+//
+#define ERROR_EAS_NOT_SUPPORTED                  282L
+
+//
+// MessageId: ERROR_NOT_OWNER
+//
+// MessageText:
+//
+// Attempt to release mutex not owned by caller.
+//
+#define ERROR_NOT_OWNER                          288L
+
+//
+// MessageId: ERROR_TOO_MANY_POSTS
+//
+// MessageText:
+//
+// Too many posts were made to a semaphore.
+//
+#define ERROR_TOO_MANY_POSTS                     298L
+
+//
+// MessageId: ERROR_PARTIAL_COPY
+//
+// MessageText:
+//
+// Only part of a ReadProcessMemory or WriteProcessMemory request was
+// completed.
+//
+#define ERROR_PARTIAL_COPY                       299L
+
+//
+// MessageId: ERROR_OPLOCK_NOT_GRANTED
+//
+// MessageText:
+//
+// The oplock request is denied.
+//
+#define ERROR_OPLOCK_NOT_GRANTED                 300L
+
+//
+// MessageId: ERROR_INVALID_OPLOCK_PROTOCOL
+//
+// MessageText:
+//
+// An invalid oplock acknowledgment was received by the system.
+//
+#define ERROR_INVALID_OPLOCK_PROTOCOL            301L
+
+//
+// MessageId: ERROR_DISK_TOO_FRAGMENTED
+//
+// MessageText:
+//
+// The volume is too fragmented to complete this operation.
+//
+#define ERROR_DISK_TOO_FRAGMENTED                302L
+
+//
+// MessageId: ERROR_DELETE_PENDING
+//
+// MessageText:
+//
+// The file cannot be opened because it is in the process of being deleted.
+//
+#define ERROR_DELETE_PENDING                     303L
+
+//
+// MessageId: ERROR_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING
+//
+// MessageText:
+//
+// Short name settings may not be changed on this volume due to the global
+// registry setting.
+//
+#define ERROR_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING 304L
+
+//
+// MessageId: ERROR_SHORT_NAMES_NOT_ENABLED_ON_VOLUME
+//
+// MessageText:
+//
+// Short names are not enabled on this volume.
+//
+#define ERROR_SHORT_NAMES_NOT_ENABLED_ON_VOLUME  305L
+
+//
+// MessageId: ERROR_SECURITY_STREAM_IS_INCONSISTENT
+//
+// MessageText:
+//
+// The security stream for the given volume is in an inconsistent state.
+//
+#define ERROR_SECURITY_STREAM_IS_INCONSISTENT    306L
+
+//
+// MessageId: ERROR_INVALID_LOCK_RANGE
+//
+// MessageText:
+//
+// A requested file lock operation cannot be processed due to an invalid byte
+// range.
+//
+#define ERROR_INVALID_LOCK_RANGE                 307L
+
+//
+// MessageId: ERROR_IMAGE_SUBSYSTEM_NOT_PRESENT
+//
+// MessageText:
+//
+// The subsystem needed to support the image type is not present.
+//
+#define ERROR_IMAGE_SUBSYSTEM_NOT_PRESENT        308L
+
+//
+// MessageId: ERROR_NOTIFICATION_GUID_ALREADY_DEFINED
+//
+// MessageText:
+//
+// The specified file already has a notification GUID associated with it.
+//
+#define ERROR_NOTIFICATION_GUID_ALREADY_DEFINED  309L
+
+//
+// MessageId: ERROR_INVALID_EXCEPTION_HANDLER
+//
+// MessageText:
+//
+// An invalid exception handler routine has been detected.
+//
+#define ERROR_INVALID_EXCEPTION_HANDLER          310L
+
+//
+// MessageId: ERROR_DUPLICATE_PRIVILEGES
+//
+// MessageText:
+//
+// Duplicate privileges were specified for the token.
+//
+#define ERROR_DUPLICATE_PRIVILEGES               311L
+
+//
+// MessageId: ERROR_NO_RANGES_PROCESSED
+//
+// MessageText:
+//
+// No ranges for the specified operation were able to be processed.
+//
+#define ERROR_NO_RANGES_PROCESSED                312L
+
+//
+// MessageId: ERROR_NOT_ALLOWED_ON_SYSTEM_FILE
+//
+// MessageText:
+//
+// Operation is not allowed on a file system internal file.
+//
+#define ERROR_NOT_ALLOWED_ON_SYSTEM_FILE         313L
+
+//
+// MessageId: ERROR_DISK_RESOURCES_EXHAUSTED
+//
+// MessageText:
+//
+// The physical resources of this disk have been exhausted.
+//
+#define ERROR_DISK_RESOURCES_EXHAUSTED           314L
+
+//
+// MessageId: ERROR_INVALID_TOKEN
+//
+// MessageText:
+//
+// The token representing the data is invalid.
+//
+#define ERROR_INVALID_TOKEN                      315L
+
+//
+// MessageId: ERROR_DEVICE_FEATURE_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The device does not support the command feature.
+//
+#define ERROR_DEVICE_FEATURE_NOT_SUPPORTED       316L
+
+//
+// MessageId: ERROR_MR_MID_NOT_FOUND
+//
+// MessageText:
+//
+// The system cannot find message text for message number 0x%1 in the message
+// file for %2.
+//
+#define ERROR_MR_MID_NOT_FOUND                   317L
+
+//
+// MessageId: ERROR_SCOPE_NOT_FOUND
+//
+// MessageText:
+//
+// The scope specified was not found.
+//
+#define ERROR_SCOPE_NOT_FOUND                    318L
+
+//
+// MessageId: ERROR_UNDEFINED_SCOPE
+//
+// MessageText:
+//
+// The Central Access Policy specified is not defined on the target machine.
+//
+#define ERROR_UNDEFINED_SCOPE                    319L
+
+//
+// MessageId: ERROR_INVALID_CAP
+//
+// MessageText:
+//
+// The Central Access Policy obtained from Active Directory is invalid.
+//
+#define ERROR_INVALID_CAP                        320L
+
+//
+// MessageId: ERROR_DEVICE_UNREACHABLE
+//
+// MessageText:
+//
+// The device is unreachable.
+//
+#define ERROR_DEVICE_UNREACHABLE                 321L
+
+//
+// MessageId: ERROR_DEVICE_NO_RESOURCES
+//
+// MessageText:
+//
+// The target device has insufficient resources to complete the operation.
+//
+#define ERROR_DEVICE_NO_RESOURCES                322L
+
+//
+// MessageId: ERROR_DATA_CHECKSUM_ERROR
+//
+// MessageText:
+//
+// A data integrity checksum error occurred. Data in the file stream is
+// corrupt.
+//
+#define ERROR_DATA_CHECKSUM_ERROR                323L
+
+//
+// MessageId: ERROR_INTERMIXED_KERNEL_EA_OPERATION
+//
+// MessageText:
+//
+// An attempt was made to modify both a KERNEL and normal Extended Attribute
+// (EA) in the same operation.
+//
+#define ERROR_INTERMIXED_KERNEL_EA_OPERATION     324L
+
+//
+// MessageId: ERROR_FILE_LEVEL_TRIM_NOT_SUPPORTED
+//
+// MessageText:
+//
+// Device does not support file-level TRIM.
+//
+#define ERROR_FILE_LEVEL_TRIM_NOT_SUPPORTED      326L
+
+//
+// MessageId: ERROR_OFFSET_ALIGNMENT_VIOLATION
+//
+// MessageText:
+//
+// The command specified a data offset that does not align to the device's
+// granularity/alignment.
+//
+#define ERROR_OFFSET_ALIGNMENT_VIOLATION         327L
+
+//
+// MessageId: ERROR_INVALID_FIELD_IN_PARAMETER_LIST
+//
+// MessageText:
+//
+// The command specified an invalid field in its parameter list.
+//
+#define ERROR_INVALID_FIELD_IN_PARAMETER_LIST    328L
+
+//
+// MessageId: ERROR_OPERATION_IN_PROGRESS
+//
+// MessageText:
+//
+// An operation is currently in progress with the device.
+//
+#define ERROR_OPERATION_IN_PROGRESS              329L
+
+//
+// MessageId: ERROR_BAD_DEVICE_PATH
+//
+// MessageText:
+//
+// An attempt was made to send down the command via an invalid path to the
+// target device.
+//
+#define ERROR_BAD_DEVICE_PATH                    330L
+
+//
+// MessageId: ERROR_TOO_MANY_DESCRIPTORS
+//
+// MessageText:
+//
+// The command specified a number of descriptors that exceeded the maximum
+// supported by the device.
+//
+#define ERROR_TOO_MANY_DESCRIPTORS               331L
+
+//
+// MessageId: ERROR_SCRUB_DATA_DISABLED
+//
+// MessageText:
+//
+// Scrub is disabled on the specified file.
+//
+#define ERROR_SCRUB_DATA_DISABLED                332L
+
+//
+// MessageId: ERROR_NOT_REDUNDANT_STORAGE
+//
+// MessageText:
+//
+// The storage device does not provide redundancy.
+//
+#define ERROR_NOT_REDUNDANT_STORAGE              333L
+
+//
+// MessageId: ERROR_RESIDENT_FILE_NOT_SUPPORTED
+//
+// MessageText:
+//
+// An operation is not supported on a resident file.
+//
+#define ERROR_RESIDENT_FILE_NOT_SUPPORTED        334L
+
+//
+// MessageId: ERROR_COMPRESSED_FILE_NOT_SUPPORTED
+//
+// MessageText:
+//
+// An operation is not supported on a compressed file.
+//
+#define ERROR_COMPRESSED_FILE_NOT_SUPPORTED      335L
+
+//
+// MessageId: ERROR_DIRECTORY_NOT_SUPPORTED
+//
+// MessageText:
+//
+// An operation is not supported on a directory.
+//
+#define ERROR_DIRECTORY_NOT_SUPPORTED            336L
+
+//
+// MessageId: ERROR_NOT_READ_FROM_COPY
+//
+// MessageText:
+//
+// The specified copy of the requested data could not be read.
+//
+#define ERROR_NOT_READ_FROM_COPY                 337L
+
+//
+// MessageId: ERROR_FT_WRITE_FAILURE
+//
+// MessageText:
+//
+// The operation could not be completed due to a fault tolerance write failure.
+//
+#define ERROR_FT_WRITE_FAILURE                   338L
+
+#define ERROR_FT_DI_SCAN_REQUIRED                339L
+
+//
+// MessageId: ERROR_INVALID_KERNEL_INFO_VERSION
+//
+// MessageText:
+//
+// The version of the supplied kernel information is invalid.
+//
+#define ERROR_INVALID_KERNEL_INFO_VERSION        340L
+
+//
+// MessageId: ERROR_INVALID_PEP_INFO_VERSION
+//
+// MessageText:
+//
+// The version of the supplied PEP information is invalid.
+//
+#define ERROR_INVALID_PEP_INFO_VERSION           341L
+
+//
+// MessageId: ERROR_OBJECT_NOT_EXTERNALLY_BACKED
+//
+// MessageText:
+//
+// This object is not externally backed by any provider.
+//
+#define ERROR_OBJECT_NOT_EXTERNALLY_BACKED       342L
+
+//
+// MessageId: ERROR_EXTERNAL_BACKING_PROVIDER_UNKNOWN
+//
+// MessageText:
+//
+// The external backing provider is not recognized.
+//
+#define ERROR_EXTERNAL_BACKING_PROVIDER_UNKNOWN  343L
+
+//
+// MessageId: ERROR_COMPRESSION_NOT_BENEFICIAL
+//
+// MessageText:
+//
+// Compressing this object would not save space.
+//
+#define ERROR_COMPRESSION_NOT_BENEFICIAL         344L
+
+#define ERROR_STORAGE_TOPOLOGY_ID_MISMATCH       345L
+
+#define ERROR_BLOCKED_BY_PARENTAL_CONTROLS       346L
+
+#define ERROR_BLOCK_TOO_MANY_REFERENCES          347L
+
+#define ERROR_MARKED_TO_DISALLOW_WRITES          348L
+
+//
+// MessageId: ERROR_ENCLAVE_FAILURE
+//
+// MessageText:
+//
+// The requested operation failed due to an enclave failure.
+//
+#define ERROR_ENCLAVE_FAILURE                    349L
+
+//
+// MessageId: ERROR_FAIL_NOACTION_REBOOT
+//
+// MessageText:
+//
+// No action was taken as a system reboot is required.
+//
+#define ERROR_FAIL_NOACTION_REBOOT               350L
+
+//
+// MessageId: ERROR_FAIL_SHUTDOWN
+//
+// MessageText:
+//
+// The shutdown operation failed.
+//
+#define ERROR_FAIL_SHUTDOWN                      351L
+
+//
+// MessageId: ERROR_FAIL_RESTART
+//
+// MessageText:
+//
+// The restart operation failed.
+//
+#define ERROR_FAIL_RESTART                       352L
+
+//
+// MessageId: ERROR_MAX_SESSIONS_REACHED
+//
+// MessageText:
+//
+// The maximum number of sessions has been reached.
+//
+#define ERROR_MAX_SESSIONS_REACHED               353L
+
+//
+// MessageId: ERROR_NETWORK_ACCESS_DENIED_EDP
+//
+// MessageText:
+//
+// The network access is denied by the Enterprise Data Protection policy.
+//
+#define ERROR_NETWORK_ACCESS_DENIED_EDP          354L
+
+//
+// MessageId: ERROR_DEVICE_HINT_NAME_BUFFER_TOO_SMALL
+//
+// MessageText:
+//
+// The device hint name buffer is too small to receive the remaining name.
+//
+#define ERROR_DEVICE_HINT_NAME_BUFFER_TOO_SMALL  355L
+
+//
+// MessageId: ERROR_EDP_POLICY_DENIES_OPERATION
+//
+// MessageText:
+//
+// The requested operation was blocked as per your company's Enterprise Data
+// Protection policy.
+//
+#define ERROR_EDP_POLICY_DENIES_OPERATION        356L
+
+#define ERROR_EDP_DPL_POLICY_CANT_BE_SATISFIED   357L
+
+#define ERROR_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT 358L
+
+//
+// MessageId: ERROR_DEVICE_IN_MAINTENANCE
+//
+// MessageText:
+//
+// The device is in maintenance mode.
+//
+#define ERROR_DEVICE_IN_MAINTENANCE              359L
+
+//
+// MessageId: ERROR_NOT_SUPPORTED_ON_DAX
+//
+// MessageText:
+//
+// This operation is not supported on a DAX volume.
+//
+#define ERROR_NOT_SUPPORTED_ON_DAX               360L
+
+//
+// MessageId: ERROR_DAX_MAPPING_EXISTS
+//
+// MessageText:
+//
+// The volume has active DAX mappings.
+//
+#define ERROR_DAX_MAPPING_EXISTS                 361L
+
+//
+// MessageId: ERROR_CLOUD_FILE_PROVIDER_NOT_RUNNING
+//
+// MessageText:
+//
+// The cloud file provider is not running.
+//
+#define ERROR_CLOUD_FILE_PROVIDER_NOT_RUNNING    362L
+
+//
+// MessageId: ERROR_CLOUD_FILE_METADATA_CORRUPT
+//
+// MessageText:
+//
+// The cloud file metadata is corrupt and unreadable.
+//
+#define ERROR_CLOUD_FILE_METADATA_CORRUPT        363L
+
+//
+// MessageId: ERROR_CLOUD_FILE_METADATA_TOO_LARGE
+//
+// MessageText:
+//
+// The cloud file metadata is too large.
+//
+#define ERROR_CLOUD_FILE_METADATA_TOO_LARGE      364L
+
+//
+// MessageId: ERROR_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE
+//
+// MessageText:
+//
+// The cloud file property is too large.
+//
+#define ERROR_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE 365L
+
+//
+// MessageId: ERROR_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH
+//
+// MessageText:
+//
+// The cloud file property is possibly corrupt. The on-disk checksum does not
+// match the computed checksum.
+//
+#define ERROR_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH 366L
+
+//
+// MessageId: ERROR_CHILD_PROCESS_BLOCKED
+//
+// MessageText:
+//
+// The process creation has been blocked.
+//
+#define ERROR_CHILD_PROCESS_BLOCKED              367L
+
+//
+// MessageId: ERROR_STORAGE_LOST_DATA_PERSISTENCE
+//
+// MessageText:
+//
+// The storage device has lost data or persistence.
+//
+#define ERROR_STORAGE_LOST_DATA_PERSISTENCE      368L
+
+//
+// MessageId: ERROR_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE
+//
+// MessageText:
+//
+// The provider that supports file system virtualization is temporarily
+// unavailable.
+//
+#define ERROR_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE 369L
+
+//
+// MessageId: ERROR_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT
+//
+// MessageText:
+//
+// The metadata for file system virtualization is corrupt and unreadable.
+//
+#define ERROR_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT 370L
+
+//
+// MessageId: ERROR_FILE_SYSTEM_VIRTUALIZATION_BUSY
+//
+// MessageText:
+//
+// The provider that supports file system virtualization is too busy to
+// complete this operation.
+//
+#define ERROR_FILE_SYSTEM_VIRTUALIZATION_BUSY    371L
+
+//
+// MessageId: ERROR_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN
+//
+// MessageText:
+//
+// The provider that supports file system virtualization is unknown.
+//
+#define ERROR_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN 372L
+
+//
+// MessageId: ERROR_GDI_HANDLE_LEAK
+//
+// MessageText:
+//
+// GDI handles were potentially leaked by the application.
+//
+#define ERROR_GDI_HANDLE_LEAK                    373L
+
+//
+// MessageId: ERROR_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS
+//
+// MessageText:
+//
+// The maximum number of cloud file properties has been reached.
+//
+#define ERROR_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS 374L
+
+//
+// MessageId: ERROR_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The version of the cloud file property store is not supported.
+//
+#define ERROR_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED 375L
+
+//
+// MessageId: ERROR_NOT_A_CLOUD_FILE
+//
+// MessageText:
+//
+// The file is not a cloud file.
+//
+#define ERROR_NOT_A_CLOUD_FILE                   376L
+
+//
+// MessageId: ERROR_CLOUD_FILE_NOT_IN_SYNC
+//
+// MessageText:
+//
+// The file is not in sync with the cloud.
+//
+#define ERROR_CLOUD_FILE_NOT_IN_SYNC             377L
+
+//
+// MessageId: ERROR_CLOUD_FILE_ALREADY_CONNECTED
+//
+// MessageText:
+//
+// The cloud sync root is already connected with another cloud sync provider.
+//
+#define ERROR_CLOUD_FILE_ALREADY_CONNECTED       378L
+
+//
+// MessageId: ERROR_CLOUD_FILE_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The operation is not supported by the cloud sync provider.
+//
+#define ERROR_CLOUD_FILE_NOT_SUPPORTED           379L
+
+//
+// MessageId: ERROR_CLOUD_FILE_INVALID_REQUEST
+//
+// MessageText:
+//
+// The cloud operation is invalid.
+//
+#define ERROR_CLOUD_FILE_INVALID_REQUEST         380L
+
+//
+// MessageId: ERROR_CLOUD_FILE_READ_ONLY_VOLUME
+//
+// MessageText:
+//
+// The cloud operation is not supported on a read-only volume.
+//
+#define ERROR_CLOUD_FILE_READ_ONLY_VOLUME        381L
+
+//
+// MessageId: ERROR_CLOUD_FILE_CONNECTED_PROVIDER_ONLY
+//
+// MessageText:
+//
+// The operation is reserved for a connected cloud sync provider.
+//
+#define ERROR_CLOUD_FILE_CONNECTED_PROVIDER_ONLY 382L
+
+//
+// MessageId: ERROR_CLOUD_FILE_VALIDATION_FAILED
+//
+// MessageText:
+//
+// The cloud sync provider failed to validate the downloaded data.
+//
+#define ERROR_CLOUD_FILE_VALIDATION_FAILED       383L
+
+//
+// MessageId: ERROR_SMB1_NOT_AVAILABLE
+//
+// MessageText:
+//
+// The SMB client requested a CIFS dialect, but the server does not support it.
+//
+#define ERROR_SMB1_NOT_AVAILABLE                 384L
+
+//
+// MessageId: ERROR_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION
+//
+// MessageText:
+//
+// The file system virtualization provider cannot perform the requested
+// operation.
+//
+#define ERROR_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION 385L
+
+//
+// MessageId: ERROR_CLOUD_FILE_AUTHENTICATION_FAILED
+//
+// MessageText:
+//
+// The cloud operation was unsuccessful due to an authentication failure.
+//
+#define ERROR_CLOUD_FILE_AUTHENTICATION_FAILED   386L
+
+//
+// MessageId: ERROR_CLOUD_FILE_INSUFFICIENT_RESOURCES
+//
+// MessageText:
+//
+// The cloud operation was not completed due to insufficient system resources.
+//
+#define ERROR_CLOUD_FILE_INSUFFICIENT_RESOURCES  387L
+
+//
+// MessageId: ERROR_CLOUD_FILE_NETWORK_UNAVAILABLE
+//
+// MessageText:
+//
+// The cloud operation was not completed due to a network failure.
+//
+#define ERROR_CLOUD_FILE_NETWORK_UNAVAILABLE     388L
+
+//
+// MessageId: ERROR_CLOUD_FILE_UNSUCCESSFUL
+//
+// MessageText:
+//
+// The cloud operation was unsuccessful.
+//
+#define ERROR_CLOUD_FILE_UNSUCCESSFUL            389L
+
+//
+// MessageId: ERROR_CLOUD_FILE_NOT_UNDER_SYNC_ROOT
+//
+// MessageText:
+//
+// The operation is only supported on files under a cloud sync root.
+//
+#define ERROR_CLOUD_FILE_NOT_UNDER_SYNC_ROOT     390L
+
+//
+// MessageId: ERROR_CLOUD_FILE_IN_USE
+//
+// MessageText:
+//
+// The operation cannot be performed on cloud files in use.
+//
+#define ERROR_CLOUD_FILE_IN_USE                  391L
+
+//
+// MessageId: ERROR_CLOUD_FILE_PINNED
+//
+// MessageText:
+//
+// The operation cannot be performed on pinned cloud files.
+//
+#define ERROR_CLOUD_FILE_PINNED                  392L
+
+//
+// MessageId: ERROR_CLOUD_FILE_REQUEST_ABORTED
+//
+// MessageText:
+//
+// The cloud operation was aborted.
+//
+#define ERROR_CLOUD_FILE_REQUEST_ABORTED         393L
+
+//
+// MessageId: ERROR_CLOUD_FILE_PROPERTY_CORRUPT
+//
+// MessageText:
+//
+// The cloud file's property store is corrupt.
+//
+#define ERROR_CLOUD_FILE_PROPERTY_CORRUPT        394L
+
+//
+// MessageId: ERROR_CLOUD_FILE_ACCESS_DENIED
+//
+// MessageText:
+//
+// Access to the cloud file is denied.
+//
+#define ERROR_CLOUD_FILE_ACCESS_DENIED           395L
+
+//
+// MessageId: ERROR_CLOUD_FILE_INCOMPATIBLE_HARDLINKS
+//
+// MessageText:
+//
+// The cloud operation cannot be performed on a file with incompatible
+// hardlinks.
+//
+#define ERROR_CLOUD_FILE_INCOMPATIBLE_HARDLINKS  396L
+
+//
+// MessageId: ERROR_CLOUD_FILE_PROPERTY_LOCK_CONFLICT
+//
+// MessageText:
+//
+// The operation failed due to a conflicting cloud file property lock.
+//
+#define ERROR_CLOUD_FILE_PROPERTY_LOCK_CONFLICT  397L
+
+//
+// MessageId: ERROR_CLOUD_FILE_REQUEST_CANCELED
+//
+// MessageText:
+//
+// The cloud operation was canceled by user.
+//
+#define ERROR_CLOUD_FILE_REQUEST_CANCELED        398L
+
+//
+// MessageId: ERROR_EXTERNAL_SYSKEY_NOT_SUPPORTED
+//
+// MessageText:
+//
+// An externally encrypted syskey has been configured, but the system no longer
+// supports this feature.
+//
+#define ERROR_EXTERNAL_SYSKEY_NOT_SUPPORTED      399L
+
+//
+// MessageId: ERROR_THREAD_MODE_ALREADY_BACKGROUND
+//
+// MessageText:
+//
+// The thread is already in background processing mode.
+//
+#define ERROR_THREAD_MODE_ALREADY_BACKGROUND     400L
+
+//
+// MessageId: ERROR_THREAD_MODE_NOT_BACKGROUND
+//
+// MessageText:
+//
+// The thread is not in background processing mode.
+//
+#define ERROR_THREAD_MODE_NOT_BACKGROUND         401L
+
+//
+// MessageId: ERROR_PROCESS_MODE_ALREADY_BACKGROUND
+//
+// MessageText:
+//
+// The process is already in background processing mode.
+//
+#define ERROR_PROCESS_MODE_ALREADY_BACKGROUND    402L
+
+//
+// MessageId: ERROR_PROCESS_MODE_NOT_BACKGROUND
+//
+// MessageText:
+//
+// The process is not in background processing mode.
+//
+#define ERROR_PROCESS_MODE_NOT_BACKGROUND        403L
+
+//
+// MessageId: ERROR_CLOUD_FILE_PROVIDER_TERMINATED
+//
+// MessageText:
+//
+// The cloud file provider exited unexpectedly.
+//
+#define ERROR_CLOUD_FILE_PROVIDER_TERMINATED     404L
+
+//
+// MessageId: ERROR_NOT_A_CLOUD_SYNC_ROOT
+//
+// MessageText:
+//
+// The file is not a cloud sync root.
+//
+#define ERROR_NOT_A_CLOUD_SYNC_ROOT              405L
+
+//
+// MessageId: ERROR_FILE_PROTECTED_UNDER_DPL
+//
+// MessageText:
+//
+// The read or write operation to an encrypted file is not supported.
+//
+#define ERROR_FILE_PROTECTED_UNDER_DPL           406L
+
+//
+// MessageId: ERROR_VOLUME_NOT_CLUSTER_ALIGNED
+//
+// MessageText:
+//
+// The volume is not cluster aligned on the disk.
+//
+#define ERROR_VOLUME_NOT_CLUSTER_ALIGNED         407L
+
+//
+// MessageId: ERROR_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND
+//
+// MessageText:
+//
+// No physically aligned free space was found on the volume.
+//
+#define ERROR_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND 408L
+
+//
+// MessageId: ERROR_APPX_FILE_NOT_ENCRYPTED
+//
+// MessageText:
+//
+// The APPX file can not be accessed because it is not encrypted as expected.
+//
+#define ERROR_APPX_FILE_NOT_ENCRYPTED            409L
+
+#define ERROR_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED 410L
+
+#define ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET 411L
+
+#define ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE 412L
+
+#define ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER 413L
+
+//
+// MessageId: ERROR_LINUX_SUBSYSTEM_NOT_PRESENT
+//
+// MessageText:
+//
+// The Windows Subsystem for Linux has not been enabled.
+//
+#define ERROR_LINUX_SUBSYSTEM_NOT_PRESENT        414L
+
+//
+// MessageId: ERROR_FT_READ_FAILURE
+//
+// MessageText:
+//
+// The operation could not be completed due to a fault tolerance read failure.
+//
+#define ERROR_FT_READ_FAILURE                    415L
+
+//
+// MessageId: ERROR_STORAGE_RESERVE_ID_INVALID
+//
+// MessageText:
+//
+// The specified storage reserve ID is invalid.
+//
+#define ERROR_STORAGE_RESERVE_ID_INVALID         416L
+
+//
+// MessageId: ERROR_STORAGE_RESERVE_DOES_NOT_EXIST
+//
+// MessageText:
+//
+// The specified storage reserve does not exist.
+//
+#define ERROR_STORAGE_RESERVE_DOES_NOT_EXIST     417L
+
+//
+// MessageId: ERROR_STORAGE_RESERVE_ALREADY_EXISTS
+//
+// MessageText:
+//
+// The specified storage reserve already exists.
+//
+#define ERROR_STORAGE_RESERVE_ALREADY_EXISTS     418L
+
+//
+// MessageId: ERROR_STORAGE_RESERVE_NOT_EMPTY
+//
+// MessageText:
+//
+// The specified storage reserve is not empty.
+//
+#define ERROR_STORAGE_RESERVE_NOT_EMPTY          419L
+
+//
+// MessageId: ERROR_NOT_A_DAX_VOLUME
+//
+// MessageText:
+//
+// This operation requires a DAX volume.
+//
+#define ERROR_NOT_A_DAX_VOLUME                   420L
+
+//
+// MessageId: ERROR_NOT_DAX_MAPPABLE
+//
+// MessageText:
+//
+// This stream is not DAX mappable.
+//
+#define ERROR_NOT_DAX_MAPPABLE                   421L
+
+//
+// MessageId: ERROR_TIME_SENSITIVE_THREAD
+//
+// MessageText:
+//
+// Time-critical thread is not allowed.
+//
+#define ERROR_TIME_SENSITIVE_THREAD              422L
+
+#define ERROR_DPL_NOT_SUPPORTED_FOR_USER         423L
+
+//
+// MessageId: ERROR_CASE_DIFFERING_NAMES_IN_DIR
+//
+// MessageText:
+//
+// The directory contains entries whose names differ only in case.
+//
+#define ERROR_CASE_DIFFERING_NAMES_IN_DIR        424L
+
+//
+// MessageId: ERROR_FILE_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The file cannot be safely opened because it is not supported.
+//
+#define ERROR_FILE_NOT_SUPPORTED                 425L
+
+//
+// MessageId: ERROR_CLOUD_FILE_REQUEST_TIMEOUT
+//
+// MessageText:
+//
+// The cloud operation was not completed before the time-out period expired.
+//
+#define ERROR_CLOUD_FILE_REQUEST_TIMEOUT         426L
+
+//
+// MessageId: ERROR_NO_TASK_QUEUE
+//
+// MessageText:
+//
+// A task queue is required for this operation but none is available.
+//
+#define ERROR_NO_TASK_QUEUE                      427L
+
+//
+// MessageId: ERROR_SRC_SRV_DLL_LOAD_FAILED
+//
+// MessageText:
+//
+// Failed loading a valid version of srcsrv.dll.
+//
+#define ERROR_SRC_SRV_DLL_LOAD_FAILED            428L
+
+#define ERROR_NOT_SUPPORTED_WITH_BTT             429L
+
+//
+// MessageId: ERROR_ENCRYPTION_DISABLED
+//
+// MessageText:
+//
+// Encryption is disabled on this volume.
+//
+#define ERROR_ENCRYPTION_DISABLED                430L
+
+//
+// MessageId: ERROR_ENCRYPTING_METADATA_DISALLOWED
+//
+// MessageText:
+//
+// Encryption metadata is not allowed to be modified.
+//
+#define ERROR_ENCRYPTING_METADATA_DISALLOWED     431L
+
+//
+// MessageId: ERROR_CANT_CLEAR_ENCRYPTION_FLAG
+//
+// MessageText:
+//
+// The encryption flag cannot be cleared because the file is encrypted.
+//
+#define ERROR_CANT_CLEAR_ENCRYPTION_FLAG         432L
+
+//
+// MessageId: ERROR_NO_SUCH_DEVICE
+//
+// MessageText:
+//
+// A device which does not exist was specified.
+//
+#define ERROR_NO_SUCH_DEVICE                     433L
+
+//
+// MessageId: ERROR_CAPAUTHZ_NOT_DEVUNLOCKED
+//
+// MessageText:
+//
+// Neither developer unlocked mode nor side loading mode is enabled on the
+// device.
+//
+#define ERROR_CAPAUTHZ_NOT_DEVUNLOCKED           450L
+
+//
+// MessageId: ERROR_CAPAUTHZ_CHANGE_TYPE
+//
+// MessageText:
+//
+// Can not change application type during upgrade or re-provision.
+//
+#define ERROR_CAPAUTHZ_CHANGE_TYPE               451L
+
+//
+// MessageId: ERROR_CAPAUTHZ_NOT_PROVISIONED
+//
+// MessageText:
+//
+// The application has not been provisioned.
+//
+#define ERROR_CAPAUTHZ_NOT_PROVISIONED           452L
+
+//
+// MessageId: ERROR_CAPAUTHZ_NOT_AUTHORIZED
+//
+// MessageText:
+//
+// The requested capability can not be authorized for this application.
+//
+#define ERROR_CAPAUTHZ_NOT_AUTHORIZED            453L
+
+//
+// MessageId: ERROR_CAPAUTHZ_NO_POLICY
+//
+// MessageText:
+//
+// There is no capability authorization policy on the device.
+//
+#define ERROR_CAPAUTHZ_NO_POLICY                 454L
+
+//
+// MessageId: ERROR_CAPAUTHZ_DB_CORRUPTED
+//
+// MessageText:
+//
+// The capability authorization database has been corrupted.
+//
+#define ERROR_CAPAUTHZ_DB_CORRUPTED              455L
+
+#define ERROR_CAPAUTHZ_SCCD_INVALID_CATALOG      456L
+
+#define ERROR_CAPAUTHZ_SCCD_NO_AUTH_ENTITY       457L
+
+#define ERROR_CAPAUTHZ_SCCD_PARSE_ERROR          458L
+
+#define ERROR_CAPAUTHZ_SCCD_DEV_MODE_REQUIRED    459L
+
+#define ERROR_CAPAUTHZ_SCCD_NO_CAPABILITY_MATCH  460L
+
+//
+// MessageId: ERROR_PNP_QUERY_REMOVE_DEVICE_TIMEOUT
+//
+// MessageText:
+//
+// The operation timed out waiting for this device to complete a PnP
+// query-remove request due to a potential hang in its device stack.
+//
+#define ERROR_PNP_QUERY_REMOVE_DEVICE_TIMEOUT    480L
+
+//
+// MessageId: ERROR_PNP_QUERY_REMOVE_RELATED_DEVICE_TIMEOUT
+//
+// MessageText:
+//
+// The operation timed out waiting for this device to complete a PnP
+// query-remove request due to a potential hang in the device stack of a
+// related device.
+//
+#define ERROR_PNP_QUERY_REMOVE_RELATED_DEVICE_TIMEOUT 481L
+
+//
+// MessageId: ERROR_PNP_QUERY_REMOVE_UNRELATED_DEVICE_TIMEOUT
+//
+// MessageText:
+//
+// The operation timed out waiting for this device to complete a PnP
+// query-remove request due to a potential hang in the device stack of an
+// unrelated device.
+//
+#define ERROR_PNP_QUERY_REMOVE_UNRELATED_DEVICE_TIMEOUT 482L
+
+//
+// MessageId: ERROR_DEVICE_HARDWARE_ERROR
+//
+// MessageText:
+//
+// The request failed due to a fatal device hardware error.
+//
+#define ERROR_DEVICE_HARDWARE_ERROR              483L
+
+//
+// MessageId: ERROR_INVALID_ADDRESS
+//
+// MessageText:
+//
+// Attempt to access invalid address.
+//
+#define ERROR_INVALID_ADDRESS                    487L
+
+//
+// MessageId: ERROR_VRF_CFG_ENABLED
+//
+// MessageText:
+//
+// Driver Verifier Volatile settings cannot be set when CFG and IO are enabled.
+//
+#define ERROR_VRF_CFG_ENABLED                    1183L
+
+//
+// MessageId: ERROR_PARTITION_TERMINATING
+//
+// MessageText:
+//
+// An attempt was made to access a partition that has begun termination.
+//
+#define ERROR_PARTITION_TERMINATING              1184L
+
+//
+// MessageId: ERROR_USER_PROFILE_LOAD
+//
+// MessageText:
+//
+// User profile cannot be loaded.
+//
+#define ERROR_USER_PROFILE_LOAD                  500L
+
+//
+// MessageId: ERROR_ARITHMETIC_OVERFLOW
+//
+// MessageText:
+//
+// Arithmetic result exceeded 32 bits.
+//
+#define ERROR_ARITHMETIC_OVERFLOW                534L
+
+//
+// MessageId: ERROR_PIPE_CONNECTED
+//
+// MessageText:
+//
+// There is a process on other end of the pipe.
+//
+#define ERROR_PIPE_CONNECTED                     535L
+
+//
+// MessageId: ERROR_PIPE_LISTENING
+//
+// MessageText:
+//
+// Waiting for a process to open the other end of the pipe.
+//
+#define ERROR_PIPE_LISTENING                     536L
+
+//
+// MessageId: ERROR_VERIFIER_STOP
+//
+// MessageText:
+//
+// Application verifier has found an error in the current process.
+//
+#define ERROR_VERIFIER_STOP                      537L
+
+//
+// MessageId: ERROR_ABIOS_ERROR
+//
+// MessageText:
+//
+// An error occurred in the ABIOS subsystem.
+//
+#define ERROR_ABIOS_ERROR                        538L
+
+//
+// MessageId: ERROR_WX86_WARNING
+//
+// MessageText:
+//
+// A warning occurred in the WX86 subsystem.
+//
+#define ERROR_WX86_WARNING                       539L
+
+//
+// MessageId: ERROR_WX86_ERROR
+//
+// MessageText:
+//
+// An error occurred in the WX86 subsystem.
+//
+#define ERROR_WX86_ERROR                         540L
+
+//
+// MessageId: ERROR_TIMER_NOT_CANCELED
+//
+// MessageText:
+//
+// An attempt was made to cancel or set a timer that has an associated APC and
+// the subject thread is not the thread that originally set the timer with an
+// associated APC routine.
+//
+#define ERROR_TIMER_NOT_CANCELED                 541L
+
+//
+// MessageId: ERROR_UNWIND
+//
+// MessageText:
+//
+// Unwind exception code.
+//
+#define ERROR_UNWIND                             542L
+
+//
+// MessageId: ERROR_BAD_STACK
+//
+// MessageText:
+//
+// An invalid or unaligned stack was encountered during an unwind operation.
+//
+#define ERROR_BAD_STACK                          543L
+
+//
+// MessageId: ERROR_INVALID_UNWIND_TARGET
+//
+// MessageText:
+//
+// An invalid unwind target was encountered during an unwind operation.
+//
+#define ERROR_INVALID_UNWIND_TARGET              544L
+
+//
+// MessageId: ERROR_INVALID_PORT_ATTRIBUTES
+//
+// MessageText:
+//
+// Invalid Object Attributes specified to NtCreatePort or invalid Port
+// Attributes specified to NtConnectPort
+//
+#define ERROR_INVALID_PORT_ATTRIBUTES            545L
+
+//
+// MessageId: ERROR_PORT_MESSAGE_TOO_LONG
+//
+// MessageText:
+//
+// Length of message passed to NtRequestPort or NtRequestWaitReplyPort was
+// longer than the maximum message allowed by the port.
+//
+#define ERROR_PORT_MESSAGE_TOO_LONG              546L
+
+//
+// MessageId: ERROR_INVALID_QUOTA_LOWER
+//
+// MessageText:
+//
+// An attempt was made to lower a quota limit below the current usage.
+//
+#define ERROR_INVALID_QUOTA_LOWER                547L
+
+//
+// MessageId: ERROR_DEVICE_ALREADY_ATTACHED
+//
+// MessageText:
+//
+// An attempt was made to attach to a device that was already attached to
+// another device.
+//
+#define ERROR_DEVICE_ALREADY_ATTACHED            548L
+
+//
+// MessageId: ERROR_INSTRUCTION_MISALIGNMENT
+//
+// MessageText:
+//
+// An attempt was made to execute an instruction at an unaligned address and
+// the host system does not support unaligned instruction references.
+//
+#define ERROR_INSTRUCTION_MISALIGNMENT           549L
+
+//
+// MessageId: ERROR_PROFILING_NOT_STARTED
+//
+// MessageText:
+//
+// Profiling not started.
+//
+#define ERROR_PROFILING_NOT_STARTED              550L
+
+//
+// MessageId: ERROR_PROFILING_NOT_STOPPED
+//
+// MessageText:
+//
+// Profiling not stopped.
+//
+#define ERROR_PROFILING_NOT_STOPPED              551L
+
+//
+// MessageId: ERROR_COULD_NOT_INTERPRET
+//
+// MessageText:
+//
+// The passed ACL did not contain the minimum required information.
+//
+#define ERROR_COULD_NOT_INTERPRET                552L
+
+//
+// MessageId: ERROR_PROFILING_AT_LIMIT
+//
+// MessageText:
+//
+// The number of active profiling objects is at the maximum and no more may be
+// started.
+//
+#define ERROR_PROFILING_AT_LIMIT                 553L
+
+//
+// MessageId: ERROR_CANT_WAIT
+//
+// MessageText:
+//
+// Used to indicate that an operation cannot continue without blocking for I/O.
+//
+#define ERROR_CANT_WAIT                          554L
+
+//
+// MessageId: ERROR_CANT_TERMINATE_SELF
+//
+// MessageText:
+//
+// Indicates that a thread attempted to terminate itself by default (called
+// NtTerminateThread with NULL) and it was the last thread in the current
+// process.
+//
+#define ERROR_CANT_TERMINATE_SELF                555L
+
+//
+// MessageId: ERROR_UNEXPECTED_MM_CREATE_ERR
+//
+// MessageText:
+//
+// If an MM error is returned which is not defined in the standard FsRtl
+// filter, it is converted to one of the following errors which is guaranteed
+// to be in the filter. In this case information is lost, however, the filter
+// correctly handles the exception.
+//
+#define ERROR_UNEXPECTED_MM_CREATE_ERR           556L
+
+//
+// MessageId: ERROR_UNEXPECTED_MM_MAP_ERROR
+//
+// MessageText:
+//
+// If an MM error is returned which is not defined in the standard FsRtl
+// filter, it is converted to one of the following errors which is guaranteed
+// to be in the filter. In this case information is lost, however, the filter
+// correctly handles the exception.
+//
+#define ERROR_UNEXPECTED_MM_MAP_ERROR            557L
+
+//
+// MessageId: ERROR_UNEXPECTED_MM_EXTEND_ERR
+//
+// MessageText:
+//
+// If an MM error is returned which is not defined in the standard FsRtl
+// filter, it is converted to one of the following errors which is guaranteed
+// to be in the filter. In this case information is lost, however, the filter
+// correctly handles the exception.
+//
+#define ERROR_UNEXPECTED_MM_EXTEND_ERR           558L
+
+//
+// MessageId: ERROR_BAD_FUNCTION_TABLE
+//
+// MessageText:
+//
+// A malformed function table was encountered during an unwind operation.
+//
+#define ERROR_BAD_FUNCTION_TABLE                 559L
+
+//
+// MessageId: ERROR_NO_GUID_TRANSLATION
+//
+// MessageText:
+//
+// Indicates that an attempt was made to assign protection to a file system
+// file or directory and one of the SIDs in the security descriptor could not
+// be translated into a GUID that could be stored by the file system. This
+// causes the protection attempt to fail, which may cause a file creation
+// attempt to fail.
+//
+#define ERROR_NO_GUID_TRANSLATION                560L
+
+//
+// MessageId: ERROR_INVALID_LDT_SIZE
+//
+// MessageText:
+//
+// Indicates that an attempt was made to grow an LDT by setting its size, or
+// that the size was not an even number of selectors.
+//
+#define ERROR_INVALID_LDT_SIZE                   561L
+
+//
+// MessageId: ERROR_INVALID_LDT_OFFSET
+//
+// MessageText:
+//
+// Indicates that the starting value for the LDT information was not an
+// integral multiple of the selector size.
+//
+#define ERROR_INVALID_LDT_OFFSET                 563L
+
+//
+// MessageId: ERROR_INVALID_LDT_DESCRIPTOR
+//
+// MessageText:
+//
+// Indicates that the user supplied an invalid descriptor when trying to set up
+// Ldt descriptors.
+//
+#define ERROR_INVALID_LDT_DESCRIPTOR             564L
+
+//
+// MessageId: ERROR_TOO_MANY_THREADS
+//
+// MessageText:
+//
+// Indicates a process has too many threads to perform the requested action.
+// For example, assignment of a primary token may only be performed when a
+// process has zero or one threads.
+//
+#define ERROR_TOO_MANY_THREADS                   565L
+
+//
+// MessageId: ERROR_THREAD_NOT_IN_PROCESS
+//
+// MessageText:
+//
+// An attempt was made to operate on a thread within a specific process, but
+// the thread specified is not in the process specified.
+//
+#define ERROR_THREAD_NOT_IN_PROCESS              566L
+
+//
+// MessageId: ERROR_PAGEFILE_QUOTA_EXCEEDED
+//
+// MessageText:
+//
+// Page file quota was exceeded.
+//
+#define ERROR_PAGEFILE_QUOTA_EXCEEDED            567L
+
+//
+// MessageId: ERROR_LOGON_SERVER_CONFLICT
+//
+// MessageText:
+//
+// The Netlogon service cannot start because another Netlogon service running
+// in the domain conflicts with the specified role.
+//
+#define ERROR_LOGON_SERVER_CONFLICT              568L
+
+//
+// MessageId: ERROR_SYNCHRONIZATION_REQUIRED
+//
+// MessageText:
+//
+// The SAM database on a Windows Server is significantly out of synchronization
+// with the copy on the Domain Controller. A complete synchronization is
+// required.
+//
+#define ERROR_SYNCHRONIZATION_REQUIRED           569L
+
+//
+// MessageId: ERROR_NET_OPEN_FAILED
+//
+// MessageText:
+//
+// The NtCreateFile API failed. This error should never be returned to an
+// application, it is a place holder for the Windows Lan Manager Redirector to
+// use in its internal error mapping routines.
+//
+#define ERROR_NET_OPEN_FAILED                    570L
+
+//
+// MessageId: ERROR_IO_PRIVILEGE_FAILED
+//
+// MessageText:
+//
+// {Privilege Failed} The I/O permissions for the process could not be changed.
+//
+#define ERROR_IO_PRIVILEGE_FAILED                571L
+
+//
+// MessageId: ERROR_CONTROL_C_EXIT
+//
+// MessageText:
+//
+// {Application Exit by CTRL+C} The application terminated as a result of a
+// CTRL+C.
+//
+#define ERROR_CONTROL_C_EXIT                     572L
+
+//
+// MessageId: ERROR_MISSING_SYSTEMFILE
+//
+// MessageText:
+//
+// {Missing System File} The required system file %hs is bad or missing.
+//
+#define ERROR_MISSING_SYSTEMFILE                 573L
+
+//
+// MessageId: ERROR_UNHANDLED_EXCEPTION
+//
+// MessageText:
+//
+// {Application Error} The exception %s (0x%08lx) occurred in the application
+// at location 0x%08lx.
+//
+#define ERROR_UNHANDLED_EXCEPTION                574L
+
+//
+// MessageId: ERROR_APP_INIT_FAILURE
+//
+// MessageText:
+//
+// {Application Error} The application was unable to start correctly (0x%lx).
+// Click OK to close the application.
+//
+#define ERROR_APP_INIT_FAILURE                   575L
+
+//
+// MessageId: ERROR_PAGEFILE_CREATE_FAILED
+//
+// MessageText:
+//
+// {Unable to Create Paging File} The creation of the paging file %hs failed
+// (%lx). The requested size was %ld.
+//
+#define ERROR_PAGEFILE_CREATE_FAILED             576L
+
+//
+// MessageId: ERROR_INVALID_IMAGE_HASH
+//
+// MessageText:
+//
+// Windows cannot verify the digital signature for this file. A recent hardware
+// or software change might have installed a file that is signed incorrectly or
+// damaged, or that might be malicious software from an unknown source.
+//
+#define ERROR_INVALID_IMAGE_HASH                 577L
+
+//
+// MessageId: ERROR_NO_PAGEFILE
+//
+// MessageText:
+//
+// {No Paging File Specified} No paging file was specified in the system
+// configuration.
+//
+#define ERROR_NO_PAGEFILE                        578L
+
+//
+// MessageId: ERROR_ILLEGAL_FLOAT_CONTEXT
+//
+// MessageText:
+//
+// {EXCEPTION} A real-mode application issued a floating-point instruction and
+// floating-point hardware is not present.
+//
+#define ERROR_ILLEGAL_FLOAT_CONTEXT              579L
+
+//
+// MessageId: ERROR_NO_EVENT_PAIR
+//
+// MessageText:
+//
+// An event pair synchronization operation was performed using the thread
+// specific client/server event pair object, but no event pair object was
+// associated with the thread.
+//
+#define ERROR_NO_EVENT_PAIR                      580L
+
+//
+// MessageId: ERROR_DOMAIN_CTRLR_CONFIG_ERROR
+//
+// MessageText:
+//
+// A Windows Server has an incorrect configuration.
+//
+#define ERROR_DOMAIN_CTRLR_CONFIG_ERROR          581L
+
+//
+// MessageId: ERROR_ILLEGAL_CHARACTER
+//
+// MessageText:
+//
+// An illegal character was encountered. For a multi-byte character set this
+// includes a lead byte without a succeeding trail byte. For the Unicode
+// character set this includes the characters 0xFFFF and 0xFFFE.
+//
+#define ERROR_ILLEGAL_CHARACTER                  582L
+
+//
+// MessageId: ERROR_UNDEFINED_CHARACTER
+//
+// MessageText:
+//
+// The Unicode character is not defined in the Unicode character set installed
+// on the system.
+//
+#define ERROR_UNDEFINED_CHARACTER                583L
+
+//
+// MessageId: ERROR_FLOPPY_VOLUME
+//
+// MessageText:
+//
+// The paging file cannot be created on a floppy diskette.
+//
+#define ERROR_FLOPPY_VOLUME                      584L
+
+//
+// MessageId: ERROR_BIOS_FAILED_TO_CONNECT_INTERRUPT
+//
+// MessageText:
+//
+// The system BIOS failed to connect a system interrupt to the device or bus
+// for which the device is connected.
+//
+#define ERROR_BIOS_FAILED_TO_CONNECT_INTERRUPT   585L
+
+//
+// MessageId: ERROR_BACKUP_CONTROLLER
+//
+// MessageText:
+//
+// This operation is only allowed for the Primary Domain Controller of the
+// domain.
+//
+#define ERROR_BACKUP_CONTROLLER                  586L
+
+//
+// MessageId: ERROR_MUTANT_LIMIT_EXCEEDED
+//
+// MessageText:
+//
+// An attempt was made to acquire a mutant such that its maximum count would
+// have been exceeded.
+//
+#define ERROR_MUTANT_LIMIT_EXCEEDED              587L
+
+//
+// MessageId: ERROR_FS_DRIVER_REQUIRED
+//
+// MessageText:
+//
+// A volume has been accessed for which a file system driver is required that
+// has not yet been loaded.
+//
+#define ERROR_FS_DRIVER_REQUIRED                 588L
+
+//
+// MessageId: ERROR_CANNOT_LOAD_REGISTRY_FILE
+//
+// MessageText:
+//
+// {Registry File Failure} The registry cannot load the hive (file): %hs or its
+// log or alternate. It is corrupt, absent, or not writable.
+//
+#define ERROR_CANNOT_LOAD_REGISTRY_FILE          589L
+
+//
+// MessageId: ERROR_DEBUG_ATTACH_FAILED
+//
+// MessageText:
+//
+// {Unexpected Failure in DebugActiveProcess} An unexpected failure occurred
+// while processing a DebugActiveProcess API request. You may choose OK to
+// terminate the process, or Cancel to ignore the error.
+//
+#define ERROR_DEBUG_ATTACH_FAILED                590L
+
+//
+// MessageId: ERROR_SYSTEM_PROCESS_TERMINATED
+//
+// MessageText:
+//
+// {Fatal System Error} The %hs system process terminated unexpectedly with a
+// status of 0x%08x (0x%08x 0x%08x). The system has been shut down.
+//
+#define ERROR_SYSTEM_PROCESS_TERMINATED          591L
+
+//
+// MessageId: ERROR_DATA_NOT_ACCEPTED
+//
+// MessageText:
+//
+// {Data Not Accepted} The TDI client could not handle the data received during
+// an indication.
+//
+#define ERROR_DATA_NOT_ACCEPTED                  592L
+
+//
+// MessageId: ERROR_VDM_HARD_ERROR
+//
+// MessageText:
+//
+// NTVDM encountered a hard error.
+//
+#define ERROR_VDM_HARD_ERROR                     593L
+
+//
+// MessageId: ERROR_DRIVER_CANCEL_TIMEOUT
+//
+// MessageText:
+//
+// {Cancel Timeout} The driver %hs failed to complete a cancelled I/O request
+// in the allotted time.
+//
+#define ERROR_DRIVER_CANCEL_TIMEOUT              594L
+
+//
+// MessageId: ERROR_REPLY_MESSAGE_MISMATCH
+//
+// MessageText:
+//
+// {Reply Message Mismatch} An attempt was made to reply to an LPC message, but
+// the thread specified by the client ID in the message was not waiting on that
+// message.
+//
+#define ERROR_REPLY_MESSAGE_MISMATCH             595L
+
+//
+// MessageId: ERROR_LOST_WRITEBEHIND_DATA
+//
+// MessageText:
+//
+// {Delayed Write Failed} Windows was unable to save all the data for the file
+// %hs. The data has been lost. This error may be caused by a failure of your
+// computer hardware or network connection. Please try to save this file
+// elsewhere.
+//
+#define ERROR_LOST_WRITEBEHIND_DATA              596L
+
+//
+// MessageId: ERROR_CLIENT_SERVER_PARAMETERS_INVALID
+//
+// MessageText:
+//
+// The parameter(s) passed to the server in the client/server shared memory
+// window were invalid. Too much data may have been put in the shared memory
+// window.
+//
+#define ERROR_CLIENT_SERVER_PARAMETERS_INVALID   597L
+
+//
+// MessageId: ERROR_NOT_TINY_STREAM
+//
+// MessageText:
+//
+// The stream is not a tiny stream.
+//
+#define ERROR_NOT_TINY_STREAM                    598L
+
+//
+// MessageId: ERROR_STACK_OVERFLOW_READ
+//
+// MessageText:
+//
+// The request must be handled by the stack overflow code.
+//
+#define ERROR_STACK_OVERFLOW_READ                599L
+
+//
+// MessageId: ERROR_CONVERT_TO_LARGE
+//
+// MessageText:
+//
+// Internal OFS status codes indicating how an allocation operation is handled.
+// Either it is retried after the containing onode is moved or the extent
+// stream is converted to a large stream.
+//
+#define ERROR_CONVERT_TO_LARGE                   600L
+
+//
+// MessageId: ERROR_FOUND_OUT_OF_SCOPE
+//
+// MessageText:
+//
+// The attempt to find the object found an object matching by ID on the volume
+// but it is out of the scope of the handle used for the operation.
+//
+#define ERROR_FOUND_OUT_OF_SCOPE                 601L
+
+//
+// MessageId: ERROR_ALLOCATE_BUCKET
+//
+// MessageText:
+//
+// The bucket array must be grown. Retry transaction after doing so.
+//
+#define ERROR_ALLOCATE_BUCKET                    602L
+
+//
+// MessageId: ERROR_MARSHALL_OVERFLOW
+//
+// MessageText:
+//
+// The user/kernel marshalling buffer has overflowed.
+//
+#define ERROR_MARSHALL_OVERFLOW                  603L
+
+//
+// MessageId: ERROR_INVALID_VARIANT
+//
+// MessageText:
+//
+// The supplied variant structure contains invalid data.
+//
+#define ERROR_INVALID_VARIANT                    604L
+
+//
+// MessageId: ERROR_BAD_COMPRESSION_BUFFER
+//
+// MessageText:
+//
+// The specified buffer contains ill-formed data.
+//
+#define ERROR_BAD_COMPRESSION_BUFFER             605L
+
+//
+// MessageId: ERROR_AUDIT_FAILED
+//
+// MessageText:
+//
+// {Audit Failed} An attempt to generate a security audit failed.
+//
+#define ERROR_AUDIT_FAILED                       606L
+
+//
+// MessageId: ERROR_TIMER_RESOLUTION_NOT_SET
+//
+// MessageText:
+//
+// The timer resolution was not previously set by the current process.
+//
+#define ERROR_TIMER_RESOLUTION_NOT_SET           607L
+
+//
+// MessageId: ERROR_INSUFFICIENT_LOGON_INFO
+//
+// MessageText:
+//
+// There is insufficient account information to log you on.
+//
+#define ERROR_INSUFFICIENT_LOGON_INFO            608L
+
+//
+// MessageId: ERROR_BAD_DLL_ENTRYPOINT
+//
+// MessageText:
+//
+// {Invalid DLL Entrypoint} The dynamic link library %hs is not written
+// correctly. The stack pointer has been left in an inconsistent state. The
+// entrypoint should be declared as WINAPI or STDCALL. Select YES to fail the
+// DLL load. Select NO to continue execution. Selecting NO may cause the
+// application to operate incorrectly.
+//
+#define ERROR_BAD_DLL_ENTRYPOINT                 609L
+
+//
+// MessageId: ERROR_BAD_SERVICE_ENTRYPOINT
+//
+// MessageText:
+//
+// {Invalid Service Callback Entrypoint} The %hs service is not written
+// correctly. The stack pointer has been left in an inconsistent state. The
+// callback entrypoint should be declared as WINAPI or STDCALL. Selecting OK
+// will cause the service to continue operation. However, the service process
+// may operate incorrectly.
+//
+#define ERROR_BAD_SERVICE_ENTRYPOINT             610L
+
+//
+// MessageId: ERROR_IP_ADDRESS_CONFLICT1
+//
+// MessageText:
+//
+// There is an IP address conflict with another system on the network.
+//
+#define ERROR_IP_ADDRESS_CONFLICT1               611L
+
+//
+// MessageId: ERROR_IP_ADDRESS_CONFLICT2
+//
+// MessageText:
+//
+// There is an IP address conflict with another system on the network.
+//
+#define ERROR_IP_ADDRESS_CONFLICT2               612L
+
+//
+// MessageId: ERROR_REGISTRY_QUOTA_LIMIT
+//
+// MessageText:
+//
+// {Low On Registry Space} The system has reached the maximum size allowed for
+// the system part of the registry. Additional storage requests will be
+// ignored.
+//
+#define ERROR_REGISTRY_QUOTA_LIMIT               613L
+
+//
+// MessageId: ERROR_NO_CALLBACK_ACTIVE
+//
+// MessageText:
+//
+// A callback return system service cannot be executed when no callback is
+// active.
+//
+#define ERROR_NO_CALLBACK_ACTIVE                 614L
+
+//
+// MessageId: ERROR_PWD_TOO_SHORT
+//
+// MessageText:
+//
+// The password provided is too short to meet the policy of your user account.
+// Please provide a longer password.
+//
+#define ERROR_PWD_TOO_SHORT                      615L
+
+//
+// MessageId: ERROR_PWD_TOO_RECENT
+//
+// MessageText:
+//
+// The policy of your user account does not allow you to change passwords too
+// frequently. This is done to prevent users from changing back to a familiar,
+// but potentially discovered, password. If you feel your password has been
+// compromised then please contact your administrator immediately to have a new
+// one assigned.
+//
+#define ERROR_PWD_TOO_RECENT                     616L
+
+//
+// MessageId: ERROR_PWD_HISTORY_CONFLICT
+//
+// MessageText:
+//
+// You have attempted to change your password to one that you have used in the
+// past. The policy of your user account does not allow this. Please select a
+// password that you have not previously used.
+//
+#define ERROR_PWD_HISTORY_CONFLICT               617L
+
+//
+// MessageId: ERROR_UNSUPPORTED_COMPRESSION
+//
+// MessageText:
+//
+// The specified compression format is unsupported.
+//
+#define ERROR_UNSUPPORTED_COMPRESSION            618L
+
+//
+// MessageId: ERROR_INVALID_HW_PROFILE
+//
+// MessageText:
+//
+// The specified hardware profile configuration is invalid.
+//
+#define ERROR_INVALID_HW_PROFILE                 619L
+
+//
+// MessageId: ERROR_INVALID_PLUGPLAY_DEVICE_PATH
+//
+// MessageText:
+//
+// The specified Plug and Play registry device path is invalid.
+//
+#define ERROR_INVALID_PLUGPLAY_DEVICE_PATH       620L
+
+//
+// MessageId: ERROR_QUOTA_LIST_INCONSISTENT
+//
+// MessageText:
+//
+// The specified quota list is internally inconsistent with its descriptor.
+//
+#define ERROR_QUOTA_LIST_INCONSISTENT            621L
+
+//
+// MessageId: ERROR_EVALUATION_EXPIRATION
+//
+// MessageText:
+//
+// {Windows Evaluation Notification} The evaluation period for this
+// installation of Windows has expired. This system will shutdown in 1 hour. To
+// restore access to this installation of Windows, please upgrade this
+// installation using a licensed distribution of this product.
+//
+#define ERROR_EVALUATION_EXPIRATION              622L
+
+//
+// MessageId: ERROR_ILLEGAL_DLL_RELOCATION
+//
+// MessageText:
+//
+// {Illegal System DLL Relocation} The system DLL %hs was relocated in memory.
+// The application will not run properly. The relocation occurred because the
+// DLL %hs occupied an address range reserved for Windows system DLLs. The
+// vendor supplying the DLL should be contacted for a new DLL.
+//
+#define ERROR_ILLEGAL_DLL_RELOCATION             623L
+
+//
+// MessageId: ERROR_DLL_INIT_FAILED_LOGOFF
+//
+// MessageText:
+//
+// {DLL Initialization Failed} The application failed to initialize because the
+// window station is shutting down.
+//
+#define ERROR_DLL_INIT_FAILED_LOGOFF             624L
+
+//
+// MessageId: ERROR_VALIDATE_CONTINUE
+//
+// MessageText:
+//
+// The validation process needs to continue on to the next step.
+//
+#define ERROR_VALIDATE_CONTINUE                  625L
+
+//
+// MessageId: ERROR_NO_MORE_MATCHES
+//
+// MessageText:
+//
+// There are no more matches for the current index enumeration.
+//
+#define ERROR_NO_MORE_MATCHES                    626L
+
+//
+// MessageId: ERROR_RANGE_LIST_CONFLICT
+//
+// MessageText:
+//
+// The range could not be added to the range list because of a conflict.
+//
+#define ERROR_RANGE_LIST_CONFLICT                627L
+
+//
+// MessageId: ERROR_SERVER_SID_MISMATCH
+//
+// MessageText:
+//
+// The server process is running under a SID different than that required by
+// client.
+//
+#define ERROR_SERVER_SID_MISMATCH                628L
+
+//
+// MessageId: ERROR_CANT_ENABLE_DENY_ONLY
+//
+// MessageText:
+//
+// A group marked use for deny only cannot be enabled.
+//
+#define ERROR_CANT_ENABLE_DENY_ONLY              629L
+
+//
+// MessageId: ERROR_FLOAT_MULTIPLE_FAULTS
+//
+// MessageText:
+//
+// {EXCEPTION} Multiple floating point faults.
+//
+#define ERROR_FLOAT_MULTIPLE_FAULTS              630L
+
+//
+// MessageId: ERROR_FLOAT_MULTIPLE_TRAPS
+//
+// MessageText:
+//
+// {EXCEPTION} Multiple floating point traps.
+//
+#define ERROR_FLOAT_MULTIPLE_TRAPS               631L
+
+//
+// MessageId: ERROR_NOINTERFACE
+//
+// MessageText:
+//
+// The requested interface is not supported.
+//
+#define ERROR_NOINTERFACE                        632L
+
+//
+// MessageId: ERROR_DRIVER_FAILED_SLEEP
+//
+// MessageText:
+//
+// {System Standby Failed} The driver %hs does not support standby mode.
+// Updating this driver may allow the system to go to standby mode.
+//
+#define ERROR_DRIVER_FAILED_SLEEP                633L
+
+//
+// MessageId: ERROR_CORRUPT_SYSTEM_FILE
+//
+// MessageText:
+//
+// The system file %1 has become corrupt and has been replaced.
+//
+#define ERROR_CORRUPT_SYSTEM_FILE                634L
+
+//
+// MessageId: ERROR_COMMITMENT_MINIMUM
+//
+// MessageText:
+//
+// {Virtual Memory Minimum Too Low} Your system is low on virtual memory.
+// Windows is increasing the size of your virtual memory paging file. During
+// this process, memory requests for some applications may be denied. For more
+// information, see Help.
+//
+#define ERROR_COMMITMENT_MINIMUM                 635L
+
+//
+// MessageId: ERROR_PNP_RESTART_ENUMERATION
+//
+// MessageText:
+//
+// A device was removed so enumeration must be restarted.
+//
+#define ERROR_PNP_RESTART_ENUMERATION            636L
+
+//
+// MessageId: ERROR_SYSTEM_IMAGE_BAD_SIGNATURE
+//
+// MessageText:
+//
+// {Fatal System Error} The system image %s is not properly signed. The file
+// has been replaced with the signed file. The system has been shut down.
+//
+#define ERROR_SYSTEM_IMAGE_BAD_SIGNATURE         637L
+
+//
+// MessageId: ERROR_PNP_REBOOT_REQUIRED
+//
+// MessageText:
+//
+// Device will not start without a reboot.
+//
+#define ERROR_PNP_REBOOT_REQUIRED                638L
+
+//
+// MessageId: ERROR_INSUFFICIENT_POWER
+//
+// MessageText:
+//
+// There is not enough power to complete the requested operation.
+//
+#define ERROR_INSUFFICIENT_POWER                 639L
+
+//
+// MessageId: ERROR_MULTIPLE_FAULT_VIOLATION
+//
+// MessageText:
+//
+// ERROR_MULTIPLE_FAULT_VIOLATION
+//
+#define ERROR_MULTIPLE_FAULT_VIOLATION           640L
+
+//
+// MessageId: ERROR_SYSTEM_SHUTDOWN
+//
+// MessageText:
+//
+// The system is in the process of shutting down.
+//
+#define ERROR_SYSTEM_SHUTDOWN                    641L
+
+//
+// MessageId: ERROR_PORT_NOT_SET
+//
+// MessageText:
+//
+// An attempt to remove a processes DebugPort was made, but a port was not
+// already associated with the process.
+//
+#define ERROR_PORT_NOT_SET                       642L
+
+//
+// MessageId: ERROR_DS_VERSION_CHECK_FAILURE
+//
+// MessageText:
+//
+// This version of Windows is not compatible with the behavior version of
+// directory forest, domain or domain controller.
+//
+#define ERROR_DS_VERSION_CHECK_FAILURE           643L
+
+//
+// MessageId: ERROR_RANGE_NOT_FOUND
+//
+// MessageText:
+//
+// The specified range could not be found in the range list.
+//
+#define ERROR_RANGE_NOT_FOUND                    644L
+
+//
+// MessageId: ERROR_NOT_SAFE_MODE_DRIVER
+//
+// MessageText:
+//
+// The driver was not loaded because the system is booting into safe mode.
+//
+#define ERROR_NOT_SAFE_MODE_DRIVER               646L
+
+//
+// MessageId: ERROR_FAILED_DRIVER_ENTRY
+//
+// MessageText:
+//
+// The driver was not loaded because it failed its initialization call.
+//
+#define ERROR_FAILED_DRIVER_ENTRY                647L
+
+//
+// MessageId: ERROR_DEVICE_ENUMERATION_ERROR
+//
+// MessageText:
+//
+// The "%hs" encountered an error while applying power or reading the device
+// configuration. This may be caused by a failure of your hardware or by a poor
+// connection.
+//
+#define ERROR_DEVICE_ENUMERATION_ERROR           648L
+
+//
+// MessageId: ERROR_MOUNT_POINT_NOT_RESOLVED
+//
+// MessageText:
+//
+// The create operation failed because the name contained at least one mount
+// point which resolves to a volume to which the specified device object is not
+// attached.
+//
+#define ERROR_MOUNT_POINT_NOT_RESOLVED           649L
+
+//
+// MessageId: ERROR_INVALID_DEVICE_OBJECT_PARAMETER
+//
+// MessageText:
+//
+// The device object parameter is either not a valid device object or is not
+// attached to the volume specified by the file name.
+//
+#define ERROR_INVALID_DEVICE_OBJECT_PARAMETER    650L
+
+//
+// MessageId: ERROR_MCA_OCCURED
+//
+// MessageText:
+//
+// A Machine Check Error has occurred. Please check the system eventlog for
+// additional information.
+//
+#define ERROR_MCA_OCCURED                        651L
+
+//
+// MessageId: ERROR_DRIVER_DATABASE_ERROR
+//
+// MessageText:
+//
+// There was error [%2] processing the driver database.
+//
+#define ERROR_DRIVER_DATABASE_ERROR              652L
+
+//
+// MessageId: ERROR_SYSTEM_HIVE_TOO_LARGE
+//
+// MessageText:
+//
+// System hive size has exceeded its limit.
+//
+#define ERROR_SYSTEM_HIVE_TOO_LARGE              653L
+
+//
+// MessageId: ERROR_DRIVER_FAILED_PRIOR_UNLOAD
+//
+// MessageText:
+//
+// The driver could not be loaded because a previous version of the driver is
+// still in memory.
+//
+#define ERROR_DRIVER_FAILED_PRIOR_UNLOAD         654L
+
+//
+// MessageId: ERROR_VOLSNAP_PREPARE_HIBERNATE
+//
+// MessageText:
+//
+// {Volume Shadow Copy Service} Please wait while the Volume Shadow Copy
+// Service prepares volume %hs for hibernation.
+//
+#define ERROR_VOLSNAP_PREPARE_HIBERNATE          655L
+
+//
+// MessageId: ERROR_HIBERNATION_FAILURE
+//
+// MessageText:
+//
+// The system has failed to hibernate (The error code is %hs). Hibernation will
+// be disabled until the system is restarted.
+//
+#define ERROR_HIBERNATION_FAILURE                656L
+
+//
+// MessageId: ERROR_PWD_TOO_LONG
+//
+// MessageText:
+//
+// The password provided is too long to meet the policy of your user account.
+// Please provide a shorter password.
+//
+#define ERROR_PWD_TOO_LONG                       657L
+
+//
+// MessageId: ERROR_FILE_SYSTEM_LIMITATION
+//
+// MessageText:
+//
+// The requested operation could not be completed due to a file system
+// limitation.
+//
+#define ERROR_FILE_SYSTEM_LIMITATION             665L
+
+//
+// MessageId: ERROR_ASSERTION_FAILURE
+//
+// MessageText:
+//
+// An assertion failure has occurred.
+//
+#define ERROR_ASSERTION_FAILURE                  668L
+
+//
+// MessageId: ERROR_ACPI_ERROR
+//
+// MessageText:
+//
+// An error occurred in the ACPI subsystem.
+//
+#define ERROR_ACPI_ERROR                         669L
+
+//
+// MessageId: ERROR_WOW_ASSERTION
+//
+// MessageText:
+//
+// WOW Assertion Error.
+//
+#define ERROR_WOW_ASSERTION                      670L
+
+//
+// MessageId: ERROR_PNP_BAD_MPS_TABLE
+//
+// MessageText:
+//
+// A device is missing in the system BIOS MPS table. This device will not be
+// used. Please contact your system vendor for system BIOS update.
+//
+#define ERROR_PNP_BAD_MPS_TABLE                  671L
+
+//
+// MessageId: ERROR_PNP_TRANSLATION_FAILED
+//
+// MessageText:
+//
+// A translator failed to translate resources.
+//
+#define ERROR_PNP_TRANSLATION_FAILED             672L
+
+//
+// MessageId: ERROR_PNP_IRQ_TRANSLATION_FAILED
+//
+// MessageText:
+//
+// A IRQ translator failed to translate resources.
+//
+#define ERROR_PNP_IRQ_TRANSLATION_FAILED         673L
+
+//
+// MessageId: ERROR_PNP_INVALID_ID
+//
+// MessageText:
+//
+// Driver %2 returned invalid ID for a child device (%3).
+//
+#define ERROR_PNP_INVALID_ID                     674L
+
+//
+// MessageId: ERROR_WAKE_SYSTEM_DEBUGGER
+//
+// MessageText:
+//
+// {Kernel Debugger Awakened} the system debugger was awakened by an interrupt.
+//
+#define ERROR_WAKE_SYSTEM_DEBUGGER               675L
+
+//
+// MessageId: ERROR_HANDLES_CLOSED
+//
+// MessageText:
+//
+// {Handles Closed} Handles to objects have been automatically closed as a
+// result of the requested operation.
+//
+#define ERROR_HANDLES_CLOSED                     676L
+
+//
+// MessageId: ERROR_EXTRANEOUS_INFORMATION
+//
+// MessageText:
+//
+// {Too Much Information} The specified access control list (ACL) contained
+// more information than was expected.
+//
+#define ERROR_EXTRANEOUS_INFORMATION             677L
+
+//
+// MessageId: ERROR_RXACT_COMMIT_NECESSARY
+//
+// MessageText:
+//
+// This warning level status indicates that the transaction state already
+// exists for the registry sub-tree, but that a transaction commit was
+// previously aborted. The commit has NOT been completed, but has not been
+// rolled back either (so it may still be committed if desired).
+//
+#define ERROR_RXACT_COMMIT_NECESSARY             678L
+
+//
+// MessageId: ERROR_MEDIA_CHECK
+//
+// MessageText:
+//
+// {Media Changed} The media may have changed.
+//
+#define ERROR_MEDIA_CHECK                        679L
+
+//
+// MessageId: ERROR_GUID_SUBSTITUTION_MADE
+//
+// MessageText:
+//
+// {GUID Substitution} During the translation of a global identifier (GUID) to
+// a Windows security ID (SID), no administratively-defined GUID prefix was
+// found. A substitute prefix was used, which will not compromise system
+// security. However, this may provide a more restrictive access than intended.
+//
+#define ERROR_GUID_SUBSTITUTION_MADE             680L
+
+//
+// MessageId: ERROR_STOPPED_ON_SYMLINK
+//
+// MessageText:
+//
+// The create operation stopped after reaching a symbolic link.
+//
+#define ERROR_STOPPED_ON_SYMLINK                 681L
+
+//
+// MessageId: ERROR_LONGJUMP
+//
+// MessageText:
+//
+// A long jump has been executed.
+//
+#define ERROR_LONGJUMP                           682L
+
+//
+// MessageId: ERROR_PLUGPLAY_QUERY_VETOED
+//
+// MessageText:
+//
+// The Plug and Play query operation was not successful.
+//
+#define ERROR_PLUGPLAY_QUERY_VETOED              683L
+
+//
+// MessageId: ERROR_UNWIND_CONSOLIDATE
+//
+// MessageText:
+//
+// A frame consolidation has been executed.
+//
+#define ERROR_UNWIND_CONSOLIDATE                 684L
+
+//
+// MessageId: ERROR_REGISTRY_HIVE_RECOVERED
+//
+// MessageText:
+//
+// {Registry Hive Recovered} Registry hive (file): %hs was corrupted and it has
+// been recovered. Some data might have been lost.
+//
+#define ERROR_REGISTRY_HIVE_RECOVERED            685L
+
+//
+// MessageId: ERROR_DLL_MIGHT_BE_INSECURE
+//
+// MessageText:
+//
+// The application is attempting to run executable code from the module %hs.
+// This may be insecure. An alternative, %hs, is available. Should the
+// application use the secure module %hs?
+//
+#define ERROR_DLL_MIGHT_BE_INSECURE              686L
+
+//
+// MessageId: ERROR_DLL_MIGHT_BE_INCOMPATIBLE
+//
+// MessageText:
+//
+// The application is loading executable code from the module %hs. This is
+// secure, but may be incompatible with previous releases of the operating
+// system. An alternative, %hs, is available. Should the application use the
+// secure module %hs?
+//
+#define ERROR_DLL_MIGHT_BE_INCOMPATIBLE          687L
+
+//
+// MessageId: ERROR_DBG_EXCEPTION_NOT_HANDLED
+//
+// MessageText:
+//
+// Debugger did not handle the exception.
+//
+#define ERROR_DBG_EXCEPTION_NOT_HANDLED          688L
+
+//
+// MessageId: ERROR_DBG_REPLY_LATER
+//
+// MessageText:
+//
+// Debugger will reply later.
+//
+#define ERROR_DBG_REPLY_LATER                    689L
+
+//
+// MessageId: ERROR_DBG_UNABLE_TO_PROVIDE_HANDLE
+//
+// MessageText:
+//
+// Debugger cannot provide handle.
+//
+#define ERROR_DBG_UNABLE_TO_PROVIDE_HANDLE       690L
+
+//
+// MessageId: ERROR_DBG_TERMINATE_THREAD
+//
+// MessageText:
+//
+// Debugger terminated thread.
+//
+#define ERROR_DBG_TERMINATE_THREAD               691L
+
+//
+// MessageId: ERROR_DBG_TERMINATE_PROCESS
+//
+// MessageText:
+//
+// Debugger terminated process.
+//
+#define ERROR_DBG_TERMINATE_PROCESS              692L
+
+//
+// MessageId: ERROR_DBG_CONTROL_C
+//
+// MessageText:
+//
+// Debugger got control C.
+//
+#define ERROR_DBG_CONTROL_C                      693L
+
+//
+// MessageId: ERROR_DBG_PRINTEXCEPTION_C
+//
+// MessageText:
+//
+// Debugger printed exception on control C.
+//
+#define ERROR_DBG_PRINTEXCEPTION_C               694L
+
+//
+// MessageId: ERROR_DBG_RIPEXCEPTION
+//
+// MessageText:
+//
+// Debugger received RIP exception.
+//
+#define ERROR_DBG_RIPEXCEPTION                   695L
+
+//
+// MessageId: ERROR_DBG_CONTROL_BREAK
+//
+// MessageText:
+//
+// Debugger received control break.
+//
+#define ERROR_DBG_CONTROL_BREAK                  696L
+
+//
+// MessageId: ERROR_DBG_COMMAND_EXCEPTION
+//
+// MessageText:
+//
+// Debugger command communication exception.
+//
+#define ERROR_DBG_COMMAND_EXCEPTION              697L
+
+//
+// MessageId: ERROR_OBJECT_NAME_EXISTS
+//
+// MessageText:
+//
+// {Object Exists} An attempt was made to create an object and the object name
+// already existed.
+//
+#define ERROR_OBJECT_NAME_EXISTS                 698L
+
+//
+// MessageId: ERROR_THREAD_WAS_SUSPENDED
+//
+// MessageText:
+//
+// {Thread Suspended} A thread termination occurred while the thread was
+// suspended. The thread was resumed, and termination proceeded.
+//
+#define ERROR_THREAD_WAS_SUSPENDED               699L
+
+//
+// MessageId: ERROR_IMAGE_NOT_AT_BASE
+//
+// MessageText:
+//
+// {Image Relocated} An image file could not be mapped at the address specified
+// in the image file. Local fixups must be performed on this image.
+//
+#define ERROR_IMAGE_NOT_AT_BASE                  700L
+
+//
+// MessageId: ERROR_RXACT_STATE_CREATED
+//
+// MessageText:
+//
+// This informational level status indicates that a specified registry sub-tree
+// transaction state did not yet exist and had to be created.
+//
+#define ERROR_RXACT_STATE_CREATED                701L
+
+//
+// MessageId: ERROR_SEGMENT_NOTIFICATION
+//
+// MessageText:
+//
+// A virtual DOS machine (VDM) is loading, unloading, or moving an MS-DOS or
+// Win16 program segment image. An exception is raised so a debugger can load,
+// unload or track symbols and breakpoints within these 16-bit segments.
+//
+#define ERROR_SEGMENT_NOTIFICATION               702L
+
+//
+// MessageId: ERROR_BAD_CURRENT_DIRECTORY
+//
+// MessageText:
+//
+// {Invalid Current Directory} The process cannot switch to the startup current
+// directory %hs. Select OK to set current directory to %hs, or select CANCEL
+// to exit.
+//
+#define ERROR_BAD_CURRENT_DIRECTORY              703L
+
+//
+// MessageId: ERROR_FT_READ_RECOVERY_FROM_BACKUP
+//
+// MessageText:
+//
+// {Redundant Read} To satisfy a read request, the NT fault-tolerant file
+// system successfully read the requested data from a redundant copy. This was
+// done because the file system encountered a failure on a member of the
+// fault-tolerant volume, but was unable to reassign the failing area of the
+// device.
+//
+#define ERROR_FT_READ_RECOVERY_FROM_BACKUP       704L
+
+//
+// MessageId: ERROR_FT_WRITE_RECOVERY
+//
+// MessageText:
+//
+// {Redundant Write} To satisfy a write request, the NT fault-tolerant file
+// system successfully wrote a redundant copy of the information. This was done
+// because the file system encountered a failure on a member of the
+// fault-tolerant volume, but was not able to reassign the failing area of the
+// device.
+//
+#define ERROR_FT_WRITE_RECOVERY                  705L
+
+//
+// MessageId: ERROR_IMAGE_MACHINE_TYPE_MISMATCH
+//
+// MessageText:
+//
+// {Machine Type Mismatch} The image file %hs is valid, but is for a machine
+// type other than the current machine. Select OK to continue, or CANCEL to
+// fail the DLL load.
+//
+#define ERROR_IMAGE_MACHINE_TYPE_MISMATCH        706L
+
+//
+// MessageId: ERROR_RECEIVE_PARTIAL
+//
+// MessageText:
+//
+// {Partial Data Received} The network transport returned partial data to its
+// client. The remaining data will be sent later.
+//
+#define ERROR_RECEIVE_PARTIAL                    707L
+
+//
+// MessageId: ERROR_RECEIVE_EXPEDITED
+//
+// MessageText:
+//
+// {Expedited Data Received} The network transport returned data to its client
+// that was marked as expedited by the remote system.
+//
+#define ERROR_RECEIVE_EXPEDITED                  708L
+
+//
+// MessageId: ERROR_RECEIVE_PARTIAL_EXPEDITED
+//
+// MessageText:
+//
+// {Partial Expedited Data Received} The network transport returned partial
+// data to its client and this data was marked as expedited by the remote
+// system. The remaining data will be sent later.
+//
+#define ERROR_RECEIVE_PARTIAL_EXPEDITED          709L
+
+//
+// MessageId: ERROR_EVENT_DONE
+//
+// MessageText:
+//
+// {TDI Event Done} The TDI indication has completed successfully.
+//
+#define ERROR_EVENT_DONE                         710L
+
+//
+// MessageId: ERROR_EVENT_PENDING
+//
+// MessageText:
+//
+// {TDI Event Pending} The TDI indication has entered the pending state.
+//
+#define ERROR_EVENT_PENDING                      711L
+
+//
+// MessageId: ERROR_CHECKING_FILE_SYSTEM
+//
+// MessageText:
+//
+// Checking file system on %wZ.
+//
+#define ERROR_CHECKING_FILE_SYSTEM               712L
+
+//
+// MessageId: ERROR_FATAL_APP_EXIT
+//
+// MessageText:
+//
+// {Fatal Application Exit} %hs
+//
+#define ERROR_FATAL_APP_EXIT                     713L
+
+//
+// MessageId: ERROR_PREDEFINED_HANDLE
+//
+// MessageText:
+//
+// The specified registry key is referenced by a predefined handle.
+//
+#define ERROR_PREDEFINED_HANDLE                  714L
+
+//
+// MessageId: ERROR_WAS_UNLOCKED
+//
+// MessageText:
+//
+// {Page Unlocked} The page protection of a locked page was changed to 'No
+// Access' and the page was unlocked from memory and from the process.
+//
+#define ERROR_WAS_UNLOCKED                       715L
+
+//
+// MessageId: ERROR_SERVICE_NOTIFICATION
+//
+// MessageText:
+//
+// %hs
+//
+#define ERROR_SERVICE_NOTIFICATION               716L
+
+//
+// MessageId: ERROR_WAS_LOCKED
+//
+// MessageText:
+//
+// {Page Locked} One of the pages to lock was already locked.
+//
+#define ERROR_WAS_LOCKED                         717L
+
+//
+// MessageId: ERROR_LOG_HARD_ERROR
+//
+// MessageText:
+//
+// Application popup: %1 : %2
+//
+#define ERROR_LOG_HARD_ERROR                     718L
+
+//
+// MessageId: ERROR_ALREADY_WIN32
+//
+// MessageText:
+//
+// ERROR_ALREADY_WIN32
+//
+#define ERROR_ALREADY_WIN32                      719L
+
+//
+// MessageId: ERROR_IMAGE_MACHINE_TYPE_MISMATCH_EXE
+//
+// MessageText:
+//
+// {Machine Type Mismatch} The image file %hs is valid, but is for a machine
+// type other than the current machine.
+//
+#define ERROR_IMAGE_MACHINE_TYPE_MISMATCH_EXE    720L
+
+//
+// MessageId: ERROR_NO_YIELD_PERFORMED
+//
+// MessageText:
+//
+// A yield execution was performed and no thread was available to run.
+//
+#define ERROR_NO_YIELD_PERFORMED                 721L
+
+//
+// MessageId: ERROR_TIMER_RESUME_IGNORED
+//
+// MessageText:
+//
+// The resumable flag to a timer API was ignored.
+//
+#define ERROR_TIMER_RESUME_IGNORED               722L
+
+//
+// MessageId: ERROR_ARBITRATION_UNHANDLED
+//
+// MessageText:
+//
+// The arbiter has deferred arbitration of these resources to its parent.
+//
+#define ERROR_ARBITRATION_UNHANDLED              723L
+
+//
+// MessageId: ERROR_CARDBUS_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The inserted CardBus device cannot be started because of a configuration
+// error on "%hs".
+//
+#define ERROR_CARDBUS_NOT_SUPPORTED              724L
+
+//
+// MessageId: ERROR_MP_PROCESSOR_MISMATCH
+//
+// MessageText:
+//
+// The CPUs in this multiprocessor system are not all the same revision level.
+// To use all processors the operating system restricts itself to the features
+// of the least capable processor in the system. Should problems occur with
+// this system, contact the CPU manufacturer to see if this mix of processors
+// is supported.
+//
+#define ERROR_MP_PROCESSOR_MISMATCH              725L
+
+//
+// MessageId: ERROR_HIBERNATED
+//
+// MessageText:
+//
+// The system was put into hibernation.
+//
+#define ERROR_HIBERNATED                         726L
+
+//
+// MessageId: ERROR_RESUME_HIBERNATION
+//
+// MessageText:
+//
+// The system was resumed from hibernation.
+//
+#define ERROR_RESUME_HIBERNATION                 727L
+
+//
+// MessageId: ERROR_FIRMWARE_UPDATED
+//
+// MessageText:
+//
+// Windows has detected that the system firmware (BIOS) was updated [previous
+// firmware date = %2, current firmware date %3].
+//
+#define ERROR_FIRMWARE_UPDATED                   728L
+
+//
+// MessageId: ERROR_DRIVERS_LEAKING_LOCKED_PAGES
+//
+// MessageText:
+//
+// A device driver is leaking locked I/O pages causing system degradation. The
+// system has automatically enabled tracking code in order to try and catch the
+// culprit.
+//
+#define ERROR_DRIVERS_LEAKING_LOCKED_PAGES       729L
+
+//
+// MessageId: ERROR_WAKE_SYSTEM
+//
+// MessageText:
+//
+// The system has awoken.
+//
+#define ERROR_WAKE_SYSTEM                        730L
+
+//
+// MessageId: ERROR_WAIT_1
+//
+// MessageText:
+//
+// ERROR_WAIT_1
+//
+#define ERROR_WAIT_1                             731L
+
+//
+// MessageId: ERROR_WAIT_2
+//
+// MessageText:
+//
+// ERROR_WAIT_2
+//
+#define ERROR_WAIT_2                             732L
+
+//
+// MessageId: ERROR_WAIT_3
+//
+// MessageText:
+//
+// ERROR_WAIT_3
+//
+#define ERROR_WAIT_3                             733L
+
+//
+// MessageId: ERROR_WAIT_63
+//
+// MessageText:
+//
+// ERROR_WAIT_63
+//
+#define ERROR_WAIT_63                            734L
+
+//
+// MessageId: ERROR_ABANDONED_WAIT_0
+//
+// MessageText:
+//
+// ERROR_ABANDONED_WAIT_0
+//
+#define ERROR_ABANDONED_WAIT_0                   735L
+
+//
+// MessageId: ERROR_ABANDONED_WAIT_63
+//
+// MessageText:
+//
+// ERROR_ABANDONED_WAIT_63
+//
+#define ERROR_ABANDONED_WAIT_63                  736L
+
+//
+// MessageId: ERROR_USER_APC
+//
+// MessageText:
+//
+// ERROR_USER_APC
+//
+#define ERROR_USER_APC                           737L
+
+//
+// MessageId: ERROR_KERNEL_APC
+//
+// MessageText:
+//
+// ERROR_KERNEL_APC
+//
+#define ERROR_KERNEL_APC                         738L
+
+//
+// MessageId: ERROR_ALERTED
+//
+// MessageText:
+//
+// ERROR_ALERTED
+//
+#define ERROR_ALERTED                            739L
+
+//
+// MessageId: ERROR_ELEVATION_REQUIRED
+//
+// MessageText:
+//
+// The requested operation requires elevation.
+//
+#define ERROR_ELEVATION_REQUIRED                 740L
+
+//
+// MessageId: ERROR_REPARSE
+//
+// MessageText:
+//
+// A reparse should be performed by the Object Manager since the name of the
+// file resulted in a symbolic link.
+//
+#define ERROR_REPARSE                            741L
+
+//
+// MessageId: ERROR_OPLOCK_BREAK_IN_PROGRESS
+//
+// MessageText:
+//
+// An open/create operation completed while an oplock break is underway.
+//
+#define ERROR_OPLOCK_BREAK_IN_PROGRESS           742L
+
+//
+// MessageId: ERROR_VOLUME_MOUNTED
+//
+// MessageText:
+//
+// A new volume has been mounted by a file system.
+//
+#define ERROR_VOLUME_MOUNTED                     743L
+
+//
+// MessageId: ERROR_RXACT_COMMITTED
+//
+// MessageText:
+//
+// This success level status indicates that the transaction state already
+// exists for the registry sub-tree, but that a transaction commit was
+// previously aborted. The commit has now been completed.
+//
+#define ERROR_RXACT_COMMITTED                    744L
+
+//
+// MessageId: ERROR_NOTIFY_CLEANUP
+//
+// MessageText:
+//
+// This indicates that a notify change request has been completed due to
+// closing the handle which made the notify change request.
+//
+#define ERROR_NOTIFY_CLEANUP                     745L
+
+//
+// MessageId: ERROR_PRIMARY_TRANSPORT_CONNECT_FAILED
+//
+// MessageText:
+//
+// {Connect Failure on Primary Transport} An attempt was made to connect to the
+// remote server %hs on the primary transport, but the connection failed. The
+// computer WAS able to connect on a secondary transport.
+//
+#define ERROR_PRIMARY_TRANSPORT_CONNECT_FAILED   746L
+
+//
+// MessageId: ERROR_PAGE_FAULT_TRANSITION
+//
+// MessageText:
+//
+// Page fault was a transition fault.
+//
+#define ERROR_PAGE_FAULT_TRANSITION              747L
+
+//
+// MessageId: ERROR_PAGE_FAULT_DEMAND_ZERO
+//
+// MessageText:
+//
+// Page fault was a demand zero fault.
+//
+#define ERROR_PAGE_FAULT_DEMAND_ZERO             748L
+
+//
+// MessageId: ERROR_PAGE_FAULT_COPY_ON_WRITE
+//
+// MessageText:
+//
+// Page fault was a demand zero fault.
+//
+#define ERROR_PAGE_FAULT_COPY_ON_WRITE           749L
+
+//
+// MessageId: ERROR_PAGE_FAULT_GUARD_PAGE
+//
+// MessageText:
+//
+// Page fault was a demand zero fault.
+//
+#define ERROR_PAGE_FAULT_GUARD_PAGE              750L
+
+//
+// MessageId: ERROR_PAGE_FAULT_PAGING_FILE
+//
+// MessageText:
+//
+// Page fault was satisfied by reading from a secondary storage device.
+//
+#define ERROR_PAGE_FAULT_PAGING_FILE             751L
+
+//
+// MessageId: ERROR_CACHE_PAGE_LOCKED
+//
+// MessageText:
+//
+// Cached page was locked during operation.
+//
+#define ERROR_CACHE_PAGE_LOCKED                  752L
+
+//
+// MessageId: ERROR_CRASH_DUMP
+//
+// MessageText:
+//
+// Crash dump exists in paging file.
+//
+#define ERROR_CRASH_DUMP                         753L
+
+//
+// MessageId: ERROR_BUFFER_ALL_ZEROS
+//
+// MessageText:
+//
+// Specified buffer contains all zeros.
+//
+#define ERROR_BUFFER_ALL_ZEROS                   754L
+
+//
+// MessageId: ERROR_REPARSE_OBJECT
+//
+// MessageText:
+//
+// A reparse should be performed by the Object Manager since the name of the
+// file resulted in a symbolic link.
+//
+#define ERROR_REPARSE_OBJECT                     755L
+
+//
+// MessageId: ERROR_RESOURCE_REQUIREMENTS_CHANGED
+//
+// MessageText:
+//
+// The device has succeeded a query-stop and its resource requirements have
+// changed.
+//
+#define ERROR_RESOURCE_REQUIREMENTS_CHANGED      756L
+
+//
+// MessageId: ERROR_TRANSLATION_COMPLETE
+//
+// MessageText:
+//
+// The translator has translated these resources into the global space and no
+// further translations should be performed.
+//
+#define ERROR_TRANSLATION_COMPLETE               757L
+
+//
+// MessageId: ERROR_NOTHING_TO_TERMINATE
+//
+// MessageText:
+//
+// A process being terminated has no threads to terminate.
+//
+#define ERROR_NOTHING_TO_TERMINATE               758L
+
+//
+// MessageId: ERROR_PROCESS_NOT_IN_JOB
+//
+// MessageText:
+//
+// The specified process is not part of a job.
+//
+#define ERROR_PROCESS_NOT_IN_JOB                 759L
+
+//
+// MessageId: ERROR_PROCESS_IN_JOB
+//
+// MessageText:
+//
+// The specified process is part of a job.
+//
+#define ERROR_PROCESS_IN_JOB                     760L
+
+//
+// MessageId: ERROR_VOLSNAP_HIBERNATE_READY
+//
+// MessageText:
+//
+// {Volume Shadow Copy Service} The system is now ready for hibernation.
+//
+#define ERROR_VOLSNAP_HIBERNATE_READY            761L
+
+//
+// MessageId: ERROR_FSFILTER_OP_COMPLETED_SUCCESSFULLY
+//
+// MessageText:
+//
+// A file system or file system filter driver has successfully completed an
+// FsFilter operation.
+//
+#define ERROR_FSFILTER_OP_COMPLETED_SUCCESSFULLY 762L
+
+//
+// MessageId: ERROR_INTERRUPT_VECTOR_ALREADY_CONNECTED
+//
+// MessageText:
+//
+// The specified interrupt vector was already connected.
+//
+#define ERROR_INTERRUPT_VECTOR_ALREADY_CONNECTED 763L
+
+//
+// MessageId: ERROR_INTERRUPT_STILL_CONNECTED
+//
+// MessageText:
+//
+// The specified interrupt vector is still connected.
+//
+#define ERROR_INTERRUPT_STILL_CONNECTED          764L
+
+//
+// MessageId: ERROR_WAIT_FOR_OPLOCK
+//
+// MessageText:
+//
+// An operation is blocked waiting for an oplock.
+//
+#define ERROR_WAIT_FOR_OPLOCK                    765L
+
+//
+// MessageId: ERROR_DBG_EXCEPTION_HANDLED
+//
+// MessageText:
+//
+// Debugger handled exception.
+//
+#define ERROR_DBG_EXCEPTION_HANDLED              766L
+
+//
+// MessageId: ERROR_DBG_CONTINUE
+//
+// MessageText:
+//
+// Debugger continued.
+//
+#define ERROR_DBG_CONTINUE                       767L
+
+//
+// MessageId: ERROR_CALLBACK_POP_STACK
+//
+// MessageText:
+//
+// An exception occurred in a user mode callback and the kernel callback frame
+// should be removed.
+//
+#define ERROR_CALLBACK_POP_STACK                 768L
+
+//
+// MessageId: ERROR_COMPRESSION_DISABLED
+//
+// MessageText:
+//
+// Compression is disabled for this volume.
+//
+#define ERROR_COMPRESSION_DISABLED               769L
+
+//
+// MessageId: ERROR_CANTFETCHBACKWARDS
+//
+// MessageText:
+//
+// The data provider cannot fetch backwards through a result set.
+//
+#define ERROR_CANTFETCHBACKWARDS                 770L
+
+//
+// MessageId: ERROR_CANTSCROLLBACKWARDS
+//
+// MessageText:
+//
+// The data provider cannot scroll backwards through a result set.
+//
+#define ERROR_CANTSCROLLBACKWARDS                771L
+
+//
+// MessageId: ERROR_ROWSNOTRELEASED
+//
+// MessageText:
+//
+// The data provider requires that previously fetched data is released before
+// asking for more data.
+//
+#define ERROR_ROWSNOTRELEASED                    772L
+
+//
+// MessageId: ERROR_BAD_ACCESSOR_FLAGS
+//
+// MessageText:
+//
+// The data provider was not able to interpret the flags set for a column
+// binding in an accessor.
+//
+#define ERROR_BAD_ACCESSOR_FLAGS                 773L
+
+//
+// MessageId: ERROR_ERRORS_ENCOUNTERED
+//
+// MessageText:
+//
+// One or more errors occurred while processing the request.
+//
+#define ERROR_ERRORS_ENCOUNTERED                 774L
+
+//
+// MessageId: ERROR_NOT_CAPABLE
+//
+// MessageText:
+//
+// The implementation is not capable of performing the request.
+//
+#define ERROR_NOT_CAPABLE                        775L
+
+//
+// MessageId: ERROR_REQUEST_OUT_OF_SEQUENCE
+//
+// MessageText:
+//
+// The client of a component requested an operation which is not valid given
+// the state of the component instance.
+//
+#define ERROR_REQUEST_OUT_OF_SEQUENCE            776L
+
+//
+// MessageId: ERROR_VERSION_PARSE_ERROR
+//
+// MessageText:
+//
+// A version number could not be parsed.
+//
+#define ERROR_VERSION_PARSE_ERROR                777L
+
+//
+// MessageId: ERROR_BADSTARTPOSITION
+//
+// MessageText:
+//
+// The iterator's start position is invalid.
+//
+#define ERROR_BADSTARTPOSITION                   778L
+
+//
+// MessageId: ERROR_MEMORY_HARDWARE
+//
+// MessageText:
+//
+// The hardware has reported an uncorrectable memory error.
+//
+#define ERROR_MEMORY_HARDWARE                    779L
+
+//
+// MessageId: ERROR_DISK_REPAIR_DISABLED
+//
+// MessageText:
+//
+// The attempted operation required self healing to be enabled.
+//
+#define ERROR_DISK_REPAIR_DISABLED               780L
+
+//
+// MessageId: ERROR_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE
+//
+// MessageText:
+//
+// The Desktop heap encountered an error while allocating session memory. There
+// is more information in the system event log.
+//
+#define ERROR_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE 781L
+
+//
+// MessageId: ERROR_SYSTEM_POWERSTATE_TRANSITION
+//
+// MessageText:
+//
+// The system power state is transitioning from %2 to %3.
+//
+#define ERROR_SYSTEM_POWERSTATE_TRANSITION       782L
+
+//
+// MessageId: ERROR_SYSTEM_POWERSTATE_COMPLEX_TRANSITION
+//
+// MessageText:
+//
+// The system power state is transitioning from %2 to %3 but could enter %4.
+//
+#define ERROR_SYSTEM_POWERSTATE_COMPLEX_TRANSITION 783L
+
+//
+// MessageId: ERROR_MCA_EXCEPTION
+//
+// MessageText:
+//
+// A thread is getting dispatched with MCA EXCEPTION because of MCA.
+//
+#define ERROR_MCA_EXCEPTION                      784L
+
+//
+// MessageId: ERROR_ACCESS_AUDIT_BY_POLICY
+//
+// MessageText:
+//
+// Access to %1 is monitored by policy rule %2.
+//
+#define ERROR_ACCESS_AUDIT_BY_POLICY             785L
+
+//
+// MessageId: ERROR_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY
+//
+// MessageText:
+//
+// Access to %1 has been restricted by your Administrator by policy rule %2.
+//
+#define ERROR_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY 786L
+
+//
+// MessageId: ERROR_ABANDON_HIBERFILE
+//
+// MessageText:
+//
+// A valid hibernation file has been invalidated and should be abandoned.
+//
+#define ERROR_ABANDON_HIBERFILE                  787L
+
+//
+// MessageId: ERROR_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED
+//
+// MessageText:
+//
+// {Delayed Write Failed} Windows was unable to save all the data for the file
+// %hs; the data has been lost. This error may be caused by network
+// connectivity issues. Please try to save this file elsewhere.
+//
+#define ERROR_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED 788L
+
+//
+// MessageId: ERROR_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR
+//
+// MessageText:
+//
+// {Delayed Write Failed} Windows was unable to save all the data for the file
+// %hs; the data has been lost. This error was returned by the server on which
+// the file exists. Please try to save this file elsewhere.
+//
+#define ERROR_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR 789L
+
+//
+// MessageId: ERROR_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR
+//
+// MessageText:
+//
+// {Delayed Write Failed} Windows was unable to save all the data for the file
+// %hs; the data has been lost. This error may be caused if the device has been
+// removed or the media is write-protected.
+//
+#define ERROR_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR 790L
+
+//
+// MessageId: ERROR_BAD_MCFG_TABLE
+//
+// MessageText:
+//
+// The resources required for this device conflict with the MCFG table.
+//
+#define ERROR_BAD_MCFG_TABLE                     791L
+
+//
+// MessageId: ERROR_DISK_REPAIR_REDIRECTED
+//
+// MessageText:
+//
+// The volume repair could not be performed while it is online. Please schedule
+// to take the volume offline so that it can be repaired.
+//
+#define ERROR_DISK_REPAIR_REDIRECTED             792L
+
+//
+// MessageId: ERROR_DISK_REPAIR_UNSUCCESSFUL
+//
+// MessageText:
+//
+// The volume repair was not successful.
+//
+#define ERROR_DISK_REPAIR_UNSUCCESSFUL           793L
+
+//
+// MessageId: ERROR_CORRUPT_LOG_OVERFULL
+//
+// MessageText:
+//
+// One of the volume corruption logs is full. Further corruptions that may be
+// detected won't be logged.
+//
+#define ERROR_CORRUPT_LOG_OVERFULL               794L
+
+//
+// MessageId: ERROR_CORRUPT_LOG_CORRUPTED
+//
+// MessageText:
+//
+// One of the volume corruption logs is internally corrupted and needs to be
+// recreated. The volume may contain undetected corruptions and must be
+// scanned.
+//
+#define ERROR_CORRUPT_LOG_CORRUPTED              795L
+
+//
+// MessageId: ERROR_CORRUPT_LOG_UNAVAILABLE
+//
+// MessageText:
+//
+// One of the volume corruption logs is unavailable for being operated on.
+//
+#define ERROR_CORRUPT_LOG_UNAVAILABLE            796L
+
+//
+// MessageId: ERROR_CORRUPT_LOG_DELETED_FULL
+//
+// MessageText:
+//
+// One of the volume corruption logs was deleted while still having corruption
+// records in them. The volume contains detected corruptions and must be
+// scanned.
+//
+#define ERROR_CORRUPT_LOG_DELETED_FULL           797L
+
+//
+// MessageId: ERROR_CORRUPT_LOG_CLEARED
+//
+// MessageText:
+//
+// One of the volume corruption logs was cleared by chkdsk and no longer
+// contains real corruptions.
+//
+#define ERROR_CORRUPT_LOG_CLEARED                798L
+
+//
+// MessageId: ERROR_ORPHAN_NAME_EXHAUSTED
+//
+// MessageText:
+//
+// Orphaned files exist on the volume but could not be recovered because no
+// more new names could be created in the recovery directory. Files must be
+// moved from the recovery directory.
+//
+#define ERROR_ORPHAN_NAME_EXHAUSTED              799L
+
+//
+// MessageId: ERROR_OPLOCK_SWITCHED_TO_NEW_HANDLE
+//
+// MessageText:
+//
+// The oplock that was associated with this handle is now associated with a
+// different handle.
+//
+#define ERROR_OPLOCK_SWITCHED_TO_NEW_HANDLE      800L
+
+//
+// MessageId: ERROR_CANNOT_GRANT_REQUESTED_OPLOCK
+//
+// MessageText:
+//
+// An oplock of the requested level cannot be granted. An oplock of a lower
+// level may be available.
+//
+#define ERROR_CANNOT_GRANT_REQUESTED_OPLOCK      801L
+
+//
+// MessageId: ERROR_CANNOT_BREAK_OPLOCK
+//
+// MessageText:
+//
+// The operation did not complete successfully because it would cause an oplock
+// to be broken. The caller has requested that existing oplocks not be broken.
+//
+#define ERROR_CANNOT_BREAK_OPLOCK                802L
+
+//
+// MessageId: ERROR_OPLOCK_HANDLE_CLOSED
+//
+// MessageText:
+//
+// The handle with which this oplock was associated has been closed. The oplock
+// is now broken.
+//
+#define ERROR_OPLOCK_HANDLE_CLOSED               803L
+
+//
+// MessageId: ERROR_NO_ACE_CONDITION
+//
+// MessageText:
+//
+// The specified access control entry (ACE) does not contain a condition.
+//
+#define ERROR_NO_ACE_CONDITION                   804L
+
+//
+// MessageId: ERROR_INVALID_ACE_CONDITION
+//
+// MessageText:
+//
+// The specified access control entry (ACE) contains an invalid condition.
+//
+#define ERROR_INVALID_ACE_CONDITION              805L
+
+//
+// MessageId: ERROR_FILE_HANDLE_REVOKED
+//
+// MessageText:
+//
+// Access to the specified file handle has been revoked.
+//
+#define ERROR_FILE_HANDLE_REVOKED                806L
+
+//
+// MessageId: ERROR_IMAGE_AT_DIFFERENT_BASE
+//
+// MessageText:
+//
+// {Image Relocated} An image file was mapped at a different address from the
+// one specified in the image file but fixups will still be automatically
+// performed on the image.
+//
+#define ERROR_IMAGE_AT_DIFFERENT_BASE            807L
+
+//
+// MessageId: ERROR_ENCRYPTED_IO_NOT_POSSIBLE
+//
+// MessageText:
+//
+// The read or write operation to an encrypted file could not be completed
+// because the file has not been opened for data access.
+//
+#define ERROR_ENCRYPTED_IO_NOT_POSSIBLE          808L
+
+//
+// MessageId: ERROR_FILE_METADATA_OPTIMIZATION_IN_PROGRESS
+//
+// MessageText:
+//
+// File metadata optimization is already in progress.
+//
+#define ERROR_FILE_METADATA_OPTIMIZATION_IN_PROGRESS 809L
+
+//
+// MessageId: ERROR_QUOTA_ACTIVITY
+//
+// MessageText:
+//
+// The requested operation failed due to quota operation is still in progress.
+//
+#define ERROR_QUOTA_ACTIVITY                     810L
+
+//
+// MessageId: ERROR_HANDLE_REVOKED
+//
+// MessageText:
+//
+// Access to the specified handle has been revoked.
+//
+#define ERROR_HANDLE_REVOKED                     811L
+
+//
+// MessageId: ERROR_CALLBACK_INVOKE_INLINE
+//
+// MessageText:
+//
+// The callback function must be invoked inline.
+//
+#define ERROR_CALLBACK_INVOKE_INLINE             812L
+
+//
+// MessageId: ERROR_CPU_SET_INVALID
+//
+// MessageText:
+//
+// The specified CPU Set IDs are invalid.
+//
+#define ERROR_CPU_SET_INVALID                    813L
+
+//
+// MessageId: ERROR_ENCLAVE_NOT_TERMINATED
+//
+// MessageText:
+//
+// The specified enclave has not yet been terminated.
+//
+#define ERROR_ENCLAVE_NOT_TERMINATED             814L
+
+//
+// MessageId: ERROR_ENCLAVE_VIOLATION
+//
+// MessageText:
+//
+// An attempt was made to access protected memory in violation of its secure
+// access policy.
+//
+#define ERROR_ENCLAVE_VIOLATION                  815L
+
+//
+// MessageId: ERROR_EA_ACCESS_DENIED
+//
+// MessageText:
+//
+// Access to the extended attribute was denied.
+//
+#define ERROR_EA_ACCESS_DENIED                   994L
+
+//
+// MessageId: ERROR_OPERATION_ABORTED
+//
+// MessageText:
+//
+// The I/O operation has been aborted because of either a thread exit or an
+// application request.
+//
+#define ERROR_OPERATION_ABORTED                  995L
+
+//
+// MessageId: ERROR_IO_INCOMPLETE
+//
+// MessageText:
+//
+// Overlapped I/O event is not in a signaled state.
+//
+#define ERROR_IO_INCOMPLETE                      996L
+
+//
+// MessageId: ERROR_IO_PENDING
+//
+// MessageText:
+//
+// Overlapped I/O operation is in progress.
+//
+#define ERROR_IO_PENDING                         997L
+
+//
+// MessageId: ERROR_NOACCESS
+//
+// MessageText:
+//
+// Invalid access to memory location.
+//
+#define ERROR_NOACCESS                           998L
+
+//
+// MessageId: ERROR_SWAPERROR
+//
+// MessageText:
+//
+// Error performing inpage operation.
+//
+#define ERROR_SWAPERROR                          999L
+
+//
+// MessageId: ERROR_STACK_OVERFLOW
+//
+// MessageText:
+//
+// Recursion too deep; the stack overflowed.
+//
+#define ERROR_STACK_OVERFLOW                     1001L
+
+//
+// MessageId: ERROR_INVALID_MESSAGE
+//
+// MessageText:
+//
+// The window cannot act on the sent message.
+//
+#define ERROR_INVALID_MESSAGE                    1002L
+
+//
+// MessageId: ERROR_CAN_NOT_COMPLETE
+//
+// MessageText:
+//
+// Cannot complete this function.
+//
+#define ERROR_CAN_NOT_COMPLETE                   1003L
+
+//
+// MessageId: ERROR_INVALID_FLAGS
+//
+// MessageText:
+//
+// Invalid flags.
+//
+#define ERROR_INVALID_FLAGS                      1004L
+
+//
+// MessageId: ERROR_UNRECOGNIZED_VOLUME
+//
+// MessageText:
+//
+// The volume does not contain a recognized file system. Please make sure that
+// all required file system drivers are loaded and that the volume is not
+// corrupted.
+//
+#define ERROR_UNRECOGNIZED_VOLUME                1005L
+
+//
+// MessageId: ERROR_FILE_INVALID
+//
+// MessageText:
+//
+// The volume for a file has been externally altered so that the opened file is
+// no longer valid.
+//
+#define ERROR_FILE_INVALID                       1006L
+
+//
+// MessageId: ERROR_FULLSCREEN_MODE
+//
+// MessageText:
+//
+// The requested operation cannot be performed in full-screen mode.
+//
+#define ERROR_FULLSCREEN_MODE                    1007L
+
+//
+// MessageId: ERROR_NO_TOKEN
+//
+// MessageText:
+//
+// An attempt was made to reference a token that does not exist.
+//
+#define ERROR_NO_TOKEN                           1008L
+
+//
+// MessageId: ERROR_BADDB
+//
+// MessageText:
+//
+// The configuration registry database is corrupt.
+//
+#define ERROR_BADDB                              1009L
+
+//
+// MessageId: ERROR_BADKEY
+//
+// MessageText:
+//
+// The configuration registry key is invalid.
+//
+#define ERROR_BADKEY                             1010L
+
+//
+// MessageId: ERROR_CANTOPEN
+//
+// MessageText:
+//
+// The configuration registry key could not be opened.
+//
+#define ERROR_CANTOPEN                           1011L
+
+//
+// MessageId: ERROR_CANTREAD
+//
+// MessageText:
+//
+// The configuration registry key could not be read.
+//
+#define ERROR_CANTREAD                           1012L
+
+//
+// MessageId: ERROR_CANTWRITE
+//
+// MessageText:
+//
+// The configuration registry key could not be written.
+//
+#define ERROR_CANTWRITE                          1013L
+
+//
+// MessageId: ERROR_REGISTRY_RECOVERED
+//
+// MessageText:
+//
+// One of the files in the registry database had to be recovered by use of a
+// log or alternate copy. The recovery was successful.
+//
+#define ERROR_REGISTRY_RECOVERED                 1014L
+
+//
+// MessageId: ERROR_REGISTRY_CORRUPT
+//
+// MessageText:
+//
+// The registry is corrupted. The structure of one of the files containing
+// registry data is corrupted, or the system's memory image of the file is
+// corrupted, or the file could not be recovered because the alternate copy or
+// log was absent or corrupted.
+//
+#define ERROR_REGISTRY_CORRUPT                   1015L
+
+//
+// MessageId: ERROR_REGISTRY_IO_FAILED
+//
+// MessageText:
+//
+// An I/O operation initiated by the registry failed unrecoverably. The
+// registry could not read in, or write out, or flush, one of the files that
+// contain the system's image of the registry.
+//
+#define ERROR_REGISTRY_IO_FAILED                 1016L
+
+//
+// MessageId: ERROR_NOT_REGISTRY_FILE
+//
+// MessageText:
+//
+// The system has attempted to load or restore a file into the registry, but
+// the specified file is not in a registry file format.
+//
+#define ERROR_NOT_REGISTRY_FILE                  1017L
+
+//
+// MessageId: ERROR_KEY_DELETED
+//
+// MessageText:
+//
+// Illegal operation attempted on a registry key that has been marked for
+// deletion.
+//
+#define ERROR_KEY_DELETED                        1018L
+
+//
+// MessageId: ERROR_NO_LOG_SPACE
+//
+// MessageText:
+//
+// System could not allocate the required space in a registry log.
+//
+#define ERROR_NO_LOG_SPACE                       1019L
+
+//
+// MessageId: ERROR_KEY_HAS_CHILDREN
+//
+// MessageText:
+//
+// Cannot create a symbolic link in a registry key that already has subkeys or
+// values.
+//
+#define ERROR_KEY_HAS_CHILDREN                   1020L
+
+//
+// MessageId: ERROR_CHILD_MUST_BE_VOLATILE
+//
+// MessageText:
+//
+// Cannot create a stable subkey under a volatile parent key.
+//
+#define ERROR_CHILD_MUST_BE_VOLATILE             1021L
+
+//
+// MessageId: ERROR_NOTIFY_ENUM_DIR
+//
+// MessageText:
+//
+// A notify change request is being completed and the information is not being
+// returned in the caller's buffer. The caller now needs to enumerate the files
+// to find the changes.
+//
+#define ERROR_NOTIFY_ENUM_DIR                    1022L
+
+//
+// MessageId: ERROR_DEPENDENT_SERVICES_RUNNING
+//
+// MessageText:
+//
+// A stop control has been sent to a service that other running services are
+// dependent on.
+//
+#define ERROR_DEPENDENT_SERVICES_RUNNING         1051L
+
+//
+// MessageId: ERROR_INVALID_SERVICE_CONTROL
+//
+// MessageText:
+//
+// The requested control is not valid for this service.
+//
+#define ERROR_INVALID_SERVICE_CONTROL            1052L
+
+//
+// MessageId: ERROR_SERVICE_REQUEST_TIMEOUT
+//
+// MessageText:
+//
+// The service did not respond to the start or control request in a timely
+// fashion.
+//
+#define ERROR_SERVICE_REQUEST_TIMEOUT            1053L
+
+//
+// MessageId: ERROR_SERVICE_NO_THREAD
+//
+// MessageText:
+//
+// A thread could not be created for the service.
+//
+#define ERROR_SERVICE_NO_THREAD                  1054L
+
+//
+// MessageId: ERROR_SERVICE_DATABASE_LOCKED
+//
+// MessageText:
+//
+// The service database is locked.
+//
+#define ERROR_SERVICE_DATABASE_LOCKED            1055L
+
+//
+// MessageId: ERROR_SERVICE_ALREADY_RUNNING
+//
+// MessageText:
+//
+// An instance of the service is already running.
+//
+#define ERROR_SERVICE_ALREADY_RUNNING            1056L
+
+//
+// MessageId: ERROR_INVALID_SERVICE_ACCOUNT
+//
+// MessageText:
+//
+// The account name is invalid or does not exist, or the password is invalid
+// for the account name specified.
+//
+#define ERROR_INVALID_SERVICE_ACCOUNT            1057L
+
+//
+// MessageId: ERROR_SERVICE_DISABLED
+//
+// MessageText:
+//
+// The service cannot be started, either because it is disabled or because it
+// has no enabled devices associated with it.
+//
+#define ERROR_SERVICE_DISABLED                   1058L
+
+//
+// MessageId: ERROR_CIRCULAR_DEPENDENCY
+//
+// MessageText:
+//
+// Circular service dependency was specified.
+//
+#define ERROR_CIRCULAR_DEPENDENCY                1059L
+
+//
+// MessageId: ERROR_SERVICE_DOES_NOT_EXIST
+//
+// MessageText:
+//
+// The specified service does not exist as an installed service.
+//
+#define ERROR_SERVICE_DOES_NOT_EXIST             1060L
+
+//
+// MessageId: ERROR_SERVICE_CANNOT_ACCEPT_CTRL
+//
+// MessageText:
+//
+// The service cannot accept control messages at this time.
+//
+#define ERROR_SERVICE_CANNOT_ACCEPT_CTRL         1061L
+
+//
+// MessageId: ERROR_SERVICE_NOT_ACTIVE
+//
+// MessageText:
+//
+// The service has not been started.
+//
+#define ERROR_SERVICE_NOT_ACTIVE                 1062L
+
+//
+// MessageId: ERROR_FAILED_SERVICE_CONTROLLER_CONNECT
+//
+// MessageText:
+//
+// The service process could not connect to the service controller.
+//
+#define ERROR_FAILED_SERVICE_CONTROLLER_CONNECT  1063L
+
+//
+// MessageId: ERROR_EXCEPTION_IN_SERVICE
+//
+// MessageText:
+//
+// An exception occurred in the service when handling the control request.
+//
+#define ERROR_EXCEPTION_IN_SERVICE               1064L
+
+//
+// MessageId: ERROR_DATABASE_DOES_NOT_EXIST
+//
+// MessageText:
+//
+// The database specified does not exist.
+//
+#define ERROR_DATABASE_DOES_NOT_EXIST            1065L
+
+//
+// MessageId: ERROR_SERVICE_SPECIFIC_ERROR
+//
+// MessageText:
+//
+// The service has returned a service-specific error code.
+//
+#define ERROR_SERVICE_SPECIFIC_ERROR             1066L
+
+//
+// MessageId: ERROR_PROCESS_ABORTED
+//
+// MessageText:
+//
+// The process terminated unexpectedly.
+//
+#define ERROR_PROCESS_ABORTED                    1067L
+
+//
+// MessageId: ERROR_SERVICE_DEPENDENCY_FAIL
+//
+// MessageText:
+//
+// The dependency service or group failed to start.
+//
+#define ERROR_SERVICE_DEPENDENCY_FAIL            1068L
+
+//
+// MessageId: ERROR_SERVICE_LOGON_FAILED
+//
+// MessageText:
+//
+// The service did not start due to a logon failure.
+//
+#define ERROR_SERVICE_LOGON_FAILED               1069L
+
+//
+// MessageId: ERROR_SERVICE_START_HANG
+//
+// MessageText:
+//
+// After starting, the service hung in a start-pending state.
+//
+#define ERROR_SERVICE_START_HANG                 1070L
+
+//
+// MessageId: ERROR_INVALID_SERVICE_LOCK
+//
+// MessageText:
+//
+// The specified service database lock is invalid.
+//
+#define ERROR_INVALID_SERVICE_LOCK               1071L
+
+//
+// MessageId: ERROR_SERVICE_MARKED_FOR_DELETE
+//
+// MessageText:
+//
+// The specified service has been marked for deletion.
+//
+#define ERROR_SERVICE_MARKED_FOR_DELETE          1072L
+
+//
+// MessageId: ERROR_SERVICE_EXISTS
+//
+// MessageText:
+//
+// The specified service already exists.
+//
+#define ERROR_SERVICE_EXISTS                     1073L
+
+//
+// MessageId: ERROR_ALREADY_RUNNING_LKG
+//
+// MessageText:
+//
+// The system is currently running with the last-known-good configuration.
+//
+#define ERROR_ALREADY_RUNNING_LKG                1074L
+
+//
+// MessageId: ERROR_SERVICE_DEPENDENCY_DELETED
+//
+// MessageText:
+//
+// The dependency service does not exist or has been marked for deletion.
+//
+#define ERROR_SERVICE_DEPENDENCY_DELETED         1075L
+
+//
+// MessageId: ERROR_BOOT_ALREADY_ACCEPTED
+//
+// MessageText:
+//
+// The current boot has already been accepted for use as the last-known-good
+// control set.
+//
+#define ERROR_BOOT_ALREADY_ACCEPTED              1076L
+
+//
+// MessageId: ERROR_SERVICE_NEVER_STARTED
+//
+// MessageText:
+//
+// No attempts to start the service have been made since the last boot.
+//
+#define ERROR_SERVICE_NEVER_STARTED              1077L
+
+//
+// MessageId: ERROR_DUPLICATE_SERVICE_NAME
+//
+// MessageText:
+//
+// The name is already in use as either a service name or a service display
+// name.
+//
+#define ERROR_DUPLICATE_SERVICE_NAME             1078L
+
+//
+// MessageId: ERROR_DIFFERENT_SERVICE_ACCOUNT
+//
+// MessageText:
+//
+// The account specified for this service is different from the account
+// specified for other services running in the same process.
+//
+#define ERROR_DIFFERENT_SERVICE_ACCOUNT          1079L
+
+//
+// MessageId: ERROR_CANNOT_DETECT_DRIVER_FAILURE
+//
+// MessageText:
+//
+// Failure actions can only be set for Win32 services, not for drivers.
+//
+#define ERROR_CANNOT_DETECT_DRIVER_FAILURE       1080L
+
+//
+// MessageId: ERROR_CANNOT_DETECT_PROCESS_ABORT
+//
+// MessageText:
+//
+// This service runs in the same process as the service control manager.
+// Therefore, the service control manager cannot take action if this service's
+// process terminates unexpectedly.
+//
+#define ERROR_CANNOT_DETECT_PROCESS_ABORT        1081L
+
+//
+// MessageId: ERROR_NO_RECOVERY_PROGRAM
+//
+// MessageText:
+//
+// No recovery program has been configured for this service.
+//
+#define ERROR_NO_RECOVERY_PROGRAM                1082L
+
+//
+// MessageId: ERROR_SERVICE_NOT_IN_EXE
+//
+// MessageText:
+//
+// The executable program that this service is configured to run in does not
+// implement the service.
+//
+#define ERROR_SERVICE_NOT_IN_EXE                 1083L
+
+//
+// MessageId: ERROR_NOT_SAFEBOOT_SERVICE
+//
+// MessageText:
+//
+// This service cannot be started in Safe Mode.
+//
+#define ERROR_NOT_SAFEBOOT_SERVICE               1084L
+
+//
+// MessageId: ERROR_END_OF_MEDIA
+//
+// MessageText:
+//
+// The physical end of the tape has been reached.
+//
+#define ERROR_END_OF_MEDIA                       1100L
+
+//
+// MessageId: ERROR_FILEMARK_DETECTED
+//
+// MessageText:
+//
+// A tape access reached a filemark.
+//
+#define ERROR_FILEMARK_DETECTED                  1101L
+
+//
+// MessageId: ERROR_BEGINNING_OF_MEDIA
+//
+// MessageText:
+//
+// The beginning of the tape or a partition was encountered.
+//
+#define ERROR_BEGINNING_OF_MEDIA                 1102L
+
+//
+// MessageId: ERROR_SETMARK_DETECTED
+//
+// MessageText:
+//
+// A tape access reached the end of a set of files.
+//
+#define ERROR_SETMARK_DETECTED                   1103L
+
+//
+// MessageId: ERROR_NO_DATA_DETECTED
+//
+// MessageText:
+//
+// No more data is on the tape.
+//
+#define ERROR_NO_DATA_DETECTED                   1104L
+
+//
+// MessageId: ERROR_PARTITION_FAILURE
+//
+// MessageText:
+//
+// Tape could not be partitioned.
+//
+#define ERROR_PARTITION_FAILURE                  1105L
+
+//
+// MessageId: ERROR_INVALID_BLOCK_LENGTH
+//
+// MessageText:
+//
+// When accessing a new tape of a multivolume partition, the current block size
+// is incorrect.
+//
+#define ERROR_INVALID_BLOCK_LENGTH               1106L
+
+//
+// MessageId: ERROR_DEVICE_NOT_PARTITIONED
+//
+// MessageText:
+//
+// Tape partition information could not be found when loading a tape.
+//
+#define ERROR_DEVICE_NOT_PARTITIONED             1107L
+
+//
+// MessageId: ERROR_UNABLE_TO_LOCK_MEDIA
+//
+// MessageText:
+//
+// Unable to lock the media eject mechanism.
+//
+#define ERROR_UNABLE_TO_LOCK_MEDIA               1108L
+
+//
+// MessageId: ERROR_UNABLE_TO_UNLOAD_MEDIA
+//
+// MessageText:
+//
+// Unable to unload the media.
+//
+#define ERROR_UNABLE_TO_UNLOAD_MEDIA             1109L
+
+//
+// MessageId: ERROR_MEDIA_CHANGED
+//
+// MessageText:
+//
+// The media in the drive may have changed.
+//
+#define ERROR_MEDIA_CHANGED                      1110L
+
+//
+// MessageId: ERROR_BUS_RESET
+//
+// MessageText:
+//
+// The I/O bus was reset.
+//
+#define ERROR_BUS_RESET                          1111L
+
+//
+// MessageId: ERROR_NO_MEDIA_IN_DRIVE
+//
+// MessageText:
+//
+// No media in drive.
+//
+#define ERROR_NO_MEDIA_IN_DRIVE                  1112L
+
+//
+// MessageId: ERROR_NO_UNICODE_TRANSLATION
+//
+// MessageText:
+//
+// No mapping for the Unicode character exists in the target multi-byte code
+// page.
+//
+#define ERROR_NO_UNICODE_TRANSLATION             1113L
+
+//
+// MessageId: ERROR_DLL_INIT_FAILED
+//
+// MessageText:
+//
+// A dynamic link library (DLL) initialization routine failed.
+//
+#define ERROR_DLL_INIT_FAILED                    1114L
+
+//
+// MessageId: ERROR_SHUTDOWN_IN_PROGRESS
+//
+// MessageText:
+//
+// A system shutdown is in progress.
+//
+#define ERROR_SHUTDOWN_IN_PROGRESS               1115L
+
+//
+// MessageId: ERROR_NO_SHUTDOWN_IN_PROGRESS
+//
+// MessageText:
+//
+// Unable to abort the system shutdown because no shutdown was in progress.
+//
+#define ERROR_NO_SHUTDOWN_IN_PROGRESS            1116L
+
+//
+// MessageId: ERROR_IO_DEVICE
+//
+// MessageText:
+//
+// The request could not be performed because of an I/O device error.
+//
+#define ERROR_IO_DEVICE                          1117L
+
+//
+// MessageId: ERROR_SERIAL_NO_DEVICE
+//
+// MessageText:
+//
+// No serial device was successfully initialized. The serial driver will
+// unload.
+//
+#define ERROR_SERIAL_NO_DEVICE                   1118L
+
+//
+// MessageId: ERROR_IRQ_BUSY
+//
+// MessageText:
+//
+// Unable to open a device that was sharing an interrupt request (IRQ) with
+// other devices. At least one other device that uses that IRQ was already
+// opened.
+//
+#define ERROR_IRQ_BUSY                           1119L
+
+//
+// MessageId: ERROR_MORE_WRITES
+//
+// MessageText:
+//
+// A serial I/O operation was completed by another write to the serial port.
+// The IOCTL_SERIAL_XOFF_COUNTER reached zero.
+//
+#define ERROR_MORE_WRITES                        1120L
+
+//
+// MessageId: ERROR_COUNTER_TIMEOUT
+//
+// MessageText:
+//
+// A serial I/O operation completed because the timeout period expired. The
+// IOCTL_SERIAL_XOFF_COUNTER did not reach zero.
+//
+#define ERROR_COUNTER_TIMEOUT                    1121L
+
+//
+// MessageId: ERROR_FLOPPY_ID_MARK_NOT_FOUND
+//
+// MessageText:
+//
+// No ID address mark was found on the floppy disk.
+//
+#define ERROR_FLOPPY_ID_MARK_NOT_FOUND           1122L
+
+//
+// MessageId: ERROR_FLOPPY_WRONG_CYLINDER
+//
+// MessageText:
+//
+// Mismatch between the floppy disk sector ID field and the floppy disk
+// controller track address.
+//
+#define ERROR_FLOPPY_WRONG_CYLINDER              1123L
+
+//
+// MessageId: ERROR_FLOPPY_UNKNOWN_ERROR
+//
+// MessageText:
+//
+// The floppy disk controller reported an error that is not recognized by the
+// floppy disk driver.
+//
+#define ERROR_FLOPPY_UNKNOWN_ERROR               1124L
+
+//
+// MessageId: ERROR_FLOPPY_BAD_REGISTERS
+//
+// MessageText:
+//
+// The floppy disk controller returned inconsistent results in its registers.
+//
+#define ERROR_FLOPPY_BAD_REGISTERS               1125L
+
+//
+// MessageId: ERROR_DISK_RECALIBRATE_FAILED
+//
+// MessageText:
+//
+// While accessing the hard disk, a recalibrate operation failed, even after
+// retries.
+//
+#define ERROR_DISK_RECALIBRATE_FAILED            1126L
+
+//
+// MessageId: ERROR_DISK_OPERATION_FAILED
+//
+// MessageText:
+//
+// While accessing the hard disk, a disk operation failed even after retries.
+//
+#define ERROR_DISK_OPERATION_FAILED              1127L
+
+//
+// MessageId: ERROR_DISK_RESET_FAILED
+//
+// MessageText:
+//
+// While accessing the hard disk, a disk controller reset was needed, but even
+// that failed.
+//
+#define ERROR_DISK_RESET_FAILED                  1128L
+
+//
+// MessageId: ERROR_EOM_OVERFLOW
+//
+// MessageText:
+//
+// Physical end of tape encountered.
+//
+#define ERROR_EOM_OVERFLOW                       1129L
+
+//
+// MessageId: ERROR_NOT_ENOUGH_SERVER_MEMORY
+//
+// MessageText:
+//
+// Not enough server memory resources are available to process this command.
+//
+#define ERROR_NOT_ENOUGH_SERVER_MEMORY           1130L
+
+//
+// MessageId: ERROR_POSSIBLE_DEADLOCK
+//
+// MessageText:
+//
+// A potential deadlock condition has been detected.
+//
+#define ERROR_POSSIBLE_DEADLOCK                  1131L
+
+//
+// MessageId: ERROR_MAPPED_ALIGNMENT
+//
+// MessageText:
+//
+// The base address or the file offset specified does not have the proper
+// alignment.
+//
+#define ERROR_MAPPED_ALIGNMENT                   1132L
+
+//
+// MessageId: ERROR_SET_POWER_STATE_VETOED
+//
+// MessageText:
+//
+// An attempt to change the system power state was vetoed by another
+// application or driver.
+//
+#define ERROR_SET_POWER_STATE_VETOED             1140L
+
+//
+// MessageId: ERROR_SET_POWER_STATE_FAILED
+//
+// MessageText:
+//
+// The system BIOS failed an attempt to change the system power state.
+//
+#define ERROR_SET_POWER_STATE_FAILED             1141L
+
+//
+// MessageId: ERROR_TOO_MANY_LINKS
+//
+// MessageText:
+//
+// An attempt was made to create more links on a file than the file system
+// supports.
+//
+#define ERROR_TOO_MANY_LINKS                     1142L
+
+//
+// MessageId: ERROR_OLD_WIN_VERSION
+//
+// MessageText:
+//
+// The specified program requires a newer version of Windows.
+//
+#define ERROR_OLD_WIN_VERSION                    1150L
+
+//
+// MessageId: ERROR_APP_WRONG_OS
+//
+// MessageText:
+//
+// The specified program is not a Windows or MS-DOS program.
+//
+#define ERROR_APP_WRONG_OS                       1151L
+
+//
+// MessageId: ERROR_SINGLE_INSTANCE_APP
+//
+// MessageText:
+//
+// Cannot start more than one instance of the specified program.
+//
+#define ERROR_SINGLE_INSTANCE_APP                1152L
+
+//
+// MessageId: ERROR_RMODE_APP
+//
+// MessageText:
+//
+// The specified program was written for an earlier version of Windows.
+//
+#define ERROR_RMODE_APP                          1153L
+
+//
+// MessageId: ERROR_INVALID_DLL
+//
+// MessageText:
+//
+// One of the library files needed to run this application is damaged.
+//
+#define ERROR_INVALID_DLL                        1154L
+
+//
+// MessageId: ERROR_NO_ASSOCIATION
+//
+// MessageText:
+//
+// No application is associated with the specified file for this operation.
+//
+#define ERROR_NO_ASSOCIATION                     1155L
+
+//
+// MessageId: ERROR_DDE_FAIL
+//
+// MessageText:
+//
+// An error occurred in sending the command to the application.
+//
+#define ERROR_DDE_FAIL                           1156L
+
+//
+// MessageId: ERROR_DLL_NOT_FOUND
+//
+// MessageText:
+//
+// One of the library files needed to run this application cannot be found.
+//
+#define ERROR_DLL_NOT_FOUND                      1157L
+
+//
+// MessageId: ERROR_NO_MORE_USER_HANDLES
+//
+// MessageText:
+//
+// The current process has used all of its system allowance of handles for
+// Window Manager objects.
+//
+#define ERROR_NO_MORE_USER_HANDLES               1158L
+
+//
+// MessageId: ERROR_MESSAGE_SYNC_ONLY
+//
+// MessageText:
+//
+// The message can be used only with synchronous operations.
+//
+#define ERROR_MESSAGE_SYNC_ONLY                  1159L
+
+//
+// MessageId: ERROR_SOURCE_ELEMENT_EMPTY
+//
+// MessageText:
+//
+// The indicated source element has no media.
+//
+#define ERROR_SOURCE_ELEMENT_EMPTY               1160L
+
+//
+// MessageId: ERROR_DESTINATION_ELEMENT_FULL
+//
+// MessageText:
+//
+// The indicated destination element already contains media.
+//
+#define ERROR_DESTINATION_ELEMENT_FULL           1161L
+
+//
+// MessageId: ERROR_ILLEGAL_ELEMENT_ADDRESS
+//
+// MessageText:
+//
+// The indicated element does not exist.
+//
+#define ERROR_ILLEGAL_ELEMENT_ADDRESS            1162L
+
+//
+// MessageId: ERROR_MAGAZINE_NOT_PRESENT
+//
+// MessageText:
+//
+// The indicated element is part of a magazine that is not present.
+//
+#define ERROR_MAGAZINE_NOT_PRESENT               1163L
+
+//
+// MessageId: ERROR_DEVICE_REINITIALIZATION_NEEDED
+//
+// MessageText:
+//
+// The indicated device requires reinitialization due to hardware errors.
+//
+#define ERROR_DEVICE_REINITIALIZATION_NEEDED     1164L
+
+//
+// MessageId: ERROR_DEVICE_REQUIRES_CLEANING
+//
+// MessageText:
+//
+// The device has indicated that cleaning is required before further operations
+// are attempted.
+//
+#define ERROR_DEVICE_REQUIRES_CLEANING           1165L
+
+//
+// MessageId: ERROR_DEVICE_DOOR_OPEN
+//
+// MessageText:
+//
+// The device has indicated that its door is open.
+//
+#define ERROR_DEVICE_DOOR_OPEN                   1166L
+
+//
+// MessageId: ERROR_DEVICE_NOT_CONNECTED
+//
+// MessageText:
+//
+// The device is not connected.
+//
+#define ERROR_DEVICE_NOT_CONNECTED               1167L
+
+//
+// MessageId: ERROR_NOT_FOUND
+//
+// MessageText:
+//
+// Element not found.
+//
+#define ERROR_NOT_FOUND                          1168L
+
+//
+// MessageId: ERROR_NO_MATCH
+//
+// MessageText:
+//
+// There was no match for the specified key in the index.
+//
+#define ERROR_NO_MATCH                           1169L
+
+//
+// MessageId: ERROR_SET_NOT_FOUND
+//
+// MessageText:
+//
+// The property set specified does not exist on the object.
+//
+#define ERROR_SET_NOT_FOUND                      1170L
+
+//
+// MessageId: ERROR_POINT_NOT_FOUND
+//
+// MessageText:
+//
+// The point passed to GetMouseMovePoints is not in the buffer.
+//
+#define ERROR_POINT_NOT_FOUND                    1171L
+
+//
+// MessageId: ERROR_NO_TRACKING_SERVICE
+//
+// MessageText:
+//
+// The tracking (workstation) service is not running.
+//
+#define ERROR_NO_TRACKING_SERVICE                1172L
+
+//
+// MessageId: ERROR_NO_VOLUME_ID
+//
+// MessageText:
+//
+// The Volume ID could not be found.
+//
+#define ERROR_NO_VOLUME_ID                       1173L
+
+//
+// MessageId: ERROR_UNABLE_TO_REMOVE_REPLACED
+//
+// MessageText:
+//
+// Unable to remove the file to be replaced.
+//
+#define ERROR_UNABLE_TO_REMOVE_REPLACED          1175L
+
+//
+// MessageId: ERROR_UNABLE_TO_MOVE_REPLACEMENT
+//
+// MessageText:
+//
+// Unable to move the replacement file to the file to be replaced. The file to
+// be replaced has retained its original name.
+//
+#define ERROR_UNABLE_TO_MOVE_REPLACEMENT         1176L
+
+//
+// MessageId: ERROR_UNABLE_TO_MOVE_REPLACEMENT_2
+//
+// MessageText:
+//
+// Unable to move the replacement file to the file to be replaced. The file to
+// be replaced has been renamed using the backup name.
+//
+#define ERROR_UNABLE_TO_MOVE_REPLACEMENT_2       1177L
+
+//
+// MessageId: ERROR_JOURNAL_DELETE_IN_PROGRESS
+//
+// MessageText:
+//
+// The volume change journal is being deleted.
+//
+#define ERROR_JOURNAL_DELETE_IN_PROGRESS         1178L
+
+//
+// MessageId: ERROR_JOURNAL_NOT_ACTIVE
+//
+// MessageText:
+//
+// The volume change journal is not active.
+//
+#define ERROR_JOURNAL_NOT_ACTIVE                 1179L
+
+//
+// MessageId: ERROR_POTENTIAL_FILE_FOUND
+//
+// MessageText:
+//
+// A file was found, but it may not be the correct file.
+//
+#define ERROR_POTENTIAL_FILE_FOUND               1180L
+
+//
+// MessageId: ERROR_JOURNAL_ENTRY_DELETED
+//
+// MessageText:
+//
+// The journal entry has been deleted from the journal.
+//
+#define ERROR_JOURNAL_ENTRY_DELETED              1181L
+
+//
+// MessageId: ERROR_SHUTDOWN_IS_SCHEDULED
+//
+// MessageText:
+//
+// A system shutdown has already been scheduled.
+//
+#define ERROR_SHUTDOWN_IS_SCHEDULED              1190L
+
+//
+// MessageId: ERROR_SHUTDOWN_USERS_LOGGED_ON
+//
+// MessageText:
+//
+// The system shutdown cannot be initiated because there are other users logged
+// on to the computer.
+//
+#define ERROR_SHUTDOWN_USERS_LOGGED_ON           1191L
+
+//
+// MessageId: ERROR_BAD_DEVICE
+//
+// MessageText:
+//
+// The specified device name is invalid.
+//
+#define ERROR_BAD_DEVICE                         1200L
+
+//
+// MessageId: ERROR_CONNECTION_UNAVAIL
+//
+// MessageText:
+//
+// The device is not currently connected but it is a remembered connection.
+//
+#define ERROR_CONNECTION_UNAVAIL                 1201L
+
+//
+// MessageId: ERROR_DEVICE_ALREADY_REMEMBERED
+//
+// MessageText:
+//
+// The local device name has a remembered connection to another network
+// resource.
+//
+#define ERROR_DEVICE_ALREADY_REMEMBERED          1202L
+
+//
+// MessageId: ERROR_NO_NET_OR_BAD_PATH
+//
+// MessageText:
+//
+// The network path was either typed incorrectly, does not exist, or the
+// network provider is not currently available. Please try retyping the path or
+// contact your network administrator.
+//
+#define ERROR_NO_NET_OR_BAD_PATH                 1203L
+
+//
+// MessageId: ERROR_BAD_PROVIDER
+//
+// MessageText:
+//
+// The specified network provider name is invalid.
+//
+#define ERROR_BAD_PROVIDER                       1204L
+
+//
+// MessageId: ERROR_CANNOT_OPEN_PROFILE
+//
+// MessageText:
+//
+// Unable to open the network connection profile.
+//
+#define ERROR_CANNOT_OPEN_PROFILE                1205L
+
+//
+// MessageId: ERROR_BAD_PROFILE
+//
+// MessageText:
+//
+// The network connection profile is corrupted.
+//
+#define ERROR_BAD_PROFILE                        1206L
+
+//
+// MessageId: ERROR_NOT_CONTAINER
+//
+// MessageText:
+//
+// Cannot enumerate a noncontainer.
+//
+#define ERROR_NOT_CONTAINER                      1207L
+
+//
+// MessageId: ERROR_EXTENDED_ERROR
+//
+// MessageText:
+//
+// An extended error has occurred.
+//
+#define ERROR_EXTENDED_ERROR                     1208L
+
+//
+// MessageId: ERROR_INVALID_GROUPNAME
+//
+// MessageText:
+//
+// The format of the specified group name is invalid.
+//
+#define ERROR_INVALID_GROUPNAME                  1209L
+
+//
+// MessageId: ERROR_INVALID_COMPUTERNAME
+//
+// MessageText:
+//
+// The format of the specified computer name is invalid.
+//
+#define ERROR_INVALID_COMPUTERNAME               1210L
+
+//
+// MessageId: ERROR_INVALID_EVENTNAME
+//
+// MessageText:
+//
+// The format of the specified event name is invalid.
+//
+#define ERROR_INVALID_EVENTNAME                  1211L
+
+//
+// MessageId: ERROR_INVALID_DOMAINNAME
+//
+// MessageText:
+//
+// The format of the specified domain name is invalid.
+//
+#define ERROR_INVALID_DOMAINNAME                 1212L
+
+//
+// MessageId: ERROR_INVALID_SERVICENAME
+//
+// MessageText:
+//
+// The format of the specified service name is invalid.
+//
+#define ERROR_INVALID_SERVICENAME                1213L
+
+//
+// MessageId: ERROR_INVALID_NETNAME
+//
+// MessageText:
+//
+// The format of the specified network name is invalid.
+//
+#define ERROR_INVALID_NETNAME                    1214L
+
+//
+// MessageId: ERROR_INVALID_SHARENAME
+//
+// MessageText:
+//
+// The format of the specified share name is invalid.
+//
+#define ERROR_INVALID_SHARENAME                  1215L
+
+//
+// MessageId: ERROR_INVALID_PASSWORDNAME
+//
+// MessageText:
+//
+// The format of the specified password is invalid.
+//
+#define ERROR_INVALID_PASSWORDNAME               1216L
+
+//
+// MessageId: ERROR_INVALID_MESSAGENAME
+//
+// MessageText:
+//
+// The format of the specified message name is invalid.
+//
+#define ERROR_INVALID_MESSAGENAME                1217L
+
+//
+// MessageId: ERROR_INVALID_MESSAGEDEST
+//
+// MessageText:
+//
+// The format of the specified message destination is invalid.
+//
+#define ERROR_INVALID_MESSAGEDEST                1218L
+
+//
+// MessageId: ERROR_SESSION_CREDENTIAL_CONFLICT
+//
+// MessageText:
+//
+// Multiple connections to a server or shared resource by the same user, using
+// more than one user name, are not allowed. Disconnect all previous
+// connections to the server or shared resource and try again.
+//
+#define ERROR_SESSION_CREDENTIAL_CONFLICT        1219L
+
+//
+// MessageId: ERROR_REMOTE_SESSION_LIMIT_EXCEEDED
+//
+// MessageText:
+//
+// An attempt was made to establish a session to a network server, but there
+// are already too many sessions established to that server.
+//
+#define ERROR_REMOTE_SESSION_LIMIT_EXCEEDED      1220L
+
+//
+// MessageId: ERROR_DUP_DOMAINNAME
+//
+// MessageText:
+//
+// The workgroup or domain name is already in use by another computer on the
+// network.
+//
+#define ERROR_DUP_DOMAINNAME                     1221L
+
+//
+// MessageId: ERROR_NO_NETWORK
+//
+// MessageText:
+//
+// The network is not present or not started.
+//
+#define ERROR_NO_NETWORK                         1222L
+
+//
+// MessageId: ERROR_CANCELLED
+//
+// MessageText:
+//
+// The operation was canceled by the user.
+//
+#define ERROR_CANCELLED                          1223L
+
+//
+// MessageId: ERROR_USER_MAPPED_FILE
+//
+// MessageText:
+//
+// The requested operation cannot be performed on a file with a user-mapped
+// section open.
+//
+#define ERROR_USER_MAPPED_FILE                   1224L
+
+//
+// MessageId: ERROR_CONNECTION_REFUSED
+//
+// MessageText:
+//
+// The remote computer refused the network connection.
+//
+#define ERROR_CONNECTION_REFUSED                 1225L
+
+//
+// MessageId: ERROR_GRACEFUL_DISCONNECT
+//
+// MessageText:
+//
+// The network connection was gracefully closed.
+//
+#define ERROR_GRACEFUL_DISCONNECT                1226L
+
+//
+// MessageId: ERROR_ADDRESS_ALREADY_ASSOCIATED
+//
+// MessageText:
+//
+// The network transport endpoint already has an address associated with it.
+//
+#define ERROR_ADDRESS_ALREADY_ASSOCIATED         1227L
+
+//
+// MessageId: ERROR_ADDRESS_NOT_ASSOCIATED
+//
+// MessageText:
+//
+// An address has not yet been associated with the network endpoint.
+//
+#define ERROR_ADDRESS_NOT_ASSOCIATED             1228L
+
+//
+// MessageId: ERROR_CONNECTION_INVALID
+//
+// MessageText:
+//
+// An operation was attempted on a nonexistent network connection.
+//
+#define ERROR_CONNECTION_INVALID                 1229L
+
+//
+// MessageId: ERROR_CONNECTION_ACTIVE
+//
+// MessageText:
+//
+// An invalid operation was attempted on an active network connection.
+//
+#define ERROR_CONNECTION_ACTIVE                  1230L
+
+//
+// MessageId: ERROR_NETWORK_UNREACHABLE
+//
+// MessageText:
+//
+// The network location cannot be reached. For information about network
+// troubleshooting, see Windows Help.
+//
+#define ERROR_NETWORK_UNREACHABLE                1231L
+
+//
+// MessageId: ERROR_HOST_UNREACHABLE
+//
+// MessageText:
+//
+// The network location cannot be reached. For information about network
+// troubleshooting, see Windows Help.
+//
+#define ERROR_HOST_UNREACHABLE                   1232L
+
+//
+// MessageId: ERROR_PROTOCOL_UNREACHABLE
+//
+// MessageText:
+//
+// The network location cannot be reached. For information about network
+// troubleshooting, see Windows Help.
+//
+#define ERROR_PROTOCOL_UNREACHABLE               1233L
+
+//
+// MessageId: ERROR_PORT_UNREACHABLE
+//
+// MessageText:
+//
+// No service is operating at the destination network endpoint on the remote
+// system.
+//
+#define ERROR_PORT_UNREACHABLE                   1234L
+
+//
+// MessageId: ERROR_REQUEST_ABORTED
+//
+// MessageText:
+//
+// The request was aborted.
+//
+#define ERROR_REQUEST_ABORTED                    1235L
+
+//
+// MessageId: ERROR_CONNECTION_ABORTED
+//
+// MessageText:
+//
+// The network connection was aborted by the local system.
+//
+#define ERROR_CONNECTION_ABORTED                 1236L
+
+//
+// MessageId: ERROR_RETRY
+//
+// MessageText:
+//
+// The operation could not be completed. A retry should be performed.
+//
+#define ERROR_RETRY                              1237L
+
+//
+// MessageId: ERROR_CONNECTION_COUNT_LIMIT
+//
+// MessageText:
+//
+// A connection to the server could not be made because the limit on the number
+// of concurrent connections for this account has been reached.
+//
+#define ERROR_CONNECTION_COUNT_LIMIT             1238L
+
+//
+// MessageId: ERROR_LOGIN_TIME_RESTRICTION
+//
+// MessageText:
+//
+// Attempting to log in during an unauthorized time of day for this account.
+//
+#define ERROR_LOGIN_TIME_RESTRICTION             1239L
+
+//
+// MessageId: ERROR_LOGIN_WKSTA_RESTRICTION
+//
+// MessageText:
+//
+// The account is not authorized to log in from this station.
+//
+#define ERROR_LOGIN_WKSTA_RESTRICTION            1240L
+
+//
+// MessageId: ERROR_INCORRECT_ADDRESS
+//
+// MessageText:
+//
+// The network address could not be used for the operation requested.
+//
+#define ERROR_INCORRECT_ADDRESS                  1241L
+
+//
+// MessageId: ERROR_ALREADY_REGISTERED
+//
+// MessageText:
+//
+// The service is already registered.
+//
+#define ERROR_ALREADY_REGISTERED                 1242L
+
+//
+// MessageId: ERROR_SERVICE_NOT_FOUND
+//
+// MessageText:
+//
+// The specified service does not exist.
+//
+#define ERROR_SERVICE_NOT_FOUND                  1243L
+
+//
+// MessageId: ERROR_NOT_AUTHENTICATED
+//
+// MessageText:
+//
+// The operation being requested was not performed because the user has not
+// been authenticated.
+//
+#define ERROR_NOT_AUTHENTICATED                  1244L
+
+//
+// MessageId: ERROR_NOT_LOGGED_ON
+//
+// MessageText:
+//
+// The operation being requested was not performed because the user has not
+// logged on to the network. The specified service does not exist.
+//
+#define ERROR_NOT_LOGGED_ON                      1245L
+
+//
+// MessageId: ERROR_CONTINUE
+//
+// MessageText:
+//
+// Continue with work in progress.
+//
+#define ERROR_CONTINUE                           1246L
+
+//
+// MessageId: ERROR_ALREADY_INITIALIZED
+//
+// MessageText:
+//
+// An attempt was made to perform an initialization operation when
+// initialization has already been completed.
+//
+#define ERROR_ALREADY_INITIALIZED                1247L
+
+//
+// MessageId: ERROR_NO_MORE_DEVICES
+//
+// MessageText:
+//
+// No more local devices.
+//
+#define ERROR_NO_MORE_DEVICES                    1248L
+
+//
+// MessageId: ERROR_NO_SUCH_SITE
+//
+// MessageText:
+//
+// The specified site does not exist.
+//
+#define ERROR_NO_SUCH_SITE                       1249L
+
+//
+// MessageId: ERROR_DOMAIN_CONTROLLER_EXISTS
+//
+// MessageText:
+//
+// A domain controller with the specified name already exists.
+//
+#define ERROR_DOMAIN_CONTROLLER_EXISTS           1250L
+
+//
+// MessageId: ERROR_ONLY_IF_CONNECTED
+//
+// MessageText:
+//
+// This operation is supported only when you are connected to the server.
+//
+#define ERROR_ONLY_IF_CONNECTED                  1251L
+
+//
+// MessageId: ERROR_OVERRIDE_NOCHANGES
+//
+// MessageText:
+//
+// The group policy framework should call the extension even if there are no
+// changes.
+//
+#define ERROR_OVERRIDE_NOCHANGES                 1252L
+
+//
+// MessageId: ERROR_BAD_USER_PROFILE
+//
+// MessageText:
+//
+// The specified user does not have a valid profile.
+//
+#define ERROR_BAD_USER_PROFILE                   1253L
+
+//
+// MessageId: ERROR_NOT_SUPPORTED_ON_SBS
+//
+// MessageText:
+//
+// This operation is not supported on a computer running Windows Server 2003
+// for Small Business Server.
+//
+#define ERROR_NOT_SUPPORTED_ON_SBS               1254L
+
+//
+// MessageId: ERROR_SERVER_SHUTDOWN_IN_PROGRESS
+//
+// MessageText:
+//
+// The server machine is shutting down.
+//
+#define ERROR_SERVER_SHUTDOWN_IN_PROGRESS        1255L
+
+//
+// MessageId: ERROR_HOST_DOWN
+//
+// MessageText:
+//
+// The remote system is not available. For information about network
+// troubleshooting, see Windows Help.
+//
+#define ERROR_HOST_DOWN                          1256L
+
+//
+// MessageId: ERROR_NON_ACCOUNT_SID
+//
+// MessageText:
+//
+// The security identifier provided is not from an account domain.
+//
+#define ERROR_NON_ACCOUNT_SID                    1257L
+
+//
+// MessageId: ERROR_NON_DOMAIN_SID
+//
+// MessageText:
+//
+// The security identifier provided does not have a domain component.
+//
+#define ERROR_NON_DOMAIN_SID                     1258L
+
+//
+// MessageId: ERROR_APPHELP_BLOCK
+//
+// MessageText:
+//
+// AppHelp dialog canceled thus preventing the application from starting.
+//
+#define ERROR_APPHELP_BLOCK                      1259L
+
+//
+// MessageId: ERROR_ACCESS_DISABLED_BY_POLICY
+//
+// MessageText:
+//
+// This program is blocked by group policy. For more information, contact your
+// system administrator.
+//
+#define ERROR_ACCESS_DISABLED_BY_POLICY          1260L
+
+//
+// MessageId: ERROR_REG_NAT_CONSUMPTION
+//
+// MessageText:
+//
+// A program attempt to use an invalid register value. Normally caused by an
+// uninitialized register. This error is Itanium specific.
+//
+#define ERROR_REG_NAT_CONSUMPTION                1261L
+
+//
+// MessageId: ERROR_CSCSHARE_OFFLINE
+//
+// MessageText:
+//
+// The share is currently offline or does not exist.
+//
+#define ERROR_CSCSHARE_OFFLINE                   1262L
+
+//
+// MessageId: ERROR_PKINIT_FAILURE
+//
+// MessageText:
+//
+// The Kerberos protocol encountered an error while validating the KDC
+// certificate during smartcard logon. There is more information in the system
+// event log.
+//
+#define ERROR_PKINIT_FAILURE                     1263L
+
+//
+// MessageId: ERROR_SMARTCARD_SUBSYSTEM_FAILURE
+//
+// MessageText:
+//
+// The Kerberos protocol encountered an error while attempting to utilize the
+// smartcard subsystem.
+//
+#define ERROR_SMARTCARD_SUBSYSTEM_FAILURE        1264L
+
+//
+// MessageId: ERROR_DOWNGRADE_DETECTED
+//
+// MessageText:
+//
+// The system cannot contact a domain controller to service the authentication
+// request. Please try again later.
+//
+#define ERROR_DOWNGRADE_DETECTED                 1265L
+
+//
+// MessageId: ERROR_MACHINE_LOCKED
+//
+// MessageText:
+//
+// The machine is locked and cannot be shut down without the force option.
+//
+#define ERROR_MACHINE_LOCKED                     1271L
+
+//
+// MessageId: ERROR_SMB_GUEST_LOGON_BLOCKED
+//
+// MessageText:
+//
+// You can't access this shared folder because your organization's security
+// policies block unauthenticated guest access. These policies help protect
+// your PC from unsafe or malicious devices on the network.
+//
+#define ERROR_SMB_GUEST_LOGON_BLOCKED            1272L
+
+//
+// MessageId: ERROR_CALLBACK_SUPPLIED_INVALID_DATA
+//
+// MessageText:
+//
+// An application-defined callback gave invalid data when called.
+//
+#define ERROR_CALLBACK_SUPPLIED_INVALID_DATA     1273L
+
+//
+// MessageId: ERROR_SYNC_FOREGROUND_REFRESH_REQUIRED
+//
+// MessageText:
+//
+// The group policy framework should call the extension in the synchronous
+// foreground policy refresh.
+//
+#define ERROR_SYNC_FOREGROUND_REFRESH_REQUIRED   1274L
+
+//
+// MessageId: ERROR_DRIVER_BLOCKED
+//
+// MessageText:
+//
+// This driver has been blocked from loading.
+//
+#define ERROR_DRIVER_BLOCKED                     1275L
+
+//
+// MessageId: ERROR_INVALID_IMPORT_OF_NON_DLL
+//
+// MessageText:
+//
+// A dynamic link library (DLL) referenced a module that was neither a DLL nor
+// the process's executable image.
+//
+#define ERROR_INVALID_IMPORT_OF_NON_DLL          1276L
+
+//
+// MessageId: ERROR_ACCESS_DISABLED_WEBBLADE
+//
+// MessageText:
+//
+// Windows cannot open this program since it has been disabled.
+//
+#define ERROR_ACCESS_DISABLED_WEBBLADE           1277L
+
+//
+// MessageId: ERROR_ACCESS_DISABLED_WEBBLADE_TAMPER
+//
+// MessageText:
+//
+// Windows cannot open this program because the license enforcement system has
+// been tampered with or become corrupted.
+//
+#define ERROR_ACCESS_DISABLED_WEBBLADE_TAMPER    1278L
+
+//
+// MessageId: ERROR_RECOVERY_FAILURE
+//
+// MessageText:
+//
+// A transaction recover failed.
+//
+#define ERROR_RECOVERY_FAILURE                   1279L
+
+//
+// MessageId: ERROR_ALREADY_FIBER
+//
+// MessageText:
+//
+// The current thread has already been converted to a fiber.
+//
+#define ERROR_ALREADY_FIBER                      1280L
+
+//
+// MessageId: ERROR_ALREADY_THREAD
+//
+// MessageText:
+//
+// The current thread has already been converted from a fiber.
+//
+#define ERROR_ALREADY_THREAD                     1281L
+
+//
+// MessageId: ERROR_STACK_BUFFER_OVERRUN
+//
+// MessageText:
+//
+// The system detected an overrun of a stack-based buffer in this application.
+// This overrun could potentially allow a malicious user to gain control of
+// this application.
+//
+#define ERROR_STACK_BUFFER_OVERRUN               1282L
+
+//
+// MessageId: ERROR_PARAMETER_QUOTA_EXCEEDED
+//
+// MessageText:
+//
+// Data present in one of the parameters is more than the function can operate
+// on.
+//
+#define ERROR_PARAMETER_QUOTA_EXCEEDED           1283L
+
+//
+// MessageId: ERROR_DEBUGGER_INACTIVE
+//
+// MessageText:
+//
+// An attempt to do an operation on a debug object failed because the object is
+// in the process of being deleted.
+//
+#define ERROR_DEBUGGER_INACTIVE                  1284L
+
+//
+// MessageId: ERROR_DELAY_LOAD_FAILED
+//
+// MessageText:
+//
+// An attempt to delay-load a .dll or get a function address in a delay-loaded
+// .dll failed.
+//
+#define ERROR_DELAY_LOAD_FAILED                  1285L
+
+//
+// MessageId: ERROR_VDM_DISALLOWED
+//
+// MessageText:
+//
+// %1 is a 16-bit application. You do not have permissions to execute 16-bit
+// applications. Check your permissions with your system administrator.
+//
+#define ERROR_VDM_DISALLOWED                     1286L
+
+//
+// MessageId: ERROR_UNIDENTIFIED_ERROR
+//
+// MessageText:
+//
+// Insufficient information exists to identify the cause of failure.
+//
+#define ERROR_UNIDENTIFIED_ERROR                 1287L
+
+//
+// MessageId: ERROR_INVALID_CRUNTIME_PARAMETER
+//
+// MessageText:
+//
+// The parameter passed to a C runtime function is incorrect.
+//
+#define ERROR_INVALID_CRUNTIME_PARAMETER         1288L
+
+//
+// MessageId: ERROR_BEYOND_VDL
+//
+// MessageText:
+//
+// The operation occurred beyond the valid data length of the file.
+//
+#define ERROR_BEYOND_VDL                         1289L
+
+//
+// MessageId: ERROR_INCOMPATIBLE_SERVICE_SID_TYPE
+//
+// MessageText:
+//
+// The service start failed since one or more services in the same process have
+// an incompatible service SID type setting. A service with restricted service
+// SID type can only coexist in the same process with other services with a
+// restricted SID type. If the service SID type for this service was just
+// configured, the hosting process must be restarted in order to start this
+// service.
+//
+#define ERROR_INCOMPATIBLE_SERVICE_SID_TYPE      1290L
+
+//
+// MessageId: ERROR_DRIVER_PROCESS_TERMINATED
+//
+// MessageText:
+//
+// The process hosting the driver for this device has been terminated.
+//
+#define ERROR_DRIVER_PROCESS_TERMINATED          1291L
+
+//
+// MessageId: ERROR_IMPLEMENTATION_LIMIT
+//
+// MessageText:
+//
+// An operation attempted to exceed an implementation-defined limit.
+//
+#define ERROR_IMPLEMENTATION_LIMIT               1292L
+
+//
+// MessageId: ERROR_PROCESS_IS_PROTECTED
+//
+// MessageText:
+//
+// Either the target process, or the target thread's containing process, is a
+// protected process.
+//
+#define ERROR_PROCESS_IS_PROTECTED               1293L
+
+//
+// MessageId: ERROR_SERVICE_NOTIFY_CLIENT_LAGGING
+//
+// MessageText:
+//
+// The service notification client is lagging too far behind the current state
+// of services in the machine.
+//
+#define ERROR_SERVICE_NOTIFY_CLIENT_LAGGING      1294L
+
+//
+// MessageId: ERROR_DISK_QUOTA_EXCEEDED
+//
+// MessageText:
+//
+// The requested file operation failed because the storage quota was exceeded.
+// To free up disk space, move files to a different location or delete
+// unnecessary files. For more information, contact your system administrator.
+//
+#define ERROR_DISK_QUOTA_EXCEEDED                1295L
+
+//
+// MessageId: ERROR_CONTENT_BLOCKED
+//
+// MessageText:
+//
+// The requested file operation failed because the storage policy blocks that
+// type of file. For more information, contact your system administrator.
+//
+#define ERROR_CONTENT_BLOCKED                    1296L
+
+//
+// MessageId: ERROR_INCOMPATIBLE_SERVICE_PRIVILEGE
+//
+// MessageText:
+//
+// A privilege that the service requires to function properly does not exist in
+// the service account configuration. You may use the Services Microsoft
+// Management Console (MMC) snap-in (services.msc) and the Local Security
+// Settings MMC snap-in (secpol.msc) to view the service configuration and the
+// account configuration.
+//
+#define ERROR_INCOMPATIBLE_SERVICE_PRIVILEGE     1297L
+
+//
+// MessageId: ERROR_APP_HANG
+//
+// MessageText:
+//
+// A thread involved in this operation appears to be unresponsive.
+//
+#define ERROR_APP_HANG                           1298L
+
+//
+// MessageId: ERROR_INVALID_LABEL
+//
+// MessageText:
+//
+// Indicates a particular Security ID may not be assigned as the label of an
+// object.
+//
+#define ERROR_INVALID_LABEL                      1299L
+
+//
+// MessageId: ERROR_NOT_ALL_ASSIGNED
+//
+// MessageText:
+//
+// Not all privileges or groups referenced are assigned to the caller.
+//
+#define ERROR_NOT_ALL_ASSIGNED                   1300L
+
+//
+// MessageId: ERROR_SOME_NOT_MAPPED
+//
+// MessageText:
+//
+// Some mapping between account names and security IDs was not done.
+//
+#define ERROR_SOME_NOT_MAPPED                    1301L
+
+//
+// MessageId: ERROR_NO_QUOTAS_FOR_ACCOUNT
+//
+// MessageText:
+//
+// No system quota limits are specifically set for this account.
+//
+#define ERROR_NO_QUOTAS_FOR_ACCOUNT              1302L
+
+//
+// MessageId: ERROR_LOCAL_USER_SESSION_KEY
+//
+// MessageText:
+//
+// No encryption key is available. A well-known encryption key was returned.
+//
+#define ERROR_LOCAL_USER_SESSION_KEY             1303L
+
+//
+// MessageId: ERROR_NULL_LM_PASSWORD
+//
+// MessageText:
+//
+// The password is too complex to be converted to a LAN Manager password. The
+// LAN Manager password returned is a NULL string.
+//
+#define ERROR_NULL_LM_PASSWORD                   1304L
+
+//
+// MessageId: ERROR_UNKNOWN_REVISION
+//
+// MessageText:
+//
+// The revision level is unknown.
+//
+#define ERROR_UNKNOWN_REVISION                   1305L
+
+//
+// MessageId: ERROR_REVISION_MISMATCH
+//
+// MessageText:
+//
+// Indicates two revision levels are incompatible.
+//
+#define ERROR_REVISION_MISMATCH                  1306L
+
+//
+// MessageId: ERROR_INVALID_OWNER
+//
+// MessageText:
+//
+// This security ID may not be assigned as the owner of this object.
+//
+#define ERROR_INVALID_OWNER                      1307L
+
+//
+// MessageId: ERROR_INVALID_PRIMARY_GROUP
+//
+// MessageText:
+//
+// This security ID may not be assigned as the primary group of an object.
+//
+#define ERROR_INVALID_PRIMARY_GROUP              1308L
+
+//
+// MessageId: ERROR_NO_IMPERSONATION_TOKEN
+//
+// MessageText:
+//
+// An attempt has been made to operate on an impersonation token by a thread
+// that is not currently impersonating a client.
+//
+#define ERROR_NO_IMPERSONATION_TOKEN             1309L
+
+//
+// MessageId: ERROR_CANT_DISABLE_MANDATORY
+//
+// MessageText:
+//
+// The group may not be disabled.
+//
+#define ERROR_CANT_DISABLE_MANDATORY             1310L
+
+//
+// MessageId: ERROR_NO_LOGON_SERVERS
+//
+// MessageText:
+//
+// We can't sign you in with this credential because your domain isn't
+// available. Make sure your device is connected to your organization's network
+// and try again. If you previously signed in on this device with another
+// credential, you can sign in with that credential.
+//
+#define ERROR_NO_LOGON_SERVERS                   1311L
+
+//
+// MessageId: ERROR_NO_SUCH_LOGON_SESSION
+//
+// MessageText:
+//
+// A specified logon session does not exist. It may already have been
+// terminated.
+//
+#define ERROR_NO_SUCH_LOGON_SESSION              1312L
+
+//
+// MessageId: ERROR_NO_SUCH_PRIVILEGE
+//
+// MessageText:
+//
+// A specified privilege does not exist.
+//
+#define ERROR_NO_SUCH_PRIVILEGE                  1313L
+
+//
+// MessageId: ERROR_PRIVILEGE_NOT_HELD
+//
+// MessageText:
+//
+// A required privilege is not held by the client.
+//
+#define ERROR_PRIVILEGE_NOT_HELD                 1314L
+
+//
+// MessageId: ERROR_INVALID_ACCOUNT_NAME
+//
+// MessageText:
+//
+// The name provided is not a properly formed account name.
+//
+#define ERROR_INVALID_ACCOUNT_NAME               1315L
+
+//
+// MessageId: ERROR_USER_EXISTS
+//
+// MessageText:
+//
+// The specified account already exists.
+//
+#define ERROR_USER_EXISTS                        1316L
+
+//
+// MessageId: ERROR_NO_SUCH_USER
+//
+// MessageText:
+//
+// The specified account does not exist.
+//
+#define ERROR_NO_SUCH_USER                       1317L
+
+//
+// MessageId: ERROR_GROUP_EXISTS
+//
+// MessageText:
+//
+// The specified group already exists.
+//
+#define ERROR_GROUP_EXISTS                       1318L
+
+//
+// MessageId: ERROR_NO_SUCH_GROUP
+//
+// MessageText:
+//
+// The specified group does not exist.
+//
+#define ERROR_NO_SUCH_GROUP                      1319L
+
+//
+// MessageId: ERROR_MEMBER_IN_GROUP
+//
+// MessageText:
+//
+// Either the specified user account is already a member of the specified
+// group, or the specified group cannot be deleted because it contains a
+// member.
+//
+#define ERROR_MEMBER_IN_GROUP                    1320L
+
+//
+// MessageId: ERROR_MEMBER_NOT_IN_GROUP
+//
+// MessageText:
+//
+// The specified user account is not a member of the specified group account.
+//
+#define ERROR_MEMBER_NOT_IN_GROUP                1321L
+
+//
+// MessageId: ERROR_LAST_ADMIN
+//
+// MessageText:
+//
+// This operation is disallowed as it could result in an administration account
+// being disabled, deleted or unable to logon.
+//
+#define ERROR_LAST_ADMIN                         1322L
+
+//
+// MessageId: ERROR_WRONG_PASSWORD
+//
+// MessageText:
+//
+// Unable to update the password. The value provided as the current password is
+// incorrect.
+//
+#define ERROR_WRONG_PASSWORD                     1323L
+
+//
+// MessageId: ERROR_ILL_FORMED_PASSWORD
+//
+// MessageText:
+//
+// Unable to update the password. The value provided for the new password
+// contains values that are not allowed in passwords.
+//
+#define ERROR_ILL_FORMED_PASSWORD                1324L
+
+//
+// MessageId: ERROR_PASSWORD_RESTRICTION
+//
+// MessageText:
+//
+// Unable to update the password. The value provided for the new password does
+// not meet the length, complexity, or history requirements of the domain.
+//
+#define ERROR_PASSWORD_RESTRICTION               1325L
+
+//
+// MessageId: ERROR_LOGON_FAILURE
+//
+// MessageText:
+//
+// The user name or password is incorrect.
+//
+#define ERROR_LOGON_FAILURE                      1326L
+
+//
+// MessageId: ERROR_ACCOUNT_RESTRICTION
+//
+// MessageText:
+//
+// Account restrictions are preventing this user from signing in. For example:
+// blank passwords aren't allowed, sign-in times are limited, or a policy
+// restriction has been enforced.
+//
+#define ERROR_ACCOUNT_RESTRICTION                1327L
+
+//
+// MessageId: ERROR_INVALID_LOGON_HOURS
+//
+// MessageText:
+//
+// Your account has time restrictions that keep you from signing in right now.
+//
+#define ERROR_INVALID_LOGON_HOURS                1328L
+
+//
+// MessageId: ERROR_INVALID_WORKSTATION
+//
+// MessageText:
+//
+// This user isn't allowed to sign in to this computer.
+//
+#define ERROR_INVALID_WORKSTATION                1329L
+
+//
+// MessageId: ERROR_PASSWORD_EXPIRED
+//
+// MessageText:
+//
+// The password for this account has expired.
+//
+#define ERROR_PASSWORD_EXPIRED                   1330L
+
+//
+// MessageId: ERROR_ACCOUNT_DISABLED
+//
+// MessageText:
+//
+// This user can't sign in because this account is currently disabled.
+//
+#define ERROR_ACCOUNT_DISABLED                   1331L
+
+//
+// MessageId: ERROR_NONE_MAPPED
+//
+// MessageText:
+//
+// No mapping between account names and security IDs was done.
+//
+#define ERROR_NONE_MAPPED                        1332L
+
+//
+// MessageId: ERROR_TOO_MANY_LUIDS_REQUESTED
+//
+// MessageText:
+//
+// Too many local user identifiers (LUIDs) were requested at one time.
+//
+#define ERROR_TOO_MANY_LUIDS_REQUESTED           1333L
+
+//
+// MessageId: ERROR_LUIDS_EXHAUSTED
+//
+// MessageText:
+//
+// No more local user identifiers (LUIDs) are available.
+//
+#define ERROR_LUIDS_EXHAUSTED                    1334L
+
+//
+// MessageId: ERROR_INVALID_SUB_AUTHORITY
+//
+// MessageText:
+//
+// The subauthority part of a security ID is invalid for this particular use.
+//
+#define ERROR_INVALID_SUB_AUTHORITY              1335L
+
+//
+// MessageId: ERROR_INVALID_ACL
+//
+// MessageText:
+//
+// The access control list (ACL) structure is invalid.
+//
+#define ERROR_INVALID_ACL                        1336L
+
+//
+// MessageId: ERROR_INVALID_SID
+//
+// MessageText:
+//
+// The security ID structure is invalid.
+//
+#define ERROR_INVALID_SID                        1337L
+
+//
+// MessageId: ERROR_INVALID_SECURITY_DESCR
+//
+// MessageText:
+//
+// The security descriptor structure is invalid.
+//
+#define ERROR_INVALID_SECURITY_DESCR             1338L
+
+//
+// MessageId: ERROR_BAD_INHERITANCE_ACL
+//
+// MessageText:
+//
+// The inherited access control list (ACL) or access control entry (ACE) could
+// not be built.
+//
+#define ERROR_BAD_INHERITANCE_ACL                1340L
+
+//
+// MessageId: ERROR_SERVER_DISABLED
+//
+// MessageText:
+//
+// The server is currently disabled.
+//
+#define ERROR_SERVER_DISABLED                    1341L
+
+//
+// MessageId: ERROR_SERVER_NOT_DISABLED
+//
+// MessageText:
+//
+// The server is currently enabled.
+//
+#define ERROR_SERVER_NOT_DISABLED                1342L
+
+//
+// MessageId: ERROR_INVALID_ID_AUTHORITY
+//
+// MessageText:
+//
+// The value provided was an invalid value for an identifier authority.
+//
+#define ERROR_INVALID_ID_AUTHORITY               1343L
+
+//
+// MessageId: ERROR_ALLOTTED_SPACE_EXCEEDED
+//
+// MessageText:
+//
+// No more memory is available for security information updates.
+//
+#define ERROR_ALLOTTED_SPACE_EXCEEDED            1344L
+
+//
+// MessageId: ERROR_INVALID_GROUP_ATTRIBUTES
+//
+// MessageText:
+//
+// The specified attributes are invalid, or incompatible with the attributes
+// for the group as a whole.
+//
+#define ERROR_INVALID_GROUP_ATTRIBUTES           1345L
+
+//
+// MessageId: ERROR_BAD_IMPERSONATION_LEVEL
+//
+// MessageText:
+//
+// Either a required impersonation level was not provided, or the provided
+// impersonation level is invalid.
+//
+#define ERROR_BAD_IMPERSONATION_LEVEL            1346L
+
+//
+// MessageId: ERROR_CANT_OPEN_ANONYMOUS
+//
+// MessageText:
+//
+// Cannot open an anonymous level security token.
+//
+#define ERROR_CANT_OPEN_ANONYMOUS                1347L
+
+//
+// MessageId: ERROR_BAD_VALIDATION_CLASS
+//
+// MessageText:
+//
+// The validation information class requested was invalid.
+//
+#define ERROR_BAD_VALIDATION_CLASS               1348L
+
+//
+// MessageId: ERROR_BAD_TOKEN_TYPE
+//
+// MessageText:
+//
+// The type of the token is inappropriate for its attempted use.
+//
+#define ERROR_BAD_TOKEN_TYPE                     1349L
+
+//
+// MessageId: ERROR_NO_SECURITY_ON_OBJECT
+//
+// MessageText:
+//
+// Unable to perform a security operation on an object that has no associated
+// security.
+//
+#define ERROR_NO_SECURITY_ON_OBJECT              1350L
+
+//
+// MessageId: ERROR_CANT_ACCESS_DOMAIN_INFO
+//
+// MessageText:
+//
+// Configuration information could not be read from the domain controller,
+// either because the machine is unavailable, or access has been denied.
+//
+#define ERROR_CANT_ACCESS_DOMAIN_INFO            1351L
+
+//
+// MessageId: ERROR_INVALID_SERVER_STATE
+//
+// MessageText:
+//
+// The security account manager (SAM) or local security authority (LSA) server
+// was in the wrong state to perform the security operation.
+//
+#define ERROR_INVALID_SERVER_STATE               1352L
+
+//
+// MessageId: ERROR_INVALID_DOMAIN_STATE
+//
+// MessageText:
+//
+// The domain was in the wrong state to perform the security operation.
+//
+#define ERROR_INVALID_DOMAIN_STATE               1353L
+
+//
+// MessageId: ERROR_INVALID_DOMAIN_ROLE
+//
+// MessageText:
+//
+// This operation is only allowed for the Primary Domain Controller of the
+// domain.
+//
+#define ERROR_INVALID_DOMAIN_ROLE                1354L
+
+//
+// MessageId: ERROR_NO_SUCH_DOMAIN
+//
+// MessageText:
+//
+// The specified domain either does not exist or could not be contacted.
+//
+#define ERROR_NO_SUCH_DOMAIN                     1355L
+
+//
+// MessageId: ERROR_DOMAIN_EXISTS
+//
+// MessageText:
+//
+// The specified domain already exists.
+//
+#define ERROR_DOMAIN_EXISTS                      1356L
+
+//
+// MessageId: ERROR_DOMAIN_LIMIT_EXCEEDED
+//
+// MessageText:
+//
+// An attempt was made to exceed the limit on the number of domains per server.
+//
+#define ERROR_DOMAIN_LIMIT_EXCEEDED              1357L
+
+//
+// MessageId: ERROR_INTERNAL_DB_CORRUPTION
+//
+// MessageText:
+//
+// Unable to complete the requested operation because of either a catastrophic
+// media failure or a data structure corruption on the disk.
+//
+#define ERROR_INTERNAL_DB_CORRUPTION             1358L
+
+//
+// MessageId: ERROR_INTERNAL_ERROR
+//
+// MessageText:
+//
+// An internal error occurred.
+//
+#define ERROR_INTERNAL_ERROR                     1359L
+
+//
+// MessageId: ERROR_GENERIC_NOT_MAPPED
+//
+// MessageText:
+//
+// Generic access types were contained in an access mask which should already
+// be mapped to nongeneric types.
+//
+#define ERROR_GENERIC_NOT_MAPPED                 1360L
+
+//
+// MessageId: ERROR_BAD_DESCRIPTOR_FORMAT
+//
+// MessageText:
+//
+// A security descriptor is not in the right format (absolute or
+// self-relative).
+//
+#define ERROR_BAD_DESCRIPTOR_FORMAT              1361L
+
+//
+// MessageId: ERROR_NOT_LOGON_PROCESS
+//
+// MessageText:
+//
+// The requested action is restricted for use by logon processes only. The
+// calling process has not registered as a logon process.
+//
+#define ERROR_NOT_LOGON_PROCESS                  1362L
+
+//
+// MessageId: ERROR_LOGON_SESSION_EXISTS
+//
+// MessageText:
+//
+// Cannot start a new logon session with an ID that is already in use.
+//
+#define ERROR_LOGON_SESSION_EXISTS               1363L
+
+//
+// MessageId: ERROR_NO_SUCH_PACKAGE
+//
+// MessageText:
+//
+// A specified authentication package is unknown.
+//
+#define ERROR_NO_SUCH_PACKAGE                    1364L
+
+//
+// MessageId: ERROR_BAD_LOGON_SESSION_STATE
+//
+// MessageText:
+//
+// The logon session is not in a state that is consistent with the requested
+// operation.
+//
+#define ERROR_BAD_LOGON_SESSION_STATE            1365L
+
+//
+// MessageId: ERROR_LOGON_SESSION_COLLISION
+//
+// MessageText:
+//
+// The logon session ID is already in use.
+//
+#define ERROR_LOGON_SESSION_COLLISION            1366L
+
+//
+// MessageId: ERROR_INVALID_LOGON_TYPE
+//
+// MessageText:
+//
+// A logon request contained an invalid logon type value.
+//
+#define ERROR_INVALID_LOGON_TYPE                 1367L
+
+//
+// MessageId: ERROR_CANNOT_IMPERSONATE
+//
+// MessageText:
+//
+// Unable to impersonate using a named pipe until data has been read from that
+// pipe.
+//
+#define ERROR_CANNOT_IMPERSONATE                 1368L
+
+//
+// MessageId: ERROR_RXACT_INVALID_STATE
+//
+// MessageText:
+//
+// The transaction state of a registry subtree is incompatible with the
+// requested operation.
+//
+#define ERROR_RXACT_INVALID_STATE                1369L
+
+//
+// MessageId: ERROR_RXACT_COMMIT_FAILURE
+//
+// MessageText:
+//
+// An internal security database corruption has been encountered.
+//
+#define ERROR_RXACT_COMMIT_FAILURE               1370L
+
+//
+// MessageId: ERROR_SPECIAL_ACCOUNT
+//
+// MessageText:
+//
+// Cannot perform this operation on built-in accounts.
+//
+#define ERROR_SPECIAL_ACCOUNT                    1371L
+
+//
+// MessageId: ERROR_SPECIAL_GROUP
+//
+// MessageText:
+//
+// Cannot perform this operation on this built-in special group.
+//
+#define ERROR_SPECIAL_GROUP                      1372L
+
+//
+// MessageId: ERROR_SPECIAL_USER
+//
+// MessageText:
+//
+// Cannot perform this operation on this built-in special user.
+//
+#define ERROR_SPECIAL_USER                       1373L
+
+//
+// MessageId: ERROR_MEMBERS_PRIMARY_GROUP
+//
+// MessageText:
+//
+// The user cannot be removed from a group because the group is currently the
+// user's primary group.
+//
+#define ERROR_MEMBERS_PRIMARY_GROUP              1374L
+
+//
+// MessageId: ERROR_TOKEN_ALREADY_IN_USE
+//
+// MessageText:
+//
+// The token is already in use as a primary token.
+//
+#define ERROR_TOKEN_ALREADY_IN_USE               1375L
+
+//
+// MessageId: ERROR_NO_SUCH_ALIAS
+//
+// MessageText:
+//
+// The specified local group does not exist.
+//
+#define ERROR_NO_SUCH_ALIAS                      1376L
+
+//
+// MessageId: ERROR_MEMBER_NOT_IN_ALIAS
+//
+// MessageText:
+//
+// The specified account name is not a member of the group.
+//
+#define ERROR_MEMBER_NOT_IN_ALIAS                1377L
+
+//
+// MessageId: ERROR_MEMBER_IN_ALIAS
+//
+// MessageText:
+//
+// The specified account name is already a member of the group.
+//
+#define ERROR_MEMBER_IN_ALIAS                    1378L
+
+//
+// MessageId: ERROR_ALIAS_EXISTS
+//
+// MessageText:
+//
+// The specified local group already exists.
+//
+#define ERROR_ALIAS_EXISTS                       1379L
+
+//
+// MessageId: ERROR_LOGON_NOT_GRANTED
+//
+// MessageText:
+//
+// Logon failure: the user has not been granted the requested logon type at
+// this computer.
+//
+#define ERROR_LOGON_NOT_GRANTED                  1380L
+
+//
+// MessageId: ERROR_TOO_MANY_SECRETS
+//
+// MessageText:
+//
+// The maximum number of secrets that may be stored in a single system has been
+// exceeded.
+//
+#define ERROR_TOO_MANY_SECRETS                   1381L
+
+//
+// MessageId: ERROR_SECRET_TOO_LONG
+//
+// MessageText:
+//
+// The length of a secret exceeds the maximum length allowed.
+//
+#define ERROR_SECRET_TOO_LONG                    1382L
+
+//
+// MessageId: ERROR_INTERNAL_DB_ERROR
+//
+// MessageText:
+//
+// The local security authority database contains an internal inconsistency.
+//
+#define ERROR_INTERNAL_DB_ERROR                  1383L
+
+//
+// MessageId: ERROR_TOO_MANY_CONTEXT_IDS
+//
+// MessageText:
+//
+// During a logon attempt, the user's security context accumulated too many
+// security IDs.
+//
+#define ERROR_TOO_MANY_CONTEXT_IDS               1384L
+
+//
+// MessageId: ERROR_LOGON_TYPE_NOT_GRANTED
+//
+// MessageText:
+//
+// Logon failure: the user has not been granted the requested logon type at
+// this computer.
+//
+#define ERROR_LOGON_TYPE_NOT_GRANTED             1385L
+
+//
+// MessageId: ERROR_NT_CROSS_ENCRYPTION_REQUIRED
+//
+// MessageText:
+//
+// A cross-encrypted password is necessary to change a user password.
+//
+#define ERROR_NT_CROSS_ENCRYPTION_REQUIRED       1386L
+
+//
+// MessageId: ERROR_NO_SUCH_MEMBER
+//
+// MessageText:
+//
+// A member could not be added to or removed from the local group because the
+// member does not exist.
+//
+#define ERROR_NO_SUCH_MEMBER                     1387L
+
+//
+// MessageId: ERROR_INVALID_MEMBER
+//
+// MessageText:
+//
+// A new member could not be added to a local group because the member has the
+// wrong account type.
+//
+#define ERROR_INVALID_MEMBER                     1388L
+
+//
+// MessageId: ERROR_TOO_MANY_SIDS
+//
+// MessageText:
+//
+// Too many security IDs have been specified.
+//
+#define ERROR_TOO_MANY_SIDS                      1389L
+
+//
+// MessageId: ERROR_LM_CROSS_ENCRYPTION_REQUIRED
+//
+// MessageText:
+//
+// A cross-encrypted password is necessary to change this user password.
+//
+#define ERROR_LM_CROSS_ENCRYPTION_REQUIRED       1390L
+
+//
+// MessageId: ERROR_NO_INHERITANCE
+//
+// MessageText:
+//
+// Indicates an ACL contains no inheritable components.
+//
+#define ERROR_NO_INHERITANCE                     1391L
+
+//
+// MessageId: ERROR_FILE_CORRUPT
+//
+// MessageText:
+//
+// The file or directory is corrupted and unreadable.
+//
+#define ERROR_FILE_CORRUPT                       1392L
+
+//
+// MessageId: ERROR_DISK_CORRUPT
+//
+// MessageText:
+//
+// The disk structure is corrupted and unreadable.
+//
+#define ERROR_DISK_CORRUPT                       1393L
+
+//
+// MessageId: ERROR_NO_USER_SESSION_KEY
+//
+// MessageText:
+//
+// There is no user session key for the specified logon session.
+//
+#define ERROR_NO_USER_SESSION_KEY                1394L
+
+//
+// MessageId: ERROR_LICENSE_QUOTA_EXCEEDED
+//
+// MessageText:
+//
+// The service being accessed is licensed for a particular number of
+// connections. No more connections can be made to the service at this time
+// because there are already as many connections as the service can accept.
+//
+#define ERROR_LICENSE_QUOTA_EXCEEDED             1395L
+
+//
+// MessageId: ERROR_WRONG_TARGET_NAME
+//
+// MessageText:
+//
+// The target account name is incorrect.
+//
+#define ERROR_WRONG_TARGET_NAME                  1396L
+
+//
+// MessageId: ERROR_MUTUAL_AUTH_FAILED
+//
+// MessageText:
+//
+// Mutual Authentication failed. The server's password is out of date at the
+// domain controller.
+//
+#define ERROR_MUTUAL_AUTH_FAILED                 1397L
+
+//
+// MessageId: ERROR_TIME_SKEW
+//
+// MessageText:
+//
+// There is a time and/or date difference between the client and server.
+//
+#define ERROR_TIME_SKEW                          1398L
+
+//
+// MessageId: ERROR_CURRENT_DOMAIN_NOT_ALLOWED
+//
+// MessageText:
+//
+// This operation cannot be performed on the current domain.
+//
+#define ERROR_CURRENT_DOMAIN_NOT_ALLOWED         1399L
+
+//
+// MessageId: ERROR_INVALID_WINDOW_HANDLE
+//
+// MessageText:
+//
+// Invalid window handle.
+//
+#define ERROR_INVALID_WINDOW_HANDLE              1400L
+
+//
+// MessageId: ERROR_INVALID_MENU_HANDLE
+//
+// MessageText:
+//
+// Invalid menu handle.
+//
+#define ERROR_INVALID_MENU_HANDLE                1401L
+
+//
+// MessageId: ERROR_INVALID_CURSOR_HANDLE
+//
+// MessageText:
+//
+// Invalid cursor handle.
+//
+#define ERROR_INVALID_CURSOR_HANDLE              1402L
+
+//
+// MessageId: ERROR_INVALID_ACCEL_HANDLE
+//
+// MessageText:
+//
+// Invalid accelerator table handle.
+//
+#define ERROR_INVALID_ACCEL_HANDLE               1403L
+
+//
+// MessageId: ERROR_INVALID_HOOK_HANDLE
+//
+// MessageText:
+//
+// Invalid hook handle.
+//
+#define ERROR_INVALID_HOOK_HANDLE                1404L
+
+//
+// MessageId: ERROR_INVALID_DWP_HANDLE
+//
+// MessageText:
+//
+// Invalid handle to a multiple-window position structure.
+//
+#define ERROR_INVALID_DWP_HANDLE                 1405L
+
+//
+// MessageId: ERROR_TLW_WITH_WSCHILD
+//
+// MessageText:
+//
+// Cannot create a top-level child window.
+//
+#define ERROR_TLW_WITH_WSCHILD                   1406L
+
+//
+// MessageId: ERROR_CANNOT_FIND_WND_CLASS
+//
+// MessageText:
+//
+// Cannot find window class.
+//
+#define ERROR_CANNOT_FIND_WND_CLASS              1407L
+
+//
+// MessageId: ERROR_WINDOW_OF_OTHER_THREAD
+//
+// MessageText:
+//
+// Invalid window; it belongs to other thread.
+//
+#define ERROR_WINDOW_OF_OTHER_THREAD             1408L
+
+//
+// MessageId: ERROR_HOTKEY_ALREADY_REGISTERED
+//
+// MessageText:
+//
+// Hot key is already registered.
+//
+#define ERROR_HOTKEY_ALREADY_REGISTERED          1409L
+
+//
+// MessageId: ERROR_CLASS_ALREADY_EXISTS
+//
+// MessageText:
+//
+// Class already exists.
+//
+#define ERROR_CLASS_ALREADY_EXISTS               1410L
+
+//
+// MessageId: ERROR_CLASS_DOES_NOT_EXIST
+//
+// MessageText:
+//
+// Class does not exist.
+//
+#define ERROR_CLASS_DOES_NOT_EXIST               1411L
+
+//
+// MessageId: ERROR_CLASS_HAS_WINDOWS
+//
+// MessageText:
+//
+// Class still has open windows.
+//
+#define ERROR_CLASS_HAS_WINDOWS                  1412L
+
+//
+// MessageId: ERROR_INVALID_INDEX
+//
+// MessageText:
+//
+// Invalid index.
+//
+#define ERROR_INVALID_INDEX                      1413L
+
+//
+// MessageId: ERROR_INVALID_ICON_HANDLE
+//
+// MessageText:
+//
+// Invalid icon handle.
+//
+#define ERROR_INVALID_ICON_HANDLE                1414L
+
+//
+// MessageId: ERROR_PRIVATE_DIALOG_INDEX
+//
+// MessageText:
+//
+// Using private DIALOG window words.
+//
+#define ERROR_PRIVATE_DIALOG_INDEX               1415L
+
+//
+// MessageId: ERROR_LISTBOX_ID_NOT_FOUND
+//
+// MessageText:
+//
+// The list box identifier was not found.
+//
+#define ERROR_LISTBOX_ID_NOT_FOUND               1416L
+
+//
+// MessageId: ERROR_NO_WILDCARD_CHARACTERS
+//
+// MessageText:
+//
+// No wildcards were found.
+//
+#define ERROR_NO_WILDCARD_CHARACTERS             1417L
+
+//
+// MessageId: ERROR_CLIPBOARD_NOT_OPEN
+//
+// MessageText:
+//
+// Thread does not have a clipboard open.
+//
+#define ERROR_CLIPBOARD_NOT_OPEN                 1418L
+
+//
+// MessageId: ERROR_HOTKEY_NOT_REGISTERED
+//
+// MessageText:
+//
+// Hot key is not registered.
+//
+#define ERROR_HOTKEY_NOT_REGISTERED              1419L
+
+//
+// MessageId: ERROR_WINDOW_NOT_DIALOG
+//
+// MessageText:
+//
+// The window is not a valid dialog window.
+//
+#define ERROR_WINDOW_NOT_DIALOG                  1420L
+
+//
+// MessageId: ERROR_CONTROL_ID_NOT_FOUND
+//
+// MessageText:
+//
+// Control ID not found.
+//
+#define ERROR_CONTROL_ID_NOT_FOUND               1421L
+
+//
+// MessageId: ERROR_INVALID_COMBOBOX_MESSAGE
+//
+// MessageText:
+//
+// Invalid message for a combo box because it does not have an edit control.
+//
+#define ERROR_INVALID_COMBOBOX_MESSAGE           1422L
+
+//
+// MessageId: ERROR_WINDOW_NOT_COMBOBOX
+//
+// MessageText:
+//
+// The window is not a combo box.
+//
+#define ERROR_WINDOW_NOT_COMBOBOX                1423L
+
+//
+// MessageId: ERROR_INVALID_EDIT_HEIGHT
+//
+// MessageText:
+//
+// Height must be less than 256.
+//
+#define ERROR_INVALID_EDIT_HEIGHT                1424L
+
+//
+// MessageId: ERROR_DC_NOT_FOUND
+//
+// MessageText:
+//
+// Invalid device context (DC) handle.
+//
+#define ERROR_DC_NOT_FOUND                       1425L
+
+//
+// MessageId: ERROR_INVALID_HOOK_FILTER
+//
+// MessageText:
+//
+// Invalid hook procedure type.
+//
+#define ERROR_INVALID_HOOK_FILTER                1426L
+
+//
+// MessageId: ERROR_INVALID_FILTER_PROC
+//
+// MessageText:
+//
+// Invalid hook procedure.
+//
+#define ERROR_INVALID_FILTER_PROC                1427L
+
+//
+// MessageId: ERROR_HOOK_NEEDS_HMOD
+//
+// MessageText:
+//
+// Cannot set nonlocal hook without a module handle.
+//
+#define ERROR_HOOK_NEEDS_HMOD                    1428L
+
+//
+// MessageId: ERROR_GLOBAL_ONLY_HOOK
+//
+// MessageText:
+//
+// This hook procedure can only be set globally.
+//
+#define ERROR_GLOBAL_ONLY_HOOK                   1429L
+
+//
+// MessageId: ERROR_JOURNAL_HOOK_SET
+//
+// MessageText:
+//
+// The journal hook procedure is already installed.
+//
+#define ERROR_JOURNAL_HOOK_SET                   1430L
+
+//
+// MessageId: ERROR_HOOK_NOT_INSTALLED
+//
+// MessageText:
+//
+// The hook procedure is not installed.
+//
+#define ERROR_HOOK_NOT_INSTALLED                 1431L
+
+//
+// MessageId: ERROR_INVALID_LB_MESSAGE
+//
+// MessageText:
+//
+// Invalid message for single-selection list box.
+//
+#define ERROR_INVALID_LB_MESSAGE                 1432L
+
+//
+// MessageId: ERROR_SETCOUNT_ON_BAD_LB
+//
+// MessageText:
+//
+// LB_SETCOUNT sent to non-lazy list box.
+//
+#define ERROR_SETCOUNT_ON_BAD_LB                 1433L
+
+//
+// MessageId: ERROR_LB_WITHOUT_TABSTOPS
+//
+// MessageText:
+//
+// This list box does not support tab stops.
+//
+#define ERROR_LB_WITHOUT_TABSTOPS                1434L
+
+//
+// MessageId: ERROR_DESTROY_OBJECT_OF_OTHER_THREAD
+//
+// MessageText:
+//
+// Cannot destroy object created by another thread.
+//
+#define ERROR_DESTROY_OBJECT_OF_OTHER_THREAD     1435L
+
+//
+// MessageId: ERROR_CHILD_WINDOW_MENU
+//
+// MessageText:
+//
+// Child windows cannot have menus.
+//
+#define ERROR_CHILD_WINDOW_MENU                  1436L
+
+//
+// MessageId: ERROR_NO_SYSTEM_MENU
+//
+// MessageText:
+//
+// The window does not have a system menu.
+//
+#define ERROR_NO_SYSTEM_MENU                     1437L
+
+//
+// MessageId: ERROR_INVALID_MSGBOX_STYLE
+//
+// MessageText:
+//
+// Invalid message box style.
+//
+#define ERROR_INVALID_MSGBOX_STYLE               1438L
+
+//
+// MessageId: ERROR_INVALID_SPI_VALUE
+//
+// MessageText:
+//
+// Invalid system-wide (SPI_*) parameter.
+//
+#define ERROR_INVALID_SPI_VALUE                  1439L
+
+//
+// MessageId: ERROR_SCREEN_ALREADY_LOCKED
+//
+// MessageText:
+//
+// Screen already locked.
+//
+#define ERROR_SCREEN_ALREADY_LOCKED              1440L
+
+//
+// MessageId: ERROR_HWNDS_HAVE_DIFF_PARENT
+//
+// MessageText:
+//
+// All handles to windows in a multiple-window position structure must have the
+// same parent.
+//
+#define ERROR_HWNDS_HAVE_DIFF_PARENT             1441L
+
+//
+// MessageId: ERROR_NOT_CHILD_WINDOW
+//
+// MessageText:
+//
+// The window is not a child window.
+//
+#define ERROR_NOT_CHILD_WINDOW                   1442L
+
+//
+// MessageId: ERROR_INVALID_GW_COMMAND
+//
+// MessageText:
+//
+// Invalid GW_* command.
+//
+#define ERROR_INVALID_GW_COMMAND                 1443L
+
+//
+// MessageId: ERROR_INVALID_THREAD_ID
+//
+// MessageText:
+//
+// Invalid thread identifier.
+//
+#define ERROR_INVALID_THREAD_ID                  1444L
+
+//
+// MessageId: ERROR_NON_MDICHILD_WINDOW
+//
+// MessageText:
+//
+// Cannot process a message from a window that is not a multiple document
+// interface (MDI) window.
+//
+#define ERROR_NON_MDICHILD_WINDOW                1445L
+
+//
+// MessageId: ERROR_POPUP_ALREADY_ACTIVE
+//
+// MessageText:
+//
+// Popup menu already active.
+//
+#define ERROR_POPUP_ALREADY_ACTIVE               1446L
+
+//
+// MessageId: ERROR_NO_SCROLLBARS
+//
+// MessageText:
+//
+// The window does not have scroll bars.
+//
+#define ERROR_NO_SCROLLBARS                      1447L
+
+//
+// MessageId: ERROR_INVALID_SCROLLBAR_RANGE
+//
+// MessageText:
+//
+// Scroll bar range cannot be greater than MAXLONG.
+//
+#define ERROR_INVALID_SCROLLBAR_RANGE            1448L
+
+//
+// MessageId: ERROR_INVALID_SHOWWIN_COMMAND
+//
+// MessageText:
+//
+// Cannot show or remove the window in the way specified.
+//
+#define ERROR_INVALID_SHOWWIN_COMMAND            1449L
+
+//
+// MessageId: ERROR_NO_SYSTEM_RESOURCES
+//
+// MessageText:
+//
+// Insufficient system resources exist to complete the requested service.
+//
+#define ERROR_NO_SYSTEM_RESOURCES                1450L
+
+//
+// MessageId: ERROR_NONPAGED_SYSTEM_RESOURCES
+//
+// MessageText:
+//
+// Insufficient system resources exist to complete the requested service.
+//
+#define ERROR_NONPAGED_SYSTEM_RESOURCES          1451L
+
+//
+// MessageId: ERROR_PAGED_SYSTEM_RESOURCES
+//
+// MessageText:
+//
+// Insufficient system resources exist to complete the requested service.
+//
+#define ERROR_PAGED_SYSTEM_RESOURCES             1452L
+
+//
+// MessageId: ERROR_WORKING_SET_QUOTA
+//
+// MessageText:
+//
+// Insufficient quota to complete the requested service.
+//
+#define ERROR_WORKING_SET_QUOTA                  1453L
+
+//
+// MessageId: ERROR_PAGEFILE_QUOTA
+//
+// MessageText:
+//
+// Insufficient quota to complete the requested service.
+//
+#define ERROR_PAGEFILE_QUOTA                     1454L
+
+//
+// MessageId: ERROR_COMMITMENT_LIMIT
+//
+// MessageText:
+//
+// The paging file is too small for this operation to complete.
+//
+#define ERROR_COMMITMENT_LIMIT                   1455L
+
+//
+// MessageId: ERROR_MENU_ITEM_NOT_FOUND
+//
+// MessageText:
+//
+// A menu item was not found.
+//
+#define ERROR_MENU_ITEM_NOT_FOUND                1456L
+
+//
+// MessageId: ERROR_INVALID_KEYBOARD_HANDLE
+//
+// MessageText:
+//
+// Invalid keyboard layout handle.
+//
+#define ERROR_INVALID_KEYBOARD_HANDLE            1457L
+
+//
+// MessageId: ERROR_HOOK_TYPE_NOT_ALLOWED
+//
+// MessageText:
+//
+// Hook type not allowed.
+//
+#define ERROR_HOOK_TYPE_NOT_ALLOWED              1458L
+
+//
+// MessageId: ERROR_REQUIRES_INTERACTIVE_WINDOWSTATION
+//
+// MessageText:
+//
+// This operation requires an interactive window station.
+//
+#define ERROR_REQUIRES_INTERACTIVE_WINDOWSTATION 1459L
+
+//
+// MessageId: ERROR_TIMEOUT
+//
+// MessageText:
+//
+// This operation returned because the timeout period expired.
+//
+#define ERROR_TIMEOUT                            1460L
+
+//
+// MessageId: ERROR_INVALID_MONITOR_HANDLE
+//
+// MessageText:
+//
+// Invalid monitor handle.
+//
+#define ERROR_INVALID_MONITOR_HANDLE             1461L
+
+//
+// MessageId: ERROR_INCORRECT_SIZE
+//
+// MessageText:
+//
+// Incorrect size argument.
+//
+#define ERROR_INCORRECT_SIZE                     1462L
+
+//
+// MessageId: ERROR_SYMLINK_CLASS_DISABLED
+//
+// MessageText:
+//
+// The symbolic link cannot be followed because its type is disabled.
+//
+#define ERROR_SYMLINK_CLASS_DISABLED             1463L
+
+//
+// MessageId: ERROR_SYMLINK_NOT_SUPPORTED
+//
+// MessageText:
+//
+// This application does not support the current operation on symbolic links.
+//
+#define ERROR_SYMLINK_NOT_SUPPORTED              1464L
+
+//
+// MessageId: ERROR_XML_PARSE_ERROR
+//
+// MessageText:
+//
+// Windows was unable to parse the requested XML data.
+//
+#define ERROR_XML_PARSE_ERROR                    1465L
+
+//
+// MessageId: ERROR_XMLDSIG_ERROR
+//
+// MessageText:
+//
+// An error was encountered while processing an XML digital signature.
+//
+#define ERROR_XMLDSIG_ERROR                      1466L
+
+//
+// MessageId: ERROR_RESTART_APPLICATION
+//
+// MessageText:
+//
+// This application must be restarted.
+//
+#define ERROR_RESTART_APPLICATION                1467L
+
+//
+// MessageId: ERROR_WRONG_COMPARTMENT
+//
+// MessageText:
+//
+// The caller made the connection request in the wrong routing compartment.
+//
+#define ERROR_WRONG_COMPARTMENT                  1468L
+
+//
+// MessageId: ERROR_AUTHIP_FAILURE
+//
+// MessageText:
+//
+// There was an AuthIP failure when attempting to connect to the remote host.
+//
+#define ERROR_AUTHIP_FAILURE                     1469L
+
+//
+// MessageId: ERROR_NO_NVRAM_RESOURCES
+//
+// MessageText:
+//
+// Insufficient NVRAM resources exist to complete the requested service. A
+// reboot might be required.
+//
+#define ERROR_NO_NVRAM_RESOURCES                 1470L
+
+//
+// MessageId: ERROR_NOT_GUI_PROCESS
+//
+// MessageText:
+//
+// Unable to finish the requested operation because the specified process is
+// not a GUI process.
+//
+#define ERROR_NOT_GUI_PROCESS                    1471L
+
+//
+// MessageId: ERROR_EVENTLOG_FILE_CORRUPT
+//
+// MessageText:
+//
+// The event log file is corrupted.
+//
+#define ERROR_EVENTLOG_FILE_CORRUPT              1500L
+
+//
+// MessageId: ERROR_EVENTLOG_CANT_START
+//
+// MessageText:
+//
+// No event log file could be opened, so the event logging service did not
+// start.
+//
+#define ERROR_EVENTLOG_CANT_START                1501L
+
+//
+// MessageId: ERROR_LOG_FILE_FULL
+//
+// MessageText:
+//
+// The event log file is full.
+//
+#define ERROR_LOG_FILE_FULL                      1502L
+
+//
+// MessageId: ERROR_EVENTLOG_FILE_CHANGED
+//
+// MessageText:
+//
+// The event log file has changed between read operations.
+//
+#define ERROR_EVENTLOG_FILE_CHANGED              1503L
+
+//
+// MessageId: ERROR_CONTAINER_ASSIGNED
+//
+// MessageText:
+//
+// The specified Job already has a container assigned to it.
+//
+#define ERROR_CONTAINER_ASSIGNED                 1504L
+
+//
+// MessageId: ERROR_JOB_NO_CONTAINER
+//
+// MessageText:
+//
+// The specified Job does not have a container assigned to it.
+//
+#define ERROR_JOB_NO_CONTAINER                   1505L
+
+//
+// MessageId: ERROR_INVALID_TASK_NAME
+//
+// MessageText:
+//
+// The specified task name is invalid.
+//
+#define ERROR_INVALID_TASK_NAME                  1550L
+
+//
+// MessageId: ERROR_INVALID_TASK_INDEX
+//
+// MessageText:
+//
+// The specified task index is invalid.
+//
+#define ERROR_INVALID_TASK_INDEX                 1551L
+
+//
+// MessageId: ERROR_THREAD_ALREADY_IN_TASK
+//
+// MessageText:
+//
+// The specified thread is already joining a task.
+//
+#define ERROR_THREAD_ALREADY_IN_TASK             1552L
+
+//
+// MessageId: ERROR_INSTALL_SERVICE_FAILURE
+//
+// MessageText:
+//
+// The Windows Installer Service could not be accessed. This can occur if the
+// Windows Installer is not correctly installed. Contact your support personnel
+// for assistance.
+//
+#define ERROR_INSTALL_SERVICE_FAILURE            1601L
+
+//
+// MessageId: ERROR_INSTALL_USEREXIT
+//
+// MessageText:
+//
+// User cancelled installation.
+//
+#define ERROR_INSTALL_USEREXIT                   1602L
+
+//
+// MessageId: ERROR_INSTALL_FAILURE
+//
+// MessageText:
+//
+// Fatal error during installation.
+//
+#define ERROR_INSTALL_FAILURE                    1603L
+
+//
+// MessageId: ERROR_INSTALL_SUSPEND
+//
+// MessageText:
+//
+// Installation suspended, incomplete.
+//
+#define ERROR_INSTALL_SUSPEND                    1604L
+
+//
+// MessageId: ERROR_UNKNOWN_PRODUCT
+//
+// MessageText:
+//
+// This action is only valid for products that are currently installed.
+//
+#define ERROR_UNKNOWN_PRODUCT                    1605L
+
+//
+// MessageId: ERROR_UNKNOWN_FEATURE
+//
+// MessageText:
+//
+// Feature ID not registered.
+//
+#define ERROR_UNKNOWN_FEATURE                    1606L
+
+//
+// MessageId: ERROR_UNKNOWN_COMPONENT
+//
+// MessageText:
+//
+// Component ID not registered.
+//
+#define ERROR_UNKNOWN_COMPONENT                  1607L
+
+//
+// MessageId: ERROR_UNKNOWN_PROPERTY
+//
+// MessageText:
+//
+// Unknown property.
+//
+#define ERROR_UNKNOWN_PROPERTY                   1608L
+
+//
+// MessageId: ERROR_INVALID_HANDLE_STATE
+//
+// MessageText:
+//
+// Handle is in an invalid state.
+//
+#define ERROR_INVALID_HANDLE_STATE               1609L
+
+//
+// MessageId: ERROR_BAD_CONFIGURATION
+//
+// MessageText:
+//
+// The configuration data for this product is corrupt. Contact your support
+// personnel.
+//
+#define ERROR_BAD_CONFIGURATION                  1610L
+
+//
+// MessageId: ERROR_INDEX_ABSENT
+//
+// MessageText:
+//
+// Component qualifier not present.
+//
+#define ERROR_INDEX_ABSENT                       1611L
+
+//
+// MessageId: ERROR_INSTALL_SOURCE_ABSENT
+//
+// MessageText:
+//
+// The installation source for this product is not available. Verify that the
+// source exists and that you can access it.
+//
+#define ERROR_INSTALL_SOURCE_ABSENT              1612L
+
+//
+// MessageId: ERROR_INSTALL_PACKAGE_VERSION
+//
+// MessageText:
+//
+// This installation package cannot be installed by the Windows Installer
+// service. You must install a Windows service pack that contains a newer
+// version of the Windows Installer service.
+//
+#define ERROR_INSTALL_PACKAGE_VERSION            1613L
+
+//
+// MessageId: ERROR_PRODUCT_UNINSTALLED
+//
+// MessageText:
+//
+// Product is uninstalled.
+//
+#define ERROR_PRODUCT_UNINSTALLED                1614L
+
+//
+// MessageId: ERROR_BAD_QUERY_SYNTAX
+//
+// MessageText:
+//
+// SQL query syntax invalid or unsupported.
+//
+#define ERROR_BAD_QUERY_SYNTAX                   1615L
+
+//
+// MessageId: ERROR_INVALID_FIELD
+//
+// MessageText:
+//
+// Record field does not exist.
+//
+#define ERROR_INVALID_FIELD                      1616L
+
+//
+// MessageId: ERROR_DEVICE_REMOVED
+//
+// MessageText:
+//
+// The device has been removed.
+//
+#define ERROR_DEVICE_REMOVED                     1617L
+
+//
+// MessageId: ERROR_INSTALL_ALREADY_RUNNING
+//
+// MessageText:
+//
+// Another installation is already in progress. Complete that installation
+// before proceeding with this install.
+//
+#define ERROR_INSTALL_ALREADY_RUNNING            1618L
+
+//
+// MessageId: ERROR_INSTALL_PACKAGE_OPEN_FAILED
+//
+// MessageText:
+//
+// This installation package could not be opened. Verify that the package
+// exists and that you can access it, or contact the application vendor to
+// verify that this is a valid Windows Installer package.
+//
+#define ERROR_INSTALL_PACKAGE_OPEN_FAILED        1619L
+
+//
+// MessageId: ERROR_INSTALL_PACKAGE_INVALID
+//
+// MessageText:
+//
+// This installation package could not be opened. Contact the application
+// vendor to verify that this is a valid Windows Installer package.
+//
+#define ERROR_INSTALL_PACKAGE_INVALID            1620L
+
+//
+// MessageId: ERROR_INSTALL_UI_FAILURE
+//
+// MessageText:
+//
+// There was an error starting the Windows Installer service user interface.
+// Contact your support personnel.
+//
+#define ERROR_INSTALL_UI_FAILURE                 1621L
+
+//
+// MessageId: ERROR_INSTALL_LOG_FAILURE
+//
+// MessageText:
+//
+// Error opening installation log file. Verify that the specified log file
+// location exists and that you can write to it.
+//
+#define ERROR_INSTALL_LOG_FAILURE                1622L
+
+//
+// MessageId: ERROR_INSTALL_LANGUAGE_UNSUPPORTED
+//
+// MessageText:
+//
+// The language of this installation package is not supported by your system.
+//
+#define ERROR_INSTALL_LANGUAGE_UNSUPPORTED       1623L
+
+//
+// MessageId: ERROR_INSTALL_TRANSFORM_FAILURE
+//
+// MessageText:
+//
+// Error applying transforms. Verify that the specified transform paths are
+// valid.
+//
+#define ERROR_INSTALL_TRANSFORM_FAILURE          1624L
+
+//
+// MessageId: ERROR_INSTALL_PACKAGE_REJECTED
+//
+// MessageText:
+//
+// This installation is forbidden by system policy. Contact your system
+// administrator.
+//
+#define ERROR_INSTALL_PACKAGE_REJECTED           1625L
+
+//
+// MessageId: ERROR_FUNCTION_NOT_CALLED
+//
+// MessageText:
+//
+// Function could not be executed.
+//
+#define ERROR_FUNCTION_NOT_CALLED                1626L
+
+//
+// MessageId: ERROR_FUNCTION_FAILED
+//
+// MessageText:
+//
+// Function failed during execution.
+//
+#define ERROR_FUNCTION_FAILED                    1627L
+
+//
+// MessageId: ERROR_INVALID_TABLE
+//
+// MessageText:
+//
+// Invalid or unknown table specified.
+//
+#define ERROR_INVALID_TABLE                      1628L
+
+//
+// MessageId: ERROR_DATATYPE_MISMATCH
+//
+// MessageText:
+//
+// Data supplied is of wrong type.
+//
+#define ERROR_DATATYPE_MISMATCH                  1629L
+
+//
+// MessageId: ERROR_UNSUPPORTED_TYPE
+//
+// MessageText:
+//
+// Data of this type is not supported.
+//
+#define ERROR_UNSUPPORTED_TYPE                   1630L
+
+//
+// MessageId: ERROR_CREATE_FAILED
+//
+// MessageText:
+//
+// The Windows Installer service failed to start. Contact your support
+// personnel.
+//
+#define ERROR_CREATE_FAILED                      1631L
+
+//
+// MessageId: ERROR_INSTALL_TEMP_UNWRITABLE
+//
+// MessageText:
+//
+// The Temp folder is on a drive that is full or is inaccessible. Free up space
+// on the drive or verify that you have write permission on the Temp folder.
+//
+#define ERROR_INSTALL_TEMP_UNWRITABLE            1632L
+
+//
+// MessageId: ERROR_INSTALL_PLATFORM_UNSUPPORTED
+//
+// MessageText:
+//
+// This installation package is not supported by this processor type. Contact
+// your product vendor.
+//
+#define ERROR_INSTALL_PLATFORM_UNSUPPORTED       1633L
+
+//
+// MessageId: ERROR_INSTALL_NOTUSED
+//
+// MessageText:
+//
+// Component not used on this computer.
+//
+#define ERROR_INSTALL_NOTUSED                    1634L
+
+//
+// MessageId: ERROR_PATCH_PACKAGE_OPEN_FAILED
+//
+// MessageText:
+//
+// This update package could not be opened. Verify that the update package
+// exists and that you can access it, or contact the application vendor to
+// verify that this is a valid Windows Installer update package.
+//
+#define ERROR_PATCH_PACKAGE_OPEN_FAILED          1635L
+
+//
+// MessageId: ERROR_PATCH_PACKAGE_INVALID
+//
+// MessageText:
+//
+// This update package could not be opened. Contact the application vendor to
+// verify that this is a valid Windows Installer update package.
+//
+#define ERROR_PATCH_PACKAGE_INVALID              1636L
+
+//
+// MessageId: ERROR_PATCH_PACKAGE_UNSUPPORTED
+//
+// MessageText:
+//
+// This update package cannot be processed by the Windows Installer service.
+// You must install a Windows service pack that contains a newer version of the
+// Windows Installer service.
+//
+#define ERROR_PATCH_PACKAGE_UNSUPPORTED          1637L
+
+//
+// MessageId: ERROR_PRODUCT_VERSION
+//
+// MessageText:
+//
+// Another version of this product is already installed. Installation of this
+// version cannot continue. To configure or remove the existing version of this
+// product, use Add/Remove Programs on the Control Panel.
+//
+#define ERROR_PRODUCT_VERSION                    1638L
+
+//
+// MessageId: ERROR_INVALID_COMMAND_LINE
+//
+// MessageText:
+//
+// Invalid command line argument. Consult the Windows Installer SDK for
+// detailed command line help.
+//
+#define ERROR_INVALID_COMMAND_LINE               1639L
+
+//
+// MessageId: ERROR_INSTALL_REMOTE_DISALLOWED
+//
+// MessageText:
+//
+// Only administrators have permission to add, remove, or configure server
+// software during a Terminal services remote session. If you want to install
+// or configure software on the server, contact your network administrator.
+//
+#define ERROR_INSTALL_REMOTE_DISALLOWED          1640L
+
+//
+// MessageId: ERROR_SUCCESS_REBOOT_INITIATED
+//
+// MessageText:
+//
+// The requested operation completed successfully. The system will be restarted
+// so the changes can take effect.
+//
+#define ERROR_SUCCESS_REBOOT_INITIATED           1641L
+
+//
+// MessageId: ERROR_PATCH_TARGET_NOT_FOUND
+//
+// MessageText:
+//
+// The upgrade cannot be installed by the Windows Installer service because the
+// program to be upgraded may be missing, or the upgrade may update a different
+// version of the program. Verify that the program to be upgraded exists on
+// your computer and that you have the correct upgrade.
+//
+#define ERROR_PATCH_TARGET_NOT_FOUND             1642L
+
+//
+// MessageId: ERROR_PATCH_PACKAGE_REJECTED
+//
+// MessageText:
+//
+// The update package is not permitted by software restriction policy.
+//
+#define ERROR_PATCH_PACKAGE_REJECTED             1643L
+
+//
+// MessageId: ERROR_INSTALL_TRANSFORM_REJECTED
+//
+// MessageText:
+//
+// One or more customizations are not permitted by software restriction policy.
+//
+#define ERROR_INSTALL_TRANSFORM_REJECTED         1644L
+
+//
+// MessageId: ERROR_INSTALL_REMOTE_PROHIBITED
+//
+// MessageText:
+//
+// The Windows Installer does not permit installation from a Remote Desktop
+// Connection.
+//
+#define ERROR_INSTALL_REMOTE_PROHIBITED          1645L
+
+//
+// MessageId: ERROR_PATCH_REMOVAL_UNSUPPORTED
+//
+// MessageText:
+//
+// Uninstallation of the update package is not supported.
+//
+#define ERROR_PATCH_REMOVAL_UNSUPPORTED          1646L
+
+//
+// MessageId: ERROR_UNKNOWN_PATCH
+//
+// MessageText:
+//
+// The update is not applied to this product.
+//
+#define ERROR_UNKNOWN_PATCH                      1647L
+
+//
+// MessageId: ERROR_PATCH_NO_SEQUENCE
+//
+// MessageText:
+//
+// No valid sequence could be found for the set of updates.
+//
+#define ERROR_PATCH_NO_SEQUENCE                  1648L
+
+//
+// MessageId: ERROR_PATCH_REMOVAL_DISALLOWED
+//
+// MessageText:
+//
+// Update removal was disallowed by policy.
+//
+#define ERROR_PATCH_REMOVAL_DISALLOWED           1649L
+
+//
+// MessageId: ERROR_INVALID_PATCH_XML
+//
+// MessageText:
+//
+// The XML update data is invalid.
+//
+#define ERROR_INVALID_PATCH_XML                  1650L
+
+//
+// MessageId: ERROR_PATCH_MANAGED_ADVERTISED_PRODUCT
+//
+// MessageText:
+//
+// Windows Installer does not permit updating of managed advertised products.
+// At least one feature of the product must be installed before applying the
+// update.
+//
+#define ERROR_PATCH_MANAGED_ADVERTISED_PRODUCT   1651L
+
+//
+// MessageId: ERROR_INSTALL_SERVICE_SAFEBOOT
+//
+// MessageText:
+//
+// The Windows Installer service is not accessible in Safe Mode. Please try
+// again when your computer is not in Safe Mode or you can use System Restore
+// to return your machine to a previous good state.
+//
+#define ERROR_INSTALL_SERVICE_SAFEBOOT           1652L
+
+//
+// MessageId: ERROR_FAIL_FAST_EXCEPTION
+//
+// MessageText:
+//
+// A fail fast exception occurred. Exception handlers will not be invoked and
+// the process will be terminated immediately.
+//
+#define ERROR_FAIL_FAST_EXCEPTION                1653L
+
+//
+// MessageId: ERROR_INSTALL_REJECTED
+//
+// MessageText:
+//
+// The app that you are trying to run is not supported on this version of
+// Windows.
+//
+#define ERROR_INSTALL_REJECTED                   1654L
+
+//
+// MessageId: ERROR_DYNAMIC_CODE_BLOCKED
+//
+// MessageText:
+//
+// The operation was blocked as the process prohibits dynamic code generation.
+//
+#define ERROR_DYNAMIC_CODE_BLOCKED               1655L
+
+//
+// MessageId: ERROR_NOT_SAME_OBJECT
+//
+// MessageText:
+//
+// The objects are not identical.
+//
+#define ERROR_NOT_SAME_OBJECT                    1656L
+
+//
+// MessageId: ERROR_STRICT_CFG_VIOLATION
+//
+// MessageText:
+//
+// The specified image file was blocked from loading because it does not enable
+// a feature required by the process: Control Flow Guard.
+//
+#define ERROR_STRICT_CFG_VIOLATION               1657L
+
+//
+// MessageId: ERROR_SET_CONTEXT_DENIED
+//
+// MessageText:
+//
+// The thread context could not be updated because this has been restricted for
+// the process.
+//
+#define ERROR_SET_CONTEXT_DENIED                 1660L
+
+//
+// MessageId: ERROR_CROSS_PARTITION_VIOLATION
+//
+// MessageText:
+//
+// An invalid cross-partition private file/section access was attempted.
+//
+#define ERROR_CROSS_PARTITION_VIOLATION          1661L
+
+//
+// MessageId: ERROR_INVALID_USER_BUFFER
+//
+// MessageText:
+//
+// The supplied user buffer is not valid for the requested operation.
+//
+#define ERROR_INVALID_USER_BUFFER                1784L
+
+//
+// MessageId: ERROR_UNRECOGNIZED_MEDIA
+//
+// MessageText:
+//
+// The disk media is not recognized. It may not be formatted.
+//
+#define ERROR_UNRECOGNIZED_MEDIA                 1785L
+
+//
+// MessageId: ERROR_NO_TRUST_LSA_SECRET
+//
+// MessageText:
+//
+// The workstation does not have a trust secret.
+//
+#define ERROR_NO_TRUST_LSA_SECRET                1786L
+
+//
+// MessageId: ERROR_NO_TRUST_SAM_ACCOUNT
+//
+// MessageText:
+//
+// The security database on the server does not have a computer account for
+// this workstation trust relationship.
+//
+#define ERROR_NO_TRUST_SAM_ACCOUNT               1787L
+
+//
+// MessageId: ERROR_TRUSTED_DOMAIN_FAILURE
+//
+// MessageText:
+//
+// The trust relationship between the primary domain and the trusted domain
+// failed.
+//
+#define ERROR_TRUSTED_DOMAIN_FAILURE             1788L
+
+//
+// MessageId: ERROR_TRUSTED_RELATIONSHIP_FAILURE
+//
+// MessageText:
+//
+// The trust relationship between this workstation and the primary domain
+// failed.
+//
+#define ERROR_TRUSTED_RELATIONSHIP_FAILURE       1789L
+
+//
+// MessageId: ERROR_TRUST_FAILURE
+//
+// MessageText:
+//
+// The network logon failed.
+//
+#define ERROR_TRUST_FAILURE                      1790L
+
+//
+// MessageId: ERROR_NETLOGON_NOT_STARTED
+//
+// MessageText:
+//
+// An attempt was made to logon, but the network logon service was not started.
+//
+#define ERROR_NETLOGON_NOT_STARTED               1792L
+
+//
+// MessageId: ERROR_ACCOUNT_EXPIRED
+//
+// MessageText:
+//
+// The user's account has expired.
+//
+#define ERROR_ACCOUNT_EXPIRED                    1793L
+
+//
+// MessageId: ERROR_REDIRECTOR_HAS_OPEN_HANDLES
+//
+// MessageText:
+//
+// The redirector is in use and cannot be unloaded.
+//
+#define ERROR_REDIRECTOR_HAS_OPEN_HANDLES        1794L
+
+//
+// MessageId: ERROR_PRINTER_DRIVER_ALREADY_INSTALLED
+//
+// MessageText:
+//
+// The specified printer driver is already installed.
+//
+#define ERROR_PRINTER_DRIVER_ALREADY_INSTALLED   1795L
+
+//
+// MessageId: ERROR_UNKNOWN_PORT
+//
+// MessageText:
+//
+// The specified port is unknown.
+//
+#define ERROR_UNKNOWN_PORT                       1796L
+
+//
+// MessageId: ERROR_UNKNOWN_PRINTER_DRIVER
+//
+// MessageText:
+//
+// The printer driver is unknown.
+//
+#define ERROR_UNKNOWN_PRINTER_DRIVER             1797L
+
+//
+// MessageId: ERROR_UNKNOWN_PRINTPROCESSOR
+//
+// MessageText:
+//
+// The print processor is unknown.
+//
+#define ERROR_UNKNOWN_PRINTPROCESSOR             1798L
+
+//
+// MessageId: ERROR_INVALID_SEPARATOR_FILE
+//
+// MessageText:
+//
+// The specified separator file is invalid.
+//
+#define ERROR_INVALID_SEPARATOR_FILE             1799L
+
+//
+// MessageId: ERROR_INVALID_PRIORITY
+//
+// MessageText:
+//
+// The specified priority is invalid.
+//
+#define ERROR_INVALID_PRIORITY                   1800L
+
+//
+// MessageId: ERROR_INVALID_PRINTER_NAME
+//
+// MessageText:
+//
+// The printer name is invalid.
+//
+#define ERROR_INVALID_PRINTER_NAME               1801L
+
+//
+// MessageId: ERROR_PRINTER_ALREADY_EXISTS
+//
+// MessageText:
+//
+// The printer already exists.
+//
+#define ERROR_PRINTER_ALREADY_EXISTS             1802L
+
+//
+// MessageId: ERROR_INVALID_PRINTER_COMMAND
+//
+// MessageText:
+//
+// The printer command is invalid.
+//
+#define ERROR_INVALID_PRINTER_COMMAND            1803L
+
+//
+// MessageId: ERROR_INVALID_DATATYPE
+//
+// MessageText:
+//
+// The specified datatype is invalid.
+//
+#define ERROR_INVALID_DATATYPE                   1804L
+
+//
+// MessageId: ERROR_INVALID_ENVIRONMENT
+//
+// MessageText:
+//
+// The environment specified is invalid.
+//
+#define ERROR_INVALID_ENVIRONMENT                1805L
+
+//
+// MessageId: ERROR_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT
+//
+// MessageText:
+//
+// The account used is an interdomain trust account. Use your global user
+// account or local user account to access this server.
+//
+#define ERROR_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT  1807L
+
+//
+// MessageId: ERROR_NOLOGON_WORKSTATION_TRUST_ACCOUNT
+//
+// MessageText:
+//
+// The account used is a computer account. Use your global user account or
+// local user account to access this server.
+//
+#define ERROR_NOLOGON_WORKSTATION_TRUST_ACCOUNT  1808L
+
+//
+// MessageId: ERROR_NOLOGON_SERVER_TRUST_ACCOUNT
+//
+// MessageText:
+//
+// The account used is a server trust account. Use your global user account or
+// local user account to access this server.
+//
+#define ERROR_NOLOGON_SERVER_TRUST_ACCOUNT       1809L
+
+//
+// MessageId: ERROR_DOMAIN_TRUST_INCONSISTENT
+//
+// MessageText:
+//
+// The name or security ID (SID) of the domain specified is inconsistent with
+// the trust information for that domain.
+//
+#define ERROR_DOMAIN_TRUST_INCONSISTENT          1810L
+
+//
+// MessageId: ERROR_SERVER_HAS_OPEN_HANDLES
+//
+// MessageText:
+//
+// The server is in use and cannot be unloaded.
+//
+#define ERROR_SERVER_HAS_OPEN_HANDLES            1811L
+
+//
+// MessageId: ERROR_RESOURCE_DATA_NOT_FOUND
+//
+// MessageText:
+//
+// The specified image file did not contain a resource section.
+//
+#define ERROR_RESOURCE_DATA_NOT_FOUND            1812L
+
+//
+// MessageId: ERROR_RESOURCE_TYPE_NOT_FOUND
+//
+// MessageText:
+//
+// The specified resource type cannot be found in the image file.
+//
+#define ERROR_RESOURCE_TYPE_NOT_FOUND            1813L
+
+//
+// MessageId: ERROR_RESOURCE_NAME_NOT_FOUND
+//
+// MessageText:
+//
+// The specified resource name cannot be found in the image file.
+//
+#define ERROR_RESOURCE_NAME_NOT_FOUND            1814L
+
+//
+// MessageId: ERROR_RESOURCE_LANG_NOT_FOUND
+//
+// MessageText:
+//
+// The specified resource language ID cannot be found in the image file.
+//
+#define ERROR_RESOURCE_LANG_NOT_FOUND            1815L
+
+//
+// MessageId: ERROR_NOT_ENOUGH_QUOTA
+//
+// MessageText:
+//
+// Not enough quota is available to process this command.
+//
+#define ERROR_NOT_ENOUGH_QUOTA                   1816L
+
+//
+// MessageId: ERROR_INVALID_TIME
+//
+// MessageText:
+//
+// The specified time is invalid.
+//
+#define ERROR_INVALID_TIME                       1901L
+
+//
+// MessageId: ERROR_INVALID_FORM_NAME
+//
+// MessageText:
+//
+// The specified form name is invalid.
+//
+#define ERROR_INVALID_FORM_NAME                  1902L
+
+//
+// MessageId: ERROR_INVALID_FORM_SIZE
+//
+// MessageText:
+//
+// The specified form size is invalid.
+//
+#define ERROR_INVALID_FORM_SIZE                  1903L
+
+//
+// MessageId: ERROR_ALREADY_WAITING
+//
+// MessageText:
+//
+// The specified printer handle is already being waited on.
+//
+#define ERROR_ALREADY_WAITING                    1904L
+
+//
+// MessageId: ERROR_PRINTER_DELETED
+//
+// MessageText:
+//
+// The specified printer has been deleted.
+//
+#define ERROR_PRINTER_DELETED                    1905L
+
+//
+// MessageId: ERROR_INVALID_PRINTER_STATE
+//
+// MessageText:
+//
+// The state of the printer is invalid.
+//
+#define ERROR_INVALID_PRINTER_STATE              1906L
+
+//
+// MessageId: ERROR_PASSWORD_MUST_CHANGE
+//
+// MessageText:
+//
+// The user's password must be changed before signing in.
+//
+#define ERROR_PASSWORD_MUST_CHANGE               1907L
+
+//
+// MessageId: ERROR_DOMAIN_CONTROLLER_NOT_FOUND
+//
+// MessageText:
+//
+// Could not find the domain controller for this domain.
+//
+#define ERROR_DOMAIN_CONTROLLER_NOT_FOUND        1908L
+
+//
+// MessageId: ERROR_ACCOUNT_LOCKED_OUT
+//
+// MessageText:
+//
+// The referenced account is currently locked out and may not be logged on to.
+//
+#define ERROR_ACCOUNT_LOCKED_OUT                 1909L
+
+//
+// MessageId: ERROR_NO_SITENAME
+//
+// MessageText:
+//
+// The site name for the computer could not be determined.
+//
+#define ERROR_NO_SITENAME                        1919L
+
+//
+// MessageId: ERROR_CANT_ACCESS_FILE
+//
+// MessageText:
+//
+// The file cannot be accessed by the system.
+//
+#define ERROR_CANT_ACCESS_FILE                   1920L
+
+//
+// MessageId: ERROR_CANT_RESOLVE_FILENAME
+//
+// MessageText:
+//
+// The name of the file cannot be resolved by the system.
+//
+#define ERROR_CANT_RESOLVE_FILENAME              1921L
+
+//
+// MessageId: ERROR_KM_DRIVER_BLOCKED
+//
+// MessageText:
+//
+// The kernel-mode driver was blocked from loading.
+//
+#define ERROR_KM_DRIVER_BLOCKED                  1930L
+
+//
+// MessageId: ERROR_CONTEXT_EXPIRED
+//
+// MessageText:
+//
+// The context has expired and can no longer be used.
+//
+#define ERROR_CONTEXT_EXPIRED                    1931L
+
+//
+// MessageId: ERROR_PER_USER_TRUST_QUOTA_EXCEEDED
+//
+// MessageText:
+//
+// The current user has exceeded the allowed number of trusted domain objects
+// that it may create.
+//
+#define ERROR_PER_USER_TRUST_QUOTA_EXCEEDED      1932L
+
+//
+// MessageId: ERROR_ALL_USER_TRUST_QUOTA_EXCEEDED
+//
+// MessageText:
+//
+// The total number of trusted domain objects across all users has exceeded the
+// allowed maximum.
+//
+#define ERROR_ALL_USER_TRUST_QUOTA_EXCEEDED      1933L
+
+//
+// MessageId: ERROR_USER_DELETE_TRUST_QUOTA_EXCEEDED
+//
+// MessageText:
+//
+// A user that has exceeded its trusted domain object quota cannot delete
+// another user's trusted domain object.
+//
+#define ERROR_USER_DELETE_TRUST_QUOTA_EXCEEDED   1934L
+
+//
+// MessageId: ERROR_AUTHENTICATION_FIREWALL_FAILED
+//
+// MessageText:
+//
+// The computer you are signing into is protected by an authentication
+// firewall. The specified account is not allowed to authenticate to the
+// computer.
+//
+#define ERROR_AUTHENTICATION_FIREWALL_FAILED     1935L
+
+//
+// MessageId: ERROR_REMOTE_PRINT_CONNECTIONS_BLOCKED
+//
+// MessageText:
+//
+// Remote connections to the Print Spooler are blocked by a policy set on your
+// machine.
+//
+#define ERROR_REMOTE_PRINT_CONNECTIONS_BLOCKED   1936L
+
+//
+// MessageId: ERROR_NTLM_BLOCKED
+//
+// MessageText:
+//
+// Authentication failed because NTLM authentication has been disabled.
+//
+#define ERROR_NTLM_BLOCKED                       1937L
+
+//
+// MessageId: ERROR_PASSWORD_CHANGE_REQUIRED
+//
+// MessageText:
+//
+// Password change for %1 is required. Please change the password.
+//
+#define ERROR_PASSWORD_CHANGE_REQUIRED           1938L
+
+//
+// MessageId: ERROR_LOST_MODE_LOGON_RESTRICTION
+//
+// MessageText:
+//
+// The computer is in lost mode and logon is restricted.
+//
+#define ERROR_LOST_MODE_LOGON_RESTRICTION        1939L
+
+//
+// MessageId: ERROR_INVALID_PIXEL_FORMAT
+//
+// MessageText:
+//
+// The pixel format is invalid.
+//
+#define ERROR_INVALID_PIXEL_FORMAT               2000L
+
+//
+// MessageId: ERROR_BAD_DRIVER
+//
+// MessageText:
+//
+// The specified driver is invalid.
+//
+#define ERROR_BAD_DRIVER                         2001L
+
+//
+// MessageId: ERROR_INVALID_WINDOW_STYLE
+//
+// MessageText:
+//
+// The window style or class attribute is invalid for this operation.
+//
+#define ERROR_INVALID_WINDOW_STYLE               2002L
+
+//
+// MessageId: ERROR_METAFILE_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The requested metafile operation is not supported.
+//
+#define ERROR_METAFILE_NOT_SUPPORTED             2003L
+
+//
+// MessageId: ERROR_TRANSFORM_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The requested transformation operation is not supported.
+//
+#define ERROR_TRANSFORM_NOT_SUPPORTED            2004L
+
+//
+// MessageId: ERROR_CLIPPING_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The requested clipping operation is not supported.
+//
+#define ERROR_CLIPPING_NOT_SUPPORTED             2005L
+
+//
+// MessageId: ERROR_INVALID_CMM
+//
+// MessageText:
+//
+// The specified color management module is invalid.
+//
+#define ERROR_INVALID_CMM                        2010L
+
+//
+// MessageId: ERROR_INVALID_PROFILE
+//
+// MessageText:
+//
+// The specified color profile is invalid.
+//
+#define ERROR_INVALID_PROFILE                    2011L
+
+//
+// MessageId: ERROR_TAG_NOT_FOUND
+//
+// MessageText:
+//
+// The specified tag was not found.
+//
+#define ERROR_TAG_NOT_FOUND                      2012L
+
+//
+// MessageId: ERROR_TAG_NOT_PRESENT
+//
+// MessageText:
+//
+// A required tag is not present.
+//
+#define ERROR_TAG_NOT_PRESENT                    2013L
+
+//
+// MessageId: ERROR_DUPLICATE_TAG
+//
+// MessageText:
+//
+// The specified tag is already present.
+//
+#define ERROR_DUPLICATE_TAG                      2014L
+
+//
+// MessageId: ERROR_PROFILE_NOT_ASSOCIATED_WITH_DEVICE
+//
+// MessageText:
+//
+// The specified color profile is not associated with the specified device.
+//
+#define ERROR_PROFILE_NOT_ASSOCIATED_WITH_DEVICE 2015L
+
+//
+// MessageId: ERROR_PROFILE_NOT_FOUND
+//
+// MessageText:
+//
+// The specified color profile was not found.
+//
+#define ERROR_PROFILE_NOT_FOUND                  2016L
+
+//
+// MessageId: ERROR_INVALID_COLORSPACE
+//
+// MessageText:
+//
+// The specified color space is invalid.
+//
+#define ERROR_INVALID_COLORSPACE                 2017L
+
+//
+// MessageId: ERROR_ICM_NOT_ENABLED
+//
+// MessageText:
+//
+// Image Color Management is not enabled.
+//
+#define ERROR_ICM_NOT_ENABLED                    2018L
+
+//
+// MessageId: ERROR_DELETING_ICM_XFORM
+//
+// MessageText:
+//
+// There was an error while deleting the color transform.
+//
+#define ERROR_DELETING_ICM_XFORM                 2019L
+
+//
+// MessageId: ERROR_INVALID_TRANSFORM
+//
+// MessageText:
+//
+// The specified color transform is invalid.
+//
+#define ERROR_INVALID_TRANSFORM                  2020L
+
+//
+// MessageId: ERROR_COLORSPACE_MISMATCH
+//
+// MessageText:
+//
+// The specified transform does not match the bitmap's color space.
+//
+#define ERROR_COLORSPACE_MISMATCH                2021L
+
+//
+// MessageId: ERROR_INVALID_COLORINDEX
+//
+// MessageText:
+//
+// The specified named color index is not present in the profile.
+//
+#define ERROR_INVALID_COLORINDEX                 2022L
+
+//
+// MessageId: ERROR_PROFILE_DOES_NOT_MATCH_DEVICE
+//
+// MessageText:
+//
+// The specified profile is intended for a device of a different type than the
+// specified device.
+//
+#define ERROR_PROFILE_DOES_NOT_MATCH_DEVICE      2023L
+
+//
+// MessageId: ERROR_CONNECTED_OTHER_PASSWORD
+//
+// MessageText:
+//
+// The network connection was made successfully, but the user had to be
+// prompted for a password other than the one originally specified.
+//
+#define ERROR_CONNECTED_OTHER_PASSWORD           2108L
+
+//
+// MessageId: ERROR_CONNECTED_OTHER_PASSWORD_DEFAULT
+//
+// MessageText:
+//
+// The network connection was made successfully using default credentials.
+//
+#define ERROR_CONNECTED_OTHER_PASSWORD_DEFAULT   2109L
+
+//
+// MessageId: ERROR_BAD_USERNAME
+//
+// MessageText:
+//
+// The specified username is invalid.
+//
+#define ERROR_BAD_USERNAME                       2202L
+
+//
+// MessageId: ERROR_NOT_CONNECTED
+//
+// MessageText:
+//
+// This network connection does not exist.
+//
+#define ERROR_NOT_CONNECTED                      2250L
+
+//
+// MessageId: ERROR_OPEN_FILES
+//
+// MessageText:
+//
+// This network connection has files open or requests pending.
+//
+#define ERROR_OPEN_FILES                         2401L
+
+//
+// MessageId: ERROR_ACTIVE_CONNECTIONS
+//
+// MessageText:
+//
+// Active connections still exist.
+//
+#define ERROR_ACTIVE_CONNECTIONS                 2402L
+
+//
+// MessageId: ERROR_DEVICE_IN_USE
+//
+// MessageText:
+//
+// The device is in use by an active process and cannot be disconnected.
+//
+#define ERROR_DEVICE_IN_USE                      2404L
+
+//
+// MessageId: ERROR_UNKNOWN_PRINT_MONITOR
+//
+// MessageText:
+//
+// The specified print monitor is unknown.
+//
+#define ERROR_UNKNOWN_PRINT_MONITOR              3000L
+
+//
+// MessageId: ERROR_PRINTER_DRIVER_IN_USE
+//
+// MessageText:
+//
+// The specified printer driver is currently in use.
+//
+#define ERROR_PRINTER_DRIVER_IN_USE              3001L
+
+//
+// MessageId: ERROR_SPOOL_FILE_NOT_FOUND
+//
+// MessageText:
+//
+// The spool file was not found.
+//
+#define ERROR_SPOOL_FILE_NOT_FOUND               3002L
+
+//
+// MessageId: ERROR_SPL_NO_STARTDOC
+//
+// MessageText:
+//
+// A StartDocPrinter call was not issued.
+//
+#define ERROR_SPL_NO_STARTDOC                    3003L
+
+//
+// MessageId: ERROR_SPL_NO_ADDJOB
+//
+// MessageText:
+//
+// An AddJob call was not issued.
+//
+#define ERROR_SPL_NO_ADDJOB                      3004L
+
+//
+// MessageId: ERROR_PRINT_PROCESSOR_ALREADY_INSTALLED
+//
+// MessageText:
+//
+// The specified print processor has already been installed.
+//
+#define ERROR_PRINT_PROCESSOR_ALREADY_INSTALLED  3005L
+
+//
+// MessageId: ERROR_PRINT_MONITOR_ALREADY_INSTALLED
+//
+// MessageText:
+//
+// The specified print monitor has already been installed.
+//
+#define ERROR_PRINT_MONITOR_ALREADY_INSTALLED    3006L
+
+//
+// MessageId: ERROR_INVALID_PRINT_MONITOR
+//
+// MessageText:
+//
+// The specified print monitor does not have the required functions.
+//
+#define ERROR_INVALID_PRINT_MONITOR              3007L
+
+//
+// MessageId: ERROR_PRINT_MONITOR_IN_USE
+//
+// MessageText:
+//
+// The specified print monitor is currently in use.
+//
+#define ERROR_PRINT_MONITOR_IN_USE               3008L
+
+//
+// MessageId: ERROR_PRINTER_HAS_JOBS_QUEUED
+//
+// MessageText:
+//
+// The requested operation is not allowed when there are jobs queued to the
+// printer.
+//
+#define ERROR_PRINTER_HAS_JOBS_QUEUED            3009L
+
+//
+// MessageId: ERROR_SUCCESS_REBOOT_REQUIRED
+//
+// MessageText:
+//
+// The requested operation is successful. Changes will not be effective until
+// the system is rebooted.
+//
+#define ERROR_SUCCESS_REBOOT_REQUIRED            3010L
+
+//
+// MessageId: ERROR_SUCCESS_RESTART_REQUIRED
+//
+// MessageText:
+//
+// The requested operation is successful. Changes will not be effective until
+// the service is restarted.
+//
+#define ERROR_SUCCESS_RESTART_REQUIRED           3011L
+
+//
+// MessageId: ERROR_PRINTER_NOT_FOUND
+//
+// MessageText:
+//
+// No printers were found.
+//
+#define ERROR_PRINTER_NOT_FOUND                  3012L
+
+//
+// MessageId: ERROR_PRINTER_DRIVER_WARNED
+//
+// MessageText:
+//
+// The printer driver is known to be unreliable.
+//
+#define ERROR_PRINTER_DRIVER_WARNED              3013L
+
+//
+// MessageId: ERROR_PRINTER_DRIVER_BLOCKED
+//
+// MessageText:
+//
+// The printer driver is known to harm the system.
+//
+#define ERROR_PRINTER_DRIVER_BLOCKED             3014L
+
+//
+// MessageId: ERROR_PRINTER_DRIVER_PACKAGE_IN_USE
+//
+// MessageText:
+//
+// The specified printer driver package is currently in use.
+//
+#define ERROR_PRINTER_DRIVER_PACKAGE_IN_USE      3015L
+
+//
+// MessageId: ERROR_CORE_DRIVER_PACKAGE_NOT_FOUND
+//
+// MessageText:
+//
+// Unable to find a core driver package that is required by the printer driver
+// package.
+//
+#define ERROR_CORE_DRIVER_PACKAGE_NOT_FOUND      3016L
+
+//
+// MessageId: ERROR_FAIL_REBOOT_REQUIRED
+//
+// MessageText:
+//
+// The requested operation failed. A system reboot is required to roll back
+// changes made.
+//
+#define ERROR_FAIL_REBOOT_REQUIRED               3017L
+
+//
+// MessageId: ERROR_FAIL_REBOOT_INITIATED
+//
+// MessageText:
+//
+// The requested operation failed. A system reboot has been initiated to roll
+// back changes made.
+//
+#define ERROR_FAIL_REBOOT_INITIATED              3018L
+
+//
+// MessageId: ERROR_PRINTER_DRIVER_DOWNLOAD_NEEDED
+//
+// MessageText:
+//
+// The specified printer driver was not found on the system and needs to be
+// downloaded.
+//
+#define ERROR_PRINTER_DRIVER_DOWNLOAD_NEEDED     3019L
+
+//
+// MessageId: ERROR_PRINT_JOB_RESTART_REQUIRED
+//
+// MessageText:
+//
+// The requested print job has failed to print. A print system update requires
+// the job to be resubmitted.
+//
+#define ERROR_PRINT_JOB_RESTART_REQUIRED         3020L
+
+//
+// MessageId: ERROR_INVALID_PRINTER_DRIVER_MANIFEST
+//
+// MessageText:
+//
+// The printer driver does not contain a valid manifest, or contains too many
+// manifests.
+//
+#define ERROR_INVALID_PRINTER_DRIVER_MANIFEST    3021L
+
+//
+// MessageId: ERROR_PRINTER_NOT_SHAREABLE
+//
+// MessageText:
+//
+// The specified printer cannot be shared.
+//
+#define ERROR_PRINTER_NOT_SHAREABLE              3022L
+
+//
+// MessageId: ERROR_REQUEST_PAUSED
+//
+// MessageText:
+//
+// The operation was paused.
+//
+#define ERROR_REQUEST_PAUSED                     3050L
+
+#define ERROR_APPEXEC_CONDITION_NOT_SATISFIED    3060L
+
+#define ERROR_APPEXEC_HANDLE_INVALIDATED         3061L
+
+#define ERROR_APPEXEC_INVALID_HOST_GENERATION    3062L
+
+#define ERROR_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION 3063L
+
+#define ERROR_APPEXEC_INVALID_HOST_STATE         3064L
+
+#define ERROR_APPEXEC_NO_DONOR                   3065L
+
+#define ERROR_APPEXEC_HOST_ID_MISMATCH           3066L
+
+#define ERROR_APPEXEC_UNKNOWN_USER               3067L
+
+//
+// MessageId: ERROR_IO_REISSUE_AS_CACHED
+//
+// MessageText:
+//
+// The I/O operation was reissued as a cached operation.
+//
+#define ERROR_IO_REISSUE_AS_CACHED               3950L
+
+//
+// MessageId: ERROR_WINS_INTERNAL
+//
+// MessageText:
+//
+// WINS encountered an error while processing the command.
+//
+#define ERROR_WINS_INTERNAL                      4000L
+
+//
+// MessageId: ERROR_CAN_NOT_DEL_LOCAL_WINS
+//
+// MessageText:
+//
+// The local WINS cannot be deleted.
+//
+#define ERROR_CAN_NOT_DEL_LOCAL_WINS             4001L
+
+//
+// MessageId: ERROR_STATIC_INIT
+//
+// MessageText:
+//
+// The importation from the file failed.
+//
+#define ERROR_STATIC_INIT                        4002L
+
+//
+// MessageId: ERROR_INC_BACKUP
+//
+// MessageText:
+//
+// The backup failed. Was a full backup done before?
+//
+#define ERROR_INC_BACKUP                         4003L
+
+//
+// MessageId: ERROR_FULL_BACKUP
+//
+// MessageText:
+//
+// The backup failed. Check the directory to which you are backing the
+// database.
+//
+#define ERROR_FULL_BACKUP                        4004L
+
+//
+// MessageId: ERROR_REC_NON_EXISTENT
+//
+// MessageText:
+//
+// The name does not exist in the WINS database.
+//
+#define ERROR_REC_NON_EXISTENT                   4005L
+
+//
+// MessageId: ERROR_RPL_NOT_ALLOWED
+//
+// MessageText:
+//
+// Replication with a nonconfigured partner is not allowed.
+//
+#define ERROR_RPL_NOT_ALLOWED                    4006L
+
+//
+// MessageId: ERROR_DHCP_ADDRESS_CONFLICT
+//
+// MessageText:
+//
+// The DHCP client has obtained an IP address that is already in use on the
+// network. The local interface will be disabled until the DHCP client can
+// obtain a new address.
+//
+#define ERROR_DHCP_ADDRESS_CONFLICT              4100L
+
+//
+// MessageId: ERROR_WMI_GUID_NOT_FOUND
+//
+// MessageText:
+//
+// The GUID passed was not recognized as valid by a WMI data provider.
+//
+#define ERROR_WMI_GUID_NOT_FOUND                 4200L
+
+//
+// MessageId: ERROR_WMI_INSTANCE_NOT_FOUND
+//
+// MessageText:
+//
+// The instance name passed was not recognized as valid by a WMI data provider.
+//
+#define ERROR_WMI_INSTANCE_NOT_FOUND             4201L
+
+//
+// MessageId: ERROR_WMI_ITEMID_NOT_FOUND
+//
+// MessageText:
+//
+// The data item ID passed was not recognized as valid by a WMI data provider.
+//
+#define ERROR_WMI_ITEMID_NOT_FOUND               4202L
+
+//
+// MessageId: ERROR_WMI_TRY_AGAIN
+//
+// MessageText:
+//
+// The WMI request could not be completed and should be retried.
+//
+#define ERROR_WMI_TRY_AGAIN                      4203L
+
+//
+// MessageId: ERROR_WMI_DP_NOT_FOUND
+//
+// MessageText:
+//
+// The WMI data provider could not be located.
+//
+#define ERROR_WMI_DP_NOT_FOUND                   4204L
+
+//
+// MessageId: ERROR_WMI_UNRESOLVED_INSTANCE_REF
+//
+// MessageText:
+//
+// The WMI data provider references an instance set that has not been
+// registered.
+//
+#define ERROR_WMI_UNRESOLVED_INSTANCE_REF        4205L
+
+//
+// MessageId: ERROR_WMI_ALREADY_ENABLED
+//
+// MessageText:
+//
+// The WMI data block or event notification has already been enabled.
+//
+#define ERROR_WMI_ALREADY_ENABLED                4206L
+
+//
+// MessageId: ERROR_WMI_GUID_DISCONNECTED
+//
+// MessageText:
+//
+// The WMI data block is no longer available.
+//
+#define ERROR_WMI_GUID_DISCONNECTED              4207L
+
+//
+// MessageId: ERROR_WMI_SERVER_UNAVAILABLE
+//
+// MessageText:
+//
+// The WMI data service is not available.
+//
+#define ERROR_WMI_SERVER_UNAVAILABLE             4208L
+
+//
+// MessageId: ERROR_WMI_DP_FAILED
+//
+// MessageText:
+//
+// The WMI data provider failed to carry out the request.
+//
+#define ERROR_WMI_DP_FAILED                      4209L
+
+//
+// MessageId: ERROR_WMI_INVALID_MOF
+//
+// MessageText:
+//
+// The WMI MOF information is not valid.
+//
+#define ERROR_WMI_INVALID_MOF                    4210L
+
+//
+// MessageId: ERROR_WMI_INVALID_REGINFO
+//
+// MessageText:
+//
+// The WMI registration information is not valid.
+//
+#define ERROR_WMI_INVALID_REGINFO                4211L
+
+//
+// MessageId: ERROR_WMI_ALREADY_DISABLED
+//
+// MessageText:
+//
+// The WMI data block or event notification has already been disabled.
+//
+#define ERROR_WMI_ALREADY_DISABLED               4212L
+
+//
+// MessageId: ERROR_WMI_READ_ONLY
+//
+// MessageText:
+//
+// The WMI data item or data block is read only.
+//
+#define ERROR_WMI_READ_ONLY                      4213L
+
+//
+// MessageId: ERROR_WMI_SET_FAILURE
+//
+// MessageText:
+//
+// The WMI data item or data block could not be changed.
+//
+#define ERROR_WMI_SET_FAILURE                    4214L
+
+//
+// MessageId: ERROR_NOT_APPCONTAINER
+//
+// MessageText:
+//
+// This operation is only valid in the context of an app container.
+//
+#define ERROR_NOT_APPCONTAINER                   4250L
+
+//
+// MessageId: ERROR_APPCONTAINER_REQUIRED
+//
+// MessageText:
+//
+// This application can only run in the context of an app container.
+//
+#define ERROR_APPCONTAINER_REQUIRED              4251L
+
+//
+// MessageId: ERROR_NOT_SUPPORTED_IN_APPCONTAINER
+//
+// MessageText:
+//
+// This functionality is not supported in the context of an app container.
+//
+#define ERROR_NOT_SUPPORTED_IN_APPCONTAINER      4252L
+
+//
+// MessageId: ERROR_INVALID_PACKAGE_SID_LENGTH
+//
+// MessageText:
+//
+// The length of the SID supplied is not a valid length for app container SIDs.
+//
+#define ERROR_INVALID_PACKAGE_SID_LENGTH         4253L
+
+//
+// MessageId: ERROR_INVALID_MEDIA
+//
+// MessageText:
+//
+// The media identifier does not represent a valid medium.
+//
+#define ERROR_INVALID_MEDIA                      4300L
+
+//
+// MessageId: ERROR_INVALID_LIBRARY
+//
+// MessageText:
+//
+// The library identifier does not represent a valid library.
+//
+#define ERROR_INVALID_LIBRARY                    4301L
+
+//
+// MessageId: ERROR_INVALID_MEDIA_POOL
+//
+// MessageText:
+//
+// The media pool identifier does not represent a valid media pool.
+//
+#define ERROR_INVALID_MEDIA_POOL                 4302L
+
+//
+// MessageId: ERROR_DRIVE_MEDIA_MISMATCH
+//
+// MessageText:
+//
+// The drive and medium are not compatible or exist in different libraries.
+//
+#define ERROR_DRIVE_MEDIA_MISMATCH               4303L
+
+//
+// MessageId: ERROR_MEDIA_OFFLINE
+//
+// MessageText:
+//
+// The medium currently exists in an offline library and must be online to
+// perform this operation.
+//
+#define ERROR_MEDIA_OFFLINE                      4304L
+
+//
+// MessageId: ERROR_LIBRARY_OFFLINE
+//
+// MessageText:
+//
+// The operation cannot be performed on an offline library.
+//
+#define ERROR_LIBRARY_OFFLINE                    4305L
+
+//
+// MessageId: ERROR_EMPTY
+//
+// MessageText:
+//
+// The library, drive, or media pool is empty.
+//
+#define ERROR_EMPTY                              4306L
+
+//
+// MessageId: ERROR_NOT_EMPTY
+//
+// MessageText:
+//
+// The library, drive, or media pool must be empty to perform this operation.
+//
+#define ERROR_NOT_EMPTY                          4307L
+
+//
+// MessageId: ERROR_MEDIA_UNAVAILABLE
+//
+// MessageText:
+//
+// No media is currently available in this media pool or library.
+//
+#define ERROR_MEDIA_UNAVAILABLE                  4308L
+
+//
+// MessageId: ERROR_RESOURCE_DISABLED
+//
+// MessageText:
+//
+// A resource required for this operation is disabled.
+//
+#define ERROR_RESOURCE_DISABLED                  4309L
+
+//
+// MessageId: ERROR_INVALID_CLEANER
+//
+// MessageText:
+//
+// The media identifier does not represent a valid cleaner.
+//
+#define ERROR_INVALID_CLEANER                    4310L
+
+//
+// MessageId: ERROR_UNABLE_TO_CLEAN
+//
+// MessageText:
+//
+// The drive cannot be cleaned or does not support cleaning.
+//
+#define ERROR_UNABLE_TO_CLEAN                    4311L
+
+//
+// MessageId: ERROR_OBJECT_NOT_FOUND
+//
+// MessageText:
+//
+// The object identifier does not represent a valid object.
+//
+#define ERROR_OBJECT_NOT_FOUND                   4312L
+
+//
+// MessageId: ERROR_DATABASE_FAILURE
+//
+// MessageText:
+//
+// Unable to read from or write to the database.
+//
+#define ERROR_DATABASE_FAILURE                   4313L
+
+//
+// MessageId: ERROR_DATABASE_FULL
+//
+// MessageText:
+//
+// The database is full.
+//
+#define ERROR_DATABASE_FULL                      4314L
+
+//
+// MessageId: ERROR_MEDIA_INCOMPATIBLE
+//
+// MessageText:
+//
+// The medium is not compatible with the device or media pool.
+//
+#define ERROR_MEDIA_INCOMPATIBLE                 4315L
+
+//
+// MessageId: ERROR_RESOURCE_NOT_PRESENT
+//
+// MessageText:
+//
+// The resource required for this operation does not exist.
+//
+#define ERROR_RESOURCE_NOT_PRESENT               4316L
+
+//
+// MessageId: ERROR_INVALID_OPERATION
+//
+// MessageText:
+//
+// The operation identifier is not valid.
+//
+#define ERROR_INVALID_OPERATION                  4317L
+
+//
+// MessageId: ERROR_MEDIA_NOT_AVAILABLE
+//
+// MessageText:
+//
+// The media is not mounted or ready for use.
+//
+#define ERROR_MEDIA_NOT_AVAILABLE                4318L
+
+//
+// MessageId: ERROR_DEVICE_NOT_AVAILABLE
+//
+// MessageText:
+//
+// The device is not ready for use.
+//
+#define ERROR_DEVICE_NOT_AVAILABLE               4319L
+
+//
+// MessageId: ERROR_REQUEST_REFUSED
+//
+// MessageText:
+//
+// The operator or administrator has refused the request.
+//
+#define ERROR_REQUEST_REFUSED                    4320L
+
+//
+// MessageId: ERROR_INVALID_DRIVE_OBJECT
+//
+// MessageText:
+//
+// The drive identifier does not represent a valid drive.
+//
+#define ERROR_INVALID_DRIVE_OBJECT               4321L
+
+//
+// MessageId: ERROR_LIBRARY_FULL
+//
+// MessageText:
+//
+// Library is full. No slot is available for use.
+//
+#define ERROR_LIBRARY_FULL                       4322L
+
+//
+// MessageId: ERROR_MEDIUM_NOT_ACCESSIBLE
+//
+// MessageText:
+//
+// The transport cannot access the medium.
+//
+#define ERROR_MEDIUM_NOT_ACCESSIBLE              4323L
+
+//
+// MessageId: ERROR_UNABLE_TO_LOAD_MEDIUM
+//
+// MessageText:
+//
+// Unable to load the medium into the drive.
+//
+#define ERROR_UNABLE_TO_LOAD_MEDIUM              4324L
+
+//
+// MessageId: ERROR_UNABLE_TO_INVENTORY_DRIVE
+//
+// MessageText:
+//
+// Unable to retrieve the drive status.
+//
+#define ERROR_UNABLE_TO_INVENTORY_DRIVE          4325L
+
+//
+// MessageId: ERROR_UNABLE_TO_INVENTORY_SLOT
+//
+// MessageText:
+//
+// Unable to retrieve the slot status.
+//
+#define ERROR_UNABLE_TO_INVENTORY_SLOT           4326L
+
+//
+// MessageId: ERROR_UNABLE_TO_INVENTORY_TRANSPORT
+//
+// MessageText:
+//
+// Unable to retrieve status about the transport.
+//
+#define ERROR_UNABLE_TO_INVENTORY_TRANSPORT      4327L
+
+//
+// MessageId: ERROR_TRANSPORT_FULL
+//
+// MessageText:
+//
+// Cannot use the transport because it is already in use.
+//
+#define ERROR_TRANSPORT_FULL                     4328L
+
+//
+// MessageId: ERROR_CONTROLLING_IEPORT
+//
+// MessageText:
+//
+// Unable to open or close the inject/eject port.
+//
+#define ERROR_CONTROLLING_IEPORT                 4329L
+
+//
+// MessageId: ERROR_UNABLE_TO_EJECT_MOUNTED_MEDIA
+//
+// MessageText:
+//
+// Unable to eject the medium because it is in a drive.
+//
+#define ERROR_UNABLE_TO_EJECT_MOUNTED_MEDIA      4330L
+
+//
+// MessageId: ERROR_CLEANER_SLOT_SET
+//
+// MessageText:
+//
+// A cleaner slot is already reserved.
+//
+#define ERROR_CLEANER_SLOT_SET                   4331L
+
+//
+// MessageId: ERROR_CLEANER_SLOT_NOT_SET
+//
+// MessageText:
+//
+// A cleaner slot is not reserved.
+//
+#define ERROR_CLEANER_SLOT_NOT_SET               4332L
+
+//
+// MessageId: ERROR_CLEANER_CARTRIDGE_SPENT
+//
+// MessageText:
+//
+// The cleaner cartridge has performed the maximum number of drive cleanings.
+//
+#define ERROR_CLEANER_CARTRIDGE_SPENT            4333L
+
+//
+// MessageId: ERROR_UNEXPECTED_OMID
+//
+// MessageText:
+//
+// Unexpected on-medium identifier.
+//
+#define ERROR_UNEXPECTED_OMID                    4334L
+
+//
+// MessageId: ERROR_CANT_DELETE_LAST_ITEM
+//
+// MessageText:
+//
+// The last remaining item in this group or resource cannot be deleted.
+//
+#define ERROR_CANT_DELETE_LAST_ITEM              4335L
+
+//
+// MessageId: ERROR_MESSAGE_EXCEEDS_MAX_SIZE
+//
+// MessageText:
+//
+// The message provided exceeds the maximum size allowed for this parameter.
+//
+#define ERROR_MESSAGE_EXCEEDS_MAX_SIZE           4336L
+
+//
+// MessageId: ERROR_VOLUME_CONTAINS_SYS_FILES
+//
+// MessageText:
+//
+// The volume contains system or paging files.
+//
+#define ERROR_VOLUME_CONTAINS_SYS_FILES          4337L
+
+//
+// MessageId: ERROR_INDIGENOUS_TYPE
+//
+// MessageText:
+//
+// The media type cannot be removed from this library since at least one drive
+// in the library reports it can support this media type.
+//
+#define ERROR_INDIGENOUS_TYPE                    4338L
+
+//
+// MessageId: ERROR_NO_SUPPORTING_DRIVES
+//
+// MessageText:
+//
+// This offline media cannot be mounted on this system since no enabled drives
+// are present which can be used.
+//
+#define ERROR_NO_SUPPORTING_DRIVES               4339L
+
+//
+// MessageId: ERROR_CLEANER_CARTRIDGE_INSTALLED
+//
+// MessageText:
+//
+// A cleaner cartridge is present in the tape library.
+//
+#define ERROR_CLEANER_CARTRIDGE_INSTALLED        4340L
+
+//
+// MessageId: ERROR_IEPORT_FULL
+//
+// MessageText:
+//
+// Cannot use the inject/eject port because it is not empty.
+//
+#define ERROR_IEPORT_FULL                        4341L
+
+//
+// MessageId: ERROR_FILE_OFFLINE
+//
+// MessageText:
+//
+// This file is currently not available for use on this computer.
+//
+#define ERROR_FILE_OFFLINE                       4350L
+
+//
+// MessageId: ERROR_REMOTE_STORAGE_NOT_ACTIVE
+//
+// MessageText:
+//
+// The remote storage service is not operational at this time.
+//
+#define ERROR_REMOTE_STORAGE_NOT_ACTIVE          4351L
+
+//
+// MessageId: ERROR_REMOTE_STORAGE_MEDIA_ERROR
+//
+// MessageText:
+//
+// The remote storage service encountered a media error.
+//
+#define ERROR_REMOTE_STORAGE_MEDIA_ERROR         4352L
+
+//
+// MessageId: ERROR_NOT_A_REPARSE_POINT
+//
+// MessageText:
+//
+// The file or directory is not a reparse point.
+//
+#define ERROR_NOT_A_REPARSE_POINT                4390L
+
+//
+// MessageId: ERROR_REPARSE_ATTRIBUTE_CONFLICT
+//
+// MessageText:
+//
+// The reparse point attribute cannot be set because it conflicts with an
+// existing attribute.
+//
+#define ERROR_REPARSE_ATTRIBUTE_CONFLICT         4391L
+
+//
+// MessageId: ERROR_INVALID_REPARSE_DATA
+//
+// MessageText:
+//
+// The data present in the reparse point buffer is invalid.
+//
+#define ERROR_INVALID_REPARSE_DATA               4392L
+
+//
+// MessageId: ERROR_REPARSE_TAG_INVALID
+//
+// MessageText:
+//
+// The tag present in the reparse point buffer is invalid.
+//
+#define ERROR_REPARSE_TAG_INVALID                4393L
+
+//
+// MessageId: ERROR_REPARSE_TAG_MISMATCH
+//
+// MessageText:
+//
+// There is a mismatch between the tag specified in the request and the tag
+// present in the reparse point.
+//
+#define ERROR_REPARSE_TAG_MISMATCH               4394L
+
+//
+// MessageId: ERROR_REPARSE_POINT_ENCOUNTERED
+//
+// MessageText:
+//
+// The object manager encountered a reparse point while retrieving an object.
+//
+#define ERROR_REPARSE_POINT_ENCOUNTERED          4395L
+
+//
+// MessageId: ERROR_APP_DATA_NOT_FOUND
+//
+// MessageText:
+//
+// Fast Cache data not found.
+//
+#define ERROR_APP_DATA_NOT_FOUND                 4400L
+
+//
+// MessageId: ERROR_APP_DATA_EXPIRED
+//
+// MessageText:
+//
+// Fast Cache data expired.
+//
+#define ERROR_APP_DATA_EXPIRED                   4401L
+
+//
+// MessageId: ERROR_APP_DATA_CORRUPT
+//
+// MessageText:
+//
+// Fast Cache data corrupt.
+//
+#define ERROR_APP_DATA_CORRUPT                   4402L
+
+//
+// MessageId: ERROR_APP_DATA_LIMIT_EXCEEDED
+//
+// MessageText:
+//
+// Fast Cache data has exceeded its max size and cannot be updated.
+//
+#define ERROR_APP_DATA_LIMIT_EXCEEDED            4403L
+
+//
+// MessageId: ERROR_APP_DATA_REBOOT_REQUIRED
+//
+// MessageText:
+//
+// Fast Cache has been ReArmed and requires a reboot until it can be updated.
+//
+#define ERROR_APP_DATA_REBOOT_REQUIRED           4404L
+
+//
+// MessageId: ERROR_SECUREBOOT_ROLLBACK_DETECTED
+//
+// MessageText:
+//
+// Secure Boot detected that rollback of protected data has been attempted.
+//
+#define ERROR_SECUREBOOT_ROLLBACK_DETECTED       4420L
+
+//
+// MessageId: ERROR_SECUREBOOT_POLICY_VIOLATION
+//
+// MessageText:
+//
+// The value is protected by Secure Boot policy and cannot be modified or
+// deleted.
+//
+#define ERROR_SECUREBOOT_POLICY_VIOLATION        4421L
+
+//
+// MessageId: ERROR_SECUREBOOT_INVALID_POLICY
+//
+// MessageText:
+//
+// The Secure Boot policy is invalid.
+//
+#define ERROR_SECUREBOOT_INVALID_POLICY          4422L
+
+//
+// MessageId: ERROR_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND
+//
+// MessageText:
+//
+// A new Secure Boot policy did not contain the current publisher on its update
+// list.
+//
+#define ERROR_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND 4423L
+
+//
+// MessageId: ERROR_SECUREBOOT_POLICY_NOT_SIGNED
+//
+// MessageText:
+//
+// The Secure Boot policy is either not signed or is signed by a non-trusted
+// signer.
+//
+#define ERROR_SECUREBOOT_POLICY_NOT_SIGNED       4424L
+
+//
+// MessageId: ERROR_SECUREBOOT_NOT_ENABLED
+//
+// MessageText:
+//
+// Secure Boot is not enabled on this machine.
+//
+#define ERROR_SECUREBOOT_NOT_ENABLED             4425L
+
+//
+// MessageId: ERROR_SECUREBOOT_FILE_REPLACED
+//
+// MessageText:
+//
+// Secure Boot requires that certain files and drivers are not replaced by
+// other files or drivers.
+//
+#define ERROR_SECUREBOOT_FILE_REPLACED           4426L
+
+#define ERROR_SECUREBOOT_POLICY_NOT_AUTHORIZED   4427L
+
+#define ERROR_SECUREBOOT_POLICY_UNKNOWN          4428L
+
+#define ERROR_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION 4429L
+
+#define ERROR_SECUREBOOT_PLATFORM_ID_MISMATCH    4430L
+
+#define ERROR_SECUREBOOT_POLICY_ROLLBACK_DETECTED 4431L
+
+#define ERROR_SECUREBOOT_POLICY_UPGRADE_MISMATCH 4432L
+
+#define ERROR_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING 4433L
+
+#define ERROR_SECUREBOOT_NOT_BASE_POLICY         4434L
+
+#define ERROR_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY 4435L
+
+//
+// MessageId: ERROR_OFFLOAD_READ_FLT_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The copy offload read operation is not supported by a filter.
+//
+#define ERROR_OFFLOAD_READ_FLT_NOT_SUPPORTED     4440L
+
+//
+// MessageId: ERROR_OFFLOAD_WRITE_FLT_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The copy offload write operation is not supported by a filter.
+//
+#define ERROR_OFFLOAD_WRITE_FLT_NOT_SUPPORTED    4441L
+
+//
+// MessageId: ERROR_OFFLOAD_READ_FILE_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The copy offload read operation is not supported for the file.
+//
+#define ERROR_OFFLOAD_READ_FILE_NOT_SUPPORTED    4442L
+
+//
+// MessageId: ERROR_OFFLOAD_WRITE_FILE_NOT_SUPPORTED
+//
+// MessageText:
+//
+// The copy offload write operation is not supported for the file.
+//
+#define ERROR_OFFLOAD_WRITE_FILE_NOT_SUPPORTED   4443L
+
+//
+// MessageId: ERROR_ALREADY_HAS_STREAM_ID
+//
+// MessageText:
+//
+// This file is currently associated with a different stream id.
+//
+#define ERROR_ALREADY_HAS_STREAM_ID              4444L
+
+//
+// MessageId: ERROR_SMR_GARBAGE_COLLECTION_REQUIRED
+//
+// MessageText:
+//
+// The volume must undergo garbage collection.
+//
+#define ERROR_SMR_GARBAGE_COLLECTION_REQUIRED    4445L
+
+#define ERROR_WOF_WIM_HEADER_CORRUPT             4446L
+
+#define ERROR_WOF_WIM_RESOURCE_TABLE_CORRUPT     4447L
+
+#define ERROR_WOF_FILE_RESOURCE_TABLE_CORRUPT    4448L
+
+//
+// MessageId: ERROR_VOLUME_NOT_SIS_ENABLED
+//
+// MessageText:
+//
+// Single Instance Storage is not available on this volume.
+//
+#define ERROR_VOLUME_NOT_SIS_ENABLED             4500L
+
+//
+// MessageId: ERROR_SYSTEM_INTEGRITY_ROLLBACK_DETECTED
+//
+// MessageText:
+//
+// System Integrity detected that policy rollback has been attempted.
+//
+#define ERROR_SYSTEM_INTEGRITY_ROLLBACK_DETECTED 4550L
+
+//
+// MessageId: ERROR_SYSTEM_INTEGRITY_POLICY_VIOLATION
+//
+// MessageText:
+//
+// Your organization used Device Guard to block this app. Contact your support
+// person for more info.
+//
+#define ERROR_SYSTEM_INTEGRITY_POLICY_VIOLATION  4551L
+
+//
+// MessageId: ERROR_SYSTEM_INTEGRITY_INVALID_POLICY
+//
+// MessageText:
+//
+// The System Integrity policy is invalid.
+//
+#define ERROR_SYSTEM_INTEGRITY_INVALID_POLICY    4552L
+
+//
+// MessageId: ERROR_SYSTEM_INTEGRITY_POLICY_NOT_SIGNED
+//
+// MessageText:
+//
+// The System Integrity policy is either not signed or is signed by a
+// non-trusted signer.
+//
+#define ERROR_SYSTEM_INTEGRITY_POLICY_NOT_SIGNED 4553L
+
+//
+// MessageId: ERROR_SYSTEM_INTEGRITY_TOO_MANY_POLICIES
+//
+// MessageText:
+//
+// The number of System Integrity policies is out of limit.
+//
+#define ERROR_SYSTEM_INTEGRITY_TOO_MANY_POLICIES 4554L
+
+#define ERROR_SYSTEM_INTEGRITY_SUPPLEMENTAL_POLICY_NOT_AUTHORIZED 4555L
+
+//
+// MessageId: ERROR_VSM_NOT_INITIALIZED
+//
+// MessageText:
+//
+// Virtual Secure Mode (VSM) is not initialized. The hypervisor or VSM may not
+// be present or enabled.
+//
+#define ERROR_VSM_NOT_INITIALIZED                4560L
+
+//
+// MessageId: ERROR_VSM_DMA_PROTECTION_NOT_IN_USE
+//
+// MessageText:
+//
+// The hypervisor is not protecting DMA because an IOMMU is not present or not
+// enabled in the BIOS.
+//
+#define ERROR_VSM_DMA_PROTECTION_NOT_IN_USE      4561L
+
+//
+// MessageId: ERROR_PLATFORM_MANIFEST_NOT_AUTHORIZED
+//
+// MessageText:
+//
+// The Platform Manifest file was not authorized on this machine.
+//
+#define ERROR_PLATFORM_MANIFEST_NOT_AUTHORIZED   4570L
+
+//
+// MessageId: ERROR_PLATFORM_MANIFEST_INVALID
+//
+// MessageText:
+//
+// The Platform Manifest file was not valid.
+//
+#define ERROR_PLATFORM_MANIFEST_INVALID          4571L
+
+//
+// MessageId: ERROR_PLATFORM_MANIFEST_FILE_NOT_AUTHORIZED
+//
+// MessageText:
+//
+// The file is not authorized on this platform because an entry was not found
+// in the Platform Manifest.
+//
+#define ERROR_PLATFORM_MANIFEST_FILE_NOT_AUTHORIZED 4572L
+
+//
+// MessageId: ERROR_PLATFORM_MANIFEST_CATALOG_NOT_AUTHORIZED
+//
+// MessageText:
+//
+// The catalog is not authorized on this platform because an entry was not
+// found in the Platform Manifest.
+//
+#define ERROR_PLATFORM_MANIFEST_CATALOG_NOT_AUTHORIZED 4573L
+
+//
+// MessageId: ERROR_PLATFORM_MANIFEST_BINARY_ID_NOT_FOUND
+//
+// MessageText:
+//
+// The file is not authorized on this platform because a Binary ID was not
+// found in the embedded signature.
+//
+#define ERROR_PLATFORM_MANIFEST_BINARY_ID_NOT_FOUND 4574L
+
+//
+// MessageId: ERROR_PLATFORM_MANIFEST_NOT_ACTIVE
+//
+// MessageText:
+//
+// No active Platform Manifest exists on this system.
+//
+#define ERROR_PLATFORM_MANIFEST_NOT_ACTIVE       4575L
+
+//
+// MessageId: ERROR_PLATFORM_MANIFEST_NOT_SIGNED
+//
+// MessageText:
+//
+// The Platform Manifest file was not properly signed.
+//
+#define ERROR_PLATFORM_MANIFEST_NOT_SIGNED       4576L
+
+//
+// MessageId: ERROR_DEPENDENT_RESOURCE_EXISTS
+//
+// MessageText:
+//
+// The cluster resource cannot be moved to another group because other
+// resources are dependent on it.
+//
+#define ERROR_DEPENDENT_RESOURCE_EXISTS          5001L
+
+//
+// MessageId: ERROR_DEPENDENCY_NOT_FOUND
+//
+// MessageText:
+//
+// The cluster resource dependency cannot be found.
+//
+#define ERROR_DEPENDENCY_NOT_FOUND               5002L
+
+//
+// MessageId: ERROR_DEPENDENCY_ALREADY_EXISTS
+//
+// MessageText:
+//
+// The cluster resource cannot be made dependent on the specified resource
+// because it is already dependent.
+//
+#define ERROR_DEPENDENCY_ALREADY_EXISTS          5003L
+
+//
+// MessageId: ERROR_RESOURCE_NOT_ONLINE
+//
+// MessageText:
+//
+// The cluster resource is not online.
+//
+#define ERROR_RESOURCE_NOT_ONLINE                5004L
+
+//
+// MessageId: ERROR_HOST_NODE_NOT_AVAILABLE
+//
+// MessageText:
+//
+// A cluster node is not available for this operation.
+//
+#define ERROR_HOST_NODE_NOT_AVAILABLE            5005L
+
+//
+// MessageId: ERROR_RESOURCE_NOT_AVAILABLE
+//
+// MessageText:
+//
+// The cluster resource is not available.
+//
+#define ERROR_RESOURCE_NOT_AVAILABLE             5006L
+
+//
+// MessageId: ERROR_RESOURCE_NOT_FOUND
+//
+// MessageText:
+//
+// The cluster resource could not be found.
+//
+#define ERROR_RESOURCE_NOT_FOUND                 5007L
+
+//
+// MessageId: ERROR_SHUTDOWN_CLUSTER
+//
+// MessageText:
+//
+// The cluster is being shut down.
+//
+#define ERROR_SHUTDOWN_CLUSTER                   5008L
+
+#define ERROR_CANT_EVICT_ACTIVE_NODE             5009L
+
+//
+// MessageId: ERROR_OBJECT_ALREADY_EXISTS
+//
+// MessageText:
+//
+// The object already exists.
+//
+#define ERROR_OBJECT_ALREADY_EXISTS              5010L
+
+//
+// MessageId: ERROR_OBJECT_IN_LIST
+//
+// MessageText:
+//
+// The object is already in the list.
+//
+#define ERROR_OBJECT_IN_LIST                     5011L
+
+//
+// MessageId: ERROR_GROUP_NOT_AVAILABLE
+//
+// MessageText:
+//
+// The cluster group is not available for any new requests.
+//
+#define ERROR_GROUP_NOT_AVAILABLE                5012L
+
+//
+// MessageId: ERROR_GROUP_NOT_FOUND
+//
+// MessageText:
+//
+// The cluster group could not be found.
+//
+#define ERROR_GROUP_NOT_FOUND                    5013L
+
+//
+// MessageId: ERROR_GROUP_NOT_ONLINE
+//
+// MessageText:
+//
+// The operation could not be completed because the cluster group is not
+// online.
+//
+#define ERROR_GROUP_NOT_ONLINE                   5014L
+
+#define ERROR_HOST_NODE_NOT_RESOURCE_OWNER       5015L
+
+#define ERROR_HOST_NODE_NOT_GROUP_OWNER          5016L
+
+#define ERROR_RESMON_CREATE_FAILED               5017L
+
+#define ERROR_RESMON_ONLINE_FAILED               5018L
+
+#define ERROR_RESOURCE_ONLINE                    5019L
+
+#define ERROR_QUORUM_RESOURCE                    5020L
+
+#define ERROR_NOT_QUORUM_CAPABLE                 5021L
+
+#define ERROR_CLUSTER_SHUTTING_DOWN              5022L
+
+//
+// MessageId: ERROR_INVALID_STATE
+//
+// MessageText:
+//
+// The group or resource is not in the correct state to perform the requested
+// operation.
+//
+#define ERROR_INVALID_STATE                      5023L
+
+#define ERROR_RESOURCE_PROPERTIES_STORED         5024L
+
+#define ERROR_NOT_QUORUM_CLASS                   5025L
+
+#define ERROR_CORE_RESOURCE                      5026L
+
+#define ERROR_QUORUM_RESOURCE_ONLINE_FAILED      5027L
+
+#define ERROR_QUORUMLOG_OPEN_FAILED              5028L
+
+#define ERROR_CLUSTERLOG_CORRUPT                 5029L
+
+#define ERROR_CLUSTERLOG_RECORD_EXCEEDS_MAXSIZE  5030L
+
+#define ERROR_CLUSTERLOG_EXCEEDS_MAXSIZE         5031L
+
+#define ERROR_CLUSTERLOG_CHKPOINT_NOT_FOUND      5032L
+
+#define ERROR_CLUSTERLOG_NOT_ENOUGH_SPACE        5033L
+
+#define ERROR_QUORUM_OWNER_ALIVE                 5034L
+
+#define ERROR_NETWORK_NOT_AVAILABLE              5035L
+
+#define ERROR_NODE_NOT_AVAILABLE                 5036L
+
+#define ERROR_ALL_NODES_NOT_AVAILABLE            5037L
+
+//
+// MessageId: ERROR_RESOURCE_FAILED
+//
+// MessageText:
+//
+// The resource failed.
+//
+#define ERROR_RESOURCE_FAILED                    5038L
+
+#define ERROR_CLUSTER_INVALID_NODE               5039L
+
+#define ERROR_CLUSTER_NODE_EXISTS                5040L
+
+#define ERROR_CLUSTER_JOIN_IN_PROGRESS           5041L
+
+#define ERROR_CLUSTER_NODE_NOT_FOUND             5042L
+
+#define ERROR_CLUSTER_LOCAL_NODE_NOT_FOUND       5043L
+
+#define ERROR_CLUSTER_NETWORK_EXISTS             5044L
+
+#define ERROR_CLUSTER_NETWORK_NOT_FOUND          5045L
+
+#define ERROR_CLUSTER_NETINTERFACE_EXISTS        5046L
+
+#define ERROR_CLUSTER_NETINTERFACE_NOT_FOUND     5047L
+
+#define ERROR_CLUSTER_INVALID_REQUEST            5048L
+
+#define ERROR_CLUSTER_INVALID_NETWORK_PROVIDER   5049L
+
+#define ERROR_CLUSTER_NODE_DOWN                  5050L
+
+#define ERROR_CLUSTER_NODE_UNREACHABLE           5051L
+
+#define ERROR_CLUSTER_NODE_NOT_MEMBER            5052L
+
+#define ERROR_CLUSTER_JOIN_NOT_IN_PROGRESS       5053L
+
+#define ERROR_CLUSTER_INVALID_NETWORK            5054L
+
+#define ERROR_CLUSTER_NODE_UP                    5056L
+
+#define ERROR_CLUSTER_IPADDR_IN_USE              5057L
+
+#define ERROR_CLUSTER_NODE_NOT_PAUSED            5058L
+
+#define ERROR_CLUSTER_NO_SECURITY_CONTEXT        5059L
+
+#define ERROR_CLUSTER_NETWORK_NOT_INTERNAL       5060L
+
+#define ERROR_CLUSTER_NODE_ALREADY_UP            5061L
+
+#define ERROR_CLUSTER_NODE_ALREADY_DOWN          5062L
+
+#define ERROR_CLUSTER_NETWORK_ALREADY_ONLINE     5063L
+
+#define ERROR_CLUSTER_NETWORK_ALREADY_OFFLINE    5064L
+
+#define ERROR_CLUSTER_NODE_ALREADY_MEMBER        5065L
+
+#define ERROR_CLUSTER_LAST_INTERNAL_NETWORK      5066L
+
+#define ERROR_CLUSTER_NETWORK_HAS_DEPENDENTS     5067L
+
+#define ERROR_INVALID_OPERATION_ON_QUORUM        5068L
+
+#define ERROR_DEPENDENCY_NOT_ALLOWED             5069L
+
+#define ERROR_CLUSTER_NODE_PAUSED                5070L
+
+#define ERROR_NODE_CANT_HOST_RESOURCE            5071L
+
+#define ERROR_CLUSTER_NODE_NOT_READY             5072L
+
+#define ERROR_CLUSTER_NODE_SHUTTING_DOWN         5073L
+
+#define ERROR_CLUSTER_JOIN_ABORTED               5074L
+
+#define ERROR_CLUSTER_INCOMPATIBLE_VERSIONS      5075L
+
+#define ERROR_CLUSTER_MAXNUM_OF_RESOURCES_EXCEEDED 5076L
+
+#define ERROR_CLUSTER_SYSTEM_CONFIG_CHANGED      5077L
+
+#define ERROR_CLUSTER_RESOURCE_TYPE_NOT_FOUND    5078L
+
+#define ERROR_CLUSTER_RESTYPE_NOT_SUPPORTED      5079L
+
+#define ERROR_CLUSTER_RESNAME_NOT_FOUND          5080L
+
+#define ERROR_CLUSTER_NO_RPC_PACKAGES_REGISTERED 5081L
+
+#define ERROR_CLUSTER_OWNER_NOT_IN_PREFLIST      5082L
+
+#define ERROR_CLUSTER_DATABASE_SEQMISMATCH       5083L
+
+#define ERROR_RESMON_INVALID_STATE               5084L
+
+#define ERROR_CLUSTER_GUM_NOT_LOCKER             5085L
+
+#define ERROR_QUORUM_DISK_NOT_FOUND              5086L
+
+#define ERROR_DATABASE_BACKUP_CORRUPT            5087L
+
+#define ERROR_CLUSTER_NODE_ALREADY_HAS_DFS_ROOT  5088L
+
+#define ERROR_RESOURCE_PROPERTY_UNCHANGEABLE     5089L
+
+#define ERROR_NO_ADMIN_ACCESS_POINT              5090L
+
+#define ERROR_CLUSTER_MEMBERSHIP_INVALID_STATE   5890L
+
+#define ERROR_CLUSTER_QUORUMLOG_NOT_FOUND        5891L
+
+#define ERROR_CLUSTER_MEMBERSHIP_HALT            5892L
+
+#define ERROR_CLUSTER_INSTANCE_ID_MISMATCH       5893L
+
+#define ERROR_CLUSTER_NETWORK_NOT_FOUND_FOR_IP   5894L
+
+#define ERROR_CLUSTER_PROPERTY_DATA_TYPE_MISMATCH 5895L
+
+#define ERROR_CLUSTER_EVICT_WITHOUT_CLEANUP      5896L
+
+#define ERROR_CLUSTER_PARAMETER_MISMATCH         5897L
+
+#define ERROR_NODE_CANNOT_BE_CLUSTERED           5898L
+
+#define ERROR_CLUSTER_WRONG_OS_VERSION           5899L
+
+#define ERROR_CLUSTER_CANT_CREATE_DUP_CLUSTER_NAME 5900L
+
+#define ERROR_CLUSCFG_ALREADY_COMMITTED          5901L
+
+#define ERROR_CLUSCFG_ROLLBACK_FAILED            5902L
+
+#define ERROR_CLUSCFG_SYSTEM_DISK_DRIVE_LETTER_CONFLICT 5903L
+
+#define ERROR_CLUSTER_OLD_VERSION                5904L
+
+#define ERROR_CLUSTER_MISMATCHED_COMPUTER_ACCT_NAME 5905L
+
+#define ERROR_CLUSTER_NO_NET_ADAPTERS            5906L
+
+#define ERROR_CLUSTER_POISONED                   5907L
+
+#define ERROR_CLUSTER_GROUP_MOVING               5908L
+
+#define ERROR_CLUSTER_RESOURCE_TYPE_BUSY         5909L
+
+//
+// MessageId: ERROR_RESOURCE_CALL_TIMED_OUT
+//
+// MessageText:
+//
+// The call to a cluster resource DLL timed out.
+//
+#define ERROR_RESOURCE_CALL_TIMED_OUT            5910L
+
+#define ERROR_INVALID_CLUSTER_IPV6_ADDRESS       5911L
+
+#define ERROR_CLUSTER_INTERNAL_INVALID_FUNCTION  5912L
+
+#define ERROR_CLUSTER_PARAMETER_OUT_OF_BOUNDS    5913L
+
+#define ERROR_CLUSTER_PARTIAL_SEND               5914L
+
+#define ERROR_CLUSTER_REGISTRY_INVALID_FUNCTION  5915L
+
+#define ERROR_CLUSTER_INVALID_STRING_TERMINATION 5916L
+
+#define ERROR_CLUSTER_INVALID_STRING_FORMAT      5917L
+
+#define ERROR_CLUSTER_DATABASE_TRANSACTION_IN_PROGRESS 5918L
+
+#define ERROR_CLUSTER_DATABASE_TRANSACTION_NOT_IN_PROGRESS 5919L
+
+#define ERROR_CLUSTER_NULL_DATA                  5920L
+
+#define ERROR_CLUSTER_PARTIAL_READ               5921L
+
+#define ERROR_CLUSTER_PARTIAL_WRITE              5922L
+
+#define ERROR_CLUSTER_CANT_DESERIALIZE_DATA      5923L
+
+#define ERROR_DEPENDENT_RESOURCE_PROPERTY_CONFLICT 5924L
+
+#define ERROR_CLUSTER_NO_QUORUM                  5925L
+
+#define ERROR_CLUSTER_INVALID_IPV6_NETWORK       5926L
+
+#define ERROR_CLUSTER_INVALID_IPV6_TUNNEL_NETWORK 5927L
+
+#define ERROR_QUORUM_NOT_ALLOWED_IN_THIS_GROUP   5928L
+
+#define ERROR_DEPENDENCY_TREE_TOO_COMPLEX        5929L
+
+#define ERROR_EXCEPTION_IN_RESOURCE_CALL         5930L
+
+#define ERROR_CLUSTER_RHS_FAILED_INITIALIZATION  5931L
+
+#define ERROR_CLUSTER_NOT_INSTALLED              5932L
+
+#define ERROR_CLUSTER_RESOURCES_MUST_BE_ONLINE_ON_THE_SAME_NODE 5933L
+
+#define ERROR_CLUSTER_MAX_NODES_IN_CLUSTER       5934L
+
+#define ERROR_CLUSTER_TOO_MANY_NODES             5935L
+
+#define ERROR_CLUSTER_OBJECT_ALREADY_USED        5936L
+
+#define ERROR_NONCORE_GROUPS_FOUND               5937L
+
+#define ERROR_FILE_SHARE_RESOURCE_CONFLICT       5938L
+
+#define ERROR_CLUSTER_EVICT_INVALID_REQUEST      5939L
+
+#define ERROR_CLUSTER_SINGLETON_RESOURCE         5940L
+
+#define ERROR_CLUSTER_GROUP_SINGLETON_RESOURCE   5941L
+
+#define ERROR_CLUSTER_RESOURCE_PROVIDER_FAILED   5942L
+
+#define ERROR_CLUSTER_RESOURCE_CONFIGURATION_ERROR 5943L
+
+#define ERROR_CLUSTER_GROUP_BUSY                 5944L
+
+#define ERROR_CLUSTER_NOT_SHARED_VOLUME          5945L
+
+#define ERROR_CLUSTER_INVALID_SECURITY_DESCRIPTOR 5946L
+
+#define ERROR_CLUSTER_SHARED_VOLUMES_IN_USE      5947L
+
+#define ERROR_CLUSTER_USE_SHARED_VOLUMES_API     5948L
+
+#define ERROR_CLUSTER_BACKUP_IN_PROGRESS         5949L
+
+#define ERROR_NON_CSV_PATH                       5950L
+
+#define ERROR_CSV_VOLUME_NOT_LOCAL               5951L
+
+#define ERROR_CLUSTER_WATCHDOG_TERMINATING       5952L
+
+#define ERROR_CLUSTER_RESOURCE_VETOED_MOVE_INCOMPATIBLE_NODES 5953L
+
+#define ERROR_CLUSTER_INVALID_NODE_WEIGHT        5954L
+
+#define ERROR_CLUSTER_RESOURCE_VETOED_CALL       5955L
+
+#define ERROR_RESMON_SYSTEM_RESOURCES_LACKING    5956L
+
+#define ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_DESTINATION 5957L
+
+#define ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_SOURCE 5958L
+
+#define ERROR_CLUSTER_GROUP_QUEUED               5959L
+
+#define ERROR_CLUSTER_RESOURCE_LOCKED_STATUS     5960L
+
+#define ERROR_CLUSTER_SHARED_VOLUME_FAILOVER_NOT_ALLOWED 5961L
+
+#define ERROR_CLUSTER_NODE_DRAIN_IN_PROGRESS     5962L
+
+#define ERROR_CLUSTER_DISK_NOT_CONNECTED         5963L
+
+#define ERROR_DISK_NOT_CSV_CAPABLE               5964L
+
+#define ERROR_RESOURCE_NOT_IN_AVAILABLE_STORAGE  5965L
+
+#define ERROR_CLUSTER_SHARED_VOLUME_REDIRECTED   5966L
+
+#define ERROR_CLUSTER_SHARED_VOLUME_NOT_REDIRECTED 5967L
+
+#define ERROR_CLUSTER_CANNOT_RETURN_PROPERTIES   5968L
+
+#define ERROR_CLUSTER_RESOURCE_CONTAINS_UNSUPPORTED_DIFF_AREA_FOR_SHARED_VOLUMES 5969L
+
+#define ERROR_CLUSTER_RESOURCE_IS_IN_MAINTENANCE_MODE 5970L
+
+#define ERROR_CLUSTER_AFFINITY_CONFLICT          5971L
+
+#define ERROR_CLUSTER_RESOURCE_IS_REPLICA_VIRTUAL_MACHINE 5972L
+
+#define ERROR_CLUSTER_UPGRADE_INCOMPATIBLE_VERSIONS 5973L
+
+#define ERROR_CLUSTER_UPGRADE_FIX_QUORUM_NOT_SUPPORTED 5974L
+
+#define ERROR_CLUSTER_UPGRADE_RESTART_REQUIRED   5975L
+
+#define ERROR_CLUSTER_UPGRADE_IN_PROGRESS        5976L
+
+#define ERROR_CLUSTER_UPGRADE_INCOMPLETE         5977L
+
+#define ERROR_CLUSTER_NODE_IN_GRACE_PERIOD       5978L
+
+#define ERROR_CLUSTER_CSV_IO_PAUSE_TIMEOUT       5979L
+
+#define ERROR_NODE_NOT_ACTIVE_CLUSTER_MEMBER     5980L
+
+#define ERROR_CLUSTER_RESOURCE_NOT_MONITORED     5981L
+
+#define ERROR_CLUSTER_RESOURCE_DOES_NOT_SUPPORT_UNMONITORED 5982L
+
+#define ERROR_CLUSTER_RESOURCE_IS_REPLICATED     5983L
+
+#define ERROR_CLUSTER_NODE_ISOLATED              5984L
+
+#define ERROR_CLUSTER_NODE_QUARANTINED           5985L
+
+#define ERROR_CLUSTER_DATABASE_UPDATE_CONDITION_FAILED 5986L
+
+#define ERROR_CLUSTER_SPACE_DEGRADED             5987L
+
+#define ERROR_CLUSTER_TOKEN_DELEGATION_NOT_SUPPORTED 5988L
+
+#define ERROR_CLUSTER_CSV_INVALID_HANDLE         5989L
+
+#define ERROR_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR 5990L
+
+#define ERROR_GROUPSET_NOT_AVAILABLE             5991L
+
+#define ERROR_GROUPSET_NOT_FOUND                 5992L
+
+#define ERROR_GROUPSET_CANT_PROVIDE              5993L
+
+#define ERROR_CLUSTER_FAULT_DOMAIN_PARENT_NOT_FOUND 5994L
+
+#define ERROR_CLUSTER_FAULT_DOMAIN_INVALID_HIERARCHY 5995L
+
+#define ERROR_CLUSTER_FAULT_DOMAIN_FAILED_S2D_VALIDATION 5996L
+
+#define ERROR_CLUSTER_FAULT_DOMAIN_S2D_CONNECTIVITY_LOSS 5997L
+
+#define ERROR_CLUSTER_INVALID_INFRASTRUCTURE_FILESERVER_NAME 5998L
+
+#define ERROR_CLUSTERSET_MANAGEMENT_CLUSTER_UNREACHABLE 5999L
+
+//
+// MessageId: ERROR_ENCRYPTION_FAILED
+//
+// MessageText:
+//
+// The specified file could not be encrypted.
+//
+#define ERROR_ENCRYPTION_FAILED                  6000L
+
+//
+// MessageId: ERROR_DECRYPTION_FAILED
+//
+// MessageText:
+//
+// The specified file could not be decrypted.
+//
+#define ERROR_DECRYPTION_FAILED                  6001L
+
+//
+// MessageId: ERROR_FILE_ENCRYPTED
+//
+// MessageText:
+//
+// The specified file is encrypted and the user does not have the ability to
+// decrypt it.
+//
+#define ERROR_FILE_ENCRYPTED                     6002L
+
+//
+// MessageId: ERROR_NO_RECOVERY_POLICY
+//
+// MessageText:
+//
+// There is no valid encryption recovery policy configured for this system.
+//
+#define ERROR_NO_RECOVERY_POLICY                 6003L
+
+//
+// MessageId: ERROR_NO_EFS
+//
+// MessageText:
+//
+// The required encryption driver is not loaded for this system.
+//
+#define ERROR_NO_EFS                             6004L
+
+//
+// MessageId: ERROR_WRONG_EFS
+//
+// MessageText:
+//
+// The file was encrypted with a different encryption driver than is currently
+// loaded.
+//
+#define ERROR_WRONG_EFS                          6005L
+
+//
+// MessageId: ERROR_NO_USER_KEYS
+//
+// MessageText:
+//
+// There are no EFS keys defined for the user.
+//
+#define ERROR_NO_USER_KEYS                       6006L
+
+//
+// MessageId: ERROR_FILE_NOT_ENCRYPTED
+//
+// MessageText:
+//
+// The specified file is not encrypted.
+//
+#define ERROR_FILE_NOT_ENCRYPTED                 6007L
+
+//
+// MessageId: ERROR_NOT_EXPORT_FORMAT
+//
+// MessageText:
+//
+// The specified file is not in the defined EFS export format.
+//
+#define ERROR_NOT_EXPORT_FORMAT                  6008L
+
+//
+// MessageId: ERROR_FILE_READ_ONLY
+//
+// MessageText:
+//
+// The specified file is read only.
+//
+#define ERROR_FILE_READ_ONLY                     6009L
+
+//
+// MessageId: ERROR_DIR_EFS_DISALLOWED
+//
+// MessageText:
+//
+// The directory has been disabled for encryption.
+//
+#define ERROR_DIR_EFS_DISALLOWED                 6010L
+
+//
+// MessageId: ERROR_EFS_SERVER_NOT_TRUSTED
+//
+// MessageText:
+//
+// The server is not trusted for remote encryption operation.
+//
+#define ERROR_EFS_SERVER_NOT_TRUSTED             6011L
+
+//
+// MessageId: ERROR_BAD_RECOVERY_POLICY
+//
+// MessageText:
+//
+// Recovery policy configured for this system contains invalid recovery
+// certificate.
+//
+#define ERROR_BAD_RECOVERY_POLICY                6012L
+
+//
+// MessageId: ERROR_EFS_ALG_BLOB_TOO_BIG
+//
+// MessageText:
+//
+// The encryption algorithm used on the source file needs a bigger key buffer
+// than the one on the destination file.
+//
+#define ERROR_EFS_ALG_BLOB_TOO_BIG               6013L
+
+//
+// MessageId: ERROR_VOLUME_NOT_SUPPORT_EFS
+//
+// MessageText:
+//
+// The disk partition does not support file encryption.
+//
+#define ERROR_VOLUME_NOT_SUPPORT_EFS             6014L
+
+//
+// MessageId: ERROR_EFS_DISABLED
+//
+// MessageText:
+//
+// This machine is disabled for file encryption.
+//
+#define ERROR_EFS_DISABLED                       6015L
+
+//
+// MessageId: ERROR_EFS_VERSION_NOT_SUPPORT
+//
+// MessageText:
+//
+// A newer system is required to decrypt this encrypted file.
+//
+#define ERROR_EFS_VERSION_NOT_SUPPORT            6016L
+
+#define ERROR_CS_ENCRYPTION_INVALID_SERVER_RESPONSE 6017L
+
+#define ERROR_CS_ENCRYPTION_UNSUPPORTED_SERVER   6018L
+
+#define ERROR_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE 6019L
+
+#define ERROR_CS_ENCRYPTION_NEW_ENCRYPTED_FILE   6020L
+
+#define ERROR_CS_ENCRYPTION_FILE_NOT_CSE         6021L
+
+#define ERROR_ENCRYPTION_POLICY_DENIES_OPERATION 6022L
+
+//
+// MessageId: ERROR_WIP_ENCRYPTION_FAILED
+//
+// MessageText:
+//
+// The specified file could not be encrypted with Windows Information
+// Protection.
+//
+#define ERROR_WIP_ENCRYPTION_FAILED              6023L
+
+//
+// MessageId: ERROR_NO_BROWSER_SERVERS_FOUND
+//
+// MessageText:
+//
+// The list of servers for this workgroup is not currently available.
+//
+#define ERROR_NO_BROWSER_SERVERS_FOUND           6118L
+
+#define ERROR_LOG_SECTOR_INVALID                 6600L
+
+#define ERROR_LOG_SECTOR_PARITY_INVALID          6601L
+
+#define ERROR_LOG_SECTOR_REMAPPED                6602L
+
+#define ERROR_LOG_BLOCK_INCOMPLETE               6603L
+
+#define ERROR_LOG_INVALID_RANGE                  6604L
+
+#define ERROR_LOG_BLOCKS_EXHAUSTED               6605L
+
+#define ERROR_LOG_READ_CONTEXT_INVALID           6606L
+
+#define ERROR_LOG_RESTART_INVALID                6607L
+
+#define ERROR_LOG_BLOCK_VERSION                  6608L
+
+#define ERROR_LOG_BLOCK_INVALID                  6609L
+
+#define ERROR_LOG_READ_MODE_INVALID              6610L
+
+#define ERROR_LOG_NO_RESTART                     6611L
+
+#define ERROR_LOG_METADATA_CORRUPT               6612L
+
+#define ERROR_LOG_METADATA_INVALID               6613L
+
+#define ERROR_LOG_METADATA_INCONSISTENT          6614L
+
+#define ERROR_LOG_RESERVATION_INVALID            6615L
+
+#define ERROR_LOG_CANT_DELETE                    6616L
+
+#define ERROR_LOG_CONTAINER_LIMIT_EXCEEDED       6617L
+
+#define ERROR_LOG_START_OF_LOG                   6618L
+
+#define ERROR_LOG_POLICY_ALREADY_INSTALLED       6619L
+
+#define ERROR_LOG_POLICY_NOT_INSTALLED           6620L
+
+#define ERROR_LOG_POLICY_INVALID                 6621L
+
+#define ERROR_LOG_POLICY_CONFLICT                6622L
+
+#define ERROR_LOG_PINNED_ARCHIVE_TAIL            6623L
+
+#define ERROR_LOG_RECORD_NONEXISTENT             6624L
+
+#define ERROR_LOG_RECORDS_RESERVED_INVALID       6625L
+
+#define ERROR_LOG_SPACE_RESERVED_INVALID         6626L
+
+#define ERROR_LOG_TAIL_INVALID                   6627L
+
+//
+// MessageId: ERROR_LOG_FULL
+//
+// MessageText:
+//
+// Log space is exhausted.
+//
+#define ERROR_LOG_FULL                           6628L
+
+#define ERROR_COULD_NOT_RESIZE_LOG               6629L
+
+#define ERROR_LOG_MULTIPLEXED                    6630L
+
+#define ERROR_LOG_DEDICATED                      6631L
+
+#define ERROR_LOG_ARCHIVE_NOT_IN_PROGRESS        6632L
+
+#define ERROR_LOG_ARCHIVE_IN_PROGRESS            6633L
+
+#define ERROR_LOG_EPHEMERAL                      6634L
+
+#define ERROR_LOG_NOT_ENOUGH_CONTAINERS          6635L
+
+#define ERROR_LOG_CLIENT_ALREADY_REGISTERED      6636L
+
+#define ERROR_LOG_CLIENT_NOT_REGISTERED          6637L
+
+#define ERROR_LOG_FULL_HANDLER_IN_PROGRESS       6638L
+
+#define ERROR_LOG_CONTAINER_READ_FAILED          6639L
+
+#define ERROR_LOG_CONTAINER_WRITE_FAILED         6640L
+
+#define ERROR_LOG_CONTAINER_OPEN_FAILED          6641L
+
+#define ERROR_LOG_CONTAINER_STATE_INVALID        6642L
+
+#define ERROR_LOG_STATE_INVALID                  6643L
+
+#define ERROR_LOG_PINNED                         6644L
+
+#define ERROR_LOG_METADATA_FLUSH_FAILED          6645L
+
+#define ERROR_LOG_INCONSISTENT_SECURITY          6646L
+
+#define ERROR_LOG_APPENDED_FLUSH_FAILED          6647L
+
+#define ERROR_LOG_PINNED_RESERVATION             6648L
+
+//
+// MessageId: ERROR_INVALID_TRANSACTION
+//
+// MessageText:
+//
+// The transaction handle associated with this operation is not valid.
+//
+#define ERROR_INVALID_TRANSACTION                6700L
+
+//
+// MessageId: ERROR_TRANSACTION_NOT_ACTIVE
+//
+// MessageText:
+//
+// The requested operation was made in the context of a transaction that is no
+// longer active.
+//
+#define ERROR_TRANSACTION_NOT_ACTIVE             6701L
+
+//
+// MessageId: ERROR_TRANSACTION_REQUEST_NOT_VALID
+//
+// MessageText:
+//
+// The requested operation is not valid on the Transaction object in its
+// current state.
+//
+#define ERROR_TRANSACTION_REQUEST_NOT_VALID      6702L
+
+//
+// MessageId: ERROR_TRANSACTION_NOT_REQUESTED
+//
+// MessageText:
+//
+// The caller has called a response API, but the response is not expected
+// because the TM did not issue the corresponding request to the caller.
+//
+#define ERROR_TRANSACTION_NOT_REQUESTED          6703L
+
+//
+// MessageId: ERROR_TRANSACTION_ALREADY_ABORTED
+//
+// MessageText:
+//
+// It is too late to perform the requested operation, since the Transaction has
+// already been aborted.
+//
+#define ERROR_TRANSACTION_ALREADY_ABORTED        6704L
+
+//
+// MessageId: ERROR_TRANSACTION_ALREADY_COMMITTED
+//
+// MessageText:
+//
+// It is too late to perform the requested operation, since the Transaction has
+// already been committed.
+//
+#define ERROR_TRANSACTION_ALREADY_COMMITTED      6705L
+
+//
+// MessageId: ERROR_TM_INITIALIZATION_FAILED
+//
+// MessageText:
+//
+// The Transaction Manager was unable to be successfully initialized.
+// Transacted operations are not supported.
+//
+#define ERROR_TM_INITIALIZATION_FAILED           6706L
+
+//
+// MessageId: ERROR_RESOURCEMANAGER_READ_ONLY
+//
+// MessageText:
+//
+// The specified ResourceManager made no changes or updates to the resource
+// under this transaction.
+//
+#define ERROR_RESOURCEMANAGER_READ_ONLY          6707L
+
+//
+// MessageId: ERROR_TRANSACTION_NOT_JOINED
+//
+// MessageText:
+//
+// The resource manager has attempted to prepare a transaction that it has not
+// successfully joined.
+//
+#define ERROR_TRANSACTION_NOT_JOINED             6708L
+
+#define ERROR_TRANSACTION_SUPERIOR_EXISTS        6709L
+
+#define ERROR_CRM_PROTOCOL_ALREADY_EXISTS        6710L
+
+#define ERROR_TRANSACTION_PROPAGATION_FAILED     6711L
+
+#define ERROR_CRM_PROTOCOL_NOT_FOUND             6712L
+
+#define ERROR_TRANSACTION_INVALID_MARSHALL_BUFFER 6713L
+
+//
+// MessageId: ERROR_CURRENT_TRANSACTION_NOT_VALID
+//
+// MessageText:
+//
+// The current transaction context associated with the thread is not a valid
+// handle to a transaction object.
+//
+#define ERROR_CURRENT_TRANSACTION_NOT_VALID      6714L
+
+//
+// MessageId: ERROR_TRANSACTION_NOT_FOUND
+//
+// MessageText:
+//
+// The specified Transaction object could not be opened, because it was not
+// found.
+//
+#define ERROR_TRANSACTION_NOT_FOUND              6715L
+
+//
+// MessageId: ERROR_RESOURCEMANAGER_NOT_FOUND
+//
+// MessageText:
+//
+// The specified ResourceManager object could not be opened, because it was not
+// found.
+//
+#define ERROR_RESOURCEMANAGER_NOT_FOUND          6716L
+
+//
+// MessageId: ERROR_ENLISTMENT_NOT_FOUND
+//
+// MessageText:
+//
+// The specified Enlistment object could not be opened, because it was not
+// found.
+//
+#define ERROR_ENLISTMENT_NOT_FOUND               6717L
+
+//
+// MessageId: ERROR_TRANSACTIONMANAGER_NOT_FOUND
+//
+// MessageText:
+//
+// The specified TransactionManager object could not be opened, because it was
+// not found.
+//
+#define ERROR_TRANSACTIONMANAGER_NOT_FOUND       6718L
+
+//
+// MessageId: ERROR_TRANSACTIONMANAGER_NOT_ONLINE
+//
+// MessageText:
+//
+// The object specified could not be created or opened, because its associated
+// TransactionManager is not online. The TransactionManager must be brought
+// fully Online by calling RecoverTransactionManager to recover to the end of
+// its LogFile before objects in its Transaction or ResourceManager namespaces
+// can be opened. In addition, errors in writing records to its LogFile can
+// cause a TransactionManager to go offline.
+//
+#define ERROR_TRANSACTIONMANAGER_NOT_ONLINE      6719L
+
+#define ERROR_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION 6720L
+
+#define ERROR_TRANSACTION_NOT_ROOT               6721L
+
+#define ERROR_TRANSACTION_OBJECT_EXPIRED         6722L
+
+#define ERROR_TRANSACTION_RESPONSE_NOT_ENLISTED  6723L
+
+#define ERROR_TRANSACTION_RECORD_TOO_LONG        6724L
+
+#define ERROR_IMPLICIT_TRANSACTION_NOT_SUPPORTED 6725L
+
+#define ERROR_TRANSACTION_INTEGRITY_VIOLATED     6726L
+
+#define ERROR_TRANSACTIONMANAGER_IDENTITY_MISMATCH 6727L
+
+#define ERROR_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT   6728L
+
+#define ERROR_TRANSACTION_MUST_WRITETHROUGH      6729L
+
+#define ERROR_TRANSACTION_NO_SUPERIOR            6730L
+
+#define ERROR_HEURISTIC_DAMAGE_POSSIBLE          6731L
+
+//
+// MessageId: ERROR_TRANSACTIONAL_CONFLICT
+//
+// MessageText:
+//
+// The function attempted to use a name that is reserved for use by another
+// transaction.
+//
+#define ERROR_TRANSACTIONAL_CONFLICT             6800L
+
+//
+// MessageId: ERROR_RM_NOT_ACTIVE
+//
+// MessageText:
+//
+// Transaction support within the specified resource manager is not started or
+// was shut down due to an error.
+//
+#define ERROR_RM_NOT_ACTIVE                      6801L
+
+//
+// MessageId: ERROR_RM_METADATA_CORRUPT
+//
+// MessageText:
+//
+// The metadata of the RM has been corrupted. The RM will not function.
+//
+#define ERROR_RM_METADATA_CORRUPT                6802L
+
+//
+// MessageId: ERROR_DIRECTORY_NOT_RM
+//
+// MessageText:
+//
+// The specified directory does not contain a resource manager.
+//
+#define ERROR_DIRECTORY_NOT_RM                   6803L
+
+//
+// MessageId: ERROR_TRANSACTIONS_UNSUPPORTED_REMOTE
+//
+// MessageText:
+//
+// The remote server or share does not support transacted file operations.
+//
+#define ERROR_TRANSACTIONS_UNSUPPORTED_REMOTE    6805L
+
+//
+// MessageId: ERROR_LOG_RESIZE_INVALID_SIZE
+//
+// MessageText:
+//
+// The requested log size is invalid.
+//
+#define ERROR_LOG_RESIZE_INVALID_SIZE            6806L
+
+//
+// MessageId: ERROR_OBJECT_NO_LONGER_EXISTS
+//
+// MessageText:
+//
+// The object (file, stream, link) corresponding to the handle has been deleted
+// by a Transaction Savepoint Rollback.
+//
+#define ERROR_OBJECT_NO_LONGER_EXISTS            6807L
+
+#define ERROR_STREAM_MINIVERSION_NOT_FOUND       6808L
+
+#define ERROR_STREAM_MINIVERSION_NOT_VALID       6809L
+
+#define ERROR_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION 6810L
+
+#define ERROR_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT 6811L
+
+#define ERROR_CANT_CREATE_MORE_STREAM_MINIVERSIONS 6812L
+
+#define ERROR_REMOTE_FILE_VERSION_MISMATCH       6814L
+
+//
+// MessageId: ERROR_HANDLE_NO_LONGER_VALID
+//
+// MessageText:
+//
+// The handle has been invalidated by a transaction. The most likely cause is
+// the presence of memory mapping on a file or an open handle when the
+// transaction ended or rolled back to savepoint.
+//
+#define ERROR_HANDLE_NO_LONGER_VALID             6815L
+
+#define ERROR_NO_TXF_METADATA                    6816L
+
+#define ERROR_LOG_CORRUPTION_DETECTED            6817L
+
+#define ERROR_CANT_RECOVER_WITH_HANDLE_OPEN      6818L
+
+#define ERROR_RM_DISCONNECTED                    6819L
+
+#define ERROR_ENLISTMENT_NOT_SUPERIOR            6820L
+
+#define ERROR_RECOVERY_NOT_NEEDED                6821L
+
+#define ERROR_RM_ALREADY_STARTED                 6822L
+
+#define ERROR_FILE_IDENTITY_NOT_PERSISTENT       6823L
+
+#define ERROR_CANT_BREAK_TRANSACTIONAL_DEPENDENCY 6824L
+
+#define ERROR_CANT_CROSS_RM_BOUNDARY             6825L
+
+#define ERROR_TXF_DIR_NOT_EMPTY                  6826L
+
+#define ERROR_INDOUBT_TRANSACTIONS_EXIST         6827L
+
+#define ERROR_TM_VOLATILE                        6828L
+
+#define ERROR_ROLLBACK_TIMER_EXPIRED             6829L
+
+#define ERROR_TXF_ATTRIBUTE_CORRUPT              6830L
+
+#define ERROR_EFS_NOT_ALLOWED_IN_TRANSACTION     6831L
+
+#define ERROR_TRANSACTIONAL_OPEN_NOT_ALLOWED     6832L
+
+#define ERROR_LOG_GROWTH_FAILED                  6833L
+
+#define ERROR_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE 6834L
+
+#define ERROR_TXF_METADATA_ALREADY_PRESENT       6835L
+
+#define ERROR_TRANSACTION_SCOPE_CALLBACKS_NOT_SET 6836L
+
+#define ERROR_TRANSACTION_REQUIRED_PROMOTION     6837L
+
+#define ERROR_CANNOT_EXECUTE_FILE_IN_TRANSACTION 6838L
+
+#define ERROR_TRANSACTIONS_NOT_FROZEN            6839L
+
+#define ERROR_TRANSACTION_FREEZE_IN_PROGRESS     6840L
+
+#define ERROR_NOT_SNAPSHOT_VOLUME                6841L
+
+#define ERROR_NO_SAVEPOINT_WITH_OPEN_FILES       6842L
+
+#define ERROR_DATA_LOST_REPAIR                   6843L
+
+#define ERROR_SPARSE_NOT_ALLOWED_IN_TRANSACTION  6844L
+
+#define ERROR_TM_IDENTITY_MISMATCH               6845L
+
+#define ERROR_FLOATED_SECTION                    6846L
+
+#define ERROR_CANNOT_ACCEPT_TRANSACTED_WORK      6847L
+
+#define ERROR_CANNOT_ABORT_TRANSACTIONS          6848L
+
+//
+// MessageId: ERROR_BAD_CLUSTERS
+//
+// MessageText:
+//
+// The request was rejected because the volume has clusters that are marked
+// bad.
+//
+#define ERROR_BAD_CLUSTERS                       6849L
+
+#define ERROR_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION 6850L
+
+//
+// MessageId: ERROR_VOLUME_DIRTY
+//
+// MessageText:
+//
+// The operation could not be completed because the volume is dirty. Please run
+// chkdsk and try again.
+//
+#define ERROR_VOLUME_DIRTY                       6851L
+
+#define ERROR_NO_LINK_TRACKING_IN_TRANSACTION    6852L
+
+#define ERROR_OPERATION_NOT_SUPPORTED_IN_TRANSACTION 6853L
+
+//
+// MessageId: ERROR_EXPIRED_HANDLE
+//
+// MessageText:
+//
+// The handle is no longer properly associated with its transaction. It may
+// have been opened in a transactional resource manager that was subsequently
+// forced to restart. Please close the handle and open a new one.
+//
+#define ERROR_EXPIRED_HANDLE                     6854L
+
+//
+// MessageId: ERROR_TRANSACTION_NOT_ENLISTED
+//
+// MessageText:
+//
+// The specified operation could not be performed because the resource manager
+// is not enlisted in the transaction.
+//
+#define ERROR_TRANSACTION_NOT_ENLISTED           6855L
+
+#define ERROR_CTX_WINSTATION_NAME_INVALID        7001L
+
+#define ERROR_CTX_INVALID_PD                     7002L
+
+#define ERROR_CTX_PD_NOT_FOUND                   7003L
+
+#define ERROR_CTX_WD_NOT_FOUND                   7004L
+
+#define ERROR_CTX_CANNOT_MAKE_EVENTLOG_ENTRY     7005L
+
+#define ERROR_CTX_SERVICE_NAME_COLLISION         7006L
+
+#define ERROR_CTX_CLOSE_PENDING                  7007L
+
+#define ERROR_CTX_NO_OUTBUF                      7008L
+
+#define ERROR_CTX_MODEM_INF_NOT_FOUND            7009L
+
+#define ERROR_CTX_INVALID_MODEMNAME              7010L
+
+#define ERROR_CTX_MODEM_RESPONSE_ERROR           7011L
+
+#define ERROR_CTX_MODEM_RESPONSE_TIMEOUT         7012L
+
+#define ERROR_CTX_MODEM_RESPONSE_NO_CARRIER      7013L
+
+#define ERROR_CTX_MODEM_RESPONSE_NO_DIALTONE     7014L
+
+#define ERROR_CTX_MODEM_RESPONSE_BUSY            7015L
+
+#define ERROR_CTX_MODEM_RESPONSE_VOICE           7016L
+
+#define ERROR_CTX_TD_ERROR                       7017L
+
+#define ERROR_CTX_WINSTATION_NOT_FOUND           7022L
+
+#define ERROR_CTX_WINSTATION_ALREADY_EXISTS      7023L
+
+#define ERROR_CTX_WINSTATION_BUSY                7024L
+
+#define ERROR_CTX_BAD_VIDEO_MODE                 7025L
+
+#define ERROR_CTX_GRAPHICS_INVALID               7035L
+
+#define ERROR_CTX_LOGON_DISABLED                 7037L
+
+#define ERROR_CTX_NOT_CONSOLE                    7038L
+
+#define ERROR_CTX_CLIENT_QUERY_TIMEOUT           7040L
+
+#define ERROR_CTX_CONSOLE_DISCONNECT             7041L
+
+#define ERROR_CTX_CONSOLE_CONNECT                7042L
+
+#define ERROR_CTX_SHADOW_DENIED                  7044L
+
+#define ERROR_CTX_WINSTATION_ACCESS_DENIED       7045L
+
+#define ERROR_CTX_INVALID_WD                     7049L
+
+#define ERROR_CTX_SHADOW_INVALID                 7050L
+
+#define ERROR_CTX_SHADOW_DISABLED                7051L
+
+#define ERROR_CTX_CLIENT_LICENSE_IN_USE          7052L
+
+#define ERROR_CTX_CLIENT_LICENSE_NOT_SET         7053L
+
+#define ERROR_CTX_LICENSE_NOT_AVAILABLE          7054L
+
+#define ERROR_CTX_LICENSE_CLIENT_INVALID         7055L
+
+#define ERROR_CTX_LICENSE_EXPIRED                7056L
+
+#define ERROR_CTX_SHADOW_NOT_RUNNING             7057L
+
+#define ERROR_CTX_SHADOW_ENDED_BY_MODE_CHANGE    7058L
+
+#define ERROR_ACTIVATION_COUNT_EXCEEDED          7059L
+
+#define ERROR_CTX_WINSTATIONS_DISABLED           7060L
+
+#define ERROR_CTX_ENCRYPTION_LEVEL_REQUIRED      7061L
+
+#define ERROR_CTX_SESSION_IN_USE                 7062L
+
+#define ERROR_CTX_NO_FORCE_LOGOFF                7063L
+
+#define ERROR_CTX_ACCOUNT_RESTRICTION            7064L
+
+#define ERROR_RDP_PROTOCOL_ERROR                 7065L
+
+#define ERROR_CTX_CDM_CONNECT                    7066L
+
+#define ERROR_CTX_CDM_DISCONNECT                 7067L
+
+#define ERROR_CTX_SECURITY_LAYER_ERROR           7068L
+
+#define ERROR_TS_INCOMPATIBLE_SESSIONS           7069L
+
+#define ERROR_TS_VIDEO_SUBSYSTEM_ERROR           7070L
+
+#define ERROR_DS_NOT_INSTALLED                   8200L
+
+#define ERROR_DS_MEMBERSHIP_EVALUATED_LOCALLY    8201L
+
+//
+// MessageId: ERROR_DS_NO_ATTRIBUTE_OR_VALUE
+//
+// MessageText:
+//
+// The specified directory service attribute or value does not exist.
+//
+#define ERROR_DS_NO_ATTRIBUTE_OR_VALUE           8202L
+
+#define ERROR_DS_INVALID_ATTRIBUTE_SYNTAX        8203L
+
+#define ERROR_DS_ATTRIBUTE_TYPE_UNDEFINED        8204L
+
+//
+// MessageId: ERROR_DS_ATTRIBUTE_OR_VALUE_EXISTS
+//
+// MessageText:
+//
+// The specified directory service attribute or value already exists.
+//
+#define ERROR_DS_ATTRIBUTE_OR_VALUE_EXISTS       8205L
+
+//
+// MessageId: ERROR_DS_BUSY
+//
+// MessageText:
+//
+// The directory service is busy.
+//
+#define ERROR_DS_BUSY                            8206L
+
+//
+// MessageId: ERROR_DS_UNAVAILABLE
+//
+// MessageText:
+//
+// The directory service is unavailable.
+//
+#define ERROR_DS_UNAVAILABLE                     8207L
+
+#define ERROR_DS_NO_RIDS_ALLOCATED               8208L
+
+#define ERROR_DS_NO_MORE_RIDS                    8209L
+
+#define ERROR_DS_INCORRECT_ROLE_OWNER            8210L
+
+#define ERROR_DS_RIDMGR_INIT_ERROR               8211L
+
+#define ERROR_DS_OBJ_CLASS_VIOLATION             8212L
+
+#define ERROR_DS_CANT_ON_NON_LEAF                8213L
+
+#define ERROR_DS_CANT_ON_RDN                     8214L
+
+#define ERROR_DS_CANT_MOD_OBJ_CLASS              8215L
+
+#define ERROR_DS_CROSS_DOM_MOVE_ERROR            8216L
+
+#define ERROR_DS_GC_NOT_AVAILABLE                8217L
+
+#define ERROR_SHARED_POLICY                      8218L
+
+#define ERROR_POLICY_OBJECT_NOT_FOUND            8219L
+
+#define ERROR_POLICY_ONLY_IN_DS                  8220L
+
+#define ERROR_PROMOTION_ACTIVE                   8221L
+
+#define ERROR_NO_PROMOTION_ACTIVE                8222L
+
+#define ERROR_DS_OPERATIONS_ERROR                8224L
+
+#define ERROR_DS_PROTOCOL_ERROR                  8225L
+
+#define ERROR_DS_TIMELIMIT_EXCEEDED              8226L
+
+#define ERROR_DS_SIZELIMIT_EXCEEDED              8227L
+
+#define ERROR_DS_ADMIN_LIMIT_EXCEEDED            8228L
+
+#define ERROR_DS_COMPARE_FALSE                   8229L
+
+#define ERROR_DS_COMPARE_TRUE                    8230L
+
+#define ERROR_DS_AUTH_METHOD_NOT_SUPPORTED       8231L
+
+#define ERROR_DS_STRONG_AUTH_REQUIRED            8232L
+
+#define ERROR_DS_INAPPROPRIATE_AUTH              8233L
+
+#define ERROR_DS_AUTH_UNKNOWN                    8234L
+
+#define ERROR_DS_REFERRAL                        8235L
+
+#define ERROR_DS_UNAVAILABLE_CRIT_EXTENSION      8236L
+
+#define ERROR_DS_CONFIDENTIALITY_REQUIRED        8237L
+
+#define ERROR_DS_INAPPROPRIATE_MATCHING          8238L
+
+#define ERROR_DS_CONSTRAINT_VIOLATION            8239L
+
+//
+// MessageId: ERROR_DS_NO_SUCH_OBJECT
+//
+// MessageText:
+//
+// There is no such object on the server.
+//
+#define ERROR_DS_NO_SUCH_OBJECT                  8240L
+
+#define ERROR_DS_ALIAS_PROBLEM                   8241L
+
+//
+// MessageId: ERROR_DS_INVALID_DN_SYNTAX
+//
+// MessageText:
+//
+// An invalid dn syntax has been specified.
+//
+#define ERROR_DS_INVALID_DN_SYNTAX               8242L
+
+#define ERROR_DS_IS_LEAF                         8243L
+
+#define ERROR_DS_ALIAS_DEREF_PROBLEM             8244L
+
+#define ERROR_DS_UNWILLING_TO_PERFORM            8245L
+
+#define ERROR_DS_LOOP_DETECT                     8246L
+
+#define ERROR_DS_NAMING_VIOLATION                8247L
+
+#define ERROR_DS_OBJECT_RESULTS_TOO_LARGE        8248L
+
+#define ERROR_DS_AFFECTS_MULTIPLE_DSAS           8249L
+
+//
+// MessageId: ERROR_DS_SERVER_DOWN
+//
+// MessageText:
+//
+// The server is not operational.
+//
+#define ERROR_DS_SERVER_DOWN                     8250L
+
+#define ERROR_DS_LOCAL_ERROR                     8251L
+
+#define ERROR_DS_ENCODING_ERROR                  8252L
+
+#define ERROR_DS_DECODING_ERROR                  8253L
+
+#define ERROR_DS_FILTER_UNKNOWN                  8254L
+
+#define ERROR_DS_PARAM_ERROR                     8255L
+
+#define ERROR_DS_NOT_SUPPORTED                   8256L
+
+#define ERROR_DS_NO_RESULTS_RETURNED             8257L
+
+#define ERROR_DS_CONTROL_NOT_FOUND               8258L
+
+#define ERROR_DS_CLIENT_LOOP                     8259L
+
+#define ERROR_DS_REFERRAL_LIMIT_EXCEEDED         8260L
+
+#define ERROR_DS_SORT_CONTROL_MISSING            8261L
+
+#define ERROR_DS_OFFSET_RANGE_ERROR              8262L
+
+#define ERROR_DS_RIDMGR_DISABLED                 8263L
+
+#define ERROR_DS_ROOT_MUST_BE_NC                 8301L
+
+#define ERROR_DS_ADD_REPLICA_INHIBITED           8302L
+
+#define ERROR_DS_ATT_NOT_DEF_IN_SCHEMA           8303L
+
+#define ERROR_DS_MAX_OBJ_SIZE_EXCEEDED           8304L
+
+#define ERROR_DS_OBJ_STRING_NAME_EXISTS          8305L
+
+#define ERROR_DS_NO_RDN_DEFINED_IN_SCHEMA        8306L
+
+#define ERROR_DS_RDN_DOESNT_MATCH_SCHEMA         8307L
+
+#define ERROR_DS_NO_REQUESTED_ATTS_FOUND         8308L
+
+#define ERROR_DS_USER_BUFFER_TO_SMALL            8309L
+
+#define ERROR_DS_ATT_IS_NOT_ON_OBJ               8310L
+
+#define ERROR_DS_ILLEGAL_MOD_OPERATION           8311L
+
+#define ERROR_DS_OBJ_TOO_LARGE                   8312L
+
+#define ERROR_DS_BAD_INSTANCE_TYPE               8313L
+
+#define ERROR_DS_MASTERDSA_REQUIRED              8314L
+
+#define ERROR_DS_OBJECT_CLASS_REQUIRED           8315L
+
+#define ERROR_DS_MISSING_REQUIRED_ATT            8316L
+
+#define ERROR_DS_ATT_NOT_DEF_FOR_CLASS           8317L
+
+#define ERROR_DS_ATT_ALREADY_EXISTS              8318L
+
+#define ERROR_DS_CANT_ADD_ATT_VALUES             8320L
+
+#define ERROR_DS_SINGLE_VALUE_CONSTRAINT         8321L
+
+#define ERROR_DS_RANGE_CONSTRAINT                8322L
+
+#define ERROR_DS_ATT_VAL_ALREADY_EXISTS          8323L
+
+#define ERROR_DS_CANT_REM_MISSING_ATT            8324L
+
+#define ERROR_DS_CANT_REM_MISSING_ATT_VAL        8325L
+
+#define ERROR_DS_ROOT_CANT_BE_SUBREF             8326L
+
+#define ERROR_DS_NO_CHAINING                     8327L
+
+#define ERROR_DS_NO_CHAINED_EVAL                 8328L
+
+#define ERROR_DS_NO_PARENT_OBJECT                8329L
+
+#define ERROR_DS_PARENT_IS_AN_ALIAS              8330L
+
+#define ERROR_DS_CANT_MIX_MASTER_AND_REPS        8331L
+
+#define ERROR_DS_CHILDREN_EXIST                  8332L
+
+//
+// MessageId: ERROR_DS_OBJ_NOT_FOUND
+//
+// MessageText:
+//
+// Directory object not found.
+//
+#define ERROR_DS_OBJ_NOT_FOUND                   8333L
+
+#define ERROR_DS_ALIASED_OBJ_MISSING             8334L
+
+#define ERROR_DS_BAD_NAME_SYNTAX                 8335L
+
+#define ERROR_DS_ALIAS_POINTS_TO_ALIAS           8336L
+
+#define ERROR_DS_CANT_DEREF_ALIAS                8337L
+
+#define ERROR_DS_OUT_OF_SCOPE                    8338L
+
+#define ERROR_DS_OBJECT_BEING_REMOVED            8339L
+
+#define ERROR_DS_CANT_DELETE_DSA_OBJ             8340L
+
+#define ERROR_DS_GENERIC_ERROR                   8341L
+
+#define ERROR_DS_DSA_MUST_BE_INT_MASTER          8342L
+
+#define ERROR_DS_CLASS_NOT_DSA                   8343L
+
+#define ERROR_DS_INSUFF_ACCESS_RIGHTS            8344L
+
+#define ERROR_DS_ILLEGAL_SUPERIOR                8345L
+
+#define ERROR_DS_ATTRIBUTE_OWNED_BY_SAM          8346L
+
+#define ERROR_DS_NAME_TOO_MANY_PARTS             8347L
+
+#define ERROR_DS_NAME_TOO_LONG                   8348L
+
+#define ERROR_DS_NAME_VALUE_TOO_LONG             8349L
+
+#define ERROR_DS_NAME_UNPARSEABLE                8350L
+
+#define ERROR_DS_NAME_TYPE_UNKNOWN               8351L
+
+#define ERROR_DS_NOT_AN_OBJECT                   8352L
+
+#define ERROR_DS_SEC_DESC_TOO_SHORT              8353L
+
+#define ERROR_DS_SEC_DESC_INVALID                8354L
+
+#define ERROR_DS_NO_DELETED_NAME                 8355L
+
+#define ERROR_DS_SUBREF_MUST_HAVE_PARENT         8356L
+
+#define ERROR_DS_NCNAME_MUST_BE_NC               8357L
+
+#define ERROR_DS_CANT_ADD_SYSTEM_ONLY            8358L
+
+#define ERROR_DS_CLASS_MUST_BE_CONCRETE          8359L
+
+#define ERROR_DS_INVALID_DMD                     8360L
+
+#define ERROR_DS_OBJ_GUID_EXISTS                 8361L
+
+#define ERROR_DS_NOT_ON_BACKLINK                 8362L
+
+#define ERROR_DS_NO_CROSSREF_FOR_NC              8363L
+
+#define ERROR_DS_SHUTTING_DOWN                   8364L
+
+#define ERROR_DS_UNKNOWN_OPERATION               8365L
+
+#define ERROR_DS_INVALID_ROLE_OWNER              8366L
+
+#define ERROR_DS_COULDNT_CONTACT_FSMO            8367L
+
+#define ERROR_DS_CROSS_NC_DN_RENAME              8368L
+
+#define ERROR_DS_CANT_MOD_SYSTEM_ONLY            8369L
+
+#define ERROR_DS_REPLICATOR_ONLY                 8370L
+
+#define ERROR_DS_OBJ_CLASS_NOT_DEFINED           8371L
+
+#define ERROR_DS_OBJ_CLASS_NOT_SUBCLASS          8372L
+
+#define ERROR_DS_NAME_REFERENCE_INVALID          8373L
+
+#define ERROR_DS_CROSS_REF_EXISTS                8374L
+
+#define ERROR_DS_CANT_DEL_MASTER_CROSSREF        8375L
+
+#define ERROR_DS_SUBTREE_NOTIFY_NOT_NC_HEAD      8376L
+
+#define ERROR_DS_NOTIFY_FILTER_TOO_COMPLEX       8377L
+
+#define ERROR_DS_DUP_RDN                         8378L
+
+#define ERROR_DS_DUP_OID                         8379L
+
+#define ERROR_DS_DUP_MAPI_ID                     8380L
+
+#define ERROR_DS_DUP_SCHEMA_ID_GUID              8381L
+
+#define ERROR_DS_DUP_LDAP_DISPLAY_NAME           8382L
+
+#define ERROR_DS_SEMANTIC_ATT_TEST               8383L
+
+#define ERROR_DS_SYNTAX_MISMATCH                 8384L
+
+#define ERROR_DS_EXISTS_IN_MUST_HAVE             8385L
+
+#define ERROR_DS_EXISTS_IN_MAY_HAVE              8386L
+
+#define ERROR_DS_NONEXISTENT_MAY_HAVE            8387L
+
+#define ERROR_DS_NONEXISTENT_MUST_HAVE           8388L
+
+#define ERROR_DS_AUX_CLS_TEST_FAIL               8389L
+
+#define ERROR_DS_NONEXISTENT_POSS_SUP            8390L
+
+#define ERROR_DS_SUB_CLS_TEST_FAIL               8391L
+
+#define ERROR_DS_BAD_RDN_ATT_ID_SYNTAX           8392L
+
+#define ERROR_DS_EXISTS_IN_AUX_CLS               8393L
+
+#define ERROR_DS_EXISTS_IN_SUB_CLS               8394L
+
+#define ERROR_DS_EXISTS_IN_POSS_SUP              8395L
+
+#define ERROR_DS_RECALCSCHEMA_FAILED             8396L
+
+#define ERROR_DS_TREE_DELETE_NOT_FINISHED        8397L
+
+#define ERROR_DS_CANT_DELETE                     8398L
+
+#define ERROR_DS_ATT_SCHEMA_REQ_ID               8399L
+
+#define ERROR_DS_BAD_ATT_SCHEMA_SYNTAX           8400L
+
+#define ERROR_DS_CANT_CACHE_ATT                  8401L
+
+#define ERROR_DS_CANT_CACHE_CLASS                8402L
+
+#define ERROR_DS_CANT_REMOVE_ATT_CACHE           8403L
+
+#define ERROR_DS_CANT_REMOVE_CLASS_CACHE         8404L
+
+#define ERROR_DS_CANT_RETRIEVE_DN                8405L
+
+#define ERROR_DS_MISSING_SUPREF                  8406L
+
+#define ERROR_DS_CANT_RETRIEVE_INSTANCE          8407L
+
+#define ERROR_DS_CODE_INCONSISTENCY              8408L
+
+#define ERROR_DS_DATABASE_ERROR                  8409L
+
+#define ERROR_DS_GOVERNSID_MISSING               8410L
+
+#define ERROR_DS_MISSING_EXPECTED_ATT            8411L
+
+#define ERROR_DS_NCNAME_MISSING_CR_REF           8412L
+
+#define ERROR_DS_SECURITY_CHECKING_ERROR         8413L
+
+#define ERROR_DS_SCHEMA_NOT_LOADED               8414L
+
+#define ERROR_DS_SCHEMA_ALLOC_FAILED             8415L
+
+#define ERROR_DS_ATT_SCHEMA_REQ_SYNTAX           8416L
+
+#define ERROR_DS_GCVERIFY_ERROR                  8417L
+
+#define ERROR_DS_DRA_SCHEMA_MISMATCH             8418L
+
+#define ERROR_DS_CANT_FIND_DSA_OBJ               8419L
+
+#define ERROR_DS_CANT_FIND_EXPECTED_NC           8420L
+
+#define ERROR_DS_CANT_FIND_NC_IN_CACHE           8421L
+
+#define ERROR_DS_CANT_RETRIEVE_CHILD             8422L
+
+#define ERROR_DS_SECURITY_ILLEGAL_MODIFY         8423L
+
+#define ERROR_DS_CANT_REPLACE_HIDDEN_REC         8424L
+
+#define ERROR_DS_BAD_HIERARCHY_FILE              8425L
+
+#define ERROR_DS_BUILD_HIERARCHY_TABLE_FAILED    8426L
+
+#define ERROR_DS_CONFIG_PARAM_MISSING            8427L
+
+#define ERROR_DS_COUNTING_AB_INDICES_FAILED      8428L
+
+#define ERROR_DS_HIERARCHY_TABLE_MALLOC_FAILED   8429L
+
+#define ERROR_DS_INTERNAL_FAILURE                8430L
+
+#define ERROR_DS_UNKNOWN_ERROR                   8431L
+
+#define ERROR_DS_ROOT_REQUIRES_CLASS_TOP         8432L
+
+#define ERROR_DS_REFUSING_FSMO_ROLES             8433L
+
+#define ERROR_DS_MISSING_FSMO_SETTINGS           8434L
+
+#define ERROR_DS_UNABLE_TO_SURRENDER_ROLES       8435L
+
+#define ERROR_DS_DRA_GENERIC                     8436L
+
+#define ERROR_DS_DRA_INVALID_PARAMETER           8437L
+
+#define ERROR_DS_DRA_BUSY                        8438L
+
+#define ERROR_DS_DRA_BAD_DN                      8439L
+
+#define ERROR_DS_DRA_BAD_NC                      8440L
+
+#define ERROR_DS_DRA_DN_EXISTS                   8441L
+
+#define ERROR_DS_DRA_INTERNAL_ERROR              8442L
+
+#define ERROR_DS_DRA_INCONSISTENT_DIT            8443L
+
+#define ERROR_DS_DRA_CONNECTION_FAILED           8444L
+
+#define ERROR_DS_DRA_BAD_INSTANCE_TYPE           8445L
+
+#define ERROR_DS_DRA_OUT_OF_MEM                  8446L
+
+#define ERROR_DS_DRA_MAIL_PROBLEM                8447L
+
+#define ERROR_DS_DRA_REF_ALREADY_EXISTS          8448L
+
+#define ERROR_DS_DRA_REF_NOT_FOUND               8449L
+
+#define ERROR_DS_DRA_OBJ_IS_REP_SOURCE           8450L
+
+#define ERROR_DS_DRA_DB_ERROR                    8451L
+
+#define ERROR_DS_DRA_NO_REPLICA                  8452L
+
+//
+// MessageId: ERROR_DS_DRA_ACCESS_DENIED
+//
+// MessageText:
+//
+// Replication access was denied.
+//
+#define ERROR_DS_DRA_ACCESS_DENIED               8453L
+
+#define ERROR_DS_DRA_NOT_SUPPORTED               8454L
+
+#define ERROR_DS_DRA_RPC_CANCELLED               8455L
+
+#define ERROR_DS_DRA_SOURCE_DISABLED             8456L
+
+#define ERROR_DS_DRA_SINK_DISABLED               8457L
+
+#define ERROR_DS_DRA_NAME_COLLISION              8458L
+
+#define ERROR_DS_DRA_SOURCE_REINSTALLED          8459L
+
+#define ERROR_DS_DRA_MISSING_PARENT              8460L
+
+#define ERROR_DS_DRA_PREEMPTED                   8461L
+
+#define ERROR_DS_DRA_ABANDON_SYNC                8462L
+
+#define ERROR_DS_DRA_SHUTDOWN                    8463L
+
+#define ERROR_DS_DRA_INCOMPATIBLE_PARTIAL_SET    8464L
+
+#define ERROR_DS_DRA_SOURCE_IS_PARTIAL_REPLICA   8465L
+
+#define ERROR_DS_DRA_EXTN_CONNECTION_FAILED      8466L
+
+#define ERROR_DS_INSTALL_SCHEMA_MISMATCH         8467L
+
+#define ERROR_DS_DUP_LINK_ID                     8468L
+
+#define ERROR_DS_NAME_ERROR_RESOLVING            8469L
+
+#define ERROR_DS_NAME_ERROR_NOT_FOUND            8470L
+
+#define ERROR_DS_NAME_ERROR_NOT_UNIQUE           8471L
+
+#define ERROR_DS_NAME_ERROR_NO_MAPPING           8472L
+
+#define ERROR_DS_NAME_ERROR_DOMAIN_ONLY          8473L
+
+#define ERROR_DS_NAME_ERROR_NO_SYNTACTICAL_MAPPING 8474L
+
+#define ERROR_DS_CONSTRUCTED_ATT_MOD             8475L
+
+#define ERROR_DS_WRONG_OM_OBJ_CLASS              8476L
+
+#define ERROR_DS_DRA_REPL_PENDING                8477L
+
+#define ERROR_DS_DS_REQUIRED                     8478L
+
+#define ERROR_DS_INVALID_LDAP_DISPLAY_NAME       8479L
+
+#define ERROR_DS_NON_BASE_SEARCH                 8480L
+
+#define ERROR_DS_CANT_RETRIEVE_ATTS              8481L
+
+#define ERROR_DS_BACKLINK_WITHOUT_LINK           8482L
+
+#define ERROR_DS_EPOCH_MISMATCH                  8483L
+
+#define ERROR_DS_SRC_NAME_MISMATCH               8484L
+
+#define ERROR_DS_SRC_AND_DST_NC_IDENTICAL        8485L
+
+#define ERROR_DS_DST_NC_MISMATCH                 8486L
+
+#define ERROR_DS_NOT_AUTHORITIVE_FOR_DST_NC      8487L
+
+#define ERROR_DS_SRC_GUID_MISMATCH               8488L
+
+#define ERROR_DS_CANT_MOVE_DELETED_OBJECT        8489L
+
+#define ERROR_DS_PDC_OPERATION_IN_PROGRESS       8490L
+
+#define ERROR_DS_CROSS_DOMAIN_CLEANUP_REQD       8491L
+
+#define ERROR_DS_ILLEGAL_XDOM_MOVE_OPERATION     8492L
+
+#define ERROR_DS_CANT_WITH_ACCT_GROUP_MEMBERSHPS 8493L
+
+#define ERROR_DS_NC_MUST_HAVE_NC_PARENT          8494L
+
+#define ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE       8495L
+
+#define ERROR_DS_DST_DOMAIN_NOT_NATIVE           8496L
+
+#define ERROR_DS_MISSING_INFRASTRUCTURE_CONTAINER 8497L
+
+#define ERROR_DS_CANT_MOVE_ACCOUNT_GROUP         8498L
+
+#define ERROR_DS_CANT_MOVE_RESOURCE_GROUP        8499L
+
+#define ERROR_DS_INVALID_SEARCH_FLAG             8500L
+
+#define ERROR_DS_NO_TREE_DELETE_ABOVE_NC         8501L
+
+#define ERROR_DS_COULDNT_LOCK_TREE_FOR_DELETE    8502L
+
+#define ERROR_DS_COULDNT_IDENTIFY_OBJECTS_FOR_TREE_DELETE 8503L
+
+#define ERROR_DS_SAM_INIT_FAILURE                8504L
+
+#define ERROR_DS_SENSITIVE_GROUP_VIOLATION       8505L
+
+#define ERROR_DS_CANT_MOD_PRIMARYGROUPID         8506L
+
+#define ERROR_DS_ILLEGAL_BASE_SCHEMA_MOD         8507L
+
+#define ERROR_DS_NONSAFE_SCHEMA_CHANGE           8508L
+
+#define ERROR_DS_SCHEMA_UPDATE_DISALLOWED        8509L
+
+#define ERROR_DS_CANT_CREATE_UNDER_SCHEMA        8510L
+
+#define ERROR_DS_INSTALL_NO_SRC_SCH_VERSION      8511L
+
+#define ERROR_DS_INSTALL_NO_SCH_VERSION_IN_INIFILE 8512L
+
+#define ERROR_DS_INVALID_GROUP_TYPE              8513L
+
+#define ERROR_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN 8514L
+
+#define ERROR_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN 8515L
+
+#define ERROR_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER   8516L
+
+#define ERROR_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER 8517L
+
+#define ERROR_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER 8518L
+
+#define ERROR_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER 8519L
+
+#define ERROR_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER 8520L
+
+#define ERROR_DS_HAVE_PRIMARY_MEMBERS            8521L
+
+#define ERROR_DS_STRING_SD_CONVERSION_FAILED     8522L
+
+#define ERROR_DS_NAMING_MASTER_GC                8523L
+
+#define ERROR_DS_DNS_LOOKUP_FAILURE              8524L
+
+#define ERROR_DS_COULDNT_UPDATE_SPNS             8525L
+
+#define ERROR_DS_CANT_RETRIEVE_SD                8526L
+
+#define ERROR_DS_KEY_NOT_UNIQUE                  8527L
+
+#define ERROR_DS_WRONG_LINKED_ATT_SYNTAX         8528L
+
+#define ERROR_DS_SAM_NEED_BOOTKEY_PASSWORD       8529L
+
+#define ERROR_DS_SAM_NEED_BOOTKEY_FLOPPY         8530L
+
+#define ERROR_DS_CANT_START                      8531L
+
+#define ERROR_DS_INIT_FAILURE                    8532L
+
+#define ERROR_DS_NO_PKT_PRIVACY_ON_CONNECTION    8533L
+
+#define ERROR_DS_SOURCE_DOMAIN_IN_FOREST         8534L
+
+#define ERROR_DS_DESTINATION_DOMAIN_NOT_IN_FOREST 8535L
+
+#define ERROR_DS_DESTINATION_AUDITING_NOT_ENABLED 8536L
+
+#define ERROR_DS_CANT_FIND_DC_FOR_SRC_DOMAIN     8537L
+
+#define ERROR_DS_SRC_OBJ_NOT_GROUP_OR_USER       8538L
+
+#define ERROR_DS_SRC_SID_EXISTS_IN_FOREST        8539L
+
+#define ERROR_DS_SRC_AND_DST_OBJECT_CLASS_MISMATCH 8540L
+
+#define ERROR_SAM_INIT_FAILURE                   8541L
+
+#define ERROR_DS_DRA_SCHEMA_INFO_SHIP            8542L
+
+#define ERROR_DS_DRA_SCHEMA_CONFLICT             8543L
+
+#define ERROR_DS_DRA_EARLIER_SCHEMA_CONFLICT     8544L
+
+#define ERROR_DS_DRA_OBJ_NC_MISMATCH             8545L
+
+#define ERROR_DS_NC_STILL_HAS_DSAS               8546L
+
+#define ERROR_DS_GC_REQUIRED                     8547L
+
+#define ERROR_DS_LOCAL_MEMBER_OF_LOCAL_ONLY      8548L
+
+#define ERROR_DS_NO_FPO_IN_UNIVERSAL_GROUPS      8549L
+
+#define ERROR_DS_CANT_ADD_TO_GC                  8550L
+
+#define ERROR_DS_NO_CHECKPOINT_WITH_PDC          8551L
+
+#define ERROR_DS_SOURCE_AUDITING_NOT_ENABLED     8552L
+
+#define ERROR_DS_CANT_CREATE_IN_NONDOMAIN_NC     8553L
+
+#define ERROR_DS_INVALID_NAME_FOR_SPN            8554L
+
+#define ERROR_DS_FILTER_USES_CONTRUCTED_ATTRS    8555L
+
+#define ERROR_DS_UNICODEPWD_NOT_IN_QUOTES        8556L
+
+#define ERROR_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED  8557L
+
+#define ERROR_DS_MUST_BE_RUN_ON_DST_DC           8558L
+
+#define ERROR_DS_SRC_DC_MUST_BE_SP4_OR_GREATER   8559L
+
+#define ERROR_DS_CANT_TREE_DELETE_CRITICAL_OBJ   8560L
+
+#define ERROR_DS_INIT_FAILURE_CONSOLE            8561L
+
+#define ERROR_DS_SAM_INIT_FAILURE_CONSOLE        8562L
+
+#define ERROR_DS_FOREST_VERSION_TOO_HIGH         8563L
+
+#define ERROR_DS_DOMAIN_VERSION_TOO_HIGH         8564L
+
+#define ERROR_DS_FOREST_VERSION_TOO_LOW          8565L
+
+#define ERROR_DS_DOMAIN_VERSION_TOO_LOW          8566L
+
+#define ERROR_DS_INCOMPATIBLE_VERSION            8567L
+
+#define ERROR_DS_LOW_DSA_VERSION                 8568L
+
+#define ERROR_DS_NO_BEHAVIOR_VERSION_IN_MIXEDDOMAIN 8569L
+
+#define ERROR_DS_NOT_SUPPORTED_SORT_ORDER        8570L
+
+#define ERROR_DS_NAME_NOT_UNIQUE                 8571L
+
+#define ERROR_DS_MACHINE_ACCOUNT_CREATED_PRENT4  8572L
+
+#define ERROR_DS_OUT_OF_VERSION_STORE            8573L
+
+#define ERROR_DS_INCOMPATIBLE_CONTROLS_USED      8574L
+
+#define ERROR_DS_NO_REF_DOMAIN                   8575L
+
+#define ERROR_DS_RESERVED_LINK_ID                8576L
+
+#define ERROR_DS_LINK_ID_NOT_AVAILABLE           8577L
+
+#define ERROR_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER   8578L
+
+#define ERROR_DS_MODIFYDN_DISALLOWED_BY_INSTANCE_TYPE 8579L
+
+#define ERROR_DS_NO_OBJECT_MOVE_IN_SCHEMA_NC     8580L
+
+#define ERROR_DS_MODIFYDN_DISALLOWED_BY_FLAG     8581L
+
+#define ERROR_DS_MODIFYDN_WRONG_GRANDPARENT      8582L
+
+#define ERROR_DS_NAME_ERROR_TRUST_REFERRAL       8583L
+
+#define ERROR_NOT_SUPPORTED_ON_STANDARD_SERVER   8584L
+
+#define ERROR_DS_CANT_ACCESS_REMOTE_PART_OF_AD   8585L
+
+#define ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE_V2    8586L
+
+#define ERROR_DS_THREAD_LIMIT_EXCEEDED           8587L
+
+#define ERROR_DS_NOT_CLOSEST                     8588L
+
+#define ERROR_DS_CANT_DERIVE_SPN_WITHOUT_SERVER_REF 8589L
+
+#define ERROR_DS_SINGLE_USER_MODE_FAILED         8590L
+
+#define ERROR_DS_NTDSCRIPT_SYNTAX_ERROR          8591L
+
+#define ERROR_DS_NTDSCRIPT_PROCESS_ERROR         8592L
+
+#define ERROR_DS_DIFFERENT_REPL_EPOCHS           8593L
+
+#define ERROR_DS_DRS_EXTENSIONS_CHANGED          8594L
+
+#define ERROR_DS_REPLICA_SET_CHANGE_NOT_ALLOWED_ON_DISABLED_CR 8595L
+
+#define ERROR_DS_NO_MSDS_INTID                   8596L
+
+#define ERROR_DS_DUP_MSDS_INTID                  8597L
+
+#define ERROR_DS_EXISTS_IN_RDNATTID              8598L
+
+#define ERROR_DS_AUTHORIZATION_FAILED            8599L
+
+#define ERROR_DS_INVALID_SCRIPT                  8600L
+
+#define ERROR_DS_REMOTE_CROSSREF_OP_FAILED       8601L
+
+#define ERROR_DS_CROSS_REF_BUSY                  8602L
+
+#define ERROR_DS_CANT_DERIVE_SPN_FOR_DELETED_DOMAIN 8603L
+
+#define ERROR_DS_CANT_DEMOTE_WITH_WRITEABLE_NC   8604L
+
+#define ERROR_DS_DUPLICATE_ID_FOUND              8605L
+
+#define ERROR_DS_INSUFFICIENT_ATTR_TO_CREATE_OBJECT 8606L
+
+#define ERROR_DS_GROUP_CONVERSION_ERROR          8607L
+
+#define ERROR_DS_CANT_MOVE_APP_BASIC_GROUP       8608L
+
+#define ERROR_DS_CANT_MOVE_APP_QUERY_GROUP       8609L
+
+#define ERROR_DS_ROLE_NOT_VERIFIED               8610L
+
+#define ERROR_DS_WKO_CONTAINER_CANNOT_BE_SPECIAL 8611L
+
+#define ERROR_DS_DOMAIN_RENAME_IN_PROGRESS       8612L
+
+#define ERROR_DS_EXISTING_AD_CHILD_NC            8613L
+
+#define ERROR_DS_REPL_LIFETIME_EXCEEDED          8614L
+
+#define ERROR_DS_DISALLOWED_IN_SYSTEM_CONTAINER  8615L
+
+#define ERROR_DS_LDAP_SEND_QUEUE_FULL            8616L
+
+#define ERROR_DS_DRA_OUT_SCHEDULE_WINDOW         8617L
+
+#define ERROR_DS_POLICY_NOT_KNOWN                8618L
+
+#define ERROR_NO_SITE_SETTINGS_OBJECT            8619L
+
+#define ERROR_NO_SECRETS                         8620L
+
+#define ERROR_NO_WRITABLE_DC_FOUND               8621L
+
+#define ERROR_DS_NO_SERVER_OBJECT                8622L
+
+#define ERROR_DS_NO_NTDSA_OBJECT                 8623L
+
+#define ERROR_DS_NON_ASQ_SEARCH                  8624L
+
+#define ERROR_DS_AUDIT_FAILURE                   8625L
+
+#define ERROR_DS_INVALID_SEARCH_FLAG_SUBTREE     8626L
+
+#define ERROR_DS_INVALID_SEARCH_FLAG_TUPLE       8627L
+
+#define ERROR_DS_HIERARCHY_TABLE_TOO_DEEP        8628L
+
+#define ERROR_DS_DRA_CORRUPT_UTD_VECTOR          8629L
+
+#define ERROR_DS_DRA_SECRETS_DENIED              8630L
+
+#define ERROR_DS_RESERVED_MAPI_ID                8631L
+
+#define ERROR_DS_MAPI_ID_NOT_AVAILABLE           8632L
+
+#define ERROR_DS_DRA_MISSING_KRBTGT_SECRET       8633L
+
+#define ERROR_DS_DOMAIN_NAME_EXISTS_IN_FOREST    8634L
+
+#define ERROR_DS_FLAT_NAME_EXISTS_IN_FOREST      8635L
+
+#define ERROR_INVALID_USER_PRINCIPAL_NAME        8636L
+
+#define ERROR_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS 8637L
+
+#define ERROR_DS_OID_NOT_FOUND                   8638L
+
+#define ERROR_DS_DRA_RECYCLED_TARGET             8639L
+
+#define ERROR_DS_DISALLOWED_NC_REDIRECT          8640L
+
+#define ERROR_DS_HIGH_ADLDS_FFL                  8641L
+
+#define ERROR_DS_HIGH_DSA_VERSION                8642L
+
+#define ERROR_DS_LOW_ADLDS_FFL                   8643L
+
+#define ERROR_DOMAIN_SID_SAME_AS_LOCAL_WORKSTATION 8644L
+
+#define ERROR_DS_UNDELETE_SAM_VALIDATION_FAILED  8645L
+
+#define ERROR_INCORRECT_ACCOUNT_TYPE             8646L
+
+#define ERROR_DS_SPN_VALUE_NOT_UNIQUE_IN_FOREST  8647L
+
+#define ERROR_DS_UPN_VALUE_NOT_UNIQUE_IN_FOREST  8648L
+
+#define ERROR_DS_MISSING_FOREST_TRUST            8649L
+
+#define ERROR_DS_VALUE_KEY_NOT_UNIQUE            8650L
+
+#define ERROR_IPSEC_QM_POLICY_EXISTS             13000L
+
+#define ERROR_IPSEC_QM_POLICY_NOT_FOUND          13001L
+
+#define ERROR_IPSEC_QM_POLICY_IN_USE             13002L
+
+#define ERROR_IPSEC_MM_POLICY_EXISTS             13003L
+
+#define ERROR_IPSEC_MM_POLICY_NOT_FOUND          13004L
+
+#define ERROR_IPSEC_MM_POLICY_IN_USE             13005L
+
+#define ERROR_IPSEC_MM_FILTER_EXISTS             13006L
+
+#define ERROR_IPSEC_MM_FILTER_NOT_FOUND          13007L
+
+#define ERROR_IPSEC_TRANSPORT_FILTER_EXISTS      13008L
+
+#define ERROR_IPSEC_TRANSPORT_FILTER_NOT_FOUND   13009L
+
+#define ERROR_IPSEC_MM_AUTH_EXISTS               13010L
+
+#define ERROR_IPSEC_MM_AUTH_NOT_FOUND            13011L
+
+#define ERROR_IPSEC_MM_AUTH_IN_USE               13012L
+
+#define ERROR_IPSEC_DEFAULT_MM_POLICY_NOT_FOUND  13013L
+
+#define ERROR_IPSEC_DEFAULT_MM_AUTH_NOT_FOUND    13014L
+
+#define ERROR_IPSEC_DEFAULT_QM_POLICY_NOT_FOUND  13015L
+
+#define ERROR_IPSEC_TUNNEL_FILTER_EXISTS         13016L
+
+#define ERROR_IPSEC_TUNNEL_FILTER_NOT_FOUND      13017L
+
+#define ERROR_IPSEC_MM_FILTER_PENDING_DELETION   13018L
+
+#define ERROR_IPSEC_TRANSPORT_FILTER_PENDING_DELETION 13019L
+
+#define ERROR_IPSEC_TUNNEL_FILTER_PENDING_DELETION 13020L
+
+#define ERROR_IPSEC_MM_POLICY_PENDING_DELETION   13021L
+
+#define ERROR_IPSEC_MM_AUTH_PENDING_DELETION     13022L
+
+#define ERROR_IPSEC_QM_POLICY_PENDING_DELETION   13023L
+
+#define ERROR_IPSEC_IKE_NEG_STATUS_BEGIN         13800L
+
+#define ERROR_IPSEC_IKE_AUTH_FAIL                13801L
+
+#define ERROR_IPSEC_IKE_ATTRIB_FAIL              13802L
+
+#define ERROR_IPSEC_IKE_NEGOTIATION_PENDING      13803L
+
+#define ERROR_IPSEC_IKE_GENERAL_PROCESSING_ERROR 13804L
+
+#define ERROR_IPSEC_IKE_TIMED_OUT                13805L
+
+#define ERROR_IPSEC_IKE_NO_CERT                  13806L
+
+#define ERROR_IPSEC_IKE_SA_DELETED               13807L
+
+#define ERROR_IPSEC_IKE_SA_REAPED                13808L
+
+#define ERROR_IPSEC_IKE_MM_ACQUIRE_DROP          13809L
+
+#define ERROR_IPSEC_IKE_QM_ACQUIRE_DROP          13810L
+
+#define ERROR_IPSEC_IKE_QUEUE_DROP_MM            13811L
+
+#define ERROR_IPSEC_IKE_QUEUE_DROP_NO_MM         13812L
+
+#define ERROR_IPSEC_IKE_DROP_NO_RESPONSE         13813L
+
+#define ERROR_IPSEC_IKE_MM_DELAY_DROP            13814L
+
+#define ERROR_IPSEC_IKE_QM_DELAY_DROP            13815L
+
+#define ERROR_IPSEC_IKE_ERROR                    13816L
+
+#define ERROR_IPSEC_IKE_CRL_FAILED               13817L
+
+#define ERROR_IPSEC_IKE_INVALID_KEY_USAGE        13818L
+
+#define ERROR_IPSEC_IKE_INVALID_CERT_TYPE        13819L
+
+#define ERROR_IPSEC_IKE_NO_PRIVATE_KEY           13820L
+
+#define ERROR_IPSEC_IKE_SIMULTANEOUS_REKEY       13821L
+
+#define ERROR_IPSEC_IKE_DH_FAIL                  13822L
+
+#define ERROR_IPSEC_IKE_CRITICAL_PAYLOAD_NOT_RECOGNIZED 13823L
+
+#define ERROR_IPSEC_IKE_INVALID_HEADER           13824L
+
+#define ERROR_IPSEC_IKE_NO_POLICY                13825L
+
+#define ERROR_IPSEC_IKE_INVALID_SIGNATURE        13826L
+
+#define ERROR_IPSEC_IKE_KERBEROS_ERROR           13827L
+
+#define ERROR_IPSEC_IKE_NO_PUBLIC_KEY            13828L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR              13829L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_SA           13830L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_PROP         13831L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_TRANS        13832L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_KE           13833L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_ID           13834L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_CERT         13835L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_CERT_REQ     13836L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_HASH         13837L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_SIG          13838L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_NONCE        13839L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_NOTIFY       13840L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_DELETE       13841L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_VENDOR       13842L
+
+#define ERROR_IPSEC_IKE_INVALID_PAYLOAD          13843L
+
+#define ERROR_IPSEC_IKE_LOAD_SOFT_SA             13844L
+
+#define ERROR_IPSEC_IKE_SOFT_SA_TORN_DOWN        13845L
+
+#define ERROR_IPSEC_IKE_INVALID_COOKIE           13846L
+
+#define ERROR_IPSEC_IKE_NO_PEER_CERT             13847L
+
+#define ERROR_IPSEC_IKE_PEER_CRL_FAILED          13848L
+
+#define ERROR_IPSEC_IKE_POLICY_CHANGE            13849L
+
+#define ERROR_IPSEC_IKE_NO_MM_POLICY             13850L
+
+#define ERROR_IPSEC_IKE_NOTCBPRIV                13851L
+
+#define ERROR_IPSEC_IKE_SECLOADFAIL              13852L
+
+#define ERROR_IPSEC_IKE_FAILSSPINIT              13853L
+
+#define ERROR_IPSEC_IKE_FAILQUERYSSP             13854L
+
+#define ERROR_IPSEC_IKE_SRVACQFAIL               13855L
+
+#define ERROR_IPSEC_IKE_SRVQUERYCRED             13856L
+
+#define ERROR_IPSEC_IKE_GETSPIFAIL               13857L
+
+#define ERROR_IPSEC_IKE_INVALID_FILTER           13858L
+
+#define ERROR_IPSEC_IKE_OUT_OF_MEMORY            13859L
+
+#define ERROR_IPSEC_IKE_ADD_UPDATE_KEY_FAILED    13860L
+
+#define ERROR_IPSEC_IKE_INVALID_POLICY           13861L
+
+#define ERROR_IPSEC_IKE_UNKNOWN_DOI              13862L
+
+#define ERROR_IPSEC_IKE_INVALID_SITUATION        13863L
+
+#define ERROR_IPSEC_IKE_DH_FAILURE               13864L
+
+#define ERROR_IPSEC_IKE_INVALID_GROUP            13865L
+
+#define ERROR_IPSEC_IKE_ENCRYPT                  13866L
+
+#define ERROR_IPSEC_IKE_DECRYPT                  13867L
+
+#define ERROR_IPSEC_IKE_POLICY_MATCH             13868L
+
+#define ERROR_IPSEC_IKE_UNSUPPORTED_ID           13869L
+
+#define ERROR_IPSEC_IKE_INVALID_HASH             13870L
+
+#define ERROR_IPSEC_IKE_INVALID_HASH_ALG         13871L
+
+#define ERROR_IPSEC_IKE_INVALID_HASH_SIZE        13872L
+
+#define ERROR_IPSEC_IKE_INVALID_ENCRYPT_ALG      13873L
+
+#define ERROR_IPSEC_IKE_INVALID_AUTH_ALG         13874L
+
+#define ERROR_IPSEC_IKE_INVALID_SIG              13875L
+
+#define ERROR_IPSEC_IKE_LOAD_FAILED              13876L
+
+#define ERROR_IPSEC_IKE_RPC_DELETE               13877L
+
+#define ERROR_IPSEC_IKE_BENIGN_REINIT            13878L
+
+#define ERROR_IPSEC_IKE_INVALID_RESPONDER_LIFETIME_NOTIFY 13879L
+
+#define ERROR_IPSEC_IKE_INVALID_MAJOR_VERSION    13880L
+
+#define ERROR_IPSEC_IKE_INVALID_CERT_KEYLEN      13881L
+
+#define ERROR_IPSEC_IKE_MM_LIMIT                 13882L
+
+#define ERROR_IPSEC_IKE_NEGOTIATION_DISABLED     13883L
+
+#define ERROR_IPSEC_IKE_QM_LIMIT                 13884L
+
+#define ERROR_IPSEC_IKE_MM_EXPIRED               13885L
+
+#define ERROR_IPSEC_IKE_PEER_MM_ASSUMED_INVALID  13886L
+
+#define ERROR_IPSEC_IKE_CERT_CHAIN_POLICY_MISMATCH 13887L
+
+#define ERROR_IPSEC_IKE_UNEXPECTED_MESSAGE_ID    13888L
+
+#define ERROR_IPSEC_IKE_INVALID_AUTH_PAYLOAD     13889L
+
+#define ERROR_IPSEC_IKE_DOS_COOKIE_SENT          13890L
+
+#define ERROR_IPSEC_IKE_SHUTTING_DOWN            13891L
+
+#define ERROR_IPSEC_IKE_CGA_AUTH_FAILED          13892L
+
+#define ERROR_IPSEC_IKE_PROCESS_ERR_NATOA        13893L
+
+#define ERROR_IPSEC_IKE_INVALID_MM_FOR_QM        13894L
+
+#define ERROR_IPSEC_IKE_QM_EXPIRED               13895L
+
+#define ERROR_IPSEC_IKE_TOO_MANY_FILTERS         13896L
+
+#define ERROR_IPSEC_IKE_NEG_STATUS_END           13897L
+
+#define ERROR_IPSEC_IKE_KILL_DUMMY_NAP_TUNNEL    13898L
+
+#define ERROR_IPSEC_IKE_INNER_IP_ASSIGNMENT_FAILURE 13899L
+
+#define ERROR_IPSEC_IKE_REQUIRE_CP_PAYLOAD_MISSING 13900L
+
+#define ERROR_IPSEC_KEY_MODULE_IMPERSONATION_NEGOTIATION_PENDING 13901L
+
+#define ERROR_IPSEC_IKE_COEXISTENCE_SUPPRESS     13902L
+
+#define ERROR_IPSEC_IKE_RATELIMIT_DROP           13903L
+
+#define ERROR_IPSEC_IKE_PEER_DOESNT_SUPPORT_MOBIKE 13904L
+
+#define ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE    13905L
+
+#define ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_FAILURE 13906L
+
+#define ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE_WITH_OPTIONAL_RETRY 13907L
+
+#define ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_AND_CERTMAP_FAILURE 13908L
+
+#define ERROR_IPSEC_IKE_NEG_STATUS_EXTENDED_END  13909L
+
+#define ERROR_IPSEC_BAD_SPI                      13910L
+
+#define ERROR_IPSEC_SA_LIFETIME_EXPIRED          13911L
+
+#define ERROR_IPSEC_WRONG_SA                     13912L
+
+#define ERROR_IPSEC_REPLAY_CHECK_FAILED          13913L
+
+#define ERROR_IPSEC_INVALID_PACKET               13914L
+
+#define ERROR_IPSEC_INTEGRITY_CHECK_FAILED       13915L
+
+#define ERROR_IPSEC_CLEAR_TEXT_DROP              13916L
+
+#define ERROR_IPSEC_AUTH_FIREWALL_DROP           13917L
+
+#define ERROR_IPSEC_THROTTLE_DROP                13918L
+
+#define ERROR_IPSEC_DOSP_BLOCK                   13925L
+
+#define ERROR_IPSEC_DOSP_RECEIVED_MULTICAST      13926L
+
+#define ERROR_IPSEC_DOSP_INVALID_PACKET          13927L
+
+#define ERROR_IPSEC_DOSP_STATE_LOOKUP_FAILED     13928L
+
+#define ERROR_IPSEC_DOSP_MAX_ENTRIES             13929L
+
+#define ERROR_IPSEC_DOSP_KEYMOD_NOT_ALLOWED      13930L
+
+#define ERROR_IPSEC_DOSP_NOT_INSTALLED           13931L
+
+#define ERROR_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES 13932L
+
+//
+// MessageId: ERROR_SXS_SECTION_NOT_FOUND
+//
+// MessageText:
+//
+// The requested section was not present in the activation context.
+//
+#define ERROR_SXS_SECTION_NOT_FOUND              14000L
+
+//
+// MessageId: ERROR_SXS_CANT_GEN_ACTCTX
+//
+// MessageText:
+//
+// The application has failed to start because its side-by-side configuration
+// is incorrect. Please see the application event log or use the command-line
+// sxstrace.exe tool for more detail.
+//
+#define ERROR_SXS_CANT_GEN_ACTCTX                14001L
+
+//
+// MessageId: ERROR_SXS_INVALID_ACTCTXDATA_FORMAT
+//
+// MessageText:
+//
+// The application binding data format is invalid.
+//
+#define ERROR_SXS_INVALID_ACTCTXDATA_FORMAT      14002L
+
+//
+// MessageId: ERROR_SXS_ASSEMBLY_NOT_FOUND
+//
+// MessageText:
+//
+// The referenced assembly is not installed on your system.
+//
+#define ERROR_SXS_ASSEMBLY_NOT_FOUND             14003L
+
+//
+// MessageId: ERROR_SXS_MANIFEST_FORMAT_ERROR
+//
+// MessageText:
+//
+// The manifest file does not begin with the required tag and format
+// information.
+//
+#define ERROR_SXS_MANIFEST_FORMAT_ERROR          14004L
+
+//
+// MessageId: ERROR_SXS_MANIFEST_PARSE_ERROR
+//
+// MessageText:
+//
+// The manifest file contains one or more syntax errors.
+//
+#define ERROR_SXS_MANIFEST_PARSE_ERROR           14005L
+
+//
+// MessageId: ERROR_SXS_ACTIVATION_CONTEXT_DISABLED
+//
+// MessageText:
+//
+// The application attempted to activate a disabled activation context.
+//
+#define ERROR_SXS_ACTIVATION_CONTEXT_DISABLED    14006L
+
+//
+// MessageId: ERROR_SXS_KEY_NOT_FOUND
+//
+// MessageText:
+//
+// The requested lookup key was not found in any active activation context.
+//
+#define ERROR_SXS_KEY_NOT_FOUND                  14007L
+
+//
+// MessageId: ERROR_SXS_VERSION_CONFLICT
+//
+// MessageText:
+//
+// A component version required by the application conflicts with another
+// component version already active.
+//
+#define ERROR_SXS_VERSION_CONFLICT               14008L
+
+//
+// MessageId: ERROR_SXS_WRONG_SECTION_TYPE
+//
+// MessageText:
+//
+// The type requested activation context section does not match the query API
+// used.
+//
+#define ERROR_SXS_WRONG_SECTION_TYPE             14009L
+
+#define ERROR_SXS_THREAD_QUERIES_DISABLED        14010L
+
+#define ERROR_SXS_PROCESS_DEFAULT_ALREADY_SET    14011L
+
+#define ERROR_SXS_UNKNOWN_ENCODING_GROUP         14012L
+
+#define ERROR_SXS_UNKNOWN_ENCODING               14013L
+
+#define ERROR_SXS_INVALID_XML_NAMESPACE_URI      14014L
+
+#define ERROR_SXS_ROOT_MANIFEST_DEPENDENCY_NOT_INSTALLED 14015L
+
+#define ERROR_SXS_LEAF_MANIFEST_DEPENDENCY_NOT_INSTALLED 14016L
+
+#define ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE 14017L
+
+#define ERROR_SXS_MANIFEST_MISSING_REQUIRED_DEFAULT_NAMESPACE 14018L
+
+#define ERROR_SXS_MANIFEST_INVALID_REQUIRED_DEFAULT_NAMESPACE 14019L
+
+#define ERROR_SXS_PRIVATE_MANIFEST_CROSS_PATH_WITH_REPARSE_POINT 14020L
+
+#define ERROR_SXS_DUPLICATE_DLL_NAME             14021L
+
+#define ERROR_SXS_DUPLICATE_WINDOWCLASS_NAME     14022L
+
+#define ERROR_SXS_DUPLICATE_CLSID                14023L
+
+#define ERROR_SXS_DUPLICATE_IID                  14024L
+
+#define ERROR_SXS_DUPLICATE_TLBID                14025L
+
+#define ERROR_SXS_DUPLICATE_PROGID               14026L
+
+#define ERROR_SXS_DUPLICATE_ASSEMBLY_NAME        14027L
+
+#define ERROR_SXS_FILE_HASH_MISMATCH             14028L
+
+#define ERROR_SXS_POLICY_PARSE_ERROR             14029L
+
+#define ERROR_SXS_XML_E_MISSINGQUOTE             14030L
+
+#define ERROR_SXS_XML_E_COMMENTSYNTAX            14031L
+
+#define ERROR_SXS_XML_E_BADSTARTNAMECHAR         14032L
+
+#define ERROR_SXS_XML_E_BADNAMECHAR              14033L
+
+#define ERROR_SXS_XML_E_BADCHARINSTRING          14034L
+
+#define ERROR_SXS_XML_E_XMLDECLSYNTAX            14035L
+
+#define ERROR_SXS_XML_E_BADCHARDATA              14036L
+
+#define ERROR_SXS_XML_E_MISSINGWHITESPACE        14037L
+
+#define ERROR_SXS_XML_E_EXPECTINGTAGEND          14038L
+
+#define ERROR_SXS_XML_E_MISSINGSEMICOLON         14039L
+
+#define ERROR_SXS_XML_E_UNBALANCEDPAREN          14040L
+
+#define ERROR_SXS_XML_E_INTERNALERROR            14041L
+
+#define ERROR_SXS_XML_E_UNEXPECTED_WHITESPACE    14042L
+
+#define ERROR_SXS_XML_E_INCOMPLETE_ENCODING      14043L
+
+#define ERROR_SXS_XML_E_MISSING_PAREN            14044L
+
+#define ERROR_SXS_XML_E_EXPECTINGCLOSEQUOTE      14045L
+
+#define ERROR_SXS_XML_E_MULTIPLE_COLONS          14046L
+
+#define ERROR_SXS_XML_E_INVALID_DECIMAL          14047L
+
+#define ERROR_SXS_XML_E_INVALID_HEXIDECIMAL      14048L
+
+#define ERROR_SXS_XML_E_INVALID_UNICODE          14049L
+
+#define ERROR_SXS_XML_E_WHITESPACEORQUESTIONMARK 14050L
+
+#define ERROR_SXS_XML_E_UNEXPECTEDENDTAG         14051L
+
+#define ERROR_SXS_XML_E_UNCLOSEDTAG              14052L
+
+#define ERROR_SXS_XML_E_DUPLICATEATTRIBUTE       14053L
+
+#define ERROR_SXS_XML_E_MULTIPLEROOTS            14054L
+
+#define ERROR_SXS_XML_E_INVALIDATROOTLEVEL       14055L
+
+#define ERROR_SXS_XML_E_BADXMLDECL               14056L
+
+#define ERROR_SXS_XML_E_MISSINGROOT              14057L
+
+#define ERROR_SXS_XML_E_UNEXPECTEDEOF            14058L
+
+#define ERROR_SXS_XML_E_BADPEREFINSUBSET         14059L
+
+#define ERROR_SXS_XML_E_UNCLOSEDSTARTTAG         14060L
+
+#define ERROR_SXS_XML_E_UNCLOSEDENDTAG           14061L
+
+#define ERROR_SXS_XML_E_UNCLOSEDSTRING           14062L
+
+#define ERROR_SXS_XML_E_UNCLOSEDCOMMENT          14063L
+
+#define ERROR_SXS_XML_E_UNCLOSEDDECL             14064L
+
+#define ERROR_SXS_XML_E_UNCLOSEDCDATA            14065L
+
+#define ERROR_SXS_XML_E_RESERVEDNAMESPACE        14066L
+
+#define ERROR_SXS_XML_E_INVALIDENCODING          14067L
+
+#define ERROR_SXS_XML_E_INVALIDSWITCH            14068L
+
+#define ERROR_SXS_XML_E_BADXMLCASE               14069L
+
+#define ERROR_SXS_XML_E_INVALID_STANDALONE       14070L
+
+#define ERROR_SXS_XML_E_UNEXPECTED_STANDALONE    14071L
+
+#define ERROR_SXS_XML_E_INVALID_VERSION          14072L
+
+#define ERROR_SXS_XML_E_MISSINGEQUALS            14073L
+
+#define ERROR_SXS_PROTECTION_RECOVERY_FAILED     14074L
+
+#define ERROR_SXS_PROTECTION_PUBLIC_KEY_TOO_SHORT 14075L
+
+#define ERROR_SXS_PROTECTION_CATALOG_NOT_VALID   14076L
+
+#define ERROR_SXS_UNTRANSLATABLE_HRESULT         14077L
+
+#define ERROR_SXS_PROTECTION_CATALOG_FILE_MISSING 14078L
+
+#define ERROR_SXS_MISSING_ASSEMBLY_IDENTITY_ATTRIBUTE 14079L
+
+#define ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE_NAME 14080L
+
+#define ERROR_SXS_ASSEMBLY_MISSING               14081L
+
+#define ERROR_SXS_CORRUPT_ACTIVATION_STACK       14082L
+
+#define ERROR_SXS_CORRUPTION                     14083L
+
+#define ERROR_SXS_EARLY_DEACTIVATION             14084L
+
+#define ERROR_SXS_INVALID_DEACTIVATION           14085L
+
+#define ERROR_SXS_MULTIPLE_DEACTIVATION          14086L
+
+#define ERROR_SXS_PROCESS_TERMINATION_REQUESTED  14087L
+
+#define ERROR_SXS_RELEASE_ACTIVATION_CONTEXT     14088L
+
+#define ERROR_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY 14089L
+
+#define ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE 14090L
+
+#define ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME 14091L
+
+#define ERROR_SXS_IDENTITY_DUPLICATE_ATTRIBUTE   14092L
+
+#define ERROR_SXS_IDENTITY_PARSE_ERROR           14093L
+
+#define ERROR_MALFORMED_SUBSTITUTION_STRING      14094L
+
+#define ERROR_SXS_INCORRECT_PUBLIC_KEY_TOKEN     14095L
+
+#define ERROR_UNMAPPED_SUBSTITUTION_STRING       14096L
+
+#define ERROR_SXS_ASSEMBLY_NOT_LOCKED            14097L
+
+#define ERROR_SXS_COMPONENT_STORE_CORRUPT        14098L
+
+#define ERROR_ADVANCED_INSTALLER_FAILED          14099L
+
+#define ERROR_XML_ENCODING_MISMATCH              14100L
+
+#define ERROR_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT 14101L
+
+#define ERROR_SXS_IDENTITIES_DIFFERENT           14102L
+
+#define ERROR_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT   14103L
+
+#define ERROR_SXS_FILE_NOT_PART_OF_ASSEMBLY      14104L
+
+#define ERROR_SXS_MANIFEST_TOO_BIG               14105L
+
+#define ERROR_SXS_SETTING_NOT_REGISTERED         14106L
+
+#define ERROR_SXS_TRANSACTION_CLOSURE_INCOMPLETE 14107L
+
+#define ERROR_SMI_PRIMITIVE_INSTALLER_FAILED     14108L
+
+#define ERROR_GENERIC_COMMAND_FAILED             14109L
+
+#define ERROR_SXS_FILE_HASH_MISSING              14110L
+
+#define ERROR_SXS_DUPLICATE_ACTIVATABLE_CLASS    14111L
+
+//
+// MessageId: ERROR_EVT_INVALID_CHANNEL_PATH
+//
+// MessageText:
+//
+// The specified channel path is invalid.
+//
+#define ERROR_EVT_INVALID_CHANNEL_PATH           15000L
+
+//
+// MessageId: ERROR_EVT_INVALID_QUERY
+//
+// MessageText:
+//
+// The specified query is invalid.
+//
+#define ERROR_EVT_INVALID_QUERY                  15001L
+
+//
+// MessageId: ERROR_EVT_PUBLISHER_METADATA_NOT_FOUND
+//
+// MessageText:
+//
+// The publisher metadata cannot be found in the resource.
+//
+#define ERROR_EVT_PUBLISHER_METADATA_NOT_FOUND   15002L
+
+//
+// MessageId: ERROR_EVT_EVENT_TEMPLATE_NOT_FOUND
+//
+// MessageText:
+//
+// The template for an event definition cannot be found in the resource (error
+// = %1).
+//
+#define ERROR_EVT_EVENT_TEMPLATE_NOT_FOUND       15003L
+
+//
+// MessageId: ERROR_EVT_INVALID_PUBLISHER_NAME
+//
+// MessageText:
+//
+// The specified publisher name is invalid.
+//
+#define ERROR_EVT_INVALID_PUBLISHER_NAME         15004L
+
+//
+// MessageId: ERROR_EVT_INVALID_EVENT_DATA
+//
+// MessageText:
+//
+// The event data raised by the publisher is not compatible with the event
+// template definition in the publisher's manifest.
+//
+#define ERROR_EVT_INVALID_EVENT_DATA             15005L
+
+//
+// MessageId: ERROR_EVT_CHANNEL_NOT_FOUND
+//
+// MessageText:
+//
+// The specified channel could not be found.
+//
+#define ERROR_EVT_CHANNEL_NOT_FOUND              15007L
+
+//
+// MessageId: ERROR_EVT_MALFORMED_XML_TEXT
+//
+// MessageText:
+//
+// The specified XML text was not well-formed. See Extended Error for more
+// details.
+//
+#define ERROR_EVT_MALFORMED_XML_TEXT             15008L
+
+#define ERROR_EVT_SUBSCRIPTION_TO_DIRECT_CHANNEL 15009L
+
+#define ERROR_EVT_CONFIGURATION_ERROR            15010L
+
+#define ERROR_EVT_QUERY_RESULT_STALE             15011L
+
+#define ERROR_EVT_QUERY_RESULT_INVALID_POSITION  15012L
+
+#define ERROR_EVT_NON_VALIDATING_MSXML           15013L
+
+#define ERROR_EVT_FILTER_ALREADYSCOPED           15014L
+
+#define ERROR_EVT_FILTER_NOTELTSET               15015L
+
+#define ERROR_EVT_FILTER_INVARG                  15016L
+
+#define ERROR_EVT_FILTER_INVTEST                 15017L
+
+#define ERROR_EVT_FILTER_INVTYPE                 15018L
+
+#define ERROR_EVT_FILTER_PARSEERR                15019L
+
+#define ERROR_EVT_FILTER_UNSUPPORTEDOP           15020L
+
+#define ERROR_EVT_FILTER_UNEXPECTEDTOKEN         15021L
+
+#define ERROR_EVT_INVALID_OPERATION_OVER_ENABLED_DIRECT_CHANNEL 15022L
+
+#define ERROR_EVT_INVALID_CHANNEL_PROPERTY_VALUE 15023L
+
+#define ERROR_EVT_INVALID_PUBLISHER_PROPERTY_VALUE 15024L
+
+#define ERROR_EVT_CHANNEL_CANNOT_ACTIVATE        15025L
+
+#define ERROR_EVT_FILTER_TOO_COMPLEX             15026L
+
+#define ERROR_EVT_MESSAGE_NOT_FOUND              15027L
+
+#define ERROR_EVT_MESSAGE_ID_NOT_FOUND           15028L
+
+#define ERROR_EVT_UNRESOLVED_VALUE_INSERT        15029L
+
+#define ERROR_EVT_UNRESOLVED_PARAMETER_INSERT    15030L
+
+#define ERROR_EVT_MAX_INSERTS_REACHED            15031L
+
+#define ERROR_EVT_EVENT_DEFINITION_NOT_FOUND     15032L
+
+#define ERROR_EVT_MESSAGE_LOCALE_NOT_FOUND       15033L
+
+#define ERROR_EVT_VERSION_TOO_OLD                15034L
+
+#define ERROR_EVT_VERSION_TOO_NEW                15035L
+
+#define ERROR_EVT_CANNOT_OPEN_CHANNEL_OF_QUERY   15036L
+
+#define ERROR_EVT_PUBLISHER_DISABLED             15037L
+
+#define ERROR_EVT_FILTER_OUT_OF_RANGE            15038L
+
+#define ERROR_EC_SUBSCRIPTION_CANNOT_ACTIVATE    15080L
+
+#define ERROR_EC_LOG_DISABLED                    15081L
+
+#define ERROR_EC_CIRCULAR_FORWARDING             15082L
+
+#define ERROR_EC_CREDSTORE_FULL                  15083L
+
+#define ERROR_EC_CRED_NOT_FOUND                  15084L
+
+#define ERROR_EC_NO_ACTIVE_CHANNEL               15085L
+
+#define ERROR_MUI_FILE_NOT_FOUND                 15100L
+
+#define ERROR_MUI_INVALID_FILE                   15101L
+
+#define ERROR_MUI_INVALID_RC_CONFIG              15102L
+
+#define ERROR_MUI_INVALID_LOCALE_NAME            15103L
+
+#define ERROR_MUI_INVALID_ULTIMATEFALLBACK_NAME  15104L
+
+#define ERROR_MUI_FILE_NOT_LOADED                15105L
+
+#define ERROR_RESOURCE_ENUM_USER_STOP            15106L
+
+#define ERROR_MUI_INTLSETTINGS_UILANG_NOT_INSTALLED 15107L
+
+#define ERROR_MUI_INTLSETTINGS_INVALID_LOCALE_NAME 15108L
+
+#define ERROR_MRM_RUNTIME_NO_DEFAULT_OR_NEUTRAL_RESOURCE 15110L
+
+#define ERROR_MRM_INVALID_PRICONFIG              15111L
+
+#define ERROR_MRM_INVALID_FILE_TYPE              15112L
+
+#define ERROR_MRM_UNKNOWN_QUALIFIER              15113L
+
+#define ERROR_MRM_INVALID_QUALIFIER_VALUE        15114L
+
+#define ERROR_MRM_NO_CANDIDATE                   15115L
+
+#define ERROR_MRM_NO_MATCH_OR_DEFAULT_CANDIDATE  15116L
+
+#define ERROR_MRM_RESOURCE_TYPE_MISMATCH         15117L
+
+#define ERROR_MRM_DUPLICATE_MAP_NAME             15118L
+
+#define ERROR_MRM_DUPLICATE_ENTRY                15119L
+
+#define ERROR_MRM_INVALID_RESOURCE_IDENTIFIER    15120L
+
+#define ERROR_MRM_FILEPATH_TOO_LONG              15121L
+
+#define ERROR_MRM_UNSUPPORTED_DIRECTORY_TYPE     15122L
+
+#define ERROR_MRM_INVALID_PRI_FILE               15126L
+
+#define ERROR_MRM_NAMED_RESOURCE_NOT_FOUND       15127L
+
+#define ERROR_MRM_MAP_NOT_FOUND                  15135L
+
+#define ERROR_MRM_UNSUPPORTED_PROFILE_TYPE       15136L
+
+#define ERROR_MRM_INVALID_QUALIFIER_OPERATOR     15137L
+
+#define ERROR_MRM_INDETERMINATE_QUALIFIER_VALUE  15138L
+
+#define ERROR_MRM_AUTOMERGE_ENABLED              15139L
+
+#define ERROR_MRM_TOO_MANY_RESOURCES             15140L
+
+#define ERROR_MRM_UNSUPPORTED_FILE_TYPE_FOR_MERGE 15141L
+
+#define ERROR_MRM_UNSUPPORTED_FILE_TYPE_FOR_LOAD_UNLOAD_PRI_FILE 15142L
+
+#define ERROR_MRM_NO_CURRENT_VIEW_ON_THREAD      15143L
+
+#define ERROR_DIFFERENT_PROFILE_RESOURCE_MANAGER_EXIST 15144L
+
+#define ERROR_OPERATION_NOT_ALLOWED_FROM_SYSTEM_COMPONENT 15145L
+
+#define ERROR_MRM_DIRECT_REF_TO_NON_DEFAULT_RESOURCE 15146L
+
+#define ERROR_MRM_GENERATION_COUNT_MISMATCH      15147L
+
+#define ERROR_PRI_MERGE_VERSION_MISMATCH         15148L
+
+#define ERROR_PRI_MERGE_MISSING_SCHEMA           15149L
+
+#define ERROR_PRI_MERGE_LOAD_FILE_FAILED         15150L
+
+#define ERROR_PRI_MERGE_ADD_FILE_FAILED          15151L
+
+#define ERROR_PRI_MERGE_WRITE_FILE_FAILED        15152L
+
+#define ERROR_PRI_MERGE_MULTIPLE_PACKAGE_FAMILIES_NOT_ALLOWED 15153L
+
+#define ERROR_PRI_MERGE_MULTIPLE_MAIN_PACKAGES_NOT_ALLOWED 15154L
+
+#define ERROR_PRI_MERGE_BUNDLE_PACKAGES_NOT_ALLOWED 15155L
+
+#define ERROR_PRI_MERGE_MAIN_PACKAGE_REQUIRED    15156L
+
+#define ERROR_PRI_MERGE_RESOURCE_PACKAGE_REQUIRED 15157L
+
+#define ERROR_PRI_MERGE_INVALID_FILE_NAME        15158L
+
+#define ERROR_MRM_PACKAGE_NOT_FOUND              15159L
+
+#define ERROR_MRM_MISSING_DEFAULT_LANGUAGE       15160L
+
+#define ERROR_MCA_INVALID_CAPABILITIES_STRING    15200L
+
+#define ERROR_MCA_INVALID_VCP_VERSION            15201L
+
+#define ERROR_MCA_MONITOR_VIOLATES_MCCS_SPECIFICATION 15202L
+
+#define ERROR_MCA_MCCS_VERSION_MISMATCH          15203L
+
+#define ERROR_MCA_UNSUPPORTED_MCCS_VERSION       15204L
+
+#define ERROR_MCA_INTERNAL_ERROR                 15205L
+
+#define ERROR_MCA_INVALID_TECHNOLOGY_TYPE_RETURNED 15206L
+
+#define ERROR_MCA_UNSUPPORTED_COLOR_TEMPERATURE  15207L
+
+#define ERROR_AMBIGUOUS_SYSTEM_DEVICE            15250L
+
+#define ERROR_SYSTEM_DEVICE_NOT_FOUND            15299L
+
+#define ERROR_HASH_NOT_SUPPORTED                 15300L
+
+#define ERROR_HASH_NOT_PRESENT                   15301L
+
+#define ERROR_SECONDARY_IC_PROVIDER_NOT_REGISTERED 15321L
+
+#define ERROR_GPIO_CLIENT_INFORMATION_INVALID    15322L
+
+#define ERROR_GPIO_VERSION_NOT_SUPPORTED         15323L
+
+#define ERROR_GPIO_INVALID_REGISTRATION_PACKET   15324L
+
+#define ERROR_GPIO_OPERATION_DENIED              15325L
+
+#define ERROR_GPIO_INCOMPATIBLE_CONNECT_MODE     15326L
+
+#define ERROR_GPIO_INTERRUPT_ALREADY_UNMASKED    15327L
+
+#define ERROR_CANNOT_SWITCH_RUNLEVEL             15400L
+
+#define ERROR_INVALID_RUNLEVEL_SETTING           15401L
+
+#define ERROR_RUNLEVEL_SWITCH_TIMEOUT            15402L
+
+#define ERROR_RUNLEVEL_SWITCH_AGENT_TIMEOUT      15403L
+
+#define ERROR_RUNLEVEL_SWITCH_IN_PROGRESS        15404L
+
+#define ERROR_SERVICES_FAILED_AUTOSTART          15405L
+
+#define ERROR_COM_TASK_STOP_PENDING              15501L
+
+//
+// MessageId: ERROR_INSTALL_OPEN_PACKAGE_FAILED
+//
+// MessageText:
+//
+// Package could not be opened.
+//
+#define ERROR_INSTALL_OPEN_PACKAGE_FAILED        15600L
+
+//
+// MessageId: ERROR_INSTALL_PACKAGE_NOT_FOUND
+//
+// MessageText:
+//
+// Package was not found.
+//
+#define ERROR_INSTALL_PACKAGE_NOT_FOUND          15601L
+
+//
+// MessageId: ERROR_INSTALL_INVALID_PACKAGE
+//
+// MessageText:
+//
+// Package data is invalid.
+//
+#define ERROR_INSTALL_INVALID_PACKAGE            15602L
+
+//
+// MessageId: ERROR_INSTALL_RESOLVE_DEPENDENCY_FAILED
+//
+// MessageText:
+//
+// Package failed updates, dependency or conflict validation.
+//
+#define ERROR_INSTALL_RESOLVE_DEPENDENCY_FAILED  15603L
+
+//
+// MessageId: ERROR_INSTALL_OUT_OF_DISK_SPACE
+//
+// MessageText:
+//
+// There is not enough disk space on your computer. Please free up some space
+// and try again.
+//
+#define ERROR_INSTALL_OUT_OF_DISK_SPACE          15604L
+
+//
+// MessageId: ERROR_INSTALL_NETWORK_FAILURE
+//
+// MessageText:
+//
+// There was a problem downloading your product.
+//
+#define ERROR_INSTALL_NETWORK_FAILURE            15605L
+
+//
+// MessageId: ERROR_INSTALL_REGISTRATION_FAILURE
+//
+// MessageText:
+//
+// Package could not be registered.
+//
+#define ERROR_INSTALL_REGISTRATION_FAILURE       15606L
+
+//
+// MessageId: ERROR_INSTALL_DEREGISTRATION_FAILURE
+//
+// MessageText:
+//
+// Package could not be unregistered.
+//
+#define ERROR_INSTALL_DEREGISTRATION_FAILURE     15607L
+
+//
+// MessageId: ERROR_INSTALL_CANCEL
+//
+// MessageText:
+//
+// User cancelled the install request.
+//
+#define ERROR_INSTALL_CANCEL                     15608L
+
+//
+// MessageId: ERROR_INSTALL_FAILED
+//
+// MessageText:
+//
+// Install failed. Please contact your software vendor.
+//
+#define ERROR_INSTALL_FAILED                     15609L
+
+//
+// MessageId: ERROR_REMOVE_FAILED
+//
+// MessageText:
+//
+// Removal failed. Please contact your software vendor.
+//
+#define ERROR_REMOVE_FAILED                      15610L
+
+//
+// MessageId: ERROR_PACKAGE_ALREADY_EXISTS
+//
+// MessageText:
+//
+// The provided package is already installed, and reinstallation of the package
+// was blocked. Check the AppXDeployment-Server event log for details.
+//
+#define ERROR_PACKAGE_ALREADY_EXISTS             15611L
+
+//
+// MessageId: ERROR_NEEDS_REMEDIATION
+//
+// MessageText:
+//
+// The application cannot be started. Try reinstalling the application to fix
+// the problem.
+//
+#define ERROR_NEEDS_REMEDIATION                  15612L
+
+//
+// MessageId: ERROR_INSTALL_PREREQUISITE_FAILED
+//
+// MessageText:
+//
+// A Prerequisite for an install could not be satisfied.
+//
+#define ERROR_INSTALL_PREREQUISITE_FAILED        15613L
+
+//
+// MessageId: ERROR_PACKAGE_REPOSITORY_CORRUPTED
+//
+// MessageText:
+//
+// The package repository is corrupted.
+//
+#define ERROR_PACKAGE_REPOSITORY_CORRUPTED       15614L
+
+//
+// MessageId: ERROR_INSTALL_POLICY_FAILURE
+//
+// MessageText:
+//
+// To install this application you need either a Windows developer license or a
+// sideloading-enabled system.
+//
+#define ERROR_INSTALL_POLICY_FAILURE             15615L
+
+//
+// MessageId: ERROR_PACKAGE_UPDATING
+//
+// MessageText:
+//
+// The application cannot be started because it is currently updating.
+//
+#define ERROR_PACKAGE_UPDATING                   15616L
+
+//
+// MessageId: ERROR_DEPLOYMENT_BLOCKED_BY_POLICY
+//
+// MessageText:
+//
+// The package deployment operation is blocked by policy. Please contact your
+// system administrator.
+//
+#define ERROR_DEPLOYMENT_BLOCKED_BY_POLICY       15617L
+
+//
+// MessageId: ERROR_PACKAGES_IN_USE
+//
+// MessageText:
+//
+// The package could not be installed because resources it modifies are
+// currently in use.
+//
+#define ERROR_PACKAGES_IN_USE                    15618L
+
+#define ERROR_RECOVERY_FILE_CORRUPT              15619L
+
+#define ERROR_INVALID_STAGED_SIGNATURE           15620L
+
+#define ERROR_DELETING_EXISTING_APPLICATIONDATA_STORE_FAILED 15621L
+
+//
+// MessageId: ERROR_INSTALL_PACKAGE_DOWNGRADE
+//
+// MessageText:
+//
+// A higher version of this package is already installed.
+//
+#define ERROR_INSTALL_PACKAGE_DOWNGRADE          15622L
+
+#define ERROR_SYSTEM_NEEDS_REMEDIATION           15623L
+
+#define ERROR_APPX_INTEGRITY_FAILURE_CLR_NGEN    15624L
+
+#define ERROR_RESILIENCY_FILE_CORRUPT            15625L
+
+#define ERROR_INSTALL_FIREWALL_SERVICE_NOT_RUNNING 15626L
+
+#define ERROR_PACKAGE_MOVE_FAILED                15627L
+
+#define ERROR_INSTALL_VOLUME_NOT_EMPTY           15628L
+
+#define ERROR_INSTALL_VOLUME_OFFLINE             15629L
+
+#define ERROR_INSTALL_VOLUME_CORRUPT             15630L
+
+#define ERROR_NEEDS_REGISTRATION                 15631L
+
+//
+// MessageId: ERROR_INSTALL_WRONG_PROCESSOR_ARCHITECTURE
+//
+// MessageText:
+//
+// The package could not be installed because it is not supported by the
+// computer's processor architecture.
+//
+#define ERROR_INSTALL_WRONG_PROCESSOR_ARCHITECTURE 15632L
+
+#define ERROR_DEV_SIDELOAD_LIMIT_EXCEEDED        15633L
+
+#define ERROR_INSTALL_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE 15634L
+
+#define ERROR_PACKAGE_NOT_SUPPORTED_ON_FILESYSTEM 15635L
+
+#define ERROR_PACKAGE_MOVE_BLOCKED_BY_STREAMING  15636L
+
+#define ERROR_INSTALL_OPTIONAL_PACKAGE_APPLICATIONID_NOT_UNIQUE 15637L
+
+#define ERROR_PACKAGE_STAGING_ONHOLD             15638L
+
+#define ERROR_INSTALL_INVALID_RELATED_SET_UPDATE 15639L
+
+#define ERROR_INSTALL_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE_FULLTRUST_CAPABILITY 15640L
+
+#define ERROR_DEPLOYMENT_BLOCKED_BY_USER_LOG_OFF 15641L
+
+#define ERROR_PROVISION_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE_PROVISIONED 15642L
+
+#define ERROR_PACKAGES_REPUTATION_CHECK_FAILED   15643L
+
+#define ERROR_PACKAGES_REPUTATION_CHECK_TIMEDOUT 15644L
+
+#define ERROR_DEPLOYMENT_OPTION_NOT_SUPPORTED    15645L
+
+#define ERROR_APPINSTALLER_ACTIVATION_BLOCKED    15646L
+
+#define ERROR_REGISTRATION_FROM_REMOTE_DRIVE_NOT_SUPPORTED 15647L
+
+#define ERROR_APPX_RAW_DATA_WRITE_FAILED         15648L
+
+#define ERROR_DEPLOYMENT_BLOCKED_BY_VOLUME_POLICY_PACKAGE 15649L
+
+#define ERROR_DEPLOYMENT_BLOCKED_BY_VOLUME_POLICY_MACHINE 15650L
+
+#define ERROR_DEPLOYMENT_BLOCKED_BY_PROFILE_POLICY 15651L
+
+#define ERROR_DEPLOYMENT_FAILED_CONFLICTING_MUTABLE_PACKAGE_DIRECTORY 15652L
+
+#define ERROR_SINGLETON_RESOURCE_INSTALLED_IN_ACTIVE_USER 15653L
+
+#define ERROR_DIFFERENT_VERSION_OF_PACKAGED_SERVICE_INSTALLED 15654L
+
+#define ERROR_SERVICE_EXISTS_AS_NON_PACKAGED_SERVICE 15655L
+
+#define ERROR_PACKAGED_SERVICE_REQUIRES_ADMIN_PRIVILEGES 15656L
+
+#define ERROR_STATE_LOAD_STORE_FAILED            15800L
+
+#define ERROR_STATE_GET_VERSION_FAILED           15801L
+
+#define ERROR_STATE_SET_VERSION_FAILED           15802L
+
+#define ERROR_STATE_STRUCTURED_RESET_FAILED      15803L
+
+#define ERROR_STATE_OPEN_CONTAINER_FAILED        15804L
+
+#define ERROR_STATE_CREATE_CONTAINER_FAILED      15805L
+
+#define ERROR_STATE_DELETE_CONTAINER_FAILED      15806L
+
+#define ERROR_STATE_READ_SETTING_FAILED          15807L
+
+#define ERROR_STATE_WRITE_SETTING_FAILED         15808L
+
+#define ERROR_STATE_DELETE_SETTING_FAILED        15809L
+
+#define ERROR_STATE_QUERY_SETTING_FAILED         15810L
+
+#define ERROR_STATE_READ_COMPOSITE_SETTING_FAILED 15811L
+
+#define ERROR_STATE_WRITE_COMPOSITE_SETTING_FAILED 15812L
+
+#define ERROR_STATE_ENUMERATE_CONTAINER_FAILED   15813L
+
+#define ERROR_STATE_ENUMERATE_SETTINGS_FAILED    15814L
+
+#define ERROR_STATE_COMPOSITE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED 15815L
+
+#define ERROR_STATE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED 15816L
+
+#define ERROR_STATE_SETTING_NAME_SIZE_LIMIT_EXCEEDED 15817L
+
+#define ERROR_STATE_CONTAINER_NAME_SIZE_LIMIT_EXCEEDED 15818L
+
+#define ERROR_API_UNAVAILABLE                    15841L
@@ -0,0 +1,121 @@
+package exitcodes
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed data/ntstatus_win32.txt
+var ntStatusWin32Table string
+
+var (
+	// ntStatusToWin32Map maps NTSTATUS codes to their Win32 error code
+	ntStatusToWin32Map map[uint32]uint32
+
+	// win32ToNTStatusMap maps Win32 error codes to every NTSTATUS code that
+	// translates to them, sorted in ascending order
+	win32ToNTStatusMap map[uint32][]uint32
+)
+
+func init() {
+	var err error
+	ntStatusToWin32Map, win32ToNTStatusMap, err = parseNTStatusWin32Table(ntStatusWin32Table)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// parseNTStatusWin32Table parses the embedded translation table into the
+// forward and reverse lookup maps
+func parseNTStatusWin32Table(table string) (map[uint32]uint32, map[uint32][]uint32, error) {
+	forward := make(map[uint32]uint32)
+	reverse := make(map[uint32][]uint32)
+
+	scanner := bufio.NewScanner(strings.NewReader(table))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return nil, nil, fmt.Errorf("ntstatus_win32.txt:%d: expected at least 2 fields", lineNumber)
+		}
+		status, err := strconv.ParseUint(fields[0], 0, 32)
+		if err != nil {
+			return nil, nil, fmt.Errorf("ntstatus_win32.txt:%d: %v", lineNumber, err)
+		}
+		win32, err := strconv.ParseUint(fields[1], 0, 32)
+		if err != nil {
+			return nil, nil, fmt.Errorf("ntstatus_win32.txt:%d: %v", lineNumber, err)
+		}
+
+		forward[uint32(status)] = uint32(win32)
+		reverse[uint32(win32)] = append(reverse[uint32(win32)], uint32(status))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	for _, statuses := range reverse {
+		sort.Slice(statuses, func(i, j int) bool { return statuses[i] < statuses[j] })
+	}
+	return forward, reverse, nil
+}
+
+// NTStatusToWin32 returns the Win32 error code equivalent to an NTSTATUS code.
+// Codes in FACILITY_NTWIN32 carry the Win32 code in their low 16 bits.
+//
+// The embedded table is partial: it covers the commonly returned codes, not
+// every code in ntstatus.h. The boolean result is false for codes it does not
+// list, even if ntdll would translate them.
+func NTStatusToWin32(code uint32) (uint32, bool) {
+	// 0xDxxxxxxx codes are treated as their 0xCxxxxxxx counterparts
+	if code&0xF0000000 == 0xD0000000 {
+		code &^= 0x10000000
+	}
+	if (code>>16)&0xFFF == 0x7 {
+		return code & 0xFFFF, true
+	}
+	win32, exists := ntStatusToWin32Map[code]
+	return win32, exists
+}
+
+// RtlNtStatusToDosError is a pure-Go equivalent of the ntdll function of the
+// same name. It translates an NTSTATUS code into a Win32 error code without
+// calling into ntdll, so it can also be used on non-Windows systems.
+//
+// Like the native function, codes with the customer bit set are returned
+// unchanged and codes with no Win32 equivalent yield ERROR_MR_MID_NOT_FOUND.
+// Because the translation table is partial, codes it does not list also yield
+// ERROR_MR_MID_NOT_FOUND, where ntdll may return a more specific error.
+func RtlNtStatusToDosError(code uint32) uint32 {
+	if code&0x20000000 != 0 {
+		return code
+	}
+	if win32, exists := NTStatusToWin32(code); exists {
+		return win32
+	}
+	return uint32(ERROR_MR_MID_NOT_FOUND)
+}
+
+// Win32ToNTStatus returns every NTSTATUS code that translates to the given
+// Win32 error code, in ascending order. Several NTSTATUS codes commonly map to
+// the same Win32 code (e.g. STATUS_NO_SUCH_FILE and STATUS_OBJECT_NAME_NOT_FOUND
+// both become ERROR_FILE_NOT_FOUND). It returns nil if none are known.
+func Win32ToNTStatus(code uint32) []uint32 {
+	statuses := win32ToNTStatusMap[code]
+	if len(statuses) == 0 {
+		return nil
+	}
+	result := make([]uint32, len(statuses))
+	copy(result, statuses)
+	return result
+}
@@ -0,0 +1,100 @@
+package exitcodes
+
+import (
+	"testing"
+)
+
+func TestNTStatusToWin32(t *testing.T) {
+	tests := []struct {
+		code      uint32
+		wantWin32 uint32
+		wantOK    bool
+	}{
+		{0x00000000, 0, true},    // STATUS_SUCCESS
+		{0xC0000022, 5, true},    // STATUS_ACCESS_DENIED
+		{0xC0000034, 2, true},    // STATUS_OBJECT_NAME_NOT_FOUND
+		{0xC0000061, 1314, true}, // STATUS_PRIVILEGE_NOT_HELD
+		{0x80000005, 234, true},  // STATUS_BUFFER_OVERFLOW
+		{0xC0070057, 87, true},   // FACILITY_NTWIN32 wrapping ERROR_INVALID_PARAMETER
+		{0xD0000022, 5, true},    // 0xD... treated as 0xC...
+		{0xC0FF0001, 0, false},   // Unknown
+	}
+
+	for _, tt := range tests {
+		got, ok := NTStatusToWin32(tt.code)
+		if ok != tt.wantOK || (ok && got != tt.wantWin32) {
+			t.Errorf("NTStatusToWin32(0x%08X) = (%d, %v), want (%d, %v)", tt.code, got, ok, tt.wantWin32, tt.wantOK)
+		}
+	}
+}
+
+func TestRtlNtStatusToDosError(t *testing.T) {
+	tests := []struct {
+		code uint32
+		want uint32
+	}{
+		{0x00000000, 0},
+		{0xC0000023, 122},
+		{0xC0000120, 995},
+		{0xE0000001, 0xE0000001},                     // Customer bit set, returned unchanged
+		{0xC0FF0001, uint32(ERROR_MR_MID_NOT_FOUND)}, // Unknown
+		{0xC0000222, uint32(ERROR_MR_MID_NOT_FOUND)}, // STATUS_LOST_WRITEBEHIND_DATA, not in the table
+	}
+
+	for _, tt := range tests {
+		if got := RtlNtStatusToDosError(tt.code); got != tt.want {
+			t.Errorf("RtlNtStatusToDosError(0x%08X) = %d, want %d", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestWin32ToNTStatus(t *testing.T) {
+	got := Win32ToNTStatus(2) // ERROR_FILE_NOT_FOUND
+	want := map[uint32]bool{0xC000000E: true, 0xC000000F: true, 0xC0000034: true}
+	for code := range want {
+		found := false
+		for _, status := range got {
+			if status == code {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("Win32ToNTStatus(2) = %X, missing 0x%08X", got, code)
+		}
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i-1] >= got[i] {
+			t.Errorf("Win32ToNTStatus(2) is not sorted: %X", got)
+		}
+	}
+
+	if got := Win32ToNTStatus(0xFFFFFF); got != nil {
+		t.Errorf("Win32ToNTStatus(unknown) = %X, want nil", got)
+	}
+}
+
+func TestNTStatusWin32TableRoundTrip(t *testing.T) {
+	// Every forward mapping must appear in the reverse table
+	for status, win32 := range ntStatusToWin32Map {
+		found := false
+		for _, candidate := range Win32ToNTStatus(win32) {
+			if candidate == status {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("0x%08X -> %d is missing from the reverse table", status, win32)
+		}
+	}
+}
+
+func TestParseNTStatusWin32TableErrors(t *testing.T) {
+	if _, _, err := parseNTStatusWin32Table("0xC0000022\n"); err == nil {
+		t.Error("expected error for line with missing Win32 code")
+	}
+	if _, _, err := parseNTStatusWin32Table("nothex 5\n"); err == nil {
+		t.Error("expected error for malformed NTSTATUS")
+	}
+}
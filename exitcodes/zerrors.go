@@ -0,0 +1,5225 @@
+// Code generated by mkcodes from testdata/winerror.h; DO NOT EDIT.
+
+package exitcodes
+
+import "syscall"
+
+// Win32 error codes
+const (
+	ERROR_SUCCESS                                                             syscall.Errno = 0
+	ERROR_INVALID_FUNCTION                                                    syscall.Errno = 1
+	ERROR_FILE_NOT_FOUND                                                      syscall.Errno = 2
+	ERROR_PATH_NOT_FOUND                                                      syscall.Errno = 3
+	ERROR_TOO_MANY_OPEN_FILES                                                 syscall.Errno = 4
+	ERROR_ACCESS_DENIED                                                       syscall.Errno = 5
+	ERROR_INVALID_HANDLE                                                      syscall.Errno = 6
+	ERROR_ARENA_TRASHED                                                       syscall.Errno = 7
+	ERROR_NOT_ENOUGH_MEMORY                                                   syscall.Errno = 8
+	ERROR_INVALID_BLOCK                                                       syscall.Errno = 9
+	ERROR_BAD_ENVIRONMENT                                                     syscall.Errno = 10
+	ERROR_BAD_FORMAT                                                          syscall.Errno = 11
+	ERROR_INVALID_ACCESS                                                      syscall.Errno = 12
+	ERROR_INVALID_DATA                                                        syscall.Errno = 13
+	ERROR_OUTOFMEMORY                                                         syscall.Errno = 14
+	ERROR_INVALID_DRIVE                                                       syscall.Errno = 15
+	ERROR_CURRENT_DIRECTORY                                                   syscall.Errno = 16
+	ERROR_NOT_SAME_DEVICE                                                     syscall.Errno = 17
+	ERROR_NO_MORE_FILES                                                       syscall.Errno = 18
+	ERROR_WRITE_PROTECT                                                       syscall.Errno = 19
+	ERROR_BAD_UNIT                                                            syscall.Errno = 20
+	ERROR_NOT_READY                                                           syscall.Errno = 21
+	ERROR_BAD_COMMAND                                                         syscall.Errno = 22
+	ERROR_CRC                                                                 syscall.Errno = 23
+	ERROR_BAD_LENGTH                                                          syscall.Errno = 24
+	ERROR_SEEK                                                                syscall.Errno = 25
+	ERROR_NOT_DOS_DISK                                                        syscall.Errno = 26
+	ERROR_SECTOR_NOT_FOUND                                                    syscall.Errno = 27
+	ERROR_OUT_OF_PAPER                                                        syscall.Errno = 28
+	ERROR_WRITE_FAULT                                                         syscall.Errno = 29
+	ERROR_READ_FAULT                                                          syscall.Errno = 30
+	ERROR_GEN_FAILURE                                                         syscall.Errno = 31
+	ERROR_SHARING_VIOLATION                                                   syscall.Errno = 32
+	ERROR_LOCK_VIOLATION                                                      syscall.Errno = 33
+	ERROR_WRONG_DISK                                                          syscall.Errno = 34
+	ERROR_SHARING_BUFFER_EXCEEDED                                             syscall.Errno = 36
+	ERROR_HANDLE_EOF                                                          syscall.Errno = 38
+	ERROR_HANDLE_DISK_FULL                                                    syscall.Errno = 39
+	ERROR_NOT_SUPPORTED                                                       syscall.Errno = 50
+	ERROR_REM_NOT_LIST                                                        syscall.Errno = 51
+	ERROR_DUP_NAME                                                            syscall.Errno = 52
+	ERROR_BAD_NETPATH                                                         syscall.Errno = 53
+	ERROR_NETWORK_BUSY                                                        syscall.Errno = 54
+	ERROR_DEV_NOT_EXIST                                                       syscall.Errno = 55
+	ERROR_TOO_MANY_CMDS                                                       syscall.Errno = 56
+	ERROR_ADAP_HDW_ERR                                                        syscall.Errno = 57
+	ERROR_BAD_NET_RESP                                                        syscall.Errno = 58
+	ERROR_UNEXP_NET_ERR                                                       syscall.Errno = 59
+	ERROR_BAD_REM_ADAP                                                        syscall.Errno = 60
+	ERROR_PRINTQ_FULL                                                         syscall.Errno = 61
+	ERROR_NO_SPOOL_SPACE                                                      syscall.Errno = 62
+	ERROR_PRINT_CANCELLED                                                     syscall.Errno = 63
+	ERROR_NETNAME_DELETED                                                     syscall.Errno = 64
+	ERROR_NETWORK_ACCESS_DENIED                                               syscall.Errno = 65
+	ERROR_BAD_DEV_TYPE                                                        syscall.Errno = 66
+	ERROR_BAD_NET_NAME                                                        syscall.Errno = 67
+	ERROR_TOO_MANY_NAMES                                                      syscall.Errno = 68
+	ERROR_TOO_MANY_SESS                                                       syscall.Errno = 69
+	ERROR_SHARING_PAUSED                                                      syscall.Errno = 70
+	ERROR_REQ_NOT_ACCEP                                                       syscall.Errno = 71
+	ERROR_REDIR_PAUSED                                                        syscall.Errno = 72
+	ERROR_FILE_EXISTS                                                         syscall.Errno = 80
+	ERROR_CANNOT_MAKE                                                         syscall.Errno = 82
+	ERROR_FAIL_I24                                                            syscall.Errno = 83
+	ERROR_OUT_OF_STRUCTURES                                                   syscall.Errno = 84
+	ERROR_ALREADY_ASSIGNED                                                    syscall.Errno = 85
+	ERROR_INVALID_PASSWORD                                                    syscall.Errno = 86
+	ERROR_INVALID_PARAMETER                                                   syscall.Errno = 87
+	ERROR_NET_WRITE_FAULT                                                     syscall.Errno = 88
+	ERROR_NO_PROC_SLOTS                                                       syscall.Errno = 89
+	ERROR_TOO_MANY_SEMAPHORES                                                 syscall.Errno = 100
+	ERROR_EXCL_SEM_ALREADY_OWNED                                              syscall.Errno = 101
+	ERROR_SEM_IS_SET                                                          syscall.Errno = 102
+	ERROR_TOO_MANY_SEM_REQUESTS                                               syscall.Errno = 103
+	ERROR_INVALID_AT_INTERRUPT_TIME                                           syscall.Errno = 104
+	ERROR_SEM_OWNER_DIED                                                      syscall.Errno = 105
+	ERROR_SEM_USER_LIMIT                                                      syscall.Errno = 106
+	ERROR_DISK_CHANGE                                                         syscall.Errno = 107
+	ERROR_DRIVE_LOCKED                                                        syscall.Errno = 108
+	ERROR_BROKEN_PIPE                                                         syscall.Errno = 109
+	ERROR_OPEN_FAILED                                                         syscall.Errno = 110
+	ERROR_BUFFER_OVERFLOW                                                     syscall.Errno = 111
+	ERROR_DISK_FULL                                                           syscall.Errno = 112
+	ERROR_NO_MORE_SEARCH_HANDLES                                              syscall.Errno = 113
+	ERROR_INVALID_TARGET_HANDLE                                               syscall.Errno = 114
+	ERROR_INVALID_CATEGORY                                                    syscall.Errno = 117
+	ERROR_INVALID_VERIFY_SWITCH                                               syscall.Errno = 118
+	ERROR_BAD_DRIVER_LEVEL                                                    syscall.Errno = 119
+	ERROR_CALL_NOT_IMPLEMENTED                                                syscall.Errno = 120
+	ERROR_SEM_TIMEOUT                                                         syscall.Errno = 121
+	ERROR_INSUFFICIENT_BUFFER                                                 syscall.Errno = 122
+	ERROR_INVALID_NAME                                                        syscall.Errno = 123
+	ERROR_INVALID_LEVEL                                                       syscall.Errno = 124
+	ERROR_NO_VOLUME_LABEL                                                     syscall.Errno = 125
+	ERROR_MOD_NOT_FOUND                                                       syscall.Errno = 126
+	ERROR_PROC_NOT_FOUND                                                      syscall.Errno = 127
+	ERROR_WAIT_NO_CHILDREN                                                    syscall.Errno = 128
+	ERROR_CHILD_NOT_COMPLETE                                                  syscall.Errno = 129
+	ERROR_DIRECT_ACCESS_HANDLE                                                syscall.Errno = 130
+	ERROR_NEGATIVE_SEEK                                                       syscall.Errno = 131
+	ERROR_SEEK_ON_DEVICE                                                      syscall.Errno = 132
+	ERROR_IS_JOIN_TARGET                                                      syscall.Errno = 133
+	ERROR_IS_JOINED                                                           syscall.Errno = 134
+	ERROR_IS_SUBSTED                                                          syscall.Errno = 135
+	ERROR_NOT_JOINED                                                          syscall.Errno = 136
+	ERROR_NOT_SUBSTED                                                         syscall.Errno = 137
+	ERROR_JOIN_TO_JOIN                                                        syscall.Errno = 138
+	ERROR_SUBST_TO_SUBST                                                      syscall.Errno = 139
+	ERROR_JOIN_TO_SUBST                                                       syscall.Errno = 140
+	ERROR_SUBST_TO_JOIN                                                       syscall.Errno = 141
+	ERROR_BUSY_DRIVE                                                          syscall.Errno = 142
+	ERROR_SAME_DRIVE                                                          syscall.Errno = 143
+	ERROR_DIR_NOT_ROOT                                                        syscall.Errno = 144
+	ERROR_DIR_NOT_EMPTY                                                       syscall.Errno = 145
+	ERROR_IS_SUBST_PATH                                                       syscall.Errno = 146
+	ERROR_IS_JOIN_PATH                                                        syscall.Errno = 147
+	ERROR_PATH_BUSY                                                           syscall.Errno = 148
+	ERROR_IS_SUBST_TARGET                                                     syscall.Errno = 149
+	ERROR_SYSTEM_TRACE                                                        syscall.Errno = 150
+	ERROR_INVALID_EVENT_COUNT                                                 syscall.Errno = 151
+	ERROR_TOO_MANY_MUXWAITERS                                                 syscall.Errno = 152
+	ERROR_INVALID_LIST_FORMAT                                                 syscall.Errno = 153
+	ERROR_LABEL_TOO_LONG                                                      syscall.Errno = 154
+	ERROR_TOO_MANY_TCBS                                                       syscall.Errno = 155
+	ERROR_SIGNAL_REFUSED                                                      syscall.Errno = 156
+	ERROR_DISCARDED                                                           syscall.Errno = 157
+	ERROR_NOT_LOCKED                                                          syscall.Errno = 158
+	ERROR_BAD_THREADID_ADDR                                                   syscall.Errno = 159
+	ERROR_BAD_ARGUMENTS                                                       syscall.Errno = 160
+	ERROR_BAD_PATHNAME                                                        syscall.Errno = 161
+	ERROR_SIGNAL_PENDING                                                      syscall.Errno = 162
+	ERROR_MAX_THRDS_REACHED                                                   syscall.Errno = 164
+	ERROR_LOCK_FAILED                                                         syscall.Errno = 167
+	ERROR_BUSY                                                                syscall.Errno = 170
+	ERROR_DEVICE_SUPPORT_IN_PROGRESS                                          syscall.Errno = 171
+	ERROR_CANCEL_VIOLATION                                                    syscall.Errno = 173
+	ERROR_ATOMIC_LOCKS_NOT_SUPPORTED                                          syscall.Errno = 174
+	ERROR_INVALID_SEGMENT_NUMBER                                              syscall.Errno = 180
+	ERROR_INVALID_ORDINAL                                                     syscall.Errno = 182
+	ERROR_ALREADY_EXISTS                                                      syscall.Errno = 183
+	ERROR_INVALID_FLAG_NUMBER                                                 syscall.Errno = 186
+	ERROR_SEM_NOT_FOUND                                                       syscall.Errno = 187
+	ERROR_INVALID_STARTING_CODESEG                                            syscall.Errno = 188
+	ERROR_INVALID_STACKSEG                                                    syscall.Errno = 189
+	ERROR_INVALID_MODULETYPE                                                  syscall.Errno = 190
+	ERROR_INVALID_EXE_SIGNATURE                                               syscall.Errno = 191
+	ERROR_EXE_MARKED_INVALID                                                  syscall.Errno = 192
+	ERROR_BAD_EXE_FORMAT                                                      syscall.Errno = 193
+	ERROR_ITERATED_DATA_EXCEEDS_64k                                           syscall.Errno = 194
+	ERROR_INVALID_MINALLOCSIZE                                                syscall.Errno = 195
+	ERROR_DYNLINK_FROM_INVALID_RING                                           syscall.Errno = 196
+	ERROR_IOPL_NOT_ENABLED                                                    syscall.Errno = 197
+	ERROR_INVALID_SEGDPL                                                      syscall.Errno = 198
+	ERROR_AUTODATASEG_EXCEEDS_64k                                             syscall.Errno = 199
+	ERROR_RING2SEG_MUST_BE_MOVABLE                                            syscall.Errno = 200
+	ERROR_RELOC_CHAIN_XEEDS_SEGLIM                                            syscall.Errno = 201
+	ERROR_INFLOOP_IN_RELOC_CHAIN                                              syscall.Errno = 202
+	ERROR_ENVVAR_NOT_FOUND                                                    syscall.Errno = 203
+	ERROR_NO_SIGNAL_SENT                                                      syscall.Errno = 205
+	ERROR_FILENAME_EXCED_RANGE                                                syscall.Errno = 206
+	ERROR_RING2_STACK_IN_USE                                                  syscall.Errno = 207
+	ERROR_META_EXPANSION_TOO_LONG                                             syscall.Errno = 208
+	ERROR_INVALID_SIGNAL_NUMBER                                               syscall.Errno = 209
+	ERROR_THREAD_1_INACTIVE                                                   syscall.Errno = 210
+	ERROR_LOCKED                                                              syscall.Errno = 212
+	ERROR_TOO_MANY_MODULES                                                    syscall.Errno = 214
+	ERROR_NESTING_NOT_ALLOWED                                                 syscall.Errno = 215
+	ERROR_EXE_MACHINE_TYPE_MISMATCH                                           syscall.Errno = 216
+	ERROR_EXE_CANNOT_MODIFY_SIGNED_BINARY                                     syscall.Errno = 217
+	ERROR_EXE_CANNOT_MODIFY_STRONG_SIGNED_BINARY                              syscall.Errno = 218
+	ERROR_FILE_CHECKED_OUT                                                    syscall.Errno = 220
+	ERROR_CHECKOUT_REQUIRED                                                   syscall.Errno = 221
+	ERROR_BAD_FILE_TYPE                                                       syscall.Errno = 222
+	ERROR_FILE_TOO_LARGE                                                      syscall.Errno = 223
+	ERROR_FORMS_AUTH_REQUIRED                                                 syscall.Errno = 224
+	ERROR_VIRUS_INFECTED                                                      syscall.Errno = 225
+	ERROR_VIRUS_DELETED                                                       syscall.Errno = 226
+	ERROR_PIPE_LOCAL                                                          syscall.Errno = 229
+	ERROR_BAD_PIPE                                                            syscall.Errno = 230
+	ERROR_PIPE_BUSY                                                           syscall.Errno = 231
+	ERROR_NO_DATA                                                             syscall.Errno = 232
+	ERROR_PIPE_NOT_CONNECTED                                                  syscall.Errno = 233
+	ERROR_MORE_DATA                                                           syscall.Errno = 234
+	ERROR_NO_WORK_DONE                                                        syscall.Errno = 235
+	ERROR_VC_DISCONNECTED                                                     syscall.Errno = 240
+	ERROR_INVALID_EA_NAME                                                     syscall.Errno = 254
+	ERROR_EA_LIST_INCONSISTENT                                                syscall.Errno = 255
+	WAIT_TIMEOUT                                                              syscall.Errno = 258
+	ERROR_NO_MORE_ITEMS                                                       syscall.Errno = 259
+	ERROR_CANNOT_COPY                                                         syscall.Errno = 266
+	ERROR_DIRECTORY                                                           syscall.Errno = 267
+	ERROR_EAS_DIDNT_FIT                                                       syscall.Errno = 275
+	ERROR_EA_FILE_CORRUPT                                                     syscall.Errno = 276
+	ERROR_EA_TABLE_FULL                                                       syscall.Errno = 277
+	ERROR_INVALID_EA_HANDLE                                                   syscall.Errno = 278
+	ERROR_EAS_NOT_SUPPORTED                                                   syscall.Errno = 282
+	ERROR_NOT_OWNER                                                           syscall.Errno = 288
+	ERROR_TOO_MANY_POSTS                                                      syscall.Errno = 298
+	ERROR_PARTIAL_COPY                                                        syscall.Errno = 299
+	ERROR_OPLOCK_NOT_GRANTED                                                  syscall.Errno = 300
+	ERROR_INVALID_OPLOCK_PROTOCOL                                             syscall.Errno = 301
+	ERROR_DISK_TOO_FRAGMENTED                                                 syscall.Errno = 302
+	ERROR_DELETE_PENDING                                                      syscall.Errno = 303
+	ERROR_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING                syscall.Errno = 304
+	ERROR_SHORT_NAMES_NOT_ENABLED_ON_VOLUME                                   syscall.Errno = 305
+	ERROR_SECURITY_STREAM_IS_INCONSISTENT                                     syscall.Errno = 306
+	ERROR_INVALID_LOCK_RANGE                                                  syscall.Errno = 307
+	ERROR_IMAGE_SUBSYSTEM_NOT_PRESENT                                         syscall.Errno = 308
+	ERROR_NOTIFICATION_GUID_ALREADY_DEFINED                                   syscall.Errno = 309
+	ERROR_INVALID_EXCEPTION_HANDLER                                           syscall.Errno = 310
+	ERROR_DUPLICATE_PRIVILEGES                                                syscall.Errno = 311
+	ERROR_NO_RANGES_PROCESSED                                                 syscall.Errno = 312
+	ERROR_NOT_ALLOWED_ON_SYSTEM_FILE                                          syscall.Errno = 313
+	ERROR_DISK_RESOURCES_EXHAUSTED                                            syscall.Errno = 314
+	ERROR_INVALID_TOKEN                                                       syscall.Errno = 315
+	ERROR_DEVICE_FEATURE_NOT_SUPPORTED                                        syscall.Errno = 316
+	ERROR_MR_MID_NOT_FOUND                                                    syscall.Errno = 317
+	ERROR_SCOPE_NOT_FOUND                                                     syscall.Errno = 318
+	ERROR_UNDEFINED_SCOPE                                                     syscall.Errno = 319
+	ERROR_INVALID_CAP                                                         syscall.Errno = 320
+	ERROR_DEVICE_UNREACHABLE                                                  syscall.Errno = 321
+	ERROR_DEVICE_NO_RESOURCES                                                 syscall.Errno = 322
+	ERROR_DATA_CHECKSUM_ERROR                                                 syscall.Errno = 323
+	ERROR_INTERMIXED_KERNEL_EA_OPERATION                                      syscall.Errno = 324
+	ERROR_FILE_LEVEL_TRIM_NOT_SUPPORTED                                       syscall.Errno = 326
+	ERROR_OFFSET_ALIGNMENT_VIOLATION                                          syscall.Errno = 327
+	ERROR_INVALID_FIELD_IN_PARAMETER_LIST                                     syscall.Errno = 328
+	ERROR_OPERATION_IN_PROGRESS                                               syscall.Errno = 329
+	ERROR_BAD_DEVICE_PATH                                                     syscall.Errno = 330
+	ERROR_TOO_MANY_DESCRIPTORS                                                syscall.Errno = 331
+	ERROR_SCRUB_DATA_DISABLED                                                 syscall.Errno = 332
+	ERROR_NOT_REDUNDANT_STORAGE                                               syscall.Errno = 333
+	ERROR_RESIDENT_FILE_NOT_SUPPORTED                                         syscall.Errno = 334
+	ERROR_COMPRESSED_FILE_NOT_SUPPORTED                                       syscall.Errno = 335
+	ERROR_DIRECTORY_NOT_SUPPORTED                                             syscall.Errno = 336
+	ERROR_NOT_READ_FROM_COPY                                                  syscall.Errno = 337
+	ERROR_FT_WRITE_FAILURE                                                    syscall.Errno = 338
+	ERROR_FT_DI_SCAN_REQUIRED                                                 syscall.Errno = 339
+	ERROR_INVALID_KERNEL_INFO_VERSION                                         syscall.Errno = 340
+	ERROR_INVALID_PEP_INFO_VERSION                                            syscall.Errno = 341
+	ERROR_OBJECT_NOT_EXTERNALLY_BACKED                                        syscall.Errno = 342
+	ERROR_EXTERNAL_BACKING_PROVIDER_UNKNOWN                                   syscall.Errno = 343
+	ERROR_COMPRESSION_NOT_BENEFICIAL                                          syscall.Errno = 344
+	ERROR_STORAGE_TOPOLOGY_ID_MISMATCH                                        syscall.Errno = 345
+	ERROR_BLOCKED_BY_PARENTAL_CONTROLS                                        syscall.Errno = 346
+	ERROR_BLOCK_TOO_MANY_REFERENCES                                           syscall.Errno = 347
+	ERROR_MARKED_TO_DISALLOW_WRITES                                           syscall.Errno = 348
+	ERROR_ENCLAVE_FAILURE                                                     syscall.Errno = 349
+	ERROR_FAIL_NOACTION_REBOOT                                                syscall.Errno = 350
+	ERROR_FAIL_SHUTDOWN                                                       syscall.Errno = 351
+	ERROR_FAIL_RESTART                                                        syscall.Errno = 352
+	ERROR_MAX_SESSIONS_REACHED                                                syscall.Errno = 353
+	ERROR_NETWORK_ACCESS_DENIED_EDP                                           syscall.Errno = 354
+	ERROR_DEVICE_HINT_NAME_BUFFER_TOO_SMALL                                   syscall.Errno = 355
+	ERROR_EDP_POLICY_DENIES_OPERATION                                         syscall.Errno = 356
+	ERROR_EDP_DPL_POLICY_CANT_BE_SATISFIED                                    syscall.Errno = 357
+	ERROR_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT                               syscall.Errno = 358
+	ERROR_DEVICE_IN_MAINTENANCE                                               syscall.Errno = 359
+	ERROR_NOT_SUPPORTED_ON_DAX                                                syscall.Errno = 360
+	ERROR_DAX_MAPPING_EXISTS                                                  syscall.Errno = 361
+	ERROR_CLOUD_FILE_PROVIDER_NOT_RUNNING                                     syscall.Errno = 362
+	ERROR_CLOUD_FILE_METADATA_CORRUPT                                         syscall.Errno = 363
+	ERROR_CLOUD_FILE_METADATA_TOO_LARGE                                       syscall.Errno = 364
+	ERROR_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE                                  syscall.Errno = 365
+	ERROR_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH                          syscall.Errno = 366
+	ERROR_CHILD_PROCESS_BLOCKED                                               syscall.Errno = 367
+	ERROR_STORAGE_LOST_DATA_PERSISTENCE                                       syscall.Errno = 368
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE                              syscall.Errno = 369
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT                         syscall.Errno = 370
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_BUSY                                     syscall.Errno = 371
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN                         syscall.Errno = 372
+	ERROR_GDI_HANDLE_LEAK                                                     syscall.Errno = 373
+	ERROR_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS                                  syscall.Errno = 374
+	ERROR_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED                           syscall.Errno = 375
+	ERROR_NOT_A_CLOUD_FILE                                                    syscall.Errno = 376
+	ERROR_CLOUD_FILE_NOT_IN_SYNC                                              syscall.Errno = 377
+	ERROR_CLOUD_FILE_ALREADY_CONNECTED                                        syscall.Errno = 378
+	ERROR_CLOUD_FILE_NOT_SUPPORTED                                            syscall.Errno = 379
+	ERROR_CLOUD_FILE_INVALID_REQUEST                                          syscall.Errno = 380
+	ERROR_CLOUD_FILE_READ_ONLY_VOLUME                                         syscall.Errno = 381
+	ERROR_CLOUD_FILE_CONNECTED_PROVIDER_ONLY                                  syscall.Errno = 382
+	ERROR_CLOUD_FILE_VALIDATION_FAILED                                        syscall.Errno = 383
+	ERROR_SMB1_NOT_AVAILABLE                                                  syscall.Errno = 384
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION                        syscall.Errno = 385
+	ERROR_CLOUD_FILE_AUTHENTICATION_FAILED                                    syscall.Errno = 386
+	ERROR_CLOUD_FILE_INSUFFICIENT_RESOURCES                                   syscall.Errno = 387
+	ERROR_CLOUD_FILE_NETWORK_UNAVAILABLE                                      syscall.Errno = 388
+	ERROR_CLOUD_FILE_UNSUCCESSFUL                                             syscall.Errno = 389
+	ERROR_CLOUD_FILE_NOT_UNDER_SYNC_ROOT                                      syscall.Errno = 390
+	ERROR_CLOUD_FILE_IN_USE                                                   syscall.Errno = 391
+	ERROR_CLOUD_FILE_PINNED                                                   syscall.Errno = 392
+	ERROR_CLOUD_FILE_REQUEST_ABORTED                                          syscall.Errno = 393
+	ERROR_CLOUD_FILE_PROPERTY_CORRUPT                                         syscall.Errno = 394
+	ERROR_CLOUD_FILE_ACCESS_DENIED                                            syscall.Errno = 395
+	ERROR_CLOUD_FILE_INCOMPATIBLE_HARDLINKS                                   syscall.Errno = 396
+	ERROR_CLOUD_FILE_PROPERTY_LOCK_CONFLICT                                   syscall.Errno = 397
+	ERROR_CLOUD_FILE_REQUEST_CANCELED                                         syscall.Errno = 398
+	ERROR_EXTERNAL_SYSKEY_NOT_SUPPORTED                                       syscall.Errno = 399
+	ERROR_THREAD_MODE_ALREADY_BACKGROUND                                      syscall.Errno = 400
+	ERROR_THREAD_MODE_NOT_BACKGROUND                                          syscall.Errno = 401
+	ERROR_PROCESS_MODE_ALREADY_BACKGROUND                                     syscall.Errno = 402
+	ERROR_PROCESS_MODE_NOT_BACKGROUND                                         syscall.Errno = 403
+	ERROR_CLOUD_FILE_PROVIDER_TERMINATED                                      syscall.Errno = 404
+	ERROR_NOT_A_CLOUD_SYNC_ROOT                                               syscall.Errno = 405
+	ERROR_FILE_PROTECTED_UNDER_DPL                                            syscall.Errno = 406
+	ERROR_VOLUME_NOT_CLUSTER_ALIGNED                                          syscall.Errno = 407
+	ERROR_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND                              syscall.Errno = 408
+	ERROR_APPX_FILE_NOT_ENCRYPTED                                             syscall.Errno = 409
+	ERROR_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED                                  syscall.Errno = 410
+	ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET                        syscall.Errno = 411
+	ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE                         syscall.Errno = 412
+	ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER                         syscall.Errno = 413
+	ERROR_LINUX_SUBSYSTEM_NOT_PRESENT                                         syscall.Errno = 414
+	ERROR_FT_READ_FAILURE                                                     syscall.Errno = 415
+	ERROR_STORAGE_RESERVE_ID_INVALID                                          syscall.Errno = 416
+	ERROR_STORAGE_RESERVE_DOES_NOT_EXIST                                      syscall.Errno = 417
+	ERROR_STORAGE_RESERVE_ALREADY_EXISTS                                      syscall.Errno = 418
+	ERROR_STORAGE_RESERVE_NOT_EMPTY                                           syscall.Errno = 419
+	ERROR_NOT_A_DAX_VOLUME                                                    syscall.Errno = 420
+	ERROR_NOT_DAX_MAPPABLE                                                    syscall.Errno = 421
+	ERROR_TIME_SENSITIVE_THREAD                                               syscall.Errno = 422
+	ERROR_DPL_NOT_SUPPORTED_FOR_USER                                          syscall.Errno = 423
+	ERROR_CASE_DIFFERING_NAMES_IN_DIR                                         syscall.Errno = 424
+	ERROR_FILE_NOT_SUPPORTED                                                  syscall.Errno = 425
+	ERROR_CLOUD_FILE_REQUEST_TIMEOUT                                          syscall.Errno = 426
+	ERROR_NO_TASK_QUEUE                                                       syscall.Errno = 427
+	ERROR_SRC_SRV_DLL_LOAD_FAILED                                             syscall.Errno = 428
+	ERROR_NOT_SUPPORTED_WITH_BTT                                              syscall.Errno = 429
+	ERROR_ENCRYPTION_DISABLED                                                 syscall.Errno = 430
+	ERROR_ENCRYPTING_METADATA_DISALLOWED                                      syscall.Errno = 431
+	ERROR_CANT_CLEAR_ENCRYPTION_FLAG                                          syscall.Errno = 432
+	ERROR_NO_SUCH_DEVICE                                                      syscall.Errno = 433
+	ERROR_CAPAUTHZ_NOT_DEVUNLOCKED                                            syscall.Errno = 450
+	ERROR_CAPAUTHZ_CHANGE_TYPE                                                syscall.Errno = 451
+	ERROR_CAPAUTHZ_NOT_PROVISIONED                                            syscall.Errno = 452
+	ERROR_CAPAUTHZ_NOT_AUTHORIZED                                             syscall.Errno = 453
+	ERROR_CAPAUTHZ_NO_POLICY                                                  syscall.Errno = 454
+	ERROR_CAPAUTHZ_DB_CORRUPTED                                               syscall.Errno = 455
+	ERROR_CAPAUTHZ_SCCD_INVALID_CATALOG                                       syscall.Errno = 456
+	ERROR_CAPAUTHZ_SCCD_NO_AUTH_ENTITY                                        syscall.Errno = 457
+	ERROR_CAPAUTHZ_SCCD_PARSE_ERROR                                           syscall.Errno = 458
+	ERROR_CAPAUTHZ_SCCD_DEV_MODE_REQUIRED                                     syscall.Errno = 459
+	ERROR_CAPAUTHZ_SCCD_NO_CAPABILITY_MATCH                                   syscall.Errno = 460
+	ERROR_PNP_QUERY_REMOVE_DEVICE_TIMEOUT                                     syscall.Errno = 480
+	ERROR_PNP_QUERY_REMOVE_RELATED_DEVICE_TIMEOUT                             syscall.Errno = 481
+	ERROR_PNP_QUERY_REMOVE_UNRELATED_DEVICE_TIMEOUT                           syscall.Errno = 482
+	ERROR_DEVICE_HARDWARE_ERROR                                               syscall.Errno = 483
+	ERROR_INVALID_ADDRESS                                                     syscall.Errno = 487
+	ERROR_VRF_CFG_ENABLED                                                     syscall.Errno = 1183
+	ERROR_PARTITION_TERMINATING                                               syscall.Errno = 1184
+	ERROR_USER_PROFILE_LOAD                                                   syscall.Errno = 500
+	ERROR_ARITHMETIC_OVERFLOW                                                 syscall.Errno = 534
+	ERROR_PIPE_CONNECTED                                                      syscall.Errno = 535
+	ERROR_PIPE_LISTENING                                                      syscall.Errno = 536
+	ERROR_VERIFIER_STOP                                                       syscall.Errno = 537
+	ERROR_ABIOS_ERROR                                                         syscall.Errno = 538
+	ERROR_WX86_WARNING                                                        syscall.Errno = 539
+	ERROR_WX86_ERROR                                                          syscall.Errno = 540
+	ERROR_TIMER_NOT_CANCELED                                                  syscall.Errno = 541
+	ERROR_UNWIND                                                              syscall.Errno = 542
+	ERROR_BAD_STACK                                                           syscall.Errno = 543
+	ERROR_INVALID_UNWIND_TARGET                                               syscall.Errno = 544
+	ERROR_INVALID_PORT_ATTRIBUTES                                             syscall.Errno = 545
+	ERROR_PORT_MESSAGE_TOO_LONG                                               syscall.Errno = 546
+	ERROR_INVALID_QUOTA_LOWER                                                 syscall.Errno = 547
+	ERROR_DEVICE_ALREADY_ATTACHED                                             syscall.Errno = 548
+	ERROR_INSTRUCTION_MISALIGNMENT                                            syscall.Errno = 549
+	ERROR_PROFILING_NOT_STARTED                                               syscall.Errno = 550
+	ERROR_PROFILING_NOT_STOPPED                                               syscall.Errno = 551
+	ERROR_COULD_NOT_INTERPRET                                                 syscall.Errno = 552
+	ERROR_PROFILING_AT_LIMIT                                                  syscall.Errno = 553
+	ERROR_CANT_WAIT                                                           syscall.Errno = 554
+	ERROR_CANT_TERMINATE_SELF                                                 syscall.Errno = 555
+	ERROR_UNEXPECTED_MM_CREATE_ERR                                            syscall.Errno = 556
+	ERROR_UNEXPECTED_MM_MAP_ERROR                                             syscall.Errno = 557
+	ERROR_UNEXPECTED_MM_EXTEND_ERR                                            syscall.Errno = 558
+	ERROR_BAD_FUNCTION_TABLE                                                  syscall.Errno = 559
+	ERROR_NO_GUID_TRANSLATION                                                 syscall.Errno = 560
+	ERROR_INVALID_LDT_SIZE                                                    syscall.Errno = 561
+	ERROR_INVALID_LDT_OFFSET                                                  syscall.Errno = 563
+	ERROR_INVALID_LDT_DESCRIPTOR                                              syscall.Errno = 564
+	ERROR_TOO_MANY_THREADS                                                    syscall.Errno = 565
+	ERROR_THREAD_NOT_IN_PROCESS                                               syscall.Errno = 566
+	ERROR_PAGEFILE_QUOTA_EXCEEDED                                             syscall.Errno = 567
+	ERROR_LOGON_SERVER_CONFLICT                                               syscall.Errno = 568
+	ERROR_SYNCHRONIZATION_REQUIRED                                            syscall.Errno = 569
+	ERROR_NET_OPEN_FAILED                                                     syscall.Errno = 570
+	ERROR_IO_PRIVILEGE_FAILED                                                 syscall.Errno = 571
+	ERROR_CONTROL_C_EXIT                                                      syscall.Errno = 572
+	ERROR_MISSING_SYSTEMFILE                                                  syscall.Errno = 573
+	ERROR_UNHANDLED_EXCEPTION                                                 syscall.Errno = 574
+	ERROR_APP_INIT_FAILURE                                                    syscall.Errno = 575
+	ERROR_PAGEFILE_CREATE_FAILED                                              syscall.Errno = 576
+	ERROR_INVALID_IMAGE_HASH                                                  syscall.Errno = 577
+	ERROR_NO_PAGEFILE                                                         syscall.Errno = 578
+	ERROR_ILLEGAL_FLOAT_CONTEXT                                               syscall.Errno = 579
+	ERROR_NO_EVENT_PAIR                                                       syscall.Errno = 580
+	ERROR_DOMAIN_CTRLR_CONFIG_ERROR                                           syscall.Errno = 581
+	ERROR_ILLEGAL_CHARACTER                                                   syscall.Errno = 582
+	ERROR_UNDEFINED_CHARACTER                                                 syscall.Errno = 583
+	ERROR_FLOPPY_VOLUME                                                       syscall.Errno = 584
+	ERROR_BIOS_FAILED_TO_CONNECT_INTERRUPT                                    syscall.Errno = 585
+	ERROR_BACKUP_CONTROLLER                                                   syscall.Errno = 586
+	ERROR_MUTANT_LIMIT_EXCEEDED                                               syscall.Errno = 587
+	ERROR_FS_DRIVER_REQUIRED                                                  syscall.Errno = 588
+	ERROR_CANNOT_LOAD_REGISTRY_FILE                                           syscall.Errno = 589
+	ERROR_DEBUG_ATTACH_FAILED                                                 syscall.Errno = 590
+	ERROR_SYSTEM_PROCESS_TERMINATED                                           syscall.Errno = 591
+	ERROR_DATA_NOT_ACCEPTED                                                   syscall.Errno = 592
+	ERROR_VDM_HARD_ERROR                                                      syscall.Errno = 593
+	ERROR_DRIVER_CANCEL_TIMEOUT                                               syscall.Errno = 594
+	ERROR_REPLY_MESSAGE_MISMATCH                                              syscall.Errno = 595
+	ERROR_LOST_WRITEBEHIND_DATA                                               syscall.Errno = 596
+	ERROR_CLIENT_SERVER_PARAMETERS_INVALID                                    syscall.Errno = 597
+	ERROR_NOT_TINY_STREAM                                                     syscall.Errno = 598
+	ERROR_STACK_OVERFLOW_READ                                                 syscall.Errno = 599
+	ERROR_CONVERT_TO_LARGE                                                    syscall.Errno = 600
+	ERROR_FOUND_OUT_OF_SCOPE                                                  syscall.Errno = 601
+	ERROR_ALLOCATE_BUCKET                                                     syscall.Errno = 602
+	ERROR_MARSHALL_OVERFLOW                                                   syscall.Errno = 603
+	ERROR_INVALID_VARIANT                                                     syscall.Errno = 604
+	ERROR_BAD_COMPRESSION_BUFFER                                              syscall.Errno = 605
+	ERROR_AUDIT_FAILED                                                        syscall.Errno = 606
+	ERROR_TIMER_RESOLUTION_NOT_SET                                            syscall.Errno = 607
+	ERROR_INSUFFICIENT_LOGON_INFO                                             syscall.Errno = 608
+	ERROR_BAD_DLL_ENTRYPOINT                                                  syscall.Errno = 609
+	ERROR_BAD_SERVICE_ENTRYPOINT                                              syscall.Errno = 610
+	ERROR_IP_ADDRESS_CONFLICT1                                                syscall.Errno = 611
+	ERROR_IP_ADDRESS_CONFLICT2                                                syscall.Errno = 612
+	ERROR_REGISTRY_QUOTA_LIMIT                                                syscall.Errno = 613
+	ERROR_NO_CALLBACK_ACTIVE                                                  syscall.Errno = 614
+	ERROR_PWD_TOO_SHORT                                                       syscall.Errno = 615
+	ERROR_PWD_TOO_RECENT                                                      syscall.Errno = 616
+	ERROR_PWD_HISTORY_CONFLICT                                                syscall.Errno = 617
+	ERROR_UNSUPPORTED_COMPRESSION                                             syscall.Errno = 618
+	ERROR_INVALID_HW_PROFILE                                                  syscall.Errno = 619
+	ERROR_INVALID_PLUGPLAY_DEVICE_PATH                                        syscall.Errno = 620
+	ERROR_QUOTA_LIST_INCONSISTENT                                             syscall.Errno = 621
+	ERROR_EVALUATION_EXPIRATION                                               syscall.Errno = 622
+	ERROR_ILLEGAL_DLL_RELOCATION                                              syscall.Errno = 623
+	ERROR_DLL_INIT_FAILED_LOGOFF                                              syscall.Errno = 624
+	ERROR_VALIDATE_CONTINUE                                                   syscall.Errno = 625
+	ERROR_NO_MORE_MATCHES                                                     syscall.Errno = 626
+	ERROR_RANGE_LIST_CONFLICT                                                 syscall.Errno = 627
+	ERROR_SERVER_SID_MISMATCH                                                 syscall.Errno = 628
+	ERROR_CANT_ENABLE_DENY_ONLY                                               syscall.Errno = 629
+	ERROR_FLOAT_MULTIPLE_FAULTS                                               syscall.Errno = 630
+	ERROR_FLOAT_MULTIPLE_TRAPS                                                syscall.Errno = 631
+	ERROR_NOINTERFACE                                                         syscall.Errno = 632
+	ERROR_DRIVER_FAILED_SLEEP                                                 syscall.Errno = 633
+	ERROR_CORRUPT_SYSTEM_FILE                                                 syscall.Errno = 634
+	ERROR_COMMITMENT_MINIMUM                                                  syscall.Errno = 635
+	ERROR_PNP_RESTART_ENUMERATION                                             syscall.Errno = 636
+	ERROR_SYSTEM_IMAGE_BAD_SIGNATURE                                          syscall.Errno = 637
+	ERROR_PNP_REBOOT_REQUIRED                                                 syscall.Errno = 638
+	ERROR_INSUFFICIENT_POWER                                                  syscall.Errno = 639
+	ERROR_MULTIPLE_FAULT_VIOLATION                                            syscall.Errno = 640
+	ERROR_SYSTEM_SHUTDOWN                                                     syscall.Errno = 641
+	ERROR_PORT_NOT_SET                                                        syscall.Errno = 642
+	ERROR_DS_VERSION_CHECK_FAILURE                                            syscall.Errno = 643
+	ERROR_RANGE_NOT_FOUND                                                     syscall.Errno = 644
+	ERROR_NOT_SAFE_MODE_DRIVER                                                syscall.Errno = 646
+	ERROR_FAILED_DRIVER_ENTRY                                                 syscall.Errno = 647
+	ERROR_DEVICE_ENUMERATION_ERROR                                            syscall.Errno = 648
+	ERROR_MOUNT_POINT_NOT_RESOLVED                                            syscall.Errno = 649
+	ERROR_INVALID_DEVICE_OBJECT_PARAMETER                                     syscall.Errno = 650
+	ERROR_MCA_OCCURED                                                         syscall.Errno = 651
+	ERROR_DRIVER_DATABASE_ERROR                                               syscall.Errno = 652
+	ERROR_SYSTEM_HIVE_TOO_LARGE                                               syscall.Errno = 653
+	ERROR_DRIVER_FAILED_PRIOR_UNLOAD                                          syscall.Errno = 654
+	ERROR_VOLSNAP_PREPARE_HIBERNATE                                           syscall.Errno = 655
+	ERROR_HIBERNATION_FAILURE                                                 syscall.Errno = 656
+	ERROR_PWD_TOO_LONG                                                        syscall.Errno = 657
+	ERROR_FILE_SYSTEM_LIMITATION                                              syscall.Errno = 665
+	ERROR_ASSERTION_FAILURE                                                   syscall.Errno = 668
+	ERROR_ACPI_ERROR                                                          syscall.Errno = 669
+	ERROR_WOW_ASSERTION                                                       syscall.Errno = 670
+	ERROR_PNP_BAD_MPS_TABLE                                                   syscall.Errno = 671
+	ERROR_PNP_TRANSLATION_FAILED                                              syscall.Errno = 672
+	ERROR_PNP_IRQ_TRANSLATION_FAILED                                          syscall.Errno = 673
+	ERROR_PNP_INVALID_ID                                                      syscall.Errno = 674
+	ERROR_WAKE_SYSTEM_DEBUGGER                                                syscall.Errno = 675
+	ERROR_HANDLES_CLOSED                                                      syscall.Errno = 676
+	ERROR_EXTRANEOUS_INFORMATION                                              syscall.Errno = 677
+	ERROR_RXACT_COMMIT_NECESSARY                                              syscall.Errno = 678
+	ERROR_MEDIA_CHECK                                                         syscall.Errno = 679
+	ERROR_GUID_SUBSTITUTION_MADE                                              syscall.Errno = 680
+	ERROR_STOPPED_ON_SYMLINK                                                  syscall.Errno = 681
+	ERROR_LONGJUMP                                                            syscall.Errno = 682
+	ERROR_PLUGPLAY_QUERY_VETOED                                               syscall.Errno = 683
+	ERROR_UNWIND_CONSOLIDATE                                                  syscall.Errno = 684
+	ERROR_REGISTRY_HIVE_RECOVERED                                             syscall.Errno = 685
+	ERROR_DLL_MIGHT_BE_INSECURE                                               syscall.Errno = 686
+	ERROR_DLL_MIGHT_BE_INCOMPATIBLE                                           syscall.Errno = 687
+	ERROR_DBG_EXCEPTION_NOT_HANDLED                                           syscall.Errno = 688
+	ERROR_DBG_REPLY_LATER                                                     syscall.Errno = 689
+	ERROR_DBG_UNABLE_TO_PROVIDE_HANDLE                                        syscall.Errno = 690
+	ERROR_DBG_TERMINATE_THREAD                                                syscall.Errno = 691
+	ERROR_DBG_TERMINATE_PROCESS                                               syscall.Errno = 692
+	ERROR_DBG_CONTROL_C                                                       syscall.Errno = 693
+	ERROR_DBG_PRINTEXCEPTION_C                                                syscall.Errno = 694
+	ERROR_DBG_RIPEXCEPTION                                                    syscall.Errno = 695
+	ERROR_DBG_CONTROL_BREAK                                                   syscall.Errno = 696
+	ERROR_DBG_COMMAND_EXCEPTION                                               syscall.Errno = 697
+	ERROR_OBJECT_NAME_EXISTS                                                  syscall.Errno = 698
+	ERROR_THREAD_WAS_SUSPENDED                                                syscall.Errno = 699
+	ERROR_IMAGE_NOT_AT_BASE                                                   syscall.Errno = 700
+	ERROR_RXACT_STATE_CREATED                                                 syscall.Errno = 701
+	ERROR_SEGMENT_NOTIFICATION                                                syscall.Errno = 702
+	ERROR_BAD_CURRENT_DIRECTORY                                               syscall.Errno = 703
+	ERROR_FT_READ_RECOVERY_FROM_BACKUP                                        syscall.Errno = 704
+	ERROR_FT_WRITE_RECOVERY                                                   syscall.Errno = 705
+	ERROR_IMAGE_MACHINE_TYPE_MISMATCH                                         syscall.Errno = 706
+	ERROR_RECEIVE_PARTIAL                                                     syscall.Errno = 707
+	ERROR_RECEIVE_EXPEDITED                                                   syscall.Errno = 708
+	ERROR_RECEIVE_PARTIAL_EXPEDITED                                           syscall.Errno = 709
+	ERROR_EVENT_DONE                                                          syscall.Errno = 710
+	ERROR_EVENT_PENDING                                                       syscall.Errno = 711
+	ERROR_CHECKING_FILE_SYSTEM                                                syscall.Errno = 712
+	ERROR_FATAL_APP_EXIT                                                      syscall.Errno = 713
+	ERROR_PREDEFINED_HANDLE                                                   syscall.Errno = 714
+	ERROR_WAS_UNLOCKED                                                        syscall.Errno = 715
+	ERROR_SERVICE_NOTIFICATION                                                syscall.Errno = 716
+	ERROR_WAS_LOCKED                                                          syscall.Errno = 717
+	ERROR_LOG_HARD_ERROR                                                      syscall.Errno = 718
+	ERROR_ALREADY_WIN32                                                       syscall.Errno = 719
+	ERROR_IMAGE_MACHINE_TYPE_MISMATCH_EXE                                     syscall.Errno = 720
+	ERROR_NO_YIELD_PERFORMED                                                  syscall.Errno = 721
+	ERROR_TIMER_RESUME_IGNORED                                                syscall.Errno = 722
+	ERROR_ARBITRATION_UNHANDLED                                               syscall.Errno = 723
+	ERROR_CARDBUS_NOT_SUPPORTED                                               syscall.Errno = 724
+	ERROR_MP_PROCESSOR_MISMATCH                                               syscall.Errno = 725
+	ERROR_HIBERNATED                                                          syscall.Errno = 726
+	ERROR_RESUME_HIBERNATION                                                  syscall.Errno = 727
+	ERROR_FIRMWARE_UPDATED                                                    syscall.Errno = 728
+	ERROR_DRIVERS_LEAKING_LOCKED_PAGES                                        syscall.Errno = 729
+	ERROR_WAKE_SYSTEM                                                         syscall.Errno = 730
+	ERROR_WAIT_1                                                              syscall.Errno = 731
+	ERROR_WAIT_2                                                              syscall.Errno = 732
+	ERROR_WAIT_3                                                              syscall.Errno = 733
+	ERROR_WAIT_63                                                             syscall.Errno = 734
+	ERROR_ABANDONED_WAIT_0                                                    syscall.Errno = 735
+	ERROR_ABANDONED_WAIT_63                                                   syscall.Errno = 736
+	ERROR_USER_APC                                                            syscall.Errno = 737
+	ERROR_KERNEL_APC                                                          syscall.Errno = 738
+	ERROR_ALERTED                                                             syscall.Errno = 739
+	ERROR_ELEVATION_REQUIRED                                                  syscall.Errno = 740
+	ERROR_REPARSE                                                             syscall.Errno = 741
+	ERROR_OPLOCK_BREAK_IN_PROGRESS                                            syscall.Errno = 742
+	ERROR_VOLUME_MOUNTED                                                      syscall.Errno = 743
+	ERROR_RXACT_COMMITTED                                                     syscall.Errno = 744
+	ERROR_NOTIFY_CLEANUP                                                      syscall.Errno = 745
+	ERROR_PRIMARY_TRANSPORT_CONNECT_FAILED                                    syscall.Errno = 746
+	ERROR_PAGE_FAULT_TRANSITION                                               syscall.Errno = 747
+	ERROR_PAGE_FAULT_DEMAND_ZERO                                              syscall.Errno = 748
+	ERROR_PAGE_FAULT_COPY_ON_WRITE                                            syscall.Errno = 749
+	ERROR_PAGE_FAULT_GUARD_PAGE                                               syscall.Errno = 750
+	ERROR_PAGE_FAULT_PAGING_FILE                                              syscall.Errno = 751
+	ERROR_CACHE_PAGE_LOCKED                                                   syscall.Errno = 752
+	ERROR_CRASH_DUMP                                                          syscall.Errno = 753
+	ERROR_BUFFER_ALL_ZEROS                                                    syscall.Errno = 754
+	ERROR_REPARSE_OBJECT                                                      syscall.Errno = 755
+	ERROR_RESOURCE_REQUIREMENTS_CHANGED                                       syscall.Errno = 756
+	ERROR_TRANSLATION_COMPLETE                                                syscall.Errno = 757
+	ERROR_NOTHING_TO_TERMINATE                                                syscall.Errno = 758
+	ERROR_PROCESS_NOT_IN_JOB                                                  syscall.Errno = 759
+	ERROR_PROCESS_IN_JOB                                                      syscall.Errno = 760
+	ERROR_VOLSNAP_HIBERNATE_READY                                             syscall.Errno = 761
+	ERROR_FSFILTER_OP_COMPLETED_SUCCESSFULLY                                  syscall.Errno = 762
+	ERROR_INTERRUPT_VECTOR_ALREADY_CONNECTED                                  syscall.Errno = 763
+	ERROR_INTERRUPT_STILL_CONNECTED                                           syscall.Errno = 764
+	ERROR_WAIT_FOR_OPLOCK                                                     syscall.Errno = 765
+	ERROR_DBG_EXCEPTION_HANDLED                                               syscall.Errno = 766
+	ERROR_DBG_CONTINUE                                                        syscall.Errno = 767
+	ERROR_CALLBACK_POP_STACK                                                  syscall.Errno = 768
+	ERROR_COMPRESSION_DISABLED                                                syscall.Errno = 769
+	ERROR_CANTFETCHBACKWARDS                                                  syscall.Errno = 770
+	ERROR_CANTSCROLLBACKWARDS                                                 syscall.Errno = 771
+	ERROR_ROWSNOTRELEASED                                                     syscall.Errno = 772
+	ERROR_BAD_ACCESSOR_FLAGS                                                  syscall.Errno = 773
+	ERROR_ERRORS_ENCOUNTERED                                                  syscall.Errno = 774
+	ERROR_NOT_CAPABLE                                                         syscall.Errno = 775
+	ERROR_REQUEST_OUT_OF_SEQUENCE                                             syscall.Errno = 776
+	ERROR_VERSION_PARSE_ERROR                                                 syscall.Errno = 777
+	ERROR_BADSTARTPOSITION                                                    syscall.Errno = 778
+	ERROR_MEMORY_HARDWARE                                                     syscall.Errno = 779
+	ERROR_DISK_REPAIR_DISABLED                                                syscall.Errno = 780
+	ERROR_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE             syscall.Errno = 781
+	ERROR_SYSTEM_POWERSTATE_TRANSITION                                        syscall.Errno = 782
+	ERROR_SYSTEM_POWERSTATE_COMPLEX_TRANSITION                                syscall.Errno = 783
+	ERROR_MCA_EXCEPTION                                                       syscall.Errno = 784
+	ERROR_ACCESS_AUDIT_BY_POLICY                                              syscall.Errno = 785
+	ERROR_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY                               syscall.Errno = 786
+	ERROR_ABANDON_HIBERFILE                                                   syscall.Errno = 787
+	ERROR_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED                          syscall.Errno = 788
+	ERROR_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR                          syscall.Errno = 789
+	ERROR_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR                              syscall.Errno = 790
+	ERROR_BAD_MCFG_TABLE                                                      syscall.Errno = 791
+	ERROR_DISK_REPAIR_REDIRECTED                                              syscall.Errno = 792
+	ERROR_DISK_REPAIR_UNSUCCESSFUL                                            syscall.Errno = 793
+	ERROR_CORRUPT_LOG_OVERFULL                                                syscall.Errno = 794
+	ERROR_CORRUPT_LOG_CORRUPTED                                               syscall.Errno = 795
+	ERROR_CORRUPT_LOG_UNAVAILABLE                                             syscall.Errno = 796
+	ERROR_CORRUPT_LOG_DELETED_FULL                                            syscall.Errno = 797
+	ERROR_CORRUPT_LOG_CLEARED                                                 syscall.Errno = 798
+	ERROR_ORPHAN_NAME_EXHAUSTED                                               syscall.Errno = 799
+	ERROR_OPLOCK_SWITCHED_TO_NEW_HANDLE                                       syscall.Errno = 800
+	ERROR_CANNOT_GRANT_REQUESTED_OPLOCK                                       syscall.Errno = 801
+	ERROR_CANNOT_BREAK_OPLOCK                                                 syscall.Errno = 802
+	ERROR_OPLOCK_HANDLE_CLOSED                                                syscall.Errno = 803
+	ERROR_NO_ACE_CONDITION                                                    syscall.Errno = 804
+	ERROR_INVALID_ACE_CONDITION                                               syscall.Errno = 805
+	ERROR_FILE_HANDLE_REVOKED                                                 syscall.Errno = 806
+	ERROR_IMAGE_AT_DIFFERENT_BASE                                             syscall.Errno = 807
+	ERROR_ENCRYPTED_IO_NOT_POSSIBLE                                           syscall.Errno = 808
+	ERROR_FILE_METADATA_OPTIMIZATION_IN_PROGRESS                              syscall.Errno = 809
+	ERROR_QUOTA_ACTIVITY                                                      syscall.Errno = 810
+	ERROR_HANDLE_REVOKED                                                      syscall.Errno = 811
+	ERROR_CALLBACK_INVOKE_INLINE                                              syscall.Errno = 812
+	ERROR_CPU_SET_INVALID                                                     syscall.Errno = 813
+	ERROR_ENCLAVE_NOT_TERMINATED                                              syscall.Errno = 814
+	ERROR_ENCLAVE_VIOLATION                                                   syscall.Errno = 815
+	ERROR_EA_ACCESS_DENIED                                                    syscall.Errno = 994
+	ERROR_OPERATION_ABORTED                                                   syscall.Errno = 995
+	ERROR_IO_INCOMPLETE                                                       syscall.Errno = 996
+	ERROR_IO_PENDING                                                          syscall.Errno = 997
+	ERROR_NOACCESS                                                            syscall.Errno = 998
+	ERROR_SWAPERROR                                                           syscall.Errno = 999
+	ERROR_STACK_OVERFLOW                                                      syscall.Errno = 1001
+	ERROR_INVALID_MESSAGE                                                     syscall.Errno = 1002
+	ERROR_CAN_NOT_COMPLETE                                                    syscall.Errno = 1003
+	ERROR_INVALID_FLAGS                                                       syscall.Errno = 1004
+	ERROR_UNRECOGNIZED_VOLUME                                                 syscall.Errno = 1005
+	ERROR_FILE_INVALID                                                        syscall.Errno = 1006
+	ERROR_FULLSCREEN_MODE                                                     syscall.Errno = 1007
+	ERROR_NO_TOKEN                                                            syscall.Errno = 1008
+	ERROR_BADDB                                                               syscall.Errno = 1009
+	ERROR_BADKEY                                                              syscall.Errno = 1010
+	ERROR_CANTOPEN                                                            syscall.Errno = 1011
+	ERROR_CANTREAD                                                            syscall.Errno = 1012
+	ERROR_CANTWRITE                                                           syscall.Errno = 1013
+	ERROR_REGISTRY_RECOVERED                                                  syscall.Errno = 1014
+	ERROR_REGISTRY_CORRUPT                                                    syscall.Errno = 1015
+	ERROR_REGISTRY_IO_FAILED                                                  syscall.Errno = 1016
+	ERROR_NOT_REGISTRY_FILE                                                   syscall.Errno = 1017
+	ERROR_KEY_DELETED                                                         syscall.Errno = 1018
+	ERROR_NO_LOG_SPACE                                                        syscall.Errno = 1019
+	ERROR_KEY_HAS_CHILDREN                                                    syscall.Errno = 1020
+	ERROR_CHILD_MUST_BE_VOLATILE                                              syscall.Errno = 1021
+	ERROR_NOTIFY_ENUM_DIR                                                     syscall.Errno = 1022
+	ERROR_DEPENDENT_SERVICES_RUNNING                                          syscall.Errno = 1051
+	ERROR_INVALID_SERVICE_CONTROL                                             syscall.Errno = 1052
+	ERROR_SERVICE_REQUEST_TIMEOUT                                             syscall.Errno = 1053
+	ERROR_SERVICE_NO_THREAD                                                   syscall.Errno = 1054
+	ERROR_SERVICE_DATABASE_LOCKED                                             syscall.Errno = 1055
+	ERROR_SERVICE_ALREADY_RUNNING                                             syscall.Errno = 1056
+	ERROR_INVALID_SERVICE_ACCOUNT                                             syscall.Errno = 1057
+	ERROR_SERVICE_DISABLED                                                    syscall.Errno = 1058
+	ERROR_CIRCULAR_DEPENDENCY                                                 syscall.Errno = 1059
+	ERROR_SERVICE_DOES_NOT_EXIST                                              syscall.Errno = 1060
+	ERROR_SERVICE_CANNOT_ACCEPT_CTRL                                          syscall.Errno = 1061
+	ERROR_SERVICE_NOT_ACTIVE                                                  syscall.Errno = 1062
+	ERROR_FAILED_SERVICE_CONTROLLER_CONNECT                                   syscall.Errno = 1063
+	ERROR_EXCEPTION_IN_SERVICE                                                syscall.Errno = 1064
+	ERROR_DATABASE_DOES_NOT_EXIST                                             syscall.Errno = 1065
+	ERROR_SERVICE_SPECIFIC_ERROR                                              syscall.Errno = 1066
+	ERROR_PROCESS_ABORTED                                                     syscall.Errno = 1067
+	ERROR_SERVICE_DEPENDENCY_FAIL                                             syscall.Errno = 1068
+	ERROR_SERVICE_LOGON_FAILED                                                syscall.Errno = 1069
+	ERROR_SERVICE_START_HANG                                                  syscall.Errno = 1070
+	ERROR_INVALID_SERVICE_LOCK                                                syscall.Errno = 1071
+	ERROR_SERVICE_MARKED_FOR_DELETE                                           syscall.Errno = 1072
+	ERROR_SERVICE_EXISTS                                                      syscall.Errno = 1073
+	ERROR_ALREADY_RUNNING_LKG                                                 syscall.Errno = 1074
+	ERROR_SERVICE_DEPENDENCY_DELETED                                          syscall.Errno = 1075
+	ERROR_BOOT_ALREADY_ACCEPTED                                               syscall.Errno = 1076
+	ERROR_SERVICE_NEVER_STARTED                                               syscall.Errno = 1077
+	ERROR_DUPLICATE_SERVICE_NAME                                              syscall.Errno = 1078
+	ERROR_DIFFERENT_SERVICE_ACCOUNT                                           syscall.Errno = 1079
+	ERROR_CANNOT_DETECT_DRIVER_FAILURE                                        syscall.Errno = 1080
+	ERROR_CANNOT_DETECT_PROCESS_ABORT                                         syscall.Errno = 1081
+	ERROR_NO_RECOVERY_PROGRAM                                                 syscall.Errno = 1082
+	ERROR_SERVICE_NOT_IN_EXE                                                  syscall.Errno = 1083
+	ERROR_NOT_SAFEBOOT_SERVICE                                                syscall.Errno = 1084
+	ERROR_END_OF_MEDIA                                                        syscall.Errno = 1100
+	ERROR_FILEMARK_DETECTED                                                   syscall.Errno = 1101
+	ERROR_BEGINNING_OF_MEDIA                                                  syscall.Errno = 1102
+	ERROR_SETMARK_DETECTED                                                    syscall.Errno = 1103
+	ERROR_NO_DATA_DETECTED                                                    syscall.Errno = 1104
+	ERROR_PARTITION_FAILURE                                                   syscall.Errno = 1105
+	ERROR_INVALID_BLOCK_LENGTH                                                syscall.Errno = 1106
+	ERROR_DEVICE_NOT_PARTITIONED                                              syscall.Errno = 1107
+	ERROR_UNABLE_TO_LOCK_MEDIA                                                syscall.Errno = 1108
+	ERROR_UNABLE_TO_UNLOAD_MEDIA                                              syscall.Errno = 1109
+	ERROR_MEDIA_CHANGED                                                       syscall.Errno = 1110
+	ERROR_BUS_RESET                                                           syscall.Errno = 1111
+	ERROR_NO_MEDIA_IN_DRIVE                                                   syscall.Errno = 1112
+	ERROR_NO_UNICODE_TRANSLATION                                              syscall.Errno = 1113
+	ERROR_DLL_INIT_FAILED                                                     syscall.Errno = 1114
+	ERROR_SHUTDOWN_IN_PROGRESS                                                syscall.Errno = 1115
+	ERROR_NO_SHUTDOWN_IN_PROGRESS                                             syscall.Errno = 1116
+	ERROR_IO_DEVICE                                                           syscall.Errno = 1117
+	ERROR_SERIAL_NO_DEVICE                                                    syscall.Errno = 1118
+	ERROR_IRQ_BUSY                                                            syscall.Errno = 1119
+	ERROR_MORE_WRITES                                                         syscall.Errno = 1120
+	ERROR_COUNTER_TIMEOUT                                                     syscall.Errno = 1121
+	ERROR_FLOPPY_ID_MARK_NOT_FOUND                                            syscall.Errno = 1122
+	ERROR_FLOPPY_WRONG_CYLINDER                                               syscall.Errno = 1123
+	ERROR_FLOPPY_UNKNOWN_ERROR                                                syscall.Errno = 1124
+	ERROR_FLOPPY_BAD_REGISTERS                                                syscall.Errno = 1125
+	ERROR_DISK_RECALIBRATE_FAILED                                             syscall.Errno = 1126
+	ERROR_DISK_OPERATION_FAILED                                               syscall.Errno = 1127
+	ERROR_DISK_RESET_FAILED                                                   syscall.Errno = 1128
+	ERROR_EOM_OVERFLOW                                                        syscall.Errno = 1129
+	ERROR_NOT_ENOUGH_SERVER_MEMORY                                            syscall.Errno = 1130
+	ERROR_POSSIBLE_DEADLOCK                                                   syscall.Errno = 1131
+	ERROR_MAPPED_ALIGNMENT                                                    syscall.Errno = 1132
+	ERROR_SET_POWER_STATE_VETOED                                              syscall.Errno = 1140
+	ERROR_SET_POWER_STATE_FAILED                                              syscall.Errno = 1141
+	ERROR_TOO_MANY_LINKS                                                      syscall.Errno = 1142
+	ERROR_OLD_WIN_VERSION                                                     syscall.Errno = 1150
+	ERROR_APP_WRONG_OS                                                        syscall.Errno = 1151
+	ERROR_SINGLE_INSTANCE_APP                                                 syscall.Errno = 1152
+	ERROR_RMODE_APP                                                           syscall.Errno = 1153
+	ERROR_INVALID_DLL                                                         syscall.Errno = 1154
+	ERROR_NO_ASSOCIATION                                                      syscall.Errno = 1155
+	ERROR_DDE_FAIL                                                            syscall.Errno = 1156
+	ERROR_DLL_NOT_FOUND                                                       syscall.Errno = 1157
+	ERROR_NO_MORE_USER_HANDLES                                                syscall.Errno = 1158
+	ERROR_MESSAGE_SYNC_ONLY                                                   syscall.Errno = 1159
+	ERROR_SOURCE_ELEMENT_EMPTY                                                syscall.Errno = 1160
+	ERROR_DESTINATION_ELEMENT_FULL                                            syscall.Errno = 1161
+	ERROR_ILLEGAL_ELEMENT_ADDRESS                                             syscall.Errno = 1162
+	ERROR_MAGAZINE_NOT_PRESENT                                                syscall.Errno = 1163
+	ERROR_DEVICE_REINITIALIZATION_NEEDED                                      syscall.Errno = 1164
+	ERROR_DEVICE_REQUIRES_CLEANING                                            syscall.Errno = 1165
+	ERROR_DEVICE_DOOR_OPEN                                                    syscall.Errno = 1166
+	ERROR_DEVICE_NOT_CONNECTED                                                syscall.Errno = 1167
+	ERROR_NOT_FOUND                                                           syscall.Errno = 1168
+	ERROR_NO_MATCH                                                            syscall.Errno = 1169
+	ERROR_SET_NOT_FOUND                                                       syscall.Errno = 1170
+	ERROR_POINT_NOT_FOUND                                                     syscall.Errno = 1171
+	ERROR_NO_TRACKING_SERVICE                                                 syscall.Errno = 1172
+	ERROR_NO_VOLUME_ID                                                        syscall.Errno = 1173
+	ERROR_UNABLE_TO_REMOVE_REPLACED                                           syscall.Errno = 1175
+	ERROR_UNABLE_TO_MOVE_REPLACEMENT                                          syscall.Errno = 1176
+	ERROR_UNABLE_TO_MOVE_REPLACEMENT_2                                        syscall.Errno = 1177
+	ERROR_JOURNAL_DELETE_IN_PROGRESS                                          syscall.Errno = 1178
+	ERROR_JOURNAL_NOT_ACTIVE                                                  syscall.Errno = 1179
+	ERROR_POTENTIAL_FILE_FOUND                                                syscall.Errno = 1180
+	ERROR_JOURNAL_ENTRY_DELETED                                               syscall.Errno = 1181
+	ERROR_SHUTDOWN_IS_SCHEDULED                                               syscall.Errno = 1190
+	ERROR_SHUTDOWN_USERS_LOGGED_ON                                            syscall.Errno = 1191
+	ERROR_BAD_DEVICE                                                          syscall.Errno = 1200
+	ERROR_CONNECTION_UNAVAIL                                                  syscall.Errno = 1201
+	ERROR_DEVICE_ALREADY_REMEMBERED                                           syscall.Errno = 1202
+	ERROR_NO_NET_OR_BAD_PATH                                                  syscall.Errno = 1203
+	ERROR_BAD_PROVIDER                                                        syscall.Errno = 1204
+	ERROR_CANNOT_OPEN_PROFILE                                                 syscall.Errno = 1205
+	ERROR_BAD_PROFILE                                                         syscall.Errno = 1206
+	ERROR_NOT_CONTAINER                                                       syscall.Errno = 1207
+	ERROR_EXTENDED_ERROR                                                      syscall.Errno = 1208
+	ERROR_INVALID_GROUPNAME                                                   syscall.Errno = 1209
+	ERROR_INVALID_COMPUTERNAME                                                syscall.Errno = 1210
+	ERROR_INVALID_EVENTNAME                                                   syscall.Errno = 1211
+	ERROR_INVALID_DOMAINNAME                                                  syscall.Errno = 1212
+	ERROR_INVALID_SERVICENAME                                                 syscall.Errno = 1213
+	ERROR_INVALID_NETNAME                                                     syscall.Errno = 1214
+	ERROR_INVALID_SHARENAME                                                   syscall.Errno = 1215
+	ERROR_INVALID_PASSWORDNAME                                                syscall.Errno = 1216
+	ERROR_INVALID_MESSAGENAME                                                 syscall.Errno = 1217
+	ERROR_INVALID_MESSAGEDEST                                                 syscall.Errno = 1218
+	ERROR_SESSION_CREDENTIAL_CONFLICT                                         syscall.Errno = 1219
+	ERROR_REMOTE_SESSION_LIMIT_EXCEEDED                                       syscall.Errno = 1220
+	ERROR_DUP_DOMAINNAME                                                      syscall.Errno = 1221
+	ERROR_NO_NETWORK                                                          syscall.Errno = 1222
+	ERROR_CANCELLED                                                           syscall.Errno = 1223
+	ERROR_USER_MAPPED_FILE                                                    syscall.Errno = 1224
+	ERROR_CONNECTION_REFUSED                                                  syscall.Errno = 1225
+	ERROR_GRACEFUL_DISCONNECT                                                 syscall.Errno = 1226
+	ERROR_ADDRESS_ALREADY_ASSOCIATED                                          syscall.Errno = 1227
+	ERROR_ADDRESS_NOT_ASSOCIATED                                              syscall.Errno = 1228
+	ERROR_CONNECTION_INVALID                                                  syscall.Errno = 1229
+	ERROR_CONNECTION_ACTIVE                                                   syscall.Errno = 1230
+	ERROR_NETWORK_UNREACHABLE                                                 syscall.Errno = 1231
+	ERROR_HOST_UNREACHABLE                                                    syscall.Errno = 1232
+	ERROR_PROTOCOL_UNREACHABLE                                                syscall.Errno = 1233
+	ERROR_PORT_UNREACHABLE                                                    syscall.Errno = 1234
+	ERROR_REQUEST_ABORTED                                                     syscall.Errno = 1235
+	ERROR_CONNECTION_ABORTED                                                  syscall.Errno = 1236
+	ERROR_RETRY                                                               syscall.Errno = 1237
+	ERROR_CONNECTION_COUNT_LIMIT                                              syscall.Errno = 1238
+	ERROR_LOGIN_TIME_RESTRICTION                                              syscall.Errno = 1239
+	ERROR_LOGIN_WKSTA_RESTRICTION                                             syscall.Errno = 1240
+	ERROR_INCORRECT_ADDRESS                                                   syscall.Errno = 1241
+	ERROR_ALREADY_REGISTERED                                                  syscall.Errno = 1242
+	ERROR_SERVICE_NOT_FOUND                                                   syscall.Errno = 1243
+	ERROR_NOT_AUTHENTICATED                                                   syscall.Errno = 1244
+	ERROR_NOT_LOGGED_ON                                                       syscall.Errno = 1245
+	ERROR_CONTINUE                                                            syscall.Errno = 1246
+	ERROR_ALREADY_INITIALIZED                                                 syscall.Errno = 1247
+	ERROR_NO_MORE_DEVICES                                                     syscall.Errno = 1248
+	ERROR_NO_SUCH_SITE                                                        syscall.Errno = 1249
+	ERROR_DOMAIN_CONTROLLER_EXISTS                                            syscall.Errno = 1250
+	ERROR_ONLY_IF_CONNECTED                                                   syscall.Errno = 1251
+	ERROR_OVERRIDE_NOCHANGES                                                  syscall.Errno = 1252
+	ERROR_BAD_USER_PROFILE                                                    syscall.Errno = 1253
+	ERROR_NOT_SUPPORTED_ON_SBS                                                syscall.Errno = 1254
+	ERROR_SERVER_SHUTDOWN_IN_PROGRESS                                         syscall.Errno = 1255
+	ERROR_HOST_DOWN                                                           syscall.Errno = 1256
+	ERROR_NON_ACCOUNT_SID                                                     syscall.Errno = 1257
+	ERROR_NON_DOMAIN_SID                                                      syscall.Errno = 1258
+	ERROR_APPHELP_BLOCK                                                       syscall.Errno = 1259
+	ERROR_ACCESS_DISABLED_BY_POLICY                                           syscall.Errno = 1260
+	ERROR_REG_NAT_CONSUMPTION                                                 syscall.Errno = 1261
+	ERROR_CSCSHARE_OFFLINE                                                    syscall.Errno = 1262
+	ERROR_PKINIT_FAILURE                                                      syscall.Errno = 1263
+	ERROR_SMARTCARD_SUBSYSTEM_FAILURE                                         syscall.Errno = 1264
+	ERROR_DOWNGRADE_DETECTED                                                  syscall.Errno = 1265
+	ERROR_MACHINE_LOCKED                                                      syscall.Errno = 1271
+	ERROR_SMB_GUEST_LOGON_BLOCKED                                             syscall.Errno = 1272
+	ERROR_CALLBACK_SUPPLIED_INVALID_DATA                                      syscall.Errno = 1273
+	ERROR_SYNC_FOREGROUND_REFRESH_REQUIRED                                    syscall.Errno = 1274
+	ERROR_DRIVER_BLOCKED                                                      syscall.Errno = 1275
+	ERROR_INVALID_IMPORT_OF_NON_DLL                                           syscall.Errno = 1276
+	ERROR_ACCESS_DISABLED_WEBBLADE                                            syscall.Errno = 1277
+	ERROR_ACCESS_DISABLED_WEBBLADE_TAMPER                                     syscall.Errno = 1278
+	ERROR_RECOVERY_FAILURE                                                    syscall.Errno = 1279
+	ERROR_ALREADY_FIBER                                                       syscall.Errno = 1280
+	ERROR_ALREADY_THREAD                                                      syscall.Errno = 1281
+	ERROR_STACK_BUFFER_OVERRUN                                                syscall.Errno = 1282
+	ERROR_PARAMETER_QUOTA_EXCEEDED                                            syscall.Errno = 1283
+	ERROR_DEBUGGER_INACTIVE                                                   syscall.Errno = 1284
+	ERROR_DELAY_LOAD_FAILED                                                   syscall.Errno = 1285
+	ERROR_VDM_DISALLOWED                                                      syscall.Errno = 1286
+	ERROR_UNIDENTIFIED_ERROR                                                  syscall.Errno = 1287
+	ERROR_INVALID_CRUNTIME_PARAMETER                                          syscall.Errno = 1288
+	ERROR_BEYOND_VDL                                                          syscall.Errno = 1289
+	ERROR_INCOMPATIBLE_SERVICE_SID_TYPE                                       syscall.Errno = 1290
+	ERROR_DRIVER_PROCESS_TERMINATED                                           syscall.Errno = 1291
+	ERROR_IMPLEMENTATION_LIMIT                                                syscall.Errno = 1292
+	ERROR_PROCESS_IS_PROTECTED                                                syscall.Errno = 1293
+	ERROR_SERVICE_NOTIFY_CLIENT_LAGGING                                       syscall.Errno = 1294
+	ERROR_DISK_QUOTA_EXCEEDED                                                 syscall.Errno = 1295
+	ERROR_CONTENT_BLOCKED                                                     syscall.Errno = 1296
+	ERROR_INCOMPATIBLE_SERVICE_PRIVILEGE                                      syscall.Errno = 1297
+	ERROR_APP_HANG                                                            syscall.Errno = 1298
+	ERROR_INVALID_LABEL                                                       syscall.Errno = 1299
+	ERROR_NOT_ALL_ASSIGNED                                                    syscall.Errno = 1300
+	ERROR_SOME_NOT_MAPPED                                                     syscall.Errno = 1301
+	ERROR_NO_QUOTAS_FOR_ACCOUNT                                               syscall.Errno = 1302
+	ERROR_LOCAL_USER_SESSION_KEY                                              syscall.Errno = 1303
+	ERROR_NULL_LM_PASSWORD                                                    syscall.Errno = 1304
+	ERROR_UNKNOWN_REVISION                                                    syscall.Errno = 1305
+	ERROR_REVISION_MISMATCH                                                   syscall.Errno = 1306
+	ERROR_INVALID_OWNER                                                       syscall.Errno = 1307
+	ERROR_INVALID_PRIMARY_GROUP                                               syscall.Errno = 1308
+	ERROR_NO_IMPERSONATION_TOKEN                                              syscall.Errno = 1309
+	ERROR_CANT_DISABLE_MANDATORY                                              syscall.Errno = 1310
+	ERROR_NO_LOGON_SERVERS                                                    syscall.Errno = 1311
+	ERROR_NO_SUCH_LOGON_SESSION                                               syscall.Errno = 1312
+	ERROR_NO_SUCH_PRIVILEGE                                                   syscall.Errno = 1313
+	ERROR_PRIVILEGE_NOT_HELD                                                  syscall.Errno = 1314
+	ERROR_INVALID_ACCOUNT_NAME                                                syscall.Errno = 1315
+	ERROR_USER_EXISTS                                                         syscall.Errno = 1316
+	ERROR_NO_SUCH_USER                                                        syscall.Errno = 1317
+	ERROR_GROUP_EXISTS                                                        syscall.Errno = 1318
+	ERROR_NO_SUCH_GROUP                                                       syscall.Errno = 1319
+	ERROR_MEMBER_IN_GROUP                                                     syscall.Errno = 1320
+	ERROR_MEMBER_NOT_IN_GROUP                                                 syscall.Errno = 1321
+	ERROR_LAST_ADMIN                                                          syscall.Errno = 1322
+	ERROR_WRONG_PASSWORD                                                      syscall.Errno = 1323
+	ERROR_ILL_FORMED_PASSWORD                                                 syscall.Errno = 1324
+	ERROR_PASSWORD_RESTRICTION                                                syscall.Errno = 1325
+	ERROR_LOGON_FAILURE                                                       syscall.Errno = 1326
+	ERROR_ACCOUNT_RESTRICTION                                                 syscall.Errno = 1327
+	ERROR_INVALID_LOGON_HOURS                                                 syscall.Errno = 1328
+	ERROR_INVALID_WORKSTATION                                                 syscall.Errno = 1329
+	ERROR_PASSWORD_EXPIRED                                                    syscall.Errno = 1330
+	ERROR_ACCOUNT_DISABLED                                                    syscall.Errno = 1331
+	ERROR_NONE_MAPPED                                                         syscall.Errno = 1332
+	ERROR_TOO_MANY_LUIDS_REQUESTED                                            syscall.Errno = 1333
+	ERROR_LUIDS_EXHAUSTED                                                     syscall.Errno = 1334
+	ERROR_INVALID_SUB_AUTHORITY                                               syscall.Errno = 1335
+	ERROR_INVALID_ACL                                                         syscall.Errno = 1336
+	ERROR_INVALID_SID                                                         syscall.Errno = 1337
+	ERROR_INVALID_SECURITY_DESCR                                              syscall.Errno = 1338
+	ERROR_BAD_INHERITANCE_ACL                                                 syscall.Errno = 1340
+	ERROR_SERVER_DISABLED                                                     syscall.Errno = 1341
+	ERROR_SERVER_NOT_DISABLED                                                 syscall.Errno = 1342
+	ERROR_INVALID_ID_AUTHORITY                                                syscall.Errno = 1343
+	ERROR_ALLOTTED_SPACE_EXCEEDED                                             syscall.Errno = 1344
+	ERROR_INVALID_GROUP_ATTRIBUTES                                            syscall.Errno = 1345
+	ERROR_BAD_IMPERSONATION_LEVEL                                             syscall.Errno = 1346
+	ERROR_CANT_OPEN_ANONYMOUS                                                 syscall.Errno = 1347
+	ERROR_BAD_VALIDATION_CLASS                                                syscall.Errno = 1348
+	ERROR_BAD_TOKEN_TYPE                                                      syscall.Errno = 1349
+	ERROR_NO_SECURITY_ON_OBJECT                                               syscall.Errno = 1350
+	ERROR_CANT_ACCESS_DOMAIN_INFO                                             syscall.Errno = 1351
+	ERROR_INVALID_SERVER_STATE                                                syscall.Errno = 1352
+	ERROR_INVALID_DOMAIN_STATE                                                syscall.Errno = 1353
+	ERROR_INVALID_DOMAIN_ROLE                                                 syscall.Errno = 1354
+	ERROR_NO_SUCH_DOMAIN                                                      syscall.Errno = 1355
+	ERROR_DOMAIN_EXISTS                                                       syscall.Errno = 1356
+	ERROR_DOMAIN_LIMIT_EXCEEDED                                               syscall.Errno = 1357
+	ERROR_INTERNAL_DB_CORRUPTION                                              syscall.Errno = 1358
+	ERROR_INTERNAL_ERROR                                                      syscall.Errno = 1359
+	ERROR_GENERIC_NOT_MAPPED                                                  syscall.Errno = 1360
+	ERROR_BAD_DESCRIPTOR_FORMAT                                               syscall.Errno = 1361
+	ERROR_NOT_LOGON_PROCESS                                                   syscall.Errno = 1362
+	ERROR_LOGON_SESSION_EXISTS                                                syscall.Errno = 1363
+	ERROR_NO_SUCH_PACKAGE                                                     syscall.Errno = 1364
+	ERROR_BAD_LOGON_SESSION_STATE                                             syscall.Errno = 1365
+	ERROR_LOGON_SESSION_COLLISION                                             syscall.Errno = 1366
+	ERROR_INVALID_LOGON_TYPE                                                  syscall.Errno = 1367
+	ERROR_CANNOT_IMPERSONATE                                                  syscall.Errno = 1368
+	ERROR_RXACT_INVALID_STATE                                                 syscall.Errno = 1369
+	ERROR_RXACT_COMMIT_FAILURE                                                syscall.Errno = 1370
+	ERROR_SPECIAL_ACCOUNT                                                     syscall.Errno = 1371
+	ERROR_SPECIAL_GROUP                                                       syscall.Errno = 1372
+	ERROR_SPECIAL_USER                                                        syscall.Errno = 1373
+	ERROR_MEMBERS_PRIMARY_GROUP                                               syscall.Errno = 1374
+	ERROR_TOKEN_ALREADY_IN_USE                                                syscall.Errno = 1375
+	ERROR_NO_SUCH_ALIAS                                                       syscall.Errno = 1376
+	ERROR_MEMBER_NOT_IN_ALIAS                                                 syscall.Errno = 1377
+	ERROR_MEMBER_IN_ALIAS                                                     syscall.Errno = 1378
+	ERROR_ALIAS_EXISTS                                                        syscall.Errno = 1379
+	ERROR_LOGON_NOT_GRANTED                                                   syscall.Errno = 1380
+	ERROR_TOO_MANY_SECRETS                                                    syscall.Errno = 1381
+	ERROR_SECRET_TOO_LONG                                                     syscall.Errno = 1382
+	ERROR_INTERNAL_DB_ERROR                                                   syscall.Errno = 1383
+	ERROR_TOO_MANY_CONTEXT_IDS                                                syscall.Errno = 1384
+	ERROR_LOGON_TYPE_NOT_GRANTED                                              syscall.Errno = 1385
+	ERROR_NT_CROSS_ENCRYPTION_REQUIRED                                        syscall.Errno = 1386
+	ERROR_NO_SUCH_MEMBER                                                      syscall.Errno = 1387
+	ERROR_INVALID_MEMBER                                                      syscall.Errno = 1388
+	ERROR_TOO_MANY_SIDS                                                       syscall.Errno = 1389
+	ERROR_LM_CROSS_ENCRYPTION_REQUIRED                                        syscall.Errno = 1390
+	ERROR_NO_INHERITANCE                                                      syscall.Errno = 1391
+	ERROR_FILE_CORRUPT                                                        syscall.Errno = 1392
+	ERROR_DISK_CORRUPT                                                        syscall.Errno = 1393
+	ERROR_NO_USER_SESSION_KEY                                                 syscall.Errno = 1394
+	ERROR_LICENSE_QUOTA_EXCEEDED                                              syscall.Errno = 1395
+	ERROR_WRONG_TARGET_NAME                                                   syscall.Errno = 1396
+	ERROR_MUTUAL_AUTH_FAILED                                                  syscall.Errno = 1397
+	ERROR_TIME_SKEW                                                           syscall.Errno = 1398
+	ERROR_CURRENT_DOMAIN_NOT_ALLOWED                                          syscall.Errno = 1399
+	ERROR_INVALID_WINDOW_HANDLE                                               syscall.Errno = 1400
+	ERROR_INVALID_MENU_HANDLE                                                 syscall.Errno = 1401
+	ERROR_INVALID_CURSOR_HANDLE                                               syscall.Errno = 1402
+	ERROR_INVALID_ACCEL_HANDLE                                                syscall.Errno = 1403
+	ERROR_INVALID_HOOK_HANDLE                                                 syscall.Errno = 1404
+	ERROR_INVALID_DWP_HANDLE                                                  syscall.Errno = 1405
+	ERROR_TLW_WITH_WSCHILD                                                    syscall.Errno = 1406
+	ERROR_CANNOT_FIND_WND_CLASS                                               syscall.Errno = 1407
+	ERROR_WINDOW_OF_OTHER_THREAD                                              syscall.Errno = 1408
+	ERROR_HOTKEY_ALREADY_REGISTERED                                           syscall.Errno = 1409
+	ERROR_CLASS_ALREADY_EXISTS                                                syscall.Errno = 1410
+	ERROR_CLASS_DOES_NOT_EXIST                                                syscall.Errno = 1411
+	ERROR_CLASS_HAS_WINDOWS                                                   syscall.Errno = 1412
+	ERROR_INVALID_INDEX                                                       syscall.Errno = 1413
+	ERROR_INVALID_ICON_HANDLE                                                 syscall.Errno = 1414
+	ERROR_PRIVATE_DIALOG_INDEX                                                syscall.Errno = 1415
+	ERROR_LISTBOX_ID_NOT_FOUND                                                syscall.Errno = 1416
+	ERROR_NO_WILDCARD_CHARACTERS                                              syscall.Errno = 1417
+	ERROR_CLIPBOARD_NOT_OPEN                                                  syscall.Errno = 1418
+	ERROR_HOTKEY_NOT_REGISTERED                                               syscall.Errno = 1419
+	ERROR_WINDOW_NOT_DIALOG                                                   syscall.Errno = 1420
+	ERROR_CONTROL_ID_NOT_FOUND                                                syscall.Errno = 1421
+	ERROR_INVALID_COMBOBOX_MESSAGE                                            syscall.Errno = 1422
+	ERROR_WINDOW_NOT_COMBOBOX                                                 syscall.Errno = 1423
+	ERROR_INVALID_EDIT_HEIGHT                                                 syscall.Errno = 1424
+	ERROR_DC_NOT_FOUND                                                        syscall.Errno = 1425
+	ERROR_INVALID_HOOK_FILTER                                                 syscall.Errno = 1426
+	ERROR_INVALID_FILTER_PROC                                                 syscall.Errno = 1427
+	ERROR_HOOK_NEEDS_HMOD                                                     syscall.Errno = 1428
+	ERROR_GLOBAL_ONLY_HOOK                                                    syscall.Errno = 1429
+	ERROR_JOURNAL_HOOK_SET                                                    syscall.Errno = 1430
+	ERROR_HOOK_NOT_INSTALLED                                                  syscall.Errno = 1431
+	ERROR_INVALID_LB_MESSAGE                                                  syscall.Errno = 1432
+	ERROR_SETCOUNT_ON_BAD_LB                                                  syscall.Errno = 1433
+	ERROR_LB_WITHOUT_TABSTOPS                                                 syscall.Errno = 1434
+	ERROR_DESTROY_OBJECT_OF_OTHER_THREAD                                      syscall.Errno = 1435
+	ERROR_CHILD_WINDOW_MENU                                                   syscall.Errno = 1436
+	ERROR_NO_SYSTEM_MENU                                                      syscall.Errno = 1437
+	ERROR_INVALID_MSGBOX_STYLE                                                syscall.Errno = 1438
+	ERROR_INVALID_SPI_VALUE                                                   syscall.Errno = 1439
+	ERROR_SCREEN_ALREADY_LOCKED                                               syscall.Errno = 1440
+	ERROR_HWNDS_HAVE_DIFF_PARENT                                              syscall.Errno = 1441
+	ERROR_NOT_CHILD_WINDOW                                                    syscall.Errno = 1442
+	ERROR_INVALID_GW_COMMAND                                                  syscall.Errno = 1443
+	ERROR_INVALID_THREAD_ID                                                   syscall.Errno = 1444
+	ERROR_NON_MDICHILD_WINDOW                                                 syscall.Errno = 1445
+	ERROR_POPUP_ALREADY_ACTIVE                                                syscall.Errno = 1446
+	ERROR_NO_SCROLLBARS                                                       syscall.Errno = 1447
+	ERROR_INVALID_SCROLLBAR_RANGE                                             syscall.Errno = 1448
+	ERROR_INVALID_SHOWWIN_COMMAND                                             syscall.Errno = 1449
+	ERROR_NO_SYSTEM_RESOURCES                                                 syscall.Errno = 1450
+	ERROR_NONPAGED_SYSTEM_RESOURCES                                           syscall.Errno = 1451
+	ERROR_PAGED_SYSTEM_RESOURCES                                              syscall.Errno = 1452
+	ERROR_WORKING_SET_QUOTA                                                   syscall.Errno = 1453
+	ERROR_PAGEFILE_QUOTA                                                      syscall.Errno = 1454
+	ERROR_COMMITMENT_LIMIT                                                    syscall.Errno = 1455
+	ERROR_MENU_ITEM_NOT_FOUND                                                 syscall.Errno = 1456
+	ERROR_INVALID_KEYBOARD_HANDLE                                             syscall.Errno = 1457
+	ERROR_HOOK_TYPE_NOT_ALLOWED                                               syscall.Errno = 1458
+	ERROR_REQUIRES_INTERACTIVE_WINDOWSTATION                                  syscall.Errno = 1459
+	ERROR_TIMEOUT                                                             syscall.Errno = 1460
+	ERROR_INVALID_MONITOR_HANDLE                                              syscall.Errno = 1461
+	ERROR_INCORRECT_SIZE                                                      syscall.Errno = 1462
+	ERROR_SYMLINK_CLASS_DISABLED                                              syscall.Errno = 1463
+	ERROR_SYMLINK_NOT_SUPPORTED                                               syscall.Errno = 1464
+	ERROR_XML_PARSE_ERROR                                                     syscall.Errno = 1465
+	ERROR_XMLDSIG_ERROR                                                       syscall.Errno = 1466
+	ERROR_RESTART_APPLICATION                                                 syscall.Errno = 1467
+	ERROR_WRONG_COMPARTMENT                                                   syscall.Errno = 1468
+	ERROR_AUTHIP_FAILURE                                                      syscall.Errno = 1469
+	ERROR_NO_NVRAM_RESOURCES                                                  syscall.Errno = 1470
+	ERROR_NOT_GUI_PROCESS                                                     syscall.Errno = 1471
+	ERROR_EVENTLOG_FILE_CORRUPT                                               syscall.Errno = 1500
+	ERROR_EVENTLOG_CANT_START                                                 syscall.Errno = 1501
+	ERROR_LOG_FILE_FULL                                                       syscall.Errno = 1502
+	ERROR_EVENTLOG_FILE_CHANGED                                               syscall.Errno = 1503
+	ERROR_CONTAINER_ASSIGNED                                                  syscall.Errno = 1504
+	ERROR_JOB_NO_CONTAINER                                                    syscall.Errno = 1505
+	ERROR_INVALID_TASK_NAME                                                   syscall.Errno = 1550
+	ERROR_INVALID_TASK_INDEX                                                  syscall.Errno = 1551
+	ERROR_THREAD_ALREADY_IN_TASK                                              syscall.Errno = 1552
+	ERROR_INSTALL_SERVICE_FAILURE                                             syscall.Errno = 1601
+	ERROR_INSTALL_USEREXIT                                                    syscall.Errno = 1602
+	ERROR_INSTALL_FAILURE                                                     syscall.Errno = 1603
+	ERROR_INSTALL_SUSPEND                                                     syscall.Errno = 1604
+	ERROR_UNKNOWN_PRODUCT                                                     syscall.Errno = 1605
+	ERROR_UNKNOWN_FEATURE                                                     syscall.Errno = 1606
+	ERROR_UNKNOWN_COMPONENT                                                   syscall.Errno = 1607
+	ERROR_UNKNOWN_PROPERTY                                                    syscall.Errno = 1608
+	ERROR_INVALID_HANDLE_STATE                                                syscall.Errno = 1609
+	ERROR_BAD_CONFIGURATION                                                   syscall.Errno = 1610
+	ERROR_INDEX_ABSENT                                                        syscall.Errno = 1611
+	ERROR_INSTALL_SOURCE_ABSENT                                               syscall.Errno = 1612
+	ERROR_INSTALL_PACKAGE_VERSION                                             syscall.Errno = 1613
+	ERROR_PRODUCT_UNINSTALLED                                                 syscall.Errno = 1614
+	ERROR_BAD_QUERY_SYNTAX                                                    syscall.Errno = 1615
+	ERROR_INVALID_FIELD                                                       syscall.Errno = 1616
+	ERROR_DEVICE_REMOVED                                                      syscall.Errno = 1617
+	ERROR_INSTALL_ALREADY_RUNNING                                             syscall.Errno = 1618
+	ERROR_INSTALL_PACKAGE_OPEN_FAILED                                         syscall.Errno = 1619
+	ERROR_INSTALL_PACKAGE_INVALID                                             syscall.Errno = 1620
+	ERROR_INSTALL_UI_FAILURE                                                  syscall.Errno = 1621
+	ERROR_INSTALL_LOG_FAILURE                                                 syscall.Errno = 1622
+	ERROR_INSTALL_LANGUAGE_UNSUPPORTED                                        syscall.Errno = 1623
+	ERROR_INSTALL_TRANSFORM_FAILURE                                           syscall.Errno = 1624
+	ERROR_INSTALL_PACKAGE_REJECTED                                            syscall.Errno = 1625
+	ERROR_FUNCTION_NOT_CALLED                                                 syscall.Errno = 1626
+	ERROR_FUNCTION_FAILED                                                     syscall.Errno = 1627
+	ERROR_INVALID_TABLE                                                       syscall.Errno = 1628
+	ERROR_DATATYPE_MISMATCH                                                   syscall.Errno = 1629
+	ERROR_UNSUPPORTED_TYPE                                                    syscall.Errno = 1630
+	ERROR_CREATE_FAILED                                                       syscall.Errno = 1631
+	ERROR_INSTALL_TEMP_UNWRITABLE                                             syscall.Errno = 1632
+	ERROR_INSTALL_PLATFORM_UNSUPPORTED                                        syscall.Errno = 1633
+	ERROR_INSTALL_NOTUSED                                                     syscall.Errno = 1634
+	ERROR_PATCH_PACKAGE_OPEN_FAILED                                           syscall.Errno = 1635
+	ERROR_PATCH_PACKAGE_INVALID                                               syscall.Errno = 1636
+	ERROR_PATCH_PACKAGE_UNSUPPORTED                                           syscall.Errno = 1637
+	ERROR_PRODUCT_VERSION                                                     syscall.Errno = 1638
+	ERROR_INVALID_COMMAND_LINE                                                syscall.Errno = 1639
+	ERROR_INSTALL_REMOTE_DISALLOWED                                           syscall.Errno = 1640
+	ERROR_SUCCESS_REBOOT_INITIATED                                            syscall.Errno = 1641
+	ERROR_PATCH_TARGET_NOT_FOUND                                              syscall.Errno = 1642
+	ERROR_PATCH_PACKAGE_REJECTED                                              syscall.Errno = 1643
+	ERROR_INSTALL_TRANSFORM_REJECTED                                          syscall.Errno = 1644
+	ERROR_INSTALL_REMOTE_PROHIBITED                                           syscall.Errno = 1645
+	ERROR_PATCH_REMOVAL_UNSUPPORTED                                           syscall.Errno = 1646
+	ERROR_UNKNOWN_PATCH                                                       syscall.Errno = 1647
+	ERROR_PATCH_NO_SEQUENCE                                                   syscall.Errno = 1648
+	ERROR_PATCH_REMOVAL_DISALLOWED                                            syscall.Errno = 1649
+	ERROR_INVALID_PATCH_XML                                                   syscall.Errno = 1650
+	ERROR_PATCH_MANAGED_ADVERTISED_PRODUCT                                    syscall.Errno = 1651
+	ERROR_INSTALL_SERVICE_SAFEBOOT                                            syscall.Errno = 1652
+	ERROR_FAIL_FAST_EXCEPTION                                                 syscall.Errno = 1653
+	ERROR_INSTALL_REJECTED                                                    syscall.Errno = 1654
+	ERROR_DYNAMIC_CODE_BLOCKED                                                syscall.Errno = 1655
+	ERROR_NOT_SAME_OBJECT                                                     syscall.Errno = 1656
+	ERROR_STRICT_CFG_VIOLATION                                                syscall.Errno = 1657
+	ERROR_SET_CONTEXT_DENIED                                                  syscall.Errno = 1660
+	ERROR_CROSS_PARTITION_VIOLATION                                           syscall.Errno = 1661
+	ERROR_INVALID_USER_BUFFER                                                 syscall.Errno = 1784
+	ERROR_UNRECOGNIZED_MEDIA                                                  syscall.Errno = 1785
+	ERROR_NO_TRUST_LSA_SECRET                                                 syscall.Errno = 1786
+	ERROR_NO_TRUST_SAM_ACCOUNT                                                syscall.Errno = 1787
+	ERROR_TRUSTED_DOMAIN_FAILURE                                              syscall.Errno = 1788
+	ERROR_TRUSTED_RELATIONSHIP_FAILURE                                        syscall.Errno = 1789
+	ERROR_TRUST_FAILURE                                                       syscall.Errno = 1790
+	ERROR_NETLOGON_NOT_STARTED                                                syscall.Errno = 1792
+	ERROR_ACCOUNT_EXPIRED                                                     syscall.Errno = 1793
+	ERROR_REDIRECTOR_HAS_OPEN_HANDLES                                         syscall.Errno = 1794
+	ERROR_PRINTER_DRIVER_ALREADY_INSTALLED                                    syscall.Errno = 1795
+	ERROR_UNKNOWN_PORT                                                        syscall.Errno = 1796
+	ERROR_UNKNOWN_PRINTER_DRIVER                                              syscall.Errno = 1797
+	ERROR_UNKNOWN_PRINTPROCESSOR                                              syscall.Errno = 1798
+	ERROR_INVALID_SEPARATOR_FILE                                              syscall.Errno = 1799
+	ERROR_INVALID_PRIORITY                                                    syscall.Errno = 1800
+	ERROR_INVALID_PRINTER_NAME                                                syscall.Errno = 1801
+	ERROR_PRINTER_ALREADY_EXISTS                                              syscall.Errno = 1802
+	ERROR_INVALID_PRINTER_COMMAND                                             syscall.Errno = 1803
+	ERROR_INVALID_DATATYPE                                                    syscall.Errno = 1804
+	ERROR_INVALID_ENVIRONMENT                                                 syscall.Errno = 1805
+	ERROR_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT                                   syscall.Errno = 1807
+	ERROR_NOLOGON_WORKSTATION_TRUST_ACCOUNT                                   syscall.Errno = 1808
+	ERROR_NOLOGON_SERVER_TRUST_ACCOUNT                                        syscall.Errno = 1809
+	ERROR_DOMAIN_TRUST_INCONSISTENT                                           syscall.Errno = 1810
+	ERROR_SERVER_HAS_OPEN_HANDLES                                             syscall.Errno = 1811
+	ERROR_RESOURCE_DATA_NOT_FOUND                                             syscall.Errno = 1812
+	ERROR_RESOURCE_TYPE_NOT_FOUND                                             syscall.Errno = 1813
+	ERROR_RESOURCE_NAME_NOT_FOUND                                             syscall.Errno = 1814
+	ERROR_RESOURCE_LANG_NOT_FOUND                                             syscall.Errno = 1815
+	ERROR_NOT_ENOUGH_QUOTA                                                    syscall.Errno = 1816
+	ERROR_INVALID_TIME                                                        syscall.Errno = 1901
+	ERROR_INVALID_FORM_NAME                                                   syscall.Errno = 1902
+	ERROR_INVALID_FORM_SIZE                                                   syscall.Errno = 1903
+	ERROR_ALREADY_WAITING                                                     syscall.Errno = 1904
+	ERROR_PRINTER_DELETED                                                     syscall.Errno = 1905
+	ERROR_INVALID_PRINTER_STATE                                               syscall.Errno = 1906
+	ERROR_PASSWORD_MUST_CHANGE                                                syscall.Errno = 1907
+	ERROR_DOMAIN_CONTROLLER_NOT_FOUND                                         syscall.Errno = 1908
+	ERROR_ACCOUNT_LOCKED_OUT                                                  syscall.Errno = 1909
+	ERROR_NO_SITENAME                                                         syscall.Errno = 1919
+	ERROR_CANT_ACCESS_FILE                                                    syscall.Errno = 1920
+	ERROR_CANT_RESOLVE_FILENAME                                               syscall.Errno = 1921
+	ERROR_KM_DRIVER_BLOCKED                                                   syscall.Errno = 1930
+	ERROR_CONTEXT_EXPIRED                                                     syscall.Errno = 1931
+	ERROR_PER_USER_TRUST_QUOTA_EXCEEDED                                       syscall.Errno = 1932
+	ERROR_ALL_USER_TRUST_QUOTA_EXCEEDED                                       syscall.Errno = 1933
+	ERROR_USER_DELETE_TRUST_QUOTA_EXCEEDED                                    syscall.Errno = 1934
+	ERROR_AUTHENTICATION_FIREWALL_FAILED                                      syscall.Errno = 1935
+	ERROR_REMOTE_PRINT_CONNECTIONS_BLOCKED                                    syscall.Errno = 1936
+	ERROR_NTLM_BLOCKED                                                        syscall.Errno = 1937
+	ERROR_PASSWORD_CHANGE_REQUIRED                                            syscall.Errno = 1938
+	ERROR_LOST_MODE_LOGON_RESTRICTION                                         syscall.Errno = 1939
+	ERROR_INVALID_PIXEL_FORMAT                                                syscall.Errno = 2000
+	ERROR_BAD_DRIVER                                                          syscall.Errno = 2001
+	ERROR_INVALID_WINDOW_STYLE                                                syscall.Errno = 2002
+	ERROR_METAFILE_NOT_SUPPORTED                                              syscall.Errno = 2003
+	ERROR_TRANSFORM_NOT_SUPPORTED                                             syscall.Errno = 2004
+	ERROR_CLIPPING_NOT_SUPPORTED                                              syscall.Errno = 2005
+	ERROR_INVALID_CMM                                                         syscall.Errno = 2010
+	ERROR_INVALID_PROFILE                                                     syscall.Errno = 2011
+	ERROR_TAG_NOT_FOUND                                                       syscall.Errno = 2012
+	ERROR_TAG_NOT_PRESENT                                                     syscall.Errno = 2013
+	ERROR_DUPLICATE_TAG                                                       syscall.Errno = 2014
+	ERROR_PROFILE_NOT_ASSOCIATED_WITH_DEVICE                                  syscall.Errno = 2015
+	ERROR_PROFILE_NOT_FOUND                                                   syscall.Errno = 2016
+	ERROR_INVALID_COLORSPACE                                                  syscall.Errno = 2017
+	ERROR_ICM_NOT_ENABLED                                                     syscall.Errno = 2018
+	ERROR_DELETING_ICM_XFORM                                                  syscall.Errno = 2019
+	ERROR_INVALID_TRANSFORM                                                   syscall.Errno = 2020
+	ERROR_COLORSPACE_MISMATCH                                                 syscall.Errno = 2021
+	ERROR_INVALID_COLORINDEX                                                  syscall.Errno = 2022
+	ERROR_PROFILE_DOES_NOT_MATCH_DEVICE                                       syscall.Errno = 2023
+	ERROR_CONNECTED_OTHER_PASSWORD                                            syscall.Errno = 2108
+	ERROR_CONNECTED_OTHER_PASSWORD_DEFAULT                                    syscall.Errno = 2109
+	ERROR_BAD_USERNAME                                                        syscall.Errno = 2202
+	ERROR_NOT_CONNECTED                                                       syscall.Errno = 2250
+	ERROR_OPEN_FILES                                                          syscall.Errno = 2401
+	ERROR_ACTIVE_CONNECTIONS                                                  syscall.Errno = 2402
+	ERROR_DEVICE_IN_USE                                                       syscall.Errno = 2404
+	ERROR_UNKNOWN_PRINT_MONITOR                                               syscall.Errno = 3000
+	ERROR_PRINTER_DRIVER_IN_USE                                               syscall.Errno = 3001
+	ERROR_SPOOL_FILE_NOT_FOUND                                                syscall.Errno = 3002
+	ERROR_SPL_NO_STARTDOC                                                     syscall.Errno = 3003
+	ERROR_SPL_NO_ADDJOB                                                       syscall.Errno = 3004
+	ERROR_PRINT_PROCESSOR_ALREADY_INSTALLED                                   syscall.Errno = 3005
+	ERROR_PRINT_MONITOR_ALREADY_INSTALLED                                     syscall.Errno = 3006
+	ERROR_INVALID_PRINT_MONITOR                                               syscall.Errno = 3007
+	ERROR_PRINT_MONITOR_IN_USE                                                syscall.Errno = 3008
+	ERROR_PRINTER_HAS_JOBS_QUEUED                                             syscall.Errno = 3009
+	ERROR_SUCCESS_REBOOT_REQUIRED                                             syscall.Errno = 3010
+	ERROR_SUCCESS_RESTART_REQUIRED                                            syscall.Errno = 3011
+	ERROR_PRINTER_NOT_FOUND                                                   syscall.Errno = 3012
+	ERROR_PRINTER_DRIVER_WARNED                                               syscall.Errno = 3013
+	ERROR_PRINTER_DRIVER_BLOCKED                                              syscall.Errno = 3014
+	ERROR_PRINTER_DRIVER_PACKAGE_IN_USE                                       syscall.Errno = 3015
+	ERROR_CORE_DRIVER_PACKAGE_NOT_FOUND                                       syscall.Errno = 3016
+	ERROR_FAIL_REBOOT_REQUIRED                                                syscall.Errno = 3017
+	ERROR_FAIL_REBOOT_INITIATED                                               syscall.Errno = 3018
+	ERROR_PRINTER_DRIVER_DOWNLOAD_NEEDED                                      syscall.Errno = 3019
+	ERROR_PRINT_JOB_RESTART_REQUIRED                                          syscall.Errno = 3020
+	ERROR_INVALID_PRINTER_DRIVER_MANIFEST                                     syscall.Errno = 3021
+	ERROR_PRINTER_NOT_SHAREABLE                                               syscall.Errno = 3022
+	ERROR_REQUEST_PAUSED                                                      syscall.Errno = 3050
+	ERROR_APPEXEC_CONDITION_NOT_SATISFIED                                     syscall.Errno = 3060
+	ERROR_APPEXEC_HANDLE_INVALIDATED                                          syscall.Errno = 3061
+	ERROR_APPEXEC_INVALID_HOST_GENERATION                                     syscall.Errno = 3062
+	ERROR_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION                             syscall.Errno = 3063
+	ERROR_APPEXEC_INVALID_HOST_STATE                                          syscall.Errno = 3064
+	ERROR_APPEXEC_NO_DONOR                                                    syscall.Errno = 3065
+	ERROR_APPEXEC_HOST_ID_MISMATCH                                            syscall.Errno = 3066
+	ERROR_APPEXEC_UNKNOWN_USER                                                syscall.Errno = 3067
+	ERROR_IO_REISSUE_AS_CACHED                                                syscall.Errno = 3950
+	ERROR_WINS_INTERNAL                                                       syscall.Errno = 4000
+	ERROR_CAN_NOT_DEL_LOCAL_WINS                                              syscall.Errno = 4001
+	ERROR_STATIC_INIT                                                         syscall.Errno = 4002
+	ERROR_INC_BACKUP                                                          syscall.Errno = 4003
+	ERROR_FULL_BACKUP                                                         syscall.Errno = 4004
+	ERROR_REC_NON_EXISTENT                                                    syscall.Errno = 4005
+	ERROR_RPL_NOT_ALLOWED                                                     syscall.Errno = 4006
+	ERROR_DHCP_ADDRESS_CONFLICT                                               syscall.Errno = 4100
+	ERROR_WMI_GUID_NOT_FOUND                                                  syscall.Errno = 4200
+	ERROR_WMI_INSTANCE_NOT_FOUND                                              syscall.Errno = 4201
+	ERROR_WMI_ITEMID_NOT_FOUND                                                syscall.Errno = 4202
+	ERROR_WMI_TRY_AGAIN                                                       syscall.Errno = 4203
+	ERROR_WMI_DP_NOT_FOUND                                                    syscall.Errno = 4204
+	ERROR_WMI_UNRESOLVED_INSTANCE_REF                                         syscall.Errno = 4205
+	ERROR_WMI_ALREADY_ENABLED                                                 syscall.Errno = 4206
+	ERROR_WMI_GUID_DISCONNECTED                                               syscall.Errno = 4207
+	ERROR_WMI_SERVER_UNAVAILABLE                                              syscall.Errno = 4208
+	ERROR_WMI_DP_FAILED                                                       syscall.Errno = 4209
+	ERROR_WMI_INVALID_MOF                                                     syscall.Errno = 4210
+	ERROR_WMI_INVALID_REGINFO                                                 syscall.Errno = 4211
+	ERROR_WMI_ALREADY_DISABLED                                                syscall.Errno = 4212
+	ERROR_WMI_READ_ONLY                                                       syscall.Errno = 4213
+	ERROR_WMI_SET_FAILURE                                                     syscall.Errno = 4214
+	ERROR_NOT_APPCONTAINER                                                    syscall.Errno = 4250
+	ERROR_APPCONTAINER_REQUIRED                                               syscall.Errno = 4251
+	ERROR_NOT_SUPPORTED_IN_APPCONTAINER                                       syscall.Errno = 4252
+	ERROR_INVALID_PACKAGE_SID_LENGTH                                          syscall.Errno = 4253
+	ERROR_INVALID_MEDIA                                                       syscall.Errno = 4300
+	ERROR_INVALID_LIBRARY                                                     syscall.Errno = 4301
+	ERROR_INVALID_MEDIA_POOL                                                  syscall.Errno = 4302
+	ERROR_DRIVE_MEDIA_MISMATCH                                                syscall.Errno = 4303
+	ERROR_MEDIA_OFFLINE                                                       syscall.Errno = 4304
+	ERROR_LIBRARY_OFFLINE                                                     syscall.Errno = 4305
+	ERROR_EMPTY                                                               syscall.Errno = 4306
+	ERROR_NOT_EMPTY                                                           syscall.Errno = 4307
+	ERROR_MEDIA_UNAVAILABLE                                                   syscall.Errno = 4308
+	ERROR_RESOURCE_DISABLED                                                   syscall.Errno = 4309
+	ERROR_INVALID_CLEANER                                                     syscall.Errno = 4310
+	ERROR_UNABLE_TO_CLEAN                                                     syscall.Errno = 4311
+	ERROR_OBJECT_NOT_FOUND                                                    syscall.Errno = 4312
+	ERROR_DATABASE_FAILURE                                                    syscall.Errno = 4313
+	ERROR_DATABASE_FULL                                                       syscall.Errno = 4314
+	ERROR_MEDIA_INCOMPATIBLE                                                  syscall.Errno = 4315
+	ERROR_RESOURCE_NOT_PRESENT                                                syscall.Errno = 4316
+	ERROR_INVALID_OPERATION                                                   syscall.Errno = 4317
+	ERROR_MEDIA_NOT_AVAILABLE                                                 syscall.Errno = 4318
+	ERROR_DEVICE_NOT_AVAILABLE                                                syscall.Errno = 4319
+	ERROR_REQUEST_REFUSED                                                     syscall.Errno = 4320
+	ERROR_INVALID_DRIVE_OBJECT                                                syscall.Errno = 4321
+	ERROR_LIBRARY_FULL                                                        syscall.Errno = 4322
+	ERROR_MEDIUM_NOT_ACCESSIBLE                                               syscall.Errno = 4323
+	ERROR_UNABLE_TO_LOAD_MEDIUM                                               syscall.Errno = 4324
+	ERROR_UNABLE_TO_INVENTORY_DRIVE                                           syscall.Errno = 4325
+	ERROR_UNABLE_TO_INVENTORY_SLOT                                            syscall.Errno = 4326
+	ERROR_UNABLE_TO_INVENTORY_TRANSPORT                                       syscall.Errno = 4327
+	ERROR_TRANSPORT_FULL                                                      syscall.Errno = 4328
+	ERROR_CONTROLLING_IEPORT                                                  syscall.Errno = 4329
+	ERROR_UNABLE_TO_EJECT_MOUNTED_MEDIA                                       syscall.Errno = 4330
+	ERROR_CLEANER_SLOT_SET                                                    syscall.Errno = 4331
+	ERROR_CLEANER_SLOT_NOT_SET                                                syscall.Errno = 4332
+	ERROR_CLEANER_CARTRIDGE_SPENT                                             syscall.Errno = 4333
+	ERROR_UNEXPECTED_OMID                                                     syscall.Errno = 4334
+	ERROR_CANT_DELETE_LAST_ITEM                                               syscall.Errno = 4335
+	ERROR_MESSAGE_EXCEEDS_MAX_SIZE                                            syscall.Errno = 4336
+	ERROR_VOLUME_CONTAINS_SYS_FILES                                           syscall.Errno = 4337
+	ERROR_INDIGENOUS_TYPE                                                     syscall.Errno = 4338
+	ERROR_NO_SUPPORTING_DRIVES                                                syscall.Errno = 4339
+	ERROR_CLEANER_CARTRIDGE_INSTALLED                                         syscall.Errno = 4340
+	ERROR_IEPORT_FULL                                                         syscall.Errno = 4341
+	ERROR_FILE_OFFLINE                                                        syscall.Errno = 4350
+	ERROR_REMOTE_STORAGE_NOT_ACTIVE                                           syscall.Errno = 4351
+	ERROR_REMOTE_STORAGE_MEDIA_ERROR                                          syscall.Errno = 4352
+	ERROR_NOT_A_REPARSE_POINT                                                 syscall.Errno = 4390
+	ERROR_REPARSE_ATTRIBUTE_CONFLICT                                          syscall.Errno = 4391
+	ERROR_INVALID_REPARSE_DATA                                                syscall.Errno = 4392
+	ERROR_REPARSE_TAG_INVALID                                                 syscall.Errno = 4393
+	ERROR_REPARSE_TAG_MISMATCH                                                syscall.Errno = 4394
+	ERROR_REPARSE_POINT_ENCOUNTERED                                           syscall.Errno = 4395
+	ERROR_APP_DATA_NOT_FOUND                                                  syscall.Errno = 4400
+	ERROR_APP_DATA_EXPIRED                                                    syscall.Errno = 4401
+	ERROR_APP_DATA_CORRUPT                                                    syscall.Errno = 4402
+	ERROR_APP_DATA_LIMIT_EXCEEDED                                             syscall.Errno = 4403
+	ERROR_APP_DATA_REBOOT_REQUIRED                                            syscall.Errno = 4404
+	ERROR_SECUREBOOT_ROLLBACK_DETECTED                                        syscall.Errno = 4420
+	ERROR_SECUREBOOT_POLICY_VIOLATION                                         syscall.Errno = 4421
+	ERROR_SECUREBOOT_INVALID_POLICY                                           syscall.Errno = 4422
+	ERROR_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND                               syscall.Errno = 4423
+	ERROR_SECUREBOOT_POLICY_NOT_SIGNED                                        syscall.Errno = 4424
+	ERROR_SECUREBOOT_NOT_ENABLED                                              syscall.Errno = 4425
+	ERROR_SECUREBOOT_FILE_REPLACED                                            syscall.Errno = 4426
+	ERROR_SECUREBOOT_POLICY_NOT_AUTHORIZED                                    syscall.Errno = 4427
+	ERROR_SECUREBOOT_POLICY_UNKNOWN                                           syscall.Errno = 4428
+	ERROR_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION                       syscall.Errno = 4429
+	ERROR_SECUREBOOT_PLATFORM_ID_MISMATCH                                     syscall.Errno = 4430
+	ERROR_SECUREBOOT_POLICY_ROLLBACK_DETECTED                                 syscall.Errno = 4431
+	ERROR_SECUREBOOT_POLICY_UPGRADE_MISMATCH                                  syscall.Errno = 4432
+	ERROR_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING                             syscall.Errno = 4433
+	ERROR_SECUREBOOT_NOT_BASE_POLICY                                          syscall.Errno = 4434
+	ERROR_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY                                  syscall.Errno = 4435
+	ERROR_OFFLOAD_READ_FLT_NOT_SUPPORTED                                      syscall.Errno = 4440
+	ERROR_OFFLOAD_WRITE_FLT_NOT_SUPPORTED                                     syscall.Errno = 4441
+	ERROR_OFFLOAD_READ_FILE_NOT_SUPPORTED                                     syscall.Errno = 4442
+	ERROR_OFFLOAD_WRITE_FILE_NOT_SUPPORTED                                    syscall.Errno = 4443
+	ERROR_ALREADY_HAS_STREAM_ID                                               syscall.Errno = 4444
+	ERROR_SMR_GARBAGE_COLLECTION_REQUIRED                                     syscall.Errno = 4445
+	ERROR_WOF_WIM_HEADER_CORRUPT                                              syscall.Errno = 4446
+	ERROR_WOF_WIM_RESOURCE_TABLE_CORRUPT                                      syscall.Errno = 4447
+	ERROR_WOF_FILE_RESOURCE_TABLE_CORRUPT                                     syscall.Errno = 4448
+	ERROR_VOLUME_NOT_SIS_ENABLED                                              syscall.Errno = 4500
+	ERROR_SYSTEM_INTEGRITY_ROLLBACK_DETECTED                                  syscall.Errno = 4550
+	ERROR_SYSTEM_INTEGRITY_POLICY_VIOLATION                                   syscall.Errno = 4551
+	ERROR_SYSTEM_INTEGRITY_INVALID_POLICY                                     syscall.Errno = 4552
+	ERROR_SYSTEM_INTEGRITY_POLICY_NOT_SIGNED                                  syscall.Errno = 4553
+	ERROR_SYSTEM_INTEGRITY_TOO_MANY_POLICIES                                  syscall.Errno = 4554
+	ERROR_SYSTEM_INTEGRITY_SUPPLEMENTAL_POLICY_NOT_AUTHORIZED                 syscall.Errno = 4555
+	ERROR_VSM_NOT_INITIALIZED                                                 syscall.Errno = 4560
+	ERROR_VSM_DMA_PROTECTION_NOT_IN_USE                                       syscall.Errno = 4561
+	ERROR_PLATFORM_MANIFEST_NOT_AUTHORIZED                                    syscall.Errno = 4570
+	ERROR_PLATFORM_MANIFEST_INVALID                                           syscall.Errno = 4571
+	ERROR_PLATFORM_MANIFEST_FILE_NOT_AUTHORIZED                               syscall.Errno = 4572
+	ERROR_PLATFORM_MANIFEST_CATALOG_NOT_AUTHORIZED                            syscall.Errno = 4573
+	ERROR_PLATFORM_MANIFEST_BINARY_ID_NOT_FOUND                               syscall.Errno = 4574
+	ERROR_PLATFORM_MANIFEST_NOT_ACTIVE                                        syscall.Errno = 4575
+	ERROR_PLATFORM_MANIFEST_NOT_SIGNED                                        syscall.Errno = 4576
+	ERROR_DEPENDENT_RESOURCE_EXISTS                                           syscall.Errno = 5001
+	ERROR_DEPENDENCY_NOT_FOUND                                                syscall.Errno = 5002
+	ERROR_DEPENDENCY_ALREADY_EXISTS                                           syscall.Errno = 5003
+	ERROR_RESOURCE_NOT_ONLINE                                                 syscall.Errno = 5004
+	ERROR_HOST_NODE_NOT_AVAILABLE                                             syscall.Errno = 5005
+	ERROR_RESOURCE_NOT_AVAILABLE                                              syscall.Errno = 5006
+	ERROR_RESOURCE_NOT_FOUND                                                  syscall.Errno = 5007
+	ERROR_SHUTDOWN_CLUSTER                                                    syscall.Errno = 5008
+	ERROR_CANT_EVICT_ACTIVE_NODE                                              syscall.Errno = 5009
+	ERROR_OBJECT_ALREADY_EXISTS                                               syscall.Errno = 5010
+	ERROR_OBJECT_IN_LIST                                                      syscall.Errno = 5011
+	ERROR_GROUP_NOT_AVAILABLE                                                 syscall.Errno = 5012
+	ERROR_GROUP_NOT_FOUND                                                     syscall.Errno = 5013
+	ERROR_GROUP_NOT_ONLINE                                                    syscall.Errno = 5014
+	ERROR_HOST_NODE_NOT_RESOURCE_OWNER                                        syscall.Errno = 5015
+	ERROR_HOST_NODE_NOT_GROUP_OWNER                                           syscall.Errno = 5016
+	ERROR_RESMON_CREATE_FAILED                                                syscall.Errno = 5017
+	ERROR_RESMON_ONLINE_FAILED                                                syscall.Errno = 5018
+	ERROR_RESOURCE_ONLINE                                                     syscall.Errno = 5019
+	ERROR_QUORUM_RESOURCE                                                     syscall.Errno = 5020
+	ERROR_NOT_QUORUM_CAPABLE                                                  syscall.Errno = 5021
+	ERROR_CLUSTER_SHUTTING_DOWN                                               syscall.Errno = 5022
+	ERROR_INVALID_STATE                                                       syscall.Errno = 5023
+	ERROR_RESOURCE_PROPERTIES_STORED                                          syscall.Errno = 5024
+	ERROR_NOT_QUORUM_CLASS                                                    syscall.Errno = 5025
+	ERROR_CORE_RESOURCE                                                       syscall.Errno = 5026
+	ERROR_QUORUM_RESOURCE_ONLINE_FAILED                                       syscall.Errno = 5027
+	ERROR_QUORUMLOG_OPEN_FAILED                                               syscall.Errno = 5028
+	ERROR_CLUSTERLOG_CORRUPT                                                  syscall.Errno = 5029
+	ERROR_CLUSTERLOG_RECORD_EXCEEDS_MAXSIZE                                   syscall.Errno = 5030
+	ERROR_CLUSTERLOG_EXCEEDS_MAXSIZE                                          syscall.Errno = 5031
+	ERROR_CLUSTERLOG_CHKPOINT_NOT_FOUND                                       syscall.Errno = 5032
+	ERROR_CLUSTERLOG_NOT_ENOUGH_SPACE                                         syscall.Errno = 5033
+	ERROR_QUORUM_OWNER_ALIVE                                                  syscall.Errno = 5034
+	ERROR_NETWORK_NOT_AVAILABLE                                               syscall.Errno = 5035
+	ERROR_NODE_NOT_AVAILABLE                                                  syscall.Errno = 5036
+	ERROR_ALL_NODES_NOT_AVAILABLE                                             syscall.Errno = 5037
+	ERROR_RESOURCE_FAILED                                                     syscall.Errno = 5038
+	ERROR_CLUSTER_INVALID_NODE                                                syscall.Errno = 5039
+	ERROR_CLUSTER_NODE_EXISTS                                                 syscall.Errno = 5040
+	ERROR_CLUSTER_JOIN_IN_PROGRESS                                            syscall.Errno = 5041
+	ERROR_CLUSTER_NODE_NOT_FOUND                                              syscall.Errno = 5042
+	ERROR_CLUSTER_LOCAL_NODE_NOT_FOUND                                        syscall.Errno = 5043
+	ERROR_CLUSTER_NETWORK_EXISTS                                              syscall.Errno = 5044
+	ERROR_CLUSTER_NETWORK_NOT_FOUND                                           syscall.Errno = 5045
+	ERROR_CLUSTER_NETINTERFACE_EXISTS                                         syscall.Errno = 5046
+	ERROR_CLUSTER_NETINTERFACE_NOT_FOUND                                      syscall.Errno = 5047
+	ERROR_CLUSTER_INVALID_REQUEST                                             syscall.Errno = 5048
+	ERROR_CLUSTER_INVALID_NETWORK_PROVIDER                                    syscall.Errno = 5049
+	ERROR_CLUSTER_NODE_DOWN                                                   syscall.Errno = 5050
+	ERROR_CLUSTER_NODE_UNREACHABLE                                            syscall.Errno = 5051
+	ERROR_CLUSTER_NODE_NOT_MEMBER                                             syscall.Errno = 5052
+	ERROR_CLUSTER_JOIN_NOT_IN_PROGRESS                                        syscall.Errno = 5053
+	ERROR_CLUSTER_INVALID_NETWORK                                             syscall.Errno = 5054
+	ERROR_CLUSTER_NODE_UP                                                     syscall.Errno = 5056
+	ERROR_CLUSTER_IPADDR_IN_USE                                               syscall.Errno = 5057
+	ERROR_CLUSTER_NODE_NOT_PAUSED                                             syscall.Errno = 5058
+	ERROR_CLUSTER_NO_SECURITY_CONTEXT                                         syscall.Errno = 5059
+	ERROR_CLUSTER_NETWORK_NOT_INTERNAL                                        syscall.Errno = 5060
+	ERROR_CLUSTER_NODE_ALREADY_UP                                             syscall.Errno = 5061
+	ERROR_CLUSTER_NODE_ALREADY_DOWN                                           syscall.Errno = 5062
+	ERROR_CLUSTER_NETWORK_ALREADY_ONLINE                                      syscall.Errno = 5063
+	ERROR_CLUSTER_NETWORK_ALREADY_OFFLINE                                     syscall.Errno = 5064
+	ERROR_CLUSTER_NODE_ALREADY_MEMBER                                         syscall.Errno = 5065
+	ERROR_CLUSTER_LAST_INTERNAL_NETWORK                                       syscall.Errno = 5066
+	ERROR_CLUSTER_NETWORK_HAS_DEPENDENTS                                      syscall.Errno = 5067
+	ERROR_INVALID_OPERATION_ON_QUORUM                                         syscall.Errno = 5068
+	ERROR_DEPENDENCY_NOT_ALLOWED                                              syscall.Errno = 5069
+	ERROR_CLUSTER_NODE_PAUSED                                                 syscall.Errno = 5070
+	ERROR_NODE_CANT_HOST_RESOURCE                                             syscall.Errno = 5071
+	ERROR_CLUSTER_NODE_NOT_READY                                              syscall.Errno = 5072
+	ERROR_CLUSTER_NODE_SHUTTING_DOWN                                          syscall.Errno = 5073
+	ERROR_CLUSTER_JOIN_ABORTED                                                syscall.Errno = 5074
+	ERROR_CLUSTER_INCOMPATIBLE_VERSIONS                                       syscall.Errno = 5075
+	ERROR_CLUSTER_MAXNUM_OF_RESOURCES_EXCEEDED                                syscall.Errno = 5076
+	ERROR_CLUSTER_SYSTEM_CONFIG_CHANGED                                       syscall.Errno = 5077
+	ERROR_CLUSTER_RESOURCE_TYPE_NOT_FOUND                                     syscall.Errno = 5078
+	ERROR_CLUSTER_RESTYPE_NOT_SUPPORTED                                       syscall.Errno = 5079
+	ERROR_CLUSTER_RESNAME_NOT_FOUND                                           syscall.Errno = 5080
+	ERROR_CLUSTER_NO_RPC_PACKAGES_REGISTERED                                  syscall.Errno = 5081
+	ERROR_CLUSTER_OWNER_NOT_IN_PREFLIST                                       syscall.Errno = 5082
+	ERROR_CLUSTER_DATABASE_SEQMISMATCH                                        syscall.Errno = 5083
+	ERROR_RESMON_INVALID_STATE                                                syscall.Errno = 5084
+	ERROR_CLUSTER_GUM_NOT_LOCKER                                              syscall.Errno = 5085
+	ERROR_QUORUM_DISK_NOT_FOUND                                               syscall.Errno = 5086
+	ERROR_DATABASE_BACKUP_CORRUPT                                             syscall.Errno = 5087
+	ERROR_CLUSTER_NODE_ALREADY_HAS_DFS_ROOT                                   syscall.Errno = 5088
+	ERROR_RESOURCE_PROPERTY_UNCHANGEABLE                                      syscall.Errno = 5089
+	ERROR_NO_ADMIN_ACCESS_POINT                                               syscall.Errno = 5090
+	ERROR_CLUSTER_MEMBERSHIP_INVALID_STATE                                    syscall.Errno = 5890
+	ERROR_CLUSTER_QUORUMLOG_NOT_FOUND                                         syscall.Errno = 5891
+	ERROR_CLUSTER_MEMBERSHIP_HALT                                             syscall.Errno = 5892
+	ERROR_CLUSTER_INSTANCE_ID_MISMATCH                                        syscall.Errno = 5893
+	ERROR_CLUSTER_NETWORK_NOT_FOUND_FOR_IP                                    syscall.Errno = 5894
+	ERROR_CLUSTER_PROPERTY_DATA_TYPE_MISMATCH                                 syscall.Errno = 5895
+	ERROR_CLUSTER_EVICT_WITHOUT_CLEANUP                                       syscall.Errno = 5896
+	ERROR_CLUSTER_PARAMETER_MISMATCH                                          syscall.Errno = 5897
+	ERROR_NODE_CANNOT_BE_CLUSTERED                                            syscall.Errno = 5898
+	ERROR_CLUSTER_WRONG_OS_VERSION                                            syscall.Errno = 5899
+	ERROR_CLUSTER_CANT_CREATE_DUP_CLUSTER_NAME                                syscall.Errno = 5900
+	ERROR_CLUSCFG_ALREADY_COMMITTED                                           syscall.Errno = 5901
+	ERROR_CLUSCFG_ROLLBACK_FAILED                                             syscall.Errno = 5902
+	ERROR_CLUSCFG_SYSTEM_DISK_DRIVE_LETTER_CONFLICT                           syscall.Errno = 5903
+	ERROR_CLUSTER_OLD_VERSION                                                 syscall.Errno = 5904
+	ERROR_CLUSTER_MISMATCHED_COMPUTER_ACCT_NAME                               syscall.Errno = 5905
+	ERROR_CLUSTER_NO_NET_ADAPTERS                                             syscall.Errno = 5906
+	ERROR_CLUSTER_POISONED                                                    syscall.Errno = 5907
+	ERROR_CLUSTER_GROUP_MOVING                                                syscall.Errno = 5908
+	ERROR_CLUSTER_RESOURCE_TYPE_BUSY                                          syscall.Errno = 5909
+	ERROR_RESOURCE_CALL_TIMED_OUT                                             syscall.Errno = 5910
+	ERROR_INVALID_CLUSTER_IPV6_ADDRESS                                        syscall.Errno = 5911
+	ERROR_CLUSTER_INTERNAL_INVALID_FUNCTION                                   syscall.Errno = 5912
+	ERROR_CLUSTER_PARAMETER_OUT_OF_BOUNDS                                     syscall.Errno = 5913
+	ERROR_CLUSTER_PARTIAL_SEND                                                syscall.Errno = 5914
+	ERROR_CLUSTER_REGISTRY_INVALID_FUNCTION                                   syscall.Errno = 5915
+	ERROR_CLUSTER_INVALID_STRING_TERMINATION                                  syscall.Errno = 5916
+	ERROR_CLUSTER_INVALID_STRING_FORMAT                                       syscall.Errno = 5917
+	ERROR_CLUSTER_DATABASE_TRANSACTION_IN_PROGRESS                            syscall.Errno = 5918
+	ERROR_CLUSTER_DATABASE_TRANSACTION_NOT_IN_PROGRESS                        syscall.Errno = 5919
+	ERROR_CLUSTER_NULL_DATA                                                   syscall.Errno = 5920
+	ERROR_CLUSTER_PARTIAL_READ                                                syscall.Errno = 5921
+	ERROR_CLUSTER_PARTIAL_WRITE                                               syscall.Errno = 5922
+	ERROR_CLUSTER_CANT_DESERIALIZE_DATA                                       syscall.Errno = 5923
+	ERROR_DEPENDENT_RESOURCE_PROPERTY_CONFLICT                                syscall.Errno = 5924
+	ERROR_CLUSTER_NO_QUORUM                                                   syscall.Errno = 5925
+	ERROR_CLUSTER_INVALID_IPV6_NETWORK                                        syscall.Errno = 5926
+	ERROR_CLUSTER_INVALID_IPV6_TUNNEL_NETWORK                                 syscall.Errno = 5927
+	ERROR_QUORUM_NOT_ALLOWED_IN_THIS_GROUP                                    syscall.Errno = 5928
+	ERROR_DEPENDENCY_TREE_TOO_COMPLEX                                         syscall.Errno = 5929
+	ERROR_EXCEPTION_IN_RESOURCE_CALL                                          syscall.Errno = 5930
+	ERROR_CLUSTER_RHS_FAILED_INITIALIZATION                                   syscall.Errno = 5931
+	ERROR_CLUSTER_NOT_INSTALLED                                               syscall.Errno = 5932
+	ERROR_CLUSTER_RESOURCES_MUST_BE_ONLINE_ON_THE_SAME_NODE                   syscall.Errno = 5933
+	ERROR_CLUSTER_MAX_NODES_IN_CLUSTER                                        syscall.Errno = 5934
+	ERROR_CLUSTER_TOO_MANY_NODES                                              syscall.Errno = 5935
+	ERROR_CLUSTER_OBJECT_ALREADY_USED                                         syscall.Errno = 5936
+	ERROR_NONCORE_GROUPS_FOUND                                                syscall.Errno = 5937
+	ERROR_FILE_SHARE_RESOURCE_CONFLICT                                        syscall.Errno = 5938
+	ERROR_CLUSTER_EVICT_INVALID_REQUEST                                       syscall.Errno = 5939
+	ERROR_CLUSTER_SINGLETON_RESOURCE                                          syscall.Errno = 5940
+	ERROR_CLUSTER_GROUP_SINGLETON_RESOURCE                                    syscall.Errno = 5941
+	ERROR_CLUSTER_RESOURCE_PROVIDER_FAILED                                    syscall.Errno = 5942
+	ERROR_CLUSTER_RESOURCE_CONFIGURATION_ERROR                                syscall.Errno = 5943
+	ERROR_CLUSTER_GROUP_BUSY                                                  syscall.Errno = 5944
+	ERROR_CLUSTER_NOT_SHARED_VOLUME                                           syscall.Errno = 5945
+	ERROR_CLUSTER_INVALID_SECURITY_DESCRIPTOR                                 syscall.Errno = 5946
+	ERROR_CLUSTER_SHARED_VOLUMES_IN_USE                                       syscall.Errno = 5947
+	ERROR_CLUSTER_USE_SHARED_VOLUMES_API                                      syscall.Errno = 5948
+	ERROR_CLUSTER_BACKUP_IN_PROGRESS                                          syscall.Errno = 5949
+	ERROR_NON_CSV_PATH                                                        syscall.Errno = 5950
+	ERROR_CSV_VOLUME_NOT_LOCAL                                                syscall.Errno = 5951
+	ERROR_CLUSTER_WATCHDOG_TERMINATING                                        syscall.Errno = 5952
+	ERROR_CLUSTER_RESOURCE_VETOED_MOVE_INCOMPATIBLE_NODES                     syscall.Errno = 5953
+	ERROR_CLUSTER_INVALID_NODE_WEIGHT                                         syscall.Errno = 5954
+	ERROR_CLUSTER_RESOURCE_VETOED_CALL                                        syscall.Errno = 5955
+	ERROR_RESMON_SYSTEM_RESOURCES_LACKING                                     syscall.Errno = 5956
+	ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_DESTINATION    syscall.Errno = 5957
+	ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_SOURCE         syscall.Errno = 5958
+	ERROR_CLUSTER_GROUP_QUEUED                                                syscall.Errno = 5959
+	ERROR_CLUSTER_RESOURCE_LOCKED_STATUS                                      syscall.Errno = 5960
+	ERROR_CLUSTER_SHARED_VOLUME_FAILOVER_NOT_ALLOWED                          syscall.Errno = 5961
+	ERROR_CLUSTER_NODE_DRAIN_IN_PROGRESS                                      syscall.Errno = 5962
+	ERROR_CLUSTER_DISK_NOT_CONNECTED                                          syscall.Errno = 5963
+	ERROR_DISK_NOT_CSV_CAPABLE                                                syscall.Errno = 5964
+	ERROR_RESOURCE_NOT_IN_AVAILABLE_STORAGE                                   syscall.Errno = 5965
+	ERROR_CLUSTER_SHARED_VOLUME_REDIRECTED                                    syscall.Errno = 5966
+	ERROR_CLUSTER_SHARED_VOLUME_NOT_REDIRECTED                                syscall.Errno = 5967
+	ERROR_CLUSTER_CANNOT_RETURN_PROPERTIES                                    syscall.Errno = 5968
+	ERROR_CLUSTER_RESOURCE_CONTAINS_UNSUPPORTED_DIFF_AREA_FOR_SHARED_VOLUMES  syscall.Errno = 5969
+	ERROR_CLUSTER_RESOURCE_IS_IN_MAINTENANCE_MODE                             syscall.Errno = 5970
+	ERROR_CLUSTER_AFFINITY_CONFLICT                                           syscall.Errno = 5971
+	ERROR_CLUSTER_RESOURCE_IS_REPLICA_VIRTUAL_MACHINE                         syscall.Errno = 5972
+	ERROR_CLUSTER_UPGRADE_INCOMPATIBLE_VERSIONS                               syscall.Errno = 5973
+	ERROR_CLUSTER_UPGRADE_FIX_QUORUM_NOT_SUPPORTED                            syscall.Errno = 5974
+	ERROR_CLUSTER_UPGRADE_RESTART_REQUIRED                                    syscall.Errno = 5975
+	ERROR_CLUSTER_UPGRADE_IN_PROGRESS                                         syscall.Errno = 5976
+	ERROR_CLUSTER_UPGRADE_INCOMPLETE                                          syscall.Errno = 5977
+	ERROR_CLUSTER_NODE_IN_GRACE_PERIOD                                        syscall.Errno = 5978
+	ERROR_CLUSTER_CSV_IO_PAUSE_TIMEOUT                                        syscall.Errno = 5979
+	ERROR_NODE_NOT_ACTIVE_CLUSTER_MEMBER                                      syscall.Errno = 5980
+	ERROR_CLUSTER_RESOURCE_NOT_MONITORED                                      syscall.Errno = 5981
+	ERROR_CLUSTER_RESOURCE_DOES_NOT_SUPPORT_UNMONITORED                       syscall.Errno = 5982
+	ERROR_CLUSTER_RESOURCE_IS_REPLICATED                                      syscall.Errno = 5983
+	ERROR_CLUSTER_NODE_ISOLATED                                               syscall.Errno = 5984
+	ERROR_CLUSTER_NODE_QUARANTINED                                            syscall.Errno = 5985
+	ERROR_CLUSTER_DATABASE_UPDATE_CONDITION_FAILED                            syscall.Errno = 5986
+	ERROR_CLUSTER_SPACE_DEGRADED                                              syscall.Errno = 5987
+	ERROR_CLUSTER_TOKEN_DELEGATION_NOT_SUPPORTED                              syscall.Errno = 5988
+	ERROR_CLUSTER_CSV_INVALID_HANDLE                                          syscall.Errno = 5989
+	ERROR_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR                           syscall.Errno = 5990
+	ERROR_GROUPSET_NOT_AVAILABLE                                              syscall.Errno = 5991
+	ERROR_GROUPSET_NOT_FOUND                                                  syscall.Errno = 5992
+	ERROR_GROUPSET_CANT_PROVIDE                                               syscall.Errno = 5993
+	ERROR_CLUSTER_FAULT_DOMAIN_PARENT_NOT_FOUND                               syscall.Errno = 5994
+	ERROR_CLUSTER_FAULT_DOMAIN_INVALID_HIERARCHY                              syscall.Errno = 5995
+	ERROR_CLUSTER_FAULT_DOMAIN_FAILED_S2D_VALIDATION                          syscall.Errno = 5996
+	ERROR_CLUSTER_FAULT_DOMAIN_S2D_CONNECTIVITY_LOSS                          syscall.Errno = 5997
+	ERROR_CLUSTER_INVALID_INFRASTRUCTURE_FILESERVER_NAME                      syscall.Errno = 5998
+	ERROR_CLUSTERSET_MANAGEMENT_CLUSTER_UNREACHABLE                           syscall.Errno = 5999
+	ERROR_ENCRYPTION_FAILED                                                   syscall.Errno = 6000
+	ERROR_DECRYPTION_FAILED                                                   syscall.Errno = 6001
+	ERROR_FILE_ENCRYPTED                                                      syscall.Errno = 6002
+	ERROR_NO_RECOVERY_POLICY                                                  syscall.Errno = 6003
+	ERROR_NO_EFS                                                              syscall.Errno = 6004
+	ERROR_WRONG_EFS                                                           syscall.Errno = 6005
+	ERROR_NO_USER_KEYS                                                        syscall.Errno = 6006
+	ERROR_FILE_NOT_ENCRYPTED                                                  syscall.Errno = 6007
+	ERROR_NOT_EXPORT_FORMAT                                                   syscall.Errno = 6008
+	ERROR_FILE_READ_ONLY                                                      syscall.Errno = 6009
+	ERROR_DIR_EFS_DISALLOWED                                                  syscall.Errno = 6010
+	ERROR_EFS_SERVER_NOT_TRUSTED                                              syscall.Errno = 6011
+	ERROR_BAD_RECOVERY_POLICY                                                 syscall.Errno = 6012
+	ERROR_EFS_ALG_BLOB_TOO_BIG                                                syscall.Errno = 6013
+	ERROR_VOLUME_NOT_SUPPORT_EFS                                              syscall.Errno = 6014
+	ERROR_EFS_DISABLED                                                        syscall.Errno = 6015
+	ERROR_EFS_VERSION_NOT_SUPPORT                                             syscall.Errno = 6016
+	ERROR_CS_ENCRYPTION_INVALID_SERVER_RESPONSE                               syscall.Errno = 6017
+	ERROR_CS_ENCRYPTION_UNSUPPORTED_SERVER                                    syscall.Errno = 6018
+	ERROR_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE                               syscall.Errno = 6019
+	ERROR_CS_ENCRYPTION_NEW_ENCRYPTED_FILE                                    syscall.Errno = 6020
+	ERROR_CS_ENCRYPTION_FILE_NOT_CSE                                          syscall.Errno = 6021
+	ERROR_ENCRYPTION_POLICY_DENIES_OPERATION                                  syscall.Errno = 6022
+	ERROR_WIP_ENCRYPTION_FAILED                                               syscall.Errno = 6023
+	ERROR_NO_BROWSER_SERVERS_FOUND                                            syscall.Errno = 6118
+	ERROR_LOG_SECTOR_INVALID                                                  syscall.Errno = 6600
+	ERROR_LOG_SECTOR_PARITY_INVALID                                           syscall.Errno = 6601
+	ERROR_LOG_SECTOR_REMAPPED                                                 syscall.Errno = 6602
+	ERROR_LOG_BLOCK_INCOMPLETE                                                syscall.Errno = 6603
+	ERROR_LOG_INVALID_RANGE                                                   syscall.Errno = 6604
+	ERROR_LOG_BLOCKS_EXHAUSTED                                                syscall.Errno = 6605
+	ERROR_LOG_READ_CONTEXT_INVALID                                            syscall.Errno = 6606
+	ERROR_LOG_RESTART_INVALID                                                 syscall.Errno = 6607
+	ERROR_LOG_BLOCK_VERSION                                                   syscall.Errno = 6608
+	ERROR_LOG_BLOCK_INVALID                                                   syscall.Errno = 6609
+	ERROR_LOG_READ_MODE_INVALID                                               syscall.Errno = 6610
+	ERROR_LOG_NO_RESTART                                                      syscall.Errno = 6611
+	ERROR_LOG_METADATA_CORRUPT                                                syscall.Errno = 6612
+	ERROR_LOG_METADATA_INVALID                                                syscall.Errno = 6613
+	ERROR_LOG_METADATA_INCONSISTENT                                           syscall.Errno = 6614
+	ERROR_LOG_RESERVATION_INVALID                                             syscall.Errno = 6615
+	ERROR_LOG_CANT_DELETE                                                     syscall.Errno = 6616
+	ERROR_LOG_CONTAINER_LIMIT_EXCEEDED                                        syscall.Errno = 6617
+	ERROR_LOG_START_OF_LOG                                                    syscall.Errno = 6618
+	ERROR_LOG_POLICY_ALREADY_INSTALLED                                        syscall.Errno = 6619
+	ERROR_LOG_POLICY_NOT_INSTALLED                                            syscall.Errno = 6620
+	ERROR_LOG_POLICY_INVALID                                                  syscall.Errno = 6621
+	ERROR_LOG_POLICY_CONFLICT                                                 syscall.Errno = 6622
+	ERROR_LOG_PINNED_ARCHIVE_TAIL                                             syscall.Errno = 6623
+	ERROR_LOG_RECORD_NONEXISTENT                                              syscall.Errno = 6624
+	ERROR_LOG_RECORDS_RESERVED_INVALID                                        syscall.Errno = 6625
+	ERROR_LOG_SPACE_RESERVED_INVALID                                          syscall.Errno = 6626
+	ERROR_LOG_TAIL_INVALID                                                    syscall.Errno = 6627
+	ERROR_LOG_FULL                                                            syscall.Errno = 6628
+	ERROR_COULD_NOT_RESIZE_LOG                                                syscall.Errno = 6629
+	ERROR_LOG_MULTIPLEXED                                                     syscall.Errno = 6630
+	ERROR_LOG_DEDICATED                                                       syscall.Errno = 6631
+	ERROR_LOG_ARCHIVE_NOT_IN_PROGRESS                                         syscall.Errno = 6632
+	ERROR_LOG_ARCHIVE_IN_PROGRESS                                             syscall.Errno = 6633
+	ERROR_LOG_EPHEMERAL                                                       syscall.Errno = 6634
+	ERROR_LOG_NOT_ENOUGH_CONTAINERS                                           syscall.Errno = 6635
+	ERROR_LOG_CLIENT_ALREADY_REGISTERED                                       syscall.Errno = 6636
+	ERROR_LOG_CLIENT_NOT_REGISTERED                                           syscall.Errno = 6637
+	ERROR_LOG_FULL_HANDLER_IN_PROGRESS                                        syscall.Errno = 6638
+	ERROR_LOG_CONTAINER_READ_FAILED                                           syscall.Errno = 6639
+	ERROR_LOG_CONTAINER_WRITE_FAILED                                          syscall.Errno = 6640
+	ERROR_LOG_CONTAINER_OPEN_FAILED                                           syscall.Errno = 6641
+	ERROR_LOG_CONTAINER_STATE_INVALID                                         syscall.Errno = 6642
+	ERROR_LOG_STATE_INVALID                                                   syscall.Errno = 6643
+	ERROR_LOG_PINNED                                                          syscall.Errno = 6644
+	ERROR_LOG_METADATA_FLUSH_FAILED                                           syscall.Errno = 6645
+	ERROR_LOG_INCONSISTENT_SECURITY                                           syscall.Errno = 6646
+	ERROR_LOG_APPENDED_FLUSH_FAILED                                           syscall.Errno = 6647
+	ERROR_LOG_PINNED_RESERVATION                                              syscall.Errno = 6648
+	ERROR_INVALID_TRANSACTION                                                 syscall.Errno = 6700
+	ERROR_TRANSACTION_NOT_ACTIVE                                              syscall.Errno = 6701
+	ERROR_TRANSACTION_REQUEST_NOT_VALID                                       syscall.Errno = 6702
+	ERROR_TRANSACTION_NOT_REQUESTED                                           syscall.Errno = 6703
+	ERROR_TRANSACTION_ALREADY_ABORTED                                         syscall.Errno = 6704
+	ERROR_TRANSACTION_ALREADY_COMMITTED                                       syscall.Errno = 6705
+	ERROR_TM_INITIALIZATION_FAILED                                            syscall.Errno = 6706
+	ERROR_RESOURCEMANAGER_READ_ONLY                                           syscall.Errno = 6707
+	ERROR_TRANSACTION_NOT_JOINED                                              syscall.Errno = 6708
+	ERROR_TRANSACTION_SUPERIOR_EXISTS                                         syscall.Errno = 6709
+	ERROR_CRM_PROTOCOL_ALREADY_EXISTS                                         syscall.Errno = 6710
+	ERROR_TRANSACTION_PROPAGATION_FAILED                                      syscall.Errno = 6711
+	ERROR_CRM_PROTOCOL_NOT_FOUND                                              syscall.Errno = 6712
+	ERROR_TRANSACTION_INVALID_MARSHALL_BUFFER                                 syscall.Errno = 6713
+	ERROR_CURRENT_TRANSACTION_NOT_VALID                                       syscall.Errno = 6714
+	ERROR_TRANSACTION_NOT_FOUND                                               syscall.Errno = 6715
+	ERROR_RESOURCEMANAGER_NOT_FOUND                                           syscall.Errno = 6716
+	ERROR_ENLISTMENT_NOT_FOUND                                                syscall.Errno = 6717
+	ERROR_TRANSACTIONMANAGER_NOT_FOUND                                        syscall.Errno = 6718
+	ERROR_TRANSACTIONMANAGER_NOT_ONLINE                                       syscall.Errno = 6719
+	ERROR_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION                          syscall.Errno = 6720
+	ERROR_TRANSACTION_NOT_ROOT                                                syscall.Errno = 6721
+	ERROR_TRANSACTION_OBJECT_EXPIRED                                          syscall.Errno = 6722
+	ERROR_TRANSACTION_RESPONSE_NOT_ENLISTED                                   syscall.Errno = 6723
+	ERROR_TRANSACTION_RECORD_TOO_LONG                                         syscall.Errno = 6724
+	ERROR_IMPLICIT_TRANSACTION_NOT_SUPPORTED                                  syscall.Errno = 6725
+	ERROR_TRANSACTION_INTEGRITY_VIOLATED                                      syscall.Errno = 6726
+	ERROR_TRANSACTIONMANAGER_IDENTITY_MISMATCH                                syscall.Errno = 6727
+	ERROR_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT                                    syscall.Errno = 6728
+	ERROR_TRANSACTION_MUST_WRITETHROUGH                                       syscall.Errno = 6729
+	ERROR_TRANSACTION_NO_SUPERIOR                                             syscall.Errno = 6730
+	ERROR_HEURISTIC_DAMAGE_POSSIBLE                                           syscall.Errno = 6731
+	ERROR_TRANSACTIONAL_CONFLICT                                              syscall.Errno = 6800
+	ERROR_RM_NOT_ACTIVE                                                       syscall.Errno = 6801
+	ERROR_RM_METADATA_CORRUPT                                                 syscall.Errno = 6802
+	ERROR_DIRECTORY_NOT_RM                                                    syscall.Errno = 6803
+	ERROR_TRANSACTIONS_UNSUPPORTED_REMOTE                                     syscall.Errno = 6805
+	ERROR_LOG_RESIZE_INVALID_SIZE                                             syscall.Errno = 6806
+	ERROR_OBJECT_NO_LONGER_EXISTS                                             syscall.Errno = 6807
+	ERROR_STREAM_MINIVERSION_NOT_FOUND                                        syscall.Errno = 6808
+	ERROR_STREAM_MINIVERSION_NOT_VALID                                        syscall.Errno = 6809
+	ERROR_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION                 syscall.Errno = 6810
+	ERROR_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT                            syscall.Errno = 6811
+	ERROR_CANT_CREATE_MORE_STREAM_MINIVERSIONS                                syscall.Errno = 6812
+	ERROR_REMOTE_FILE_VERSION_MISMATCH                                        syscall.Errno = 6814
+	ERROR_HANDLE_NO_LONGER_VALID                                              syscall.Errno = 6815
+	ERROR_NO_TXF_METADATA                                                     syscall.Errno = 6816
+	ERROR_LOG_CORRUPTION_DETECTED                                             syscall.Errno = 6817
+	ERROR_CANT_RECOVER_WITH_HANDLE_OPEN                                       syscall.Errno = 6818
+	ERROR_RM_DISCONNECTED                                                     syscall.Errno = 6819
+	ERROR_ENLISTMENT_NOT_SUPERIOR                                             syscall.Errno = 6820
+	ERROR_RECOVERY_NOT_NEEDED                                                 syscall.Errno = 6821
+	ERROR_RM_ALREADY_STARTED                                                  syscall.Errno = 6822
+	ERROR_FILE_IDENTITY_NOT_PERSISTENT                                        syscall.Errno = 6823
+	ERROR_CANT_BREAK_TRANSACTIONAL_DEPENDENCY                                 syscall.Errno = 6824
+	ERROR_CANT_CROSS_RM_BOUNDARY                                              syscall.Errno = 6825
+	ERROR_TXF_DIR_NOT_EMPTY                                                   syscall.Errno = 6826
+	ERROR_INDOUBT_TRANSACTIONS_EXIST                                          syscall.Errno = 6827
+	ERROR_TM_VOLATILE                                                         syscall.Errno = 6828
+	ERROR_ROLLBACK_TIMER_EXPIRED                                              syscall.Errno = 6829
+	ERROR_TXF_ATTRIBUTE_CORRUPT                                               syscall.Errno = 6830
+	ERROR_EFS_NOT_ALLOWED_IN_TRANSACTION                                      syscall.Errno = 6831
+	ERROR_TRANSACTIONAL_OPEN_NOT_ALLOWED                                      syscall.Errno = 6832
+	ERROR_LOG_GROWTH_FAILED                                                   syscall.Errno = 6833
+	ERROR_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE                               syscall.Errno = 6834
+	ERROR_TXF_METADATA_ALREADY_PRESENT                                        syscall.Errno = 6835
+	ERROR_TRANSACTION_SCOPE_CALLBACKS_NOT_SET                                 syscall.Errno = 6836
+	ERROR_TRANSACTION_REQUIRED_PROMOTION                                      syscall.Errno = 6837
+	ERROR_CANNOT_EXECUTE_FILE_IN_TRANSACTION                                  syscall.Errno = 6838
+	ERROR_TRANSACTIONS_NOT_FROZEN                                             syscall.Errno = 6839
+	ERROR_TRANSACTION_FREEZE_IN_PROGRESS                                      syscall.Errno = 6840
+	ERROR_NOT_SNAPSHOT_VOLUME                                                 syscall.Errno = 6841
+	ERROR_NO_SAVEPOINT_WITH_OPEN_FILES                                        syscall.Errno = 6842
+	ERROR_DATA_LOST_REPAIR                                                    syscall.Errno = 6843
+	ERROR_SPARSE_NOT_ALLOWED_IN_TRANSACTION                                   syscall.Errno = 6844
+	ERROR_TM_IDENTITY_MISMATCH                                                syscall.Errno = 6845
+	ERROR_FLOATED_SECTION                                                     syscall.Errno = 6846
+	ERROR_CANNOT_ACCEPT_TRANSACTED_WORK                                       syscall.Errno = 6847
+	ERROR_CANNOT_ABORT_TRANSACTIONS                                           syscall.Errno = 6848
+	ERROR_BAD_CLUSTERS                                                        syscall.Errno = 6849
+	ERROR_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION                              syscall.Errno = 6850
+	ERROR_VOLUME_DIRTY                                                        syscall.Errno = 6851
+	ERROR_NO_LINK_TRACKING_IN_TRANSACTION                                     syscall.Errno = 6852
+	ERROR_OPERATION_NOT_SUPPORTED_IN_TRANSACTION                              syscall.Errno = 6853
+	ERROR_EXPIRED_HANDLE                                                      syscall.Errno = 6854
+	ERROR_TRANSACTION_NOT_ENLISTED                                            syscall.Errno = 6855
+	ERROR_CTX_WINSTATION_NAME_INVALID                                         syscall.Errno = 7001
+	ERROR_CTX_INVALID_PD                                                      syscall.Errno = 7002
+	ERROR_CTX_PD_NOT_FOUND                                                    syscall.Errno = 7003
+	ERROR_CTX_WD_NOT_FOUND                                                    syscall.Errno = 7004
+	ERROR_CTX_CANNOT_MAKE_EVENTLOG_ENTRY                                      syscall.Errno = 7005
+	ERROR_CTX_SERVICE_NAME_COLLISION                                          syscall.Errno = 7006
+	ERROR_CTX_CLOSE_PENDING                                                   syscall.Errno = 7007
+	ERROR_CTX_NO_OUTBUF                                                       syscall.Errno = 7008
+	ERROR_CTX_MODEM_INF_NOT_FOUND                                             syscall.Errno = 7009
+	ERROR_CTX_INVALID_MODEMNAME                                               syscall.Errno = 7010
+	ERROR_CTX_MODEM_RESPONSE_ERROR                                            syscall.Errno = 7011
+	ERROR_CTX_MODEM_RESPONSE_TIMEOUT                                          syscall.Errno = 7012
+	ERROR_CTX_MODEM_RESPONSE_NO_CARRIER                                       syscall.Errno = 7013
+	ERROR_CTX_MODEM_RESPONSE_NO_DIALTONE                                      syscall.Errno = 7014
+	ERROR_CTX_MODEM_RESPONSE_BUSY                                             syscall.Errno = 7015
+	ERROR_CTX_MODEM_RESPONSE_VOICE                                            syscall.Errno = 7016
+	ERROR_CTX_TD_ERROR                                                        syscall.Errno = 7017
+	ERROR_CTX_WINSTATION_NOT_FOUND                                            syscall.Errno = 7022
+	ERROR_CTX_WINSTATION_ALREADY_EXISTS                                       syscall.Errno = 7023
+	ERROR_CTX_WINSTATION_BUSY                                                 syscall.Errno = 7024
+	ERROR_CTX_BAD_VIDEO_MODE                                                  syscall.Errno = 7025
+	ERROR_CTX_GRAPHICS_INVALID                                                syscall.Errno = 7035
+	ERROR_CTX_LOGON_DISABLED                                                  syscall.Errno = 7037
+	ERROR_CTX_NOT_CONSOLE                                                     syscall.Errno = 7038
+	ERROR_CTX_CLIENT_QUERY_TIMEOUT                                            syscall.Errno = 7040
+	ERROR_CTX_CONSOLE_DISCONNECT                                              syscall.Errno = 7041
+	ERROR_CTX_CONSOLE_CONNECT                                                 syscall.Errno = 7042
+	ERROR_CTX_SHADOW_DENIED                                                   syscall.Errno = 7044
+	ERROR_CTX_WINSTATION_ACCESS_DENIED                                        syscall.Errno = 7045
+	ERROR_CTX_INVALID_WD                                                      syscall.Errno = 7049
+	ERROR_CTX_SHADOW_INVALID                                                  syscall.Errno = 7050
+	ERROR_CTX_SHADOW_DISABLED                                                 syscall.Errno = 7051
+	ERROR_CTX_CLIENT_LICENSE_IN_USE                                           syscall.Errno = 7052
+	ERROR_CTX_CLIENT_LICENSE_NOT_SET                                          syscall.Errno = 7053
+	ERROR_CTX_LICENSE_NOT_AVAILABLE                                           syscall.Errno = 7054
+	ERROR_CTX_LICENSE_CLIENT_INVALID                                          syscall.Errno = 7055
+	ERROR_CTX_LICENSE_EXPIRED                                                 syscall.Errno = 7056
+	ERROR_CTX_SHADOW_NOT_RUNNING                                              syscall.Errno = 7057
+	ERROR_CTX_SHADOW_ENDED_BY_MODE_CHANGE                                     syscall.Errno = 7058
+	ERROR_ACTIVATION_COUNT_EXCEEDED                                           syscall.Errno = 7059
+	ERROR_CTX_WINSTATIONS_DISABLED                                            syscall.Errno = 7060
+	ERROR_CTX_ENCRYPTION_LEVEL_REQUIRED                                       syscall.Errno = 7061
+	ERROR_CTX_SESSION_IN_USE                                                  syscall.Errno = 7062
+	ERROR_CTX_NO_FORCE_LOGOFF                                                 syscall.Errno = 7063
+	ERROR_CTX_ACCOUNT_RESTRICTION                                             syscall.Errno = 7064
+	ERROR_RDP_PROTOCOL_ERROR                                                  syscall.Errno = 7065
+	ERROR_CTX_CDM_CONNECT                                                     syscall.Errno = 7066
+	ERROR_CTX_CDM_DISCONNECT                                                  syscall.Errno = 7067
+	ERROR_CTX_SECURITY_LAYER_ERROR                                            syscall.Errno = 7068
+	ERROR_TS_INCOMPATIBLE_SESSIONS                                            syscall.Errno = 7069
+	ERROR_TS_VIDEO_SUBSYSTEM_ERROR                                            syscall.Errno = 7070
+	ERROR_DS_NOT_INSTALLED                                                    syscall.Errno = 8200
+	ERROR_DS_MEMBERSHIP_EVALUATED_LOCALLY                                     syscall.Errno = 8201
+	ERROR_DS_NO_ATTRIBUTE_OR_VALUE                                            syscall.Errno = 8202
+	ERROR_DS_INVALID_ATTRIBUTE_SYNTAX                                         syscall.Errno = 8203
+	ERROR_DS_ATTRIBUTE_TYPE_UNDEFINED                                         syscall.Errno = 8204
+	ERROR_DS_ATTRIBUTE_OR_VALUE_EXISTS                                        syscall.Errno = 8205
+	ERROR_DS_BUSY                                                             syscall.Errno = 8206
+	ERROR_DS_UNAVAILABLE                                                      syscall.Errno = 8207
+	ERROR_DS_NO_RIDS_ALLOCATED                                                syscall.Errno = 8208
+	ERROR_DS_NO_MORE_RIDS                                                     syscall.Errno = 8209
+	ERROR_DS_INCORRECT_ROLE_OWNER                                             syscall.Errno = 8210
+	ERROR_DS_RIDMGR_INIT_ERROR                                                syscall.Errno = 8211
+	ERROR_DS_OBJ_CLASS_VIOLATION                                              syscall.Errno = 8212
+	ERROR_DS_CANT_ON_NON_LEAF                                                 syscall.Errno = 8213
+	ERROR_DS_CANT_ON_RDN                                                      syscall.Errno = 8214
+	ERROR_DS_CANT_MOD_OBJ_CLASS                                               syscall.Errno = 8215
+	ERROR_DS_CROSS_DOM_MOVE_ERROR                                             syscall.Errno = 8216
+	ERROR_DS_GC_NOT_AVAILABLE                                                 syscall.Errno = 8217
+	ERROR_SHARED_POLICY                                                       syscall.Errno = 8218
+	ERROR_POLICY_OBJECT_NOT_FOUND                                             syscall.Errno = 8219
+	ERROR_POLICY_ONLY_IN_DS                                                   syscall.Errno = 8220
+	ERROR_PROMOTION_ACTIVE                                                    syscall.Errno = 8221
+	ERROR_NO_PROMOTION_ACTIVE                                                 syscall.Errno = 8222
+	ERROR_DS_OPERATIONS_ERROR                                                 syscall.Errno = 8224
+	ERROR_DS_PROTOCOL_ERROR                                                   syscall.Errno = 8225
+	ERROR_DS_TIMELIMIT_EXCEEDED                                               syscall.Errno = 8226
+	ERROR_DS_SIZELIMIT_EXCEEDED                                               syscall.Errno = 8227
+	ERROR_DS_ADMIN_LIMIT_EXCEEDED                                             syscall.Errno = 8228
+	ERROR_DS_COMPARE_FALSE                                                    syscall.Errno = 8229
+	ERROR_DS_COMPARE_TRUE                                                     syscall.Errno = 8230
+	ERROR_DS_AUTH_METHOD_NOT_SUPPORTED                                        syscall.Errno = 8231
+	ERROR_DS_STRONG_AUTH_REQUIRED                                             syscall.Errno = 8232
+	ERROR_DS_INAPPROPRIATE_AUTH                                               syscall.Errno = 8233
+	ERROR_DS_AUTH_UNKNOWN                                                     syscall.Errno = 8234
+	ERROR_DS_REFERRAL                                                         syscall.Errno = 8235
+	ERROR_DS_UNAVAILABLE_CRIT_EXTENSION                                       syscall.Errno = 8236
+	ERROR_DS_CONFIDENTIALITY_REQUIRED                                         syscall.Errno = 8237
+	ERROR_DS_INAPPROPRIATE_MATCHING                                           syscall.Errno = 8238
+	ERROR_DS_CONSTRAINT_VIOLATION                                             syscall.Errno = 8239
+	ERROR_DS_NO_SUCH_OBJECT                                                   syscall.Errno = 8240
+	ERROR_DS_ALIAS_PROBLEM                                                    syscall.Errno = 8241
+	ERROR_DS_INVALID_DN_SYNTAX                                                syscall.Errno = 8242
+	ERROR_DS_IS_LEAF                                                          syscall.Errno = 8243
+	ERROR_DS_ALIAS_DEREF_PROBLEM                                              syscall.Errno = 8244
+	ERROR_DS_UNWILLING_TO_PERFORM                                             syscall.Errno = 8245
+	ERROR_DS_LOOP_DETECT                                                      syscall.Errno = 8246
+	ERROR_DS_NAMING_VIOLATION                                                 syscall.Errno = 8247
+	ERROR_DS_OBJECT_RESULTS_TOO_LARGE                                         syscall.Errno = 8248
+	ERROR_DS_AFFECTS_MULTIPLE_DSAS                                            syscall.Errno = 8249
+	ERROR_DS_SERVER_DOWN                                                      syscall.Errno = 8250
+	ERROR_DS_LOCAL_ERROR                                                      syscall.Errno = 8251
+	ERROR_DS_ENCODING_ERROR                                                   syscall.Errno = 8252
+	ERROR_DS_DECODING_ERROR                                                   syscall.Errno = 8253
+	ERROR_DS_FILTER_UNKNOWN                                                   syscall.Errno = 8254
+	ERROR_DS_PARAM_ERROR                                                      syscall.Errno = 8255
+	ERROR_DS_NOT_SUPPORTED                                                    syscall.Errno = 8256
+	ERROR_DS_NO_RESULTS_RETURNED                                              syscall.Errno = 8257
+	ERROR_DS_CONTROL_NOT_FOUND                                                syscall.Errno = 8258
+	ERROR_DS_CLIENT_LOOP                                                      syscall.Errno = 8259
+	ERROR_DS_REFERRAL_LIMIT_EXCEEDED                                          syscall.Errno = 8260
+	ERROR_DS_SORT_CONTROL_MISSING                                             syscall.Errno = 8261
+	ERROR_DS_OFFSET_RANGE_ERROR                                               syscall.Errno = 8262
+	ERROR_DS_RIDMGR_DISABLED                                                  syscall.Errno = 8263
+	ERROR_DS_ROOT_MUST_BE_NC                                                  syscall.Errno = 8301
+	ERROR_DS_ADD_REPLICA_INHIBITED                                            syscall.Errno = 8302
+	ERROR_DS_ATT_NOT_DEF_IN_SCHEMA                                            syscall.Errno = 8303
+	ERROR_DS_MAX_OBJ_SIZE_EXCEEDED                                            syscall.Errno = 8304
+	ERROR_DS_OBJ_STRING_NAME_EXISTS                                           syscall.Errno = 8305
+	ERROR_DS_NO_RDN_DEFINED_IN_SCHEMA                                         syscall.Errno = 8306
+	ERROR_DS_RDN_DOESNT_MATCH_SCHEMA                                          syscall.Errno = 8307
+	ERROR_DS_NO_REQUESTED_ATTS_FOUND                                          syscall.Errno = 8308
+	ERROR_DS_USER_BUFFER_TO_SMALL                                             syscall.Errno = 8309
+	ERROR_DS_ATT_IS_NOT_ON_OBJ                                                syscall.Errno = 8310
+	ERROR_DS_ILLEGAL_MOD_OPERATION                                            syscall.Errno = 8311
+	ERROR_DS_OBJ_TOO_LARGE                                                    syscall.Errno = 8312
+	ERROR_DS_BAD_INSTANCE_TYPE                                                syscall.Errno = 8313
+	ERROR_DS_MASTERDSA_REQUIRED                                               syscall.Errno = 8314
+	ERROR_DS_OBJECT_CLASS_REQUIRED                                            syscall.Errno = 8315
+	ERROR_DS_MISSING_REQUIRED_ATT                                             syscall.Errno = 8316
+	ERROR_DS_ATT_NOT_DEF_FOR_CLASS                                            syscall.Errno = 8317
+	ERROR_DS_ATT_ALREADY_EXISTS                                               syscall.Errno = 8318
+	ERROR_DS_CANT_ADD_ATT_VALUES                                              syscall.Errno = 8320
+	ERROR_DS_SINGLE_VALUE_CONSTRAINT                                          syscall.Errno = 8321
+	ERROR_DS_RANGE_CONSTRAINT                                                 syscall.Errno = 8322
+	ERROR_DS_ATT_VAL_ALREADY_EXISTS                                           syscall.Errno = 8323
+	ERROR_DS_CANT_REM_MISSING_ATT                                             syscall.Errno = 8324
+	ERROR_DS_CANT_REM_MISSING_ATT_VAL                                         syscall.Errno = 8325
+	ERROR_DS_ROOT_CANT_BE_SUBREF                                              syscall.Errno = 8326
+	ERROR_DS_NO_CHAINING                                                      syscall.Errno = 8327
+	ERROR_DS_NO_CHAINED_EVAL                                                  syscall.Errno = 8328
+	ERROR_DS_NO_PARENT_OBJECT                                                 syscall.Errno = 8329
+	ERROR_DS_PARENT_IS_AN_ALIAS                                               syscall.Errno = 8330
+	ERROR_DS_CANT_MIX_MASTER_AND_REPS                                         syscall.Errno = 8331
+	ERROR_DS_CHILDREN_EXIST                                                   syscall.Errno = 8332
+	ERROR_DS_OBJ_NOT_FOUND                                                    syscall.Errno = 8333
+	ERROR_DS_ALIASED_OBJ_MISSING                                              syscall.Errno = 8334
+	ERROR_DS_BAD_NAME_SYNTAX                                                  syscall.Errno = 8335
+	ERROR_DS_ALIAS_POINTS_TO_ALIAS                                            syscall.Errno = 8336
+	ERROR_DS_CANT_DEREF_ALIAS                                                 syscall.Errno = 8337
+	ERROR_DS_OUT_OF_SCOPE                                                     syscall.Errno = 8338
+	ERROR_DS_OBJECT_BEING_REMOVED                                             syscall.Errno = 8339
+	ERROR_DS_CANT_DELETE_DSA_OBJ                                              syscall.Errno = 8340
+	ERROR_DS_GENERIC_ERROR                                                    syscall.Errno = 8341
+	ERROR_DS_DSA_MUST_BE_INT_MASTER                                           syscall.Errno = 8342
+	ERROR_DS_CLASS_NOT_DSA                                                    syscall.Errno = 8343
+	ERROR_DS_INSUFF_ACCESS_RIGHTS                                             syscall.Errno = 8344
+	ERROR_DS_ILLEGAL_SUPERIOR                                                 syscall.Errno = 8345
+	ERROR_DS_ATTRIBUTE_OWNED_BY_SAM                                           syscall.Errno = 8346
+	ERROR_DS_NAME_TOO_MANY_PARTS                                              syscall.Errno = 8347
+	ERROR_DS_NAME_TOO_LONG                                                    syscall.Errno = 8348
+	ERROR_DS_NAME_VALUE_TOO_LONG                                              syscall.Errno = 8349
+	ERROR_DS_NAME_UNPARSEABLE                                                 syscall.Errno = 8350
+	ERROR_DS_NAME_TYPE_UNKNOWN                                                syscall.Errno = 8351
+	ERROR_DS_NOT_AN_OBJECT                                                    syscall.Errno = 8352
+	ERROR_DS_SEC_DESC_TOO_SHORT                                               syscall.Errno = 8353
+	ERROR_DS_SEC_DESC_INVALID                                                 syscall.Errno = 8354
+	ERROR_DS_NO_DELETED_NAME                                                  syscall.Errno = 8355
+	ERROR_DS_SUBREF_MUST_HAVE_PARENT                                          syscall.Errno = 8356
+	ERROR_DS_NCNAME_MUST_BE_NC                                                syscall.Errno = 8357
+	ERROR_DS_CANT_ADD_SYSTEM_ONLY                                             syscall.Errno = 8358
+	ERROR_DS_CLASS_MUST_BE_CONCRETE                                           syscall.Errno = 8359
+	ERROR_DS_INVALID_DMD                                                      syscall.Errno = 8360
+	ERROR_DS_OBJ_GUID_EXISTS                                                  syscall.Errno = 8361
+	ERROR_DS_NOT_ON_BACKLINK                                                  syscall.Errno = 8362
+	ERROR_DS_NO_CROSSREF_FOR_NC                                               syscall.Errno = 8363
+	ERROR_DS_SHUTTING_DOWN                                                    syscall.Errno = 8364
+	ERROR_DS_UNKNOWN_OPERATION                                                syscall.Errno = 8365
+	ERROR_DS_INVALID_ROLE_OWNER                                               syscall.Errno = 8366
+	ERROR_DS_COULDNT_CONTACT_FSMO                                             syscall.Errno = 8367
+	ERROR_DS_CROSS_NC_DN_RENAME                                               syscall.Errno = 8368
+	ERROR_DS_CANT_MOD_SYSTEM_ONLY                                             syscall.Errno = 8369
+	ERROR_DS_REPLICATOR_ONLY                                                  syscall.Errno = 8370
+	ERROR_DS_OBJ_CLASS_NOT_DEFINED                                            syscall.Errno = 8371
+	ERROR_DS_OBJ_CLASS_NOT_SUBCLASS                                           syscall.Errno = 8372
+	ERROR_DS_NAME_REFERENCE_INVALID                                           syscall.Errno = 8373
+	ERROR_DS_CROSS_REF_EXISTS                                                 syscall.Errno = 8374
+	ERROR_DS_CANT_DEL_MASTER_CROSSREF                                         syscall.Errno = 8375
+	ERROR_DS_SUBTREE_NOTIFY_NOT_NC_HEAD                                       syscall.Errno = 8376
+	ERROR_DS_NOTIFY_FILTER_TOO_COMPLEX                                        syscall.Errno = 8377
+	ERROR_DS_DUP_RDN                                                          syscall.Errno = 8378
+	ERROR_DS_DUP_OID                                                          syscall.Errno = 8379
+	ERROR_DS_DUP_MAPI_ID                                                      syscall.Errno = 8380
+	ERROR_DS_DUP_SCHEMA_ID_GUID                                               syscall.Errno = 8381
+	ERROR_DS_DUP_LDAP_DISPLAY_NAME                                            syscall.Errno = 8382
+	ERROR_DS_SEMANTIC_ATT_TEST                                                syscall.Errno = 8383
+	ERROR_DS_SYNTAX_MISMATCH                                                  syscall.Errno = 8384
+	ERROR_DS_EXISTS_IN_MUST_HAVE                                              syscall.Errno = 8385
+	ERROR_DS_EXISTS_IN_MAY_HAVE                                               syscall.Errno = 8386
+	ERROR_DS_NONEXISTENT_MAY_HAVE                                             syscall.Errno = 8387
+	ERROR_DS_NONEXISTENT_MUST_HAVE                                            syscall.Errno = 8388
+	ERROR_DS_AUX_CLS_TEST_FAIL                                                syscall.Errno = 8389
+	ERROR_DS_NONEXISTENT_POSS_SUP                                             syscall.Errno = 8390
+	ERROR_DS_SUB_CLS_TEST_FAIL                                                syscall.Errno = 8391
+	ERROR_DS_BAD_RDN_ATT_ID_SYNTAX                                            syscall.Errno = 8392
+	ERROR_DS_EXISTS_IN_AUX_CLS                                                syscall.Errno = 8393
+	ERROR_DS_EXISTS_IN_SUB_CLS                                                syscall.Errno = 8394
+	ERROR_DS_EXISTS_IN_POSS_SUP                                               syscall.Errno = 8395
+	ERROR_DS_RECALCSCHEMA_FAILED                                              syscall.Errno = 8396
+	ERROR_DS_TREE_DELETE_NOT_FINISHED                                         syscall.Errno = 8397
+	ERROR_DS_CANT_DELETE                                                      syscall.Errno = 8398
+	ERROR_DS_ATT_SCHEMA_REQ_ID                                                syscall.Errno = 8399
+	ERROR_DS_BAD_ATT_SCHEMA_SYNTAX                                            syscall.Errno = 8400
+	ERROR_DS_CANT_CACHE_ATT                                                   syscall.Errno = 8401
+	ERROR_DS_CANT_CACHE_CLASS                                                 syscall.Errno = 8402
+	ERROR_DS_CANT_REMOVE_ATT_CACHE                                            syscall.Errno = 8403
+	ERROR_DS_CANT_REMOVE_CLASS_CACHE                                          syscall.Errno = 8404
+	ERROR_DS_CANT_RETRIEVE_DN                                                 syscall.Errno = 8405
+	ERROR_DS_MISSING_SUPREF                                                   syscall.Errno = 8406
+	ERROR_DS_CANT_RETRIEVE_INSTANCE                                           syscall.Errno = 8407
+	ERROR_DS_CODE_INCONSISTENCY                                               syscall.Errno = 8408
+	ERROR_DS_DATABASE_ERROR                                                   syscall.Errno = 8409
+	ERROR_DS_GOVERNSID_MISSING                                                syscall.Errno = 8410
+	ERROR_DS_MISSING_EXPECTED_ATT                                             syscall.Errno = 8411
+	ERROR_DS_NCNAME_MISSING_CR_REF                                            syscall.Errno = 8412
+	ERROR_DS_SECURITY_CHECKING_ERROR                                          syscall.Errno = 8413
+	ERROR_DS_SCHEMA_NOT_LOADED                                                syscall.Errno = 8414
+	ERROR_DS_SCHEMA_ALLOC_FAILED                                              syscall.Errno = 8415
+	ERROR_DS_ATT_SCHEMA_REQ_SYNTAX                                            syscall.Errno = 8416
+	ERROR_DS_GCVERIFY_ERROR                                                   syscall.Errno = 8417
+	ERROR_DS_DRA_SCHEMA_MISMATCH                                              syscall.Errno = 8418
+	ERROR_DS_CANT_FIND_DSA_OBJ                                                syscall.Errno = 8419
+	ERROR_DS_CANT_FIND_EXPECTED_NC                                            syscall.Errno = 8420
+	ERROR_DS_CANT_FIND_NC_IN_CACHE                                            syscall.Errno = 8421
+	ERROR_DS_CANT_RETRIEVE_CHILD                                              syscall.Errno = 8422
+	ERROR_DS_SECURITY_ILLEGAL_MODIFY                                          syscall.Errno = 8423
+	ERROR_DS_CANT_REPLACE_HIDDEN_REC                                          syscall.Errno = 8424
+	ERROR_DS_BAD_HIERARCHY_FILE                                               syscall.Errno = 8425
+	ERROR_DS_BUILD_HIERARCHY_TABLE_FAILED                                     syscall.Errno = 8426
+	ERROR_DS_CONFIG_PARAM_MISSING                                             syscall.Errno = 8427
+	ERROR_DS_COUNTING_AB_INDICES_FAILED                                       syscall.Errno = 8428
+	ERROR_DS_HIERARCHY_TABLE_MALLOC_FAILED                                    syscall.Errno = 8429
+	ERROR_DS_INTERNAL_FAILURE                                                 syscall.Errno = 8430
+	ERROR_DS_UNKNOWN_ERROR                                                    syscall.Errno = 8431
+	ERROR_DS_ROOT_REQUIRES_CLASS_TOP                                          syscall.Errno = 8432
+	ERROR_DS_REFUSING_FSMO_ROLES                                              syscall.Errno = 8433
+	ERROR_DS_MISSING_FSMO_SETTINGS                                            syscall.Errno = 8434
+	ERROR_DS_UNABLE_TO_SURRENDER_ROLES                                        syscall.Errno = 8435
+	ERROR_DS_DRA_GENERIC                                                      syscall.Errno = 8436
+	ERROR_DS_DRA_INVALID_PARAMETER                                            syscall.Errno = 8437
+	ERROR_DS_DRA_BUSY                                                         syscall.Errno = 8438
+	ERROR_DS_DRA_BAD_DN                                                       syscall.Errno = 8439
+	ERROR_DS_DRA_BAD_NC                                                       syscall.Errno = 8440
+	ERROR_DS_DRA_DN_EXISTS                                                    syscall.Errno = 8441
+	ERROR_DS_DRA_INTERNAL_ERROR                                               syscall.Errno = 8442
+	ERROR_DS_DRA_INCONSISTENT_DIT                                             syscall.Errno = 8443
+	ERROR_DS_DRA_CONNECTION_FAILED                                            syscall.Errno = 8444
+	ERROR_DS_DRA_BAD_INSTANCE_TYPE                                            syscall.Errno = 8445
+	ERROR_DS_DRA_OUT_OF_MEM                                                   syscall.Errno = 8446
+	ERROR_DS_DRA_MAIL_PROBLEM                                                 syscall.Errno = 8447
+	ERROR_DS_DRA_REF_ALREADY_EXISTS                                           syscall.Errno = 8448
+	ERROR_DS_DRA_REF_NOT_FOUND                                                syscall.Errno = 8449
+	ERROR_DS_DRA_OBJ_IS_REP_SOURCE                                            syscall.Errno = 8450
+	ERROR_DS_DRA_DB_ERROR                                                     syscall.Errno = 8451
+	ERROR_DS_DRA_NO_REPLICA                                                   syscall.Errno = 8452
+	ERROR_DS_DRA_ACCESS_DENIED                                                syscall.Errno = 8453
+	ERROR_DS_DRA_NOT_SUPPORTED                                                syscall.Errno = 8454
+	ERROR_DS_DRA_RPC_CANCELLED                                                syscall.Errno = 8455
+	ERROR_DS_DRA_SOURCE_DISABLED                                              syscall.Errno = 8456
+	ERROR_DS_DRA_SINK_DISABLED                                                syscall.Errno = 8457
+	ERROR_DS_DRA_NAME_COLLISION                                               syscall.Errno = 8458
+	ERROR_DS_DRA_SOURCE_REINSTALLED                                           syscall.Errno = 8459
+	ERROR_DS_DRA_MISSING_PARENT                                               syscall.Errno = 8460
+	ERROR_DS_DRA_PREEMPTED                                                    syscall.Errno = 8461
+	ERROR_DS_DRA_ABANDON_SYNC                                                 syscall.Errno = 8462
+	ERROR_DS_DRA_SHUTDOWN                                                     syscall.Errno = 8463
+	ERROR_DS_DRA_INCOMPATIBLE_PARTIAL_SET                                     syscall.Errno = 8464
+	ERROR_DS_DRA_SOURCE_IS_PARTIAL_REPLICA                                    syscall.Errno = 8465
+	ERROR_DS_DRA_EXTN_CONNECTION_FAILED                                       syscall.Errno = 8466
+	ERROR_DS_INSTALL_SCHEMA_MISMATCH                                          syscall.Errno = 8467
+	ERROR_DS_DUP_LINK_ID                                                      syscall.Errno = 8468
+	ERROR_DS_NAME_ERROR_RESOLVING                                             syscall.Errno = 8469
+	ERROR_DS_NAME_ERROR_NOT_FOUND                                             syscall.Errno = 8470
+	ERROR_DS_NAME_ERROR_NOT_UNIQUE                                            syscall.Errno = 8471
+	ERROR_DS_NAME_ERROR_NO_MAPPING                                            syscall.Errno = 8472
+	ERROR_DS_NAME_ERROR_DOMAIN_ONLY                                           syscall.Errno = 8473
+	ERROR_DS_NAME_ERROR_NO_SYNTACTICAL_MAPPING                                syscall.Errno = 8474
+	ERROR_DS_CONSTRUCTED_ATT_MOD                                              syscall.Errno = 8475
+	ERROR_DS_WRONG_OM_OBJ_CLASS                                               syscall.Errno = 8476
+	ERROR_DS_DRA_REPL_PENDING                                                 syscall.Errno = 8477
+	ERROR_DS_DS_REQUIRED                                                      syscall.Errno = 8478
+	ERROR_DS_INVALID_LDAP_DISPLAY_NAME                                        syscall.Errno = 8479
+	ERROR_DS_NON_BASE_SEARCH                                                  syscall.Errno = 8480
+	ERROR_DS_CANT_RETRIEVE_ATTS                                               syscall.Errno = 8481
+	ERROR_DS_BACKLINK_WITHOUT_LINK                                            syscall.Errno = 8482
+	ERROR_DS_EPOCH_MISMATCH                                                   syscall.Errno = 8483
+	ERROR_DS_SRC_NAME_MISMATCH                                                syscall.Errno = 8484
+	ERROR_DS_SRC_AND_DST_NC_IDENTICAL                                         syscall.Errno = 8485
+	ERROR_DS_DST_NC_MISMATCH                                                  syscall.Errno = 8486
+	ERROR_DS_NOT_AUTHORITIVE_FOR_DST_NC                                       syscall.Errno = 8487
+	ERROR_DS_SRC_GUID_MISMATCH                                                syscall.Errno = 8488
+	ERROR_DS_CANT_MOVE_DELETED_OBJECT                                         syscall.Errno = 8489
+	ERROR_DS_PDC_OPERATION_IN_PROGRESS                                        syscall.Errno = 8490
+	ERROR_DS_CROSS_DOMAIN_CLEANUP_REQD                                        syscall.Errno = 8491
+	ERROR_DS_ILLEGAL_XDOM_MOVE_OPERATION                                      syscall.Errno = 8492
+	ERROR_DS_CANT_WITH_ACCT_GROUP_MEMBERSHPS                                  syscall.Errno = 8493
+	ERROR_DS_NC_MUST_HAVE_NC_PARENT                                           syscall.Errno = 8494
+	ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE                                        syscall.Errno = 8495
+	ERROR_DS_DST_DOMAIN_NOT_NATIVE                                            syscall.Errno = 8496
+	ERROR_DS_MISSING_INFRASTRUCTURE_CONTAINER                                 syscall.Errno = 8497
+	ERROR_DS_CANT_MOVE_ACCOUNT_GROUP                                          syscall.Errno = 8498
+	ERROR_DS_CANT_MOVE_RESOURCE_GROUP                                         syscall.Errno = 8499
+	ERROR_DS_INVALID_SEARCH_FLAG                                              syscall.Errno = 8500
+	ERROR_DS_NO_TREE_DELETE_ABOVE_NC                                          syscall.Errno = 8501
+	ERROR_DS_COULDNT_LOCK_TREE_FOR_DELETE                                     syscall.Errno = 8502
+	ERROR_DS_COULDNT_IDENTIFY_OBJECTS_FOR_TREE_DELETE                         syscall.Errno = 8503
+	ERROR_DS_SAM_INIT_FAILURE                                                 syscall.Errno = 8504
+	ERROR_DS_SENSITIVE_GROUP_VIOLATION                                        syscall.Errno = 8505
+	ERROR_DS_CANT_MOD_PRIMARYGROUPID                                          syscall.Errno = 8506
+	ERROR_DS_ILLEGAL_BASE_SCHEMA_MOD                                          syscall.Errno = 8507
+	ERROR_DS_NONSAFE_SCHEMA_CHANGE                                            syscall.Errno = 8508
+	ERROR_DS_SCHEMA_UPDATE_DISALLOWED                                         syscall.Errno = 8509
+	ERROR_DS_CANT_CREATE_UNDER_SCHEMA                                         syscall.Errno = 8510
+	ERROR_DS_INSTALL_NO_SRC_SCH_VERSION                                       syscall.Errno = 8511
+	ERROR_DS_INSTALL_NO_SCH_VERSION_IN_INIFILE                                syscall.Errno = 8512
+	ERROR_DS_INVALID_GROUP_TYPE                                               syscall.Errno = 8513
+	ERROR_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN                               syscall.Errno = 8514
+	ERROR_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN                                syscall.Errno = 8515
+	ERROR_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER                                    syscall.Errno = 8516
+	ERROR_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER                                syscall.Errno = 8517
+	ERROR_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER                                 syscall.Errno = 8518
+	ERROR_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER                              syscall.Errno = 8519
+	ERROR_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER                         syscall.Errno = 8520
+	ERROR_DS_HAVE_PRIMARY_MEMBERS                                             syscall.Errno = 8521
+	ERROR_DS_STRING_SD_CONVERSION_FAILED                                      syscall.Errno = 8522
+	ERROR_DS_NAMING_MASTER_GC                                                 syscall.Errno = 8523
+	ERROR_DS_DNS_LOOKUP_FAILURE                                               syscall.Errno = 8524
+	ERROR_DS_COULDNT_UPDATE_SPNS                                              syscall.Errno = 8525
+	ERROR_DS_CANT_RETRIEVE_SD                                                 syscall.Errno = 8526
+	ERROR_DS_KEY_NOT_UNIQUE                                                   syscall.Errno = 8527
+	ERROR_DS_WRONG_LINKED_ATT_SYNTAX                                          syscall.Errno = 8528
+	ERROR_DS_SAM_NEED_BOOTKEY_PASSWORD                                        syscall.Errno = 8529
+	ERROR_DS_SAM_NEED_BOOTKEY_FLOPPY                                          syscall.Errno = 8530
+	ERROR_DS_CANT_START                                                       syscall.Errno = 8531
+	ERROR_DS_INIT_FAILURE                                                     syscall.Errno = 8532
+	ERROR_DS_NO_PKT_PRIVACY_ON_CONNECTION                                     syscall.Errno = 8533
+	ERROR_DS_SOURCE_DOMAIN_IN_FOREST                                          syscall.Errno = 8534
+	ERROR_DS_DESTINATION_DOMAIN_NOT_IN_FOREST                                 syscall.Errno = 8535
+	ERROR_DS_DESTINATION_AUDITING_NOT_ENABLED                                 syscall.Errno = 8536
+	ERROR_DS_CANT_FIND_DC_FOR_SRC_DOMAIN                                      syscall.Errno = 8537
+	ERROR_DS_SRC_OBJ_NOT_GROUP_OR_USER                                        syscall.Errno = 8538
+	ERROR_DS_SRC_SID_EXISTS_IN_FOREST                                         syscall.Errno = 8539
+	ERROR_DS_SRC_AND_DST_OBJECT_CLASS_MISMATCH                                syscall.Errno = 8540
+	ERROR_SAM_INIT_FAILURE                                                    syscall.Errno = 8541
+	ERROR_DS_DRA_SCHEMA_INFO_SHIP                                             syscall.Errno = 8542
+	ERROR_DS_DRA_SCHEMA_CONFLICT                                              syscall.Errno = 8543
+	ERROR_DS_DRA_EARLIER_SCHEMA_CONFLICT                                      syscall.Errno = 8544
+	ERROR_DS_DRA_OBJ_NC_MISMATCH                                              syscall.Errno = 8545
+	ERROR_DS_NC_STILL_HAS_DSAS                                                syscall.Errno = 8546
+	ERROR_DS_GC_REQUIRED                                                      syscall.Errno = 8547
+	ERROR_DS_LOCAL_MEMBER_OF_LOCAL_ONLY                                       syscall.Errno = 8548
+	ERROR_DS_NO_FPO_IN_UNIVERSAL_GROUPS                                       syscall.Errno = 8549
+	ERROR_DS_CANT_ADD_TO_GC                                                   syscall.Errno = 8550
+	ERROR_DS_NO_CHECKPOINT_WITH_PDC                                           syscall.Errno = 8551
+	ERROR_DS_SOURCE_AUDITING_NOT_ENABLED                                      syscall.Errno = 8552
+	ERROR_DS_CANT_CREATE_IN_NONDOMAIN_NC                                      syscall.Errno = 8553
+	ERROR_DS_INVALID_NAME_FOR_SPN                                             syscall.Errno = 8554
+	ERROR_DS_FILTER_USES_CONTRUCTED_ATTRS                                     syscall.Errno = 8555
+	ERROR_DS_UNICODEPWD_NOT_IN_QUOTES                                         syscall.Errno = 8556
+	ERROR_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED                                   syscall.Errno = 8557
+	ERROR_DS_MUST_BE_RUN_ON_DST_DC                                            syscall.Errno = 8558
+	ERROR_DS_SRC_DC_MUST_BE_SP4_OR_GREATER                                    syscall.Errno = 8559
+	ERROR_DS_CANT_TREE_DELETE_CRITICAL_OBJ                                    syscall.Errno = 8560
+	ERROR_DS_INIT_FAILURE_CONSOLE                                             syscall.Errno = 8561
+	ERROR_DS_SAM_INIT_FAILURE_CONSOLE                                         syscall.Errno = 8562
+	ERROR_DS_FOREST_VERSION_TOO_HIGH                                          syscall.Errno = 8563
+	ERROR_DS_DOMAIN_VERSION_TOO_HIGH                                          syscall.Errno = 8564
+	ERROR_DS_FOREST_VERSION_TOO_LOW                                           syscall.Errno = 8565
+	ERROR_DS_DOMAIN_VERSION_TOO_LOW                                           syscall.Errno = 8566
+	ERROR_DS_INCOMPATIBLE_VERSION                                             syscall.Errno = 8567
+	ERROR_DS_LOW_DSA_VERSION                                                  syscall.Errno = 8568
+	ERROR_DS_NO_BEHAVIOR_VERSION_IN_MIXEDDOMAIN                               syscall.Errno = 8569
+	ERROR_DS_NOT_SUPPORTED_SORT_ORDER                                         syscall.Errno = 8570
+	ERROR_DS_NAME_NOT_UNIQUE                                                  syscall.Errno = 8571
+	ERROR_DS_MACHINE_ACCOUNT_CREATED_PRENT4                                   syscall.Errno = 8572
+	ERROR_DS_OUT_OF_VERSION_STORE                                             syscall.Errno = 8573
+	ERROR_DS_INCOMPATIBLE_CONTROLS_USED                                       syscall.Errno = 8574
+	ERROR_DS_NO_REF_DOMAIN                                                    syscall.Errno = 8575
+	ERROR_DS_RESERVED_LINK_ID                                                 syscall.Errno = 8576
+	ERROR_DS_LINK_ID_NOT_AVAILABLE                                            syscall.Errno = 8577
+	ERROR_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER                                    syscall.Errno = 8578
+	ERROR_DS_MODIFYDN_DISALLOWED_BY_INSTANCE_TYPE                             syscall.Errno = 8579
+	ERROR_DS_NO_OBJECT_MOVE_IN_SCHEMA_NC                                      syscall.Errno = 8580
+	ERROR_DS_MODIFYDN_DISALLOWED_BY_FLAG                                      syscall.Errno = 8581
+	ERROR_DS_MODIFYDN_WRONG_GRANDPARENT                                       syscall.Errno = 8582
+	ERROR_DS_NAME_ERROR_TRUST_REFERRAL                                        syscall.Errno = 8583
+	ERROR_NOT_SUPPORTED_ON_STANDARD_SERVER                                    syscall.Errno = 8584
+	ERROR_DS_CANT_ACCESS_REMOTE_PART_OF_AD                                    syscall.Errno = 8585
+	ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE_V2                                     syscall.Errno = 8586
+	ERROR_DS_THREAD_LIMIT_EXCEEDED                                            syscall.Errno = 8587
+	ERROR_DS_NOT_CLOSEST                                                      syscall.Errno = 8588
+	ERROR_DS_CANT_DERIVE_SPN_WITHOUT_SERVER_REF                               syscall.Errno = 8589
+	ERROR_DS_SINGLE_USER_MODE_FAILED                                          syscall.Errno = 8590
+	ERROR_DS_NTDSCRIPT_SYNTAX_ERROR                                           syscall.Errno = 8591
+	ERROR_DS_NTDSCRIPT_PROCESS_ERROR                                          syscall.Errno = 8592
+	ERROR_DS_DIFFERENT_REPL_EPOCHS                                            syscall.Errno = 8593
+	ERROR_DS_DRS_EXTENSIONS_CHANGED                                           syscall.Errno = 8594
+	ERROR_DS_REPLICA_SET_CHANGE_NOT_ALLOWED_ON_DISABLED_CR                    syscall.Errno = 8595
+	ERROR_DS_NO_MSDS_INTID                                                    syscall.Errno = 8596
+	ERROR_DS_DUP_MSDS_INTID                                                   syscall.Errno = 8597
+	ERROR_DS_EXISTS_IN_RDNATTID                                               syscall.Errno = 8598
+	ERROR_DS_AUTHORIZATION_FAILED                                             syscall.Errno = 8599
+	ERROR_DS_INVALID_SCRIPT                                                   syscall.Errno = 8600
+	ERROR_DS_REMOTE_CROSSREF_OP_FAILED                                        syscall.Errno = 8601
+	ERROR_DS_CROSS_REF_BUSY                                                   syscall.Errno = 8602
+	ERROR_DS_CANT_DERIVE_SPN_FOR_DELETED_DOMAIN                               syscall.Errno = 8603
+	ERROR_DS_CANT_DEMOTE_WITH_WRITEABLE_NC                                    syscall.Errno = 8604
+	ERROR_DS_DUPLICATE_ID_FOUND                                               syscall.Errno = 8605
+	ERROR_DS_INSUFFICIENT_ATTR_TO_CREATE_OBJECT                               syscall.Errno = 8606
+	ERROR_DS_GROUP_CONVERSION_ERROR                                           syscall.Errno = 8607
+	ERROR_DS_CANT_MOVE_APP_BASIC_GROUP                                        syscall.Errno = 8608
+	ERROR_DS_CANT_MOVE_APP_QUERY_GROUP                                        syscall.Errno = 8609
+	ERROR_DS_ROLE_NOT_VERIFIED                                                syscall.Errno = 8610
+	ERROR_DS_WKO_CONTAINER_CANNOT_BE_SPECIAL                                  syscall.Errno = 8611
+	ERROR_DS_DOMAIN_RENAME_IN_PROGRESS                                        syscall.Errno = 8612
+	ERROR_DS_EXISTING_AD_CHILD_NC                                             syscall.Errno = 8613
+	ERROR_DS_REPL_LIFETIME_EXCEEDED                                           syscall.Errno = 8614
+	ERROR_DS_DISALLOWED_IN_SYSTEM_CONTAINER                                   syscall.Errno = 8615
+	ERROR_DS_LDAP_SEND_QUEUE_FULL                                             syscall.Errno = 8616
+	ERROR_DS_DRA_OUT_SCHEDULE_WINDOW                                          syscall.Errno = 8617
+	ERROR_DS_POLICY_NOT_KNOWN                                                 syscall.Errno = 8618
+	ERROR_NO_SITE_SETTINGS_OBJECT                                             syscall.Errno = 8619
+	ERROR_NO_SECRETS                                                          syscall.Errno = 8620
+	ERROR_NO_WRITABLE_DC_FOUND                                                syscall.Errno = 8621
+	ERROR_DS_NO_SERVER_OBJECT                                                 syscall.Errno = 8622
+	ERROR_DS_NO_NTDSA_OBJECT                                                  syscall.Errno = 8623
+	ERROR_DS_NON_ASQ_SEARCH                                                   syscall.Errno = 8624
+	ERROR_DS_AUDIT_FAILURE                                                    syscall.Errno = 8625
+	ERROR_DS_INVALID_SEARCH_FLAG_SUBTREE                                      syscall.Errno = 8626
+	ERROR_DS_INVALID_SEARCH_FLAG_TUPLE                                        syscall.Errno = 8627
+	ERROR_DS_HIERARCHY_TABLE_TOO_DEEP                                         syscall.Errno = 8628
+	ERROR_DS_DRA_CORRUPT_UTD_VECTOR                                           syscall.Errno = 8629
+	ERROR_DS_DRA_SECRETS_DENIED                                               syscall.Errno = 8630
+	ERROR_DS_RESERVED_MAPI_ID                                                 syscall.Errno = 8631
+	ERROR_DS_MAPI_ID_NOT_AVAILABLE                                            syscall.Errno = 8632
+	ERROR_DS_DRA_MISSING_KRBTGT_SECRET                                        syscall.Errno = 8633
+	ERROR_DS_DOMAIN_NAME_EXISTS_IN_FOREST                                     syscall.Errno = 8634
+	ERROR_DS_FLAT_NAME_EXISTS_IN_FOREST                                       syscall.Errno = 8635
+	ERROR_INVALID_USER_PRINCIPAL_NAME                                         syscall.Errno = 8636
+	ERROR_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS                               syscall.Errno = 8637
+	ERROR_DS_OID_NOT_FOUND                                                    syscall.Errno = 8638
+	ERROR_DS_DRA_RECYCLED_TARGET                                              syscall.Errno = 8639
+	ERROR_DS_DISALLOWED_NC_REDIRECT                                           syscall.Errno = 8640
+	ERROR_DS_HIGH_ADLDS_FFL                                                   syscall.Errno = 8641
+	ERROR_DS_HIGH_DSA_VERSION                                                 syscall.Errno = 8642
+	ERROR_DS_LOW_ADLDS_FFL                                                    syscall.Errno = 8643
+	ERROR_DOMAIN_SID_SAME_AS_LOCAL_WORKSTATION                                syscall.Errno = 8644
+	ERROR_DS_UNDELETE_SAM_VALIDATION_FAILED                                   syscall.Errno = 8645
+	ERROR_INCORRECT_ACCOUNT_TYPE                                              syscall.Errno = 8646
+	ERROR_DS_SPN_VALUE_NOT_UNIQUE_IN_FOREST                                   syscall.Errno = 8647
+	ERROR_DS_UPN_VALUE_NOT_UNIQUE_IN_FOREST                                   syscall.Errno = 8648
+	ERROR_DS_MISSING_FOREST_TRUST                                             syscall.Errno = 8649
+	ERROR_DS_VALUE_KEY_NOT_UNIQUE                                             syscall.Errno = 8650
+	ERROR_IPSEC_QM_POLICY_EXISTS                                              syscall.Errno = 13000
+	ERROR_IPSEC_QM_POLICY_NOT_FOUND                                           syscall.Errno = 13001
+	ERROR_IPSEC_QM_POLICY_IN_USE                                              syscall.Errno = 13002
+	ERROR_IPSEC_MM_POLICY_EXISTS                                              syscall.Errno = 13003
+	ERROR_IPSEC_MM_POLICY_NOT_FOUND                                           syscall.Errno = 13004
+	ERROR_IPSEC_MM_POLICY_IN_USE                                              syscall.Errno = 13005
+	ERROR_IPSEC_MM_FILTER_EXISTS                                              syscall.Errno = 13006
+	ERROR_IPSEC_MM_FILTER_NOT_FOUND                                           syscall.Errno = 13007
+	ERROR_IPSEC_TRANSPORT_FILTER_EXISTS                                       syscall.Errno = 13008
+	ERROR_IPSEC_TRANSPORT_FILTER_NOT_FOUND                                    syscall.Errno = 13009
+	ERROR_IPSEC_MM_AUTH_EXISTS                                                syscall.Errno = 13010
+	ERROR_IPSEC_MM_AUTH_NOT_FOUND                                             syscall.Errno = 13011
+	ERROR_IPSEC_MM_AUTH_IN_USE                                                syscall.Errno = 13012
+	ERROR_IPSEC_DEFAULT_MM_POLICY_NOT_FOUND                                   syscall.Errno = 13013
+	ERROR_IPSEC_DEFAULT_MM_AUTH_NOT_FOUND                                     syscall.Errno = 13014
+	ERROR_IPSEC_DEFAULT_QM_POLICY_NOT_FOUND                                   syscall.Errno = 13015
+	ERROR_IPSEC_TUNNEL_FILTER_EXISTS                                          syscall.Errno = 13016
+	ERROR_IPSEC_TUNNEL_FILTER_NOT_FOUND                                       syscall.Errno = 13017
+	ERROR_IPSEC_MM_FILTER_PENDING_DELETION                                    syscall.Errno = 13018
+	ERROR_IPSEC_TRANSPORT_FILTER_PENDING_DELETION                             syscall.Errno = 13019
+	ERROR_IPSEC_TUNNEL_FILTER_PENDING_DELETION                                syscall.Errno = 13020
+	ERROR_IPSEC_MM_POLICY_PENDING_DELETION                                    syscall.Errno = 13021
+	ERROR_IPSEC_MM_AUTH_PENDING_DELETION                                      syscall.Errno = 13022
+	ERROR_IPSEC_QM_POLICY_PENDING_DELETION                                    syscall.Errno = 13023
+	ERROR_IPSEC_IKE_NEG_STATUS_BEGIN                                          syscall.Errno = 13800
+	ERROR_IPSEC_IKE_AUTH_FAIL                                                 syscall.Errno = 13801
+	ERROR_IPSEC_IKE_ATTRIB_FAIL                                               syscall.Errno = 13802
+	ERROR_IPSEC_IKE_NEGOTIATION_PENDING                                       syscall.Errno = 13803
+	ERROR_IPSEC_IKE_GENERAL_PROCESSING_ERROR                                  syscall.Errno = 13804
+	ERROR_IPSEC_IKE_TIMED_OUT                                                 syscall.Errno = 13805
+	ERROR_IPSEC_IKE_NO_CERT                                                   syscall.Errno = 13806
+	ERROR_IPSEC_IKE_SA_DELETED                                                syscall.Errno = 13807
+	ERROR_IPSEC_IKE_SA_REAPED                                                 syscall.Errno = 13808
+	ERROR_IPSEC_IKE_MM_ACQUIRE_DROP                                           syscall.Errno = 13809
+	ERROR_IPSEC_IKE_QM_ACQUIRE_DROP                                           syscall.Errno = 13810
+	ERROR_IPSEC_IKE_QUEUE_DROP_MM                                             syscall.Errno = 13811
+	ERROR_IPSEC_IKE_QUEUE_DROP_NO_MM                                          syscall.Errno = 13812
+	ERROR_IPSEC_IKE_DROP_NO_RESPONSE                                          syscall.Errno = 13813
+	ERROR_IPSEC_IKE_MM_DELAY_DROP                                             syscall.Errno = 13814
+	ERROR_IPSEC_IKE_QM_DELAY_DROP                                             syscall.Errno = 13815
+	ERROR_IPSEC_IKE_ERROR                                                     syscall.Errno = 13816
+	ERROR_IPSEC_IKE_CRL_FAILED                                                syscall.Errno = 13817
+	ERROR_IPSEC_IKE_INVALID_KEY_USAGE                                         syscall.Errno = 13818
+	ERROR_IPSEC_IKE_INVALID_CERT_TYPE                                         syscall.Errno = 13819
+	ERROR_IPSEC_IKE_NO_PRIVATE_KEY                                            syscall.Errno = 13820
+	ERROR_IPSEC_IKE_SIMULTANEOUS_REKEY                                        syscall.Errno = 13821
+	ERROR_IPSEC_IKE_DH_FAIL                                                   syscall.Errno = 13822
+	ERROR_IPSEC_IKE_CRITICAL_PAYLOAD_NOT_RECOGNIZED                           syscall.Errno = 13823
+	ERROR_IPSEC_IKE_INVALID_HEADER                                            syscall.Errno = 13824
+	ERROR_IPSEC_IKE_NO_POLICY                                                 syscall.Errno = 13825
+	ERROR_IPSEC_IKE_INVALID_SIGNATURE                                         syscall.Errno = 13826
+	ERROR_IPSEC_IKE_KERBEROS_ERROR                                            syscall.Errno = 13827
+	ERROR_IPSEC_IKE_NO_PUBLIC_KEY                                             syscall.Errno = 13828
+	ERROR_IPSEC_IKE_PROCESS_ERR                                               syscall.Errno = 13829
+	ERROR_IPSEC_IKE_PROCESS_ERR_SA                                            syscall.Errno = 13830
+	ERROR_IPSEC_IKE_PROCESS_ERR_PROP                                          syscall.Errno = 13831
+	ERROR_IPSEC_IKE_PROCESS_ERR_TRANS                                         syscall.Errno = 13832
+	ERROR_IPSEC_IKE_PROCESS_ERR_KE                                            syscall.Errno = 13833
+	ERROR_IPSEC_IKE_PROCESS_ERR_ID                                            syscall.Errno = 13834
+	ERROR_IPSEC_IKE_PROCESS_ERR_CERT                                          syscall.Errno = 13835
+	ERROR_IPSEC_IKE_PROCESS_ERR_CERT_REQ                                      syscall.Errno = 13836
+	ERROR_IPSEC_IKE_PROCESS_ERR_HASH                                          syscall.Errno = 13837
+	ERROR_IPSEC_IKE_PROCESS_ERR_SIG                                           syscall.Errno = 13838
+	ERROR_IPSEC_IKE_PROCESS_ERR_NONCE                                         syscall.Errno = 13839
+	ERROR_IPSEC_IKE_PROCESS_ERR_NOTIFY                                        syscall.Errno = 13840
+	ERROR_IPSEC_IKE_PROCESS_ERR_DELETE                                        syscall.Errno = 13841
+	ERROR_IPSEC_IKE_PROCESS_ERR_VENDOR                                        syscall.Errno = 13842
+	ERROR_IPSEC_IKE_INVALID_PAYLOAD                                           syscall.Errno = 13843
+	ERROR_IPSEC_IKE_LOAD_SOFT_SA                                              syscall.Errno = 13844
+	ERROR_IPSEC_IKE_SOFT_SA_TORN_DOWN                                         syscall.Errno = 13845
+	ERROR_IPSEC_IKE_INVALID_COOKIE                                            syscall.Errno = 13846
+	ERROR_IPSEC_IKE_NO_PEER_CERT                                              syscall.Errno = 13847
+	ERROR_IPSEC_IKE_PEER_CRL_FAILED                                           syscall.Errno = 13848
+	ERROR_IPSEC_IKE_POLICY_CHANGE                                             syscall.Errno = 13849
+	ERROR_IPSEC_IKE_NO_MM_POLICY                                              syscall.Errno = 13850
+	ERROR_IPSEC_IKE_NOTCBPRIV                                                 syscall.Errno = 13851
+	ERROR_IPSEC_IKE_SECLOADFAIL                                               syscall.Errno = 13852
+	ERROR_IPSEC_IKE_FAILSSPINIT                                               syscall.Errno = 13853
+	ERROR_IPSEC_IKE_FAILQUERYSSP                                              syscall.Errno = 13854
+	ERROR_IPSEC_IKE_SRVACQFAIL                                                syscall.Errno = 13855
+	ERROR_IPSEC_IKE_SRVQUERYCRED                                              syscall.Errno = 13856
+	ERROR_IPSEC_IKE_GETSPIFAIL                                                syscall.Errno = 13857
+	ERROR_IPSEC_IKE_INVALID_FILTER                                            syscall.Errno = 13858
+	ERROR_IPSEC_IKE_OUT_OF_MEMORY                                             syscall.Errno = 13859
+	ERROR_IPSEC_IKE_ADD_UPDATE_KEY_FAILED                                     syscall.Errno = 13860
+	ERROR_IPSEC_IKE_INVALID_POLICY                                            syscall.Errno = 13861
+	ERROR_IPSEC_IKE_UNKNOWN_DOI                                               syscall.Errno = 13862
+	ERROR_IPSEC_IKE_INVALID_SITUATION                                         syscall.Errno = 13863
+	ERROR_IPSEC_IKE_DH_FAILURE                                                syscall.Errno = 13864
+	ERROR_IPSEC_IKE_INVALID_GROUP                                             syscall.Errno = 13865
+	ERROR_IPSEC_IKE_ENCRYPT                                                   syscall.Errno = 13866
+	ERROR_IPSEC_IKE_DECRYPT                                                   syscall.Errno = 13867
+	ERROR_IPSEC_IKE_POLICY_MATCH                                              syscall.Errno = 13868
+	ERROR_IPSEC_IKE_UNSUPPORTED_ID                                            syscall.Errno = 13869
+	ERROR_IPSEC_IKE_INVALID_HASH                                              syscall.Errno = 13870
+	ERROR_IPSEC_IKE_INVALID_HASH_ALG                                          syscall.Errno = 13871
+	ERROR_IPSEC_IKE_INVALID_HASH_SIZE                                         syscall.Errno = 13872
+	ERROR_IPSEC_IKE_INVALID_ENCRYPT_ALG                                       syscall.Errno = 13873
+	ERROR_IPSEC_IKE_INVALID_AUTH_ALG                                          syscall.Errno = 13874
+	ERROR_IPSEC_IKE_INVALID_SIG                                               syscall.Errno = 13875
+	ERROR_IPSEC_IKE_LOAD_FAILED                                               syscall.Errno = 13876
+	ERROR_IPSEC_IKE_RPC_DELETE                                                syscall.Errno = 13877
+	ERROR_IPSEC_IKE_BENIGN_REINIT                                             syscall.Errno = 13878
+	ERROR_IPSEC_IKE_INVALID_RESPONDER_LIFETIME_NOTIFY                         syscall.Errno = 13879
+	ERROR_IPSEC_IKE_INVALID_MAJOR_VERSION                                     syscall.Errno = 13880
+	ERROR_IPSEC_IKE_INVALID_CERT_KEYLEN                                       syscall.Errno = 13881
+	ERROR_IPSEC_IKE_MM_LIMIT                                                  syscall.Errno = 13882
+	ERROR_IPSEC_IKE_NEGOTIATION_DISABLED                                      syscall.Errno = 13883
+	ERROR_IPSEC_IKE_QM_LIMIT                                                  syscall.Errno = 13884
+	ERROR_IPSEC_IKE_MM_EXPIRED                                                syscall.Errno = 13885
+	ERROR_IPSEC_IKE_PEER_MM_ASSUMED_INVALID                                   syscall.Errno = 13886
+	ERROR_IPSEC_IKE_CERT_CHAIN_POLICY_MISMATCH                                syscall.Errno = 13887
+	ERROR_IPSEC_IKE_UNEXPECTED_MESSAGE_ID                                     syscall.Errno = 13888
+	ERROR_IPSEC_IKE_INVALID_AUTH_PAYLOAD                                      syscall.Errno = 13889
+	ERROR_IPSEC_IKE_DOS_COOKIE_SENT                                           syscall.Errno = 13890
+	ERROR_IPSEC_IKE_SHUTTING_DOWN                                             syscall.Errno = 13891
+	ERROR_IPSEC_IKE_CGA_AUTH_FAILED                                           syscall.Errno = 13892
+	ERROR_IPSEC_IKE_PROCESS_ERR_NATOA                                         syscall.Errno = 13893
+	ERROR_IPSEC_IKE_INVALID_MM_FOR_QM                                         syscall.Errno = 13894
+	ERROR_IPSEC_IKE_QM_EXPIRED                                                syscall.Errno = 13895
+	ERROR_IPSEC_IKE_TOO_MANY_FILTERS                                          syscall.Errno = 13896
+	ERROR_IPSEC_IKE_NEG_STATUS_END                                            syscall.Errno = 13897
+	ERROR_IPSEC_IKE_KILL_DUMMY_NAP_TUNNEL                                     syscall.Errno = 13898
+	ERROR_IPSEC_IKE_INNER_IP_ASSIGNMENT_FAILURE                               syscall.Errno = 13899
+	ERROR_IPSEC_IKE_REQUIRE_CP_PAYLOAD_MISSING                                syscall.Errno = 13900
+	ERROR_IPSEC_KEY_MODULE_IMPERSONATION_NEGOTIATION_PENDING                  syscall.Errno = 13901
+	ERROR_IPSEC_IKE_COEXISTENCE_SUPPRESS                                      syscall.Errno = 13902
+	ERROR_IPSEC_IKE_RATELIMIT_DROP                                            syscall.Errno = 13903
+	ERROR_IPSEC_IKE_PEER_DOESNT_SUPPORT_MOBIKE                                syscall.Errno = 13904
+	ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE                                     syscall.Errno = 13905
+	ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_FAILURE                         syscall.Errno = 13906
+	ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE_WITH_OPTIONAL_RETRY                 syscall.Errno = 13907
+	ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_AND_CERTMAP_FAILURE             syscall.Errno = 13908
+	ERROR_IPSEC_IKE_NEG_STATUS_EXTENDED_END                                   syscall.Errno = 13909
+	ERROR_IPSEC_BAD_SPI                                                       syscall.Errno = 13910
+	ERROR_IPSEC_SA_LIFETIME_EXPIRED                                           syscall.Errno = 13911
+	ERROR_IPSEC_WRONG_SA                                                      syscall.Errno = 13912
+	ERROR_IPSEC_REPLAY_CHECK_FAILED                                           syscall.Errno = 13913
+	ERROR_IPSEC_INVALID_PACKET                                                syscall.Errno = 13914
+	ERROR_IPSEC_INTEGRITY_CHECK_FAILED                                        syscall.Errno = 13915
+	ERROR_IPSEC_CLEAR_TEXT_DROP                                               syscall.Errno = 13916
+	ERROR_IPSEC_AUTH_FIREWALL_DROP                                            syscall.Errno = 13917
+	ERROR_IPSEC_THROTTLE_DROP                                                 syscall.Errno = 13918
+	ERROR_IPSEC_DOSP_BLOCK                                                    syscall.Errno = 13925
+	ERROR_IPSEC_DOSP_RECEIVED_MULTICAST                                       syscall.Errno = 13926
+	ERROR_IPSEC_DOSP_INVALID_PACKET                                           syscall.Errno = 13927
+	ERROR_IPSEC_DOSP_STATE_LOOKUP_FAILED                                      syscall.Errno = 13928
+	ERROR_IPSEC_DOSP_MAX_ENTRIES                                              syscall.Errno = 13929
+	ERROR_IPSEC_DOSP_KEYMOD_NOT_ALLOWED                                       syscall.Errno = 13930
+	ERROR_IPSEC_DOSP_NOT_INSTALLED                                            syscall.Errno = 13931
+	ERROR_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES                              syscall.Errno = 13932
+	ERROR_SXS_SECTION_NOT_FOUND                                               syscall.Errno = 14000
+	ERROR_SXS_CANT_GEN_ACTCTX                                                 syscall.Errno = 14001
+	ERROR_SXS_INVALID_ACTCTXDATA_FORMAT                                       syscall.Errno = 14002
+	ERROR_SXS_ASSEMBLY_NOT_FOUND                                              syscall.Errno = 14003
+	ERROR_SXS_MANIFEST_FORMAT_ERROR                                           syscall.Errno = 14004
+	ERROR_SXS_MANIFEST_PARSE_ERROR                                            syscall.Errno = 14005
+	ERROR_SXS_ACTIVATION_CONTEXT_DISABLED                                     syscall.Errno = 14006
+	ERROR_SXS_KEY_NOT_FOUND                                                   syscall.Errno = 14007
+	ERROR_SXS_VERSION_CONFLICT                                                syscall.Errno = 14008
+	ERROR_SXS_WRONG_SECTION_TYPE                                              syscall.Errno = 14009
+	ERROR_SXS_THREAD_QUERIES_DISABLED                                         syscall.Errno = 14010
+	ERROR_SXS_PROCESS_DEFAULT_ALREADY_SET                                     syscall.Errno = 14011
+	ERROR_SXS_UNKNOWN_ENCODING_GROUP                                          syscall.Errno = 14012
+	ERROR_SXS_UNKNOWN_ENCODING                                                syscall.Errno = 14013
+	ERROR_SXS_INVALID_XML_NAMESPACE_URI                                       syscall.Errno = 14014
+	ERROR_SXS_ROOT_MANIFEST_DEPENDENCY_NOT_INSTALLED                          syscall.Errno = 14015
+	ERROR_SXS_LEAF_MANIFEST_DEPENDENCY_NOT_INSTALLED                          syscall.Errno = 14016
+	ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE                             syscall.Errno = 14017
+	ERROR_SXS_MANIFEST_MISSING_REQUIRED_DEFAULT_NAMESPACE                     syscall.Errno = 14018
+	ERROR_SXS_MANIFEST_INVALID_REQUIRED_DEFAULT_NAMESPACE                     syscall.Errno = 14019
+	ERROR_SXS_PRIVATE_MANIFEST_CROSS_PATH_WITH_REPARSE_POINT                  syscall.Errno = 14020
+	ERROR_SXS_DUPLICATE_DLL_NAME                                              syscall.Errno = 14021
+	ERROR_SXS_DUPLICATE_WINDOWCLASS_NAME                                      syscall.Errno = 14022
+	ERROR_SXS_DUPLICATE_CLSID                                                 syscall.Errno = 14023
+	ERROR_SXS_DUPLICATE_IID                                                   syscall.Errno = 14024
+	ERROR_SXS_DUPLICATE_TLBID                                                 syscall.Errno = 14025
+	ERROR_SXS_DUPLICATE_PROGID                                                syscall.Errno = 14026
+	ERROR_SXS_DUPLICATE_ASSEMBLY_NAME                                         syscall.Errno = 14027
+	ERROR_SXS_FILE_HASH_MISMATCH                                              syscall.Errno = 14028
+	ERROR_SXS_POLICY_PARSE_ERROR                                              syscall.Errno = 14029
+	ERROR_SXS_XML_E_MISSINGQUOTE                                              syscall.Errno = 14030
+	ERROR_SXS_XML_E_COMMENTSYNTAX                                             syscall.Errno = 14031
+	ERROR_SXS_XML_E_BADSTARTNAMECHAR                                          syscall.Errno = 14032
+	ERROR_SXS_XML_E_BADNAMECHAR                                               syscall.Errno = 14033
+	ERROR_SXS_XML_E_BADCHARINSTRING                                           syscall.Errno = 14034
+	ERROR_SXS_XML_E_XMLDECLSYNTAX                                             syscall.Errno = 14035
+	ERROR_SXS_XML_E_BADCHARDATA                                               syscall.Errno = 14036
+	ERROR_SXS_XML_E_MISSINGWHITESPACE                                         syscall.Errno = 14037
+	ERROR_SXS_XML_E_EXPECTINGTAGEND                                           syscall.Errno = 14038
+	ERROR_SXS_XML_E_MISSINGSEMICOLON                                          syscall.Errno = 14039
+	ERROR_SXS_XML_E_UNBALANCEDPAREN                                           syscall.Errno = 14040
+	ERROR_SXS_XML_E_INTERNALERROR                                             syscall.Errno = 14041
+	ERROR_SXS_XML_E_UNEXPECTED_WHITESPACE                                     syscall.Errno = 14042
+	ERROR_SXS_XML_E_INCOMPLETE_ENCODING                                       syscall.Errno = 14043
+	ERROR_SXS_XML_E_MISSING_PAREN                                             syscall.Errno = 14044
+	ERROR_SXS_XML_E_EXPECTINGCLOSEQUOTE                                       syscall.Errno = 14045
+	ERROR_SXS_XML_E_MULTIPLE_COLONS                                           syscall.Errno = 14046
+	ERROR_SXS_XML_E_INVALID_DECIMAL                                           syscall.Errno = 14047
+	ERROR_SXS_XML_E_INVALID_HEXIDECIMAL                                       syscall.Errno = 14048
+	ERROR_SXS_XML_E_INVALID_UNICODE                                           syscall.Errno = 14049
+	ERROR_SXS_XML_E_WHITESPACEORQUESTIONMARK                                  syscall.Errno = 14050
+	ERROR_SXS_XML_E_UNEXPECTEDENDTAG                                          syscall.Errno = 14051
+	ERROR_SXS_XML_E_UNCLOSEDTAG                                               syscall.Errno = 14052
+	ERROR_SXS_XML_E_DUPLICATEATTRIBUTE                                        syscall.Errno = 14053
+	ERROR_SXS_XML_E_MULTIPLEROOTS                                             syscall.Errno = 14054
+	ERROR_SXS_XML_E_INVALIDATROOTLEVEL                                        syscall.Errno = 14055
+	ERROR_SXS_XML_E_BADXMLDECL                                                syscall.Errno = 14056
+	ERROR_SXS_XML_E_MISSINGROOT                                               syscall.Errno = 14057
+	ERROR_SXS_XML_E_UNEXPECTEDEOF                                             syscall.Errno = 14058
+	ERROR_SXS_XML_E_BADPEREFINSUBSET                                          syscall.Errno = 14059
+	ERROR_SXS_XML_E_UNCLOSEDSTARTTAG                                          syscall.Errno = 14060
+	ERROR_SXS_XML_E_UNCLOSEDENDTAG                                            syscall.Errno = 14061
+	ERROR_SXS_XML_E_UNCLOSEDSTRING                                            syscall.Errno = 14062
+	ERROR_SXS_XML_E_UNCLOSEDCOMMENT                                           syscall.Errno = 14063
+	ERROR_SXS_XML_E_UNCLOSEDDECL                                              syscall.Errno = 14064
+	ERROR_SXS_XML_E_UNCLOSEDCDATA                                             syscall.Errno = 14065
+	ERROR_SXS_XML_E_RESERVEDNAMESPACE                                         syscall.Errno = 14066
+	ERROR_SXS_XML_E_INVALIDENCODING                                           syscall.Errno = 14067
+	ERROR_SXS_XML_E_INVALIDSWITCH                                             syscall.Errno = 14068
+	ERROR_SXS_XML_E_BADXMLCASE                                                syscall.Errno = 14069
+	ERROR_SXS_XML_E_INVALID_STANDALONE                                        syscall.Errno = 14070
+	ERROR_SXS_XML_E_UNEXPECTED_STANDALONE                                     syscall.Errno = 14071
+	ERROR_SXS_XML_E_INVALID_VERSION                                           syscall.Errno = 14072
+	ERROR_SXS_XML_E_MISSINGEQUALS                                             syscall.Errno = 14073
+	ERROR_SXS_PROTECTION_RECOVERY_FAILED                                      syscall.Errno = 14074
+	ERROR_SXS_PROTECTION_PUBLIC_KEY_TOO_SHORT                                 syscall.Errno = 14075
+	ERROR_SXS_PROTECTION_CATALOG_NOT_VALID                                    syscall.Errno = 14076
+	ERROR_SXS_UNTRANSLATABLE_HRESULT                                          syscall.Errno = 14077
+	ERROR_SXS_PROTECTION_CATALOG_FILE_MISSING                                 syscall.Errno = 14078
+	ERROR_SXS_MISSING_ASSEMBLY_IDENTITY_ATTRIBUTE                             syscall.Errno = 14079
+	ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE_NAME                        syscall.Errno = 14080
+	ERROR_SXS_ASSEMBLY_MISSING                                                syscall.Errno = 14081
+	ERROR_SXS_CORRUPT_ACTIVATION_STACK                                        syscall.Errno = 14082
+	ERROR_SXS_CORRUPTION                                                      syscall.Errno = 14083
+	ERROR_SXS_EARLY_DEACTIVATION                                              syscall.Errno = 14084
+	ERROR_SXS_INVALID_DEACTIVATION                                            syscall.Errno = 14085
+	ERROR_SXS_MULTIPLE_DEACTIVATION                                           syscall.Errno = 14086
+	ERROR_SXS_PROCESS_TERMINATION_REQUESTED                                   syscall.Errno = 14087
+	ERROR_SXS_RELEASE_ACTIVATION_CONTEXT                                      syscall.Errno = 14088
+	ERROR_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY                         syscall.Errno = 14089
+	ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE                                syscall.Errno = 14090
+	ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME                                 syscall.Errno = 14091
+	ERROR_SXS_IDENTITY_DUPLICATE_ATTRIBUTE                                    syscall.Errno = 14092
+	ERROR_SXS_IDENTITY_PARSE_ERROR                                            syscall.Errno = 14093
+	ERROR_MALFORMED_SUBSTITUTION_STRING                                       syscall.Errno = 14094
+	ERROR_SXS_INCORRECT_PUBLIC_KEY_TOKEN                                      syscall.Errno = 14095
+	ERROR_UNMAPPED_SUBSTITUTION_STRING                                        syscall.Errno = 14096
+	ERROR_SXS_ASSEMBLY_NOT_LOCKED                                             syscall.Errno = 14097
+	ERROR_SXS_COMPONENT_STORE_CORRUPT                                         syscall.Errno = 14098
+	ERROR_ADVANCED_INSTALLER_FAILED                                           syscall.Errno = 14099
+	ERROR_XML_ENCODING_MISMATCH                                               syscall.Errno = 14100
+	ERROR_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT                   syscall.Errno = 14101
+	ERROR_SXS_IDENTITIES_DIFFERENT                                            syscall.Errno = 14102
+	ERROR_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT                                    syscall.Errno = 14103
+	ERROR_SXS_FILE_NOT_PART_OF_ASSEMBLY                                       syscall.Errno = 14104
+	ERROR_SXS_MANIFEST_TOO_BIG                                                syscall.Errno = 14105
+	ERROR_SXS_SETTING_NOT_REGISTERED                                          syscall.Errno = 14106
+	ERROR_SXS_TRANSACTION_CLOSURE_INCOMPLETE                                  syscall.Errno = 14107
+	ERROR_SMI_PRIMITIVE_INSTALLER_FAILED                                      syscall.Errno = 14108
+	ERROR_GENERIC_COMMAND_FAILED                                              syscall.Errno = 14109
+	ERROR_SXS_FILE_HASH_MISSING                                               syscall.Errno = 14110
+	ERROR_SXS_DUPLICATE_ACTIVATABLE_CLASS                                     syscall.Errno = 14111
+	ERROR_EVT_INVALID_CHANNEL_PATH                                            syscall.Errno = 15000
+	ERROR_EVT_INVALID_QUERY                                                   syscall.Errno = 15001
+	ERROR_EVT_PUBLISHER_METADATA_NOT_FOUND                                    syscall.Errno = 15002
+	ERROR_EVT_EVENT_TEMPLATE_NOT_FOUND                                        syscall.Errno = 15003
+	ERROR_EVT_INVALID_PUBLISHER_NAME                                          syscall.Errno = 15004
+	ERROR_EVT_INVALID_EVENT_DATA                                              syscall.Errno = 15005
+	ERROR_EVT_CHANNEL_NOT_FOUND                                               syscall.Errno = 15007
+	ERROR_EVT_MALFORMED_XML_TEXT                                              syscall.Errno = 15008
+	ERROR_EVT_SUBSCRIPTION_TO_DIRECT_CHANNEL                                  syscall.Errno = 15009
+	ERROR_EVT_CONFIGURATION_ERROR                                             syscall.Errno = 15010
+	ERROR_EVT_QUERY_RESULT_STALE                                              syscall.Errno = 15011
+	ERROR_EVT_QUERY_RESULT_INVALID_POSITION                                   syscall.Errno = 15012
+	ERROR_EVT_NON_VALIDATING_MSXML                                            syscall.Errno = 15013
+	ERROR_EVT_FILTER_ALREADYSCOPED                                            syscall.Errno = 15014
+	ERROR_EVT_FILTER_NOTELTSET                                                syscall.Errno = 15015
+	ERROR_EVT_FILTER_INVARG                                                   syscall.Errno = 15016
+	ERROR_EVT_FILTER_INVTEST                                                  syscall.Errno = 15017
+	ERROR_EVT_FILTER_INVTYPE                                                  syscall.Errno = 15018
+	ERROR_EVT_FILTER_PARSEERR                                                 syscall.Errno = 15019
+	ERROR_EVT_FILTER_UNSUPPORTEDOP                                            syscall.Errno = 15020
+	ERROR_EVT_FILTER_UNEXPECTEDTOKEN                                          syscall.Errno = 15021
+	ERROR_EVT_INVALID_OPERATION_OVER_ENABLED_DIRECT_CHANNEL                   syscall.Errno = 15022
+	ERROR_EVT_INVALID_CHANNEL_PROPERTY_VALUE                                  syscall.Errno = 15023
+	ERROR_EVT_INVALID_PUBLISHER_PROPERTY_VALUE                                syscall.Errno = 15024
+	ERROR_EVT_CHANNEL_CANNOT_ACTIVATE                                         syscall.Errno = 15025
+	ERROR_EVT_FILTER_TOO_COMPLEX                                              syscall.Errno = 15026
+	ERROR_EVT_MESSAGE_NOT_FOUND                                               syscall.Errno = 15027
+	ERROR_EVT_MESSAGE_ID_NOT_FOUND                                            syscall.Errno = 15028
+	ERROR_EVT_UNRESOLVED_VALUE_INSERT                                         syscall.Errno = 15029
+	ERROR_EVT_UNRESOLVED_PARAMETER_INSERT                                     syscall.Errno = 15030
+	ERROR_EVT_MAX_INSERTS_REACHED                                             syscall.Errno = 15031
+	ERROR_EVT_EVENT_DEFINITION_NOT_FOUND                                      syscall.Errno = 15032
+	ERROR_EVT_MESSAGE_LOCALE_NOT_FOUND                                        syscall.Errno = 15033
+	ERROR_EVT_VERSION_TOO_OLD                                                 syscall.Errno = 15034
+	ERROR_EVT_VERSION_TOO_NEW                                                 syscall.Errno = 15035
+	ERROR_EVT_CANNOT_OPEN_CHANNEL_OF_QUERY                                    syscall.Errno = 15036
+	ERROR_EVT_PUBLISHER_DISABLED                                              syscall.Errno = 15037
+	ERROR_EVT_FILTER_OUT_OF_RANGE                                             syscall.Errno = 15038
+	ERROR_EC_SUBSCRIPTION_CANNOT_ACTIVATE                                     syscall.Errno = 15080
+	ERROR_EC_LOG_DISABLED                                                     syscall.Errno = 15081
+	ERROR_EC_CIRCULAR_FORWARDING                                              syscall.Errno = 15082
+	ERROR_EC_CREDSTORE_FULL                                                   syscall.Errno = 15083
+	ERROR_EC_CRED_NOT_FOUND                                                   syscall.Errno = 15084
+	ERROR_EC_NO_ACTIVE_CHANNEL                                                syscall.Errno = 15085
+	ERROR_MUI_FILE_NOT_FOUND                                                  syscall.Errno = 15100
+	ERROR_MUI_INVALID_FILE                                                    syscall.Errno = 15101
+	ERROR_MUI_INVALID_RC_CONFIG                                               syscall.Errno = 15102
+	ERROR_MUI_INVALID_LOCALE_NAME                                             syscall.Errno = 15103
+	ERROR_MUI_INVALID_ULTIMATEFALLBACK_NAME                                   syscall.Errno = 15104
+	ERROR_MUI_FILE_NOT_LOADED                                                 syscall.Errno = 15105
+	ERROR_RESOURCE_ENUM_USER_STOP                                             syscall.Errno = 15106
+	ERROR_MUI_INTLSETTINGS_UILANG_NOT_INSTALLED                               syscall.Errno = 15107
+	ERROR_MUI_INTLSETTINGS_INVALID_LOCALE_NAME                                syscall.Errno = 15108
+	ERROR_MRM_RUNTIME_NO_DEFAULT_OR_NEUTRAL_RESOURCE                          syscall.Errno = 15110
+	ERROR_MRM_INVALID_PRICONFIG                                               syscall.Errno = 15111
+	ERROR_MRM_INVALID_FILE_TYPE                                               syscall.Errno = 15112
+	ERROR_MRM_UNKNOWN_QUALIFIER                                               syscall.Errno = 15113
+	ERROR_MRM_INVALID_QUALIFIER_VALUE                                         syscall.Errno = 15114
+	ERROR_MRM_NO_CANDIDATE                                                    syscall.Errno = 15115
+	ERROR_MRM_NO_MATCH_OR_DEFAULT_CANDIDATE                                   syscall.Errno = 15116
+	ERROR_MRM_RESOURCE_TYPE_MISMATCH                                          syscall.Errno = 15117
+	ERROR_MRM_DUPLICATE_MAP_NAME                                              syscall.Errno = 15118
+	ERROR_MRM_DUPLICATE_ENTRY                                                 syscall.Errno = 15119
+	ERROR_MRM_INVALID_RESOURCE_IDENTIFIER                                     syscall.Errno = 15120
+	ERROR_MRM_FILEPATH_TOO_LONG                                               syscall.Errno = 15121
+	ERROR_MRM_UNSUPPORTED_DIRECTORY_TYPE                                      syscall.Errno = 15122
+	ERROR_MRM_INVALID_PRI_FILE                                                syscall.Errno = 15126
+	ERROR_MRM_NAMED_RESOURCE_NOT_FOUND                                        syscall.Errno = 15127
+	ERROR_MRM_MAP_NOT_FOUND                                                   syscall.Errno = 15135
+	ERROR_MRM_UNSUPPORTED_PROFILE_TYPE                                        syscall.Errno = 15136
+	ERROR_MRM_INVALID_QUALIFIER_OPERATOR                                      syscall.Errno = 15137
+	ERROR_MRM_INDETERMINATE_QUALIFIER_VALUE                                   syscall.Errno = 15138
+	ERROR_MRM_AUTOMERGE_ENABLED                                               syscall.Errno = 15139
+	ERROR_MRM_TOO_MANY_RESOURCES                                              syscall.Errno = 15140
+	ERROR_MRM_UNSUPPORTED_FILE_TYPE_FOR_MERGE                                 syscall.Errno = 15141
+	ERROR_MRM_UNSUPPORTED_FILE_TYPE_FOR_LOAD_UNLOAD_PRI_FILE                  syscall.Errno = 15142
+	ERROR_MRM_NO_CURRENT_VIEW_ON_THREAD                                       syscall.Errno = 15143
+	ERROR_DIFFERENT_PROFILE_RESOURCE_MANAGER_EXIST                            syscall.Errno = 15144
+	ERROR_OPERATION_NOT_ALLOWED_FROM_SYSTEM_COMPONENT                         syscall.Errno = 15145
+	ERROR_MRM_DIRECT_REF_TO_NON_DEFAULT_RESOURCE                              syscall.Errno = 15146
+	ERROR_MRM_GENERATION_COUNT_MISMATCH                                       syscall.Errno = 15147
+	ERROR_PRI_MERGE_VERSION_MISMATCH                                          syscall.Errno = 15148
+	ERROR_PRI_MERGE_MISSING_SCHEMA                                            syscall.Errno = 15149
+	ERROR_PRI_MERGE_LOAD_FILE_FAILED                                          syscall.Errno = 15150
+	ERROR_PRI_MERGE_ADD_FILE_FAILED                                           syscall.Errno = 15151
+	ERROR_PRI_MERGE_WRITE_FILE_FAILED                                         syscall.Errno = 15152
+	ERROR_PRI_MERGE_MULTIPLE_PACKAGE_FAMILIES_NOT_ALLOWED                     syscall.Errno = 15153
+	ERROR_PRI_MERGE_MULTIPLE_MAIN_PACKAGES_NOT_ALLOWED                        syscall.Errno = 15154
+	ERROR_PRI_MERGE_BUNDLE_PACKAGES_NOT_ALLOWED                               syscall.Errno = 15155
+	ERROR_PRI_MERGE_MAIN_PACKAGE_REQUIRED                                     syscall.Errno = 15156
+	ERROR_PRI_MERGE_RESOURCE_PACKAGE_REQUIRED                                 syscall.Errno = 15157
+	ERROR_PRI_MERGE_INVALID_FILE_NAME                                         syscall.Errno = 15158
+	ERROR_MRM_PACKAGE_NOT_FOUND                                               syscall.Errno = 15159
+	ERROR_MRM_MISSING_DEFAULT_LANGUAGE                                        syscall.Errno = 15160
+	ERROR_MCA_INVALID_CAPABILITIES_STRING                                     syscall.Errno = 15200
+	ERROR_MCA_INVALID_VCP_VERSION                                             syscall.Errno = 15201
+	ERROR_MCA_MONITOR_VIOLATES_MCCS_SPECIFICATION                             syscall.Errno = 15202
+	ERROR_MCA_MCCS_VERSION_MISMATCH                                           syscall.Errno = 15203
+	ERROR_MCA_UNSUPPORTED_MCCS_VERSION                                        syscall.Errno = 15204
+	ERROR_MCA_INTERNAL_ERROR                                                  syscall.Errno = 15205
+	ERROR_MCA_INVALID_TECHNOLOGY_TYPE_RETURNED                                syscall.Errno = 15206
+	ERROR_MCA_UNSUPPORTED_COLOR_TEMPERATURE                                   syscall.Errno = 15207
+	ERROR_AMBIGUOUS_SYSTEM_DEVICE                                             syscall.Errno = 15250
+	ERROR_SYSTEM_DEVICE_NOT_FOUND                                             syscall.Errno = 15299
+	ERROR_HASH_NOT_SUPPORTED                                                  syscall.Errno = 15300
+	ERROR_HASH_NOT_PRESENT                                                    syscall.Errno = 15301
+	ERROR_SECONDARY_IC_PROVIDER_NOT_REGISTERED                                syscall.Errno = 15321
+	ERROR_GPIO_CLIENT_INFORMATION_INVALID                                     syscall.Errno = 15322
+	ERROR_GPIO_VERSION_NOT_SUPPORTED                                          syscall.Errno = 15323
+	ERROR_GPIO_INVALID_REGISTRATION_PACKET                                    syscall.Errno = 15324
+	ERROR_GPIO_OPERATION_DENIED                                               syscall.Errno = 15325
+	ERROR_GPIO_INCOMPATIBLE_CONNECT_MODE                                      syscall.Errno = 15326
+	ERROR_GPIO_INTERRUPT_ALREADY_UNMASKED                                     syscall.Errno = 15327
+	ERROR_CANNOT_SWITCH_RUNLEVEL                                              syscall.Errno = 15400
+	ERROR_INVALID_RUNLEVEL_SETTING                                            syscall.Errno = 15401
+	ERROR_RUNLEVEL_SWITCH_TIMEOUT                                             syscall.Errno = 15402
+	ERROR_RUNLEVEL_SWITCH_AGENT_TIMEOUT                                       syscall.Errno = 15403
+	ERROR_RUNLEVEL_SWITCH_IN_PROGRESS                                         syscall.Errno = 15404
+	ERROR_SERVICES_FAILED_AUTOSTART                                           syscall.Errno = 15405
+	ERROR_COM_TASK_STOP_PENDING                                               syscall.Errno = 15501
+	ERROR_INSTALL_OPEN_PACKAGE_FAILED                                         syscall.Errno = 15600
+	ERROR_INSTALL_PACKAGE_NOT_FOUND                                           syscall.Errno = 15601
+	ERROR_INSTALL_INVALID_PACKAGE                                             syscall.Errno = 15602
+	ERROR_INSTALL_RESOLVE_DEPENDENCY_FAILED                                   syscall.Errno = 15603
+	ERROR_INSTALL_OUT_OF_DISK_SPACE                                           syscall.Errno = 15604
+	ERROR_INSTALL_NETWORK_FAILURE                                             syscall.Errno = 15605
+	ERROR_INSTALL_REGISTRATION_FAILURE                                        syscall.Errno = 15606
+	ERROR_INSTALL_DEREGISTRATION_FAILURE                                      syscall.Errno = 15607
+	ERROR_INSTALL_CANCEL                                                      syscall.Errno = 15608
+	ERROR_INSTALL_FAILED                                                      syscall.Errno = 15609
+	ERROR_REMOVE_FAILED                                                       syscall.Errno = 15610
+	ERROR_PACKAGE_ALREADY_EXISTS                                              syscall.Errno = 15611
+	ERROR_NEEDS_REMEDIATION                                                   syscall.Errno = 15612
+	ERROR_INSTALL_PREREQUISITE_FAILED                                         syscall.Errno = 15613
+	ERROR_PACKAGE_REPOSITORY_CORRUPTED                                        syscall.Errno = 15614
+	ERROR_INSTALL_POLICY_FAILURE                                              syscall.Errno = 15615
+	ERROR_PACKAGE_UPDATING                                                    syscall.Errno = 15616
+	ERROR_DEPLOYMENT_BLOCKED_BY_POLICY                                        syscall.Errno = 15617
+	ERROR_PACKAGES_IN_USE                                                     syscall.Errno = 15618
+	ERROR_RECOVERY_FILE_CORRUPT                                               syscall.Errno = 15619
+	ERROR_INVALID_STAGED_SIGNATURE                                            syscall.Errno = 15620
+	ERROR_DELETING_EXISTING_APPLICATIONDATA_STORE_FAILED                      syscall.Errno = 15621
+	ERROR_INSTALL_PACKAGE_DOWNGRADE                                           syscall.Errno = 15622
+	ERROR_SYSTEM_NEEDS_REMEDIATION                                            syscall.Errno = 15623
+	ERROR_APPX_INTEGRITY_FAILURE_CLR_NGEN                                     syscall.Errno = 15624
+	ERROR_RESILIENCY_FILE_CORRUPT                                             syscall.Errno = 15625
+	ERROR_INSTALL_FIREWALL_SERVICE_NOT_RUNNING                                syscall.Errno = 15626
+	ERROR_PACKAGE_MOVE_FAILED                                                 syscall.Errno = 15627
+	ERROR_INSTALL_VOLUME_NOT_EMPTY                                            syscall.Errno = 15628
+	ERROR_INSTALL_VOLUME_OFFLINE                                              syscall.Errno = 15629
+	ERROR_INSTALL_VOLUME_CORRUPT                                              syscall.Errno = 15630
+	ERROR_NEEDS_REGISTRATION                                                  syscall.Errno = 15631
+	ERROR_INSTALL_WRONG_PROCESSOR_ARCHITECTURE                                syscall.Errno = 15632
+	ERROR_DEV_SIDELOAD_LIMIT_EXCEEDED                                         syscall.Errno = 15633
+	ERROR_INSTALL_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE                      syscall.Errno = 15634
+	ERROR_PACKAGE_NOT_SUPPORTED_ON_FILESYSTEM                                 syscall.Errno = 15635
+	ERROR_PACKAGE_MOVE_BLOCKED_BY_STREAMING                                   syscall.Errno = 15636
+	ERROR_INSTALL_OPTIONAL_PACKAGE_APPLICATIONID_NOT_UNIQUE                   syscall.Errno = 15637
+	ERROR_PACKAGE_STAGING_ONHOLD                                              syscall.Errno = 15638
+	ERROR_INSTALL_INVALID_RELATED_SET_UPDATE                                  syscall.Errno = 15639
+	ERROR_INSTALL_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE_FULLTRUST_CAPABILITY syscall.Errno = 15640
+	ERROR_DEPLOYMENT_BLOCKED_BY_USER_LOG_OFF                                  syscall.Errno = 15641
+	ERROR_PROVISION_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE_PROVISIONED        syscall.Errno = 15642
+	ERROR_PACKAGES_REPUTATION_CHECK_FAILED                                    syscall.Errno = 15643
+	ERROR_PACKAGES_REPUTATION_CHECK_TIMEDOUT                                  syscall.Errno = 15644
+	ERROR_DEPLOYMENT_OPTION_NOT_SUPPORTED                                     syscall.Errno = 15645
+	ERROR_APPINSTALLER_ACTIVATION_BLOCKED                                     syscall.Errno = 15646
+	ERROR_REGISTRATION_FROM_REMOTE_DRIVE_NOT_SUPPORTED                        syscall.Errno = 15647
+	ERROR_APPX_RAW_DATA_WRITE_FAILED                                          syscall.Errno = 15648
+	ERROR_DEPLOYMENT_BLOCKED_BY_VOLUME_POLICY_PACKAGE                         syscall.Errno = 15649
+	ERROR_DEPLOYMENT_BLOCKED_BY_VOLUME_POLICY_MACHINE                         syscall.Errno = 15650
+	ERROR_DEPLOYMENT_BLOCKED_BY_PROFILE_POLICY                                syscall.Errno = 15651
+	ERROR_DEPLOYMENT_FAILED_CONFLICTING_MUTABLE_PACKAGE_DIRECTORY             syscall.Errno = 15652
+	ERROR_SINGLETON_RESOURCE_INSTALLED_IN_ACTIVE_USER                         syscall.Errno = 15653
+	ERROR_DIFFERENT_VERSION_OF_PACKAGED_SERVICE_INSTALLED                     syscall.Errno = 15654
+	ERROR_SERVICE_EXISTS_AS_NON_PACKAGED_SERVICE                              syscall.Errno = 15655
+	ERROR_PACKAGED_SERVICE_REQUIRES_ADMIN_PRIVILEGES                          syscall.Errno = 15656
+	ERROR_STATE_LOAD_STORE_FAILED                                             syscall.Errno = 15800
+	ERROR_STATE_GET_VERSION_FAILED                                            syscall.Errno = 15801
+	ERROR_STATE_SET_VERSION_FAILED                                            syscall.Errno = 15802
+	ERROR_STATE_STRUCTURED_RESET_FAILED                                       syscall.Errno = 15803
+	ERROR_STATE_OPEN_CONTAINER_FAILED                                         syscall.Errno = 15804
+	ERROR_STATE_CREATE_CONTAINER_FAILED                                       syscall.Errno = 15805
+	ERROR_STATE_DELETE_CONTAINER_FAILED                                       syscall.Errno = 15806
+	ERROR_STATE_READ_SETTING_FAILED                                           syscall.Errno = 15807
+	ERROR_STATE_WRITE_SETTING_FAILED                                          syscall.Errno = 15808
+	ERROR_STATE_DELETE_SETTING_FAILED                                         syscall.Errno = 15809
+	ERROR_STATE_QUERY_SETTING_FAILED                                          syscall.Errno = 15810
+	ERROR_STATE_READ_COMPOSITE_SETTING_FAILED                                 syscall.Errno = 15811
+	ERROR_STATE_WRITE_COMPOSITE_SETTING_FAILED                                syscall.Errno = 15812
+	ERROR_STATE_ENUMERATE_CONTAINER_FAILED                                    syscall.Errno = 15813
+	ERROR_STATE_ENUMERATE_SETTINGS_FAILED                                     syscall.Errno = 15814
+	ERROR_STATE_COMPOSITE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED                   syscall.Errno = 15815
+	ERROR_STATE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED                             syscall.Errno = 15816
+	ERROR_STATE_SETTING_NAME_SIZE_LIMIT_EXCEEDED                              syscall.Errno = 15817
+	ERROR_STATE_CONTAINER_NAME_SIZE_LIMIT_EXCEEDED                            syscall.Errno = 15818
+	ERROR_API_UNAVAILABLE                                                     syscall.Errno = 15841
+)
+
+// ErrorCodeMap contains every Windows system error code
+var ErrorCodeMap = map[uint32]WindowsErrorCode{
+	0:     {0, "SUCCESS", "The operation completed successfully."},
+	1:     {1, "ERROR_INVALID_FUNCTION", "Incorrect function."},
+	2:     {2, "ERROR_FILE_NOT_FOUND", "The system cannot find the file specified."},
+	3:     {3, "ERROR_PATH_NOT_FOUND", "The system cannot find the path specified."},
+	4:     {4, "ERROR_TOO_MANY_OPEN_FILES", "The system cannot open the file."},
+	5:     {5, "ERROR_ACCESS_DENIED", "Access is denied."},
+	6:     {6, "ERROR_INVALID_HANDLE", "The handle is invalid."},
+	7:     {7, "ERROR_ARENA_TRASHED", "The storage control blocks were destroyed."},
+	8:     {8, "ERROR_NOT_ENOUGH_MEMORY", "Not enough memory resources are available to process this command."},
+	9:     {9, "ERROR_INVALID_BLOCK", "The storage control block address is invalid."},
+	10:    {10, "ERROR_BAD_ENVIRONMENT", "The environment is incorrect."},
+	11:    {11, "ERROR_BAD_FORMAT", "An attempt was made to load a program with an incorrect format."},
+	12:    {12, "ERROR_INVALID_ACCESS", "The access code is invalid."},
+	13:    {13, "ERROR_INVALID_DATA", "The data is invalid."},
+	14:    {14, "ERROR_OUTOFMEMORY", "Not enough storage is available to complete this operation."},
+	15:    {15, "ERROR_INVALID_DRIVE", "The system cannot find the drive specified."},
+	16:    {16, "ERROR_CURRENT_DIRECTORY", "The directory cannot be removed."},
+	17:    {17, "ERROR_NOT_SAME_DEVICE", "The system cannot move the file to a different disk drive."},
+	18:    {18, "ERROR_NO_MORE_FILES", "There are no more files."},
+	19:    {19, "ERROR_WRITE_PROTECT", "The media is write protected."},
+	20:    {20, "ERROR_BAD_UNIT", "The system cannot find the device specified."},
+	21:    {21, "ERROR_NOT_READY", "The device is not ready."},
+	22:    {22, "ERROR_BAD_COMMAND", "The device does not recognize the command."},
+	23:    {23, "ERROR_CRC", "Data error (cyclic redundancy check)."},
+	24:    {24, "ERROR_BAD_LENGTH", "The program issued a command but the command length is incorrect."},
+	25:    {25, "ERROR_SEEK", "The drive cannot locate a specific area or track on the disk."},
+	26:    {26, "ERROR_NOT_DOS_DISK", "The specified disk or diskette cannot be accessed."},
+	27:    {27, "ERROR_SECTOR_NOT_FOUND", "The drive cannot find the sector requested."},
+	28:    {28, "ERROR_OUT_OF_PAPER", "The printer is out of paper."},
+	29:    {29, "ERROR_WRITE_FAULT", "The system cannot write to the specified device."},
+	30:    {30, "ERROR_READ_FAULT", "The system cannot read from the specified device."},
+	31:    {31, "ERROR_GEN_FAILURE", "A device attached to the system is not functioning."},
+	32:    {32, "ERROR_SHARING_VIOLATION", "The process cannot access the file because it is being used by another process."},
+	33:    {33, "ERROR_LOCK_VIOLATION", "The process cannot access the file because another process has locked a portion of the file."},
+	34:    {34, "ERROR_WRONG_DISK", "The wrong diskette is in the drive. Insert %2 (Volume Serial Number: %3) into drive %1."},
+	36:    {36, "ERROR_SHARING_BUFFER_EXCEEDED", "Too many files opened for sharing."},
+	38:    {38, "ERROR_HANDLE_EOF", "Reached the end of the file."},
+	39:    {39, "ERROR_HANDLE_DISK_FULL", "The disk is full."},
+	50:    {50, "ERROR_NOT_SUPPORTED", "The request is not supported."},
+	51:    {51, "ERROR_REM_NOT_LIST", "Windows cannot find the network path. Verify that the network path is correct and the destination computer is not busy or turned off."},
+	52:    {52, "ERROR_DUP_NAME", "You were not connected because a duplicate name exists on the network."},
+	53:    {53, "ERROR_BAD_NETPATH", "The network path was not found."},
+	54:    {54, "ERROR_NETWORK_BUSY", "The network is busy."},
+	55:    {55, "ERROR_DEV_NOT_EXIST", "The specified network resource or device is no longer available."},
+	56:    {56, "ERROR_TOO_MANY_CMDS", "The network BIOS command limit has been reached."},
+	57:    {57, "ERROR_ADAP_HDW_ERR", "A network adapter hardware error occurred."},
+	58:    {58, "ERROR_BAD_NET_RESP", "The specified server cannot perform the requested operation."},
+	59:    {59, "ERROR_UNEXP_NET_ERR", "An unexpected network error occurred."},
+	60:    {60, "ERROR_BAD_REM_ADAP", "The remote adapter is not compatible."},
+	61:    {61, "ERROR_PRINTQ_FULL", "The printer queue is full."},
+	62:    {62, "ERROR_NO_SPOOL_SPACE", "Space to store the file waiting to be printed is not available on the server."},
+	63:    {63, "ERROR_PRINT_CANCELLED", "Your file waiting to be printed was deleted."},
+	64:    {64, "ERROR_NETNAME_DELETED", "The specified network name is no longer available."},
+	65:    {65, "ERROR_NETWORK_ACCESS_DENIED", "Network access is denied."},
+	66:    {66, "ERROR_BAD_DEV_TYPE", "The network resource type is not correct."},
+	67:    {67, "ERROR_BAD_NET_NAME", "The network name cannot be found."},
+	68:    {68, "ERROR_TOO_MANY_NAMES", "The name limit for the local computer network adapter card was exceeded."},
+	69:    {69, "ERROR_TOO_MANY_SESS", "The network BIOS session limit was exceeded."},
+	70:    {70, "ERROR_SHARING_PAUSED", "The remote server has been paused or is in the process of being started."},
+	71:    {71, "ERROR_REQ_NOT_ACCEP", "No more connections can be made to this remote computer at this time."},
+	72:    {72, "ERROR_REDIR_PAUSED", "The specified printer or disk device has been paused."},
+	80:    {80, "ERROR_FILE_EXISTS", "The file exists."},
+	82:    {82, "ERROR_CANNOT_MAKE", "The directory or file cannot be created."},
+	83:    {83, "ERROR_FAIL_I24", "Fail on INT 24."},
+	84:    {84, "ERROR_OUT_OF_STRUCTURES", "Storage to process this request is not available."},
+	85:    {85, "ERROR_ALREADY_ASSIGNED", "The local device name is already in use."},
+	86:    {86, "ERROR_INVALID_PASSWORD", "The specified network password is not correct."},
+	87:    {87, "ERROR_INVALID_PARAMETER", "The parameter is incorrect."},
+	88:    {88, "ERROR_NET_WRITE_FAULT", "A write fault occurred on the network."},
+	89:    {89, "ERROR_NO_PROC_SLOTS", "The system cannot start another process at this time."},
+	100:   {100, "ERROR_TOO_MANY_SEMAPHORES", "Cannot create another system semaphore."},
+	101:   {101, "ERROR_EXCL_SEM_ALREADY_OWNED", "The exclusive semaphore is owned by another process."},
+	102:   {102, "ERROR_SEM_IS_SET", "The semaphore is set and cannot be closed."},
+	103:   {103, "ERROR_TOO_MANY_SEM_REQUESTS", "The semaphore cannot be set again."},
+	104:   {104, "ERROR_INVALID_AT_INTERRUPT_TIME", "Cannot request exclusive semaphores at interrupt time."},
+	105:   {105, "ERROR_SEM_OWNER_DIED", "The previous ownership of this semaphore has ended."},
+	106:   {106, "ERROR_SEM_USER_LIMIT", "Insert the diskette for drive %1."},
+	107:   {107, "ERROR_DISK_CHANGE", "The program stopped because an alternate diskette was not inserted."},
+	108:   {108, "ERROR_DRIVE_LOCKED", "The disk is in use or locked by another process."},
+	109:   {109, "ERROR_BROKEN_PIPE", "The pipe has been ended."},
+	110:   {110, "ERROR_OPEN_FAILED", "The system cannot open the device or file specified."},
+	111:   {111, "ERROR_BUFFER_OVERFLOW", "The file name is too long."},
+	112:   {112, "ERROR_DISK_FULL", "There is not enough space on the disk."},
+	113:   {113, "ERROR_NO_MORE_SEARCH_HANDLES", "No more internal file identifiers available."},
+	114:   {114, "ERROR_INVALID_TARGET_HANDLE", "The target internal file identifier is incorrect."},
+	117:   {117, "ERROR_INVALID_CATEGORY", "The IOCTL call made by the application program is not correct."},
+	118:   {118, "ERROR_INVALID_VERIFY_SWITCH", "The verify-on-write switch parameter value is not correct."},
+	119:   {119, "ERROR_BAD_DRIVER_LEVEL", "The system does not support the command requested."},
+	120:   {120, "ERROR_CALL_NOT_IMPLEMENTED", "This function is not supported on this system."},
+	121:   {121, "ERROR_SEM_TIMEOUT", "The semaphore timeout period has expired."},
+	122:   {122, "ERROR_INSUFFICIENT_BUFFER", "The data area passed to a system call is too small."},
+	123:   {123, "ERROR_INVALID_NAME", "The filename, directory name, or volume label syntax is incorrect."},
+	124:   {124, "ERROR_INVALID_LEVEL", "The system call level is not correct."},
+	125:   {125, "ERROR_NO_VOLUME_LABEL", "The disk has no volume label."},
+	126:   {126, "ERROR_MOD_NOT_FOUND", "The specified module could not be found."},
+	127:   {127, "ERROR_PROC_NOT_FOUND", "The specified procedure could not be found."},
+	128:   {128, "ERROR_WAIT_NO_CHILDREN", "There are no child processes to wait for."},
+	129:   {129, "ERROR_CHILD_NOT_COMPLETE", "The %1 application cannot be run in Win32 mode."},
+	130:   {130, "ERROR_DIRECT_ACCESS_HANDLE", "Attempt to use a file handle to an open disk partition for an operation other than raw disk I/O."},
+	131:   {131, "ERROR_NEGATIVE_SEEK", "An attempt was made to move the file pointer before the beginning of the file."},
+	132:   {132, "ERROR_SEEK_ON_DEVICE", "The file pointer cannot be set on the specified device or file."},
+	133:   {133, "ERROR_IS_JOIN_TARGET", "A JOIN or SUBST command cannot be used for a drive that contains previously joined drives."},
+	134:   {134, "ERROR_IS_JOINED", "An attempt was made to use a JOIN or SUBST command on a drive that has already been joined."},
+	135:   {135, "ERROR_IS_SUBSTED", "An attempt was made to use a JOIN or SUBST command on a drive that has already been substituted."},
+	136:   {136, "ERROR_NOT_JOINED", "The system tried to delete the JOIN of a drive that is not joined."},
+	137:   {137, "ERROR_NOT_SUBSTED", "The system tried to delete the substitution of a drive that is not substituted."},
+	138:   {138, "ERROR_JOIN_TO_JOIN", "The system tried to join a drive to a directory on a joined drive."},
+	139:   {139, "ERROR_SUBST_TO_SUBST", "The system tried to substitute a drive to a directory on a substituted drive."},
+	140:   {140, "ERROR_JOIN_TO_SUBST", "The system tried to join a drive to a directory on a substituted drive."},
+	141:   {141, "ERROR_SUBST_TO_JOIN", "The system tried to SUBST a drive to a directory on a joined drive."},
+	142:   {142, "ERROR_BUSY_DRIVE", "The system cannot perform a JOIN or SUBST at this time."},
+	143:   {143, "ERROR_SAME_DRIVE", "The system cannot join or substitute a drive to or for a directory on the same drive."},
+	144:   {144, "ERROR_DIR_NOT_ROOT", "The directory is not a subdirectory of the root directory."},
+	145:   {145, "ERROR_DIR_NOT_EMPTY", "The directory is not empty."},
+	146:   {146, "ERROR_IS_SUBST_PATH", "The path specified is being used in a substitute."},
+	147:   {147, "ERROR_IS_JOIN_PATH", "Not enough resources are available to process this command."},
+	148:   {148, "ERROR_PATH_BUSY", "The path specified cannot be used at this time."},
+	149:   {149, "ERROR_IS_SUBST_TARGET", "An attempt was made to join or substitute a drive for which a directory on the drive is the target of a previous substitute."},
+	150:   {150, "ERROR_SYSTEM_TRACE", "System trace information was not specified in your CONFIG.SYS file, or tracing is disallowed."},
+	151:   {151, "ERROR_INVALID_EVENT_COUNT", "The number of specified semaphore events for DosMuxSemWait is not correct."},
+	152:   {152, "ERROR_TOO_MANY_MUXWAITERS", "DosMuxSemWait did not execute; too many semaphores are already set."},
+	153:   {153, "ERROR_INVALID_LIST_FORMAT", "The DosMuxSemWait list is not correct."},
+	154:   {154, "ERROR_LABEL_TOO_LONG", "The volume label you entered exceeds the label character limit of the target file system."},
+	155:   {155, "ERROR_TOO_MANY_TCBS", "Cannot create another thread."},
+	156:   {156, "ERROR_SIGNAL_REFUSED", "The recipient process has refused the signal."},
+	157:   {157, "ERROR_DISCARDED", "The segment is already discarded and cannot be locked."},
+	158:   {158, "ERROR_NOT_LOCKED", "The segment is already unlocked."},
+	159:   {159, "ERROR_BAD_THREADID_ADDR", "The address for the thread ID is not correct."},
+	160:   {160, "ERROR_BAD_ARGUMENTS", "One or more arguments are not correct."},
+	161:   {161, "ERROR_BAD_PATHNAME", "The specified path is invalid."},
+	162:   {162, "ERROR_SIGNAL_PENDING", "A signal is already pending."},
+	164:   {164, "ERROR_MAX_THRDS_REACHED", "No more threads can be created in the system."},
+	167:   {167, "ERROR_LOCK_FAILED", "Unable to lock a region of a file."},
+	170:   {170, "ERROR_BUSY", "The requested resource is in use."},
+	171:   {171, "ERROR_DEVICE_SUPPORT_IN_PROGRESS", "Device's command support detection is in progress."},
+	173:   {173, "ERROR_CANCEL_VIOLATION", "A lock request was not outstanding for the supplied cancel region."},
+	174:   {174, "ERROR_ATOMIC_LOCKS_NOT_SUPPORTED", "The file system does not support atomic changes to the lock type."},
+	180:   {180, "ERROR_INVALID_SEGMENT_NUMBER", "The system detected a segment number that was not correct."},
+	182:   {182, "ERROR_INVALID_ORDINAL", "The operating system cannot run %1."},
+	183:   {183, "ERROR_ALREADY_EXISTS", "Cannot create a file when that file already exists."},
+	186:   {186, "ERROR_INVALID_FLAG_NUMBER", "The flag passed is not correct."},
+	187:   {187, "ERROR_SEM_NOT_FOUND", "The specified system semaphore name was not found."},
+	188:   {188, "ERROR_INVALID_STARTING_CODESEG", "The operating system cannot run %1."},
+	189:   {189, "ERROR_INVALID_STACKSEG", "The operating system cannot run %1."},
+	190:   {190, "ERROR_INVALID_MODULETYPE", "The operating system cannot run %1."},
+	191:   {191, "ERROR_INVALID_EXE_SIGNATURE", "Cannot run %1 in Win32 mode."},
+	192:   {192, "ERROR_EXE_MARKED_INVALID", "The operating system cannot run %1."},
+	193:   {193, "ERROR_BAD_EXE_FORMAT", "%1 is not a valid Win32 application."},
+	194:   {194, "ERROR_ITERATED_DATA_EXCEEDS_64k", "The operating system cannot run %1."},
+	195:   {195, "ERROR_INVALID_MINALLOCSIZE", "The operating system cannot run %1."},
+	196:   {196, "ERROR_DYNLINK_FROM_INVALID_RING", "The operating system cannot run this application program."},
+	197:   {197, "ERROR_IOPL_NOT_ENABLED", "The operating system is not presently configured to run this application."},
+	198:   {198, "ERROR_INVALID_SEGDPL", "The operating system cannot run %1."},
+	199:   {199, "ERROR_AUTODATASEG_EXCEEDS_64k", "The operating system cannot run this application program."},
+	200:   {200, "ERROR_RING2SEG_MUST_BE_MOVABLE", "The code segment cannot be greater than or equal to 64K."},
+	201:   {201, "ERROR_RELOC_CHAIN_XEEDS_SEGLIM", "The operating system cannot run %1."},
+	202:   {202, "ERROR_INFLOOP_IN_RELOC_CHAIN", "The operating system cannot run %1."},
+	203:   {203, "ERROR_ENVVAR_NOT_FOUND", "The system could not find the environment option that was entered."},
+	205:   {205, "ERROR_NO_SIGNAL_SENT", "No process in the command subtree has a signal handler."},
+	206:   {206, "ERROR_FILENAME_EXCED_RANGE", "The filename or extension is too long."},
+	207:   {207, "ERROR_RING2_STACK_IN_USE", "The ring 2 stack is in use."},
+	208:   {208, "ERROR_META_EXPANSION_TOO_LONG", "The global filename characters, * or ?, are entered incorrectly or too many global filename characters are specified."},
+	209:   {209, "ERROR_INVALID_SIGNAL_NUMBER", "The signal being posted is not correct."},
+	210:   {210, "ERROR_THREAD_1_INACTIVE", "The signal handler cannot be set."},
+	212:   {212, "ERROR_LOCKED", "The segment is locked and cannot be reallocated."},
+	214:   {214, "ERROR_TOO_MANY_MODULES", "Too many dynamic-link modules are attached to this program or dynamic-link module."},
+	215:   {215, "ERROR_NESTING_NOT_ALLOWED", "Cannot nest calls to LoadModule."},
+	216:   {216, "ERROR_EXE_MACHINE_TYPE_MISMATCH", "This version of %1 is not compatible with the version of Windows you're running."},
+	217:   {217, "ERROR_EXE_CANNOT_MODIFY_SIGNED_BINARY", "The image file %1 is signed, unable to modify."},
+	218:   {218, "ERROR_EXE_CANNOT_MODIFY_STRONG_SIGNED_BINARY", "The image file %1 is strong signed, unable to modify."},
+	220:   {220, "ERROR_FILE_CHECKED_OUT", "This file is checked out or locked for editing by another user."},
+	221:   {221, "ERROR_CHECKOUT_REQUIRED", "The file must be checked out before saving changes."},
+	222:   {222, "ERROR_BAD_FILE_TYPE", "The file type being saved or retrieved has been blocked."},
+	223:   {223, "ERROR_FILE_TOO_LARGE", "The file size exceeds the limit allowed and cannot be saved."},
+	224:   {224, "ERROR_FORMS_AUTH_REQUIRED", "Access Denied. Before opening files in this location, you must first add the web site to your trusted sites list."},
+	225:   {225, "ERROR_VIRUS_INFECTED", "Operation did not complete successfully because the file contains a virus or potentially unwanted software."},
+	226:   {226, "ERROR_VIRUS_DELETED", "This file contains a virus or potentially unwanted software and cannot be opened."},
+	229:   {229, "ERROR_PIPE_LOCAL", "The pipe is local."},
+	230:   {230, "ERROR_BAD_PIPE", "The pipe state is invalid."},
+	231:   {231, "ERROR_PIPE_BUSY", "All pipe instances are busy."},
+	232:   {232, "ERROR_NO_DATA", "The pipe is being closed."},
+	233:   {233, "ERROR_PIPE_NOT_CONNECTED", "No process is on the other end of the pipe."},
+	234:   {234, "ERROR_MORE_DATA", "More data is available."},
+	235:   {235, "ERROR_NO_WORK_DONE", "Not all the requested work was done."},
+	240:   {240, "ERROR_VC_DISCONNECTED", "The session was canceled."},
+	254:   {254, "ERROR_INVALID_EA_NAME", "The specified extended attribute name was invalid."},
+	255:   {255, "ERROR_EA_LIST_INCONSISTENT", "The extended attributes are inconsistent."},
+	258:   {258, "WAIT_TIMEOUT", "The wait operation timed out."},
+	259:   {259, "ERROR_NO_MORE_ITEMS", "No more data is available."},
+	266:   {266, "ERROR_CANNOT_COPY", "The copy functions cannot be used."},
+	267:   {267, "ERROR_DIRECTORY", "The directory name is invalid."},
+	275:   {275, "ERROR_EAS_DIDNT_FIT", "The extended attributes did not fit in the buffer."},
+	276:   {276, "ERROR_EA_FILE_CORRUPT", "The extended attribute file on the mounted file system is corrupt."},
+	277:   {277, "ERROR_EA_TABLE_FULL", "The extended attribute table file is full."},
+	278:   {278, "ERROR_INVALID_EA_HANDLE", "The specified extended attribute handle is invalid."},
+	282:   {282, "ERROR_EAS_NOT_SUPPORTED", "The mounted file system does not support extended attributes."},
+	288:   {288, "ERROR_NOT_OWNER", "Attempt to release mutex not owned by caller."},
+	298:   {298, "ERROR_TOO_MANY_POSTS", "Too many posts were made to a semaphore."},
+	299:   {299, "ERROR_PARTIAL_COPY", "Only part of a ReadProcessMemory or WriteProcessMemory request was completed."},
+	300:   {300, "ERROR_OPLOCK_NOT_GRANTED", "The oplock request is denied."},
+	301:   {301, "ERROR_INVALID_OPLOCK_PROTOCOL", "An invalid oplock acknowledgment was received by the system."},
+	302:   {302, "ERROR_DISK_TOO_FRAGMENTED", "The volume is too fragmented to complete this operation."},
+	303:   {303, "ERROR_DELETE_PENDING", "The file cannot be opened because it is in the process of being deleted."},
+	304:   {304, "ERROR_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING", "Short name settings may not be changed on this volume due to the global registry setting."},
+	305:   {305, "ERROR_SHORT_NAMES_NOT_ENABLED_ON_VOLUME", "Short names are not enabled on this volume."},
+	306:   {306, "ERROR_SECURITY_STREAM_IS_INCONSISTENT", "The security stream for the given volume is in an inconsistent state."},
+	307:   {307, "ERROR_INVALID_LOCK_RANGE", "A requested file lock operation cannot be processed due to an invalid byte range."},
+	308:   {308, "ERROR_IMAGE_SUBSYSTEM_NOT_PRESENT", "The subsystem needed to support the image type is not present."},
+	309:   {309, "ERROR_NOTIFICATION_GUID_ALREADY_DEFINED", "The specified file already has a notification GUID associated with it."},
+	310:   {310, "ERROR_INVALID_EXCEPTION_HANDLER", "An invalid exception handler routine has been detected."},
+	311:   {311, "ERROR_DUPLICATE_PRIVILEGES", "Duplicate privileges were specified for the token."},
+	312:   {312, "ERROR_NO_RANGES_PROCESSED", "No ranges for the specified operation were able to be processed."},
+	313:   {313, "ERROR_NOT_ALLOWED_ON_SYSTEM_FILE", "Operation is not allowed on a file system internal file."},
+	314:   {314, "ERROR_DISK_RESOURCES_EXHAUSTED", "The physical resources of this disk have been exhausted."},
+	315:   {315, "ERROR_INVALID_TOKEN", "The token representing the data is invalid."},
+	316:   {316, "ERROR_DEVICE_FEATURE_NOT_SUPPORTED", "The device does not support the command feature."},
+	317:   {317, "ERROR_MR_MID_NOT_FOUND", "The system cannot find message text for message number 0x%1 in the message file for %2."},
+	318:   {318, "ERROR_SCOPE_NOT_FOUND", "The scope specified was not found."},
+	319:   {319, "ERROR_UNDEFINED_SCOPE", "The Central Access Policy specified is not defined on the target machine."},
+	320:   {320, "ERROR_INVALID_CAP", "The Central Access Policy obtained from Active Directory is invalid."},
+	321:   {321, "ERROR_DEVICE_UNREACHABLE", "The device is unreachable."},
+	322:   {322, "ERROR_DEVICE_NO_RESOURCES", "The target device has insufficient resources to complete the operation."},
+	323:   {323, "ERROR_DATA_CHECKSUM_ERROR", "A data integrity checksum error occurred. Data in the file stream is corrupt."},
+	324:   {324, "ERROR_INTERMIXED_KERNEL_EA_OPERATION", "An attempt was made to modify both a KERNEL and normal Extended Attribute (EA) in the same operation."},
+	326:   {326, "ERROR_FILE_LEVEL_TRIM_NOT_SUPPORTED", "Device does not support file-level TRIM."},
+	327:   {327, "ERROR_OFFSET_ALIGNMENT_VIOLATION", "The command specified a data offset that does not align to the device's granularity/alignment."},
+	328:   {328, "ERROR_INVALID_FIELD_IN_PARAMETER_LIST", "The command specified an invalid field in its parameter list."},
+	329:   {329, "ERROR_OPERATION_IN_PROGRESS", "An operation is currently in progress with the device."},
+	330:   {330, "ERROR_BAD_DEVICE_PATH", "An attempt was made to send down the command via an invalid path to the target device."},
+	331:   {331, "ERROR_TOO_MANY_DESCRIPTORS", "The command specified a number of descriptors that exceeded the maximum supported by the device."},
+	332:   {332, "ERROR_SCRUB_DATA_DISABLED", "Scrub is disabled on the specified file."},
+	333:   {333, "ERROR_NOT_REDUNDANT_STORAGE", "The storage device does not provide redundancy."},
+	334:   {334, "ERROR_RESIDENT_FILE_NOT_SUPPORTED", "An operation is not supported on a resident file."},
+	335:   {335, "ERROR_COMPRESSED_FILE_NOT_SUPPORTED", "An operation is not supported on a compressed file."},
+	336:   {336, "ERROR_DIRECTORY_NOT_SUPPORTED", "An operation is not supported on a directory."},
+	337:   {337, "ERROR_NOT_READ_FROM_COPY", "The specified copy of the requested data could not be read."},
+	338:   {338, "ERROR_FT_WRITE_FAILURE", "The operation could not be completed due to a fault tolerance write failure."},
+	339:   {339, "ERROR_FT_DI_SCAN_REQUIRED", "ERROR_FT_DI_SCAN_REQUIRED"},
+	340:   {340, "ERROR_INVALID_KERNEL_INFO_VERSION", "The version of the supplied kernel information is invalid."},
+	341:   {341, "ERROR_INVALID_PEP_INFO_VERSION", "The version of the supplied PEP information is invalid."},
+	342:   {342, "ERROR_OBJECT_NOT_EXTERNALLY_BACKED", "This object is not externally backed by any provider."},
+	343:   {343, "ERROR_EXTERNAL_BACKING_PROVIDER_UNKNOWN", "The external backing provider is not recognized."},
+	344:   {344, "ERROR_COMPRESSION_NOT_BENEFICIAL", "Compressing this object would not save space."},
+	345:   {345, "ERROR_STORAGE_TOPOLOGY_ID_MISMATCH", "ERROR_STORAGE_TOPOLOGY_ID_MISMATCH"},
+	346:   {346, "ERROR_BLOCKED_BY_PARENTAL_CONTROLS", "ERROR_BLOCKED_BY_PARENTAL_CONTROLS"},
+	347:   {347, "ERROR_BLOCK_TOO_MANY_REFERENCES", "ERROR_BLOCK_TOO_MANY_REFERENCES"},
+	348:   {348, "ERROR_MARKED_TO_DISALLOW_WRITES", "ERROR_MARKED_TO_DISALLOW_WRITES"},
+	349:   {349, "ERROR_ENCLAVE_FAILURE", "The requested operation failed due to an enclave failure."},
+	350:   {350, "ERROR_FAIL_NOACTION_REBOOT", "No action was taken as a system reboot is required."},
+	351:   {351, "ERROR_FAIL_SHUTDOWN", "The shutdown operation failed."},
+	352:   {352, "ERROR_FAIL_RESTART", "The restart operation failed."},
+	353:   {353, "ERROR_MAX_SESSIONS_REACHED", "The maximum number of sessions has been reached."},
+	354:   {354, "ERROR_NETWORK_ACCESS_DENIED_EDP", "The network access is denied by the Enterprise Data Protection policy."},
+	355:   {355, "ERROR_DEVICE_HINT_NAME_BUFFER_TOO_SMALL", "The device hint name buffer is too small to receive the remaining name."},
+	356:   {356, "ERROR_EDP_POLICY_DENIES_OPERATION", "The requested operation was blocked as per your company's Enterprise Data Protection policy."},
+	357:   {357, "ERROR_EDP_DPL_POLICY_CANT_BE_SATISFIED", "ERROR_EDP_DPL_POLICY_CANT_BE_SATISFIED"},
+	358:   {358, "ERROR_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT", "ERROR_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT"},
+	359:   {359, "ERROR_DEVICE_IN_MAINTENANCE", "The device is in maintenance mode."},
+	360:   {360, "ERROR_NOT_SUPPORTED_ON_DAX", "This operation is not supported on a DAX volume."},
+	361:   {361, "ERROR_DAX_MAPPING_EXISTS", "The volume has active DAX mappings."},
+	362:   {362, "ERROR_CLOUD_FILE_PROVIDER_NOT_RUNNING", "The cloud file provider is not running."},
+	363:   {363, "ERROR_CLOUD_FILE_METADATA_CORRUPT", "The cloud file metadata is corrupt and unreadable."},
+	364:   {364, "ERROR_CLOUD_FILE_METADATA_TOO_LARGE", "The cloud file metadata is too large."},
+	365:   {365, "ERROR_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE", "The cloud file property is too large."},
+	366:   {366, "ERROR_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH", "The cloud file property is possibly corrupt. The on-disk checksum does not match the computed checksum."},
+	367:   {367, "ERROR_CHILD_PROCESS_BLOCKED", "The process creation has been blocked."},
+	368:   {368, "ERROR_STORAGE_LOST_DATA_PERSISTENCE", "The storage device has lost data or persistence."},
+	369:   {369, "ERROR_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE", "The provider that supports file system virtualization is temporarily unavailable."},
+	370:   {370, "ERROR_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT", "The metadata for file system virtualization is corrupt and unreadable."},
+	371:   {371, "ERROR_FILE_SYSTEM_VIRTUALIZATION_BUSY", "The provider that supports file system virtualization is too busy to complete this operation."},
+	372:   {372, "ERROR_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN", "The provider that supports file system virtualization is unknown."},
+	373:   {373, "ERROR_GDI_HANDLE_LEAK", "GDI handles were potentially leaked by the application."},
+	374:   {374, "ERROR_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS", "The maximum number of cloud file properties has been reached."},
+	375:   {375, "ERROR_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED", "The version of the cloud file property store is not supported."},
+	376:   {376, "ERROR_NOT_A_CLOUD_FILE", "The file is not a cloud file."},
+	377:   {377, "ERROR_CLOUD_FILE_NOT_IN_SYNC", "The file is not in sync with the cloud."},
+	378:   {378, "ERROR_CLOUD_FILE_ALREADY_CONNECTED", "The cloud sync root is already connected with another cloud sync provider."},
+	379:   {379, "ERROR_CLOUD_FILE_NOT_SUPPORTED", "The operation is not supported by the cloud sync provider."},
+	380:   {380, "ERROR_CLOUD_FILE_INVALID_REQUEST", "The cloud operation is invalid."},
+	381:   {381, "ERROR_CLOUD_FILE_READ_ONLY_VOLUME", "The cloud operation is not supported on a read-only volume."},
+	382:   {382, "ERROR_CLOUD_FILE_CONNECTED_PROVIDER_ONLY", "The operation is reserved for a connected cloud sync provider."},
+	383:   {383, "ERROR_CLOUD_FILE_VALIDATION_FAILED", "The cloud sync provider failed to validate the downloaded data."},
+	384:   {384, "ERROR_SMB1_NOT_AVAILABLE", "The SMB client requested a CIFS dialect, but the server does not support it."},
+	385:   {385, "ERROR_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION", "The file system virtualization provider cannot perform the requested operation."},
+	386:   {386, "ERROR_CLOUD_FILE_AUTHENTICATION_FAILED", "The cloud operation was unsuccessful due to an authentication failure."},
+	387:   {387, "ERROR_CLOUD_FILE_INSUFFICIENT_RESOURCES", "The cloud operation was not completed due to insufficient system resources."},
+	388:   {388, "ERROR_CLOUD_FILE_NETWORK_UNAVAILABLE", "The cloud operation was not completed due to a network failure."},
+	389:   {389, "ERROR_CLOUD_FILE_UNSUCCESSFUL", "The cloud operation was unsuccessful."},
+	390:   {390, "ERROR_CLOUD_FILE_NOT_UNDER_SYNC_ROOT", "The operation is only supported on files under a cloud sync root."},
+	391:   {391, "ERROR_CLOUD_FILE_IN_USE", "The operation cannot be performed on cloud files in use."},
+	392:   {392, "ERROR_CLOUD_FILE_PINNED", "The operation cannot be performed on pinned cloud files."},
+	393:   {393, "ERROR_CLOUD_FILE_REQUEST_ABORTED", "The cloud operation was aborted."},
+	394:   {394, "ERROR_CLOUD_FILE_PROPERTY_CORRUPT", "The cloud file's property store is corrupt."},
+	395:   {395, "ERROR_CLOUD_FILE_ACCESS_DENIED", "Access to the cloud file is denied."},
+	396:   {396, "ERROR_CLOUD_FILE_INCOMPATIBLE_HARDLINKS", "The cloud operation cannot be performed on a file with incompatible hardlinks."},
+	397:   {397, "ERROR_CLOUD_FILE_PROPERTY_LOCK_CONFLICT", "The operation failed due to a conflicting cloud file property lock."},
+	398:   {398, "ERROR_CLOUD_FILE_REQUEST_CANCELED", "The cloud operation was canceled by user."},
+	399:   {399, "ERROR_EXTERNAL_SYSKEY_NOT_SUPPORTED", "An externally encrypted syskey has been configured, but the system no longer supports this feature."},
+	400:   {400, "ERROR_THREAD_MODE_ALREADY_BACKGROUND", "The thread is already in background processing mode."},
+	401:   {401, "ERROR_THREAD_MODE_NOT_BACKGROUND", "The thread is not in background processing mode."},
+	402:   {402, "ERROR_PROCESS_MODE_ALREADY_BACKGROUND", "The process is already in background processing mode."},
+	403:   {403, "ERROR_PROCESS_MODE_NOT_BACKGROUND", "The process is not in background processing mode."},
+	404:   {404, "ERROR_CLOUD_FILE_PROVIDER_TERMINATED", "The cloud file provider exited unexpectedly."},
+	405:   {405, "ERROR_NOT_A_CLOUD_SYNC_ROOT", "The file is not a cloud sync root."},
+	406:   {406, "ERROR_FILE_PROTECTED_UNDER_DPL", "The read or write operation to an encrypted file is not supported."},
+	407:   {407, "ERROR_VOLUME_NOT_CLUSTER_ALIGNED", "The volume is not cluster aligned on the disk."},
+	408:   {408, "ERROR_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND", "No physically aligned free space was found on the volume."},
+	409:   {409, "ERROR_APPX_FILE_NOT_ENCRYPTED", "The APPX file can not be accessed because it is not encrypted as expected."},
+	410:   {410, "ERROR_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED", "ERROR_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED"},
+	411:   {411, "ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET", "ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET"},
+	412:   {412, "ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE", "ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE"},
+	413:   {413, "ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER", "ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER"},
+	414:   {414, "ERROR_LINUX_SUBSYSTEM_NOT_PRESENT", "The Windows Subsystem for Linux has not been enabled."},
+	415:   {415, "ERROR_FT_READ_FAILURE", "The operation could not be completed due to a fault tolerance read failure."},
+	416:   {416, "ERROR_STORAGE_RESERVE_ID_INVALID", "The specified storage reserve ID is invalid."},
+	417:   {417, "ERROR_STORAGE_RESERVE_DOES_NOT_EXIST", "The specified storage reserve does not exist."},
+	418:   {418, "ERROR_STORAGE_RESERVE_ALREADY_EXISTS", "The specified storage reserve already exists."},
+	419:   {419, "ERROR_STORAGE_RESERVE_NOT_EMPTY", "The specified storage reserve is not empty."},
+	420:   {420, "ERROR_NOT_A_DAX_VOLUME", "This operation requires a DAX volume."},
+	421:   {421, "ERROR_NOT_DAX_MAPPABLE", "This stream is not DAX mappable."},
+	422:   {422, "ERROR_TIME_SENSITIVE_THREAD", "Time-critical thread is not allowed."},
+	423:   {423, "ERROR_DPL_NOT_SUPPORTED_FOR_USER", "ERROR_DPL_NOT_SUPPORTED_FOR_USER"},
+	424:   {424, "ERROR_CASE_DIFFERING_NAMES_IN_DIR", "The directory contains entries whose names differ only in case."},
+	425:   {425, "ERROR_FILE_NOT_SUPPORTED", "The file cannot be safely opened because it is not supported."},
+	426:   {426, "ERROR_CLOUD_FILE_REQUEST_TIMEOUT", "The cloud operation was not completed before the time-out period expired."},
+	427:   {427, "ERROR_NO_TASK_QUEUE", "A task queue is required for this operation but none is available."},
+	428:   {428, "ERROR_SRC_SRV_DLL_LOAD_FAILED", "Failed loading a valid version of srcsrv.dll."},
+	429:   {429, "ERROR_NOT_SUPPORTED_WITH_BTT", "ERROR_NOT_SUPPORTED_WITH_BTT"},
+	430:   {430, "ERROR_ENCRYPTION_DISABLED", "Encryption is disabled on this volume."},
+	431:   {431, "ERROR_ENCRYPTING_METADATA_DISALLOWED", "Encryption metadata is not allowed to be modified."},
+	432:   {432, "ERROR_CANT_CLEAR_ENCRYPTION_FLAG", "The encryption flag cannot be cleared because the file is encrypted."},
+	433:   {433, "ERROR_NO_SUCH_DEVICE", "A device which does not exist was specified."},
+	450:   {450, "ERROR_CAPAUTHZ_NOT_DEVUNLOCKED", "Neither developer unlocked mode nor side loading mode is enabled on the device."},
+	451:   {451, "ERROR_CAPAUTHZ_CHANGE_TYPE", "Can not change application type during upgrade or re-provision."},
+	452:   {452, "ERROR_CAPAUTHZ_NOT_PROVISIONED", "The application has not been provisioned."},
+	453:   {453, "ERROR_CAPAUTHZ_NOT_AUTHORIZED", "The requested capability can not be authorized for this application."},
+	454:   {454, "ERROR_CAPAUTHZ_NO_POLICY", "There is no capability authorization policy on the device."},
+	455:   {455, "ERROR_CAPAUTHZ_DB_CORRUPTED", "The capability authorization database has been corrupted."},
+	456:   {456, "ERROR_CAPAUTHZ_SCCD_INVALID_CATALOG", "ERROR_CAPAUTHZ_SCCD_INVALID_CATALOG"},
+	457:   {457, "ERROR_CAPAUTHZ_SCCD_NO_AUTH_ENTITY", "ERROR_CAPAUTHZ_SCCD_NO_AUTH_ENTITY"},
+	458:   {458, "ERROR_CAPAUTHZ_SCCD_PARSE_ERROR", "ERROR_CAPAUTHZ_SCCD_PARSE_ERROR"},
+	459:   {459, "ERROR_CAPAUTHZ_SCCD_DEV_MODE_REQUIRED", "ERROR_CAPAUTHZ_SCCD_DEV_MODE_REQUIRED"},
+	460:   {460, "ERROR_CAPAUTHZ_SCCD_NO_CAPABILITY_MATCH", "ERROR_CAPAUTHZ_SCCD_NO_CAPABILITY_MATCH"},
+	480:   {480, "ERROR_PNP_QUERY_REMOVE_DEVICE_TIMEOUT", "The operation timed out waiting for this device to complete a PnP query-remove request due to a potential hang in its device stack."},
+	481:   {481, "ERROR_PNP_QUERY_REMOVE_RELATED_DEVICE_TIMEOUT", "The operation timed out waiting for this device to complete a PnP query-remove request due to a potential hang in the device stack of a related device."},
+	482:   {482, "ERROR_PNP_QUERY_REMOVE_UNRELATED_DEVICE_TIMEOUT", "The operation timed out waiting for this device to complete a PnP query-remove request due to a potential hang in the device stack of an unrelated device."},
+	483:   {483, "ERROR_DEVICE_HARDWARE_ERROR", "The request failed due to a fatal device hardware error."},
+	487:   {487, "ERROR_INVALID_ADDRESS", "Attempt to access invalid address."},
+	1183:  {1183, "ERROR_VRF_CFG_ENABLED", "Driver Verifier Volatile settings cannot be set when CFG and IO are enabled."},
+	1184:  {1184, "ERROR_PARTITION_TERMINATING", "An attempt was made to access a partition that has begun termination."},
+	500:   {500, "ERROR_USER_PROFILE_LOAD", "User profile cannot be loaded."},
+	534:   {534, "ERROR_ARITHMETIC_OVERFLOW", "Arithmetic result exceeded 32 bits."},
+	535:   {535, "ERROR_PIPE_CONNECTED", "There is a process on other end of the pipe."},
+	536:   {536, "ERROR_PIPE_LISTENING", "Waiting for a process to open the other end of the pipe."},
+	537:   {537, "ERROR_VERIFIER_STOP", "Application verifier has found an error in the current process."},
+	538:   {538, "ERROR_ABIOS_ERROR", "An error occurred in the ABIOS subsystem."},
+	539:   {539, "ERROR_WX86_WARNING", "A warning occurred in the WX86 subsystem."},
+	540:   {540, "ERROR_WX86_ERROR", "An error occurred in the WX86 subsystem."},
+	541:   {541, "ERROR_TIMER_NOT_CANCELED", "An attempt was made to cancel or set a timer that has an associated APC and the subject thread is not the thread that originally set the timer with an associated APC routine."},
+	542:   {542, "ERROR_UNWIND", "Unwind exception code."},
+	543:   {543, "ERROR_BAD_STACK", "An invalid or unaligned stack was encountered during an unwind operation."},
+	544:   {544, "ERROR_INVALID_UNWIND_TARGET", "An invalid unwind target was encountered during an unwind operation."},
+	545:   {545, "ERROR_INVALID_PORT_ATTRIBUTES", "Invalid Object Attributes specified to NtCreatePort or invalid Port Attributes specified to NtConnectPort"},
+	546:   {546, "ERROR_PORT_MESSAGE_TOO_LONG", "Length of message passed to NtRequestPort or NtRequestWaitReplyPort was longer than the maximum message allowed by the port."},
+	547:   {547, "ERROR_INVALID_QUOTA_LOWER", "An attempt was made to lower a quota limit below the current usage."},
+	548:   {548, "ERROR_DEVICE_ALREADY_ATTACHED", "An attempt was made to attach to a device that was already attached to another device."},
+	549:   {549, "ERROR_INSTRUCTION_MISALIGNMENT", "An attempt was made to execute an instruction at an unaligned address and the host system does not support unaligned instruction references."},
+	550:   {550, "ERROR_PROFILING_NOT_STARTED", "Profiling not started."},
+	551:   {551, "ERROR_PROFILING_NOT_STOPPED", "Profiling not stopped."},
+	552:   {552, "ERROR_COULD_NOT_INTERPRET", "The passed ACL did not contain the minimum required information."},
+	553:   {553, "ERROR_PROFILING_AT_LIMIT", "The number of active profiling objects is at the maximum and no more may be started."},
+	554:   {554, "ERROR_CANT_WAIT", "Used to indicate that an operation cannot continue without blocking for I/O."},
+	555:   {555, "ERROR_CANT_TERMINATE_SELF", "Indicates that a thread attempted to terminate itself by default (called NtTerminateThread with NULL) and it was the last thread in the current process."},
+	556:   {556, "ERROR_UNEXPECTED_MM_CREATE_ERR", "If an MM error is returned which is not defined in the standard FsRtl filter, it is converted to one of the following errors which is guaranteed to be in the filter. In this case information is lost, however, the filter correctly handles the exception."},
+	557:   {557, "ERROR_UNEXPECTED_MM_MAP_ERROR", "If an MM error is returned which is not defined in the standard FsRtl filter, it is converted to one of the following errors which is guaranteed to be in the filter. In this case information is lost, however, the filter correctly handles the exception."},
+	558:   {558, "ERROR_UNEXPECTED_MM_EXTEND_ERR", "If an MM error is returned which is not defined in the standard FsRtl filter, it is converted to one of the following errors which is guaranteed to be in the filter. In this case information is lost, however, the filter correctly handles the exception."},
+	559:   {559, "ERROR_BAD_FUNCTION_TABLE", "A malformed function table was encountered during an unwind operation."},
+	560:   {560, "ERROR_NO_GUID_TRANSLATION", "Indicates that an attempt was made to assign protection to a file system file or directory and one of the SIDs in the security descriptor could not be translated into a GUID that could be stored by the file system. This causes the protection attempt to fail, which may cause a file creation attempt to fail."},
+	561:   {561, "ERROR_INVALID_LDT_SIZE", "Indicates that an attempt was made to grow an LDT by setting its size, or that the size was not an even number of selectors."},
+	563:   {563, "ERROR_INVALID_LDT_OFFSET", "Indicates that the starting value for the LDT information was not an integral multiple of the selector size."},
+	564:   {564, "ERROR_INVALID_LDT_DESCRIPTOR", "Indicates that the user supplied an invalid descriptor when trying to set up Ldt descriptors."},
+	565:   {565, "ERROR_TOO_MANY_THREADS", "Indicates a process has too many threads to perform the requested action. For example, assignment of a primary token may only be performed when a process has zero or one threads."},
+	566:   {566, "ERROR_THREAD_NOT_IN_PROCESS", "An attempt was made to operate on a thread within a specific process, but the thread specified is not in the process specified."},
+	567:   {567, "ERROR_PAGEFILE_QUOTA_EXCEEDED", "Page file quota was exceeded."},
+	568:   {568, "ERROR_LOGON_SERVER_CONFLICT", "The Netlogon service cannot start because another Netlogon service running in the domain conflicts with the specified role."},
+	569:   {569, "ERROR_SYNCHRONIZATION_REQUIRED", "The SAM database on a Windows Server is significantly out of synchronization with the copy on the Domain Controller. A complete synchronization is required."},
+	570:   {570, "ERROR_NET_OPEN_FAILED", "The NtCreateFile API failed. This error should never be returned to an application, it is a place holder for the Windows Lan Manager Redirector to use in its internal error mapping routines."},
+	571:   {571, "ERROR_IO_PRIVILEGE_FAILED", "{Privilege Failed} The I/O permissions for the process could not be changed."},
+	572:   {572, "ERROR_CONTROL_C_EXIT", "{Application Exit by CTRL+C} The application terminated as a result of a CTRL+C."},
+	573:   {573, "ERROR_MISSING_SYSTEMFILE", "{Missing System File} The required system file %hs is bad or missing."},
+	574:   {574, "ERROR_UNHANDLED_EXCEPTION", "{Application Error} The exception %s (0x%08lx) occurred in the application at location 0x%08lx."},
+	575:   {575, "ERROR_APP_INIT_FAILURE", "{Application Error} The application was unable to start correctly (0x%lx). Click OK to close the application."},
+	576:   {576, "ERROR_PAGEFILE_CREATE_FAILED", "{Unable to Create Paging File} The creation of the paging file %hs failed (%lx). The requested size was %ld."},
+	577:   {577, "ERROR_INVALID_IMAGE_HASH", "Windows cannot verify the digital signature for this file. A recent hardware or software change might have installed a file that is signed incorrectly or damaged, or that might be malicious software from an unknown source."},
+	578:   {578, "ERROR_NO_PAGEFILE", "{No Paging File Specified} No paging file was specified in the system configuration."},
+	579:   {579, "ERROR_ILLEGAL_FLOAT_CONTEXT", "{EXCEPTION} A real-mode application issued a floating-point instruction and floating-point hardware is not present."},
+	580:   {580, "ERROR_NO_EVENT_PAIR", "An event pair synchronization operation was performed using the thread specific client/server event pair object, but no event pair object was associated with the thread."},
+	581:   {581, "ERROR_DOMAIN_CTRLR_CONFIG_ERROR", "A Windows Server has an incorrect configuration."},
+	582:   {582, "ERROR_ILLEGAL_CHARACTER", "An illegal character was encountered. For a multi-byte character set this includes a lead byte without a succeeding trail byte. For the Unicode character set this includes the characters 0xFFFF and 0xFFFE."},
+	583:   {583, "ERROR_UNDEFINED_CHARACTER", "The Unicode character is not defined in the Unicode character set installed on the system."},
+	584:   {584, "ERROR_FLOPPY_VOLUME", "The paging file cannot be created on a floppy diskette."},
+	585:   {585, "ERROR_BIOS_FAILED_TO_CONNECT_INTERRUPT", "The system BIOS failed to connect a system interrupt to the device or bus for which the device is connected."},
+	586:   {586, "ERROR_BACKUP_CONTROLLER", "This operation is only allowed for the Primary Domain Controller of the domain."},
+	587:   {587, "ERROR_MUTANT_LIMIT_EXCEEDED", "An attempt was made to acquire a mutant such that its maximum count would have been exceeded."},
+	588:   {588, "ERROR_FS_DRIVER_REQUIRED", "A volume has been accessed for which a file system driver is required that has not yet been loaded."},
+	589:   {589, "ERROR_CANNOT_LOAD_REGISTRY_FILE", "{Registry File Failure} The registry cannot load the hive (file): %hs or its log or alternate. It is corrupt, absent, or not writable."},
+	590:   {590, "ERROR_DEBUG_ATTACH_FAILED", "{Unexpected Failure in DebugActiveProcess} An unexpected failure occurred while processing a DebugActiveProcess API request. You may choose OK to terminate the process, or Cancel to ignore the error."},
+	591:   {591, "ERROR_SYSTEM_PROCESS_TERMINATED", "{Fatal System Error} The %hs system process terminated unexpectedly with a status of 0x%08x (0x%08x 0x%08x). The system has been shut down."},
+	592:   {592, "ERROR_DATA_NOT_ACCEPTED", "{Data Not Accepted} The TDI client could not handle the data received during an indication."},
+	593:   {593, "ERROR_VDM_HARD_ERROR", "NTVDM encountered a hard error."},
+	594:   {594, "ERROR_DRIVER_CANCEL_TIMEOUT", "{Cancel Timeout} The driver %hs failed to complete a cancelled I/O request in the allotted time."},
+	595:   {595, "ERROR_REPLY_MESSAGE_MISMATCH", "{Reply Message Mismatch} An attempt was made to reply to an LPC message, but the thread specified by the client ID in the message was not waiting on that message."},
+	596:   {596, "ERROR_LOST_WRITEBEHIND_DATA", "{Delayed Write Failed} Windows was unable to save all the data for the file %hs. The data has been lost. This error may be caused by a failure of your computer hardware or network connection. Please try to save this file elsewhere."},
+	597:   {597, "ERROR_CLIENT_SERVER_PARAMETERS_INVALID", "The parameter(s) passed to the server in the client/server shared memory window were invalid. Too much data may have been put in the shared memory window."},
+	598:   {598, "ERROR_NOT_TINY_STREAM", "The stream is not a tiny stream."},
+	599:   {599, "ERROR_STACK_OVERFLOW_READ", "The request must be handled by the stack overflow code."},
+	600:   {600, "ERROR_CONVERT_TO_LARGE", "Internal OFS status codes indicating how an allocation operation is handled. Either it is retried after the containing onode is moved or the extent stream is converted to a large stream."},
+	601:   {601, "ERROR_FOUND_OUT_OF_SCOPE", "The attempt to find the object found an object matching by ID on the volume but it is out of the scope of the handle used for the operation."},
+	602:   {602, "ERROR_ALLOCATE_BUCKET", "The bucket array must be grown. Retry transaction after doing so."},
+	603:   {603, "ERROR_MARSHALL_OVERFLOW", "The user/kernel marshalling buffer has overflowed."},
+	604:   {604, "ERROR_INVALID_VARIANT", "The supplied variant structure contains invalid data."},
+	605:   {605, "ERROR_BAD_COMPRESSION_BUFFER", "The specified buffer contains ill-formed data."},
+	606:   {606, "ERROR_AUDIT_FAILED", "{Audit Failed} An attempt to generate a security audit failed."},
+	607:   {607, "ERROR_TIMER_RESOLUTION_NOT_SET", "The timer resolution was not previously set by the current process."},
+	608:   {608, "ERROR_INSUFFICIENT_LOGON_INFO", "There is insufficient account information to log you on."},
+	609:   {609, "ERROR_BAD_DLL_ENTRYPOINT", "{Invalid DLL Entrypoint} The dynamic link library %hs is not written correctly. The stack pointer has been left in an inconsistent state. The entrypoint should be declared as WINAPI or STDCALL. Select YES to fail the DLL load. Select NO to continue execution. Selecting NO may cause the application to operate incorrectly."},
+	610:   {610, "ERROR_BAD_SERVICE_ENTRYPOINT", "{Invalid Service Callback Entrypoint} The %hs service is not written correctly. The stack pointer has been left in an inconsistent state. The callback entrypoint should be declared as WINAPI or STDCALL. Selecting OK will cause the service to continue operation. However, the service process may operate incorrectly."},
+	611:   {611, "ERROR_IP_ADDRESS_CONFLICT1", "There is an IP address conflict with another system on the network."},
+	612:   {612, "ERROR_IP_ADDRESS_CONFLICT2", "There is an IP address conflict with another system on the network."},
+	613:   {613, "ERROR_REGISTRY_QUOTA_LIMIT", "{Low On Registry Space} The system has reached the maximum size allowed for the system part of the registry. Additional storage requests will be ignored."},
+	614:   {614, "ERROR_NO_CALLBACK_ACTIVE", "A callback return system service cannot be executed when no callback is active."},
+	615:   {615, "ERROR_PWD_TOO_SHORT", "The password provided is too short to meet the policy of your user account. Please provide a longer password."},
+	616:   {616, "ERROR_PWD_TOO_RECENT", "The policy of your user account does not allow you to change passwords too frequently. This is done to prevent users from changing back to a familiar, but potentially discovered, password. If you feel your password has been compromised then please contact your administrator immediately to have a new one assigned."},
+	617:   {617, "ERROR_PWD_HISTORY_CONFLICT", "You have attempted to change your password to one that you have used in the past. The policy of your user account does not allow this. Please select a password that you have not previously used."},
+	618:   {618, "ERROR_UNSUPPORTED_COMPRESSION", "The specified compression format is unsupported."},
+	619:   {619, "ERROR_INVALID_HW_PROFILE", "The specified hardware profile configuration is invalid."},
+	620:   {620, "ERROR_INVALID_PLUGPLAY_DEVICE_PATH", "The specified Plug and Play registry device path is invalid."},
+	621:   {621, "ERROR_QUOTA_LIST_INCONSISTENT", "The specified quota list is internally inconsistent with its descriptor."},
+	622:   {622, "ERROR_EVALUATION_EXPIRATION", "{Windows Evaluation Notification} The evaluation period for this installation of Windows has expired. This system will shutdown in 1 hour. To restore access to this installation of Windows, please upgrade this installation using a licensed distribution of this product."},
+	623:   {623, "ERROR_ILLEGAL_DLL_RELOCATION", "{Illegal System DLL Relocation} The system DLL %hs was relocated in memory. The application will not run properly. The relocation occurred because the DLL %hs occupied an address range reserved for Windows system DLLs. The vendor supplying the DLL should be contacted for a new DLL."},
+	624:   {624, "ERROR_DLL_INIT_FAILED_LOGOFF", "{DLL Initialization Failed} The application failed to initialize because the window station is shutting down."},
+	625:   {625, "ERROR_VALIDATE_CONTINUE", "The validation process needs to continue on to the next step."},
+	626:   {626, "ERROR_NO_MORE_MATCHES", "There are no more matches for the current index enumeration."},
+	627:   {627, "ERROR_RANGE_LIST_CONFLICT", "The range could not be added to the range list because of a conflict."},
+	628:   {628, "ERROR_SERVER_SID_MISMATCH", "The server process is running under a SID different than that required by client."},
+	629:   {629, "ERROR_CANT_ENABLE_DENY_ONLY", "A group marked use for deny only cannot be enabled."},
+	630:   {630, "ERROR_FLOAT_MULTIPLE_FAULTS", "{EXCEPTION} Multiple floating point faults."},
+	631:   {631, "ERROR_FLOAT_MULTIPLE_TRAPS", "{EXCEPTION} Multiple floating point traps."},
+	632:   {632, "ERROR_NOINTERFACE", "The requested interface is not supported."},
+	633:   {633, "ERROR_DRIVER_FAILED_SLEEP", "{System Standby Failed} The driver %hs does not support standby mode. Updating this driver may allow the system to go to standby mode."},
+	634:   {634, "ERROR_CORRUPT_SYSTEM_FILE", "The system file %1 has become corrupt and has been replaced."},
+	635:   {635, "ERROR_COMMITMENT_MINIMUM", "{Virtual Memory Minimum Too Low} Your system is low on virtual memory. Windows is increasing the size of your virtual memory paging file. During this process, memory requests for some applications may be denied. For more information, see Help."},
+	636:   {636, "ERROR_PNP_RESTART_ENUMERATION", "A device was removed so enumeration must be restarted."},
+	637:   {637, "ERROR_SYSTEM_IMAGE_BAD_SIGNATURE", "{Fatal System Error} The system image %s is not properly signed. The file has been replaced with the signed file. The system has been shut down."},
+	638:   {638, "ERROR_PNP_REBOOT_REQUIRED", "Device will not start without a reboot."},
+	639:   {639, "ERROR_INSUFFICIENT_POWER", "There is not enough power to complete the requested operation."},
+	640:   {640, "ERROR_MULTIPLE_FAULT_VIOLATION", "ERROR_MULTIPLE_FAULT_VIOLATION"},
+	641:   {641, "ERROR_SYSTEM_SHUTDOWN", "The system is in the process of shutting down."},
+	642:   {642, "ERROR_PORT_NOT_SET", "An attempt to remove a processes DebugPort was made, but a port was not already associated with the process."},
+	643:   {643, "ERROR_DS_VERSION_CHECK_FAILURE", "This version of Windows is not compatible with the behavior version of directory forest, domain or domain controller."},
+	644:   {644, "ERROR_RANGE_NOT_FOUND", "The specified range could not be found in the range list."},
+	646:   {646, "ERROR_NOT_SAFE_MODE_DRIVER", "The driver was not loaded because the system is booting into safe mode."},
+	647:   {647, "ERROR_FAILED_DRIVER_ENTRY", "The driver was not loaded because it failed its initialization call."},
+	648:   {648, "ERROR_DEVICE_ENUMERATION_ERROR", "The \"%hs\" encountered an error while applying power or reading the device configuration. This may be caused by a failure of your hardware or by a poor connection."},
+	649:   {649, "ERROR_MOUNT_POINT_NOT_RESOLVED", "The create operation failed because the name contained at least one mount point which resolves to a volume to which the specified device object is not attached."},
+	650:   {650, "ERROR_INVALID_DEVICE_OBJECT_PARAMETER", "The device object parameter is either not a valid device object or is not attached to the volume specified by the file name."},
+	651:   {651, "ERROR_MCA_OCCURED", "A Machine Check Error has occurred. Please check the system eventlog for additional information."},
+	652:   {652, "ERROR_DRIVER_DATABASE_ERROR", "There was error [%2] processing the driver database."},
+	653:   {653, "ERROR_SYSTEM_HIVE_TOO_LARGE", "System hive size has exceeded its limit."},
+	654:   {654, "ERROR_DRIVER_FAILED_PRIOR_UNLOAD", "The driver could not be loaded because a previous version of the driver is still in memory."},
+	655:   {655, "ERROR_VOLSNAP_PREPARE_HIBERNATE", "{Volume Shadow Copy Service} Please wait while the Volume Shadow Copy Service prepares volume %hs for hibernation."},
+	656:   {656, "ERROR_HIBERNATION_FAILURE", "The system has failed to hibernate (The error code is %hs). Hibernation will be disabled until the system is restarted."},
+	657:   {657, "ERROR_PWD_TOO_LONG", "The password provided is too long to meet the policy of your user account. Please provide a shorter password."},
+	665:   {665, "ERROR_FILE_SYSTEM_LIMITATION", "The requested operation could not be completed due to a file system limitation."},
+	668:   {668, "ERROR_ASSERTION_FAILURE", "An assertion failure has occurred."},
+	669:   {669, "ERROR_ACPI_ERROR", "An error occurred in the ACPI subsystem."},
+	670:   {670, "ERROR_WOW_ASSERTION", "WOW Assertion Error."},
+	671:   {671, "ERROR_PNP_BAD_MPS_TABLE", "A device is missing in the system BIOS MPS table. This device will not be used. Please contact your system vendor for system BIOS update."},
+	672:   {672, "ERROR_PNP_TRANSLATION_FAILED", "A translator failed to translate resources."},
+	673:   {673, "ERROR_PNP_IRQ_TRANSLATION_FAILED", "A IRQ translator failed to translate resources."},
+	674:   {674, "ERROR_PNP_INVALID_ID", "Driver %2 returned invalid ID for a child device (%3)."},
+	675:   {675, "ERROR_WAKE_SYSTEM_DEBUGGER", "{Kernel Debugger Awakened} the system debugger was awakened by an interrupt."},
+	676:   {676, "ERROR_HANDLES_CLOSED", "{Handles Closed} Handles to objects have been automatically closed as a result of the requested operation."},
+	677:   {677, "ERROR_EXTRANEOUS_INFORMATION", "{Too Much Information} The specified access control list (ACL) contained more information than was expected."},
+	678:   {678, "ERROR_RXACT_COMMIT_NECESSARY", "This warning level status indicates that the transaction state already exists for the registry sub-tree, but that a transaction commit was previously aborted. The commit has NOT been completed, but has not been rolled back either (so it may still be committed if desired)."},
+	679:   {679, "ERROR_MEDIA_CHECK", "{Media Changed} The media may have changed."},
+	680:   {680, "ERROR_GUID_SUBSTITUTION_MADE", "{GUID Substitution} During the translation of a global identifier (GUID) to a Windows security ID (SID), no administratively-defined GUID prefix was found. A substitute prefix was used, which will not compromise system security. However, this may provide a more restrictive access than intended."},
+	681:   {681, "ERROR_STOPPED_ON_SYMLINK", "The create operation stopped after reaching a symbolic link."},
+	682:   {682, "ERROR_LONGJUMP", "A long jump has been executed."},
+	683:   {683, "ERROR_PLUGPLAY_QUERY_VETOED", "The Plug and Play query operation was not successful."},
+	684:   {684, "ERROR_UNWIND_CONSOLIDATE", "A frame consolidation has been executed."},
+	685:   {685, "ERROR_REGISTRY_HIVE_RECOVERED", "{Registry Hive Recovered} Registry hive (file): %hs was corrupted and it has been recovered. Some data might have been lost."},
+	686:   {686, "ERROR_DLL_MIGHT_BE_INSECURE", "The application is attempting to run executable code from the module %hs. This may be insecure. An alternative, %hs, is available. Should the application use the secure module %hs?"},
+	687:   {687, "ERROR_DLL_MIGHT_BE_INCOMPATIBLE", "The application is loading executable code from the module %hs. This is secure, but may be incompatible with previous releases of the operating system. An alternative, %hs, is available. Should the application use the secure module %hs?"},
+	688:   {688, "ERROR_DBG_EXCEPTION_NOT_HANDLED", "Debugger did not handle the exception."},
+	689:   {689, "ERROR_DBG_REPLY_LATER", "Debugger will reply later."},
+	690:   {690, "ERROR_DBG_UNABLE_TO_PROVIDE_HANDLE", "Debugger cannot provide handle."},
+	691:   {691, "ERROR_DBG_TERMINATE_THREAD", "Debugger terminated thread."},
+	692:   {692, "ERROR_DBG_TERMINATE_PROCESS", "Debugger terminated process."},
+	693:   {693, "ERROR_DBG_CONTROL_C", "Debugger got control C."},
+	694:   {694, "ERROR_DBG_PRINTEXCEPTION_C", "Debugger printed exception on control C."},
+	695:   {695, "ERROR_DBG_RIPEXCEPTION", "Debugger received RIP exception."},
+	696:   {696, "ERROR_DBG_CONTROL_BREAK", "Debugger received control break."},
+	697:   {697, "ERROR_DBG_COMMAND_EXCEPTION", "Debugger command communication exception."},
+	698:   {698, "ERROR_OBJECT_NAME_EXISTS", "{Object Exists} An attempt was made to create an object and the object name already existed."},
+	699:   {699, "ERROR_THREAD_WAS_SUSPENDED", "{Thread Suspended} A thread termination occurred while the thread was suspended. The thread was resumed, and termination proceeded."},
+	700:   {700, "ERROR_IMAGE_NOT_AT_BASE", "{Image Relocated} An image file could not be mapped at the address specified in the image file. Local fixups must be performed on this image."},
+	701:   {701, "ERROR_RXACT_STATE_CREATED", "This informational level status indicates that a specified registry sub-tree transaction state did not yet exist and had to be created."},
+	702:   {702, "ERROR_SEGMENT_NOTIFICATION", "A virtual DOS machine (VDM) is loading, unloading, or moving an MS-DOS or Win16 program segment image. An exception is raised so a debugger can load, unload or track symbols and breakpoints within these 16-bit segments."},
+	703:   {703, "ERROR_BAD_CURRENT_DIRECTORY", "{Invalid Current Directory} The process cannot switch to the startup current directory %hs. Select OK to set current directory to %hs, or select CANCEL to exit."},
+	704:   {704, "ERROR_FT_READ_RECOVERY_FROM_BACKUP", "{Redundant Read} To satisfy a read request, the NT fault-tolerant file system successfully read the requested data from a redundant copy. This was done because the file system encountered a failure on a member of the fault-tolerant volume, but was unable to reassign the failing area of the device."},
+	705:   {705, "ERROR_FT_WRITE_RECOVERY", "{Redundant Write} To satisfy a write request, the NT fault-tolerant file system successfully wrote a redundant copy of the information. This was done because the file system encountered a failure on a member of the fault-tolerant volume, but was not able to reassign the failing area of the device."},
+	706:   {706, "ERROR_IMAGE_MACHINE_TYPE_MISMATCH", "{Machine Type Mismatch} The image file %hs is valid, but is for a machine type other than the current machine. Select OK to continue, or CANCEL to fail the DLL load."},
+	707:   {707, "ERROR_RECEIVE_PARTIAL", "{Partial Data Received} The network transport returned partial data to its client. The remaining data will be sent later."},
+	708:   {708, "ERROR_RECEIVE_EXPEDITED", "{Expedited Data Received} The network transport returned data to its client that was marked as expedited by the remote system."},
+	709:   {709, "ERROR_RECEIVE_PARTIAL_EXPEDITED", "{Partial Expedited Data Received} The network transport returned partial data to its client and this data was marked as expedited by the remote system. The remaining data will be sent later."},
+	710:   {710, "ERROR_EVENT_DONE", "{TDI Event Done} The TDI indication has completed successfully."},
+	711:   {711, "ERROR_EVENT_PENDING", "{TDI Event Pending} The TDI indication has entered the pending state."},
+	712:   {712, "ERROR_CHECKING_FILE_SYSTEM", "Checking file system on %wZ."},
+	713:   {713, "ERROR_FATAL_APP_EXIT", "{Fatal Application Exit} %hs"},
+	714:   {714, "ERROR_PREDEFINED_HANDLE", "The specified registry key is referenced by a predefined handle."},
+	715:   {715, "ERROR_WAS_UNLOCKED", "{Page Unlocked} The page protection of a locked page was changed to 'No Access' and the page was unlocked from memory and from the process."},
+	716:   {716, "ERROR_SERVICE_NOTIFICATION", "%hs"},
+	717:   {717, "ERROR_WAS_LOCKED", "{Page Locked} One of the pages to lock was already locked."},
+	718:   {718, "ERROR_LOG_HARD_ERROR", "Application popup: %1 : %2"},
+	719:   {719, "ERROR_ALREADY_WIN32", "ERROR_ALREADY_WIN32"},
+	720:   {720, "ERROR_IMAGE_MACHINE_TYPE_MISMATCH_EXE", "{Machine Type Mismatch} The image file %hs is valid, but is for a machine type other than the current machine."},
+	721:   {721, "ERROR_NO_YIELD_PERFORMED", "A yield execution was performed and no thread was available to run."},
+	722:   {722, "ERROR_TIMER_RESUME_IGNORED", "The resumable flag to a timer API was ignored."},
+	723:   {723, "ERROR_ARBITRATION_UNHANDLED", "The arbiter has deferred arbitration of these resources to its parent."},
+	724:   {724, "ERROR_CARDBUS_NOT_SUPPORTED", "The inserted CardBus device cannot be started because of a configuration error on \"%hs\"."},
+	725:   {725, "ERROR_MP_PROCESSOR_MISMATCH", "The CPUs in this multiprocessor system are not all the same revision level. To use all processors the operating system restricts itself to the features of the least capable processor in the system. Should problems occur with this system, contact the CPU manufacturer to see if this mix of processors is supported."},
+	726:   {726, "ERROR_HIBERNATED", "The system was put into hibernation."},
+	727:   {727, "ERROR_RESUME_HIBERNATION", "The system was resumed from hibernation."},
+	728:   {728, "ERROR_FIRMWARE_UPDATED", "Windows has detected that the system firmware (BIOS) was updated [previous firmware date = %2, current firmware date %3]."},
+	729:   {729, "ERROR_DRIVERS_LEAKING_LOCKED_PAGES", "A device driver is leaking locked I/O pages causing system degradation. The system has automatically enabled tracking code in order to try and catch the culprit."},
+	730:   {730, "ERROR_WAKE_SYSTEM", "The system has awoken."},
+	731:   {731, "ERROR_WAIT_1", "ERROR_WAIT_1"},
+	732:   {732, "ERROR_WAIT_2", "ERROR_WAIT_2"},
+	733:   {733, "ERROR_WAIT_3", "ERROR_WAIT_3"},
+	734:   {734, "ERROR_WAIT_63", "ERROR_WAIT_63"},
+	735:   {735, "ERROR_ABANDONED_WAIT_0", "ERROR_ABANDONED_WAIT_0"},
+	736:   {736, "ERROR_ABANDONED_WAIT_63", "ERROR_ABANDONED_WAIT_63"},
+	737:   {737, "ERROR_USER_APC", "ERROR_USER_APC"},
+	738:   {738, "ERROR_KERNEL_APC", "ERROR_KERNEL_APC"},
+	739:   {739, "ERROR_ALERTED", "ERROR_ALERTED"},
+	740:   {740, "ERROR_ELEVATION_REQUIRED", "The requested operation requires elevation."},
+	741:   {741, "ERROR_REPARSE", "A reparse should be performed by the Object Manager since the name of the file resulted in a symbolic link."},
+	742:   {742, "ERROR_OPLOCK_BREAK_IN_PROGRESS", "An open/create operation completed while an oplock break is underway."},
+	743:   {743, "ERROR_VOLUME_MOUNTED", "A new volume has been mounted by a file system."},
+	744:   {744, "ERROR_RXACT_COMMITTED", "This success level status indicates that the transaction state already exists for the registry sub-tree, but that a transaction commit was previously aborted. The commit has now been completed."},
+	745:   {745, "ERROR_NOTIFY_CLEANUP", "This indicates that a notify change request has been completed due to closing the handle which made the notify change request."},
+	746:   {746, "ERROR_PRIMARY_TRANSPORT_CONNECT_FAILED", "{Connect Failure on Primary Transport} An attempt was made to connect to the remote server %hs on the primary transport, but the connection failed. The computer WAS able to connect on a secondary transport."},
+	747:   {747, "ERROR_PAGE_FAULT_TRANSITION", "Page fault was a transition fault."},
+	748:   {748, "ERROR_PAGE_FAULT_DEMAND_ZERO", "Page fault was a demand zero fault."},
+	749:   {749, "ERROR_PAGE_FAULT_COPY_ON_WRITE", "Page fault was a demand zero fault."},
+	750:   {750, "ERROR_PAGE_FAULT_GUARD_PAGE", "Page fault was a demand zero fault."},
+	751:   {751, "ERROR_PAGE_FAULT_PAGING_FILE", "Page fault was satisfied by reading from a secondary storage device."},
+	752:   {752, "ERROR_CACHE_PAGE_LOCKED", "Cached page was locked during operation."},
+	753:   {753, "ERROR_CRASH_DUMP", "Crash dump exists in paging file."},
+	754:   {754, "ERROR_BUFFER_ALL_ZEROS", "Specified buffer contains all zeros."},
+	755:   {755, "ERROR_REPARSE_OBJECT", "A reparse should be performed by the Object Manager since the name of the file resulted in a symbolic link."},
+	756:   {756, "ERROR_RESOURCE_REQUIREMENTS_CHANGED", "The device has succeeded a query-stop and its resource requirements have changed."},
+	757:   {757, "ERROR_TRANSLATION_COMPLETE", "The translator has translated these resources into the global space and no further translations should be performed."},
+	758:   {758, "ERROR_NOTHING_TO_TERMINATE", "A process being terminated has no threads to terminate."},
+	759:   {759, "ERROR_PROCESS_NOT_IN_JOB", "The specified process is not part of a job."},
+	760:   {760, "ERROR_PROCESS_IN_JOB", "The specified process is part of a job."},
+	761:   {761, "ERROR_VOLSNAP_HIBERNATE_READY", "{Volume Shadow Copy Service} The system is now ready for hibernation."},
+	762:   {762, "ERROR_FSFILTER_OP_COMPLETED_SUCCESSFULLY", "A file system or file system filter driver has successfully completed an FsFilter operation."},
+	763:   {763, "ERROR_INTERRUPT_VECTOR_ALREADY_CONNECTED", "The specified interrupt vector was already connected."},
+	764:   {764, "ERROR_INTERRUPT_STILL_CONNECTED", "The specified interrupt vector is still connected."},
+	765:   {765, "ERROR_WAIT_FOR_OPLOCK", "An operation is blocked waiting for an oplock."},
+	766:   {766, "ERROR_DBG_EXCEPTION_HANDLED", "Debugger handled exception."},
+	767:   {767, "ERROR_DBG_CONTINUE", "Debugger continued."},
+	768:   {768, "ERROR_CALLBACK_POP_STACK", "An exception occurred in a user mode callback and the kernel callback frame should be removed."},
+	769:   {769, "ERROR_COMPRESSION_DISABLED", "Compression is disabled for this volume."},
+	770:   {770, "ERROR_CANTFETCHBACKWARDS", "The data provider cannot fetch backwards through a result set."},
+	771:   {771, "ERROR_CANTSCROLLBACKWARDS", "The data provider cannot scroll backwards through a result set."},
+	772:   {772, "ERROR_ROWSNOTRELEASED", "The data provider requires that previously fetched data is released before asking for more data."},
+	773:   {773, "ERROR_BAD_ACCESSOR_FLAGS", "The data provider was not able to interpret the flags set for a column binding in an accessor."},
+	774:   {774, "ERROR_ERRORS_ENCOUNTERED", "One or more errors occurred while processing the request."},
+	775:   {775, "ERROR_NOT_CAPABLE", "The implementation is not capable of performing the request."},
+	776:   {776, "ERROR_REQUEST_OUT_OF_SEQUENCE", "The client of a component requested an operation which is not valid given the state of the component instance."},
+	777:   {777, "ERROR_VERSION_PARSE_ERROR", "A version number could not be parsed."},
+	778:   {778, "ERROR_BADSTARTPOSITION", "The iterator's start position is invalid."},
+	779:   {779, "ERROR_MEMORY_HARDWARE", "The hardware has reported an uncorrectable memory error."},
+	780:   {780, "ERROR_DISK_REPAIR_DISABLED", "The attempted operation required self healing to be enabled."},
+	781:   {781, "ERROR_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE", "The Desktop heap encountered an error while allocating session memory. There is more information in the system event log."},
+	782:   {782, "ERROR_SYSTEM_POWERSTATE_TRANSITION", "The system power state is transitioning from %2 to %3."},
+	783:   {783, "ERROR_SYSTEM_POWERSTATE_COMPLEX_TRANSITION", "The system power state is transitioning from %2 to %3 but could enter %4."},
+	784:   {784, "ERROR_MCA_EXCEPTION", "A thread is getting dispatched with MCA EXCEPTION because of MCA."},
+	785:   {785, "ERROR_ACCESS_AUDIT_BY_POLICY", "Access to %1 is monitored by policy rule %2."},
+	786:   {786, "ERROR_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY", "Access to %1 has been restricted by your Administrator by policy rule %2."},
+	787:   {787, "ERROR_ABANDON_HIBERFILE", "A valid hibernation file has been invalidated and should be abandoned."},
+	788:   {788, "ERROR_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED", "{Delayed Write Failed} Windows was unable to save all the data for the file %hs; the data has been lost. This error may be caused by network connectivity issues. Please try to save this file elsewhere."},
+	789:   {789, "ERROR_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR", "{Delayed Write Failed} Windows was unable to save all the data for the file %hs; the data has been lost. This error was returned by the server on which the file exists. Please try to save this file elsewhere."},
+	790:   {790, "ERROR_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR", "{Delayed Write Failed} Windows was unable to save all the data for the file %hs; the data has been lost. This error may be caused if the device has been removed or the media is write-protected."},
+	791:   {791, "ERROR_BAD_MCFG_TABLE", "The resources required for this device conflict with the MCFG table."},
+	792:   {792, "ERROR_DISK_REPAIR_REDIRECTED", "The volume repair could not be performed while it is online. Please schedule to take the volume offline so that it can be repaired."},
+	793:   {793, "ERROR_DISK_REPAIR_UNSUCCESSFUL", "The volume repair was not successful."},
+	794:   {794, "ERROR_CORRUPT_LOG_OVERFULL", "One of the volume corruption logs is full. Further corruptions that may be detected won't be logged."},
+	795:   {795, "ERROR_CORRUPT_LOG_CORRUPTED", "One of the volume corruption logs is internally corrupted and needs to be recreated. The volume may contain undetected corruptions and must be scanned."},
+	796:   {796, "ERROR_CORRUPT_LOG_UNAVAILABLE", "One of the volume corruption logs is unavailable for being operated on."},
+	797:   {797, "ERROR_CORRUPT_LOG_DELETED_FULL", "One of the volume corruption logs was deleted while still having corruption records in them. The volume contains detected corruptions and must be scanned."},
+	798:   {798, "ERROR_CORRUPT_LOG_CLEARED", "One of the volume corruption logs was cleared by chkdsk and no longer contains real corruptions."},
+	799:   {799, "ERROR_ORPHAN_NAME_EXHAUSTED", "Orphaned files exist on the volume but could not be recovered because no more new names could be created in the recovery directory. Files must be moved from the recovery directory."},
+	800:   {800, "ERROR_OPLOCK_SWITCHED_TO_NEW_HANDLE", "The oplock that was associated with this handle is now associated with a different handle."},
+	801:   {801, "ERROR_CANNOT_GRANT_REQUESTED_OPLOCK", "An oplock of the requested level cannot be granted. An oplock of a lower level may be available."},
+	802:   {802, "ERROR_CANNOT_BREAK_OPLOCK", "The operation did not complete successfully because it would cause an oplock to be broken. The caller has requested that existing oplocks not be broken."},
+	803:   {803, "ERROR_OPLOCK_HANDLE_CLOSED", "The handle with which this oplock was associated has been closed. The oplock is now broken."},
+	804:   {804, "ERROR_NO_ACE_CONDITION", "The specified access control entry (ACE) does not contain a condition."},
+	805:   {805, "ERROR_INVALID_ACE_CONDITION", "The specified access control entry (ACE) contains an invalid condition."},
+	806:   {806, "ERROR_FILE_HANDLE_REVOKED", "Access to the specified file handle has been revoked."},
+	807:   {807, "ERROR_IMAGE_AT_DIFFERENT_BASE", "{Image Relocated} An image file was mapped at a different address from the one specified in the image file but fixups will still be automatically performed on the image."},
+	808:   {808, "ERROR_ENCRYPTED_IO_NOT_POSSIBLE", "The read or write operation to an encrypted file could not be completed because the file has not been opened for data access."},
+	809:   {809, "ERROR_FILE_METADATA_OPTIMIZATION_IN_PROGRESS", "File metadata optimization is already in progress."},
+	810:   {810, "ERROR_QUOTA_ACTIVITY", "The requested operation failed due to quota operation is still in progress."},
+	811:   {811, "ERROR_HANDLE_REVOKED", "Access to the specified handle has been revoked."},
+	812:   {812, "ERROR_CALLBACK_INVOKE_INLINE", "The callback function must be invoked inline."},
+	813:   {813, "ERROR_CPU_SET_INVALID", "The specified CPU Set IDs are invalid."},
+	814:   {814, "ERROR_ENCLAVE_NOT_TERMINATED", "The specified enclave has not yet been terminated."},
+	815:   {815, "ERROR_ENCLAVE_VIOLATION", "An attempt was made to access protected memory in violation of its secure access policy."},
+	994:   {994, "ERROR_EA_ACCESS_DENIED", "Access to the extended attribute was denied."},
+	995:   {995, "ERROR_OPERATION_ABORTED", "The I/O operation has been aborted because of either a thread exit or an application request."},
+	996:   {996, "ERROR_IO_INCOMPLETE", "Overlapped I/O event is not in a signaled state."},
+	997:   {997, "ERROR_IO_PENDING", "Overlapped I/O operation is in progress."},
+	998:   {998, "ERROR_NOACCESS", "Invalid access to memory location."},
+	999:   {999, "ERROR_SWAPERROR", "Error performing inpage operation."},
+	1001:  {1001, "ERROR_STACK_OVERFLOW", "Recursion too deep; the stack overflowed."},
+	1002:  {1002, "ERROR_INVALID_MESSAGE", "The window cannot act on the sent message."},
+	1003:  {1003, "ERROR_CAN_NOT_COMPLETE", "Cannot complete this function."},
+	1004:  {1004, "ERROR_INVALID_FLAGS", "Invalid flags."},
+	1005:  {1005, "ERROR_UNRECOGNIZED_VOLUME", "The volume does not contain a recognized file system. Please make sure that all required file system drivers are loaded and that the volume is not corrupted."},
+	1006:  {1006, "ERROR_FILE_INVALID", "The volume for a file has been externally altered so that the opened file is no longer valid."},
+	1007:  {1007, "ERROR_FULLSCREEN_MODE", "The requested operation cannot be performed in full-screen mode."},
+	1008:  {1008, "ERROR_NO_TOKEN", "An attempt was made to reference a token that does not exist."},
+	1009:  {1009, "ERROR_BADDB", "The configuration registry database is corrupt."},
+	1010:  {1010, "ERROR_BADKEY", "The configuration registry key is invalid."},
+	1011:  {1011, "ERROR_CANTOPEN", "The configuration registry key could not be opened."},
+	1012:  {1012, "ERROR_CANTREAD", "The configuration registry key could not be read."},
+	1013:  {1013, "ERROR_CANTWRITE", "The configuration registry key could not be written."},
+	1014:  {1014, "ERROR_REGISTRY_RECOVERED", "One of the files in the registry database had to be recovered by use of a log or alternate copy. The recovery was successful."},
+	1015:  {1015, "ERROR_REGISTRY_CORRUPT", "The registry is corrupted. The structure of one of the files containing registry data is corrupted, or the system's memory image of the file is corrupted, or the file could not be recovered because the alternate copy or log was absent or corrupted."},
+	1016:  {1016, "ERROR_REGISTRY_IO_FAILED", "An I/O operation initiated by the registry failed unrecoverably. The registry could not read in, or write out, or flush, one of the files that contain the system's image of the registry."},
+	1017:  {1017, "ERROR_NOT_REGISTRY_FILE", "The system has attempted to load or restore a file into the registry, but the specified file is not in a registry file format."},
+	1018:  {1018, "ERROR_KEY_DELETED", "Illegal operation attempted on a registry key that has been marked for deletion."},
+	1019:  {1019, "ERROR_NO_LOG_SPACE", "System could not allocate the required space in a registry log."},
+	1020:  {1020, "ERROR_KEY_HAS_CHILDREN", "Cannot create a symbolic link in a registry key that already has subkeys or values."},
+	1021:  {1021, "ERROR_CHILD_MUST_BE_VOLATILE", "Cannot create a stable subkey under a volatile parent key."},
+	1022:  {1022, "ERROR_NOTIFY_ENUM_DIR", "A notify change request is being completed and the information is not being returned in the caller's buffer. The caller now needs to enumerate the files to find the changes."},
+	1051:  {1051, "ERROR_DEPENDENT_SERVICES_RUNNING", "A stop control has been sent to a service that other running services are dependent on."},
+	1052:  {1052, "ERROR_INVALID_SERVICE_CONTROL", "The requested control is not valid for this service."},
+	1053:  {1053, "ERROR_SERVICE_REQUEST_TIMEOUT", "The service did not respond to the start or control request in a timely fashion."},
+	1054:  {1054, "ERROR_SERVICE_NO_THREAD", "A thread could not be created for the service."},
+	1055:  {1055, "ERROR_SERVICE_DATABASE_LOCKED", "The service database is locked."},
+	1056:  {1056, "ERROR_SERVICE_ALREADY_RUNNING", "An instance of the service is already running."},
+	1057:  {1057, "ERROR_INVALID_SERVICE_ACCOUNT", "The account name is invalid or does not exist, or the password is invalid for the account name specified."},
+	1058:  {1058, "ERROR_SERVICE_DISABLED", "The service cannot be started, either because it is disabled or because it has no enabled devices associated with it."},
+	1059:  {1059, "ERROR_CIRCULAR_DEPENDENCY", "Circular service dependency was specified."},
+	1060:  {1060, "ERROR_SERVICE_DOES_NOT_EXIST", "The specified service does not exist as an installed service."},
+	1061:  {1061, "ERROR_SERVICE_CANNOT_ACCEPT_CTRL", "The service cannot accept control messages at this time."},
+	1062:  {1062, "ERROR_SERVICE_NOT_ACTIVE", "The service has not been started."},
+	1063:  {1063, "ERROR_FAILED_SERVICE_CONTROLLER_CONNECT", "The service process could not connect to the service controller."},
+	1064:  {1064, "ERROR_EXCEPTION_IN_SERVICE", "An exception occurred in the service when handling the control request."},
+	1065:  {1065, "ERROR_DATABASE_DOES_NOT_EXIST", "The database specified does not exist."},
+	1066:  {1066, "ERROR_SERVICE_SPECIFIC_ERROR", "The service has returned a service-specific error code."},
+	1067:  {1067, "ERROR_PROCESS_ABORTED", "The process terminated unexpectedly."},
+	1068:  {1068, "ERROR_SERVICE_DEPENDENCY_FAIL", "The dependency service or group failed to start."},
+	1069:  {1069, "ERROR_SERVICE_LOGON_FAILED", "The service did not start due to a logon failure."},
+	1070:  {1070, "ERROR_SERVICE_START_HANG", "After starting, the service hung in a start-pending state."},
+	1071:  {1071, "ERROR_INVALID_SERVICE_LOCK", "The specified service database lock is invalid."},
+	1072:  {1072, "ERROR_SERVICE_MARKED_FOR_DELETE", "The specified service has been marked for deletion."},
+	1073:  {1073, "ERROR_SERVICE_EXISTS", "The specified service already exists."},
+	1074:  {1074, "ERROR_ALREADY_RUNNING_LKG", "The system is currently running with the last-known-good configuration."},
+	1075:  {1075, "ERROR_SERVICE_DEPENDENCY_DELETED", "The dependency service does not exist or has been marked for deletion."},
+	1076:  {1076, "ERROR_BOOT_ALREADY_ACCEPTED", "The current boot has already been accepted for use as the last-known-good control set."},
+	1077:  {1077, "ERROR_SERVICE_NEVER_STARTED", "No attempts to start the service have been made since the last boot."},
+	1078:  {1078, "ERROR_DUPLICATE_SERVICE_NAME", "The name is already in use as either a service name or a service display name."},
+	1079:  {1079, "ERROR_DIFFERENT_SERVICE_ACCOUNT", "The account specified for this service is different from the account specified for other services running in the same process."},
+	1080:  {1080, "ERROR_CANNOT_DETECT_DRIVER_FAILURE", "Failure actions can only be set for Win32 services, not for drivers."},
+	1081:  {1081, "ERROR_CANNOT_DETECT_PROCESS_ABORT", "This service runs in the same process as the service control manager. Therefore, the service control manager cannot take action if this service's process terminates unexpectedly."},
+	1082:  {1082, "ERROR_NO_RECOVERY_PROGRAM", "No recovery program has been configured for this service."},
+	1083:  {1083, "ERROR_SERVICE_NOT_IN_EXE", "The executable program that this service is configured to run in does not implement the service."},
+	1084:  {1084, "ERROR_NOT_SAFEBOOT_SERVICE", "This service cannot be started in Safe Mode."},
+	1100:  {1100, "ERROR_END_OF_MEDIA", "The physical end of the tape has been reached."},
+	1101:  {1101, "ERROR_FILEMARK_DETECTED", "A tape access reached a filemark."},
+	1102:  {1102, "ERROR_BEGINNING_OF_MEDIA", "The beginning of the tape or a partition was encountered."},
+	1103:  {1103, "ERROR_SETMARK_DETECTED", "A tape access reached the end of a set of files."},
+	1104:  {1104, "ERROR_NO_DATA_DETECTED", "No more data is on the tape."},
+	1105:  {1105, "ERROR_PARTITION_FAILURE", "Tape could not be partitioned."},
+	1106:  {1106, "ERROR_INVALID_BLOCK_LENGTH", "When accessing a new tape of a multivolume partition, the current block size is incorrect."},
+	1107:  {1107, "ERROR_DEVICE_NOT_PARTITIONED", "Tape partition information could not be found when loading a tape."},
+	1108:  {1108, "ERROR_UNABLE_TO_LOCK_MEDIA", "Unable to lock the media eject mechanism."},
+	1109:  {1109, "ERROR_UNABLE_TO_UNLOAD_MEDIA", "Unable to unload the media."},
+	1110:  {1110, "ERROR_MEDIA_CHANGED", "The media in the drive may have changed."},
+	1111:  {1111, "ERROR_BUS_RESET", "The I/O bus was reset."},
+	1112:  {1112, "ERROR_NO_MEDIA_IN_DRIVE", "No media in drive."},
+	1113:  {1113, "ERROR_NO_UNICODE_TRANSLATION", "No mapping for the Unicode character exists in the target multi-byte code page."},
+	1114:  {1114, "ERROR_DLL_INIT_FAILED", "A dynamic link library (DLL) initialization routine failed."},
+	1115:  {1115, "ERROR_SHUTDOWN_IN_PROGRESS", "A system shutdown is in progress."},
+	1116:  {1116, "ERROR_NO_SHUTDOWN_IN_PROGRESS", "Unable to abort the system shutdown because no shutdown was in progress."},
+	1117:  {1117, "ERROR_IO_DEVICE", "The request could not be performed because of an I/O device error."},
+	1118:  {1118, "ERROR_SERIAL_NO_DEVICE", "No serial device was successfully initialized. The serial driver will unload."},
+	1119:  {1119, "ERROR_IRQ_BUSY", "Unable to open a device that was sharing an interrupt request (IRQ) with other devices. At least one other device that uses that IRQ was already opened."},
+	1120:  {1120, "ERROR_MORE_WRITES", "A serial I/O operation was completed by another write to the serial port. The IOCTL_SERIAL_XOFF_COUNTER reached zero."},
+	1121:  {1121, "ERROR_COUNTER_TIMEOUT", "A serial I/O operation completed because the timeout period expired. The IOCTL_SERIAL_XOFF_COUNTER did not reach zero."},
+	1122:  {1122, "ERROR_FLOPPY_ID_MARK_NOT_FOUND", "No ID address mark was found on the floppy disk."},
+	1123:  {1123, "ERROR_FLOPPY_WRONG_CYLINDER", "Mismatch between the floppy disk sector ID field and the floppy disk controller track address."},
+	1124:  {1124, "ERROR_FLOPPY_UNKNOWN_ERROR", "The floppy disk controller reported an error that is not recognized by the floppy disk driver."},
+	1125:  {1125, "ERROR_FLOPPY_BAD_REGISTERS", "The floppy disk controller returned inconsistent results in its registers."},
+	1126:  {1126, "ERROR_DISK_RECALIBRATE_FAILED", "While accessing the hard disk, a recalibrate operation failed, even after retries."},
+	1127:  {1127, "ERROR_DISK_OPERATION_FAILED", "While accessing the hard disk, a disk operation failed even after retries."},
+	1128:  {1128, "ERROR_DISK_RESET_FAILED", "While accessing the hard disk, a disk controller reset was needed, but even that failed."},
+	1129:  {1129, "ERROR_EOM_OVERFLOW", "Physical end of tape encountered."},
+	1130:  {1130, "ERROR_NOT_ENOUGH_SERVER_MEMORY", "Not enough server memory resources are available to process this command."},
+	1131:  {1131, "ERROR_POSSIBLE_DEADLOCK", "A potential deadlock condition has been detected."},
+	1132:  {1132, "ERROR_MAPPED_ALIGNMENT", "The base address or the file offset specified does not have the proper alignment."},
+	1140:  {1140, "ERROR_SET_POWER_STATE_VETOED", "An attempt to change the system power state was vetoed by another application or driver."},
+	1141:  {1141, "ERROR_SET_POWER_STATE_FAILED", "The system BIOS failed an attempt to change the system power state."},
+	1142:  {1142, "ERROR_TOO_MANY_LINKS", "An attempt was made to create more links on a file than the file system supports."},
+	1150:  {1150, "ERROR_OLD_WIN_VERSION", "The specified program requires a newer version of Windows."},
+	1151:  {1151, "ERROR_APP_WRONG_OS", "The specified program is not a Windows or MS-DOS program."},
+	1152:  {1152, "ERROR_SINGLE_INSTANCE_APP", "Cannot start more than one instance of the specified program."},
+	1153:  {1153, "ERROR_RMODE_APP", "The specified program was written for an earlier version of Windows."},
+	1154:  {1154, "ERROR_INVALID_DLL", "One of the library files needed to run this application is damaged."},
+	1155:  {1155, "ERROR_NO_ASSOCIATION", "No application is associated with the specified file for this operation."},
+	1156:  {1156, "ERROR_DDE_FAIL", "An error occurred in sending the command to the application."},
+	1157:  {1157, "ERROR_DLL_NOT_FOUND", "One of the library files needed to run this application cannot be found."},
+	1158:  {1158, "ERROR_NO_MORE_USER_HANDLES", "The current process has used all of its system allowance of handles for Window Manager objects."},
+	1159:  {1159, "ERROR_MESSAGE_SYNC_ONLY", "The message can be used only with synchronous operations."},
+	1160:  {1160, "ERROR_SOURCE_ELEMENT_EMPTY", "The indicated source element has no media."},
+	1161:  {1161, "ERROR_DESTINATION_ELEMENT_FULL", "The indicated destination element already contains media."},
+	1162:  {1162, "ERROR_ILLEGAL_ELEMENT_ADDRESS", "The indicated element does not exist."},
+	1163:  {1163, "ERROR_MAGAZINE_NOT_PRESENT", "The indicated element is part of a magazine that is not present."},
+	1164:  {1164, "ERROR_DEVICE_REINITIALIZATION_NEEDED", "The indicated device requires reinitialization due to hardware errors."},
+	1165:  {1165, "ERROR_DEVICE_REQUIRES_CLEANING", "The device has indicated that cleaning is required before further operations are attempted."},
+	1166:  {1166, "ERROR_DEVICE_DOOR_OPEN", "The device has indicated that its door is open."},
+	1167:  {1167, "ERROR_DEVICE_NOT_CONNECTED", "The device is not connected."},
+	1168:  {1168, "ERROR_NOT_FOUND", "Element not found."},
+	1169:  {1169, "ERROR_NO_MATCH", "There was no match for the specified key in the index."},
+	1170:  {1170, "ERROR_SET_NOT_FOUND", "The property set specified does not exist on the object."},
+	1171:  {1171, "ERROR_POINT_NOT_FOUND", "The point passed to GetMouseMovePoints is not in the buffer."},
+	1172:  {1172, "ERROR_NO_TRACKING_SERVICE", "The tracking (workstation) service is not running."},
+	1173:  {1173, "ERROR_NO_VOLUME_ID", "The Volume ID could not be found."},
+	1175:  {1175, "ERROR_UNABLE_TO_REMOVE_REPLACED", "Unable to remove the file to be replaced."},
+	1176:  {1176, "ERROR_UNABLE_TO_MOVE_REPLACEMENT", "Unable to move the replacement file to the file to be replaced. The file to be replaced has retained its original name."},
+	1177:  {1177, "ERROR_UNABLE_TO_MOVE_REPLACEMENT_2", "Unable to move the replacement file to the file to be replaced. The file to be replaced has been renamed using the backup name."},
+	1178:  {1178, "ERROR_JOURNAL_DELETE_IN_PROGRESS", "The volume change journal is being deleted."},
+	1179:  {1179, "ERROR_JOURNAL_NOT_ACTIVE", "The volume change journal is not active."},
+	1180:  {1180, "ERROR_POTENTIAL_FILE_FOUND", "A file was found, but it may not be the correct file."},
+	1181:  {1181, "ERROR_JOURNAL_ENTRY_DELETED", "The journal entry has been deleted from the journal."},
+	1190:  {1190, "ERROR_SHUTDOWN_IS_SCHEDULED", "A system shutdown has already been scheduled."},
+	1191:  {1191, "ERROR_SHUTDOWN_USERS_LOGGED_ON", "The system shutdown cannot be initiated because there are other users logged on to the computer."},
+	1200:  {1200, "ERROR_BAD_DEVICE", "The specified device name is invalid."},
+	1201:  {1201, "ERROR_CONNECTION_UNAVAIL", "The device is not currently connected but it is a remembered connection."},
+	1202:  {1202, "ERROR_DEVICE_ALREADY_REMEMBERED", "The local device name has a remembered connection to another network resource."},
+	1203:  {1203, "ERROR_NO_NET_OR_BAD_PATH", "The network path was either typed incorrectly, does not exist, or the network provider is not currently available. Please try retyping the path or contact your network administrator."},
+	1204:  {1204, "ERROR_BAD_PROVIDER", "The specified network provider name is invalid."},
+	1205:  {1205, "ERROR_CANNOT_OPEN_PROFILE", "Unable to open the network connection profile."},
+	1206:  {1206, "ERROR_BAD_PROFILE", "The network connection profile is corrupted."},
+	1207:  {1207, "ERROR_NOT_CONTAINER", "Cannot enumerate a noncontainer."},
+	1208:  {1208, "ERROR_EXTENDED_ERROR", "An extended error has occurred."},
+	1209:  {1209, "ERROR_INVALID_GROUPNAME", "The format of the specified group name is invalid."},
+	1210:  {1210, "ERROR_INVALID_COMPUTERNAME", "The format of the specified computer name is invalid."},
+	1211:  {1211, "ERROR_INVALID_EVENTNAME", "The format of the specified event name is invalid."},
+	1212:  {1212, "ERROR_INVALID_DOMAINNAME", "The format of the specified domain name is invalid."},
+	1213:  {1213, "ERROR_INVALID_SERVICENAME", "The format of the specified service name is invalid."},
+	1214:  {1214, "ERROR_INVALID_NETNAME", "The format of the specified network name is invalid."},
+	1215:  {1215, "ERROR_INVALID_SHARENAME", "The format of the specified share name is invalid."},
+	1216:  {1216, "ERROR_INVALID_PASSWORDNAME", "The format of the specified password is invalid."},
+	1217:  {1217, "ERROR_INVALID_MESSAGENAME", "The format of the specified message name is invalid."},
+	1218:  {1218, "ERROR_INVALID_MESSAGEDEST", "The format of the specified message destination is invalid."},
+	1219:  {1219, "ERROR_SESSION_CREDENTIAL_CONFLICT", "Multiple connections to a server or shared resource by the same user, using more than one user name, are not allowed. Disconnect all previous connections to the server or shared resource and try again."},
+	1220:  {1220, "ERROR_REMOTE_SESSION_LIMIT_EXCEEDED", "An attempt was made to establish a session to a network server, but there are already too many sessions established to that server."},
+	1221:  {1221, "ERROR_DUP_DOMAINNAME", "The workgroup or domain name is already in use by another computer on the network."},
+	1222:  {1222, "ERROR_NO_NETWORK", "The network is not present or not started."},
+	1223:  {1223, "ERROR_CANCELLED", "The operation was canceled by the user."},
+	1224:  {1224, "ERROR_USER_MAPPED_FILE", "The requested operation cannot be performed on a file with a user-mapped section open."},
+	1225:  {1225, "ERROR_CONNECTION_REFUSED", "The remote computer refused the network connection."},
+	1226:  {1226, "ERROR_GRACEFUL_DISCONNECT", "The network connection was gracefully closed."},
+	1227:  {1227, "ERROR_ADDRESS_ALREADY_ASSOCIATED", "The network transport endpoint already has an address associated with it."},
+	1228:  {1228, "ERROR_ADDRESS_NOT_ASSOCIATED", "An address has not yet been associated with the network endpoint."},
+	1229:  {1229, "ERROR_CONNECTION_INVALID", "An operation was attempted on a nonexistent network connection."},
+	1230:  {1230, "ERROR_CONNECTION_ACTIVE", "An invalid operation was attempted on an active network connection."},
+	1231:  {1231, "ERROR_NETWORK_UNREACHABLE", "The network location cannot be reached. For information about network troubleshooting, see Windows Help."},
+	1232:  {1232, "ERROR_HOST_UNREACHABLE", "The network location cannot be reached. For information about network troubleshooting, see Windows Help."},
+	1233:  {1233, "ERROR_PROTOCOL_UNREACHABLE", "The network location cannot be reached. For information about network troubleshooting, see Windows Help."},
+	1234:  {1234, "ERROR_PORT_UNREACHABLE", "No service is operating at the destination network endpoint on the remote system."},
+	1235:  {1235, "ERROR_REQUEST_ABORTED", "The request was aborted."},
+	1236:  {1236, "ERROR_CONNECTION_ABORTED", "The network connection was aborted by the local system."},
+	1237:  {1237, "ERROR_RETRY", "The operation could not be completed. A retry should be performed."},
+	1238:  {1238, "ERROR_CONNECTION_COUNT_LIMIT", "A connection to the server could not be made because the limit on the number of concurrent connections for this account has been reached."},
+	1239:  {1239, "ERROR_LOGIN_TIME_RESTRICTION", "Attempting to log in during an unauthorized time of day for this account."},
+	1240:  {1240, "ERROR_LOGIN_WKSTA_RESTRICTION", "The account is not authorized to log in from this station."},
+	1241:  {1241, "ERROR_INCORRECT_ADDRESS", "The network address could not be used for the operation requested."},
+	1242:  {1242, "ERROR_ALREADY_REGISTERED", "The service is already registered."},
+	1243:  {1243, "ERROR_SERVICE_NOT_FOUND", "The specified service does not exist."},
+	1244:  {1244, "ERROR_NOT_AUTHENTICATED", "The operation being requested was not performed because the user has not been authenticated."},
+	1245:  {1245, "ERROR_NOT_LOGGED_ON", "The operation being requested was not performed because the user has not logged on to the network. The specified service does not exist."},
+	1246:  {1246, "ERROR_CONTINUE", "Continue with work in progress."},
+	1247:  {1247, "ERROR_ALREADY_INITIALIZED", "An attempt was made to perform an initialization operation when initialization has already been completed."},
+	1248:  {1248, "ERROR_NO_MORE_DEVICES", "No more local devices."},
+	1249:  {1249, "ERROR_NO_SUCH_SITE", "The specified site does not exist."},
+	1250:  {1250, "ERROR_DOMAIN_CONTROLLER_EXISTS", "A domain controller with the specified name already exists."},
+	1251:  {1251, "ERROR_ONLY_IF_CONNECTED", "This operation is supported only when you are connected to the server."},
+	1252:  {1252, "ERROR_OVERRIDE_NOCHANGES", "The group policy framework should call the extension even if there are no changes."},
+	1253:  {1253, "ERROR_BAD_USER_PROFILE", "The specified user does not have a valid profile."},
+	1254:  {1254, "ERROR_NOT_SUPPORTED_ON_SBS", "This operation is not supported on a computer running Windows Server 2003 for Small Business Server."},
+	1255:  {1255, "ERROR_SERVER_SHUTDOWN_IN_PROGRESS", "The server machine is shutting down."},
+	1256:  {1256, "ERROR_HOST_DOWN", "The remote system is not available. For information about network troubleshooting, see Windows Help."},
+	1257:  {1257, "ERROR_NON_ACCOUNT_SID", "The security identifier provided is not from an account domain."},
+	1258:  {1258, "ERROR_NON_DOMAIN_SID", "The security identifier provided does not have a domain component."},
+	1259:  {1259, "ERROR_APPHELP_BLOCK", "AppHelp dialog canceled thus preventing the application from starting."},
+	1260:  {1260, "ERROR_ACCESS_DISABLED_BY_POLICY", "This program is blocked by group policy. For more information, contact your system administrator."},
+	1261:  {1261, "ERROR_REG_NAT_CONSUMPTION", "A program attempt to use an invalid register value. Normally caused by an uninitialized register. This error is Itanium specific."},
+	1262:  {1262, "ERROR_CSCSHARE_OFFLINE", "The share is currently offline or does not exist."},
+	1263:  {1263, "ERROR_PKINIT_FAILURE", "The Kerberos protocol encountered an error while validating the KDC certificate during smartcard logon. There is more information in the system event log."},
+	1264:  {1264, "ERROR_SMARTCARD_SUBSYSTEM_FAILURE", "The Kerberos protocol encountered an error while attempting to utilize the smartcard subsystem."},
+	1265:  {1265, "ERROR_DOWNGRADE_DETECTED", "The system cannot contact a domain controller to service the authentication request. Please try again later."},
+	1271:  {1271, "ERROR_MACHINE_LOCKED", "The machine is locked and cannot be shut down without the force option."},
+	1272:  {1272, "ERROR_SMB_GUEST_LOGON_BLOCKED", "You can't access this shared folder because your organization's security policies block unauthenticated guest access. These policies help protect your PC from unsafe or malicious devices on the network."},
+	1273:  {1273, "ERROR_CALLBACK_SUPPLIED_INVALID_DATA", "An application-defined callback gave invalid data when called."},
+	1274:  {1274, "ERROR_SYNC_FOREGROUND_REFRESH_REQUIRED", "The group policy framework should call the extension in the synchronous foreground policy refresh."},
+	1275:  {1275, "ERROR_DRIVER_BLOCKED", "This driver has been blocked from loading."},
+	1276:  {1276, "ERROR_INVALID_IMPORT_OF_NON_DLL", "A dynamic link library (DLL) referenced a module that was neither a DLL nor the process's executable image."},
+	1277:  {1277, "ERROR_ACCESS_DISABLED_WEBBLADE", "Windows cannot open this program since it has been disabled."},
+	1278:  {1278, "ERROR_ACCESS_DISABLED_WEBBLADE_TAMPER", "Windows cannot open this program because the license enforcement system has been tampered with or become corrupted."},
+	1279:  {1279, "ERROR_RECOVERY_FAILURE", "A transaction recover failed."},
+	1280:  {1280, "ERROR_ALREADY_FIBER", "The current thread has already been converted to a fiber."},
+	1281:  {1281, "ERROR_ALREADY_THREAD", "The current thread has already been converted from a fiber."},
+	1282:  {1282, "ERROR_STACK_BUFFER_OVERRUN", "The system detected an overrun of a stack-based buffer in this application. This overrun could potentially allow a malicious user to gain control of this application."},
+	1283:  {1283, "ERROR_PARAMETER_QUOTA_EXCEEDED", "Data present in one of the parameters is more than the function can operate on."},
+	1284:  {1284, "ERROR_DEBUGGER_INACTIVE", "An attempt to do an operation on a debug object failed because the object is in the process of being deleted."},
+	1285:  {1285, "ERROR_DELAY_LOAD_FAILED", "An attempt to delay-load a .dll or get a function address in a delay-loaded .dll failed."},
+	1286:  {1286, "ERROR_VDM_DISALLOWED", "%1 is a 16-bit application. You do not have permissions to execute 16-bit applications. Check your permissions with your system administrator."},
+	1287:  {1287, "ERROR_UNIDENTIFIED_ERROR", "Insufficient information exists to identify the cause of failure."},
+	1288:  {1288, "ERROR_INVALID_CRUNTIME_PARAMETER", "The parameter passed to a C runtime function is incorrect."},
+	1289:  {1289, "ERROR_BEYOND_VDL", "The operation occurred beyond the valid data length of the file."},
+	1290:  {1290, "ERROR_INCOMPATIBLE_SERVICE_SID_TYPE", "The service start failed since one or more services in the same process have an incompatible service SID type setting. A service with restricted service SID type can only coexist in the same process with other services with a restricted SID type. If the service SID type for this service was just configured, the hosting process must be restarted in order to start this service."},
+	1291:  {1291, "ERROR_DRIVER_PROCESS_TERMINATED", "The process hosting the driver for this device has been terminated."},
+	1292:  {1292, "ERROR_IMPLEMENTATION_LIMIT", "An operation attempted to exceed an implementation-defined limit."},
+	1293:  {1293, "ERROR_PROCESS_IS_PROTECTED", "Either the target process, or the target thread's containing process, is a protected process."},
+	1294:  {1294, "ERROR_SERVICE_NOTIFY_CLIENT_LAGGING", "The service notification client is lagging too far behind the current state of services in the machine."},
+	1295:  {1295, "ERROR_DISK_QUOTA_EXCEEDED", "The requested file operation failed because the storage quota was exceeded. To free up disk space, move files to a different location or delete unnecessary files. For more information, contact your system administrator."},
+	1296:  {1296, "ERROR_CONTENT_BLOCKED", "The requested file operation failed because the storage policy blocks that type of file. For more information, contact your system administrator."},
+	1297:  {1297, "ERROR_INCOMPATIBLE_SERVICE_PRIVILEGE", "A privilege that the service requires to function properly does not exist in the service account configuration. You may use the Services Microsoft Management Console (MMC) snap-in (services.msc) and the Local Security Settings MMC snap-in (secpol.msc) to view the service configuration and the account configuration."},
+	1298:  {1298, "ERROR_APP_HANG", "A thread involved in this operation appears to be unresponsive."},
+	1299:  {1299, "ERROR_INVALID_LABEL", "Indicates a particular Security ID may not be assigned as the label of an object."},
+	1300:  {1300, "ERROR_NOT_ALL_ASSIGNED", "Not all privileges or groups referenced are assigned to the caller."},
+	1301:  {1301, "ERROR_SOME_NOT_MAPPED", "Some mapping between account names and security IDs was not done."},
+	1302:  {1302, "ERROR_NO_QUOTAS_FOR_ACCOUNT", "No system quota limits are specifically set for this account."},
+	1303:  {1303, "ERROR_LOCAL_USER_SESSION_KEY", "No encryption key is available. A well-known encryption key was returned."},
+	1304:  {1304, "ERROR_NULL_LM_PASSWORD", "The password is too complex to be converted to a LAN Manager password. The LAN Manager password returned is a NULL string."},
+	1305:  {1305, "ERROR_UNKNOWN_REVISION", "The revision level is unknown."},
+	1306:  {1306, "ERROR_REVISION_MISMATCH", "Indicates two revision levels are incompatible."},
+	1307:  {1307, "ERROR_INVALID_OWNER", "This security ID may not be assigned as the owner of this object."},
+	1308:  {1308, "ERROR_INVALID_PRIMARY_GROUP", "This security ID may not be assigned as the primary group of an object."},
+	1309:  {1309, "ERROR_NO_IMPERSONATION_TOKEN", "An attempt has been made to operate on an impersonation token by a thread that is not currently impersonating a client."},
+	1310:  {1310, "ERROR_CANT_DISABLE_MANDATORY", "The group may not be disabled."},
+	1311:  {1311, "ERROR_NO_LOGON_SERVERS", "We can't sign you in with this credential because your domain isn't available. Make sure your device is connected to your organization's network and try again. If you previously signed in on this device with another credential, you can sign in with that credential."},
+	1312:  {1312, "ERROR_NO_SUCH_LOGON_SESSION", "A specified logon session does not exist. It may already have been terminated."},
+	1313:  {1313, "ERROR_NO_SUCH_PRIVILEGE", "A specified privilege does not exist."},
+	1314:  {1314, "ERROR_PRIVILEGE_NOT_HELD", "A required privilege is not held by the client."},
+	1315:  {1315, "ERROR_INVALID_ACCOUNT_NAME", "The name provided is not a properly formed account name."},
+	1316:  {1316, "ERROR_USER_EXISTS", "The specified account already exists."},
+	1317:  {1317, "ERROR_NO_SUCH_USER", "The specified account does not exist."},
+	1318:  {1318, "ERROR_GROUP_EXISTS", "The specified group already exists."},
+	1319:  {1319, "ERROR_NO_SUCH_GROUP", "The specified group does not exist."},
+	1320:  {1320, "ERROR_MEMBER_IN_GROUP", "Either the specified user account is already a member of the specified group, or the specified group cannot be deleted because it contains a member."},
+	1321:  {1321, "ERROR_MEMBER_NOT_IN_GROUP", "The specified user account is not a member of the specified group account."},
+	1322:  {1322, "ERROR_LAST_ADMIN", "This operation is disallowed as it could result in an administration account being disabled, deleted or unable to logon."},
+	1323:  {1323, "ERROR_WRONG_PASSWORD", "Unable to update the password. The value provided as the current password is incorrect."},
+	1324:  {1324, "ERROR_ILL_FORMED_PASSWORD", "Unable to update the password. The value provided for the new password contains values that are not allowed in passwords."},
+	1325:  {1325, "ERROR_PASSWORD_RESTRICTION", "Unable to update the password. The value provided for the new password does not meet the length, complexity, or history requirements of the domain."},
+	1326:  {1326, "ERROR_LOGON_FAILURE", "The user name or password is incorrect."},
+	1327:  {1327, "ERROR_ACCOUNT_RESTRICTION", "Account restrictions are preventing this user from signing in. For example: blank passwords aren't allowed, sign-in times are limited, or a policy restriction has been enforced."},
+	1328:  {1328, "ERROR_INVALID_LOGON_HOURS", "Your account has time restrictions that keep you from signing in right now."},
+	1329:  {1329, "ERROR_INVALID_WORKSTATION", "This user isn't allowed to sign in to this computer."},
+	1330:  {1330, "ERROR_PASSWORD_EXPIRED", "The password for this account has expired."},
+	1331:  {1331, "ERROR_ACCOUNT_DISABLED", "This user can't sign in because this account is currently disabled."},
+	1332:  {1332, "ERROR_NONE_MAPPED", "No mapping between account names and security IDs was done."},
+	1333:  {1333, "ERROR_TOO_MANY_LUIDS_REQUESTED", "Too many local user identifiers (LUIDs) were requested at one time."},
+	1334:  {1334, "ERROR_LUIDS_EXHAUSTED", "No more local user identifiers (LUIDs) are available."},
+	1335:  {1335, "ERROR_INVALID_SUB_AUTHORITY", "The subauthority part of a security ID is invalid for this particular use."},
+	1336:  {1336, "ERROR_INVALID_ACL", "The access control list (ACL) structure is invalid."},
+	1337:  {1337, "ERROR_INVALID_SID", "The security ID structure is invalid."},
+	1338:  {1338, "ERROR_INVALID_SECURITY_DESCR", "The security descriptor structure is invalid."},
+	1340:  {1340, "ERROR_BAD_INHERITANCE_ACL", "The inherited access control list (ACL) or access control entry (ACE) could not be built."},
+	1341:  {1341, "ERROR_SERVER_DISABLED", "The server is currently disabled."},
+	1342:  {1342, "ERROR_SERVER_NOT_DISABLED", "The server is currently enabled."},
+	1343:  {1343, "ERROR_INVALID_ID_AUTHORITY", "The value provided was an invalid value for an identifier authority."},
+	1344:  {1344, "ERROR_ALLOTTED_SPACE_EXCEEDED", "No more memory is available for security information updates."},
+	1345:  {1345, "ERROR_INVALID_GROUP_ATTRIBUTES", "The specified attributes are invalid, or incompatible with the attributes for the group as a whole."},
+	1346:  {1346, "ERROR_BAD_IMPERSONATION_LEVEL", "Either a required impersonation level was not provided, or the provided impersonation level is invalid."},
+	1347:  {1347, "ERROR_CANT_OPEN_ANONYMOUS", "Cannot open an anonymous level security token."},
+	1348:  {1348, "ERROR_BAD_VALIDATION_CLASS", "The validation information class requested was invalid."},
+	1349:  {1349, "ERROR_BAD_TOKEN_TYPE", "The type of the token is inappropriate for its attempted use."},
+	1350:  {1350, "ERROR_NO_SECURITY_ON_OBJECT", "Unable to perform a security operation on an object that has no associated security."},
+	1351:  {1351, "ERROR_CANT_ACCESS_DOMAIN_INFO", "Configuration information could not be read from the domain controller, either because the machine is unavailable, or access has been denied."},
+	1352:  {1352, "ERROR_INVALID_SERVER_STATE", "The security account manager (SAM) or local security authority (LSA) server was in the wrong state to perform the security operation."},
+	1353:  {1353, "ERROR_INVALID_DOMAIN_STATE", "The domain was in the wrong state to perform the security operation."},
+	1354:  {1354, "ERROR_INVALID_DOMAIN_ROLE", "This operation is only allowed for the Primary Domain Controller of the domain."},
+	1355:  {1355, "ERROR_NO_SUCH_DOMAIN", "The specified domain either does not exist or could not be contacted."},
+	1356:  {1356, "ERROR_DOMAIN_EXISTS", "The specified domain already exists."},
+	1357:  {1357, "ERROR_DOMAIN_LIMIT_EXCEEDED", "An attempt was made to exceed the limit on the number of domains per server."},
+	1358:  {1358, "ERROR_INTERNAL_DB_CORRUPTION", "Unable to complete the requested operation because of either a catastrophic media failure or a data structure corruption on the disk."},
+	1359:  {1359, "ERROR_INTERNAL_ERROR", "An internal error occurred."},
+	1360:  {1360, "ERROR_GENERIC_NOT_MAPPED", "Generic access types were contained in an access mask which should already be mapped to nongeneric types."},
+	1361:  {1361, "ERROR_BAD_DESCRIPTOR_FORMAT", "A security descriptor is not in the right format (absolute or self-relative)."},
+	1362:  {1362, "ERROR_NOT_LOGON_PROCESS", "The requested action is restricted for use by logon processes only. The calling process has not registered as a logon process."},
+	1363:  {1363, "ERROR_LOGON_SESSION_EXISTS", "Cannot start a new logon session with an ID that is already in use."},
+	1364:  {1364, "ERROR_NO_SUCH_PACKAGE", "A specified authentication package is unknown."},
+	1365:  {1365, "ERROR_BAD_LOGON_SESSION_STATE", "The logon session is not in a state that is consistent with the requested operation."},
+	1366:  {1366, "ERROR_LOGON_SESSION_COLLISION", "The logon session ID is already in use."},
+	1367:  {1367, "ERROR_INVALID_LOGON_TYPE", "A logon request contained an invalid logon type value."},
+	1368:  {1368, "ERROR_CANNOT_IMPERSONATE", "Unable to impersonate using a named pipe until data has been read from that pipe."},
+	1369:  {1369, "ERROR_RXACT_INVALID_STATE", "The transaction state of a registry subtree is incompatible with the requested operation."},
+	1370:  {1370, "ERROR_RXACT_COMMIT_FAILURE", "An internal security database corruption has been encountered."},
+	1371:  {1371, "ERROR_SPECIAL_ACCOUNT", "Cannot perform this operation on built-in accounts."},
+	1372:  {1372, "ERROR_SPECIAL_GROUP", "Cannot perform this operation on this built-in special group."},
+	1373:  {1373, "ERROR_SPECIAL_USER", "Cannot perform this operation on this built-in special user."},
+	1374:  {1374, "ERROR_MEMBERS_PRIMARY_GROUP", "The user cannot be removed from a group because the group is currently the user's primary group."},
+	1375:  {1375, "ERROR_TOKEN_ALREADY_IN_USE", "The token is already in use as a primary token."},
+	1376:  {1376, "ERROR_NO_SUCH_ALIAS", "The specified local group does not exist."},
+	1377:  {1377, "ERROR_MEMBER_NOT_IN_ALIAS", "The specified account name is not a member of the group."},
+	1378:  {1378, "ERROR_MEMBER_IN_ALIAS", "The specified account name is already a member of the group."},
+	1379:  {1379, "ERROR_ALIAS_EXISTS", "The specified local group already exists."},
+	1380:  {1380, "ERROR_LOGON_NOT_GRANTED", "Logon failure: the user has not been granted the requested logon type at this computer."},
+	1381:  {1381, "ERROR_TOO_MANY_SECRETS", "The maximum number of secrets that may be stored in a single system has been exceeded."},
+	1382:  {1382, "ERROR_SECRET_TOO_LONG", "The length of a secret exceeds the maximum length allowed."},
+	1383:  {1383, "ERROR_INTERNAL_DB_ERROR", "The local security authority database contains an internal inconsistency."},
+	1384:  {1384, "ERROR_TOO_MANY_CONTEXT_IDS", "During a logon attempt, the user's security context accumulated too many security IDs."},
+	1385:  {1385, "ERROR_LOGON_TYPE_NOT_GRANTED", "Logon failure: the user has not been granted the requested logon type at this computer."},
+	1386:  {1386, "ERROR_NT_CROSS_ENCRYPTION_REQUIRED", "A cross-encrypted password is necessary to change a user password."},
+	1387:  {1387, "ERROR_NO_SUCH_MEMBER", "A member could not be added to or removed from the local group because the member does not exist."},
+	1388:  {1388, "ERROR_INVALID_MEMBER", "A new member could not be added to a local group because the member has the wrong account type."},
+	1389:  {1389, "ERROR_TOO_MANY_SIDS", "Too many security IDs have been specified."},
+	1390:  {1390, "ERROR_LM_CROSS_ENCRYPTION_REQUIRED", "A cross-encrypted password is necessary to change this user password."},
+	1391:  {1391, "ERROR_NO_INHERITANCE", "Indicates an ACL contains no inheritable components."},
+	1392:  {1392, "ERROR_FILE_CORRUPT", "The file or directory is corrupted and unreadable."},
+	1393:  {1393, "ERROR_DISK_CORRUPT", "The disk structure is corrupted and unreadable."},
+	1394:  {1394, "ERROR_NO_USER_SESSION_KEY", "There is no user session key for the specified logon session."},
+	1395:  {1395, "ERROR_LICENSE_QUOTA_EXCEEDED", "The service being accessed is licensed for a particular number of connections. No more connections can be made to the service at this time because there are already as many connections as the service can accept."},
+	1396:  {1396, "ERROR_WRONG_TARGET_NAME", "The target account name is incorrect."},
+	1397:  {1397, "ERROR_MUTUAL_AUTH_FAILED", "Mutual Authentication failed. The server's password is out of date at the domain controller."},
+	1398:  {1398, "ERROR_TIME_SKEW", "There is a time and/or date difference between the client and server."},
+	1399:  {1399, "ERROR_CURRENT_DOMAIN_NOT_ALLOWED", "This operation cannot be performed on the current domain."},
+	1400:  {1400, "ERROR_INVALID_WINDOW_HANDLE", "Invalid window handle."},
+	1401:  {1401, "ERROR_INVALID_MENU_HANDLE", "Invalid menu handle."},
+	1402:  {1402, "ERROR_INVALID_CURSOR_HANDLE", "Invalid cursor handle."},
+	1403:  {1403, "ERROR_INVALID_ACCEL_HANDLE", "Invalid accelerator table handle."},
+	1404:  {1404, "ERROR_INVALID_HOOK_HANDLE", "Invalid hook handle."},
+	1405:  {1405, "ERROR_INVALID_DWP_HANDLE", "Invalid handle to a multiple-window position structure."},
+	1406:  {1406, "ERROR_TLW_WITH_WSCHILD", "Cannot create a top-level child window."},
+	1407:  {1407, "ERROR_CANNOT_FIND_WND_CLASS", "Cannot find window class."},
+	1408:  {1408, "ERROR_WINDOW_OF_OTHER_THREAD", "Invalid window; it belongs to other thread."},
+	1409:  {1409, "ERROR_HOTKEY_ALREADY_REGISTERED", "Hot key is already registered."},
+	1410:  {1410, "ERROR_CLASS_ALREADY_EXISTS", "Class already exists."},
+	1411:  {1411, "ERROR_CLASS_DOES_NOT_EXIST", "Class does not exist."},
+	1412:  {1412, "ERROR_CLASS_HAS_WINDOWS", "Class still has open windows."},
+	1413:  {1413, "ERROR_INVALID_INDEX", "Invalid index."},
+	1414:  {1414, "ERROR_INVALID_ICON_HANDLE", "Invalid icon handle."},
+	1415:  {1415, "ERROR_PRIVATE_DIALOG_INDEX", "Using private DIALOG window words."},
+	1416:  {1416, "ERROR_LISTBOX_ID_NOT_FOUND", "The list box identifier was not found."},
+	1417:  {1417, "ERROR_NO_WILDCARD_CHARACTERS", "No wildcards were found."},
+	1418:  {1418, "ERROR_CLIPBOARD_NOT_OPEN", "Thread does not have a clipboard open."},
+	1419:  {1419, "ERROR_HOTKEY_NOT_REGISTERED", "Hot key is not registered."},
+	1420:  {1420, "ERROR_WINDOW_NOT_DIALOG", "The window is not a valid dialog window."},
+	1421:  {1421, "ERROR_CONTROL_ID_NOT_FOUND", "Control ID not found."},
+	1422:  {1422, "ERROR_INVALID_COMBOBOX_MESSAGE", "Invalid message for a combo box because it does not have an edit control."},
+	1423:  {1423, "ERROR_WINDOW_NOT_COMBOBOX", "The window is not a combo box."},
+	1424:  {1424, "ERROR_INVALID_EDIT_HEIGHT", "Height must be less than 256."},
+	1425:  {1425, "ERROR_DC_NOT_FOUND", "Invalid device context (DC) handle."},
+	1426:  {1426, "ERROR_INVALID_HOOK_FILTER", "Invalid hook procedure type."},
+	1427:  {1427, "ERROR_INVALID_FILTER_PROC", "Invalid hook procedure."},
+	1428:  {1428, "ERROR_HOOK_NEEDS_HMOD", "Cannot set nonlocal hook without a module handle."},
+	1429:  {1429, "ERROR_GLOBAL_ONLY_HOOK", "This hook procedure can only be set globally."},
+	1430:  {1430, "ERROR_JOURNAL_HOOK_SET", "The journal hook procedure is already installed."},
+	1431:  {1431, "ERROR_HOOK_NOT_INSTALLED", "The hook procedure is not installed."},
+	1432:  {1432, "ERROR_INVALID_LB_MESSAGE", "Invalid message for single-selection list box."},
+	1433:  {1433, "ERROR_SETCOUNT_ON_BAD_LB", "LB_SETCOUNT sent to non-lazy list box."},
+	1434:  {1434, "ERROR_LB_WITHOUT_TABSTOPS", "This list box does not support tab stops."},
+	1435:  {1435, "ERROR_DESTROY_OBJECT_OF_OTHER_THREAD", "Cannot destroy object created by another thread."},
+	1436:  {1436, "ERROR_CHILD_WINDOW_MENU", "Child windows cannot have menus."},
+	1437:  {1437, "ERROR_NO_SYSTEM_MENU", "The window does not have a system menu."},
+	1438:  {1438, "ERROR_INVALID_MSGBOX_STYLE", "Invalid message box style."},
+	1439:  {1439, "ERROR_INVALID_SPI_VALUE", "Invalid system-wide (SPI_*) parameter."},
+	1440:  {1440, "ERROR_SCREEN_ALREADY_LOCKED", "Screen already locked."},
+	1441:  {1441, "ERROR_HWNDS_HAVE_DIFF_PARENT", "All handles to windows in a multiple-window position structure must have the same parent."},
+	1442:  {1442, "ERROR_NOT_CHILD_WINDOW", "The window is not a child window."},
+	1443:  {1443, "ERROR_INVALID_GW_COMMAND", "Invalid GW_* command."},
+	1444:  {1444, "ERROR_INVALID_THREAD_ID", "Invalid thread identifier."},
+	1445:  {1445, "ERROR_NON_MDICHILD_WINDOW", "Cannot process a message from a window that is not a multiple document interface (MDI) window."},
+	1446:  {1446, "ERROR_POPUP_ALREADY_ACTIVE", "Popup menu already active."},
+	1447:  {1447, "ERROR_NO_SCROLLBARS", "The window does not have scroll bars."},
+	1448:  {1448, "ERROR_INVALID_SCROLLBAR_RANGE", "Scroll bar range cannot be greater than MAXLONG."},
+	1449:  {1449, "ERROR_INVALID_SHOWWIN_COMMAND", "Cannot show or remove the window in the way specified."},
+	1450:  {1450, "ERROR_NO_SYSTEM_RESOURCES", "Insufficient system resources exist to complete the requested service."},
+	1451:  {1451, "ERROR_NONPAGED_SYSTEM_RESOURCES", "Insufficient system resources exist to complete the requested service."},
+	1452:  {1452, "ERROR_PAGED_SYSTEM_RESOURCES", "Insufficient system resources exist to complete the requested service."},
+	1453:  {1453, "ERROR_WORKING_SET_QUOTA", "Insufficient quota to complete the requested service."},
+	1454:  {1454, "ERROR_PAGEFILE_QUOTA", "Insufficient quota to complete the requested service."},
+	1455:  {1455, "ERROR_COMMITMENT_LIMIT", "The paging file is too small for this operation to complete."},
+	1456:  {1456, "ERROR_MENU_ITEM_NOT_FOUND", "A menu item was not found."},
+	1457:  {1457, "ERROR_INVALID_KEYBOARD_HANDLE", "Invalid keyboard layout handle."},
+	1458:  {1458, "ERROR_HOOK_TYPE_NOT_ALLOWED", "Hook type not allowed."},
+	1459:  {1459, "ERROR_REQUIRES_INTERACTIVE_WINDOWSTATION", "This operation requires an interactive window station."},
+	1460:  {1460, "ERROR_TIMEOUT", "This operation returned because the timeout period expired."},
+	1461:  {1461, "ERROR_INVALID_MONITOR_HANDLE", "Invalid monitor handle."},
+	1462:  {1462, "ERROR_INCORRECT_SIZE", "Incorrect size argument."},
+	1463:  {1463, "ERROR_SYMLINK_CLASS_DISABLED", "The symbolic link cannot be followed because its type is disabled."},
+	1464:  {1464, "ERROR_SYMLINK_NOT_SUPPORTED", "This application does not support the current operation on symbolic links."},
+	1465:  {1465, "ERROR_XML_PARSE_ERROR", "Windows was unable to parse the requested XML data."},
+	1466:  {1466, "ERROR_XMLDSIG_ERROR", "An error was encountered while processing an XML digital signature."},
+	1467:  {1467, "ERROR_RESTART_APPLICATION", "This application must be restarted."},
+	1468:  {1468, "ERROR_WRONG_COMPARTMENT", "The caller made the connection request in the wrong routing compartment."},
+	1469:  {1469, "ERROR_AUTHIP_FAILURE", "There was an AuthIP failure when attempting to connect to the remote host."},
+	1470:  {1470, "ERROR_NO_NVRAM_RESOURCES", "Insufficient NVRAM resources exist to complete the requested service. A reboot might be required."},
+	1471:  {1471, "ERROR_NOT_GUI_PROCESS", "Unable to finish the requested operation because the specified process is not a GUI process."},
+	1500:  {1500, "ERROR_EVENTLOG_FILE_CORRUPT", "The event log file is corrupted."},
+	1501:  {1501, "ERROR_EVENTLOG_CANT_START", "No event log file could be opened, so the event logging service did not start."},
+	1502:  {1502, "ERROR_LOG_FILE_FULL", "The event log file is full."},
+	1503:  {1503, "ERROR_EVENTLOG_FILE_CHANGED", "The event log file has changed between read operations."},
+	1504:  {1504, "ERROR_CONTAINER_ASSIGNED", "The specified Job already has a container assigned to it."},
+	1505:  {1505, "ERROR_JOB_NO_CONTAINER", "The specified Job does not have a container assigned to it."},
+	1550:  {1550, "ERROR_INVALID_TASK_NAME", "The specified task name is invalid."},
+	1551:  {1551, "ERROR_INVALID_TASK_INDEX", "The specified task index is invalid."},
+	1552:  {1552, "ERROR_THREAD_ALREADY_IN_TASK", "The specified thread is already joining a task."},
+	1601:  {1601, "ERROR_INSTALL_SERVICE_FAILURE", "The Windows Installer Service could not be accessed. This can occur if the Windows Installer is not correctly installed. Contact your support personnel for assistance."},
+	1602:  {1602, "ERROR_INSTALL_USEREXIT", "User cancelled installation."},
+	1603:  {1603, "ERROR_INSTALL_FAILURE", "Fatal error during installation."},
+	1604:  {1604, "ERROR_INSTALL_SUSPEND", "Installation suspended, incomplete."},
+	1605:  {1605, "ERROR_UNKNOWN_PRODUCT", "This action is only valid for products that are currently installed."},
+	1606:  {1606, "ERROR_UNKNOWN_FEATURE", "Feature ID not registered."},
+	1607:  {1607, "ERROR_UNKNOWN_COMPONENT", "Component ID not registered."},
+	1608:  {1608, "ERROR_UNKNOWN_PROPERTY", "Unknown property."},
+	1609:  {1609, "ERROR_INVALID_HANDLE_STATE", "Handle is in an invalid state."},
+	1610:  {1610, "ERROR_BAD_CONFIGURATION", "The configuration data for this product is corrupt. Contact your support personnel."},
+	1611:  {1611, "ERROR_INDEX_ABSENT", "Component qualifier not present."},
+	1612:  {1612, "ERROR_INSTALL_SOURCE_ABSENT", "The installation source for this product is not available. Verify that the source exists and that you can access it."},
+	1613:  {1613, "ERROR_INSTALL_PACKAGE_VERSION", "This installation package cannot be installed by the Windows Installer service. You must install a Windows service pack that contains a newer version of the Windows Installer service."},
+	1614:  {1614, "ERROR_PRODUCT_UNINSTALLED", "Product is uninstalled."},
+	1615:  {1615, "ERROR_BAD_QUERY_SYNTAX", "SQL query syntax invalid or unsupported."},
+	1616:  {1616, "ERROR_INVALID_FIELD", "Record field does not exist."},
+	1617:  {1617, "ERROR_DEVICE_REMOVED", "The device has been removed."},
+	1618:  {1618, "ERROR_INSTALL_ALREADY_RUNNING", "Another installation is already in progress. Complete that installation before proceeding with this install."},
+	1619:  {1619, "ERROR_INSTALL_PACKAGE_OPEN_FAILED", "This installation package could not be opened. Verify that the package exists and that you can access it, or contact the application vendor to verify that this is a valid Windows Installer package."},
+	1620:  {1620, "ERROR_INSTALL_PACKAGE_INVALID", "This installation package could not be opened. Contact the application vendor to verify that this is a valid Windows Installer package."},
+	1621:  {1621, "ERROR_INSTALL_UI_FAILURE", "There was an error starting the Windows Installer service user interface. Contact your support personnel."},
+	1622:  {1622, "ERROR_INSTALL_LOG_FAILURE", "Error opening installation log file. Verify that the specified log file location exists and that you can write to it."},
+	1623:  {1623, "ERROR_INSTALL_LANGUAGE_UNSUPPORTED", "The language of this installation package is not supported by your system."},
+	1624:  {1624, "ERROR_INSTALL_TRANSFORM_FAILURE", "Error applying transforms. Verify that the specified transform paths are valid."},
+	1625:  {1625, "ERROR_INSTALL_PACKAGE_REJECTED", "This installation is forbidden by system policy. Contact your system administrator."},
+	1626:  {1626, "ERROR_FUNCTION_NOT_CALLED", "Function could not be executed."},
+	1627:  {1627, "ERROR_FUNCTION_FAILED", "Function failed during execution."},
+	1628:  {1628, "ERROR_INVALID_TABLE", "Invalid or unknown table specified."},
+	1629:  {1629, "ERROR_DATATYPE_MISMATCH", "Data supplied is of wrong type."},
+	1630:  {1630, "ERROR_UNSUPPORTED_TYPE", "Data of this type is not supported."},
+	1631:  {1631, "ERROR_CREATE_FAILED", "The Windows Installer service failed to start. Contact your support personnel."},
+	1632:  {1632, "ERROR_INSTALL_TEMP_UNWRITABLE", "The Temp folder is on a drive that is full or is inaccessible. Free up space on the drive or verify that you have write permission on the Temp folder."},
+	1633:  {1633, "ERROR_INSTALL_PLATFORM_UNSUPPORTED", "This installation package is not supported by this processor type. Contact your product vendor."},
+	1634:  {1634, "ERROR_INSTALL_NOTUSED", "Component not used on this computer."},
+	1635:  {1635, "ERROR_PATCH_PACKAGE_OPEN_FAILED", "This update package could not be opened. Verify that the update package exists and that you can access it, or contact the application vendor to verify that this is a valid Windows Installer update package."},
+	1636:  {1636, "ERROR_PATCH_PACKAGE_INVALID", "This update package could not be opened. Contact the application vendor to verify that this is a valid Windows Installer update package."},
+	1637:  {1637, "ERROR_PATCH_PACKAGE_UNSUPPORTED", "This update package cannot be processed by the Windows Installer service. You must install a Windows service pack that contains a newer version of the Windows Installer service."},
+	1638:  {1638, "ERROR_PRODUCT_VERSION", "Another version of this product is already installed. Installation of this version cannot continue. To configure or remove the existing version of this product, use Add/Remove Programs on the Control Panel."},
+	1639:  {1639, "ERROR_INVALID_COMMAND_LINE", "Invalid command line argument. Consult the Windows Installer SDK for detailed command line help."},
+	1640:  {1640, "ERROR_INSTALL_REMOTE_DISALLOWED", "Only administrators have permission to add, remove, or configure server software during a Terminal services remote session. If you want to install or configure software on the server, contact your network administrator."},
+	1641:  {1641, "ERROR_SUCCESS_REBOOT_INITIATED", "The requested operation completed successfully. The system will be restarted so the changes can take effect."},
+	1642:  {1642, "ERROR_PATCH_TARGET_NOT_FOUND", "The upgrade cannot be installed by the Windows Installer service because the program to be upgraded may be missing, or the upgrade may update a different version of the program. Verify that the program to be upgraded exists on your computer and that you have the correct upgrade."},
+	1643:  {1643, "ERROR_PATCH_PACKAGE_REJECTED", "The update package is not permitted by software restriction policy."},
+	1644:  {1644, "ERROR_INSTALL_TRANSFORM_REJECTED", "One or more customizations are not permitted by software restriction policy."},
+	1645:  {1645, "ERROR_INSTALL_REMOTE_PROHIBITED", "The Windows Installer does not permit installation from a Remote Desktop Connection."},
+	1646:  {1646, "ERROR_PATCH_REMOVAL_UNSUPPORTED", "Uninstallation of the update package is not supported."},
+	1647:  {1647, "ERROR_UNKNOWN_PATCH", "The update is not applied to this product."},
+	1648:  {1648, "ERROR_PATCH_NO_SEQUENCE", "No valid sequence could be found for the set of updates."},
+	1649:  {1649, "ERROR_PATCH_REMOVAL_DISALLOWED", "Update removal was disallowed by policy."},
+	1650:  {1650, "ERROR_INVALID_PATCH_XML", "The XML update data is invalid."},
+	1651:  {1651, "ERROR_PATCH_MANAGED_ADVERTISED_PRODUCT", "Windows Installer does not permit updating of managed advertised products. At least one feature of the product must be installed before applying the update."},
+	1652:  {1652, "ERROR_INSTALL_SERVICE_SAFEBOOT", "The Windows Installer service is not accessible in Safe Mode. Please try again when your computer is not in Safe Mode or you can use System Restore to return your machine to a previous good state."},
+	1653:  {1653, "ERROR_FAIL_FAST_EXCEPTION", "A fail fast exception occurred. Exception handlers will not be invoked and the process will be terminated immediately."},
+	1654:  {1654, "ERROR_INSTALL_REJECTED", "The app that you are trying to run is not supported on this version of Windows."},
+	1655:  {1655, "ERROR_DYNAMIC_CODE_BLOCKED", "The operation was blocked as the process prohibits dynamic code generation."},
+	1656:  {1656, "ERROR_NOT_SAME_OBJECT", "The objects are not identical."},
+	1657:  {1657, "ERROR_STRICT_CFG_VIOLATION", "The specified image file was blocked from loading because it does not enable a feature required by the process: Control Flow Guard."},
+	1660:  {1660, "ERROR_SET_CONTEXT_DENIED", "The thread context could not be updated because this has been restricted for the process."},
+	1661:  {1661, "ERROR_CROSS_PARTITION_VIOLATION", "An invalid cross-partition private file/section access was attempted."},
+	1784:  {1784, "ERROR_INVALID_USER_BUFFER", "The supplied user buffer is not valid for the requested operation."},
+	1785:  {1785, "ERROR_UNRECOGNIZED_MEDIA", "The disk media is not recognized. It may not be formatted."},
+	1786:  {1786, "ERROR_NO_TRUST_LSA_SECRET", "The workstation does not have a trust secret."},
+	1787:  {1787, "ERROR_NO_TRUST_SAM_ACCOUNT", "The security database on the server does not have a computer account for this workstation trust relationship."},
+	1788:  {1788, "ERROR_TRUSTED_DOMAIN_FAILURE", "The trust relationship between the primary domain and the trusted domain failed."},
+	1789:  {1789, "ERROR_TRUSTED_RELATIONSHIP_FAILURE", "The trust relationship between this workstation and the primary domain failed."},
+	1790:  {1790, "ERROR_TRUST_FAILURE", "The network logon failed."},
+	1792:  {1792, "ERROR_NETLOGON_NOT_STARTED", "An attempt was made to logon, but the network logon service was not started."},
+	1793:  {1793, "ERROR_ACCOUNT_EXPIRED", "The user's account has expired."},
+	1794:  {1794, "ERROR_REDIRECTOR_HAS_OPEN_HANDLES", "The redirector is in use and cannot be unloaded."},
+	1795:  {1795, "ERROR_PRINTER_DRIVER_ALREADY_INSTALLED", "The specified printer driver is already installed."},
+	1796:  {1796, "ERROR_UNKNOWN_PORT", "The specified port is unknown."},
+	1797:  {1797, "ERROR_UNKNOWN_PRINTER_DRIVER", "The printer driver is unknown."},
+	1798:  {1798, "ERROR_UNKNOWN_PRINTPROCESSOR", "The print processor is unknown."},
+	1799:  {1799, "ERROR_INVALID_SEPARATOR_FILE", "The specified separator file is invalid."},
+	1800:  {1800, "ERROR_INVALID_PRIORITY", "The specified priority is invalid."},
+	1801:  {1801, "ERROR_INVALID_PRINTER_NAME", "The printer name is invalid."},
+	1802:  {1802, "ERROR_PRINTER_ALREADY_EXISTS", "The printer already exists."},
+	1803:  {1803, "ERROR_INVALID_PRINTER_COMMAND", "The printer command is invalid."},
+	1804:  {1804, "ERROR_INVALID_DATATYPE", "The specified datatype is invalid."},
+	1805:  {1805, "ERROR_INVALID_ENVIRONMENT", "The environment specified is invalid."},
+	1807:  {1807, "ERROR_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT", "The account used is an interdomain trust account. Use your global user account or local user account to access this server."},
+	1808:  {1808, "ERROR_NOLOGON_WORKSTATION_TRUST_ACCOUNT", "The account used is a computer account. Use your global user account or local user account to access this server."},
+	1809:  {1809, "ERROR_NOLOGON_SERVER_TRUST_ACCOUNT", "The account used is a server trust account. Use your global user account or local user account to access this server."},
+	1810:  {1810, "ERROR_DOMAIN_TRUST_INCONSISTENT", "The name or security ID (SID) of the domain specified is inconsistent with the trust information for that domain."},
+	1811:  {1811, "ERROR_SERVER_HAS_OPEN_HANDLES", "The server is in use and cannot be unloaded."},
+	1812:  {1812, "ERROR_RESOURCE_DATA_NOT_FOUND", "The specified image file did not contain a resource section."},
+	1813:  {1813, "ERROR_RESOURCE_TYPE_NOT_FOUND", "The specified resource type cannot be found in the image file."},
+	1814:  {1814, "ERROR_RESOURCE_NAME_NOT_FOUND", "The specified resource name cannot be found in the image file."},
+	1815:  {1815, "ERROR_RESOURCE_LANG_NOT_FOUND", "The specified resource language ID cannot be found in the image file."},
+	1816:  {1816, "ERROR_NOT_ENOUGH_QUOTA", "Not enough quota is available to process this command."},
+	1901:  {1901, "ERROR_INVALID_TIME", "The specified time is invalid."},
+	1902:  {1902, "ERROR_INVALID_FORM_NAME", "The specified form name is invalid."},
+	1903:  {1903, "ERROR_INVALID_FORM_SIZE", "The specified form size is invalid."},
+	1904:  {1904, "ERROR_ALREADY_WAITING", "The specified printer handle is already being waited on."},
+	1905:  {1905, "ERROR_PRINTER_DELETED", "The specified printer has been deleted."},
+	1906:  {1906, "ERROR_INVALID_PRINTER_STATE", "The state of the printer is invalid."},
+	1907:  {1907, "ERROR_PASSWORD_MUST_CHANGE", "The user's password must be changed before signing in."},
+	1908:  {1908, "ERROR_DOMAIN_CONTROLLER_NOT_FOUND", "Could not find the domain controller for this domain."},
+	1909:  {1909, "ERROR_ACCOUNT_LOCKED_OUT", "The referenced account is currently locked out and may not be logged on to."},
+	1919:  {1919, "ERROR_NO_SITENAME", "The site name for the computer could not be determined."},
+	1920:  {1920, "ERROR_CANT_ACCESS_FILE", "The file cannot be accessed by the system."},
+	1921:  {1921, "ERROR_CANT_RESOLVE_FILENAME", "The name of the file cannot be resolved by the system."},
+	1930:  {1930, "ERROR_KM_DRIVER_BLOCKED", "The kernel-mode driver was blocked from loading."},
+	1931:  {1931, "ERROR_CONTEXT_EXPIRED", "The context has expired and can no longer be used."},
+	1932:  {1932, "ERROR_PER_USER_TRUST_QUOTA_EXCEEDED", "The current user has exceeded the allowed number of trusted domain objects that it may create."},
+	1933:  {1933, "ERROR_ALL_USER_TRUST_QUOTA_EXCEEDED", "The total number of trusted domain objects across all users has exceeded the allowed maximum."},
+	1934:  {1934, "ERROR_USER_DELETE_TRUST_QUOTA_EXCEEDED", "A user that has exceeded its trusted domain object quota cannot delete another user's trusted domain object."},
+	1935:  {1935, "ERROR_AUTHENTICATION_FIREWALL_FAILED", "The computer you are signing into is protected by an authentication firewall. The specified account is not allowed to authenticate to the computer."},
+	1936:  {1936, "ERROR_REMOTE_PRINT_CONNECTIONS_BLOCKED", "Remote connections to the Print Spooler are blocked by a policy set on your machine."},
+	1937:  {1937, "ERROR_NTLM_BLOCKED", "Authentication failed because NTLM authentication has been disabled."},
+	1938:  {1938, "ERROR_PASSWORD_CHANGE_REQUIRED", "Password change for %1 is required. Please change the password."},
+	1939:  {1939, "ERROR_LOST_MODE_LOGON_RESTRICTION", "The computer is in lost mode and logon is restricted."},
+	2000:  {2000, "ERROR_INVALID_PIXEL_FORMAT", "The pixel format is invalid."},
+	2001:  {2001, "ERROR_BAD_DRIVER", "The specified driver is invalid."},
+	2002:  {2002, "ERROR_INVALID_WINDOW_STYLE", "The window style or class attribute is invalid for this operation."},
+	2003:  {2003, "ERROR_METAFILE_NOT_SUPPORTED", "The requested metafile operation is not supported."},
+	2004:  {2004, "ERROR_TRANSFORM_NOT_SUPPORTED", "The requested transformation operation is not supported."},
+	2005:  {2005, "ERROR_CLIPPING_NOT_SUPPORTED", "The requested clipping operation is not supported."},
+	2010:  {2010, "ERROR_INVALID_CMM", "The specified color management module is invalid."},
+	2011:  {2011, "ERROR_INVALID_PROFILE", "The specified color profile is invalid."},
+	2012:  {2012, "ERROR_TAG_NOT_FOUND", "The specified tag was not found."},
+	2013:  {2013, "ERROR_TAG_NOT_PRESENT", "A required tag is not present."},
+	2014:  {2014, "ERROR_DUPLICATE_TAG", "The specified tag is already present."},
+	2015:  {2015, "ERROR_PROFILE_NOT_ASSOCIATED_WITH_DEVICE", "The specified color profile is not associated with the specified device."},
+	2016:  {2016, "ERROR_PROFILE_NOT_FOUND", "The specified color profile was not found."},
+	2017:  {2017, "ERROR_INVALID_COLORSPACE", "The specified color space is invalid."},
+	2018:  {2018, "ERROR_ICM_NOT_ENABLED", "Image Color Management is not enabled."},
+	2019:  {2019, "ERROR_DELETING_ICM_XFORM", "There was an error while deleting the color transform."},
+	2020:  {2020, "ERROR_INVALID_TRANSFORM", "The specified color transform is invalid."},
+	2021:  {2021, "ERROR_COLORSPACE_MISMATCH", "The specified transform does not match the bitmap's color space."},
+	2022:  {2022, "ERROR_INVALID_COLORINDEX", "The specified named color index is not present in the profile."},
+	2023:  {2023, "ERROR_PROFILE_DOES_NOT_MATCH_DEVICE", "The specified profile is intended for a device of a different type than the specified device."},
+	2108:  {2108, "ERROR_CONNECTED_OTHER_PASSWORD", "The network connection was made successfully, but the user had to be prompted for a password other than the one originally specified."},
+	2109:  {2109, "ERROR_CONNECTED_OTHER_PASSWORD_DEFAULT", "The network connection was made successfully using default credentials."},
+	2202:  {2202, "ERROR_BAD_USERNAME", "The specified username is invalid."},
+	2250:  {2250, "ERROR_NOT_CONNECTED", "This network connection does not exist."},
+	2401:  {2401, "ERROR_OPEN_FILES", "This network connection has files open or requests pending."},
+	2402:  {2402, "ERROR_ACTIVE_CONNECTIONS", "Active connections still exist."},
+	2404:  {2404, "ERROR_DEVICE_IN_USE", "The device is in use by an active process and cannot be disconnected."},
+	3000:  {3000, "ERROR_UNKNOWN_PRINT_MONITOR", "The specified print monitor is unknown."},
+	3001:  {3001, "ERROR_PRINTER_DRIVER_IN_USE", "The specified printer driver is currently in use."},
+	3002:  {3002, "ERROR_SPOOL_FILE_NOT_FOUND", "The spool file was not found."},
+	3003:  {3003, "ERROR_SPL_NO_STARTDOC", "A StartDocPrinter call was not issued."},
+	3004:  {3004, "ERROR_SPL_NO_ADDJOB", "An AddJob call was not issued."},
+	3005:  {3005, "ERROR_PRINT_PROCESSOR_ALREADY_INSTALLED", "The specified print processor has already been installed."},
+	3006:  {3006, "ERROR_PRINT_MONITOR_ALREADY_INSTALLED", "The specified print monitor has already been installed."},
+	3007:  {3007, "ERROR_INVALID_PRINT_MONITOR", "The specified print monitor does not have the required functions."},
+	3008:  {3008, "ERROR_PRINT_MONITOR_IN_USE", "The specified print monitor is currently in use."},
+	3009:  {3009, "ERROR_PRINTER_HAS_JOBS_QUEUED", "The requested operation is not allowed when there are jobs queued to the printer."},
+	3010:  {3010, "ERROR_SUCCESS_REBOOT_REQUIRED", "The requested operation is successful. Changes will not be effective until the system is rebooted."},
+	3011:  {3011, "ERROR_SUCCESS_RESTART_REQUIRED", "The requested operation is successful. Changes will not be effective until the service is restarted."},
+	3012:  {3012, "ERROR_PRINTER_NOT_FOUND", "No printers were found."},
+	3013:  {3013, "ERROR_PRINTER_DRIVER_WARNED", "The printer driver is known to be unreliable."},
+	3014:  {3014, "ERROR_PRINTER_DRIVER_BLOCKED", "The printer driver is known to harm the system."},
+	3015:  {3015, "ERROR_PRINTER_DRIVER_PACKAGE_IN_USE", "The specified printer driver package is currently in use."},
+	3016:  {3016, "ERROR_CORE_DRIVER_PACKAGE_NOT_FOUND", "Unable to find a core driver package that is required by the printer driver package."},
+	3017:  {3017, "ERROR_FAIL_REBOOT_REQUIRED", "The requested operation failed. A system reboot is required to roll back changes made."},
+	3018:  {3018, "ERROR_FAIL_REBOOT_INITIATED", "The requested operation failed. A system reboot has been initiated to roll back changes made."},
+	3019:  {3019, "ERROR_PRINTER_DRIVER_DOWNLOAD_NEEDED", "The specified printer driver was not found on the system and needs to be downloaded."},
+	3020:  {3020, "ERROR_PRINT_JOB_RESTART_REQUIRED", "The requested print job has failed to print. A print system update requires the job to be resubmitted."},
+	3021:  {3021, "ERROR_INVALID_PRINTER_DRIVER_MANIFEST", "The printer driver does not contain a valid manifest, or contains too many manifests."},
+	3022:  {3022, "ERROR_PRINTER_NOT_SHAREABLE", "The specified printer cannot be shared."},
+	3050:  {3050, "ERROR_REQUEST_PAUSED", "The operation was paused."},
+	3060:  {3060, "ERROR_APPEXEC_CONDITION_NOT_SATISFIED", "ERROR_APPEXEC_CONDITION_NOT_SATISFIED"},
+	3061:  {3061, "ERROR_APPEXEC_HANDLE_INVALIDATED", "ERROR_APPEXEC_HANDLE_INVALIDATED"},
+	3062:  {3062, "ERROR_APPEXEC_INVALID_HOST_GENERATION", "ERROR_APPEXEC_INVALID_HOST_GENERATION"},
+	3063:  {3063, "ERROR_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION", "ERROR_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION"},
+	3064:  {3064, "ERROR_APPEXEC_INVALID_HOST_STATE", "ERROR_APPEXEC_INVALID_HOST_STATE"},
+	3065:  {3065, "ERROR_APPEXEC_NO_DONOR", "ERROR_APPEXEC_NO_DONOR"},
+	3066:  {3066, "ERROR_APPEXEC_HOST_ID_MISMATCH", "ERROR_APPEXEC_HOST_ID_MISMATCH"},
+	3067:  {3067, "ERROR_APPEXEC_UNKNOWN_USER", "ERROR_APPEXEC_UNKNOWN_USER"},
+	3950:  {3950, "ERROR_IO_REISSUE_AS_CACHED", "The I/O operation was reissued as a cached operation."},
+	4000:  {4000, "ERROR_WINS_INTERNAL", "WINS encountered an error while processing the command."},
+	4001:  {4001, "ERROR_CAN_NOT_DEL_LOCAL_WINS", "The local WINS cannot be deleted."},
+	4002:  {4002, "ERROR_STATIC_INIT", "The importation from the file failed."},
+	4003:  {4003, "ERROR_INC_BACKUP", "The backup failed. Was a full backup done before?"},
+	4004:  {4004, "ERROR_FULL_BACKUP", "The backup failed. Check the directory to which you are backing the database."},
+	4005:  {4005, "ERROR_REC_NON_EXISTENT", "The name does not exist in the WINS database."},
+	4006:  {4006, "ERROR_RPL_NOT_ALLOWED", "Replication with a nonconfigured partner is not allowed."},
+	4100:  {4100, "ERROR_DHCP_ADDRESS_CONFLICT", "The DHCP client has obtained an IP address that is already in use on the network. The local interface will be disabled until the DHCP client can obtain a new address."},
+	4200:  {4200, "ERROR_WMI_GUID_NOT_FOUND", "The GUID passed was not recognized as valid by a WMI data provider."},
+	4201:  {4201, "ERROR_WMI_INSTANCE_NOT_FOUND", "The instance name passed was not recognized as valid by a WMI data provider."},
+	4202:  {4202, "ERROR_WMI_ITEMID_NOT_FOUND", "The data item ID passed was not recognized as valid by a WMI data provider."},
+	4203:  {4203, "ERROR_WMI_TRY_AGAIN", "The WMI request could not be completed and should be retried."},
+	4204:  {4204, "ERROR_WMI_DP_NOT_FOUND", "The WMI data provider could not be located."},
+	4205:  {4205, "ERROR_WMI_UNRESOLVED_INSTANCE_REF", "The WMI data provider references an instance set that has not been registered."},
+	4206:  {4206, "ERROR_WMI_ALREADY_ENABLED", "The WMI data block or event notification has already been enabled."},
+	4207:  {4207, "ERROR_WMI_GUID_DISCONNECTED", "The WMI data block is no longer available."},
+	4208:  {4208, "ERROR_WMI_SERVER_UNAVAILABLE", "The WMI data service is not available."},
+	4209:  {4209, "ERROR_WMI_DP_FAILED", "The WMI data provider failed to carry out the request."},
+	4210:  {4210, "ERROR_WMI_INVALID_MOF", "The WMI MOF information is not valid."},
+	4211:  {4211, "ERROR_WMI_INVALID_REGINFO", "The WMI registration information is not valid."},
+	4212:  {4212, "ERROR_WMI_ALREADY_DISABLED", "The WMI data block or event notification has already been disabled."},
+	4213:  {4213, "ERROR_WMI_READ_ONLY", "The WMI data item or data block is read only."},
+	4214:  {4214, "ERROR_WMI_SET_FAILURE", "The WMI data item or data block could not be changed."},
+	4250:  {4250, "ERROR_NOT_APPCONTAINER", "This operation is only valid in the context of an app container."},
+	4251:  {4251, "ERROR_APPCONTAINER_REQUIRED", "This application can only run in the context of an app container."},
+	4252:  {4252, "ERROR_NOT_SUPPORTED_IN_APPCONTAINER", "This functionality is not supported in the context of an app container."},
+	4253:  {4253, "ERROR_INVALID_PACKAGE_SID_LENGTH", "The length of the SID supplied is not a valid length for app container SIDs."},
+	4300:  {4300, "ERROR_INVALID_MEDIA", "The media identifier does not represent a valid medium."},
+	4301:  {4301, "ERROR_INVALID_LIBRARY", "The library identifier does not represent a valid library."},
+	4302:  {4302, "ERROR_INVALID_MEDIA_POOL", "The media pool identifier does not represent a valid media pool."},
+	4303:  {4303, "ERROR_DRIVE_MEDIA_MISMATCH", "The drive and medium are not compatible or exist in different libraries."},
+	4304:  {4304, "ERROR_MEDIA_OFFLINE", "The medium currently exists in an offline library and must be online to perform this operation."},
+	4305:  {4305, "ERROR_LIBRARY_OFFLINE", "The operation cannot be performed on an offline library."},
+	4306:  {4306, "ERROR_EMPTY", "The library, drive, or media pool is empty."},
+	4307:  {4307, "ERROR_NOT_EMPTY", "The library, drive, or media pool must be empty to perform this operation."},
+	4308:  {4308, "ERROR_MEDIA_UNAVAILABLE", "No media is currently available in this media pool or library."},
+	4309:  {4309, "ERROR_RESOURCE_DISABLED", "A resource required for this operation is disabled."},
+	4310:  {4310, "ERROR_INVALID_CLEANER", "The media identifier does not represent a valid cleaner."},
+	4311:  {4311, "ERROR_UNABLE_TO_CLEAN", "The drive cannot be cleaned or does not support cleaning."},
+	4312:  {4312, "ERROR_OBJECT_NOT_FOUND", "The object identifier does not represent a valid object."},
+	4313:  {4313, "ERROR_DATABASE_FAILURE", "Unable to read from or write to the database."},
+	4314:  {4314, "ERROR_DATABASE_FULL", "The database is full."},
+	4315:  {4315, "ERROR_MEDIA_INCOMPATIBLE", "The medium is not compatible with the device or media pool."},
+	4316:  {4316, "ERROR_RESOURCE_NOT_PRESENT", "The resource required for this operation does not exist."},
+	4317:  {4317, "ERROR_INVALID_OPERATION", "The operation identifier is not valid."},
+	4318:  {4318, "ERROR_MEDIA_NOT_AVAILABLE", "The media is not mounted or ready for use."},
+	4319:  {4319, "ERROR_DEVICE_NOT_AVAILABLE", "The device is not ready for use."},
+	4320:  {4320, "ERROR_REQUEST_REFUSED", "The operator or administrator has refused the request."},
+	4321:  {4321, "ERROR_INVALID_DRIVE_OBJECT", "The drive identifier does not represent a valid drive."},
+	4322:  {4322, "ERROR_LIBRARY_FULL", "Library is full. No slot is available for use."},
+	4323:  {4323, "ERROR_MEDIUM_NOT_ACCESSIBLE", "The transport cannot access the medium."},
+	4324:  {4324, "ERROR_UNABLE_TO_LOAD_MEDIUM", "Unable to load the medium into the drive."},
+	4325:  {4325, "ERROR_UNABLE_TO_INVENTORY_DRIVE", "Unable to retrieve the drive status."},
+	4326:  {4326, "ERROR_UNABLE_TO_INVENTORY_SLOT", "Unable to retrieve the slot status."},
+	4327:  {4327, "ERROR_UNABLE_TO_INVENTORY_TRANSPORT", "Unable to retrieve status about the transport."},
+	4328:  {4328, "ERROR_TRANSPORT_FULL", "Cannot use the transport because it is already in use."},
+	4329:  {4329, "ERROR_CONTROLLING_IEPORT", "Unable to open or close the inject/eject port."},
+	4330:  {4330, "ERROR_UNABLE_TO_EJECT_MOUNTED_MEDIA", "Unable to eject the medium because it is in a drive."},
+	4331:  {4331, "ERROR_CLEANER_SLOT_SET", "A cleaner slot is already reserved."},
+	4332:  {4332, "ERROR_CLEANER_SLOT_NOT_SET", "A cleaner slot is not reserved."},
+	4333:  {4333, "ERROR_CLEANER_CARTRIDGE_SPENT", "The cleaner cartridge has performed the maximum number of drive cleanings."},
+	4334:  {4334, "ERROR_UNEXPECTED_OMID", "Unexpected on-medium identifier."},
+	4335:  {4335, "ERROR_CANT_DELETE_LAST_ITEM", "The last remaining item in this group or resource cannot be deleted."},
+	4336:  {4336, "ERROR_MESSAGE_EXCEEDS_MAX_SIZE", "The message provided exceeds the maximum size allowed for this parameter."},
+	4337:  {4337, "ERROR_VOLUME_CONTAINS_SYS_FILES", "The volume contains system or paging files."},
+	4338:  {4338, "ERROR_INDIGENOUS_TYPE", "The media type cannot be removed from this library since at least one drive in the library reports it can support this media type."},
+	4339:  {4339, "ERROR_NO_SUPPORTING_DRIVES", "This offline media cannot be mounted on this system since no enabled drives are present which can be used."},
+	4340:  {4340, "ERROR_CLEANER_CARTRIDGE_INSTALLED", "A cleaner cartridge is present in the tape library."},
+	4341:  {4341, "ERROR_IEPORT_FULL", "Cannot use the inject/eject port because it is not empty."},
+	4350:  {4350, "ERROR_FILE_OFFLINE", "This file is currently not available for use on this computer."},
+	4351:  {4351, "ERROR_REMOTE_STORAGE_NOT_ACTIVE", "The remote storage service is not operational at this time."},
+	4352:  {4352, "ERROR_REMOTE_STORAGE_MEDIA_ERROR", "The remote storage service encountered a media error."},
+	4390:  {4390, "ERROR_NOT_A_REPARSE_POINT", "The file or directory is not a reparse point."},
+	4391:  {4391, "ERROR_REPARSE_ATTRIBUTE_CONFLICT", "The reparse point attribute cannot be set because it conflicts with an existing attribute."},
+	4392:  {4392, "ERROR_INVALID_REPARSE_DATA", "The data present in the reparse point buffer is invalid."},
+	4393:  {4393, "ERROR_REPARSE_TAG_INVALID", "The tag present in the reparse point buffer is invalid."},
+	4394:  {4394, "ERROR_REPARSE_TAG_MISMATCH", "There is a mismatch between the tag specified in the request and the tag present in the reparse point."},
+	4395:  {4395, "ERROR_REPARSE_POINT_ENCOUNTERED", "The object manager encountered a reparse point while retrieving an object."},
+	4400:  {4400, "ERROR_APP_DATA_NOT_FOUND", "Fast Cache data not found."},
+	4401:  {4401, "ERROR_APP_DATA_EXPIRED", "Fast Cache data expired."},
+	4402:  {4402, "ERROR_APP_DATA_CORRUPT", "Fast Cache data corrupt."},
+	4403:  {4403, "ERROR_APP_DATA_LIMIT_EXCEEDED", "Fast Cache data has exceeded its max size and cannot be updated."},
+	4404:  {4404, "ERROR_APP_DATA_REBOOT_REQUIRED", "Fast Cache has been ReArmed and requires a reboot until it can be updated."},
+	4420:  {4420, "ERROR_SECUREBOOT_ROLLBACK_DETECTED", "Secure Boot detected that rollback of protected data has been attempted."},
+	4421:  {4421, "ERROR_SECUREBOOT_POLICY_VIOLATION", "The value is protected by Secure Boot policy and cannot be modified or deleted."},
+	4422:  {4422, "ERROR_SECUREBOOT_INVALID_POLICY", "The Secure Boot policy is invalid."},
+	4423:  {4423, "ERROR_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND", "A new Secure Boot policy did not contain the current publisher on its update list."},
+	4424:  {4424, "ERROR_SECUREBOOT_POLICY_NOT_SIGNED", "The Secure Boot policy is either not signed or is signed by a non-trusted signer."},
+	4425:  {4425, "ERROR_SECUREBOOT_NOT_ENABLED", "Secure Boot is not enabled on this machine."},
+	4426:  {4426, "ERROR_SECUREBOOT_FILE_REPLACED", "Secure Boot requires that certain files and drivers are not replaced by other files or drivers."},
+	4427:  {4427, "ERROR_SECUREBOOT_POLICY_NOT_AUTHORIZED", "ERROR_SECUREBOOT_POLICY_NOT_AUTHORIZED"},
+	4428:  {4428, "ERROR_SECUREBOOT_POLICY_UNKNOWN", "ERROR_SECUREBOOT_POLICY_UNKNOWN"},
+	4429:  {4429, "ERROR_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION", "ERROR_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION"},
+	4430:  {4430, "ERROR_SECUREBOOT_PLATFORM_ID_MISMATCH", "ERROR_SECUREBOOT_PLATFORM_ID_MISMATCH"},
+	4431:  {4431, "ERROR_SECUREBOOT_POLICY_ROLLBACK_DETECTED", "ERROR_SECUREBOOT_POLICY_ROLLBACK_DETECTED"},
+	4432:  {4432, "ERROR_SECUREBOOT_POLICY_UPGRADE_MISMATCH", "ERROR_SECUREBOOT_POLICY_UPGRADE_MISMATCH"},
+	4433:  {4433, "ERROR_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING", "ERROR_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING"},
+	4434:  {4434, "ERROR_SECUREBOOT_NOT_BASE_POLICY", "ERROR_SECUREBOOT_NOT_BASE_POLICY"},
+	4435:  {4435, "ERROR_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY", "ERROR_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY"},
+	4440:  {4440, "ERROR_OFFLOAD_READ_FLT_NOT_SUPPORTED", "The copy offload read operation is not supported by a filter."},
+	4441:  {4441, "ERROR_OFFLOAD_WRITE_FLT_NOT_SUPPORTED", "The copy offload write operation is not supported by a filter."},
+	4442:  {4442, "ERROR_OFFLOAD_READ_FILE_NOT_SUPPORTED", "The copy offload read operation is not supported for the file."},
+	4443:  {4443, "ERROR_OFFLOAD_WRITE_FILE_NOT_SUPPORTED", "The copy offload write operation is not supported for the file."},
+	4444:  {4444, "ERROR_ALREADY_HAS_STREAM_ID", "This file is currently associated with a different stream id."},
+	4445:  {4445, "ERROR_SMR_GARBAGE_COLLECTION_REQUIRED", "The volume must undergo garbage collection."},
+	4446:  {4446, "ERROR_WOF_WIM_HEADER_CORRUPT", "ERROR_WOF_WIM_HEADER_CORRUPT"},
+	4447:  {4447, "ERROR_WOF_WIM_RESOURCE_TABLE_CORRUPT", "ERROR_WOF_WIM_RESOURCE_TABLE_CORRUPT"},
+	4448:  {4448, "ERROR_WOF_FILE_RESOURCE_TABLE_CORRUPT", "ERROR_WOF_FILE_RESOURCE_TABLE_CORRUPT"},
+	4500:  {4500, "ERROR_VOLUME_NOT_SIS_ENABLED", "Single Instance Storage is not available on this volume."},
+	4550:  {4550, "ERROR_SYSTEM_INTEGRITY_ROLLBACK_DETECTED", "System Integrity detected that policy rollback has been attempted."},
+	4551:  {4551, "ERROR_SYSTEM_INTEGRITY_POLICY_VIOLATION", "Your organization used Device Guard to block this app. Contact your support person for more info."},
+	4552:  {4552, "ERROR_SYSTEM_INTEGRITY_INVALID_POLICY", "The System Integrity policy is invalid."},
+	4553:  {4553, "ERROR_SYSTEM_INTEGRITY_POLICY_NOT_SIGNED", "The System Integrity policy is either not signed or is signed by a non-trusted signer."},
+	4554:  {4554, "ERROR_SYSTEM_INTEGRITY_TOO_MANY_POLICIES", "The number of System Integrity policies is out of limit."},
+	4555:  {4555, "ERROR_SYSTEM_INTEGRITY_SUPPLEMENTAL_POLICY_NOT_AUTHORIZED", "ERROR_SYSTEM_INTEGRITY_SUPPLEMENTAL_POLICY_NOT_AUTHORIZED"},
+	4560:  {4560, "ERROR_VSM_NOT_INITIALIZED", "Virtual Secure Mode (VSM) is not initialized. The hypervisor or VSM may not be present or enabled."},
+	4561:  {4561, "ERROR_VSM_DMA_PROTECTION_NOT_IN_USE", "The hypervisor is not protecting DMA because an IOMMU is not present or not enabled in the BIOS."},
+	4570:  {4570, "ERROR_PLATFORM_MANIFEST_NOT_AUTHORIZED", "The Platform Manifest file was not authorized on this machine."},
+	4571:  {4571, "ERROR_PLATFORM_MANIFEST_INVALID", "The Platform Manifest file was not valid."},
+	4572:  {4572, "ERROR_PLATFORM_MANIFEST_FILE_NOT_AUTHORIZED", "The file is not authorized on this platform because an entry was not found in the Platform Manifest."},
+	4573:  {4573, "ERROR_PLATFORM_MANIFEST_CATALOG_NOT_AUTHORIZED", "The catalog is not authorized on this platform because an entry was not found in the Platform Manifest."},
+	4574:  {4574, "ERROR_PLATFORM_MANIFEST_BINARY_ID_NOT_FOUND", "The file is not authorized on this platform because a Binary ID was not found in the embedded signature."},
+	4575:  {4575, "ERROR_PLATFORM_MANIFEST_NOT_ACTIVE", "No active Platform Manifest exists on this system."},
+	4576:  {4576, "ERROR_PLATFORM_MANIFEST_NOT_SIGNED", "The Platform Manifest file was not properly signed."},
+	5001:  {5001, "ERROR_DEPENDENT_RESOURCE_EXISTS", "The cluster resource cannot be moved to another group because other resources are dependent on it."},
+	5002:  {5002, "ERROR_DEPENDENCY_NOT_FOUND", "The cluster resource dependency cannot be found."},
+	5003:  {5003, "ERROR_DEPENDENCY_ALREADY_EXISTS", "The cluster resource cannot be made dependent on the specified resource because it is already dependent."},
+	5004:  {5004, "ERROR_RESOURCE_NOT_ONLINE", "The cluster resource is not online."},
+	5005:  {5005, "ERROR_HOST_NODE_NOT_AVAILABLE", "A cluster node is not available for this operation."},
+	5006:  {5006, "ERROR_RESOURCE_NOT_AVAILABLE", "The cluster resource is not available."},
+	5007:  {5007, "ERROR_RESOURCE_NOT_FOUND", "The cluster resource could not be found."},
+	5008:  {5008, "ERROR_SHUTDOWN_CLUSTER", "The cluster is being shut down."},
+	5009:  {5009, "ERROR_CANT_EVICT_ACTIVE_NODE", "ERROR_CANT_EVICT_ACTIVE_NODE"},
+	5010:  {5010, "ERROR_OBJECT_ALREADY_EXISTS", "The object already exists."},
+	5011:  {5011, "ERROR_OBJECT_IN_LIST", "The object is already in the list."},
+	5012:  {5012, "ERROR_GROUP_NOT_AVAILABLE", "The cluster group is not available for any new requests."},
+	5013:  {5013, "ERROR_GROUP_NOT_FOUND", "The cluster group could not be found."},
+	5014:  {5014, "ERROR_GROUP_NOT_ONLINE", "The operation could not be completed because the cluster group is not online."},
+	5015:  {5015, "ERROR_HOST_NODE_NOT_RESOURCE_OWNER", "ERROR_HOST_NODE_NOT_RESOURCE_OWNER"},
+	5016:  {5016, "ERROR_HOST_NODE_NOT_GROUP_OWNER", "ERROR_HOST_NODE_NOT_GROUP_OWNER"},
+	5017:  {5017, "ERROR_RESMON_CREATE_FAILED", "ERROR_RESMON_CREATE_FAILED"},
+	5018:  {5018, "ERROR_RESMON_ONLINE_FAILED", "ERROR_RESMON_ONLINE_FAILED"},
+	5019:  {5019, "ERROR_RESOURCE_ONLINE", "ERROR_RESOURCE_ONLINE"},
+	5020:  {5020, "ERROR_QUORUM_RESOURCE", "ERROR_QUORUM_RESOURCE"},
+	5021:  {5021, "ERROR_NOT_QUORUM_CAPABLE", "ERROR_NOT_QUORUM_CAPABLE"},
+	5022:  {5022, "ERROR_CLUSTER_SHUTTING_DOWN", "ERROR_CLUSTER_SHUTTING_DOWN"},
+	5023:  {5023, "ERROR_INVALID_STATE", "The group or resource is not in the correct state to perform the requested operation."},
+	5024:  {5024, "ERROR_RESOURCE_PROPERTIES_STORED", "ERROR_RESOURCE_PROPERTIES_STORED"},
+	5025:  {5025, "ERROR_NOT_QUORUM_CLASS", "ERROR_NOT_QUORUM_CLASS"},
+	5026:  {5026, "ERROR_CORE_RESOURCE", "ERROR_CORE_RESOURCE"},
+	5027:  {5027, "ERROR_QUORUM_RESOURCE_ONLINE_FAILED", "ERROR_QUORUM_RESOURCE_ONLINE_FAILED"},
+	5028:  {5028, "ERROR_QUORUMLOG_OPEN_FAILED", "ERROR_QUORUMLOG_OPEN_FAILED"},
+	5029:  {5029, "ERROR_CLUSTERLOG_CORRUPT", "ERROR_CLUSTERLOG_CORRUPT"},
+	5030:  {5030, "ERROR_CLUSTERLOG_RECORD_EXCEEDS_MAXSIZE", "ERROR_CLUSTERLOG_RECORD_EXCEEDS_MAXSIZE"},
+	5031:  {5031, "ERROR_CLUSTERLOG_EXCEEDS_MAXSIZE", "ERROR_CLUSTERLOG_EXCEEDS_MAXSIZE"},
+	5032:  {5032, "ERROR_CLUSTERLOG_CHKPOINT_NOT_FOUND", "ERROR_CLUSTERLOG_CHKPOINT_NOT_FOUND"},
+	5033:  {5033, "ERROR_CLUSTERLOG_NOT_ENOUGH_SPACE", "ERROR_CLUSTERLOG_NOT_ENOUGH_SPACE"},
+	5034:  {5034, "ERROR_QUORUM_OWNER_ALIVE", "ERROR_QUORUM_OWNER_ALIVE"},
+	5035:  {5035, "ERROR_NETWORK_NOT_AVAILABLE", "ERROR_NETWORK_NOT_AVAILABLE"},
+	5036:  {5036, "ERROR_NODE_NOT_AVAILABLE", "ERROR_NODE_NOT_AVAILABLE"},
+	5037:  {5037, "ERROR_ALL_NODES_NOT_AVAILABLE", "ERROR_ALL_NODES_NOT_AVAILABLE"},
+	5038:  {5038, "ERROR_RESOURCE_FAILED", "The resource failed."},
+	5039:  {5039, "ERROR_CLUSTER_INVALID_NODE", "ERROR_CLUSTER_INVALID_NODE"},
+	5040:  {5040, "ERROR_CLUSTER_NODE_EXISTS", "ERROR_CLUSTER_NODE_EXISTS"},
+	5041:  {5041, "ERROR_CLUSTER_JOIN_IN_PROGRESS", "ERROR_CLUSTER_JOIN_IN_PROGRESS"},
+	5042:  {5042, "ERROR_CLUSTER_NODE_NOT_FOUND", "ERROR_CLUSTER_NODE_NOT_FOUND"},
+	5043:  {5043, "ERROR_CLUSTER_LOCAL_NODE_NOT_FOUND", "ERROR_CLUSTER_LOCAL_NODE_NOT_FOUND"},
+	5044:  {5044, "ERROR_CLUSTER_NETWORK_EXISTS", "ERROR_CLUSTER_NETWORK_EXISTS"},
+	5045:  {5045, "ERROR_CLUSTER_NETWORK_NOT_FOUND", "ERROR_CLUSTER_NETWORK_NOT_FOUND"},
+	5046:  {5046, "ERROR_CLUSTER_NETINTERFACE_EXISTS", "ERROR_CLUSTER_NETINTERFACE_EXISTS"},
+	5047:  {5047, "ERROR_CLUSTER_NETINTERFACE_NOT_FOUND", "ERROR_CLUSTER_NETINTERFACE_NOT_FOUND"},
+	5048:  {5048, "ERROR_CLUSTER_INVALID_REQUEST", "ERROR_CLUSTER_INVALID_REQUEST"},
+	5049:  {5049, "ERROR_CLUSTER_INVALID_NETWORK_PROVIDER", "ERROR_CLUSTER_INVALID_NETWORK_PROVIDER"},
+	5050:  {5050, "ERROR_CLUSTER_NODE_DOWN", "ERROR_CLUSTER_NODE_DOWN"},
+	5051:  {5051, "ERROR_CLUSTER_NODE_UNREACHABLE", "ERROR_CLUSTER_NODE_UNREACHABLE"},
+	5052:  {5052, "ERROR_CLUSTER_NODE_NOT_MEMBER", "ERROR_CLUSTER_NODE_NOT_MEMBER"},
+	5053:  {5053, "ERROR_CLUSTER_JOIN_NOT_IN_PROGRESS", "ERROR_CLUSTER_JOIN_NOT_IN_PROGRESS"},
+	5054:  {5054, "ERROR_CLUSTER_INVALID_NETWORK", "ERROR_CLUSTER_INVALID_NETWORK"},
+	5056:  {5056, "ERROR_CLUSTER_NODE_UP", "ERROR_CLUSTER_NODE_UP"},
+	5057:  {5057, "ERROR_CLUSTER_IPADDR_IN_USE", "ERROR_CLUSTER_IPADDR_IN_USE"},
+	5058:  {5058, "ERROR_CLUSTER_NODE_NOT_PAUSED", "ERROR_CLUSTER_NODE_NOT_PAUSED"},
+	5059:  {5059, "ERROR_CLUSTER_NO_SECURITY_CONTEXT", "ERROR_CLUSTER_NO_SECURITY_CONTEXT"},
+	5060:  {5060, "ERROR_CLUSTER_NETWORK_NOT_INTERNAL", "ERROR_CLUSTER_NETWORK_NOT_INTERNAL"},
+	5061:  {5061, "ERROR_CLUSTER_NODE_ALREADY_UP", "ERROR_CLUSTER_NODE_ALREADY_UP"},
+	5062:  {5062, "ERROR_CLUSTER_NODE_ALREADY_DOWN", "ERROR_CLUSTER_NODE_ALREADY_DOWN"},
+	5063:  {5063, "ERROR_CLUSTER_NETWORK_ALREADY_ONLINE", "ERROR_CLUSTER_NETWORK_ALREADY_ONLINE"},
+	5064:  {5064, "ERROR_CLUSTER_NETWORK_ALREADY_OFFLINE", "ERROR_CLUSTER_NETWORK_ALREADY_OFFLINE"},
+	5065:  {5065, "ERROR_CLUSTER_NODE_ALREADY_MEMBER", "ERROR_CLUSTER_NODE_ALREADY_MEMBER"},
+	5066:  {5066, "ERROR_CLUSTER_LAST_INTERNAL_NETWORK", "ERROR_CLUSTER_LAST_INTERNAL_NETWORK"},
+	5067:  {5067, "ERROR_CLUSTER_NETWORK_HAS_DEPENDENTS", "ERROR_CLUSTER_NETWORK_HAS_DEPENDENTS"},
+	5068:  {5068, "ERROR_INVALID_OPERATION_ON_QUORUM", "ERROR_INVALID_OPERATION_ON_QUORUM"},
+	5069:  {5069, "ERROR_DEPENDENCY_NOT_ALLOWED", "ERROR_DEPENDENCY_NOT_ALLOWED"},
+	5070:  {5070, "ERROR_CLUSTER_NODE_PAUSED", "ERROR_CLUSTER_NODE_PAUSED"},
+	5071:  {5071, "ERROR_NODE_CANT_HOST_RESOURCE", "ERROR_NODE_CANT_HOST_RESOURCE"},
+	5072:  {5072, "ERROR_CLUSTER_NODE_NOT_READY", "ERROR_CLUSTER_NODE_NOT_READY"},
+	5073:  {5073, "ERROR_CLUSTER_NODE_SHUTTING_DOWN", "ERROR_CLUSTER_NODE_SHUTTING_DOWN"},
+	5074:  {5074, "ERROR_CLUSTER_JOIN_ABORTED", "ERROR_CLUSTER_JOIN_ABORTED"},
+	5075:  {5075, "ERROR_CLUSTER_INCOMPATIBLE_VERSIONS", "ERROR_CLUSTER_INCOMPATIBLE_VERSIONS"},
+	5076:  {5076, "ERROR_CLUSTER_MAXNUM_OF_RESOURCES_EXCEEDED", "ERROR_CLUSTER_MAXNUM_OF_RESOURCES_EXCEEDED"},
+	5077:  {5077, "ERROR_CLUSTER_SYSTEM_CONFIG_CHANGED", "ERROR_CLUSTER_SYSTEM_CONFIG_CHANGED"},
+	5078:  {5078, "ERROR_CLUSTER_RESOURCE_TYPE_NOT_FOUND", "ERROR_CLUSTER_RESOURCE_TYPE_NOT_FOUND"},
+	5079:  {5079, "ERROR_CLUSTER_RESTYPE_NOT_SUPPORTED", "ERROR_CLUSTER_RESTYPE_NOT_SUPPORTED"},
+	5080:  {5080, "ERROR_CLUSTER_RESNAME_NOT_FOUND", "ERROR_CLUSTER_RESNAME_NOT_FOUND"},
+	5081:  {5081, "ERROR_CLUSTER_NO_RPC_PACKAGES_REGISTERED", "ERROR_CLUSTER_NO_RPC_PACKAGES_REGISTERED"},
+	5082:  {5082, "ERROR_CLUSTER_OWNER_NOT_IN_PREFLIST", "ERROR_CLUSTER_OWNER_NOT_IN_PREFLIST"},
+	5083:  {5083, "ERROR_CLUSTER_DATABASE_SEQMISMATCH", "ERROR_CLUSTER_DATABASE_SEQMISMATCH"},
+	5084:  {5084, "ERROR_RESMON_INVALID_STATE", "ERROR_RESMON_INVALID_STATE"},
+	5085:  {5085, "ERROR_CLUSTER_GUM_NOT_LOCKER", "ERROR_CLUSTER_GUM_NOT_LOCKER"},
+	5086:  {5086, "ERROR_QUORUM_DISK_NOT_FOUND", "ERROR_QUORUM_DISK_NOT_FOUND"},
+	5087:  {5087, "ERROR_DATABASE_BACKUP_CORRUPT", "ERROR_DATABASE_BACKUP_CORRUPT"},
+	5088:  {5088, "ERROR_CLUSTER_NODE_ALREADY_HAS_DFS_ROOT", "ERROR_CLUSTER_NODE_ALREADY_HAS_DFS_ROOT"},
+	5089:  {5089, "ERROR_RESOURCE_PROPERTY_UNCHANGEABLE", "ERROR_RESOURCE_PROPERTY_UNCHANGEABLE"},
+	5090:  {5090, "ERROR_NO_ADMIN_ACCESS_POINT", "ERROR_NO_ADMIN_ACCESS_POINT"},
+	5890:  {5890, "ERROR_CLUSTER_MEMBERSHIP_INVALID_STATE", "ERROR_CLUSTER_MEMBERSHIP_INVALID_STATE"},
+	5891:  {5891, "ERROR_CLUSTER_QUORUMLOG_NOT_FOUND", "ERROR_CLUSTER_QUORUMLOG_NOT_FOUND"},
+	5892:  {5892, "ERROR_CLUSTER_MEMBERSHIP_HALT", "ERROR_CLUSTER_MEMBERSHIP_HALT"},
+	5893:  {5893, "ERROR_CLUSTER_INSTANCE_ID_MISMATCH", "ERROR_CLUSTER_INSTANCE_ID_MISMATCH"},
+	5894:  {5894, "ERROR_CLUSTER_NETWORK_NOT_FOUND_FOR_IP", "ERROR_CLUSTER_NETWORK_NOT_FOUND_FOR_IP"},
+	5895:  {5895, "ERROR_CLUSTER_PROPERTY_DATA_TYPE_MISMATCH", "ERROR_CLUSTER_PROPERTY_DATA_TYPE_MISMATCH"},
+	5896:  {5896, "ERROR_CLUSTER_EVICT_WITHOUT_CLEANUP", "ERROR_CLUSTER_EVICT_WITHOUT_CLEANUP"},
+	5897:  {5897, "ERROR_CLUSTER_PARAMETER_MISMATCH", "ERROR_CLUSTER_PARAMETER_MISMATCH"},
+	5898:  {5898, "ERROR_NODE_CANNOT_BE_CLUSTERED", "ERROR_NODE_CANNOT_BE_CLUSTERED"},
+	5899:  {5899, "ERROR_CLUSTER_WRONG_OS_VERSION", "ERROR_CLUSTER_WRONG_OS_VERSION"},
+	5900:  {5900, "ERROR_CLUSTER_CANT_CREATE_DUP_CLUSTER_NAME", "ERROR_CLUSTER_CANT_CREATE_DUP_CLUSTER_NAME"},
+	5901:  {5901, "ERROR_CLUSCFG_ALREADY_COMMITTED", "ERROR_CLUSCFG_ALREADY_COMMITTED"},
+	5902:  {5902, "ERROR_CLUSCFG_ROLLBACK_FAILED", "ERROR_CLUSCFG_ROLLBACK_FAILED"},
+	5903:  {5903, "ERROR_CLUSCFG_SYSTEM_DISK_DRIVE_LETTER_CONFLICT", "ERROR_CLUSCFG_SYSTEM_DISK_DRIVE_LETTER_CONFLICT"},
+	5904:  {5904, "ERROR_CLUSTER_OLD_VERSION", "ERROR_CLUSTER_OLD_VERSION"},
+	5905:  {5905, "ERROR_CLUSTER_MISMATCHED_COMPUTER_ACCT_NAME", "ERROR_CLUSTER_MISMATCHED_COMPUTER_ACCT_NAME"},
+	5906:  {5906, "ERROR_CLUSTER_NO_NET_ADAPTERS", "ERROR_CLUSTER_NO_NET_ADAPTERS"},
+	5907:  {5907, "ERROR_CLUSTER_POISONED", "ERROR_CLUSTER_POISONED"},
+	5908:  {5908, "ERROR_CLUSTER_GROUP_MOVING", "ERROR_CLUSTER_GROUP_MOVING"},
+	5909:  {5909, "ERROR_CLUSTER_RESOURCE_TYPE_BUSY", "ERROR_CLUSTER_RESOURCE_TYPE_BUSY"},
+	5910:  {5910, "ERROR_RESOURCE_CALL_TIMED_OUT", "The call to a cluster resource DLL timed out."},
+	5911:  {5911, "ERROR_INVALID_CLUSTER_IPV6_ADDRESS", "ERROR_INVALID_CLUSTER_IPV6_ADDRESS"},
+	5912:  {5912, "ERROR_CLUSTER_INTERNAL_INVALID_FUNCTION", "ERROR_CLUSTER_INTERNAL_INVALID_FUNCTION"},
+	5913:  {5913, "ERROR_CLUSTER_PARAMETER_OUT_OF_BOUNDS", "ERROR_CLUSTER_PARAMETER_OUT_OF_BOUNDS"},
+	5914:  {5914, "ERROR_CLUSTER_PARTIAL_SEND", "ERROR_CLUSTER_PARTIAL_SEND"},
+	5915:  {5915, "ERROR_CLUSTER_REGISTRY_INVALID_FUNCTION", "ERROR_CLUSTER_REGISTRY_INVALID_FUNCTION"},
+	5916:  {5916, "ERROR_CLUSTER_INVALID_STRING_TERMINATION", "ERROR_CLUSTER_INVALID_STRING_TERMINATION"},
+	5917:  {5917, "ERROR_CLUSTER_INVALID_STRING_FORMAT", "ERROR_CLUSTER_INVALID_STRING_FORMAT"},
+	5918:  {5918, "ERROR_CLUSTER_DATABASE_TRANSACTION_IN_PROGRESS", "ERROR_CLUSTER_DATABASE_TRANSACTION_IN_PROGRESS"},
+	5919:  {5919, "ERROR_CLUSTER_DATABASE_TRANSACTION_NOT_IN_PROGRESS", "ERROR_CLUSTER_DATABASE_TRANSACTION_NOT_IN_PROGRESS"},
+	5920:  {5920, "ERROR_CLUSTER_NULL_DATA", "ERROR_CLUSTER_NULL_DATA"},
+	5921:  {5921, "ERROR_CLUSTER_PARTIAL_READ", "ERROR_CLUSTER_PARTIAL_READ"},
+	5922:  {5922, "ERROR_CLUSTER_PARTIAL_WRITE", "ERROR_CLUSTER_PARTIAL_WRITE"},
+	5923:  {5923, "ERROR_CLUSTER_CANT_DESERIALIZE_DATA", "ERROR_CLUSTER_CANT_DESERIALIZE_DATA"},
+	5924:  {5924, "ERROR_DEPENDENT_RESOURCE_PROPERTY_CONFLICT", "ERROR_DEPENDENT_RESOURCE_PROPERTY_CONFLICT"},
+	5925:  {5925, "ERROR_CLUSTER_NO_QUORUM", "ERROR_CLUSTER_NO_QUORUM"},
+	5926:  {5926, "ERROR_CLUSTER_INVALID_IPV6_NETWORK", "ERROR_CLUSTER_INVALID_IPV6_NETWORK"},
+	5927:  {5927, "ERROR_CLUSTER_INVALID_IPV6_TUNNEL_NETWORK", "ERROR_CLUSTER_INVALID_IPV6_TUNNEL_NETWORK"},
+	5928:  {5928, "ERROR_QUORUM_NOT_ALLOWED_IN_THIS_GROUP", "ERROR_QUORUM_NOT_ALLOWED_IN_THIS_GROUP"},
+	5929:  {5929, "ERROR_DEPENDENCY_TREE_TOO_COMPLEX", "ERROR_DEPENDENCY_TREE_TOO_COMPLEX"},
+	5930:  {5930, "ERROR_EXCEPTION_IN_RESOURCE_CALL", "ERROR_EXCEPTION_IN_RESOURCE_CALL"},
+	5931:  {5931, "ERROR_CLUSTER_RHS_FAILED_INITIALIZATION", "ERROR_CLUSTER_RHS_FAILED_INITIALIZATION"},
+	5932:  {5932, "ERROR_CLUSTER_NOT_INSTALLED", "ERROR_CLUSTER_NOT_INSTALLED"},
+	5933:  {5933, "ERROR_CLUSTER_RESOURCES_MUST_BE_ONLINE_ON_THE_SAME_NODE", "ERROR_CLUSTER_RESOURCES_MUST_BE_ONLINE_ON_THE_SAME_NODE"},
+	5934:  {5934, "ERROR_CLUSTER_MAX_NODES_IN_CLUSTER", "ERROR_CLUSTER_MAX_NODES_IN_CLUSTER"},
+	5935:  {5935, "ERROR_CLUSTER_TOO_MANY_NODES", "ERROR_CLUSTER_TOO_MANY_NODES"},
+	5936:  {5936, "ERROR_CLUSTER_OBJECT_ALREADY_USED", "ERROR_CLUSTER_OBJECT_ALREADY_USED"},
+	5937:  {5937, "ERROR_NONCORE_GROUPS_FOUND", "ERROR_NONCORE_GROUPS_FOUND"},
+	5938:  {5938, "ERROR_FILE_SHARE_RESOURCE_CONFLICT", "ERROR_FILE_SHARE_RESOURCE_CONFLICT"},
+	5939:  {5939, "ERROR_CLUSTER_EVICT_INVALID_REQUEST", "ERROR_CLUSTER_EVICT_INVALID_REQUEST"},
+	5940:  {5940, "ERROR_CLUSTER_SINGLETON_RESOURCE", "ERROR_CLUSTER_SINGLETON_RESOURCE"},
+	5941:  {5941, "ERROR_CLUSTER_GROUP_SINGLETON_RESOURCE", "ERROR_CLUSTER_GROUP_SINGLETON_RESOURCE"},
+	5942:  {5942, "ERROR_CLUSTER_RESOURCE_PROVIDER_FAILED", "ERROR_CLUSTER_RESOURCE_PROVIDER_FAILED"},
+	5943:  {5943, "ERROR_CLUSTER_RESOURCE_CONFIGURATION_ERROR", "ERROR_CLUSTER_RESOURCE_CONFIGURATION_ERROR"},
+	5944:  {5944, "ERROR_CLUSTER_GROUP_BUSY", "ERROR_CLUSTER_GROUP_BUSY"},
+	5945:  {5945, "ERROR_CLUSTER_NOT_SHARED_VOLUME", "ERROR_CLUSTER_NOT_SHARED_VOLUME"},
+	5946:  {5946, "ERROR_CLUSTER_INVALID_SECURITY_DESCRIPTOR", "ERROR_CLUSTER_INVALID_SECURITY_DESCRIPTOR"},
+	5947:  {5947, "ERROR_CLUSTER_SHARED_VOLUMES_IN_USE", "ERROR_CLUSTER_SHARED_VOLUMES_IN_USE"},
+	5948:  {5948, "ERROR_CLUSTER_USE_SHARED_VOLUMES_API", "ERROR_CLUSTER_USE_SHARED_VOLUMES_API"},
+	5949:  {5949, "ERROR_CLUSTER_BACKUP_IN_PROGRESS", "ERROR_CLUSTER_BACKUP_IN_PROGRESS"},
+	5950:  {5950, "ERROR_NON_CSV_PATH", "ERROR_NON_CSV_PATH"},
+	5951:  {5951, "ERROR_CSV_VOLUME_NOT_LOCAL", "ERROR_CSV_VOLUME_NOT_LOCAL"},
+	5952:  {5952, "ERROR_CLUSTER_WATCHDOG_TERMINATING", "ERROR_CLUSTER_WATCHDOG_TERMINATING"},
+	5953:  {5953, "ERROR_CLUSTER_RESOURCE_VETOED_MOVE_INCOMPATIBLE_NODES", "ERROR_CLUSTER_RESOURCE_VETOED_MOVE_INCOMPATIBLE_NODES"},
+	5954:  {5954, "ERROR_CLUSTER_INVALID_NODE_WEIGHT", "ERROR_CLUSTER_INVALID_NODE_WEIGHT"},
+	5955:  {5955, "ERROR_CLUSTER_RESOURCE_VETOED_CALL", "ERROR_CLUSTER_RESOURCE_VETOED_CALL"},
+	5956:  {5956, "ERROR_RESMON_SYSTEM_RESOURCES_LACKING", "ERROR_RESMON_SYSTEM_RESOURCES_LACKING"},
+	5957:  {5957, "ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_DESTINATION", "ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_DESTINATION"},
+	5958:  {5958, "ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_SOURCE", "ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_SOURCE"},
+	5959:  {5959, "ERROR_CLUSTER_GROUP_QUEUED", "ERROR_CLUSTER_GROUP_QUEUED"},
+	5960:  {5960, "ERROR_CLUSTER_RESOURCE_LOCKED_STATUS", "ERROR_CLUSTER_RESOURCE_LOCKED_STATUS"},
+	5961:  {5961, "ERROR_CLUSTER_SHARED_VOLUME_FAILOVER_NOT_ALLOWED", "ERROR_CLUSTER_SHARED_VOLUME_FAILOVER_NOT_ALLOWED"},
+	5962:  {5962, "ERROR_CLUSTER_NODE_DRAIN_IN_PROGRESS", "ERROR_CLUSTER_NODE_DRAIN_IN_PROGRESS"},
+	5963:  {5963, "ERROR_CLUSTER_DISK_NOT_CONNECTED", "ERROR_CLUSTER_DISK_NOT_CONNECTED"},
+	5964:  {5964, "ERROR_DISK_NOT_CSV_CAPABLE", "ERROR_DISK_NOT_CSV_CAPABLE"},
+	5965:  {5965, "ERROR_RESOURCE_NOT_IN_AVAILABLE_STORAGE", "ERROR_RESOURCE_NOT_IN_AVAILABLE_STORAGE"},
+	5966:  {5966, "ERROR_CLUSTER_SHARED_VOLUME_REDIRECTED", "ERROR_CLUSTER_SHARED_VOLUME_REDIRECTED"},
+	5967:  {5967, "ERROR_CLUSTER_SHARED_VOLUME_NOT_REDIRECTED", "ERROR_CLUSTER_SHARED_VOLUME_NOT_REDIRECTED"},
+	5968:  {5968, "ERROR_CLUSTER_CANNOT_RETURN_PROPERTIES", "ERROR_CLUSTER_CANNOT_RETURN_PROPERTIES"},
+	5969:  {5969, "ERROR_CLUSTER_RESOURCE_CONTAINS_UNSUPPORTED_DIFF_AREA_FOR_SHARED_VOLUMES", "ERROR_CLUSTER_RESOURCE_CONTAINS_UNSUPPORTED_DIFF_AREA_FOR_SHARED_VOLUMES"},
+	5970:  {5970, "ERROR_CLUSTER_RESOURCE_IS_IN_MAINTENANCE_MODE", "ERROR_CLUSTER_RESOURCE_IS_IN_MAINTENANCE_MODE"},
+	5971:  {5971, "ERROR_CLUSTER_AFFINITY_CONFLICT", "ERROR_CLUSTER_AFFINITY_CONFLICT"},
+	5972:  {5972, "ERROR_CLUSTER_RESOURCE_IS_REPLICA_VIRTUAL_MACHINE", "ERROR_CLUSTER_RESOURCE_IS_REPLICA_VIRTUAL_MACHINE"},
+	5973:  {5973, "ERROR_CLUSTER_UPGRADE_INCOMPATIBLE_VERSIONS", "ERROR_CLUSTER_UPGRADE_INCOMPATIBLE_VERSIONS"},
+	5974:  {5974, "ERROR_CLUSTER_UPGRADE_FIX_QUORUM_NOT_SUPPORTED", "ERROR_CLUSTER_UPGRADE_FIX_QUORUM_NOT_SUPPORTED"},
+	5975:  {5975, "ERROR_CLUSTER_UPGRADE_RESTART_REQUIRED", "ERROR_CLUSTER_UPGRADE_RESTART_REQUIRED"},
+	5976:  {5976, "ERROR_CLUSTER_UPGRADE_IN_PROGRESS", "ERROR_CLUSTER_UPGRADE_IN_PROGRESS"},
+	5977:  {5977, "ERROR_CLUSTER_UPGRADE_INCOMPLETE", "ERROR_CLUSTER_UPGRADE_INCOMPLETE"},
+	5978:  {5978, "ERROR_CLUSTER_NODE_IN_GRACE_PERIOD", "ERROR_CLUSTER_NODE_IN_GRACE_PERIOD"},
+	5979:  {5979, "ERROR_CLUSTER_CSV_IO_PAUSE_TIMEOUT", "ERROR_CLUSTER_CSV_IO_PAUSE_TIMEOUT"},
+	5980:  {5980, "ERROR_NODE_NOT_ACTIVE_CLUSTER_MEMBER", "ERROR_NODE_NOT_ACTIVE_CLUSTER_MEMBER"},
+	5981:  {5981, "ERROR_CLUSTER_RESOURCE_NOT_MONITORED", "ERROR_CLUSTER_RESOURCE_NOT_MONITORED"},
+	5982:  {5982, "ERROR_CLUSTER_RESOURCE_DOES_NOT_SUPPORT_UNMONITORED", "ERROR_CLUSTER_RESOURCE_DOES_NOT_SUPPORT_UNMONITORED"},
+	5983:  {5983, "ERROR_CLUSTER_RESOURCE_IS_REPLICATED", "ERROR_CLUSTER_RESOURCE_IS_REPLICATED"},
+	5984:  {5984, "ERROR_CLUSTER_NODE_ISOLATED", "ERROR_CLUSTER_NODE_ISOLATED"},
+	5985:  {5985, "ERROR_CLUSTER_NODE_QUARANTINED", "ERROR_CLUSTER_NODE_QUARANTINED"},
+	5986:  {5986, "ERROR_CLUSTER_DATABASE_UPDATE_CONDITION_FAILED", "ERROR_CLUSTER_DATABASE_UPDATE_CONDITION_FAILED"},
+	5987:  {5987, "ERROR_CLUSTER_SPACE_DEGRADED", "ERROR_CLUSTER_SPACE_DEGRADED"},
+	5988:  {5988, "ERROR_CLUSTER_TOKEN_DELEGATION_NOT_SUPPORTED", "ERROR_CLUSTER_TOKEN_DELEGATION_NOT_SUPPORTED"},
+	5989:  {5989, "ERROR_CLUSTER_CSV_INVALID_HANDLE", "ERROR_CLUSTER_CSV_INVALID_HANDLE"},
+	5990:  {5990, "ERROR_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR", "ERROR_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR"},
+	5991:  {5991, "ERROR_GROUPSET_NOT_AVAILABLE", "ERROR_GROUPSET_NOT_AVAILABLE"},
+	5992:  {5992, "ERROR_GROUPSET_NOT_FOUND", "ERROR_GROUPSET_NOT_FOUND"},
+	5993:  {5993, "ERROR_GROUPSET_CANT_PROVIDE", "ERROR_GROUPSET_CANT_PROVIDE"},
+	5994:  {5994, "ERROR_CLUSTER_FAULT_DOMAIN_PARENT_NOT_FOUND", "ERROR_CLUSTER_FAULT_DOMAIN_PARENT_NOT_FOUND"},
+	5995:  {5995, "ERROR_CLUSTER_FAULT_DOMAIN_INVALID_HIERARCHY", "ERROR_CLUSTER_FAULT_DOMAIN_INVALID_HIERARCHY"},
+	5996:  {5996, "ERROR_CLUSTER_FAULT_DOMAIN_FAILED_S2D_VALIDATION", "ERROR_CLUSTER_FAULT_DOMAIN_FAILED_S2D_VALIDATION"},
+	5997:  {5997, "ERROR_CLUSTER_FAULT_DOMAIN_S2D_CONNECTIVITY_LOSS", "ERROR_CLUSTER_FAULT_DOMAIN_S2D_CONNECTIVITY_LOSS"},
+	5998:  {5998, "ERROR_CLUSTER_INVALID_INFRASTRUCTURE_FILESERVER_NAME", "ERROR_CLUSTER_INVALID_INFRASTRUCTURE_FILESERVER_NAME"},
+	5999:  {5999, "ERROR_CLUSTERSET_MANAGEMENT_CLUSTER_UNREACHABLE", "ERROR_CLUSTERSET_MANAGEMENT_CLUSTER_UNREACHABLE"},
+	6000:  {6000, "ERROR_ENCRYPTION_FAILED", "The specified file could not be encrypted."},
+	6001:  {6001, "ERROR_DECRYPTION_FAILED", "The specified file could not be decrypted."},
+	6002:  {6002, "ERROR_FILE_ENCRYPTED", "The specified file is encrypted and the user does not have the ability to decrypt it."},
+	6003:  {6003, "ERROR_NO_RECOVERY_POLICY", "There is no valid encryption recovery policy configured for this system."},
+	6004:  {6004, "ERROR_NO_EFS", "The required encryption driver is not loaded for this system."},
+	6005:  {6005, "ERROR_WRONG_EFS", "The file was encrypted with a different encryption driver than is currently loaded."},
+	6006:  {6006, "ERROR_NO_USER_KEYS", "There are no EFS keys defined for the user."},
+	6007:  {6007, "ERROR_FILE_NOT_ENCRYPTED", "The specified file is not encrypted."},
+	6008:  {6008, "ERROR_NOT_EXPORT_FORMAT", "The specified file is not in the defined EFS export format."},
+	6009:  {6009, "ERROR_FILE_READ_ONLY", "The specified file is read only."},
+	6010:  {6010, "ERROR_DIR_EFS_DISALLOWED", "The directory has been disabled for encryption."},
+	6011:  {6011, "ERROR_EFS_SERVER_NOT_TRUSTED", "The server is not trusted for remote encryption operation."},
+	6012:  {6012, "ERROR_BAD_RECOVERY_POLICY", "Recovery policy configured for this system contains invalid recovery certificate."},
+	6013:  {6013, "ERROR_EFS_ALG_BLOB_TOO_BIG", "The encryption algorithm used on the source file needs a bigger key buffer than the one on the destination file."},
+	6014:  {6014, "ERROR_VOLUME_NOT_SUPPORT_EFS", "The disk partition does not support file encryption."},
+	6015:  {6015, "ERROR_EFS_DISABLED", "This machine is disabled for file encryption."},
+	6016:  {6016, "ERROR_EFS_VERSION_NOT_SUPPORT", "A newer system is required to decrypt this encrypted file."},
+	6017:  {6017, "ERROR_CS_ENCRYPTION_INVALID_SERVER_RESPONSE", "ERROR_CS_ENCRYPTION_INVALID_SERVER_RESPONSE"},
+	6018:  {6018, "ERROR_CS_ENCRYPTION_UNSUPPORTED_SERVER", "ERROR_CS_ENCRYPTION_UNSUPPORTED_SERVER"},
+	6019:  {6019, "ERROR_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE", "ERROR_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE"},
+	6020:  {6020, "ERROR_CS_ENCRYPTION_NEW_ENCRYPTED_FILE", "ERROR_CS_ENCRYPTION_NEW_ENCRYPTED_FILE"},
+	6021:  {6021, "ERROR_CS_ENCRYPTION_FILE_NOT_CSE", "ERROR_CS_ENCRYPTION_FILE_NOT_CSE"},
+	6022:  {6022, "ERROR_ENCRYPTION_POLICY_DENIES_OPERATION", "ERROR_ENCRYPTION_POLICY_DENIES_OPERATION"},
+	6023:  {6023, "ERROR_WIP_ENCRYPTION_FAILED", "The specified file could not be encrypted with Windows Information Protection."},
+	6118:  {6118, "ERROR_NO_BROWSER_SERVERS_FOUND", "The list of servers for this workgroup is not currently available."},
+	6600:  {6600, "ERROR_LOG_SECTOR_INVALID", "ERROR_LOG_SECTOR_INVALID"},
+	6601:  {6601, "ERROR_LOG_SECTOR_PARITY_INVALID", "ERROR_LOG_SECTOR_PARITY_INVALID"},
+	6602:  {6602, "ERROR_LOG_SECTOR_REMAPPED", "ERROR_LOG_SECTOR_REMAPPED"},
+	6603:  {6603, "ERROR_LOG_BLOCK_INCOMPLETE", "ERROR_LOG_BLOCK_INCOMPLETE"},
+	6604:  {6604, "ERROR_LOG_INVALID_RANGE", "ERROR_LOG_INVALID_RANGE"},
+	6605:  {6605, "ERROR_LOG_BLOCKS_EXHAUSTED", "ERROR_LOG_BLOCKS_EXHAUSTED"},
+	6606:  {6606, "ERROR_LOG_READ_CONTEXT_INVALID", "ERROR_LOG_READ_CONTEXT_INVALID"},
+	6607:  {6607, "ERROR_LOG_RESTART_INVALID", "ERROR_LOG_RESTART_INVALID"},
+	6608:  {6608, "ERROR_LOG_BLOCK_VERSION", "ERROR_LOG_BLOCK_VERSION"},
+	6609:  {6609, "ERROR_LOG_BLOCK_INVALID", "ERROR_LOG_BLOCK_INVALID"},
+	6610:  {6610, "ERROR_LOG_READ_MODE_INVALID", "ERROR_LOG_READ_MODE_INVALID"},
+	6611:  {6611, "ERROR_LOG_NO_RESTART", "ERROR_LOG_NO_RESTART"},
+	6612:  {6612, "ERROR_LOG_METADATA_CORRUPT", "ERROR_LOG_METADATA_CORRUPT"},
+	6613:  {6613, "ERROR_LOG_METADATA_INVALID", "ERROR_LOG_METADATA_INVALID"},
+	6614:  {6614, "ERROR_LOG_METADATA_INCONSISTENT", "ERROR_LOG_METADATA_INCONSISTENT"},
+	6615:  {6615, "ERROR_LOG_RESERVATION_INVALID", "ERROR_LOG_RESERVATION_INVALID"},
+	6616:  {6616, "ERROR_LOG_CANT_DELETE", "ERROR_LOG_CANT_DELETE"},
+	6617:  {6617, "ERROR_LOG_CONTAINER_LIMIT_EXCEEDED", "ERROR_LOG_CONTAINER_LIMIT_EXCEEDED"},
+	6618:  {6618, "ERROR_LOG_START_OF_LOG", "ERROR_LOG_START_OF_LOG"},
+	6619:  {6619, "ERROR_LOG_POLICY_ALREADY_INSTALLED", "ERROR_LOG_POLICY_ALREADY_INSTALLED"},
+	6620:  {6620, "ERROR_LOG_POLICY_NOT_INSTALLED", "ERROR_LOG_POLICY_NOT_INSTALLED"},
+	6621:  {6621, "ERROR_LOG_POLICY_INVALID", "ERROR_LOG_POLICY_INVALID"},
+	6622:  {6622, "ERROR_LOG_POLICY_CONFLICT", "ERROR_LOG_POLICY_CONFLICT"},
+	6623:  {6623, "ERROR_LOG_PINNED_ARCHIVE_TAIL", "ERROR_LOG_PINNED_ARCHIVE_TAIL"},
+	6624:  {6624, "ERROR_LOG_RECORD_NONEXISTENT", "ERROR_LOG_RECORD_NONEXISTENT"},
+	6625:  {6625, "ERROR_LOG_RECORDS_RESERVED_INVALID", "ERROR_LOG_RECORDS_RESERVED_INVALID"},
+	6626:  {6626, "ERROR_LOG_SPACE_RESERVED_INVALID", "ERROR_LOG_SPACE_RESERVED_INVALID"},
+	6627:  {6627, "ERROR_LOG_TAIL_INVALID", "ERROR_LOG_TAIL_INVALID"},
+	6628:  {6628, "ERROR_LOG_FULL", "Log space is exhausted."},
+	6629:  {6629, "ERROR_COULD_NOT_RESIZE_LOG", "ERROR_COULD_NOT_RESIZE_LOG"},
+	6630:  {6630, "ERROR_LOG_MULTIPLEXED", "ERROR_LOG_MULTIPLEXED"},
+	6631:  {6631, "ERROR_LOG_DEDICATED", "ERROR_LOG_DEDICATED"},
+	6632:  {6632, "ERROR_LOG_ARCHIVE_NOT_IN_PROGRESS", "ERROR_LOG_ARCHIVE_NOT_IN_PROGRESS"},
+	6633:  {6633, "ERROR_LOG_ARCHIVE_IN_PROGRESS", "ERROR_LOG_ARCHIVE_IN_PROGRESS"},
+	6634:  {6634, "ERROR_LOG_EPHEMERAL", "ERROR_LOG_EPHEMERAL"},
+	6635:  {6635, "ERROR_LOG_NOT_ENOUGH_CONTAINERS", "ERROR_LOG_NOT_ENOUGH_CONTAINERS"},
+	6636:  {6636, "ERROR_LOG_CLIENT_ALREADY_REGISTERED", "ERROR_LOG_CLIENT_ALREADY_REGISTERED"},
+	6637:  {6637, "ERROR_LOG_CLIENT_NOT_REGISTERED", "ERROR_LOG_CLIENT_NOT_REGISTERED"},
+	6638:  {6638, "ERROR_LOG_FULL_HANDLER_IN_PROGRESS", "ERROR_LOG_FULL_HANDLER_IN_PROGRESS"},
+	6639:  {6639, "ERROR_LOG_CONTAINER_READ_FAILED", "ERROR_LOG_CONTAINER_READ_FAILED"},
+	6640:  {6640, "ERROR_LOG_CONTAINER_WRITE_FAILED", "ERROR_LOG_CONTAINER_WRITE_FAILED"},
+	6641:  {6641, "ERROR_LOG_CONTAINER_OPEN_FAILED", "ERROR_LOG_CONTAINER_OPEN_FAILED"},
+	6642:  {6642, "ERROR_LOG_CONTAINER_STATE_INVALID", "ERROR_LOG_CONTAINER_STATE_INVALID"},
+	6643:  {6643, "ERROR_LOG_STATE_INVALID", "ERROR_LOG_STATE_INVALID"},
+	6644:  {6644, "ERROR_LOG_PINNED", "ERROR_LOG_PINNED"},
+	6645:  {6645, "ERROR_LOG_METADATA_FLUSH_FAILED", "ERROR_LOG_METADATA_FLUSH_FAILED"},
+	6646:  {6646, "ERROR_LOG_INCONSISTENT_SECURITY", "ERROR_LOG_INCONSISTENT_SECURITY"},
+	6647:  {6647, "ERROR_LOG_APPENDED_FLUSH_FAILED", "ERROR_LOG_APPENDED_FLUSH_FAILED"},
+	6648:  {6648, "ERROR_LOG_PINNED_RESERVATION", "ERROR_LOG_PINNED_RESERVATION"},
+	6700:  {6700, "ERROR_INVALID_TRANSACTION", "The transaction handle associated with this operation is not valid."},
+	6701:  {6701, "ERROR_TRANSACTION_NOT_ACTIVE", "The requested operation was made in the context of a transaction that is no longer active."},
+	6702:  {6702, "ERROR_TRANSACTION_REQUEST_NOT_VALID", "The requested operation is not valid on the Transaction object in its current state."},
+	6703:  {6703, "ERROR_TRANSACTION_NOT_REQUESTED", "The caller has called a response API, but the response is not expected because the TM did not issue the corresponding request to the caller."},
+	6704:  {6704, "ERROR_TRANSACTION_ALREADY_ABORTED", "It is too late to perform the requested operation, since the Transaction has already been aborted."},
+	6705:  {6705, "ERROR_TRANSACTION_ALREADY_COMMITTED", "It is too late to perform the requested operation, since the Transaction has already been committed."},
+	6706:  {6706, "ERROR_TM_INITIALIZATION_FAILED", "The Transaction Manager was unable to be successfully initialized. Transacted operations are not supported."},
+	6707:  {6707, "ERROR_RESOURCEMANAGER_READ_ONLY", "The specified ResourceManager made no changes or updates to the resource under this transaction."},
+	6708:  {6708, "ERROR_TRANSACTION_NOT_JOINED", "The resource manager has attempted to prepare a transaction that it has not successfully joined."},
+	6709:  {6709, "ERROR_TRANSACTION_SUPERIOR_EXISTS", "ERROR_TRANSACTION_SUPERIOR_EXISTS"},
+	6710:  {6710, "ERROR_CRM_PROTOCOL_ALREADY_EXISTS", "ERROR_CRM_PROTOCOL_ALREADY_EXISTS"},
+	6711:  {6711, "ERROR_TRANSACTION_PROPAGATION_FAILED", "ERROR_TRANSACTION_PROPAGATION_FAILED"},
+	6712:  {6712, "ERROR_CRM_PROTOCOL_NOT_FOUND", "ERROR_CRM_PROTOCOL_NOT_FOUND"},
+	6713:  {6713, "ERROR_TRANSACTION_INVALID_MARSHALL_BUFFER", "ERROR_TRANSACTION_INVALID_MARSHALL_BUFFER"},
+	6714:  {6714, "ERROR_CURRENT_TRANSACTION_NOT_VALID", "The current transaction context associated with the thread is not a valid handle to a transaction object."},
+	6715:  {6715, "ERROR_TRANSACTION_NOT_FOUND", "The specified Transaction object could not be opened, because it was not found."},
+	6716:  {6716, "ERROR_RESOURCEMANAGER_NOT_FOUND", "The specified ResourceManager object could not be opened, because it was not found."},
+	6717:  {6717, "ERROR_ENLISTMENT_NOT_FOUND", "The specified Enlistment object could not be opened, because it was not found."},
+	6718:  {6718, "ERROR_TRANSACTIONMANAGER_NOT_FOUND", "The specified TransactionManager object could not be opened, because it was not found."},
+	6719:  {6719, "ERROR_TRANSACTIONMANAGER_NOT_ONLINE", "The object specified could not be created or opened, because its associated TransactionManager is not online. The TransactionManager must be brought fully Online by calling RecoverTransactionManager to recover to the end of its LogFile before objects in its Transaction or ResourceManager namespaces can be opened. In addition, errors in writing records to its LogFile can cause a TransactionManager to go offline."},
+	6720:  {6720, "ERROR_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION", "ERROR_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION"},
+	6721:  {6721, "ERROR_TRANSACTION_NOT_ROOT", "ERROR_TRANSACTION_NOT_ROOT"},
+	6722:  {6722, "ERROR_TRANSACTION_OBJECT_EXPIRED", "ERROR_TRANSACTION_OBJECT_EXPIRED"},
+	6723:  {6723, "ERROR_TRANSACTION_RESPONSE_NOT_ENLISTED", "ERROR_TRANSACTION_RESPONSE_NOT_ENLISTED"},
+	6724:  {6724, "ERROR_TRANSACTION_RECORD_TOO_LONG", "ERROR_TRANSACTION_RECORD_TOO_LONG"},
+	6725:  {6725, "ERROR_IMPLICIT_TRANSACTION_NOT_SUPPORTED", "ERROR_IMPLICIT_TRANSACTION_NOT_SUPPORTED"},
+	6726:  {6726, "ERROR_TRANSACTION_INTEGRITY_VIOLATED", "ERROR_TRANSACTION_INTEGRITY_VIOLATED"},
+	6727:  {6727, "ERROR_TRANSACTIONMANAGER_IDENTITY_MISMATCH", "ERROR_TRANSACTIONMANAGER_IDENTITY_MISMATCH"},
+	6728:  {6728, "ERROR_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT", "ERROR_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT"},
+	6729:  {6729, "ERROR_TRANSACTION_MUST_WRITETHROUGH", "ERROR_TRANSACTION_MUST_WRITETHROUGH"},
+	6730:  {6730, "ERROR_TRANSACTION_NO_SUPERIOR", "ERROR_TRANSACTION_NO_SUPERIOR"},
+	6731:  {6731, "ERROR_HEURISTIC_DAMAGE_POSSIBLE", "ERROR_HEURISTIC_DAMAGE_POSSIBLE"},
+	6800:  {6800, "ERROR_TRANSACTIONAL_CONFLICT", "The function attempted to use a name that is reserved for use by another transaction."},
+	6801:  {6801, "ERROR_RM_NOT_ACTIVE", "Transaction support within the specified resource manager is not started or was shut down due to an error."},
+	6802:  {6802, "ERROR_RM_METADATA_CORRUPT", "The metadata of the RM has been corrupted. The RM will not function."},
+	6803:  {6803, "ERROR_DIRECTORY_NOT_RM", "The specified directory does not contain a resource manager."},
+	6805:  {6805, "ERROR_TRANSACTIONS_UNSUPPORTED_REMOTE", "The remote server or share does not support transacted file operations."},
+	6806:  {6806, "ERROR_LOG_RESIZE_INVALID_SIZE", "The requested log size is invalid."},
+	6807:  {6807, "ERROR_OBJECT_NO_LONGER_EXISTS", "The object (file, stream, link) corresponding to the handle has been deleted by a Transaction Savepoint Rollback."},
+	6808:  {6808, "ERROR_STREAM_MINIVERSION_NOT_FOUND", "ERROR_STREAM_MINIVERSION_NOT_FOUND"},
+	6809:  {6809, "ERROR_STREAM_MINIVERSION_NOT_VALID", "ERROR_STREAM_MINIVERSION_NOT_VALID"},
+	6810:  {6810, "ERROR_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION", "ERROR_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION"},
+	6811:  {6811, "ERROR_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT", "ERROR_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT"},
+	6812:  {6812, "ERROR_CANT_CREATE_MORE_STREAM_MINIVERSIONS", "ERROR_CANT_CREATE_MORE_STREAM_MINIVERSIONS"},
+	6814:  {6814, "ERROR_REMOTE_FILE_VERSION_MISMATCH", "ERROR_REMOTE_FILE_VERSION_MISMATCH"},
+	6815:  {6815, "ERROR_HANDLE_NO_LONGER_VALID", "The handle has been invalidated by a transaction. The most likely cause is the presence of memory mapping on a file or an open handle when the transaction ended or rolled back to savepoint."},
+	6816:  {6816, "ERROR_NO_TXF_METADATA", "ERROR_NO_TXF_METADATA"},
+	6817:  {6817, "ERROR_LOG_CORRUPTION_DETECTED", "ERROR_LOG_CORRUPTION_DETECTED"},
+	6818:  {6818, "ERROR_CANT_RECOVER_WITH_HANDLE_OPEN", "ERROR_CANT_RECOVER_WITH_HANDLE_OPEN"},
+	6819:  {6819, "ERROR_RM_DISCONNECTED", "ERROR_RM_DISCONNECTED"},
+	6820:  {6820, "ERROR_ENLISTMENT_NOT_SUPERIOR", "ERROR_ENLISTMENT_NOT_SUPERIOR"},
+	6821:  {6821, "ERROR_RECOVERY_NOT_NEEDED", "ERROR_RECOVERY_NOT_NEEDED"},
+	6822:  {6822, "ERROR_RM_ALREADY_STARTED", "ERROR_RM_ALREADY_STARTED"},
+	6823:  {6823, "ERROR_FILE_IDENTITY_NOT_PERSISTENT", "ERROR_FILE_IDENTITY_NOT_PERSISTENT"},
+	6824:  {6824, "ERROR_CANT_BREAK_TRANSACTIONAL_DEPENDENCY", "ERROR_CANT_BREAK_TRANSACTIONAL_DEPENDENCY"},
+	6825:  {6825, "ERROR_CANT_CROSS_RM_BOUNDARY", "ERROR_CANT_CROSS_RM_BOUNDARY"},
+	6826:  {6826, "ERROR_TXF_DIR_NOT_EMPTY", "ERROR_TXF_DIR_NOT_EMPTY"},
+	6827:  {6827, "ERROR_INDOUBT_TRANSACTIONS_EXIST", "ERROR_INDOUBT_TRANSACTIONS_EXIST"},
+	6828:  {6828, "ERROR_TM_VOLATILE", "ERROR_TM_VOLATILE"},
+	6829:  {6829, "ERROR_ROLLBACK_TIMER_EXPIRED", "ERROR_ROLLBACK_TIMER_EXPIRED"},
+	6830:  {6830, "ERROR_TXF_ATTRIBUTE_CORRUPT", "ERROR_TXF_ATTRIBUTE_CORRUPT"},
+	6831:  {6831, "ERROR_EFS_NOT_ALLOWED_IN_TRANSACTION", "ERROR_EFS_NOT_ALLOWED_IN_TRANSACTION"},
+	6832:  {6832, "ERROR_TRANSACTIONAL_OPEN_NOT_ALLOWED", "ERROR_TRANSACTIONAL_OPEN_NOT_ALLOWED"},
+	6833:  {6833, "ERROR_LOG_GROWTH_FAILED", "ERROR_LOG_GROWTH_FAILED"},
+	6834:  {6834, "ERROR_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE", "ERROR_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE"},
+	6835:  {6835, "ERROR_TXF_METADATA_ALREADY_PRESENT", "ERROR_TXF_METADATA_ALREADY_PRESENT"},
+	6836:  {6836, "ERROR_TRANSACTION_SCOPE_CALLBACKS_NOT_SET", "ERROR_TRANSACTION_SCOPE_CALLBACKS_NOT_SET"},
+	6837:  {6837, "ERROR_TRANSACTION_REQUIRED_PROMOTION", "ERROR_TRANSACTION_REQUIRED_PROMOTION"},
+	6838:  {6838, "ERROR_CANNOT_EXECUTE_FILE_IN_TRANSACTION", "ERROR_CANNOT_EXECUTE_FILE_IN_TRANSACTION"},
+	6839:  {6839, "ERROR_TRANSACTIONS_NOT_FROZEN", "ERROR_TRANSACTIONS_NOT_FROZEN"},
+	6840:  {6840, "ERROR_TRANSACTION_FREEZE_IN_PROGRESS", "ERROR_TRANSACTION_FREEZE_IN_PROGRESS"},
+	6841:  {6841, "ERROR_NOT_SNAPSHOT_VOLUME", "ERROR_NOT_SNAPSHOT_VOLUME"},
+	6842:  {6842, "ERROR_NO_SAVEPOINT_WITH_OPEN_FILES", "ERROR_NO_SAVEPOINT_WITH_OPEN_FILES"},
+	6843:  {6843, "ERROR_DATA_LOST_REPAIR", "ERROR_DATA_LOST_REPAIR"},
+	6844:  {6844, "ERROR_SPARSE_NOT_ALLOWED_IN_TRANSACTION", "ERROR_SPARSE_NOT_ALLOWED_IN_TRANSACTION"},
+	6845:  {6845, "ERROR_TM_IDENTITY_MISMATCH", "ERROR_TM_IDENTITY_MISMATCH"},
+	6846:  {6846, "ERROR_FLOATED_SECTION", "ERROR_FLOATED_SECTION"},
+	6847:  {6847, "ERROR_CANNOT_ACCEPT_TRANSACTED_WORK", "ERROR_CANNOT_ACCEPT_TRANSACTED_WORK"},
+	6848:  {6848, "ERROR_CANNOT_ABORT_TRANSACTIONS", "ERROR_CANNOT_ABORT_TRANSACTIONS"},
+	6849:  {6849, "ERROR_BAD_CLUSTERS", "The request was rejected because the volume has clusters that are marked bad."},
+	6850:  {6850, "ERROR_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION", "ERROR_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION"},
+	6851:  {6851, "ERROR_VOLUME_DIRTY", "The operation could not be completed because the volume is dirty. Please run chkdsk and try again."},
+	6852:  {6852, "ERROR_NO_LINK_TRACKING_IN_TRANSACTION", "ERROR_NO_LINK_TRACKING_IN_TRANSACTION"},
+	6853:  {6853, "ERROR_OPERATION_NOT_SUPPORTED_IN_TRANSACTION", "ERROR_OPERATION_NOT_SUPPORTED_IN_TRANSACTION"},
+	6854:  {6854, "ERROR_EXPIRED_HANDLE", "The handle is no longer properly associated with its transaction. It may have been opened in a transactional resource manager that was subsequently forced to restart. Please close the handle and open a new one."},
+	6855:  {6855, "ERROR_TRANSACTION_NOT_ENLISTED", "The specified operation could not be performed because the resource manager is not enlisted in the transaction."},
+	7001:  {7001, "ERROR_CTX_WINSTATION_NAME_INVALID", "ERROR_CTX_WINSTATION_NAME_INVALID"},
+	7002:  {7002, "ERROR_CTX_INVALID_PD", "ERROR_CTX_INVALID_PD"},
+	7003:  {7003, "ERROR_CTX_PD_NOT_FOUND", "ERROR_CTX_PD_NOT_FOUND"},
+	7004:  {7004, "ERROR_CTX_WD_NOT_FOUND", "ERROR_CTX_WD_NOT_FOUND"},
+	7005:  {7005, "ERROR_CTX_CANNOT_MAKE_EVENTLOG_ENTRY", "ERROR_CTX_CANNOT_MAKE_EVENTLOG_ENTRY"},
+	7006:  {7006, "ERROR_CTX_SERVICE_NAME_COLLISION", "ERROR_CTX_SERVICE_NAME_COLLISION"},
+	7007:  {7007, "ERROR_CTX_CLOSE_PENDING", "ERROR_CTX_CLOSE_PENDING"},
+	7008:  {7008, "ERROR_CTX_NO_OUTBUF", "ERROR_CTX_NO_OUTBUF"},
+	7009:  {7009, "ERROR_CTX_MODEM_INF_NOT_FOUND", "ERROR_CTX_MODEM_INF_NOT_FOUND"},
+	7010:  {7010, "ERROR_CTX_INVALID_MODEMNAME", "ERROR_CTX_INVALID_MODEMNAME"},
+	7011:  {7011, "ERROR_CTX_MODEM_RESPONSE_ERROR", "ERROR_CTX_MODEM_RESPONSE_ERROR"},
+	7012:  {7012, "ERROR_CTX_MODEM_RESPONSE_TIMEOUT", "ERROR_CTX_MODEM_RESPONSE_TIMEOUT"},
+	7013:  {7013, "ERROR_CTX_MODEM_RESPONSE_NO_CARRIER", "ERROR_CTX_MODEM_RESPONSE_NO_CARRIER"},
+	7014:  {7014, "ERROR_CTX_MODEM_RESPONSE_NO_DIALTONE", "ERROR_CTX_MODEM_RESPONSE_NO_DIALTONE"},
+	7015:  {7015, "ERROR_CTX_MODEM_RESPONSE_BUSY", "ERROR_CTX_MODEM_RESPONSE_BUSY"},
+	7016:  {7016, "ERROR_CTX_MODEM_RESPONSE_VOICE", "ERROR_CTX_MODEM_RESPONSE_VOICE"},
+	7017:  {7017, "ERROR_CTX_TD_ERROR", "ERROR_CTX_TD_ERROR"},
+	7022:  {7022, "ERROR_CTX_WINSTATION_NOT_FOUND", "ERROR_CTX_WINSTATION_NOT_FOUND"},
+	7023:  {7023, "ERROR_CTX_WINSTATION_ALREADY_EXISTS", "ERROR_CTX_WINSTATION_ALREADY_EXISTS"},
+	7024:  {7024, "ERROR_CTX_WINSTATION_BUSY", "ERROR_CTX_WINSTATION_BUSY"},
+	7025:  {7025, "ERROR_CTX_BAD_VIDEO_MODE", "ERROR_CTX_BAD_VIDEO_MODE"},
+	7035:  {7035, "ERROR_CTX_GRAPHICS_INVALID", "ERROR_CTX_GRAPHICS_INVALID"},
+	7037:  {7037, "ERROR_CTX_LOGON_DISABLED", "ERROR_CTX_LOGON_DISABLED"},
+	7038:  {7038, "ERROR_CTX_NOT_CONSOLE", "ERROR_CTX_NOT_CONSOLE"},
+	7040:  {7040, "ERROR_CTX_CLIENT_QUERY_TIMEOUT", "ERROR_CTX_CLIENT_QUERY_TIMEOUT"},
+	7041:  {7041, "ERROR_CTX_CONSOLE_DISCONNECT", "ERROR_CTX_CONSOLE_DISCONNECT"},
+	7042:  {7042, "ERROR_CTX_CONSOLE_CONNECT", "ERROR_CTX_CONSOLE_CONNECT"},
+	7044:  {7044, "ERROR_CTX_SHADOW_DENIED", "ERROR_CTX_SHADOW_DENIED"},
+	7045:  {7045, "ERROR_CTX_WINSTATION_ACCESS_DENIED", "ERROR_CTX_WINSTATION_ACCESS_DENIED"},
+	7049:  {7049, "ERROR_CTX_INVALID_WD", "ERROR_CTX_INVALID_WD"},
+	7050:  {7050, "ERROR_CTX_SHADOW_INVALID", "ERROR_CTX_SHADOW_INVALID"},
+	7051:  {7051, "ERROR_CTX_SHADOW_DISABLED", "ERROR_CTX_SHADOW_DISABLED"},
+	7052:  {7052, "ERROR_CTX_CLIENT_LICENSE_IN_USE", "ERROR_CTX_CLIENT_LICENSE_IN_USE"},
+	7053:  {7053, "ERROR_CTX_CLIENT_LICENSE_NOT_SET", "ERROR_CTX_CLIENT_LICENSE_NOT_SET"},
+	7054:  {7054, "ERROR_CTX_LICENSE_NOT_AVAILABLE", "ERROR_CTX_LICENSE_NOT_AVAILABLE"},
+	7055:  {7055, "ERROR_CTX_LICENSE_CLIENT_INVALID", "ERROR_CTX_LICENSE_CLIENT_INVALID"},
+	7056:  {7056, "ERROR_CTX_LICENSE_EXPIRED", "ERROR_CTX_LICENSE_EXPIRED"},
+	7057:  {7057, "ERROR_CTX_SHADOW_NOT_RUNNING", "ERROR_CTX_SHADOW_NOT_RUNNING"},
+	7058:  {7058, "ERROR_CTX_SHADOW_ENDED_BY_MODE_CHANGE", "ERROR_CTX_SHADOW_ENDED_BY_MODE_CHANGE"},
+	7059:  {7059, "ERROR_ACTIVATION_COUNT_EXCEEDED", "ERROR_ACTIVATION_COUNT_EXCEEDED"},
+	7060:  {7060, "ERROR_CTX_WINSTATIONS_DISABLED", "ERROR_CTX_WINSTATIONS_DISABLED"},
+	7061:  {7061, "ERROR_CTX_ENCRYPTION_LEVEL_REQUIRED", "ERROR_CTX_ENCRYPTION_LEVEL_REQUIRED"},
+	7062:  {7062, "ERROR_CTX_SESSION_IN_USE", "ERROR_CTX_SESSION_IN_USE"},
+	7063:  {7063, "ERROR_CTX_NO_FORCE_LOGOFF", "ERROR_CTX_NO_FORCE_LOGOFF"},
+	7064:  {7064, "ERROR_CTX_ACCOUNT_RESTRICTION", "ERROR_CTX_ACCOUNT_RESTRICTION"},
+	7065:  {7065, "ERROR_RDP_PROTOCOL_ERROR", "ERROR_RDP_PROTOCOL_ERROR"},
+	7066:  {7066, "ERROR_CTX_CDM_CONNECT", "ERROR_CTX_CDM_CONNECT"},
+	7067:  {7067, "ERROR_CTX_CDM_DISCONNECT", "ERROR_CTX_CDM_DISCONNECT"},
+	7068:  {7068, "ERROR_CTX_SECURITY_LAYER_ERROR", "ERROR_CTX_SECURITY_LAYER_ERROR"},
+	7069:  {7069, "ERROR_TS_INCOMPATIBLE_SESSIONS", "ERROR_TS_INCOMPATIBLE_SESSIONS"},
+	7070:  {7070, "ERROR_TS_VIDEO_SUBSYSTEM_ERROR", "ERROR_TS_VIDEO_SUBSYSTEM_ERROR"},
+	8200:  {8200, "ERROR_DS_NOT_INSTALLED", "ERROR_DS_NOT_INSTALLED"},
+	8201:  {8201, "ERROR_DS_MEMBERSHIP_EVALUATED_LOCALLY", "ERROR_DS_MEMBERSHIP_EVALUATED_LOCALLY"},
+	8202:  {8202, "ERROR_DS_NO_ATTRIBUTE_OR_VALUE", "The specified directory service attribute or value does not exist."},
+	8203:  {8203, "ERROR_DS_INVALID_ATTRIBUTE_SYNTAX", "ERROR_DS_INVALID_ATTRIBUTE_SYNTAX"},
+	8204:  {8204, "ERROR_DS_ATTRIBUTE_TYPE_UNDEFINED", "ERROR_DS_ATTRIBUTE_TYPE_UNDEFINED"},
+	8205:  {8205, "ERROR_DS_ATTRIBUTE_OR_VALUE_EXISTS", "The specified directory service attribute or value already exists."},
+	8206:  {8206, "ERROR_DS_BUSY", "The directory service is busy."},
+	8207:  {8207, "ERROR_DS_UNAVAILABLE", "The directory service is unavailable."},
+	8208:  {8208, "ERROR_DS_NO_RIDS_ALLOCATED", "ERROR_DS_NO_RIDS_ALLOCATED"},
+	8209:  {8209, "ERROR_DS_NO_MORE_RIDS", "ERROR_DS_NO_MORE_RIDS"},
+	8210:  {8210, "ERROR_DS_INCORRECT_ROLE_OWNER", "ERROR_DS_INCORRECT_ROLE_OWNER"},
+	8211:  {8211, "ERROR_DS_RIDMGR_INIT_ERROR", "ERROR_DS_RIDMGR_INIT_ERROR"},
+	8212:  {8212, "ERROR_DS_OBJ_CLASS_VIOLATION", "ERROR_DS_OBJ_CLASS_VIOLATION"},
+	8213:  {8213, "ERROR_DS_CANT_ON_NON_LEAF", "ERROR_DS_CANT_ON_NON_LEAF"},
+	8214:  {8214, "ERROR_DS_CANT_ON_RDN", "ERROR_DS_CANT_ON_RDN"},
+	8215:  {8215, "ERROR_DS_CANT_MOD_OBJ_CLASS", "ERROR_DS_CANT_MOD_OBJ_CLASS"},
+	8216:  {8216, "ERROR_DS_CROSS_DOM_MOVE_ERROR", "ERROR_DS_CROSS_DOM_MOVE_ERROR"},
+	8217:  {8217, "ERROR_DS_GC_NOT_AVAILABLE", "ERROR_DS_GC_NOT_AVAILABLE"},
+	8218:  {8218, "ERROR_SHARED_POLICY", "ERROR_SHARED_POLICY"},
+	8219:  {8219, "ERROR_POLICY_OBJECT_NOT_FOUND", "ERROR_POLICY_OBJECT_NOT_FOUND"},
+	8220:  {8220, "ERROR_POLICY_ONLY_IN_DS", "ERROR_POLICY_ONLY_IN_DS"},
+	8221:  {8221, "ERROR_PROMOTION_ACTIVE", "ERROR_PROMOTION_ACTIVE"},
+	8222:  {8222, "ERROR_NO_PROMOTION_ACTIVE", "ERROR_NO_PROMOTION_ACTIVE"},
+	8224:  {8224, "ERROR_DS_OPERATIONS_ERROR", "ERROR_DS_OPERATIONS_ERROR"},
+	8225:  {8225, "ERROR_DS_PROTOCOL_ERROR", "ERROR_DS_PROTOCOL_ERROR"},
+	8226:  {8226, "ERROR_DS_TIMELIMIT_EXCEEDED", "ERROR_DS_TIMELIMIT_EXCEEDED"},
+	8227:  {8227, "ERROR_DS_SIZELIMIT_EXCEEDED", "ERROR_DS_SIZELIMIT_EXCEEDED"},
+	8228:  {8228, "ERROR_DS_ADMIN_LIMIT_EXCEEDED", "ERROR_DS_ADMIN_LIMIT_EXCEEDED"},
+	8229:  {8229, "ERROR_DS_COMPARE_FALSE", "ERROR_DS_COMPARE_FALSE"},
+	8230:  {8230, "ERROR_DS_COMPARE_TRUE", "ERROR_DS_COMPARE_TRUE"},
+	8231:  {8231, "ERROR_DS_AUTH_METHOD_NOT_SUPPORTED", "ERROR_DS_AUTH_METHOD_NOT_SUPPORTED"},
+	8232:  {8232, "ERROR_DS_STRONG_AUTH_REQUIRED", "ERROR_DS_STRONG_AUTH_REQUIRED"},
+	8233:  {8233, "ERROR_DS_INAPPROPRIATE_AUTH", "ERROR_DS_INAPPROPRIATE_AUTH"},
+	8234:  {8234, "ERROR_DS_AUTH_UNKNOWN", "ERROR_DS_AUTH_UNKNOWN"},
+	8235:  {8235, "ERROR_DS_REFERRAL", "ERROR_DS_REFERRAL"},
+	8236:  {8236, "ERROR_DS_UNAVAILABLE_CRIT_EXTENSION", "ERROR_DS_UNAVAILABLE_CRIT_EXTENSION"},
+	8237:  {8237, "ERROR_DS_CONFIDENTIALITY_REQUIRED", "ERROR_DS_CONFIDENTIALITY_REQUIRED"},
+	8238:  {8238, "ERROR_DS_INAPPROPRIATE_MATCHING", "ERROR_DS_INAPPROPRIATE_MATCHING"},
+	8239:  {8239, "ERROR_DS_CONSTRAINT_VIOLATION", "ERROR_DS_CONSTRAINT_VIOLATION"},
+	8240:  {8240, "ERROR_DS_NO_SUCH_OBJECT", "There is no such object on the server."},
+	8241:  {8241, "ERROR_DS_ALIAS_PROBLEM", "ERROR_DS_ALIAS_PROBLEM"},
+	8242:  {8242, "ERROR_DS_INVALID_DN_SYNTAX", "An invalid dn syntax has been specified."},
+	8243:  {8243, "ERROR_DS_IS_LEAF", "ERROR_DS_IS_LEAF"},
+	8244:  {8244, "ERROR_DS_ALIAS_DEREF_PROBLEM", "ERROR_DS_ALIAS_DEREF_PROBLEM"},
+	8245:  {8245, "ERROR_DS_UNWILLING_TO_PERFORM", "ERROR_DS_UNWILLING_TO_PERFORM"},
+	8246:  {8246, "ERROR_DS_LOOP_DETECT", "ERROR_DS_LOOP_DETECT"},
+	8247:  {8247, "ERROR_DS_NAMING_VIOLATION", "ERROR_DS_NAMING_VIOLATION"},
+	8248:  {8248, "ERROR_DS_OBJECT_RESULTS_TOO_LARGE", "ERROR_DS_OBJECT_RESULTS_TOO_LARGE"},
+	8249:  {8249, "ERROR_DS_AFFECTS_MULTIPLE_DSAS", "ERROR_DS_AFFECTS_MULTIPLE_DSAS"},
+	8250:  {8250, "ERROR_DS_SERVER_DOWN", "The server is not operational."},
+	8251:  {8251, "ERROR_DS_LOCAL_ERROR", "ERROR_DS_LOCAL_ERROR"},
+	8252:  {8252, "ERROR_DS_ENCODING_ERROR", "ERROR_DS_ENCODING_ERROR"},
+	8253:  {8253, "ERROR_DS_DECODING_ERROR", "ERROR_DS_DECODING_ERROR"},
+	8254:  {8254, "ERROR_DS_FILTER_UNKNOWN", "ERROR_DS_FILTER_UNKNOWN"},
+	8255:  {8255, "ERROR_DS_PARAM_ERROR", "ERROR_DS_PARAM_ERROR"},
+	8256:  {8256, "ERROR_DS_NOT_SUPPORTED", "ERROR_DS_NOT_SUPPORTED"},
+	8257:  {8257, "ERROR_DS_NO_RESULTS_RETURNED", "ERROR_DS_NO_RESULTS_RETURNED"},
+	8258:  {8258, "ERROR_DS_CONTROL_NOT_FOUND", "ERROR_DS_CONTROL_NOT_FOUND"},
+	8259:  {8259, "ERROR_DS_CLIENT_LOOP", "ERROR_DS_CLIENT_LOOP"},
+	8260:  {8260, "ERROR_DS_REFERRAL_LIMIT_EXCEEDED", "ERROR_DS_REFERRAL_LIMIT_EXCEEDED"},
+	8261:  {8261, "ERROR_DS_SORT_CONTROL_MISSING", "ERROR_DS_SORT_CONTROL_MISSING"},
+	8262:  {8262, "ERROR_DS_OFFSET_RANGE_ERROR", "ERROR_DS_OFFSET_RANGE_ERROR"},
+	8263:  {8263, "ERROR_DS_RIDMGR_DISABLED", "ERROR_DS_RIDMGR_DISABLED"},
+	8301:  {8301, "ERROR_DS_ROOT_MUST_BE_NC", "ERROR_DS_ROOT_MUST_BE_NC"},
+	8302:  {8302, "ERROR_DS_ADD_REPLICA_INHIBITED", "ERROR_DS_ADD_REPLICA_INHIBITED"},
+	8303:  {8303, "ERROR_DS_ATT_NOT_DEF_IN_SCHEMA", "ERROR_DS_ATT_NOT_DEF_IN_SCHEMA"},
+	8304:  {8304, "ERROR_DS_MAX_OBJ_SIZE_EXCEEDED", "ERROR_DS_MAX_OBJ_SIZE_EXCEEDED"},
+	8305:  {8305, "ERROR_DS_OBJ_STRING_NAME_EXISTS", "ERROR_DS_OBJ_STRING_NAME_EXISTS"},
+	8306:  {8306, "ERROR_DS_NO_RDN_DEFINED_IN_SCHEMA", "ERROR_DS_NO_RDN_DEFINED_IN_SCHEMA"},
+	8307:  {8307, "ERROR_DS_RDN_DOESNT_MATCH_SCHEMA", "ERROR_DS_RDN_DOESNT_MATCH_SCHEMA"},
+	8308:  {8308, "ERROR_DS_NO_REQUESTED_ATTS_FOUND", "ERROR_DS_NO_REQUESTED_ATTS_FOUND"},
+	8309:  {8309, "ERROR_DS_USER_BUFFER_TO_SMALL", "ERROR_DS_USER_BUFFER_TO_SMALL"},
+	8310:  {8310, "ERROR_DS_ATT_IS_NOT_ON_OBJ", "ERROR_DS_ATT_IS_NOT_ON_OBJ"},
+	8311:  {8311, "ERROR_DS_ILLEGAL_MOD_OPERATION", "ERROR_DS_ILLEGAL_MOD_OPERATION"},
+	8312:  {8312, "ERROR_DS_OBJ_TOO_LARGE", "ERROR_DS_OBJ_TOO_LARGE"},
+	8313:  {8313, "ERROR_DS_BAD_INSTANCE_TYPE", "ERROR_DS_BAD_INSTANCE_TYPE"},
+	8314:  {8314, "ERROR_DS_MASTERDSA_REQUIRED", "ERROR_DS_MASTERDSA_REQUIRED"},
+	8315:  {8315, "ERROR_DS_OBJECT_CLASS_REQUIRED", "ERROR_DS_OBJECT_CLASS_REQUIRED"},
+	8316:  {8316, "ERROR_DS_MISSING_REQUIRED_ATT", "ERROR_DS_MISSING_REQUIRED_ATT"},
+	8317:  {8317, "ERROR_DS_ATT_NOT_DEF_FOR_CLASS", "ERROR_DS_ATT_NOT_DEF_FOR_CLASS"},
+	8318:  {8318, "ERROR_DS_ATT_ALREADY_EXISTS", "ERROR_DS_ATT_ALREADY_EXISTS"},
+	8320:  {8320, "ERROR_DS_CANT_ADD_ATT_VALUES", "ERROR_DS_CANT_ADD_ATT_VALUES"},
+	8321:  {8321, "ERROR_DS_SINGLE_VALUE_CONSTRAINT", "ERROR_DS_SINGLE_VALUE_CONSTRAINT"},
+	8322:  {8322, "ERROR_DS_RANGE_CONSTRAINT", "ERROR_DS_RANGE_CONSTRAINT"},
+	8323:  {8323, "ERROR_DS_ATT_VAL_ALREADY_EXISTS", "ERROR_DS_ATT_VAL_ALREADY_EXISTS"},
+	8324:  {8324, "ERROR_DS_CANT_REM_MISSING_ATT", "ERROR_DS_CANT_REM_MISSING_ATT"},
+	8325:  {8325, "ERROR_DS_CANT_REM_MISSING_ATT_VAL", "ERROR_DS_CANT_REM_MISSING_ATT_VAL"},
+	8326:  {8326, "ERROR_DS_ROOT_CANT_BE_SUBREF", "ERROR_DS_ROOT_CANT_BE_SUBREF"},
+	8327:  {8327, "ERROR_DS_NO_CHAINING", "ERROR_DS_NO_CHAINING"},
+	8328:  {8328, "ERROR_DS_NO_CHAINED_EVAL", "ERROR_DS_NO_CHAINED_EVAL"},
+	8329:  {8329, "ERROR_DS_NO_PARENT_OBJECT", "ERROR_DS_NO_PARENT_OBJECT"},
+	8330:  {8330, "ERROR_DS_PARENT_IS_AN_ALIAS", "ERROR_DS_PARENT_IS_AN_ALIAS"},
+	8331:  {8331, "ERROR_DS_CANT_MIX_MASTER_AND_REPS", "ERROR_DS_CANT_MIX_MASTER_AND_REPS"},
+	8332:  {8332, "ERROR_DS_CHILDREN_EXIST", "ERROR_DS_CHILDREN_EXIST"},
+	8333:  {8333, "ERROR_DS_OBJ_NOT_FOUND", "Directory object not found."},
+	8334:  {8334, "ERROR_DS_ALIASED_OBJ_MISSING", "ERROR_DS_ALIASED_OBJ_MISSING"},
+	8335:  {8335, "ERROR_DS_BAD_NAME_SYNTAX", "ERROR_DS_BAD_NAME_SYNTAX"},
+	8336:  {8336, "ERROR_DS_ALIAS_POINTS_TO_ALIAS", "ERROR_DS_ALIAS_POINTS_TO_ALIAS"},
+	8337:  {8337, "ERROR_DS_CANT_DEREF_ALIAS", "ERROR_DS_CANT_DEREF_ALIAS"},
+	8338:  {8338, "ERROR_DS_OUT_OF_SCOPE", "ERROR_DS_OUT_OF_SCOPE"},
+	8339:  {8339, "ERROR_DS_OBJECT_BEING_REMOVED", "ERROR_DS_OBJECT_BEING_REMOVED"},
+	8340:  {8340, "ERROR_DS_CANT_DELETE_DSA_OBJ", "ERROR_DS_CANT_DELETE_DSA_OBJ"},
+	8341:  {8341, "ERROR_DS_GENERIC_ERROR", "ERROR_DS_GENERIC_ERROR"},
+	8342:  {8342, "ERROR_DS_DSA_MUST_BE_INT_MASTER", "ERROR_DS_DSA_MUST_BE_INT_MASTER"},
+	8343:  {8343, "ERROR_DS_CLASS_NOT_DSA", "ERROR_DS_CLASS_NOT_DSA"},
+	8344:  {8344, "ERROR_DS_INSUFF_ACCESS_RIGHTS", "ERROR_DS_INSUFF_ACCESS_RIGHTS"},
+	8345:  {8345, "ERROR_DS_ILLEGAL_SUPERIOR", "ERROR_DS_ILLEGAL_SUPERIOR"},
+	8346:  {8346, "ERROR_DS_ATTRIBUTE_OWNED_BY_SAM", "ERROR_DS_ATTRIBUTE_OWNED_BY_SAM"},
+	8347:  {8347, "ERROR_DS_NAME_TOO_MANY_PARTS", "ERROR_DS_NAME_TOO_MANY_PARTS"},
+	8348:  {8348, "ERROR_DS_NAME_TOO_LONG", "ERROR_DS_NAME_TOO_LONG"},
+	8349:  {8349, "ERROR_DS_NAME_VALUE_TOO_LONG", "ERROR_DS_NAME_VALUE_TOO_LONG"},
+	8350:  {8350, "ERROR_DS_NAME_UNPARSEABLE", "ERROR_DS_NAME_UNPARSEABLE"},
+	8351:  {8351, "ERROR_DS_NAME_TYPE_UNKNOWN", "ERROR_DS_NAME_TYPE_UNKNOWN"},
+	8352:  {8352, "ERROR_DS_NOT_AN_OBJECT", "ERROR_DS_NOT_AN_OBJECT"},
+	8353:  {8353, "ERROR_DS_SEC_DESC_TOO_SHORT", "ERROR_DS_SEC_DESC_TOO_SHORT"},
+	8354:  {8354, "ERROR_DS_SEC_DESC_INVALID", "ERROR_DS_SEC_DESC_INVALID"},
+	8355:  {8355, "ERROR_DS_NO_DELETED_NAME", "ERROR_DS_NO_DELETED_NAME"},
+	8356:  {8356, "ERROR_DS_SUBREF_MUST_HAVE_PARENT", "ERROR_DS_SUBREF_MUST_HAVE_PARENT"},
+	8357:  {8357, "ERROR_DS_NCNAME_MUST_BE_NC", "ERROR_DS_NCNAME_MUST_BE_NC"},
+	8358:  {8358, "ERROR_DS_CANT_ADD_SYSTEM_ONLY", "ERROR_DS_CANT_ADD_SYSTEM_ONLY"},
+	8359:  {8359, "ERROR_DS_CLASS_MUST_BE_CONCRETE", "ERROR_DS_CLASS_MUST_BE_CONCRETE"},
+	8360:  {8360, "ERROR_DS_INVALID_DMD", "ERROR_DS_INVALID_DMD"},
+	8361:  {8361, "ERROR_DS_OBJ_GUID_EXISTS", "ERROR_DS_OBJ_GUID_EXISTS"},
+	8362:  {8362, "ERROR_DS_NOT_ON_BACKLINK", "ERROR_DS_NOT_ON_BACKLINK"},
+	8363:  {8363, "ERROR_DS_NO_CROSSREF_FOR_NC", "ERROR_DS_NO_CROSSREF_FOR_NC"},
+	8364:  {8364, "ERROR_DS_SHUTTING_DOWN", "ERROR_DS_SHUTTING_DOWN"},
+	8365:  {8365, "ERROR_DS_UNKNOWN_OPERATION", "ERROR_DS_UNKNOWN_OPERATION"},
+	8366:  {8366, "ERROR_DS_INVALID_ROLE_OWNER", "ERROR_DS_INVALID_ROLE_OWNER"},
+	8367:  {8367, "ERROR_DS_COULDNT_CONTACT_FSMO", "ERROR_DS_COULDNT_CONTACT_FSMO"},
+	8368:  {8368, "ERROR_DS_CROSS_NC_DN_RENAME", "ERROR_DS_CROSS_NC_DN_RENAME"},
+	8369:  {8369, "ERROR_DS_CANT_MOD_SYSTEM_ONLY", "ERROR_DS_CANT_MOD_SYSTEM_ONLY"},
+	8370:  {8370, "ERROR_DS_REPLICATOR_ONLY", "ERROR_DS_REPLICATOR_ONLY"},
+	8371:  {8371, "ERROR_DS_OBJ_CLASS_NOT_DEFINED", "ERROR_DS_OBJ_CLASS_NOT_DEFINED"},
+	8372:  {8372, "ERROR_DS_OBJ_CLASS_NOT_SUBCLASS", "ERROR_DS_OBJ_CLASS_NOT_SUBCLASS"},
+	8373:  {8373, "ERROR_DS_NAME_REFERENCE_INVALID", "ERROR_DS_NAME_REFERENCE_INVALID"},
+	8374:  {8374, "ERROR_DS_CROSS_REF_EXISTS", "ERROR_DS_CROSS_REF_EXISTS"},
+	8375:  {8375, "ERROR_DS_CANT_DEL_MASTER_CROSSREF", "ERROR_DS_CANT_DEL_MASTER_CROSSREF"},
+	8376:  {8376, "ERROR_DS_SUBTREE_NOTIFY_NOT_NC_HEAD", "ERROR_DS_SUBTREE_NOTIFY_NOT_NC_HEAD"},
+	8377:  {8377, "ERROR_DS_NOTIFY_FILTER_TOO_COMPLEX", "ERROR_DS_NOTIFY_FILTER_TOO_COMPLEX"},
+	8378:  {8378, "ERROR_DS_DUP_RDN", "ERROR_DS_DUP_RDN"},
+	8379:  {8379, "ERROR_DS_DUP_OID", "ERROR_DS_DUP_OID"},
+	8380:  {8380, "ERROR_DS_DUP_MAPI_ID", "ERROR_DS_DUP_MAPI_ID"},
+	8381:  {8381, "ERROR_DS_DUP_SCHEMA_ID_GUID", "ERROR_DS_DUP_SCHEMA_ID_GUID"},
+	8382:  {8382, "ERROR_DS_DUP_LDAP_DISPLAY_NAME", "ERROR_DS_DUP_LDAP_DISPLAY_NAME"},
+	8383:  {8383, "ERROR_DS_SEMANTIC_ATT_TEST", "ERROR_DS_SEMANTIC_ATT_TEST"},
+	8384:  {8384, "ERROR_DS_SYNTAX_MISMATCH", "ERROR_DS_SYNTAX_MISMATCH"},
+	8385:  {8385, "ERROR_DS_EXISTS_IN_MUST_HAVE", "ERROR_DS_EXISTS_IN_MUST_HAVE"},
+	8386:  {8386, "ERROR_DS_EXISTS_IN_MAY_HAVE", "ERROR_DS_EXISTS_IN_MAY_HAVE"},
+	8387:  {8387, "ERROR_DS_NONEXISTENT_MAY_HAVE", "ERROR_DS_NONEXISTENT_MAY_HAVE"},
+	8388:  {8388, "ERROR_DS_NONEXISTENT_MUST_HAVE", "ERROR_DS_NONEXISTENT_MUST_HAVE"},
+	8389:  {8389, "ERROR_DS_AUX_CLS_TEST_FAIL", "ERROR_DS_AUX_CLS_TEST_FAIL"},
+	8390:  {8390, "ERROR_DS_NONEXISTENT_POSS_SUP", "ERROR_DS_NONEXISTENT_POSS_SUP"},
+	8391:  {8391, "ERROR_DS_SUB_CLS_TEST_FAIL", "ERROR_DS_SUB_CLS_TEST_FAIL"},
+	8392:  {8392, "ERROR_DS_BAD_RDN_ATT_ID_SYNTAX", "ERROR_DS_BAD_RDN_ATT_ID_SYNTAX"},
+	8393:  {8393, "ERROR_DS_EXISTS_IN_AUX_CLS", "ERROR_DS_EXISTS_IN_AUX_CLS"},
+	8394:  {8394, "ERROR_DS_EXISTS_IN_SUB_CLS", "ERROR_DS_EXISTS_IN_SUB_CLS"},
+	8395:  {8395, "ERROR_DS_EXISTS_IN_POSS_SUP", "ERROR_DS_EXISTS_IN_POSS_SUP"},
+	8396:  {8396, "ERROR_DS_RECALCSCHEMA_FAILED", "ERROR_DS_RECALCSCHEMA_FAILED"},
+	8397:  {8397, "ERROR_DS_TREE_DELETE_NOT_FINISHED", "ERROR_DS_TREE_DELETE_NOT_FINISHED"},
+	8398:  {8398, "ERROR_DS_CANT_DELETE", "ERROR_DS_CANT_DELETE"},
+	8399:  {8399, "ERROR_DS_ATT_SCHEMA_REQ_ID", "ERROR_DS_ATT_SCHEMA_REQ_ID"},
+	8400:  {8400, "ERROR_DS_BAD_ATT_SCHEMA_SYNTAX", "ERROR_DS_BAD_ATT_SCHEMA_SYNTAX"},
+	8401:  {8401, "ERROR_DS_CANT_CACHE_ATT", "ERROR_DS_CANT_CACHE_ATT"},
+	8402:  {8402, "ERROR_DS_CANT_CACHE_CLASS", "ERROR_DS_CANT_CACHE_CLASS"},
+	8403:  {8403, "ERROR_DS_CANT_REMOVE_ATT_CACHE", "ERROR_DS_CANT_REMOVE_ATT_CACHE"},
+	8404:  {8404, "ERROR_DS_CANT_REMOVE_CLASS_CACHE", "ERROR_DS_CANT_REMOVE_CLASS_CACHE"},
+	8405:  {8405, "ERROR_DS_CANT_RETRIEVE_DN", "ERROR_DS_CANT_RETRIEVE_DN"},
+	8406:  {8406, "ERROR_DS_MISSING_SUPREF", "ERROR_DS_MISSING_SUPREF"},
+	8407:  {8407, "ERROR_DS_CANT_RETRIEVE_INSTANCE", "ERROR_DS_CANT_RETRIEVE_INSTANCE"},
+	8408:  {8408, "ERROR_DS_CODE_INCONSISTENCY", "ERROR_DS_CODE_INCONSISTENCY"},
+	8409:  {8409, "ERROR_DS_DATABASE_ERROR", "ERROR_DS_DATABASE_ERROR"},
+	8410:  {8410, "ERROR_DS_GOVERNSID_MISSING", "ERROR_DS_GOVERNSID_MISSING"},
+	8411:  {8411, "ERROR_DS_MISSING_EXPECTED_ATT", "ERROR_DS_MISSING_EXPECTED_ATT"},
+	8412:  {8412, "ERROR_DS_NCNAME_MISSING_CR_REF", "ERROR_DS_NCNAME_MISSING_CR_REF"},
+	8413:  {8413, "ERROR_DS_SECURITY_CHECKING_ERROR", "ERROR_DS_SECURITY_CHECKING_ERROR"},
+	8414:  {8414, "ERROR_DS_SCHEMA_NOT_LOADED", "ERROR_DS_SCHEMA_NOT_LOADED"},
+	8415:  {8415, "ERROR_DS_SCHEMA_ALLOC_FAILED", "ERROR_DS_SCHEMA_ALLOC_FAILED"},
+	8416:  {8416, "ERROR_DS_ATT_SCHEMA_REQ_SYNTAX", "ERROR_DS_ATT_SCHEMA_REQ_SYNTAX"},
+	8417:  {8417, "ERROR_DS_GCVERIFY_ERROR", "ERROR_DS_GCVERIFY_ERROR"},
+	8418:  {8418, "ERROR_DS_DRA_SCHEMA_MISMATCH", "ERROR_DS_DRA_SCHEMA_MISMATCH"},
+	8419:  {8419, "ERROR_DS_CANT_FIND_DSA_OBJ", "ERROR_DS_CANT_FIND_DSA_OBJ"},
+	8420:  {8420, "ERROR_DS_CANT_FIND_EXPECTED_NC", "ERROR_DS_CANT_FIND_EXPECTED_NC"},
+	8421:  {8421, "ERROR_DS_CANT_FIND_NC_IN_CACHE", "ERROR_DS_CANT_FIND_NC_IN_CACHE"},
+	8422:  {8422, "ERROR_DS_CANT_RETRIEVE_CHILD", "ERROR_DS_CANT_RETRIEVE_CHILD"},
+	8423:  {8423, "ERROR_DS_SECURITY_ILLEGAL_MODIFY", "ERROR_DS_SECURITY_ILLEGAL_MODIFY"},
+	8424:  {8424, "ERROR_DS_CANT_REPLACE_HIDDEN_REC", "ERROR_DS_CANT_REPLACE_HIDDEN_REC"},
+	8425:  {8425, "ERROR_DS_BAD_HIERARCHY_FILE", "ERROR_DS_BAD_HIERARCHY_FILE"},
+	8426:  {8426, "ERROR_DS_BUILD_HIERARCHY_TABLE_FAILED", "ERROR_DS_BUILD_HIERARCHY_TABLE_FAILED"},
+	8427:  {8427, "ERROR_DS_CONFIG_PARAM_MISSING", "ERROR_DS_CONFIG_PARAM_MISSING"},
+	8428:  {8428, "ERROR_DS_COUNTING_AB_INDICES_FAILED", "ERROR_DS_COUNTING_AB_INDICES_FAILED"},
+	8429:  {8429, "ERROR_DS_HIERARCHY_TABLE_MALLOC_FAILED", "ERROR_DS_HIERARCHY_TABLE_MALLOC_FAILED"},
+	8430:  {8430, "ERROR_DS_INTERNAL_FAILURE", "ERROR_DS_INTERNAL_FAILURE"},
+	8431:  {8431, "ERROR_DS_UNKNOWN_ERROR", "ERROR_DS_UNKNOWN_ERROR"},
+	8432:  {8432, "ERROR_DS_ROOT_REQUIRES_CLASS_TOP", "ERROR_DS_ROOT_REQUIRES_CLASS_TOP"},
+	8433:  {8433, "ERROR_DS_REFUSING_FSMO_ROLES", "ERROR_DS_REFUSING_FSMO_ROLES"},
+	8434:  {8434, "ERROR_DS_MISSING_FSMO_SETTINGS", "ERROR_DS_MISSING_FSMO_SETTINGS"},
+	8435:  {8435, "ERROR_DS_UNABLE_TO_SURRENDER_ROLES", "ERROR_DS_UNABLE_TO_SURRENDER_ROLES"},
+	8436:  {8436, "ERROR_DS_DRA_GENERIC", "ERROR_DS_DRA_GENERIC"},
+	8437:  {8437, "ERROR_DS_DRA_INVALID_PARAMETER", "ERROR_DS_DRA_INVALID_PARAMETER"},
+	8438:  {8438, "ERROR_DS_DRA_BUSY", "ERROR_DS_DRA_BUSY"},
+	8439:  {8439, "ERROR_DS_DRA_BAD_DN", "ERROR_DS_DRA_BAD_DN"},
+	8440:  {8440, "ERROR_DS_DRA_BAD_NC", "ERROR_DS_DRA_BAD_NC"},
+	8441:  {8441, "ERROR_DS_DRA_DN_EXISTS", "ERROR_DS_DRA_DN_EXISTS"},
+	8442:  {8442, "ERROR_DS_DRA_INTERNAL_ERROR", "ERROR_DS_DRA_INTERNAL_ERROR"},
+	8443:  {8443, "ERROR_DS_DRA_INCONSISTENT_DIT", "ERROR_DS_DRA_INCONSISTENT_DIT"},
+	8444:  {8444, "ERROR_DS_DRA_CONNECTION_FAILED", "ERROR_DS_DRA_CONNECTION_FAILED"},
+	8445:  {8445, "ERROR_DS_DRA_BAD_INSTANCE_TYPE", "ERROR_DS_DRA_BAD_INSTANCE_TYPE"},
+	8446:  {8446, "ERROR_DS_DRA_OUT_OF_MEM", "ERROR_DS_DRA_OUT_OF_MEM"},
+	8447:  {8447, "ERROR_DS_DRA_MAIL_PROBLEM", "ERROR_DS_DRA_MAIL_PROBLEM"},
+	8448:  {8448, "ERROR_DS_DRA_REF_ALREADY_EXISTS", "ERROR_DS_DRA_REF_ALREADY_EXISTS"},
+	8449:  {8449, "ERROR_DS_DRA_REF_NOT_FOUND", "ERROR_DS_DRA_REF_NOT_FOUND"},
+	8450:  {8450, "ERROR_DS_DRA_OBJ_IS_REP_SOURCE", "ERROR_DS_DRA_OBJ_IS_REP_SOURCE"},
+	8451:  {8451, "ERROR_DS_DRA_DB_ERROR", "ERROR_DS_DRA_DB_ERROR"},
+	8452:  {8452, "ERROR_DS_DRA_NO_REPLICA", "ERROR_DS_DRA_NO_REPLICA"},
+	8453:  {8453, "ERROR_DS_DRA_ACCESS_DENIED", "Replication access was denied."},
+	8454:  {8454, "ERROR_DS_DRA_NOT_SUPPORTED", "ERROR_DS_DRA_NOT_SUPPORTED"},
+	8455:  {8455, "ERROR_DS_DRA_RPC_CANCELLED", "ERROR_DS_DRA_RPC_CANCELLED"},
+	8456:  {8456, "ERROR_DS_DRA_SOURCE_DISABLED", "ERROR_DS_DRA_SOURCE_DISABLED"},
+	8457:  {8457, "ERROR_DS_DRA_SINK_DISABLED", "ERROR_DS_DRA_SINK_DISABLED"},
+	8458:  {8458, "ERROR_DS_DRA_NAME_COLLISION", "ERROR_DS_DRA_NAME_COLLISION"},
+	8459:  {8459, "ERROR_DS_DRA_SOURCE_REINSTALLED", "ERROR_DS_DRA_SOURCE_REINSTALLED"},
+	8460:  {8460, "ERROR_DS_DRA_MISSING_PARENT", "ERROR_DS_DRA_MISSING_PARENT"},
+	8461:  {8461, "ERROR_DS_DRA_PREEMPTED", "ERROR_DS_DRA_PREEMPTED"},
+	8462:  {8462, "ERROR_DS_DRA_ABANDON_SYNC", "ERROR_DS_DRA_ABANDON_SYNC"},
+	8463:  {8463, "ERROR_DS_DRA_SHUTDOWN", "ERROR_DS_DRA_SHUTDOWN"},
+	8464:  {8464, "ERROR_DS_DRA_INCOMPATIBLE_PARTIAL_SET", "ERROR_DS_DRA_INCOMPATIBLE_PARTIAL_SET"},
+	8465:  {8465, "ERROR_DS_DRA_SOURCE_IS_PARTIAL_REPLICA", "ERROR_DS_DRA_SOURCE_IS_PARTIAL_REPLICA"},
+	8466:  {8466, "ERROR_DS_DRA_EXTN_CONNECTION_FAILED", "ERROR_DS_DRA_EXTN_CONNECTION_FAILED"},
+	8467:  {8467, "ERROR_DS_INSTALL_SCHEMA_MISMATCH", "ERROR_DS_INSTALL_SCHEMA_MISMATCH"},
+	8468:  {8468, "ERROR_DS_DUP_LINK_ID", "ERROR_DS_DUP_LINK_ID"},
+	8469:  {8469, "ERROR_DS_NAME_ERROR_RESOLVING", "ERROR_DS_NAME_ERROR_RESOLVING"},
+	8470:  {8470, "ERROR_DS_NAME_ERROR_NOT_FOUND", "ERROR_DS_NAME_ERROR_NOT_FOUND"},
+	8471:  {8471, "ERROR_DS_NAME_ERROR_NOT_UNIQUE", "ERROR_DS_NAME_ERROR_NOT_UNIQUE"},
+	8472:  {8472, "ERROR_DS_NAME_ERROR_NO_MAPPING", "ERROR_DS_NAME_ERROR_NO_MAPPING"},
+	8473:  {8473, "ERROR_DS_NAME_ERROR_DOMAIN_ONLY", "ERROR_DS_NAME_ERROR_DOMAIN_ONLY"},
+	8474:  {8474, "ERROR_DS_NAME_ERROR_NO_SYNTACTICAL_MAPPING", "ERROR_DS_NAME_ERROR_NO_SYNTACTICAL_MAPPING"},
+	8475:  {8475, "ERROR_DS_CONSTRUCTED_ATT_MOD", "ERROR_DS_CONSTRUCTED_ATT_MOD"},
+	8476:  {8476, "ERROR_DS_WRONG_OM_OBJ_CLASS", "ERROR_DS_WRONG_OM_OBJ_CLASS"},
+	8477:  {8477, "ERROR_DS_DRA_REPL_PENDING", "ERROR_DS_DRA_REPL_PENDING"},
+	8478:  {8478, "ERROR_DS_DS_REQUIRED", "ERROR_DS_DS_REQUIRED"},
+	8479:  {8479, "ERROR_DS_INVALID_LDAP_DISPLAY_NAME", "ERROR_DS_INVALID_LDAP_DISPLAY_NAME"},
+	8480:  {8480, "ERROR_DS_NON_BASE_SEARCH", "ERROR_DS_NON_BASE_SEARCH"},
+	8481:  {8481, "ERROR_DS_CANT_RETRIEVE_ATTS", "ERROR_DS_CANT_RETRIEVE_ATTS"},
+	8482:  {8482, "ERROR_DS_BACKLINK_WITHOUT_LINK", "ERROR_DS_BACKLINK_WITHOUT_LINK"},
+	8483:  {8483, "ERROR_DS_EPOCH_MISMATCH", "ERROR_DS_EPOCH_MISMATCH"},
+	8484:  {8484, "ERROR_DS_SRC_NAME_MISMATCH", "ERROR_DS_SRC_NAME_MISMATCH"},
+	8485:  {8485, "ERROR_DS_SRC_AND_DST_NC_IDENTICAL", "ERROR_DS_SRC_AND_DST_NC_IDENTICAL"},
+	8486:  {8486, "ERROR_DS_DST_NC_MISMATCH", "ERROR_DS_DST_NC_MISMATCH"},
+	8487:  {8487, "ERROR_DS_NOT_AUTHORITIVE_FOR_DST_NC", "ERROR_DS_NOT_AUTHORITIVE_FOR_DST_NC"},
+	8488:  {8488, "ERROR_DS_SRC_GUID_MISMATCH", "ERROR_DS_SRC_GUID_MISMATCH"},
+	8489:  {8489, "ERROR_DS_CANT_MOVE_DELETED_OBJECT", "ERROR_DS_CANT_MOVE_DELETED_OBJECT"},
+	8490:  {8490, "ERROR_DS_PDC_OPERATION_IN_PROGRESS", "ERROR_DS_PDC_OPERATION_IN_PROGRESS"},
+	8491:  {8491, "ERROR_DS_CROSS_DOMAIN_CLEANUP_REQD", "ERROR_DS_CROSS_DOMAIN_CLEANUP_REQD"},
+	8492:  {8492, "ERROR_DS_ILLEGAL_XDOM_MOVE_OPERATION", "ERROR_DS_ILLEGAL_XDOM_MOVE_OPERATION"},
+	8493:  {8493, "ERROR_DS_CANT_WITH_ACCT_GROUP_MEMBERSHPS", "ERROR_DS_CANT_WITH_ACCT_GROUP_MEMBERSHPS"},
+	8494:  {8494, "ERROR_DS_NC_MUST_HAVE_NC_PARENT", "ERROR_DS_NC_MUST_HAVE_NC_PARENT"},
+	8495:  {8495, "ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE", "ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE"},
+	8496:  {8496, "ERROR_DS_DST_DOMAIN_NOT_NATIVE", "ERROR_DS_DST_DOMAIN_NOT_NATIVE"},
+	8497:  {8497, "ERROR_DS_MISSING_INFRASTRUCTURE_CONTAINER", "ERROR_DS_MISSING_INFRASTRUCTURE_CONTAINER"},
+	8498:  {8498, "ERROR_DS_CANT_MOVE_ACCOUNT_GROUP", "ERROR_DS_CANT_MOVE_ACCOUNT_GROUP"},
+	8499:  {8499, "ERROR_DS_CANT_MOVE_RESOURCE_GROUP", "ERROR_DS_CANT_MOVE_RESOURCE_GROUP"},
+	8500:  {8500, "ERROR_DS_INVALID_SEARCH_FLAG", "ERROR_DS_INVALID_SEARCH_FLAG"},
+	8501:  {8501, "ERROR_DS_NO_TREE_DELETE_ABOVE_NC", "ERROR_DS_NO_TREE_DELETE_ABOVE_NC"},
+	8502:  {8502, "ERROR_DS_COULDNT_LOCK_TREE_FOR_DELETE", "ERROR_DS_COULDNT_LOCK_TREE_FOR_DELETE"},
+	8503:  {8503, "ERROR_DS_COULDNT_IDENTIFY_OBJECTS_FOR_TREE_DELETE", "ERROR_DS_COULDNT_IDENTIFY_OBJECTS_FOR_TREE_DELETE"},
+	8504:  {8504, "ERROR_DS_SAM_INIT_FAILURE", "ERROR_DS_SAM_INIT_FAILURE"},
+	8505:  {8505, "ERROR_DS_SENSITIVE_GROUP_VIOLATION", "ERROR_DS_SENSITIVE_GROUP_VIOLATION"},
+	8506:  {8506, "ERROR_DS_CANT_MOD_PRIMARYGROUPID", "ERROR_DS_CANT_MOD_PRIMARYGROUPID"},
+	8507:  {8507, "ERROR_DS_ILLEGAL_BASE_SCHEMA_MOD", "ERROR_DS_ILLEGAL_BASE_SCHEMA_MOD"},
+	8508:  {8508, "ERROR_DS_NONSAFE_SCHEMA_CHANGE", "ERROR_DS_NONSAFE_SCHEMA_CHANGE"},
+	8509:  {8509, "ERROR_DS_SCHEMA_UPDATE_DISALLOWED", "ERROR_DS_SCHEMA_UPDATE_DISALLOWED"},
+	8510:  {8510, "ERROR_DS_CANT_CREATE_UNDER_SCHEMA", "ERROR_DS_CANT_CREATE_UNDER_SCHEMA"},
+	8511:  {8511, "ERROR_DS_INSTALL_NO_SRC_SCH_VERSION", "ERROR_DS_INSTALL_NO_SRC_SCH_VERSION"},
+	8512:  {8512, "ERROR_DS_INSTALL_NO_SCH_VERSION_IN_INIFILE", "ERROR_DS_INSTALL_NO_SCH_VERSION_IN_INIFILE"},
+	8513:  {8513, "ERROR_DS_INVALID_GROUP_TYPE", "ERROR_DS_INVALID_GROUP_TYPE"},
+	8514:  {8514, "ERROR_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN", "ERROR_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN"},
+	8515:  {8515, "ERROR_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN", "ERROR_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN"},
+	8516:  {8516, "ERROR_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER", "ERROR_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER"},
+	8517:  {8517, "ERROR_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER", "ERROR_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER"},
+	8518:  {8518, "ERROR_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER", "ERROR_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER"},
+	8519:  {8519, "ERROR_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER", "ERROR_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER"},
+	8520:  {8520, "ERROR_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER", "ERROR_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER"},
+	8521:  {8521, "ERROR_DS_HAVE_PRIMARY_MEMBERS", "ERROR_DS_HAVE_PRIMARY_MEMBERS"},
+	8522:  {8522, "ERROR_DS_STRING_SD_CONVERSION_FAILED", "ERROR_DS_STRING_SD_CONVERSION_FAILED"},
+	8523:  {8523, "ERROR_DS_NAMING_MASTER_GC", "ERROR_DS_NAMING_MASTER_GC"},
+	8524:  {8524, "ERROR_DS_DNS_LOOKUP_FAILURE", "ERROR_DS_DNS_LOOKUP_FAILURE"},
+	8525:  {8525, "ERROR_DS_COULDNT_UPDATE_SPNS", "ERROR_DS_COULDNT_UPDATE_SPNS"},
+	8526:  {8526, "ERROR_DS_CANT_RETRIEVE_SD", "ERROR_DS_CANT_RETRIEVE_SD"},
+	8527:  {8527, "ERROR_DS_KEY_NOT_UNIQUE", "ERROR_DS_KEY_NOT_UNIQUE"},
+	8528:  {8528, "ERROR_DS_WRONG_LINKED_ATT_SYNTAX", "ERROR_DS_WRONG_LINKED_ATT_SYNTAX"},
+	8529:  {8529, "ERROR_DS_SAM_NEED_BOOTKEY_PASSWORD", "ERROR_DS_SAM_NEED_BOOTKEY_PASSWORD"},
+	8530:  {8530, "ERROR_DS_SAM_NEED_BOOTKEY_FLOPPY", "ERROR_DS_SAM_NEED_BOOTKEY_FLOPPY"},
+	8531:  {8531, "ERROR_DS_CANT_START", "ERROR_DS_CANT_START"},
+	8532:  {8532, "ERROR_DS_INIT_FAILURE", "ERROR_DS_INIT_FAILURE"},
+	8533:  {8533, "ERROR_DS_NO_PKT_PRIVACY_ON_CONNECTION", "ERROR_DS_NO_PKT_PRIVACY_ON_CONNECTION"},
+	8534:  {8534, "ERROR_DS_SOURCE_DOMAIN_IN_FOREST", "ERROR_DS_SOURCE_DOMAIN_IN_FOREST"},
+	8535:  {8535, "ERROR_DS_DESTINATION_DOMAIN_NOT_IN_FOREST", "ERROR_DS_DESTINATION_DOMAIN_NOT_IN_FOREST"},
+	8536:  {8536, "ERROR_DS_DESTINATION_AUDITING_NOT_ENABLED", "ERROR_DS_DESTINATION_AUDITING_NOT_ENABLED"},
+	8537:  {8537, "ERROR_DS_CANT_FIND_DC_FOR_SRC_DOMAIN", "ERROR_DS_CANT_FIND_DC_FOR_SRC_DOMAIN"},
+	8538:  {8538, "ERROR_DS_SRC_OBJ_NOT_GROUP_OR_USER", "ERROR_DS_SRC_OBJ_NOT_GROUP_OR_USER"},
+	8539:  {8539, "ERROR_DS_SRC_SID_EXISTS_IN_FOREST", "ERROR_DS_SRC_SID_EXISTS_IN_FOREST"},
+	8540:  {8540, "ERROR_DS_SRC_AND_DST_OBJECT_CLASS_MISMATCH", "ERROR_DS_SRC_AND_DST_OBJECT_CLASS_MISMATCH"},
+	8541:  {8541, "ERROR_SAM_INIT_FAILURE", "ERROR_SAM_INIT_FAILURE"},
+	8542:  {8542, "ERROR_DS_DRA_SCHEMA_INFO_SHIP", "ERROR_DS_DRA_SCHEMA_INFO_SHIP"},
+	8543:  {8543, "ERROR_DS_DRA_SCHEMA_CONFLICT", "ERROR_DS_DRA_SCHEMA_CONFLICT"},
+	8544:  {8544, "ERROR_DS_DRA_EARLIER_SCHEMA_CONFLICT", "ERROR_DS_DRA_EARLIER_SCHEMA_CONFLICT"},
+	8545:  {8545, "ERROR_DS_DRA_OBJ_NC_MISMATCH", "ERROR_DS_DRA_OBJ_NC_MISMATCH"},
+	8546:  {8546, "ERROR_DS_NC_STILL_HAS_DSAS", "ERROR_DS_NC_STILL_HAS_DSAS"},
+	8547:  {8547, "ERROR_DS_GC_REQUIRED", "ERROR_DS_GC_REQUIRED"},
+	8548:  {8548, "ERROR_DS_LOCAL_MEMBER_OF_LOCAL_ONLY", "ERROR_DS_LOCAL_MEMBER_OF_LOCAL_ONLY"},
+	8549:  {8549, "ERROR_DS_NO_FPO_IN_UNIVERSAL_GROUPS", "ERROR_DS_NO_FPO_IN_UNIVERSAL_GROUPS"},
+	8550:  {8550, "ERROR_DS_CANT_ADD_TO_GC", "ERROR_DS_CANT_ADD_TO_GC"},
+	8551:  {8551, "ERROR_DS_NO_CHECKPOINT_WITH_PDC", "ERROR_DS_NO_CHECKPOINT_WITH_PDC"},
+	8552:  {8552, "ERROR_DS_SOURCE_AUDITING_NOT_ENABLED", "ERROR_DS_SOURCE_AUDITING_NOT_ENABLED"},
+	8553:  {8553, "ERROR_DS_CANT_CREATE_IN_NONDOMAIN_NC", "ERROR_DS_CANT_CREATE_IN_NONDOMAIN_NC"},
+	8554:  {8554, "ERROR_DS_INVALID_NAME_FOR_SPN", "ERROR_DS_INVALID_NAME_FOR_SPN"},
+	8555:  {8555, "ERROR_DS_FILTER_USES_CONTRUCTED_ATTRS", "ERROR_DS_FILTER_USES_CONTRUCTED_ATTRS"},
+	8556:  {8556, "ERROR_DS_UNICODEPWD_NOT_IN_QUOTES", "ERROR_DS_UNICODEPWD_NOT_IN_QUOTES"},
+	8557:  {8557, "ERROR_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED", "ERROR_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED"},
+	8558:  {8558, "ERROR_DS_MUST_BE_RUN_ON_DST_DC", "ERROR_DS_MUST_BE_RUN_ON_DST_DC"},
+	8559:  {8559, "ERROR_DS_SRC_DC_MUST_BE_SP4_OR_GREATER", "ERROR_DS_SRC_DC_MUST_BE_SP4_OR_GREATER"},
+	8560:  {8560, "ERROR_DS_CANT_TREE_DELETE_CRITICAL_OBJ", "ERROR_DS_CANT_TREE_DELETE_CRITICAL_OBJ"},
+	8561:  {8561, "ERROR_DS_INIT_FAILURE_CONSOLE", "ERROR_DS_INIT_FAILURE_CONSOLE"},
+	8562:  {8562, "ERROR_DS_SAM_INIT_FAILURE_CONSOLE", "ERROR_DS_SAM_INIT_FAILURE_CONSOLE"},
+	8563:  {8563, "ERROR_DS_FOREST_VERSION_TOO_HIGH", "ERROR_DS_FOREST_VERSION_TOO_HIGH"},
+	8564:  {8564, "ERROR_DS_DOMAIN_VERSION_TOO_HIGH", "ERROR_DS_DOMAIN_VERSION_TOO_HIGH"},
+	8565:  {8565, "ERROR_DS_FOREST_VERSION_TOO_LOW", "ERROR_DS_FOREST_VERSION_TOO_LOW"},
+	8566:  {8566, "ERROR_DS_DOMAIN_VERSION_TOO_LOW", "ERROR_DS_DOMAIN_VERSION_TOO_LOW"},
+	8567:  {8567, "ERROR_DS_INCOMPATIBLE_VERSION", "ERROR_DS_INCOMPATIBLE_VERSION"},
+	8568:  {8568, "ERROR_DS_LOW_DSA_VERSION", "ERROR_DS_LOW_DSA_VERSION"},
+	8569:  {8569, "ERROR_DS_NO_BEHAVIOR_VERSION_IN_MIXEDDOMAIN", "ERROR_DS_NO_BEHAVIOR_VERSION_IN_MIXEDDOMAIN"},
+	8570:  {8570, "ERROR_DS_NOT_SUPPORTED_SORT_ORDER", "ERROR_DS_NOT_SUPPORTED_SORT_ORDER"},
+	8571:  {8571, "ERROR_DS_NAME_NOT_UNIQUE", "ERROR_DS_NAME_NOT_UNIQUE"},
+	8572:  {8572, "ERROR_DS_MACHINE_ACCOUNT_CREATED_PRENT4", "ERROR_DS_MACHINE_ACCOUNT_CREATED_PRENT4"},
+	8573:  {8573, "ERROR_DS_OUT_OF_VERSION_STORE", "ERROR_DS_OUT_OF_VERSION_STORE"},
+	8574:  {8574, "ERROR_DS_INCOMPATIBLE_CONTROLS_USED", "ERROR_DS_INCOMPATIBLE_CONTROLS_USED"},
+	8575:  {8575, "ERROR_DS_NO_REF_DOMAIN", "ERROR_DS_NO_REF_DOMAIN"},
+	8576:  {8576, "ERROR_DS_RESERVED_LINK_ID", "ERROR_DS_RESERVED_LINK_ID"},
+	8577:  {8577, "ERROR_DS_LINK_ID_NOT_AVAILABLE", "ERROR_DS_LINK_ID_NOT_AVAILABLE"},
+	8578:  {8578, "ERROR_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER", "ERROR_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER"},
+	8579:  {8579, "ERROR_DS_MODIFYDN_DISALLOWED_BY_INSTANCE_TYPE", "ERROR_DS_MODIFYDN_DISALLOWED_BY_INSTANCE_TYPE"},
+	8580:  {8580, "ERROR_DS_NO_OBJECT_MOVE_IN_SCHEMA_NC", "ERROR_DS_NO_OBJECT_MOVE_IN_SCHEMA_NC"},
+	8581:  {8581, "ERROR_DS_MODIFYDN_DISALLOWED_BY_FLAG", "ERROR_DS_MODIFYDN_DISALLOWED_BY_FLAG"},
+	8582:  {8582, "ERROR_DS_MODIFYDN_WRONG_GRANDPARENT", "ERROR_DS_MODIFYDN_WRONG_GRANDPARENT"},
+	8583:  {8583, "ERROR_DS_NAME_ERROR_TRUST_REFERRAL", "ERROR_DS_NAME_ERROR_TRUST_REFERRAL"},
+	8584:  {8584, "ERROR_NOT_SUPPORTED_ON_STANDARD_SERVER", "ERROR_NOT_SUPPORTED_ON_STANDARD_SERVER"},
+	8585:  {8585, "ERROR_DS_CANT_ACCESS_REMOTE_PART_OF_AD", "ERROR_DS_CANT_ACCESS_REMOTE_PART_OF_AD"},
+	8586:  {8586, "ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE_V2", "ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE_V2"},
+	8587:  {8587, "ERROR_DS_THREAD_LIMIT_EXCEEDED", "ERROR_DS_THREAD_LIMIT_EXCEEDED"},
+	8588:  {8588, "ERROR_DS_NOT_CLOSEST", "ERROR_DS_NOT_CLOSEST"},
+	8589:  {8589, "ERROR_DS_CANT_DERIVE_SPN_WITHOUT_SERVER_REF", "ERROR_DS_CANT_DERIVE_SPN_WITHOUT_SERVER_REF"},
+	8590:  {8590, "ERROR_DS_SINGLE_USER_MODE_FAILED", "ERROR_DS_SINGLE_USER_MODE_FAILED"},
+	8591:  {8591, "ERROR_DS_NTDSCRIPT_SYNTAX_ERROR", "ERROR_DS_NTDSCRIPT_SYNTAX_ERROR"},
+	8592:  {8592, "ERROR_DS_NTDSCRIPT_PROCESS_ERROR", "ERROR_DS_NTDSCRIPT_PROCESS_ERROR"},
+	8593:  {8593, "ERROR_DS_DIFFERENT_REPL_EPOCHS", "ERROR_DS_DIFFERENT_REPL_EPOCHS"},
+	8594:  {8594, "ERROR_DS_DRS_EXTENSIONS_CHANGED", "ERROR_DS_DRS_EXTENSIONS_CHANGED"},
+	8595:  {8595, "ERROR_DS_REPLICA_SET_CHANGE_NOT_ALLOWED_ON_DISABLED_CR", "ERROR_DS_REPLICA_SET_CHANGE_NOT_ALLOWED_ON_DISABLED_CR"},
+	8596:  {8596, "ERROR_DS_NO_MSDS_INTID", "ERROR_DS_NO_MSDS_INTID"},
+	8597:  {8597, "ERROR_DS_DUP_MSDS_INTID", "ERROR_DS_DUP_MSDS_INTID"},
+	8598:  {8598, "ERROR_DS_EXISTS_IN_RDNATTID", "ERROR_DS_EXISTS_IN_RDNATTID"},
+	8599:  {8599, "ERROR_DS_AUTHORIZATION_FAILED", "ERROR_DS_AUTHORIZATION_FAILED"},
+	8600:  {8600, "ERROR_DS_INVALID_SCRIPT", "ERROR_DS_INVALID_SCRIPT"},
+	8601:  {8601, "ERROR_DS_REMOTE_CROSSREF_OP_FAILED", "ERROR_DS_REMOTE_CROSSREF_OP_FAILED"},
+	8602:  {8602, "ERROR_DS_CROSS_REF_BUSY", "ERROR_DS_CROSS_REF_BUSY"},
+	8603:  {8603, "ERROR_DS_CANT_DERIVE_SPN_FOR_DELETED_DOMAIN", "ERROR_DS_CANT_DERIVE_SPN_FOR_DELETED_DOMAIN"},
+	8604:  {8604, "ERROR_DS_CANT_DEMOTE_WITH_WRITEABLE_NC", "ERROR_DS_CANT_DEMOTE_WITH_WRITEABLE_NC"},
+	8605:  {8605, "ERROR_DS_DUPLICATE_ID_FOUND", "ERROR_DS_DUPLICATE_ID_FOUND"},
+	8606:  {8606, "ERROR_DS_INSUFFICIENT_ATTR_TO_CREATE_OBJECT", "ERROR_DS_INSUFFICIENT_ATTR_TO_CREATE_OBJECT"},
+	8607:  {8607, "ERROR_DS_GROUP_CONVERSION_ERROR", "ERROR_DS_GROUP_CONVERSION_ERROR"},
+	8608:  {8608, "ERROR_DS_CANT_MOVE_APP_BASIC_GROUP", "ERROR_DS_CANT_MOVE_APP_BASIC_GROUP"},
+	8609:  {8609, "ERROR_DS_CANT_MOVE_APP_QUERY_GROUP", "ERROR_DS_CANT_MOVE_APP_QUERY_GROUP"},
+	8610:  {8610, "ERROR_DS_ROLE_NOT_VERIFIED", "ERROR_DS_ROLE_NOT_VERIFIED"},
+	8611:  {8611, "ERROR_DS_WKO_CONTAINER_CANNOT_BE_SPECIAL", "ERROR_DS_WKO_CONTAINER_CANNOT_BE_SPECIAL"},
+	8612:  {8612, "ERROR_DS_DOMAIN_RENAME_IN_PROGRESS", "ERROR_DS_DOMAIN_RENAME_IN_PROGRESS"},
+	8613:  {8613, "ERROR_DS_EXISTING_AD_CHILD_NC", "ERROR_DS_EXISTING_AD_CHILD_NC"},
+	8614:  {8614, "ERROR_DS_REPL_LIFETIME_EXCEEDED", "ERROR_DS_REPL_LIFETIME_EXCEEDED"},
+	8615:  {8615, "ERROR_DS_DISALLOWED_IN_SYSTEM_CONTAINER", "ERROR_DS_DISALLOWED_IN_SYSTEM_CONTAINER"},
+	8616:  {8616, "ERROR_DS_LDAP_SEND_QUEUE_FULL", "ERROR_DS_LDAP_SEND_QUEUE_FULL"},
+	8617:  {8617, "ERROR_DS_DRA_OUT_SCHEDULE_WINDOW", "ERROR_DS_DRA_OUT_SCHEDULE_WINDOW"},
+	8618:  {8618, "ERROR_DS_POLICY_NOT_KNOWN", "ERROR_DS_POLICY_NOT_KNOWN"},
+	8619:  {8619, "ERROR_NO_SITE_SETTINGS_OBJECT", "ERROR_NO_SITE_SETTINGS_OBJECT"},
+	8620:  {8620, "ERROR_NO_SECRETS", "ERROR_NO_SECRETS"},
+	8621:  {8621, "ERROR_NO_WRITABLE_DC_FOUND", "ERROR_NO_WRITABLE_DC_FOUND"},
+	8622:  {8622, "ERROR_DS_NO_SERVER_OBJECT", "ERROR_DS_NO_SERVER_OBJECT"},
+	8623:  {8623, "ERROR_DS_NO_NTDSA_OBJECT", "ERROR_DS_NO_NTDSA_OBJECT"},
+	8624:  {8624, "ERROR_DS_NON_ASQ_SEARCH", "ERROR_DS_NON_ASQ_SEARCH"},
+	8625:  {8625, "ERROR_DS_AUDIT_FAILURE", "ERROR_DS_AUDIT_FAILURE"},
+	8626:  {8626, "ERROR_DS_INVALID_SEARCH_FLAG_SUBTREE", "ERROR_DS_INVALID_SEARCH_FLAG_SUBTREE"},
+	8627:  {8627, "ERROR_DS_INVALID_SEARCH_FLAG_TUPLE", "ERROR_DS_INVALID_SEARCH_FLAG_TUPLE"},
+	8628:  {8628, "ERROR_DS_HIERARCHY_TABLE_TOO_DEEP", "ERROR_DS_HIERARCHY_TABLE_TOO_DEEP"},
+	8629:  {8629, "ERROR_DS_DRA_CORRUPT_UTD_VECTOR", "ERROR_DS_DRA_CORRUPT_UTD_VECTOR"},
+	8630:  {8630, "ERROR_DS_DRA_SECRETS_DENIED", "ERROR_DS_DRA_SECRETS_DENIED"},
+	8631:  {8631, "ERROR_DS_RESERVED_MAPI_ID", "ERROR_DS_RESERVED_MAPI_ID"},
+	8632:  {8632, "ERROR_DS_MAPI_ID_NOT_AVAILABLE", "ERROR_DS_MAPI_ID_NOT_AVAILABLE"},
+	8633:  {8633, "ERROR_DS_DRA_MISSING_KRBTGT_SECRET", "ERROR_DS_DRA_MISSING_KRBTGT_SECRET"},
+	8634:  {8634, "ERROR_DS_DOMAIN_NAME_EXISTS_IN_FOREST", "ERROR_DS_DOMAIN_NAME_EXISTS_IN_FOREST"},
+	8635:  {8635, "ERROR_DS_FLAT_NAME_EXISTS_IN_FOREST", "ERROR_DS_FLAT_NAME_EXISTS_IN_FOREST"},
+	8636:  {8636, "ERROR_INVALID_USER_PRINCIPAL_NAME", "ERROR_INVALID_USER_PRINCIPAL_NAME"},
+	8637:  {8637, "ERROR_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS", "ERROR_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS"},
+	8638:  {8638, "ERROR_DS_OID_NOT_FOUND", "ERROR_DS_OID_NOT_FOUND"},
+	8639:  {8639, "ERROR_DS_DRA_RECYCLED_TARGET", "ERROR_DS_DRA_RECYCLED_TARGET"},
+	8640:  {8640, "ERROR_DS_DISALLOWED_NC_REDIRECT", "ERROR_DS_DISALLOWED_NC_REDIRECT"},
+	8641:  {8641, "ERROR_DS_HIGH_ADLDS_FFL", "ERROR_DS_HIGH_ADLDS_FFL"},
+	8642:  {8642, "ERROR_DS_HIGH_DSA_VERSION", "ERROR_DS_HIGH_DSA_VERSION"},
+	8643:  {8643, "ERROR_DS_LOW_ADLDS_FFL", "ERROR_DS_LOW_ADLDS_FFL"},
+	8644:  {8644, "ERROR_DOMAIN_SID_SAME_AS_LOCAL_WORKSTATION", "ERROR_DOMAIN_SID_SAME_AS_LOCAL_WORKSTATION"},
+	8645:  {8645, "ERROR_DS_UNDELETE_SAM_VALIDATION_FAILED", "ERROR_DS_UNDELETE_SAM_VALIDATION_FAILED"},
+	8646:  {8646, "ERROR_INCORRECT_ACCOUNT_TYPE", "ERROR_INCORRECT_ACCOUNT_TYPE"},
+	8647:  {8647, "ERROR_DS_SPN_VALUE_NOT_UNIQUE_IN_FOREST", "ERROR_DS_SPN_VALUE_NOT_UNIQUE_IN_FOREST"},
+	8648:  {8648, "ERROR_DS_UPN_VALUE_NOT_UNIQUE_IN_FOREST", "ERROR_DS_UPN_VALUE_NOT_UNIQUE_IN_FOREST"},
+	8649:  {8649, "ERROR_DS_MISSING_FOREST_TRUST", "ERROR_DS_MISSING_FOREST_TRUST"},
+	8650:  {8650, "ERROR_DS_VALUE_KEY_NOT_UNIQUE", "ERROR_DS_VALUE_KEY_NOT_UNIQUE"},
+	13000: {13000, "ERROR_IPSEC_QM_POLICY_EXISTS", "ERROR_IPSEC_QM_POLICY_EXISTS"},
+	13001: {13001, "ERROR_IPSEC_QM_POLICY_NOT_FOUND", "ERROR_IPSEC_QM_POLICY_NOT_FOUND"},
+	13002: {13002, "ERROR_IPSEC_QM_POLICY_IN_USE", "ERROR_IPSEC_QM_POLICY_IN_USE"},
+	13003: {13003, "ERROR_IPSEC_MM_POLICY_EXISTS", "ERROR_IPSEC_MM_POLICY_EXISTS"},
+	13004: {13004, "ERROR_IPSEC_MM_POLICY_NOT_FOUND", "ERROR_IPSEC_MM_POLICY_NOT_FOUND"},
+	13005: {13005, "ERROR_IPSEC_MM_POLICY_IN_USE", "ERROR_IPSEC_MM_POLICY_IN_USE"},
+	13006: {13006, "ERROR_IPSEC_MM_FILTER_EXISTS", "ERROR_IPSEC_MM_FILTER_EXISTS"},
+	13007: {13007, "ERROR_IPSEC_MM_FILTER_NOT_FOUND", "ERROR_IPSEC_MM_FILTER_NOT_FOUND"},
+	13008: {13008, "ERROR_IPSEC_TRANSPORT_FILTER_EXISTS", "ERROR_IPSEC_TRANSPORT_FILTER_EXISTS"},
+	13009: {13009, "ERROR_IPSEC_TRANSPORT_FILTER_NOT_FOUND", "ERROR_IPSEC_TRANSPORT_FILTER_NOT_FOUND"},
+	13010: {13010, "ERROR_IPSEC_MM_AUTH_EXISTS", "ERROR_IPSEC_MM_AUTH_EXISTS"},
+	13011: {13011, "ERROR_IPSEC_MM_AUTH_NOT_FOUND", "ERROR_IPSEC_MM_AUTH_NOT_FOUND"},
+	13012: {13012, "ERROR_IPSEC_MM_AUTH_IN_USE", "ERROR_IPSEC_MM_AUTH_IN_USE"},
+	13013: {13013, "ERROR_IPSEC_DEFAULT_MM_POLICY_NOT_FOUND", "ERROR_IPSEC_DEFAULT_MM_POLICY_NOT_FOUND"},
+	13014: {13014, "ERROR_IPSEC_DEFAULT_MM_AUTH_NOT_FOUND", "ERROR_IPSEC_DEFAULT_MM_AUTH_NOT_FOUND"},
+	13015: {13015, "ERROR_IPSEC_DEFAULT_QM_POLICY_NOT_FOUND", "ERROR_IPSEC_DEFAULT_QM_POLICY_NOT_FOUND"},
+	13016: {13016, "ERROR_IPSEC_TUNNEL_FILTER_EXISTS", "ERROR_IPSEC_TUNNEL_FILTER_EXISTS"},
+	13017: {13017, "ERROR_IPSEC_TUNNEL_FILTER_NOT_FOUND", "ERROR_IPSEC_TUNNEL_FILTER_NOT_FOUND"},
+	13018: {13018, "ERROR_IPSEC_MM_FILTER_PENDING_DELETION", "ERROR_IPSEC_MM_FILTER_PENDING_DELETION"},
+	13019: {13019, "ERROR_IPSEC_TRANSPORT_FILTER_PENDING_DELETION", "ERROR_IPSEC_TRANSPORT_FILTER_PENDING_DELETION"},
+	13020: {13020, "ERROR_IPSEC_TUNNEL_FILTER_PENDING_DELETION", "ERROR_IPSEC_TUNNEL_FILTER_PENDING_DELETION"},
+	13021: {13021, "ERROR_IPSEC_MM_POLICY_PENDING_DELETION", "ERROR_IPSEC_MM_POLICY_PENDING_DELETION"},
+	13022: {13022, "ERROR_IPSEC_MM_AUTH_PENDING_DELETION", "ERROR_IPSEC_MM_AUTH_PENDING_DELETION"},
+	13023: {13023, "ERROR_IPSEC_QM_POLICY_PENDING_DELETION", "ERROR_IPSEC_QM_POLICY_PENDING_DELETION"},
+	13800: {13800, "ERROR_IPSEC_IKE_NEG_STATUS_BEGIN", "ERROR_IPSEC_IKE_NEG_STATUS_BEGIN"},
+	13801: {13801, "ERROR_IPSEC_IKE_AUTH_FAIL", "ERROR_IPSEC_IKE_AUTH_FAIL"},
+	13802: {13802, "ERROR_IPSEC_IKE_ATTRIB_FAIL", "ERROR_IPSEC_IKE_ATTRIB_FAIL"},
+	13803: {13803, "ERROR_IPSEC_IKE_NEGOTIATION_PENDING", "ERROR_IPSEC_IKE_NEGOTIATION_PENDING"},
+	13804: {13804, "ERROR_IPSEC_IKE_GENERAL_PROCESSING_ERROR", "ERROR_IPSEC_IKE_GENERAL_PROCESSING_ERROR"},
+	13805: {13805, "ERROR_IPSEC_IKE_TIMED_OUT", "ERROR_IPSEC_IKE_TIMED_OUT"},
+	13806: {13806, "ERROR_IPSEC_IKE_NO_CERT", "ERROR_IPSEC_IKE_NO_CERT"},
+	13807: {13807, "ERROR_IPSEC_IKE_SA_DELETED", "ERROR_IPSEC_IKE_SA_DELETED"},
+	13808: {13808, "ERROR_IPSEC_IKE_SA_REAPED", "ERROR_IPSEC_IKE_SA_REAPED"},
+	13809: {13809, "ERROR_IPSEC_IKE_MM_ACQUIRE_DROP", "ERROR_IPSEC_IKE_MM_ACQUIRE_DROP"},
+	13810: {13810, "ERROR_IPSEC_IKE_QM_ACQUIRE_DROP", "ERROR_IPSEC_IKE_QM_ACQUIRE_DROP"},
+	13811: {13811, "ERROR_IPSEC_IKE_QUEUE_DROP_MM", "ERROR_IPSEC_IKE_QUEUE_DROP_MM"},
+	13812: {13812, "ERROR_IPSEC_IKE_QUEUE_DROP_NO_MM", "ERROR_IPSEC_IKE_QUEUE_DROP_NO_MM"},
+	13813: {13813, "ERROR_IPSEC_IKE_DROP_NO_RESPONSE", "ERROR_IPSEC_IKE_DROP_NO_RESPONSE"},
+	13814: {13814, "ERROR_IPSEC_IKE_MM_DELAY_DROP", "ERROR_IPSEC_IKE_MM_DELAY_DROP"},
+	13815: {13815, "ERROR_IPSEC_IKE_QM_DELAY_DROP", "ERROR_IPSEC_IKE_QM_DELAY_DROP"},
+	13816: {13816, "ERROR_IPSEC_IKE_ERROR", "ERROR_IPSEC_IKE_ERROR"},
+	13817: {13817, "ERROR_IPSEC_IKE_CRL_FAILED", "ERROR_IPSEC_IKE_CRL_FAILED"},
+	13818: {13818, "ERROR_IPSEC_IKE_INVALID_KEY_USAGE", "ERROR_IPSEC_IKE_INVALID_KEY_USAGE"},
+	13819: {13819, "ERROR_IPSEC_IKE_INVALID_CERT_TYPE", "ERROR_IPSEC_IKE_INVALID_CERT_TYPE"},
+	13820: {13820, "ERROR_IPSEC_IKE_NO_PRIVATE_KEY", "ERROR_IPSEC_IKE_NO_PRIVATE_KEY"},
+	13821: {13821, "ERROR_IPSEC_IKE_SIMULTANEOUS_REKEY", "ERROR_IPSEC_IKE_SIMULTANEOUS_REKEY"},
+	13822: {13822, "ERROR_IPSEC_IKE_DH_FAIL", "ERROR_IPSEC_IKE_DH_FAIL"},
+	13823: {13823, "ERROR_IPSEC_IKE_CRITICAL_PAYLOAD_NOT_RECOGNIZED", "ERROR_IPSEC_IKE_CRITICAL_PAYLOAD_NOT_RECOGNIZED"},
+	13824: {13824, "ERROR_IPSEC_IKE_INVALID_HEADER", "ERROR_IPSEC_IKE_INVALID_HEADER"},
+	13825: {13825, "ERROR_IPSEC_IKE_NO_POLICY", "ERROR_IPSEC_IKE_NO_POLICY"},
+	13826: {13826, "ERROR_IPSEC_IKE_INVALID_SIGNATURE", "ERROR_IPSEC_IKE_INVALID_SIGNATURE"},
+	13827: {13827, "ERROR_IPSEC_IKE_KERBEROS_ERROR", "ERROR_IPSEC_IKE_KERBEROS_ERROR"},
+	13828: {13828, "ERROR_IPSEC_IKE_NO_PUBLIC_KEY", "ERROR_IPSEC_IKE_NO_PUBLIC_KEY"},
+	13829: {13829, "ERROR_IPSEC_IKE_PROCESS_ERR", "ERROR_IPSEC_IKE_PROCESS_ERR"},
+	13830: {13830, "ERROR_IPSEC_IKE_PROCESS_ERR_SA", "ERROR_IPSEC_IKE_PROCESS_ERR_SA"},
+	13831: {13831, "ERROR_IPSEC_IKE_PROCESS_ERR_PROP", "ERROR_IPSEC_IKE_PROCESS_ERR_PROP"},
+	13832: {13832, "ERROR_IPSEC_IKE_PROCESS_ERR_TRANS", "ERROR_IPSEC_IKE_PROCESS_ERR_TRANS"},
+	13833: {13833, "ERROR_IPSEC_IKE_PROCESS_ERR_KE", "ERROR_IPSEC_IKE_PROCESS_ERR_KE"},
+	13834: {13834, "ERROR_IPSEC_IKE_PROCESS_ERR_ID", "ERROR_IPSEC_IKE_PROCESS_ERR_ID"},
+	13835: {13835, "ERROR_IPSEC_IKE_PROCESS_ERR_CERT", "ERROR_IPSEC_IKE_PROCESS_ERR_CERT"},
+	13836: {13836, "ERROR_IPSEC_IKE_PROCESS_ERR_CERT_REQ", "ERROR_IPSEC_IKE_PROCESS_ERR_CERT_REQ"},
+	13837: {13837, "ERROR_IPSEC_IKE_PROCESS_ERR_HASH", "ERROR_IPSEC_IKE_PROCESS_ERR_HASH"},
+	13838: {13838, "ERROR_IPSEC_IKE_PROCESS_ERR_SIG", "ERROR_IPSEC_IKE_PROCESS_ERR_SIG"},
+	13839: {13839, "ERROR_IPSEC_IKE_PROCESS_ERR_NONCE", "ERROR_IPSEC_IKE_PROCESS_ERR_NONCE"},
+	13840: {13840, "ERROR_IPSEC_IKE_PROCESS_ERR_NOTIFY", "ERROR_IPSEC_IKE_PROCESS_ERR_NOTIFY"},
+	13841: {13841, "ERROR_IPSEC_IKE_PROCESS_ERR_DELETE", "ERROR_IPSEC_IKE_PROCESS_ERR_DELETE"},
+	13842: {13842, "ERROR_IPSEC_IKE_PROCESS_ERR_VENDOR", "ERROR_IPSEC_IKE_PROCESS_ERR_VENDOR"},
+	13843: {13843, "ERROR_IPSEC_IKE_INVALID_PAYLOAD", "ERROR_IPSEC_IKE_INVALID_PAYLOAD"},
+	13844: {13844, "ERROR_IPSEC_IKE_LOAD_SOFT_SA", "ERROR_IPSEC_IKE_LOAD_SOFT_SA"},
+	13845: {13845, "ERROR_IPSEC_IKE_SOFT_SA_TORN_DOWN", "ERROR_IPSEC_IKE_SOFT_SA_TORN_DOWN"},
+	13846: {13846, "ERROR_IPSEC_IKE_INVALID_COOKIE", "ERROR_IPSEC_IKE_INVALID_COOKIE"},
+	13847: {13847, "ERROR_IPSEC_IKE_NO_PEER_CERT", "ERROR_IPSEC_IKE_NO_PEER_CERT"},
+	13848: {13848, "ERROR_IPSEC_IKE_PEER_CRL_FAILED", "ERROR_IPSEC_IKE_PEER_CRL_FAILED"},
+	13849: {13849, "ERROR_IPSEC_IKE_POLICY_CHANGE", "ERROR_IPSEC_IKE_POLICY_CHANGE"},
+	13850: {13850, "ERROR_IPSEC_IKE_NO_MM_POLICY", "ERROR_IPSEC_IKE_NO_MM_POLICY"},
+	13851: {13851, "ERROR_IPSEC_IKE_NOTCBPRIV", "ERROR_IPSEC_IKE_NOTCBPRIV"},
+	13852: {13852, "ERROR_IPSEC_IKE_SECLOADFAIL", "ERROR_IPSEC_IKE_SECLOADFAIL"},
+	13853: {13853, "ERROR_IPSEC_IKE_FAILSSPINIT", "ERROR_IPSEC_IKE_FAILSSPINIT"},
+	13854: {13854, "ERROR_IPSEC_IKE_FAILQUERYSSP", "ERROR_IPSEC_IKE_FAILQUERYSSP"},
+	13855: {13855, "ERROR_IPSEC_IKE_SRVACQFAIL", "ERROR_IPSEC_IKE_SRVACQFAIL"},
+	13856: {13856, "ERROR_IPSEC_IKE_SRVQUERYCRED", "ERROR_IPSEC_IKE_SRVQUERYCRED"},
+	13857: {13857, "ERROR_IPSEC_IKE_GETSPIFAIL", "ERROR_IPSEC_IKE_GETSPIFAIL"},
+	13858: {13858, "ERROR_IPSEC_IKE_INVALID_FILTER", "ERROR_IPSEC_IKE_INVALID_FILTER"},
+	13859: {13859, "ERROR_IPSEC_IKE_OUT_OF_MEMORY", "ERROR_IPSEC_IKE_OUT_OF_MEMORY"},
+	13860: {13860, "ERROR_IPSEC_IKE_ADD_UPDATE_KEY_FAILED", "ERROR_IPSEC_IKE_ADD_UPDATE_KEY_FAILED"},
+	13861: {13861, "ERROR_IPSEC_IKE_INVALID_POLICY", "ERROR_IPSEC_IKE_INVALID_POLICY"},
+	13862: {13862, "ERROR_IPSEC_IKE_UNKNOWN_DOI", "ERROR_IPSEC_IKE_UNKNOWN_DOI"},
+	13863: {13863, "ERROR_IPSEC_IKE_INVALID_SITUATION", "ERROR_IPSEC_IKE_INVALID_SITUATION"},
+	13864: {13864, "ERROR_IPSEC_IKE_DH_FAILURE", "ERROR_IPSEC_IKE_DH_FAILURE"},
+	13865: {13865, "ERROR_IPSEC_IKE_INVALID_GROUP", "ERROR_IPSEC_IKE_INVALID_GROUP"},
+	13866: {13866, "ERROR_IPSEC_IKE_ENCRYPT", "ERROR_IPSEC_IKE_ENCRYPT"},
+	13867: {13867, "ERROR_IPSEC_IKE_DECRYPT", "ERROR_IPSEC_IKE_DECRYPT"},
+	13868: {13868, "ERROR_IPSEC_IKE_POLICY_MATCH", "ERROR_IPSEC_IKE_POLICY_MATCH"},
+	13869: {13869, "ERROR_IPSEC_IKE_UNSUPPORTED_ID", "ERROR_IPSEC_IKE_UNSUPPORTED_ID"},
+	13870: {13870, "ERROR_IPSEC_IKE_INVALID_HASH", "ERROR_IPSEC_IKE_INVALID_HASH"},
+	13871: {13871, "ERROR_IPSEC_IKE_INVALID_HASH_ALG", "ERROR_IPSEC_IKE_INVALID_HASH_ALG"},
+	13872: {13872, "ERROR_IPSEC_IKE_INVALID_HASH_SIZE", "ERROR_IPSEC_IKE_INVALID_HASH_SIZE"},
+	13873: {13873, "ERROR_IPSEC_IKE_INVALID_ENCRYPT_ALG", "ERROR_IPSEC_IKE_INVALID_ENCRYPT_ALG"},
+	13874: {13874, "ERROR_IPSEC_IKE_INVALID_AUTH_ALG", "ERROR_IPSEC_IKE_INVALID_AUTH_ALG"},
+	13875: {13875, "ERROR_IPSEC_IKE_INVALID_SIG", "ERROR_IPSEC_IKE_INVALID_SIG"},
+	13876: {13876, "ERROR_IPSEC_IKE_LOAD_FAILED", "ERROR_IPSEC_IKE_LOAD_FAILED"},
+	13877: {13877, "ERROR_IPSEC_IKE_RPC_DELETE", "ERROR_IPSEC_IKE_RPC_DELETE"},
+	13878: {13878, "ERROR_IPSEC_IKE_BENIGN_REINIT", "ERROR_IPSEC_IKE_BENIGN_REINIT"},
+	13879: {13879, "ERROR_IPSEC_IKE_INVALID_RESPONDER_LIFETIME_NOTIFY", "ERROR_IPSEC_IKE_INVALID_RESPONDER_LIFETIME_NOTIFY"},
+	13880: {13880, "ERROR_IPSEC_IKE_INVALID_MAJOR_VERSION", "ERROR_IPSEC_IKE_INVALID_MAJOR_VERSION"},
+	13881: {13881, "ERROR_IPSEC_IKE_INVALID_CERT_KEYLEN", "ERROR_IPSEC_IKE_INVALID_CERT_KEYLEN"},
+	13882: {13882, "ERROR_IPSEC_IKE_MM_LIMIT", "ERROR_IPSEC_IKE_MM_LIMIT"},
+	13883: {13883, "ERROR_IPSEC_IKE_NEGOTIATION_DISABLED", "ERROR_IPSEC_IKE_NEGOTIATION_DISABLED"},
+	13884: {13884, "ERROR_IPSEC_IKE_QM_LIMIT", "ERROR_IPSEC_IKE_QM_LIMIT"},
+	13885: {13885, "ERROR_IPSEC_IKE_MM_EXPIRED", "ERROR_IPSEC_IKE_MM_EXPIRED"},
+	13886: {13886, "ERROR_IPSEC_IKE_PEER_MM_ASSUMED_INVALID", "ERROR_IPSEC_IKE_PEER_MM_ASSUMED_INVALID"},
+	13887: {13887, "ERROR_IPSEC_IKE_CERT_CHAIN_POLICY_MISMATCH", "ERROR_IPSEC_IKE_CERT_CHAIN_POLICY_MISMATCH"},
+	13888: {13888, "ERROR_IPSEC_IKE_UNEXPECTED_MESSAGE_ID", "ERROR_IPSEC_IKE_UNEXPECTED_MESSAGE_ID"},
+	13889: {13889, "ERROR_IPSEC_IKE_INVALID_AUTH_PAYLOAD", "ERROR_IPSEC_IKE_INVALID_AUTH_PAYLOAD"},
+	13890: {13890, "ERROR_IPSEC_IKE_DOS_COOKIE_SENT", "ERROR_IPSEC_IKE_DOS_COOKIE_SENT"},
+	13891: {13891, "ERROR_IPSEC_IKE_SHUTTING_DOWN", "ERROR_IPSEC_IKE_SHUTTING_DOWN"},
+	13892: {13892, "ERROR_IPSEC_IKE_CGA_AUTH_FAILED", "ERROR_IPSEC_IKE_CGA_AUTH_FAILED"},
+	13893: {13893, "ERROR_IPSEC_IKE_PROCESS_ERR_NATOA", "ERROR_IPSEC_IKE_PROCESS_ERR_NATOA"},
+	13894: {13894, "ERROR_IPSEC_IKE_INVALID_MM_FOR_QM", "ERROR_IPSEC_IKE_INVALID_MM_FOR_QM"},
+	13895: {13895, "ERROR_IPSEC_IKE_QM_EXPIRED", "ERROR_IPSEC_IKE_QM_EXPIRED"},
+	13896: {13896, "ERROR_IPSEC_IKE_TOO_MANY_FILTERS", "ERROR_IPSEC_IKE_TOO_MANY_FILTERS"},
+	13897: {13897, "ERROR_IPSEC_IKE_NEG_STATUS_END", "ERROR_IPSEC_IKE_NEG_STATUS_END"},
+	13898: {13898, "ERROR_IPSEC_IKE_KILL_DUMMY_NAP_TUNNEL", "ERROR_IPSEC_IKE_KILL_DUMMY_NAP_TUNNEL"},
+	13899: {13899, "ERROR_IPSEC_IKE_INNER_IP_ASSIGNMENT_FAILURE", "ERROR_IPSEC_IKE_INNER_IP_ASSIGNMENT_FAILURE"},
+	13900: {13900, "ERROR_IPSEC_IKE_REQUIRE_CP_PAYLOAD_MISSING", "ERROR_IPSEC_IKE_REQUIRE_CP_PAYLOAD_MISSING"},
+	13901: {13901, "ERROR_IPSEC_KEY_MODULE_IMPERSONATION_NEGOTIATION_PENDING", "ERROR_IPSEC_KEY_MODULE_IMPERSONATION_NEGOTIATION_PENDING"},
+	13902: {13902, "ERROR_IPSEC_IKE_COEXISTENCE_SUPPRESS", "ERROR_IPSEC_IKE_COEXISTENCE_SUPPRESS"},
+	13903: {13903, "ERROR_IPSEC_IKE_RATELIMIT_DROP", "ERROR_IPSEC_IKE_RATELIMIT_DROP"},
+	13904: {13904, "ERROR_IPSEC_IKE_PEER_DOESNT_SUPPORT_MOBIKE", "ERROR_IPSEC_IKE_PEER_DOESNT_SUPPORT_MOBIKE"},
+	13905: {13905, "ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE", "ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE"},
+	13906: {13906, "ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_FAILURE", "ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_FAILURE"},
+	13907: {13907, "ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE_WITH_OPTIONAL_RETRY", "ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE_WITH_OPTIONAL_RETRY"},
+	13908: {13908, "ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_AND_CERTMAP_FAILURE", "ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_AND_CERTMAP_FAILURE"},
+	13909: {13909, "ERROR_IPSEC_IKE_NEG_STATUS_EXTENDED_END", "ERROR_IPSEC_IKE_NEG_STATUS_EXTENDED_END"},
+	13910: {13910, "ERROR_IPSEC_BAD_SPI", "ERROR_IPSEC_BAD_SPI"},
+	13911: {13911, "ERROR_IPSEC_SA_LIFETIME_EXPIRED", "ERROR_IPSEC_SA_LIFETIME_EXPIRED"},
+	13912: {13912, "ERROR_IPSEC_WRONG_SA", "ERROR_IPSEC_WRONG_SA"},
+	13913: {13913, "ERROR_IPSEC_REPLAY_CHECK_FAILED", "ERROR_IPSEC_REPLAY_CHECK_FAILED"},
+	13914: {13914, "ERROR_IPSEC_INVALID_PACKET", "ERROR_IPSEC_INVALID_PACKET"},
+	13915: {13915, "ERROR_IPSEC_INTEGRITY_CHECK_FAILED", "ERROR_IPSEC_INTEGRITY_CHECK_FAILED"},
+	13916: {13916, "ERROR_IPSEC_CLEAR_TEXT_DROP", "ERROR_IPSEC_CLEAR_TEXT_DROP"},
+	13917: {13917, "ERROR_IPSEC_AUTH_FIREWALL_DROP", "ERROR_IPSEC_AUTH_FIREWALL_DROP"},
+	13918: {13918, "ERROR_IPSEC_THROTTLE_DROP", "ERROR_IPSEC_THROTTLE_DROP"},
+	13925: {13925, "ERROR_IPSEC_DOSP_BLOCK", "ERROR_IPSEC_DOSP_BLOCK"},
+	13926: {13926, "ERROR_IPSEC_DOSP_RECEIVED_MULTICAST", "ERROR_IPSEC_DOSP_RECEIVED_MULTICAST"},
+	13927: {13927, "ERROR_IPSEC_DOSP_INVALID_PACKET", "ERROR_IPSEC_DOSP_INVALID_PACKET"},
+	13928: {13928, "ERROR_IPSEC_DOSP_STATE_LOOKUP_FAILED", "ERROR_IPSEC_DOSP_STATE_LOOKUP_FAILED"},
+	13929: {13929, "ERROR_IPSEC_DOSP_MAX_ENTRIES", "ERROR_IPSEC_DOSP_MAX_ENTRIES"},
+	13930: {13930, "ERROR_IPSEC_DOSP_KEYMOD_NOT_ALLOWED", "ERROR_IPSEC_DOSP_KEYMOD_NOT_ALLOWED"},
+	13931: {13931, "ERROR_IPSEC_DOSP_NOT_INSTALLED", "ERROR_IPSEC_DOSP_NOT_INSTALLED"},
+	13932: {13932, "ERROR_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES", "ERROR_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES"},
+	14000: {14000, "ERROR_SXS_SECTION_NOT_FOUND", "The requested section was not present in the activation context."},
+	14001: {14001, "ERROR_SXS_CANT_GEN_ACTCTX", "The application has failed to start because its side-by-side configuration is incorrect. Please see the application event log or use the command-line sxstrace.exe tool for more detail."},
+	14002: {14002, "ERROR_SXS_INVALID_ACTCTXDATA_FORMAT", "The application binding data format is invalid."},
+	14003: {14003, "ERROR_SXS_ASSEMBLY_NOT_FOUND", "The referenced assembly is not installed on your system."},
+	14004: {14004, "ERROR_SXS_MANIFEST_FORMAT_ERROR", "The manifest file does not begin with the required tag and format information."},
+	14005: {14005, "ERROR_SXS_MANIFEST_PARSE_ERROR", "The manifest file contains one or more syntax errors."},
+	14006: {14006, "ERROR_SXS_ACTIVATION_CONTEXT_DISABLED", "The application attempted to activate a disabled activation context."},
+	14007: {14007, "ERROR_SXS_KEY_NOT_FOUND", "The requested lookup key was not found in any active activation context."},
+	14008: {14008, "ERROR_SXS_VERSION_CONFLICT", "A component version required by the application conflicts with another component version already active."},
+	14009: {14009, "ERROR_SXS_WRONG_SECTION_TYPE", "The type requested activation context section does not match the query API used."},
+	14010: {14010, "ERROR_SXS_THREAD_QUERIES_DISABLED", "ERROR_SXS_THREAD_QUERIES_DISABLED"},
+	14011: {14011, "ERROR_SXS_PROCESS_DEFAULT_ALREADY_SET", "ERROR_SXS_PROCESS_DEFAULT_ALREADY_SET"},
+	14012: {14012, "ERROR_SXS_UNKNOWN_ENCODING_GROUP", "ERROR_SXS_UNKNOWN_ENCODING_GROUP"},
+	14013: {14013, "ERROR_SXS_UNKNOWN_ENCODING", "ERROR_SXS_UNKNOWN_ENCODING"},
+	14014: {14014, "ERROR_SXS_INVALID_XML_NAMESPACE_URI", "ERROR_SXS_INVALID_XML_NAMESPACE_URI"},
+	14015: {14015, "ERROR_SXS_ROOT_MANIFEST_DEPENDENCY_NOT_INSTALLED", "ERROR_SXS_ROOT_MANIFEST_DEPENDENCY_NOT_INSTALLED"},
+	14016: {14016, "ERROR_SXS_LEAF_MANIFEST_DEPENDENCY_NOT_INSTALLED", "ERROR_SXS_LEAF_MANIFEST_DEPENDENCY_NOT_INSTALLED"},
+	14017: {14017, "ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE", "ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE"},
+	14018: {14018, "ERROR_SXS_MANIFEST_MISSING_REQUIRED_DEFAULT_NAMESPACE", "ERROR_SXS_MANIFEST_MISSING_REQUIRED_DEFAULT_NAMESPACE"},
+	14019: {14019, "ERROR_SXS_MANIFEST_INVALID_REQUIRED_DEFAULT_NAMESPACE", "ERROR_SXS_MANIFEST_INVALID_REQUIRED_DEFAULT_NAMESPACE"},
+	14020: {14020, "ERROR_SXS_PRIVATE_MANIFEST_CROSS_PATH_WITH_REPARSE_POINT", "ERROR_SXS_PRIVATE_MANIFEST_CROSS_PATH_WITH_REPARSE_POINT"},
+	14021: {14021, "ERROR_SXS_DUPLICATE_DLL_NAME", "ERROR_SXS_DUPLICATE_DLL_NAME"},
+	14022: {14022, "ERROR_SXS_DUPLICATE_WINDOWCLASS_NAME", "ERROR_SXS_DUPLICATE_WINDOWCLASS_NAME"},
+	14023: {14023, "ERROR_SXS_DUPLICATE_CLSID", "ERROR_SXS_DUPLICATE_CLSID"},
+	14024: {14024, "ERROR_SXS_DUPLICATE_IID", "ERROR_SXS_DUPLICATE_IID"},
+	14025: {14025, "ERROR_SXS_DUPLICATE_TLBID", "ERROR_SXS_DUPLICATE_TLBID"},
+	14026: {14026, "ERROR_SXS_DUPLICATE_PROGID", "ERROR_SXS_DUPLICATE_PROGID"},
+	14027: {14027, "ERROR_SXS_DUPLICATE_ASSEMBLY_NAME", "ERROR_SXS_DUPLICATE_ASSEMBLY_NAME"},
+	14028: {14028, "ERROR_SXS_FILE_HASH_MISMATCH", "ERROR_SXS_FILE_HASH_MISMATCH"},
+	14029: {14029, "ERROR_SXS_POLICY_PARSE_ERROR", "ERROR_SXS_POLICY_PARSE_ERROR"},
+	14030: {14030, "ERROR_SXS_XML_E_MISSINGQUOTE", "ERROR_SXS_XML_E_MISSINGQUOTE"},
+	14031: {14031, "ERROR_SXS_XML_E_COMMENTSYNTAX", "ERROR_SXS_XML_E_COMMENTSYNTAX"},
+	14032: {14032, "ERROR_SXS_XML_E_BADSTARTNAMECHAR", "ERROR_SXS_XML_E_BADSTARTNAMECHAR"},
+	14033: {14033, "ERROR_SXS_XML_E_BADNAMECHAR", "ERROR_SXS_XML_E_BADNAMECHAR"},
+	14034: {14034, "ERROR_SXS_XML_E_BADCHARINSTRING", "ERROR_SXS_XML_E_BADCHARINSTRING"},
+	14035: {14035, "ERROR_SXS_XML_E_XMLDECLSYNTAX", "ERROR_SXS_XML_E_XMLDECLSYNTAX"},
+	14036: {14036, "ERROR_SXS_XML_E_BADCHARDATA", "ERROR_SXS_XML_E_BADCHARDATA"},
+	14037: {14037, "ERROR_SXS_XML_E_MISSINGWHITESPACE", "ERROR_SXS_XML_E_MISSINGWHITESPACE"},
+	14038: {14038, "ERROR_SXS_XML_E_EXPECTINGTAGEND", "ERROR_SXS_XML_E_EXPECTINGTAGEND"},
+	14039: {14039, "ERROR_SXS_XML_E_MISSINGSEMICOLON", "ERROR_SXS_XML_E_MISSINGSEMICOLON"},
+	14040: {14040, "ERROR_SXS_XML_E_UNBALANCEDPAREN", "ERROR_SXS_XML_E_UNBALANCEDPAREN"},
+	14041: {14041, "ERROR_SXS_XML_E_INTERNALERROR", "ERROR_SXS_XML_E_INTERNALERROR"},
+	14042: {14042, "ERROR_SXS_XML_E_UNEXPECTED_WHITESPACE", "ERROR_SXS_XML_E_UNEXPECTED_WHITESPACE"},
+	14043: {14043, "ERROR_SXS_XML_E_INCOMPLETE_ENCODING", "ERROR_SXS_XML_E_INCOMPLETE_ENCODING"},
+	14044: {14044, "ERROR_SXS_XML_E_MISSING_PAREN", "ERROR_SXS_XML_E_MISSING_PAREN"},
+	14045: {14045, "ERROR_SXS_XML_E_EXPECTINGCLOSEQUOTE", "ERROR_SXS_XML_E_EXPECTINGCLOSEQUOTE"},
+	14046: {14046, "ERROR_SXS_XML_E_MULTIPLE_COLONS", "ERROR_SXS_XML_E_MULTIPLE_COLONS"},
+	14047: {14047, "ERROR_SXS_XML_E_INVALID_DECIMAL", "ERROR_SXS_XML_E_INVALID_DECIMAL"},
+	14048: {14048, "ERROR_SXS_XML_E_INVALID_HEXIDECIMAL", "ERROR_SXS_XML_E_INVALID_HEXIDECIMAL"},
+	14049: {14049, "ERROR_SXS_XML_E_INVALID_UNICODE", "ERROR_SXS_XML_E_INVALID_UNICODE"},
+	14050: {14050, "ERROR_SXS_XML_E_WHITESPACEORQUESTIONMARK", "ERROR_SXS_XML_E_WHITESPACEORQUESTIONMARK"},
+	14051: {14051, "ERROR_SXS_XML_E_UNEXPECTEDENDTAG", "ERROR_SXS_XML_E_UNEXPECTEDENDTAG"},
+	14052: {14052, "ERROR_SXS_XML_E_UNCLOSEDTAG", "ERROR_SXS_XML_E_UNCLOSEDTAG"},
+	14053: {14053, "ERROR_SXS_XML_E_DUPLICATEATTRIBUTE", "ERROR_SXS_XML_E_DUPLICATEATTRIBUTE"},
+	14054: {14054, "ERROR_SXS_XML_E_MULTIPLEROOTS", "ERROR_SXS_XML_E_MULTIPLEROOTS"},
+	14055: {14055, "ERROR_SXS_XML_E_INVALIDATROOTLEVEL", "ERROR_SXS_XML_E_INVALIDATROOTLEVEL"},
+	14056: {14056, "ERROR_SXS_XML_E_BADXMLDECL", "ERROR_SXS_XML_E_BADXMLDECL"},
+	14057: {14057, "ERROR_SXS_XML_E_MISSINGROOT", "ERROR_SXS_XML_E_MISSINGROOT"},
+	14058: {14058, "ERROR_SXS_XML_E_UNEXPECTEDEOF", "ERROR_SXS_XML_E_UNEXPECTEDEOF"},
+	14059: {14059, "ERROR_SXS_XML_E_BADPEREFINSUBSET", "ERROR_SXS_XML_E_BADPEREFINSUBSET"},
+	14060: {14060, "ERROR_SXS_XML_E_UNCLOSEDSTARTTAG", "ERROR_SXS_XML_E_UNCLOSEDSTARTTAG"},
+	14061: {14061, "ERROR_SXS_XML_E_UNCLOSEDENDTAG", "ERROR_SXS_XML_E_UNCLOSEDENDTAG"},
+	14062: {14062, "ERROR_SXS_XML_E_UNCLOSEDSTRING", "ERROR_SXS_XML_E_UNCLOSEDSTRING"},
+	14063: {14063, "ERROR_SXS_XML_E_UNCLOSEDCOMMENT", "ERROR_SXS_XML_E_UNCLOSEDCOMMENT"},
+	14064: {14064, "ERROR_SXS_XML_E_UNCLOSEDDECL", "ERROR_SXS_XML_E_UNCLOSEDDECL"},
+	14065: {14065, "ERROR_SXS_XML_E_UNCLOSEDCDATA", "ERROR_SXS_XML_E_UNCLOSEDCDATA"},
+	14066: {14066, "ERROR_SXS_XML_E_RESERVEDNAMESPACE", "ERROR_SXS_XML_E_RESERVEDNAMESPACE"},
+	14067: {14067, "ERROR_SXS_XML_E_INVALIDENCODING", "ERROR_SXS_XML_E_INVALIDENCODING"},
+	14068: {14068, "ERROR_SXS_XML_E_INVALIDSWITCH", "ERROR_SXS_XML_E_INVALIDSWITCH"},
+	14069: {14069, "ERROR_SXS_XML_E_BADXMLCASE", "ERROR_SXS_XML_E_BADXMLCASE"},
+	14070: {14070, "ERROR_SXS_XML_E_INVALID_STANDALONE", "ERROR_SXS_XML_E_INVALID_STANDALONE"},
+	14071: {14071, "ERROR_SXS_XML_E_UNEXPECTED_STANDALONE", "ERROR_SXS_XML_E_UNEXPECTED_STANDALONE"},
+	14072: {14072, "ERROR_SXS_XML_E_INVALID_VERSION", "ERROR_SXS_XML_E_INVALID_VERSION"},
+	14073: {14073, "ERROR_SXS_XML_E_MISSINGEQUALS", "ERROR_SXS_XML_E_MISSINGEQUALS"},
+	14074: {14074, "ERROR_SXS_PROTECTION_RECOVERY_FAILED", "ERROR_SXS_PROTECTION_RECOVERY_FAILED"},
+	14075: {14075, "ERROR_SXS_PROTECTION_PUBLIC_KEY_TOO_SHORT", "ERROR_SXS_PROTECTION_PUBLIC_KEY_TOO_SHORT"},
+	14076: {14076, "ERROR_SXS_PROTECTION_CATALOG_NOT_VALID", "ERROR_SXS_PROTECTION_CATALOG_NOT_VALID"},
+	14077: {14077, "ERROR_SXS_UNTRANSLATABLE_HRESULT", "ERROR_SXS_UNTRANSLATABLE_HRESULT"},
+	14078: {14078, "ERROR_SXS_PROTECTION_CATALOG_FILE_MISSING", "ERROR_SXS_PROTECTION_CATALOG_FILE_MISSING"},
+	14079: {14079, "ERROR_SXS_MISSING_ASSEMBLY_IDENTITY_ATTRIBUTE", "ERROR_SXS_MISSING_ASSEMBLY_IDENTITY_ATTRIBUTE"},
+	14080: {14080, "ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE_NAME", "ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE_NAME"},
+	14081: {14081, "ERROR_SXS_ASSEMBLY_MISSING", "ERROR_SXS_ASSEMBLY_MISSING"},
+	14082: {14082, "ERROR_SXS_CORRUPT_ACTIVATION_STACK", "ERROR_SXS_CORRUPT_ACTIVATION_STACK"},
+	14083: {14083, "ERROR_SXS_CORRUPTION", "ERROR_SXS_CORRUPTION"},
+	14084: {14084, "ERROR_SXS_EARLY_DEACTIVATION", "ERROR_SXS_EARLY_DEACTIVATION"},
+	14085: {14085, "ERROR_SXS_INVALID_DEACTIVATION", "ERROR_SXS_INVALID_DEACTIVATION"},
+	14086: {14086, "ERROR_SXS_MULTIPLE_DEACTIVATION", "ERROR_SXS_MULTIPLE_DEACTIVATION"},
+	14087: {14087, "ERROR_SXS_PROCESS_TERMINATION_REQUESTED", "ERROR_SXS_PROCESS_TERMINATION_REQUESTED"},
+	14088: {14088, "ERROR_SXS_RELEASE_ACTIVATION_CONTEXT", "ERROR_SXS_RELEASE_ACTIVATION_CONTEXT"},
+	14089: {14089, "ERROR_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY", "ERROR_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY"},
+	14090: {14090, "ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE", "ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE"},
+	14091: {14091, "ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME", "ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME"},
+	14092: {14092, "ERROR_SXS_IDENTITY_DUPLICATE_ATTRIBUTE", "ERROR_SXS_IDENTITY_DUPLICATE_ATTRIBUTE"},
+	14093: {14093, "ERROR_SXS_IDENTITY_PARSE_ERROR", "ERROR_SXS_IDENTITY_PARSE_ERROR"},
+	14094: {14094, "ERROR_MALFORMED_SUBSTITUTION_STRING", "ERROR_MALFORMED_SUBSTITUTION_STRING"},
+	14095: {14095, "ERROR_SXS_INCORRECT_PUBLIC_KEY_TOKEN", "ERROR_SXS_INCORRECT_PUBLIC_KEY_TOKEN"},
+	14096: {14096, "ERROR_UNMAPPED_SUBSTITUTION_STRING", "ERROR_UNMAPPED_SUBSTITUTION_STRING"},
+	14097: {14097, "ERROR_SXS_ASSEMBLY_NOT_LOCKED", "ERROR_SXS_ASSEMBLY_NOT_LOCKED"},
+	14098: {14098, "ERROR_SXS_COMPONENT_STORE_CORRUPT", "ERROR_SXS_COMPONENT_STORE_CORRUPT"},
+	14099: {14099, "ERROR_ADVANCED_INSTALLER_FAILED", "ERROR_ADVANCED_INSTALLER_FAILED"},
+	14100: {14100, "ERROR_XML_ENCODING_MISMATCH", "ERROR_XML_ENCODING_MISMATCH"},
+	14101: {14101, "ERROR_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT", "ERROR_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT"},
+	14102: {14102, "ERROR_SXS_IDENTITIES_DIFFERENT", "ERROR_SXS_IDENTITIES_DIFFERENT"},
+	14103: {14103, "ERROR_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT", "ERROR_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT"},
+	14104: {14104, "ERROR_SXS_FILE_NOT_PART_OF_ASSEMBLY", "ERROR_SXS_FILE_NOT_PART_OF_ASSEMBLY"},
+	14105: {14105, "ERROR_SXS_MANIFEST_TOO_BIG", "ERROR_SXS_MANIFEST_TOO_BIG"},
+	14106: {14106, "ERROR_SXS_SETTING_NOT_REGISTERED", "ERROR_SXS_SETTING_NOT_REGISTERED"},
+	14107: {14107, "ERROR_SXS_TRANSACTION_CLOSURE_INCOMPLETE", "ERROR_SXS_TRANSACTION_CLOSURE_INCOMPLETE"},
+	14108: {14108, "ERROR_SMI_PRIMITIVE_INSTALLER_FAILED", "ERROR_SMI_PRIMITIVE_INSTALLER_FAILED"},
+	14109: {14109, "ERROR_GENERIC_COMMAND_FAILED", "ERROR_GENERIC_COMMAND_FAILED"},
+	14110: {14110, "ERROR_SXS_FILE_HASH_MISSING", "ERROR_SXS_FILE_HASH_MISSING"},
+	14111: {14111, "ERROR_SXS_DUPLICATE_ACTIVATABLE_CLASS", "ERROR_SXS_DUPLICATE_ACTIVATABLE_CLASS"},
+	15000: {15000, "ERROR_EVT_INVALID_CHANNEL_PATH", "The specified channel path is invalid."},
+	15001: {15001, "ERROR_EVT_INVALID_QUERY", "The specified query is invalid."},
+	15002: {15002, "ERROR_EVT_PUBLISHER_METADATA_NOT_FOUND", "The publisher metadata cannot be found in the resource."},
+	15003: {15003, "ERROR_EVT_EVENT_TEMPLATE_NOT_FOUND", "The template for an event definition cannot be found in the resource (error = %1)."},
+	15004: {15004, "ERROR_EVT_INVALID_PUBLISHER_NAME", "The specified publisher name is invalid."},
+	15005: {15005, "ERROR_EVT_INVALID_EVENT_DATA", "The event data raised by the publisher is not compatible with the event template definition in the publisher's manifest."},
+	15007: {15007, "ERROR_EVT_CHANNEL_NOT_FOUND", "The specified channel could not be found."},
+	15008: {15008, "ERROR_EVT_MALFORMED_XML_TEXT", "The specified XML text was not well-formed. See Extended Error for more details."},
+	15009: {15009, "ERROR_EVT_SUBSCRIPTION_TO_DIRECT_CHANNEL", "ERROR_EVT_SUBSCRIPTION_TO_DIRECT_CHANNEL"},
+	15010: {15010, "ERROR_EVT_CONFIGURATION_ERROR", "ERROR_EVT_CONFIGURATION_ERROR"},
+	15011: {15011, "ERROR_EVT_QUERY_RESULT_STALE", "ERROR_EVT_QUERY_RESULT_STALE"},
+	15012: {15012, "ERROR_EVT_QUERY_RESULT_INVALID_POSITION", "ERROR_EVT_QUERY_RESULT_INVALID_POSITION"},
+	15013: {15013, "ERROR_EVT_NON_VALIDATING_MSXML", "ERROR_EVT_NON_VALIDATING_MSXML"},
+	15014: {15014, "ERROR_EVT_FILTER_ALREADYSCOPED", "ERROR_EVT_FILTER_ALREADYSCOPED"},
+	15015: {15015, "ERROR_EVT_FILTER_NOTELTSET", "ERROR_EVT_FILTER_NOTELTSET"},
+	15016: {15016, "ERROR_EVT_FILTER_INVARG", "ERROR_EVT_FILTER_INVARG"},
+	15017: {15017, "ERROR_EVT_FILTER_INVTEST", "ERROR_EVT_FILTER_INVTEST"},
+	15018: {15018, "ERROR_EVT_FILTER_INVTYPE", "ERROR_EVT_FILTER_INVTYPE"},
+	15019: {15019, "ERROR_EVT_FILTER_PARSEERR", "ERROR_EVT_FILTER_PARSEERR"},
+	15020: {15020, "ERROR_EVT_FILTER_UNSUPPORTEDOP", "ERROR_EVT_FILTER_UNSUPPORTEDOP"},
+	15021: {15021, "ERROR_EVT_FILTER_UNEXPECTEDTOKEN", "ERROR_EVT_FILTER_UNEXPECTEDTOKEN"},
+	15022: {15022, "ERROR_EVT_INVALID_OPERATION_OVER_ENABLED_DIRECT_CHANNEL", "ERROR_EVT_INVALID_OPERATION_OVER_ENABLED_DIRECT_CHANNEL"},
+	15023: {15023, "ERROR_EVT_INVALID_CHANNEL_PROPERTY_VALUE", "ERROR_EVT_INVALID_CHANNEL_PROPERTY_VALUE"},
+	15024: {15024, "ERROR_EVT_INVALID_PUBLISHER_PROPERTY_VALUE", "ERROR_EVT_INVALID_PUBLISHER_PROPERTY_VALUE"},
+	15025: {15025, "ERROR_EVT_CHANNEL_CANNOT_ACTIVATE", "ERROR_EVT_CHANNEL_CANNOT_ACTIVATE"},
+	15026: {15026, "ERROR_EVT_FILTER_TOO_COMPLEX", "ERROR_EVT_FILTER_TOO_COMPLEX"},
+	15027: {15027, "ERROR_EVT_MESSAGE_NOT_FOUND", "ERROR_EVT_MESSAGE_NOT_FOUND"},
+	15028: {15028, "ERROR_EVT_MESSAGE_ID_NOT_FOUND", "ERROR_EVT_MESSAGE_ID_NOT_FOUND"},
+	15029: {15029, "ERROR_EVT_UNRESOLVED_VALUE_INSERT", "ERROR_EVT_UNRESOLVED_VALUE_INSERT"},
+	15030: {15030, "ERROR_EVT_UNRESOLVED_PARAMETER_INSERT", "ERROR_EVT_UNRESOLVED_PARAMETER_INSERT"},
+	15031: {15031, "ERROR_EVT_MAX_INSERTS_REACHED", "ERROR_EVT_MAX_INSERTS_REACHED"},
+	15032: {15032, "ERROR_EVT_EVENT_DEFINITION_NOT_FOUND", "ERROR_EVT_EVENT_DEFINITION_NOT_FOUND"},
+	15033: {15033, "ERROR_EVT_MESSAGE_LOCALE_NOT_FOUND", "ERROR_EVT_MESSAGE_LOCALE_NOT_FOUND"},
+	15034: {15034, "ERROR_EVT_VERSION_TOO_OLD", "ERROR_EVT_VERSION_TOO_OLD"},
+	15035: {15035, "ERROR_EVT_VERSION_TOO_NEW", "ERROR_EVT_VERSION_TOO_NEW"},
+	15036: {15036, "ERROR_EVT_CANNOT_OPEN_CHANNEL_OF_QUERY", "ERROR_EVT_CANNOT_OPEN_CHANNEL_OF_QUERY"},
+	15037: {15037, "ERROR_EVT_PUBLISHER_DISABLED", "ERROR_EVT_PUBLISHER_DISABLED"},
+	15038: {15038, "ERROR_EVT_FILTER_OUT_OF_RANGE", "ERROR_EVT_FILTER_OUT_OF_RANGE"},
+	15080: {15080, "ERROR_EC_SUBSCRIPTION_CANNOT_ACTIVATE", "ERROR_EC_SUBSCRIPTION_CANNOT_ACTIVATE"},
+	15081: {15081, "ERROR_EC_LOG_DISABLED", "ERROR_EC_LOG_DISABLED"},
+	15082: {15082, "ERROR_EC_CIRCULAR_FORWARDING", "ERROR_EC_CIRCULAR_FORWARDING"},
+	15083: {15083, "ERROR_EC_CREDSTORE_FULL", "ERROR_EC_CREDSTORE_FULL"},
+	15084: {15084, "ERROR_EC_CRED_NOT_FOUND", "ERROR_EC_CRED_NOT_FOUND"},
+	15085: {15085, "ERROR_EC_NO_ACTIVE_CHANNEL", "ERROR_EC_NO_ACTIVE_CHANNEL"},
+	15100: {15100, "ERROR_MUI_FILE_NOT_FOUND", "ERROR_MUI_FILE_NOT_FOUND"},
+	15101: {15101, "ERROR_MUI_INVALID_FILE", "ERROR_MUI_INVALID_FILE"},
+	15102: {15102, "ERROR_MUI_INVALID_RC_CONFIG", "ERROR_MUI_INVALID_RC_CONFIG"},
+	15103: {15103, "ERROR_MUI_INVALID_LOCALE_NAME", "ERROR_MUI_INVALID_LOCALE_NAME"},
+	15104: {15104, "ERROR_MUI_INVALID_ULTIMATEFALLBACK_NAME", "ERROR_MUI_INVALID_ULTIMATEFALLBACK_NAME"},
+	15105: {15105, "ERROR_MUI_FILE_NOT_LOADED", "ERROR_MUI_FILE_NOT_LOADED"},
+	15106: {15106, "ERROR_RESOURCE_ENUM_USER_STOP", "ERROR_RESOURCE_ENUM_USER_STOP"},
+	15107: {15107, "ERROR_MUI_INTLSETTINGS_UILANG_NOT_INSTALLED", "ERROR_MUI_INTLSETTINGS_UILANG_NOT_INSTALLED"},
+	15108: {15108, "ERROR_MUI_INTLSETTINGS_INVALID_LOCALE_NAME", "ERROR_MUI_INTLSETTINGS_INVALID_LOCALE_NAME"},
+	15110: {15110, "ERROR_MRM_RUNTIME_NO_DEFAULT_OR_NEUTRAL_RESOURCE", "ERROR_MRM_RUNTIME_NO_DEFAULT_OR_NEUTRAL_RESOURCE"},
+	15111: {15111, "ERROR_MRM_INVALID_PRICONFIG", "ERROR_MRM_INVALID_PRICONFIG"},
+	15112: {15112, "ERROR_MRM_INVALID_FILE_TYPE", "ERROR_MRM_INVALID_FILE_TYPE"},
+	15113: {15113, "ERROR_MRM_UNKNOWN_QUALIFIER", "ERROR_MRM_UNKNOWN_QUALIFIER"},
+	15114: {15114, "ERROR_MRM_INVALID_QUALIFIER_VALUE", "ERROR_MRM_INVALID_QUALIFIER_VALUE"},
+	15115: {15115, "ERROR_MRM_NO_CANDIDATE", "ERROR_MRM_NO_CANDIDATE"},
+	15116: {15116, "ERROR_MRM_NO_MATCH_OR_DEFAULT_CANDIDATE", "ERROR_MRM_NO_MATCH_OR_DEFAULT_CANDIDATE"},
+	15117: {15117, "ERROR_MRM_RESOURCE_TYPE_MISMATCH", "ERROR_MRM_RESOURCE_TYPE_MISMATCH"},
+	15118: {15118, "ERROR_MRM_DUPLICATE_MAP_NAME", "ERROR_MRM_DUPLICATE_MAP_NAME"},
+	15119: {15119, "ERROR_MRM_DUPLICATE_ENTRY", "ERROR_MRM_DUPLICATE_ENTRY"},
+	15120: {15120, "ERROR_MRM_INVALID_RESOURCE_IDENTIFIER", "ERROR_MRM_INVALID_RESOURCE_IDENTIFIER"},
+	15121: {15121, "ERROR_MRM_FILEPATH_TOO_LONG", "ERROR_MRM_FILEPATH_TOO_LONG"},
+	15122: {15122, "ERROR_MRM_UNSUPPORTED_DIRECTORY_TYPE", "ERROR_MRM_UNSUPPORTED_DIRECTORY_TYPE"},
+	15126: {15126, "ERROR_MRM_INVALID_PRI_FILE", "ERROR_MRM_INVALID_PRI_FILE"},
+	15127: {15127, "ERROR_MRM_NAMED_RESOURCE_NOT_FOUND", "ERROR_MRM_NAMED_RESOURCE_NOT_FOUND"},
+	15135: {15135, "ERROR_MRM_MAP_NOT_FOUND", "ERROR_MRM_MAP_NOT_FOUND"},
+	15136: {15136, "ERROR_MRM_UNSUPPORTED_PROFILE_TYPE", "ERROR_MRM_UNSUPPORTED_PROFILE_TYPE"},
+	15137: {15137, "ERROR_MRM_INVALID_QUALIFIER_OPERATOR", "ERROR_MRM_INVALID_QUALIFIER_OPERATOR"},
+	15138: {15138, "ERROR_MRM_INDETERMINATE_QUALIFIER_VALUE", "ERROR_MRM_INDETERMINATE_QUALIFIER_VALUE"},
+	15139: {15139, "ERROR_MRM_AUTOMERGE_ENABLED", "ERROR_MRM_AUTOMERGE_ENABLED"},
+	15140: {15140, "ERROR_MRM_TOO_MANY_RESOURCES", "ERROR_MRM_TOO_MANY_RESOURCES"},
+	15141: {15141, "ERROR_MRM_UNSUPPORTED_FILE_TYPE_FOR_MERGE", "ERROR_MRM_UNSUPPORTED_FILE_TYPE_FOR_MERGE"},
+	15142: {15142, "ERROR_MRM_UNSUPPORTED_FILE_TYPE_FOR_LOAD_UNLOAD_PRI_FILE", "ERROR_MRM_UNSUPPORTED_FILE_TYPE_FOR_LOAD_UNLOAD_PRI_FILE"},
+	15143: {15143, "ERROR_MRM_NO_CURRENT_VIEW_ON_THREAD", "ERROR_MRM_NO_CURRENT_VIEW_ON_THREAD"},
+	15144: {15144, "ERROR_DIFFERENT_PROFILE_RESOURCE_MANAGER_EXIST", "ERROR_DIFFERENT_PROFILE_RESOURCE_MANAGER_EXIST"},
+	15145: {15145, "ERROR_OPERATION_NOT_ALLOWED_FROM_SYSTEM_COMPONENT", "ERROR_OPERATION_NOT_ALLOWED_FROM_SYSTEM_COMPONENT"},
+	15146: {15146, "ERROR_MRM_DIRECT_REF_TO_NON_DEFAULT_RESOURCE", "ERROR_MRM_DIRECT_REF_TO_NON_DEFAULT_RESOURCE"},
+	15147: {15147, "ERROR_MRM_GENERATION_COUNT_MISMATCH", "ERROR_MRM_GENERATION_COUNT_MISMATCH"},
+	15148: {15148, "ERROR_PRI_MERGE_VERSION_MISMATCH", "ERROR_PRI_MERGE_VERSION_MISMATCH"},
+	15149: {15149, "ERROR_PRI_MERGE_MISSING_SCHEMA", "ERROR_PRI_MERGE_MISSING_SCHEMA"},
+	15150: {15150, "ERROR_PRI_MERGE_LOAD_FILE_FAILED", "ERROR_PRI_MERGE_LOAD_FILE_FAILED"},
+	15151: {15151, "ERROR_PRI_MERGE_ADD_FILE_FAILED", "ERROR_PRI_MERGE_ADD_FILE_FAILED"},
+	15152: {15152, "ERROR_PRI_MERGE_WRITE_FILE_FAILED", "ERROR_PRI_MERGE_WRITE_FILE_FAILED"},
+	15153: {15153, "ERROR_PRI_MERGE_MULTIPLE_PACKAGE_FAMILIES_NOT_ALLOWED", "ERROR_PRI_MERGE_MULTIPLE_PACKAGE_FAMILIES_NOT_ALLOWED"},
+	15154: {15154, "ERROR_PRI_MERGE_MULTIPLE_MAIN_PACKAGES_NOT_ALLOWED", "ERROR_PRI_MERGE_MULTIPLE_MAIN_PACKAGES_NOT_ALLOWED"},
+	15155: {15155, "ERROR_PRI_MERGE_BUNDLE_PACKAGES_NOT_ALLOWED", "ERROR_PRI_MERGE_BUNDLE_PACKAGES_NOT_ALLOWED"},
+	15156: {15156, "ERROR_PRI_MERGE_MAIN_PACKAGE_REQUIRED", "ERROR_PRI_MERGE_MAIN_PACKAGE_REQUIRED"},
+	15157: {15157, "ERROR_PRI_MERGE_RESOURCE_PACKAGE_REQUIRED", "ERROR_PRI_MERGE_RESOURCE_PACKAGE_REQUIRED"},
+	15158: {15158, "ERROR_PRI_MERGE_INVALID_FILE_NAME", "ERROR_PRI_MERGE_INVALID_FILE_NAME"},
+	15159: {15159, "ERROR_MRM_PACKAGE_NOT_FOUND", "ERROR_MRM_PACKAGE_NOT_FOUND"},
+	15160: {15160, "ERROR_MRM_MISSING_DEFAULT_LANGUAGE", "ERROR_MRM_MISSING_DEFAULT_LANGUAGE"},
+	15200: {15200, "ERROR_MCA_INVALID_CAPABILITIES_STRING", "ERROR_MCA_INVALID_CAPABILITIES_STRING"},
+	15201: {15201, "ERROR_MCA_INVALID_VCP_VERSION", "ERROR_MCA_INVALID_VCP_VERSION"},
+	15202: {15202, "ERROR_MCA_MONITOR_VIOLATES_MCCS_SPECIFICATION", "ERROR_MCA_MONITOR_VIOLATES_MCCS_SPECIFICATION"},
+	15203: {15203, "ERROR_MCA_MCCS_VERSION_MISMATCH", "ERROR_MCA_MCCS_VERSION_MISMATCH"},
+	15204: {15204, "ERROR_MCA_UNSUPPORTED_MCCS_VERSION", "ERROR_MCA_UNSUPPORTED_MCCS_VERSION"},
+	15205: {15205, "ERROR_MCA_INTERNAL_ERROR", "ERROR_MCA_INTERNAL_ERROR"},
+	15206: {15206, "ERROR_MCA_INVALID_TECHNOLOGY_TYPE_RETURNED", "ERROR_MCA_INVALID_TECHNOLOGY_TYPE_RETURNED"},
+	15207: {15207, "ERROR_MCA_UNSUPPORTED_COLOR_TEMPERATURE", "ERROR_MCA_UNSUPPORTED_COLOR_TEMPERATURE"},
+	15250: {15250, "ERROR_AMBIGUOUS_SYSTEM_DEVICE", "ERROR_AMBIGUOUS_SYSTEM_DEVICE"},
+	15299: {15299, "ERROR_SYSTEM_DEVICE_NOT_FOUND", "ERROR_SYSTEM_DEVICE_NOT_FOUND"},
+	15300: {15300, "ERROR_HASH_NOT_SUPPORTED", "ERROR_HASH_NOT_SUPPORTED"},
+	15301: {15301, "ERROR_HASH_NOT_PRESENT", "ERROR_HASH_NOT_PRESENT"},
+	15321: {15321, "ERROR_SECONDARY_IC_PROVIDER_NOT_REGISTERED", "ERROR_SECONDARY_IC_PROVIDER_NOT_REGISTERED"},
+	15322: {15322, "ERROR_GPIO_CLIENT_INFORMATION_INVALID", "ERROR_GPIO_CLIENT_INFORMATION_INVALID"},
+	15323: {15323, "ERROR_GPIO_VERSION_NOT_SUPPORTED", "ERROR_GPIO_VERSION_NOT_SUPPORTED"},
+	15324: {15324, "ERROR_GPIO_INVALID_REGISTRATION_PACKET", "ERROR_GPIO_INVALID_REGISTRATION_PACKET"},
+	15325: {15325, "ERROR_GPIO_OPERATION_DENIED", "ERROR_GPIO_OPERATION_DENIED"},
+	15326: {15326, "ERROR_GPIO_INCOMPATIBLE_CONNECT_MODE", "ERROR_GPIO_INCOMPATIBLE_CONNECT_MODE"},
+	15327: {15327, "ERROR_GPIO_INTERRUPT_ALREADY_UNMASKED", "ERROR_GPIO_INTERRUPT_ALREADY_UNMASKED"},
+	15400: {15400, "ERROR_CANNOT_SWITCH_RUNLEVEL", "ERROR_CANNOT_SWITCH_RUNLEVEL"},
+	15401: {15401, "ERROR_INVALID_RUNLEVEL_SETTING", "ERROR_INVALID_RUNLEVEL_SETTING"},
+	15402: {15402, "ERROR_RUNLEVEL_SWITCH_TIMEOUT", "ERROR_RUNLEVEL_SWITCH_TIMEOUT"},
+	15403: {15403, "ERROR_RUNLEVEL_SWITCH_AGENT_TIMEOUT", "ERROR_RUNLEVEL_SWITCH_AGENT_TIMEOUT"},
+	15404: {15404, "ERROR_RUNLEVEL_SWITCH_IN_PROGRESS", "ERROR_RUNLEVEL_SWITCH_IN_PROGRESS"},
+	15405: {15405, "ERROR_SERVICES_FAILED_AUTOSTART", "ERROR_SERVICES_FAILED_AUTOSTART"},
+	15501: {15501, "ERROR_COM_TASK_STOP_PENDING", "ERROR_COM_TASK_STOP_PENDING"},
+	15600: {15600, "ERROR_INSTALL_OPEN_PACKAGE_FAILED", "Package could not be opened."},
+	15601: {15601, "ERROR_INSTALL_PACKAGE_NOT_FOUND", "Package was not found."},
+	15602: {15602, "ERROR_INSTALL_INVALID_PACKAGE", "Package data is invalid."},
+	15603: {15603, "ERROR_INSTALL_RESOLVE_DEPENDENCY_FAILED", "Package failed updates, dependency or conflict validation."},
+	15604: {15604, "ERROR_INSTALL_OUT_OF_DISK_SPACE", "There is not enough disk space on your computer. Please free up some space and try again."},
+	15605: {15605, "ERROR_INSTALL_NETWORK_FAILURE", "There was a problem downloading your product."},
+	15606: {15606, "ERROR_INSTALL_REGISTRATION_FAILURE", "Package could not be registered."},
+	15607: {15607, "ERROR_INSTALL_DEREGISTRATION_FAILURE", "Package could not be unregistered."},
+	15608: {15608, "ERROR_INSTALL_CANCEL", "User cancelled the install request."},
+	15609: {15609, "ERROR_INSTALL_FAILED", "Install failed. Please contact your software vendor."},
+	15610: {15610, "ERROR_REMOVE_FAILED", "Removal failed. Please contact your software vendor."},
+	15611: {15611, "ERROR_PACKAGE_ALREADY_EXISTS", "The provided package is already installed, and reinstallation of the package was blocked. Check the AppXDeployment-Server event log for details."},
+	15612: {15612, "ERROR_NEEDS_REMEDIATION", "The application cannot be started. Try reinstalling the application to fix the problem."},
+	15613: {15613, "ERROR_INSTALL_PREREQUISITE_FAILED", "A Prerequisite for an install could not be satisfied."},
+	15614: {15614, "ERROR_PACKAGE_REPOSITORY_CORRUPTED", "The package repository is corrupted."},
+	15615: {15615, "ERROR_INSTALL_POLICY_FAILURE", "To install this application you need either a Windows developer license or a sideloading-enabled system."},
+	15616: {15616, "ERROR_PACKAGE_UPDATING", "The application cannot be started because it is currently updating."},
+	15617: {15617, "ERROR_DEPLOYMENT_BLOCKED_BY_POLICY", "The package deployment operation is blocked by policy. Please contact your system administrator."},
+	15618: {15618, "ERROR_PACKAGES_IN_USE", "The package could not be installed because resources it modifies are currently in use."},
+	15619: {15619, "ERROR_RECOVERY_FILE_CORRUPT", "ERROR_RECOVERY_FILE_CORRUPT"},
+	15620: {15620, "ERROR_INVALID_STAGED_SIGNATURE", "ERROR_INVALID_STAGED_SIGNATURE"},
+	15621: {15621, "ERROR_DELETING_EXISTING_APPLICATIONDATA_STORE_FAILED", "ERROR_DELETING_EXISTING_APPLICATIONDATA_STORE_FAILED"},
+	15622: {15622, "ERROR_INSTALL_PACKAGE_DOWNGRADE", "A higher version of this package is already installed."},
+	15623: {15623, "ERROR_SYSTEM_NEEDS_REMEDIATION", "ERROR_SYSTEM_NEEDS_REMEDIATION"},
+	15624: {15624, "ERROR_APPX_INTEGRITY_FAILURE_CLR_NGEN", "ERROR_APPX_INTEGRITY_FAILURE_CLR_NGEN"},
+	15625: {15625, "ERROR_RESILIENCY_FILE_CORRUPT", "ERROR_RESILIENCY_FILE_CORRUPT"},
+	15626: {15626, "ERROR_INSTALL_FIREWALL_SERVICE_NOT_RUNNING", "ERROR_INSTALL_FIREWALL_SERVICE_NOT_RUNNING"},
+	15627: {15627, "ERROR_PACKAGE_MOVE_FAILED", "ERROR_PACKAGE_MOVE_FAILED"},
+	15628: {15628, "ERROR_INSTALL_VOLUME_NOT_EMPTY", "ERROR_INSTALL_VOLUME_NOT_EMPTY"},
+	15629: {15629, "ERROR_INSTALL_VOLUME_OFFLINE", "ERROR_INSTALL_VOLUME_OFFLINE"},
+	15630: {15630, "ERROR_INSTALL_VOLUME_CORRUPT", "ERROR_INSTALL_VOLUME_CORRUPT"},
+	15631: {15631, "ERROR_NEEDS_REGISTRATION", "ERROR_NEEDS_REGISTRATION"},
+	15632: {15632, "ERROR_INSTALL_WRONG_PROCESSOR_ARCHITECTURE", "The package could not be installed because it is not supported by the computer's processor architecture."},
+	15633: {15633, "ERROR_DEV_SIDELOAD_LIMIT_EXCEEDED", "ERROR_DEV_SIDELOAD_LIMIT_EXCEEDED"},
+	15634: {15634, "ERROR_INSTALL_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE", "ERROR_INSTALL_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE"},
+	15635: {15635, "ERROR_PACKAGE_NOT_SUPPORTED_ON_FILESYSTEM", "ERROR_PACKAGE_NOT_SUPPORTED_ON_FILESYSTEM"},
+	15636: {15636, "ERROR_PACKAGE_MOVE_BLOCKED_BY_STREAMING", "ERROR_PACKAGE_MOVE_BLOCKED_BY_STREAMING"},
+	15637: {15637, "ERROR_INSTALL_OPTIONAL_PACKAGE_APPLICATIONID_NOT_UNIQUE", "ERROR_INSTALL_OPTIONAL_PACKAGE_APPLICATIONID_NOT_UNIQUE"},
+	15638: {15638, "ERROR_PACKAGE_STAGING_ONHOLD", "ERROR_PACKAGE_STAGING_ONHOLD"},
+	15639: {15639, "ERROR_INSTALL_INVALID_RELATED_SET_UPDATE", "ERROR_INSTALL_INVALID_RELATED_SET_UPDATE"},
+	15640: {15640, "ERROR_INSTALL_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE_FULLTRUST_CAPABILITY", "ERROR_INSTALL_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE_FULLTRUST_CAPABILITY"},
+	15641: {15641, "ERROR_DEPLOYMENT_BLOCKED_BY_USER_LOG_OFF", "ERROR_DEPLOYMENT_BLOCKED_BY_USER_LOG_OFF"},
+	15642: {15642, "ERROR_PROVISION_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE_PROVISIONED", "ERROR_PROVISION_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE_PROVISIONED"},
+	15643: {15643, "ERROR_PACKAGES_REPUTATION_CHECK_FAILED", "ERROR_PACKAGES_REPUTATION_CHECK_FAILED"},
+	15644: {15644, "ERROR_PACKAGES_REPUTATION_CHECK_TIMEDOUT", "ERROR_PACKAGES_REPUTATION_CHECK_TIMEDOUT"},
+	15645: {15645, "ERROR_DEPLOYMENT_OPTION_NOT_SUPPORTED", "ERROR_DEPLOYMENT_OPTION_NOT_SUPPORTED"},
+	15646: {15646, "ERROR_APPINSTALLER_ACTIVATION_BLOCKED", "ERROR_APPINSTALLER_ACTIVATION_BLOCKED"},
+	15647: {15647, "ERROR_REGISTRATION_FROM_REMOTE_DRIVE_NOT_SUPPORTED", "ERROR_REGISTRATION_FROM_REMOTE_DRIVE_NOT_SUPPORTED"},
+	15648: {15648, "ERROR_APPX_RAW_DATA_WRITE_FAILED", "ERROR_APPX_RAW_DATA_WRITE_FAILED"},
+	15649: {15649, "ERROR_DEPLOYMENT_BLOCKED_BY_VOLUME_POLICY_PACKAGE", "ERROR_DEPLOYMENT_BLOCKED_BY_VOLUME_POLICY_PACKAGE"},
+	15650: {15650, "ERROR_DEPLOYMENT_BLOCKED_BY_VOLUME_POLICY_MACHINE", "ERROR_DEPLOYMENT_BLOCKED_BY_VOLUME_POLICY_MACHINE"},
+	15651: {15651, "ERROR_DEPLOYMENT_BLOCKED_BY_PROFILE_POLICY", "ERROR_DEPLOYMENT_BLOCKED_BY_PROFILE_POLICY"},
+	15652: {15652, "ERROR_DEPLOYMENT_FAILED_CONFLICTING_MUTABLE_PACKAGE_DIRECTORY", "ERROR_DEPLOYMENT_FAILED_CONFLICTING_MUTABLE_PACKAGE_DIRECTORY"},
+	15653: {15653, "ERROR_SINGLETON_RESOURCE_INSTALLED_IN_ACTIVE_USER", "ERROR_SINGLETON_RESOURCE_INSTALLED_IN_ACTIVE_USER"},
+	15654: {15654, "ERROR_DIFFERENT_VERSION_OF_PACKAGED_SERVICE_INSTALLED", "ERROR_DIFFERENT_VERSION_OF_PACKAGED_SERVICE_INSTALLED"},
+	15655: {15655, "ERROR_SERVICE_EXISTS_AS_NON_PACKAGED_SERVICE", "ERROR_SERVICE_EXISTS_AS_NON_PACKAGED_SERVICE"},
+	15656: {15656, "ERROR_PACKAGED_SERVICE_REQUIRES_ADMIN_PRIVILEGES", "ERROR_PACKAGED_SERVICE_REQUIRES_ADMIN_PRIVILEGES"},
+	15800: {15800, "ERROR_STATE_LOAD_STORE_FAILED", "ERROR_STATE_LOAD_STORE_FAILED"},
+	15801: {15801, "ERROR_STATE_GET_VERSION_FAILED", "ERROR_STATE_GET_VERSION_FAILED"},
+	15802: {15802, "ERROR_STATE_SET_VERSION_FAILED", "ERROR_STATE_SET_VERSION_FAILED"},
+	15803: {15803, "ERROR_STATE_STRUCTURED_RESET_FAILED", "ERROR_STATE_STRUCTURED_RESET_FAILED"},
+	15804: {15804, "ERROR_STATE_OPEN_CONTAINER_FAILED", "ERROR_STATE_OPEN_CONTAINER_FAILED"},
+	15805: {15805, "ERROR_STATE_CREATE_CONTAINER_FAILED", "ERROR_STATE_CREATE_CONTAINER_FAILED"},
+	15806: {15806, "ERROR_STATE_DELETE_CONTAINER_FAILED", "ERROR_STATE_DELETE_CONTAINER_FAILED"},
+	15807: {15807, "ERROR_STATE_READ_SETTING_FAILED", "ERROR_STATE_READ_SETTING_FAILED"},
+	15808: {15808, "ERROR_STATE_WRITE_SETTING_FAILED", "ERROR_STATE_WRITE_SETTING_FAILED"},
+	15809: {15809, "ERROR_STATE_DELETE_SETTING_FAILED", "ERROR_STATE_DELETE_SETTING_FAILED"},
+	15810: {15810, "ERROR_STATE_QUERY_SETTING_FAILED", "ERROR_STATE_QUERY_SETTING_FAILED"},
+	15811: {15811, "ERROR_STATE_READ_COMPOSITE_SETTING_FAILED", "ERROR_STATE_READ_COMPOSITE_SETTING_FAILED"},
+	15812: {15812, "ERROR_STATE_WRITE_COMPOSITE_SETTING_FAILED", "ERROR_STATE_WRITE_COMPOSITE_SETTING_FAILED"},
+	15813: {15813, "ERROR_STATE_ENUMERATE_CONTAINER_FAILED", "ERROR_STATE_ENUMERATE_CONTAINER_FAILED"},
+	15814: {15814, "ERROR_STATE_ENUMERATE_SETTINGS_FAILED", "ERROR_STATE_ENUMERATE_SETTINGS_FAILED"},
+	15815: {15815, "ERROR_STATE_COMPOSITE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED", "ERROR_STATE_COMPOSITE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED"},
+	15816: {15816, "ERROR_STATE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED", "ERROR_STATE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED"},
+	15817: {15817, "ERROR_STATE_SETTING_NAME_SIZE_LIMIT_EXCEEDED", "ERROR_STATE_SETTING_NAME_SIZE_LIMIT_EXCEEDED"},
+	15818: {15818, "ERROR_STATE_CONTAINER_NAME_SIZE_LIMIT_EXCEEDED", "ERROR_STATE_CONTAINER_NAME_SIZE_LIMIT_EXCEEDED"},
+	15841: {15841, "ERROR_API_UNAVAILABLE", "ERROR_API_UNAVAILABLE"},
+}
@@ -0,0 +1,2575 @@
+// Code generated by mkcodes from testdata/ntstatus.h; DO NOT EDIT.
+
+package exitcodes
+
+// NTStatusCodeMap contains every NT status code
+var NTStatusCodeMap = map[uint32]NTStatusCode{
+	0x00000000: {0x00000000, "STATUS_SUCCESS", "The operation completed successfully."},
+	0x00000001: {0x00000001, "STATUS_WAIT_1", "The caller specified WaitAny for WaitType and one of the dispatcher objects in the Object array has been set to the signaled state."},
+	0x00000002: {0x00000002, "STATUS_WAIT_2", "The caller specified WaitAny for WaitType and one of the dispatcher objects in the Object array has been set to the signaled state."},
+	0x00000003: {0x00000003, "STATUS_WAIT_3", "The caller specified WaitAny for WaitType and one of the dispatcher objects in the Object array has been set to the signaled state."},
+	0x0000003F: {0x0000003F, "STATUS_WAIT_63", "The caller specified WaitAny for WaitType and one of the dispatcher objects in the Object array has been set to the signaled state."},
+	0x00000080: {0x00000080, "STATUS_ABANDONED", "The caller attempted to wait for a mutex that has been abandoned."},
+	0x000000BF: {0x000000BF, "STATUS_ABANDONED_WAIT_63", "The caller attempted to wait for a mutex that has been abandoned."},
+	0x000000C0: {0x000000C0, "STATUS_USER_APC", "A user-mode APC was delivered before the given Interval expired."},
+	0x000000FF: {0x000000FF, "STATUS_ALREADY_COMPLETE", "STATUS_ALREADY_COMPLETE"},
+	0x00000100: {0x00000100, "STATUS_KERNEL_APC", "The delay completed because the thread was alerted."},
+	0x00000101: {0x00000101, "STATUS_ALERTED", "The delay completed because the thread was alerted."},
+	0x00000102: {0x00000102, "STATUS_TIMEOUT", "The given Timeout interval expired."},
+	0x00000103: {0x00000103, "STATUS_PENDING", "The operation that was requested is pending completion."},
+	0x00000104: {0x00000104, "STATUS_REPARSE", "A reparse should be performed by the Object Manager because the name of the file resulted in a symbolic link."},
+	0x00000105: {0x00000105, "STATUS_MORE_ENTRIES", "Returned by enumeration APIs to indicate more information is available to successive calls."},
+	0x00000106: {0x00000106, "STATUS_NOT_ALL_ASSIGNED", "Indicates not all privileges or groups that are referenced are assigned to the caller. This allows, for example, all privileges to be disabled without having to know exactly which privileges are assigned."},
+	0x00000107: {0x00000107, "STATUS_SOME_NOT_MAPPED", "Some of the information to be translated has not been translated."},
+	0x00000108: {0x00000108, "STATUS_OPLOCK_BREAK_IN_PROGRESS", "An open/create operation completed while an opportunistic lock (oplock) break is underway."},
+	0x00000109: {0x00000109, "STATUS_VOLUME_MOUNTED", "A new volume has been mounted by a file system."},
+	0x0000010A: {0x0000010A, "STATUS_RXACT_COMMITTED", "This success level status indicates that the transaction state already exists for the registry subtree but that a transaction commit was previously aborted. The commit has now been completed."},
+	0x0000010B: {0x0000010B, "STATUS_NOTIFY_CLEANUP", "Indicates that a notify change request has been completed due to closing the handle that made the notify change request."},
+	0x0000010C: {0x0000010C, "STATUS_NOTIFY_ENUM_DIR", "Indicates that a notify change request is being completed and that the information is not being returned in the caller's buffer. The caller now needs to enumerate the files to find the changes."},
+	0x0000010D: {0x0000010D, "STATUS_NO_QUOTAS_FOR_ACCOUNT", "{No Quotas} No system quota limits are specifically set for this account."},
+	0x0000010E: {0x0000010E, "STATUS_PRIMARY_TRANSPORT_CONNECT_FAILED", "{Connect Failure on Primary Transport} An attempt was made to connect to the remote server %hs on the primary transport, but the connection failed. The computer WAS able to connect on a secondary transport."},
+	0x00000110: {0x00000110, "STATUS_PAGE_FAULT_TRANSITION", "The page fault was a transition fault."},
+	0x00000111: {0x00000111, "STATUS_PAGE_FAULT_DEMAND_ZERO", "The page fault was a demand zero fault."},
+	0x00000112: {0x00000112, "STATUS_PAGE_FAULT_COPY_ON_WRITE", "The page fault was a demand zero fault."},
+	0x00000113: {0x00000113, "STATUS_PAGE_FAULT_GUARD_PAGE", "The page fault was a demand zero fault."},
+	0x00000114: {0x00000114, "STATUS_PAGE_FAULT_PAGING_FILE", "The page fault was satisfied by reading from a secondary storage device."},
+	0x00000115: {0x00000115, "STATUS_CACHE_PAGE_LOCKED", "The cached page was locked during operation."},
+	0x00000116: {0x00000116, "STATUS_CRASH_DUMP", "The crash dump exists in a paging file."},
+	0x00000117: {0x00000117, "STATUS_BUFFER_ALL_ZEROS", "The specified buffer contains all zeros."},
+	0x00000118: {0x00000118, "STATUS_REPARSE_OBJECT", "A reparse should be performed by the Object Manager because the name of the file resulted in a symbolic link."},
+	0x00000119: {0x00000119, "STATUS_RESOURCE_REQUIREMENTS_CHANGED", "The device has succeeded a query-stop and its resource requirements have changed."},
+	0x00000120: {0x00000120, "STATUS_TRANSLATION_COMPLETE", "The translator has translated these resources into the global space and no additional translations should be performed."},
+	0x00000121: {0x00000121, "STATUS_DS_MEMBERSHIP_EVALUATED_LOCALLY", "The directory service evaluated group memberships locally, because it was unable to contact a global catalog server."},
+	0x00000122: {0x00000122, "STATUS_NOTHING_TO_TERMINATE", "A process being terminated has no threads to terminate."},
+	0x00000123: {0x00000123, "STATUS_PROCESS_NOT_IN_JOB", "The specified process is not part of a job."},
+	0x00000124: {0x00000124, "STATUS_PROCESS_IN_JOB", "The specified process is part of a job."},
+	0x00000125: {0x00000125, "STATUS_VOLSNAP_HIBERNATE_READY", "{Volume Shadow Copy Service} The system is now ready for hibernation."},
+	0x00000126: {0x00000126, "STATUS_FSFILTER_OP_COMPLETED_SUCCESSFULLY", "A file system or file system filter driver has successfully completed an FsFilter operation."},
+	0x00000127: {0x00000127, "STATUS_INTERRUPT_VECTOR_ALREADY_CONNECTED", "The specified interrupt vector was already connected."},
+	0x00000128: {0x00000128, "STATUS_INTERRUPT_STILL_CONNECTED", "The specified interrupt vector is still connected."},
+	0x00000129: {0x00000129, "STATUS_PROCESS_CLONED", "The current process is a cloned process."},
+	0x0000012A: {0x0000012A, "STATUS_FILE_LOCKED_WITH_ONLY_READERS", "The file was locked and all users of the file can only read."},
+	0x0000012B: {0x0000012B, "STATUS_FILE_LOCKED_WITH_WRITERS", "The file was locked and at least one user of the file can write."},
+	0x0000012C: {0x0000012C, "STATUS_VALID_IMAGE_HASH", "STATUS_VALID_IMAGE_HASH"},
+	0x0000012D: {0x0000012D, "STATUS_VALID_CATALOG_HASH", "STATUS_VALID_CATALOG_HASH"},
+	0x0000012E: {0x0000012E, "STATUS_VALID_STRONG_CODE_HASH", "STATUS_VALID_STRONG_CODE_HASH"},
+	0x0000012F: {0x0000012F, "STATUS_GHOSTED", "STATUS_GHOSTED"},
+	0x00000130: {0x00000130, "STATUS_DATA_OVERWRITTEN", "STATUS_DATA_OVERWRITTEN"},
+	0x00000202: {0x00000202, "STATUS_RESOURCEMANAGER_READ_ONLY", "STATUS_RESOURCEMANAGER_READ_ONLY"},
+	0x00000210: {0x00000210, "STATUS_RING_PREVIOUSLY_EMPTY", "STATUS_RING_PREVIOUSLY_EMPTY"},
+	0x00000211: {0x00000211, "STATUS_RING_PREVIOUSLY_FULL", "STATUS_RING_PREVIOUSLY_FULL"},
+	0x00000212: {0x00000212, "STATUS_RING_PREVIOUSLY_ABOVE_QUOTA", "STATUS_RING_PREVIOUSLY_ABOVE_QUOTA"},
+	0x00000213: {0x00000213, "STATUS_RING_NEWLY_EMPTY", "STATUS_RING_NEWLY_EMPTY"},
+	0x00000214: {0x00000214, "STATUS_RING_SIGNAL_OPPOSITE_ENDPOINT", "STATUS_RING_SIGNAL_OPPOSITE_ENDPOINT"},
+	0x00000215: {0x00000215, "STATUS_OPLOCK_SWITCHED_TO_NEW_HANDLE", "STATUS_OPLOCK_SWITCHED_TO_NEW_HANDLE"},
+	0x00000216: {0x00000216, "STATUS_OPLOCK_HANDLE_CLOSED", "STATUS_OPLOCK_HANDLE_CLOSED"},
+	0x00000367: {0x00000367, "STATUS_WAIT_FOR_OPLOCK", "The operation is blocked waiting for an oplock."},
+	0x00000368: {0x00000368, "STATUS_REPARSE_GLOBAL", "STATUS_REPARSE_GLOBAL"},
+	0x001C0001: {0x001C0001, "STATUS_FLT_IO_COMPLETE", "STATUS_FLT_IO_COMPLETE"},
+	0x40000000: {0x40000000, "STATUS_OBJECT_NAME_EXISTS", "{Object Exists} An attempt was made to create an object but the object name already exists."},
+	0x40000001: {0x40000001, "STATUS_THREAD_WAS_SUSPENDED", "{Thread Suspended} A thread termination occurred while the thread was suspended. The thread resumed, and termination proceeded."},
+	0x40000002: {0x40000002, "STATUS_WORKING_SET_LIMIT_RANGE", "{Working Set Range Error} An attempt was made to set the working set minimum or maximum to values that are outside the allowable range."},
+	0x40000003: {0x40000003, "STATUS_IMAGE_NOT_AT_BASE", "{Image Relocated} An image file could not be mapped at the address that is specified in the image file. Local fixes must be performed on this image."},
+	0x40000004: {0x40000004, "STATUS_RXACT_STATE_CREATED", "This informational level status indicates that a specified registry subtree transaction state did not yet exist and had to be created."},
+	0x40000005: {0x40000005, "STATUS_SEGMENT_NOTIFICATION", "STATUS_SEGMENT_NOTIFICATION"},
+	0x40000006: {0x40000006, "STATUS_LOCAL_USER_SESSION_KEY", "{Local Session Key} A user session key was requested for a local remote procedure call (RPC) connection. The session key that is returned is a constant value and not unique to this connection."},
+	0x40000007: {0x40000007, "STATUS_BAD_CURRENT_DIRECTORY", "STATUS_BAD_CURRENT_DIRECTORY"},
+	0x40000008: {0x40000008, "STATUS_SERIAL_MORE_WRITES", "{Invalid Current Directory} The process cannot switch to the startup current directory %hs. Select OK to set the current directory to %hs, or select CANCEL to exit."},
+	0x40000009: {0x40000009, "STATUS_REGISTRY_RECOVERED", "STATUS_REGISTRY_RECOVERED"},
+	0x4000000A: {0x4000000A, "STATUS_FT_READ_RECOVERY_FROM_BACKUP", "STATUS_FT_READ_RECOVERY_FROM_BACKUP"},
+	0x4000000B: {0x4000000B, "STATUS_FT_WRITE_RECOVERY", "STATUS_FT_WRITE_RECOVERY"},
+	0x4000000C: {0x4000000C, "STATUS_SERIAL_COUNTER_TIMEOUT", "STATUS_SERIAL_COUNTER_TIMEOUT"},
+	0x4000000D: {0x4000000D, "STATUS_NULL_LM_PASSWORD", "{Page Unlocked} The page protection of a locked page was changed to 'No Access' and the page was unlocked from memory and from the process."},
+	0x4000000E: {0x4000000E, "STATUS_IMAGE_MACHINE_TYPE_MISMATCH", "STATUS_IMAGE_MACHINE_TYPE_MISMATCH"},
+	0x4000000F: {0x4000000F, "STATUS_RECEIVE_PARTIAL", "{Page Locked} One of the pages to lock was already locked."},
+	0x40000010: {0x40000010, "STATUS_RECEIVE_EXPEDITED", "STATUS_RECEIVE_EXPEDITED"},
+	0x40000011: {0x40000011, "STATUS_RECEIVE_PARTIAL_EXPEDITED", "STATUS_RECEIVE_PARTIAL_EXPEDITED"},
+	0x40000012: {0x40000012, "STATUS_EVENT_DONE", "{Kernel Debugger Awakened} The system debugger was awakened by an interrupt."},
+	0x40000013: {0x40000013, "STATUS_EVENT_PENDING", "STATUS_EVENT_PENDING"},
+	0x40000014: {0x40000014, "STATUS_CHECKING_FILE_SYSTEM", "STATUS_CHECKING_FILE_SYSTEM"},
+	0x40000015: {0x40000015, "STATUS_FATAL_APP_EXIT", "STATUS_FATAL_APP_EXIT"},
+	0x40000016: {0x40000016, "STATUS_PREDEFINED_HANDLE", "A yield execution was performed and no thread was available to run."},
+	0x40000017: {0x40000017, "STATUS_WAS_UNLOCKED", "STATUS_WAS_UNLOCKED"},
+	0x40000018: {0x40000018, "STATUS_SERVICE_NOTIFICATION", "STATUS_SERVICE_NOTIFICATION"},
+	0x40000019: {0x40000019, "STATUS_WAS_LOCKED", "STATUS_WAS_LOCKED"},
+	0x4000001A: {0x4000001A, "STATUS_LOG_HARD_ERROR", "STATUS_LOG_HARD_ERROR"},
+	0x4000001B: {0x4000001B, "STATUS_ALREADY_WIN32", "STATUS_ALREADY_WIN32"},
+	0x4000001C: {0x4000001C, "STATUS_WX86_UNSIMULATE", "STATUS_WX86_UNSIMULATE"},
+	0x4000001D: {0x4000001D, "STATUS_WX86_CONTINUE", "STATUS_WX86_CONTINUE"},
+	0x4000001E: {0x4000001E, "STATUS_WX86_SINGLE_STEP", "The system was put into hibernation."},
+	0x4000001F: {0x4000001F, "STATUS_WX86_BREAKPOINT", "STATUS_WX86_BREAKPOINT"},
+	0x40000020: {0x40000020, "STATUS_WX86_EXCEPTION_CONTINUE", "STATUS_WX86_EXCEPTION_CONTINUE"},
+	0x40000021: {0x40000021, "STATUS_WX86_EXCEPTION_LASTCHANCE", "STATUS_WX86_EXCEPTION_LASTCHANCE"},
+	0x40000022: {0x40000022, "STATUS_WX86_EXCEPTION_CHAIN", "STATUS_WX86_EXCEPTION_CHAIN"},
+	0x40000023: {0x40000023, "STATUS_IMAGE_MACHINE_TYPE_MISMATCH_EXE", "STATUS_IMAGE_MACHINE_TYPE_MISMATCH_EXE"},
+	0x40000024: {0x40000024, "STATUS_NO_YIELD_PERFORMED", "An operation is blocked and waiting for an oplock."},
+	0x40000025: {0x40000025, "STATUS_TIMER_RESUME_IGNORED", "STATUS_TIMER_RESUME_IGNORED"},
+	0x40000026: {0x40000026, "STATUS_ARBITRATION_UNHANDLED", "STATUS_ARBITRATION_UNHANDLED"},
+	0x40000027: {0x40000027, "STATUS_CARDBUS_NOT_SUPPORTED", "STATUS_CARDBUS_NOT_SUPPORTED"},
+	0x40000028: {0x40000028, "STATUS_WX86_CREATEWX86TIB", "STATUS_WX86_CREATEWX86TIB"},
+	0x40000029: {0x40000029, "STATUS_MP_PROCESSOR_MISMATCH", "STATUS_MP_PROCESSOR_MISMATCH"},
+	0x4000002A: {0x4000002A, "STATUS_HIBERNATED", "STATUS_HIBERNATED"},
+	0x4000002B: {0x4000002B, "STATUS_RESUME_HIBERNATION", "STATUS_RESUME_HIBERNATION"},
+	0x4000002C: {0x4000002C, "STATUS_FIRMWARE_UPDATED", "STATUS_FIRMWARE_UPDATED"},
+	0x4000002D: {0x4000002D, "STATUS_DRIVERS_LEAKING_LOCKED_PAGES", "STATUS_DRIVERS_LEAKING_LOCKED_PAGES"},
+	0x4000002E: {0x4000002E, "STATUS_MESSAGE_RETRIEVED", "STATUS_MESSAGE_RETRIEVED"},
+	0x4000002F: {0x4000002F, "STATUS_SYSTEM_POWERSTATE_TRANSITION", "STATUS_SYSTEM_POWERSTATE_TRANSITION"},
+	0x40000030: {0x40000030, "STATUS_ALPC_CHECK_COMPLETION_LIST", "STATUS_ALPC_CHECK_COMPLETION_LIST"},
+	0x40000031: {0x40000031, "STATUS_SYSTEM_POWERSTATE_COMPLEX_TRANSITION", "STATUS_SYSTEM_POWERSTATE_COMPLEX_TRANSITION"},
+	0x40000032: {0x40000032, "STATUS_ACCESS_AUDIT_BY_POLICY", "STATUS_ACCESS_AUDIT_BY_POLICY"},
+	0x40000033: {0x40000033, "STATUS_ABANDON_HIBERFILE", "STATUS_ABANDON_HIBERFILE"},
+	0x40000034: {0x40000034, "STATUS_BIZRULES_NOT_ENABLED", "STATUS_BIZRULES_NOT_ENABLED"},
+	0x40000035: {0x40000035, "STATUS_FT_READ_FROM_COPY", "STATUS_FT_READ_FROM_COPY"},
+	0x40000036: {0x40000036, "STATUS_IMAGE_AT_DIFFERENT_BASE", "STATUS_IMAGE_AT_DIFFERENT_BASE"},
+	0x40000037: {0x40000037, "STATUS_PATCH_DEFERRED", "STATUS_PATCH_DEFERRED"},
+	0x40190001: {0x40190001, "STATUS_HEURISTIC_DAMAGE_POSSIBLE", "STATUS_HEURISTIC_DAMAGE_POSSIBLE"},
+	0x80000001: {0x80000001, "STATUS_GUARD_PAGE_VIOLATION", "{EXCEPTION} Guard Page Exception A page of memory that marks the end of a data structure, such as a stack or an array, has been accessed."},
+	0x80000002: {0x80000002, "STATUS_DATATYPE_MISALIGNMENT", "{EXCEPTION} Alignment Fault A data type misalignment was detected in a load or store instruction."},
+	0x80000003: {0x80000003, "STATUS_BREAKPOINT", "{EXCEPTION} Breakpoint A breakpoint has been reached."},
+	0x80000004: {0x80000004, "STATUS_SINGLE_STEP", "{EXCEPTION} Single Step A single step or trace operation has just been completed."},
+	0x80000005: {0x80000005, "STATUS_BUFFER_OVERFLOW", "{Buffer Overflow} The data was too large to fit into the specified buffer."},
+	0x80000006: {0x80000006, "STATUS_NO_MORE_FILES", "{No More Files} No more files were found which match the file specification."},
+	0x80000007: {0x80000007, "STATUS_WAKE_SYSTEM_DEBUGGER", "{Kernel Debugger Awakened} The system debugger was awakened by an interrupt."},
+	0x8000000A: {0x8000000A, "STATUS_HANDLES_CLOSED", "{Handles Closed} Handles to objects have been automatically closed because of the requested operation."},
+	0x8000000B: {0x8000000B, "STATUS_NO_INHERITANCE", "{Non-Inheritable ACL} An access control list (ACL) contains no components that can be inherited."},
+	0x8000000C: {0x8000000C, "STATUS_GUID_SUBSTITUTION_MADE", "{GUID Substitution} During the translation of a globally unique identifier (GUID) to a Windows security ID (SID), no administratively defined GUID prefix was found. A substitute prefix was used, which will not compromise system security. However, this might provide a more restrictive access than intended."},
+	0x8000000D: {0x8000000D, "STATUS_PARTIAL_COPY", "Because of protection conflicts, not all the requested bytes could be copied."},
+	0x8000000E: {0x8000000E, "STATUS_DEVICE_PAPER_EMPTY", "{Out of Paper} The printer is out of paper."},
+	0x8000000F: {0x8000000F, "STATUS_DEVICE_POWERED_OFF", "{Device Power Is Off} The printer power has been turned off."},
+	0x80000010: {0x80000010, "STATUS_DEVICE_OFF_LINE", "{Device Offline} The printer has been taken offline."},
+	0x80000011: {0x80000011, "STATUS_DEVICE_BUSY", "{Device Busy} The device is currently busy."},
+	0x80000012: {0x80000012, "STATUS_NO_MORE_EAS", "{No More EAs} No more extended attributes (EAs) were found for the file."},
+	0x80000013: {0x80000013, "STATUS_INVALID_EA_NAME", "{Illegal EA} The specified extended attribute (EA) name contains at least one illegal character."},
+	0x80000014: {0x80000014, "STATUS_EA_LIST_INCONSISTENT", "{Inconsistent EA List} The extended attribute (EA) list is inconsistent."},
+	0x80000015: {0x80000015, "STATUS_INVALID_EA_FLAG", "{Invalid EA Flag} An invalid extended attribute (EA) flag was set."},
+	0x80000016: {0x80000016, "STATUS_VERIFY_REQUIRED", "{Verifying Disk} The media has changed and a verify operation is in progress; therefore, no reads or writes can be performed to the device, except those that are used in the verify operation."},
+	0x80000017: {0x80000017, "STATUS_EXTRANEOUS_INFORMATION", "{Too Much Information} The specified access control list (ACL) contained more information than was expected."},
+	0x80000018: {0x80000018, "STATUS_RXACT_COMMIT_NECESSARY", "This warning level status indicates that the transaction state already exists for the registry subtree, but that a transaction commit was previously aborted. The commit has NOT been completed but has not been rolled back either; therefore, it can still be committed, if needed."},
+	0x8000001A: {0x8000001A, "STATUS_NO_MORE_ENTRIES", "{No More Entries} No more entries are available from an enumeration operation."},
+	0x8000001B: {0x8000001B, "STATUS_FILEMARK_DETECTED", "{Filemark Found} A filemark was detected."},
+	0x8000001C: {0x8000001C, "STATUS_MEDIA_CHANGED", "{Media Changed} The media has changed."},
+	0x8000001D: {0x8000001D, "STATUS_BUS_RESET", "{I/O Bus Reset} An I/O bus reset was detected."},
+	0x8000001E: {0x8000001E, "STATUS_END_OF_MEDIA", "{End of Media} The end of the media was encountered."},
+	0x8000001F: {0x8000001F, "STATUS_BEGINNING_OF_MEDIA", "The beginning of a tape or partition has been detected."},
+	0x80000020: {0x80000020, "STATUS_MEDIA_CHECK", "{Media Changed} The media might have changed."},
+	0x80000021: {0x80000021, "STATUS_SETMARK_DETECTED", "A tape access reached a set mark."},
+	0x80000022: {0x80000022, "STATUS_NO_DATA_DETECTED", "During a tape access, the end of the data written is reached."},
+	0x80000023: {0x80000023, "STATUS_REDIRECTOR_HAS_OPEN_HANDLES", "The redirector is in use and cannot be unloaded."},
+	0x80000024: {0x80000024, "STATUS_SERVER_HAS_OPEN_HANDLES", "The server is in use and cannot be unloaded."},
+	0x80000025: {0x80000025, "STATUS_ALREADY_DISCONNECTED", "The specified connection has already been disconnected."},
+	0x80000026: {0x80000026, "STATUS_LONGJUMP", "A long jump has been executed."},
+	0x80000027: {0x80000027, "STATUS_CLEANER_CARTRIDGE_INSTALLED", "A cleaner cartridge is present in the tape library."},
+	0x80000028: {0x80000028, "STATUS_PLUGPLAY_QUERY_VETOED", "The Plug and Play query operation was not successful."},
+	0x80000029: {0x80000029, "STATUS_UNWIND_CONSOLIDATE", "A frame consolidation has been executed."},
+	0x8000002A: {0x8000002A, "STATUS_REGISTRY_HIVE_RECOVERED", "{Registry Hive Recovered} The registry hive (file): %hs was corrupted and it has been recovered. Some data might have been lost."},
+	0x8000002B: {0x8000002B, "STATUS_DLL_MIGHT_BE_INSECURE", "STATUS_DLL_MIGHT_BE_INSECURE"},
+	0x8000002C: {0x8000002C, "STATUS_DLL_MIGHT_BE_INCOMPATIBLE", "STATUS_DLL_MIGHT_BE_INCOMPATIBLE"},
+	0x8000002D: {0x8000002D, "STATUS_STOPPED_ON_SYMLINK", "The stopped-on-symbolic-link operation was executed."},
+	0x8000002E: {0x8000002E, "STATUS_CANNOT_GRANT_REQUESTED_OPLOCK", "STATUS_CANNOT_GRANT_REQUESTED_OPLOCK"},
+	0x8000002F: {0x8000002F, "STATUS_NO_ACE_CONDITION", "STATUS_NO_ACE_CONDITION"},
+	0x80000030: {0x80000030, "STATUS_DEVICE_SUPPORT_IN_PROGRESS", "STATUS_DEVICE_SUPPORT_IN_PROGRESS"},
+	0x80000031: {0x80000031, "STATUS_DEVICE_POWER_CYCLE_REQUIRED", "STATUS_DEVICE_POWER_CYCLE_REQUIRED"},
+	0x80000032: {0x80000032, "STATUS_NO_WORK_DONE", "STATUS_NO_WORK_DONE"},
+	0x80130001: {0x80130001, "STATUS_CLUSTER_NODE_ALREADY_UP", "STATUS_CLUSTER_NODE_ALREADY_UP"},
+	0x80130002: {0x80130002, "STATUS_CLUSTER_NODE_ALREADY_DOWN", "STATUS_CLUSTER_NODE_ALREADY_DOWN"},
+	0x80130003: {0x80130003, "STATUS_CLUSTER_NETWORK_ALREADY_ONLINE", "STATUS_CLUSTER_NETWORK_ALREADY_ONLINE"},
+	0x80130004: {0x80130004, "STATUS_CLUSTER_NETWORK_ALREADY_OFFLINE", "STATUS_CLUSTER_NETWORK_ALREADY_OFFLINE"},
+	0x80130005: {0x80130005, "STATUS_CLUSTER_NODE_ALREADY_MEMBER", "STATUS_CLUSTER_NODE_ALREADY_MEMBER"},
+	0x801C0001: {0x801C0001, "STATUS_FLT_BUFFER_TOO_SMALL", "STATUS_FLT_BUFFER_TOO_SMALL"},
+	0x80210001: {0x80210001, "STATUS_FVE_PARTIAL_METADATA", "STATUS_FVE_PARTIAL_METADATA"},
+	0x80210002: {0x80210002, "STATUS_FVE_TRANSIENT_STATE", "STATUS_FVE_TRANSIENT_STATE"},
+	0x8000CF00: {0x8000CF00, "STATUS_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH", "STATUS_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH"},
+	0xC0000001: {0xC0000001, "STATUS_UNSUCCESSFUL", "{Operation Failed} The requested operation was unsuccessful."},
+	0xC0000002: {0xC0000002, "STATUS_NOT_IMPLEMENTED", "{Not Implemented} The requested operation is not implemented."},
+	0xC0000003: {0xC0000003, "STATUS_INVALID_INFO_CLASS", "{Invalid Parameter} The specified information class is not a valid information class for the specified object."},
+	0xC0000004: {0xC0000004, "STATUS_INFO_LENGTH_MISMATCH", "The specified information record length does not match the length that is required for the specified information class."},
+	0xC0000005: {0xC0000005, "STATUS_ACCESS_VIOLATION", "The instruction at 0x%08lx referenced memory at 0x%08lx. The memory could not be %s."},
+	0xC0000006: {0xC0000006, "STATUS_IN_PAGE_ERROR", "The instruction at 0x%08lx referenced memory at 0x%08lx. The required data was not placed into memory because of an I/O error status of 0x%08lx."},
+	0xC0000007: {0xC0000007, "STATUS_PAGEFILE_QUOTA", "The page file quota for the process has been exhausted."},
+	0xC0000008: {0xC0000008, "STATUS_INVALID_HANDLE", "An invalid HANDLE was specified."},
+	0xC0000009: {0xC0000009, "STATUS_BAD_INITIAL_STACK", "An invalid initial stack was specified in a call to NtCreateThread."},
+	0xC000000A: {0xC000000A, "STATUS_BAD_INITIAL_PC", "An invalid initial start address was specified in a call to NtCreateThread."},
+	0xC000000B: {0xC000000B, "STATUS_INVALID_CID", "An invalid client ID was specified."},
+	0xC000000C: {0xC000000C, "STATUS_TIMER_NOT_CANCELED", "An attempt was made to cancel or set a timer that has an associated APC and the specified thread is not the thread that originally set the timer with an associated APC routine."},
+	0xC000000D: {0xC000000D, "STATUS_INVALID_PARAMETER", "An invalid parameter was passed to a service or function."},
+	0xC000000E: {0xC000000E, "STATUS_NO_SUCH_DEVICE", "A device that does not exist was specified."},
+	0xC000000F: {0xC000000F, "STATUS_NO_SUCH_FILE", "{File Not Found} The file %hs does not exist."},
+	0xC0000010: {0xC0000010, "STATUS_INVALID_DEVICE_REQUEST", "The specified request is not a valid operation for the target device."},
+	0xC0000011: {0xC0000011, "STATUS_END_OF_FILE", "The end-of-file marker has been reached. There is no valid data in the file beyond this marker."},
+	0xC0000012: {0xC0000012, "STATUS_WRONG_VOLUME", "{Wrong Volume} The wrong volume is in the drive. Insert volume %hs into drive %hs."},
+	0xC0000013: {0xC0000013, "STATUS_NO_MEDIA_IN_DEVICE", "{No Disk} There is no disk in the drive. Insert a disk into drive %hs."},
+	0xC0000014: {0xC0000014, "STATUS_UNRECOGNIZED_MEDIA", "{Unknown Disk Format} The disk in drive %hs is not formatted properly. Check the disk, and reformat it, if needed."},
+	0xC0000015: {0xC0000015, "STATUS_NONEXISTENT_SECTOR", "{Sector Not Found} The specified sector does not exist."},
+	0xC0000016: {0xC0000016, "STATUS_MORE_PROCESSING_REQUIRED", "{Still Busy} The specified I/O request packet (IRP) cannot be disposed of because the I/O operation is not complete."},
+	0xC0000017: {0xC0000017, "STATUS_NO_MEMORY", "{Not Enough Quota} Not enough virtual memory or paging file quota is available to complete the specified operation."},
+	0xC0000018: {0xC0000018, "STATUS_CONFLICTING_ADDRESSES", "{Conflicting Address Range} The specified address range conflicts with the address space."},
+	0xC0000019: {0xC0000019, "STATUS_NOT_MAPPED_VIEW", "The address range to unmap is not a mapped view."},
+	0xC000001A: {0xC000001A, "STATUS_UNABLE_TO_FREE_VM", "The virtual memory cannot be freed."},
+	0xC000001B: {0xC000001B, "STATUS_UNABLE_TO_DELETE_SECTION", "The specified section cannot be deleted."},
+	0xC000001C: {0xC000001C, "STATUS_INVALID_SYSTEM_SERVICE", "An invalid system service was specified in a system service call."},
+	0xC000001D: {0xC000001D, "STATUS_ILLEGAL_INSTRUCTION", "{EXCEPTION} Illegal Instruction An attempt was made to execute an illegal instruction."},
+	0xC000001E: {0xC000001E, "STATUS_INVALID_LOCK_SEQUENCE", "{Invalid Lock Sequence} An attempt was made to execute an invalid lock sequence."},
+	0xC000001F: {0xC000001F, "STATUS_INVALID_VIEW_SIZE", "{Invalid Mapping} An attempt was made to create a view for a section that is bigger than the section."},
+	0xC0000020: {0xC0000020, "STATUS_INVALID_FILE_FOR_SECTION", "{Bad File} The attributes of the specified mapping file for a section of memory cannot be read."},
+	0xC0000021: {0xC0000021, "STATUS_ALREADY_COMMITTED", "{Already Committed} The specified address range is already committed."},
+	0xC0000022: {0xC0000022, "STATUS_ACCESS_DENIED", "{Access Denied} A process has requested access to an object but has not been granted those access rights."},
+	0xC0000023: {0xC0000023, "STATUS_BUFFER_TOO_SMALL", "{Buffer Too Small} The buffer is too small to contain the entry. No information has been written to the buffer."},
+	0xC0000024: {0xC0000024, "STATUS_OBJECT_TYPE_MISMATCH", "{Wrong Type} There is a mismatch between the type of object that is required by the requested operation and the type of object that is specified in the request."},
+	0xC0000025: {0xC0000025, "STATUS_NONCONTINUABLE_EXCEPTION", "{EXCEPTION} Cannot Continue Windows cannot continue from this exception."},
+	0xC0000026: {0xC0000026, "STATUS_INVALID_DISPOSITION", "An invalid exception disposition was returned by an exception handler."},
+	0xC0000027: {0xC0000027, "STATUS_UNWIND", "Unwind exception code."},
+	0xC0000028: {0xC0000028, "STATUS_BAD_STACK", "An invalid or unaligned stack was encountered during an unwind operation."},
+	0xC0000029: {0xC0000029, "STATUS_INVALID_UNWIND_TARGET", "An invalid unwind target was encountered during an unwind operation."},
+	0xC000002A: {0xC000002A, "STATUS_NOT_LOCKED", "An attempt was made to unlock a page of memory that was not locked."},
+	0xC000002B: {0xC000002B, "STATUS_PARITY_ERROR", "A device parity error on an I/O operation."},
+	0xC000002C: {0xC000002C, "STATUS_UNABLE_TO_DECOMMIT_VM", "An attempt was made to decommit uncommitted virtual memory."},
+	0xC000002D: {0xC000002D, "STATUS_NOT_COMMITTED", "An attempt was made to change the attributes on memory that has not been committed."},
+	0xC000002E: {0xC000002E, "STATUS_INVALID_PORT_ATTRIBUTES", "Invalid object attributes specified to NtCreatePort or invalid port attributes specified to NtConnectPort."},
+	0xC000002F: {0xC000002F, "STATUS_PORT_MESSAGE_TOO_LONG", "The length of the message that was passed to NtRequestPort or NtRequestWaitReplyPort is longer than the maximum message that is allowed by the port."},
+	0xC0000030: {0xC0000030, "STATUS_INVALID_PARAMETER_MIX", "An invalid combination of parameters was specified."},
+	0xC0000031: {0xC0000031, "STATUS_INVALID_QUOTA_LOWER", "An attempt was made to lower a quota limit below the current usage."},
+	0xC0000032: {0xC0000032, "STATUS_DISK_CORRUPT_ERROR", "{Corrupt Disk} The file system structure on the disk is corrupt and unusable. Run the Chkdsk utility on the volume %hs."},
+	0xC0000033: {0xC0000033, "STATUS_OBJECT_NAME_INVALID", "The object name is invalid."},
+	0xC0000034: {0xC0000034, "STATUS_OBJECT_NAME_NOT_FOUND", "The object name is not found."},
+	0xC0000035: {0xC0000035, "STATUS_OBJECT_NAME_COLLISION", "The object name already exists."},
+	0xC0000036: {0xC0000036, "STATUS_PORT_DO_NOT_DISTURB", "STATUS_PORT_DO_NOT_DISTURB"},
+	0xC0000037: {0xC0000037, "STATUS_PORT_DISCONNECTED", "An attempt was made to send a message to a disconnected communication port."},
+	0xC0000038: {0xC0000038, "STATUS_DEVICE_ALREADY_ATTACHED", "An attempt was made to attach to a device that was already attached to another device."},
+	0xC0000039: {0xC0000039, "STATUS_OBJECT_PATH_INVALID", "The object path component was not a directory object."},
+	0xC000003A: {0xC000003A, "STATUS_OBJECT_PATH_NOT_FOUND", "{Path Not Found} The path %hs does not exist."},
+	0xC000003B: {0xC000003B, "STATUS_OBJECT_PATH_SYNTAX_BAD", "The object path component was not a directory object."},
+	0xC000003C: {0xC000003C, "STATUS_DATA_OVERRUN", "{Data Overrun} A data overrun error occurred."},
+	0xC000003D: {0xC000003D, "STATUS_DATA_LATE_ERROR", "{Data Late} A data late error occurred."},
+	0xC000003E: {0xC000003E, "STATUS_DATA_ERROR", "{Data Error} An error occurred in reading or writing data."},
+	0xC000003F: {0xC000003F, "STATUS_CRC_ERROR", "{Bad CRC} A cyclic redundancy check (CRC) checksum error occurred."},
+	0xC0000040: {0xC0000040, "STATUS_SECTION_TOO_BIG", "{Section Too Large} The specified section is too big to map the file."},
+	0xC0000041: {0xC0000041, "STATUS_PORT_CONNECTION_REFUSED", "The NtConnectPort request is refused."},
+	0xC0000042: {0xC0000042, "STATUS_INVALID_PORT_HANDLE", "The type of port handle is invalid for the operation that is requested."},
+	0xC0000043: {0xC0000043, "STATUS_SHARING_VIOLATION", "A file cannot be opened because the share access flags are incompatible."},
+	0xC0000044: {0xC0000044, "STATUS_QUOTA_EXCEEDED", "Insufficient quota exists to complete the operation."},
+	0xC0000045: {0xC0000045, "STATUS_INVALID_PAGE_PROTECTION", "The specified page protection was not valid."},
+	0xC0000046: {0xC0000046, "STATUS_MUTANT_NOT_OWNED", "An attempt to release a mutant object was made by a thread that was not the owner of the mutant object."},
+	0xC0000047: {0xC0000047, "STATUS_SEMAPHORE_LIMIT_EXCEEDED", "An attempt was made to release a semaphore such that its maximum count would have been exceeded."},
+	0xC0000048: {0xC0000048, "STATUS_PORT_ALREADY_SET", "An attempt was made to set the DebugPort or ExceptionPort of a process, but a port already exists in the process, or an attempt was made to set the CompletionPort of a file but a port was already set in the file, or an attempt was made to set the associated completion port of an ALPC port but it is already set."},
+	0xC0000049: {0xC0000049, "STATUS_SECTION_NOT_IMAGE", "An attempt was made to query image information on a section that does not map an image."},
+	0xC000004A: {0xC000004A, "STATUS_SUSPEND_COUNT_EXCEEDED", "An attempt was made to suspend a thread whose suspend count was at its maximum."},
+	0xC000004B: {0xC000004B, "STATUS_THREAD_IS_TERMINATING", "An attempt was made to suspend a thread that has begun termination."},
+	0xC000004C: {0xC000004C, "STATUS_BAD_WORKING_SET_LIMIT", "An attempt was made to set the working set limit to an invalid value (for example, the minimum greater than maximum)."},
+	0xC000004D: {0xC000004D, "STATUS_INCOMPATIBLE_FILE_MAP", "A section was created to map a file that is not compatible with an already existing section that maps the same file."},
+	0xC000004E: {0xC000004E, "STATUS_SECTION_PROTECTION", "A view to a section specifies a protection that is incompatible with the protection of the initial view."},
+	0xC000004F: {0xC000004F, "STATUS_EAS_NOT_SUPPORTED", "An operation involving EAs failed because the file system does not support EAs."},
+	0xC0000050: {0xC0000050, "STATUS_EA_TOO_LARGE", "An EA operation failed because the EA set is too large."},
+	0xC0000051: {0xC0000051, "STATUS_NONEXISTENT_EA_ENTRY", "An EA operation failed because the name or EA index is invalid."},
+	0xC0000052: {0xC0000052, "STATUS_NO_EAS_ON_FILE", "The file for which EAs were requested has no EAs."},
+	0xC0000053: {0xC0000053, "STATUS_EA_CORRUPT_ERROR", "The EA is corrupt and cannot be read."},
+	0xC0000054: {0xC0000054, "STATUS_FILE_LOCK_CONFLICT", "A requested read/write cannot be granted due to a conflicting file lock."},
+	0xC0000055: {0xC0000055, "STATUS_LOCK_NOT_GRANTED", "A requested file lock cannot be granted due to other existing locks."},
+	0xC0000056: {0xC0000056, "STATUS_DELETE_PENDING", "A non-close operation has been requested of a file object that has a delete pending."},
+	0xC0000057: {0xC0000057, "STATUS_CTL_FILE_NOT_SUPPORTED", "An attempt was made to set the control attribute on a file. This attribute is not supported in the destination file system."},
+	0xC0000058: {0xC0000058, "STATUS_UNKNOWN_REVISION", "Indicates a revision number that was encountered or specified is not one that is known by the service. It might be a more recent revision than the service is aware of."},
+	0xC0000059: {0xC0000059, "STATUS_REVISION_MISMATCH", "Indicates that two revision levels are incompatible."},
+	0xC000005A: {0xC000005A, "STATUS_INVALID_OWNER", "Indicates a particular security ID cannot be assigned as the owner of an object."},
+	0xC000005B: {0xC000005B, "STATUS_INVALID_PRIMARY_GROUP", "Indicates a particular security ID cannot be assigned as the primary group of an object."},
+	0xC000005C: {0xC000005C, "STATUS_NO_IMPERSONATION_TOKEN", "An attempt has been made to operate on an impersonation token by a thread that is not currently impersonating a client."},
+	0xC000005D: {0xC000005D, "STATUS_CANT_DISABLE_MANDATORY", "A mandatory group cannot be disabled."},
+	0xC000005E: {0xC000005E, "STATUS_NO_LOGON_SERVERS", "No logon servers are currently available to service the logon request."},
+	0xC000005F: {0xC000005F, "STATUS_NO_SUCH_LOGON_SESSION", "A specified logon session does not exist. It might already have been terminated."},
+	0xC0000060: {0xC0000060, "STATUS_NO_SUCH_PRIVILEGE", "A specified privilege does not exist."},
+	0xC0000061: {0xC0000061, "STATUS_PRIVILEGE_NOT_HELD", "A required privilege is not held by the client."},
+	0xC0000062: {0xC0000062, "STATUS_INVALID_ACCOUNT_NAME", "The name provided is not a properly formed account name."},
+	0xC0000063: {0xC0000063, "STATUS_USER_EXISTS", "The specified account already exists."},
+	0xC0000064: {0xC0000064, "STATUS_NO_SUCH_USER", "The specified account does not exist."},
+	0xC0000065: {0xC0000065, "STATUS_GROUP_EXISTS", "The specified group already exists."},
+	0xC0000066: {0xC0000066, "STATUS_NO_SUCH_GROUP", "The specified group does not exist."},
+	0xC0000067: {0xC0000067, "STATUS_MEMBER_IN_GROUP", "The specified user account is already in the specified group account. Also used to indicate a group cannot be deleted because it contains a member."},
+	0xC0000068: {0xC0000068, "STATUS_MEMBER_NOT_IN_GROUP", "The specified user account is not a member of the specified group account."},
+	0xC0000069: {0xC0000069, "STATUS_LAST_ADMIN", "Indicates the requested operation would disable or delete the last remaining administration account. This is not allowed to prevent creating a situation in which the system cannot be administrated."},
+	0xC000006A: {0xC000006A, "STATUS_WRONG_PASSWORD", "When trying to update a password, this return status indicates that the value provided as the current password is not correct."},
+	0xC000006B: {0xC000006B, "STATUS_ILL_FORMED_PASSWORD", "When trying to update a password, this return status indicates that the value provided for the new password contains values that are not allowed in passwords."},
+	0xC000006C: {0xC000006C, "STATUS_PASSWORD_RESTRICTION", "When trying to update a password, this status indicates that some password update rule has been violated. For example, the password might not meet length criteria."},
+	0xC000006D: {0xC000006D, "STATUS_LOGON_FAILURE", "The attempted logon is invalid. This is either due to a bad username or authentication information."},
+	0xC000006E: {0xC000006E, "STATUS_ACCOUNT_RESTRICTION", "Indicates a referenced user name and authentication information are valid, but some user account restriction has prevented successful authentication (such as time-of-day restrictions)."},
+	0xC000006F: {0xC000006F, "STATUS_INVALID_LOGON_HOURS", "The user account has time restrictions and cannot be logged onto at this time."},
+	0xC0000070: {0xC0000070, "STATUS_INVALID_WORKSTATION", "The user account is restricted so that it cannot be used to log on from the source workstation."},
+	0xC0000071: {0xC0000071, "STATUS_PASSWORD_EXPIRED", "The user account password has expired."},
+	0xC0000072: {0xC0000072, "STATUS_ACCOUNT_DISABLED", "The referenced account is currently disabled and cannot be logged on to."},
+	0xC0000073: {0xC0000073, "STATUS_NONE_MAPPED", "None of the information to be translated has been translated."},
+	0xC0000074: {0xC0000074, "STATUS_TOO_MANY_LUIDS_REQUESTED", "The number of LUIDs requested cannot be allocated with a single allocation."},
+	0xC0000075: {0xC0000075, "STATUS_LUIDS_EXHAUSTED", "Indicates there are no more LUIDs to allocate."},
+	0xC0000076: {0xC0000076, "STATUS_INVALID_SUB_AUTHORITY", "Indicates the sub-authority value is invalid for the particular use."},
+	0xC0000077: {0xC0000077, "STATUS_INVALID_ACL", "Indicates the ACL structure is not valid."},
+	0xC0000078: {0xC0000078, "STATUS_INVALID_SID", "Indicates the SID structure is not valid."},
+	0xC0000079: {0xC0000079, "STATUS_INVALID_SECURITY_DESCR", "Indicates the SECURITY_DESCRIPTOR structure is not valid."},
+	0xC000007A: {0xC000007A, "STATUS_PROCEDURE_NOT_FOUND", "Indicates the specified procedure address cannot be found in the DLL."},
+	0xC000007B: {0xC000007B, "STATUS_INVALID_IMAGE_FORMAT", "{Bad Image} %hs is either not designed to run on Windows or it contains an error. Try installing the program again using the original installation media or contact your system administrator or the software vendor for support."},
+	0xC000007C: {0xC000007C, "STATUS_NO_TOKEN", "An attempt was made to reference a token that does not exist. This is typically done by referencing the token that is associated with a thread when the thread is not impersonating a client."},
+	0xC000007D: {0xC000007D, "STATUS_BAD_INHERITANCE_ACL", "Indicates that an attempt to build either an inherited ACL or ACE was not successful. This can be caused by a number of things. One of the more probable causes is the replacement of a CreatorId with a SID that did not fit into the ACE or ACL."},
+	0xC000007E: {0xC000007E, "STATUS_RANGE_NOT_LOCKED", "The range specified in NtUnlockFile was not locked."},
+	0xC000007F: {0xC000007F, "STATUS_DISK_FULL", "An operation failed because the disk was full."},
+	0xC0000080: {0xC0000080, "STATUS_SERVER_DISABLED", "The GUID allocation server is disabled at the moment."},
+	0xC0000081: {0xC0000081, "STATUS_SERVER_NOT_DISABLED", "The GUID allocation server is enabled at the moment."},
+	0xC0000082: {0xC0000082, "STATUS_TOO_MANY_GUIDS_REQUESTED", "Too many GUIDs were requested from the allocation server at once."},
+	0xC0000083: {0xC0000083, "STATUS_GUIDS_EXHAUSTED", "The GUIDs could not be allocated because the Authority Agent was exhausted."},
+	0xC0000084: {0xC0000084, "STATUS_INVALID_ID_AUTHORITY", "The value provided was an invalid value for an identifier authority."},
+	0xC0000085: {0xC0000085, "STATUS_AGENTS_EXHAUSTED", "No more authority agent values are available for the particular identifier authority value."},
+	0xC0000086: {0xC0000086, "STATUS_INVALID_VOLUME_LABEL", "An invalid volume label has been specified."},
+	0xC0000087: {0xC0000087, "STATUS_SECTION_NOT_EXTENDED", "A mapped section could not be extended."},
+	0xC0000088: {0xC0000088, "STATUS_NOT_MAPPED_DATA", "Specified section to flush does not map a data file."},
+	0xC0000089: {0xC0000089, "STATUS_RESOURCE_DATA_NOT_FOUND", "Indicates the specified image file did not contain a resource section."},
+	0xC000008A: {0xC000008A, "STATUS_RESOURCE_TYPE_NOT_FOUND", "Indicates the specified resource type cannot be found in the image file."},
+	0xC000008B: {0xC000008B, "STATUS_RESOURCE_NAME_NOT_FOUND", "Indicates the specified resource name cannot be found in the image file."},
+	0xC000008C: {0xC000008C, "STATUS_ARRAY_BOUNDS_EXCEEDED", "{EXCEPTION} Array bounds exceeded."},
+	0xC000008D: {0xC000008D, "STATUS_FLOAT_DENORMAL_OPERAND", "{EXCEPTION} Floating-point denormal operand."},
+	0xC000008E: {0xC000008E, "STATUS_FLOAT_DIVIDE_BY_ZERO", "{EXCEPTION} Floating-point division by zero."},
+	0xC000008F: {0xC000008F, "STATUS_FLOAT_INEXACT_RESULT", "{EXCEPTION} Floating-point inexact result."},
+	0xC0000090: {0xC0000090, "STATUS_FLOAT_INVALID_OPERATION", "{EXCEPTION} Floating-point invalid operation."},
+	0xC0000091: {0xC0000091, "STATUS_FLOAT_OVERFLOW", "{EXCEPTION} Floating-point overflow."},
+	0xC0000092: {0xC0000092, "STATUS_FLOAT_STACK_CHECK", "{EXCEPTION} Floating-point stack check."},
+	0xC0000093: {0xC0000093, "STATUS_FLOAT_UNDERFLOW", "{EXCEPTION} Floating-point underflow."},
+	0xC0000094: {0xC0000094, "STATUS_INTEGER_DIVIDE_BY_ZERO", "{EXCEPTION} Integer division by zero."},
+	0xC0000095: {0xC0000095, "STATUS_INTEGER_OVERFLOW", "{EXCEPTION} Integer overflow."},
+	0xC0000096: {0xC0000096, "STATUS_PRIVILEGED_INSTRUCTION", "{EXCEPTION} Privileged instruction."},
+	0xC0000097: {0xC0000097, "STATUS_TOO_MANY_PAGING_FILES", "An attempt was made to install more paging files than the system supports."},
+	0xC0000098: {0xC0000098, "STATUS_FILE_INVALID", "The volume for a file has been externally altered such that the opened file is no longer valid."},
+	0xC0000099: {0xC0000099, "STATUS_ALLOTTED_SPACE_EXCEEDED", "When a block of memory is allotted for future updates, such as the memory allocated to hold discretionary access control and primary group information, successive updates might exceed the amount of memory originally allotted."},
+	0xC000009A: {0xC000009A, "STATUS_INSUFFICIENT_RESOURCES", "Insufficient system resources exist to complete the API."},
+	0xC000009B: {0xC000009B, "STATUS_DFS_EXIT_PATH_FOUND", "An attempt has been made to open a DFS exit path control file."},
+	0xC000009C: {0xC000009C, "STATUS_DEVICE_DATA_ERROR", "There are bad blocks (sectors) on the hard disk."},
+	0xC000009D: {0xC000009D, "STATUS_DEVICE_NOT_CONNECTED", "There is bad cabling, non-termination, or the controller is not able to obtain access to the hard disk."},
+	0xC000009E: {0xC000009E, "STATUS_DEVICE_POWER_FAILURE", "STATUS_DEVICE_POWER_FAILURE"},
+	0xC000009F: {0xC000009F, "STATUS_FREE_VM_NOT_AT_BASE", "Virtual memory cannot be freed because the base address is not the base of the region and a region size of zero was specified."},
+	0xC00000A0: {0xC00000A0, "STATUS_MEMORY_NOT_ALLOCATED", "An attempt was made to free virtual memory that is not allocated."},
+	0xC00000A1: {0xC00000A1, "STATUS_WORKING_SET_QUOTA", "The working set is not big enough to allow the requested pages to be locked."},
+	0xC00000A2: {0xC00000A2, "STATUS_MEDIA_WRITE_PROTECTED", "{Write Protect Error} The disk cannot be written to because it is write-protected. Remove the write protection from the volume %hs in drive %hs."},
+	0xC00000A3: {0xC00000A3, "STATUS_DEVICE_NOT_READY", "{Drive Not Ready} The drive is not ready for use; its door might be open. Check drive %hs and make sure that a disk is inserted and that the drive door is closed."},
+	0xC00000A4: {0xC00000A4, "STATUS_INVALID_GROUP_ATTRIBUTES", "The specified attributes are invalid or are incompatible with the attributes for the group as a whole."},
+	0xC00000A5: {0xC00000A5, "STATUS_BAD_IMPERSONATION_LEVEL", "A specified impersonation level is invalid. Also used to indicate that a required impersonation level was not provided."},
+	0xC00000A6: {0xC00000A6, "STATUS_CANT_OPEN_ANONYMOUS", "An attempt was made to open an anonymous-level token. Anonymous tokens cannot be opened."},
+	0xC00000A7: {0xC00000A7, "STATUS_BAD_VALIDATION_CLASS", "The validation information class requested was invalid."},
+	0xC00000A8: {0xC00000A8, "STATUS_BAD_TOKEN_TYPE", "The type of a token object is inappropriate for its attempted use."},
+	0xC00000A9: {0xC00000A9, "STATUS_BAD_MASTER_BOOT_RECORD", "The type of a token object is inappropriate for its attempted use."},
+	0xC00000AA: {0xC00000AA, "STATUS_INSTRUCTION_MISALIGNMENT", "An attempt was made to execute an instruction at an unaligned address and the host system does not support unaligned instruction references."},
+	0xC00000AB: {0xC00000AB, "STATUS_INSTANCE_NOT_AVAILABLE", "The maximum named pipe instance count has been reached."},
+	0xC00000AC: {0xC00000AC, "STATUS_PIPE_NOT_AVAILABLE", "An instance of a named pipe cannot be found in the listening state."},
+	0xC00000AD: {0xC00000AD, "STATUS_INVALID_PIPE_STATE", "The named pipe is not in the connected or closing state."},
+	0xC00000AE: {0xC00000AE, "STATUS_PIPE_BUSY", "The specified pipe is set to complete operations and there are current I/O operations queued so that it cannot be changed to queue operations."},
+	0xC00000AF: {0xC00000AF, "STATUS_ILLEGAL_FUNCTION", "The specified handle is not open to the server end of the named pipe."},
+	0xC00000B0: {0xC00000B0, "STATUS_PIPE_DISCONNECTED", "The specified named pipe is in the disconnected state."},
+	0xC00000B1: {0xC00000B1, "STATUS_PIPE_CLOSING", "The specified named pipe is in the closing state."},
+	0xC00000B2: {0xC00000B2, "STATUS_PIPE_CONNECTED", "The specified named pipe is in the connected state."},
+	0xC00000B3: {0xC00000B3, "STATUS_PIPE_LISTENING", "The specified named pipe is in the listening state."},
+	0xC00000B4: {0xC00000B4, "STATUS_INVALID_READ_MODE", "The specified named pipe is not in message mode."},
+	0xC00000B5: {0xC00000B5, "STATUS_IO_TIMEOUT", "{Device Timeout} The specified I/O operation on %hs was not completed before the time-out period expired."},
+	0xC00000B6: {0xC00000B6, "STATUS_FILE_FORCED_CLOSED", "The specified file has been closed by another process."},
+	0xC00000B7: {0xC00000B7, "STATUS_PROFILING_NOT_STARTED", "Profiling is not started."},
+	0xC00000B8: {0xC00000B8, "STATUS_PROFILING_NOT_STOPPED", "Profiling is not stopped."},
+	0xC00000B9: {0xC00000B9, "STATUS_COULD_NOT_INTERPRET", "The passed ACL did not contain the minimum required information."},
+	0xC00000BA: {0xC00000BA, "STATUS_FILE_IS_A_DIRECTORY", "The file that was specified as a target is a directory, and the caller specified that it could be anything but a directory."},
+	0xC00000BB: {0xC00000BB, "STATUS_NOT_SUPPORTED", "The request is not supported."},
+	0xC00000BC: {0xC00000BC, "STATUS_REMOTE_NOT_LISTENING", "This remote computer is not listening."},
+	0xC00000BD: {0xC00000BD, "STATUS_DUPLICATE_NAME", "A duplicate name exists on the network."},
+	0xC00000BE: {0xC00000BE, "STATUS_BAD_NETWORK_PATH", "The network path cannot be located."},
+	0xC00000BF: {0xC00000BF, "STATUS_NETWORK_BUSY", "The network is busy."},
+	0xC00000C0: {0xC00000C0, "STATUS_DEVICE_DOES_NOT_EXIST", "This device does not exist."},
+	0xC00000C1: {0xC00000C1, "STATUS_TOO_MANY_COMMANDS", "STATUS_TOO_MANY_COMMANDS"},
+	0xC00000C2: {0xC00000C2, "STATUS_ADAPTER_HARDWARE_ERROR", "STATUS_ADAPTER_HARDWARE_ERROR"},
+	0xC00000C3: {0xC00000C3, "STATUS_INVALID_NETWORK_RESPONSE", "The network responded incorrectly."},
+	0xC00000C4: {0xC00000C4, "STATUS_UNEXPECTED_NETWORK_ERROR", "STATUS_UNEXPECTED_NETWORK_ERROR"},
+	0xC00000C5: {0xC00000C5, "STATUS_BAD_REMOTE_ADAPTER", "STATUS_BAD_REMOTE_ADAPTER"},
+	0xC00000C6: {0xC00000C6, "STATUS_PRINT_QUEUE_FULL", "STATUS_PRINT_QUEUE_FULL"},
+	0xC00000C7: {0xC00000C7, "STATUS_NO_SPOOL_SPACE", "STATUS_NO_SPOOL_SPACE"},
+	0xC00000C8: {0xC00000C8, "STATUS_PRINT_CANCELLED", "STATUS_PRINT_CANCELLED"},
+	0xC00000C9: {0xC00000C9, "STATUS_NETWORK_NAME_DELETED", "STATUS_NETWORK_NAME_DELETED"},
+	0xC00000CA: {0xC00000CA, "STATUS_NETWORK_ACCESS_DENIED", "STATUS_NETWORK_ACCESS_DENIED"},
+	0xC00000CB: {0xC00000CB, "STATUS_BAD_DEVICE_TYPE", "STATUS_BAD_DEVICE_TYPE"},
+	0xC00000CC: {0xC00000CC, "STATUS_BAD_NETWORK_NAME", "{Network Name Not Found} The specified share name cannot be found on the remote server."},
+	0xC00000CD: {0xC00000CD, "STATUS_TOO_MANY_NAMES", "STATUS_TOO_MANY_NAMES"},
+	0xC00000CE: {0xC00000CE, "STATUS_TOO_MANY_SESSIONS", "STATUS_TOO_MANY_SESSIONS"},
+	0xC00000CF: {0xC00000CF, "STATUS_SHARING_PAUSED", "STATUS_SHARING_PAUSED"},
+	0xC00000D0: {0xC00000D0, "STATUS_REQUEST_NOT_ACCEPTED", "No more connections can be made to this remote computer at this time because the computer has already accepted the maximum number of connections."},
+	0xC00000D1: {0xC00000D1, "STATUS_REDIRECTOR_PAUSED", "STATUS_REDIRECTOR_PAUSED"},
+	0xC00000D2: {0xC00000D2, "STATUS_NET_WRITE_FAULT", "STATUS_NET_WRITE_FAULT"},
+	0xC00000D3: {0xC00000D3, "STATUS_PROFILING_AT_LIMIT", "STATUS_PROFILING_AT_LIMIT"},
+	0xC00000D4: {0xC00000D4, "STATUS_NOT_SAME_DEVICE", "{Incorrect Volume} The destination file of a rename request is located on a different device than the source of the rename request."},
+	0xC00000D5: {0xC00000D5, "STATUS_FILE_RENAMED", "STATUS_FILE_RENAMED"},
+	0xC00000D6: {0xC00000D6, "STATUS_VIRTUAL_CIRCUIT_CLOSED", "STATUS_VIRTUAL_CIRCUIT_CLOSED"},
+	0xC00000D7: {0xC00000D7, "STATUS_NO_SECURITY_ON_OBJECT", "STATUS_NO_SECURITY_ON_OBJECT"},
+	0xC00000D8: {0xC00000D8, "STATUS_CANT_WAIT", "STATUS_CANT_WAIT"},
+	0xC00000D9: {0xC00000D9, "STATUS_PIPE_EMPTY", "STATUS_PIPE_EMPTY"},
+	0xC00000DA: {0xC00000DA, "STATUS_CANT_ACCESS_DOMAIN_INFO", "STATUS_CANT_ACCESS_DOMAIN_INFO"},
+	0xC00000DB: {0xC00000DB, "STATUS_CANT_TERMINATE_SELF", "STATUS_CANT_TERMINATE_SELF"},
+	0xC00000DC: {0xC00000DC, "STATUS_INVALID_SERVER_STATE", "STATUS_INVALID_SERVER_STATE"},
+	0xC00000DD: {0xC00000DD, "STATUS_INVALID_DOMAIN_STATE", "STATUS_INVALID_DOMAIN_STATE"},
+	0xC00000DE: {0xC00000DE, "STATUS_INVALID_DOMAIN_ROLE", "STATUS_INVALID_DOMAIN_ROLE"},
+	0xC00000DF: {0xC00000DF, "STATUS_NO_SUCH_DOMAIN", "STATUS_NO_SUCH_DOMAIN"},
+	0xC00000E0: {0xC00000E0, "STATUS_DOMAIN_EXISTS", "STATUS_DOMAIN_EXISTS"},
+	0xC00000E1: {0xC00000E1, "STATUS_DOMAIN_LIMIT_EXCEEDED", "STATUS_DOMAIN_LIMIT_EXCEEDED"},
+	0xC00000E2: {0xC00000E2, "STATUS_OPLOCK_NOT_GRANTED", "STATUS_OPLOCK_NOT_GRANTED"},
+	0xC00000E3: {0xC00000E3, "STATUS_INVALID_OPLOCK_PROTOCOL", "An error status returned when an invalid opportunistic lock (oplock) acknowledgment is received by a file system."},
+	0xC00000E4: {0xC00000E4, "STATUS_INTERNAL_DB_CORRUPTION", "STATUS_INTERNAL_DB_CORRUPTION"},
+	0xC00000E5: {0xC00000E5, "STATUS_INTERNAL_ERROR", "An internal error occurred."},
+	0xC00000E6: {0xC00000E6, "STATUS_GENERIC_NOT_MAPPED", "STATUS_GENERIC_NOT_MAPPED"},
+	0xC00000E7: {0xC00000E7, "STATUS_BAD_DESCRIPTOR_FORMAT", "STATUS_BAD_DESCRIPTOR_FORMAT"},
+	0xC00000E8: {0xC00000E8, "STATUS_INVALID_USER_BUFFER", "STATUS_INVALID_USER_BUFFER"},
+	0xC00000E9: {0xC00000E9, "STATUS_UNEXPECTED_IO_ERROR", "STATUS_UNEXPECTED_IO_ERROR"},
+	0xC00000EA: {0xC00000EA, "STATUS_UNEXPECTED_MM_CREATE_ERR", "STATUS_UNEXPECTED_MM_CREATE_ERR"},
+	0xC00000EB: {0xC00000EB, "STATUS_UNEXPECTED_MM_MAP_ERROR", "STATUS_UNEXPECTED_MM_MAP_ERROR"},
+	0xC00000EC: {0xC00000EC, "STATUS_UNEXPECTED_MM_EXTEND_ERR", "STATUS_UNEXPECTED_MM_EXTEND_ERR"},
+	0xC00000ED: {0xC00000ED, "STATUS_NOT_LOGON_PROCESS", "STATUS_NOT_LOGON_PROCESS"},
+	0xC00000EE: {0xC00000EE, "STATUS_LOGON_SESSION_EXISTS", "STATUS_LOGON_SESSION_EXISTS"},
+	0xC00000EF: {0xC00000EF, "STATUS_INVALID_PARAMETER_1", "STATUS_INVALID_PARAMETER_1"},
+	0xC00000F0: {0xC00000F0, "STATUS_INVALID_PARAMETER_2", "STATUS_INVALID_PARAMETER_2"},
+	0xC00000F1: {0xC00000F1, "STATUS_INVALID_PARAMETER_3", "STATUS_INVALID_PARAMETER_3"},
+	0xC00000F2: {0xC00000F2, "STATUS_INVALID_PARAMETER_4", "STATUS_INVALID_PARAMETER_4"},
+	0xC00000F3: {0xC00000F3, "STATUS_INVALID_PARAMETER_5", "STATUS_INVALID_PARAMETER_5"},
+	0xC00000F4: {0xC00000F4, "STATUS_INVALID_PARAMETER_6", "STATUS_INVALID_PARAMETER_6"},
+	0xC00000F5: {0xC00000F5, "STATUS_INVALID_PARAMETER_7", "STATUS_INVALID_PARAMETER_7"},
+	0xC00000F6: {0xC00000F6, "STATUS_INVALID_PARAMETER_8", "STATUS_INVALID_PARAMETER_8"},
+	0xC00000F7: {0xC00000F7, "STATUS_INVALID_PARAMETER_9", "STATUS_INVALID_PARAMETER_9"},
+	0xC00000F8: {0xC00000F8, "STATUS_INVALID_PARAMETER_10", "STATUS_INVALID_PARAMETER_10"},
+	0xC00000F9: {0xC00000F9, "STATUS_INVALID_PARAMETER_11", "STATUS_INVALID_PARAMETER_11"},
+	0xC00000FA: {0xC00000FA, "STATUS_INVALID_PARAMETER_12", "STATUS_INVALID_PARAMETER_12"},
+	0xC00000FB: {0xC00000FB, "STATUS_REDIRECTOR_NOT_STARTED", "STATUS_REDIRECTOR_NOT_STARTED"},
+	0xC00000FC: {0xC00000FC, "STATUS_REDIRECTOR_STARTED", "STATUS_REDIRECTOR_STARTED"},
+	0xC00000FD: {0xC00000FD, "STATUS_STACK_OVERFLOW", "STATUS_STACK_OVERFLOW"},
+	0xC00000FE: {0xC00000FE, "STATUS_NO_SUCH_PACKAGE", "STATUS_NO_SUCH_PACKAGE"},
+	0xC00000FF: {0xC00000FF, "STATUS_BAD_FUNCTION_TABLE", "STATUS_BAD_FUNCTION_TABLE"},
+	0xC0000100: {0xC0000100, "STATUS_VARIABLE_NOT_FOUND", "STATUS_VARIABLE_NOT_FOUND"},
+	0xC0000101: {0xC0000101, "STATUS_DIRECTORY_NOT_EMPTY", "Indicates that the directory trying to be deleted is not empty."},
+	0xC0000102: {0xC0000102, "STATUS_FILE_CORRUPT_ERROR", "{Corrupt File} The file or directory %hs is corrupt and unreadable. Run the Chkdsk utility."},
+	0xC0000103: {0xC0000103, "STATUS_NOT_A_DIRECTORY", "A requested opened file is not a directory."},
+	0xC0000104: {0xC0000104, "STATUS_BAD_LOGON_SESSION_STATE", "The logon session is not in a state that is consistent with the requested operation."},
+	0xC0000105: {0xC0000105, "STATUS_LOGON_SESSION_COLLISION", "STATUS_LOGON_SESSION_COLLISION"},
+	0xC0000106: {0xC0000106, "STATUS_NAME_TOO_LONG", "A specified name string is too long for its intended use."},
+	0xC0000107: {0xC0000107, "STATUS_FILES_OPEN", "The user attempted to force close the files on a redirected drive, but there were opened files on the drive, and the user did not specify a sufficient level of force."},
+	0xC0000108: {0xC0000108, "STATUS_CONNECTION_IN_USE", "STATUS_CONNECTION_IN_USE"},
+	0xC0000109: {0xC0000109, "STATUS_MESSAGE_NOT_FOUND", "STATUS_MESSAGE_NOT_FOUND"},
+	0xC000010A: {0xC000010A, "STATUS_PROCESS_IS_TERMINATING", "An attempt was made to duplicate an object handle into or out of an exiting process."},
+	0xC000010B: {0xC000010B, "STATUS_INVALID_LOGON_TYPE", "Indicates an invalid value has been provided for the LogonType requested."},
+	0xC000010C: {0xC000010C, "STATUS_NO_GUID_TRANSLATION", "STATUS_NO_GUID_TRANSLATION"},
+	0xC000010D: {0xC000010D, "STATUS_CANNOT_IMPERSONATE", "STATUS_CANNOT_IMPERSONATE"},
+	0xC000010E: {0xC000010E, "STATUS_IMAGE_ALREADY_LOADED", "STATUS_IMAGE_ALREADY_LOADED"},
+	0xC000010F: {0xC000010F, "STATUS_ABIOS_NOT_PRESENT", "STATUS_ABIOS_NOT_PRESENT"},
+	0xC0000110: {0xC0000110, "STATUS_ABIOS_LID_NOT_EXIST", "STATUS_ABIOS_LID_NOT_EXIST"},
+	0xC0000111: {0xC0000111, "STATUS_ABIOS_LID_ALREADY_OWNED", "STATUS_ABIOS_LID_ALREADY_OWNED"},
+	0xC0000112: {0xC0000112, "STATUS_ABIOS_NOT_LID_OWNER", "STATUS_ABIOS_NOT_LID_OWNER"},
+	0xC0000113: {0xC0000113, "STATUS_ABIOS_INVALID_COMMAND", "STATUS_ABIOS_INVALID_COMMAND"},
+	0xC0000114: {0xC0000114, "STATUS_ABIOS_INVALID_LID", "STATUS_ABIOS_INVALID_LID"},
+	0xC0000115: {0xC0000115, "STATUS_ABIOS_SELECTOR_NOT_AVAILABLE", "STATUS_ABIOS_SELECTOR_NOT_AVAILABLE"},
+	0xC0000116: {0xC0000116, "STATUS_ABIOS_INVALID_SELECTOR", "STATUS_ABIOS_INVALID_SELECTOR"},
+	0xC0000117: {0xC0000117, "STATUS_NO_LDT", "STATUS_NO_LDT"},
+	0xC0000118: {0xC0000118, "STATUS_INVALID_LDT_SIZE", "STATUS_INVALID_LDT_SIZE"},
+	0xC0000119: {0xC0000119, "STATUS_INVALID_LDT_OFFSET", "STATUS_INVALID_LDT_OFFSET"},
+	0xC000011A: {0xC000011A, "STATUS_INVALID_LDT_DESCRIPTOR", "STATUS_INVALID_LDT_DESCRIPTOR"},
+	0xC000011B: {0xC000011B, "STATUS_INVALID_IMAGE_NE_FORMAT", "STATUS_INVALID_IMAGE_NE_FORMAT"},
+	0xC000011C: {0xC000011C, "STATUS_RXACT_INVALID_STATE", "STATUS_RXACT_INVALID_STATE"},
+	0xC000011D: {0xC000011D, "STATUS_RXACT_COMMIT_FAILURE", "STATUS_RXACT_COMMIT_FAILURE"},
+	0xC000011E: {0xC000011E, "STATUS_MAPPED_FILE_SIZE_ZERO", "STATUS_MAPPED_FILE_SIZE_ZERO"},
+	0xC000011F: {0xC000011F, "STATUS_TOO_MANY_OPENED_FILES", "Too many files are opened on a remote server. This error should only be returned by the Windows redirector on a remote drive."},
+	0xC0000120: {0xC0000120, "STATUS_CANCELLED", "The I/O request was canceled."},
+	0xC0000121: {0xC0000121, "STATUS_CANNOT_DELETE", "An attempt has been made to remove a file or directory that cannot be deleted."},
+	0xC0000122: {0xC0000122, "STATUS_INVALID_COMPUTER_NAME", "STATUS_INVALID_COMPUTER_NAME"},
+	0xC0000123: {0xC0000123, "STATUS_FILE_DELETED", "An I/O request other than close and several other special case operations was attempted using a file object that had already been deleted."},
+	0xC0000124: {0xC0000124, "STATUS_SPECIAL_ACCOUNT", "STATUS_SPECIAL_ACCOUNT"},
+	0xC0000125: {0xC0000125, "STATUS_SPECIAL_GROUP", "STATUS_SPECIAL_GROUP"},
+	0xC0000126: {0xC0000126, "STATUS_SPECIAL_USER", "STATUS_SPECIAL_USER"},
+	0xC0000127: {0xC0000127, "STATUS_MEMBERS_PRIMARY_GROUP", "STATUS_MEMBERS_PRIMARY_GROUP"},
+	0xC0000128: {0xC0000128, "STATUS_FILE_CLOSED", "An I/O request other than close was performed on a file after it was returned from a cleanup operation."},
+	0xC0000129: {0xC0000129, "STATUS_TOO_MANY_THREADS", "STATUS_TOO_MANY_THREADS"},
+	0xC000012A: {0xC000012A, "STATUS_THREAD_NOT_IN_PROCESS", "STATUS_THREAD_NOT_IN_PROCESS"},
+	0xC000012B: {0xC000012B, "STATUS_TOKEN_ALREADY_IN_USE", "An attempt was made to establish a token for use as a primary token but the token is already in use. A token can only be the primary token of one process at a time."},
+	0xC000012C: {0xC000012C, "STATUS_PAGEFILE_QUOTA_EXCEEDED", "The page file quota was exceeded."},
+	0xC000012D: {0xC000012D, "STATUS_COMMITMENT_LIMIT", "{Out of Virtual Memory} Your system is low on virtual memory. To ensure that Windows runs correctly, increase the size of your virtual memory paging file."},
+	0xC000012E: {0xC000012E, "STATUS_INVALID_IMAGE_LE_FORMAT", "STATUS_INVALID_IMAGE_LE_FORMAT"},
+	0xC000012F: {0xC000012F, "STATUS_INVALID_IMAGE_NOT_MZ", "STATUS_INVALID_IMAGE_NOT_MZ"},
+	0xC0000130: {0xC0000130, "STATUS_INVALID_IMAGE_PROTECT", "The specified image file did not have the correct format: it did not have a proper extension."},
+	0xC0000131: {0xC0000131, "STATUS_INVALID_IMAGE_WIN_16", "The specified image file did not have the correct format: it appears to be a 16-bit Windows image."},
+	0xC0000132: {0xC0000132, "STATUS_LOGON_SERVER_CONFLICT", "STATUS_LOGON_SERVER_CONFLICT"},
+	0xC0000133: {0xC0000133, "STATUS_TIME_DIFFERENCE_AT_DC", "STATUS_TIME_DIFFERENCE_AT_DC"},
+	0xC0000134: {0xC0000134, "STATUS_SYNCHRONIZATION_REQUIRED", "STATUS_SYNCHRONIZATION_REQUIRED"},
+	0xC0000135: {0xC0000135, "STATUS_DLL_NOT_FOUND", "{Unable To Locate Component} This application has failed to start because %hs was not found. Reinstalling the application might fix this problem."},
+	0xC0000136: {0xC0000136, "STATUS_OPEN_FAILED", "The NtCreateFile API failed. This error should never be returned to an application; it is a place holder that the Windows LAN Manager Redirector uses in its internal error-mapping routines."},
+	0xC0000137: {0xC0000137, "STATUS_IO_PRIVILEGE_FAILED", "STATUS_IO_PRIVILEGE_FAILED"},
+	0xC0000138: {0xC0000138, "STATUS_ORDINAL_NOT_FOUND", "{Ordinal Not Found} The ordinal %ld could not be located in the dynamic link library %hs."},
+	0xC0000139: {0xC0000139, "STATUS_ENTRYPOINT_NOT_FOUND", "{Entry Point Not Found} The procedure entry point %hs could not be located in the dynamic link library %hs."},
+	0xC000013A: {0xC000013A, "STATUS_CONTROL_C_EXIT", "{Application Exit by CTRL+C} The application terminated as a result of a CTRL+C."},
+	0xC000013B: {0xC000013B, "STATUS_LOCAL_DISCONNECT", "{Virtual Circuit Closed} The network transport on your computer has closed a network connection. There might or might not be I/O requests outstanding."},
+	0xC000013C: {0xC000013C, "STATUS_REMOTE_DISCONNECT", "{Virtual Circuit Closed} The network transport on a remote computer has closed a network connection. There might or might not be I/O requests outstanding."},
+	0xC000013D: {0xC000013D, "STATUS_REMOTE_RESOURCES", "STATUS_REMOTE_RESOURCES"},
+	0xC000013E: {0xC000013E, "STATUS_LINK_FAILED", "STATUS_LINK_FAILED"},
+	0xC000013F: {0xC000013F, "STATUS_LINK_TIMEOUT", "STATUS_LINK_TIMEOUT"},
+	0xC0000140: {0xC0000140, "STATUS_INVALID_CONNECTION", "STATUS_INVALID_CONNECTION"},
+	0xC0000141: {0xC0000141, "STATUS_INVALID_ADDRESS", "STATUS_INVALID_ADDRESS"},
+	0xC0000142: {0xC0000142, "STATUS_DLL_INIT_FAILED", "{DLL Initialization Failed} Initialization of the dynamic link library %hs failed. The process is terminating abnormally."},
+	0xC0000143: {0xC0000143, "STATUS_MISSING_SYSTEMFILE", "STATUS_MISSING_SYSTEMFILE"},
+	0xC0000144: {0xC0000144, "STATUS_UNHANDLED_EXCEPTION", "STATUS_UNHANDLED_EXCEPTION"},
+	0xC0000145: {0xC0000145, "STATUS_APP_INIT_FAILURE", "{Application Error} The application was unable to start correctly (0x%lx). Click OK to close the application."},
+	0xC0000146: {0xC0000146, "STATUS_PAGEFILE_CREATE_FAILED", "STATUS_PAGEFILE_CREATE_FAILED"},
+	0xC0000147: {0xC0000147, "STATUS_NO_PAGEFILE", "STATUS_NO_PAGEFILE"},
+	0xC0000148: {0xC0000148, "STATUS_INVALID_LEVEL", "STATUS_INVALID_LEVEL"},
+	0xC0000149: {0xC0000149, "STATUS_WRONG_PASSWORD_CORE", "STATUS_WRONG_PASSWORD_CORE"},
+	0xC000014A: {0xC000014A, "STATUS_ILLEGAL_FLOAT_CONTEXT", "STATUS_ILLEGAL_FLOAT_CONTEXT"},
+	0xC000014B: {0xC000014B, "STATUS_PIPE_BROKEN", "The pipe operation has failed because the other end of the pipe has been closed."},
+	0xC000014C: {0xC000014C, "STATUS_REGISTRY_CORRUPT", "STATUS_REGISTRY_CORRUPT"},
+	0xC000014D: {0xC000014D, "STATUS_REGISTRY_IO_FAILED", "STATUS_REGISTRY_IO_FAILED"},
+	0xC000014E: {0xC000014E, "STATUS_NO_EVENT_PAIR", "STATUS_NO_EVENT_PAIR"},
+	0xC000014F: {0xC000014F, "STATUS_UNRECOGNIZED_VOLUME", "STATUS_UNRECOGNIZED_VOLUME"},
+	0xC0000150: {0xC0000150, "STATUS_SERIAL_NO_DEVICE_INITED", "STATUS_SERIAL_NO_DEVICE_INITED"},
+	0xC0000151: {0xC0000151, "STATUS_NO_SUCH_ALIAS", "STATUS_NO_SUCH_ALIAS"},
+	0xC0000152: {0xC0000152, "STATUS_MEMBER_NOT_IN_ALIAS", "STATUS_MEMBER_NOT_IN_ALIAS"},
+	0xC0000153: {0xC0000153, "STATUS_MEMBER_IN_ALIAS", "STATUS_MEMBER_IN_ALIAS"},
+	0xC0000154: {0xC0000154, "STATUS_ALIAS_EXISTS", "STATUS_ALIAS_EXISTS"},
+	0xC0000155: {0xC0000155, "STATUS_LOGON_NOT_GRANTED", "STATUS_LOGON_NOT_GRANTED"},
+	0xC0000156: {0xC0000156, "STATUS_TOO_MANY_SECRETS", "The maximum number of secrets that can be stored in a single system was exceeded."},
+	0xC0000157: {0xC0000157, "STATUS_SECRET_TOO_LONG", "STATUS_SECRET_TOO_LONG"},
+	0xC0000158: {0xC0000158, "STATUS_INTERNAL_DB_ERROR", "STATUS_INTERNAL_DB_ERROR"},
+	0xC0000159: {0xC0000159, "STATUS_FULLSCREEN_MODE", "STATUS_FULLSCREEN_MODE"},
+	0xC000015A: {0xC000015A, "STATUS_TOO_MANY_CONTEXT_IDS", "STATUS_TOO_MANY_CONTEXT_IDS"},
+	0xC000015B: {0xC000015B, "STATUS_LOGON_TYPE_NOT_GRANTED", "A user has requested a type of logon (for example, interactive or network) that has not been granted."},
+	0xC000015C: {0xC000015C, "STATUS_NOT_REGISTRY_FILE", "STATUS_NOT_REGISTRY_FILE"},
+	0xC000015D: {0xC000015D, "STATUS_NT_CROSS_ENCRYPTION_REQUIRED", "STATUS_NT_CROSS_ENCRYPTION_REQUIRED"},
+	0xC000015E: {0xC000015E, "STATUS_DOMAIN_CTRLR_CONFIG_ERROR", "STATUS_DOMAIN_CTRLR_CONFIG_ERROR"},
+	0xC000015F: {0xC000015F, "STATUS_FT_MISSING_MEMBER", "STATUS_FT_MISSING_MEMBER"},
+	0xC0000160: {0xC0000160, "STATUS_ILL_FORMED_SERVICE_ENTRY", "STATUS_ILL_FORMED_SERVICE_ENTRY"},
+	0xC0000161: {0xC0000161, "STATUS_ILLEGAL_CHARACTER", "An illegal character was encountered. For a multibyte character set, this includes a lead byte without a succeeding trail byte."},
+	0xC0000162: {0xC0000162, "STATUS_UNMAPPABLE_CHARACTER", "STATUS_UNMAPPABLE_CHARACTER"},
+	0xC0000163: {0xC0000163, "STATUS_UNDEFINED_CHARACTER", "STATUS_UNDEFINED_CHARACTER"},
+	0xC0000164: {0xC0000164, "STATUS_FLOPPY_VOLUME", "STATUS_FLOPPY_VOLUME"},
+	0xC0000165: {0xC0000165, "STATUS_FLOPPY_ID_MARK_NOT_FOUND", "STATUS_FLOPPY_ID_MARK_NOT_FOUND"},
+	0xC0000166: {0xC0000166, "STATUS_FLOPPY_WRONG_CYLINDER", "STATUS_FLOPPY_WRONG_CYLINDER"},
+	0xC0000167: {0xC0000167, "STATUS_FLOPPY_UNKNOWN_ERROR", "STATUS_FLOPPY_UNKNOWN_ERROR"},
+	0xC0000168: {0xC0000168, "STATUS_FLOPPY_BAD_REGISTERS", "STATUS_FLOPPY_BAD_REGISTERS"},
+	0xC0000169: {0xC0000169, "STATUS_DISK_RECALIBRATE_FAILED", "STATUS_DISK_RECALIBRATE_FAILED"},
+	0xC000016A: {0xC000016A, "STATUS_DISK_OPERATION_FAILED", "STATUS_DISK_OPERATION_FAILED"},
+	0xC000016B: {0xC000016B, "STATUS_DISK_RESET_FAILED", "STATUS_DISK_RESET_FAILED"},
+	0xC000016C: {0xC000016C, "STATUS_SHARED_IRQ_BUSY", "STATUS_SHARED_IRQ_BUSY"},
+	0xC000016D: {0xC000016D, "STATUS_FT_ORPHANING", "STATUS_FT_ORPHANING"},
+	0xC000016E: {0xC000016E, "STATUS_BIOS_FAILED_TO_CONNECT_INTERRUPT", "STATUS_BIOS_FAILED_TO_CONNECT_INTERRUPT"},
+	0xC0000172: {0xC0000172, "STATUS_PARTITION_FAILURE", "STATUS_PARTITION_FAILURE"},
+	0xC0000173: {0xC0000173, "STATUS_INVALID_BLOCK_LENGTH", "STATUS_INVALID_BLOCK_LENGTH"},
+	0xC0000174: {0xC0000174, "STATUS_DEVICE_NOT_PARTITIONED", "STATUS_DEVICE_NOT_PARTITIONED"},
+	0xC0000175: {0xC0000175, "STATUS_UNABLE_TO_LOCK_MEDIA", "STATUS_UNABLE_TO_LOCK_MEDIA"},
+	0xC0000176: {0xC0000176, "STATUS_UNABLE_TO_UNLOAD_MEDIA", "STATUS_UNABLE_TO_UNLOAD_MEDIA"},
+	0xC0000177: {0xC0000177, "STATUS_EOM_OVERFLOW", "STATUS_EOM_OVERFLOW"},
+	0xC0000178: {0xC0000178, "STATUS_NO_MEDIA", "STATUS_NO_MEDIA"},
+	0xC000017A: {0xC000017A, "STATUS_NO_SUCH_MEMBER", "STATUS_NO_SUCH_MEMBER"},
+	0xC000017B: {0xC000017B, "STATUS_INVALID_MEMBER", "STATUS_INVALID_MEMBER"},
+	0xC000017C: {0xC000017C, "STATUS_KEY_DELETED", "STATUS_KEY_DELETED"},
+	0xC000017D: {0xC000017D, "STATUS_NO_LOG_SPACE", "STATUS_NO_LOG_SPACE"},
+	0xC000017E: {0xC000017E, "STATUS_TOO_MANY_SIDS", "STATUS_TOO_MANY_SIDS"},
+	0xC000017F: {0xC000017F, "STATUS_LM_CROSS_ENCRYPTION_REQUIRED", "STATUS_LM_CROSS_ENCRYPTION_REQUIRED"},
+	0xC0000180: {0xC0000180, "STATUS_KEY_HAS_CHILDREN", "STATUS_KEY_HAS_CHILDREN"},
+	0xC0000181: {0xC0000181, "STATUS_CHILD_MUST_BE_VOLATILE", "STATUS_CHILD_MUST_BE_VOLATILE"},
+	0xC0000182: {0xC0000182, "STATUS_DEVICE_CONFIGURATION_ERROR", "STATUS_DEVICE_CONFIGURATION_ERROR"},
+	0xC0000183: {0xC0000183, "STATUS_DRIVER_INTERNAL_ERROR", "STATUS_DRIVER_INTERNAL_ERROR"},
+	0xC0000184: {0xC0000184, "STATUS_INVALID_DEVICE_STATE", "The device is not in a valid state to perform this request."},
+	0xC0000185: {0xC0000185, "STATUS_IO_DEVICE_ERROR", "The I/O device reported an I/O error."},
+	0xC0000186: {0xC0000186, "STATUS_DEVICE_PROTOCOL_ERROR", "STATUS_DEVICE_PROTOCOL_ERROR"},
+	0xC0000187: {0xC0000187, "STATUS_BACKUP_CONTROLLER", "STATUS_BACKUP_CONTROLLER"},
+	0xC0000188: {0xC0000188, "STATUS_LOG_FILE_FULL", "The log file space is insufficient to support this operation."},
+	0xC0000189: {0xC0000189, "STATUS_TOO_LATE", "A write operation was attempted to a volume after it was dismounted."},
+	0xC000018A: {0xC000018A, "STATUS_NO_TRUST_LSA_SECRET", "STATUS_NO_TRUST_LSA_SECRET"},
+	0xC000018B: {0xC000018B, "STATUS_NO_TRUST_SAM_ACCOUNT", "STATUS_NO_TRUST_SAM_ACCOUNT"},
+	0xC000018C: {0xC000018C, "STATUS_TRUSTED_DOMAIN_FAILURE", "STATUS_TRUSTED_DOMAIN_FAILURE"},
+	0xC000018D: {0xC000018D, "STATUS_TRUSTED_RELATIONSHIP_FAILURE", "STATUS_TRUSTED_RELATIONSHIP_FAILURE"},
+	0xC000018E: {0xC000018E, "STATUS_EVENTLOG_FILE_CORRUPT", "STATUS_EVENTLOG_FILE_CORRUPT"},
+	0xC000018F: {0xC000018F, "STATUS_EVENTLOG_CANT_START", "STATUS_EVENTLOG_CANT_START"},
+	0xC0000190: {0xC0000190, "STATUS_TRUST_FAILURE", "STATUS_TRUST_FAILURE"},
+	0xC0000191: {0xC0000191, "STATUS_MUTANT_LIMIT_EXCEEDED", "STATUS_MUTANT_LIMIT_EXCEEDED"},
+	0xC0000192: {0xC0000192, "STATUS_NETLOGON_NOT_STARTED", "STATUS_NETLOGON_NOT_STARTED"},
+	0xC0000193: {0xC0000193, "STATUS_ACCOUNT_EXPIRED", "STATUS_ACCOUNT_EXPIRED"},
+	0xC0000194: {0xC0000194, "STATUS_POSSIBLE_DEADLOCK", "{EXCEPTION} Possible deadlock condition."},
+	0xC0000195: {0xC0000195, "STATUS_NETWORK_CREDENTIAL_CONFLICT", "The user attempted to make multiple connections to a server or shared resource using more than one user name. Disconnect all previous connections to the server or shared resource and try again."},
+	0xC0000196: {0xC0000196, "STATUS_REMOTE_SESSION_LIMIT", "STATUS_REMOTE_SESSION_LIMIT"},
+	0xC0000197: {0xC0000197, "STATUS_EVENTLOG_FILE_CHANGED", "STATUS_EVENTLOG_FILE_CHANGED"},
+	0xC0000198: {0xC0000198, "STATUS_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT", "STATUS_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT"},
+	0xC0000199: {0xC0000199, "STATUS_NOLOGON_WORKSTATION_TRUST_ACCOUNT", "STATUS_NOLOGON_WORKSTATION_TRUST_ACCOUNT"},
+	0xC000019A: {0xC000019A, "STATUS_NOLOGON_SERVER_TRUST_ACCOUNT", "STATUS_NOLOGON_SERVER_TRUST_ACCOUNT"},
+	0xC000019B: {0xC000019B, "STATUS_DOMAIN_TRUST_INCONSISTENT", "The name of the primary domain changed while the trust relationship was being verified."},
+	0xC000019C: {0xC000019C, "STATUS_FS_DRIVER_REQUIRED", "STATUS_FS_DRIVER_REQUIRED"},
+	0xC000019D: {0xC000019D, "STATUS_IMAGE_ALREADY_LOADED_AS_DLL", "STATUS_IMAGE_ALREADY_LOADED_AS_DLL"},
+	0xC000019E: {0xC000019E, "STATUS_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING", "STATUS_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING"},
+	0xC000019F: {0xC000019F, "STATUS_SHORT_NAMES_NOT_ENABLED_ON_VOLUME", "STATUS_SHORT_NAMES_NOT_ENABLED_ON_VOLUME"},
+	0xC00001A0: {0xC00001A0, "STATUS_SECURITY_STREAM_IS_INCONSISTENT", "STATUS_SECURITY_STREAM_IS_INCONSISTENT"},
+	0xC00001A1: {0xC00001A1, "STATUS_INVALID_LOCK_RANGE", "A requested file lock operation cannot be processed due to an invalid byte range."},
+	0xC00001A2: {0xC00001A2, "STATUS_INVALID_ACE_CONDITION", "STATUS_INVALID_ACE_CONDITION"},
+	0xC00001A3: {0xC00001A3, "STATUS_IMAGE_SUBSYSTEM_NOT_PRESENT", "STATUS_IMAGE_SUBSYSTEM_NOT_PRESENT"},
+	0xC00001A4: {0xC00001A4, "STATUS_NOTIFICATION_GUID_ALREADY_DEFINED", "STATUS_NOTIFICATION_GUID_ALREADY_DEFINED"},
+	0xC00001A5: {0xC00001A5, "STATUS_INVALID_EXCEPTION_HANDLER", "STATUS_INVALID_EXCEPTION_HANDLER"},
+	0xC00001A6: {0xC00001A6, "STATUS_DUPLICATE_PRIVILEGES", "STATUS_DUPLICATE_PRIVILEGES"},
+	0xC00001A7: {0xC00001A7, "STATUS_NOT_ALLOWED_ON_SYSTEM_FILE", "STATUS_NOT_ALLOWED_ON_SYSTEM_FILE"},
+	0xC00001A8: {0xC00001A8, "STATUS_REPAIR_NEEDED", "STATUS_REPAIR_NEEDED"},
+	0xC00001A9: {0xC00001A9, "STATUS_QUOTA_NOT_ENABLED", "STATUS_QUOTA_NOT_ENABLED"},
+	0xC00001AA: {0xC00001AA, "STATUS_NO_APPLICATION_PACKAGE", "STATUS_NO_APPLICATION_PACKAGE"},
+	0xC00001AB: {0xC00001AB, "STATUS_FILE_METADATA_OPTIMIZATION_IN_PROGRESS", "STATUS_FILE_METADATA_OPTIMIZATION_IN_PROGRESS"},
+	0xC00001AC: {0xC00001AC, "STATUS_NOT_SAME_OBJECT", "STATUS_NOT_SAME_OBJECT"},
+	0xC00001AD: {0xC00001AD, "STATUS_FATAL_MEMORY_EXHAUSTION", "There is insufficient memory to perform this operation."},
+	0xC00001AE: {0xC00001AE, "STATUS_ERROR_PROCESS_NOT_IN_JOB", "STATUS_ERROR_PROCESS_NOT_IN_JOB"},
+	0xC00001AF: {0xC00001AF, "STATUS_CPU_SET_INVALID", "STATUS_CPU_SET_INVALID"},
+	0xC00001B0: {0xC00001B0, "STATUS_IO_DEVICE_INVALID_DATA", "STATUS_IO_DEVICE_INVALID_DATA"},
+	0xC00001B1: {0xC00001B1, "STATUS_IO_UNALIGNED_WRITE", "STATUS_IO_UNALIGNED_WRITE"},
+	0xC0000201: {0xC0000201, "STATUS_NETWORK_OPEN_RESTRICTION", "STATUS_NETWORK_OPEN_RESTRICTION"},
+	0xC0000202: {0xC0000202, "STATUS_NO_USER_SESSION_KEY", "STATUS_NO_USER_SESSION_KEY"},
+	0xC0000203: {0xC0000203, "STATUS_USER_SESSION_DELETED", "The remote user session has been deleted."},
+	0xC0000204: {0xC0000204, "STATUS_RESOURCE_LANG_NOT_FOUND", "STATUS_RESOURCE_LANG_NOT_FOUND"},
+	0xC0000205: {0xC0000205, "STATUS_INSUFF_SERVER_RESOURCES", "Insufficient server resources exist to complete the request."},
+	0xC0000206: {0xC0000206, "STATUS_INVALID_BUFFER_SIZE", "The size of the buffer is invalid for the specified operation."},
+	0xC0000207: {0xC0000207, "STATUS_INVALID_ADDRESS_COMPONENT", "STATUS_INVALID_ADDRESS_COMPONENT"},
+	0xC0000208: {0xC0000208, "STATUS_INVALID_ADDRESS_WILDCARD", "STATUS_INVALID_ADDRESS_WILDCARD"},
+	0xC0000209: {0xC0000209, "STATUS_TOO_MANY_ADDRESSES", "STATUS_TOO_MANY_ADDRESSES"},
+	0xC000020A: {0xC000020A, "STATUS_ADDRESS_ALREADY_EXISTS", "STATUS_ADDRESS_ALREADY_EXISTS"},
+	0xC000020B: {0xC000020B, "STATUS_ADDRESS_CLOSED", "STATUS_ADDRESS_CLOSED"},
+	0xC000020C: {0xC000020C, "STATUS_CONNECTION_DISCONNECTED", "The transport connection is now disconnected."},
+	0xC000020D: {0xC000020D, "STATUS_CONNECTION_RESET", "STATUS_CONNECTION_RESET"},
+	0xC000020E: {0xC000020E, "STATUS_TOO_MANY_NODES", "STATUS_TOO_MANY_NODES"},
+	0xC000020F: {0xC000020F, "STATUS_TRANSACTION_ABORTED", "STATUS_TRANSACTION_ABORTED"},
+	0xC0000210: {0xC0000210, "STATUS_TRANSACTION_TIMED_OUT", "STATUS_TRANSACTION_TIMED_OUT"},
+	0xC0000211: {0xC0000211, "STATUS_TRANSACTION_NO_RELEASE", "STATUS_TRANSACTION_NO_RELEASE"},
+	0xC0000212: {0xC0000212, "STATUS_TRANSACTION_NO_MATCH", "STATUS_TRANSACTION_NO_MATCH"},
+	0xC0000213: {0xC0000213, "STATUS_TRANSACTION_RESPONDED", "STATUS_TRANSACTION_RESPONDED"},
+	0xC0000214: {0xC0000214, "STATUS_TRANSACTION_INVALID_ID", "STATUS_TRANSACTION_INVALID_ID"},
+	0xC0000215: {0xC0000215, "STATUS_TRANSACTION_INVALID_TYPE", "STATUS_TRANSACTION_INVALID_TYPE"},
+	0xC0000216: {0xC0000216, "STATUS_NOT_SERVER_SESSION", "STATUS_NOT_SERVER_SESSION"},
+	0xC0000217: {0xC0000217, "STATUS_NOT_CLIENT_SESSION", "STATUS_NOT_CLIENT_SESSION"},
+	0xC0000218: {0xC0000218, "STATUS_CANNOT_LOAD_REGISTRY_FILE", "STATUS_CANNOT_LOAD_REGISTRY_FILE"},
+	0xC0000219: {0xC0000219, "STATUS_DEBUG_ATTACH_FAILED", "STATUS_DEBUG_ATTACH_FAILED"},
+	0xC000021A: {0xC000021A, "STATUS_SYSTEM_PROCESS_TERMINATED", "STATUS_SYSTEM_PROCESS_TERMINATED"},
+	0xC000021B: {0xC000021B, "STATUS_DATA_NOT_ACCEPTED", "STATUS_DATA_NOT_ACCEPTED"},
+	0xC000021C: {0xC000021C, "STATUS_NO_BROWSER_SERVERS_FOUND", "STATUS_NO_BROWSER_SERVERS_FOUND"},
+	0xC000021D: {0xC000021D, "STATUS_VDM_HARD_ERROR", "STATUS_VDM_HARD_ERROR"},
+	0xC000021E: {0xC000021E, "STATUS_DRIVER_CANCEL_TIMEOUT", "STATUS_DRIVER_CANCEL_TIMEOUT"},
+	0xC000021F: {0xC000021F, "STATUS_REPLY_MESSAGE_MISMATCH", "STATUS_REPLY_MESSAGE_MISMATCH"},
+	0xC0000220: {0xC0000220, "STATUS_MAPPED_ALIGNMENT", "STATUS_MAPPED_ALIGNMENT"},
+	0xC0000221: {0xC0000221, "STATUS_IMAGE_CHECKSUM_MISMATCH", "STATUS_IMAGE_CHECKSUM_MISMATCH"},
+	0xC0000222: {0xC0000222, "STATUS_LOST_WRITEBEHIND_DATA", "STATUS_LOST_WRITEBEHIND_DATA"},
+	0xC0000223: {0xC0000223, "STATUS_CLIENT_SERVER_PARAMETERS_INVALID", "STATUS_CLIENT_SERVER_PARAMETERS_INVALID"},
+	0xC0000224: {0xC0000224, "STATUS_PASSWORD_MUST_CHANGE", "STATUS_PASSWORD_MUST_CHANGE"},
+	0xC0000225: {0xC0000225, "STATUS_NOT_FOUND", "The object was not found."},
+	0xC0000226: {0xC0000226, "STATUS_NOT_TINY_STREAM", "STATUS_NOT_TINY_STREAM"},
+	0xC0000227: {0xC0000227, "STATUS_RECOVERY_FAILURE", "STATUS_RECOVERY_FAILURE"},
+	0xC0000228: {0xC0000228, "STATUS_STACK_OVERFLOW_READ", "STATUS_STACK_OVERFLOW_READ"},
+	0xC0000229: {0xC0000229, "STATUS_FAIL_CHECK", "STATUS_FAIL_CHECK"},
+	0xC000022A: {0xC000022A, "STATUS_DUPLICATE_OBJECTID", "STATUS_DUPLICATE_OBJECTID"},
+	0xC000022B: {0xC000022B, "STATUS_OBJECTID_EXISTS", "STATUS_OBJECTID_EXISTS"},
+	0xC000022C: {0xC000022C, "STATUS_CONVERT_TO_LARGE", "STATUS_CONVERT_TO_LARGE"},
+	0xC000022D: {0xC000022D, "STATUS_RETRY", "STATUS_RETRY"},
+	0xC000022E: {0xC000022E, "STATUS_FOUND_OUT_OF_SCOPE", "STATUS_FOUND_OUT_OF_SCOPE"},
+	0xC000022F: {0xC000022F, "STATUS_ALLOCATE_BUCKET", "STATUS_ALLOCATE_BUCKET"},
+	0xC0000230: {0xC0000230, "STATUS_PROPSET_NOT_FOUND", "STATUS_PROPSET_NOT_FOUND"},
+	0xC0000231: {0xC0000231, "STATUS_MARSHALL_OVERFLOW", "STATUS_MARSHALL_OVERFLOW"},
+	0xC0000232: {0xC0000232, "STATUS_INVALID_VARIANT", "STATUS_INVALID_VARIANT"},
+	0xC0000233: {0xC0000233, "STATUS_DOMAIN_CONTROLLER_NOT_FOUND", "STATUS_DOMAIN_CONTROLLER_NOT_FOUND"},
+	0xC0000234: {0xC0000234, "STATUS_ACCOUNT_LOCKED_OUT", "STATUS_ACCOUNT_LOCKED_OUT"},
+	0xC0000235: {0xC0000235, "STATUS_HANDLE_NOT_CLOSABLE", "NtClose was called on a handle that was protected from close via NtSetInformationObject."},
+	0xC0000236: {0xC0000236, "STATUS_CONNECTION_REFUSED", "The transport connection attempt was refused by the remote system."},
+	0xC0000237: {0xC0000237, "STATUS_GRACEFUL_DISCONNECT", "STATUS_GRACEFUL_DISCONNECT"},
+	0xC0000238: {0xC0000238, "STATUS_ADDRESS_ALREADY_ASSOCIATED", "STATUS_ADDRESS_ALREADY_ASSOCIATED"},
+	0xC0000239: {0xC0000239, "STATUS_ADDRESS_NOT_ASSOCIATED", "STATUS_ADDRESS_NOT_ASSOCIATED"},
+	0xC000023A: {0xC000023A, "STATUS_CONNECTION_INVALID", "STATUS_CONNECTION_INVALID"},
+	0xC000023B: {0xC000023B, "STATUS_CONNECTION_ACTIVE", "STATUS_CONNECTION_ACTIVE"},
+	0xC000023C: {0xC000023C, "STATUS_NETWORK_UNREACHABLE", "STATUS_NETWORK_UNREACHABLE"},
+	0xC000023D: {0xC000023D, "STATUS_HOST_UNREACHABLE", "STATUS_HOST_UNREACHABLE"},
+	0xC000023E: {0xC000023E, "STATUS_PROTOCOL_UNREACHABLE", "STATUS_PROTOCOL_UNREACHABLE"},
+	0xC000023F: {0xC000023F, "STATUS_PORT_UNREACHABLE", "STATUS_PORT_UNREACHABLE"},
+	0xC0000240: {0xC0000240, "STATUS_REQUEST_ABORTED", "The request was aborted."},
+	0xC0000241: {0xC0000241, "STATUS_CONNECTION_ABORTED", "The transport connection was aborted by the local system."},
+	0xC0000242: {0xC0000242, "STATUS_BAD_COMPRESSION_BUFFER", "STATUS_BAD_COMPRESSION_BUFFER"},
+	0xC0000243: {0xC0000243, "STATUS_USER_MAPPED_FILE", "The requested operation cannot be performed on a file with a user mapped section open."},
+	0xC0000244: {0xC0000244, "STATUS_AUDIT_FAILED", "STATUS_AUDIT_FAILED"},
+	0xC0000245: {0xC0000245, "STATUS_TIMER_RESOLUTION_NOT_SET", "STATUS_TIMER_RESOLUTION_NOT_SET"},
+	0xC0000246: {0xC0000246, "STATUS_CONNECTION_COUNT_LIMIT", "STATUS_CONNECTION_COUNT_LIMIT"},
+	0xC0000247: {0xC0000247, "STATUS_LOGIN_TIME_RESTRICTION", "STATUS_LOGIN_TIME_RESTRICTION"},
+	0xC0000248: {0xC0000248, "STATUS_LOGIN_WKSTA_RESTRICTION", "STATUS_LOGIN_WKSTA_RESTRICTION"},
+	0xC0000249: {0xC0000249, "STATUS_IMAGE_MP_UP_MISMATCH", "STATUS_IMAGE_MP_UP_MISMATCH"},
+	0xC0000250: {0xC0000250, "STATUS_INSUFFICIENT_LOGON_INFO", "STATUS_INSUFFICIENT_LOGON_INFO"},
+	0xC0000251: {0xC0000251, "STATUS_BAD_DLL_ENTRYPOINT", "STATUS_BAD_DLL_ENTRYPOINT"},
+	0xC0000252: {0xC0000252, "STATUS_BAD_SERVICE_ENTRYPOINT", "STATUS_BAD_SERVICE_ENTRYPOINT"},
+	0xC0000253: {0xC0000253, "STATUS_LPC_REPLY_LOST", "STATUS_LPC_REPLY_LOST"},
+	0xC0000254: {0xC0000254, "STATUS_IP_ADDRESS_CONFLICT1", "STATUS_IP_ADDRESS_CONFLICT1"},
+	0xC0000255: {0xC0000255, "STATUS_IP_ADDRESS_CONFLICT2", "STATUS_IP_ADDRESS_CONFLICT2"},
+	0xC0000256: {0xC0000256, "STATUS_REGISTRY_QUOTA_LIMIT", "STATUS_REGISTRY_QUOTA_LIMIT"},
+	0xC0000257: {0xC0000257, "STATUS_PATH_NOT_COVERED", "STATUS_PATH_NOT_COVERED"},
+	0xC0000258: {0xC0000258, "STATUS_NO_CALLBACK_ACTIVE", "STATUS_NO_CALLBACK_ACTIVE"},
+	0xC0000259: {0xC0000259, "STATUS_LICENSE_QUOTA_EXCEEDED", "STATUS_LICENSE_QUOTA_EXCEEDED"},
+	0xC000025A: {0xC000025A, "STATUS_PWD_TOO_SHORT", "STATUS_PWD_TOO_SHORT"},
+	0xC000025B: {0xC000025B, "STATUS_PWD_TOO_RECENT", "STATUS_PWD_TOO_RECENT"},
+	0xC000025C: {0xC000025C, "STATUS_PWD_HISTORY_CONFLICT", "STATUS_PWD_HISTORY_CONFLICT"},
+	0xC000025E: {0xC000025E, "STATUS_PLUGPLAY_NO_DEVICE", "STATUS_PLUGPLAY_NO_DEVICE"},
+	0xC000025F: {0xC000025F, "STATUS_UNSUPPORTED_COMPRESSION", "STATUS_UNSUPPORTED_COMPRESSION"},
+	0xC0000260: {0xC0000260, "STATUS_INVALID_HW_PROFILE", "STATUS_INVALID_HW_PROFILE"},
+	0xC0000261: {0xC0000261, "STATUS_INVALID_PLUGPLAY_DEVICE_PATH", "STATUS_INVALID_PLUGPLAY_DEVICE_PATH"},
+	0xC0000262: {0xC0000262, "STATUS_DRIVER_ORDINAL_NOT_FOUND", "STATUS_DRIVER_ORDINAL_NOT_FOUND"},
+	0xC0000263: {0xC0000263, "STATUS_DRIVER_ENTRYPOINT_NOT_FOUND", "{Driver Entry Point Not Found} The %hs device driver could not locate the entry point %hs in driver %hs."},
+	0xC0000264: {0xC0000264, "STATUS_RESOURCE_NOT_OWNED", "{Insufficient Rights} The caller attempted to release a resource that it does not own."},
+	0xC0000265: {0xC0000265, "STATUS_TOO_MANY_LINKS", "STATUS_TOO_MANY_LINKS"},
+	0xC0000266: {0xC0000266, "STATUS_QUOTA_LIST_INCONSISTENT", "STATUS_QUOTA_LIST_INCONSISTENT"},
+	0xC0000267: {0xC0000267, "STATUS_FILE_IS_OFFLINE", "STATUS_FILE_IS_OFFLINE"},
+	0xC0000268: {0xC0000268, "STATUS_EVALUATION_EXPIRATION", "STATUS_EVALUATION_EXPIRATION"},
+	0xC0000269: {0xC0000269, "STATUS_ILLEGAL_DLL_RELOCATION", "STATUS_ILLEGAL_DLL_RELOCATION"},
+	0xC000026A: {0xC000026A, "STATUS_LICENSE_VIOLATION", "STATUS_LICENSE_VIOLATION"},
+	0xC000026B: {0xC000026B, "STATUS_DLL_INIT_FAILED_LOGOFF", "STATUS_DLL_INIT_FAILED_LOGOFF"},
+	0xC000026C: {0xC000026C, "STATUS_DRIVER_UNABLE_TO_LOAD", "The driver could not be loaded."},
+	0xC000026D: {0xC000026D, "STATUS_DFS_UNAVAILABLE", "STATUS_DFS_UNAVAILABLE"},
+	0xC000026E: {0xC000026E, "STATUS_VOLUME_DISMOUNTED", "An operation was attempted to a volume after it was dismounted."},
+	0xC000026F: {0xC000026F, "STATUS_WX86_INTERNAL_ERROR", "STATUS_WX86_INTERNAL_ERROR"},
+	0xC0000270: {0xC0000270, "STATUS_WX86_FLOAT_STACK_CHECK", "STATUS_WX86_FLOAT_STACK_CHECK"},
+	0xC0000271: {0xC0000271, "STATUS_VALIDATE_CONTINUE", "STATUS_VALIDATE_CONTINUE"},
+	0xC0000272: {0xC0000272, "STATUS_NO_MATCH", "STATUS_NO_MATCH"},
+	0xC0000273: {0xC0000273, "STATUS_NO_MORE_MATCHES", "STATUS_NO_MORE_MATCHES"},
+	0xC0000275: {0xC0000275, "STATUS_NOT_A_REPARSE_POINT", "The NTFS file or directory is not a reparse point."},
+	0xC0000276: {0xC0000276, "STATUS_IO_REPARSE_TAG_INVALID", "STATUS_IO_REPARSE_TAG_INVALID"},
+	0xC0000277: {0xC0000277, "STATUS_IO_REPARSE_TAG_MISMATCH", "STATUS_IO_REPARSE_TAG_MISMATCH"},
+	0xC0000278: {0xC0000278, "STATUS_IO_REPARSE_DATA_INVALID", "STATUS_IO_REPARSE_DATA_INVALID"},
+	0xC0000279: {0xC0000279, "STATUS_IO_REPARSE_TAG_NOT_HANDLED", "STATUS_IO_REPARSE_TAG_NOT_HANDLED"},
+	0xC000027A: {0xC000027A, "STATUS_PWD_TOO_LONG", "STATUS_PWD_TOO_LONG"},
+	0xC000027B: {0xC000027B, "STATUS_STOWED_EXCEPTION", "STATUS_STOWED_EXCEPTION"},
+	0xC000027C: {0xC000027C, "STATUS_CONTEXT_STOWED_EXCEPTION", "STATUS_CONTEXT_STOWED_EXCEPTION"},
+	0xC0000280: {0xC0000280, "STATUS_REPARSE_POINT_NOT_RESOLVED", "The reparse point could not be resolved."},
+	0xC0000281: {0xC0000281, "STATUS_DIRECTORY_IS_A_REPARSE_POINT", "STATUS_DIRECTORY_IS_A_REPARSE_POINT"},
+	0xC0000282: {0xC0000282, "STATUS_RANGE_LIST_CONFLICT", "STATUS_RANGE_LIST_CONFLICT"},
+	0xC0000283: {0xC0000283, "STATUS_SOURCE_ELEMENT_EMPTY", "STATUS_SOURCE_ELEMENT_EMPTY"},
+	0xC0000284: {0xC0000284, "STATUS_DESTINATION_ELEMENT_FULL", "STATUS_DESTINATION_ELEMENT_FULL"},
+	0xC0000285: {0xC0000285, "STATUS_ILLEGAL_ELEMENT_ADDRESS", "STATUS_ILLEGAL_ELEMENT_ADDRESS"},
+	0xC0000286: {0xC0000286, "STATUS_MAGAZINE_NOT_PRESENT", "STATUS_MAGAZINE_NOT_PRESENT"},
+	0xC0000287: {0xC0000287, "STATUS_REINITIALIZATION_NEEDED", "STATUS_REINITIALIZATION_NEEDED"},
+	0x80000288: {0x80000288, "STATUS_DEVICE_REQUIRES_CLEANING", "The device has indicated that cleaning is necessary."},
+	0x80000289: {0x80000289, "STATUS_DEVICE_DOOR_OPEN", "The device has indicated that its door is open. Further operations require it closed and secured."},
+	0xC000028A: {0xC000028A, "STATUS_ENCRYPTION_FAILED", "The requested encryption operation failed."},
+	0xC000028B: {0xC000028B, "STATUS_DECRYPTION_FAILED", "STATUS_DECRYPTION_FAILED"},
+	0xC000028C: {0xC000028C, "STATUS_RANGE_NOT_FOUND", "STATUS_RANGE_NOT_FOUND"},
+	0xC000028D: {0xC000028D, "STATUS_NO_RECOVERY_POLICY", "STATUS_NO_RECOVERY_POLICY"},
+	0xC000028E: {0xC000028E, "STATUS_NO_EFS", "STATUS_NO_EFS"},
+	0xC000028F: {0xC000028F, "STATUS_WRONG_EFS", "STATUS_WRONG_EFS"},
+	0xC0000290: {0xC0000290, "STATUS_NO_USER_KEYS", "STATUS_NO_USER_KEYS"},
+	0xC0000291: {0xC0000291, "STATUS_FILE_NOT_ENCRYPTED", "STATUS_FILE_NOT_ENCRYPTED"},
+	0xC0000292: {0xC0000292, "STATUS_NOT_EXPORT_FORMAT", "STATUS_NOT_EXPORT_FORMAT"},
+	0xC0000293: {0xC0000293, "STATUS_FILE_ENCRYPTED", "STATUS_FILE_ENCRYPTED"},
+	0x40000294: {0x40000294, "STATUS_WAKE_SYSTEM", "The system has awoken."},
+	0xC0000295: {0xC0000295, "STATUS_WMI_GUID_NOT_FOUND", "STATUS_WMI_GUID_NOT_FOUND"},
+	0xC0000296: {0xC0000296, "STATUS_WMI_INSTANCE_NOT_FOUND", "STATUS_WMI_INSTANCE_NOT_FOUND"},
+	0xC0000297: {0xC0000297, "STATUS_WMI_ITEMID_NOT_FOUND", "STATUS_WMI_ITEMID_NOT_FOUND"},
+	0xC0000298: {0xC0000298, "STATUS_WMI_TRY_AGAIN", "STATUS_WMI_TRY_AGAIN"},
+	0xC0000299: {0xC0000299, "STATUS_SHARED_POLICY", "STATUS_SHARED_POLICY"},
+	0xC000029A: {0xC000029A, "STATUS_POLICY_OBJECT_NOT_FOUND", "STATUS_POLICY_OBJECT_NOT_FOUND"},
+	0xC000029B: {0xC000029B, "STATUS_POLICY_ONLY_IN_DS", "STATUS_POLICY_ONLY_IN_DS"},
+	0xC000029C: {0xC000029C, "STATUS_VOLUME_NOT_UPGRADED", "STATUS_VOLUME_NOT_UPGRADED"},
+	0xC000029D: {0xC000029D, "STATUS_REMOTE_STORAGE_NOT_ACTIVE", "STATUS_REMOTE_STORAGE_NOT_ACTIVE"},
+	0xC000029E: {0xC000029E, "STATUS_REMOTE_STORAGE_MEDIA_ERROR", "STATUS_REMOTE_STORAGE_MEDIA_ERROR"},
+	0xC000029F: {0xC000029F, "STATUS_NO_TRACKING_SERVICE", "STATUS_NO_TRACKING_SERVICE"},
+	0xC00002A0: {0xC00002A0, "STATUS_SERVER_SID_MISMATCH", "STATUS_SERVER_SID_MISMATCH"},
+	0xC00002A1: {0xC00002A1, "STATUS_DS_NO_ATTRIBUTE_OR_VALUE", "STATUS_DS_NO_ATTRIBUTE_OR_VALUE"},
+	0xC00002A2: {0xC00002A2, "STATUS_DS_INVALID_ATTRIBUTE_SYNTAX", "STATUS_DS_INVALID_ATTRIBUTE_SYNTAX"},
+	0xC00002A3: {0xC00002A3, "STATUS_DS_ATTRIBUTE_TYPE_UNDEFINED", "STATUS_DS_ATTRIBUTE_TYPE_UNDEFINED"},
+	0xC00002A4: {0xC00002A4, "STATUS_DS_ATTRIBUTE_OR_VALUE_EXISTS", "STATUS_DS_ATTRIBUTE_OR_VALUE_EXISTS"},
+	0xC00002A5: {0xC00002A5, "STATUS_DS_BUSY", "STATUS_DS_BUSY"},
+	0xC00002A6: {0xC00002A6, "STATUS_DS_UNAVAILABLE", "STATUS_DS_UNAVAILABLE"},
+	0xC00002A7: {0xC00002A7, "STATUS_DS_NO_RIDS_ALLOCATED", "STATUS_DS_NO_RIDS_ALLOCATED"},
+	0xC00002A8: {0xC00002A8, "STATUS_DS_NO_MORE_RIDS", "STATUS_DS_NO_MORE_RIDS"},
+	0xC00002A9: {0xC00002A9, "STATUS_DS_INCORRECT_ROLE_OWNER", "STATUS_DS_INCORRECT_ROLE_OWNER"},
+	0xC00002AA: {0xC00002AA, "STATUS_DS_RIDMGR_INIT_ERROR", "STATUS_DS_RIDMGR_INIT_ERROR"},
+	0xC00002AB: {0xC00002AB, "STATUS_DS_OBJ_CLASS_VIOLATION", "STATUS_DS_OBJ_CLASS_VIOLATION"},
+	0xC00002AC: {0xC00002AC, "STATUS_DS_CANT_ON_NON_LEAF", "STATUS_DS_CANT_ON_NON_LEAF"},
+	0xC00002AD: {0xC00002AD, "STATUS_DS_CANT_ON_RDN", "STATUS_DS_CANT_ON_RDN"},
+	0xC00002AE: {0xC00002AE, "STATUS_DS_CANT_MOD_OBJ_CLASS", "STATUS_DS_CANT_MOD_OBJ_CLASS"},
+	0xC00002AF: {0xC00002AF, "STATUS_DS_CROSS_DOM_MOVE_FAILED", "STATUS_DS_CROSS_DOM_MOVE_FAILED"},
+	0xC00002B0: {0xC00002B0, "STATUS_DS_GC_NOT_AVAILABLE", "STATUS_DS_GC_NOT_AVAILABLE"},
+	0xC00002B1: {0xC00002B1, "STATUS_DIRECTORY_SERVICE_REQUIRED", "STATUS_DIRECTORY_SERVICE_REQUIRED"},
+	0xC00002B2: {0xC00002B2, "STATUS_REPARSE_ATTRIBUTE_CONFLICT", "STATUS_REPARSE_ATTRIBUTE_CONFLICT"},
+	0xC00002B3: {0xC00002B3, "STATUS_CANT_ENABLE_DENY_ONLY", "STATUS_CANT_ENABLE_DENY_ONLY"},
+	0xC00002B4: {0xC00002B4, "STATUS_FLOAT_MULTIPLE_FAULTS", "{EXCEPTION} Multiple floating-point faults."},
+	0xC00002B5: {0xC00002B5, "STATUS_FLOAT_MULTIPLE_TRAPS", "{EXCEPTION} Multiple floating-point traps."},
+	0xC00002B6: {0xC00002B6, "STATUS_DEVICE_REMOVED", "STATUS_DEVICE_REMOVED"},
+	0xC00002B7: {0xC00002B7, "STATUS_JOURNAL_DELETE_IN_PROGRESS", "STATUS_JOURNAL_DELETE_IN_PROGRESS"},
+	0xC00002B8: {0xC00002B8, "STATUS_JOURNAL_NOT_ACTIVE", "STATUS_JOURNAL_NOT_ACTIVE"},
+	0xC00002B9: {0xC00002B9, "STATUS_NOINTERFACE", "STATUS_NOINTERFACE"},
+	0xC00002BA: {0xC00002BA, "STATUS_DS_RIDMGR_DISABLED", "STATUS_DS_RIDMGR_DISABLED"},
+	0xC00002C1: {0xC00002C1, "STATUS_DS_ADMIN_LIMIT_EXCEEDED", "STATUS_DS_ADMIN_LIMIT_EXCEEDED"},
+	0xC00002C2: {0xC00002C2, "STATUS_DRIVER_FAILED_SLEEP", "STATUS_DRIVER_FAILED_SLEEP"},
+	0xC00002C3: {0xC00002C3, "STATUS_MUTUAL_AUTHENTICATION_FAILED", "STATUS_MUTUAL_AUTHENTICATION_FAILED"},
+	0xC00002C4: {0xC00002C4, "STATUS_CORRUPT_SYSTEM_FILE", "STATUS_CORRUPT_SYSTEM_FILE"},
+	0xC00002C5: {0xC00002C5, "STATUS_DATATYPE_MISALIGNMENT_ERROR", "{EXCEPTION} Alignment Error A data type misalignment error was detected in a load or store instruction."},
+	0xC00002C6: {0xC00002C6, "STATUS_WMI_READ_ONLY", "STATUS_WMI_READ_ONLY"},
+	0xC00002C7: {0xC00002C7, "STATUS_WMI_SET_FAILURE", "STATUS_WMI_SET_FAILURE"},
+	0xC00002C8: {0xC00002C8, "STATUS_COMMITMENT_MINIMUM", "STATUS_COMMITMENT_MINIMUM"},
+	0xC00002C9: {0xC00002C9, "STATUS_REG_NAT_CONSUMPTION", "{EXCEPTION} Register NaT consumption faults. A NaT value is consumed on a non-speculative instruction."},
+	0xC00002CA: {0xC00002CA, "STATUS_TRANSPORT_FULL", "STATUS_TRANSPORT_FULL"},
+	0xC00002CB: {0xC00002CB, "STATUS_DS_SAM_INIT_FAILURE", "STATUS_DS_SAM_INIT_FAILURE"},
+	0xC00002CC: {0xC00002CC, "STATUS_ONLY_IF_CONNECTED", "STATUS_ONLY_IF_CONNECTED"},
+	0xC00002CD: {0xC00002CD, "STATUS_DS_SENSITIVE_GROUP_VIOLATION", "STATUS_DS_SENSITIVE_GROUP_VIOLATION"},
+	0xC00002CE: {0xC00002CE, "STATUS_PNP_RESTART_ENUMERATION", "STATUS_PNP_RESTART_ENUMERATION"},
+	0xC00002CF: {0xC00002CF, "STATUS_JOURNAL_ENTRY_DELETED", "STATUS_JOURNAL_ENTRY_DELETED"},
+	0xC00002D0: {0xC00002D0, "STATUS_DS_CANT_MOD_PRIMARYGROUPID", "STATUS_DS_CANT_MOD_PRIMARYGROUPID"},
+	0xC00002D1: {0xC00002D1, "STATUS_SYSTEM_IMAGE_BAD_SIGNATURE", "STATUS_SYSTEM_IMAGE_BAD_SIGNATURE"},
+	0xC00002D2: {0xC00002D2, "STATUS_PNP_REBOOT_REQUIRED", "STATUS_PNP_REBOOT_REQUIRED"},
+	0xC00002D3: {0xC00002D3, "STATUS_POWER_STATE_INVALID", "STATUS_POWER_STATE_INVALID"},
+	0xC00002D4: {0xC00002D4, "STATUS_DS_INVALID_GROUP_TYPE", "STATUS_DS_INVALID_GROUP_TYPE"},
+	0xC00002D5: {0xC00002D5, "STATUS_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN", "STATUS_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN"},
+	0xC00002D6: {0xC00002D6, "STATUS_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN", "STATUS_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN"},
+	0xC00002D7: {0xC00002D7, "STATUS_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER", "STATUS_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER"},
+	0xC00002D8: {0xC00002D8, "STATUS_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER", "STATUS_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER"},
+	0xC00002D9: {0xC00002D9, "STATUS_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER", "STATUS_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER"},
+	0xC00002DA: {0xC00002DA, "STATUS_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER", "STATUS_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER"},
+	0xC00002DB: {0xC00002DB, "STATUS_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER", "STATUS_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER"},
+	0xC00002DC: {0xC00002DC, "STATUS_DS_HAVE_PRIMARY_MEMBERS", "STATUS_DS_HAVE_PRIMARY_MEMBERS"},
+	0xC00002DD: {0xC00002DD, "STATUS_WMI_NOT_SUPPORTED", "STATUS_WMI_NOT_SUPPORTED"},
+	0xC00002DE: {0xC00002DE, "STATUS_INSUFFICIENT_POWER", "STATUS_INSUFFICIENT_POWER"},
+	0xC00002DF: {0xC00002DF, "STATUS_SAM_NEED_BOOTKEY_PASSWORD", "STATUS_SAM_NEED_BOOTKEY_PASSWORD"},
+	0xC00002E0: {0xC00002E0, "STATUS_SAM_NEED_BOOTKEY_FLOPPY", "STATUS_SAM_NEED_BOOTKEY_FLOPPY"},
+	0xC00002E1: {0xC00002E1, "STATUS_DS_CANT_START", "STATUS_DS_CANT_START"},
+	0xC00002E2: {0xC00002E2, "STATUS_DS_INIT_FAILURE", "STATUS_DS_INIT_FAILURE"},
+	0xC00002E3: {0xC00002E3, "STATUS_SAM_INIT_FAILURE", "STATUS_SAM_INIT_FAILURE"},
+	0xC00002E4: {0xC00002E4, "STATUS_DS_GC_REQUIRED", "STATUS_DS_GC_REQUIRED"},
+	0xC00002E5: {0xC00002E5, "STATUS_DS_LOCAL_MEMBER_OF_LOCAL_ONLY", "STATUS_DS_LOCAL_MEMBER_OF_LOCAL_ONLY"},
+	0xC00002E6: {0xC00002E6, "STATUS_DS_NO_FPO_IN_UNIVERSAL_GROUPS", "STATUS_DS_NO_FPO_IN_UNIVERSAL_GROUPS"},
+	0xC00002E7: {0xC00002E7, "STATUS_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED", "STATUS_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED"},
+	0xC00002E8: {0xC00002E8, "STATUS_MULTIPLE_FAULT_VIOLATION", "STATUS_MULTIPLE_FAULT_VIOLATION"},
+	0xC00002E9: {0xC00002E9, "STATUS_CURRENT_DOMAIN_NOT_ALLOWED", "STATUS_CURRENT_DOMAIN_NOT_ALLOWED"},
+	0xC00002EA: {0xC00002EA, "STATUS_CANNOT_MAKE", "STATUS_CANNOT_MAKE"},
+	0xC00002EB: {0xC00002EB, "STATUS_SYSTEM_SHUTDOWN", "STATUS_SYSTEM_SHUTDOWN"},
+	0xC00002EC: {0xC00002EC, "STATUS_DS_INIT_FAILURE_CONSOLE", "STATUS_DS_INIT_FAILURE_CONSOLE"},
+	0xC00002ED: {0xC00002ED, "STATUS_DS_SAM_INIT_FAILURE_CONSOLE", "STATUS_DS_SAM_INIT_FAILURE_CONSOLE"},
+	0xC00002EE: {0xC00002EE, "STATUS_UNFINISHED_CONTEXT_DELETED", "STATUS_UNFINISHED_CONTEXT_DELETED"},
+	0xC00002EF: {0xC00002EF, "STATUS_NO_TGT_REPLY", "STATUS_NO_TGT_REPLY"},
+	0xC00002F0: {0xC00002F0, "STATUS_OBJECTID_NOT_FOUND", "STATUS_OBJECTID_NOT_FOUND"},
+	0xC00002F1: {0xC00002F1, "STATUS_NO_IP_ADDRESSES", "STATUS_NO_IP_ADDRESSES"},
+	0xC00002F2: {0xC00002F2, "STATUS_WRONG_CREDENTIAL_HANDLE", "STATUS_WRONG_CREDENTIAL_HANDLE"},
+	0xC00002F3: {0xC00002F3, "STATUS_CRYPTO_SYSTEM_INVALID", "STATUS_CRYPTO_SYSTEM_INVALID"},
+	0xC00002F4: {0xC00002F4, "STATUS_MAX_REFERRALS_EXCEEDED", "STATUS_MAX_REFERRALS_EXCEEDED"},
+	0xC00002F5: {0xC00002F5, "STATUS_MUST_BE_KDC", "STATUS_MUST_BE_KDC"},
+	0xC00002F6: {0xC00002F6, "STATUS_STRONG_CRYPTO_NOT_SUPPORTED", "STATUS_STRONG_CRYPTO_NOT_SUPPORTED"},
+	0xC00002F7: {0xC00002F7, "STATUS_TOO_MANY_PRINCIPALS", "STATUS_TOO_MANY_PRINCIPALS"},
+	0xC00002F8: {0xC00002F8, "STATUS_NO_PA_DATA", "STATUS_NO_PA_DATA"},
+	0xC00002F9: {0xC00002F9, "STATUS_PKINIT_NAME_MISMATCH", "STATUS_PKINIT_NAME_MISMATCH"},
+	0xC00002FA: {0xC00002FA, "STATUS_SMARTCARD_LOGON_REQUIRED", "STATUS_SMARTCARD_LOGON_REQUIRED"},
+	0xC00002FB: {0xC00002FB, "STATUS_KDC_INVALID_REQUEST", "STATUS_KDC_INVALID_REQUEST"},
+	0xC00002FC: {0xC00002FC, "STATUS_KDC_UNABLE_TO_REFER", "STATUS_KDC_UNABLE_TO_REFER"},
+	0xC00002FD: {0xC00002FD, "STATUS_KDC_UNKNOWN_ETYPE", "STATUS_KDC_UNKNOWN_ETYPE"},
+	0xC00002FE: {0xC00002FE, "STATUS_SHUTDOWN_IN_PROGRESS", "STATUS_SHUTDOWN_IN_PROGRESS"},
+	0xC00002FF: {0xC00002FF, "STATUS_SERVER_SHUTDOWN_IN_PROGRESS", "STATUS_SERVER_SHUTDOWN_IN_PROGRESS"},
+	0xC0000300: {0xC0000300, "STATUS_NOT_SUPPORTED_ON_SBS", "STATUS_NOT_SUPPORTED_ON_SBS"},
+	0xC0000301: {0xC0000301, "STATUS_WMI_GUID_DISCONNECTED", "STATUS_WMI_GUID_DISCONNECTED"},
+	0xC0000302: {0xC0000302, "STATUS_WMI_ALREADY_DISABLED", "STATUS_WMI_ALREADY_DISABLED"},
+	0xC0000303: {0xC0000303, "STATUS_WMI_ALREADY_ENABLED", "STATUS_WMI_ALREADY_ENABLED"},
+	0xC0000304: {0xC0000304, "STATUS_MFT_TOO_FRAGMENTED", "The file could not be opened because the file's MFT is too fragmented."},
+	0xC0000305: {0xC0000305, "STATUS_COPY_PROTECTION_FAILURE", "STATUS_COPY_PROTECTION_FAILURE"},
+	0xC0000306: {0xC0000306, "STATUS_CSS_AUTHENTICATION_FAILURE", "STATUS_CSS_AUTHENTICATION_FAILURE"},
+	0xC0000307: {0xC0000307, "STATUS_CSS_KEY_NOT_PRESENT", "STATUS_CSS_KEY_NOT_PRESENT"},
+	0xC0000308: {0xC0000308, "STATUS_CSS_KEY_NOT_ESTABLISHED", "STATUS_CSS_KEY_NOT_ESTABLISHED"},
+	0xC0000309: {0xC0000309, "STATUS_CSS_SCRAMBLED_SECTOR", "STATUS_CSS_SCRAMBLED_SECTOR"},
+	0xC000030A: {0xC000030A, "STATUS_CSS_REGION_MISMATCH", "STATUS_CSS_REGION_MISMATCH"},
+	0xC000030B: {0xC000030B, "STATUS_CSS_RESETS_EXHAUSTED", "STATUS_CSS_RESETS_EXHAUSTED"},
+	0xC000030C: {0xC000030C, "STATUS_PASSWORD_CHANGE_REQUIRED", "STATUS_PASSWORD_CHANGE_REQUIRED"},
+	0xC000030D: {0xC000030D, "STATUS_LOST_MODE_LOGON_RESTRICTION", "STATUS_LOST_MODE_LOGON_RESTRICTION"},
+	0xC0000320: {0xC0000320, "STATUS_PKINIT_FAILURE", "STATUS_PKINIT_FAILURE"},
+	0xC0000321: {0xC0000321, "STATUS_SMARTCARD_SUBSYSTEM_FAILURE", "STATUS_SMARTCARD_SUBSYSTEM_FAILURE"},
+	0xC0000322: {0xC0000322, "STATUS_NO_KERB_KEY", "STATUS_NO_KERB_KEY"},
+	0xC0000350: {0xC0000350, "STATUS_HOST_DOWN", "STATUS_HOST_DOWN"},
+	0xC0000351: {0xC0000351, "STATUS_UNSUPPORTED_PREAUTH", "STATUS_UNSUPPORTED_PREAUTH"},
+	0xC0000352: {0xC0000352, "STATUS_EFS_ALG_BLOB_TOO_BIG", "STATUS_EFS_ALG_BLOB_TOO_BIG"},
+	0xC0000353: {0xC0000353, "STATUS_PORT_NOT_SET", "STATUS_PORT_NOT_SET"},
+	0xC0000354: {0xC0000354, "STATUS_DEBUGGER_INACTIVE", "An attempt to do an operation on a debug port failed because the port is in the process of being deleted."},
+	0xC0000355: {0xC0000355, "STATUS_DS_VERSION_CHECK_FAILURE", "STATUS_DS_VERSION_CHECK_FAILURE"},
+	0xC0000356: {0xC0000356, "STATUS_AUDITING_DISABLED", "STATUS_AUDITING_DISABLED"},
+	0xC0000357: {0xC0000357, "STATUS_PRENT4_MACHINE_ACCOUNT", "STATUS_PRENT4_MACHINE_ACCOUNT"},
+	0xC0000358: {0xC0000358, "STATUS_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER", "STATUS_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER"},
+	0xC0000359: {0xC0000359, "STATUS_INVALID_IMAGE_WIN_32", "STATUS_INVALID_IMAGE_WIN_32"},
+	0xC000035A: {0xC000035A, "STATUS_INVALID_IMAGE_WIN_64", "STATUS_INVALID_IMAGE_WIN_64"},
+	0xC000035B: {0xC000035B, "STATUS_BAD_BINDINGS", "STATUS_BAD_BINDINGS"},
+	0xC000035C: {0xC000035C, "STATUS_NETWORK_SESSION_EXPIRED", "The client session has expired; so the client must re-authenticate to continue accessing the remote resources."},
+	0xC000035D: {0xC000035D, "STATUS_APPHELP_BLOCK", "STATUS_APPHELP_BLOCK"},
+	0xC000035E: {0xC000035E, "STATUS_ALL_SIDS_FILTERED", "STATUS_ALL_SIDS_FILTERED"},
+	0xC000035F: {0xC000035F, "STATUS_NOT_SAFE_MODE_DRIVER", "STATUS_NOT_SAFE_MODE_DRIVER"},
+	0xC0000361: {0xC0000361, "STATUS_ACCESS_DISABLED_BY_POLICY_DEFAULT", "Access to %1 has been restricted by your Administrator by the default software restriction policy level."},
+	0xC0000362: {0xC0000362, "STATUS_ACCESS_DISABLED_BY_POLICY_PATH", "Access to %1 has been restricted by your Administrator by location with policy rule %2 placed on path %3."},
+	0xC0000363: {0xC0000363, "STATUS_ACCESS_DISABLED_BY_POLICY_PUBLISHER", "STATUS_ACCESS_DISABLED_BY_POLICY_PUBLISHER"},
+	0xC0000364: {0xC0000364, "STATUS_ACCESS_DISABLED_BY_POLICY_OTHER", "STATUS_ACCESS_DISABLED_BY_POLICY_OTHER"},
+	0xC0000365: {0xC0000365, "STATUS_FAILED_DRIVER_ENTRY", "STATUS_FAILED_DRIVER_ENTRY"},
+	0xC0000366: {0xC0000366, "STATUS_DEVICE_ENUMERATION_ERROR", "STATUS_DEVICE_ENUMERATION_ERROR"},
+	0xC0000368: {0xC0000368, "STATUS_MOUNT_POINT_NOT_RESOLVED", "STATUS_MOUNT_POINT_NOT_RESOLVED"},
+	0xC0000369: {0xC0000369, "STATUS_INVALID_DEVICE_OBJECT_PARAMETER", "STATUS_INVALID_DEVICE_OBJECT_PARAMETER"},
+	0xC000036A: {0xC000036A, "STATUS_MCA_OCCURED", "STATUS_MCA_OCCURED"},
+	0xC000036B: {0xC000036B, "STATUS_DRIVER_BLOCKED_CRITICAL", "STATUS_DRIVER_BLOCKED_CRITICAL"},
+	0xC000036C: {0xC000036C, "STATUS_DRIVER_BLOCKED", "STATUS_DRIVER_BLOCKED"},
+	0xC000036D: {0xC000036D, "STATUS_DRIVER_DATABASE_ERROR", "STATUS_DRIVER_DATABASE_ERROR"},
+	0xC000036E: {0xC000036E, "STATUS_SYSTEM_HIVE_TOO_LARGE", "STATUS_SYSTEM_HIVE_TOO_LARGE"},
+	0xC000036F: {0xC000036F, "STATUS_INVALID_IMPORT_OF_NON_DLL", "An attempt was made to load a non-DLL image as an import."},
+	0x40000370: {0x40000370, "STATUS_DS_SHUTTING_DOWN", "STATUS_DS_SHUTTING_DOWN"},
+	0xC0000371: {0xC0000371, "STATUS_NO_SECRETS", "STATUS_NO_SECRETS"},
+	0xC0000372: {0xC0000372, "STATUS_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY", "STATUS_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY"},
+	0xC0000373: {0xC0000373, "STATUS_FAILED_STACK_SWITCH", "STATUS_FAILED_STACK_SWITCH"},
+	0xC0000374: {0xC0000374, "STATUS_HEAP_CORRUPTION", "A heap has been corrupted."},
+	0xC0000380: {0xC0000380, "STATUS_SMARTCARD_WRONG_PIN", "STATUS_SMARTCARD_WRONG_PIN"},
+	0xC0000381: {0xC0000381, "STATUS_SMARTCARD_CARD_BLOCKED", "STATUS_SMARTCARD_CARD_BLOCKED"},
+	0xC0000382: {0xC0000382, "STATUS_SMARTCARD_CARD_NOT_AUTHENTICATED", "STATUS_SMARTCARD_CARD_NOT_AUTHENTICATED"},
+	0xC0000383: {0xC0000383, "STATUS_SMARTCARD_NO_CARD", "STATUS_SMARTCARD_NO_CARD"},
+	0xC0000384: {0xC0000384, "STATUS_SMARTCARD_NO_KEY_CONTAINER", "STATUS_SMARTCARD_NO_KEY_CONTAINER"},
+	0xC0000385: {0xC0000385, "STATUS_SMARTCARD_NO_CERTIFICATE", "STATUS_SMARTCARD_NO_CERTIFICATE"},
+	0xC0000386: {0xC0000386, "STATUS_SMARTCARD_NO_KEYSET", "STATUS_SMARTCARD_NO_KEYSET"},
+	0xC0000387: {0xC0000387, "STATUS_SMARTCARD_IO_ERROR", "STATUS_SMARTCARD_IO_ERROR"},
+	0xC0000388: {0xC0000388, "STATUS_DOWNGRADE_DETECTED", "The system detected a possible attempt to compromise security. Ensure that you can contact the server that authenticated you."},
+	0xC0000389: {0xC0000389, "STATUS_SMARTCARD_CERT_REVOKED", "STATUS_SMARTCARD_CERT_REVOKED"},
+	0xC000038A: {0xC000038A, "STATUS_ISSUING_CA_UNTRUSTED", "STATUS_ISSUING_CA_UNTRUSTED"},
+	0xC000038B: {0xC000038B, "STATUS_REVOCATION_OFFLINE_C", "STATUS_REVOCATION_OFFLINE_C"},
+	0xC000038C: {0xC000038C, "STATUS_PKINIT_CLIENT_FAILURE", "STATUS_PKINIT_CLIENT_FAILURE"},
+	0xC000038D: {0xC000038D, "STATUS_SMARTCARD_CERT_EXPIRED", "STATUS_SMARTCARD_CERT_EXPIRED"},
+	0xC000038E: {0xC000038E, "STATUS_DRIVER_FAILED_PRIOR_UNLOAD", "STATUS_DRIVER_FAILED_PRIOR_UNLOAD"},
+	0xC000038F: {0xC000038F, "STATUS_SMARTCARD_SILENT_CONTEXT", "STATUS_SMARTCARD_SILENT_CONTEXT"},
+	0xC0000401: {0xC0000401, "STATUS_PER_USER_TRUST_QUOTA_EXCEEDED", "STATUS_PER_USER_TRUST_QUOTA_EXCEEDED"},
+	0xC0000402: {0xC0000402, "STATUS_ALL_USER_TRUST_QUOTA_EXCEEDED", "STATUS_ALL_USER_TRUST_QUOTA_EXCEEDED"},
+	0xC0000403: {0xC0000403, "STATUS_USER_DELETE_TRUST_QUOTA_EXCEEDED", "STATUS_USER_DELETE_TRUST_QUOTA_EXCEEDED"},
+	0xC0000404: {0xC0000404, "STATUS_DS_NAME_NOT_UNIQUE", "STATUS_DS_NAME_NOT_UNIQUE"},
+	0xC0000405: {0xC0000405, "STATUS_DS_DUPLICATE_ID_FOUND", "STATUS_DS_DUPLICATE_ID_FOUND"},
+	0xC0000406: {0xC0000406, "STATUS_DS_GROUP_CONVERSION_ERROR", "STATUS_DS_GROUP_CONVERSION_ERROR"},
+	0xC0000407: {0xC0000407, "STATUS_VOLSNAP_PREPARE_HIBERNATE", "STATUS_VOLSNAP_PREPARE_HIBERNATE"},
+	0xC0000408: {0xC0000408, "STATUS_USER2USER_REQUIRED", "STATUS_USER2USER_REQUIRED"},
+	0xC0000409: {0xC0000409, "STATUS_STACK_BUFFER_OVERRUN", "The system detected an overrun of a stack-based buffer in this application. This overrun could potentially allow a malicious user to gain control of this application."},
+	0xC000040A: {0xC000040A, "STATUS_NO_S4U_PROT_SUPPORT", "STATUS_NO_S4U_PROT_SUPPORT"},
+	0xC000040B: {0xC000040B, "STATUS_CROSSREALM_DELEGATION_FAILURE", "STATUS_CROSSREALM_DELEGATION_FAILURE"},
+	0xC000040C: {0xC000040C, "STATUS_REVOCATION_OFFLINE_KDC", "STATUS_REVOCATION_OFFLINE_KDC"},
+	0xC000040D: {0xC000040D, "STATUS_ISSUING_CA_UNTRUSTED_KDC", "STATUS_ISSUING_CA_UNTRUSTED_KDC"},
+	0xC000040E: {0xC000040E, "STATUS_KDC_CERT_EXPIRED", "STATUS_KDC_CERT_EXPIRED"},
+	0xC000040F: {0xC000040F, "STATUS_KDC_CERT_REVOKED", "STATUS_KDC_CERT_REVOKED"},
+	0xC0000410: {0xC0000410, "STATUS_PARAMETER_QUOTA_EXCEEDED", "STATUS_PARAMETER_QUOTA_EXCEEDED"},
+	0xC0000411: {0xC0000411, "STATUS_HIBERNATION_FAILURE", "STATUS_HIBERNATION_FAILURE"},
+	0xC0000412: {0xC0000412, "STATUS_DELAY_LOAD_FAILED", "STATUS_DELAY_LOAD_FAILED"},
+	0xC0000413: {0xC0000413, "STATUS_AUTHENTICATION_FIREWALL_FAILED", "STATUS_AUTHENTICATION_FIREWALL_FAILED"},
+	0xC0000414: {0xC0000414, "STATUS_VDM_DISALLOWED", "STATUS_VDM_DISALLOWED"},
+	0xC0000415: {0xC0000415, "STATUS_HUNG_DISPLAY_DRIVER_THREAD", "STATUS_HUNG_DISPLAY_DRIVER_THREAD"},
+	0xC0000416: {0xC0000416, "STATUS_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE", "STATUS_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE"},
+	0xC0000417: {0xC0000417, "STATUS_INVALID_CRUNTIME_PARAMETER", "An invalid parameter was passed to a C runtime function."},
+	0xC0000418: {0xC0000418, "STATUS_NTLM_BLOCKED", "STATUS_NTLM_BLOCKED"},
+	0xC0000419: {0xC0000419, "STATUS_DS_SRC_SID_EXISTS_IN_FOREST", "STATUS_DS_SRC_SID_EXISTS_IN_FOREST"},
+	0xC000041A: {0xC000041A, "STATUS_DS_DOMAIN_NAME_EXISTS_IN_FOREST", "STATUS_DS_DOMAIN_NAME_EXISTS_IN_FOREST"},
+	0xC000041B: {0xC000041B, "STATUS_DS_FLAT_NAME_EXISTS_IN_FOREST", "STATUS_DS_FLAT_NAME_EXISTS_IN_FOREST"},
+	0xC000041C: {0xC000041C, "STATUS_INVALID_USER_PRINCIPAL_NAME", "STATUS_INVALID_USER_PRINCIPAL_NAME"},
+	0xC000041D: {0xC000041D, "STATUS_FATAL_USER_CALLBACK_EXCEPTION", "STATUS_FATAL_USER_CALLBACK_EXCEPTION"},
+	0xC0000420: {0xC0000420, "STATUS_ASSERTION_FAILURE", "STATUS_ASSERTION_FAILURE"},
+	0xC0000421: {0xC0000421, "STATUS_VERIFIER_STOP", "STATUS_VERIFIER_STOP"},
+	0xC0000423: {0xC0000423, "STATUS_CALLBACK_POP_STACK", "STATUS_CALLBACK_POP_STACK"},
+	0xC0000424: {0xC0000424, "STATUS_INCOMPATIBLE_DRIVER_BLOCKED", "STATUS_INCOMPATIBLE_DRIVER_BLOCKED"},
+	0xC0000425: {0xC0000425, "STATUS_HIVE_UNLOADED", "STATUS_HIVE_UNLOADED"},
+	0xC0000426: {0xC0000426, "STATUS_COMPRESSION_DISABLED", "STATUS_COMPRESSION_DISABLED"},
+	0xC0000427: {0xC0000427, "STATUS_FILE_SYSTEM_LIMITATION", "STATUS_FILE_SYSTEM_LIMITATION"},
+	0xC0000428: {0xC0000428, "STATUS_INVALID_IMAGE_HASH", "Windows cannot verify the digital signature for this file. The signing certificate for this file has been revoked."},
+	0xC0000429: {0xC0000429, "STATUS_NOT_CAPABLE", "STATUS_NOT_CAPABLE"},
+	0xC000042A: {0xC000042A, "STATUS_REQUEST_OUT_OF_SEQUENCE", "STATUS_REQUEST_OUT_OF_SEQUENCE"},
+	0xC000042B: {0xC000042B, "STATUS_IMPLEMENTATION_LIMIT", "An operation attempted to exceed an implementation-defined limit."},
+	0xC000042C: {0xC000042C, "STATUS_ELEVATION_REQUIRED", "STATUS_ELEVATION_REQUIRED"},
+	0xC000042D: {0xC000042D, "STATUS_NO_SECURITY_CONTEXT", "STATUS_NO_SECURITY_CONTEXT"},
+	0xC000042F: {0xC000042F, "STATUS_PKU2U_CERT_FAILURE", "STATUS_PKU2U_CERT_FAILURE"},
+	0xC0000432: {0xC0000432, "STATUS_BEYOND_VDL", "STATUS_BEYOND_VDL"},
+	0xC0000433: {0xC0000433, "STATUS_ENCOUNTERED_WRITE_IN_PROGRESS", "STATUS_ENCOUNTERED_WRITE_IN_PROGRESS"},
+	0xC0000434: {0xC0000434, "STATUS_PTE_CHANGED", "STATUS_PTE_CHANGED"},
+	0xC0000435: {0xC0000435, "STATUS_PURGE_FAILED", "STATUS_PURGE_FAILED"},
+	0xC0000440: {0xC0000440, "STATUS_CRED_REQUIRES_CONFIRMATION", "STATUS_CRED_REQUIRES_CONFIRMATION"},
+	0xC0000441: {0xC0000441, "STATUS_CS_ENCRYPTION_INVALID_SERVER_RESPONSE", "STATUS_CS_ENCRYPTION_INVALID_SERVER_RESPONSE"},
+	0xC0000442: {0xC0000442, "STATUS_CS_ENCRYPTION_UNSUPPORTED_SERVER", "STATUS_CS_ENCRYPTION_UNSUPPORTED_SERVER"},
+	0xC0000443: {0xC0000443, "STATUS_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE", "STATUS_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE"},
+	0xC0000444: {0xC0000444, "STATUS_CS_ENCRYPTION_NEW_ENCRYPTED_FILE", "STATUS_CS_ENCRYPTION_NEW_ENCRYPTED_FILE"},
+	0xC0000445: {0xC0000445, "STATUS_CS_ENCRYPTION_FILE_NOT_CSE", "STATUS_CS_ENCRYPTION_FILE_NOT_CSE"},
+	0xC0000446: {0xC0000446, "STATUS_INVALID_LABEL", "STATUS_INVALID_LABEL"},
+	0xC0000450: {0xC0000450, "STATUS_DRIVER_PROCESS_TERMINATED", "STATUS_DRIVER_PROCESS_TERMINATED"},
+	0xC0000451: {0xC0000451, "STATUS_AMBIGUOUS_SYSTEM_DEVICE", "STATUS_AMBIGUOUS_SYSTEM_DEVICE"},
+	0xC0000452: {0xC0000452, "STATUS_SYSTEM_DEVICE_NOT_FOUND", "STATUS_SYSTEM_DEVICE_NOT_FOUND"},
+	0xC0000453: {0xC0000453, "STATUS_RESTART_BOOT_APPLICATION", "STATUS_RESTART_BOOT_APPLICATION"},
+	0xC0000454: {0xC0000454, "STATUS_INSUFFICIENT_NVRAM_RESOURCES", "STATUS_INSUFFICIENT_NVRAM_RESOURCES"},
+	0xC0000455: {0xC0000455, "STATUS_INVALID_SESSION", "STATUS_INVALID_SESSION"},
+	0xC0000456: {0xC0000456, "STATUS_THREAD_ALREADY_IN_SESSION", "The thread is already in a session."},
+	0xC0000457: {0xC0000457, "STATUS_THREAD_NOT_IN_SESSION", "STATUS_THREAD_NOT_IN_SESSION"},
+	0xC0000458: {0xC0000458, "STATUS_INVALID_WEIGHT", "STATUS_INVALID_WEIGHT"},
+	0xC0000459: {0xC0000459, "STATUS_REQUEST_PAUSED", "STATUS_REQUEST_PAUSED"},
+	0xC0000460: {0xC0000460, "STATUS_NO_RANGES_PROCESSED", "STATUS_NO_RANGES_PROCESSED"},
+	0xC0000461: {0xC0000461, "STATUS_DISK_RESOURCES_EXHAUSTED", "STATUS_DISK_RESOURCES_EXHAUSTED"},
+	0xC0000462: {0xC0000462, "STATUS_NEEDS_REMEDIATION", "STATUS_NEEDS_REMEDIATION"},
+	0xC0000463: {0xC0000463, "STATUS_DEVICE_FEATURE_NOT_SUPPORTED", "STATUS_DEVICE_FEATURE_NOT_SUPPORTED"},
+	0xC0000464: {0xC0000464, "STATUS_DEVICE_UNREACHABLE", "STATUS_DEVICE_UNREACHABLE"},
+	0xC0000465: {0xC0000465, "STATUS_INVALID_TOKEN", "STATUS_INVALID_TOKEN"},
+	0xC0000466: {0xC0000466, "STATUS_SERVER_UNAVAILABLE", "STATUS_SERVER_UNAVAILABLE"},
+	0xC0000467: {0xC0000467, "STATUS_FILE_NOT_AVAILABLE", "The file is temporarily unavailable."},
+	0xC0000468: {0xC0000468, "STATUS_DEVICE_INSUFFICIENT_RESOURCES", "STATUS_DEVICE_INSUFFICIENT_RESOURCES"},
+	0xC0000469: {0xC0000469, "STATUS_PACKAGE_UPDATING", "STATUS_PACKAGE_UPDATING"},
+	0xC000046A: {0xC000046A, "STATUS_NOT_READ_FROM_COPY", "STATUS_NOT_READ_FROM_COPY"},
+	0xC000046B: {0xC000046B, "STATUS_FT_WRITE_FAILURE", "STATUS_FT_WRITE_FAILURE"},
+	0xC000046C: {0xC000046C, "STATUS_FT_DI_SCAN_REQUIRED", "STATUS_FT_DI_SCAN_REQUIRED"},
+	0xC000046D: {0xC000046D, "STATUS_OBJECT_NOT_EXTERNALLY_BACKED", "STATUS_OBJECT_NOT_EXTERNALLY_BACKED"},
+	0xC000046E: {0xC000046E, "STATUS_EXTERNAL_BACKING_PROVIDER_UNKNOWN", "STATUS_EXTERNAL_BACKING_PROVIDER_UNKNOWN"},
+	0xC000046F: {0xC000046F, "STATUS_COMPRESSION_NOT_BENEFICIAL", "STATUS_COMPRESSION_NOT_BENEFICIAL"},
+	0xC0000470: {0xC0000470, "STATUS_DATA_CHECKSUM_ERROR", "STATUS_DATA_CHECKSUM_ERROR"},
+	0xC0000471: {0xC0000471, "STATUS_INTERMIXED_KERNEL_EA_OPERATION", "STATUS_INTERMIXED_KERNEL_EA_OPERATION"},
+	0xC0000472: {0xC0000472, "STATUS_TRIM_READ_ZERO_NOT_SUPPORTED", "STATUS_TRIM_READ_ZERO_NOT_SUPPORTED"},
+	0xC0000473: {0xC0000473, "STATUS_TOO_MANY_SEGMENT_DESCRIPTORS", "STATUS_TOO_MANY_SEGMENT_DESCRIPTORS"},
+	0xC0000474: {0xC0000474, "STATUS_INVALID_OFFSET_ALIGNMENT", "STATUS_INVALID_OFFSET_ALIGNMENT"},
+	0xC0000475: {0xC0000475, "STATUS_INVALID_FIELD_IN_PARAMETER_LIST", "STATUS_INVALID_FIELD_IN_PARAMETER_LIST"},
+	0xC0000476: {0xC0000476, "STATUS_OPERATION_IN_PROGRESS", "STATUS_OPERATION_IN_PROGRESS"},
+	0xC0000477: {0xC0000477, "STATUS_INVALID_INITIATOR_TARGET_PATH", "STATUS_INVALID_INITIATOR_TARGET_PATH"},
+	0xC0000478: {0xC0000478, "STATUS_SCRUB_DATA_DISABLED", "STATUS_SCRUB_DATA_DISABLED"},
+	0xC0000479: {0xC0000479, "STATUS_NOT_REDUNDANT_STORAGE", "STATUS_NOT_REDUNDANT_STORAGE"},
+	0xC000047A: {0xC000047A, "STATUS_RESIDENT_FILE_NOT_SUPPORTED", "STATUS_RESIDENT_FILE_NOT_SUPPORTED"},
+	0xC000047B: {0xC000047B, "STATUS_COMPRESSED_FILE_NOT_SUPPORTED", "STATUS_COMPRESSED_FILE_NOT_SUPPORTED"},
+	0xC000047C: {0xC000047C, "STATUS_DIRECTORY_NOT_SUPPORTED", "STATUS_DIRECTORY_NOT_SUPPORTED"},
+	0xC000047D: {0xC000047D, "STATUS_IO_OPERATION_TIMEOUT", "STATUS_IO_OPERATION_TIMEOUT"},
+	0xC000047E: {0xC000047E, "STATUS_SYSTEM_NEEDS_REMEDIATION", "STATUS_SYSTEM_NEEDS_REMEDIATION"},
+	0xC000047F: {0xC000047F, "STATUS_APPX_INTEGRITY_FAILURE_CLR_NGEN", "STATUS_APPX_INTEGRITY_FAILURE_CLR_NGEN"},
+	0xC0000480: {0xC0000480, "STATUS_SHARE_UNAVAILABLE", "STATUS_SHARE_UNAVAILABLE"},
+	0xC0000481: {0xC0000481, "STATUS_APISET_NOT_HOSTED", "STATUS_APISET_NOT_HOSTED"},
+	0xC0000482: {0xC0000482, "STATUS_APISET_NOT_PRESENT", "STATUS_APISET_NOT_PRESENT"},
+	0xC0000483: {0xC0000483, "STATUS_DEVICE_HARDWARE_ERROR", "STATUS_DEVICE_HARDWARE_ERROR"},
+	0xC0000484: {0xC0000484, "STATUS_FIRMWARE_SLOT_INVALID", "STATUS_FIRMWARE_SLOT_INVALID"},
+	0xC0000485: {0xC0000485, "STATUS_FIRMWARE_IMAGE_INVALID", "STATUS_FIRMWARE_IMAGE_INVALID"},
+	0xC0000486: {0xC0000486, "STATUS_STORAGE_TOPOLOGY_ID_MISMATCH", "STATUS_STORAGE_TOPOLOGY_ID_MISMATCH"},
+	0xC0000487: {0xC0000487, "STATUS_WIM_NOT_BOOTABLE", "STATUS_WIM_NOT_BOOTABLE"},
+	0xC0000488: {0xC0000488, "STATUS_BLOCKED_BY_PARENTAL_CONTROLS", "STATUS_BLOCKED_BY_PARENTAL_CONTROLS"},
+	0xC0000489: {0xC0000489, "STATUS_NEEDS_REGISTRATION", "STATUS_NEEDS_REGISTRATION"},
+	0xC000048A: {0xC000048A, "STATUS_QUOTA_ACTIVITY", "STATUS_QUOTA_ACTIVITY"},
+	0xC000048B: {0xC000048B, "STATUS_CALLBACK_INVOKE_INLINE", "STATUS_CALLBACK_INVOKE_INLINE"},
+	0xC000048C: {0xC000048C, "STATUS_BLOCK_TOO_MANY_REFERENCES", "STATUS_BLOCK_TOO_MANY_REFERENCES"},
+	0xC000048D: {0xC000048D, "STATUS_MARKED_TO_DISALLOW_WRITES", "STATUS_MARKED_TO_DISALLOW_WRITES"},
+	0xC000048E: {0xC000048E, "STATUS_NETWORK_ACCESS_DENIED_EDP", "STATUS_NETWORK_ACCESS_DENIED_EDP"},
+	0xC000048F: {0xC000048F, "STATUS_ENCLAVE_FAILURE", "STATUS_ENCLAVE_FAILURE"},
+	0xC0000490: {0xC0000490, "STATUS_PNP_NO_COMPAT_DRIVERS", "STATUS_PNP_NO_COMPAT_DRIVERS"},
+	0xC0000491: {0xC0000491, "STATUS_PNP_DRIVER_PACKAGE_NOT_FOUND", "STATUS_PNP_DRIVER_PACKAGE_NOT_FOUND"},
+	0xC0000492: {0xC0000492, "STATUS_PNP_DRIVER_CONFIGURATION_NOT_FOUND", "STATUS_PNP_DRIVER_CONFIGURATION_NOT_FOUND"},
+	0xC0000493: {0xC0000493, "STATUS_PNP_DRIVER_CONFIGURATION_INCOMPLETE", "STATUS_PNP_DRIVER_CONFIGURATION_INCOMPLETE"},
+	0xC0000494: {0xC0000494, "STATUS_PNP_FUNCTION_DRIVER_REQUIRED", "STATUS_PNP_FUNCTION_DRIVER_REQUIRED"},
+	0xC0000495: {0xC0000495, "STATUS_PNP_DEVICE_CONFIGURATION_PENDING", "STATUS_PNP_DEVICE_CONFIGURATION_PENDING"},
+	0xC0000496: {0xC0000496, "STATUS_DEVICE_HINT_NAME_BUFFER_TOO_SMALL", "STATUS_DEVICE_HINT_NAME_BUFFER_TOO_SMALL"},
+	0xC0000497: {0xC0000497, "STATUS_PACKAGE_NOT_AVAILABLE", "STATUS_PACKAGE_NOT_AVAILABLE"},
+	0xC0000499: {0xC0000499, "STATUS_DEVICE_IN_MAINTENANCE", "STATUS_DEVICE_IN_MAINTENANCE"},
+	0xC000049A: {0xC000049A, "STATUS_NOT_SUPPORTED_ON_DAX", "STATUS_NOT_SUPPORTED_ON_DAX"},
+	0xC000049B: {0xC000049B, "STATUS_FREE_SPACE_TOO_FRAGMENTED", "STATUS_FREE_SPACE_TOO_FRAGMENTED"},
+	0xC000049C: {0xC000049C, "STATUS_DAX_MAPPING_EXISTS", "STATUS_DAX_MAPPING_EXISTS"},
+	0xC000049D: {0xC000049D, "STATUS_CHILD_PROCESS_BLOCKED", "STATUS_CHILD_PROCESS_BLOCKED"},
+	0xC000049E: {0xC000049E, "STATUS_STORAGE_LOST_DATA_PERSISTENCE", "STATUS_STORAGE_LOST_DATA_PERSISTENCE"},
+	0xC000049F: {0xC000049F, "STATUS_VRF_CFG_ENABLED", "STATUS_VRF_CFG_ENABLED"},
+	0xC00004A0: {0xC00004A0, "STATUS_PARTITION_TERMINATING", "STATUS_PARTITION_TERMINATING"},
+	0xC00004A1: {0xC00004A1, "STATUS_EXTERNAL_SYSKEY_NOT_SUPPORTED", "STATUS_EXTERNAL_SYSKEY_NOT_SUPPORTED"},
+	0xC00004A2: {0xC00004A2, "STATUS_ENCLAVE_VIOLATION", "STATUS_ENCLAVE_VIOLATION"},
+	0xC00004A3: {0xC00004A3, "STATUS_FILE_PROTECTED_UNDER_DPL", "STATUS_FILE_PROTECTED_UNDER_DPL"},
+	0xC00004A4: {0xC00004A4, "STATUS_VOLUME_NOT_CLUSTER_ALIGNED", "STATUS_VOLUME_NOT_CLUSTER_ALIGNED"},
+	0xC00004A5: {0xC00004A5, "STATUS_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND", "STATUS_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND"},
+	0xC00004A6: {0xC00004A6, "STATUS_APPX_FILE_NOT_ENCRYPTED", "STATUS_APPX_FILE_NOT_ENCRYPTED"},
+	0xC00004A7: {0xC00004A7, "STATUS_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED", "STATUS_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED"},
+	0xC00004A8: {0xC00004A8, "STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET", "STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET"},
+	0xC00004A9: {0xC00004A9, "STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE", "STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE"},
+	0xC00004AA: {0xC00004AA, "STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER", "STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER"},
+	0xC00004AB: {0xC00004AB, "STATUS_FT_READ_FAILURE", "STATUS_FT_READ_FAILURE"},
+	0xC00004AC: {0xC00004AC, "STATUS_PATCH_CONFLICT", "STATUS_PATCH_CONFLICT"},
+	0xC00004AD: {0xC00004AD, "STATUS_STORAGE_RESERVE_ID_INVALID", "STATUS_STORAGE_RESERVE_ID_INVALID"},
+	0xC00004AE: {0xC00004AE, "STATUS_STORAGE_RESERVE_DOES_NOT_EXIST", "STATUS_STORAGE_RESERVE_DOES_NOT_EXIST"},
+	0xC00004AF: {0xC00004AF, "STATUS_STORAGE_RESERVE_ALREADY_EXISTS", "STATUS_STORAGE_RESERVE_ALREADY_EXISTS"},
+	0xC00004B0: {0xC00004B0, "STATUS_STORAGE_RESERVE_NOT_EMPTY", "STATUS_STORAGE_RESERVE_NOT_EMPTY"},
+	0xC00004B1: {0xC00004B1, "STATUS_NOT_A_DAX_VOLUME", "STATUS_NOT_A_DAX_VOLUME"},
+	0xC00004B2: {0xC00004B2, "STATUS_NOT_DAX_MAPPABLE", "STATUS_NOT_DAX_MAPPABLE"},
+	0xC00004B3: {0xC00004B3, "STATUS_CASE_DIFFERING_NAMES_IN_DIR", "STATUS_CASE_DIFFERING_NAMES_IN_DIR"},
+	0xC00004B4: {0xC00004B4, "STATUS_FILE_NOT_SUPPORTED", "STATUS_FILE_NOT_SUPPORTED"},
+	0xC00004B5: {0xC00004B5, "STATUS_NOT_SUPPORTED_WITH_BTT", "STATUS_NOT_SUPPORTED_WITH_BTT"},
+	0xC00004B6: {0xC00004B6, "STATUS_ENCRYPTION_DISABLED", "STATUS_ENCRYPTION_DISABLED"},
+	0xC00004B7: {0xC00004B7, "STATUS_ENCRYPTING_METADATA_DISALLOWED", "STATUS_ENCRYPTING_METADATA_DISALLOWED"},
+	0xC00004B8: {0xC00004B8, "STATUS_CANT_CLEAR_ENCRYPTION_FLAG", "STATUS_CANT_CLEAR_ENCRYPTION_FLAG"},
+	0xC0000500: {0xC0000500, "STATUS_INVALID_TASK_NAME", "STATUS_INVALID_TASK_NAME"},
+	0xC0000501: {0xC0000501, "STATUS_INVALID_TASK_INDEX", "STATUS_INVALID_TASK_INDEX"},
+	0xC0000502: {0xC0000502, "STATUS_THREAD_ALREADY_IN_TASK", "STATUS_THREAD_ALREADY_IN_TASK"},
+	0xC0000503: {0xC0000503, "STATUS_CALLBACK_BYPASS", "STATUS_CALLBACK_BYPASS"},
+	0xC0000504: {0xC0000504, "STATUS_UNDEFINED_SCOPE", "STATUS_UNDEFINED_SCOPE"},
+	0xC0000505: {0xC0000505, "STATUS_INVALID_CAP", "STATUS_INVALID_CAP"},
+	0xC0000506: {0xC0000506, "STATUS_NOT_GUI_PROCESS", "STATUS_NOT_GUI_PROCESS"},
+	0xC0000507: {0xC0000507, "STATUS_DEVICE_HUNG", "STATUS_DEVICE_HUNG"},
+	0xC0000508: {0xC0000508, "STATUS_CONTAINER_ASSIGNED", "STATUS_CONTAINER_ASSIGNED"},
+	0xC0000509: {0xC0000509, "STATUS_JOB_NO_CONTAINER", "STATUS_JOB_NO_CONTAINER"},
+	0xC000050A: {0xC000050A, "STATUS_DEVICE_UNRESPONSIVE", "STATUS_DEVICE_UNRESPONSIVE"},
+	0xC000050B: {0xC000050B, "STATUS_REPARSE_POINT_ENCOUNTERED", "STATUS_REPARSE_POINT_ENCOUNTERED"},
+	0xC000050C: {0xC000050C, "STATUS_ATTRIBUTE_NOT_PRESENT", "STATUS_ATTRIBUTE_NOT_PRESENT"},
+	0xC000050D: {0xC000050D, "STATUS_NOT_A_TIERED_VOLUME", "STATUS_NOT_A_TIERED_VOLUME"},
+	0xC000050E: {0xC000050E, "STATUS_ALREADY_HAS_STREAM_ID", "STATUS_ALREADY_HAS_STREAM_ID"},
+	0xC000050F: {0xC000050F, "STATUS_JOB_NOT_EMPTY", "STATUS_JOB_NOT_EMPTY"},
+	0xC0000510: {0xC0000510, "STATUS_ALREADY_INITIALIZED", "STATUS_ALREADY_INITIALIZED"},
+	0xC0000511: {0xC0000511, "STATUS_ENCLAVE_NOT_TERMINATED", "STATUS_ENCLAVE_NOT_TERMINATED"},
+	0xC0000512: {0xC0000512, "STATUS_ENCLAVE_IS_TERMINATING", "STATUS_ENCLAVE_IS_TERMINATING"},
+	0xC0000513: {0xC0000513, "STATUS_SMB1_NOT_AVAILABLE", "STATUS_SMB1_NOT_AVAILABLE"},
+	0xC0000514: {0xC0000514, "STATUS_SMR_GARBAGE_COLLECTION_REQUIRED", "STATUS_SMR_GARBAGE_COLLECTION_REQUIRED"},
+	0xC0000515: {0xC0000515, "STATUS_INTERRUPTED", "STATUS_INTERRUPTED"},
+	0xC0000516: {0xC0000516, "STATUS_THREAD_NOT_RUNNING", "STATUS_THREAD_NOT_RUNNING"},
+	0xC0000602: {0xC0000602, "STATUS_FAIL_FAST_EXCEPTION", "{Fail Fast Exception} A fail fast exception occurred. Exception handlers will not be invoked and the process will be terminated immediately."},
+	0xC0000603: {0xC0000603, "STATUS_IMAGE_CERT_REVOKED", "STATUS_IMAGE_CERT_REVOKED"},
+	0xC0000604: {0xC0000604, "STATUS_DYNAMIC_CODE_BLOCKED", "STATUS_DYNAMIC_CODE_BLOCKED"},
+	0xC0000605: {0xC0000605, "STATUS_IMAGE_CERT_EXPIRED", "STATUS_IMAGE_CERT_EXPIRED"},
+	0xC0000606: {0xC0000606, "STATUS_STRICT_CFG_VIOLATION", "STATUS_STRICT_CFG_VIOLATION"},
+	0xC000060A: {0xC000060A, "STATUS_SET_CONTEXT_DENIED", "STATUS_SET_CONTEXT_DENIED"},
+	0xC000060B: {0xC000060B, "STATUS_CROSS_PARTITION_VIOLATION", "STATUS_CROSS_PARTITION_VIOLATION"},
+	0xC0000700: {0xC0000700, "STATUS_PORT_CLOSED", "The ALPC port is closed."},
+	0xC0000701: {0xC0000701, "STATUS_MESSAGE_LOST", "STATUS_MESSAGE_LOST"},
+	0xC0000702: {0xC0000702, "STATUS_INVALID_MESSAGE", "STATUS_INVALID_MESSAGE"},
+	0xC0000703: {0xC0000703, "STATUS_REQUEST_CANCELED", "STATUS_REQUEST_CANCELED"},
+	0xC0000704: {0xC0000704, "STATUS_RECURSIVE_DISPATCH", "STATUS_RECURSIVE_DISPATCH"},
+	0xC0000705: {0xC0000705, "STATUS_LPC_RECEIVE_BUFFER_EXPECTED", "STATUS_LPC_RECEIVE_BUFFER_EXPECTED"},
+	0xC0000706: {0xC0000706, "STATUS_LPC_INVALID_CONNECTION_USAGE", "STATUS_LPC_INVALID_CONNECTION_USAGE"},
+	0xC0000707: {0xC0000707, "STATUS_LPC_REQUESTS_NOT_ALLOWED", "STATUS_LPC_REQUESTS_NOT_ALLOWED"},
+	0xC0000708: {0xC0000708, "STATUS_RESOURCE_IN_USE", "STATUS_RESOURCE_IN_USE"},
+	0xC0000709: {0xC0000709, "STATUS_HARDWARE_MEMORY_ERROR", "STATUS_HARDWARE_MEMORY_ERROR"},
+	0xC000070A: {0xC000070A, "STATUS_THREADPOOL_HANDLE_EXCEPTION", "The thread pool callback exited while holding a handle that was not released."},
+	0xC000070B: {0xC000070B, "STATUS_THREADPOOL_SET_EVENT_ON_COMPLETION_FAILED", "STATUS_THREADPOOL_SET_EVENT_ON_COMPLETION_FAILED"},
+	0xC000070C: {0xC000070C, "STATUS_THREADPOOL_RELEASE_SEMAPHORE_ON_COMPLETION_FAILED", "STATUS_THREADPOOL_RELEASE_SEMAPHORE_ON_COMPLETION_FAILED"},
+	0xC000070D: {0xC000070D, "STATUS_THREADPOOL_RELEASE_MUTEX_ON_COMPLETION_FAILED", "STATUS_THREADPOOL_RELEASE_MUTEX_ON_COMPLETION_FAILED"},
+	0xC000070E: {0xC000070E, "STATUS_THREADPOOL_FREE_LIBRARY_ON_COMPLETION_FAILED", "STATUS_THREADPOOL_FREE_LIBRARY_ON_COMPLETION_FAILED"},
+	0xC000070F: {0xC000070F, "STATUS_THREADPOOL_RELEASED_DURING_OPERATION", "STATUS_THREADPOOL_RELEASED_DURING_OPERATION"},
+	0xC0000710: {0xC0000710, "STATUS_CALLBACK_RETURNED_WHILE_IMPERSONATING", "A threadpool worker thread entered a callback, which left with a thread that was impersonating a client."},
+	0xC0000711: {0xC0000711, "STATUS_APC_RETURNED_WHILE_IMPERSONATING", "A thread that was impersonating a client returned from an asynchronous procedure call (APC)."},
+	0xC0000712: {0xC0000712, "STATUS_PROCESS_IS_PROTECTED", "STATUS_PROCESS_IS_PROTECTED"},
+	0xC0000713: {0xC0000713, "STATUS_MCA_EXCEPTION", "STATUS_MCA_EXCEPTION"},
+	0xC0000714: {0xC0000714, "STATUS_CERTIFICATE_MAPPING_NOT_UNIQUE", "STATUS_CERTIFICATE_MAPPING_NOT_UNIQUE"},
+	0xC0000715: {0xC0000715, "STATUS_SYMLINK_CLASS_DISABLED", "STATUS_SYMLINK_CLASS_DISABLED"},
+	0xC0000716: {0xC0000716, "STATUS_INVALID_IDN_NORMALIZATION", "STATUS_INVALID_IDN_NORMALIZATION"},
+	0xC0000717: {0xC0000717, "STATUS_NO_UNICODE_TRANSLATION", "STATUS_NO_UNICODE_TRANSLATION"},
+	0xC0000718: {0xC0000718, "STATUS_ALREADY_REGISTERED", "STATUS_ALREADY_REGISTERED"},
+	0xC0000719: {0xC0000719, "STATUS_CONTEXT_MISMATCH", "STATUS_CONTEXT_MISMATCH"},
+	0xC000071A: {0xC000071A, "STATUS_PORT_ALREADY_HAS_COMPLETION_LIST", "STATUS_PORT_ALREADY_HAS_COMPLETION_LIST"},
+	0xC000071B: {0xC000071B, "STATUS_CALLBACK_RETURNED_THREAD_PRIORITY", "STATUS_CALLBACK_RETURNED_THREAD_PRIORITY"},
+	0xC000071C: {0xC000071C, "STATUS_INVALID_THREAD", "STATUS_INVALID_THREAD"},
+	0xC000071D: {0xC000071D, "STATUS_CALLBACK_RETURNED_TRANSACTION", "STATUS_CALLBACK_RETURNED_TRANSACTION"},
+	0xC000071E: {0xC000071E, "STATUS_CALLBACK_RETURNED_LDR_LOCK", "STATUS_CALLBACK_RETURNED_LDR_LOCK"},
+	0xC000071F: {0xC000071F, "STATUS_CALLBACK_RETURNED_LANG", "STATUS_CALLBACK_RETURNED_LANG"},
+	0xC0000720: {0xC0000720, "STATUS_CALLBACK_RETURNED_PRI_BACK", "STATUS_CALLBACK_RETURNED_PRI_BACK"},
+	0xC0000721: {0xC0000721, "STATUS_CALLBACK_RETURNED_THREAD_AFFINITY", "STATUS_CALLBACK_RETURNED_THREAD_AFFINITY"},
+	0xC0000722: {0xC0000722, "STATUS_LPC_HANDLE_COUNT_EXCEEDED", "STATUS_LPC_HANDLE_COUNT_EXCEEDED"},
+	0xC0000723: {0xC0000723, "STATUS_EXECUTABLE_MEMORY_WRITE", "STATUS_EXECUTABLE_MEMORY_WRITE"},
+	0xC0000724: {0xC0000724, "STATUS_KERNEL_EXECUTABLE_MEMORY_WRITE", "STATUS_KERNEL_EXECUTABLE_MEMORY_WRITE"},
+	0xC0000725: {0xC0000725, "STATUS_ATTACHED_EXECUTABLE_MEMORY_WRITE", "STATUS_ATTACHED_EXECUTABLE_MEMORY_WRITE"},
+	0xC0000726: {0xC0000726, "STATUS_TRIGGERED_EXECUTABLE_MEMORY_WRITE", "STATUS_TRIGGERED_EXECUTABLE_MEMORY_WRITE"},
+	0xC0000800: {0xC0000800, "STATUS_DISK_REPAIR_DISABLED", "STATUS_DISK_REPAIR_DISABLED"},
+	0xC0000801: {0xC0000801, "STATUS_DS_DOMAIN_RENAME_IN_PROGRESS", "STATUS_DS_DOMAIN_RENAME_IN_PROGRESS"},
+	0xC0000802: {0xC0000802, "STATUS_DISK_QUOTA_EXCEEDED", "STATUS_DISK_QUOTA_EXCEEDED"},
+	0x80000803: {0x80000803, "STATUS_DATA_LOST_REPAIR", "STATUS_DATA_LOST_REPAIR"},
+	0xC0000804: {0xC0000804, "STATUS_CONTENT_BLOCKED", "STATUS_CONTENT_BLOCKED"},
+	0xC0000805: {0xC0000805, "STATUS_BAD_CLUSTERS", "STATUS_BAD_CLUSTERS"},
+	0xC0000806: {0xC0000806, "STATUS_VOLUME_DIRTY", "STATUS_VOLUME_DIRTY"},
+	0x40000807: {0x40000807, "STATUS_DISK_REPAIR_REDIRECTED", "STATUS_DISK_REPAIR_REDIRECTED"},
+	0xC0000808: {0xC0000808, "STATUS_DISK_REPAIR_UNSUCCESSFUL", "STATUS_DISK_REPAIR_UNSUCCESSFUL"},
+	0xC0000809: {0xC0000809, "STATUS_CORRUPT_LOG_OVERFULL", "STATUS_CORRUPT_LOG_OVERFULL"},
+	0xC000080A: {0xC000080A, "STATUS_CORRUPT_LOG_CORRUPTED", "STATUS_CORRUPT_LOG_CORRUPTED"},
+	0xC000080B: {0xC000080B, "STATUS_CORRUPT_LOG_UNAVAILABLE", "STATUS_CORRUPT_LOG_UNAVAILABLE"},
+	0xC000080C: {0xC000080C, "STATUS_CORRUPT_LOG_DELETED_FULL", "STATUS_CORRUPT_LOG_DELETED_FULL"},
+	0xC000080D: {0xC000080D, "STATUS_CORRUPT_LOG_CLEARED", "STATUS_CORRUPT_LOG_CLEARED"},
+	0xC000080E: {0xC000080E, "STATUS_ORPHAN_NAME_EXHAUSTED", "STATUS_ORPHAN_NAME_EXHAUSTED"},
+	0xC000080F: {0xC000080F, "STATUS_PROACTIVE_SCAN_IN_PROGRESS", "STATUS_PROACTIVE_SCAN_IN_PROGRESS"},
+	0xC0000810: {0xC0000810, "STATUS_ENCRYPTED_IO_NOT_POSSIBLE", "STATUS_ENCRYPTED_IO_NOT_POSSIBLE"},
+	0xC0000811: {0xC0000811, "STATUS_CORRUPT_LOG_UPLEVEL_RECORDS", "STATUS_CORRUPT_LOG_UPLEVEL_RECORDS"},
+	0xC0000901: {0xC0000901, "STATUS_FILE_CHECKED_OUT", "STATUS_FILE_CHECKED_OUT"},
+	0xC0000902: {0xC0000902, "STATUS_CHECKOUT_REQUIRED", "STATUS_CHECKOUT_REQUIRED"},
+	0xC0000903: {0xC0000903, "STATUS_BAD_FILE_TYPE", "STATUS_BAD_FILE_TYPE"},
+	0xC0000904: {0xC0000904, "STATUS_FILE_TOO_LARGE", "STATUS_FILE_TOO_LARGE"},
+	0xC0000905: {0xC0000905, "STATUS_FORMS_AUTH_REQUIRED", "STATUS_FORMS_AUTH_REQUIRED"},
+	0xC0000906: {0xC0000906, "STATUS_VIRUS_INFECTED", "The operation did not complete successfully because the file contains a virus."},
+	0xC0000907: {0xC0000907, "STATUS_VIRUS_DELETED", "This file contains a virus and was deleted."},
+	0xC0000908: {0xC0000908, "STATUS_BAD_MCFG_TABLE", "STATUS_BAD_MCFG_TABLE"},
+	0xC0000909: {0xC0000909, "STATUS_CANNOT_BREAK_OPLOCK", "STATUS_CANNOT_BREAK_OPLOCK"},
+	0xC000090A: {0xC000090A, "STATUS_BAD_KEY", "STATUS_BAD_KEY"},
+	0xC000090B: {0xC000090B, "STATUS_BAD_DATA", "STATUS_BAD_DATA"},
+	0xC000090C: {0xC000090C, "STATUS_NO_KEY", "STATUS_NO_KEY"},
+	0xC0000910: {0xC0000910, "STATUS_FILE_HANDLE_REVOKED", "STATUS_FILE_HANDLE_REVOKED"},
+	0xC0009898: {0xC0009898, "STATUS_WOW_ASSERTION", "STATUS_WOW_ASSERTION"},
+	0xC000A000: {0xC000A000, "STATUS_INVALID_SIGNATURE", "STATUS_INVALID_SIGNATURE"},
+	0xC000A001: {0xC000A001, "STATUS_HMAC_NOT_SUPPORTED", "STATUS_HMAC_NOT_SUPPORTED"},
+	0xC000A002: {0xC000A002, "STATUS_AUTH_TAG_MISMATCH", "STATUS_AUTH_TAG_MISMATCH"},
+	0xC000A003: {0xC000A003, "STATUS_INVALID_STATE_TRANSITION", "STATUS_INVALID_STATE_TRANSITION"},
+	0xC000A004: {0xC000A004, "STATUS_INVALID_KERNEL_INFO_VERSION", "STATUS_INVALID_KERNEL_INFO_VERSION"},
+	0xC000A005: {0xC000A005, "STATUS_INVALID_PEP_INFO_VERSION", "STATUS_INVALID_PEP_INFO_VERSION"},
+	0xC000A006: {0xC000A006, "STATUS_HANDLE_REVOKED", "STATUS_HANDLE_REVOKED"},
+	0xC000A007: {0xC000A007, "STATUS_EOF_ON_GHOSTED_RANGE", "STATUS_EOF_ON_GHOSTED_RANGE"},
+	0xC000A010: {0xC000A010, "STATUS_IPSEC_QUEUE_OVERFLOW", "STATUS_IPSEC_QUEUE_OVERFLOW"},
+	0xC000A011: {0xC000A011, "STATUS_ND_QUEUE_OVERFLOW", "STATUS_ND_QUEUE_OVERFLOW"},
+	0xC000A012: {0xC000A012, "STATUS_HOPLIMIT_EXCEEDED", "STATUS_HOPLIMIT_EXCEEDED"},
+	0xC000A013: {0xC000A013, "STATUS_PROTOCOL_NOT_SUPPORTED", "STATUS_PROTOCOL_NOT_SUPPORTED"},
+	0xC000A014: {0xC000A014, "STATUS_FASTPATH_REJECTED", "STATUS_FASTPATH_REJECTED"},
+	0xC000A080: {0xC000A080, "STATUS_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED", "STATUS_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED"},
+	0xC000A081: {0xC000A081, "STATUS_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR", "STATUS_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR"},
+	0xC000A082: {0xC000A082, "STATUS_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR", "STATUS_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR"},
+	0xC000A083: {0xC000A083, "STATUS_XML_PARSE_ERROR", "STATUS_XML_PARSE_ERROR"},
+	0xC000A084: {0xC000A084, "STATUS_XMLDSIG_ERROR", "STATUS_XMLDSIG_ERROR"},
+	0xC000A085: {0xC000A085, "STATUS_WRONG_COMPARTMENT", "STATUS_WRONG_COMPARTMENT"},
+	0xC000A086: {0xC000A086, "STATUS_AUTHIP_FAILURE", "STATUS_AUTHIP_FAILURE"},
+	0xC000A087: {0xC000A087, "STATUS_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS", "STATUS_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS"},
+	0xC000A088: {0xC000A088, "STATUS_DS_OID_NOT_FOUND", "STATUS_DS_OID_NOT_FOUND"},
+	0xC000A089: {0xC000A089, "STATUS_INCORRECT_ACCOUNT_TYPE", "STATUS_INCORRECT_ACCOUNT_TYPE"},
+	0xC000A100: {0xC000A100, "STATUS_HASH_NOT_SUPPORTED", "STATUS_HASH_NOT_SUPPORTED"},
+	0xC000A101: {0xC000A101, "STATUS_HASH_NOT_PRESENT", "STATUS_HASH_NOT_PRESENT"},
+	0xC000A121: {0xC000A121, "STATUS_SECONDARY_IC_PROVIDER_NOT_REGISTERED", "STATUS_SECONDARY_IC_PROVIDER_NOT_REGISTERED"},
+	0xC000A122: {0xC000A122, "STATUS_GPIO_CLIENT_INFORMATION_INVALID", "STATUS_GPIO_CLIENT_INFORMATION_INVALID"},
+	0xC000A123: {0xC000A123, "STATUS_GPIO_VERSION_NOT_SUPPORTED", "STATUS_GPIO_VERSION_NOT_SUPPORTED"},
+	0xC000A124: {0xC000A124, "STATUS_GPIO_INVALID_REGISTRATION_PACKET", "STATUS_GPIO_INVALID_REGISTRATION_PACKET"},
+	0xC000A125: {0xC000A125, "STATUS_GPIO_OPERATION_DENIED", "STATUS_GPIO_OPERATION_DENIED"},
+	0xC000A126: {0xC000A126, "STATUS_GPIO_INCOMPATIBLE_CONNECT_MODE", "STATUS_GPIO_INCOMPATIBLE_CONNECT_MODE"},
+	0x8000A127: {0x8000A127, "STATUS_GPIO_INTERRUPT_ALREADY_UNMASKED", "STATUS_GPIO_INTERRUPT_ALREADY_UNMASKED"},
+	0xC000A141: {0xC000A141, "STATUS_CANNOT_SWITCH_RUNLEVEL", "STATUS_CANNOT_SWITCH_RUNLEVEL"},
+	0xC000A142: {0xC000A142, "STATUS_INVALID_RUNLEVEL_SETTING", "STATUS_INVALID_RUNLEVEL_SETTING"},
+	0xC000A143: {0xC000A143, "STATUS_RUNLEVEL_SWITCH_TIMEOUT", "STATUS_RUNLEVEL_SWITCH_TIMEOUT"},
+	0x4000A144: {0x4000A144, "STATUS_SERVICES_FAILED_AUTOSTART", "STATUS_SERVICES_FAILED_AUTOSTART"},
+	0xC000A145: {0xC000A145, "STATUS_RUNLEVEL_SWITCH_AGENT_TIMEOUT", "STATUS_RUNLEVEL_SWITCH_AGENT_TIMEOUT"},
+	0xC000A146: {0xC000A146, "STATUS_RUNLEVEL_SWITCH_IN_PROGRESS", "STATUS_RUNLEVEL_SWITCH_IN_PROGRESS"},
+	0xC000A200: {0xC000A200, "STATUS_NOT_APPCONTAINER", "STATUS_NOT_APPCONTAINER"},
+	0xC000A201: {0xC000A201, "STATUS_NOT_SUPPORTED_IN_APPCONTAINER", "STATUS_NOT_SUPPORTED_IN_APPCONTAINER"},
+	0xC000A202: {0xC000A202, "STATUS_INVALID_PACKAGE_SID_LENGTH", "STATUS_INVALID_PACKAGE_SID_LENGTH"},
+	0xC000A203: {0xC000A203, "STATUS_LPAC_ACCESS_DENIED", "The process has been denied access because it is a less privileged AppContainer."},
+	0xC000A204: {0xC000A204, "STATUS_ADMINLESS_ACCESS_DENIED", "STATUS_ADMINLESS_ACCESS_DENIED"},
+	0xC000A281: {0xC000A281, "STATUS_APP_DATA_NOT_FOUND", "STATUS_APP_DATA_NOT_FOUND"},
+	0xC000A282: {0xC000A282, "STATUS_APP_DATA_EXPIRED", "STATUS_APP_DATA_EXPIRED"},
+	0xC000A283: {0xC000A283, "STATUS_APP_DATA_CORRUPT", "STATUS_APP_DATA_CORRUPT"},
+	0xC000A284: {0xC000A284, "STATUS_APP_DATA_LIMIT_EXCEEDED", "STATUS_APP_DATA_LIMIT_EXCEEDED"},
+	0xC000A285: {0xC000A285, "STATUS_APP_DATA_REBOOT_REQUIRED", "STATUS_APP_DATA_REBOOT_REQUIRED"},
+	0xC000A2A1: {0xC000A2A1, "STATUS_OFFLOAD_READ_FLT_NOT_SUPPORTED", "STATUS_OFFLOAD_READ_FLT_NOT_SUPPORTED"},
+	0xC000A2A2: {0xC000A2A2, "STATUS_OFFLOAD_WRITE_FLT_NOT_SUPPORTED", "STATUS_OFFLOAD_WRITE_FLT_NOT_SUPPORTED"},
+	0xC000A2A3: {0xC000A2A3, "STATUS_OFFLOAD_READ_FILE_NOT_SUPPORTED", "STATUS_OFFLOAD_READ_FILE_NOT_SUPPORTED"},
+	0xC000A2A4: {0xC000A2A4, "STATUS_OFFLOAD_WRITE_FILE_NOT_SUPPORTED", "STATUS_OFFLOAD_WRITE_FILE_NOT_SUPPORTED"},
+	0xC000A2A5: {0xC000A2A5, "STATUS_WOF_WIM_HEADER_CORRUPT", "STATUS_WOF_WIM_HEADER_CORRUPT"},
+	0xC000A2A6: {0xC000A2A6, "STATUS_WOF_WIM_RESOURCE_TABLE_CORRUPT", "STATUS_WOF_WIM_RESOURCE_TABLE_CORRUPT"},
+	0xC000A2A7: {0xC000A2A7, "STATUS_WOF_FILE_RESOURCE_TABLE_CORRUPT", "STATUS_WOF_FILE_RESOURCE_TABLE_CORRUPT"},
+	0xC000CE01: {0xC000CE01, "STATUS_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE", "STATUS_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE"},
+	0xC000CE02: {0xC000CE02, "STATUS_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT", "STATUS_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT"},
+	0xC000CE03: {0xC000CE03, "STATUS_FILE_SYSTEM_VIRTUALIZATION_BUSY", "STATUS_FILE_SYSTEM_VIRTUALIZATION_BUSY"},
+	0xC000CE04: {0xC000CE04, "STATUS_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN", "STATUS_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN"},
+	0xC000CE05: {0xC000CE05, "STATUS_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION", "STATUS_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION"},
+	0xC000CF00: {0xC000CF00, "STATUS_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT", "STATUS_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT"},
+	0xC000CF01: {0xC000CF01, "STATUS_CLOUD_FILE_PROVIDER_NOT_RUNNING", "STATUS_CLOUD_FILE_PROVIDER_NOT_RUNNING"},
+	0xC000CF02: {0xC000CF02, "STATUS_CLOUD_FILE_METADATA_CORRUPT", "STATUS_CLOUD_FILE_METADATA_CORRUPT"},
+	0xC000CF03: {0xC000CF03, "STATUS_CLOUD_FILE_METADATA_TOO_LARGE", "STATUS_CLOUD_FILE_METADATA_TOO_LARGE"},
+	0x8000CF04: {0x8000CF04, "STATUS_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE", "STATUS_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE"},
+	0x8000CF05: {0x8000CF05, "STATUS_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS", "STATUS_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS"},
+	0xC000CF06: {0xC000CF06, "STATUS_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED", "STATUS_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED"},
+	0xC000CF07: {0xC000CF07, "STATUS_NOT_A_CLOUD_FILE", "STATUS_NOT_A_CLOUD_FILE"},
+	0xC000CF08: {0xC000CF08, "STATUS_CLOUD_FILE_NOT_IN_SYNC", "STATUS_CLOUD_FILE_NOT_IN_SYNC"},
+	0xC000CF09: {0xC000CF09, "STATUS_CLOUD_FILE_ALREADY_CONNECTED", "STATUS_CLOUD_FILE_ALREADY_CONNECTED"},
+	0xC000CF0A: {0xC000CF0A, "STATUS_CLOUD_FILE_NOT_SUPPORTED", "STATUS_CLOUD_FILE_NOT_SUPPORTED"},
+	0xC000CF0B: {0xC000CF0B, "STATUS_CLOUD_FILE_INVALID_REQUEST", "STATUS_CLOUD_FILE_INVALID_REQUEST"},
+	0xC000CF0C: {0xC000CF0C, "STATUS_CLOUD_FILE_READ_ONLY_VOLUME", "STATUS_CLOUD_FILE_READ_ONLY_VOLUME"},
+	0xC000CF0D: {0xC000CF0D, "STATUS_CLOUD_FILE_CONNECTED_PROVIDER_ONLY", "STATUS_CLOUD_FILE_CONNECTED_PROVIDER_ONLY"},
+	0xC000CF0E: {0xC000CF0E, "STATUS_CLOUD_FILE_VALIDATION_FAILED", "STATUS_CLOUD_FILE_VALIDATION_FAILED"},
+	0xC000CF0F: {0xC000CF0F, "STATUS_CLOUD_FILE_AUTHENTICATION_FAILED", "STATUS_CLOUD_FILE_AUTHENTICATION_FAILED"},
+	0xC000CF10: {0xC000CF10, "STATUS_CLOUD_FILE_INSUFFICIENT_RESOURCES", "STATUS_CLOUD_FILE_INSUFFICIENT_RESOURCES"},
+	0xC000CF11: {0xC000CF11, "STATUS_CLOUD_FILE_NETWORK_UNAVAILABLE", "STATUS_CLOUD_FILE_NETWORK_UNAVAILABLE"},
+	0xC000CF12: {0xC000CF12, "STATUS_CLOUD_FILE_UNSUCCESSFUL", "STATUS_CLOUD_FILE_UNSUCCESSFUL"},
+	0xC000CF13: {0xC000CF13, "STATUS_CLOUD_FILE_NOT_UNDER_SYNC_ROOT", "STATUS_CLOUD_FILE_NOT_UNDER_SYNC_ROOT"},
+	0xC000CF14: {0xC000CF14, "STATUS_CLOUD_FILE_IN_USE", "STATUS_CLOUD_FILE_IN_USE"},
+	0xC000CF15: {0xC000CF15, "STATUS_CLOUD_FILE_PINNED", "STATUS_CLOUD_FILE_PINNED"},
+	0xC000CF16: {0xC000CF16, "STATUS_CLOUD_FILE_REQUEST_ABORTED", "STATUS_CLOUD_FILE_REQUEST_ABORTED"},
+	0xC000CF17: {0xC000CF17, "STATUS_CLOUD_FILE_PROPERTY_CORRUPT", "STATUS_CLOUD_FILE_PROPERTY_CORRUPT"},
+	0xC000CF18: {0xC000CF18, "STATUS_CLOUD_FILE_ACCESS_DENIED", "STATUS_CLOUD_FILE_ACCESS_DENIED"},
+	0xC000CF19: {0xC000CF19, "STATUS_CLOUD_FILE_INCOMPATIBLE_HARDLINKS", "STATUS_CLOUD_FILE_INCOMPATIBLE_HARDLINKS"},
+	0xC000CF1A: {0xC000CF1A, "STATUS_CLOUD_FILE_PROPERTY_LOCK_CONFLICT", "STATUS_CLOUD_FILE_PROPERTY_LOCK_CONFLICT"},
+	0xC000CF1B: {0xC000CF1B, "STATUS_CLOUD_FILE_REQUEST_CANCELED", "STATUS_CLOUD_FILE_REQUEST_CANCELED"},
+	0xC000CF1D: {0xC000CF1D, "STATUS_CLOUD_FILE_PROVIDER_TERMINATED", "STATUS_CLOUD_FILE_PROVIDER_TERMINATED"},
+	0xC000CF1E: {0xC000CF1E, "STATUS_NOT_A_CLOUD_SYNC_ROOT", "STATUS_NOT_A_CLOUD_SYNC_ROOT"},
+	0xC000CF1F: {0xC000CF1F, "STATUS_CLOUD_FILE_REQUEST_TIMEOUT", "STATUS_CLOUD_FILE_REQUEST_TIMEOUT"},
+	0xC0140001: {0xC0140001, "STATUS_ACPI_INVALID_OPCODE", "STATUS_ACPI_INVALID_OPCODE"},
+	0xC0140002: {0xC0140002, "STATUS_ACPI_STACK_OVERFLOW", "STATUS_ACPI_STACK_OVERFLOW"},
+	0xC0140003: {0xC0140003, "STATUS_ACPI_ASSERT_FAILED", "STATUS_ACPI_ASSERT_FAILED"},
+	0xC0140004: {0xC0140004, "STATUS_ACPI_INVALID_INDEX", "STATUS_ACPI_INVALID_INDEX"},
+	0xC0140005: {0xC0140005, "STATUS_ACPI_INVALID_ARGUMENT", "STATUS_ACPI_INVALID_ARGUMENT"},
+	0xC0140006: {0xC0140006, "STATUS_ACPI_FATAL", "STATUS_ACPI_FATAL"},
+	0xC0140007: {0xC0140007, "STATUS_ACPI_INVALID_SUPERNAME", "STATUS_ACPI_INVALID_SUPERNAME"},
+	0xC0140008: {0xC0140008, "STATUS_ACPI_INVALID_ARGTYPE", "STATUS_ACPI_INVALID_ARGTYPE"},
+	0xC0140009: {0xC0140009, "STATUS_ACPI_INVALID_OBJTYPE", "STATUS_ACPI_INVALID_OBJTYPE"},
+	0xC014000A: {0xC014000A, "STATUS_ACPI_INVALID_TARGETTYPE", "STATUS_ACPI_INVALID_TARGETTYPE"},
+	0xC014000B: {0xC014000B, "STATUS_ACPI_INCORRECT_ARGUMENT_COUNT", "STATUS_ACPI_INCORRECT_ARGUMENT_COUNT"},
+	0xC014000C: {0xC014000C, "STATUS_ACPI_ADDRESS_NOT_MAPPED", "STATUS_ACPI_ADDRESS_NOT_MAPPED"},
+	0xC014000D: {0xC014000D, "STATUS_ACPI_INVALID_EVENTTYPE", "STATUS_ACPI_INVALID_EVENTTYPE"},
+	0xC014000E: {0xC014000E, "STATUS_ACPI_HANDLER_COLLISION", "STATUS_ACPI_HANDLER_COLLISION"},
+	0xC014000F: {0xC014000F, "STATUS_ACPI_INVALID_DATA", "STATUS_ACPI_INVALID_DATA"},
+	0xC0140010: {0xC0140010, "STATUS_ACPI_INVALID_REGION", "STATUS_ACPI_INVALID_REGION"},
+	0xC0140011: {0xC0140011, "STATUS_ACPI_INVALID_ACCESS_SIZE", "STATUS_ACPI_INVALID_ACCESS_SIZE"},
+	0xC0140012: {0xC0140012, "STATUS_ACPI_ACQUIRE_GLOBAL_LOCK", "STATUS_ACPI_ACQUIRE_GLOBAL_LOCK"},
+	0xC0140013: {0xC0140013, "STATUS_ACPI_ALREADY_INITIALIZED", "STATUS_ACPI_ALREADY_INITIALIZED"},
+	0xC0140014: {0xC0140014, "STATUS_ACPI_NOT_INITIALIZED", "STATUS_ACPI_NOT_INITIALIZED"},
+	0xC0140015: {0xC0140015, "STATUS_ACPI_INVALID_MUTEX_LEVEL", "STATUS_ACPI_INVALID_MUTEX_LEVEL"},
+	0xC0140016: {0xC0140016, "STATUS_ACPI_MUTEX_NOT_OWNED", "STATUS_ACPI_MUTEX_NOT_OWNED"},
+	0xC0140017: {0xC0140017, "STATUS_ACPI_MUTEX_NOT_OWNER", "STATUS_ACPI_MUTEX_NOT_OWNER"},
+	0xC0140018: {0xC0140018, "STATUS_ACPI_RS_ACCESS", "STATUS_ACPI_RS_ACCESS"},
+	0xC0140019: {0xC0140019, "STATUS_ACPI_INVALID_TABLE", "STATUS_ACPI_INVALID_TABLE"},
+	0xC0140020: {0xC0140020, "STATUS_ACPI_REG_HANDLER_FAILED", "STATUS_ACPI_REG_HANDLER_FAILED"},
+	0xC0140021: {0xC0140021, "STATUS_ACPI_POWER_REQUEST_FAILED", "STATUS_ACPI_POWER_REQUEST_FAILED"},
+	0xC00A0001: {0xC00A0001, "STATUS_CTX_WINSTATION_NAME_INVALID", "STATUS_CTX_WINSTATION_NAME_INVALID"},
+	0xC00A0002: {0xC00A0002, "STATUS_CTX_INVALID_PD", "STATUS_CTX_INVALID_PD"},
+	0xC00A0003: {0xC00A0003, "STATUS_CTX_PD_NOT_FOUND", "STATUS_CTX_PD_NOT_FOUND"},
+	0x400A0004: {0x400A0004, "STATUS_CTX_CDM_CONNECT", "STATUS_CTX_CDM_CONNECT"},
+	0x400A0005: {0x400A0005, "STATUS_CTX_CDM_DISCONNECT", "STATUS_CTX_CDM_DISCONNECT"},
+	0xC00A0006: {0xC00A0006, "STATUS_CTX_CLOSE_PENDING", "STATUS_CTX_CLOSE_PENDING"},
+	0xC00A0007: {0xC00A0007, "STATUS_CTX_NO_OUTBUF", "STATUS_CTX_NO_OUTBUF"},
+	0xC00A0008: {0xC00A0008, "STATUS_CTX_MODEM_INF_NOT_FOUND", "STATUS_CTX_MODEM_INF_NOT_FOUND"},
+	0xC00A0009: {0xC00A0009, "STATUS_CTX_INVALID_MODEMNAME", "STATUS_CTX_INVALID_MODEMNAME"},
+	0xC00A000A: {0xC00A000A, "STATUS_CTX_RESPONSE_ERROR", "STATUS_CTX_RESPONSE_ERROR"},
+	0xC00A000B: {0xC00A000B, "STATUS_CTX_MODEM_RESPONSE_TIMEOUT", "STATUS_CTX_MODEM_RESPONSE_TIMEOUT"},
+	0xC00A000C: {0xC00A000C, "STATUS_CTX_MODEM_RESPONSE_NO_CARRIER", "STATUS_CTX_MODEM_RESPONSE_NO_CARRIER"},
+	0xC00A000D: {0xC00A000D, "STATUS_CTX_MODEM_RESPONSE_NO_DIALTONE", "STATUS_CTX_MODEM_RESPONSE_NO_DIALTONE"},
+	0xC00A000E: {0xC00A000E, "STATUS_CTX_MODEM_RESPONSE_BUSY", "STATUS_CTX_MODEM_RESPONSE_BUSY"},
+	0xC00A000F: {0xC00A000F, "STATUS_CTX_MODEM_RESPONSE_VOICE", "STATUS_CTX_MODEM_RESPONSE_VOICE"},
+	0xC00A0010: {0xC00A0010, "STATUS_CTX_TD_ERROR", "STATUS_CTX_TD_ERROR"},
+	0xC00A0012: {0xC00A0012, "STATUS_CTX_LICENSE_CLIENT_INVALID", "STATUS_CTX_LICENSE_CLIENT_INVALID"},
+	0xC00A0013: {0xC00A0013, "STATUS_CTX_LICENSE_NOT_AVAILABLE", "STATUS_CTX_LICENSE_NOT_AVAILABLE"},
+	0xC00A0014: {0xC00A0014, "STATUS_CTX_LICENSE_EXPIRED", "STATUS_CTX_LICENSE_EXPIRED"},
+	0xC00A0015: {0xC00A0015, "STATUS_CTX_WINSTATION_NOT_FOUND", "STATUS_CTX_WINSTATION_NOT_FOUND"},
+	0xC00A0016: {0xC00A0016, "STATUS_CTX_WINSTATION_NAME_COLLISION", "STATUS_CTX_WINSTATION_NAME_COLLISION"},
+	0xC00A0017: {0xC00A0017, "STATUS_CTX_WINSTATION_BUSY", "STATUS_CTX_WINSTATION_BUSY"},
+	0xC00A0018: {0xC00A0018, "STATUS_CTX_BAD_VIDEO_MODE", "STATUS_CTX_BAD_VIDEO_MODE"},
+	0xC00A0022: {0xC00A0022, "STATUS_CTX_GRAPHICS_INVALID", "STATUS_CTX_GRAPHICS_INVALID"},
+	0xC00A0024: {0xC00A0024, "STATUS_CTX_NOT_CONSOLE", "STATUS_CTX_NOT_CONSOLE"},
+	0xC00A0026: {0xC00A0026, "STATUS_CTX_CLIENT_QUERY_TIMEOUT", "STATUS_CTX_CLIENT_QUERY_TIMEOUT"},
+	0xC00A0027: {0xC00A0027, "STATUS_CTX_CONSOLE_DISCONNECT", "STATUS_CTX_CONSOLE_DISCONNECT"},
+	0xC00A0028: {0xC00A0028, "STATUS_CTX_CONSOLE_CONNECT", "STATUS_CTX_CONSOLE_CONNECT"},
+	0xC00A002A: {0xC00A002A, "STATUS_CTX_SHADOW_DENIED", "STATUS_CTX_SHADOW_DENIED"},
+	0xC00A002B: {0xC00A002B, "STATUS_CTX_WINSTATION_ACCESS_DENIED", "STATUS_CTX_WINSTATION_ACCESS_DENIED"},
+	0xC00A002E: {0xC00A002E, "STATUS_CTX_INVALID_WD", "STATUS_CTX_INVALID_WD"},
+	0xC00A002F: {0xC00A002F, "STATUS_CTX_WD_NOT_FOUND", "STATUS_CTX_WD_NOT_FOUND"},
+	0xC00A0030: {0xC00A0030, "STATUS_CTX_SHADOW_INVALID", "STATUS_CTX_SHADOW_INVALID"},
+	0xC00A0031: {0xC00A0031, "STATUS_CTX_SHADOW_DISABLED", "STATUS_CTX_SHADOW_DISABLED"},
+	0xC00A0032: {0xC00A0032, "STATUS_RDP_PROTOCOL_ERROR", "STATUS_RDP_PROTOCOL_ERROR"},
+	0xC00A0033: {0xC00A0033, "STATUS_CTX_CLIENT_LICENSE_NOT_SET", "STATUS_CTX_CLIENT_LICENSE_NOT_SET"},
+	0xC00A0034: {0xC00A0034, "STATUS_CTX_CLIENT_LICENSE_IN_USE", "STATUS_CTX_CLIENT_LICENSE_IN_USE"},
+	0xC00A0035: {0xC00A0035, "STATUS_CTX_SHADOW_ENDED_BY_MODE_CHANGE", "STATUS_CTX_SHADOW_ENDED_BY_MODE_CHANGE"},
+	0xC00A0036: {0xC00A0036, "STATUS_CTX_SHADOW_NOT_RUNNING", "STATUS_CTX_SHADOW_NOT_RUNNING"},
+	0xC00A0037: {0xC00A0037, "STATUS_CTX_LOGON_DISABLED", "STATUS_CTX_LOGON_DISABLED"},
+	0xC00A0038: {0xC00A0038, "STATUS_CTX_SECURITY_LAYER_ERROR", "STATUS_CTX_SECURITY_LAYER_ERROR"},
+	0xC00A0039: {0xC00A0039, "STATUS_TS_INCOMPATIBLE_SESSIONS", "STATUS_TS_INCOMPATIBLE_SESSIONS"},
+	0xC00A003A: {0xC00A003A, "STATUS_TS_VIDEO_SUBSYSTEM_ERROR", "STATUS_TS_VIDEO_SUBSYSTEM_ERROR"},
+	0xC0040035: {0xC0040035, "STATUS_PNP_BAD_MPS_TABLE", "STATUS_PNP_BAD_MPS_TABLE"},
+	0xC0040036: {0xC0040036, "STATUS_PNP_TRANSLATION_FAILED", "STATUS_PNP_TRANSLATION_FAILED"},
+	0xC0040037: {0xC0040037, "STATUS_PNP_IRQ_TRANSLATION_FAILED", "STATUS_PNP_IRQ_TRANSLATION_FAILED"},
+	0xC0040038: {0xC0040038, "STATUS_PNP_INVALID_ID", "STATUS_PNP_INVALID_ID"},
+	0xC0040039: {0xC0040039, "STATUS_IO_REISSUE_AS_CACHED", "STATUS_IO_REISSUE_AS_CACHED"},
+	0xC00B0001: {0xC00B0001, "STATUS_MUI_FILE_NOT_FOUND", "STATUS_MUI_FILE_NOT_FOUND"},
+	0xC00B0002: {0xC00B0002, "STATUS_MUI_INVALID_FILE", "STATUS_MUI_INVALID_FILE"},
+	0xC00B0003: {0xC00B0003, "STATUS_MUI_INVALID_RC_CONFIG", "STATUS_MUI_INVALID_RC_CONFIG"},
+	0xC00B0004: {0xC00B0004, "STATUS_MUI_INVALID_LOCALE_NAME", "STATUS_MUI_INVALID_LOCALE_NAME"},
+	0xC00B0005: {0xC00B0005, "STATUS_MUI_INVALID_ULTIMATEFALLBACK_NAME", "STATUS_MUI_INVALID_ULTIMATEFALLBACK_NAME"},
+	0xC00B0006: {0xC00B0006, "STATUS_MUI_FILE_NOT_LOADED", "STATUS_MUI_FILE_NOT_LOADED"},
+	0xC00B0007: {0xC00B0007, "STATUS_RESOURCE_ENUM_USER_STOP", "STATUS_RESOURCE_ENUM_USER_STOP"},
+	0xC01C0001: {0xC01C0001, "STATUS_FLT_NO_HANDLER_DEFINED", "STATUS_FLT_NO_HANDLER_DEFINED"},
+	0xC01C0002: {0xC01C0002, "STATUS_FLT_CONTEXT_ALREADY_DEFINED", "STATUS_FLT_CONTEXT_ALREADY_DEFINED"},
+	0xC01C0003: {0xC01C0003, "STATUS_FLT_INVALID_ASYNCHRONOUS_REQUEST", "STATUS_FLT_INVALID_ASYNCHRONOUS_REQUEST"},
+	0xC01C0004: {0xC01C0004, "STATUS_FLT_DISALLOW_FAST_IO", "STATUS_FLT_DISALLOW_FAST_IO"},
+	0xC01C0005: {0xC01C0005, "STATUS_FLT_INVALID_NAME_REQUEST", "STATUS_FLT_INVALID_NAME_REQUEST"},
+	0xC01C0006: {0xC01C0006, "STATUS_FLT_NOT_SAFE_TO_POST_OPERATION", "STATUS_FLT_NOT_SAFE_TO_POST_OPERATION"},
+	0xC01C0007: {0xC01C0007, "STATUS_FLT_NOT_INITIALIZED", "STATUS_FLT_NOT_INITIALIZED"},
+	0xC01C0008: {0xC01C0008, "STATUS_FLT_FILTER_NOT_READY", "STATUS_FLT_FILTER_NOT_READY"},
+	0xC01C0009: {0xC01C0009, "STATUS_FLT_POST_OPERATION_CLEANUP", "STATUS_FLT_POST_OPERATION_CLEANUP"},
+	0xC01C000A: {0xC01C000A, "STATUS_FLT_INTERNAL_ERROR", "STATUS_FLT_INTERNAL_ERROR"},
+	0xC01C000B: {0xC01C000B, "STATUS_FLT_DELETING_OBJECT", "STATUS_FLT_DELETING_OBJECT"},
+	0xC01C000C: {0xC01C000C, "STATUS_FLT_MUST_BE_NONPAGED_POOL", "STATUS_FLT_MUST_BE_NONPAGED_POOL"},
+	0xC01C000D: {0xC01C000D, "STATUS_FLT_DUPLICATE_ENTRY", "STATUS_FLT_DUPLICATE_ENTRY"},
+	0xC01C000E: {0xC01C000E, "STATUS_FLT_CBDQ_DISABLED", "STATUS_FLT_CBDQ_DISABLED"},
+	0xC01C000F: {0xC01C000F, "STATUS_FLT_DO_NOT_ATTACH", "STATUS_FLT_DO_NOT_ATTACH"},
+	0xC01C0010: {0xC01C0010, "STATUS_FLT_DO_NOT_DETACH", "STATUS_FLT_DO_NOT_DETACH"},
+	0xC01C0011: {0xC01C0011, "STATUS_FLT_INSTANCE_ALTITUDE_COLLISION", "STATUS_FLT_INSTANCE_ALTITUDE_COLLISION"},
+	0xC01C0012: {0xC01C0012, "STATUS_FLT_INSTANCE_NAME_COLLISION", "STATUS_FLT_INSTANCE_NAME_COLLISION"},
+	0xC01C0013: {0xC01C0013, "STATUS_FLT_FILTER_NOT_FOUND", "STATUS_FLT_FILTER_NOT_FOUND"},
+	0xC01C0014: {0xC01C0014, "STATUS_FLT_VOLUME_NOT_FOUND", "STATUS_FLT_VOLUME_NOT_FOUND"},
+	0xC01C0015: {0xC01C0015, "STATUS_FLT_INSTANCE_NOT_FOUND", "STATUS_FLT_INSTANCE_NOT_FOUND"},
+	0xC01C0016: {0xC01C0016, "STATUS_FLT_CONTEXT_ALLOCATION_NOT_FOUND", "STATUS_FLT_CONTEXT_ALLOCATION_NOT_FOUND"},
+	0xC01C0017: {0xC01C0017, "STATUS_FLT_INVALID_CONTEXT_REGISTRATION", "STATUS_FLT_INVALID_CONTEXT_REGISTRATION"},
+	0xC01C0018: {0xC01C0018, "STATUS_FLT_NAME_CACHE_MISS", "STATUS_FLT_NAME_CACHE_MISS"},
+	0xC01C0019: {0xC01C0019, "STATUS_FLT_NO_DEVICE_OBJECT", "STATUS_FLT_NO_DEVICE_OBJECT"},
+	0xC01C001A: {0xC01C001A, "STATUS_FLT_VOLUME_ALREADY_MOUNTED", "STATUS_FLT_VOLUME_ALREADY_MOUNTED"},
+	0xC01C001B: {0xC01C001B, "STATUS_FLT_ALREADY_ENLISTED", "STATUS_FLT_ALREADY_ENLISTED"},
+	0xC01C001C: {0xC01C001C, "STATUS_FLT_CONTEXT_ALREADY_LINKED", "STATUS_FLT_CONTEXT_ALREADY_LINKED"},
+	0xC01C0020: {0xC01C0020, "STATUS_FLT_NO_WAITER_FOR_REPLY", "STATUS_FLT_NO_WAITER_FOR_REPLY"},
+	0xC01C0023: {0xC01C0023, "STATUS_FLT_REGISTRATION_BUSY", "STATUS_FLT_REGISTRATION_BUSY"},
+	0xC0150001: {0xC0150001, "STATUS_SXS_SECTION_NOT_FOUND", "STATUS_SXS_SECTION_NOT_FOUND"},
+	0xC0150002: {0xC0150002, "STATUS_SXS_CANT_GEN_ACTCTX", "STATUS_SXS_CANT_GEN_ACTCTX"},
+	0xC0150003: {0xC0150003, "STATUS_SXS_INVALID_ACTCTXDATA_FORMAT", "STATUS_SXS_INVALID_ACTCTXDATA_FORMAT"},
+	0xC0150004: {0xC0150004, "STATUS_SXS_ASSEMBLY_NOT_FOUND", "STATUS_SXS_ASSEMBLY_NOT_FOUND"},
+	0xC0150005: {0xC0150005, "STATUS_SXS_MANIFEST_FORMAT_ERROR", "STATUS_SXS_MANIFEST_FORMAT_ERROR"},
+	0xC0150006: {0xC0150006, "STATUS_SXS_MANIFEST_PARSE_ERROR", "STATUS_SXS_MANIFEST_PARSE_ERROR"},
+	0xC0150007: {0xC0150007, "STATUS_SXS_ACTIVATION_CONTEXT_DISABLED", "STATUS_SXS_ACTIVATION_CONTEXT_DISABLED"},
+	0xC0150008: {0xC0150008, "STATUS_SXS_KEY_NOT_FOUND", "STATUS_SXS_KEY_NOT_FOUND"},
+	0xC0150009: {0xC0150009, "STATUS_SXS_VERSION_CONFLICT", "STATUS_SXS_VERSION_CONFLICT"},
+	0xC015000A: {0xC015000A, "STATUS_SXS_WRONG_SECTION_TYPE", "STATUS_SXS_WRONG_SECTION_TYPE"},
+	0xC015000B: {0xC015000B, "STATUS_SXS_THREAD_QUERIES_DISABLED", "STATUS_SXS_THREAD_QUERIES_DISABLED"},
+	0xC015000C: {0xC015000C, "STATUS_SXS_ASSEMBLY_MISSING", "STATUS_SXS_ASSEMBLY_MISSING"},
+	0x4015000D: {0x4015000D, "STATUS_SXS_RELEASE_ACTIVATION_CONTEXT", "STATUS_SXS_RELEASE_ACTIVATION_CONTEXT"},
+	0xC015000E: {0xC015000E, "STATUS_SXS_PROCESS_DEFAULT_ALREADY_SET", "STATUS_SXS_PROCESS_DEFAULT_ALREADY_SET"},
+	0xC015000F: {0xC015000F, "STATUS_SXS_EARLY_DEACTIVATION", "STATUS_SXS_EARLY_DEACTIVATION"},
+	0xC0150010: {0xC0150010, "STATUS_SXS_INVALID_DEACTIVATION", "STATUS_SXS_INVALID_DEACTIVATION"},
+	0xC0150011: {0xC0150011, "STATUS_SXS_MULTIPLE_DEACTIVATION", "STATUS_SXS_MULTIPLE_DEACTIVATION"},
+	0xC0150012: {0xC0150012, "STATUS_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY", "STATUS_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY"},
+	0xC0150013: {0xC0150013, "STATUS_SXS_PROCESS_TERMINATION_REQUESTED", "STATUS_SXS_PROCESS_TERMINATION_REQUESTED"},
+	0xC0150014: {0xC0150014, "STATUS_SXS_CORRUPT_ACTIVATION_STACK", "STATUS_SXS_CORRUPT_ACTIVATION_STACK"},
+	0xC0150015: {0xC0150015, "STATUS_SXS_CORRUPTION", "STATUS_SXS_CORRUPTION"},
+	0xC0150016: {0xC0150016, "STATUS_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE", "STATUS_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE"},
+	0xC0150017: {0xC0150017, "STATUS_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME", "STATUS_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME"},
+	0xC0150018: {0xC0150018, "STATUS_SXS_IDENTITY_DUPLICATE_ATTRIBUTE", "STATUS_SXS_IDENTITY_DUPLICATE_ATTRIBUTE"},
+	0xC0150019: {0xC0150019, "STATUS_SXS_IDENTITY_PARSE_ERROR", "STATUS_SXS_IDENTITY_PARSE_ERROR"},
+	0xC015001A: {0xC015001A, "STATUS_SXS_COMPONENT_STORE_CORRUPT", "STATUS_SXS_COMPONENT_STORE_CORRUPT"},
+	0xC015001B: {0xC015001B, "STATUS_SXS_FILE_HASH_MISMATCH", "STATUS_SXS_FILE_HASH_MISMATCH"},
+	0xC015001C: {0xC015001C, "STATUS_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT", "STATUS_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT"},
+	0xC015001D: {0xC015001D, "STATUS_SXS_IDENTITIES_DIFFERENT", "STATUS_SXS_IDENTITIES_DIFFERENT"},
+	0xC015001E: {0xC015001E, "STATUS_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT", "STATUS_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT"},
+	0xC015001F: {0xC015001F, "STATUS_SXS_FILE_NOT_PART_OF_ASSEMBLY", "STATUS_SXS_FILE_NOT_PART_OF_ASSEMBLY"},
+	0xC0150020: {0xC0150020, "STATUS_ADVANCED_INSTALLER_FAILED", "STATUS_ADVANCED_INSTALLER_FAILED"},
+	0xC0150021: {0xC0150021, "STATUS_XML_ENCODING_MISMATCH", "STATUS_XML_ENCODING_MISMATCH"},
+	0xC0150022: {0xC0150022, "STATUS_SXS_MANIFEST_TOO_BIG", "STATUS_SXS_MANIFEST_TOO_BIG"},
+	0xC0150023: {0xC0150023, "STATUS_SXS_SETTING_NOT_REGISTERED", "STATUS_SXS_SETTING_NOT_REGISTERED"},
+	0xC0150024: {0xC0150024, "STATUS_SXS_TRANSACTION_CLOSURE_INCOMPLETE", "STATUS_SXS_TRANSACTION_CLOSURE_INCOMPLETE"},
+	0xC0150025: {0xC0150025, "STATUS_SMI_PRIMITIVE_INSTALLER_FAILED", "STATUS_SMI_PRIMITIVE_INSTALLER_FAILED"},
+	0xC0150026: {0xC0150026, "STATUS_GENERIC_COMMAND_FAILED", "STATUS_GENERIC_COMMAND_FAILED"},
+	0xC0150027: {0xC0150027, "STATUS_SXS_FILE_HASH_MISSING", "STATUS_SXS_FILE_HASH_MISSING"},
+	0xC0130001: {0xC0130001, "STATUS_CLUSTER_INVALID_NODE", "STATUS_CLUSTER_INVALID_NODE"},
+	0xC0130002: {0xC0130002, "STATUS_CLUSTER_NODE_EXISTS", "STATUS_CLUSTER_NODE_EXISTS"},
+	0xC0130003: {0xC0130003, "STATUS_CLUSTER_JOIN_IN_PROGRESS", "STATUS_CLUSTER_JOIN_IN_PROGRESS"},
+	0xC0130004: {0xC0130004, "STATUS_CLUSTER_NODE_NOT_FOUND", "STATUS_CLUSTER_NODE_NOT_FOUND"},
+	0xC0130005: {0xC0130005, "STATUS_CLUSTER_LOCAL_NODE_NOT_FOUND", "STATUS_CLUSTER_LOCAL_NODE_NOT_FOUND"},
+	0xC0130006: {0xC0130006, "STATUS_CLUSTER_NETWORK_EXISTS", "STATUS_CLUSTER_NETWORK_EXISTS"},
+	0xC0130007: {0xC0130007, "STATUS_CLUSTER_NETWORK_NOT_FOUND", "STATUS_CLUSTER_NETWORK_NOT_FOUND"},
+	0xC0130008: {0xC0130008, "STATUS_CLUSTER_NETINTERFACE_EXISTS", "STATUS_CLUSTER_NETINTERFACE_EXISTS"},
+	0xC0130009: {0xC0130009, "STATUS_CLUSTER_NETINTERFACE_NOT_FOUND", "STATUS_CLUSTER_NETINTERFACE_NOT_FOUND"},
+	0xC013000A: {0xC013000A, "STATUS_CLUSTER_INVALID_REQUEST", "STATUS_CLUSTER_INVALID_REQUEST"},
+	0xC013000B: {0xC013000B, "STATUS_CLUSTER_INVALID_NETWORK_PROVIDER", "STATUS_CLUSTER_INVALID_NETWORK_PROVIDER"},
+	0xC013000C: {0xC013000C, "STATUS_CLUSTER_NODE_DOWN", "STATUS_CLUSTER_NODE_DOWN"},
+	0xC013000D: {0xC013000D, "STATUS_CLUSTER_NODE_UNREACHABLE", "STATUS_CLUSTER_NODE_UNREACHABLE"},
+	0xC013000E: {0xC013000E, "STATUS_CLUSTER_NODE_NOT_MEMBER", "STATUS_CLUSTER_NODE_NOT_MEMBER"},
+	0xC013000F: {0xC013000F, "STATUS_CLUSTER_JOIN_NOT_IN_PROGRESS", "STATUS_CLUSTER_JOIN_NOT_IN_PROGRESS"},
+	0xC0130010: {0xC0130010, "STATUS_CLUSTER_INVALID_NETWORK", "STATUS_CLUSTER_INVALID_NETWORK"},
+	0xC0130011: {0xC0130011, "STATUS_CLUSTER_NO_NET_ADAPTERS", "STATUS_CLUSTER_NO_NET_ADAPTERS"},
+	0xC0130012: {0xC0130012, "STATUS_CLUSTER_NODE_UP", "STATUS_CLUSTER_NODE_UP"},
+	0xC0130013: {0xC0130013, "STATUS_CLUSTER_NODE_PAUSED", "STATUS_CLUSTER_NODE_PAUSED"},
+	0xC0130014: {0xC0130014, "STATUS_CLUSTER_NODE_NOT_PAUSED", "STATUS_CLUSTER_NODE_NOT_PAUSED"},
+	0xC0130015: {0xC0130015, "STATUS_CLUSTER_NO_SECURITY_CONTEXT", "STATUS_CLUSTER_NO_SECURITY_CONTEXT"},
+	0xC0130016: {0xC0130016, "STATUS_CLUSTER_NETWORK_NOT_INTERNAL", "STATUS_CLUSTER_NETWORK_NOT_INTERNAL"},
+	0xC0130017: {0xC0130017, "STATUS_CLUSTER_POISONED", "STATUS_CLUSTER_POISONED"},
+	0xC0130018: {0xC0130018, "STATUS_CLUSTER_NON_CSV_PATH", "STATUS_CLUSTER_NON_CSV_PATH"},
+	0xC0130019: {0xC0130019, "STATUS_CLUSTER_CSV_VOLUME_NOT_LOCAL", "STATUS_CLUSTER_CSV_VOLUME_NOT_LOCAL"},
+	0xC0130020: {0xC0130020, "STATUS_CLUSTER_CSV_READ_OPLOCK_BREAK_IN_PROGRESS", "STATUS_CLUSTER_CSV_READ_OPLOCK_BREAK_IN_PROGRESS"},
+	0xC0130021: {0xC0130021, "STATUS_CLUSTER_CSV_AUTO_PAUSE_ERROR", "STATUS_CLUSTER_CSV_AUTO_PAUSE_ERROR"},
+	0xC0130022: {0xC0130022, "STATUS_CLUSTER_CSV_REDIRECTED", "STATUS_CLUSTER_CSV_REDIRECTED"},
+	0xC0130023: {0xC0130023, "STATUS_CLUSTER_CSV_NOT_REDIRECTED", "STATUS_CLUSTER_CSV_NOT_REDIRECTED"},
+	0xC0130024: {0xC0130024, "STATUS_CLUSTER_CSV_VOLUME_DRAINING", "STATUS_CLUSTER_CSV_VOLUME_DRAINING"},
+	0xC0130025: {0xC0130025, "STATUS_CLUSTER_CSV_SNAPSHOT_CREATION_IN_PROGRESS", "STATUS_CLUSTER_CSV_SNAPSHOT_CREATION_IN_PROGRESS"},
+	0xC0130026: {0xC0130026, "STATUS_CLUSTER_CSV_VOLUME_DRAINING_SUCCEEDED_DOWNLEVEL", "STATUS_CLUSTER_CSV_VOLUME_DRAINING_SUCCEEDED_DOWNLEVEL"},
+	0xC0130027: {0xC0130027, "STATUS_CLUSTER_CSV_NO_SNAPSHOTS", "STATUS_CLUSTER_CSV_NO_SNAPSHOTS"},
+	0xC0130028: {0xC0130028, "STATUS_CSV_IO_PAUSE_TIMEOUT", "STATUS_CSV_IO_PAUSE_TIMEOUT"},
+	0xC0130029: {0xC0130029, "STATUS_CLUSTER_CSV_INVALID_HANDLE", "STATUS_CLUSTER_CSV_INVALID_HANDLE"},
+	0xC0130030: {0xC0130030, "STATUS_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR", "STATUS_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR"},
+	0xC0130031: {0xC0130031, "STATUS_CLUSTER_CAM_TICKET_REPLAY_DETECTED", "STATUS_CLUSTER_CAM_TICKET_REPLAY_DETECTED"},
+	0xC0190001: {0xC0190001, "STATUS_TRANSACTIONAL_CONFLICT", "STATUS_TRANSACTIONAL_CONFLICT"},
+	0xC0190002: {0xC0190002, "STATUS_INVALID_TRANSACTION", "STATUS_INVALID_TRANSACTION"},
+	0xC0190003: {0xC0190003, "STATUS_TRANSACTION_NOT_ACTIVE", "STATUS_TRANSACTION_NOT_ACTIVE"},
+	0xC0190004: {0xC0190004, "STATUS_TM_INITIALIZATION_FAILED", "STATUS_TM_INITIALIZATION_FAILED"},
+	0xC0190005: {0xC0190005, "STATUS_RM_NOT_ACTIVE", "STATUS_RM_NOT_ACTIVE"},
+	0xC0190006: {0xC0190006, "STATUS_RM_METADATA_CORRUPT", "STATUS_RM_METADATA_CORRUPT"},
+	0xC0190007: {0xC0190007, "STATUS_TRANSACTION_NOT_JOINED", "STATUS_TRANSACTION_NOT_JOINED"},
+	0xC0190008: {0xC0190008, "STATUS_DIRECTORY_NOT_RM", "STATUS_DIRECTORY_NOT_RM"},
+	0x80190009: {0x80190009, "STATUS_COULD_NOT_RESIZE_LOG", "STATUS_COULD_NOT_RESIZE_LOG"},
+	0xC019000A: {0xC019000A, "STATUS_TRANSACTIONS_UNSUPPORTED_REMOTE", "STATUS_TRANSACTIONS_UNSUPPORTED_REMOTE"},
+	0xC019000B: {0xC019000B, "STATUS_LOG_RESIZE_INVALID_SIZE", "STATUS_LOG_RESIZE_INVALID_SIZE"},
+	0xC019000C: {0xC019000C, "STATUS_REMOTE_FILE_VERSION_MISMATCH", "STATUS_REMOTE_FILE_VERSION_MISMATCH"},
+	0xC019000F: {0xC019000F, "STATUS_CRM_PROTOCOL_ALREADY_EXISTS", "STATUS_CRM_PROTOCOL_ALREADY_EXISTS"},
+	0xC0190010: {0xC0190010, "STATUS_TRANSACTION_PROPAGATION_FAILED", "STATUS_TRANSACTION_PROPAGATION_FAILED"},
+	0xC0190011: {0xC0190011, "STATUS_CRM_PROTOCOL_NOT_FOUND", "STATUS_CRM_PROTOCOL_NOT_FOUND"},
+	0xC0190012: {0xC0190012, "STATUS_TRANSACTION_SUPERIOR_EXISTS", "STATUS_TRANSACTION_SUPERIOR_EXISTS"},
+	0xC0190013: {0xC0190013, "STATUS_TRANSACTION_REQUEST_NOT_VALID", "STATUS_TRANSACTION_REQUEST_NOT_VALID"},
+	0xC0190014: {0xC0190014, "STATUS_TRANSACTION_NOT_REQUESTED", "STATUS_TRANSACTION_NOT_REQUESTED"},
+	0xC0190015: {0xC0190015, "STATUS_TRANSACTION_ALREADY_ABORTED", "STATUS_TRANSACTION_ALREADY_ABORTED"},
+	0xC0190016: {0xC0190016, "STATUS_TRANSACTION_ALREADY_COMMITTED", "STATUS_TRANSACTION_ALREADY_COMMITTED"},
+	0xC0190017: {0xC0190017, "STATUS_TRANSACTION_INVALID_MARSHALL_BUFFER", "STATUS_TRANSACTION_INVALID_MARSHALL_BUFFER"},
+	0xC0190018: {0xC0190018, "STATUS_CURRENT_TRANSACTION_NOT_VALID", "STATUS_CURRENT_TRANSACTION_NOT_VALID"},
+	0xC0190019: {0xC0190019, "STATUS_LOG_GROWTH_FAILED", "STATUS_LOG_GROWTH_FAILED"},
+	0xC0190021: {0xC0190021, "STATUS_OBJECT_NO_LONGER_EXISTS", "STATUS_OBJECT_NO_LONGER_EXISTS"},
+	0xC0190022: {0xC0190022, "STATUS_STREAM_MINIVERSION_NOT_FOUND", "STATUS_STREAM_MINIVERSION_NOT_FOUND"},
+	0xC0190023: {0xC0190023, "STATUS_STREAM_MINIVERSION_NOT_VALID", "STATUS_STREAM_MINIVERSION_NOT_VALID"},
+	0xC0190024: {0xC0190024, "STATUS_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION", "STATUS_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION"},
+	0xC0190025: {0xC0190025, "STATUS_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT", "STATUS_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT"},
+	0xC0190026: {0xC0190026, "STATUS_CANT_CREATE_MORE_STREAM_MINIVERSIONS", "STATUS_CANT_CREATE_MORE_STREAM_MINIVERSIONS"},
+	0xC0190028: {0xC0190028, "STATUS_HANDLE_NO_LONGER_VALID", "STATUS_HANDLE_NO_LONGER_VALID"},
+	0x80190029: {0x80190029, "STATUS_NO_TXF_METADATA", "STATUS_NO_TXF_METADATA"},
+	0xC0190030: {0xC0190030, "STATUS_LOG_CORRUPTION_DETECTED", "STATUS_LOG_CORRUPTION_DETECTED"},
+	0x80190031: {0x80190031, "STATUS_CANT_RECOVER_WITH_HANDLE_OPEN", "STATUS_CANT_RECOVER_WITH_HANDLE_OPEN"},
+	0xC0190032: {0xC0190032, "STATUS_RM_DISCONNECTED", "STATUS_RM_DISCONNECTED"},
+	0xC0190033: {0xC0190033, "STATUS_ENLISTMENT_NOT_SUPERIOR", "STATUS_ENLISTMENT_NOT_SUPERIOR"},
+	0x40190034: {0x40190034, "STATUS_RECOVERY_NOT_NEEDED", "STATUS_RECOVERY_NOT_NEEDED"},
+	0x40190035: {0x40190035, "STATUS_RM_ALREADY_STARTED", "STATUS_RM_ALREADY_STARTED"},
+	0xC0190036: {0xC0190036, "STATUS_FILE_IDENTITY_NOT_PERSISTENT", "STATUS_FILE_IDENTITY_NOT_PERSISTENT"},
+	0xC0190037: {0xC0190037, "STATUS_CANT_BREAK_TRANSACTIONAL_DEPENDENCY", "STATUS_CANT_BREAK_TRANSACTIONAL_DEPENDENCY"},
+	0xC0190038: {0xC0190038, "STATUS_CANT_CROSS_RM_BOUNDARY", "STATUS_CANT_CROSS_RM_BOUNDARY"},
+	0xC0190039: {0xC0190039, "STATUS_TXF_DIR_NOT_EMPTY", "STATUS_TXF_DIR_NOT_EMPTY"},
+	0xC019003A: {0xC019003A, "STATUS_INDOUBT_TRANSACTIONS_EXIST", "STATUS_INDOUBT_TRANSACTIONS_EXIST"},
+	0xC019003B: {0xC019003B, "STATUS_TM_VOLATILE", "STATUS_TM_VOLATILE"},
+	0xC019003C: {0xC019003C, "STATUS_ROLLBACK_TIMER_EXPIRED", "STATUS_ROLLBACK_TIMER_EXPIRED"},
+	0xC019003D: {0xC019003D, "STATUS_TXF_ATTRIBUTE_CORRUPT", "STATUS_TXF_ATTRIBUTE_CORRUPT"},
+	0xC019003E: {0xC019003E, "STATUS_EFS_NOT_ALLOWED_IN_TRANSACTION", "STATUS_EFS_NOT_ALLOWED_IN_TRANSACTION"},
+	0xC019003F: {0xC019003F, "STATUS_TRANSACTIONAL_OPEN_NOT_ALLOWED", "STATUS_TRANSACTIONAL_OPEN_NOT_ALLOWED"},
+	0xC0190040: {0xC0190040, "STATUS_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE", "STATUS_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE"},
+	0x80190041: {0x80190041, "STATUS_TXF_METADATA_ALREADY_PRESENT", "STATUS_TXF_METADATA_ALREADY_PRESENT"},
+	0x80190042: {0x80190042, "STATUS_TRANSACTION_SCOPE_CALLBACKS_NOT_SET", "STATUS_TRANSACTION_SCOPE_CALLBACKS_NOT_SET"},
+	0xC0190043: {0xC0190043, "STATUS_TRANSACTION_REQUIRED_PROMOTION", "STATUS_TRANSACTION_REQUIRED_PROMOTION"},
+	0xC0190044: {0xC0190044, "STATUS_CANNOT_EXECUTE_FILE_IN_TRANSACTION", "STATUS_CANNOT_EXECUTE_FILE_IN_TRANSACTION"},
+	0xC0190045: {0xC0190045, "STATUS_TRANSACTIONS_NOT_FROZEN", "STATUS_TRANSACTIONS_NOT_FROZEN"},
+	0xC0190046: {0xC0190046, "STATUS_TRANSACTION_FREEZE_IN_PROGRESS", "STATUS_TRANSACTION_FREEZE_IN_PROGRESS"},
+	0xC0190047: {0xC0190047, "STATUS_NOT_SNAPSHOT_VOLUME", "STATUS_NOT_SNAPSHOT_VOLUME"},
+	0xC0190048: {0xC0190048, "STATUS_NO_SAVEPOINT_WITH_OPEN_FILES", "STATUS_NO_SAVEPOINT_WITH_OPEN_FILES"},
+	0xC0190049: {0xC0190049, "STATUS_SPARSE_NOT_ALLOWED_IN_TRANSACTION", "STATUS_SPARSE_NOT_ALLOWED_IN_TRANSACTION"},
+	0xC019004A: {0xC019004A, "STATUS_TM_IDENTITY_MISMATCH", "STATUS_TM_IDENTITY_MISMATCH"},
+	0xC019004B: {0xC019004B, "STATUS_FLOATED_SECTION", "STATUS_FLOATED_SECTION"},
+	0xC019004C: {0xC019004C, "STATUS_CANNOT_ACCEPT_TRANSACTED_WORK", "STATUS_CANNOT_ACCEPT_TRANSACTED_WORK"},
+	0xC019004D: {0xC019004D, "STATUS_CANNOT_ABORT_TRANSACTIONS", "STATUS_CANNOT_ABORT_TRANSACTIONS"},
+	0xC019004E: {0xC019004E, "STATUS_TRANSACTION_NOT_FOUND", "STATUS_TRANSACTION_NOT_FOUND"},
+	0xC019004F: {0xC019004F, "STATUS_RESOURCEMANAGER_NOT_FOUND", "STATUS_RESOURCEMANAGER_NOT_FOUND"},
+	0xC0190050: {0xC0190050, "STATUS_ENLISTMENT_NOT_FOUND", "STATUS_ENLISTMENT_NOT_FOUND"},
+	0xC0190051: {0xC0190051, "STATUS_TRANSACTIONMANAGER_NOT_FOUND", "STATUS_TRANSACTIONMANAGER_NOT_FOUND"},
+	0xC0190052: {0xC0190052, "STATUS_TRANSACTIONMANAGER_NOT_ONLINE", "STATUS_TRANSACTIONMANAGER_NOT_ONLINE"},
+	0xC0190053: {0xC0190053, "STATUS_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION", "STATUS_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION"},
+	0xC0190054: {0xC0190054, "STATUS_TRANSACTION_NOT_ROOT", "STATUS_TRANSACTION_NOT_ROOT"},
+	0xC0190055: {0xC0190055, "STATUS_TRANSACTION_OBJECT_EXPIRED", "STATUS_TRANSACTION_OBJECT_EXPIRED"},
+	0xC0190056: {0xC0190056, "STATUS_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION", "STATUS_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION"},
+	0xC0190057: {0xC0190057, "STATUS_TRANSACTION_RESPONSE_NOT_ENLISTED", "STATUS_TRANSACTION_RESPONSE_NOT_ENLISTED"},
+	0xC0190058: {0xC0190058, "STATUS_TRANSACTION_RECORD_TOO_LONG", "STATUS_TRANSACTION_RECORD_TOO_LONG"},
+	0xC0190059: {0xC0190059, "STATUS_NO_LINK_TRACKING_IN_TRANSACTION", "STATUS_NO_LINK_TRACKING_IN_TRANSACTION"},
+	0xC019005A: {0xC019005A, "STATUS_OPERATION_NOT_SUPPORTED_IN_TRANSACTION", "STATUS_OPERATION_NOT_SUPPORTED_IN_TRANSACTION"},
+	0xC019005B: {0xC019005B, "STATUS_TRANSACTION_INTEGRITY_VIOLATED", "STATUS_TRANSACTION_INTEGRITY_VIOLATED"},
+	0xC019005C: {0xC019005C, "STATUS_TRANSACTIONMANAGER_IDENTITY_MISMATCH", "STATUS_TRANSACTIONMANAGER_IDENTITY_MISMATCH"},
+	0xC019005D: {0xC019005D, "STATUS_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT", "STATUS_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT"},
+	0xC019005E: {0xC019005E, "STATUS_TRANSACTION_MUST_WRITETHROUGH", "STATUS_TRANSACTION_MUST_WRITETHROUGH"},
+	0xC019005F: {0xC019005F, "STATUS_TRANSACTION_NO_SUPERIOR", "STATUS_TRANSACTION_NO_SUPERIOR"},
+	0xC0190060: {0xC0190060, "STATUS_EXPIRED_HANDLE", "STATUS_EXPIRED_HANDLE"},
+	0xC0190061: {0xC0190061, "STATUS_TRANSACTION_NOT_ENLISTED", "STATUS_TRANSACTION_NOT_ENLISTED"},
+	0xC01A0001: {0xC01A0001, "STATUS_LOG_SECTOR_INVALID", "STATUS_LOG_SECTOR_INVALID"},
+	0xC01A0002: {0xC01A0002, "STATUS_LOG_SECTOR_PARITY_INVALID", "STATUS_LOG_SECTOR_PARITY_INVALID"},
+	0xC01A0003: {0xC01A0003, "STATUS_LOG_SECTOR_REMAPPED", "STATUS_LOG_SECTOR_REMAPPED"},
+	0xC01A0004: {0xC01A0004, "STATUS_LOG_BLOCK_INCOMPLETE", "STATUS_LOG_BLOCK_INCOMPLETE"},
+	0xC01A0005: {0xC01A0005, "STATUS_LOG_INVALID_RANGE", "STATUS_LOG_INVALID_RANGE"},
+	0xC01A0006: {0xC01A0006, "STATUS_LOG_BLOCKS_EXHAUSTED", "STATUS_LOG_BLOCKS_EXHAUSTED"},
+	0xC01A0007: {0xC01A0007, "STATUS_LOG_READ_CONTEXT_INVALID", "STATUS_LOG_READ_CONTEXT_INVALID"},
+	0xC01A0008: {0xC01A0008, "STATUS_LOG_RESTART_INVALID", "STATUS_LOG_RESTART_INVALID"},
+	0xC01A0009: {0xC01A0009, "STATUS_LOG_BLOCK_VERSION", "STATUS_LOG_BLOCK_VERSION"},
+	0xC01A000A: {0xC01A000A, "STATUS_LOG_BLOCK_INVALID", "STATUS_LOG_BLOCK_INVALID"},
+	0xC01A000B: {0xC01A000B, "STATUS_LOG_READ_MODE_INVALID", "STATUS_LOG_READ_MODE_INVALID"},
+	0x401A000C: {0x401A000C, "STATUS_LOG_NO_RESTART", "STATUS_LOG_NO_RESTART"},
+	0xC01A000D: {0xC01A000D, "STATUS_LOG_METADATA_CORRUPT", "STATUS_LOG_METADATA_CORRUPT"},
+	0xC01A000E: {0xC01A000E, "STATUS_LOG_METADATA_INVALID", "STATUS_LOG_METADATA_INVALID"},
+	0xC01A000F: {0xC01A000F, "STATUS_LOG_METADATA_INCONSISTENT", "STATUS_LOG_METADATA_INCONSISTENT"},
+	0xC01A0010: {0xC01A0010, "STATUS_LOG_RESERVATION_INVALID", "STATUS_LOG_RESERVATION_INVALID"},
+	0xC01A0011: {0xC01A0011, "STATUS_LOG_CANT_DELETE", "STATUS_LOG_CANT_DELETE"},
+	0xC01A0012: {0xC01A0012, "STATUS_LOG_CONTAINER_LIMIT_EXCEEDED", "STATUS_LOG_CONTAINER_LIMIT_EXCEEDED"},
+	0xC01A0013: {0xC01A0013, "STATUS_LOG_START_OF_LOG", "STATUS_LOG_START_OF_LOG"},
+	0xC01A0014: {0xC01A0014, "STATUS_LOG_POLICY_ALREADY_INSTALLED", "STATUS_LOG_POLICY_ALREADY_INSTALLED"},
+	0xC01A0015: {0xC01A0015, "STATUS_LOG_POLICY_NOT_INSTALLED", "STATUS_LOG_POLICY_NOT_INSTALLED"},
+	0xC01A0016: {0xC01A0016, "STATUS_LOG_POLICY_INVALID", "STATUS_LOG_POLICY_INVALID"},
+	0xC01A0017: {0xC01A0017, "STATUS_LOG_POLICY_CONFLICT", "STATUS_LOG_POLICY_CONFLICT"},
+	0xC01A0018: {0xC01A0018, "STATUS_LOG_PINNED_ARCHIVE_TAIL", "STATUS_LOG_PINNED_ARCHIVE_TAIL"},
+	0xC01A0019: {0xC01A0019, "STATUS_LOG_RECORD_NONEXISTENT", "STATUS_LOG_RECORD_NONEXISTENT"},
+	0xC01A001A: {0xC01A001A, "STATUS_LOG_RECORDS_RESERVED_INVALID", "STATUS_LOG_RECORDS_RESERVED_INVALID"},
+	0xC01A001B: {0xC01A001B, "STATUS_LOG_SPACE_RESERVED_INVALID", "STATUS_LOG_SPACE_RESERVED_INVALID"},
+	0xC01A001C: {0xC01A001C, "STATUS_LOG_TAIL_INVALID", "STATUS_LOG_TAIL_INVALID"},
+	0xC01A001D: {0xC01A001D, "STATUS_LOG_FULL", "STATUS_LOG_FULL"},
+	0xC01A001E: {0xC01A001E, "STATUS_LOG_MULTIPLEXED", "STATUS_LOG_MULTIPLEXED"},
+	0xC01A001F: {0xC01A001F, "STATUS_LOG_DEDICATED", "STATUS_LOG_DEDICATED"},
+	0xC01A0020: {0xC01A0020, "STATUS_LOG_ARCHIVE_NOT_IN_PROGRESS", "STATUS_LOG_ARCHIVE_NOT_IN_PROGRESS"},
+	0xC01A0021: {0xC01A0021, "STATUS_LOG_ARCHIVE_IN_PROGRESS", "STATUS_LOG_ARCHIVE_IN_PROGRESS"},
+	0xC01A0022: {0xC01A0022, "STATUS_LOG_EPHEMERAL", "STATUS_LOG_EPHEMERAL"},
+	0xC01A0023: {0xC01A0023, "STATUS_LOG_NOT_ENOUGH_CONTAINERS", "STATUS_LOG_NOT_ENOUGH_CONTAINERS"},
+	0xC01A0024: {0xC01A0024, "STATUS_LOG_CLIENT_ALREADY_REGISTERED", "STATUS_LOG_CLIENT_ALREADY_REGISTERED"},
+	0xC01A0025: {0xC01A0025, "STATUS_LOG_CLIENT_NOT_REGISTERED", "STATUS_LOG_CLIENT_NOT_REGISTERED"},
+	0xC01A0026: {0xC01A0026, "STATUS_LOG_FULL_HANDLER_IN_PROGRESS", "STATUS_LOG_FULL_HANDLER_IN_PROGRESS"},
+	0xC01A0027: {0xC01A0027, "STATUS_LOG_CONTAINER_READ_FAILED", "STATUS_LOG_CONTAINER_READ_FAILED"},
+	0xC01A0028: {0xC01A0028, "STATUS_LOG_CONTAINER_WRITE_FAILED", "STATUS_LOG_CONTAINER_WRITE_FAILED"},
+	0xC01A0029: {0xC01A0029, "STATUS_LOG_CONTAINER_OPEN_FAILED", "STATUS_LOG_CONTAINER_OPEN_FAILED"},
+	0xC01A002A: {0xC01A002A, "STATUS_LOG_CONTAINER_STATE_INVALID", "STATUS_LOG_CONTAINER_STATE_INVALID"},
+	0xC01A002B: {0xC01A002B, "STATUS_LOG_STATE_INVALID", "STATUS_LOG_STATE_INVALID"},
+	0xC01A002C: {0xC01A002C, "STATUS_LOG_PINNED", "STATUS_LOG_PINNED"},
+	0xC01A002D: {0xC01A002D, "STATUS_LOG_METADATA_FLUSH_FAILED", "STATUS_LOG_METADATA_FLUSH_FAILED"},
+	0xC01A002E: {0xC01A002E, "STATUS_LOG_INCONSISTENT_SECURITY", "STATUS_LOG_INCONSISTENT_SECURITY"},
+	0xC01A002F: {0xC01A002F, "STATUS_LOG_APPENDED_FLUSH_FAILED", "STATUS_LOG_APPENDED_FLUSH_FAILED"},
+	0xC01A0030: {0xC01A0030, "STATUS_LOG_PINNED_RESERVATION", "STATUS_LOG_PINNED_RESERVATION"},
+	0xC01B00EA: {0xC01B00EA, "STATUS_VIDEO_HUNG_DISPLAY_DRIVER_THREAD", "STATUS_VIDEO_HUNG_DISPLAY_DRIVER_THREAD"},
+	0x801B00EB: {0x801B00EB, "STATUS_VIDEO_HUNG_DISPLAY_DRIVER_THREAD_RECOVERED", "STATUS_VIDEO_HUNG_DISPLAY_DRIVER_THREAD_RECOVERED"},
+	0x401B00EC: {0x401B00EC, "STATUS_VIDEO_DRIVER_DEBUG_REPORT_REQUEST", "STATUS_VIDEO_DRIVER_DEBUG_REPORT_REQUEST"},
+	0xC01D0001: {0xC01D0001, "STATUS_MONITOR_NO_DESCRIPTOR", "STATUS_MONITOR_NO_DESCRIPTOR"},
+	0xC01D0002: {0xC01D0002, "STATUS_MONITOR_UNKNOWN_DESCRIPTOR_FORMAT", "STATUS_MONITOR_UNKNOWN_DESCRIPTOR_FORMAT"},
+	0xC01D0003: {0xC01D0003, "STATUS_MONITOR_INVALID_DESCRIPTOR_CHECKSUM", "STATUS_MONITOR_INVALID_DESCRIPTOR_CHECKSUM"},
+	0xC01D0004: {0xC01D0004, "STATUS_MONITOR_INVALID_STANDARD_TIMING_BLOCK", "STATUS_MONITOR_INVALID_STANDARD_TIMING_BLOCK"},
+	0xC01D0005: {0xC01D0005, "STATUS_MONITOR_WMI_DATABLOCK_REGISTRATION_FAILED", "STATUS_MONITOR_WMI_DATABLOCK_REGISTRATION_FAILED"},
+	0xC01D0006: {0xC01D0006, "STATUS_MONITOR_INVALID_SERIAL_NUMBER_MONDSC_BLOCK", "STATUS_MONITOR_INVALID_SERIAL_NUMBER_MONDSC_BLOCK"},
+	0xC01D0007: {0xC01D0007, "STATUS_MONITOR_INVALID_USER_FRIENDLY_MONDSC_BLOCK", "STATUS_MONITOR_INVALID_USER_FRIENDLY_MONDSC_BLOCK"},
+	0xC01D0008: {0xC01D0008, "STATUS_MONITOR_NO_MORE_DESCRIPTOR_DATA", "STATUS_MONITOR_NO_MORE_DESCRIPTOR_DATA"},
+	0xC01D0009: {0xC01D0009, "STATUS_MONITOR_INVALID_DETAILED_TIMING_BLOCK", "STATUS_MONITOR_INVALID_DETAILED_TIMING_BLOCK"},
+	0xC01D000A: {0xC01D000A, "STATUS_MONITOR_INVALID_MANUFACTURE_DATE", "STATUS_MONITOR_INVALID_MANUFACTURE_DATE"},
+	0xC01E0000: {0xC01E0000, "STATUS_GRAPHICS_NOT_EXCLUSIVE_MODE_OWNER", "STATUS_GRAPHICS_NOT_EXCLUSIVE_MODE_OWNER"},
+	0xC01E0001: {0xC01E0001, "STATUS_GRAPHICS_INSUFFICIENT_DMA_BUFFER", "STATUS_GRAPHICS_INSUFFICIENT_DMA_BUFFER"},
+	0xC01E0002: {0xC01E0002, "STATUS_GRAPHICS_INVALID_DISPLAY_ADAPTER", "STATUS_GRAPHICS_INVALID_DISPLAY_ADAPTER"},
+	0xC01E0003: {0xC01E0003, "STATUS_GRAPHICS_ADAPTER_WAS_RESET", "STATUS_GRAPHICS_ADAPTER_WAS_RESET"},
+	0xC01E0004: {0xC01E0004, "STATUS_GRAPHICS_INVALID_DRIVER_MODEL", "STATUS_GRAPHICS_INVALID_DRIVER_MODEL"},
+	0xC01E0005: {0xC01E0005, "STATUS_GRAPHICS_PRESENT_MODE_CHANGED", "STATUS_GRAPHICS_PRESENT_MODE_CHANGED"},
+	0xC01E0006: {0xC01E0006, "STATUS_GRAPHICS_PRESENT_OCCLUDED", "STATUS_GRAPHICS_PRESENT_OCCLUDED"},
+	0xC01E0007: {0xC01E0007, "STATUS_GRAPHICS_PRESENT_DENIED", "STATUS_GRAPHICS_PRESENT_DENIED"},
+	0xC01E0008: {0xC01E0008, "STATUS_GRAPHICS_CANNOTCOLORCONVERT", "STATUS_GRAPHICS_CANNOTCOLORCONVERT"},
+	0xC01E0009: {0xC01E0009, "STATUS_GRAPHICS_DRIVER_MISMATCH", "STATUS_GRAPHICS_DRIVER_MISMATCH"},
+	0x401E000A: {0x401E000A, "STATUS_GRAPHICS_PARTIAL_DATA_POPULATED", "STATUS_GRAPHICS_PARTIAL_DATA_POPULATED"},
+	0xC01E000B: {0xC01E000B, "STATUS_GRAPHICS_PRESENT_REDIRECTION_DISABLED", "STATUS_GRAPHICS_PRESENT_REDIRECTION_DISABLED"},
+	0xC01E000C: {0xC01E000C, "STATUS_GRAPHICS_PRESENT_UNOCCLUDED", "STATUS_GRAPHICS_PRESENT_UNOCCLUDED"},
+	0xC01E000D: {0xC01E000D, "STATUS_GRAPHICS_WINDOWDC_NOT_AVAILABLE", "STATUS_GRAPHICS_WINDOWDC_NOT_AVAILABLE"},
+	0xC01E000E: {0xC01E000E, "STATUS_GRAPHICS_WINDOWLESS_PRESENT_DISABLED", "STATUS_GRAPHICS_WINDOWLESS_PRESENT_DISABLED"},
+	0xC01E000F: {0xC01E000F, "STATUS_GRAPHICS_PRESENT_INVALID_WINDOW", "STATUS_GRAPHICS_PRESENT_INVALID_WINDOW"},
+	0xC01E0010: {0xC01E0010, "STATUS_GRAPHICS_PRESENT_BUFFER_NOT_BOUND", "STATUS_GRAPHICS_PRESENT_BUFFER_NOT_BOUND"},
+	0xC01E0011: {0xC01E0011, "STATUS_GRAPHICS_VAIL_STATE_CHANGED", "STATUS_GRAPHICS_VAIL_STATE_CHANGED"},
+	0xC01E0012: {0xC01E0012, "STATUS_GRAPHICS_INDIRECT_DISPLAY_ABANDON_SWAPCHAIN", "STATUS_GRAPHICS_INDIRECT_DISPLAY_ABANDON_SWAPCHAIN"},
+	0xC01E0013: {0xC01E0013, "STATUS_GRAPHICS_INDIRECT_DISPLAY_DEVICE_STOPPED", "STATUS_GRAPHICS_INDIRECT_DISPLAY_DEVICE_STOPPED"},
+	0xC01E0100: {0xC01E0100, "STATUS_GRAPHICS_NO_VIDEO_MEMORY", "STATUS_GRAPHICS_NO_VIDEO_MEMORY"},
+	0xC01E0101: {0xC01E0101, "STATUS_GRAPHICS_CANT_LOCK_MEMORY", "STATUS_GRAPHICS_CANT_LOCK_MEMORY"},
+	0xC01E0102: {0xC01E0102, "STATUS_GRAPHICS_ALLOCATION_BUSY", "STATUS_GRAPHICS_ALLOCATION_BUSY"},
+	0xC01E0103: {0xC01E0103, "STATUS_GRAPHICS_TOO_MANY_REFERENCES", "STATUS_GRAPHICS_TOO_MANY_REFERENCES"},
+	0xC01E0104: {0xC01E0104, "STATUS_GRAPHICS_TRY_AGAIN_LATER", "STATUS_GRAPHICS_TRY_AGAIN_LATER"},
+	0xC01E0105: {0xC01E0105, "STATUS_GRAPHICS_TRY_AGAIN_NOW", "STATUS_GRAPHICS_TRY_AGAIN_NOW"},
+	0xC01E0106: {0xC01E0106, "STATUS_GRAPHICS_ALLOCATION_INVALID", "STATUS_GRAPHICS_ALLOCATION_INVALID"},
+	0xC01E0107: {0xC01E0107, "STATUS_GRAPHICS_UNSWIZZLING_APERTURE_UNAVAILABLE", "STATUS_GRAPHICS_UNSWIZZLING_APERTURE_UNAVAILABLE"},
+	0xC01E0108: {0xC01E0108, "STATUS_GRAPHICS_UNSWIZZLING_APERTURE_UNSUPPORTED", "STATUS_GRAPHICS_UNSWIZZLING_APERTURE_UNSUPPORTED"},
+	0xC01E0109: {0xC01E0109, "STATUS_GRAPHICS_CANT_EVICT_PINNED_ALLOCATION", "STATUS_GRAPHICS_CANT_EVICT_PINNED_ALLOCATION"},
+	0xC01E0110: {0xC01E0110, "STATUS_GRAPHICS_INVALID_ALLOCATION_USAGE", "STATUS_GRAPHICS_INVALID_ALLOCATION_USAGE"},
+	0xC01E0111: {0xC01E0111, "STATUS_GRAPHICS_CANT_RENDER_LOCKED_ALLOCATION", "STATUS_GRAPHICS_CANT_RENDER_LOCKED_ALLOCATION"},
+	0xC01E0112: {0xC01E0112, "STATUS_GRAPHICS_ALLOCATION_CLOSED", "STATUS_GRAPHICS_ALLOCATION_CLOSED"},
+	0xC01E0113: {0xC01E0113, "STATUS_GRAPHICS_INVALID_ALLOCATION_INSTANCE", "STATUS_GRAPHICS_INVALID_ALLOCATION_INSTANCE"},
+	0xC01E0114: {0xC01E0114, "STATUS_GRAPHICS_INVALID_ALLOCATION_HANDLE", "STATUS_GRAPHICS_INVALID_ALLOCATION_HANDLE"},
+	0xC01E0115: {0xC01E0115, "STATUS_GRAPHICS_WRONG_ALLOCATION_DEVICE", "STATUS_GRAPHICS_WRONG_ALLOCATION_DEVICE"},
+	0xC01E0116: {0xC01E0116, "STATUS_GRAPHICS_ALLOCATION_CONTENT_LOST", "STATUS_GRAPHICS_ALLOCATION_CONTENT_LOST"},
+	0xC01E0200: {0xC01E0200, "STATUS_GRAPHICS_GPU_EXCEPTION_ON_DEVICE", "STATUS_GRAPHICS_GPU_EXCEPTION_ON_DEVICE"},
+	0x401E0201: {0x401E0201, "STATUS_GRAPHICS_SKIP_ALLOCATION_PREPARATION", "STATUS_GRAPHICS_SKIP_ALLOCATION_PREPARATION"},
+	0xC01E0300: {0xC01E0300, "STATUS_GRAPHICS_INVALID_VIDPN_TOPOLOGY", "STATUS_GRAPHICS_INVALID_VIDPN_TOPOLOGY"},
+	0xC01E0301: {0xC01E0301, "STATUS_GRAPHICS_VIDPN_TOPOLOGY_NOT_SUPPORTED", "STATUS_GRAPHICS_VIDPN_TOPOLOGY_NOT_SUPPORTED"},
+	0xC01E0302: {0xC01E0302, "STATUS_GRAPHICS_VIDPN_TOPOLOGY_CURRENTLY_NOT_SUPPORTED", "STATUS_GRAPHICS_VIDPN_TOPOLOGY_CURRENTLY_NOT_SUPPORTED"},
+	0xC01E0303: {0xC01E0303, "STATUS_GRAPHICS_INVALID_VIDPN", "STATUS_GRAPHICS_INVALID_VIDPN"},
+	0xC01E0304: {0xC01E0304, "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE", "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE"},
+	0xC01E0305: {0xC01E0305, "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET", "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET"},
+	0xC01E0306: {0xC01E0306, "STATUS_GRAPHICS_VIDPN_MODALITY_NOT_SUPPORTED", "STATUS_GRAPHICS_VIDPN_MODALITY_NOT_SUPPORTED"},
+	0x401E0307: {0x401E0307, "STATUS_GRAPHICS_MODE_NOT_PINNED", "STATUS_GRAPHICS_MODE_NOT_PINNED"},
+	0xC01E0308: {0xC01E0308, "STATUS_GRAPHICS_INVALID_VIDPN_SOURCEMODESET", "STATUS_GRAPHICS_INVALID_VIDPN_SOURCEMODESET"},
+	0xC01E0309: {0xC01E0309, "STATUS_GRAPHICS_INVALID_VIDPN_TARGETMODESET", "STATUS_GRAPHICS_INVALID_VIDPN_TARGETMODESET"},
+	0xC01E030A: {0xC01E030A, "STATUS_GRAPHICS_INVALID_FREQUENCY", "STATUS_GRAPHICS_INVALID_FREQUENCY"},
+	0xC01E030B: {0xC01E030B, "STATUS_GRAPHICS_INVALID_ACTIVE_REGION", "STATUS_GRAPHICS_INVALID_ACTIVE_REGION"},
+	0xC01E030C: {0xC01E030C, "STATUS_GRAPHICS_INVALID_TOTAL_REGION", "STATUS_GRAPHICS_INVALID_TOTAL_REGION"},
+	0xC01E0310: {0xC01E0310, "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE_MODE", "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE_MODE"},
+	0xC01E0311: {0xC01E0311, "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET_MODE", "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET_MODE"},
+	0xC01E0312: {0xC01E0312, "STATUS_GRAPHICS_PINNED_MODE_MUST_REMAIN_IN_SET", "STATUS_GRAPHICS_PINNED_MODE_MUST_REMAIN_IN_SET"},
+	0xC01E0313: {0xC01E0313, "STATUS_GRAPHICS_PATH_ALREADY_IN_TOPOLOGY", "STATUS_GRAPHICS_PATH_ALREADY_IN_TOPOLOGY"},
+	0xC01E0314: {0xC01E0314, "STATUS_GRAPHICS_MODE_ALREADY_IN_MODESET", "STATUS_GRAPHICS_MODE_ALREADY_IN_MODESET"},
+	0xC01E0315: {0xC01E0315, "STATUS_GRAPHICS_INVALID_VIDEOPRESENTSOURCESET", "STATUS_GRAPHICS_INVALID_VIDEOPRESENTSOURCESET"},
+	0xC01E0316: {0xC01E0316, "STATUS_GRAPHICS_INVALID_VIDEOPRESENTTARGETSET", "STATUS_GRAPHICS_INVALID_VIDEOPRESENTTARGETSET"},
+	0xC01E0317: {0xC01E0317, "STATUS_GRAPHICS_SOURCE_ALREADY_IN_SET", "STATUS_GRAPHICS_SOURCE_ALREADY_IN_SET"},
+	0xC01E0318: {0xC01E0318, "STATUS_GRAPHICS_TARGET_ALREADY_IN_SET", "STATUS_GRAPHICS_TARGET_ALREADY_IN_SET"},
+	0xC01E0319: {0xC01E0319, "STATUS_GRAPHICS_INVALID_VIDPN_PRESENT_PATH", "STATUS_GRAPHICS_INVALID_VIDPN_PRESENT_PATH"},
+	0xC01E031A: {0xC01E031A, "STATUS_GRAPHICS_NO_RECOMMENDED_VIDPN_TOPOLOGY", "STATUS_GRAPHICS_NO_RECOMMENDED_VIDPN_TOPOLOGY"},
+	0xC01E031B: {0xC01E031B, "STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGESET", "STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGESET"},
+	0xC01E031C: {0xC01E031C, "STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE", "STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE"},
+	0xC01E031D: {0xC01E031D, "STATUS_GRAPHICS_FREQUENCYRANGE_NOT_IN_SET", "STATUS_GRAPHICS_FREQUENCYRANGE_NOT_IN_SET"},
+	0x401E031E: {0x401E031E, "STATUS_GRAPHICS_NO_PREFERRED_MODE", "STATUS_GRAPHICS_NO_PREFERRED_MODE"},
+	0xC01E031F: {0xC01E031F, "STATUS_GRAPHICS_FREQUENCYRANGE_ALREADY_IN_SET", "STATUS_GRAPHICS_FREQUENCYRANGE_ALREADY_IN_SET"},
+	0xC01E0320: {0xC01E0320, "STATUS_GRAPHICS_STALE_MODESET", "STATUS_GRAPHICS_STALE_MODESET"},
+	0xC01E0321: {0xC01E0321, "STATUS_GRAPHICS_INVALID_MONITOR_SOURCEMODESET", "STATUS_GRAPHICS_INVALID_MONITOR_SOURCEMODESET"},
+	0xC01E0322: {0xC01E0322, "STATUS_GRAPHICS_INVALID_MONITOR_SOURCE_MODE", "STATUS_GRAPHICS_INVALID_MONITOR_SOURCE_MODE"},
+	0xC01E0323: {0xC01E0323, "STATUS_GRAPHICS_NO_RECOMMENDED_FUNCTIONAL_VIDPN", "STATUS_GRAPHICS_NO_RECOMMENDED_FUNCTIONAL_VIDPN"},
+	0xC01E0324: {0xC01E0324, "STATUS_GRAPHICS_MODE_ID_MUST_BE_UNIQUE", "STATUS_GRAPHICS_MODE_ID_MUST_BE_UNIQUE"},
+	0xC01E0325: {0xC01E0325, "STATUS_GRAPHICS_EMPTY_ADAPTER_MONITOR_MODE_SUPPORT_INTERSECTION", "STATUS_GRAPHICS_EMPTY_ADAPTER_MONITOR_MODE_SUPPORT_INTERSECTION"},
+	0xC01E0326: {0xC01E0326, "STATUS_GRAPHICS_VIDEO_PRESENT_TARGETS_LESS_THAN_SOURCES", "STATUS_GRAPHICS_VIDEO_PRESENT_TARGETS_LESS_THAN_SOURCES"},
+	0xC01E0327: {0xC01E0327, "STATUS_GRAPHICS_PATH_NOT_IN_TOPOLOGY", "STATUS_GRAPHICS_PATH_NOT_IN_TOPOLOGY"},
+	0xC01E0328: {0xC01E0328, "STATUS_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_SOURCE", "STATUS_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_SOURCE"},
+	0xC01E0329: {0xC01E0329, "STATUS_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_TARGET", "STATUS_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_TARGET"},
+	0xC01E032A: {0xC01E032A, "STATUS_GRAPHICS_INVALID_MONITORDESCRIPTORSET", "STATUS_GRAPHICS_INVALID_MONITORDESCRIPTORSET"},
+	0xC01E032B: {0xC01E032B, "STATUS_GRAPHICS_INVALID_MONITORDESCRIPTOR", "STATUS_GRAPHICS_INVALID_MONITORDESCRIPTOR"},
+	0xC01E032C: {0xC01E032C, "STATUS_GRAPHICS_MONITORDESCRIPTOR_NOT_IN_SET", "STATUS_GRAPHICS_MONITORDESCRIPTOR_NOT_IN_SET"},
+	0xC01E032D: {0xC01E032D, "STATUS_GRAPHICS_MONITORDESCRIPTOR_ALREADY_IN_SET", "STATUS_GRAPHICS_MONITORDESCRIPTOR_ALREADY_IN_SET"},
+	0xC01E032E: {0xC01E032E, "STATUS_GRAPHICS_MONITORDESCRIPTOR_ID_MUST_BE_UNIQUE", "STATUS_GRAPHICS_MONITORDESCRIPTOR_ID_MUST_BE_UNIQUE"},
+	0xC01E032F: {0xC01E032F, "STATUS_GRAPHICS_INVALID_VIDPN_TARGET_SUBSET_TYPE", "STATUS_GRAPHICS_INVALID_VIDPN_TARGET_SUBSET_TYPE"},
+	0xC01E0330: {0xC01E0330, "STATUS_GRAPHICS_RESOURCES_NOT_RELATED", "STATUS_GRAPHICS_RESOURCES_NOT_RELATED"},
+	0xC01E0331: {0xC01E0331, "STATUS_GRAPHICS_SOURCE_ID_MUST_BE_UNIQUE", "STATUS_GRAPHICS_SOURCE_ID_MUST_BE_UNIQUE"},
+	0xC01E0332: {0xC01E0332, "STATUS_GRAPHICS_TARGET_ID_MUST_BE_UNIQUE", "STATUS_GRAPHICS_TARGET_ID_MUST_BE_UNIQUE"},
+	0xC01E0333: {0xC01E0333, "STATUS_GRAPHICS_NO_AVAILABLE_VIDPN_TARGET", "STATUS_GRAPHICS_NO_AVAILABLE_VIDPN_TARGET"},
+	0xC01E0334: {0xC01E0334, "STATUS_GRAPHICS_MONITOR_COULD_NOT_BE_ASSOCIATED_WITH_ADAPTER", "STATUS_GRAPHICS_MONITOR_COULD_NOT_BE_ASSOCIATED_WITH_ADAPTER"},
+	0xC01E0335: {0xC01E0335, "STATUS_GRAPHICS_NO_VIDPNMGR", "STATUS_GRAPHICS_NO_VIDPNMGR"},
+	0xC01E0336: {0xC01E0336, "STATUS_GRAPHICS_NO_ACTIVE_VIDPN", "STATUS_GRAPHICS_NO_ACTIVE_VIDPN"},
+	0xC01E0337: {0xC01E0337, "STATUS_GRAPHICS_STALE_VIDPN_TOPOLOGY", "STATUS_GRAPHICS_STALE_VIDPN_TOPOLOGY"},
+	0xC01E0338: {0xC01E0338, "STATUS_GRAPHICS_MONITOR_NOT_CONNECTED", "STATUS_GRAPHICS_MONITOR_NOT_CONNECTED"},
+	0xC01E0339: {0xC01E0339, "STATUS_GRAPHICS_SOURCE_NOT_IN_TOPOLOGY", "STATUS_GRAPHICS_SOURCE_NOT_IN_TOPOLOGY"},
+	0xC01E033A: {0xC01E033A, "STATUS_GRAPHICS_INVALID_PRIMARYSURFACE_SIZE", "STATUS_GRAPHICS_INVALID_PRIMARYSURFACE_SIZE"},
+	0xC01E033B: {0xC01E033B, "STATUS_GRAPHICS_INVALID_VISIBLEREGION_SIZE", "STATUS_GRAPHICS_INVALID_VISIBLEREGION_SIZE"},
+	0xC01E033C: {0xC01E033C, "STATUS_GRAPHICS_INVALID_STRIDE", "STATUS_GRAPHICS_INVALID_STRIDE"},
+	0xC01E033D: {0xC01E033D, "STATUS_GRAPHICS_INVALID_PIXELFORMAT", "STATUS_GRAPHICS_INVALID_PIXELFORMAT"},
+	0xC01E033E: {0xC01E033E, "STATUS_GRAPHICS_INVALID_COLORBASIS", "STATUS_GRAPHICS_INVALID_COLORBASIS"},
+	0xC01E033F: {0xC01E033F, "STATUS_GRAPHICS_INVALID_PIXELVALUEACCESSMODE", "STATUS_GRAPHICS_INVALID_PIXELVALUEACCESSMODE"},
+	0xC01E0340: {0xC01E0340, "STATUS_GRAPHICS_TARGET_NOT_IN_TOPOLOGY", "STATUS_GRAPHICS_TARGET_NOT_IN_TOPOLOGY"},
+	0xC01E0341: {0xC01E0341, "STATUS_GRAPHICS_NO_DISPLAY_MODE_MANAGEMENT_SUPPORT", "STATUS_GRAPHICS_NO_DISPLAY_MODE_MANAGEMENT_SUPPORT"},
+	0xC01E0342: {0xC01E0342, "STATUS_GRAPHICS_VIDPN_SOURCE_IN_USE", "STATUS_GRAPHICS_VIDPN_SOURCE_IN_USE"},
+	0xC01E0343: {0xC01E0343, "STATUS_GRAPHICS_CANT_ACCESS_ACTIVE_VIDPN", "STATUS_GRAPHICS_CANT_ACCESS_ACTIVE_VIDPN"},
+	0xC01E0344: {0xC01E0344, "STATUS_GRAPHICS_INVALID_PATH_IMPORTANCE_ORDINAL", "STATUS_GRAPHICS_INVALID_PATH_IMPORTANCE_ORDINAL"},
+	0xC01E0345: {0xC01E0345, "STATUS_GRAPHICS_INVALID_PATH_CONTENT_GEOMETRY_TRANSFORMATION", "STATUS_GRAPHICS_INVALID_PATH_CONTENT_GEOMETRY_TRANSFORMATION"},
+	0xC01E0346: {0xC01E0346, "STATUS_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_SUPPORTED", "STATUS_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_SUPPORTED"},
+	0xC01E0347: {0xC01E0347, "STATUS_GRAPHICS_INVALID_GAMMA_RAMP", "STATUS_GRAPHICS_INVALID_GAMMA_RAMP"},
+	0xC01E0348: {0xC01E0348, "STATUS_GRAPHICS_GAMMA_RAMP_NOT_SUPPORTED", "STATUS_GRAPHICS_GAMMA_RAMP_NOT_SUPPORTED"},
+	0xC01E0349: {0xC01E0349, "STATUS_GRAPHICS_MULTISAMPLING_NOT_SUPPORTED", "STATUS_GRAPHICS_MULTISAMPLING_NOT_SUPPORTED"},
+	0xC01E034A: {0xC01E034A, "STATUS_GRAPHICS_MODE_NOT_IN_MODESET", "STATUS_GRAPHICS_MODE_NOT_IN_MODESET"},
+	0x401E034B: {0x401E034B, "STATUS_GRAPHICS_DATASET_IS_EMPTY", "STATUS_GRAPHICS_DATASET_IS_EMPTY"},
+	0x401E034C: {0x401E034C, "STATUS_GRAPHICS_NO_MORE_ELEMENTS_IN_DATASET", "STATUS_GRAPHICS_NO_MORE_ELEMENTS_IN_DATASET"},
+	0xC01E034D: {0xC01E034D, "STATUS_GRAPHICS_INVALID_VIDPN_TOPOLOGY_RECOMMENDATION_REASON", "STATUS_GRAPHICS_INVALID_VIDPN_TOPOLOGY_RECOMMENDATION_REASON"},
+	0xC01E034E: {0xC01E034E, "STATUS_GRAPHICS_INVALID_PATH_CONTENT_TYPE", "STATUS_GRAPHICS_INVALID_PATH_CONTENT_TYPE"},
+	0xC01E034F: {0xC01E034F, "STATUS_GRAPHICS_INVALID_COPYPROTECTION_TYPE", "STATUS_GRAPHICS_INVALID_COPYPROTECTION_TYPE"},
+	0xC01E0350: {0xC01E0350, "STATUS_GRAPHICS_UNASSIGNED_MODESET_ALREADY_EXISTS", "STATUS_GRAPHICS_UNASSIGNED_MODESET_ALREADY_EXISTS"},
+	0x401E0351: {0x401E0351, "STATUS_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_PINNED", "STATUS_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_PINNED"},
+	0xC01E0352: {0xC01E0352, "STATUS_GRAPHICS_INVALID_SCANLINE_ORDERING", "STATUS_GRAPHICS_INVALID_SCANLINE_ORDERING"},
+	0xC01E0353: {0xC01E0353, "STATUS_GRAPHICS_TOPOLOGY_CHANGES_NOT_ALLOWED", "STATUS_GRAPHICS_TOPOLOGY_CHANGES_NOT_ALLOWED"},
+	0xC01E0354: {0xC01E0354, "STATUS_GRAPHICS_NO_AVAILABLE_IMPORTANCE_ORDINALS", "STATUS_GRAPHICS_NO_AVAILABLE_IMPORTANCE_ORDINALS"},
+	0xC01E0355: {0xC01E0355, "STATUS_GRAPHICS_INCOMPATIBLE_PRIVATE_FORMAT", "STATUS_GRAPHICS_INCOMPATIBLE_PRIVATE_FORMAT"},
+	0xC01E0356: {0xC01E0356, "STATUS_GRAPHICS_INVALID_MODE_PRUNING_ALGORITHM", "STATUS_GRAPHICS_INVALID_MODE_PRUNING_ALGORITHM"},
+	0xC01E0357: {0xC01E0357, "STATUS_GRAPHICS_INVALID_MONITOR_CAPABILITY_ORIGIN", "STATUS_GRAPHICS_INVALID_MONITOR_CAPABILITY_ORIGIN"},
+	0xC01E0358: {0xC01E0358, "STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE_CONSTRAINT", "STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE_CONSTRAINT"},
+	0xC01E0359: {0xC01E0359, "STATUS_GRAPHICS_MAX_NUM_PATHS_REACHED", "STATUS_GRAPHICS_MAX_NUM_PATHS_REACHED"},
+	0xC01E035A: {0xC01E035A, "STATUS_GRAPHICS_CANCEL_VIDPN_TOPOLOGY_AUGMENTATION", "STATUS_GRAPHICS_CANCEL_VIDPN_TOPOLOGY_AUGMENTATION"},
+	0xC01E035B: {0xC01E035B, "STATUS_GRAPHICS_INVALID_CLIENT_TYPE", "STATUS_GRAPHICS_INVALID_CLIENT_TYPE"},
+	0xC01E035C: {0xC01E035C, "STATUS_GRAPHICS_CLIENTVIDPN_NOT_SET", "STATUS_GRAPHICS_CLIENTVIDPN_NOT_SET"},
+	0xC01E0400: {0xC01E0400, "STATUS_GRAPHICS_SPECIFIED_CHILD_ALREADY_CONNECTED", "STATUS_GRAPHICS_SPECIFIED_CHILD_ALREADY_CONNECTED"},
+	0xC01E0401: {0xC01E0401, "STATUS_GRAPHICS_CHILD_DESCRIPTOR_NOT_SUPPORTED", "STATUS_GRAPHICS_CHILD_DESCRIPTOR_NOT_SUPPORTED"},
+	0x401E042F: {0x401E042F, "STATUS_GRAPHICS_UNKNOWN_CHILD_STATUS", "STATUS_GRAPHICS_UNKNOWN_CHILD_STATUS"},
+	0xC01E0430: {0xC01E0430, "STATUS_GRAPHICS_NOT_A_LINKED_ADAPTER", "STATUS_GRAPHICS_NOT_A_LINKED_ADAPTER"},
+	0xC01E0431: {0xC01E0431, "STATUS_GRAPHICS_LEADLINK_NOT_ENUMERATED", "STATUS_GRAPHICS_LEADLINK_NOT_ENUMERATED"},
+	0xC01E0432: {0xC01E0432, "STATUS_GRAPHICS_CHAINLINKS_NOT_ENUMERATED", "STATUS_GRAPHICS_CHAINLINKS_NOT_ENUMERATED"},
+	0xC01E0433: {0xC01E0433, "STATUS_GRAPHICS_ADAPTER_CHAIN_NOT_READY", "STATUS_GRAPHICS_ADAPTER_CHAIN_NOT_READY"},
+	0xC01E0434: {0xC01E0434, "STATUS_GRAPHICS_CHAINLINKS_NOT_STARTED", "STATUS_GRAPHICS_CHAINLINKS_NOT_STARTED"},
+	0xC01E0435: {0xC01E0435, "STATUS_GRAPHICS_CHAINLINKS_NOT_POWERED_ON", "STATUS_GRAPHICS_CHAINLINKS_NOT_POWERED_ON"},
+	0xC01E0436: {0xC01E0436, "STATUS_GRAPHICS_INCONSISTENT_DEVICE_LINK_STATE", "STATUS_GRAPHICS_INCONSISTENT_DEVICE_LINK_STATE"},
+	0x401E0437: {0x401E0437, "STATUS_GRAPHICS_LEADLINK_START_DEFERRED", "STATUS_GRAPHICS_LEADLINK_START_DEFERRED"},
+	0xC01E0438: {0xC01E0438, "STATUS_GRAPHICS_NOT_POST_DEVICE_DRIVER", "STATUS_GRAPHICS_NOT_POST_DEVICE_DRIVER"},
+	0x401E0439: {0x401E0439, "STATUS_GRAPHICS_POLLING_TOO_FREQUENTLY", "STATUS_GRAPHICS_POLLING_TOO_FREQUENTLY"},
+	0x401E043A: {0x401E043A, "STATUS_GRAPHICS_START_DEFERRED", "STATUS_GRAPHICS_START_DEFERRED"},
+	0xC01E043B: {0xC01E043B, "STATUS_GRAPHICS_ADAPTER_ACCESS_NOT_EXCLUDED", "STATUS_GRAPHICS_ADAPTER_ACCESS_NOT_EXCLUDED"},
+	0x401E043C: {0x401E043C, "STATUS_GRAPHICS_DEPENDABLE_CHILD_STATUS", "STATUS_GRAPHICS_DEPENDABLE_CHILD_STATUS"},
+	0xC01E0500: {0xC01E0500, "STATUS_GRAPHICS_OPM_NOT_SUPPORTED", "STATUS_GRAPHICS_OPM_NOT_SUPPORTED"},
+	0xC01E0501: {0xC01E0501, "STATUS_GRAPHICS_COPP_NOT_SUPPORTED", "STATUS_GRAPHICS_COPP_NOT_SUPPORTED"},
+	0xC01E0502: {0xC01E0502, "STATUS_GRAPHICS_UAB_NOT_SUPPORTED", "STATUS_GRAPHICS_UAB_NOT_SUPPORTED"},
+	0xC01E0503: {0xC01E0503, "STATUS_GRAPHICS_OPM_INVALID_ENCRYPTED_PARAMETERS", "STATUS_GRAPHICS_OPM_INVALID_ENCRYPTED_PARAMETERS"},
+	0xC01E0505: {0xC01E0505, "STATUS_GRAPHICS_OPM_NO_PROTECTED_OUTPUTS_EXIST", "STATUS_GRAPHICS_OPM_NO_PROTECTED_OUTPUTS_EXIST"},
+	0xC01E050B: {0xC01E050B, "STATUS_GRAPHICS_OPM_INTERNAL_ERROR", "STATUS_GRAPHICS_OPM_INTERNAL_ERROR"},
+	0xC01E050C: {0xC01E050C, "STATUS_GRAPHICS_OPM_INVALID_HANDLE", "STATUS_GRAPHICS_OPM_INVALID_HANDLE"},
+	0xC01E050E: {0xC01E050E, "STATUS_GRAPHICS_PVP_INVALID_CERTIFICATE_LENGTH", "STATUS_GRAPHICS_PVP_INVALID_CERTIFICATE_LENGTH"},
+	0xC01E050F: {0xC01E050F, "STATUS_GRAPHICS_OPM_SPANNING_MODE_ENABLED", "STATUS_GRAPHICS_OPM_SPANNING_MODE_ENABLED"},
+	0xC01E0510: {0xC01E0510, "STATUS_GRAPHICS_OPM_THEATER_MODE_ENABLED", "STATUS_GRAPHICS_OPM_THEATER_MODE_ENABLED"},
+	0xC01E0511: {0xC01E0511, "STATUS_GRAPHICS_PVP_HFS_FAILED", "STATUS_GRAPHICS_PVP_HFS_FAILED"},
+	0xC01E0512: {0xC01E0512, "STATUS_GRAPHICS_OPM_INVALID_SRM", "STATUS_GRAPHICS_OPM_INVALID_SRM"},
+	0xC01E0513: {0xC01E0513, "STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_HDCP", "STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_HDCP"},
+	0xC01E0514: {0xC01E0514, "STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_ACP", "STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_ACP"},
+	0xC01E0515: {0xC01E0515, "STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_CGMSA", "STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_CGMSA"},
+	0xC01E0516: {0xC01E0516, "STATUS_GRAPHICS_OPM_HDCP_SRM_NEVER_SET", "STATUS_GRAPHICS_OPM_HDCP_SRM_NEVER_SET"},
+	0xC01E0517: {0xC01E0517, "STATUS_GRAPHICS_OPM_RESOLUTION_TOO_HIGH", "STATUS_GRAPHICS_OPM_RESOLUTION_TOO_HIGH"},
+	0xC01E0518: {0xC01E0518, "STATUS_GRAPHICS_OPM_ALL_HDCP_HARDWARE_ALREADY_IN_USE", "STATUS_GRAPHICS_OPM_ALL_HDCP_HARDWARE_ALREADY_IN_USE"},
+	0xC01E051A: {0xC01E051A, "STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_NO_LONGER_EXISTS", "STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_NO_LONGER_EXISTS"},
+	0xC01E051C: {0xC01E051C, "STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_DOES_NOT_HAVE_COPP_SEMANTICS", "STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_DOES_NOT_HAVE_COPP_SEMANTICS"},
+	0xC01E051D: {0xC01E051D, "STATUS_GRAPHICS_OPM_INVALID_INFORMATION_REQUEST", "STATUS_GRAPHICS_OPM_INVALID_INFORMATION_REQUEST"},
+	0xC01E051E: {0xC01E051E, "STATUS_GRAPHICS_OPM_DRIVER_INTERNAL_ERROR", "STATUS_GRAPHICS_OPM_DRIVER_INTERNAL_ERROR"},
+	0xC01E051F: {0xC01E051F, "STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_DOES_NOT_HAVE_OPM_SEMANTICS", "STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_DOES_NOT_HAVE_OPM_SEMANTICS"},
+	0xC01E0520: {0xC01E0520, "STATUS_GRAPHICS_OPM_SIGNALING_NOT_SUPPORTED", "STATUS_GRAPHICS_OPM_SIGNALING_NOT_SUPPORTED"},
+	0xC01E0521: {0xC01E0521, "STATUS_GRAPHICS_OPM_INVALID_CONFIGURATION_REQUEST", "STATUS_GRAPHICS_OPM_INVALID_CONFIGURATION_REQUEST"},
+	0xC01E0580: {0xC01E0580, "STATUS_GRAPHICS_I2C_NOT_SUPPORTED", "STATUS_GRAPHICS_I2C_NOT_SUPPORTED"},
+	0xC01E0581: {0xC01E0581, "STATUS_GRAPHICS_I2C_DEVICE_DOES_NOT_EXIST", "STATUS_GRAPHICS_I2C_DEVICE_DOES_NOT_EXIST"},
+	0xC01E0582: {0xC01E0582, "STATUS_GRAPHICS_I2C_ERROR_TRANSMITTING_DATA", "STATUS_GRAPHICS_I2C_ERROR_TRANSMITTING_DATA"},
+	0xC01E0583: {0xC01E0583, "STATUS_GRAPHICS_I2C_ERROR_RECEIVING_DATA", "STATUS_GRAPHICS_I2C_ERROR_RECEIVING_DATA"},
+	0xC01E0584: {0xC01E0584, "STATUS_GRAPHICS_DDCCI_VCP_NOT_SUPPORTED", "STATUS_GRAPHICS_DDCCI_VCP_NOT_SUPPORTED"},
+	0xC01E0585: {0xC01E0585, "STATUS_GRAPHICS_DDCCI_INVALID_DATA", "STATUS_GRAPHICS_DDCCI_INVALID_DATA"},
+	0xC01E0586: {0xC01E0586, "STATUS_GRAPHICS_DDCCI_MONITOR_RETURNED_INVALID_TIMING_STATUS_BYTE", "STATUS_GRAPHICS_DDCCI_MONITOR_RETURNED_INVALID_TIMING_STATUS_BYTE"},
+	0xC01E0587: {0xC01E0587, "STATUS_GRAPHICS_DDCCI_INVALID_CAPABILITIES_STRING", "STATUS_GRAPHICS_DDCCI_INVALID_CAPABILITIES_STRING"},
+	0xC01E0588: {0xC01E0588, "STATUS_GRAPHICS_MCA_INTERNAL_ERROR", "STATUS_GRAPHICS_MCA_INTERNAL_ERROR"},
+	0xC01E0589: {0xC01E0589, "STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_COMMAND", "STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_COMMAND"},
+	0xC01E058A: {0xC01E058A, "STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_LENGTH", "STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_LENGTH"},
+	0xC01E058B: {0xC01E058B, "STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_CHECKSUM", "STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_CHECKSUM"},
+	0xC01E058C: {0xC01E058C, "STATUS_GRAPHICS_INVALID_PHYSICAL_MONITOR_HANDLE", "STATUS_GRAPHICS_INVALID_PHYSICAL_MONITOR_HANDLE"},
+	0xC01E058D: {0xC01E058D, "STATUS_GRAPHICS_MONITOR_NO_LONGER_EXISTS", "STATUS_GRAPHICS_MONITOR_NO_LONGER_EXISTS"},
+	0xC01E05E0: {0xC01E05E0, "STATUS_GRAPHICS_ONLY_CONSOLE_SESSION_SUPPORTED", "STATUS_GRAPHICS_ONLY_CONSOLE_SESSION_SUPPORTED"},
+	0xC01E05E1: {0xC01E05E1, "STATUS_GRAPHICS_NO_DISPLAY_DEVICE_CORRESPONDS_TO_NAME", "STATUS_GRAPHICS_NO_DISPLAY_DEVICE_CORRESPONDS_TO_NAME"},
+	0xC01E05E2: {0xC01E05E2, "STATUS_GRAPHICS_DISPLAY_DEVICE_NOT_ATTACHED_TO_DESKTOP", "STATUS_GRAPHICS_DISPLAY_DEVICE_NOT_ATTACHED_TO_DESKTOP"},
+	0xC01E05E3: {0xC01E05E3, "STATUS_GRAPHICS_MIRRORING_DEVICES_NOT_SUPPORTED", "STATUS_GRAPHICS_MIRRORING_DEVICES_NOT_SUPPORTED"},
+	0xC01E05E4: {0xC01E05E4, "STATUS_GRAPHICS_INVALID_POINTER", "STATUS_GRAPHICS_INVALID_POINTER"},
+	0xC01E05E5: {0xC01E05E5, "STATUS_GRAPHICS_NO_MONITORS_CORRESPOND_TO_DISPLAY_DEVICE", "STATUS_GRAPHICS_NO_MONITORS_CORRESPOND_TO_DISPLAY_DEVICE"},
+	0xC01E05E6: {0xC01E05E6, "STATUS_GRAPHICS_PARAMETER_ARRAY_TOO_SMALL", "STATUS_GRAPHICS_PARAMETER_ARRAY_TOO_SMALL"},
+	0xC01E05E7: {0xC01E05E7, "STATUS_GRAPHICS_INTERNAL_ERROR", "STATUS_GRAPHICS_INTERNAL_ERROR"},
+	0xC01E05E8: {0xC01E05E8, "STATUS_GRAPHICS_SESSION_TYPE_CHANGE_IN_PROGRESS", "STATUS_GRAPHICS_SESSION_TYPE_CHANGE_IN_PROGRESS"},
+	0xC0210000: {0xC0210000, "STATUS_FVE_LOCKED_VOLUME", "STATUS_FVE_LOCKED_VOLUME"},
+	0xC0210001: {0xC0210001, "STATUS_FVE_NOT_ENCRYPTED", "STATUS_FVE_NOT_ENCRYPTED"},
+	0xC0210002: {0xC0210002, "STATUS_FVE_BAD_INFORMATION", "STATUS_FVE_BAD_INFORMATION"},
+	0xC0210003: {0xC0210003, "STATUS_FVE_TOO_SMALL", "STATUS_FVE_TOO_SMALL"},
+	0xC0210004: {0xC0210004, "STATUS_FVE_FAILED_WRONG_FS", "STATUS_FVE_FAILED_WRONG_FS"},
+	0xC0210005: {0xC0210005, "STATUS_FVE_BAD_PARTITION_SIZE", "STATUS_FVE_BAD_PARTITION_SIZE"},
+	0xC0210006: {0xC0210006, "STATUS_FVE_FS_NOT_EXTENDED", "STATUS_FVE_FS_NOT_EXTENDED"},
+	0xC0210007: {0xC0210007, "STATUS_FVE_FS_MOUNTED", "STATUS_FVE_FS_MOUNTED"},
+	0xC0210008: {0xC0210008, "STATUS_FVE_NO_LICENSE", "STATUS_FVE_NO_LICENSE"},
+	0xC0210009: {0xC0210009, "STATUS_FVE_ACTION_NOT_ALLOWED", "STATUS_FVE_ACTION_NOT_ALLOWED"},
+	0xC021000A: {0xC021000A, "STATUS_FVE_BAD_DATA", "STATUS_FVE_BAD_DATA"},
+	0xC021000B: {0xC021000B, "STATUS_FVE_VOLUME_NOT_BOUND", "STATUS_FVE_VOLUME_NOT_BOUND"},
+	0xC021000C: {0xC021000C, "STATUS_FVE_NOT_DATA_VOLUME", "STATUS_FVE_NOT_DATA_VOLUME"},
+	0xC021000D: {0xC021000D, "STATUS_FVE_CONV_READ_ERROR", "STATUS_FVE_CONV_READ_ERROR"},
+	0xC021000E: {0xC021000E, "STATUS_FVE_CONV_WRITE_ERROR", "STATUS_FVE_CONV_WRITE_ERROR"},
+	0xC021000F: {0xC021000F, "STATUS_FVE_OVERLAPPED_UPDATE", "STATUS_FVE_OVERLAPPED_UPDATE"},
+	0xC0210010: {0xC0210010, "STATUS_FVE_FAILED_SECTOR_SIZE", "STATUS_FVE_FAILED_SECTOR_SIZE"},
+	0xC0210011: {0xC0210011, "STATUS_FVE_FAILED_AUTHENTICATION", "STATUS_FVE_FAILED_AUTHENTICATION"},
+	0xC0210012: {0xC0210012, "STATUS_FVE_NOT_OS_VOLUME", "STATUS_FVE_NOT_OS_VOLUME"},
+	0xC0210013: {0xC0210013, "STATUS_FVE_KEYFILE_NOT_FOUND", "STATUS_FVE_KEYFILE_NOT_FOUND"},
+	0xC0210014: {0xC0210014, "STATUS_FVE_KEYFILE_INVALID", "STATUS_FVE_KEYFILE_INVALID"},
+	0xC0210015: {0xC0210015, "STATUS_FVE_KEYFILE_NO_VMK", "STATUS_FVE_KEYFILE_NO_VMK"},
+	0xC0210016: {0xC0210016, "STATUS_FVE_TPM_DISABLED", "STATUS_FVE_TPM_DISABLED"},
+	0xC0210017: {0xC0210017, "STATUS_FVE_TPM_SRK_AUTH_NOT_ZERO", "STATUS_FVE_TPM_SRK_AUTH_NOT_ZERO"},
+	0xC0210018: {0xC0210018, "STATUS_FVE_TPM_INVALID_PCR", "STATUS_FVE_TPM_INVALID_PCR"},
+	0xC0210019: {0xC0210019, "STATUS_FVE_TPM_NO_VMK", "STATUS_FVE_TPM_NO_VMK"},
+	0xC021001A: {0xC021001A, "STATUS_FVE_PIN_INVALID", "STATUS_FVE_PIN_INVALID"},
+	0xC021001B: {0xC021001B, "STATUS_FVE_AUTH_INVALID_APPLICATION", "STATUS_FVE_AUTH_INVALID_APPLICATION"},
+	0xC021001C: {0xC021001C, "STATUS_FVE_AUTH_INVALID_CONFIG", "STATUS_FVE_AUTH_INVALID_CONFIG"},
+	0xC021001D: {0xC021001D, "STATUS_FVE_DEBUGGER_ENABLED", "STATUS_FVE_DEBUGGER_ENABLED"},
+	0xC021001E: {0xC021001E, "STATUS_FVE_DRY_RUN_FAILED", "STATUS_FVE_DRY_RUN_FAILED"},
+	0xC021001F: {0xC021001F, "STATUS_FVE_BAD_METADATA_POINTER", "STATUS_FVE_BAD_METADATA_POINTER"},
+	0xC0210020: {0xC0210020, "STATUS_FVE_OLD_METADATA_COPY", "STATUS_FVE_OLD_METADATA_COPY"},
+	0xC0210021: {0xC0210021, "STATUS_FVE_REBOOT_REQUIRED", "STATUS_FVE_REBOOT_REQUIRED"},
+	0xC0210022: {0xC0210022, "STATUS_FVE_RAW_ACCESS", "STATUS_FVE_RAW_ACCESS"},
+	0xC0210023: {0xC0210023, "STATUS_FVE_RAW_BLOCKED", "STATUS_FVE_RAW_BLOCKED"},
+	0xC0210024: {0xC0210024, "STATUS_FVE_NO_AUTOUNLOCK_MASTER_KEY", "STATUS_FVE_NO_AUTOUNLOCK_MASTER_KEY"},
+	0xC0210025: {0xC0210025, "STATUS_FVE_MOR_FAILED", "STATUS_FVE_MOR_FAILED"},
+	0xC0210026: {0xC0210026, "STATUS_FVE_NO_FEATURE_LICENSE", "STATUS_FVE_NO_FEATURE_LICENSE"},
+	0xC0210027: {0xC0210027, "STATUS_FVE_POLICY_USER_DISABLE_RDV_NOT_ALLOWED", "STATUS_FVE_POLICY_USER_DISABLE_RDV_NOT_ALLOWED"},
+	0xC0210028: {0xC0210028, "STATUS_FVE_CONV_RECOVERY_FAILED", "STATUS_FVE_CONV_RECOVERY_FAILED"},
+	0xC0210029: {0xC0210029, "STATUS_FVE_VIRTUALIZED_SPACE_TOO_BIG", "STATUS_FVE_VIRTUALIZED_SPACE_TOO_BIG"},
+	0xC021002A: {0xC021002A, "STATUS_FVE_INVALID_DATUM_TYPE", "STATUS_FVE_INVALID_DATUM_TYPE"},
+	0xC0210030: {0xC0210030, "STATUS_FVE_VOLUME_TOO_SMALL", "STATUS_FVE_VOLUME_TOO_SMALL"},
+	0xC0210031: {0xC0210031, "STATUS_FVE_ENH_PIN_INVALID", "STATUS_FVE_ENH_PIN_INVALID"},
+	0xC0210032: {0xC0210032, "STATUS_FVE_FULL_ENCRYPTION_NOT_ALLOWED_ON_TP_STORAGE", "STATUS_FVE_FULL_ENCRYPTION_NOT_ALLOWED_ON_TP_STORAGE"},
+	0xC0210033: {0xC0210033, "STATUS_FVE_WIPE_NOT_ALLOWED_ON_TP_STORAGE", "STATUS_FVE_WIPE_NOT_ALLOWED_ON_TP_STORAGE"},
+	0xC0210034: {0xC0210034, "STATUS_FVE_NOT_ALLOWED_ON_CSV_STACK", "STATUS_FVE_NOT_ALLOWED_ON_CSV_STACK"},
+	0xC0210035: {0xC0210035, "STATUS_FVE_NOT_ALLOWED_ON_CLUSTER", "STATUS_FVE_NOT_ALLOWED_ON_CLUSTER"},
+	0xC0210036: {0xC0210036, "STATUS_FVE_NOT_ALLOWED_TO_UPGRADE_WHILE_CONVERTING", "STATUS_FVE_NOT_ALLOWED_TO_UPGRADE_WHILE_CONVERTING"},
+	0xC0210037: {0xC0210037, "STATUS_FVE_WIPE_CANCEL_NOT_APPLICABLE", "STATUS_FVE_WIPE_CANCEL_NOT_APPLICABLE"},
+	0xC0210038: {0xC0210038, "STATUS_FVE_EDRIVE_DRY_RUN_FAILED", "STATUS_FVE_EDRIVE_DRY_RUN_FAILED"},
+	0xC0210039: {0xC0210039, "STATUS_FVE_SECUREBOOT_DISABLED", "STATUS_FVE_SECUREBOOT_DISABLED"},
+	0xC021003A: {0xC021003A, "STATUS_FVE_SECUREBOOT_CONFIG_CHANGE", "STATUS_FVE_SECUREBOOT_CONFIG_CHANGE"},
+	0xC021003B: {0xC021003B, "STATUS_FVE_DEVICE_LOCKEDOUT", "STATUS_FVE_DEVICE_LOCKEDOUT"},
+	0xC021003C: {0xC021003C, "STATUS_FVE_VOLUME_EXTEND_PREVENTS_EOW_DECRYPT", "STATUS_FVE_VOLUME_EXTEND_PREVENTS_EOW_DECRYPT"},
+	0xC021003D: {0xC021003D, "STATUS_FVE_NOT_DE_VOLUME", "STATUS_FVE_NOT_DE_VOLUME"},
+	0xC021003E: {0xC021003E, "STATUS_FVE_PROTECTION_DISABLED", "STATUS_FVE_PROTECTION_DISABLED"},
+	0xC021003F: {0xC021003F, "STATUS_FVE_PROTECTION_CANNOT_BE_DISABLED", "STATUS_FVE_PROTECTION_CANNOT_BE_DISABLED"},
+	0xC0210040: {0xC0210040, "STATUS_FVE_OSV_KSR_NOT_ALLOWED", "STATUS_FVE_OSV_KSR_NOT_ALLOWED"},
+	0xC0220001: {0xC0220001, "STATUS_FWP_CALLOUT_NOT_FOUND", "STATUS_FWP_CALLOUT_NOT_FOUND"},
+	0xC0220002: {0xC0220002, "STATUS_FWP_CONDITION_NOT_FOUND", "STATUS_FWP_CONDITION_NOT_FOUND"},
+	0xC0220003: {0xC0220003, "STATUS_FWP_FILTER_NOT_FOUND", "STATUS_FWP_FILTER_NOT_FOUND"},
+	0xC0220004: {0xC0220004, "STATUS_FWP_LAYER_NOT_FOUND", "STATUS_FWP_LAYER_NOT_FOUND"},
+	0xC0220005: {0xC0220005, "STATUS_FWP_PROVIDER_NOT_FOUND", "STATUS_FWP_PROVIDER_NOT_FOUND"},
+	0xC0220006: {0xC0220006, "STATUS_FWP_PROVIDER_CONTEXT_NOT_FOUND", "STATUS_FWP_PROVIDER_CONTEXT_NOT_FOUND"},
+	0xC0220007: {0xC0220007, "STATUS_FWP_SUBLAYER_NOT_FOUND", "STATUS_FWP_SUBLAYER_NOT_FOUND"},
+	0xC0220008: {0xC0220008, "STATUS_FWP_NOT_FOUND", "STATUS_FWP_NOT_FOUND"},
+	0xC0220009: {0xC0220009, "STATUS_FWP_ALREADY_EXISTS", "STATUS_FWP_ALREADY_EXISTS"},
+	0xC022000A: {0xC022000A, "STATUS_FWP_IN_USE", "STATUS_FWP_IN_USE"},
+	0xC022000B: {0xC022000B, "STATUS_FWP_DYNAMIC_SESSION_IN_PROGRESS", "STATUS_FWP_DYNAMIC_SESSION_IN_PROGRESS"},
+	0xC022000C: {0xC022000C, "STATUS_FWP_WRONG_SESSION", "STATUS_FWP_WRONG_SESSION"},
+	0xC022000D: {0xC022000D, "STATUS_FWP_NO_TXN_IN_PROGRESS", "STATUS_FWP_NO_TXN_IN_PROGRESS"},
+	0xC022000E: {0xC022000E, "STATUS_FWP_TXN_IN_PROGRESS", "STATUS_FWP_TXN_IN_PROGRESS"},
+	0xC022000F: {0xC022000F, "STATUS_FWP_TXN_ABORTED", "STATUS_FWP_TXN_ABORTED"},
+	0xC0220010: {0xC0220010, "STATUS_FWP_SESSION_ABORTED", "STATUS_FWP_SESSION_ABORTED"},
+	0xC0220011: {0xC0220011, "STATUS_FWP_INCOMPATIBLE_TXN", "STATUS_FWP_INCOMPATIBLE_TXN"},
+	0xC0220012: {0xC0220012, "STATUS_FWP_TIMEOUT", "STATUS_FWP_TIMEOUT"},
+	0xC0220013: {0xC0220013, "STATUS_FWP_NET_EVENTS_DISABLED", "STATUS_FWP_NET_EVENTS_DISABLED"},
+	0xC0220014: {0xC0220014, "STATUS_FWP_INCOMPATIBLE_LAYER", "STATUS_FWP_INCOMPATIBLE_LAYER"},
+	0xC0220015: {0xC0220015, "STATUS_FWP_KM_CLIENTS_ONLY", "STATUS_FWP_KM_CLIENTS_ONLY"},
+	0xC0220016: {0xC0220016, "STATUS_FWP_LIFETIME_MISMATCH", "STATUS_FWP_LIFETIME_MISMATCH"},
+	0xC0220017: {0xC0220017, "STATUS_FWP_BUILTIN_OBJECT", "STATUS_FWP_BUILTIN_OBJECT"},
+	0xC0220018: {0xC0220018, "STATUS_FWP_TOO_MANY_CALLOUTS", "STATUS_FWP_TOO_MANY_CALLOUTS"},
+	0xC0220019: {0xC0220019, "STATUS_FWP_NOTIFICATION_DROPPED", "STATUS_FWP_NOTIFICATION_DROPPED"},
+	0xC022001A: {0xC022001A, "STATUS_FWP_TRAFFIC_MISMATCH", "STATUS_FWP_TRAFFIC_MISMATCH"},
+	0xC022001B: {0xC022001B, "STATUS_FWP_INCOMPATIBLE_SA_STATE", "STATUS_FWP_INCOMPATIBLE_SA_STATE"},
+	0xC022001C: {0xC022001C, "STATUS_FWP_NULL_POINTER", "STATUS_FWP_NULL_POINTER"},
+	0xC022001D: {0xC022001D, "STATUS_FWP_INVALID_ENUMERATOR", "STATUS_FWP_INVALID_ENUMERATOR"},
+	0xC022001E: {0xC022001E, "STATUS_FWP_INVALID_FLAGS", "STATUS_FWP_INVALID_FLAGS"},
+	0xC022001F: {0xC022001F, "STATUS_FWP_INVALID_NET_MASK", "STATUS_FWP_INVALID_NET_MASK"},
+	0xC0220020: {0xC0220020, "STATUS_FWP_INVALID_RANGE", "STATUS_FWP_INVALID_RANGE"},
+	0xC0220021: {0xC0220021, "STATUS_FWP_INVALID_INTERVAL", "STATUS_FWP_INVALID_INTERVAL"},
+	0xC0220022: {0xC0220022, "STATUS_FWP_ZERO_LENGTH_ARRAY", "STATUS_FWP_ZERO_LENGTH_ARRAY"},
+	0xC0220023: {0xC0220023, "STATUS_FWP_NULL_DISPLAY_NAME", "STATUS_FWP_NULL_DISPLAY_NAME"},
+	0xC0220024: {0xC0220024, "STATUS_FWP_INVALID_ACTION_TYPE", "STATUS_FWP_INVALID_ACTION_TYPE"},
+	0xC0220025: {0xC0220025, "STATUS_FWP_INVALID_WEIGHT", "STATUS_FWP_INVALID_WEIGHT"},
+	0xC0220026: {0xC0220026, "STATUS_FWP_MATCH_TYPE_MISMATCH", "STATUS_FWP_MATCH_TYPE_MISMATCH"},
+	0xC0220027: {0xC0220027, "STATUS_FWP_TYPE_MISMATCH", "STATUS_FWP_TYPE_MISMATCH"},
+	0xC0220028: {0xC0220028, "STATUS_FWP_OUT_OF_BOUNDS", "STATUS_FWP_OUT_OF_BOUNDS"},
+	0xC0220029: {0xC0220029, "STATUS_FWP_RESERVED", "STATUS_FWP_RESERVED"},
+	0xC022002A: {0xC022002A, "STATUS_FWP_DUPLICATE_CONDITION", "STATUS_FWP_DUPLICATE_CONDITION"},
+	0xC022002B: {0xC022002B, "STATUS_FWP_DUPLICATE_KEYMOD", "STATUS_FWP_DUPLICATE_KEYMOD"},
+	0xC022002C: {0xC022002C, "STATUS_FWP_ACTION_INCOMPATIBLE_WITH_LAYER", "STATUS_FWP_ACTION_INCOMPATIBLE_WITH_LAYER"},
+	0xC022002D: {0xC022002D, "STATUS_FWP_ACTION_INCOMPATIBLE_WITH_SUBLAYER", "STATUS_FWP_ACTION_INCOMPATIBLE_WITH_SUBLAYER"},
+	0xC022002E: {0xC022002E, "STATUS_FWP_CONTEXT_INCOMPATIBLE_WITH_LAYER", "STATUS_FWP_CONTEXT_INCOMPATIBLE_WITH_LAYER"},
+	0xC022002F: {0xC022002F, "STATUS_FWP_CONTEXT_INCOMPATIBLE_WITH_CALLOUT", "STATUS_FWP_CONTEXT_INCOMPATIBLE_WITH_CALLOUT"},
+	0xC0220030: {0xC0220030, "STATUS_FWP_INCOMPATIBLE_AUTH_METHOD", "STATUS_FWP_INCOMPATIBLE_AUTH_METHOD"},
+	0xC0220031: {0xC0220031, "STATUS_FWP_INCOMPATIBLE_DH_GROUP", "STATUS_FWP_INCOMPATIBLE_DH_GROUP"},
+	0xC0220032: {0xC0220032, "STATUS_FWP_EM_NOT_SUPPORTED", "STATUS_FWP_EM_NOT_SUPPORTED"},
+	0xC0220033: {0xC0220033, "STATUS_FWP_NEVER_MATCH", "STATUS_FWP_NEVER_MATCH"},
+	0xC0220034: {0xC0220034, "STATUS_FWP_PROVIDER_CONTEXT_MISMATCH", "STATUS_FWP_PROVIDER_CONTEXT_MISMATCH"},
+	0xC0220035: {0xC0220035, "STATUS_FWP_INVALID_PARAMETER", "STATUS_FWP_INVALID_PARAMETER"},
+	0xC0220036: {0xC0220036, "STATUS_FWP_TOO_MANY_SUBLAYERS", "STATUS_FWP_TOO_MANY_SUBLAYERS"},
+	0xC0220037: {0xC0220037, "STATUS_FWP_CALLOUT_NOTIFICATION_FAILED", "STATUS_FWP_CALLOUT_NOTIFICATION_FAILED"},
+	0xC0220038: {0xC0220038, "STATUS_FWP_INVALID_AUTH_TRANSFORM", "STATUS_FWP_INVALID_AUTH_TRANSFORM"},
+	0xC0220039: {0xC0220039, "STATUS_FWP_INVALID_CIPHER_TRANSFORM", "STATUS_FWP_INVALID_CIPHER_TRANSFORM"},
+	0xC022003A: {0xC022003A, "STATUS_FWP_INCOMPATIBLE_CIPHER_TRANSFORM", "STATUS_FWP_INCOMPATIBLE_CIPHER_TRANSFORM"},
+	0xC022003B: {0xC022003B, "STATUS_FWP_INVALID_TRANSFORM_COMBINATION", "STATUS_FWP_INVALID_TRANSFORM_COMBINATION"},
+	0xC022003C: {0xC022003C, "STATUS_FWP_DUPLICATE_AUTH_METHOD", "STATUS_FWP_DUPLICATE_AUTH_METHOD"},
+	0xC022003D: {0xC022003D, "STATUS_FWP_INVALID_TUNNEL_ENDPOINT", "STATUS_FWP_INVALID_TUNNEL_ENDPOINT"},
+	0xC022003E: {0xC022003E, "STATUS_FWP_L2_DRIVER_NOT_READY", "STATUS_FWP_L2_DRIVER_NOT_READY"},
+	0xC022003F: {0xC022003F, "STATUS_FWP_KEY_DICTATOR_ALREADY_REGISTERED", "STATUS_FWP_KEY_DICTATOR_ALREADY_REGISTERED"},
+	0xC0220040: {0xC0220040, "STATUS_FWP_KEY_DICTATION_INVALID_KEYING_MATERIAL", "STATUS_FWP_KEY_DICTATION_INVALID_KEYING_MATERIAL"},
+	0xC0220041: {0xC0220041, "STATUS_FWP_CONNECTIONS_DISABLED", "STATUS_FWP_CONNECTIONS_DISABLED"},
+	0xC0220042: {0xC0220042, "STATUS_FWP_INVALID_DNS_NAME", "STATUS_FWP_INVALID_DNS_NAME"},
+	0xC0220043: {0xC0220043, "STATUS_FWP_STILL_ON", "STATUS_FWP_STILL_ON"},
+	0xC0220044: {0xC0220044, "STATUS_FWP_IKEEXT_NOT_RUNNING", "STATUS_FWP_IKEEXT_NOT_RUNNING"},
+	0xC0220100: {0xC0220100, "STATUS_FWP_TCPIP_NOT_READY", "STATUS_FWP_TCPIP_NOT_READY"},
+	0xC0220101: {0xC0220101, "STATUS_FWP_INJECT_HANDLE_CLOSING", "STATUS_FWP_INJECT_HANDLE_CLOSING"},
+	0xC0220102: {0xC0220102, "STATUS_FWP_INJECT_HANDLE_STALE", "STATUS_FWP_INJECT_HANDLE_STALE"},
+	0xC0220103: {0xC0220103, "STATUS_FWP_CANNOT_PEND", "STATUS_FWP_CANNOT_PEND"},
+	0xC0220104: {0xC0220104, "STATUS_FWP_DROP_NOICMP", "STATUS_FWP_DROP_NOICMP"},
+	0xC0230002: {0xC0230002, "STATUS_NDIS_CLOSING", "STATUS_NDIS_CLOSING"},
+	0xC0230004: {0xC0230004, "STATUS_NDIS_BAD_VERSION", "STATUS_NDIS_BAD_VERSION"},
+	0xC0230005: {0xC0230005, "STATUS_NDIS_BAD_CHARACTERISTICS", "STATUS_NDIS_BAD_CHARACTERISTICS"},
+	0xC0230006: {0xC0230006, "STATUS_NDIS_ADAPTER_NOT_FOUND", "STATUS_NDIS_ADAPTER_NOT_FOUND"},
+	0xC0230007: {0xC0230007, "STATUS_NDIS_OPEN_FAILED", "STATUS_NDIS_OPEN_FAILED"},
+	0xC0230008: {0xC0230008, "STATUS_NDIS_DEVICE_FAILED", "STATUS_NDIS_DEVICE_FAILED"},
+	0xC0230009: {0xC0230009, "STATUS_NDIS_MULTICAST_FULL", "STATUS_NDIS_MULTICAST_FULL"},
+	0xC023000A: {0xC023000A, "STATUS_NDIS_MULTICAST_EXISTS", "STATUS_NDIS_MULTICAST_EXISTS"},
+	0xC023000B: {0xC023000B, "STATUS_NDIS_MULTICAST_NOT_FOUND", "STATUS_NDIS_MULTICAST_NOT_FOUND"},
+	0xC023000C: {0xC023000C, "STATUS_NDIS_REQUEST_ABORTED", "STATUS_NDIS_REQUEST_ABORTED"},
+	0xC023000D: {0xC023000D, "STATUS_NDIS_RESET_IN_PROGRESS", "STATUS_NDIS_RESET_IN_PROGRESS"},
+	0xC02300BB: {0xC02300BB, "STATUS_NDIS_NOT_SUPPORTED", "STATUS_NDIS_NOT_SUPPORTED"},
+	0xC023000F: {0xC023000F, "STATUS_NDIS_INVALID_PACKET", "STATUS_NDIS_INVALID_PACKET"},
+	0xC0230011: {0xC0230011, "STATUS_NDIS_ADAPTER_NOT_READY", "STATUS_NDIS_ADAPTER_NOT_READY"},
+	0xC0230014: {0xC0230014, "STATUS_NDIS_INVALID_LENGTH", "STATUS_NDIS_INVALID_LENGTH"},
+	0xC0230015: {0xC0230015, "STATUS_NDIS_INVALID_DATA", "STATUS_NDIS_INVALID_DATA"},
+	0xC0230016: {0xC0230016, "STATUS_NDIS_BUFFER_TOO_SHORT", "STATUS_NDIS_BUFFER_TOO_SHORT"},
+	0xC0230017: {0xC0230017, "STATUS_NDIS_INVALID_OID", "STATUS_NDIS_INVALID_OID"},
+	0xC0230018: {0xC0230018, "STATUS_NDIS_ADAPTER_REMOVED", "STATUS_NDIS_ADAPTER_REMOVED"},
+	0xC0230019: {0xC0230019, "STATUS_NDIS_UNSUPPORTED_MEDIA", "STATUS_NDIS_UNSUPPORTED_MEDIA"},
+	0xC023001A: {0xC023001A, "STATUS_NDIS_GROUP_ADDRESS_IN_USE", "STATUS_NDIS_GROUP_ADDRESS_IN_USE"},
+	0xC023001B: {0xC023001B, "STATUS_NDIS_FILE_NOT_FOUND", "STATUS_NDIS_FILE_NOT_FOUND"},
+	0xC023001C: {0xC023001C, "STATUS_NDIS_ERROR_READING_FILE", "STATUS_NDIS_ERROR_READING_FILE"},
+	0xC023001D: {0xC023001D, "STATUS_NDIS_ALREADY_MAPPED", "STATUS_NDIS_ALREADY_MAPPED"},
+	0xC023001E: {0xC023001E, "STATUS_NDIS_RESOURCE_CONFLICT", "STATUS_NDIS_RESOURCE_CONFLICT"},
+	0xC023001F: {0xC023001F, "STATUS_NDIS_MEDIA_DISCONNECTED", "STATUS_NDIS_MEDIA_DISCONNECTED"},
+	0xC0230022: {0xC0230022, "STATUS_NDIS_INVALID_ADDRESS", "STATUS_NDIS_INVALID_ADDRESS"},
+	0xC0230010: {0xC0230010, "STATUS_NDIS_INVALID_DEVICE_REQUEST", "STATUS_NDIS_INVALID_DEVICE_REQUEST"},
+	0xC023002A: {0xC023002A, "STATUS_NDIS_PAUSED", "STATUS_NDIS_PAUSED"},
+	0xC023002B: {0xC023002B, "STATUS_NDIS_INTERFACE_NOT_FOUND", "STATUS_NDIS_INTERFACE_NOT_FOUND"},
+	0xC023002C: {0xC023002C, "STATUS_NDIS_UNSUPPORTED_REVISION", "STATUS_NDIS_UNSUPPORTED_REVISION"},
+	0xC023002D: {0xC023002D, "STATUS_NDIS_INVALID_PORT", "STATUS_NDIS_INVALID_PORT"},
+	0xC023002E: {0xC023002E, "STATUS_NDIS_INVALID_PORT_STATE", "STATUS_NDIS_INVALID_PORT_STATE"},
+	0xC023002F: {0xC023002F, "STATUS_NDIS_LOW_POWER_STATE", "STATUS_NDIS_LOW_POWER_STATE"},
+	0xC0230030: {0xC0230030, "STATUS_NDIS_REINIT_REQUIRED", "STATUS_NDIS_REINIT_REQUIRED"},
+	0xC0230031: {0xC0230031, "STATUS_NDIS_NO_QUEUES", "STATUS_NDIS_NO_QUEUES"},
+	0xC0232000: {0xC0232000, "STATUS_NDIS_DOT11_AUTO_CONFIG_ENABLED", "STATUS_NDIS_DOT11_AUTO_CONFIG_ENABLED"},
+	0xC0232001: {0xC0232001, "STATUS_NDIS_DOT11_MEDIA_IN_USE", "STATUS_NDIS_DOT11_MEDIA_IN_USE"},
+	0xC0232002: {0xC0232002, "STATUS_NDIS_DOT11_POWER_STATE_INVALID", "STATUS_NDIS_DOT11_POWER_STATE_INVALID"},
+	0xC0232003: {0xC0232003, "STATUS_NDIS_PM_WOL_PATTERN_LIST_FULL", "STATUS_NDIS_PM_WOL_PATTERN_LIST_FULL"},
+	0xC0232004: {0xC0232004, "STATUS_NDIS_PM_PROTOCOL_OFFLOAD_LIST_FULL", "STATUS_NDIS_PM_PROTOCOL_OFFLOAD_LIST_FULL"},
+	0xC0232005: {0xC0232005, "STATUS_NDIS_DOT11_AP_CHANNEL_CURRENTLY_NOT_AVAILABLE", "STATUS_NDIS_DOT11_AP_CHANNEL_CURRENTLY_NOT_AVAILABLE"},
+	0xC0232006: {0xC0232006, "STATUS_NDIS_DOT11_AP_BAND_CURRENTLY_NOT_AVAILABLE", "STATUS_NDIS_DOT11_AP_BAND_CURRENTLY_NOT_AVAILABLE"},
+	0xC0232007: {0xC0232007, "STATUS_NDIS_DOT11_AP_CHANNEL_NOT_ALLOWED", "STATUS_NDIS_DOT11_AP_CHANNEL_NOT_ALLOWED"},
+	0xC0232008: {0xC0232008, "STATUS_NDIS_DOT11_AP_BAND_NOT_ALLOWED", "STATUS_NDIS_DOT11_AP_BAND_NOT_ALLOWED"},
+	0x40230001: {0x40230001, "STATUS_NDIS_INDICATION_REQUIRED", "STATUS_NDIS_INDICATION_REQUIRED"},
+	0xC023100F: {0xC023100F, "STATUS_NDIS_OFFLOAD_POLICY", "STATUS_NDIS_OFFLOAD_POLICY"},
+	0xC0231012: {0xC0231012, "STATUS_NDIS_OFFLOAD_CONNECTION_REJECTED", "STATUS_NDIS_OFFLOAD_CONNECTION_REJECTED"},
+	0xC0231013: {0xC0231013, "STATUS_NDIS_OFFLOAD_PATH_REJECTED", "STATUS_NDIS_OFFLOAD_PATH_REJECTED"},
+	0xC0290000: {0xC0290000, "STATUS_TPM_ERROR_MASK", "STATUS_TPM_ERROR_MASK"},
+	0xC0290001: {0xC0290001, "STATUS_TPM_AUTHFAIL", "STATUS_TPM_AUTHFAIL"},
+	0xC0290002: {0xC0290002, "STATUS_TPM_BADINDEX", "STATUS_TPM_BADINDEX"},
+	0xC0290003: {0xC0290003, "STATUS_TPM_BAD_PARAMETER", "STATUS_TPM_BAD_PARAMETER"},
+	0xC0290004: {0xC0290004, "STATUS_TPM_AUDITFAILURE", "STATUS_TPM_AUDITFAILURE"},
+	0xC0290005: {0xC0290005, "STATUS_TPM_CLEAR_DISABLED", "STATUS_TPM_CLEAR_DISABLED"},
+	0xC0290006: {0xC0290006, "STATUS_TPM_DEACTIVATED", "STATUS_TPM_DEACTIVATED"},
+	0xC0290007: {0xC0290007, "STATUS_TPM_DISABLED", "STATUS_TPM_DISABLED"},
+	0xC0290008: {0xC0290008, "STATUS_TPM_DISABLED_CMD", "STATUS_TPM_DISABLED_CMD"},
+	0xC0290009: {0xC0290009, "STATUS_TPM_FAIL", "STATUS_TPM_FAIL"},
+	0xC029000A: {0xC029000A, "STATUS_TPM_BAD_ORDINAL", "STATUS_TPM_BAD_ORDINAL"},
+	0xC029000B: {0xC029000B, "STATUS_TPM_INSTALL_DISABLED", "STATUS_TPM_INSTALL_DISABLED"},
+	0xC029000C: {0xC029000C, "STATUS_TPM_INVALID_KEYHANDLE", "STATUS_TPM_INVALID_KEYHANDLE"},
+	0xC029000D: {0xC029000D, "STATUS_TPM_KEYNOTFOUND", "STATUS_TPM_KEYNOTFOUND"},
+	0xC029000E: {0xC029000E, "STATUS_TPM_INAPPROPRIATE_ENC", "STATUS_TPM_INAPPROPRIATE_ENC"},
+	0xC029000F: {0xC029000F, "STATUS_TPM_MIGRATEFAIL", "STATUS_TPM_MIGRATEFAIL"},
+	0xC0290010: {0xC0290010, "STATUS_TPM_INVALID_PCR_INFO", "STATUS_TPM_INVALID_PCR_INFO"},
+	0xC0290011: {0xC0290011, "STATUS_TPM_NOSPACE", "STATUS_TPM_NOSPACE"},
+	0xC0290012: {0xC0290012, "STATUS_TPM_NOSRK", "STATUS_TPM_NOSRK"},
+	0xC0290013: {0xC0290013, "STATUS_TPM_NOTSEALED_BLOB", "STATUS_TPM_NOTSEALED_BLOB"},
+	0xC0290014: {0xC0290014, "STATUS_TPM_OWNER_SET", "STATUS_TPM_OWNER_SET"},
+	0xC0290015: {0xC0290015, "STATUS_TPM_RESOURCES", "STATUS_TPM_RESOURCES"},
+	0xC0290016: {0xC0290016, "STATUS_TPM_SHORTRANDOM", "STATUS_TPM_SHORTRANDOM"},
+	0xC0290017: {0xC0290017, "STATUS_TPM_SIZE", "STATUS_TPM_SIZE"},
+	0xC0290018: {0xC0290018, "STATUS_TPM_WRONGPCRVAL", "STATUS_TPM_WRONGPCRVAL"},
+	0xC0290019: {0xC0290019, "STATUS_TPM_BAD_PARAM_SIZE", "STATUS_TPM_BAD_PARAM_SIZE"},
+	0xC029001A: {0xC029001A, "STATUS_TPM_SHA_THREAD", "STATUS_TPM_SHA_THREAD"},
+	0xC029001B: {0xC029001B, "STATUS_TPM_SHA_ERROR", "STATUS_TPM_SHA_ERROR"},
+	0xC029001C: {0xC029001C, "STATUS_TPM_FAILEDSELFTEST", "STATUS_TPM_FAILEDSELFTEST"},
+	0xC029001D: {0xC029001D, "STATUS_TPM_AUTH2FAIL", "STATUS_TPM_AUTH2FAIL"},
+	0xC029001E: {0xC029001E, "STATUS_TPM_BADTAG", "STATUS_TPM_BADTAG"},
+	0xC029001F: {0xC029001F, "STATUS_TPM_IOERROR", "STATUS_TPM_IOERROR"},
+	0xC0290020: {0xC0290020, "STATUS_TPM_ENCRYPT_ERROR", "STATUS_TPM_ENCRYPT_ERROR"},
+	0xC0290021: {0xC0290021, "STATUS_TPM_DECRYPT_ERROR", "STATUS_TPM_DECRYPT_ERROR"},
+	0xC0290022: {0xC0290022, "STATUS_TPM_INVALID_AUTHHANDLE", "STATUS_TPM_INVALID_AUTHHANDLE"},
+	0xC0290023: {0xC0290023, "STATUS_TPM_NO_ENDORSEMENT", "STATUS_TPM_NO_ENDORSEMENT"},
+	0xC0290024: {0xC0290024, "STATUS_TPM_INVALID_KEYUSAGE", "STATUS_TPM_INVALID_KEYUSAGE"},
+	0xC0290025: {0xC0290025, "STATUS_TPM_WRONG_ENTITYTYPE", "STATUS_TPM_WRONG_ENTITYTYPE"},
+	0xC0290026: {0xC0290026, "STATUS_TPM_INVALID_POSTINIT", "STATUS_TPM_INVALID_POSTINIT"},
+	0xC0290027: {0xC0290027, "STATUS_TPM_INAPPROPRIATE_SIG", "STATUS_TPM_INAPPROPRIATE_SIG"},
+	0xC0290028: {0xC0290028, "STATUS_TPM_BAD_KEY_PROPERTY", "STATUS_TPM_BAD_KEY_PROPERTY"},
+	0xC0290029: {0xC0290029, "STATUS_TPM_BAD_MIGRATION", "STATUS_TPM_BAD_MIGRATION"},
+	0xC029002A: {0xC029002A, "STATUS_TPM_BAD_SCHEME", "STATUS_TPM_BAD_SCHEME"},
+	0xC029002B: {0xC029002B, "STATUS_TPM_BAD_DATASIZE", "STATUS_TPM_BAD_DATASIZE"},
+	0xC029002C: {0xC029002C, "STATUS_TPM_BAD_MODE", "STATUS_TPM_BAD_MODE"},
+	0xC029002D: {0xC029002D, "STATUS_TPM_BAD_PRESENCE", "STATUS_TPM_BAD_PRESENCE"},
+	0xC029002E: {0xC029002E, "STATUS_TPM_BAD_VERSION", "STATUS_TPM_BAD_VERSION"},
+	0xC029002F: {0xC029002F, "STATUS_TPM_NO_WRAP_TRANSPORT", "STATUS_TPM_NO_WRAP_TRANSPORT"},
+	0xC0290030: {0xC0290030, "STATUS_TPM_AUDITFAIL_UNSUCCESSFUL", "STATUS_TPM_AUDITFAIL_UNSUCCESSFUL"},
+	0xC0290031: {0xC0290031, "STATUS_TPM_AUDITFAIL_SUCCESSFUL", "STATUS_TPM_AUDITFAIL_SUCCESSFUL"},
+	0xC0290032: {0xC0290032, "STATUS_TPM_NOTRESETABLE", "STATUS_TPM_NOTRESETABLE"},
+	0xC0290033: {0xC0290033, "STATUS_TPM_NOTLOCAL", "STATUS_TPM_NOTLOCAL"},
+	0xC0290034: {0xC0290034, "STATUS_TPM_BAD_TYPE", "STATUS_TPM_BAD_TYPE"},
+	0xC0290035: {0xC0290035, "STATUS_TPM_INVALID_RESOURCE", "STATUS_TPM_INVALID_RESOURCE"},
+	0xC0290036: {0xC0290036, "STATUS_TPM_NOTFIPS", "STATUS_TPM_NOTFIPS"},
+	0xC0290037: {0xC0290037, "STATUS_TPM_INVALID_FAMILY", "STATUS_TPM_INVALID_FAMILY"},
+	0xC0290038: {0xC0290038, "STATUS_TPM_NO_NV_PERMISSION", "STATUS_TPM_NO_NV_PERMISSION"},
+	0xC0290039: {0xC0290039, "STATUS_TPM_REQUIRES_SIGN", "STATUS_TPM_REQUIRES_SIGN"},
+	0xC029003A: {0xC029003A, "STATUS_TPM_KEY_NOTSUPPORTED", "STATUS_TPM_KEY_NOTSUPPORTED"},
+	0xC029003B: {0xC029003B, "STATUS_TPM_AUTH_CONFLICT", "STATUS_TPM_AUTH_CONFLICT"},
+	0xC029003C: {0xC029003C, "STATUS_TPM_AREA_LOCKED", "STATUS_TPM_AREA_LOCKED"},
+	0xC029003D: {0xC029003D, "STATUS_TPM_BAD_LOCALITY", "STATUS_TPM_BAD_LOCALITY"},
+	0xC029003E: {0xC029003E, "STATUS_TPM_READ_ONLY", "STATUS_TPM_READ_ONLY"},
+	0xC029003F: {0xC029003F, "STATUS_TPM_PER_NOWRITE", "STATUS_TPM_PER_NOWRITE"},
+	0xC0290040: {0xC0290040, "STATUS_TPM_FAMILYCOUNT", "STATUS_TPM_FAMILYCOUNT"},
+	0xC0290041: {0xC0290041, "STATUS_TPM_WRITE_LOCKED", "STATUS_TPM_WRITE_LOCKED"},
+	0xC0290042: {0xC0290042, "STATUS_TPM_BAD_ATTRIBUTES", "STATUS_TPM_BAD_ATTRIBUTES"},
+	0xC0290043: {0xC0290043, "STATUS_TPM_INVALID_STRUCTURE", "STATUS_TPM_INVALID_STRUCTURE"},
+	0xC0290044: {0xC0290044, "STATUS_TPM_KEY_OWNER_CONTROL", "STATUS_TPM_KEY_OWNER_CONTROL"},
+	0xC0290045: {0xC0290045, "STATUS_TPM_BAD_COUNTER", "STATUS_TPM_BAD_COUNTER"},
+	0xC0290046: {0xC0290046, "STATUS_TPM_NOT_FULLWRITE", "STATUS_TPM_NOT_FULLWRITE"},
+	0xC0290047: {0xC0290047, "STATUS_TPM_CONTEXT_GAP", "STATUS_TPM_CONTEXT_GAP"},
+	0xC0290048: {0xC0290048, "STATUS_TPM_MAXNVWRITES", "STATUS_TPM_MAXNVWRITES"},
+	0xC0290049: {0xC0290049, "STATUS_TPM_NOOPERATOR", "STATUS_TPM_NOOPERATOR"},
+	0xC029004A: {0xC029004A, "STATUS_TPM_RESOURCEMISSING", "STATUS_TPM_RESOURCEMISSING"},
+	0xC029004B: {0xC029004B, "STATUS_TPM_DELEGATE_LOCK", "STATUS_TPM_DELEGATE_LOCK"},
+	0xC029004C: {0xC029004C, "STATUS_TPM_DELEGATE_FAMILY", "STATUS_TPM_DELEGATE_FAMILY"},
+	0xC029004D: {0xC029004D, "STATUS_TPM_DELEGATE_ADMIN", "STATUS_TPM_DELEGATE_ADMIN"},
+	0xC029004E: {0xC029004E, "STATUS_TPM_TRANSPORT_NOTEXCLUSIVE", "STATUS_TPM_TRANSPORT_NOTEXCLUSIVE"},
+	0xC029004F: {0xC029004F, "STATUS_TPM_OWNER_CONTROL", "STATUS_TPM_OWNER_CONTROL"},
+	0xC0290050: {0xC0290050, "STATUS_TPM_DAA_RESOURCES", "STATUS_TPM_DAA_RESOURCES"},
+	0xC0290051: {0xC0290051, "STATUS_TPM_DAA_INPUT_DATA0", "STATUS_TPM_DAA_INPUT_DATA0"},
+	0xC0290052: {0xC0290052, "STATUS_TPM_DAA_INPUT_DATA1", "STATUS_TPM_DAA_INPUT_DATA1"},
+	0xC0290053: {0xC0290053, "STATUS_TPM_DAA_ISSUER_SETTINGS", "STATUS_TPM_DAA_ISSUER_SETTINGS"},
+	0xC0290054: {0xC0290054, "STATUS_TPM_DAA_TPM_SETTINGS", "STATUS_TPM_DAA_TPM_SETTINGS"},
+	0xC0290055: {0xC0290055, "STATUS_TPM_DAA_STAGE", "STATUS_TPM_DAA_STAGE"},
+	0xC0290056: {0xC0290056, "STATUS_TPM_DAA_ISSUER_VALIDITY", "STATUS_TPM_DAA_ISSUER_VALIDITY"},
+	0xC0290057: {0xC0290057, "STATUS_TPM_DAA_WRONG_W", "STATUS_TPM_DAA_WRONG_W"},
+	0xC0290058: {0xC0290058, "STATUS_TPM_BAD_HANDLE", "STATUS_TPM_BAD_HANDLE"},
+	0xC0290059: {0xC0290059, "STATUS_TPM_BAD_DELEGATE", "STATUS_TPM_BAD_DELEGATE"},
+	0xC029005A: {0xC029005A, "STATUS_TPM_BADCONTEXT", "STATUS_TPM_BADCONTEXT"},
+	0xC029005B: {0xC029005B, "STATUS_TPM_TOOMANYCONTEXTS", "STATUS_TPM_TOOMANYCONTEXTS"},
+	0xC029005C: {0xC029005C, "STATUS_TPM_MA_TICKET_SIGNATURE", "STATUS_TPM_MA_TICKET_SIGNATURE"},
+	0xC029005D: {0xC029005D, "STATUS_TPM_MA_DESTINATION", "STATUS_TPM_MA_DESTINATION"},
+	0xC029005E: {0xC029005E, "STATUS_TPM_MA_SOURCE", "STATUS_TPM_MA_SOURCE"},
+	0xC029005F: {0xC029005F, "STATUS_TPM_MA_AUTHORITY", "STATUS_TPM_MA_AUTHORITY"},
+	0xC0290061: {0xC0290061, "STATUS_TPM_PERMANENTEK", "STATUS_TPM_PERMANENTEK"},
+	0xC0290062: {0xC0290062, "STATUS_TPM_BAD_SIGNATURE", "STATUS_TPM_BAD_SIGNATURE"},
+	0xC0290063: {0xC0290063, "STATUS_TPM_NOCONTEXTSPACE", "STATUS_TPM_NOCONTEXTSPACE"},
+	0xC0290081: {0xC0290081, "STATUS_TPM_20_E_ASYMMETRIC", "STATUS_TPM_20_E_ASYMMETRIC"},
+	0xC0290082: {0xC0290082, "STATUS_TPM_20_E_ATTRIBUTES", "STATUS_TPM_20_E_ATTRIBUTES"},
+	0xC0290083: {0xC0290083, "STATUS_TPM_20_E_HASH", "STATUS_TPM_20_E_HASH"},
+	0xC0290084: {0xC0290084, "STATUS_TPM_20_E_VALUE", "STATUS_TPM_20_E_VALUE"},
+	0xC0290085: {0xC0290085, "STATUS_TPM_20_E_HIERARCHY", "STATUS_TPM_20_E_HIERARCHY"},
+	0xC0290087: {0xC0290087, "STATUS_TPM_20_E_KEY_SIZE", "STATUS_TPM_20_E_KEY_SIZE"},
+	0xC0290088: {0xC0290088, "STATUS_TPM_20_E_MGF", "STATUS_TPM_20_E_MGF"},
+	0xC0290089: {0xC0290089, "STATUS_TPM_20_E_MODE", "STATUS_TPM_20_E_MODE"},
+	0xC029008A: {0xC029008A, "STATUS_TPM_20_E_TYPE", "STATUS_TPM_20_E_TYPE"},
+	0xC029008B: {0xC029008B, "STATUS_TPM_20_E_HANDLE", "STATUS_TPM_20_E_HANDLE"},
+	0xC029008C: {0xC029008C, "STATUS_TPM_20_E_KDF", "STATUS_TPM_20_E_KDF"},
+	0xC029008D: {0xC029008D, "STATUS_TPM_20_E_RANGE", "STATUS_TPM_20_E_RANGE"},
+	0xC029008E: {0xC029008E, "STATUS_TPM_20_E_AUTH_FAIL", "STATUS_TPM_20_E_AUTH_FAIL"},
+	0xC029008F: {0xC029008F, "STATUS_TPM_20_E_NONCE", "STATUS_TPM_20_E_NONCE"},
+	0xC0290090: {0xC0290090, "STATUS_TPM_20_E_PP", "STATUS_TPM_20_E_PP"},
+	0xC0290092: {0xC0290092, "STATUS_TPM_20_E_SCHEME", "STATUS_TPM_20_E_SCHEME"},
+	0xC0290095: {0xC0290095, "STATUS_TPM_20_E_SIZE", "STATUS_TPM_20_E_SIZE"},
+	0xC0290096: {0xC0290096, "STATUS_TPM_20_E_SYMMETRIC", "STATUS_TPM_20_E_SYMMETRIC"},
+	0xC0290097: {0xC0290097, "STATUS_TPM_20_E_TAG", "STATUS_TPM_20_E_TAG"},
+	0xC0290098: {0xC0290098, "STATUS_TPM_20_E_SELECTOR", "STATUS_TPM_20_E_SELECTOR"},
+	0xC029009A: {0xC029009A, "STATUS_TPM_20_E_INSUFFICIENT", "STATUS_TPM_20_E_INSUFFICIENT"},
+	0xC029009B: {0xC029009B, "STATUS_TPM_20_E_SIGNATURE", "STATUS_TPM_20_E_SIGNATURE"},
+	0xC029009C: {0xC029009C, "STATUS_TPM_20_E_KEY", "STATUS_TPM_20_E_KEY"},
+	0xC029009D: {0xC029009D, "STATUS_TPM_20_E_POLICY_FAIL", "STATUS_TPM_20_E_POLICY_FAIL"},
+	0xC029009F: {0xC029009F, "STATUS_TPM_20_E_INTEGRITY", "STATUS_TPM_20_E_INTEGRITY"},
+	0xC02900A0: {0xC02900A0, "STATUS_TPM_20_E_TICKET", "STATUS_TPM_20_E_TICKET"},
+	0xC02900A1: {0xC02900A1, "STATUS_TPM_20_E_RESERVED_BITS", "STATUS_TPM_20_E_RESERVED_BITS"},
+	0xC02900A2: {0xC02900A2, "STATUS_TPM_20_E_BAD_AUTH", "STATUS_TPM_20_E_BAD_AUTH"},
+	0xC02900A3: {0xC02900A3, "STATUS_TPM_20_E_EXPIRED", "STATUS_TPM_20_E_EXPIRED"},
+	0xC02900A4: {0xC02900A4, "STATUS_TPM_20_E_POLICY_CC", "STATUS_TPM_20_E_POLICY_CC"},
+	0xC02900A5: {0xC02900A5, "STATUS_TPM_20_E_BINDING", "STATUS_TPM_20_E_BINDING"},
+	0xC02900A6: {0xC02900A6, "STATUS_TPM_20_E_CURVE", "STATUS_TPM_20_E_CURVE"},
+	0xC02900A7: {0xC02900A7, "STATUS_TPM_20_E_ECC_POINT", "STATUS_TPM_20_E_ECC_POINT"},
+	0xC0290100: {0xC0290100, "STATUS_TPM_20_E_INITIALIZE", "STATUS_TPM_20_E_INITIALIZE"},
+	0xC0290101: {0xC0290101, "STATUS_TPM_20_E_FAILURE", "STATUS_TPM_20_E_FAILURE"},
+	0xC0290103: {0xC0290103, "STATUS_TPM_20_E_SEQUENCE", "STATUS_TPM_20_E_SEQUENCE"},
+	0xC029010B: {0xC029010B, "STATUS_TPM_20_E_PRIVATE", "STATUS_TPM_20_E_PRIVATE"},
+	0xC0290119: {0xC0290119, "STATUS_TPM_20_E_HMAC", "STATUS_TPM_20_E_HMAC"},
+	0xC0290120: {0xC0290120, "STATUS_TPM_20_E_DISABLED", "STATUS_TPM_20_E_DISABLED"},
+	0xC0290121: {0xC0290121, "STATUS_TPM_20_E_EXCLUSIVE", "STATUS_TPM_20_E_EXCLUSIVE"},
+	0xC0290123: {0xC0290123, "STATUS_TPM_20_E_ECC_CURVE", "STATUS_TPM_20_E_ECC_CURVE"},
+	0xC0290124: {0xC0290124, "STATUS_TPM_20_E_AUTH_TYPE", "STATUS_TPM_20_E_AUTH_TYPE"},
+	0xC0290125: {0xC0290125, "STATUS_TPM_20_E_AUTH_MISSING", "STATUS_TPM_20_E_AUTH_MISSING"},
+	0xC0290126: {0xC0290126, "STATUS_TPM_20_E_POLICY", "STATUS_TPM_20_E_POLICY"},
+	0xC0290127: {0xC0290127, "STATUS_TPM_20_E_PCR", "STATUS_TPM_20_E_PCR"},
+	0xC0290128: {0xC0290128, "STATUS_TPM_20_E_PCR_CHANGED", "STATUS_TPM_20_E_PCR_CHANGED"},
+	0xC029012D: {0xC029012D, "STATUS_TPM_20_E_UPGRADE", "STATUS_TPM_20_E_UPGRADE"},
+	0xC029012E: {0xC029012E, "STATUS_TPM_20_E_TOO_MANY_CONTEXTS", "STATUS_TPM_20_E_TOO_MANY_CONTEXTS"},
+	0xC029012F: {0xC029012F, "STATUS_TPM_20_E_AUTH_UNAVAILABLE", "STATUS_TPM_20_E_AUTH_UNAVAILABLE"},
+	0xC0290130: {0xC0290130, "STATUS_TPM_20_E_REBOOT", "STATUS_TPM_20_E_REBOOT"},
+	0xC0290131: {0xC0290131, "STATUS_TPM_20_E_UNBALANCED", "STATUS_TPM_20_E_UNBALANCED"},
+	0xC0290142: {0xC0290142, "STATUS_TPM_20_E_COMMAND_SIZE", "STATUS_TPM_20_E_COMMAND_SIZE"},
+	0xC0290143: {0xC0290143, "STATUS_TPM_20_E_COMMAND_CODE", "STATUS_TPM_20_E_COMMAND_CODE"},
+	0xC0290144: {0xC0290144, "STATUS_TPM_20_E_AUTHSIZE", "STATUS_TPM_20_E_AUTHSIZE"},
+	0xC0290145: {0xC0290145, "STATUS_TPM_20_E_AUTH_CONTEXT", "STATUS_TPM_20_E_AUTH_CONTEXT"},
+	0xC0290146: {0xC0290146, "STATUS_TPM_20_E_NV_RANGE", "STATUS_TPM_20_E_NV_RANGE"},
+	0xC0290147: {0xC0290147, "STATUS_TPM_20_E_NV_SIZE", "STATUS_TPM_20_E_NV_SIZE"},
+	0xC0290148: {0xC0290148, "STATUS_TPM_20_E_NV_LOCKED", "STATUS_TPM_20_E_NV_LOCKED"},
+	0xC0290149: {0xC0290149, "STATUS_TPM_20_E_NV_AUTHORIZATION", "STATUS_TPM_20_E_NV_AUTHORIZATION"},
+	0xC029014A: {0xC029014A, "STATUS_TPM_20_E_NV_UNINITIALIZED", "STATUS_TPM_20_E_NV_UNINITIALIZED"},
+	0xC029014B: {0xC029014B, "STATUS_TPM_20_E_NV_SPACE", "STATUS_TPM_20_E_NV_SPACE"},
+	0xC029014C: {0xC029014C, "STATUS_TPM_20_E_NV_DEFINED", "STATUS_TPM_20_E_NV_DEFINED"},
+	0xC0290150: {0xC0290150, "STATUS_TPM_20_E_BAD_CONTEXT", "STATUS_TPM_20_E_BAD_CONTEXT"},
+	0xC0290151: {0xC0290151, "STATUS_TPM_20_E_CPHASH", "STATUS_TPM_20_E_CPHASH"},
+	0xC0290152: {0xC0290152, "STATUS_TPM_20_E_PARENT", "STATUS_TPM_20_E_PARENT"},
+	0xC0290153: {0xC0290153, "STATUS_TPM_20_E_NEEDS_TEST", "STATUS_TPM_20_E_NEEDS_TEST"},
+	0xC0290154: {0xC0290154, "STATUS_TPM_20_E_NO_RESULT", "STATUS_TPM_20_E_NO_RESULT"},
+	0xC0290155: {0xC0290155, "STATUS_TPM_20_E_SENSITIVE", "STATUS_TPM_20_E_SENSITIVE"},
+	0xC0290400: {0xC0290400, "STATUS_TPM_COMMAND_BLOCKED", "STATUS_TPM_COMMAND_BLOCKED"},
+	0xC0290401: {0xC0290401, "STATUS_TPM_INVALID_HANDLE", "STATUS_TPM_INVALID_HANDLE"},
+	0xC0290402: {0xC0290402, "STATUS_TPM_DUPLICATE_VHANDLE", "STATUS_TPM_DUPLICATE_VHANDLE"},
+	0xC0290403: {0xC0290403, "STATUS_TPM_EMBEDDED_COMMAND_BLOCKED", "STATUS_TPM_EMBEDDED_COMMAND_BLOCKED"},
+	0xC0290404: {0xC0290404, "STATUS_TPM_EMBEDDED_COMMAND_UNSUPPORTED", "STATUS_TPM_EMBEDDED_COMMAND_UNSUPPORTED"},
+	0xC0290800: {0xC0290800, "STATUS_TPM_RETRY", "STATUS_TPM_RETRY"},
+	0xC0290801: {0xC0290801, "STATUS_TPM_NEEDS_SELFTEST", "STATUS_TPM_NEEDS_SELFTEST"},
+	0xC0290802: {0xC0290802, "STATUS_TPM_DOING_SELFTEST", "STATUS_TPM_DOING_SELFTEST"},
+	0xC0290803: {0xC0290803, "STATUS_TPM_DEFEND_LOCK_RUNNING", "STATUS_TPM_DEFEND_LOCK_RUNNING"},
+	0xC0291001: {0xC0291001, "STATUS_TPM_COMMAND_CANCELED", "STATUS_TPM_COMMAND_CANCELED"},
+	0xC0291002: {0xC0291002, "STATUS_TPM_TOO_MANY_CONTEXTS", "STATUS_TPM_TOO_MANY_CONTEXTS"},
+	0xC0291003: {0xC0291003, "STATUS_TPM_NOT_FOUND", "STATUS_TPM_NOT_FOUND"},
+	0xC0291004: {0xC0291004, "STATUS_TPM_ACCESS_DENIED", "STATUS_TPM_ACCESS_DENIED"},
+	0xC0291005: {0xC0291005, "STATUS_TPM_INSUFFICIENT_BUFFER", "STATUS_TPM_INSUFFICIENT_BUFFER"},
+	0xC0291006: {0xC0291006, "STATUS_TPM_PPI_FUNCTION_UNSUPPORTED", "STATUS_TPM_PPI_FUNCTION_UNSUPPORTED"},
+	0xC0292000: {0xC0292000, "STATUS_PCP_ERROR_MASK", "STATUS_PCP_ERROR_MASK"},
+	0xC0292001: {0xC0292001, "STATUS_PCP_DEVICE_NOT_READY", "STATUS_PCP_DEVICE_NOT_READY"},
+	0xC0292002: {0xC0292002, "STATUS_PCP_INVALID_HANDLE", "STATUS_PCP_INVALID_HANDLE"},
+	0xC0292003: {0xC0292003, "STATUS_PCP_INVALID_PARAMETER", "STATUS_PCP_INVALID_PARAMETER"},
+	0xC0292004: {0xC0292004, "STATUS_PCP_FLAG_NOT_SUPPORTED", "STATUS_PCP_FLAG_NOT_SUPPORTED"},
+	0xC0292005: {0xC0292005, "STATUS_PCP_NOT_SUPPORTED", "STATUS_PCP_NOT_SUPPORTED"},
+	0xC0292006: {0xC0292006, "STATUS_PCP_BUFFER_TOO_SMALL", "STATUS_PCP_BUFFER_TOO_SMALL"},
+	0xC0292007: {0xC0292007, "STATUS_PCP_INTERNAL_ERROR", "STATUS_PCP_INTERNAL_ERROR"},
+	0xC0292008: {0xC0292008, "STATUS_PCP_AUTHENTICATION_FAILED", "STATUS_PCP_AUTHENTICATION_FAILED"},
+	0xC0292009: {0xC0292009, "STATUS_PCP_AUTHENTICATION_IGNORED", "STATUS_PCP_AUTHENTICATION_IGNORED"},
+	0xC029200A: {0xC029200A, "STATUS_PCP_POLICY_NOT_FOUND", "STATUS_PCP_POLICY_NOT_FOUND"},
+	0xC029200B: {0xC029200B, "STATUS_PCP_PROFILE_NOT_FOUND", "STATUS_PCP_PROFILE_NOT_FOUND"},
+	0xC029200C: {0xC029200C, "STATUS_PCP_VALIDATION_FAILED", "STATUS_PCP_VALIDATION_FAILED"},
+	0xC029200D: {0xC029200D, "STATUS_PCP_DEVICE_NOT_FOUND", "STATUS_PCP_DEVICE_NOT_FOUND"},
+	0xC029200E: {0xC029200E, "STATUS_PCP_WRONG_PARENT", "STATUS_PCP_WRONG_PARENT"},
+	0xC029200F: {0xC029200F, "STATUS_PCP_KEY_NOT_LOADED", "STATUS_PCP_KEY_NOT_LOADED"},
+	0xC0292010: {0xC0292010, "STATUS_PCP_NO_KEY_CERTIFICATION", "STATUS_PCP_NO_KEY_CERTIFICATION"},
+	0xC0292011: {0xC0292011, "STATUS_PCP_KEY_NOT_FINALIZED", "STATUS_PCP_KEY_NOT_FINALIZED"},
+	0xC0292012: {0xC0292012, "STATUS_PCP_ATTESTATION_CHALLENGE_NOT_SET", "STATUS_PCP_ATTESTATION_CHALLENGE_NOT_SET"},
+	0xC0292013: {0xC0292013, "STATUS_PCP_NOT_PCR_BOUND", "STATUS_PCP_NOT_PCR_BOUND"},
+	0xC0292014: {0xC0292014, "STATUS_PCP_KEY_ALREADY_FINALIZED", "STATUS_PCP_KEY_ALREADY_FINALIZED"},
+	0xC0292015: {0xC0292015, "STATUS_PCP_KEY_USAGE_POLICY_NOT_SUPPORTED", "STATUS_PCP_KEY_USAGE_POLICY_NOT_SUPPORTED"},
+	0xC0292016: {0xC0292016, "STATUS_PCP_KEY_USAGE_POLICY_INVALID", "STATUS_PCP_KEY_USAGE_POLICY_INVALID"},
+	0xC0292017: {0xC0292017, "STATUS_PCP_SOFT_KEY_ERROR", "STATUS_PCP_SOFT_KEY_ERROR"},
+	0xC0292018: {0xC0292018, "STATUS_PCP_KEY_NOT_AUTHENTICATED", "STATUS_PCP_KEY_NOT_AUTHENTICATED"},
+	0xC0292019: {0xC0292019, "STATUS_PCP_KEY_NOT_AIK", "STATUS_PCP_KEY_NOT_AIK"},
+	0xC029201A: {0xC029201A, "STATUS_PCP_KEY_NOT_SIGNING_KEY", "STATUS_PCP_KEY_NOT_SIGNING_KEY"},
+	0xC029201B: {0xC029201B, "STATUS_PCP_LOCKED_OUT", "STATUS_PCP_LOCKED_OUT"},
+	0xC029201C: {0xC029201C, "STATUS_PCP_CLAIM_TYPE_NOT_SUPPORTED", "STATUS_PCP_CLAIM_TYPE_NOT_SUPPORTED"},
+	0xC029201D: {0xC029201D, "STATUS_PCP_TPM_VERSION_NOT_SUPPORTED", "STATUS_PCP_TPM_VERSION_NOT_SUPPORTED"},
+	0xC029201E: {0xC029201E, "STATUS_PCP_BUFFER_LENGTH_MISMATCH", "STATUS_PCP_BUFFER_LENGTH_MISMATCH"},
+	0xC029201F: {0xC029201F, "STATUS_PCP_IFX_RSA_KEY_CREATION_BLOCKED", "STATUS_PCP_IFX_RSA_KEY_CREATION_BLOCKED"},
+	0xC0292020: {0xC0292020, "STATUS_PCP_TICKET_MISSING", "STATUS_PCP_TICKET_MISSING"},
+	0xC0292021: {0xC0292021, "STATUS_PCP_RAW_POLICY_NOT_SUPPORTED", "STATUS_PCP_RAW_POLICY_NOT_SUPPORTED"},
+	0xC0292022: {0xC0292022, "STATUS_PCP_KEY_HANDLE_INVALIDATED", "STATUS_PCP_KEY_HANDLE_INVALIDATED"},
+	0x40292023: {0x40292023, "STATUS_PCP_UNSUPPORTED_PSS_SALT", "STATUS_PCP_UNSUPPORTED_PSS_SALT"},
+	0x00293000: {0x00293000, "STATUS_RTPM_CONTEXT_CONTINUE", "STATUS_RTPM_CONTEXT_CONTINUE"},
+	0x00293001: {0x00293001, "STATUS_RTPM_CONTEXT_COMPLETE", "STATUS_RTPM_CONTEXT_COMPLETE"},
+	0xC0293002: {0xC0293002, "STATUS_RTPM_NO_RESULT", "STATUS_RTPM_NO_RESULT"},
+	0xC0293003: {0xC0293003, "STATUS_RTPM_PCR_READ_INCOMPLETE", "STATUS_RTPM_PCR_READ_INCOMPLETE"},
+	0xC0293004: {0xC0293004, "STATUS_RTPM_INVALID_CONTEXT", "STATUS_RTPM_INVALID_CONTEXT"},
+	0xC0293005: {0xC0293005, "STATUS_RTPM_UNSUPPORTED_CMD", "STATUS_RTPM_UNSUPPORTED_CMD"},
+	0xC0294000: {0xC0294000, "STATUS_TPM_ZERO_EXHAUST_ENABLED", "STATUS_TPM_ZERO_EXHAUST_ENABLED"},
+	0xC0350002: {0xC0350002, "STATUS_HV_INVALID_HYPERCALL_CODE", "STATUS_HV_INVALID_HYPERCALL_CODE"},
+	0xC0350003: {0xC0350003, "STATUS_HV_INVALID_HYPERCALL_INPUT", "STATUS_HV_INVALID_HYPERCALL_INPUT"},
+	0xC0350004: {0xC0350004, "STATUS_HV_INVALID_ALIGNMENT", "STATUS_HV_INVALID_ALIGNMENT"},
+	0xC0350005: {0xC0350005, "STATUS_HV_INVALID_PARAMETER", "STATUS_HV_INVALID_PARAMETER"},
+	0xC0350006: {0xC0350006, "STATUS_HV_ACCESS_DENIED", "STATUS_HV_ACCESS_DENIED"},
+	0xC0350007: {0xC0350007, "STATUS_HV_INVALID_PARTITION_STATE", "STATUS_HV_INVALID_PARTITION_STATE"},
+	0xC0350008: {0xC0350008, "STATUS_HV_OPERATION_DENIED", "STATUS_HV_OPERATION_DENIED"},
+	0xC0350009: {0xC0350009, "STATUS_HV_UNKNOWN_PROPERTY", "STATUS_HV_UNKNOWN_PROPERTY"},
+	0xC035000A: {0xC035000A, "STATUS_HV_PROPERTY_VALUE_OUT_OF_RANGE", "STATUS_HV_PROPERTY_VALUE_OUT_OF_RANGE"},
+	0xC035000B: {0xC035000B, "STATUS_HV_INSUFFICIENT_MEMORY", "STATUS_HV_INSUFFICIENT_MEMORY"},
+	0xC035000C: {0xC035000C, "STATUS_HV_PARTITION_TOO_DEEP", "STATUS_HV_PARTITION_TOO_DEEP"},
+	0xC035000D: {0xC035000D, "STATUS_HV_INVALID_PARTITION_ID", "STATUS_HV_INVALID_PARTITION_ID"},
+	0xC035000E: {0xC035000E, "STATUS_HV_INVALID_VP_INDEX", "STATUS_HV_INVALID_VP_INDEX"},
+	0xC0350011: {0xC0350011, "STATUS_HV_INVALID_PORT_ID", "STATUS_HV_INVALID_PORT_ID"},
+	0xC0350012: {0xC0350012, "STATUS_HV_INVALID_CONNECTION_ID", "STATUS_HV_INVALID_CONNECTION_ID"},
+	0xC0350013: {0xC0350013, "STATUS_HV_INSUFFICIENT_BUFFERS", "STATUS_HV_INSUFFICIENT_BUFFERS"},
+	0xC0350014: {0xC0350014, "STATUS_HV_NOT_ACKNOWLEDGED", "STATUS_HV_NOT_ACKNOWLEDGED"},
+	0xC0350015: {0xC0350015, "STATUS_HV_INVALID_VP_STATE", "STATUS_HV_INVALID_VP_STATE"},
+	0xC0350016: {0xC0350016, "STATUS_HV_ACKNOWLEDGED", "STATUS_HV_ACKNOWLEDGED"},
+	0xC0350017: {0xC0350017, "STATUS_HV_INVALID_SAVE_RESTORE_STATE", "STATUS_HV_INVALID_SAVE_RESTORE_STATE"},
+	0xC0350018: {0xC0350018, "STATUS_HV_INVALID_SYNIC_STATE", "STATUS_HV_INVALID_SYNIC_STATE"},
+	0xC0350019: {0xC0350019, "STATUS_HV_OBJECT_IN_USE", "STATUS_HV_OBJECT_IN_USE"},
+	0xC035001A: {0xC035001A, "STATUS_HV_INVALID_PROXIMITY_DOMAIN_INFO", "STATUS_HV_INVALID_PROXIMITY_DOMAIN_INFO"},
+	0xC035001B: {0xC035001B, "STATUS_HV_NO_DATA", "STATUS_HV_NO_DATA"},
+	0xC035001C: {0xC035001C, "STATUS_HV_INACTIVE", "STATUS_HV_INACTIVE"},
+	0xC035001D: {0xC035001D, "STATUS_HV_NO_RESOURCES", "STATUS_HV_NO_RESOURCES"},
+	0xC035001E: {0xC035001E, "STATUS_HV_FEATURE_UNAVAILABLE", "STATUS_HV_FEATURE_UNAVAILABLE"},
+	0xC0350033: {0xC0350033, "STATUS_HV_INSUFFICIENT_BUFFER", "STATUS_HV_INSUFFICIENT_BUFFER"},
+	0xC0350038: {0xC0350038, "STATUS_HV_INSUFFICIENT_DEVICE_DOMAINS", "STATUS_HV_INSUFFICIENT_DEVICE_DOMAINS"},
+	0xC035003C: {0xC035003C, "STATUS_HV_CPUID_FEATURE_VALIDATION_ERROR", "STATUS_HV_CPUID_FEATURE_VALIDATION_ERROR"},
+	0xC035003D: {0xC035003D, "STATUS_HV_CPUID_XSAVE_FEATURE_VALIDATION_ERROR", "STATUS_HV_CPUID_XSAVE_FEATURE_VALIDATION_ERROR"},
+	0xC035003E: {0xC035003E, "STATUS_HV_PROCESSOR_STARTUP_TIMEOUT", "STATUS_HV_PROCESSOR_STARTUP_TIMEOUT"},
+	0xC035003F: {0xC035003F, "STATUS_HV_SMX_ENABLED", "STATUS_HV_SMX_ENABLED"},
+	0xC0350041: {0xC0350041, "STATUS_HV_INVALID_LP_INDEX", "STATUS_HV_INVALID_LP_INDEX"},
+	0xC0350050: {0xC0350050, "STATUS_HV_INVALID_REGISTER_VALUE", "STATUS_HV_INVALID_REGISTER_VALUE"},
+	0xC0350051: {0xC0350051, "STATUS_HV_INVALID_VTL_STATE", "STATUS_HV_INVALID_VTL_STATE"},
+	0xC0350055: {0xC0350055, "STATUS_HV_NX_NOT_DETECTED", "STATUS_HV_NX_NOT_DETECTED"},
+	0xC0350057: {0xC0350057, "STATUS_HV_INVALID_DEVICE_ID", "STATUS_HV_INVALID_DEVICE_ID"},
+	0xC0350058: {0xC0350058, "STATUS_HV_INVALID_DEVICE_STATE", "STATUS_HV_INVALID_DEVICE_STATE"},
+	0x00350059: {0x00350059, "STATUS_HV_PENDING_PAGE_REQUESTS", "STATUS_HV_PENDING_PAGE_REQUESTS"},
+	0xC0350060: {0xC0350060, "STATUS_HV_PAGE_REQUEST_INVALID", "STATUS_HV_PAGE_REQUEST_INVALID"},
+	0xC035006F: {0xC035006F, "STATUS_HV_INVALID_CPU_GROUP_ID", "STATUS_HV_INVALID_CPU_GROUP_ID"},
+	0xC0350070: {0xC0350070, "STATUS_HV_INVALID_CPU_GROUP_STATE", "STATUS_HV_INVALID_CPU_GROUP_STATE"},
+	0xC0350071: {0xC0350071, "STATUS_HV_OPERATION_FAILED", "STATUS_HV_OPERATION_FAILED"},
+	0xC0350072: {0xC0350072, "STATUS_HV_NOT_ALLOWED_WITH_NESTED_VIRT_ACTIVE", "STATUS_HV_NOT_ALLOWED_WITH_NESTED_VIRT_ACTIVE"},
+	0xC0350073: {0xC0350073, "STATUS_HV_INSUFFICIENT_ROOT_MEMORY", "STATUS_HV_INSUFFICIENT_ROOT_MEMORY"},
+	0xC0351000: {0xC0351000, "STATUS_HV_NOT_PRESENT", "STATUS_HV_NOT_PRESENT"},
+	0xC0370001: {0xC0370001, "STATUS_VID_DUPLICATE_HANDLER", "STATUS_VID_DUPLICATE_HANDLER"},
+	0xC0370002: {0xC0370002, "STATUS_VID_TOO_MANY_HANDLERS", "STATUS_VID_TOO_MANY_HANDLERS"},
+	0xC0370003: {0xC0370003, "STATUS_VID_QUEUE_FULL", "STATUS_VID_QUEUE_FULL"},
+	0xC0370004: {0xC0370004, "STATUS_VID_HANDLER_NOT_PRESENT", "STATUS_VID_HANDLER_NOT_PRESENT"},
+	0xC0370005: {0xC0370005, "STATUS_VID_INVALID_OBJECT_NAME", "STATUS_VID_INVALID_OBJECT_NAME"},
+	0xC0370006: {0xC0370006, "STATUS_VID_PARTITION_NAME_TOO_LONG", "STATUS_VID_PARTITION_NAME_TOO_LONG"},
+	0xC0370007: {0xC0370007, "STATUS_VID_MESSAGE_QUEUE_NAME_TOO_LONG", "STATUS_VID_MESSAGE_QUEUE_NAME_TOO_LONG"},
+	0xC0370008: {0xC0370008, "STATUS_VID_PARTITION_ALREADY_EXISTS", "STATUS_VID_PARTITION_ALREADY_EXISTS"},
+	0xC0370009: {0xC0370009, "STATUS_VID_PARTITION_DOES_NOT_EXIST", "STATUS_VID_PARTITION_DOES_NOT_EXIST"},
+	0xC037000A: {0xC037000A, "STATUS_VID_PARTITION_NAME_NOT_FOUND", "STATUS_VID_PARTITION_NAME_NOT_FOUND"},
+	0xC037000B: {0xC037000B, "STATUS_VID_MESSAGE_QUEUE_ALREADY_EXISTS", "STATUS_VID_MESSAGE_QUEUE_ALREADY_EXISTS"},
+	0xC037000C: {0xC037000C, "STATUS_VID_EXCEEDED_MBP_ENTRY_MAP_LIMIT", "STATUS_VID_EXCEEDED_MBP_ENTRY_MAP_LIMIT"},
+	0xC037000D: {0xC037000D, "STATUS_VID_MB_STILL_REFERENCED", "STATUS_VID_MB_STILL_REFERENCED"},
+	0xC037000E: {0xC037000E, "STATUS_VID_CHILD_GPA_PAGE_SET_CORRUPTED", "STATUS_VID_CHILD_GPA_PAGE_SET_CORRUPTED"},
+	0xC037000F: {0xC037000F, "STATUS_VID_INVALID_NUMA_SETTINGS", "STATUS_VID_INVALID_NUMA_SETTINGS"},
+	0xC0370010: {0xC0370010, "STATUS_VID_INVALID_NUMA_NODE_INDEX", "STATUS_VID_INVALID_NUMA_NODE_INDEX"},
+	0xC0370011: {0xC0370011, "STATUS_VID_NOTIFICATION_QUEUE_ALREADY_ASSOCIATED", "STATUS_VID_NOTIFICATION_QUEUE_ALREADY_ASSOCIATED"},
+	0xC0370012: {0xC0370012, "STATUS_VID_INVALID_MEMORY_BLOCK_HANDLE", "STATUS_VID_INVALID_MEMORY_BLOCK_HANDLE"},
+	0xC0370013: {0xC0370013, "STATUS_VID_PAGE_RANGE_OVERFLOW", "STATUS_VID_PAGE_RANGE_OVERFLOW"},
+	0xC0370014: {0xC0370014, "STATUS_VID_INVALID_MESSAGE_QUEUE_HANDLE", "STATUS_VID_INVALID_MESSAGE_QUEUE_HANDLE"},
+	0xC0370015: {0xC0370015, "STATUS_VID_INVALID_GPA_RANGE_HANDLE", "STATUS_VID_INVALID_GPA_RANGE_HANDLE"},
+	0xC0370016: {0xC0370016, "STATUS_VID_NO_MEMORY_BLOCK_NOTIFICATION_QUEUE", "STATUS_VID_NO_MEMORY_BLOCK_NOTIFICATION_QUEUE"},
+	0xC0370017: {0xC0370017, "STATUS_VID_MEMORY_BLOCK_LOCK_COUNT_EXCEEDED", "STATUS_VID_MEMORY_BLOCK_LOCK_COUNT_EXCEEDED"},
+	0xC0370018: {0xC0370018, "STATUS_VID_INVALID_PPM_HANDLE", "STATUS_VID_INVALID_PPM_HANDLE"},
+	0xC0370019: {0xC0370019, "STATUS_VID_MBPS_ARE_LOCKED", "STATUS_VID_MBPS_ARE_LOCKED"},
+	0xC037001A: {0xC037001A, "STATUS_VID_MESSAGE_QUEUE_CLOSED", "STATUS_VID_MESSAGE_QUEUE_CLOSED"},
+	0xC037001B: {0xC037001B, "STATUS_VID_VIRTUAL_PROCESSOR_LIMIT_EXCEEDED", "STATUS_VID_VIRTUAL_PROCESSOR_LIMIT_EXCEEDED"},
+	0xC037001C: {0xC037001C, "STATUS_VID_STOP_PENDING", "STATUS_VID_STOP_PENDING"},
+	0xC037001D: {0xC037001D, "STATUS_VID_INVALID_PROCESSOR_STATE", "STATUS_VID_INVALID_PROCESSOR_STATE"},
+	0xC037001E: {0xC037001E, "STATUS_VID_EXCEEDED_KM_CONTEXT_COUNT_LIMIT", "STATUS_VID_EXCEEDED_KM_CONTEXT_COUNT_LIMIT"},
+	0xC037001F: {0xC037001F, "STATUS_VID_KM_INTERFACE_ALREADY_INITIALIZED", "STATUS_VID_KM_INTERFACE_ALREADY_INITIALIZED"},
+	0xC0370020: {0xC0370020, "STATUS_VID_MB_PROPERTY_ALREADY_SET_RESET", "STATUS_VID_MB_PROPERTY_ALREADY_SET_RESET"},
+	0xC0370021: {0xC0370021, "STATUS_VID_MMIO_RANGE_DESTROYED", "STATUS_VID_MMIO_RANGE_DESTROYED"},
+	0xC0370022: {0xC0370022, "STATUS_VID_INVALID_CHILD_GPA_PAGE_SET", "STATUS_VID_INVALID_CHILD_GPA_PAGE_SET"},
+	0xC0370023: {0xC0370023, "STATUS_VID_RESERVE_PAGE_SET_IS_BEING_USED", "STATUS_VID_RESERVE_PAGE_SET_IS_BEING_USED"},
+	0xC0370024: {0xC0370024, "STATUS_VID_RESERVE_PAGE_SET_TOO_SMALL", "STATUS_VID_RESERVE_PAGE_SET_TOO_SMALL"},
+	0xC0370025: {0xC0370025, "STATUS_VID_MBP_ALREADY_LOCKED_USING_RESERVED_PAGE", "STATUS_VID_MBP_ALREADY_LOCKED_USING_RESERVED_PAGE"},
+	0xC0370026: {0xC0370026, "STATUS_VID_MBP_COUNT_EXCEEDED_LIMIT", "STATUS_VID_MBP_COUNT_EXCEEDED_LIMIT"},
+	0xC0370027: {0xC0370027, "STATUS_VID_SAVED_STATE_CORRUPT", "STATUS_VID_SAVED_STATE_CORRUPT"},
+	0xC0370028: {0xC0370028, "STATUS_VID_SAVED_STATE_UNRECOGNIZED_ITEM", "STATUS_VID_SAVED_STATE_UNRECOGNIZED_ITEM"},
+	0xC0370029: {0xC0370029, "STATUS_VID_SAVED_STATE_INCOMPATIBLE", "STATUS_VID_SAVED_STATE_INCOMPATIBLE"},
+	0xC037002A: {0xC037002A, "STATUS_VID_VTL_ACCESS_DENIED", "STATUS_VID_VTL_ACCESS_DENIED"},
+	0x80370001: {0x80370001, "STATUS_VID_REMOTE_NODE_PARENT_GPA_PAGES_USED", "STATUS_VID_REMOTE_NODE_PARENT_GPA_PAGES_USED"},
+	0xC0360001: {0xC0360001, "STATUS_IPSEC_BAD_SPI", "STATUS_IPSEC_BAD_SPI"},
+	0xC0360002: {0xC0360002, "STATUS_IPSEC_SA_LIFETIME_EXPIRED", "STATUS_IPSEC_SA_LIFETIME_EXPIRED"},
+	0xC0360003: {0xC0360003, "STATUS_IPSEC_WRONG_SA", "STATUS_IPSEC_WRONG_SA"},
+	0xC0360004: {0xC0360004, "STATUS_IPSEC_REPLAY_CHECK_FAILED", "STATUS_IPSEC_REPLAY_CHECK_FAILED"},
+	0xC0360005: {0xC0360005, "STATUS_IPSEC_INVALID_PACKET", "STATUS_IPSEC_INVALID_PACKET"},
+	0xC0360006: {0xC0360006, "STATUS_IPSEC_INTEGRITY_CHECK_FAILED", "STATUS_IPSEC_INTEGRITY_CHECK_FAILED"},
+	0xC0360007: {0xC0360007, "STATUS_IPSEC_CLEAR_TEXT_DROP", "STATUS_IPSEC_CLEAR_TEXT_DROP"},
+	0xC0360008: {0xC0360008, "STATUS_IPSEC_AUTH_FIREWALL_DROP", "STATUS_IPSEC_AUTH_FIREWALL_DROP"},
+	0xC0360009: {0xC0360009, "STATUS_IPSEC_THROTTLE_DROP", "STATUS_IPSEC_THROTTLE_DROP"},
+	0xC0368000: {0xC0368000, "STATUS_IPSEC_DOSP_BLOCK", "STATUS_IPSEC_DOSP_BLOCK"},
+	0xC0368001: {0xC0368001, "STATUS_IPSEC_DOSP_RECEIVED_MULTICAST", "STATUS_IPSEC_DOSP_RECEIVED_MULTICAST"},
+	0xC0368002: {0xC0368002, "STATUS_IPSEC_DOSP_INVALID_PACKET", "STATUS_IPSEC_DOSP_INVALID_PACKET"},
+	0xC0368003: {0xC0368003, "STATUS_IPSEC_DOSP_STATE_LOOKUP_FAILED", "STATUS_IPSEC_DOSP_STATE_LOOKUP_FAILED"},
+	0xC0368004: {0xC0368004, "STATUS_IPSEC_DOSP_MAX_ENTRIES", "STATUS_IPSEC_DOSP_MAX_ENTRIES"},
+	0xC0368005: {0xC0368005, "STATUS_IPSEC_DOSP_KEYMOD_NOT_ALLOWED", "STATUS_IPSEC_DOSP_KEYMOD_NOT_ALLOWED"},
+	0xC0368006: {0xC0368006, "STATUS_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES", "STATUS_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES"},
+	0x80380001: {0x80380001, "STATUS_VOLMGR_INCOMPLETE_REGENERATION", "STATUS_VOLMGR_INCOMPLETE_REGENERATION"},
+	0x80380002: {0x80380002, "STATUS_VOLMGR_INCOMPLETE_DISK_MIGRATION", "STATUS_VOLMGR_INCOMPLETE_DISK_MIGRATION"},
+	0xC0380001: {0xC0380001, "STATUS_VOLMGR_DATABASE_FULL", "STATUS_VOLMGR_DATABASE_FULL"},
+	0xC0380002: {0xC0380002, "STATUS_VOLMGR_DISK_CONFIGURATION_CORRUPTED", "STATUS_VOLMGR_DISK_CONFIGURATION_CORRUPTED"},
+	0xC0380003: {0xC0380003, "STATUS_VOLMGR_DISK_CONFIGURATION_NOT_IN_SYNC", "STATUS_VOLMGR_DISK_CONFIGURATION_NOT_IN_SYNC"},
+	0xC0380004: {0xC0380004, "STATUS_VOLMGR_PACK_CONFIG_UPDATE_FAILED", "STATUS_VOLMGR_PACK_CONFIG_UPDATE_FAILED"},
+	0xC0380005: {0xC0380005, "STATUS_VOLMGR_DISK_CONTAINS_NON_SIMPLE_VOLUME", "STATUS_VOLMGR_DISK_CONTAINS_NON_SIMPLE_VOLUME"},
+	0xC0380006: {0xC0380006, "STATUS_VOLMGR_DISK_DUPLICATE", "STATUS_VOLMGR_DISK_DUPLICATE"},
+	0xC0380007: {0xC0380007, "STATUS_VOLMGR_DISK_DYNAMIC", "STATUS_VOLMGR_DISK_DYNAMIC"},
+	0xC0380008: {0xC0380008, "STATUS_VOLMGR_DISK_ID_INVALID", "STATUS_VOLMGR_DISK_ID_INVALID"},
+	0xC0380009: {0xC0380009, "STATUS_VOLMGR_DISK_INVALID", "STATUS_VOLMGR_DISK_INVALID"},
+	0xC038000A: {0xC038000A, "STATUS_VOLMGR_DISK_LAST_VOTER", "STATUS_VOLMGR_DISK_LAST_VOTER"},
+	0xC038000B: {0xC038000B, "STATUS_VOLMGR_DISK_LAYOUT_INVALID", "STATUS_VOLMGR_DISK_LAYOUT_INVALID"},
+	0xC038000C: {0xC038000C, "STATUS_VOLMGR_DISK_LAYOUT_NON_BASIC_BETWEEN_BASIC_PARTITIONS", "STATUS_VOLMGR_DISK_LAYOUT_NON_BASIC_BETWEEN_BASIC_PARTITIONS"},
+	0xC038000D: {0xC038000D, "STATUS_VOLMGR_DISK_LAYOUT_NOT_CYLINDER_ALIGNED", "STATUS_VOLMGR_DISK_LAYOUT_NOT_CYLINDER_ALIGNED"},
+	0xC038000E: {0xC038000E, "STATUS_VOLMGR_DISK_LAYOUT_PARTITIONS_TOO_SMALL", "STATUS_VOLMGR_DISK_LAYOUT_PARTITIONS_TOO_SMALL"},
+	0xC038000F: {0xC038000F, "STATUS_VOLMGR_DISK_LAYOUT_PRIMARY_BETWEEN_LOGICAL_PARTITIONS", "STATUS_VOLMGR_DISK_LAYOUT_PRIMARY_BETWEEN_LOGICAL_PARTITIONS"},
+	0xC0380010: {0xC0380010, "STATUS_VOLMGR_DISK_LAYOUT_TOO_MANY_PARTITIONS", "STATUS_VOLMGR_DISK_LAYOUT_TOO_MANY_PARTITIONS"},
+	0xC0380011: {0xC0380011, "STATUS_VOLMGR_DISK_MISSING", "STATUS_VOLMGR_DISK_MISSING"},
+	0xC0380012: {0xC0380012, "STATUS_VOLMGR_DISK_NOT_EMPTY", "STATUS_VOLMGR_DISK_NOT_EMPTY"},
+	0xC0380013: {0xC0380013, "STATUS_VOLMGR_DISK_NOT_ENOUGH_SPACE", "STATUS_VOLMGR_DISK_NOT_ENOUGH_SPACE"},
+	0xC0380014: {0xC0380014, "STATUS_VOLMGR_DISK_REVECTORING_FAILED", "STATUS_VOLMGR_DISK_REVECTORING_FAILED"},
+	0xC0380015: {0xC0380015, "STATUS_VOLMGR_DISK_SECTOR_SIZE_INVALID", "STATUS_VOLMGR_DISK_SECTOR_SIZE_INVALID"},
+	0xC0380016: {0xC0380016, "STATUS_VOLMGR_DISK_SET_NOT_CONTAINED", "STATUS_VOLMGR_DISK_SET_NOT_CONTAINED"},
+	0xC0380017: {0xC0380017, "STATUS_VOLMGR_DISK_USED_BY_MULTIPLE_MEMBERS", "STATUS_VOLMGR_DISK_USED_BY_MULTIPLE_MEMBERS"},
+	0xC0380018: {0xC0380018, "STATUS_VOLMGR_DISK_USED_BY_MULTIPLE_PLEXES", "STATUS_VOLMGR_DISK_USED_BY_MULTIPLE_PLEXES"},
+	0xC0380019: {0xC0380019, "STATUS_VOLMGR_DYNAMIC_DISK_NOT_SUPPORTED", "STATUS_VOLMGR_DYNAMIC_DISK_NOT_SUPPORTED"},
+	0xC038001A: {0xC038001A, "STATUS_VOLMGR_EXTENT_ALREADY_USED", "STATUS_VOLMGR_EXTENT_ALREADY_USED"},
+	0xC038001B: {0xC038001B, "STATUS_VOLMGR_EXTENT_NOT_CONTIGUOUS", "STATUS_VOLMGR_EXTENT_NOT_CONTIGUOUS"},
+	0xC038001C: {0xC038001C, "STATUS_VOLMGR_EXTENT_NOT_IN_PUBLIC_REGION", "STATUS_VOLMGR_EXTENT_NOT_IN_PUBLIC_REGION"},
+	0xC038001D: {0xC038001D, "STATUS_VOLMGR_EXTENT_NOT_SECTOR_ALIGNED", "STATUS_VOLMGR_EXTENT_NOT_SECTOR_ALIGNED"},
+	0xC038001E: {0xC038001E, "STATUS_VOLMGR_EXTENT_OVERLAPS_EBR_PARTITION", "STATUS_VOLMGR_EXTENT_OVERLAPS_EBR_PARTITION"},
+	0xC038001F: {0xC038001F, "STATUS_VOLMGR_EXTENT_VOLUME_LENGTHS_DO_NOT_MATCH", "STATUS_VOLMGR_EXTENT_VOLUME_LENGTHS_DO_NOT_MATCH"},
+	0xC0380020: {0xC0380020, "STATUS_VOLMGR_FAULT_TOLERANT_NOT_SUPPORTED", "STATUS_VOLMGR_FAULT_TOLERANT_NOT_SUPPORTED"},
+	0xC0380021: {0xC0380021, "STATUS_VOLMGR_INTERLEAVE_LENGTH_INVALID", "STATUS_VOLMGR_INTERLEAVE_LENGTH_INVALID"},
+	0xC0380022: {0xC0380022, "STATUS_VOLMGR_MAXIMUM_REGISTERED_USERS", "STATUS_VOLMGR_MAXIMUM_REGISTERED_USERS"},
+	0xC0380023: {0xC0380023, "STATUS_VOLMGR_MEMBER_IN_SYNC", "STATUS_VOLMGR_MEMBER_IN_SYNC"},
+	0xC0380024: {0xC0380024, "STATUS_VOLMGR_MEMBER_INDEX_DUPLICATE", "STATUS_VOLMGR_MEMBER_INDEX_DUPLICATE"},
+	0xC0380025: {0xC0380025, "STATUS_VOLMGR_MEMBER_INDEX_INVALID", "STATUS_VOLMGR_MEMBER_INDEX_INVALID"},
+	0xC0380026: {0xC0380026, "STATUS_VOLMGR_MEMBER_MISSING", "STATUS_VOLMGR_MEMBER_MISSING"},
+	0xC0380027: {0xC0380027, "STATUS_VOLMGR_MEMBER_NOT_DETACHED", "STATUS_VOLMGR_MEMBER_NOT_DETACHED"},
+	0xC0380028: {0xC0380028, "STATUS_VOLMGR_MEMBER_REGENERATING", "STATUS_VOLMGR_MEMBER_REGENERATING"},
+	0xC0380029: {0xC0380029, "STATUS_VOLMGR_ALL_DISKS_FAILED", "STATUS_VOLMGR_ALL_DISKS_FAILED"},
+	0xC038002A: {0xC038002A, "STATUS_VOLMGR_NO_REGISTERED_USERS", "STATUS_VOLMGR_NO_REGISTERED_USERS"},
+	0xC038002B: {0xC038002B, "STATUS_VOLMGR_NO_SUCH_USER", "STATUS_VOLMGR_NO_SUCH_USER"},
+	0xC038002C: {0xC038002C, "STATUS_VOLMGR_NOTIFICATION_RESET", "STATUS_VOLMGR_NOTIFICATION_RESET"},
+	0xC038002D: {0xC038002D, "STATUS_VOLMGR_NUMBER_OF_MEMBERS_INVALID", "STATUS_VOLMGR_NUMBER_OF_MEMBERS_INVALID"},
+	0xC038002E: {0xC038002E, "STATUS_VOLMGR_NUMBER_OF_PLEXES_INVALID", "STATUS_VOLMGR_NUMBER_OF_PLEXES_INVALID"},
+	0xC038002F: {0xC038002F, "STATUS_VOLMGR_PACK_DUPLICATE", "STATUS_VOLMGR_PACK_DUPLICATE"},
+	0xC0380030: {0xC0380030, "STATUS_VOLMGR_PACK_ID_INVALID", "STATUS_VOLMGR_PACK_ID_INVALID"},
+	0xC0380031: {0xC0380031, "STATUS_VOLMGR_PACK_INVALID", "STATUS_VOLMGR_PACK_INVALID"},
+	0xC0380032: {0xC0380032, "STATUS_VOLMGR_PACK_NAME_INVALID", "STATUS_VOLMGR_PACK_NAME_INVALID"},
+	0xC0380033: {0xC0380033, "STATUS_VOLMGR_PACK_OFFLINE", "STATUS_VOLMGR_PACK_OFFLINE"},
+	0xC0380034: {0xC0380034, "STATUS_VOLMGR_PACK_HAS_QUORUM", "STATUS_VOLMGR_PACK_HAS_QUORUM"},
+	0xC0380035: {0xC0380035, "STATUS_VOLMGR_PACK_WITHOUT_QUORUM", "STATUS_VOLMGR_PACK_WITHOUT_QUORUM"},
+	0xC0380036: {0xC0380036, "STATUS_VOLMGR_PARTITION_STYLE_INVALID", "STATUS_VOLMGR_PARTITION_STYLE_INVALID"},
+	0xC0380037: {0xC0380037, "STATUS_VOLMGR_PARTITION_UPDATE_FAILED", "STATUS_VOLMGR_PARTITION_UPDATE_FAILED"},
+	0xC0380038: {0xC0380038, "STATUS_VOLMGR_PLEX_IN_SYNC", "STATUS_VOLMGR_PLEX_IN_SYNC"},
+	0xC0380039: {0xC0380039, "STATUS_VOLMGR_PLEX_INDEX_DUPLICATE", "STATUS_VOLMGR_PLEX_INDEX_DUPLICATE"},
+	0xC038003A: {0xC038003A, "STATUS_VOLMGR_PLEX_INDEX_INVALID", "STATUS_VOLMGR_PLEX_INDEX_INVALID"},
+	0xC038003B: {0xC038003B, "STATUS_VOLMGR_PLEX_LAST_ACTIVE", "STATUS_VOLMGR_PLEX_LAST_ACTIVE"},
+	0xC038003C: {0xC038003C, "STATUS_VOLMGR_PLEX_MISSING", "STATUS_VOLMGR_PLEX_MISSING"},
+	0xC038003D: {0xC038003D, "STATUS_VOLMGR_PLEX_REGENERATING", "STATUS_VOLMGR_PLEX_REGENERATING"},
+	0xC038003E: {0xC038003E, "STATUS_VOLMGR_PLEX_TYPE_INVALID", "STATUS_VOLMGR_PLEX_TYPE_INVALID"},
+	0xC038003F: {0xC038003F, "STATUS_VOLMGR_PLEX_NOT_RAID5", "STATUS_VOLMGR_PLEX_NOT_RAID5"},
+	0xC0380040: {0xC0380040, "STATUS_VOLMGR_PLEX_NOT_SIMPLE", "STATUS_VOLMGR_PLEX_NOT_SIMPLE"},
+	0xC0380041: {0xC0380041, "STATUS_VOLMGR_STRUCTURE_SIZE_INVALID", "STATUS_VOLMGR_STRUCTURE_SIZE_INVALID"},
+	0xC0380042: {0xC0380042, "STATUS_VOLMGR_TOO_MANY_NOTIFICATION_REQUESTS", "STATUS_VOLMGR_TOO_MANY_NOTIFICATION_REQUESTS"},
+	0xC0380043: {0xC0380043, "STATUS_VOLMGR_TRANSACTION_IN_PROGRESS", "STATUS_VOLMGR_TRANSACTION_IN_PROGRESS"},
+	0xC0380044: {0xC0380044, "STATUS_VOLMGR_UNEXPECTED_DISK_LAYOUT_CHANGE", "STATUS_VOLMGR_UNEXPECTED_DISK_LAYOUT_CHANGE"},
+	0xC0380045: {0xC0380045, "STATUS_VOLMGR_VOLUME_CONTAINS_MISSING_DISK", "STATUS_VOLMGR_VOLUME_CONTAINS_MISSING_DISK"},
+	0xC0380046: {0xC0380046, "STATUS_VOLMGR_VOLUME_ID_INVALID", "STATUS_VOLMGR_VOLUME_ID_INVALID"},
+	0xC0380047: {0xC0380047, "STATUS_VOLMGR_VOLUME_LENGTH_INVALID", "STATUS_VOLMGR_VOLUME_LENGTH_INVALID"},
+	0xC0380048: {0xC0380048, "STATUS_VOLMGR_VOLUME_LENGTH_NOT_SECTOR_SIZE_MULTIPLE", "STATUS_VOLMGR_VOLUME_LENGTH_NOT_SECTOR_SIZE_MULTIPLE"},
+	0xC0380049: {0xC0380049, "STATUS_VOLMGR_VOLUME_NOT_MIRRORED", "STATUS_VOLMGR_VOLUME_NOT_MIRRORED"},
+	0xC038004A: {0xC038004A, "STATUS_VOLMGR_VOLUME_NOT_RETAINED", "STATUS_VOLMGR_VOLUME_NOT_RETAINED"},
+	0xC038004B: {0xC038004B, "STATUS_VOLMGR_VOLUME_OFFLINE", "STATUS_VOLMGR_VOLUME_OFFLINE"},
+	0xC038004C: {0xC038004C, "STATUS_VOLMGR_VOLUME_RETAINED", "STATUS_VOLMGR_VOLUME_RETAINED"},
+	0xC038004D: {0xC038004D, "STATUS_VOLMGR_NUMBER_OF_EXTENTS_INVALID", "STATUS_VOLMGR_NUMBER_OF_EXTENTS_INVALID"},
+	0xC038004E: {0xC038004E, "STATUS_VOLMGR_DIFFERENT_SECTOR_SIZE", "STATUS_VOLMGR_DIFFERENT_SECTOR_SIZE"},
+	0xC038004F: {0xC038004F, "STATUS_VOLMGR_BAD_BOOT_DISK", "STATUS_VOLMGR_BAD_BOOT_DISK"},
+	0xC0380050: {0xC0380050, "STATUS_VOLMGR_PACK_CONFIG_OFFLINE", "STATUS_VOLMGR_PACK_CONFIG_OFFLINE"},
+	0xC0380051: {0xC0380051, "STATUS_VOLMGR_PACK_CONFIG_ONLINE", "STATUS_VOLMGR_PACK_CONFIG_ONLINE"},
+	0xC0380052: {0xC0380052, "STATUS_VOLMGR_NOT_PRIMARY_PACK", "STATUS_VOLMGR_NOT_PRIMARY_PACK"},
+	0xC0380053: {0xC0380053, "STATUS_VOLMGR_PACK_LOG_UPDATE_FAILED", "STATUS_VOLMGR_PACK_LOG_UPDATE_FAILED"},
+	0xC0380054: {0xC0380054, "STATUS_VOLMGR_NUMBER_OF_DISKS_IN_PLEX_INVALID", "STATUS_VOLMGR_NUMBER_OF_DISKS_IN_PLEX_INVALID"},
+	0xC0380055: {0xC0380055, "STATUS_VOLMGR_NUMBER_OF_DISKS_IN_MEMBER_INVALID", "STATUS_VOLMGR_NUMBER_OF_DISKS_IN_MEMBER_INVALID"},
+	0xC0380056: {0xC0380056, "STATUS_VOLMGR_VOLUME_MIRRORED", "STATUS_VOLMGR_VOLUME_MIRRORED"},
+	0xC0380057: {0xC0380057, "STATUS_VOLMGR_PLEX_NOT_SIMPLE_SPANNED", "STATUS_VOLMGR_PLEX_NOT_SIMPLE_SPANNED"},
+	0xC0380058: {0xC0380058, "STATUS_VOLMGR_NO_VALID_LOG_COPIES", "STATUS_VOLMGR_NO_VALID_LOG_COPIES"},
+	0xC0380059: {0xC0380059, "STATUS_VOLMGR_PRIMARY_PACK_PRESENT", "STATUS_VOLMGR_PRIMARY_PACK_PRESENT"},
+	0xC038005A: {0xC038005A, "STATUS_VOLMGR_NUMBER_OF_DISKS_INVALID", "STATUS_VOLMGR_NUMBER_OF_DISKS_INVALID"},
+	0xC038005B: {0xC038005B, "STATUS_VOLMGR_MIRROR_NOT_SUPPORTED", "STATUS_VOLMGR_MIRROR_NOT_SUPPORTED"},
+	0xC038005C: {0xC038005C, "STATUS_VOLMGR_RAID5_NOT_SUPPORTED", "STATUS_VOLMGR_RAID5_NOT_SUPPORTED"},
+	0x80390001: {0x80390001, "STATUS_BCD_NOT_ALL_ENTRIES_IMPORTED", "STATUS_BCD_NOT_ALL_ENTRIES_IMPORTED"},
+	0xC0390002: {0xC0390002, "STATUS_BCD_TOO_MANY_ELEMENTS", "STATUS_BCD_TOO_MANY_ELEMENTS"},
+	0x80390003: {0x80390003, "STATUS_BCD_NOT_ALL_ENTRIES_SYNCHRONIZED", "STATUS_BCD_NOT_ALL_ENTRIES_SYNCHRONIZED"},
+	0xC03A0001: {0xC03A0001, "STATUS_VHD_DRIVE_FOOTER_MISSING", "STATUS_VHD_DRIVE_FOOTER_MISSING"},
+	0xC03A0002: {0xC03A0002, "STATUS_VHD_DRIVE_FOOTER_CHECKSUM_MISMATCH", "STATUS_VHD_DRIVE_FOOTER_CHECKSUM_MISMATCH"},
+	0xC03A0003: {0xC03A0003, "STATUS_VHD_DRIVE_FOOTER_CORRUPT", "STATUS_VHD_DRIVE_FOOTER_CORRUPT"},
+	0xC03A0004: {0xC03A0004, "STATUS_VHD_FORMAT_UNKNOWN", "STATUS_VHD_FORMAT_UNKNOWN"},
+	0xC03A0005: {0xC03A0005, "STATUS_VHD_FORMAT_UNSUPPORTED_VERSION", "STATUS_VHD_FORMAT_UNSUPPORTED_VERSION"},
+	0xC03A0006: {0xC03A0006, "STATUS_VHD_SPARSE_HEADER_CHECKSUM_MISMATCH", "STATUS_VHD_SPARSE_HEADER_CHECKSUM_MISMATCH"},
+	0xC03A0007: {0xC03A0007, "STATUS_VHD_SPARSE_HEADER_UNSUPPORTED_VERSION", "STATUS_VHD_SPARSE_HEADER_UNSUPPORTED_VERSION"},
+	0xC03A0008: {0xC03A0008, "STATUS_VHD_SPARSE_HEADER_CORRUPT", "STATUS_VHD_SPARSE_HEADER_CORRUPT"},
+	0xC03A0009: {0xC03A0009, "STATUS_VHD_BLOCK_ALLOCATION_FAILURE", "STATUS_VHD_BLOCK_ALLOCATION_FAILURE"},
+	0xC03A000A: {0xC03A000A, "STATUS_VHD_BLOCK_ALLOCATION_TABLE_CORRUPT", "STATUS_VHD_BLOCK_ALLOCATION_TABLE_CORRUPT"},
+	0xC03A000B: {0xC03A000B, "STATUS_VHD_INVALID_BLOCK_SIZE", "STATUS_VHD_INVALID_BLOCK_SIZE"},
+	0xC03A000C: {0xC03A000C, "STATUS_VHD_BITMAP_MISMATCH", "STATUS_VHD_BITMAP_MISMATCH"},
+	0xC03A000D: {0xC03A000D, "STATUS_VHD_PARENT_VHD_NOT_FOUND", "STATUS_VHD_PARENT_VHD_NOT_FOUND"},
+	0xC03A000E: {0xC03A000E, "STATUS_VHD_CHILD_PARENT_ID_MISMATCH", "STATUS_VHD_CHILD_PARENT_ID_MISMATCH"},
+	0xC03A000F: {0xC03A000F, "STATUS_VHD_CHILD_PARENT_TIMESTAMP_MISMATCH", "STATUS_VHD_CHILD_PARENT_TIMESTAMP_MISMATCH"},
+	0xC03A0010: {0xC03A0010, "STATUS_VHD_METADATA_READ_FAILURE", "STATUS_VHD_METADATA_READ_FAILURE"},
+	0xC03A0011: {0xC03A0011, "STATUS_VHD_METADATA_WRITE_FAILURE", "STATUS_VHD_METADATA_WRITE_FAILURE"},
+	0xC03A0012: {0xC03A0012, "STATUS_VHD_INVALID_SIZE", "STATUS_VHD_INVALID_SIZE"},
+	0xC03A0013: {0xC03A0013, "STATUS_VHD_INVALID_FILE_SIZE", "STATUS_VHD_INVALID_FILE_SIZE"},
+	0xC03A0014: {0xC03A0014, "STATUS_VIRTDISK_PROVIDER_NOT_FOUND", "STATUS_VIRTDISK_PROVIDER_NOT_FOUND"},
+	0xC03A0015: {0xC03A0015, "STATUS_VIRTDISK_NOT_VIRTUAL_DISK", "STATUS_VIRTDISK_NOT_VIRTUAL_DISK"},
+	0xC03A0016: {0xC03A0016, "STATUS_VHD_PARENT_VHD_ACCESS_DENIED", "STATUS_VHD_PARENT_VHD_ACCESS_DENIED"},
+	0xC03A0017: {0xC03A0017, "STATUS_VHD_CHILD_PARENT_SIZE_MISMATCH", "STATUS_VHD_CHILD_PARENT_SIZE_MISMATCH"},
+	0xC03A0018: {0xC03A0018, "STATUS_VHD_DIFFERENCING_CHAIN_CYCLE_DETECTED", "STATUS_VHD_DIFFERENCING_CHAIN_CYCLE_DETECTED"},
+	0xC03A0019: {0xC03A0019, "STATUS_VHD_DIFFERENCING_CHAIN_ERROR_IN_PARENT", "STATUS_VHD_DIFFERENCING_CHAIN_ERROR_IN_PARENT"},
+	0xC03A001A: {0xC03A001A, "STATUS_VIRTUAL_DISK_LIMITATION", "STATUS_VIRTUAL_DISK_LIMITATION"},
+	0xC03A001B: {0xC03A001B, "STATUS_VHD_INVALID_TYPE", "STATUS_VHD_INVALID_TYPE"},
+	0xC03A001C: {0xC03A001C, "STATUS_VHD_INVALID_STATE", "STATUS_VHD_INVALID_STATE"},
+	0xC03A001D: {0xC03A001D, "STATUS_VIRTDISK_UNSUPPORTED_DISK_SECTOR_SIZE", "STATUS_VIRTDISK_UNSUPPORTED_DISK_SECTOR_SIZE"},
+	0xC03A001E: {0xC03A001E, "STATUS_VIRTDISK_DISK_ALREADY_OWNED", "STATUS_VIRTDISK_DISK_ALREADY_OWNED"},
+	0xC03A001F: {0xC03A001F, "STATUS_VIRTDISK_DISK_ONLINE_AND_WRITABLE", "STATUS_VIRTDISK_DISK_ONLINE_AND_WRITABLE"},
+	0xC03A0020: {0xC03A0020, "STATUS_CTLOG_TRACKING_NOT_INITIALIZED", "STATUS_CTLOG_TRACKING_NOT_INITIALIZED"},
+	0xC03A0021: {0xC03A0021, "STATUS_CTLOG_LOGFILE_SIZE_EXCEEDED_MAXSIZE", "STATUS_CTLOG_LOGFILE_SIZE_EXCEEDED_MAXSIZE"},
+	0xC03A0022: {0xC03A0022, "STATUS_CTLOG_VHD_CHANGED_OFFLINE", "STATUS_CTLOG_VHD_CHANGED_OFFLINE"},
+	0xC03A0023: {0xC03A0023, "STATUS_CTLOG_INVALID_TRACKING_STATE", "STATUS_CTLOG_INVALID_TRACKING_STATE"},
+	0xC03A0024: {0xC03A0024, "STATUS_CTLOG_INCONSISTENT_TRACKING_FILE", "STATUS_CTLOG_INCONSISTENT_TRACKING_FILE"},
+	0xC03A0028: {0xC03A0028, "STATUS_VHD_METADATA_FULL", "STATUS_VHD_METADATA_FULL"},
+	0xC03A0029: {0xC03A0029, "STATUS_VHD_INVALID_CHANGE_TRACKING_ID", "STATUS_VHD_INVALID_CHANGE_TRACKING_ID"},
+	0xC03A002A: {0xC03A002A, "STATUS_VHD_CHANGE_TRACKING_DISABLED", "STATUS_VHD_CHANGE_TRACKING_DISABLED"},
+	0xC03A0030: {0xC03A0030, "STATUS_VHD_MISSING_CHANGE_TRACKING_INFORMATION", "STATUS_VHD_MISSING_CHANGE_TRACKING_INFORMATION"},
+	0xC03A0031: {0xC03A0031, "STATUS_VHD_RESIZE_WOULD_TRUNCATE_DATA", "STATUS_VHD_RESIZE_WOULD_TRUNCATE_DATA"},
+	0xC03A0032: {0xC03A0032, "STATUS_VHD_COULD_NOT_COMPUTE_MINIMUM_VIRTUAL_SIZE", "STATUS_VHD_COULD_NOT_COMPUTE_MINIMUM_VIRTUAL_SIZE"},
+	0xC03A0033: {0xC03A0033, "STATUS_VHD_ALREADY_AT_OR_BELOW_MINIMUM_VIRTUAL_SIZE", "STATUS_VHD_ALREADY_AT_OR_BELOW_MINIMUM_VIRTUAL_SIZE"},
+	0x803A0001: {0x803A0001, "STATUS_QUERY_STORAGE_ERROR", "STATUS_QUERY_STORAGE_ERROR"},
+	0x803F0001: {0x803F0001, "STATUS_GDI_HANDLE_LEAK", "STATUS_GDI_HANDLE_LEAK"},
+	0xC0400001: {0xC0400001, "STATUS_RKF_KEY_NOT_FOUND", "STATUS_RKF_KEY_NOT_FOUND"},
+	0xC0400002: {0xC0400002, "STATUS_RKF_DUPLICATE_KEY", "STATUS_RKF_DUPLICATE_KEY"},
+	0xC0400003: {0xC0400003, "STATUS_RKF_BLOB_FULL", "STATUS_RKF_BLOB_FULL"},
+	0xC0400004: {0xC0400004, "STATUS_RKF_STORE_FULL", "STATUS_RKF_STORE_FULL"},
+	0xC0400005: {0xC0400005, "STATUS_RKF_FILE_BLOCKED", "STATUS_RKF_FILE_BLOCKED"},
+	0xC0400006: {0xC0400006, "STATUS_RKF_ACTIVE_KEY", "STATUS_RKF_ACTIVE_KEY"},
+	0xC0410001: {0xC0410001, "STATUS_RDBSS_RESTART_OPERATION", "STATUS_RDBSS_RESTART_OPERATION"},
+	0xC0410002: {0xC0410002, "STATUS_RDBSS_CONTINUE_OPERATION", "STATUS_RDBSS_CONTINUE_OPERATION"},
+	0xC0410003: {0xC0410003, "STATUS_RDBSS_POST_OPERATION", "STATUS_RDBSS_POST_OPERATION"},
+	0xC0410004: {0xC0410004, "STATUS_RDBSS_RETRY_LOOKUP", "STATUS_RDBSS_RETRY_LOOKUP"},
+	0xC0420001: {0xC0420001, "STATUS_BTH_ATT_INVALID_HANDLE", "STATUS_BTH_ATT_INVALID_HANDLE"},
+	0xC0420002: {0xC0420002, "STATUS_BTH_ATT_READ_NOT_PERMITTED", "STATUS_BTH_ATT_READ_NOT_PERMITTED"},
+	0xC0420003: {0xC0420003, "STATUS_BTH_ATT_WRITE_NOT_PERMITTED", "STATUS_BTH_ATT_WRITE_NOT_PERMITTED"},
+	0xC0420004: {0xC0420004, "STATUS_BTH_ATT_INVALID_PDU", "STATUS_BTH_ATT_INVALID_PDU"},
+	0xC0420005: {0xC0420005, "STATUS_BTH_ATT_INSUFFICIENT_AUTHENTICATION", "STATUS_BTH_ATT_INSUFFICIENT_AUTHENTICATION"},
+	0xC0420006: {0xC0420006, "STATUS_BTH_ATT_REQUEST_NOT_SUPPORTED", "STATUS_BTH_ATT_REQUEST_NOT_SUPPORTED"},
+	0xC0420007: {0xC0420007, "STATUS_BTH_ATT_INVALID_OFFSET", "STATUS_BTH_ATT_INVALID_OFFSET"},
+	0xC0420008: {0xC0420008, "STATUS_BTH_ATT_INSUFFICIENT_AUTHORIZATION", "STATUS_BTH_ATT_INSUFFICIENT_AUTHORIZATION"},
+	0xC0420009: {0xC0420009, "STATUS_BTH_ATT_PREPARE_QUEUE_FULL", "STATUS_BTH_ATT_PREPARE_QUEUE_FULL"},
+	0xC042000A: {0xC042000A, "STATUS_BTH_ATT_ATTRIBUTE_NOT_FOUND", "STATUS_BTH_ATT_ATTRIBUTE_NOT_FOUND"},
+	0xC042000B: {0xC042000B, "STATUS_BTH_ATT_ATTRIBUTE_NOT_LONG", "STATUS_BTH_ATT_ATTRIBUTE_NOT_LONG"},
+	0xC042000C: {0xC042000C, "STATUS_BTH_ATT_INSUFFICIENT_ENCRYPTION_KEY_SIZE", "STATUS_BTH_ATT_INSUFFICIENT_ENCRYPTION_KEY_SIZE"},
+	0xC042000D: {0xC042000D, "STATUS_BTH_ATT_INVALID_ATTRIBUTE_VALUE_LENGTH", "STATUS_BTH_ATT_INVALID_ATTRIBUTE_VALUE_LENGTH"},
+	0xC042000E: {0xC042000E, "STATUS_BTH_ATT_UNLIKELY", "STATUS_BTH_ATT_UNLIKELY"},
+	0xC042000F: {0xC042000F, "STATUS_BTH_ATT_INSUFFICIENT_ENCRYPTION", "STATUS_BTH_ATT_INSUFFICIENT_ENCRYPTION"},
+	0xC0420010: {0xC0420010, "STATUS_BTH_ATT_UNSUPPORTED_GROUP_TYPE", "STATUS_BTH_ATT_UNSUPPORTED_GROUP_TYPE"},
+	0xC0420011: {0xC0420011, "STATUS_BTH_ATT_INSUFFICIENT_RESOURCES", "STATUS_BTH_ATT_INSUFFICIENT_RESOURCES"},
+	0xC0421000: {0xC0421000, "STATUS_BTH_ATT_UNKNOWN_ERROR", "STATUS_BTH_ATT_UNKNOWN_ERROR"},
+	0xC0430001: {0xC0430001, "STATUS_SECUREBOOT_ROLLBACK_DETECTED", "STATUS_SECUREBOOT_ROLLBACK_DETECTED"},
+	0xC0430002: {0xC0430002, "STATUS_SECUREBOOT_POLICY_VIOLATION", "STATUS_SECUREBOOT_POLICY_VIOLATION"},
+	0xC0430003: {0xC0430003, "STATUS_SECUREBOOT_INVALID_POLICY", "STATUS_SECUREBOOT_INVALID_POLICY"},
+	0xC0430004: {0xC0430004, "STATUS_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND", "STATUS_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND"},
+	0xC0430005: {0xC0430005, "STATUS_SECUREBOOT_POLICY_NOT_SIGNED", "STATUS_SECUREBOOT_POLICY_NOT_SIGNED"},
+	0x80430006: {0x80430006, "STATUS_SECUREBOOT_NOT_ENABLED", "STATUS_SECUREBOOT_NOT_ENABLED"},
+	0xC0430007: {0xC0430007, "STATUS_SECUREBOOT_FILE_REPLACED", "STATUS_SECUREBOOT_FILE_REPLACED"},
+	0xC0430008: {0xC0430008, "STATUS_SECUREBOOT_POLICY_NOT_AUTHORIZED", "STATUS_SECUREBOOT_POLICY_NOT_AUTHORIZED"},
+	0xC0430009: {0xC0430009, "STATUS_SECUREBOOT_POLICY_UNKNOWN", "STATUS_SECUREBOOT_POLICY_UNKNOWN"},
+	0xC043000A: {0xC043000A, "STATUS_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION", "STATUS_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION"},
+	0xC043000B: {0xC043000B, "STATUS_SECUREBOOT_PLATFORM_ID_MISMATCH", "STATUS_SECUREBOOT_PLATFORM_ID_MISMATCH"},
+	0xC043000C: {0xC043000C, "STATUS_SECUREBOOT_POLICY_ROLLBACK_DETECTED", "STATUS_SECUREBOOT_POLICY_ROLLBACK_DETECTED"},
+	0xC043000D: {0xC043000D, "STATUS_SECUREBOOT_POLICY_UPGRADE_MISMATCH", "STATUS_SECUREBOOT_POLICY_UPGRADE_MISMATCH"},
+	0xC043000E: {0xC043000E, "STATUS_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING", "STATUS_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING"},
+	0xC043000F: {0xC043000F, "STATUS_SECUREBOOT_NOT_BASE_POLICY", "STATUS_SECUREBOOT_NOT_BASE_POLICY"},
+	0xC0430010: {0xC0430010, "STATUS_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY", "STATUS_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY"},
+	0xC0EB0001: {0xC0EB0001, "STATUS_PLATFORM_MANIFEST_NOT_AUTHORIZED", "STATUS_PLATFORM_MANIFEST_NOT_AUTHORIZED"},
+	0xC0EB0002: {0xC0EB0002, "STATUS_PLATFORM_MANIFEST_INVALID", "STATUS_PLATFORM_MANIFEST_INVALID"},
+	0xC0EB0003: {0xC0EB0003, "STATUS_PLATFORM_MANIFEST_FILE_NOT_AUTHORIZED", "STATUS_PLATFORM_MANIFEST_FILE_NOT_AUTHORIZED"},
+	0xC0EB0004: {0xC0EB0004, "STATUS_PLATFORM_MANIFEST_CATALOG_NOT_AUTHORIZED", "STATUS_PLATFORM_MANIFEST_CATALOG_NOT_AUTHORIZED"},
+	0xC0EB0005: {0xC0EB0005, "STATUS_PLATFORM_MANIFEST_BINARY_ID_NOT_FOUND", "STATUS_PLATFORM_MANIFEST_BINARY_ID_NOT_FOUND"},
+	0xC0EB0006: {0xC0EB0006, "STATUS_PLATFORM_MANIFEST_NOT_ACTIVE", "STATUS_PLATFORM_MANIFEST_NOT_ACTIVE"},
+	0xC0EB0007: {0xC0EB0007, "STATUS_PLATFORM_MANIFEST_NOT_SIGNED", "STATUS_PLATFORM_MANIFEST_NOT_SIGNED"},
+	0xC0E90001: {0xC0E90001, "STATUS_SYSTEM_INTEGRITY_ROLLBACK_DETECTED", "STATUS_SYSTEM_INTEGRITY_ROLLBACK_DETECTED"},
+	0xC0E90002: {0xC0E90002, "STATUS_SYSTEM_INTEGRITY_POLICY_VIOLATION", "STATUS_SYSTEM_INTEGRITY_POLICY_VIOLATION"},
+	0xC0E90003: {0xC0E90003, "STATUS_SYSTEM_INTEGRITY_INVALID_POLICY", "STATUS_SYSTEM_INTEGRITY_INVALID_POLICY"},
+	0xC0E90004: {0xC0E90004, "STATUS_SYSTEM_INTEGRITY_POLICY_NOT_SIGNED", "STATUS_SYSTEM_INTEGRITY_POLICY_NOT_SIGNED"},
+	0xC0E90005: {0xC0E90005, "STATUS_SYSTEM_INTEGRITY_TOO_MANY_POLICIES", "STATUS_SYSTEM_INTEGRITY_TOO_MANY_POLICIES"},
+	0xC0E90006: {0xC0E90006, "STATUS_SYSTEM_INTEGRITY_SUPPLEMENTAL_POLICY_NOT_AUTHORIZED", "STATUS_SYSTEM_INTEGRITY_SUPPLEMENTAL_POLICY_NOT_AUTHORIZED"},
+	0xC0EA0001: {0xC0EA0001, "STATUS_NO_APPLICABLE_APP_LICENSES_FOUND", "STATUS_NO_APPLICABLE_APP_LICENSES_FOUND"},
+	0xC0EA0002: {0xC0EA0002, "STATUS_CLIP_LICENSE_NOT_FOUND", "STATUS_CLIP_LICENSE_NOT_FOUND"},
+	0xC0EA0003: {0xC0EA0003, "STATUS_CLIP_DEVICE_LICENSE_MISSING", "STATUS_CLIP_DEVICE_LICENSE_MISSING"},
+	0xC0EA0004: {0xC0EA0004, "STATUS_CLIP_LICENSE_INVALID_SIGNATURE", "STATUS_CLIP_LICENSE_INVALID_SIGNATURE"},
+	0xC0EA0005: {0xC0EA0005, "STATUS_CLIP_KEYHOLDER_LICENSE_MISSING_OR_INVALID", "STATUS_CLIP_KEYHOLDER_LICENSE_MISSING_OR_INVALID"},
+	0xC0EA0006: {0xC0EA0006, "STATUS_CLIP_LICENSE_EXPIRED", "STATUS_CLIP_LICENSE_EXPIRED"},
+	0xC0EA0007: {0xC0EA0007, "STATUS_CLIP_LICENSE_SIGNED_BY_UNKNOWN_SOURCE", "STATUS_CLIP_LICENSE_SIGNED_BY_UNKNOWN_SOURCE"},
+	0xC0EA0008: {0xC0EA0008, "STATUS_CLIP_LICENSE_NOT_SIGNED", "STATUS_CLIP_LICENSE_NOT_SIGNED"},
+	0xC0EA0009: {0xC0EA0009, "STATUS_CLIP_LICENSE_HARDWARE_ID_OUT_OF_TOLERANCE", "STATUS_CLIP_LICENSE_HARDWARE_ID_OUT_OF_TOLERANCE"},
+	0xC0EA000A: {0xC0EA000A, "STATUS_CLIP_LICENSE_DEVICE_ID_MISMATCH", "STATUS_CLIP_LICENSE_DEVICE_ID_MISMATCH"},
+	0xC0440001: {0xC0440001, "STATUS_AUDIO_ENGINE_NODE_NOT_FOUND", "STATUS_AUDIO_ENGINE_NODE_NOT_FOUND"},
+	0xC0440002: {0xC0440002, "STATUS_HDAUDIO_EMPTY_CONNECTION_LIST", "STATUS_HDAUDIO_EMPTY_CONNECTION_LIST"},
+	0xC0440003: {0xC0440003, "STATUS_HDAUDIO_CONNECTION_LIST_NOT_SUPPORTED", "STATUS_HDAUDIO_CONNECTION_LIST_NOT_SUPPORTED"},
+	0xC0440004: {0xC0440004, "STATUS_HDAUDIO_NO_LOGICAL_DEVICES_CREATED", "STATUS_HDAUDIO_NO_LOGICAL_DEVICES_CREATED"},
+	0xC0440005: {0xC0440005, "STATUS_HDAUDIO_NULL_LINKED_LIST_ENTRY", "STATUS_HDAUDIO_NULL_LINKED_LIST_ENTRY"},
+	0x00E70000: {0x00E70000, "STATUS_SPACES_REPAIRED", "STATUS_SPACES_REPAIRED"},
+	0x00E70001: {0x00E70001, "STATUS_SPACES_PAUSE", "STATUS_SPACES_PAUSE"},
+	0x00E70002: {0x00E70002, "STATUS_SPACES_COMPLETE", "STATUS_SPACES_COMPLETE"},
+	0x00E70003: {0x00E70003, "STATUS_SPACES_REDIRECT", "STATUS_SPACES_REDIRECT"},
+	0xC0E70001: {0xC0E70001, "STATUS_SPACES_FAULT_DOMAIN_TYPE_INVALID", "STATUS_SPACES_FAULT_DOMAIN_TYPE_INVALID"},
+	0xC0E70003: {0xC0E70003, "STATUS_SPACES_RESILIENCY_TYPE_INVALID", "STATUS_SPACES_RESILIENCY_TYPE_INVALID"},
+	0xC0E70004: {0xC0E70004, "STATUS_SPACES_DRIVE_SECTOR_SIZE_INVALID", "STATUS_SPACES_DRIVE_SECTOR_SIZE_INVALID"},
+	0xC0E70006: {0xC0E70006, "STATUS_SPACES_DRIVE_REDUNDANCY_INVALID", "STATUS_SPACES_DRIVE_REDUNDANCY_INVALID"},
+	0xC0E70007: {0xC0E70007, "STATUS_SPACES_NUMBER_OF_DATA_COPIES_INVALID", "STATUS_SPACES_NUMBER_OF_DATA_COPIES_INVALID"},
+	0xC0E70009: {0xC0E70009, "STATUS_SPACES_INTERLEAVE_LENGTH_INVALID", "STATUS_SPACES_INTERLEAVE_LENGTH_INVALID"},
+	0xC0E7000A: {0xC0E7000A, "STATUS_SPACES_NUMBER_OF_COLUMNS_INVALID", "STATUS_SPACES_NUMBER_OF_COLUMNS_INVALID"},
+	0xC0E7000B: {0xC0E7000B, "STATUS_SPACES_NOT_ENOUGH_DRIVES", "STATUS_SPACES_NOT_ENOUGH_DRIVES"},
+	0xC0E7000C: {0xC0E7000C, "STATUS_SPACES_EXTENDED_ERROR", "STATUS_SPACES_EXTENDED_ERROR"},
+	0xC0E7000D: {0xC0E7000D, "STATUS_SPACES_PROVISIONING_TYPE_INVALID", "STATUS_SPACES_PROVISIONING_TYPE_INVALID"},
+	0xC0E7000E: {0xC0E7000E, "STATUS_SPACES_ALLOCATION_SIZE_INVALID", "STATUS_SPACES_ALLOCATION_SIZE_INVALID"},
+	0xC0E7000F: {0xC0E7000F, "STATUS_SPACES_ENCLOSURE_AWARE_INVALID", "STATUS_SPACES_ENCLOSURE_AWARE_INVALID"},
+	0xC0E70010: {0xC0E70010, "STATUS_SPACES_WRITE_CACHE_SIZE_INVALID", "STATUS_SPACES_WRITE_CACHE_SIZE_INVALID"},
+	0xC0E70011: {0xC0E70011, "STATUS_SPACES_NUMBER_OF_GROUPS_INVALID", "STATUS_SPACES_NUMBER_OF_GROUPS_INVALID"},
+	0xC0E70012: {0xC0E70012, "STATUS_SPACES_DRIVE_OPERATIONAL_STATE_INVALID", "STATUS_SPACES_DRIVE_OPERATIONAL_STATE_INVALID"},
+	0xC0E70013: {0xC0E70013, "STATUS_SPACES_UPDATE_COLUMN_STATE", "STATUS_SPACES_UPDATE_COLUMN_STATE"},
+	0xC0E70014: {0xC0E70014, "STATUS_SPACES_MAP_REQUIRED", "STATUS_SPACES_MAP_REQUIRED"},
+	0xC0E70015: {0xC0E70015, "STATUS_SPACES_UNSUPPORTED_VERSION", "STATUS_SPACES_UNSUPPORTED_VERSION"},
+	0xC0E70016: {0xC0E70016, "STATUS_SPACES_CORRUPT_METADATA", "STATUS_SPACES_CORRUPT_METADATA"},
+	0xC0E70017: {0xC0E70017, "STATUS_SPACES_DRT_FULL", "STATUS_SPACES_DRT_FULL"},
+	0xC0E70018: {0xC0E70018, "STATUS_SPACES_INCONSISTENCY", "STATUS_SPACES_INCONSISTENCY"},
+	0xC0E70019: {0xC0E70019, "STATUS_SPACES_LOG_NOT_READY", "STATUS_SPACES_LOG_NOT_READY"},
+	0xC0E7001A: {0xC0E7001A, "STATUS_SPACES_NO_REDUNDANCY", "STATUS_SPACES_NO_REDUNDANCY"},
+	0xC0E7001B: {0xC0E7001B, "STATUS_SPACES_DRIVE_NOT_READY", "STATUS_SPACES_DRIVE_NOT_READY"},
+	0xC0E7001C: {0xC0E7001C, "STATUS_SPACES_DRIVE_SPLIT", "STATUS_SPACES_DRIVE_SPLIT"},
+	0xC0E7001D: {0xC0E7001D, "STATUS_SPACES_DRIVE_LOST_DATA", "STATUS_SPACES_DRIVE_LOST_DATA"},
+	0xC0E7001E: {0xC0E7001E, "STATUS_SPACES_ENTRY_INCOMPLETE", "STATUS_SPACES_ENTRY_INCOMPLETE"},
+	0xC0E7001F: {0xC0E7001F, "STATUS_SPACES_ENTRY_INVALID", "STATUS_SPACES_ENTRY_INVALID"},
+	0xC0E70020: {0xC0E70020, "STATUS_SPACES_MARK_DIRTY", "STATUS_SPACES_MARK_DIRTY"},
+	0xC0500003: {0xC0500003, "STATUS_VOLSNAP_BOOTFILE_NOT_VALID", "STATUS_VOLSNAP_BOOTFILE_NOT_VALID"},
+	0xC0500004: {0xC0500004, "STATUS_VOLSNAP_ACTIVATION_TIMEOUT", "STATUS_VOLSNAP_ACTIVATION_TIMEOUT"},
+	0xC0510001: {0xC0510001, "STATUS_IO_PREEMPTED", "STATUS_IO_PREEMPTED"},
+	0xC05C0000: {0xC05C0000, "STATUS_SVHDX_ERROR_STORED", "STATUS_SVHDX_ERROR_STORED"},
+	0xC05CFF00: {0xC05CFF00, "STATUS_SVHDX_ERROR_NOT_AVAILABLE", "STATUS_SVHDX_ERROR_NOT_AVAILABLE"},
+	0xC05CFF01: {0xC05CFF01, "STATUS_SVHDX_UNIT_ATTENTION_AVAILABLE", "STATUS_SVHDX_UNIT_ATTENTION_AVAILABLE"},
+	0xC05CFF02: {0xC05CFF02, "STATUS_SVHDX_UNIT_ATTENTION_CAPACITY_DATA_CHANGED", "STATUS_SVHDX_UNIT_ATTENTION_CAPACITY_DATA_CHANGED"},
+	0xC05CFF03: {0xC05CFF03, "STATUS_SVHDX_UNIT_ATTENTION_RESERVATIONS_PREEMPTED", "STATUS_SVHDX_UNIT_ATTENTION_RESERVATIONS_PREEMPTED"},
+	0xC05CFF04: {0xC05CFF04, "STATUS_SVHDX_UNIT_ATTENTION_RESERVATIONS_RELEASED", "STATUS_SVHDX_UNIT_ATTENTION_RESERVATIONS_RELEASED"},
+	0xC05CFF05: {0xC05CFF05, "STATUS_SVHDX_UNIT_ATTENTION_REGISTRATIONS_PREEMPTED", "STATUS_SVHDX_UNIT_ATTENTION_REGISTRATIONS_PREEMPTED"},
+	0xC05CFF06: {0xC05CFF06, "STATUS_SVHDX_UNIT_ATTENTION_OPERATING_DEFINITION_CHANGED", "STATUS_SVHDX_UNIT_ATTENTION_OPERATING_DEFINITION_CHANGED"},
+	0xC05CFF07: {0xC05CFF07, "STATUS_SVHDX_RESERVATION_CONFLICT", "STATUS_SVHDX_RESERVATION_CONFLICT"},
+	0xC05CFF08: {0xC05CFF08, "STATUS_SVHDX_WRONG_FILE_TYPE", "STATUS_SVHDX_WRONG_FILE_TYPE"},
+	0xC05CFF09: {0xC05CFF09, "STATUS_SVHDX_VERSION_MISMATCH", "STATUS_SVHDX_VERSION_MISMATCH"},
+	0xC05CFF0A: {0xC05CFF0A, "STATUS_VHD_SHARED", "STATUS_VHD_SHARED"},
+	0xC05CFF0B: {0xC05CFF0B, "STATUS_SVHDX_NO_INITIATOR", "STATUS_SVHDX_NO_INITIATOR"},
+	0xC05CFF0C: {0xC05CFF0C, "STATUS_VHDSET_BACKING_STORAGE_NOT_FOUND", "STATUS_VHDSET_BACKING_STORAGE_NOT_FOUND"},
+	0xC05D0000: {0xC05D0000, "STATUS_SMB_NO_PREAUTH_INTEGRITY_HASH_OVERLAP", "STATUS_SMB_NO_PREAUTH_INTEGRITY_HASH_OVERLAP"},
+	0xC05D0001: {0xC05D0001, "STATUS_SMB_BAD_CLUSTER_DIALECT", "STATUS_SMB_BAD_CLUSTER_DIALECT"},
+	0xC05D0002: {0xC05D0002, "STATUS_SMB_GUEST_LOGON_BLOCKED", "STATUS_SMB_GUEST_LOGON_BLOCKED"},
+	0xC0E80000: {0xC0E80000, "STATUS_SECCORE_INVALID_COMMAND", "STATUS_SECCORE_INVALID_COMMAND"},
+	0xC0450000: {0xC0450000, "STATUS_VSM_NOT_INITIALIZED", "STATUS_VSM_NOT_INITIALIZED"},
+	0xC0450001: {0xC0450001, "STATUS_VSM_DMA_PROTECTION_NOT_IN_USE", "STATUS_VSM_DMA_PROTECTION_NOT_IN_USE"},
+	0xC0EC0000: {0xC0EC0000, "STATUS_APPEXEC_CONDITION_NOT_SATISFIED", "STATUS_APPEXEC_CONDITION_NOT_SATISFIED"},
+	0xC0EC0001: {0xC0EC0001, "STATUS_APPEXEC_HANDLE_INVALIDATED", "STATUS_APPEXEC_HANDLE_INVALIDATED"},
+	0xC0EC0002: {0xC0EC0002, "STATUS_APPEXEC_INVALID_HOST_GENERATION", "STATUS_APPEXEC_INVALID_HOST_GENERATION"},
+	0xC0EC0003: {0xC0EC0003, "STATUS_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION", "STATUS_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION"},
+	0xC0EC0004: {0xC0EC0004, "STATUS_APPEXEC_INVALID_HOST_STATE", "STATUS_APPEXEC_INVALID_HOST_STATE"},
+	0xC0EC0005: {0xC0EC0005, "STATUS_APPEXEC_NO_DONOR", "STATUS_APPEXEC_NO_DONOR"},
+	0xC0EC0006: {0xC0EC0006, "STATUS_APPEXEC_HOST_ID_MISMATCH", "STATUS_APPEXEC_HOST_ID_MISMATCH"},
+	0xC0EC0007: {0xC0EC0007, "STATUS_APPEXEC_UNKNOWN_USER", "STATUS_APPEXEC_UNKNOWN_USER"},
+}
@@ -0,0 +1,642 @@
+package firmware
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// acpiHeaderSize is the size of the common ACPI description header
+const acpiHeaderSize = 36
+
+// ACPITableHeader is the system description header shared by all ACPI tables
+type ACPITableHeader struct {
+	Signature       string
+	Length          uint32
+	Revision        uint8
+	Checksum        uint8
+	OEMID           string
+	OEMTableID      string
+	OEMRevision     uint32
+	CreatorID       string
+	CreatorRevision uint32
+	ChecksumValid   bool // all Length bytes of the table sum to zero
+}
+
+// ACPITable is an ACPI table that has no specific parser
+type ACPITable struct {
+	Header ACPITableHeader
+	Data   []byte // the whole table, including the header
+}
+
+// GenericAddress is an ACPI Generic Address Structure (GAS)
+type GenericAddress struct {
+	AddressSpace uint8 // 0 system memory, 1 system I/O, 2 PCI configuration space, ...
+	BitWidth     uint8
+	BitOffset    uint8
+	AccessSize   uint8
+	Address      uint64
+}
+
+// ACPIChecksum returns the 8-bit sum of data. A table is valid when the sum
+// over its Length bytes is zero.
+func ACPIChecksum(data []byte) uint8 {
+	var sum uint8
+	for _, b := range data {
+		sum += b
+	}
+	return sum
+}
+
+// VerifyACPIChecksum checks that data holds a complete ACPI table whose
+// checksum is valid. It returns an NTStatusError with STATUS_ACPI_INVALID_TABLE
+// otherwise.
+func VerifyACPIChecksum(data []byte) error {
+	header, err := ParseACPITableHeader(data)
+	if err != nil {
+		return err
+	}
+	if !header.ChecksumValid {
+		return winx.NewNTStatusError(winx.STATUS_ACPI_INVALID_TABLE, fmt.Sprintf("%s checksum mismatch: bytes sum to 0x%02X", header.Signature, ACPIChecksum(data[:header.Length])))
+	}
+	return nil
+}
+
+// ParseACPITableHeader parses the description header at the start of data and
+// verifies the table checksum. The table may be followed by unrelated bytes;
+// only Length bytes are checked.
+func ParseACPITableHeader(data []byte) (ACPITableHeader, error) {
+	if len(data) < acpiHeaderSize {
+		return ACPITableHeader{}, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("ACPI header needs %d bytes, got %d", acpiHeaderSize, len(data)))
+	}
+	header := ACPITableHeader{
+		Signature:       string(data[0:4]),
+		Length:          binary.LittleEndian.Uint32(data[4:]),
+		Revision:        data[8],
+		Checksum:        data[9],
+		OEMID:           acpiString(data[10:16]),
+		OEMTableID:      acpiString(data[16:24]),
+		OEMRevision:     binary.LittleEndian.Uint32(data[24:]),
+		CreatorID:       acpiString(data[28:32]),
+		CreatorRevision: binary.LittleEndian.Uint32(data[32:]),
+	}
+	if header.Length < acpiHeaderSize || uint64(header.Length) > uint64(len(data)) {
+		return header, winx.NewNTStatusError(winx.STATUS_ACPI_INVALID_TABLE, fmt.Sprintf("%s length %d is invalid for %d bytes of data", header.Signature, header.Length, len(data)))
+	}
+	header.ChecksumValid = ACPIChecksum(data[:header.Length]) == 0
+	return header, nil
+}
+
+// acpiString trims the space and NUL padding of fixed-size ACPI ID fields
+func acpiString(b []byte) string {
+	return strings.TrimRight(string(b), " \x00")
+}
+
+// parseACPITable parses the header and checks that the table has the expected
+// signature and at least minLength bytes. It returns the table data trimmed
+// to Length.
+func parseACPITable(data []byte, signatures []string, minLength int) (ACPITableHeader, []byte, error) {
+	header, err := ParseACPITableHeader(data)
+	if err != nil {
+		return header, nil, err
+	}
+	matched := false
+	for _, signature := range signatures {
+		matched = matched || header.Signature == signature
+	}
+	if !matched {
+		return header, nil, winx.NewNTStatusError(winx.STATUS_ACPI_INVALID_TABLE, fmt.Sprintf("table signature %q, want %s", header.Signature, strings.Join(signatures, " or ")))
+	}
+	if int(header.Length) < minLength {
+		return header, nil, winx.NewNTStatusError(winx.STATUS_ACPI_INVALID_TABLE, fmt.Sprintf("%s table of %d bytes is shorter than %d", header.Signature, header.Length, minLength))
+	}
+	return header, data[:header.Length], nil
+}
+
+func parseGenericAddress(b []byte) GenericAddress {
+	return GenericAddress{
+		AddressSpace: b[0],
+		BitWidth:     b[1],
+		BitOffset:    b[2],
+		AccessSize:   b[3],
+		Address:      binary.LittleEndian.Uint64(b[4:]),
+	}
+}
+
+// RSDT is a Root or Extended System Description Table, listing the physical
+// addresses of the other tables
+type RSDT struct {
+	Header  ACPITableHeader
+	Entries []uint64
+}
+
+// ParseRSDT parses an RSDT (32-bit entries) or XSDT (64-bit entries)
+func ParseRSDT(data []byte) (*RSDT, error) {
+	header, data, err := parseACPITable(data, []string{"RSDT", "XSDT"}, acpiHeaderSize)
+	if err != nil {
+		return nil, err
+	}
+	entrySize := 4
+	if header.Signature == "XSDT" {
+		entrySize = 8
+	}
+
+	table := &RSDT{Header: header}
+	for offset := acpiHeaderSize; offset+entrySize <= len(data); offset += entrySize {
+		if entrySize == 4 {
+			table.Entries = append(table.Entries, uint64(binary.LittleEndian.Uint32(data[offset:])))
+		} else {
+			table.Entries = append(table.Entries, binary.LittleEndian.Uint64(data[offset:]))
+		}
+	}
+	return table, nil
+}
+
+// FADT flags
+const (
+	FADTFlagWBINVD          = 1 << 0
+	FADTFlagPowerButton     = 1 << 4
+	FADTFlagSleepButton     = 1 << 5
+	FADTFlagResetRegSupport = 1 << 10
+	FADTFlagHardwareReduced = 1 << 20
+	FADTFlagLowPowerS0Idle  = 1 << 21
+)
+
+// preferredPMProfileNames are the FADT Preferred_PM_Profile values
+var preferredPMProfileNames = [...]string{
+	"Unspecified",
+	"Desktop",
+	"Mobile",
+	"Workstation",
+	"Enterprise Server",
+	"SOHO Server",
+	"Appliance PC",
+	"Performance Server",
+	"Tablet",
+}
+
+// FADT is the Fixed ACPI Description Table (signature "FACP")
+type FADT struct {
+	Header             ACPITableHeader
+	FirmwareControl    uint64 // FACS address, X_FIRMWARE_CTRL when present
+	DSDT               uint64 // DSDT address, X_DSDT when present
+	PreferredPMProfile uint8
+	SCIInterrupt       uint16
+	SMICommand         uint32
+	PMTimerBlock       uint32
+	Century            uint8
+	IAPCBootArch       uint16
+	Flags              uint32
+	ResetRegister      GenericAddress
+	ResetValue         uint8
+	ARMBootArch        uint16
+	MinorVersion       uint8
+	HypervisorVendor   string // "Hypervisor Vendor Identity", ACPI 6.0 and later
+}
+
+// PreferredPMProfileName returns the name of the preferred power management
+// profile, e.g. "Mobile"
+func (fadt *FADT) PreferredPMProfileName() string {
+	if int(fadt.PreferredPMProfile) < len(preferredPMProfileNames) {
+		return preferredPMProfileNames[fadt.PreferredPMProfile]
+	}
+	return fmt.Sprintf("Reserved(%d)", fadt.PreferredPMProfile)
+}
+
+// HardwareReduced reports whether the platform implements the ACPI
+// hardware-reduced model
+func (fadt *FADT) HardwareReduced() bool {
+	return fadt.Flags&FADTFlagHardwareReduced != 0
+}
+
+// ParseFADT parses the Fixed ACPI Description Table. Fields added by later
+// ACPI revisions are zero when the table is too short to contain them.
+func ParseFADT(data []byte) (*FADT, error) {
+	header, data, err := parseACPITable(data, []string{"FACP"}, 116)
+	if err != nil {
+		return nil, err
+	}
+
+	u8 := func(offset int) uint8 {
+		if offset < len(data) {
+			return data[offset]
+		}
+		return 0
+	}
+	u16 := func(offset int) uint16 {
+		if offset+2 <= len(data) {
+			return binary.LittleEndian.Uint16(data[offset:])
+		}
+		return 0
+	}
+	u32 := func(offset int) uint32 {
+		if offset+4 <= len(data) {
+			return binary.LittleEndian.Uint32(data[offset:])
+		}
+		return 0
+	}
+	u64 := func(offset int) uint64 {
+		if offset+8 <= len(data) {
+			return binary.LittleEndian.Uint64(data[offset:])
+		}
+		return 0
+	}
+
+	fadt := &FADT{
+		Header:             header,
+		FirmwareControl:    uint64(u32(36)),
+		DSDT:               uint64(u32(40)),
+		PreferredPMProfile: u8(45),
+		SCIInterrupt:       u16(46),
+		SMICommand:         u32(48),
+		PMTimerBlock:       u32(76),
+		Century:            u8(108),
+		IAPCBootArch:       u16(109),
+		Flags:              u32(112),
+		ResetValue:         u8(128),
+		ARMBootArch:        u16(129),
+		MinorVersion:       u8(131),
+	}
+	if len(data) >= 128 {
+		fadt.ResetRegister = parseGenericAddress(data[116:128])
+	}
+	if x := u64(132); x != 0 {
+		fadt.FirmwareControl = x
+	}
+	if x := u64(140); x != 0 {
+		fadt.DSDT = x
+	}
+	if len(data) >= 276 {
+		fadt.HypervisorVendor = acpiString(data[268:276])
+	}
+	return fadt, nil
+}
+
+// MADT interrupt controller structure types
+const (
+	MADTTypeLocalAPIC             = 0
+	MADTTypeIOAPIC                = 1
+	MADTTypeInterruptOverride     = 2
+	MADTTypeLocalAPICNMI          = 4
+	MADTTypeLocalAPICAddrOverride = 5
+	MADTTypeLocalX2APIC           = 9
+)
+
+// MADTEntry is a raw interrupt controller structure
+type MADTEntry struct {
+	Type uint8
+	Data []byte // the whole structure, including the type and length bytes
+}
+
+// MADTLocalAPIC is a Processor Local APIC or Processor Local x2APIC structure
+type MADTLocalAPIC struct {
+	ProcessorUID  uint32
+	APICID        uint32
+	Enabled       bool
+	OnlineCapable bool
+	X2APIC        bool
+}
+
+// MADTIOAPIC is an I/O APIC structure
+type MADTIOAPIC struct {
+	ID      uint8
+	Address uint32
+	GSIBase uint32
+}
+
+// MADTInterruptOverride is an Interrupt Source Override structure
+type MADTInterruptOverride struct {
+	Bus    uint8
+	Source uint8
+	GSI    uint32
+	Flags  uint16
+}
+
+// MADT is the Multiple APIC Description Table (signature "APIC")
+type MADT struct {
+	Header             ACPITableHeader
+	LocalAPICAddress   uint64 // after any Local APIC Address Override
+	Flags              uint32
+	LocalAPICs         []MADTLocalAPIC
+	IOAPICs            []MADTIOAPIC
+	InterruptOverrides []MADTInterruptOverride
+	Entries            []MADTEntry
+}
+
+// PCATCompatible reports whether the system also has dual 8259 PICs
+func (madt *MADT) PCATCompatible() bool {
+	return madt.Flags&1 != 0
+}
+
+// ParseMADT parses the Multiple APIC Description Table
+func ParseMADT(data []byte) (*MADT, error) {
+	header, data, err := parseACPITable(data, []string{"APIC"}, 44)
+	if err != nil {
+		return nil, err
+	}
+
+	madt := &MADT{
+		Header:           header,
+		LocalAPICAddress: uint64(binary.LittleEndian.Uint32(data[36:])),
+		Flags:            binary.LittleEndian.Uint32(data[40:]),
+	}
+	for offset := 44; offset+2 <= len(data); {
+		entryType, length := data[offset], int(data[offset+1])
+		if length < 2 || offset+length > len(data) {
+			return nil, winx.NewNTStatusError(winx.STATUS_ACPI_INVALID_TABLE, fmt.Sprintf("MADT entry at offset %d has invalid length %d", offset, length))
+		}
+		e := data[offset : offset+length]
+		madt.Entries = append(madt.Entries, MADTEntry{Type: entryType, Data: e})
+
+		switch {
+		case entryType == MADTTypeLocalAPIC && length >= 8:
+			flags := binary.LittleEndian.Uint32(e[4:])
+			madt.LocalAPICs = append(madt.LocalAPICs, MADTLocalAPIC{
+				ProcessorUID:  uint32(e[2]),
+				APICID:        uint32(e[3]),
+				Enabled:       flags&1 != 0,
+				OnlineCapable: flags&2 != 0,
+			})
+		case entryType == MADTTypeLocalX2APIC && length >= 16:
+			flags := binary.LittleEndian.Uint32(e[8:])
+			madt.LocalAPICs = append(madt.LocalAPICs, MADTLocalAPIC{
+				ProcessorUID:  binary.LittleEndian.Uint32(e[12:]),
+				APICID:        binary.LittleEndian.Uint32(e[4:]),
+				Enabled:       flags&1 != 0,
+				OnlineCapable: flags&2 != 0,
+				X2APIC:        true,
+			})
+		case entryType == MADTTypeIOAPIC && length >= 12:
+			madt.IOAPICs = append(madt.IOAPICs, MADTIOAPIC{
+				ID:      e[2],
+				Address: binary.LittleEndian.Uint32(e[4:]),
+				GSIBase: binary.LittleEndian.Uint32(e[8:]),
+			})
+		case entryType == MADTTypeInterruptOverride && length >= 10:
+			madt.InterruptOverrides = append(madt.InterruptOverrides, MADTInterruptOverride{
+				Bus:    e[2],
+				Source: e[3],
+				GSI:    binary.LittleEndian.Uint32(e[4:]),
+				Flags:  binary.LittleEndian.Uint16(e[8:]),
+			})
+		case entryType == MADTTypeLocalAPICAddrOverride && length >= 12:
+			madt.LocalAPICAddress = binary.LittleEndian.Uint64(e[4:])
+		}
+		offset += length
+	}
+	return madt, nil
+}
+
+// MCFGAllocation describes the ECAM region of a PCI segment group
+type MCFGAllocation struct {
+	BaseAddress uint64
+	Segment     uint16
+	StartBus    uint8
+	EndBus      uint8
+}
+
+// MCFG is the PCI Express memory mapped configuration space table
+type MCFG struct {
+	Header      ACPITableHeader
+	Allocations []MCFGAllocation
+}
+
+// ParseMCFG parses the PCI Express memory mapped configuration table
+func ParseMCFG(data []byte) (*MCFG, error) {
+	header, data, err := parseACPITable(data, []string{"MCFG"}, 44)
+	if err != nil {
+		return nil, err
+	}
+
+	mcfg := &MCFG{Header: header}
+	for offset := 44; offset+16 <= len(data); offset += 16 {
+		mcfg.Allocations = append(mcfg.Allocations, MCFGAllocation{
+			BaseAddress: binary.LittleEndian.Uint64(data[offset:]),
+			Segment:     binary.LittleEndian.Uint16(data[offset+8:]),
+			StartBus:    data[offset+10],
+			EndBus:      data[offset+11],
+		})
+	}
+	return mcfg, nil
+}
+
+// HPET is the High Precision Event Timer table
+type HPET struct {
+	Header             ACPITableHeader
+	HardwareRevisionID uint8
+	Comparators        int
+	Counter64Bit       bool
+	LegacyReplacement  bool
+	VendorID           uint16
+	Address            GenericAddress
+	Number             uint8
+	MinimumTick        uint16
+	PageProtection     uint8
+}
+
+// ParseHPET parses the High Precision Event Timer table
+func ParseHPET(data []byte) (*HPET, error) {
+	header, data, err := parseACPITable(data, []string{"HPET"}, 56)
+	if err != nil {
+		return nil, err
+	}
+
+	blockID := binary.LittleEndian.Uint32(data[36:])
+	return &HPET{
+		Header:             header,
+		HardwareRevisionID: uint8(blockID),
+		Comparators:        int(blockID>>8&0x1F) + 1,
+		Counter64Bit:       blockID&(1<<13) != 0,
+		LegacyReplacement:  blockID&(1<<15) != 0,
+		VendorID:           uint16(blockID >> 16),
+		Address:            parseGenericAddress(data[40:52]),
+		Number:             data[52],
+		MinimumTick:        binary.LittleEndian.Uint16(data[53:]),
+		PageProtection:     data[55],
+	}, nil
+}
+
+// DMAR remapping structure types
+const (
+	DMARTypeDRHD = 0 // DMA remapping hardware unit definition
+	DMARTypeRMRR = 1 // reserved memory region reporting
+	DMARTypeATSR = 2 // root port ATS capability reporting
+	DMARTypeRHSA = 3 // remapping hardware static affinity
+	DMARTypeANDD = 4 // ACPI name-space device declaration
+	DMARTypeSATC = 5 // SoC integrated address translation cache
+)
+
+// DMARStructure is a raw remapping structure
+type DMARStructure struct {
+	Type uint16
+	Data []byte // the whole structure, including the type and length fields
+}
+
+// DMARDeviceScope is a device scope entry of a DRHD or RMRR structure
+type DMARDeviceScope struct {
+	Type          uint8 // 1 PCI endpoint, 2 PCI sub-hierarchy, 3 IOAPIC, 4 HPET, 5 ACPI namespace device
+	EnumerationID uint8
+	StartBus      uint8
+	Path          []DMARPathEntry
+}
+
+// DMARPathEntry is a device and function number on the path to a device
+type DMARPathEntry struct {
+	Device   uint8
+	Function uint8
+}
+
+// DMARHardwareUnit is a DMA Remapping Hardware Unit Definition (DRHD)
+type DMARHardwareUnit struct {
+	Flags         uint8
+	Segment       uint16
+	RegisterBase  uint64
+	IncludePCIAll bool
+	Scopes        []DMARDeviceScope
+}
+
+// DMARReservedMemory is a Reserved Memory Region Reporting structure (RMRR)
+type DMARReservedMemory struct {
+	Segment uint16
+	Base    uint64
+	Limit   uint64 // last byte of the region
+	Scopes  []DMARDeviceScope
+}
+
+// DMAR is the DMA Remapping table describing Intel VT-d hardware
+type DMAR struct {
+	Header           ACPITableHeader
+	HostAddressWidth int // DMA physical addressing width in bits
+	Flags            uint8
+	HardwareUnits    []DMARHardwareUnit
+	ReservedMemory   []DMARReservedMemory
+	Structures       []DMARStructure
+}
+
+// InterruptRemapping reports whether interrupt remapping is supported
+func (dmar *DMAR) InterruptRemapping() bool {
+	return dmar.Flags&1 != 0
+}
+
+// ParseDMAR parses the DMA Remapping table
+func ParseDMAR(data []byte) (*DMAR, error) {
+	header, data, err := parseACPITable(data, []string{"DMAR"}, 48)
+	if err != nil {
+		return nil, err
+	}
+
+	dmar := &DMAR{
+		Header:           header,
+		HostAddressWidth: int(data[36]) + 1,
+		Flags:            data[37],
+	}
+	for offset := 48; offset+4 <= len(data); {
+		structureType := binary.LittleEndian.Uint16(data[offset:])
+		length := int(binary.LittleEndian.Uint16(data[offset+2:]))
+		if length < 4 || offset+length > len(data) {
+			return nil, winx.NewNTStatusError(winx.STATUS_ACPI_INVALID_TABLE, fmt.Sprintf("DMAR structure at offset %d has invalid length %d", offset, length))
+		}
+		s := data[offset : offset+length]
+		dmar.Structures = append(dmar.Structures, DMARStructure{Type: structureType, Data: s})
+
+		switch {
+		case structureType == DMARTypeDRHD && length >= 16:
+			dmar.HardwareUnits = append(dmar.HardwareUnits, DMARHardwareUnit{
+				Flags:         s[4],
+				Segment:       binary.LittleEndian.Uint16(s[6:]),
+				RegisterBase:  binary.LittleEndian.Uint64(s[8:]),
+				IncludePCIAll: s[4]&1 != 0,
+				Scopes:        parseDMARDeviceScopes(s[16:]),
+			})
+		case structureType == DMARTypeRMRR && length >= 24:
+			dmar.ReservedMemory = append(dmar.ReservedMemory, DMARReservedMemory{
+				Segment: binary.LittleEndian.Uint16(s[6:]),
+				Base:    binary.LittleEndian.Uint64(s[8:]),
+				Limit:   binary.LittleEndian.Uint64(s[16:]),
+				Scopes:  parseDMARDeviceScopes(s[24:]),
+			})
+		}
+		offset += length
+	}
+	return dmar, nil
+}
+
+// parseDMARDeviceScopes parses the device scope list at the end of a
+// remapping structure, stopping at the first malformed entry
+func parseDMARDeviceScopes(b []byte) []DMARDeviceScope {
+	var scopes []DMARDeviceScope
+	for len(b) >= 6 {
+		length := int(b[1])
+		if length < 6 || length > len(b) {
+			break
+		}
+		scope := DMARDeviceScope{Type: b[0], EnumerationID: b[4], StartBus: b[5]}
+		for path := b[6:length]; len(path) >= 2; path = path[2:] {
+			scope.Path = append(scope.Path, DMARPathEntry{Device: path[0], Function: path[1]})
+		}
+		scopes = append(scopes, scope)
+		b = b[length:]
+	}
+	return scopes
+}
+
+// BGRT is the Boot Graphics Resource Table describing the boot logo
+type BGRT struct {
+	Header       ACPITableHeader
+	Version      uint16
+	Displayed    bool  // the image is currently displayed on screen
+	Orientation  int   // clockwise rotation in degrees: 0, 90, 180 or 270
+	ImageType    uint8 // 0 is a bitmap
+	ImageAddress uint64
+	OffsetX      uint32
+	OffsetY      uint32
+}
+
+// ParseBGRT parses the Boot Graphics Resource Table
+func ParseBGRT(data []byte) (*BGRT, error) {
+	header, data, err := parseACPITable(data, []string{"BGRT"}, 56)
+	if err != nil {
+		return nil, err
+	}
+
+	status := data[38]
+	return &BGRT{
+		Header:       header,
+		Version:      binary.LittleEndian.Uint16(data[36:]),
+		Displayed:    status&1 != 0,
+		Orientation:  int(status>>1&3) * 90,
+		ImageType:    data[39],
+		ImageAddress: binary.LittleEndian.Uint64(data[40:]),
+		OffsetX:      binary.LittleEndian.Uint32(data[48:]),
+		OffsetY:      binary.LittleEndian.Uint32(data[52:]),
+	}, nil
+}
+
+// ParseACPITable parses a table with the parser matching its signature. It
+// returns *RSDT, *FADT, *MADT, *MCFG, *HPET, *DMAR or *BGRT, or *ACPITable for
+// tables without a specific parser.
+func ParseACPITable(data []byte) (interface{}, error) {
+	header, err := ParseACPITableHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	switch header.Signature {
+	case "RSDT", "XSDT":
+		return ParseRSDT(data)
+	case "FACP":
+		return ParseFADT(data)
+	case "APIC":
+		return ParseMADT(data)
+	case "MCFG":
+		return ParseMCFG(data)
+	case "HPET":
+		return ParseHPET(data)
+	case "DMAR":
+		return ParseDMAR(data)
+	case "BGRT":
+		return ParseBGRT(data)
+	}
+	return &ACPITable{Header: header, Data: data[:header.Length]}, nil
+}
@@ -0,0 +1,279 @@
+package firmware
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// testdata/acpi holds APIC, FACP and MCFG tables dumped from a Firecracker
+// guest and synthetic XSDT, RSDT, HPET, DMAR and BGRT tables
+func loadACPITable(t *testing.T, signature string) []byte {
+	t.Helper()
+	data, err := os.ReadFile("testdata/acpi/" + signature + ".bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// TestACPIChecksum tests checksum verification of every checked-in table and
+// of a corrupted copy
+func TestACPIChecksum(t *testing.T) {
+	for _, signature := range []string{"APIC", "BGRT", "DMAR", "FACP", "HPET", "MCFG", "RSDT", "XSDT"} {
+		data := loadACPITable(t, signature)
+		if err := VerifyACPIChecksum(data); err != nil {
+			t.Errorf("VerifyACPIChecksum(%s) error = %v", signature, err)
+		}
+	}
+
+	data := loadACPITable(t, "MCFG")
+	data[len(data)-1]++
+	header, err := ParseACPITableHeader(data)
+	if err != nil {
+		t.Fatalf("ParseACPITableHeader() error = %v", err)
+	}
+	if header.ChecksumValid {
+		t.Error("ChecksumValid = true for a corrupted table")
+	}
+	if err := VerifyACPIChecksum(data); !errors.Is(err, winx.STATUS_ACPI_INVALID_TABLE) {
+		t.Errorf("VerifyACPIChecksum() error = %v, want STATUS_ACPI_INVALID_TABLE", err)
+	}
+	if _, err := ParseMCFG(data); err != nil {
+		t.Errorf("ParseMCFG() of a table with a bad checksum error = %v", err)
+	}
+}
+
+// TestParseACPITableHeader tests header fields and rejection of short or
+// inconsistent buffers
+func TestParseACPITableHeader(t *testing.T) {
+	header, err := ParseACPITableHeader(loadACPITable(t, "HPET"))
+	if err != nil {
+		t.Fatalf("ParseACPITableHeader() error = %v", err)
+	}
+	want := ACPITableHeader{
+		Signature:       "HPET",
+		Length:          56,
+		Revision:        1,
+		Checksum:        header.Checksum,
+		OEMID:           "WINX",
+		OEMTableID:      "WINXTEST",
+		OEMRevision:     1,
+		CreatorID:       "WINX",
+		CreatorRevision: 0x20240101,
+		ChecksumValid:   true,
+	}
+	if header != want {
+		t.Errorf("header = %+v, want %+v", header, want)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want winx.NTSTATUS
+	}{
+		{"short header", loadACPITable(t, "HPET")[:20], winx.STATUS_BUFFER_TOO_SMALL},
+		{"truncated table", loadACPITable(t, "HPET")[:40], winx.STATUS_ACPI_INVALID_TABLE},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseACPITableHeader(tt.data); !errors.Is(err, tt.want) {
+				t.Errorf("ParseACPITableHeader() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParseMADT(loadACPITable(t, "HPET")); !errors.Is(err, winx.STATUS_ACPI_INVALID_TABLE) {
+		t.Errorf("ParseMADT(HPET) error = %v, want STATUS_ACPI_INVALID_TABLE", err)
+	}
+}
+
+// TestParseRSDT tests 32-bit RSDT and 64-bit XSDT entry decoding
+func TestParseRSDT(t *testing.T) {
+	for _, signature := range []string{"RSDT", "XSDT"} {
+		t.Run(signature, func(t *testing.T) {
+			table, err := ParseRSDT(loadACPITable(t, signature))
+			if err != nil {
+				t.Fatalf("ParseRSDT() error = %v", err)
+			}
+			if len(table.Entries) != 6 {
+				t.Fatalf("got %d entries, want 6", len(table.Entries))
+			}
+			if table.Entries[0] != 0x7FF4A000 || table.Entries[5] != 0x7FF45000 {
+				t.Errorf("entries = %#x, want 0x7ff4a000 .. 0x7ff45000", table.Entries)
+			}
+		})
+	}
+}
+
+// TestParseFADT tests a revision 6.5 FADT from a hardware-reduced platform
+func TestParseFADT(t *testing.T) {
+	fadt, err := ParseFADT(loadACPITable(t, "FACP"))
+	if err != nil {
+		t.Fatalf("ParseFADT() error = %v", err)
+	}
+	if fadt.Header.Revision != 6 || fadt.MinorVersion != 5 {
+		t.Errorf("version = %d.%d, want 6.5", fadt.Header.Revision, fadt.MinorVersion)
+	}
+	if fadt.DSDT != 0x9FD30 {
+		t.Errorf("DSDT = %#x, want 0x9fd30", fadt.DSDT)
+	}
+	if !fadt.HardwareReduced() {
+		t.Error("HardwareReduced() = false")
+	}
+	if fadt.Flags&(FADTFlagPowerButton|FADTFlagSleepButton) == 0 {
+		t.Errorf("Flags = %#x, want power and sleep button bits", fadt.Flags)
+	}
+	if fadt.IAPCBootArch != 0x0004 {
+		t.Errorf("IAPCBootArch = %#x, want 0x4", fadt.IAPCBootArch)
+	}
+	if fadt.HypervisorVendor != "FIRECKVM" {
+		t.Errorf("HypervisorVendor = %q, want FIRECKVM", fadt.HypervisorVendor)
+	}
+	if got := fadt.PreferredPMProfileName(); got != preferredPMProfileNames[fadt.PreferredPMProfile] {
+		t.Errorf("PreferredPMProfileName() = %q", got)
+	}
+}
+
+// TestParseMADT tests local APIC and I/O APIC decoding
+func TestParseMADT(t *testing.T) {
+	madt, err := ParseMADT(loadACPITable(t, "APIC"))
+	if err != nil {
+		t.Fatalf("ParseMADT() error = %v", err)
+	}
+	if madt.LocalAPICAddress != 0xFEE00000 {
+		t.Errorf("LocalAPICAddress = %#x, want 0xfee00000", madt.LocalAPICAddress)
+	}
+	if len(madt.IOAPICs) != 1 || madt.IOAPICs[0] != (MADTIOAPIC{ID: 0, Address: 0xFEC00000, GSIBase: 0}) {
+		t.Errorf("IOAPICs = %+v", madt.IOAPICs)
+	}
+	if len(madt.LocalAPICs) != 1 || !madt.LocalAPICs[0].Enabled || madt.LocalAPICs[0].APICID != 0 {
+		t.Errorf("LocalAPICs = %+v", madt.LocalAPICs)
+	}
+	if len(madt.Entries) != 2 {
+		t.Errorf("got %d entries, want 2", len(madt.Entries))
+	}
+}
+
+// TestParseMCFG tests ECAM allocation decoding
+func TestParseMCFG(t *testing.T) {
+	mcfg, err := ParseMCFG(loadACPITable(t, "MCFG"))
+	if err != nil {
+		t.Fatalf("ParseMCFG() error = %v", err)
+	}
+	want := MCFGAllocation{BaseAddress: 0xEEC00000, Segment: 0, StartBus: 0, EndBus: 0}
+	if len(mcfg.Allocations) != 1 || mcfg.Allocations[0] != want {
+		t.Errorf("Allocations = %+v, want [%+v]", mcfg.Allocations, want)
+	}
+}
+
+// TestParseHPET tests event timer block ID and address decoding
+func TestParseHPET(t *testing.T) {
+	hpet, err := ParseHPET(loadACPITable(t, "HPET"))
+	if err != nil {
+		t.Fatalf("ParseHPET() error = %v", err)
+	}
+	if hpet.HardwareRevisionID != 1 || hpet.Comparators != 3 || !hpet.Counter64Bit || !hpet.LegacyReplacement || hpet.VendorID != 0x8086 {
+		t.Errorf("block ID fields = %+v", hpet)
+	}
+	want := GenericAddress{AddressSpace: 0, BitWidth: 64, Address: 0xFED00000}
+	if hpet.Address != want {
+		t.Errorf("Address = %+v, want %+v", hpet.Address, want)
+	}
+	if hpet.MinimumTick != 0x37EE {
+		t.Errorf("MinimumTick = %#x, want 0x37ee", hpet.MinimumTick)
+	}
+}
+
+// TestParseDMAR tests hardware unit, reserved memory and device scope decoding
+func TestParseDMAR(t *testing.T) {
+	dmar, err := ParseDMAR(loadACPITable(t, "DMAR"))
+	if err != nil {
+		t.Fatalf("ParseDMAR() error = %v", err)
+	}
+	if dmar.HostAddressWidth != 39 || !dmar.InterruptRemapping() {
+		t.Errorf("HostAddressWidth = %d, Flags = %#x", dmar.HostAddressWidth, dmar.Flags)
+	}
+	if len(dmar.Structures) != 3 {
+		t.Errorf("got %d structures, want 3", len(dmar.Structures))
+	}
+
+	if len(dmar.HardwareUnits) != 2 {
+		t.Fatalf("got %d hardware units, want 2", len(dmar.HardwareUnits))
+	}
+	gfx := dmar.HardwareUnits[0]
+	if gfx.RegisterBase != 0xFED90000 || gfx.IncludePCIAll || len(gfx.Scopes) != 1 {
+		t.Errorf("HardwareUnits[0] = %+v", gfx)
+	} else if path := gfx.Scopes[0].Path; len(path) != 1 || path[0] != (DMARPathEntry{Device: 2, Function: 0}) {
+		t.Errorf("HardwareUnits[0] scope path = %+v, want 00:02.0", path)
+	}
+	if unit := dmar.HardwareUnits[1]; unit.RegisterBase != 0xFED91000 || !unit.IncludePCIAll {
+		t.Errorf("HardwareUnits[1] = %+v", unit)
+	}
+
+	if len(dmar.ReservedMemory) != 1 {
+		t.Fatalf("got %d reserved regions, want 1", len(dmar.ReservedMemory))
+	}
+	rmrr := dmar.ReservedMemory[0]
+	if rmrr.Base != 0x7C000000 || rmrr.Limit != 0x7C81FFFF || len(rmrr.Scopes) != 1 || rmrr.Scopes[0].Path[0].Device != 0x14 {
+		t.Errorf("ReservedMemory[0] = %+v", rmrr)
+	}
+}
+
+// TestParseBGRT tests boot logo decoding
+func TestParseBGRT(t *testing.T) {
+	bgrt, err := ParseBGRT(loadACPITable(t, "BGRT"))
+	if err != nil {
+		t.Fatalf("ParseBGRT() error = %v", err)
+	}
+	want := BGRT{
+		Header:       bgrt.Header,
+		Version:      1,
+		Displayed:    true,
+		ImageAddress: 0x7E5B1018,
+		OffsetX:      760,
+		OffsetY:      303,
+	}
+	if *bgrt != want {
+		t.Errorf("BGRT = %+v, want %+v", *bgrt, want)
+	}
+}
+
+// TestParseACPITable tests dispatch by signature
+func TestParseACPITable(t *testing.T) {
+	tests := []struct {
+		signature string
+		check     func(interface{}) bool
+	}{
+		{"XSDT", func(v interface{}) bool { _, ok := v.(*RSDT); return ok }},
+		{"FACP", func(v interface{}) bool { _, ok := v.(*FADT); return ok }},
+		{"APIC", func(v interface{}) bool { _, ok := v.(*MADT); return ok }},
+		{"MCFG", func(v interface{}) bool { _, ok := v.(*MCFG); return ok }},
+		{"HPET", func(v interface{}) bool { _, ok := v.(*HPET); return ok }},
+		{"DMAR", func(v interface{}) bool { _, ok := v.(*DMAR); return ok }},
+		{"BGRT", func(v interface{}) bool { _, ok := v.(*BGRT); return ok }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.signature, func(t *testing.T) {
+			table, err := ParseACPITable(loadACPITable(t, tt.signature))
+			if err != nil {
+				t.Fatalf("ParseACPITable() error = %v", err)
+			}
+			if !tt.check(table) {
+				t.Errorf("ParseACPITable() returned %T", table)
+			}
+		})
+	}
+
+	data := loadACPITable(t, "HPET")
+	copy(data, "SSDT")
+	table, err := ParseACPITable(data)
+	if err != nil {
+		t.Fatalf("ParseACPITable(SSDT) error = %v", err)
+	}
+	if raw, ok := table.(*ACPITable); !ok || raw.Header.Signature != "SSDT" || len(raw.Data) != 56 {
+		t.Errorf("ParseACPITable(SSDT) = %+v", table)
+	}
+}
@@ -0,0 +1,556 @@
+// Package firmware parses SMBIOS and ACPI tables returned by the Windows
+// firmware table providers ('RSMB' and 'ACPI').
+//
+// The parsers work on raw byte dumps and do not call into Windows, so they can
+// be used on captured tables on any operating system. Use
+// ntdll.GetSystemFirmwareTable to retrieve the tables on a live system.
+package firmware
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// SMBIOS structure types decoded by ParseSMBIOS
+const (
+	SMBIOSTypeBIOS         = 0
+	SMBIOSTypeSystem       = 1
+	SMBIOSTypeBaseboard    = 2
+	SMBIOSTypeChassis      = 3
+	SMBIOSTypeProcessor    = 4
+	SMBIOSTypeMemoryDevice = 17
+	SMBIOSTypeEndOfTable   = 127
+)
+
+// rawSMBIOSHeaderSize is the size of the RawSMBIOSData header that precedes
+// the structure table in the 'RSMB' firmware table
+const rawSMBIOSHeaderSize = 8
+
+// SMBIOSStructure is a single structure of the SMBIOS table
+type SMBIOSStructure struct {
+	Type      uint8
+	Handle    uint16
+	Formatted []byte   // formatted area, including the 4 byte header
+	Strings   []string // string set; string number n is Strings[n-1]
+}
+
+// String returns string number n of the structure's string set, or "" for 0
+// or a missing string
+func (s *SMBIOSStructure) String(n uint8) string {
+	if n == 0 || int(n) > len(s.Strings) {
+		return ""
+	}
+	return s.Strings[n-1]
+}
+
+// The field accessors return zero for offsets beyond the formatted area, so
+// structures from older SMBIOS versions decode with their newer fields empty
+func (s *SMBIOSStructure) byteAt(offset int) uint8 {
+	if offset+1 > len(s.Formatted) {
+		return 0
+	}
+	return s.Formatted[offset]
+}
+
+func (s *SMBIOSStructure) wordAt(offset int) uint16 {
+	if offset+2 > len(s.Formatted) {
+		return 0
+	}
+	return binary.LittleEndian.Uint16(s.Formatted[offset:])
+}
+
+func (s *SMBIOSStructure) dwordAt(offset int) uint32 {
+	if offset+4 > len(s.Formatted) {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(s.Formatted[offset:])
+}
+
+func (s *SMBIOSStructure) qwordAt(offset int) uint64 {
+	if offset+8 > len(s.Formatted) {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(s.Formatted[offset:])
+}
+
+func (s *SMBIOSStructure) stringAt(offset int) string {
+	return s.String(s.byteAt(offset))
+}
+
+// SMBIOS is a parsed SMBIOS table
+type SMBIOS struct {
+	MajorVersion  uint8
+	MinorVersion  uint8
+	DMIRevision   uint8
+	Structures    []SMBIOSStructure
+	BIOS          *BIOSInfo
+	System        *SystemInfo
+	Baseboards    []BaseboardInfo
+	Chassis       []ChassisInfo
+	Processors    []ProcessorInfo
+	MemoryDevices []MemoryDevice
+}
+
+// BIOSInfo is the BIOS Information structure (type 0)
+type BIOSInfo struct {
+	Vendor          string
+	Version         string
+	ReleaseDate     string
+	StartingSegment uint16
+	ROMSize         uint64 // bytes
+	Characteristics uint64
+	MajorRelease    uint8
+	MinorRelease    uint8
+	ECMajorRelease  uint8
+	ECMinorRelease  uint8
+}
+
+// SystemInfo is the System Information structure (type 1)
+type SystemInfo struct {
+	Manufacturer string
+	ProductName  string
+	Version      string
+	SerialNumber string
+	UUID         string // empty if not present or not set
+	WakeUpType   uint8
+	SKUNumber    string
+	Family       string
+}
+
+// BaseboardInfo is the Baseboard Information structure (type 2)
+type BaseboardInfo struct {
+	Manufacturer      string
+	Product           string
+	Version           string
+	SerialNumber      string
+	AssetTag          string
+	FeatureFlags      uint8
+	LocationInChassis string
+	ChassisHandle     uint16
+	BoardType         uint8
+}
+
+// ChassisInfo is the System Enclosure structure (type 3)
+type ChassisInfo struct {
+	Manufacturer     string
+	Type             ChassisType
+	Lock             bool
+	Version          string
+	SerialNumber     string
+	AssetTag         string
+	BootUpState      uint8
+	PowerSupplyState uint8
+	ThermalState     uint8
+	SecurityStatus   uint8
+	SKUNumber        string
+}
+
+// ProcessorInfo is the Processor Information structure (type 4)
+type ProcessorInfo struct {
+	SocketDesignation string
+	ProcessorType     uint8
+	Family            uint16
+	Manufacturer      string
+	ID                uint64 // CPUID signature and feature flags
+	Version           string
+	ExternalClock     uint16 // MHz
+	MaxSpeed          uint16 // MHz
+	CurrentSpeed      uint16 // MHz
+	Populated         bool
+	Status            uint8 // CPU status, bits 2:0 of the Status field
+	SerialNumber      string
+	AssetTag          string
+	PartNumber        string
+	CoreCount         uint16
+	CoreEnabled       uint16
+	ThreadCount       uint16
+	Characteristics   uint16
+}
+
+// MemoryDevice is the Memory Device structure (type 17)
+type MemoryDevice struct {
+	ArrayHandle         uint16
+	TotalWidth          uint16 // bits, 0xFFFF if unknown
+	DataWidth           uint16 // bits, 0xFFFF if unknown
+	Size                uint64 // bytes, 0 if no module is installed or the size is unknown
+	FormFactor          uint8
+	DeviceLocator       string
+	BankLocator         string
+	MemoryType          MemoryType
+	TypeDetail          uint16
+	Speed               uint16 // MT/s, 0 if unknown
+	Manufacturer        string
+	SerialNumber        string
+	AssetTag            string
+	PartNumber          string
+	Rank                uint8
+	ConfiguredSpeed     uint16 // MT/s, 0 if unknown
+	ConfiguredVoltageMV uint16
+}
+
+// Installed reports whether a memory module of known size is present in the slot
+func (device *MemoryDevice) Installed() bool {
+	return device.Size != 0
+}
+
+// ChassisType is the enclosure type of a System Enclosure structure
+type ChassisType uint8
+
+var chassisTypeNames = [...]string{
+	1:  "Other",
+	2:  "Unknown",
+	3:  "Desktop",
+	4:  "Low Profile Desktop",
+	5:  "Pizza Box",
+	6:  "Mini Tower",
+	7:  "Tower",
+	8:  "Portable",
+	9:  "Laptop",
+	10: "Notebook",
+	11: "Hand Held",
+	12: "Docking Station",
+	13: "All in One",
+	14: "Sub Notebook",
+	15: "Space-saving",
+	16: "Lunch Box",
+	17: "Main Server Chassis",
+	18: "Expansion Chassis",
+	19: "SubChassis",
+	20: "Bus Expansion Chassis",
+	21: "Peripheral Chassis",
+	22: "RAID Chassis",
+	23: "Rack Mount Chassis",
+	24: "Sealed-case PC",
+	25: "Multi-system chassis",
+	26: "Compact PCI",
+	27: "Advanced TCA",
+	28: "Blade",
+	29: "Blade Enclosure",
+	30: "Tablet",
+	31: "Convertible",
+	32: "Detachable",
+	33: "IoT Gateway",
+	34: "Embedded PC",
+	35: "Mini PC",
+	36: "Stick PC",
+}
+
+// String returns the name of the chassis type, e.g. "Rack Mount Chassis"
+func (t ChassisType) String() string {
+	if int(t) < len(chassisTypeNames) && chassisTypeNames[t] != "" {
+		return chassisTypeNames[t]
+	}
+	return fmt.Sprintf("ChassisType(%d)", uint8(t))
+}
+
+// MemoryType is the memory technology of a Memory Device structure
+type MemoryType uint8
+
+var memoryTypeNames = map[MemoryType]string{
+	0x01: "Other",
+	0x02: "Unknown",
+	0x03: "DRAM",
+	0x04: "EDRAM",
+	0x05: "VRAM",
+	0x06: "SRAM",
+	0x07: "RAM",
+	0x08: "ROM",
+	0x09: "FLASH",
+	0x0A: "EEPROM",
+	0x0B: "FEPROM",
+	0x0C: "EPROM",
+	0x0D: "CDRAM",
+	0x0E: "3DRAM",
+	0x0F: "SDRAM",
+	0x10: "SGRAM",
+	0x11: "RDRAM",
+	0x12: "DDR",
+	0x13: "DDR2",
+	0x14: "DDR2 FB-DIMM",
+	0x18: "DDR3",
+	0x19: "FBD2",
+	0x1A: "DDR4",
+	0x1B: "LPDDR",
+	0x1C: "LPDDR2",
+	0x1D: "LPDDR3",
+	0x1E: "LPDDR4",
+	0x1F: "Logical non-volatile device",
+	0x20: "HBM",
+	0x21: "HBM2",
+	0x22: "DDR5",
+	0x23: "LPDDR5",
+	0x24: "HBM3",
+}
+
+// String returns the name of the memory type, e.g. "DDR4"
+func (t MemoryType) String() string {
+	if name, ok := memoryTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("MemoryType(0x%02X)", uint8(t))
+}
+
+// ParseSMBIOS parses the 'RSMB' firmware table, which is the SMBIOS structure
+// table preceded by the RawSMBIOSData header holding the SMBIOS version.
+func ParseSMBIOS(raw []byte) (*SMBIOS, error) {
+	if len(raw) < rawSMBIOSHeaderSize {
+		return nil, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("RawSMBIOSData header needs %d bytes, got %d", rawSMBIOSHeaderSize, len(raw)))
+	}
+	length := binary.LittleEndian.Uint32(raw[4:])
+	if uint64(length) > uint64(len(raw)-rawSMBIOSHeaderSize) {
+		return nil, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("SMBIOS table of %d bytes is truncated to %d", length, len(raw)-rawSMBIOSHeaderSize))
+	}
+
+	table, err := ParseSMBIOSTable(raw[rawSMBIOSHeaderSize:rawSMBIOSHeaderSize+length], raw[1], raw[2])
+	if err != nil {
+		return nil, err
+	}
+	table.DMIRevision = raw[3]
+	return table, nil
+}
+
+// ParseSMBIOSTable parses a bare SMBIOS structure table, such as a dump of
+// /sys/firmware/dmi/tables/DMI, for the given SMBIOS version. Parsing stops at
+// the end-of-table structure or the end of data.
+func ParseSMBIOSTable(data []byte, major, minor uint8) (*SMBIOS, error) {
+	table := &SMBIOS{MajorVersion: major, MinorVersion: minor}
+
+	offset := 0
+	for offset+4 <= len(data) {
+		length := int(data[offset+1])
+		if length < 4 || offset+length > len(data) {
+			return nil, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("SMBIOS structure at offset %d has invalid length %d", offset, length))
+		}
+		s := SMBIOSStructure{
+			Type:      data[offset],
+			Handle:    binary.LittleEndian.Uint16(data[offset+2:]),
+			Formatted: data[offset : offset+length],
+		}
+
+		// the string set ends with a double NUL, which is also present when
+		// the structure has no strings
+		end := bytes.Index(data[offset+length:], []byte{0, 0})
+		if end < 0 {
+			return nil, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("SMBIOS structure at offset %d has an unterminated string set", offset))
+		}
+		if end > 0 {
+			for _, str := range bytes.Split(data[offset+length:offset+length+end], []byte{0}) {
+				s.Strings = append(s.Strings, string(str))
+			}
+		}
+		offset += length + end + 2
+
+		table.Structures = append(table.Structures, s)
+		table.decode(&table.Structures[len(table.Structures)-1])
+		if s.Type == SMBIOSTypeEndOfTable {
+			break
+		}
+	}
+	return table, nil
+}
+
+// StructuresOfType returns every structure of the given type
+func (table *SMBIOS) StructuresOfType(t uint8) []SMBIOSStructure {
+	var result []SMBIOSStructure
+	for _, s := range table.Structures {
+		if s.Type == t {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// atLeast reports whether the table version is major.minor or later
+func (table *SMBIOS) atLeast(major, minor uint8) bool {
+	return table.MajorVersion > major || (table.MajorVersion == major && table.MinorVersion >= minor)
+}
+
+func (table *SMBIOS) decode(s *SMBIOSStructure) {
+	switch s.Type {
+	case SMBIOSTypeBIOS:
+		if table.BIOS == nil {
+			table.BIOS = decodeBIOS(s)
+		}
+	case SMBIOSTypeSystem:
+		if table.System == nil {
+			table.System = decodeSystem(s, table.atLeast(2, 6))
+		}
+	case SMBIOSTypeBaseboard:
+		table.Baseboards = append(table.Baseboards, decodeBaseboard(s))
+	case SMBIOSTypeChassis:
+		table.Chassis = append(table.Chassis, decodeChassis(s))
+	case SMBIOSTypeProcessor:
+		table.Processors = append(table.Processors, decodeProcessor(s))
+	case SMBIOSTypeMemoryDevice:
+		table.MemoryDevices = append(table.MemoryDevices, decodeMemoryDevice(s))
+	}
+}
+
+func decodeBIOS(s *SMBIOSStructure) *BIOSInfo {
+	bios := &BIOSInfo{
+		Vendor:          s.stringAt(0x04),
+		Version:         s.stringAt(0x05),
+		StartingSegment: s.wordAt(0x06),
+		ReleaseDate:     s.stringAt(0x08),
+		Characteristics: s.qwordAt(0x0A),
+		MajorRelease:    s.byteAt(0x14),
+		MinorRelease:    s.byteAt(0x15),
+		ECMajorRelease:  s.byteAt(0x16),
+		ECMinorRelease:  s.byteAt(0x17),
+	}
+
+	romSize := s.byteAt(0x09)
+	if romSize == 0xFF && len(s.Formatted) >= 0x1A {
+		// Extended BIOS ROM Size: bits 15:14 select MB or GB units
+		extended := s.wordAt(0x18)
+		size := uint64(extended & 0x3FFF)
+		switch extended >> 14 {
+		case 0:
+			bios.ROMSize = size << 20
+		case 1:
+			bios.ROMSize = size << 30
+		}
+	} else {
+		bios.ROMSize = (uint64(romSize) + 1) << 16
+	}
+	return bios
+}
+
+func decodeSystem(s *SMBIOSStructure, littleEndianUUID bool) *SystemInfo {
+	system := &SystemInfo{
+		Manufacturer: s.stringAt(0x04),
+		ProductName:  s.stringAt(0x05),
+		Version:      s.stringAt(0x06),
+		SerialNumber: s.stringAt(0x07),
+		WakeUpType:   s.byteAt(0x18),
+		SKUNumber:    s.stringAt(0x19),
+		Family:       s.stringAt(0x1A),
+	}
+	if len(s.Formatted) >= 0x18 {
+		system.UUID = formatSMBIOSUUID(s.Formatted[0x08:0x18], littleEndianUUID)
+	}
+	return system
+}
+
+// formatSMBIOSUUID formats the System UUID field. Since SMBIOS 2.6 the first
+// three fields are stored little-endian. All zero (not set) and all 0xFF (not
+// present) values are returned as "".
+func formatSMBIOSUUID(b []byte, littleEndian bool) string {
+	if bytes.Equal(b, make([]byte, 16)) || bytes.Equal(b, bytes.Repeat([]byte{0xFF}, 16)) {
+		return ""
+	}
+	if littleEndian {
+		return fmt.Sprintf("%08X-%04X-%04X-%X-%X",
+			binary.LittleEndian.Uint32(b[0:]), binary.LittleEndian.Uint16(b[4:]), binary.LittleEndian.Uint16(b[6:]), b[8:10], b[10:16])
+	}
+	return fmt.Sprintf("%X-%X-%X-%X-%X", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+func decodeBaseboard(s *SMBIOSStructure) BaseboardInfo {
+	return BaseboardInfo{
+		Manufacturer:      s.stringAt(0x04),
+		Product:           s.stringAt(0x05),
+		Version:           s.stringAt(0x06),
+		SerialNumber:      s.stringAt(0x07),
+		AssetTag:          s.stringAt(0x08),
+		FeatureFlags:      s.byteAt(0x09),
+		LocationInChassis: s.stringAt(0x0A),
+		ChassisHandle:     s.wordAt(0x0B),
+		BoardType:         s.byteAt(0x0D),
+	}
+}
+
+func decodeChassis(s *SMBIOSStructure) ChassisInfo {
+	chassis := ChassisInfo{
+		Manufacturer:     s.stringAt(0x04),
+		Type:             ChassisType(s.byteAt(0x05) & 0x7F),
+		Lock:             s.byteAt(0x05)&0x80 != 0,
+		Version:          s.stringAt(0x06),
+		SerialNumber:     s.stringAt(0x07),
+		AssetTag:         s.stringAt(0x08),
+		BootUpState:      s.byteAt(0x09),
+		PowerSupplyState: s.byteAt(0x0A),
+		ThermalState:     s.byteAt(0x0B),
+		SecurityStatus:   s.byteAt(0x0C),
+	}
+
+	// the SKU number follows the variable length contained element records
+	count, recordLength := int(s.byteAt(0x13)), int(s.byteAt(0x14))
+	chassis.SKUNumber = s.stringAt(0x15 + count*recordLength)
+	return chassis
+}
+
+func decodeProcessor(s *SMBIOSStructure) ProcessorInfo {
+	processor := ProcessorInfo{
+		SocketDesignation: s.stringAt(0x04),
+		ProcessorType:     s.byteAt(0x05),
+		Family:            uint16(s.byteAt(0x06)),
+		Manufacturer:      s.stringAt(0x07),
+		ID:                s.qwordAt(0x08),
+		Version:           s.stringAt(0x10),
+		ExternalClock:     s.wordAt(0x12),
+		MaxSpeed:          s.wordAt(0x14),
+		CurrentSpeed:      s.wordAt(0x16),
+		Populated:         s.byteAt(0x18)&0x40 != 0,
+		Status:            s.byteAt(0x18) & 0x07,
+		SerialNumber:      s.stringAt(0x20),
+		AssetTag:          s.stringAt(0x21),
+		PartNumber:        s.stringAt(0x22),
+		CoreCount:         uint16(s.byteAt(0x23)),
+		CoreEnabled:       uint16(s.byteAt(0x24)),
+		ThreadCount:       uint16(s.byteAt(0x25)),
+		Characteristics:   s.wordAt(0x26),
+	}
+
+	// 0xFE and 0xFF indicate that the value is in the 2.6 and 3.0 word fields
+	if processor.Family == 0xFE {
+		processor.Family = s.wordAt(0x28)
+	}
+	if processor.CoreCount == 0xFF {
+		processor.CoreCount = s.wordAt(0x2A)
+	}
+	if processor.CoreEnabled == 0xFF {
+		processor.CoreEnabled = s.wordAt(0x2C)
+	}
+	if processor.ThreadCount == 0xFF {
+		processor.ThreadCount = s.wordAt(0x2E)
+	}
+	return processor
+}
+
+func decodeMemoryDevice(s *SMBIOSStructure) MemoryDevice {
+	device := MemoryDevice{
+		ArrayHandle:         s.wordAt(0x04),
+		TotalWidth:          s.wordAt(0x08),
+		DataWidth:           s.wordAt(0x0A),
+		FormFactor:          s.byteAt(0x0E),
+		DeviceLocator:       s.stringAt(0x10),
+		BankLocator:         s.stringAt(0x11),
+		MemoryType:          MemoryType(s.byteAt(0x12)),
+		TypeDetail:          s.wordAt(0x13),
+		Speed:               s.wordAt(0x15),
+		Manufacturer:        s.stringAt(0x17),
+		SerialNumber:        s.stringAt(0x18),
+		AssetTag:            s.stringAt(0x19),
+		PartNumber:          s.stringAt(0x1A),
+		Rank:                s.byteAt(0x1B) & 0x0F,
+		ConfiguredSpeed:     s.wordAt(0x20),
+		ConfiguredVoltageMV: s.wordAt(0x26),
+	}
+
+	// Size is in MB, or KB when bit 15 is set; 0x7FFF defers to the
+	// Extended Size field and 0xFFFF means unknown
+	switch size := s.wordAt(0x0C); {
+	case size == 0xFFFF:
+	case size == 0x7FFF:
+		device.Size = uint64(s.dwordAt(0x1C)&0x7FFFFFFF) << 20
+	case size&0x8000 != 0:
+		device.Size = uint64(size&0x7FFF) << 10
+	default:
+		device.Size = uint64(size) << 20
+	}
+	return device
+}
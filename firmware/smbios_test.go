@@ -0,0 +1,158 @@
+package firmware
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// testdata/rsmb.bin is an SMBIOS 3.2 'RSMB' table with BIOS, system,
+// chassis, baseboard, processor and three memory device structures
+func loadSMBIOS(t *testing.T) *SMBIOS {
+	t.Helper()
+	raw, err := os.ReadFile("testdata/rsmb.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	table, err := ParseSMBIOS(raw)
+	if err != nil {
+		t.Fatalf("ParseSMBIOS() error = %v", err)
+	}
+	return table
+}
+
+// TestParseSMBIOS tests the table header and structure walk
+func TestParseSMBIOS(t *testing.T) {
+	table := loadSMBIOS(t)
+	if table.MajorVersion != 3 || table.MinorVersion != 2 {
+		t.Errorf("version = %d.%d, want 3.2", table.MajorVersion, table.MinorVersion)
+	}
+	if len(table.Structures) != 9 {
+		t.Errorf("got %d structures, want 9", len(table.Structures))
+	}
+	if last := table.Structures[len(table.Structures)-1]; last.Type != SMBIOSTypeEndOfTable {
+		t.Errorf("last structure type = %d, want %d", last.Type, SMBIOSTypeEndOfTable)
+	}
+	if got := len(table.StructuresOfType(SMBIOSTypeMemoryDevice)); got != 3 {
+		t.Errorf("StructuresOfType(17) returned %d structures, want 3", got)
+	}
+}
+
+// TestParseSMBIOS_BIOSAndSystem tests the type 0 and type 1 decoders
+func TestParseSMBIOS_BIOSAndSystem(t *testing.T) {
+	table := loadSMBIOS(t)
+
+	bios := table.BIOS
+	if bios == nil {
+		t.Fatal("BIOS = nil")
+	}
+	if bios.Vendor != "Microsoft Corporation" || bios.Version != "Hyper-V UEFI Release v4.1" || bios.ReleaseDate != "04/06/2022" {
+		t.Errorf("BIOS = %+v", bios)
+	}
+	if bios.ROMSize != 16<<20 || bios.MajorRelease != 4 || bios.MinorRelease != 1 {
+		t.Errorf("BIOS ROMSize = %d, release %d.%d", bios.ROMSize, bios.MajorRelease, bios.MinorRelease)
+	}
+
+	system := table.System
+	if system == nil {
+		t.Fatal("System = nil")
+	}
+	if system.Manufacturer != "Microsoft Corporation" || system.ProductName != "Virtual Machine" || system.Family != "Virtual Machine" {
+		t.Errorf("System = %+v", system)
+	}
+	if system.UUID != "4C4C4544-0051-3510-8057-B7C04F563232" {
+		t.Errorf("System.UUID = %s", system.UUID)
+	}
+}
+
+// TestParseSMBIOS_Hardware tests the chassis, baseboard, processor and memory decoders
+func TestParseSMBIOS_Hardware(t *testing.T) {
+	table := loadSMBIOS(t)
+
+	if len(table.Chassis) != 1 || table.Chassis[0].Type.String() != "Desktop" || table.Chassis[0].SKUNumber != "Virtual Machine" {
+		t.Errorf("Chassis = %+v", table.Chassis)
+	}
+	if len(table.Baseboards) != 1 || table.Baseboards[0].Product != "Virtual Machine" || table.Baseboards[0].ChassisHandle != 0x0003 {
+		t.Errorf("Baseboards = %+v", table.Baseboards)
+	}
+
+	if len(table.Processors) != 1 {
+		t.Fatalf("got %d processors, want 1", len(table.Processors))
+	}
+	cpu := table.Processors[0]
+	if cpu.Version != "Intel(R) Xeon(R) Platinum 8272CL CPU @ 2.60GHz" || cpu.MaxSpeed != 3700 || cpu.CurrentSpeed != 2600 || !cpu.Populated {
+		t.Errorf("Processor = %+v", cpu)
+	}
+	if cpu.Family != 0xB3 || cpu.CoreCount != 300 || cpu.ThreadCount != 600 {
+		t.Errorf("Processor family 0x%X, %d cores, %d threads", cpu.Family, cpu.CoreCount, cpu.ThreadCount)
+	}
+
+	tests := []struct {
+		locator   string
+		size      uint64
+		installed bool
+		memType   string
+	}{
+		{"DIMM 0", 64 << 30, true, "DDR4"},
+		{"DIMM 1", 16 << 30, true, "DDR4"},
+		{"DIMM 2", 0, false, "Unknown"},
+	}
+	if len(table.MemoryDevices) != len(tests) {
+		t.Fatalf("got %d memory devices, want %d", len(table.MemoryDevices), len(tests))
+	}
+	for i, tt := range tests {
+		device := table.MemoryDevices[i]
+		if device.DeviceLocator != tt.locator || device.Size != tt.size || device.Installed() != tt.installed || device.MemoryType.String() != tt.memType {
+			t.Errorf("MemoryDevices[%d] = %+v", i, device)
+		}
+	}
+	if dimm := table.MemoryDevices[0]; dimm.PartNumber != "M393A4K40DB3-CWE" || dimm.Speed != 3200 || dimm.ConfiguredSpeed != 2933 || dimm.Rank != 2 {
+		t.Errorf("MemoryDevices[0] = %+v", dimm)
+	}
+}
+
+// TestParseSMBIOS_Truncated tests rejection of malformed tables
+func TestParseSMBIOS_Truncated(t *testing.T) {
+	raw, err := os.ReadFile("testdata/rsmb.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"header", raw[:4]},
+		{"length", raw[:100]},
+	}
+	for _, tt := range tests {
+		if _, err := ParseSMBIOS(tt.data); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+			t.Errorf("%s: ParseSMBIOS() error = %v, want STATUS_BUFFER_TOO_SMALL", tt.name, err)
+		}
+	}
+
+	// a string set that runs off the end of the table
+	if _, err := ParseSMBIOSTable(raw[8:60], 3, 2); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("ParseSMBIOSTable(unterminated) error = %v, want STATUS_BUFFER_TOO_SMALL", err)
+	}
+}
+
+// TestChassisType_String tests enclosure type names
+func TestChassisType_String(t *testing.T) {
+	tests := []struct {
+		chassis ChassisType
+		want    string
+	}{
+		{3, "Desktop"},
+		{23, "Rack Mount Chassis"},
+		{0, "ChassisType(0)"},
+		{99, "ChassisType(99)"},
+	}
+	for _, tt := range tests {
+		if got := tt.chassis.String(); got != tt.want {
+			t.Errorf("ChassisType(%d).String() = %q, want %q", uint8(tt.chassis), got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package handle
+
+// ObjectNamer supplies the names of the objects referenced by handle table
+// entries. ntdll.HandleNamer implements it with NtQueryObject.
+type ObjectNamer interface {
+	// TypeName returns the name of an object type index, e.g. "File"
+	TypeName(typeIndex uint16) string
+
+	// ObjectName returns the name of the object behind the handle of entry,
+	// or "" if the object is unnamed
+	ObjectName(entry SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX) (string, error)
+}
+
+// NamedEntry is a handle table entry with the names of its object
+type NamedEntry struct {
+	SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX
+	TypeName   string // e.g. "File", "Key", "Process"
+	ObjectName string // e.g. \REGISTRY\MACHINE\SOFTWARE; "" if unnamed or unknown
+	NameErr    error  // why ObjectName could not be queried
+}
+
+// Enrich names the types and objects of entries with namer. Querying object
+// names needs a call per handle, so filter entries first, for example by
+// process. Entries whose name cannot be queried keep the error in NameErr.
+func Enrich(entries []SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX, namer ObjectNamer) []NamedEntry {
+	named := make([]NamedEntry, len(entries))
+	for i, entry := range entries {
+		named[i] = NamedEntry{SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX: entry, TypeName: namer.TypeName(entry.ObjectTypeIndex)}
+		named[i].ObjectName, named[i].NameErr = namer.ObjectName(entry)
+	}
+	return named
+}
+
+// EnrichTypes names only the types of entries, which needs no system calls
+// once the type table is known.
+func EnrichTypes(entries []SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX, typeName func(typeIndex uint16) string) []NamedEntry {
+	named := make([]NamedEntry, len(entries))
+	for i, entry := range entries {
+		named[i] = NamedEntry{SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX: entry, TypeName: typeName(entry.ObjectTypeIndex)}
+	}
+	return named
+}
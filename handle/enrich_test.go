@@ -0,0 +1,61 @@
+package handle
+
+import (
+	"errors"
+	"testing"
+)
+
+// fakeNamer names objects from fixed tables
+type fakeNamer struct {
+	types map[uint16]string
+	names map[uintptr]string
+}
+
+func (namer fakeNamer) TypeName(typeIndex uint16) string {
+	return namer.types[typeIndex]
+}
+
+func (namer fakeNamer) ObjectName(entry SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX) (string, error) {
+	if name, ok := namer.names[entry.HandleValue]; ok {
+		return name, nil
+	}
+	return "", errors.New("access denied")
+}
+
+// TestEnrich tests naming handle table entries
+func TestEnrich(t *testing.T) {
+	namer := fakeNamer{
+		types: map[uint16]string{37: "File", 44: "Key"},
+		names: map[uintptr]string{0x4: `\Device\HarddiskVolume3\Windows`, 0x8: `\REGISTRY\MACHINE`},
+	}
+	entries := []SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX{
+		{UniqueProcessId: 100, HandleValue: 0x4, ObjectTypeIndex: 37},
+		{UniqueProcessId: 100, HandleValue: 0x8, ObjectTypeIndex: 44},
+		{UniqueProcessId: 100, HandleValue: 0xC, ObjectTypeIndex: 44},
+	}
+
+	named := Enrich(entries, namer)
+	if len(named) != len(entries) {
+		t.Fatalf("Enrich() returned %d entries, want %d", len(named), len(entries))
+	}
+	tests := []struct {
+		typeName   string
+		objectName string
+		wantErr    bool
+	}{
+		{"File", `\Device\HarddiskVolume3\Windows`, false},
+		{"Key", `\REGISTRY\MACHINE`, false},
+		{"Key", "", true},
+	}
+	for i, tt := range tests {
+		got := named[i]
+		if got.SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX != entries[i] || got.TypeName != tt.typeName || got.ObjectName != tt.objectName || (got.NameErr != nil) != tt.wantErr {
+			t.Errorf("entry %d = %+v", i, got)
+		}
+	}
+
+	typed := EnrichTypes(entries, namer.TypeName)
+	if typed[0].TypeName != "File" || typed[1].TypeName != "Key" || typed[0].ObjectName != "" || typed[0].NameErr != nil {
+		t.Errorf("EnrichTypes() = %+v", typed)
+	}
+}
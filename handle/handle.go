@@ -9,6 +9,14 @@ type HANDLE uintptr
 // InvalidHandleValue is the constant representing an invalid handle (-1).
 const InvalidHandleValue = ^HANDLE(0)
 
+// Pseudo handles to the calling process and thread, as returned by
+// GetCurrentProcess and GetCurrentThread. CurrentProcess has the same value as
+// InvalidHandleValue, so IsValidHandle reports false for it.
+const (
+	CurrentProcess = ^HANDLE(0) // -1
+	CurrentThread  = ^HANDLE(1) // -2
+)
+
 // IsValidHandle returns true if the handle is valid (non-zero and not INVALID_HANDLE_VALUE).
 func (h HANDLE) IsValidHandle() bool {
 	return h != 0 && h != InvalidHandleValue
@@ -1,11 +1,17 @@
 package handle
 
-import "unsafe"
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/internal/layout"
+)
 
 // SYSTEM_HANDLE_TABLE_ENTRY_INFO represents a single entry in the system handle table
 type SYSTEM_HANDLE_TABLE_ENTRY_INFO struct {
 	UniqueProcessId      uint16
-	CreateBackTraceIndex uint8
+	CreateBackTraceIndex uint16
 	ObjectTypeIndex      uint8
 	HandleAttributes     uint8
 	HandleValue          uint16
@@ -39,7 +45,9 @@ type SYSTEM_HANDLE_INFORMATION_EX struct {
 	Handles         unsafe.Pointer // SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX[1]
 }
 
-// HandlesSlice converts the handle table to a Go slice for easier iteration
+// HandlesSlice converts the handle table to a Go slice for easier iteration.
+// It is only valid for tables built in Go; buffers returned by the kernel hold
+// the entries inline and must be decoded with DecodeHandleInformation.
 func (table *SYSTEM_HANDLE_INFORMATION) HandlesSlice() []SYSTEM_HANDLE_TABLE_ENTRY_INFO {
 	if table.NumberOfHandles == 0 {
 		return nil
@@ -47,10 +55,122 @@ func (table *SYSTEM_HANDLE_INFORMATION) HandlesSlice() []SYSTEM_HANDLE_TABLE_ENT
 	return unsafe.Slice((*SYSTEM_HANDLE_TABLE_ENTRY_INFO)(table.Handles), table.NumberOfHandles)
 }
 
-// HandlesSlice converts the extended handle table to a Go slice for easier iteration
+// HandlesSlice converts the extended handle table to a Go slice for easier iteration.
+// It is only valid for tables built in Go; buffers returned by the kernel hold
+// the entries inline and must be decoded with DecodeHandleInformationEx.
 func (table *SYSTEM_HANDLE_INFORMATION_EX) HandlesSlice() []SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX {
 	if table.NumberOfHandles == 0 {
 		return nil
 	}
 	return unsafe.Slice((*SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX)(table.Handles), table.NumberOfHandles)
 }
+
+// Native sizes of the handle table entries for 32-bit and 64-bit layouts
+const (
+	handleEntrySize32   = 0x10
+	handleEntrySize64   = 0x18
+	handleEntryExSize32 = 0x1C
+	handleEntryExSize64 = 0x28
+)
+
+// DecodeHandleInformation decodes the buffer returned by
+// NtQuerySystemInformation(SystemHandleInformation). This legacy class
+// truncates process IDs to 16 bits; prefer DecodeHandleInformationEx.
+//
+// Parameters:
+//   - buf: the returned buffer
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the handle table entries
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if the buffer is shorter
+//     than NumberOfHandles entries
+func DecodeHandleInformation(buf []byte, pointerSize int) ([]SYSTEM_HANDLE_TABLE_ENTRY_INFO, error) {
+	entrySize := handleEntrySize64
+	if pointerSize == 4 {
+		entrySize = handleEntrySize32
+	}
+
+	r := layout.NewReader(buf, 0, pointerSize)
+	count := uint64(r.Uint32())
+	r.Align(pointerSize)
+	if err := checkHandleCount(r, count, entrySize, len(buf)); err != nil {
+		return nil, err
+	}
+
+	entries := make([]SYSTEM_HANDLE_TABLE_ENTRY_INFO, count)
+	for i := range entries {
+		e := &entries[i]
+		e.UniqueProcessId = r.Uint16()
+		e.CreateBackTraceIndex = r.Uint16()
+		e.ObjectTypeIndex = r.Uint8()
+		e.HandleAttributes = r.Uint8()
+		e.HandleValue = r.Uint16()
+		e.Object = uintptr(r.Pointer())
+		e.GrantedAccess = r.Uint32()
+		r.Align(pointerSize)
+	}
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// DecodeHandleInformationEx decodes the buffer returned by
+// NtQuerySystemInformation(SystemExtendedHandleInformation).
+//
+// Parameters:
+//   - buf: the returned buffer
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the handle table entries
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if the buffer is shorter
+//     than NumberOfHandles entries
+func DecodeHandleInformationEx(buf []byte, pointerSize int) ([]SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX, error) {
+	entrySize := handleEntryExSize64
+	if pointerSize == 4 {
+		entrySize = handleEntryExSize32
+	}
+
+	r := layout.NewReader(buf, 0, pointerSize)
+	count := r.Pointer()
+	r.Pointer() // Reserved
+	if err := checkHandleCount(r, count, entrySize, len(buf)); err != nil {
+		return nil, err
+	}
+
+	entries := make([]SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX, count)
+	for i := range entries {
+		e := &entries[i]
+		e.Object = uintptr(r.Pointer())
+		e.UniqueProcessId = uintptr(r.Pointer())
+		e.HandleValue = uintptr(r.Pointer())
+		e.GrantedAccess = r.Uint32()
+		e.CreatorBackTraceIndex = r.Uint16()
+		e.ObjectTypeIndex = r.Uint16()
+		e.HandleAttributes = r.Uint32()
+		e.Reserved = r.Uint32()
+		r.Align(pointerSize)
+	}
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// checkHandleCount verifies that count entries of entrySize bytes follow the
+// header, before any memory is allocated for them
+func checkHandleCount(r *layout.Reader, count uint64, entrySize, bufLen int) error {
+	if err := r.Err(); err != nil {
+		return err
+	}
+	available := uint64(0)
+	if bufLen > r.Offset() {
+		available = uint64(bufLen-r.Offset()) / uint64(entrySize)
+	}
+	if count > available {
+		return winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("handle table declares %d entries but the buffer holds %d", count, available))
+	}
+	return nil
+}
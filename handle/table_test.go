@@ -1,8 +1,12 @@
 package handle
 
 import (
+	"encoding/binary"
+	"errors"
 	"testing"
 	"unsafe"
+
+	"github.com/ArkaprabhaChakraborty/winx"
 )
 
 // TestSYSTEM_HANDLE_INFORMATION_HandlesSlice tests the HandlesSlice method
@@ -149,7 +153,7 @@ func TestSYSTEM_HANDLE_INFORMATION_EX_HandlesSlice(t *testing.T) {
 // TestStructSizes verifies the struct sizes are as expected
 func TestStructSizes(t *testing.T) {
 	t.Run("SYSTEM_HANDLE_TABLE_ENTRY_INFO size", func(t *testing.T) {
-		// Expected size: 2+1+1+1+2+8+4 = 19 bytes (plus padding)
+		// Expected size: 2+2+1+1+2+8+4 = 20 bytes (24 with padding)
 		size := unsafe.Sizeof(SYSTEM_HANDLE_TABLE_ENTRY_INFO{})
 		if size == 0 {
 			t.Error("SYSTEM_HANDLE_TABLE_ENTRY_INFO size should not be zero")
@@ -183,6 +187,116 @@ func TestStructSizes(t *testing.T) {
 	})
 }
 
+// TestDecodeHandleInformationEx tests decoding of inline 32-bit and 64-bit entries
+func TestDecodeHandleInformationEx(t *testing.T) {
+	tests := []struct {
+		pointerSize int
+		buf         []byte
+	}{
+		{4, handleExBuffer(4)},
+		{8, handleExBuffer(8)},
+	}
+
+	for _, tt := range tests {
+		entries, err := DecodeHandleInformationEx(tt.buf, tt.pointerSize)
+		if err != nil {
+			t.Fatalf("pointerSize %d: DecodeHandleInformationEx() error = %v", tt.pointerSize, err)
+		}
+		if len(entries) != 2 {
+			t.Fatalf("pointerSize %d: got %d entries, want 2", tt.pointerSize, len(entries))
+		}
+		e := entries[1]
+		if e.Object != 0x81000000 || e.UniqueProcessId != 70000 || e.HandleValue != 0x1F8 {
+			t.Errorf("pointerSize %d: entry = %+v", tt.pointerSize, e)
+		}
+		if e.GrantedAccess != 0x1F0FFF || e.CreatorBackTraceIndex != 3 || e.ObjectTypeIndex != 7 || e.HandleAttributes != 2 {
+			t.Errorf("pointerSize %d: entry = %+v", tt.pointerSize, e)
+		}
+	}
+}
+
+// handleExBuffer builds a SYSTEM_HANDLE_INFORMATION_EX buffer with two entries
+func handleExBuffer(pointerSize int) []byte {
+	ptr := func(b []byte, v uint64) []byte {
+		if pointerSize == 4 {
+			return binary.LittleEndian.AppendUint32(b, uint32(v))
+		}
+		return binary.LittleEndian.AppendUint64(b, v)
+	}
+
+	buf := ptr(nil, 2)
+	buf = ptr(buf, 0)
+	for i := uint64(0); i < 2; i++ {
+		buf = ptr(buf, 0x80000000+i*0x1000000)
+		buf = ptr(buf, 4+i*69996)
+		buf = ptr(buf, 0x4+i*0x1F4)
+		buf = binary.LittleEndian.AppendUint32(buf, 0x1F0FFF)
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(i*3))
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(i*7))
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(i*2))
+		buf = binary.LittleEndian.AppendUint32(buf, 0)
+	}
+	return buf
+}
+
+// TestDecodeHandleInformation tests decoding of the legacy 16-bit PID layout
+func TestDecodeHandleInformation(t *testing.T) {
+	tests := []struct {
+		pointerSize int
+		entrySize   int
+		header      int
+	}{
+		{4, 0x10, 4},
+		{8, 0x18, 8},
+	}
+
+	for _, tt := range tests {
+		buf := make([]byte, tt.header+tt.entrySize)
+		binary.LittleEndian.PutUint32(buf, 1)
+		entry := buf[tt.header:]
+		binary.LittleEndian.PutUint16(entry[0:], 1234)
+		binary.LittleEndian.PutUint16(entry[2:], 9)
+		entry[4] = 37
+		entry[5] = 1
+		binary.LittleEndian.PutUint16(entry[6:], 0x44)
+		if tt.pointerSize == 4 {
+			binary.LittleEndian.PutUint32(entry[8:], 0x8A000000)
+			binary.LittleEndian.PutUint32(entry[12:], 0x120089)
+		} else {
+			binary.LittleEndian.PutUint64(entry[8:], 0x8A000000)
+			binary.LittleEndian.PutUint32(entry[16:], 0x120089)
+		}
+
+		entries, err := DecodeHandleInformation(buf, tt.pointerSize)
+		if err != nil || len(entries) != 1 {
+			t.Fatalf("pointerSize %d: DecodeHandleInformation() = %v, %v", tt.pointerSize, entries, err)
+		}
+		want := SYSTEM_HANDLE_TABLE_ENTRY_INFO{1234, 9, 37, 1, 0x44, 0x8A000000, 0x120089}
+		if entries[0] != want {
+			t.Errorf("pointerSize %d: entry = %+v, want %+v", tt.pointerSize, entries[0], want)
+		}
+	}
+}
+
+// TestDecodeHandleInformation_Truncated tests bounds checking against NumberOfHandles
+func TestDecodeHandleInformation_Truncated(t *testing.T) {
+	buf := handleExBuffer(8)
+	for _, n := range []int{0, 12, len(buf) - 1} {
+		if _, err := DecodeHandleInformationEx(buf[:n], 8); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+			t.Errorf("DecodeHandleInformationEx(%d bytes) error = %v, want STATUS_BUFFER_TOO_SMALL", n, err)
+		}
+	}
+
+	huge := make([]byte, 8)
+	binary.LittleEndian.PutUint32(huge, 0xFFFFFFFF)
+	if _, err := DecodeHandleInformation(huge, 8); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("DecodeHandleInformation(huge count) error = %v, want STATUS_BUFFER_TOO_SMALL", err)
+	}
+	if entries, err := DecodeHandleInformation(make([]byte, 8), 8); err != nil || len(entries) != 0 {
+		t.Errorf("DecodeHandleInformation(empty table) = %v, %v", entries, err)
+	}
+}
+
 // BenchmarkHandlesSlice benchmarks the HandlesSlice method
 func BenchmarkHandlesSlice(b *testing.B) {
 	handles := make([]SYSTEM_HANDLE_TABLE_ENTRY_INFO, 100)
@@ -1,10 +1,11 @@
 package heap
 
 import (
-	"syscall"
 	"unsafe"
 
+	"github.com/ArkaprabhaChakraborty/winx"
 	"github.com/ArkaprabhaChakraborty/winx/handle"
+	"github.com/ArkaprabhaChakraborty/winx/internal/proc"
 )
 
 // This package contains heap-related Windows API functions and types.
@@ -25,16 +26,19 @@ const (
 )
 
 var (
-	kernel32           = syscall.NewLazyDLL("kernel32.dll")
-	procHeapCreate     = kernel32.NewProc("HeapCreate")
-	procHeapDestroy    = kernel32.NewProc("HeapDestroy")
-	procHeapAlloc      = kernel32.NewProc("HeapAlloc")
-	procHeapReAlloc    = kernel32.NewProc("HeapReAlloc")
-	procHeapFree       = kernel32.NewProc("HeapFree")
-	procHeapSize       = kernel32.NewProc("HeapSize")
-	procHeapValidate   = kernel32.NewProc("HeapValidate")
-	procGetProcessHeap = kernel32.NewProc("GetProcessHeap")
-	procGetProcessHeaps = kernel32.NewProc("GetProcessHeaps")
+	procHeapCreate     = proc.Kernel32.Proc("HeapCreate")
+	procHeapDestroy    = proc.Kernel32.Proc("HeapDestroy")
+	procHeapAlloc      = proc.Kernel32.Proc("HeapAlloc")
+	procHeapReAlloc    = proc.Kernel32.Proc("HeapReAlloc")
+	procHeapFree       = proc.Kernel32.Proc("HeapFree")
+	procHeapSize       = proc.Kernel32.Proc("HeapSize")
+	procHeapValidate   = proc.Kernel32.Proc("HeapValidate")
+	procGetProcessHeap = proc.Kernel32.Proc("GetProcessHeap")
+	procGetProcessHeaps = proc.Kernel32.Proc("GetProcessHeaps")
+	procHeapWalk       = proc.Kernel32.Proc("HeapWalk")
+	procHeapLock       = proc.Kernel32.Proc("HeapLock")
+	procHeapUnlock     = proc.Kernel32.Proc("HeapUnlock")
+	procHeapCompact    = proc.Kernel32.Proc("HeapCompact")
 )
 
 // HeapCreate creates a private heap object that can be used by the calling process.
@@ -48,8 +52,9 @@ var (
 // Returns:
 //   - A handle to the newly created heap if successful, 0 otherwise.
 func HeapCreate(flOptions uint32, dwInitialSize uintptr, dwMaximumSize uintptr) handle.HANDLE {
-	ret, _, _ := syscall.SyscallN(
-		procHeapCreate.Addr(),
+	ret, _, _ := procHeapCreate.Call(
+		winx.Call{API: "HeapCreate"},
+		winx.ReturnsPointer,
 		uintptr(flOptions),
 		dwInitialSize,
 		dwMaximumSize,
@@ -66,8 +71,9 @@ func HeapCreate(flOptions uint32, dwInitialSize uintptr, dwMaximumSize uintptr)
 // Returns:
 //   - true if successful, false otherwise.
 func HeapDestroy(hHeap handle.HANDLE) bool {
-	ret, _, _ := syscall.SyscallN(
-		procHeapDestroy.Addr(),
+	ret, _, _ := procHeapDestroy.Call(
+		winx.Call{API: "HeapDestroy"},
+		winx.ReturnsBOOL,
 		uintptr(hHeap),
 	)
 	return ret != 0
@@ -84,8 +90,9 @@ func HeapDestroy(hHeap handle.HANDLE) bool {
 // Returns:
 //   - A pointer to the allocated memory block if successful, nil otherwise.
 func HeapAlloc(hHeap handle.HANDLE, dwFlags uint32, dwBytes uintptr) unsafe.Pointer {
-	ret, _, _ := syscall.SyscallN(
-		procHeapAlloc.Addr(),
+	ret, _, _ := procHeapAlloc.Call(
+		winx.Call{API: "HeapAlloc"},
+		winx.ReturnsPointer,
 		uintptr(hHeap),
 		uintptr(dwFlags),
 		dwBytes,
@@ -108,8 +115,9 @@ func HeapAlloc(hHeap handle.HANDLE, dwFlags uint32, dwBytes uintptr) unsafe.Poin
 // Returns:
 //   - A pointer to the reallocated memory block if successful, nil otherwise.
 func HeapReAlloc(hHeap handle.HANDLE, dwFlags uint32, lpMem unsafe.Pointer, dwBytes uintptr) unsafe.Pointer {
-	ret, _, _ := syscall.SyscallN(
-		procHeapReAlloc.Addr(),
+	ret, _, _ := procHeapReAlloc.Call(
+		winx.Call{API: "HeapReAlloc"},
+		winx.ReturnsPointer,
 		uintptr(hHeap),
 		uintptr(dwFlags),
 		uintptr(lpMem),
@@ -131,8 +139,9 @@ func HeapReAlloc(hHeap handle.HANDLE, dwFlags uint32, lpMem unsafe.Pointer, dwBy
 // Returns:
 //   - true if successful, false otherwise.
 func HeapFree(hHeap handle.HANDLE, dwFlags uint32, lpMem unsafe.Pointer) bool {
-	ret, _, _ := syscall.SyscallN(
-		procHeapFree.Addr(),
+	ret, _, _ := procHeapFree.Call(
+		winx.Call{API: "HeapFree"},
+		winx.ReturnsBOOL,
 		uintptr(hHeap),
 		uintptr(dwFlags),
 		uintptr(lpMem),
@@ -150,8 +159,9 @@ func HeapFree(hHeap handle.HANDLE, dwFlags uint32, lpMem unsafe.Pointer) bool {
 // Returns:
 //   - The size of the allocated memory block, in bytes, or ^uintptr(0) on failure.
 func HeapSize(hHeap handle.HANDLE, dwFlags uint32, lpMem unsafe.Pointer) uintptr {
-	ret, _, _ := syscall.SyscallN(
-		procHeapSize.Addr(),
+	ret, _, _ := procHeapSize.Call(
+		winx.Call{API: "HeapSize"},
+		winx.ReturnsVoid,
 		uintptr(hHeap),
 		uintptr(dwFlags),
 		uintptr(lpMem),
@@ -170,8 +180,9 @@ func HeapSize(hHeap handle.HANDLE, dwFlags uint32, lpMem unsafe.Pointer) uintptr
 // Returns:
 //   - true if the specified heap is valid, false otherwise.
 func HeapValidate(hHeap handle.HANDLE, dwFlags uint32, lpMem unsafe.Pointer) bool {
-	ret, _, _ := syscall.SyscallN(
-		procHeapValidate.Addr(),
+	ret, _, _ := procHeapValidate.Call(
+		winx.Call{API: "HeapValidate"},
+		winx.ReturnsBOOL,
 		uintptr(hHeap),
 		uintptr(dwFlags),
 		uintptr(lpMem),
@@ -185,8 +196,9 @@ func HeapValidate(hHeap handle.HANDLE, dwFlags uint32, lpMem unsafe.Pointer) boo
 // Returns:
 //   - A handle to the calling process's heap.
 func GetProcessHeap() handle.HANDLE {
-	ret, _, _ := syscall.SyscallN(
-		procGetProcessHeap.Addr(),
+	ret, _, _ := procGetProcessHeap.Call(
+		winx.Call{API: "GetProcessHeap"},
+		winx.ReturnsPointer,
 	)
 	return handle.HANDLE(ret)
 }
@@ -205,8 +217,9 @@ func GetProcessHeaps(numberOfHeaps uint32, processHeaps []handle.HANDLE) uint32
 		heapArrayPtr = uintptr(unsafe.Pointer(&processHeaps[0]))
 	}
 
-	ret, _, _ := syscall.SyscallN(
-		procGetProcessHeaps.Addr(),
+	ret, _, _ := procGetProcessHeaps.Call(
+		winx.Call{API: "GetProcessHeaps"},
+		winx.ReturnsPointer,
 		uintptr(numberOfHeaps),
 		heapArrayPtr,
 	)
@@ -223,9 +236,9 @@ func GetProcessHeaps(numberOfHeaps uint32, processHeaps []handle.HANDLE) uint32
 // Returns:
 //   - true if the function succeeds, false otherwise.
 func HeapWalk(hHeap handle.HANDLE, entry *PROCESS_HEAP_ENTRY) bool {
-	var procHeapWalk = kernel32.NewProc("HeapWalk")
-	ret, _, _ := syscall.SyscallN(
-		procHeapWalk.Addr(),
+	ret, _, _ := procHeapWalk.Call(
+		winx.Call{API: "HeapWalk"},
+		winx.ReturnsBOOL,
 		uintptr(hHeap),
 		uintptr(unsafe.Pointer(entry)),
 	)
@@ -241,9 +254,9 @@ func HeapWalk(hHeap handle.HANDLE, entry *PROCESS_HEAP_ENTRY) bool {
 // Returns:
 //   - true if successful, false otherwise.
 func HeapLock(hHeap handle.HANDLE) bool {
-	var procHeapLock = kernel32.NewProc("HeapLock")
-	ret, _, _ := syscall.SyscallN(
-		procHeapLock.Addr(),
+	ret, _, _ := procHeapLock.Call(
+		winx.Call{API: "HeapLock"},
+		winx.ReturnsBOOL,
 		uintptr(hHeap),
 	)
 	return ret != 0
@@ -257,9 +270,9 @@ func HeapLock(hHeap handle.HANDLE) bool {
 // Returns:
 //   - true if successful, false otherwise.
 func HeapUnlock(hHeap handle.HANDLE) bool {
-	var procHeapUnlock = kernel32.NewProc("HeapUnlock")
-	ret, _, _ := syscall.SyscallN(
-		procHeapUnlock.Addr(),
+	ret, _, _ := procHeapUnlock.Call(
+		winx.Call{API: "HeapUnlock"},
+		winx.ReturnsBOOL,
 		uintptr(hHeap),
 	)
 	return ret != 0
@@ -274,9 +287,9 @@ func HeapUnlock(hHeap handle.HANDLE) bool {
 // Returns:
 //   - The size of the largest committed free block in the heap, or 0 on failure.
 func HeapCompact(hHeap handle.HANDLE, dwFlags uint32) uintptr {
-	var procHeapCompact = kernel32.NewProc("HeapCompact")
-	ret, _, _ := syscall.SyscallN(
-		procHeapCompact.Addr(),
+	ret, _, _ := procHeapCompact.Call(
+		winx.Call{API: "HeapCompact"},
+		winx.ReturnsPointer,
 		uintptr(hHeap),
 		uintptr(dwFlags),
 	)
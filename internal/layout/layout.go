@@ -0,0 +1,147 @@
+// Package layout reads C structures out of byte buffers returned by NT APIs.
+//
+// Fields are read in declaration order and aligned to their natural size the
+// way the Windows compilers lay them out, so a structure can be decoded for a
+// 32-bit or 64-bit target on any host by reading its fields one after another.
+package layout
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// Reader decodes little-endian fields from a buffer.
+// The first out of range read sets a sticky error reported by Err; later
+// reads return zero values, so a whole structure can be read before checking.
+type Reader struct {
+	buf         []byte
+	off         int
+	pointerSize int
+	err         error
+}
+
+// NewReader returns a Reader positioned at offset in buf.
+// pointerSize must be 4 or 8 and selects the size of pointer and SIZE_T fields.
+func NewReader(buf []byte, offset, pointerSize int) *Reader {
+	r := &Reader{buf: buf, off: offset, pointerSize: pointerSize}
+	if pointerSize != 4 && pointerSize != 8 {
+		r.err = winx.NewNTStatusError(winx.STATUS_INVALID_PARAMETER, fmt.Sprintf("unsupported pointer size %d", pointerSize))
+	}
+	return r
+}
+
+// Err returns the first error encountered while reading, if any.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// Offset returns the current position in the buffer.
+func (r *Reader) Offset() int {
+	return r.off
+}
+
+// PointerSize returns the pointer size the Reader was created with.
+func (r *Reader) PointerSize() int {
+	return r.pointerSize
+}
+
+// Align advances the position to the next multiple of n.
+func (r *Reader) Align(n int) {
+	if rem := r.off % n; rem != 0 {
+		r.off += n - rem
+	}
+}
+
+// Skip advances the position by n bytes.
+func (r *Reader) Skip(n int) {
+	r.off += n
+}
+
+// Bytes returns the next n bytes without copying them.
+func (r *Reader) Bytes(n int) []byte {
+	return r.take(n)
+}
+
+// Uint8 reads a UCHAR.
+func (r *Reader) Uint8() uint8 {
+	if b := r.take(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+// Uint16 reads an aligned USHORT.
+func (r *Reader) Uint16() uint16 {
+	r.Align(2)
+	if b := r.take(2); b != nil {
+		return binary.LittleEndian.Uint16(b)
+	}
+	return 0
+}
+
+// Uint32 reads an aligned ULONG.
+func (r *Reader) Uint32() uint32 {
+	r.Align(4)
+	if b := r.take(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+	return 0
+}
+
+// Int32 reads an aligned LONG.
+func (r *Reader) Int32() int32 {
+	return int32(r.Uint32())
+}
+
+// Uint64 reads an aligned ULONGLONG. LARGE_INTEGER fields are 8-byte aligned
+// on both 32-bit and 64-bit targets.
+func (r *Reader) Uint64() uint64 {
+	r.Align(8)
+	if b := r.take(8); b != nil {
+		return binary.LittleEndian.Uint64(b)
+	}
+	return 0
+}
+
+// Int64 reads an aligned LONGLONG or LARGE_INTEGER.
+func (r *Reader) Int64() int64 {
+	return int64(r.Uint64())
+}
+
+// Pointer reads an aligned pointer, HANDLE, SIZE_T or ULONG_PTR field.
+func (r *Reader) Pointer() uint64 {
+	if r.pointerSize == 4 {
+		return uint64(r.Uint32())
+	}
+	return r.Uint64()
+}
+
+// UnicodeString reads an embedded UNICODE_STRING whose buffer points into the
+// same buffer, which was located at address base when it was filled.
+func (r *Reader) UnicodeString(base uintptr) string {
+	r.Align(r.pointerSize)
+	offset := r.off
+	if r.take(winx.UnicodeStringSize(r.pointerSize)) == nil {
+		return ""
+	}
+	s, err := winx.DecodeUnicodeString(r.buf, offset, base, r.pointerSize)
+	if err != nil {
+		r.err = err
+	}
+	return s
+}
+
+func (r *Reader) take(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if r.off < 0 || n < 0 || r.off+n > len(r.buf) {
+		r.err = winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("read of %d bytes at offset %d is outside the %d byte buffer", n, r.off, len(r.buf)))
+		return nil
+	}
+	b := r.buf[r.off : r.off+n]
+	r.off += n
+	return b
+}
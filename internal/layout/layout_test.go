@@ -0,0 +1,50 @@
+package layout
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// TestReader_Alignment tests natural alignment for 32-bit and 64-bit layouts
+func TestReader_Alignment(t *testing.T) {
+	// struct { UCHAR a; ULONG b; PVOID c; LARGE_INTEGER d; }
+	buf := []byte{
+		0x01, 0, 0, 0, 0x02, 0, 0, 0,
+		0x03, 0, 0, 0, 0, 0, 0, 0,
+		0x04, 0, 0, 0, 0, 0, 0, 0,
+	}
+	tests := []struct {
+		pointerSize int
+		c, d        uint64
+		end         int
+	}{
+		{4, 3, 4, 24},
+		{8, 3, 4, 24},
+	}
+
+	for _, tt := range tests {
+		r := NewReader(buf, 0, tt.pointerSize)
+		a, b, c, d := r.Uint8(), r.Uint32(), r.Pointer(), r.Uint64()
+		if err := r.Err(); err != nil {
+			t.Fatalf("pointerSize %d: Err() = %v", tt.pointerSize, err)
+		}
+		if a != 1 || b != 2 || c != tt.c || d != tt.d || r.Offset() != tt.end {
+			t.Errorf("pointerSize %d: got %d %d %d %d at offset %d", tt.pointerSize, a, b, c, d, r.Offset())
+		}
+	}
+}
+
+// TestReader_Errors tests the sticky out of range error
+func TestReader_Errors(t *testing.T) {
+	r := NewReader(make([]byte, 6), 0, 8)
+	r.Uint32()
+	if v := r.Uint32(); v != 0 || !errors.Is(r.Err(), winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("Uint32() past end = %d, Err() = %v", v, r.Err())
+	}
+
+	if err := NewReader(nil, 0, 2).Err(); !errors.Is(err, winx.STATUS_INVALID_PARAMETER) {
+		t.Errorf("NewReader(pointerSize 2).Err() = %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+//go:build windows
+
+// Package proc resolves DLL exports once and shares them between packages.
+//
+// A missing DLL or export is reported as a *winx.ProcNotFoundError instead of
+// the panic raised by syscall.LazyProc, so callers can probe for functions
+// added in later Windows builds and degrade gracefully.
+package proc
+
+import (
+	"sync"
+	"syscall"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// DLL is a lazily loaded system DLL
+type DLL struct {
+	Name string
+
+	once  sync.Once
+	dll   *syscall.DLL
+	err   error
+	mu    sync.Mutex
+	procs map[string]*Proc
+}
+
+// Proc is a lazily resolved DLL export
+type Proc struct {
+	DLL  *DLL
+	Name string
+
+	once sync.Once
+	addr uintptr
+	err  error
+}
+
+var (
+	dllsMu sync.Mutex
+	dlls   = make(map[string]*DLL)
+)
+
+// Shared system DLLs
+var (
+	NTDLL    = LoadDLL("ntdll.dll")
+	Kernel32 = LoadDLL("kernel32.dll")
+	Advapi32 = LoadDLL("advapi32.dll")
+	SetupAPI = LoadDLL("setupapi.dll")
+)
+
+// LoadDLL returns the registry entry for a DLL. The DLL is loaded on first use
+// and every caller naming the same DLL shares the entry.
+func LoadDLL(name string) *DLL {
+	dllsMu.Lock()
+	defer dllsMu.Unlock()
+	if d, ok := dlls[name]; ok {
+		return d
+	}
+	d := &DLL{Name: name, procs: make(map[string]*Proc)}
+	dlls[name] = d
+	return d
+}
+
+// Load loads the DLL, once. It returns a *winx.ProcNotFoundError with an
+// empty Proc if the DLL cannot be loaded.
+func (d *DLL) Load() error {
+	d.once.Do(func() {
+		d.dll, d.err = syscall.LoadDLL(d.Name)
+		if d.err != nil {
+			var loadErr error = d.err
+			if dllErr, ok := d.err.(*syscall.DLLError); ok {
+				loadErr = dllErr.Err
+			}
+			d.err = &winx.ProcNotFoundError{DLL: d.Name, Err: loadErr}
+		}
+	})
+	return d.err
+}
+
+// Proc returns the registry entry for an export of the DLL. The export is
+// resolved on first use.
+func (d *DLL) Proc(name string) *Proc {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if p, ok := d.procs[name]; ok {
+		return p
+	}
+	p := &Proc{DLL: d, Name: name}
+	d.procs[name] = p
+	return p
+}
+
+// Find resolves the export, once. It returns a *winx.ProcNotFoundError if
+// the DLL or the export is missing.
+func (p *Proc) Find() error {
+	p.once.Do(func() {
+		if err := p.DLL.Load(); err != nil {
+			p.err = err
+			return
+		}
+		proc, err := p.DLL.dll.FindProc(p.Name)
+		if err != nil {
+			var findErr error = err
+			if dllErr, ok := err.(*syscall.DLLError); ok {
+				findErr = dllErr.Err
+			}
+			p.err = &winx.ProcNotFoundError{DLL: p.DLL.Name, Proc: p.Name, Err: findErr}
+			return
+		}
+		p.addr = proc.Addr()
+	})
+	return p.err
+}
+
+// Has reports whether the export exists on this system
+func (p *Proc) Has() bool {
+	return p.Find() == nil
+}
+
+// Addr returns the address of the export, or 0 and the error from Find
+func (p *Proc) Addr() (uintptr, error) {
+	if err := p.Find(); err != nil {
+		return 0, err
+	}
+	return p.addr, nil
+}
+
+// Call invokes the export through winx.SyscallN, so it is traced. A non-zero
+// last error is returned as err; whether the call failed is decided by r1.
+//
+// If the export is missing it is not called. r1 is then the failure value for
+// kind (STATUS_PROCEDURE_NOT_FOUND, FALSE, INVALID_HANDLE_VALUE or NULL) and
+// err is the *winx.ProcNotFoundError from Find.
+//
+// Like syscall.LazyProc.Call, Call keeps the memory behind pointer arguments
+// converted with uintptr(unsafe.Pointer(x)) in the call expression alive and
+// unmoved until it returns.
+//
+//go:uintptrescapes
+func (p *Proc) Call(call winx.Call, kind winx.ReturnKind, args ...uintptr) (r1, r2 uintptr, err error) {
+	addr, findErr := p.Addr()
+	if findErr != nil {
+		r1 = failureValue(kind)
+		if tracer := winx.ActiveTracer(); tracer != nil {
+			call.Args = args
+			call.Return = r1
+			call.Err = findErr
+			tracer.TraceCall(&call)
+		}
+		return r1, 0, findErr
+	}
+
+	r1, r2, errno := winx.SyscallN(call, kind, addr, args...)
+	if errno != 0 {
+		return r1, r2, errno
+	}
+	return r1, r2, nil
+}
+
+// failureValue returns the value a function of the given kind returns on failure
+func failureValue(kind winx.ReturnKind) uintptr {
+	switch kind {
+	case winx.ReturnsNTSTATUS:
+		return uintptr(winx.STATUS_PROCEDURE_NOT_FOUND)
+	case winx.ReturnsHANDLE:
+		return ^uintptr(0)
+	}
+	return 0
+}
@@ -0,0 +1,59 @@
+//go:build windows
+
+package proc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// TestRegistry tests that DLLs and exports are shared between callers
+func TestRegistry(t *testing.T) {
+	if LoadDLL("ntdll.dll") != NTDLL {
+		t.Error(`LoadDLL("ntdll.dll") returned a new entry`)
+	}
+	if NTDLL.Proc("NtClose") != NTDLL.Proc("NtClose") {
+		t.Error(`Proc("NtClose") returned a new entry`)
+	}
+	addr, err := NTDLL.Proc("NtClose").Addr()
+	if err != nil || addr == 0 {
+		t.Errorf("Addr() = 0x%X, %v", addr, err)
+	}
+}
+
+// TestMissing tests calling a missing export or DLL
+func TestMissing(t *testing.T) {
+	tests := []struct {
+		name   string
+		proc   *Proc
+		kind   winx.ReturnKind
+		want   uintptr
+		status winx.NTSTATUS
+	}{
+		{"NTSTATUS export", NTDLL.Proc("NtDoesNotExist"), winx.ReturnsNTSTATUS, uintptr(winx.STATUS_PROCEDURE_NOT_FOUND), winx.STATUS_PROCEDURE_NOT_FOUND},
+		{"HANDLE export", Kernel32.Proc("CreateDoesNotExistW"), winx.ReturnsHANDLE, ^uintptr(0), winx.STATUS_PROCEDURE_NOT_FOUND},
+		{"BOOL export", Kernel32.Proc("DoesNotExist"), winx.ReturnsBOOL, 0, winx.STATUS_PROCEDURE_NOT_FOUND},
+		{"missing DLL", LoadDLL("winx-does-not-exist.dll").Proc("Anything"), winx.ReturnsBOOL, 0, winx.STATUS_DLL_NOT_FOUND},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.proc.Has() {
+				t.Fatalf("Has() = true for %s", tt.proc.Name)
+			}
+			r1, _, err := tt.proc.Call(winx.Call{API: tt.proc.Name}, tt.kind, 1, 2)
+			if r1 != tt.want {
+				t.Errorf("Call() r1 = 0x%X, want 0x%X", r1, tt.want)
+			}
+			var procErr *winx.ProcNotFoundError
+			if !errors.As(err, &procErr) || procErr.DLL != tt.proc.DLL.Name {
+				t.Fatalf("Call() error = %v, want a *winx.ProcNotFoundError", err)
+			}
+			if !errors.Is(err, tt.status) {
+				t.Errorf("errors.Is(%v, %v) = false, want true", err, tt.status)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package winx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MemoryInformationClass identifies the kind of data requested from
+// NtQueryVirtualMemory (MEMORY_INFORMATION_CLASS).
+type MemoryInformationClass uint32
+
+// memoryInformationClassNames is indexed by class value
+var memoryInformationClassNames = [...]string{
+	MemoryBasicInformation:              "MemoryBasicInformation",
+	MemoryWorkingSetInformation:         "MemoryWorkingSetInformation",
+	MemoryMappedFilenameInformation:     "MemoryMappedFilenameInformation",
+	MemoryRegionInformation:             "MemoryRegionInformation",
+	MemoryWorkingSetExInformation:       "MemoryWorkingSetExInformation",
+	MemorySharedCommitInformation:       "MemorySharedCommitInformation",
+	MemoryImageInformation:              "MemoryImageInformation",
+	MemoryRegionInformationEx:           "MemoryRegionInformationEx",
+	MemoryPrivilegedBasicInformation:    "MemoryPrivilegedBasicInformation",
+	MemoryEnclaveImageInformation:       "MemoryEnclaveImageInformation",
+	MemoryBasicInformationCapped:        "MemoryBasicInformationCapped",
+	MemoryPhysicalContiguityInformation: "MemoryPhysicalContiguityInformation",
+	MemoryBadInformation:                "MemoryBadInformation",
+	MemoryBadInformationAllProcesses:    "MemoryBadInformationAllProcesses",
+}
+
+// String returns the name of the class (e.g. "MemoryBasicInformation"), or
+// "MemoryInformationClass(0x..)" for unknown values.
+func (c MemoryInformationClass) String() string {
+	if c.IsKnown() {
+		return memoryInformationClassNames[c]
+	}
+	return fmt.Sprintf("MemoryInformationClass(0x%X)", uint32(c))
+}
+
+// IsKnown reports whether the class is defined in this package.
+func (c MemoryInformationClass) IsKnown() bool {
+	return int(c) < len(memoryInformationClassNames)
+}
+
+// ParseMemoryInformationClass returns the class with the given name. Names
+// are matched case-insensitively and the "Memory" prefix may be omitted, so
+// "MemoryRegionInformation" and "RegionInformation" are equivalent. Numeric
+// values such as "0x3" or "3" are also accepted.
+func ParseMemoryInformationClass(name string) (MemoryInformationClass, error) {
+	name = strings.TrimSpace(name)
+	if value, err := strconv.ParseUint(name, 0, 32); err == nil {
+		return MemoryInformationClass(value), nil
+	}
+
+	for class, className := range memoryInformationClassNames {
+		if strings.EqualFold(className, name) || strings.EqualFold(className, "Memory"+name) {
+			return MemoryInformationClass(class), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown memory information class %q", name)
+}
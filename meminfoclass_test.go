@@ -0,0 +1,30 @@
+package winx
+
+import "testing"
+
+// TestMemoryInformationClass tests class names and parsing
+func TestMemoryInformationClass(t *testing.T) {
+	tests := []struct {
+		class MemoryInformationClass
+		want  string
+	}{
+		{MemoryBasicInformation, "MemoryBasicInformation"},
+		{MemoryMappedFilenameInformation, "MemoryMappedFilenameInformation"},
+		{MemoryBadInformationAllProcesses, "MemoryBadInformationAllProcesses"},
+		{MemoryInformationClass(0x40), "MemoryInformationClass(0x40)"},
+	}
+	for _, tt := range tests {
+		if got := tt.class.String(); got != tt.want {
+			t.Errorf("MemoryInformationClass(0x%X).String() = %q, want %q", uint32(tt.class), got, tt.want)
+		}
+	}
+
+	for _, name := range []string{"MemoryRegionInformation", "regioninformation", "0x3", "3"} {
+		if class, err := ParseMemoryInformationClass(name); err != nil || class != MemoryRegionInformation {
+			t.Errorf("ParseMemoryInformationClass(%q) = %v, %v", name, class, err)
+		}
+	}
+	if _, err := ParseMemoryInformationClass("Bogus"); err == nil {
+		t.Error("ParseMemoryInformationClass(\"Bogus\") succeeded")
+	}
+}
@@ -0,0 +1,257 @@
+package ntdll
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/internal/layout"
+)
+
+// processorPerformanceSize is the size of SYSTEM_PROCESSOR_PERFORMANCE_INFORMATION,
+// which is the same for 32-bit and 64-bit processes
+const processorPerformanceSize = 48
+
+// ProcessorPerformance is a decoded SYSTEM_PROCESSOR_PERFORMANCE_INFORMATION
+// entry. The times are cumulative since boot; KernelTime includes IdleTime,
+// DpcTime and InterruptTime.
+type ProcessorPerformance struct {
+	Group          uint16
+	Number         int // processor number within the group
+	IdleTime       time.Duration
+	KernelTime     time.Duration
+	UserTime       time.Duration
+	DpcTime        time.Duration
+	InterruptTime  time.Duration
+	InterruptCount uint32
+}
+
+// DecodeProcessorPerformanceInformation decodes the buffer returned by
+// NtQuerySystemInformationEx(SystemProcessorPerformanceInformation) for the
+// given processor group.
+//
+// Parameters:
+//   - buf: the returned buffer, one entry per processor in the group
+//   - group: the processor group the buffer was queried for
+//
+// Returns:
+//   - one entry per processor
+//   - an NTStatusError with STATUS_INFO_LENGTH_MISMATCH if buf is not a whole
+//     number of entries
+func DecodeProcessorPerformanceInformation(buf []byte, group uint16) ([]ProcessorPerformance, error) {
+	if len(buf)%processorPerformanceSize != 0 {
+		return nil, winx.NewNTStatusError(winx.STATUS_INFO_LENGTH_MISMATCH, fmt.Sprintf("%d bytes is not a multiple of the %d byte entry size", len(buf), processorPerformanceSize))
+	}
+
+	r := layout.NewReader(buf, 0, 8)
+	processors := make([]ProcessorPerformance, len(buf)/processorPerformanceSize)
+	for i := range processors {
+		p := &processors[i]
+		p.Group = group
+		p.Number = i
+		p.IdleTime = duration100ns(r.Int64())
+		p.KernelTime = duration100ns(r.Int64())
+		p.UserTime = duration100ns(r.Int64())
+		p.DpcTime = duration100ns(r.Int64())
+		p.InterruptTime = duration100ns(r.Int64())
+		p.InterruptCount = r.Uint32()
+		r.Align(8)
+	}
+	return processors, r.Err()
+}
+
+// CPUUsage is the utilization of a processor between two samples.
+// Percentages are of the total elapsed processor time: Idle, Kernel and User
+// add up to 100, and DPC and Interrupt are the parts of Kernel spent servicing
+// deferred procedure calls and interrupts.
+type CPUUsage struct {
+	Group      uint16
+	Number     int
+	Busy       float64 // 100 - Idle
+	Idle       float64
+	Kernel     float64 // kernel time excluding idle time
+	User       float64
+	DPC        float64
+	Interrupt  float64
+	Interrupts uint32 // interrupts serviced between the samples
+}
+
+// ComputeCPUUsage returns the per-processor utilization between two snapshots.
+// Processors are matched by group and number; processors missing from
+// previous (for example after a hot add) are skipped.
+func ComputeCPUUsage(previous, current []ProcessorPerformance) []CPUUsage {
+	usage := make([]CPUUsage, 0, len(current))
+	for _, p := range performanceDeltas(previous, current) {
+		u := cpuUsage(p)
+		u.Group, u.Number = p.Group, p.Number
+		usage = append(usage, u)
+	}
+	return usage
+}
+
+// TotalCPUUsage returns the utilization of all processors combined between
+// two snapshots. Group and Number are zero in the result.
+func TotalCPUUsage(previous, current []ProcessorPerformance) CPUUsage {
+	var total ProcessorPerformance
+	for _, d := range performanceDeltas(previous, current) {
+		total.IdleTime += d.IdleTime
+		total.KernelTime += d.KernelTime
+		total.UserTime += d.UserTime
+		total.DpcTime += d.DpcTime
+		total.InterruptTime += d.InterruptTime
+		total.InterruptCount += d.InterruptCount
+	}
+	return cpuUsage(total)
+}
+
+// performanceDeltas returns the counter increase of every processor in current
+// that is also present in previous
+func performanceDeltas(previous, current []ProcessorPerformance) []ProcessorPerformance {
+	type key struct {
+		group  uint16
+		number int
+	}
+	before := make(map[key]ProcessorPerformance, len(previous))
+	for _, p := range previous {
+		before[key{p.Group, p.Number}] = p
+	}
+
+	deltas := make([]ProcessorPerformance, 0, len(current))
+	for _, p := range current {
+		if old, ok := before[key{p.Group, p.Number}]; ok {
+			deltas = append(deltas, subtractPerformance(p, old))
+		}
+	}
+	return deltas
+}
+
+func subtractPerformance(current, previous ProcessorPerformance) ProcessorPerformance {
+	return ProcessorPerformance{
+		Group:          current.Group,
+		Number:         current.Number,
+		IdleTime:       current.IdleTime - previous.IdleTime,
+		KernelTime:     current.KernelTime - previous.KernelTime,
+		UserTime:       current.UserTime - previous.UserTime,
+		DpcTime:        current.DpcTime - previous.DpcTime,
+		InterruptTime:  current.InterruptTime - previous.InterruptTime,
+		InterruptCount: current.InterruptCount - previous.InterruptCount,
+	}
+}
+
+// cpuUsage converts a delta between two samples to percentages
+func cpuUsage(delta ProcessorPerformance) CPUUsage {
+	u := CPUUsage{Interrupts: delta.InterruptCount}
+	total := float64(delta.KernelTime + delta.UserTime)
+	if total <= 0 {
+		return u
+	}
+	percent := func(d time.Duration) float64 {
+		v := float64(d) / total * 100
+		if v < 0 {
+			return 0
+		}
+		if v > 100 {
+			return 100
+		}
+		return v
+	}
+	u.Idle = percent(delta.IdleTime)
+	u.Busy = 100 - u.Idle
+	u.Kernel = percent(delta.KernelTime - delta.IdleTime)
+	u.User = percent(delta.UserTime)
+	u.DPC = percent(delta.DpcTime)
+	u.Interrupt = percent(delta.InterruptTime)
+	return u
+}
+
+// ProcessorPerformanceQuery returns a snapshot of every processor's counters.
+// On Windows, QueryProcessorPerformance is the standard implementation.
+type ProcessorPerformanceQuery func() ([]ProcessorPerformance, error)
+
+// CPUSample is a set of per-processor utilizations streamed by CPUSampler.Run
+type CPUSample struct {
+	Time  time.Time
+	Cores []CPUUsage
+	Total CPUUsage
+	Err   error // set if the snapshot could not be taken
+}
+
+// DefaultCPUSampleInterval is how often CPUSampler.Run takes a snapshot when
+// the sampler has no positive Interval
+const DefaultCPUSampleInterval = time.Second
+
+// CPUSampler computes processor utilization between successive snapshots
+type CPUSampler struct {
+	// Interval is the time between samples streamed by Run. Defaults to
+	// DefaultCPUSampleInterval.
+	Interval time.Duration
+	query    ProcessorPerformanceQuery
+
+	mu       sync.Mutex
+	previous []ProcessorPerformance // baseline of Sample; Run keeps its own
+}
+
+// NewCPUSampler creates a sampler that takes a snapshot with query every interval.
+func NewCPUSampler(interval time.Duration, query ProcessorPerformanceQuery) *CPUSampler {
+	return &CPUSampler{Interval: interval, query: query}
+}
+
+// Sample takes a snapshot and returns the utilization since the previous one.
+// The first call only records a baseline and returns a sample with no cores.
+func (s *CPUSampler) Sample() CPUSample {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sample(&s.previous)
+}
+
+// sample takes a snapshot and returns the utilization since *previous, which
+// it then replaces
+func (s *CPUSampler) sample(previous *[]ProcessorPerformance) CPUSample {
+	sample := CPUSample{Time: time.Now()}
+	current, err := s.query()
+	if err != nil {
+		sample.Err = err
+		return sample
+	}
+	if *previous != nil {
+		sample.Cores = ComputeCPUUsage(*previous, current)
+		sample.Total = TotalCPUUsage(*previous, current)
+	}
+	*previous = current
+	return sample
+}
+
+// Run takes a baseline snapshot and then streams a sample every Interval until
+// ctx is cancelled, at which point the returned channel is closed. Failed
+// snapshots are reported through CPUSample.Err and sampling continues. Run
+// keeps its own baseline, so it may be used alongside Sample.
+func (s *CPUSampler) Run(ctx context.Context) <-chan CPUSample {
+	interval := s.Interval
+	if interval <= 0 {
+		interval = DefaultCPUSampleInterval
+	}
+	samples := make(chan CPUSample)
+	go func() {
+		defer close(samples)
+		var previous []ProcessorPerformance
+		s.sample(&previous)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			select {
+			case samples <- s.sample(&previous):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return samples
+}
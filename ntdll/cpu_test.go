@@ -0,0 +1,153 @@
+package ntdll
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// performanceBuffer builds SYSTEM_PROCESSOR_PERFORMANCE_INFORMATION entries
+// from idle, kernel, user, dpc and interrupt times in 100ns units
+func performanceBuffer(entries ...[5]int64) []byte {
+	var buf []byte
+	for _, e := range entries {
+		for _, v := range e {
+			buf = binary.LittleEndian.AppendUint64(buf, uint64(v))
+		}
+		buf = binary.LittleEndian.AppendUint32(buf, 1000)
+		buf = append(buf, 0, 0, 0, 0)
+	}
+	return buf
+}
+
+func closeTo(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// TestDecodeProcessorPerformanceInformation tests entry decoding
+func TestDecodeProcessorPerformanceInformation(t *testing.T) {
+	buf := performanceBuffer([5]int64{10, 20, 30, 4, 5}, [5]int64{100, 200, 300, 40, 50})
+	processors, err := DecodeProcessorPerformanceInformation(buf, 1)
+	if err != nil {
+		t.Fatalf("DecodeProcessorPerformanceInformation() error = %v", err)
+	}
+	want := ProcessorPerformance{1, 1, 10 * time.Microsecond, 20 * time.Microsecond, 30 * time.Microsecond, 4 * time.Microsecond, 5 * time.Microsecond, 1000}
+	if len(processors) != 2 || processors[1] != want {
+		t.Errorf("DecodeProcessorPerformanceInformation() = %+v, want second entry %+v", processors, want)
+	}
+
+	if _, err := DecodeProcessorPerformanceInformation(buf[:50], 0); !errors.Is(err, winx.STATUS_INFO_LENGTH_MISMATCH) {
+		t.Errorf("DecodeProcessorPerformanceInformation(50 bytes) error = %v", err)
+	}
+}
+
+// TestComputeCPUUsage tests the percentage maths between two snapshots
+func TestComputeCPUUsage(t *testing.T) {
+	previous, _ := DecodeProcessorPerformanceInformation(performanceBuffer(
+		[5]int64{1000, 2000, 1000, 0, 0},
+		[5]int64{5000, 5000, 0, 0, 0},
+	), 0)
+	// core 0: 600 idle, 800 kernel (incl. idle), 200 user, 100 dpc, 50 interrupt
+	// core 1: fully idle
+	current, _ := DecodeProcessorPerformanceInformation(performanceBuffer(
+		[5]int64{1600, 2800, 1200, 100, 50},
+		[5]int64{6000, 6000, 0, 0, 0},
+	), 0)
+
+	usage := ComputeCPUUsage(previous, current)
+	if len(usage) != 2 {
+		t.Fatalf("ComputeCPUUsage() returned %d cores, want 2", len(usage))
+	}
+	core := usage[0]
+	if !closeTo(core.Idle, 60) || !closeTo(core.Busy, 40) || !closeTo(core.Kernel, 20) || !closeTo(core.User, 20) {
+		t.Errorf("core 0 = %+v", core)
+	}
+	if !closeTo(core.DPC, 10) || !closeTo(core.Interrupt, 5) {
+		t.Errorf("core 0 DPC = %v, Interrupt = %v", core.DPC, core.Interrupt)
+	}
+	if !closeTo(usage[1].Idle, 100) || usage[1].Number != 1 {
+		t.Errorf("core 1 = %+v", usage[1])
+	}
+
+	total := TotalCPUUsage(previous, current)
+	if !closeTo(total.Idle, 80) || !closeTo(total.User, 10) {
+		t.Errorf("TotalCPUUsage() = %+v", total)
+	}
+
+	if got := ComputeCPUUsage(previous, previous); len(got) != 2 || got[0].Idle != 0 {
+		t.Errorf("ComputeCPUUsage(no elapsed time) = %+v", got)
+	}
+	if got := ComputeCPUUsage(nil, current); len(got) != 0 {
+		t.Errorf("ComputeCPUUsage(no baseline) = %+v", got)
+	}
+}
+
+// TestCPUSampler_Run tests streaming and cancellation
+func TestCPUSampler_Run(t *testing.T) {
+	var tick int64
+	query := func() ([]ProcessorPerformance, error) {
+		tick++
+		if tick == 3 {
+			return nil, winx.STATUS_UNSUCCESSFUL
+		}
+		return DecodeProcessorPerformanceInformation(performanceBuffer([5]int64{tick * 50, tick * 100, 0, 0, 0}), 0)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	samples := NewCPUSampler(time.Millisecond, query).Run(ctx)
+
+	first := <-samples
+	if first.Err != nil || len(first.Cores) != 1 || !closeTo(first.Cores[0].Busy, 50) {
+		t.Errorf("first sample = %+v", first)
+	}
+	if second := <-samples; !errors.Is(second.Err, winx.STATUS_UNSUCCESSFUL) {
+		t.Errorf("second sample error = %v, want STATUS_UNSUCCESSFUL", second.Err)
+	}
+
+	cancel()
+	for range samples {
+	}
+}
+
+// TestCPUSampler_RunDefaultInterval tests that a non-positive interval falls
+// back to DefaultCPUSampleInterval instead of panicking
+func TestCPUSampler_RunDefaultInterval(t *testing.T) {
+	query := func() ([]ProcessorPerformance, error) {
+		return DecodeProcessorPerformanceInformation(performanceBuffer([5]int64{0, 0, 0, 0, 0}), 0)
+	}
+	for _, interval := range []time.Duration{0, -time.Second} {
+		ctx, cancel := context.WithCancel(context.Background())
+		samples := NewCPUSampler(interval, query).Run(ctx)
+		cancel()
+		for range samples {
+		}
+	}
+}
+
+// TestCPUSampler_Concurrent tests that Sample may be called while Run streams
+func TestCPUSampler_Concurrent(t *testing.T) {
+	var tick atomic.Int64
+	query := func() ([]ProcessorPerformance, error) {
+		n := tick.Add(1)
+		return DecodeProcessorPerformanceInformation(performanceBuffer([5]int64{n * 50, n * 100, 0, 0, 0}), 0)
+	}
+
+	sampler := NewCPUSampler(time.Millisecond, query)
+	ctx, cancel := context.WithCancel(context.Background())
+	samples := sampler.Run(ctx)
+	for i := 0; i < 5; i++ {
+		sampler.Sample()
+		if sample := <-samples; sample.Err != nil || len(sample.Cores) != 1 {
+			t.Errorf("streamed sample = %+v", sample)
+		}
+	}
+	cancel()
+	for range samples {
+	}
+}
@@ -0,0 +1,117 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// IOStatus is a completed IO_STATUS_BLOCK
+type IOStatus struct {
+	Status winx.NTSTATUS
+
+	// Information is the number of bytes transferred by I/O and control
+	// requests, and FILE_OPENED, FILE_CREATED etc. for NtCreateFile
+	Information uint64
+}
+
+// IsNTPath reports whether path is in the NT namespace, e.g. `\Device\Afd` or
+// `\??\C:\Windows`, rather than a Win32 path such as `\\.\PhysicalDrive0`,
+// `\\?\C:\Windows`, `\\server\share` or `C:\Windows`
+func IsNTPath(path string) bool {
+	return strings.HasPrefix(path, `\`) && !strings.HasPrefix(path, `\\`)
+}
+
+// ExtendedAttribute is a file extended attribute, a FILE_FULL_EA_INFORMATION
+// entry
+type ExtendedAttribute struct {
+	Name  string // ASCII, at most 255 characters
+	Value []byte // at most 65535 bytes
+	Flags uint8  // 0 or FILE_NEED_EA
+}
+
+// fileFullEAHeaderSize is the size of FILE_FULL_EA_INFORMATION up to EaName
+const fileFullEAHeaderSize = 8
+
+// EncodeExtendedAttributes builds the FILE_FULL_EA_INFORMATION list passed to
+// NtCreateFile as its EA buffer. Some drivers take their open parameters this
+// way, e.g. AFD for \Device\Afd\Endpoint.
+//
+// Parameters:
+//   - attributes: the attributes in the order the list should hold them
+//
+// Returns:
+//   - the list, with each entry aligned to 4 bytes; nil if attributes is empty
+//   - an NTStatusError with STATUS_INVALID_EA_NAME for empty, non-ASCII or
+//     overlong names, or STATUS_INVALID_PARAMETER for overlong values
+func EncodeExtendedAttributes(attributes []ExtendedAttribute) ([]byte, error) {
+	var buf []byte
+	previous := -1
+	for _, ea := range attributes {
+		if ea.Name == "" || len(ea.Name) > 255 || strings.IndexFunc(ea.Name, func(r rune) bool { return r == 0 || r > 0x7F }) >= 0 {
+			return nil, winx.NewNTStatusError(winx.STATUS_INVALID_EA_NAME, fmt.Sprintf("extended attribute name %q must be 1 to 255 ASCII characters", ea.Name))
+		}
+		if len(ea.Value) > 0xFFFF {
+			return nil, winx.NewNTStatusError(winx.STATUS_INVALID_PARAMETER, fmt.Sprintf("extended attribute %s: %d byte value exceeds 65535 bytes", ea.Name, len(ea.Value)))
+		}
+
+		offset := (len(buf) + 3) &^ 3
+		if previous >= 0 {
+			binary.LittleEndian.PutUint32(buf[previous:], uint32(offset-previous))
+		}
+		entry := make([]byte, offset-len(buf)+fileFullEAHeaderSize+len(ea.Name)+1+len(ea.Value))
+		header := entry[offset-len(buf):]
+		header[4] = ea.Flags
+		header[5] = uint8(len(ea.Name))
+		binary.LittleEndian.PutUint16(header[6:], uint16(len(ea.Value)))
+		copy(header[fileFullEAHeaderSize:], ea.Name)
+		copy(header[fileFullEAHeaderSize+len(ea.Name)+1:], ea.Value)
+		buf = append(buf, entry...)
+		previous = offset
+	}
+	return buf, nil
+}
+
+// DecodeExtendedAttributes decodes a FILE_FULL_EA_INFORMATION list, as built
+// by EncodeExtendedAttributes or returned by NtQueryEaFile.
+//
+// Parameters:
+//   - buf: the list
+//
+// Returns:
+//   - the attributes in list order
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if an entry lies outside
+//     buf, or STATUS_EA_LIST_INCONSISTENT if the entries do not chain forward
+func DecodeExtendedAttributes(buf []byte) ([]ExtendedAttribute, error) {
+	var attributes []ExtendedAttribute
+	for offset := 0; len(buf) > 0; {
+		if offset+fileFullEAHeaderSize > len(buf) {
+			return nil, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("FILE_FULL_EA_INFORMATION at offset %d is outside the %d byte buffer", offset, len(buf)))
+		}
+		next := int(binary.LittleEndian.Uint32(buf[offset:]))
+		nameLength := int(buf[offset+5])
+		valueLength := int(binary.LittleEndian.Uint16(buf[offset+6:]))
+		size := fileFullEAHeaderSize + nameLength + 1 + valueLength
+		if err := checkBuffer(buf[offset:], size, "FILE_FULL_EA_INFORMATION"); err != nil {
+			return nil, err
+		}
+		if next != 0 && next < size {
+			return nil, winx.NewNTStatusError(winx.STATUS_EA_LIST_INCONSISTENT, fmt.Sprintf("FILE_FULL_EA_INFORMATION at offset %d: NextEntryOffset %d overlaps the %d byte entry", offset, next, size))
+		}
+
+		name := offset + fileFullEAHeaderSize
+		value := name + nameLength + 1
+		attributes = append(attributes, ExtendedAttribute{
+			Name:  string(buf[name : name+nameLength]),
+			Value: append([]byte(nil), buf[value:value+valueLength]...),
+			Flags: buf[offset+4],
+		})
+		if next == 0 {
+			break
+		}
+		offset += next
+	}
+	return attributes, nil
+}
@@ -0,0 +1,118 @@
+package ntdll
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// TestIsNTPath tests telling NT paths from Win32 paths
+func TestIsNTPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{`\Device\Afd`, true},
+		{`\??\C:\Windows`, true},
+		{`\GLOBAL??\NUL`, true},
+		{`\\.\PhysicalDrive0`, false},
+		{`\\?\C:\Windows`, false},
+		{`\\server\share`, false},
+		{`C:\Windows`, false},
+		{``, false},
+	}
+	for _, tt := range tests {
+		if got := IsNTPath(tt.path); got != tt.want {
+			t.Errorf("IsNTPath(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+// TestExtendedAttributes tests encoding and decoding FILE_FULL_EA_INFORMATION
+func TestExtendedAttributes(t *testing.T) {
+	attributes := []ExtendedAttribute{
+		{Name: "AfdOpenPacketXX", Value: []byte{1, 2, 3, 4, 5}},
+		{Name: "X", Value: nil, Flags: winx.FILE_NEED_EA},
+		{Name: "user.comment", Value: []byte("hello")},
+	}
+	buf, err := EncodeExtendedAttributes(attributes)
+	if err != nil {
+		t.Fatalf("EncodeExtendedAttributes() error = %v", err)
+	}
+
+	// Entries are 4-byte aligned and chained by NextEntryOffset
+	wantOffsets := []int{0, 32, 44}
+	for i, offset := range wantOffsets {
+		next := binary.LittleEndian.Uint32(buf[offset:])
+		if i+1 < len(wantOffsets) && int(next) != wantOffsets[i+1]-offset || i+1 == len(wantOffsets) && next != 0 {
+			t.Errorf("entry %d NextEntryOffset = %d", i, next)
+		}
+	}
+	if want := 44 + fileFullEAHeaderSize + len("user.comment") + 1 + len("hello"); len(buf) != want {
+		t.Errorf("list is %d bytes, want %d", len(buf), want)
+	}
+
+	decoded, err := DecodeExtendedAttributes(buf)
+	if err != nil {
+		t.Fatalf("DecodeExtendedAttributes() error = %v", err)
+	}
+	if len(decoded) != len(attributes) {
+		t.Fatalf("DecodeExtendedAttributes() returned %d attributes, want %d", len(decoded), len(attributes))
+	}
+	for i, ea := range decoded {
+		if ea.Name != attributes[i].Name || !bytes.Equal(ea.Value, attributes[i].Value) || ea.Flags != attributes[i].Flags {
+			t.Errorf("attribute %d = %+v, want %+v", i, ea, attributes[i])
+		}
+	}
+
+	if buf, err := EncodeExtendedAttributes(nil); buf != nil || err != nil {
+		t.Errorf("EncodeExtendedAttributes(nil) = %v, %v", buf, err)
+	}
+	if attributes, err := DecodeExtendedAttributes(nil); attributes != nil || err != nil {
+		t.Errorf("DecodeExtendedAttributes(nil) = %v, %v", attributes, err)
+	}
+}
+
+// TestExtendedAttributesErrors tests invalid attributes and lists
+func TestExtendedAttributesErrors(t *testing.T) {
+	encodeTests := []struct {
+		name string
+		ea   ExtendedAttribute
+		want winx.NTSTATUS
+	}{
+		{"empty name", ExtendedAttribute{}, winx.STATUS_INVALID_EA_NAME},
+		{"long name", ExtendedAttribute{Name: strings.Repeat("A", 256)}, winx.STATUS_INVALID_EA_NAME},
+		{"non-ASCII name", ExtendedAttribute{Name: "caf\u00e9"}, winx.STATUS_INVALID_EA_NAME},
+		{"long value", ExtendedAttribute{Name: "A", Value: make([]byte, 0x10000)}, winx.STATUS_INVALID_PARAMETER},
+	}
+	for _, tt := range encodeTests {
+		if _, err := EncodeExtendedAttributes([]ExtendedAttribute{tt.ea}); !errors.Is(err, tt.want) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	buf, _ := EncodeExtendedAttributes([]ExtendedAttribute{{Name: "A", Value: []byte{1}}, {Name: "B"}})
+	overlapping := append([]byte(nil), buf...)
+	binary.LittleEndian.PutUint32(overlapping, 4)
+	beyond := append([]byte(nil), buf...)
+	binary.LittleEndian.PutUint32(beyond, 64)
+	decodeTests := []struct {
+		name string
+		buf  []byte
+		want winx.NTSTATUS
+	}{
+		{"truncated header", buf[:6], winx.STATUS_BUFFER_TOO_SMALL},
+		{"truncated value", buf[:len(buf)-1], winx.STATUS_BUFFER_TOO_SMALL},
+		{"overlapping entries", overlapping, winx.STATUS_EA_LIST_INCONSISTENT},
+		{"next entry outside buffer", beyond, winx.STATUS_BUFFER_TOO_SMALL},
+	}
+	for _, tt := range decodeTests {
+		if _, err := DecodeExtendedAttributes(tt.buf); !errors.Is(err, tt.want) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+//go:build windows
+
+package ntdll
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/handle"
+)
+
+// ioStatusBlock is an IO_STATUS_BLOCK
+type ioStatusBlock struct {
+	Status      uintptr // NTSTATUS, in a union with a pointer
+	Information uintptr
+}
+
+// result returns the block as an IOStatus
+func (iosb *ioStatusBlock) result() IOStatus {
+	return IOStatus{Status: winx.NTSTATUS(uint32(iosb.Status)), Information: uint64(iosb.Information)}
+}
+
+// _NtCreateFile is the low-level wrapper for NtCreateFile
+func _NtCreateFile(
+	FileHandle *handle.HANDLE,
+	DesiredAccess uint32,
+	ObjectAttributes *winx.OBJECT_ATTRIBUTES,
+	IoStatusBlock *ioStatusBlock,
+	AllocationSize *int64,
+	FileAttributes uint32,
+	ShareAccess uint32,
+	CreateDisposition uint32,
+	CreateOptions uint32,
+	EaBuffer unsafe.Pointer,
+	EaLength uint32) uint32 {
+
+	ret_code, _, _ := procNtCreateFile.Call(
+		winx.Call{API: "NtCreateFile", Detail: ObjectAttributes.Name(), InputSize: int(EaLength)},
+		winx.ReturnsNTSTATUS,
+		uintptr(unsafe.Pointer(FileHandle)),
+		uintptr(DesiredAccess),
+		uintptr(unsafe.Pointer(ObjectAttributes)),
+		uintptr(unsafe.Pointer(IoStatusBlock)),
+		uintptr(unsafe.Pointer(AllocationSize)),
+		uintptr(FileAttributes),
+		uintptr(ShareAccess),
+		uintptr(CreateDisposition),
+		uintptr(CreateOptions),
+		uintptr(EaBuffer),
+		uintptr(EaLength),
+	)
+	return uint32(ret_code)
+}
+
+// CreateFileOptions are the optional parameters of NtCreateFile. The zero
+// value opens the file without sharing, for asynchronous I/O.
+type CreateFileOptions struct {
+	// RootDirectory, if set, is a directory handle that the path is
+	// relative to
+	RootDirectory handle.HANDLE
+
+	// CaseSensitive disables OBJ_CASE_INSENSITIVE
+	CaseSensitive bool
+
+	AllocationSize int64  // initial size of created or overwritten files
+	FileAttributes uint32 // FILE_ATTRIBUTE_* flags of created files
+	ShareAccess    uint32 // FILE_SHARE_* flags
+
+	// CreateOptions takes FILE_* create options. With
+	// FILE_SYNCHRONOUS_IO_NONALERT or FILE_SYNCHRONOUS_IO_ALERT the access
+	// must include SYNCHRONIZE.
+	CreateOptions uint32
+
+	// ExtendedAttributes are set on created files, or passed to the driver
+	// as its open parameters
+	ExtendedAttributes []ExtendedAttribute
+}
+
+// NtCreateFile opens or creates a file, directory or device by NT path, e.g.
+// `\Device\Afd` or `\??\C:\Windows\win.ini`, which need no DOS device link.
+//
+// Parameters:
+//   - path: the NT path, relative to opts.RootDirectory if that is set
+//   - access: the FILE_*, GENERIC_* and standard access rights to request
+//   - disposition: FILE_OPEN, FILE_CREATE, FILE_OPEN_IF etc.
+//   - opts: the optional parameters, may be nil
+//
+// Returns:
+//   - the handle, to be closed with NtClose
+//   - the I/O status; Information is FILE_OPENED, FILE_CREATED etc.
+//   - an NTStatusError with the status of the open
+func NtCreateFile(path string, access, disposition uint32, opts *CreateFileOptions) (handle.HANDLE, IOStatus, error) {
+	var o CreateFileOptions
+	if opts != nil {
+		o = *opts
+	}
+	attributes := uint32(winx.OBJ_CASE_INSENSITIVE)
+	if o.CaseSensitive {
+		attributes = 0
+	}
+	oa, err := winx.NewObjectAttributes(path, attributes, uintptr(o.RootDirectory), nil)
+	if err != nil {
+		return 0, IOStatus{}, err
+	}
+	eas, err := EncodeExtendedAttributes(o.ExtendedAttributes)
+	if err != nil {
+		return 0, IOStatus{}, err
+	}
+	var eaBuffer unsafe.Pointer
+	if len(eas) > 0 {
+		eaBuffer = unsafe.Pointer(&eas[0])
+	}
+	var allocationSize *int64
+	if o.AllocationSize != 0 {
+		allocationSize = &o.AllocationSize
+	}
+
+	var h handle.HANDLE
+	var iosb ioStatusBlock
+	status := winx.NTSTATUS(_NtCreateFile(&h, access, oa, &iosb, allocationSize, o.FileAttributes, o.ShareAccess, disposition, o.CreateOptions, eaBuffer, uint32(len(eas))))
+	runtime.KeepAlive(oa)
+	if status != winx.STATUS_SUCCESS {
+		return 0, iosb.result(), winx.NewNTStatusError(status, fmt.Sprintf("NtCreateFile(%s)", path))
+	}
+	return h, iosb.result(), nil
+}
+
+// _NtOpenFile is the low-level wrapper for NtOpenFile
+func _NtOpenFile(
+	FileHandle *handle.HANDLE,
+	DesiredAccess uint32,
+	ObjectAttributes *winx.OBJECT_ATTRIBUTES,
+	IoStatusBlock *ioStatusBlock,
+	ShareAccess uint32,
+	OpenOptions uint32) uint32 {
+
+	ret_code, _, _ := procNtOpenFile.Call(
+		winx.Call{API: "NtOpenFile", Detail: ObjectAttributes.Name()},
+		winx.ReturnsNTSTATUS,
+		uintptr(unsafe.Pointer(FileHandle)),
+		uintptr(DesiredAccess),
+		uintptr(unsafe.Pointer(ObjectAttributes)),
+		uintptr(unsafe.Pointer(IoStatusBlock)),
+		uintptr(ShareAccess),
+		uintptr(OpenOptions),
+	)
+	return uint32(ret_code)
+}
+
+// NtOpenFile opens an existing file, directory or device by NT path, with
+// FILE_SHARE_* share access and FILE_* open options. Close the handle with
+// NtClose. Use NtCreateFile to pass extended attributes.
+func NtOpenFile(path string, access, shareAccess, openOptions uint32) (handle.HANDLE, IOStatus, error) {
+	oa, err := winx.NewObjectAttributes(path, winx.OBJ_CASE_INSENSITIVE, 0, nil)
+	if err != nil {
+		return 0, IOStatus{}, err
+	}
+	var h handle.HANDLE
+	var iosb ioStatusBlock
+	status := winx.NTSTATUS(_NtOpenFile(&h, access, oa, &iosb, shareAccess, openOptions))
+	runtime.KeepAlive(oa)
+	if status != winx.STATUS_SUCCESS {
+		return 0, iosb.result(), winx.NewNTStatusError(status, fmt.Sprintf("NtOpenFile(%s)", path))
+	}
+	return h, iosb.result(), nil
+}
+
+// _NtDeviceIoControlFile is the low-level wrapper for NtDeviceIoControlFile
+func _NtDeviceIoControlFile(
+	FileHandle handle.HANDLE,
+	Event handle.HANDLE,
+	ApcRoutine uintptr,
+	ApcContext uintptr,
+	IoStatusBlock *ioStatusBlock,
+	IoControlCode uint32,
+	InputBuffer unsafe.Pointer,
+	InputBufferLength uint32,
+	OutputBuffer unsafe.Pointer,
+	OutputBufferLength uint32) uint32 {
+
+	ret_code, _, _ := procNtDeviceIoControlFile.Call(
+		winx.Call{API: "NtDeviceIoControlFile", Detail: winx.TraceDetail("IOCTL 0x%08X", IoControlCode), InputSize: int(InputBufferLength), OutputSize: int(OutputBufferLength)},
+		winx.ReturnsNTSTATUS,
+		uintptr(FileHandle),
+		uintptr(Event),
+		ApcRoutine,
+		ApcContext,
+		uintptr(unsafe.Pointer(IoStatusBlock)),
+		uintptr(IoControlCode),
+		uintptr(InputBuffer),
+		uintptr(InputBufferLength),
+		uintptr(OutputBuffer),
+		uintptr(OutputBufferLength),
+	)
+	return uint32(ret_code)
+}
+
+// _NtFsControlFile is the low-level wrapper for NtFsControlFile
+func _NtFsControlFile(
+	FileHandle handle.HANDLE,
+	Event handle.HANDLE,
+	ApcRoutine uintptr,
+	ApcContext uintptr,
+	IoStatusBlock *ioStatusBlock,
+	FsControlCode uint32,
+	InputBuffer unsafe.Pointer,
+	InputBufferLength uint32,
+	OutputBuffer unsafe.Pointer,
+	OutputBufferLength uint32) uint32 {
+
+	ret_code, _, _ := procNtFsControlFile.Call(
+		winx.Call{API: "NtFsControlFile", Detail: winx.TraceDetail("FSCTL 0x%08X", FsControlCode), InputSize: int(InputBufferLength), OutputSize: int(OutputBufferLength)},
+		winx.ReturnsNTSTATUS,
+		uintptr(FileHandle),
+		uintptr(Event),
+		ApcRoutine,
+		ApcContext,
+		uintptr(unsafe.Pointer(IoStatusBlock)),
+		uintptr(FsControlCode),
+		uintptr(InputBuffer),
+		uintptr(InputBufferLength),
+		uintptr(OutputBuffer),
+		uintptr(OutputBufferLength),
+	)
+	return uint32(ret_code)
+}
+
+// _NtWaitForSingleObject is the low-level wrapper for NtWaitForSingleObject
+func _NtWaitForSingleObject(
+	Handle handle.HANDLE,
+	Alertable bool,
+	Timeout *int64) uint32 {
+
+	ret_code, _, _ := procNtWaitForSingleObject.Call(
+		winx.Call{API: "NtWaitForSingleObject"},
+		winx.ReturnsNTSTATUS,
+		uintptr(Handle),
+		boolArg(Alertable),
+		uintptr(unsafe.Pointer(Timeout)),
+	)
+	return uint32(ret_code)
+}
+
+// controlFile is _NtDeviceIoControlFile or _NtFsControlFile
+type controlFile func(handle.HANDLE, handle.HANDLE, uintptr, uintptr, *ioStatusBlock, uint32, unsafe.Pointer, uint32, unsafe.Pointer, uint32) uint32
+
+// sendControl issues a control request and waits for it to complete. Files
+// opened for asynchronous I/O return STATUS_PENDING and signal the file
+// object on completion, so the request is synchronous for every handle.
+func sendControl(call controlFile, name string, file handle.HANDLE, code uint32, input, output []byte) (IOStatus, error) {
+	var inPtr, outPtr unsafe.Pointer
+	if len(input) > 0 {
+		inPtr = unsafe.Pointer(&input[0])
+	}
+	if len(output) > 0 {
+		outPtr = unsafe.Pointer(&output[0])
+	}
+
+	var iosb ioStatusBlock
+	status := winx.NTSTATUS(call(file, 0, 0, 0, &iosb, code, inPtr, uint32(len(input)), outPtr, uint32(len(output))))
+	if status == winx.STATUS_PENDING {
+		if wait := winx.NTSTATUS(_NtWaitForSingleObject(file, false, nil)); wait != winx.STATUS_SUCCESS {
+			return IOStatus{}, winx.NewNTStatusError(wait, fmt.Sprintf("%s(0x%08X): waiting for completion", name, code))
+		}
+		status = iosb.result().Status
+	}
+	runtime.KeepAlive(input)
+	runtime.KeepAlive(output)
+
+	result := iosb.result()
+	if !status.IsSuccess() {
+		// Warnings such as STATUS_BUFFER_OVERFLOW still return data
+		if !status.IsWarning() {
+			result.Information = 0
+		}
+		return result, winx.NewNTStatusError(status, fmt.Sprintf("%s(0x%08X)", name, code))
+	}
+	return result, nil
+}
+
+// NtDeviceIoControlFile sends an I/O control code to the device behind file
+// and waits for it to complete.
+//
+// Parameters:
+//   - file: a handle opened with NtCreateFile, NtOpenFile or CreateFile
+//   - code: the IOCTL, e.g. built with CTL_CODE
+//   - input: the input buffer, may be nil
+//   - output: the output buffer, may be nil
+//
+// Returns:
+//   - the I/O status; Information is the number of bytes written to output
+//   - an NTStatusError with the status of the request. For warnings such as
+//     STATUS_BUFFER_OVERFLOW output holds Information valid bytes.
+func NtDeviceIoControlFile(file handle.HANDLE, code uint32, input, output []byte) (IOStatus, error) {
+	return sendControl(_NtDeviceIoControlFile, "NtDeviceIoControlFile", file, code, input, output)
+}
+
+// NtFsControlFile sends a file system control code (FSCTL_*) to the file
+// system or volume behind file and waits for it to complete. Parameters and
+// results are as for NtDeviceIoControlFile.
+func NtFsControlFile(file handle.HANDLE, code uint32, input, output []byte) (IOStatus, error) {
+	return sendControl(_NtFsControlFile, "NtFsControlFile", file, code, input, output)
+}
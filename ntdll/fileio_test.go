@@ -0,0 +1,65 @@
+//go:build windows
+
+package ntdll
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// TestNtCreateFile tests creating a file with extended attributes by NT path,
+// reopening it and sending it a control code
+func TestNtCreateFile(t *testing.T) {
+	path := `\??\` + filepath.Join(t.TempDir(), "winx.txt")
+	h, status, err := NtCreateFile(path, winx.FILE_GENERIC_READ|winx.FILE_GENERIC_WRITE, winx.FILE_CREATE, &CreateFileOptions{
+		CreateOptions:      winx.FILE_SYNCHRONOUS_IO_NONALERT | winx.FILE_NON_DIRECTORY_FILE,
+		ExtendedAttributes: []ExtendedAttribute{{Name: "WINX", Value: []byte("test")}},
+	})
+	if err != nil {
+		t.Fatalf("NtCreateFile(%s) error = %v", path, err)
+	}
+	if status.Information != winx.FILE_CREATED {
+		t.Errorf("NtCreateFile() Information = %d, want FILE_CREATED", status.Information)
+	}
+
+	const fsctlGetReparsePoint = 0x000900A8
+	output := make([]byte, 1024)
+	if _, err := NtFsControlFile(h, fsctlGetReparsePoint, nil, output); !errors.Is(err, winx.STATUS_NOT_A_REPARSE_POINT) {
+		t.Errorf("NtFsControlFile(FSCTL_GET_REPARSE_POINT) error = %v", err)
+	}
+	NtClose(h)
+
+	if _, _, err := NtCreateFile(path, winx.SYNCHRONIZE, winx.FILE_CREATE, nil); !errors.Is(err, winx.STATUS_OBJECT_NAME_COLLISION) {
+		t.Errorf("NtCreateFile(FILE_CREATE) of an existing file error = %v", err)
+	}
+
+	h, status, err = NtOpenFile(path, winx.SYNCHRONIZE|winx.FILE_READ_ATTRIBUTES, winx.FILE_SHARE_READ|winx.FILE_SHARE_WRITE|winx.FILE_SHARE_DELETE, winx.FILE_SYNCHRONOUS_IO_NONALERT)
+	if err != nil || status.Information != winx.FILE_OPENED {
+		t.Fatalf("NtOpenFile(%s) = %+v, %v", path, status, err)
+	}
+	NtClose(h)
+}
+
+// TestNtDeviceIoControlFile tests a control code the null device rejects
+func TestNtDeviceIoControlFile(t *testing.T) {
+	h, _, err := NtOpenFile(`\Device\Null`, winx.SYNCHRONIZE|winx.FILE_READ_ATTRIBUTES, winx.FILE_SHARE_READ|winx.FILE_SHARE_WRITE, winx.FILE_SYNCHRONOUS_IO_NONALERT)
+	if err != nil {
+		t.Fatalf("NtOpenFile(\\Device\\Null) error = %v", err)
+	}
+	defer NtClose(h)
+
+	const ioctlDiskGetDriveGeometry = 0x00070000
+	status, err := NtDeviceIoControlFile(h, ioctlDiskGetDriveGeometry, nil, make([]byte, 24))
+	if err == nil || status.Information != 0 {
+		t.Errorf("NtDeviceIoControlFile() = %+v, %v; want an error", status, err)
+	}
+
+	missing := `\??\` + os.Getenv("SystemRoot") + `\NoSuchFile`
+	if _, _, err := NtOpenFile(missing, winx.SYNCHRONIZE, winx.FILE_SHARE_READ, 0); !errors.Is(err, winx.STATUS_OBJECT_NAME_NOT_FOUND) {
+		t.Errorf("NtOpenFile(%s) error = %v", missing, err)
+	}
+}
@@ -0,0 +1,78 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// FirmwareTableProvider is the signature of a firmware table provider
+type FirmwareTableProvider uint32
+
+// Firmware table providers
+const (
+	FirmwareTableProviderACPI FirmwareTableProvider = 0x41435049 // 'ACPI'
+	FirmwareTableProviderFIRM FirmwareTableProvider = 0x4649524D // 'FIRM'
+	FirmwareTableProviderRSMB FirmwareTableProvider = 0x52534D42 // 'RSMB'
+)
+
+// String returns the provider signature, e.g. "RSMB"
+func (provider FirmwareTableProvider) String() string {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(provider))
+	return string(b[:])
+}
+
+// SYSTEM_FIRMWARE_TABLE_ACTION values
+const (
+	SystemFirmwareTableEnumerate = 0
+	SystemFirmwareTableGet       = 1
+)
+
+// firmwareTableHeaderSize is the size of SYSTEM_FIRMWARE_TABLE_INFORMATION
+// without its TableBuffer
+const firmwareTableHeaderSize = 16
+
+// ACPITableID returns the table ID of an ACPI table signature such as "FACP",
+// as returned by enumeration and expected by GetSystemFirmwareTable.
+func ACPITableID(signature string) uint32 {
+	var b [4]byte
+	copy(b[:], signature)
+	return binary.LittleEndian.Uint32(b[:])
+}
+
+// encodeFirmwareTableRequest builds the SYSTEM_FIRMWARE_TABLE_INFORMATION
+// buffer passed to NtQuerySystemInformation(SystemFirmwareTableInformation),
+// with room for tableBufferLength bytes of output
+func encodeFirmwareTableRequest(provider FirmwareTableProvider, action, tableID, tableBufferLength uint32) []byte {
+	buf := make([]byte, firmwareTableHeaderSize+int(tableBufferLength))
+	binary.LittleEndian.PutUint32(buf[0:], uint32(provider))
+	binary.LittleEndian.PutUint32(buf[4:], action)
+	binary.LittleEndian.PutUint32(buf[8:], tableID)
+	binary.LittleEndian.PutUint32(buf[12:], tableBufferLength)
+	return buf
+}
+
+// decodeFirmwareTableResponse returns the TableBufferLength reported by the
+// kernel and the table data that follows the header
+func decodeFirmwareTableResponse(buf []byte) (uint32, []byte, error) {
+	if len(buf) < firmwareTableHeaderSize {
+		return 0, nil, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("firmware table header needs %d bytes, got %d", firmwareTableHeaderSize, len(buf)))
+	}
+	length := binary.LittleEndian.Uint32(buf[12:])
+	if uint64(length) > uint64(len(buf)-firmwareTableHeaderSize) {
+		return length, nil, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("firmware table of %d bytes does not fit in %d", length, len(buf)-firmwareTableHeaderSize))
+	}
+	return length, buf[firmwareTableHeaderSize : firmwareTableHeaderSize+length], nil
+}
+
+// DecodeFirmwareTableIDs decodes the table ID list returned by enumerating a
+// provider. Trailing bytes that do not form a whole ID are ignored.
+func DecodeFirmwareTableIDs(data []byte) []uint32 {
+	ids := make([]uint32, 0, len(data)/4)
+	for i := 0; i+4 <= len(data); i += 4 {
+		ids = append(ids, binary.LittleEndian.Uint32(data[i:]))
+	}
+	return ids
+}
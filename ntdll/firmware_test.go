@@ -0,0 +1,53 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// TestFirmwareTableProvider_String tests provider signatures
+func TestFirmwareTableProvider_String(t *testing.T) {
+	tests := []struct {
+		provider FirmwareTableProvider
+		want     string
+	}{
+		{FirmwareTableProviderACPI, "ACPI"},
+		{FirmwareTableProviderFIRM, "FIRM"},
+		{FirmwareTableProviderRSMB, "RSMB"},
+	}
+	for _, tt := range tests {
+		if got := tt.provider.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+// TestFirmwareTableRequest tests the SYSTEM_FIRMWARE_TABLE_INFORMATION round trip
+func TestFirmwareTableRequest(t *testing.T) {
+	buf := encodeFirmwareTableRequest(FirmwareTableProviderACPI, SystemFirmwareTableGet, ACPITableID("FACP"), 8)
+	if len(buf) != firmwareTableHeaderSize+8 {
+		t.Fatalf("request length = %d", len(buf))
+	}
+	if string(buf[8:12]) != "FACP" {
+		t.Errorf("TableID bytes = %q, want FACP", buf[8:12])
+	}
+
+	// the kernel reports the real length and fills the table buffer
+	binary.LittleEndian.PutUint32(buf[12:], 4)
+	copy(buf[16:], "DSDT")
+	length, data, err := decodeFirmwareTableResponse(buf)
+	if err != nil || length != 4 || string(data) != "DSDT" {
+		t.Errorf("decodeFirmwareTableResponse() = %d, %q, %v", length, data, err)
+	}
+	if ids := DecodeFirmwareTableIDs(data); len(ids) != 1 || ids[0] != ACPITableID("DSDT") {
+		t.Errorf("DecodeFirmwareTableIDs() = %v", ids)
+	}
+
+	binary.LittleEndian.PutUint32(buf[12:], 4096)
+	if length, _, err := decodeFirmwareTableResponse(buf); length != 4096 || !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("decodeFirmwareTableResponse(short) = %d, %v", length, err)
+	}
+}
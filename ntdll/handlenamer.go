@@ -0,0 +1,178 @@
+//go:build windows
+
+package ntdll
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/handle"
+)
+
+// DefaultObjectNameTimeout is how long HandleNamer waits for the name of a
+// file object before giving up on it
+const DefaultObjectNameTimeout = 200 * time.Millisecond
+
+// MaxAbandonedNameQueries is how many timed out file name queries a
+// HandleNamer leaves running before it stops querying file names
+const MaxAbandonedNameQueries = 16
+
+// HandleNamer names the objects behind the handles of any process, for
+// handle.Enrich. Handles of other processes are duplicated into the current
+// process, which needs PROCESS_DUP_HANDLE access to them; run elevated with
+// SeDebugPrivilege to cover every process. A HandleNamer is safe for
+// concurrent use and must be closed to release its process handles.
+type HandleNamer struct {
+	Types ObjectTypeTable
+
+	// Timeout bounds name queries on file objects, which block forever on
+	// synchronous named pipes. File handles whose access marks such a pipe
+	// are not queried at all. Any other query that times out is abandoned
+	// on a goroutine that holds an OS thread and the handle copy until the
+	// pipe is read; once MaxAbandonedNameQueries are stuck, file names are
+	// no longer queried. Defaults to DefaultObjectNameTimeout.
+	Timeout time.Duration
+
+	pending   atomic.Int32 // file name queries still running
+	mu        sync.Mutex
+	processes map[uint64]processHandle
+	closed    bool
+}
+
+var _ handle.ObjectNamer = (*HandleNamer)(nil)
+
+// processHandle is a cached result of opening a process
+type processHandle struct {
+	h   handle.HANDLE
+	err error
+}
+
+// NewHandleNamer queries the object type table and returns a namer using it
+func NewHandleNamer() (*HandleNamer, error) {
+	types, err := QueryObjectTypes()
+	if err != nil {
+		return nil, err
+	}
+	return &HandleNamer{Types: types, processes: make(map[uint64]processHandle)}, nil
+}
+
+// TypeName returns the name of an object type index, e.g. "File"
+func (namer *HandleNamer) TypeName(typeIndex uint16) string {
+	return namer.Types.Name(typeIndex)
+}
+
+// ObjectName returns the name of the object behind the handle of entry, or
+// "" if the object is unnamed. The handle is always queried through a copy,
+// so an abandoned file name query never touches a handle its owner may close.
+// File handles that look like synchronous pipes fail with
+// STATUS_NOT_SUPPORTED without being queried.
+func (namer *HandleNamer) ObjectName(entry handle.SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX) (string, error) {
+	isFile := namer.Types.Name(entry.ObjectTypeIndex) == "File"
+	if isFile && nameQueryMayBlock(entry.GrantedAccess) {
+		return "", winx.NewNTStatusError(winx.STATUS_NOT_SUPPORTED, fmt.Sprintf("name query for handle 0x%X of process %d skipped: access 0x%08X marks a synchronous pipe", entry.HandleValue, entry.UniqueProcessId, entry.GrantedAccess))
+	}
+	if isFile && namer.pending.Load() >= MaxAbandonedNameQueries {
+		return "", winx.NewNTStatusError(winx.STATUS_TOO_MANY_THREADS, fmt.Sprintf("name query for handle 0x%X of process %d skipped: %d earlier queries are still blocked", entry.HandleValue, entry.UniqueProcessId, MaxAbandonedNameQueries))
+	}
+
+	process := handle.CurrentProcess
+	if entry.UniqueProcessId != uintptr(os.Getpid()) {
+		var err error
+		if process, err = namer.process(uint64(entry.UniqueProcessId)); err != nil {
+			return "", err
+		}
+	}
+	h, err := NtDuplicateObject(process, handle.HANDLE(entry.HandleValue), 0, 0)
+	if err != nil {
+		return "", err
+	}
+
+	if !isFile {
+		defer NtClose(h)
+		return QueryObjectName(h)
+	}
+
+	type result struct {
+		name string
+		err  error
+	}
+	done := make(chan result, 1)
+	namer.pending.Add(1)
+	go func() {
+		defer namer.pending.Add(-1)
+		name, err := QueryObjectName(h)
+		NtClose(h)
+		done <- result{name, err}
+	}()
+
+	timeout := namer.Timeout
+	if timeout <= 0 {
+		timeout = DefaultObjectNameTimeout
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case r := <-done:
+		return r.name, r.err
+	case <-timer.C:
+		return "", winx.NewNTStatusError(winx.STATUS_TIMEOUT, fmt.Sprintf("name query for handle 0x%X of process %d did not complete in %v", entry.HandleValue, entry.UniqueProcessId, timeout))
+	}
+}
+
+// process returns a PROCESS_DUP_HANDLE handle to a process, opening it on
+// first use
+func (namer *HandleNamer) process(pid uint64) (handle.HANDLE, error) {
+	namer.mu.Lock()
+	defer namer.mu.Unlock()
+	if namer.closed {
+		return 0, winx.NewNTStatusError(winx.STATUS_INVALID_HANDLE, "HandleNamer is closed")
+	}
+	cached, ok := namer.processes[pid]
+	if !ok {
+		cached.h, cached.err = NtOpenProcess(pid, winx.PROCESS_DUP_HANDLE)
+		namer.processes[pid] = cached
+	}
+	return cached.h, cached.err
+}
+
+// Close closes the process handles opened by the namer
+func (namer *HandleNamer) Close() error {
+	namer.mu.Lock()
+	defer namer.mu.Unlock()
+	var errs []error
+	for _, cached := range namer.processes {
+		if cached.err == nil {
+			errs = append(errs, NtClose(cached.h))
+		}
+	}
+	namer.processes = nil
+	namer.closed = true
+	return errors.Join(errs...)
+}
+
+// QueryNamedHandles returns the handles of a process with the names of their
+// types and objects.
+func QueryNamedHandles(pid uint64) ([]handle.NamedEntry, error) {
+	handles, err := QuerySystemHandles()
+	if err != nil {
+		return nil, err
+	}
+	var entries []handle.SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX
+	for _, entry := range handles {
+		if uint64(entry.UniqueProcessId) == pid {
+			entries = append(entries, entry)
+		}
+	}
+
+	namer, err := NewHandleNamer()
+	if err != nil {
+		return nil, err
+	}
+	defer namer.Close()
+	return handle.Enrich(entries, namer), nil
+}
@@ -1,60 +1,51 @@
+//go:build windows
+
 package ntdll
 
 import (
+	"context"
+	"encoding/binary"
+	"errors"
 	"fmt"
-	"syscall"
 	"unsafe"
 
-	"github.com/ArkaprabhaChakraborty/winx/exitcodes"
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/firmware"
+	"github.com/ArkaprabhaChakraborty/winx/handle"
 )
 
 // _NtQuerySystemInformation is the low-level wrapper for NtQuerySystemInformation
 func _NtQuerySystemInformation(
-	SystemInformationClass uint32,
+	SystemInformationClass winx.SystemInformationClass,
 	SystemInformation unsafe.Pointer,
 	SystemInformationLength uint32,
-	ReturnLength *uint32,
-	debug bool) uint32 {
-
-	var ntdll = syscall.NewLazyDLL("ntdll.dll")
-	var procNtQuerySystemInformation = ntdll.NewProc("NtQuerySystemInformation")
+	ReturnLength *uint32) uint32 {
 
-	ret_code, err_no, kerr := syscall.SyscallN(
-		procNtQuerySystemInformation.Addr(),
+	ret_code, _, _ := procNtQuerySystemInformation.Call(
+		winx.Call{API: "NtQuerySystemInformation", Detail: SystemInformationClass.String(), OutputSize: int(SystemInformationLength)},
+		winx.ReturnsNTSTATUS,
 		uintptr(SystemInformationClass),
 		uintptr(SystemInformation),
 		uintptr(SystemInformationLength),
 		uintptr(unsafe.Pointer(ReturnLength)),
 	)
 
-	if debug {
-		fmt.Printf("[DEBUG] === NtQuerySystemInformation Call ===\n")
-		fmt.Printf("[DEBUG] Class: 0x%02X (%d)\n", SystemInformationClass, SystemInformationClass)
-		fmt.Printf("[DEBUG] Buffer: %p, Length: %d bytes\n", SystemInformation, SystemInformationLength)
-		fmt.Printf("[DEBUG] Return Code: 0x%08X (%s)\n", ret_code, exitcodes.FormatError(uint32(ret_code)))
-		fmt.Printf("[DEBUG] Errno: %v\n", err_no)
-		fmt.Printf("[DEBUG] Kernel Error: %v\n", kerr)
-	}
-
 	return uint32(ret_code)
 }
 
 // _NtQuerySystemInformationEx is the low-level wrapper for NtQuerySystemInformationEx
 func _NtQuerySystemInformationEx(
-	SystemInformationClass uint32,
+	SystemInformationClass winx.SystemInformationClass,
 	InputBuffer unsafe.Pointer,
 	InputBufferLength uint32,
 	SystemInformation unsafe.Pointer,
 	SystemInformationLength uint32,
-	ReturnLength *uint32,
-	debug bool) uint32 {
+	ReturnLength *uint32) uint32 {
 
-	var ntdll = syscall.NewLazyDLL("ntdll.dll")
-	var procNtQuerySystemInformationEx = ntdll.NewProc("NtQuerySystemInformationEx")
-
-	// Use SyscallN with proper parameter array
-	ret_code, _, kerr := syscall.SyscallN(
-		procNtQuerySystemInformationEx.Addr(),
+	// Returns STATUS_PROCEDURE_NOT_FOUND before Windows 7
+	ret_code, _, _ := procNtQuerySystemInformationEx.Call(
+		winx.Call{API: "NtQuerySystemInformationEx", Detail: SystemInformationClass.String(), InputSize: int(InputBufferLength), OutputSize: int(SystemInformationLength)},
+		winx.ReturnsNTSTATUS,
 		uintptr(SystemInformationClass),
 		uintptr(InputBuffer),
 		uintptr(InputBufferLength),
@@ -63,122 +54,311 @@ func _NtQuerySystemInformationEx(
 		uintptr(unsafe.Pointer(ReturnLength)),
 	)
 
-	if debug {
-		fmt.Printf("[DEBUG] === NtQuerySystemInformationEx Call ===\n")
-		fmt.Printf("[DEBUG] Class: 0x%02X (%d)\n", SystemInformationClass, SystemInformationClass)
-		fmt.Printf("[DEBUG] Input Buffer: %p, Length: %d bytes\n", InputBuffer, InputBufferLength)
-		fmt.Printf("[DEBUG] Output Buffer: %p, Length: %d bytes\n", SystemInformation, SystemInformationLength)
-		fmt.Printf("[DEBUG] Return Code: 0x%08X (%s)\n", ret_code, exitcodes.FormatError(uint32(ret_code)))
-		fmt.Printf("[DEBUG] Kernel Error: %v\n", kerr)
-		if ReturnLength != nil {
-			fmt.Printf("[DEBUG] Return Length: %d bytes\n", *ReturnLength)
-		}
-	}
 	return uint32(ret_code)
 }
 
 // NtQuerySystemInformation is a convenience wrapper around _NtQuerySystemInformation
 // that automatically allocates and resizes a buffer when STATUS_INFO_LENGTH_MISMATCH
 // is returned. It returns the filled byte slice and the NTSTATUS code.
-func NtQuerySystemInformation(class uint32, initialSize uint32, debug bool) ([]byte, uint32) {
-	var returnLen uint32
-	size := initialSize
-	if size == 0 {
-		size = 65536 // start with 64 KiB
+// New code should use QueryRaw or Query, which return a typed error.
+//
+// debug is ignored and kept for compatibility; install a tracer with
+// winx.SetTracer to log system calls.
+func NtQuerySystemInformation(class winx.SystemInformationClass, initialSize uint32, debug bool) ([]byte, uint32) {
+	buf, err := QueryRaw(context.Background(), class, &QueryOptions{InitialSize: initialSize})
+	return buf, ntStatusOf(err)
+}
+
+// NtQuerySystemInformationEx is a convenience wrapper around _NtQuerySystemInformationEx
+// that automatically allocates and resizes a buffer when STATUS_INFO_LENGTH_MISMATCH
+// is returned. The processor group is passed as the input buffer. It returns the
+// filled byte slice and the NTSTATUS code. debug is ignored, as for
+// NtQuerySystemInformation.
+func NtQuerySystemInformationEx(
+	class winx.SystemInformationClass,
+	processorGroup uint16, // Add processor group parameter
+	initialSize uint32,
+	debug bool) ([]byte, uint32) {
+
+	// Create input buffer with processor group information
+	inputBuffer := make([]byte, 4) // USHORT + padding
+	binary.LittleEndian.PutUint16(inputBuffer, processorGroup)
+
+	buf, err := QueryRaw(context.Background(), class, &QueryOptions{InitialSize: initialSize, Input: inputBuffer})
+	return buf, ntStatusOf(err)
+}
+
+// ntStatusOf returns the status carried by an error from QueryRaw
+func ntStatusOf(err error) uint32 {
+	var statusErr *winx.NTStatusError
+	if errors.As(err, &statusErr) {
+		return uint32(statusErr.Status)
 	}
+	if err != nil {
+		return uint32(winx.STATUS_UNSUCCESSFUL)
+	}
+	return 0
+}
 
-	for attempts := 0; attempts < 8; attempts++ {
-		buf := make([]byte, size)
-		ret := _NtQuerySystemInformation(class, unsafe.Pointer(&buf[0]), size, &returnLen, debug)
-		if ret == 0 {
-			// success; trim to actual returned length when sensible
-			if returnLen > 0 && returnLen <= uint32(len(buf)) {
-				return buf[:returnLen], ret
-			}
-			return buf, ret
+// QueryRaw queries a system information class and returns the output
+// buffer, growing it as directed by opts. The buffer is not returned to
+// opts.Pool. Failures are returned as an NTStatusError, or as ctx.Err() if ctx
+// is done before the query succeeds.
+func QueryRaw(ctx context.Context, class winx.SystemInformationClass, opts *QueryOptions) ([]byte, error) {
+	return querySystemInformation(ctx, class, opts.withDefaults(0))
+}
+
+// Query queries a system information class and decodes the output with the
+// decoder registered for it, for example
+//
+//	processes, err := Query[[]SystemProcess](ctx, winx.SystemProcessInformation, nil)
+//
+// The buffer is recycled through opts.Pool once decoded, so a Query polled
+// with the same pool allocates only when the output grows.
+func Query[T any](ctx context.Context, class winx.SystemInformationClass, opts *QueryOptions) (T, error) {
+	var zero T
+	decoder, err := LookupClassDecoder[T](class)
+	if err != nil {
+		return zero, err
+	}
+	o := opts.withDefaults(decoder.Size)
+	buf, err := querySystemInformation(ctx, class, o)
+	if err != nil {
+		return zero, err
+	}
+	defer o.Pool.Put(buf)
+	return decoder.Decode(buf, uintptr(unsafe.Pointer(&buf[0])), int(unsafe.Sizeof(uintptr(0))))
+}
+
+// querySystemInformation runs the sizing loop over NtQuerySystemInformation,
+// or NtQuerySystemInformationEx when o.Input is not empty
+func querySystemInformation(ctx context.Context, class winx.SystemInformationClass, o QueryOptions) ([]byte, error) {
+	ex := len(o.Input) > 0
+	name, api := fmt.Sprintf("NtQuerySystemInformation(%s)", class), procNtQuerySystemInformation
+	if ex {
+		name, api = fmt.Sprintf("NtQuerySystemInformationEx(%s)", class), procNtQuerySystemInformationEx
+	}
+	if err := api.Find(); err != nil {
+		return nil, err
+	}
+	return runQuery(ctx, name, o, func(buf []byte) (winx.NTSTATUS, uint32) {
+		var returnLen uint32
+		var ret uint32
+		if ex {
+			ret = _NtQuerySystemInformationEx(class, unsafe.Pointer(&o.Input[0]), uint32(len(o.Input)), unsafe.Pointer(&buf[0]), uint32(len(buf)), &returnLen)
+		} else {
+			ret = _NtQuerySystemInformation(class, unsafe.Pointer(&buf[0]), uint32(len(buf)), &returnLen)
 		}
+		return winx.NTSTATUS(ret), returnLen
+	})
+}
 
-		// STATUS_INFO_LENGTH_MISMATCH -> need larger buffer
-		if ret == 0xC0000004 {
-			// use returned length if provided and larger
-			if returnLen > 0 {
-				size = returnLen
-			} else {
-				// exponential backoff
-				size *= 2
-			}
-			if debug {
-				fmt.Printf("[DEBUG] STATUS_INFO_LENGTH_MISMATCH, increasing buffer to %d\n", size)
-			}
+// QuerySystemProcesses returns every process on the system along with its
+// threads, as reported by NtQuerySystemInformation(SystemProcessInformation).
+func QuerySystemProcesses() ([]SystemProcess, error) {
+	return Query[[]SystemProcess](context.Background(), winx.SystemProcessInformation, nil)
+}
+
+// QuerySystemHandles returns every open handle on the system, as reported by
+// NtQuerySystemInformation(SystemExtendedHandleInformation).
+func QuerySystemHandles() ([]handle.SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX, error) {
+	return Query[[]handle.SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX](context.Background(), winx.SystemExtendedHandleInformation, nil)
+}
+
+// QuerySystemModules returns the loaded kernel modules (ntoskrnl, hal and
+// drivers), as reported by NtQuerySystemInformation(SystemModuleInformation).
+func QuerySystemModules() (SystemModules, error) {
+	return Query[SystemModules](context.Background(), winx.SystemModuleInformation, nil)
+}
+
+// QueryProcessorPerformance returns the cumulative counters of every logical
+// processor in every active processor group, as reported by
+// NtQuerySystemInformationEx(SystemProcessorPerformanceInformation).
+// It can be passed directly to NewCPUSampler.
+func QueryProcessorPerformance() ([]ProcessorPerformance, error) {
+	// Missing before Windows 7, where there is a single group
+	groups, _, _ := procGetActiveProcessorGroupCount.Call(winx.Call{API: "GetActiveProcessorGroupCount"}, winx.ReturnsVoid)
+	if groups == 0 {
+		groups = 1
+	}
+
+	var processors []ProcessorPerformance
+	for group := uint16(0); group < uint16(groups); group++ {
+		buf, ret := NtQuerySystemInformationEx(winx.SystemProcessorPerformanceInformation, group, 0, false)
+		if ret != 0 {
+			return nil, winx.NewNTStatusError(winx.NTSTATUS(ret), fmt.Sprintf("NtQuerySystemInformationEx(SystemProcessorPerformanceInformation, group %d)", group))
+		}
+		groupProcessors, err := DecodeProcessorPerformanceInformation(buf, group)
+		if err != nil {
+			return nil, err
+		}
+		processors = append(processors, groupProcessors...)
+	}
+	return processors, nil
+}
+
+// QueryPoolTags returns the usage of every pool tag, as reported by
+// NtQuerySystemInformation(SystemPoolTagInformation).
+func QueryPoolTags() ([]PoolTagUsage, error) {
+	return Query[[]PoolTagUsage](context.Background(), winx.SystemPoolTagInformation, nil)
+}
+
+// QueryBigPool returns every big pool allocation, as reported by
+// NtQuerySystemInformation(SystemBigPoolInformation).
+func QueryBigPool() ([]BigPoolAllocation, error) {
+	return Query[[]BigPoolAllocation](context.Background(), winx.SystemBigPoolInformation, nil)
+}
+
+// queryFirmwareTable issues a SystemFirmwareTableInformation request, growing
+// the buffer to the size the kernel reports when it is too small.
+func queryFirmwareTable(provider FirmwareTableProvider, action, tableID uint32) ([]byte, error) {
+	var returnLen uint32
+	size := uint32(64 * 1024)
+
+	for attempts := 0; attempts < 4; attempts++ {
+		buf := encodeFirmwareTableRequest(provider, action, tableID, size)
+		ret := _NtQuerySystemInformation(winx.SystemFirmwareTableInformation, unsafe.Pointer(&buf[0]), uint32(len(buf)), &returnLen)
+
+		length, data, err := decodeFirmwareTableResponse(buf)
+		if ret == 0 {
+			return data, err
+		}
+		if winx.NTSTATUS(ret) == winx.STATUS_BUFFER_TOO_SMALL && length > size {
+			size = length
 			continue
 		}
+		return nil, winx.NewNTStatusError(winx.NTSTATUS(ret), fmt.Sprintf("NtQuerySystemInformation(SystemFirmwareTableInformation, %s, 0x%08X)", provider, tableID))
+	}
+	return nil, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("firmware table %s 0x%08X kept growing", provider, tableID))
+}
 
-		// other error - return immediately
-		return nil, ret
+// EnumSystemFirmwareTables returns the IDs of the tables available from a
+// firmware table provider, like the kernel32 function of the same name.
+func EnumSystemFirmwareTables(provider FirmwareTableProvider) ([]uint32, error) {
+	data, err := queryFirmwareTable(provider, SystemFirmwareTableEnumerate, 0)
+	if err != nil {
+		return nil, err
 	}
-	return nil, 0xC0000004 // give up with INFO_LENGTH_MISMATCH
+	return DecodeFirmwareTableIDs(data), nil
 }
 
-// NtQuerySystemInformationEx is a convenience wrapper around _NtQuerySystemInformationEx
-// that automatically allocates and resizes a buffer when STATUS_INFO_LENGTH_MISMATCH
-// is returned. It returns the filled byte slice and the NTSTATUS code.
-func NtQuerySystemInformationEx(
-	class uint32,
-	processorGroup uint16, // Add processor group parameter
-	initialSize uint32,
-	debug bool) ([]byte, uint32) {
+// GetSystemFirmwareTable returns a raw firmware table, like the kernel32
+// function of the same name. The 'RSMB' provider has a single table with ID 0.
+func GetSystemFirmwareTable(provider FirmwareTableProvider, tableID uint32) ([]byte, error) {
+	return queryFirmwareTable(provider, SystemFirmwareTableGet, tableID)
+}
+
+// QuerySMBIOS retrieves and parses the SMBIOS table from the 'RSMB' provider.
+func QuerySMBIOS() (*firmware.SMBIOS, error) {
+	raw, err := GetSystemFirmwareTable(FirmwareTableProviderRSMB, 0)
+	if err != nil {
+		return nil, err
+	}
+	return firmware.ParseSMBIOS(raw)
+}
+
+// QueryACPITables retrieves every table from the 'ACPI' provider and returns
+// the raw tables keyed by signature. Signatures that occur more than once,
+// such as SSDT, keep only the first table; use EnumSystemFirmwareTables and
+// GetSystemFirmwareTable to fetch every instance. Pass a table to
+// firmware.ParseACPITable or one of the specific parsers to decode it.
+func QueryACPITables() (map[string][]byte, error) {
+	ids, err := EnumSystemFirmwareTables(FirmwareTableProviderACPI)
+	if err != nil {
+		return nil, err
+	}
+	tables := make(map[string][]byte, len(ids))
+	for _, id := range ids {
+		raw, err := GetSystemFirmwareTable(FirmwareTableProviderACPI, id)
+		if err != nil {
+			return nil, err
+		}
+		header, err := firmware.ParseACPITableHeader(raw)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := tables[header.Signature]; !exists {
+			tables[header.Signature] = raw
+		}
+	}
+	return tables, nil
+}
 
+// queryFixedSystemInformation queries a class whose output has a fixed size
+// into buf, which the caller may pre-fill with input fields
+func queryFixedSystemInformation(class winx.SystemInformationClass, buf []byte) error {
 	var returnLen uint32
-	size := initialSize
+	ret := _NtQuerySystemInformation(class, unsafe.Pointer(&buf[0]), uint32(len(buf)), &returnLen)
+	if ret != 0 {
+		return winx.NewNTStatusError(winx.NTSTATUS(ret), fmt.Sprintf("NtQuerySystemInformation(%s)", class))
+	}
+	return nil
+}
 
-	if size == 0 {
-		size = 65536
+// QuerySecurityPosture gathers the code integrity options, kernel debugger
+// state, Secure Boot state and policy, boot environment and hypervisor
+// presence. Classes that fail (for example Secure Boot on BIOS systems) leave
+// their section nil and record the error in SecurityPosture.Errors; an error is
+// only returned if no class could be queried.
+func QuerySecurityPosture() (*SecurityPosture, error) {
+	posture := &SecurityPosture{Errors: make(map[winx.SystemInformationClass]error)}
+	record := func(class winx.SystemInformationClass, err error) bool {
+		if err != nil {
+			posture.Errors[class] = err
+			return false
+		}
+		return true
 	}
 
-	// Create input buffer with processor group information
-	inputBuffer := make([]byte, 4) // USHORT + padding
-	inputBuffer[0] = byte(processorGroup & 0xFF)
-	inputBuffer[1] = byte((processorGroup >> 8) & 0xFF)
-
-	for attempts := 0; attempts < 8; attempts++ {
-		buf := make([]byte, size)
-
-		ret := _NtQuerySystemInformationEx(
-			class,
-			unsafe.Pointer(&inputBuffer[0]), // Provide input buffer
-			uint32(len(inputBuffer)),        // Input buffer length
-			unsafe.Pointer(&buf[0]),
-			size,
-			&returnLen,
-			debug,
-		)
+	// SYSTEM_CODEINTEGRITY_INFORMATION.Length must be set on input
+	ci := make([]byte, 8)
+	binary.LittleEndian.PutUint32(ci, uint32(len(ci)))
+	if record(winx.SystemCodeIntegrityInformation, queryFixedSystemInformation(winx.SystemCodeIntegrityInformation, ci)) {
+		options, err := DecodeCodeIntegrityInformation(ci)
+		if record(winx.SystemCodeIntegrityInformation, err) {
+			posture.CodeIntegrity = &options
+		}
+	}
 
-		if ret == 0 {
-			if returnLen > 0 && returnLen <= uint32(len(buf)) {
-				result := make([]byte, returnLen)
-				copy(result, buf[:returnLen])
-				return result, ret
-			}
-			return buf, ret
+	kd := make([]byte, 2)
+	if record(winx.SystemKernelDebuggerInformation, queryFixedSystemInformation(winx.SystemKernelDebuggerInformation, kd)) {
+		info, err := DecodeKernelDebuggerInformation(kd)
+		if record(winx.SystemKernelDebuggerInformation, err) {
+			posture.KernelDebugger = &info
 		}
+	}
 
-		if ret == 0xC0000004 {
-			if returnLen > 0 {
-				size = returnLen
-			} else {
-				size *= 2
-				if size > 16*1024*1024 {
-					break
-				}
-			}
-			if debug {
-				fmt.Printf("[DEBUG] STATUS_INFO_LENGTH_MISMATCH, increasing buffer to %d bytes\n", size)
+	sb := make([]byte, 2)
+	if record(winx.SystemSecureBootInformation, queryFixedSystemInformation(winx.SystemSecureBootInformation, sb)) {
+		info, err := DecodeSecureBootInformation(sb)
+		if record(winx.SystemSecureBootInformation, err) {
+			policy := make([]byte, 24)
+			if info.Enabled && record(winx.SystemSecureBootPolicyInformation, queryFixedSystemInformation(winx.SystemSecureBootPolicyInformation, policy)) {
+				record(winx.SystemSecureBootPolicyInformation, DecodeSecureBootPolicyInformation(policy, &info))
 			}
-			continue
+			posture.SecureBoot = &info
 		}
+	}
+
+	boot := make([]byte, 32)
+	if record(winx.SystemBootEnvironmentInformation, queryFixedSystemInformation(winx.SystemBootEnvironmentInformation, boot)) {
+		info, err := DecodeBootEnvironmentInformation(boot)
+		if record(winx.SystemBootEnvironmentInformation, err) {
+			posture.BootEnvironment = &info
+		}
+	}
 
-		return nil, ret
+	hv := make([]byte, 16)
+	if record(winx.SystemHypervisorInformation, queryFixedSystemInformation(winx.SystemHypervisorInformation, hv)) {
+		info, err := DecodeHypervisorInformation(hv)
+		if record(winx.SystemHypervisorInformation, err) {
+			posture.Hypervisor = &info
+		}
 	}
 
-	return nil, 0xC0000004
+	if posture.CodeIntegrity == nil && posture.KernelDebugger == nil && posture.SecureBoot == nil &&
+		posture.BootEnvironment == nil && posture.Hypervisor == nil {
+		return nil, posture.Errors[winx.SystemCodeIntegrityInformation]
+	}
+	return posture, nil
 }
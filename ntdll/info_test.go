@@ -1,10 +1,17 @@
+//go:build windows
+
 package ntdll
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"testing"
 	"unsafe"
 
+	"github.com/ArkaprabhaChakraborty/winx"
 	"github.com/ArkaprabhaChakraborty/winx/exitcodes"
 )
 
@@ -27,4 +34,59 @@ func TestNtQuerySystemInformationEx(t *testing.T) {
 	if ret != 0 {
 		t.Errorf("NtQuerySystemInformationEx failed with code: 0x%08X (%s)", ret, exitcodes.FormatError(ret))
 	}
+
+	processors, err := DecodeProcessorPerformanceInformation(buf, 0)
+	if err != nil || len(processors) == 0 {
+		t.Errorf("DecodeProcessorPerformanceInformation() = %d processors, %v", len(processors), err)
+	}
+}
+
+// TestNtQuerySystemInformation_DebugIsSilent tests that the debug flag no
+// longer writes to stdout while the buffer grows
+func TestNtQuerySystemInformation_DebugIsSilent(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	_, ret := NtQuerySystemInformation(winx.SystemProcessInformation, 16, true)
+	os.Stdout = stdout
+	w.Close()
+	output, _ := io.ReadAll(r)
+	r.Close()
+
+	if ret != 0 {
+		t.Errorf("NtQuerySystemInformation failed with code: 0x%08X (%s)", ret, exitcodes.FormatError(ret))
+	}
+	if len(output) != 0 {
+		t.Errorf("debug query wrote %q to stdout", output)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	pool := NewBufferPool()
+	for i := 0; i < 2; i++ {
+		processes, err := Query[[]SystemProcess](context.Background(), winx.SystemProcessInformation, &QueryOptions{Pool: pool})
+		if err != nil || len(processes) == 0 {
+			t.Fatalf("Query(SystemProcessInformation) = %d processes, %v", len(processes), err)
+		}
+	}
+	if pool.SizeHint() == 0 {
+		t.Error("SizeHint() = 0 after a successful query")
+	}
+
+	if _, err := Query[SystemModules](context.Background(), winx.SystemProcessInformation, nil); !errors.Is(err, winx.STATUS_INVALID_PARAMETER) {
+		t.Errorf("Query with the wrong type error = %v, want STATUS_INVALID_PARAMETER", err)
+	}
+}
+
+// TestHas tests probing ntdll.dll exports
+func TestHas(t *testing.T) {
+	if !Has("NtQuerySystemInformation") {
+		t.Error(`Has("NtQuerySystemInformation") = false, want true`)
+	}
+	if Has("NtQuerySystemInformationDoesNotExist") {
+		t.Error(`Has("NtQuerySystemInformationDoesNotExist") = true, want false`)
+	}
 }
@@ -0,0 +1,431 @@
+package ntdll
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/internal/layout"
+)
+
+// MemoryState is the MEM_COMMIT, MEM_RESERVE or MEM_FREE state of a region
+type MemoryState uint32
+
+// String returns "Commit", "Reserve", "Free" or "MemoryState(0x..)"
+func (state MemoryState) String() string {
+	switch state {
+	case winx.MEM_COMMIT:
+		return "Commit"
+	case winx.MEM_RESERVE:
+		return "Reserve"
+	case winx.MEM_FREE:
+		return "Free"
+	}
+	return fmt.Sprintf("MemoryState(0x%X)", uint32(state))
+}
+
+// MemoryType is the MEM_IMAGE, MEM_MAPPED or MEM_PRIVATE type of a region,
+// 0 for free regions
+type MemoryType uint32
+
+// String returns "Image", "Mapped", "Private", "" for free regions or
+// "MemoryType(0x..)"
+func (typ MemoryType) String() string {
+	switch typ {
+	case 0:
+		return ""
+	case winx.MEM_IMAGE:
+		return "Image"
+	case winx.MEM_MAPPED:
+		return "Mapped"
+	case winx.MEM_PRIVATE:
+		return "Private"
+	}
+	return fmt.Sprintf("MemoryType(0x%X)", uint32(typ))
+}
+
+// MemoryProtection is a PAGE_* protection, optionally combined with
+// PAGE_GUARD, PAGE_NOCACHE or PAGE_WRITECOMBINE
+type MemoryProtection uint32
+
+// memoryProtectionNames names the base protections
+var memoryProtectionNames = map[MemoryProtection]string{
+	winx.PAGE_NOACCESS:          "No access",
+	winx.PAGE_READONLY:          "Read",
+	winx.PAGE_READWRITE:         "Read/Write",
+	winx.PAGE_WRITECOPY:         "Copy on write",
+	winx.PAGE_EXECUTE:           "Execute",
+	winx.PAGE_EXECUTE_READ:      "Execute/Read",
+	winx.PAGE_EXECUTE_READWRITE: "Execute/Read/Write",
+	winx.PAGE_EXECUTE_WRITECOPY: "Execute/Copy on write",
+}
+
+// String returns the protection as VMMap shows it, e.g. "Execute/Read" or
+// "Read/Write/Guard", or "" for the 0 protection of reserved and free regions
+func (protection MemoryProtection) String() string {
+	if protection == 0 {
+		return ""
+	}
+	base := protection & 0xFF
+	name, ok := memoryProtectionNames[base]
+	if !ok {
+		name = fmt.Sprintf("MemoryProtection(0x%X)", uint32(base))
+	}
+	for _, modifier := range []struct {
+		flag MemoryProtection
+		name string
+	}{{winx.PAGE_GUARD, "Guard"}, {winx.PAGE_NOCACHE, "No cache"}, {winx.PAGE_WRITECOMBINE, "Write combine"}} {
+		if protection&modifier.flag != 0 {
+			name += "/" + modifier.name
+		}
+	}
+	return name
+}
+
+// Executable reports whether code can run from pages with the protection
+func (protection MemoryProtection) Executable() bool {
+	return protection&(winx.PAGE_EXECUTE|winx.PAGE_EXECUTE_READ|winx.PAGE_EXECUTE_READWRITE|winx.PAGE_EXECUTE_WRITECOPY) != 0
+}
+
+// Writable reports whether pages with the protection can be written,
+// including copy on write
+func (protection MemoryProtection) Writable() bool {
+	return protection&(winx.PAGE_READWRITE|winx.PAGE_WRITECOPY|winx.PAGE_EXECUTE_READWRITE|winx.PAGE_EXECUTE_WRITECOPY) != 0
+}
+
+// MemoryBasicInfo is a decoded MEMORY_BASIC_INFORMATION, a run of pages with
+// the same state, type and protection
+type MemoryBasicInfo struct {
+	BaseAddress       uint64
+	AllocationBase    uint64 // 0 for free regions
+	AllocationProtect MemoryProtection
+	PartitionID       uint16 // only set by 64-bit Windows 10 1709 and later
+	RegionSize        uint64
+	State             MemoryState
+	Protect           MemoryProtection // 0 unless State is MEM_COMMIT
+	Type              MemoryType
+}
+
+// End returns the address just past the region
+func (info MemoryBasicInfo) End() uint64 {
+	return info.BaseAddress + info.RegionSize
+}
+
+// Contains reports whether address lies in the region
+func (info MemoryBasicInfo) Contains(address uint64) bool {
+	return address >= info.BaseAddress && address-info.BaseAddress < info.RegionSize
+}
+
+// DecodeMemoryBasicInformation decodes the buffer returned by
+// NtQueryVirtualMemory(MemoryBasicInformation).
+//
+// Parameters:
+//   - buf: the returned buffer, 48 bytes on 64-bit Windows and 28 on 32-bit
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the region containing the queried address
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeMemoryBasicInformation(buf []byte, pointerSize int) (MemoryBasicInfo, error) {
+	r := layout.NewReader(buf, 0, pointerSize)
+	var info MemoryBasicInfo
+	info.BaseAddress = r.Pointer()
+	info.AllocationBase = r.Pointer()
+	info.AllocationProtect = MemoryProtection(r.Uint32())
+	if pointerSize == 8 {
+		info.PartitionID = r.Uint16()
+	}
+	info.RegionSize = r.Pointer()
+	info.State = MemoryState(r.Uint32())
+	info.Protect = MemoryProtection(r.Uint32())
+	info.Type = MemoryType(r.Uint32())
+	if err := r.Err(); err != nil {
+		return MemoryBasicInfo{}, fmt.Errorf("MEMORY_BASIC_INFORMATION: %w", err)
+	}
+	return info, nil
+}
+
+// memoryBasicInformationSize returns the size of MEMORY_BASIC_INFORMATION
+func memoryBasicInformationSize(pointerSize int) int {
+	if pointerSize == 4 {
+		return 28
+	}
+	return 48
+}
+
+// DecodeMemoryMappedFilename decodes the buffer returned by
+// NtQueryVirtualMemory(MemoryMappedFilenameInformation).
+//
+// Parameters:
+//   - buf: the returned buffer, a UNICODE_STRING followed by its characters
+//   - base: the address buf was located at during the call
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the file backing the region in NT form, e.g.
+//     \Device\HarddiskVolume3\Windows\System32\ntdll.dll
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if the name lies outside buf
+func DecodeMemoryMappedFilename(buf []byte, base uintptr, pointerSize int) (string, error) {
+	return winx.DecodeUnicodeString(buf, 0, base, pointerSize)
+}
+
+// MemoryRegionType holds the flags of MEMORY_REGION_INFORMATION.RegionType
+type MemoryRegionType uint32
+
+// MemoryRegionType flags
+const (
+	MemoryRegionPrivate                MemoryRegionType = 1 << 0
+	MemoryRegionMappedDataFile         MemoryRegionType = 1 << 1
+	MemoryRegionMappedImage            MemoryRegionType = 1 << 2
+	MemoryRegionMappedPageFile         MemoryRegionType = 1 << 3
+	MemoryRegionMappedPhysical         MemoryRegionType = 1 << 4
+	MemoryRegionDirectMapped           MemoryRegionType = 1 << 5
+	MemoryRegionSoftwareEnclave        MemoryRegionType = 1 << 6
+	MemoryRegionPageSize64K            MemoryRegionType = 1 << 7
+	MemoryRegionPlaceholderReservation MemoryRegionType = 1 << 8
+	MemoryRegionMappedAwe              MemoryRegionType = 1 << 9
+	MemoryRegionMappedWriteWatch       MemoryRegionType = 1 << 10
+	MemoryRegionPageSizeLarge          MemoryRegionType = 1 << 11
+	MemoryRegionPageSizeHuge           MemoryRegionType = 1 << 12
+)
+
+// memoryRegionTypeNames is indexed by flag bit
+var memoryRegionTypeNames = [...]string{
+	"Private", "MappedDataFile", "MappedImage", "MappedPageFile", "MappedPhysical", "DirectMapped", "SoftwareEnclave",
+	"PageSize64K", "PlaceholderReservation", "MappedAwe", "MappedWriteWatch", "PageSizeLarge", "PageSizeHuge",
+}
+
+// String returns the set flags joined by "|", e.g. "MappedImage", "" for none
+func (typ MemoryRegionType) String() string {
+	var names []string
+	for bit, name := range memoryRegionTypeNames {
+		if typ&(1<<bit) != 0 {
+			names = append(names, name)
+		}
+	}
+	if unknown := typ &^ (1<<len(memoryRegionTypeNames) - 1); unknown != 0 {
+		names = append(names, fmt.Sprintf("0x%X", uint32(unknown)))
+	}
+	return strings.Join(names, "|")
+}
+
+// MemoryRegionInfo is a decoded MEMORY_REGION_INFORMATION, describing a whole
+// allocation rather than a run of pages
+type MemoryRegionInfo struct {
+	AllocationBase    uint64
+	AllocationProtect MemoryProtection
+	RegionType        MemoryRegionType
+	RegionSize        uint64 // size of the allocation
+	CommitSize        uint64 // committed bytes in the allocation
+	PartitionID       uint64 // Windows 10 1809 and later
+	NodePreference    uint64 // Windows 11 22H2 and later
+}
+
+// memoryRegionInformationSizes returns the sizes of MEMORY_REGION_INFORMATION
+// in each Windows version, largest first
+func memoryRegionInformationSizes(pointerSize int) []int {
+	short := 2*pointerSize + 8 + pointerSize
+	return []int{short + 2*pointerSize, short + pointerSize, short}
+}
+
+// DecodeMemoryRegionInformation decodes the buffer returned by
+// NtQueryVirtualMemory(MemoryRegionInformation).
+//
+// Parameters:
+//   - buf: the returned buffer, in any of the structure versions
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the allocation containing the queried address; fields missing from
+//     older structure versions are 0
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeMemoryRegionInformation(buf []byte, pointerSize int) (MemoryRegionInfo, error) {
+	sizes := memoryRegionInformationSizes(pointerSize)
+	if err := checkBuffer(buf, sizes[len(sizes)-1], "MEMORY_REGION_INFORMATION"); err != nil {
+		return MemoryRegionInfo{}, err
+	}
+	r := layout.NewReader(buf, 0, pointerSize)
+	var info MemoryRegionInfo
+	info.AllocationBase = r.Pointer()
+	info.AllocationProtect = MemoryProtection(r.Uint32())
+	info.RegionType = MemoryRegionType(r.Uint32())
+	info.RegionSize = r.Pointer()
+	info.CommitSize = r.Pointer()
+	if len(buf) >= sizes[1] {
+		info.PartitionID = r.Pointer()
+	}
+	if len(buf) >= sizes[0] {
+		info.NodePreference = r.Pointer()
+	}
+	if err := r.Err(); err != nil {
+		return MemoryRegionInfo{}, fmt.Errorf("MEMORY_REGION_INFORMATION: %w", err)
+	}
+	return info, nil
+}
+
+// MemoryRegionKind classifies regions the way VMMap does in its summary
+type MemoryRegionKind int
+
+// Memory region kinds
+const (
+	MemoryKindFree       MemoryRegionKind = iota
+	MemoryKindImage                       // mapped executable images
+	MemoryKindMappedFile                  // views of data files
+	MemoryKindShareable                   // views of page file backed sections
+	MemoryKindPrivate                     // private data, including heaps and stacks
+)
+
+// memoryRegionKindNames is indexed by kind
+var memoryRegionKindNames = [...]string{
+	MemoryKindFree:       "Free",
+	MemoryKindImage:      "Image",
+	MemoryKindMappedFile: "Mapped File",
+	MemoryKindShareable:  "Shareable",
+	MemoryKindPrivate:    "Private Data",
+}
+
+// String returns the name VMMap uses for the kind, e.g. "Mapped File"
+func (kind MemoryRegionKind) String() string {
+	if kind >= 0 && int(kind) < len(memoryRegionKindNames) {
+		return memoryRegionKindNames[kind]
+	}
+	return fmt.Sprintf("MemoryRegionKind(%d)", int(kind))
+}
+
+// MemoryRegion is a region of a process address space with the file backing
+// it, if any
+type MemoryRegion struct {
+	MemoryBasicInfo
+	FileName string // NT path of the file behind image and mapped regions
+}
+
+// Kind classifies the region
+func (region MemoryRegion) Kind() MemoryRegionKind {
+	switch {
+	case region.State == winx.MEM_FREE:
+		return MemoryKindFree
+	case region.Type == winx.MEM_IMAGE:
+		return MemoryKindImage
+	case region.Type == winx.MEM_MAPPED && region.FileName != "":
+		return MemoryKindMappedFile
+	case region.Type == winx.MEM_MAPPED:
+		return MemoryKindShareable
+	}
+	return MemoryKindPrivate
+}
+
+// MemoryQuerier queries the address space of a process.
+// ProcessMemoryQuerier queries a live process.
+type MemoryQuerier interface {
+	// BasicInformation returns the region containing address. It fails with
+	// STATUS_INVALID_PARAMETER past the end of the user address space.
+	BasicInformation(address uint64) (MemoryBasicInfo, error)
+
+	// MappedFileName returns the NT path of the file mapped at address
+	MappedFileName(address uint64) (string, error)
+}
+
+// MemoryRegions iterates over the address space of a process from address 0
+// to the highest user address, yielding every region including free ones.
+// File names are looked up for image and mapped regions; regions whose name
+// cannot be read, such as page file backed sections, are left without one.
+// The iteration stops after the first error, which is yielded with a zero
+// region.
+func MemoryRegions(querier MemoryQuerier) iter.Seq2[MemoryRegion, error] {
+	return func(yield func(MemoryRegion, error) bool) {
+		for address := uint64(0); ; {
+			info, err := querier.BasicInformation(address)
+			if err != nil {
+				if address == 0 || !errors.Is(err, winx.STATUS_INVALID_PARAMETER) {
+					yield(MemoryRegion{}, err)
+				}
+				return
+			}
+			region := MemoryRegion{MemoryBasicInfo: info}
+			if info.Type == winx.MEM_IMAGE || info.Type == winx.MEM_MAPPED {
+				region.FileName, _ = querier.MappedFileName(info.BaseAddress)
+			}
+			if !yield(region, nil) {
+				return
+			}
+
+			next := info.End()
+			if info.RegionSize == 0 || next <= address {
+				return
+			}
+			address = next
+		}
+	}
+}
+
+// ReadMemoryMap returns every region of the address space of a process, as
+// yielded by MemoryRegions.
+func ReadMemoryMap(querier MemoryQuerier) ([]MemoryRegion, error) {
+	var regions []MemoryRegion
+	for region, err := range MemoryRegions(querier) {
+		if err != nil {
+			return nil, err
+		}
+		regions = append(regions, region)
+	}
+	return regions, nil
+}
+
+// MemoryUsage totals the regions of one kind
+type MemoryUsage struct {
+	Regions     int    // runs of pages
+	Allocations int    // distinct allocation bases, 0 for free memory
+	Size        uint64 // bytes in the regions
+	Committed   uint64 // bytes in MEM_COMMIT regions
+	Reserved    uint64 // bytes in MEM_RESERVE regions
+}
+
+// add adds the usage of region
+func (usage *MemoryUsage) add(region MemoryRegion) {
+	usage.Regions++
+	usage.Size += region.RegionSize
+	switch region.State {
+	case winx.MEM_COMMIT:
+		usage.Committed += region.RegionSize
+	case winx.MEM_RESERVE:
+		usage.Reserved += region.RegionSize
+	}
+}
+
+// MemorySummary totals the regions of an address space by kind
+type MemorySummary map[MemoryRegionKind]MemoryUsage
+
+// SummarizeMemory totals regions, as returned by ReadMemoryMap, by kind
+func SummarizeMemory(regions []MemoryRegion) MemorySummary {
+	summary := make(MemorySummary)
+	allocations := make(map[uint64]bool)
+	for _, region := range regions {
+		kind := region.Kind()
+		usage := summary[kind]
+		usage.add(region)
+		if kind != MemoryKindFree && !allocations[region.AllocationBase] {
+			allocations[region.AllocationBase] = true
+			usage.Allocations++
+		}
+		summary[kind] = usage
+	}
+	return summary
+}
+
+// Total returns the usage of all allocated kinds, excluding free memory
+func (summary MemorySummary) Total() MemoryUsage {
+	var total MemoryUsage
+	for kind, usage := range summary {
+		if kind == MemoryKindFree {
+			continue
+		}
+		total.Regions += usage.Regions
+		total.Allocations += usage.Allocations
+		total.Size += usage.Size
+		total.Committed += usage.Committed
+		total.Reserved += usage.Reserved
+	}
+	return total
+}
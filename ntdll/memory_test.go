@@ -0,0 +1,244 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// TestDecodeMemoryBasicInformation tests the 32-bit and 64-bit layouts
+func TestDecodeMemoryBasicInformation(t *testing.T) {
+	for _, pointerSize := range []int{4, 8} {
+		buf := make([]byte, memoryBasicInformationSize(pointerSize))
+		put := func(offset int, v uint64) {
+			if pointerSize == 4 {
+				binary.LittleEndian.PutUint32(buf[offset:], uint32(v))
+			} else {
+				binary.LittleEndian.PutUint64(buf[offset:], v)
+			}
+		}
+		put(0, 0x7FF8A0001000)
+		put(pointerSize, 0x7FF8A0000000)
+		binary.LittleEndian.PutUint32(buf[2*pointerSize:], winx.PAGE_EXECUTE_WRITECOPY)
+		sizeOffset := 12
+		want := MemoryBasicInfo{BaseAddress: 0x7FF8A0001000, AllocationBase: 0x7FF8A0000000, AllocationProtect: winx.PAGE_EXECUTE_WRITECOPY,
+			RegionSize: 0x9000, State: winx.MEM_COMMIT, Protect: winx.PAGE_EXECUTE_READ, Type: winx.MEM_IMAGE}
+		if pointerSize == 8 {
+			binary.LittleEndian.PutUint16(buf[20:], 1)
+			sizeOffset = 24
+			want.PartitionID = 1
+		} else {
+			want.BaseAddress &= 0xFFFFFFFF
+			want.AllocationBase &= 0xFFFFFFFF
+		}
+		put(sizeOffset, 0x9000)
+		binary.LittleEndian.PutUint32(buf[sizeOffset+pointerSize:], winx.MEM_COMMIT)
+		binary.LittleEndian.PutUint32(buf[sizeOffset+pointerSize+4:], winx.PAGE_EXECUTE_READ)
+		binary.LittleEndian.PutUint32(buf[sizeOffset+pointerSize+8:], winx.MEM_IMAGE)
+
+		info, err := DecodeMemoryBasicInformation(buf, pointerSize)
+		if err != nil {
+			t.Fatalf("pointerSize %d: error = %v", pointerSize, err)
+		}
+		if info != want {
+			t.Errorf("pointerSize %d: got %+v, want %+v", pointerSize, info, want)
+		}
+		if !info.Contains(want.BaseAddress+0x8FFF) || info.Contains(info.End()) {
+			t.Errorf("pointerSize %d: Contains() is wrong at the region bounds", pointerSize)
+		}
+
+		if _, err := DecodeMemoryBasicInformation(buf[:sizeOffset+pointerSize+8], pointerSize); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+			t.Errorf("pointerSize %d: truncated error = %v", pointerSize, err)
+		}
+	}
+}
+
+// TestDecodeMemoryRegionInformation tests each version of the structure
+func TestDecodeMemoryRegionInformation(t *testing.T) {
+	for _, pointerSize := range []int{4, 8} {
+		sizes := memoryRegionInformationSizes(pointerSize)
+		for version, size := range sizes {
+			buf := make([]byte, size)
+			put := func(offset int, v uint64) {
+				if offset+pointerSize > len(buf) {
+					return
+				}
+				if pointerSize == 4 {
+					binary.LittleEndian.PutUint32(buf[offset:], uint32(v))
+				} else {
+					binary.LittleEndian.PutUint64(buf[offset:], v)
+				}
+			}
+			put(0, 0x20000)
+			binary.LittleEndian.PutUint32(buf[pointerSize:], winx.PAGE_READWRITE)
+			binary.LittleEndian.PutUint32(buf[pointerSize+4:], uint32(MemoryRegionPrivate|MemoryRegionPageSize64K))
+			put(pointerSize+8, 0x100000)
+			put(2*pointerSize+8, 0x3000)
+			put(3*pointerSize+8, 2)
+			put(4*pointerSize+8, 1)
+
+			info, err := DecodeMemoryRegionInformation(buf, pointerSize)
+			if err != nil {
+				t.Fatalf("pointerSize %d size %d: error = %v", pointerSize, size, err)
+			}
+			want := MemoryRegionInfo{AllocationBase: 0x20000, AllocationProtect: winx.PAGE_READWRITE, RegionType: MemoryRegionPrivate | MemoryRegionPageSize64K, RegionSize: 0x100000, CommitSize: 0x3000}
+			if version <= 1 {
+				want.PartitionID = 2
+			}
+			if version == 0 {
+				want.NodePreference = 1
+			}
+			if info != want {
+				t.Errorf("pointerSize %d size %d: got %+v, want %+v", pointerSize, size, info, want)
+			}
+		}
+		if _, err := DecodeMemoryRegionInformation(make([]byte, sizes[2]-1), pointerSize); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+			t.Errorf("pointerSize %d: truncated error = %v", pointerSize, err)
+		}
+	}
+}
+
+// TestMemoryStrings tests the names of states, types, protections and flags
+func TestMemoryStrings(t *testing.T) {
+	tests := []struct {
+		value fmt.Stringer
+		want  string
+	}{
+		{MemoryState(winx.MEM_COMMIT), "Commit"},
+		{MemoryState(winx.MEM_FREE), "Free"},
+		{MemoryType(winx.MEM_IMAGE), "Image"},
+		{MemoryType(0), ""},
+		{MemoryProtection(winx.PAGE_EXECUTE_READ), "Execute/Read"},
+		{MemoryProtection(winx.PAGE_READWRITE | winx.PAGE_GUARD), "Read/Write/Guard"},
+		{MemoryProtection(0), ""},
+		{MemoryProtection(0x3), "MemoryProtection(0x3)"},
+		{MemoryRegionPrivate | MemoryRegionPageSizeLarge, "Private|PageSizeLarge"},
+		{MemoryRegionType(1 << 20), "0x100000"},
+		{MemoryKindMappedFile, "Mapped File"},
+	}
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.want {
+			t.Errorf("%T(0x%X).String() = %q, want %q", tt.value, tt.value, got, tt.want)
+		}
+	}
+
+	if p := MemoryProtection(winx.PAGE_EXECUTE_WRITECOPY); !p.Executable() || !p.Writable() {
+		t.Errorf("%s: Executable() = %v, Writable() = %v", p, p.Executable(), p.Writable())
+	}
+	if p := MemoryProtection(winx.PAGE_READONLY); p.Executable() || p.Writable() {
+		t.Errorf("%s: Executable() = %v, Writable() = %v", p, p.Executable(), p.Writable())
+	}
+}
+
+// fakeAddressSpace is a MemoryQuerier over a fixed list of regions that ends
+// at the last region, as the user address space does
+type fakeAddressSpace struct {
+	regions []MemoryBasicInfo
+	files   map[uint64]string
+	fail    uint64 // address whose query fails with STATUS_ACCESS_DENIED
+}
+
+func (space fakeAddressSpace) BasicInformation(address uint64) (MemoryBasicInfo, error) {
+	if space.fail != 0 && address == space.fail {
+		return MemoryBasicInfo{}, winx.NewNTStatusError(winx.STATUS_ACCESS_DENIED, "query")
+	}
+	for _, region := range space.regions {
+		if region.Contains(address) {
+			return region, nil
+		}
+	}
+	return MemoryBasicInfo{}, winx.NewNTStatusError(winx.STATUS_INVALID_PARAMETER, "beyond the user address space")
+}
+
+func (space fakeAddressSpace) MappedFileName(address uint64) (string, error) {
+	if name, ok := space.files[address]; ok {
+		return name, nil
+	}
+	return "", winx.NewNTStatusError(winx.STATUS_FILE_INVALID, "not a file")
+}
+
+// testAddressSpace has one region of each kind
+var testAddressSpace = fakeAddressSpace{
+	regions: []MemoryBasicInfo{
+		{BaseAddress: 0, RegionSize: 0x10000, State: winx.MEM_FREE},
+		{BaseAddress: 0x10000, AllocationBase: 0x10000, RegionSize: 0x1000, State: winx.MEM_COMMIT, Protect: winx.PAGE_READWRITE, Type: winx.MEM_PRIVATE},
+		{BaseAddress: 0x11000, AllocationBase: 0x10000, RegionSize: 0xF000, State: winx.MEM_RESERVE, Type: winx.MEM_PRIVATE},
+		{BaseAddress: 0x20000, AllocationBase: 0x20000, RegionSize: 0x2000, State: winx.MEM_COMMIT, Protect: winx.PAGE_READONLY, Type: winx.MEM_MAPPED},
+		{BaseAddress: 0x22000, RegionSize: 0xE000, State: winx.MEM_FREE},
+		{BaseAddress: 0x30000, AllocationBase: 0x30000, RegionSize: 0x4000, State: winx.MEM_COMMIT, Protect: winx.PAGE_READONLY, Type: winx.MEM_MAPPED},
+		{BaseAddress: 0x34000, AllocationBase: 0x34000, RegionSize: 0x1000, State: winx.MEM_COMMIT, Protect: winx.PAGE_READONLY, Type: winx.MEM_IMAGE},
+		{BaseAddress: 0x35000, AllocationBase: 0x34000, RegionSize: 0x3000, State: winx.MEM_COMMIT, Protect: winx.PAGE_EXECUTE_READ, Type: winx.MEM_IMAGE},
+	},
+	files: map[uint64]string{
+		0x20000: `\Device\HarddiskVolume3\Windows\Fonts\arial.ttf`,
+		0x34000: `\Device\HarddiskVolume3\Windows\System32\ntdll.dll`,
+		0x35000: `\Device\HarddiskVolume3\Windows\System32\ntdll.dll`,
+	},
+}
+
+// TestMemoryRegions tests iteration over an address space and its summary
+func TestMemoryRegions(t *testing.T) {
+	regions, err := ReadMemoryMap(testAddressSpace)
+	if err != nil {
+		t.Fatalf("ReadMemoryMap() error = %v", err)
+	}
+	if len(regions) != len(testAddressSpace.regions) {
+		t.Fatalf("ReadMemoryMap() returned %d regions, want %d", len(regions), len(testAddressSpace.regions))
+	}
+	wantKinds := []MemoryRegionKind{MemoryKindFree, MemoryKindPrivate, MemoryKindPrivate, MemoryKindMappedFile, MemoryKindFree, MemoryKindShareable, MemoryKindImage, MemoryKindImage}
+	for i, region := range regions {
+		if region.MemoryBasicInfo != testAddressSpace.regions[i] || region.FileName != testAddressSpace.files[region.BaseAddress] || region.Kind() != wantKinds[i] {
+			t.Errorf("region %d = %+v (%s), want kind %s", i, region, region.Kind(), wantKinds[i])
+		}
+	}
+
+	summary := SummarizeMemory(regions)
+	tests := []struct {
+		kind MemoryRegionKind
+		want MemoryUsage
+	}{
+		{MemoryKindFree, MemoryUsage{Regions: 2, Size: 0x1E000}},
+		{MemoryKindPrivate, MemoryUsage{Regions: 2, Allocations: 1, Size: 0x10000, Committed: 0x1000, Reserved: 0xF000}},
+		{MemoryKindMappedFile, MemoryUsage{Regions: 1, Allocations: 1, Size: 0x2000, Committed: 0x2000}},
+		{MemoryKindShareable, MemoryUsage{Regions: 1, Allocations: 1, Size: 0x4000, Committed: 0x4000}},
+		{MemoryKindImage, MemoryUsage{Regions: 2, Allocations: 1, Size: 0x4000, Committed: 0x4000}},
+	}
+	for _, tt := range tests {
+		if got := summary[tt.kind]; got != tt.want {
+			t.Errorf("summary[%s] = %+v, want %+v", tt.kind, got, tt.want)
+		}
+	}
+	want := MemoryUsage{Regions: 6, Allocations: 4, Size: 0x1A000, Committed: 0xB000, Reserved: 0xF000}
+	if total := summary.Total(); total != want {
+		t.Errorf("Total() = %+v, want %+v", total, want)
+	}
+
+	// Stopping early
+	count := 0
+	for range MemoryRegions(testAddressSpace) {
+		if count++; count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Errorf("iteration ran %d times after break at 3", count)
+	}
+}
+
+// TestMemoryRegionsError tests that failures other than the end of the address
+// space are returned
+func TestMemoryRegionsError(t *testing.T) {
+	space := testAddressSpace
+	space.fail = 0x20000
+	regions, err := ReadMemoryMap(space)
+	if !errors.Is(err, winx.STATUS_ACCESS_DENIED) || regions != nil {
+		t.Errorf("ReadMemoryMap() = %d regions, %v", len(regions), err)
+	}
+
+	if _, err := ReadMemoryMap(fakeAddressSpace{}); !errors.Is(err, winx.STATUS_INVALID_PARAMETER) {
+		t.Errorf("ReadMemoryMap(empty) error = %v", err)
+	}
+}
@@ -0,0 +1,153 @@
+//go:build windows
+
+package ntdll
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/handle"
+)
+
+// _NtQueryVirtualMemory is the low-level wrapper for NtQueryVirtualMemory
+func _NtQueryVirtualMemory(
+	ProcessHandle handle.HANDLE,
+	BaseAddress uintptr,
+	MemoryInformationClass winx.MemoryInformationClass,
+	MemoryInformation unsafe.Pointer,
+	MemoryInformationLength uintptr,
+	ReturnLength *uintptr) uint32 {
+
+	ret_code, _, _ := procNtQueryVirtualMemory.Call(
+		winx.Call{API: "NtQueryVirtualMemory", Detail: fmt.Sprintf("%s at 0x%X", MemoryInformationClass, BaseAddress), OutputSize: int(MemoryInformationLength)},
+		winx.ReturnsNTSTATUS,
+		uintptr(ProcessHandle),
+		BaseAddress,
+		uintptr(MemoryInformationClass),
+		uintptr(MemoryInformation),
+		MemoryInformationLength,
+		uintptr(unsafe.Pointer(ReturnLength)),
+	)
+
+	return uint32(ret_code)
+}
+
+// NtQueryVirtualMemory is a convenience wrapper around _NtQueryVirtualMemory
+// that automatically allocates and resizes a buffer when the output does not
+// fit. It returns the filled byte slice and the NTSTATUS code.
+func NtQueryVirtualMemory(process handle.HANDLE, address uint64, class winx.MemoryInformationClass, initialSize uint32) ([]byte, uint32) {
+	buf, err := QueryVirtualMemoryRaw(context.Background(), process, address, class, &QueryOptions{InitialSize: initialSize})
+	return buf, ntStatusOf(err)
+}
+
+// QueryVirtualMemoryRaw queries a memory information class for the region
+// containing address and returns the output buffer, growing it as directed
+// by opts. Errors are as for QueryRaw. The process handle needs
+// PROCESS_QUERY_INFORMATION access, or PROCESS_QUERY_LIMITED_INFORMATION on
+// Windows 8.1 and later.
+func QueryVirtualMemoryRaw(ctx context.Context, process handle.HANDLE, address uint64, class winx.MemoryInformationClass, opts *QueryOptions) ([]byte, error) {
+	return queryVirtualMemory(ctx, process, address, class, opts.withDefaults(memoryClassSize(class)))
+}
+
+// memoryClassSize returns the initial buffer size for class
+func memoryClassSize(class winx.MemoryInformationClass) uint32 {
+	switch class {
+	case winx.MemoryBasicInformation:
+		return uint32(memoryBasicInformationSize(nativePointerSize))
+	case winx.MemoryRegionInformation:
+		return uint32(memoryRegionInformationSizes(nativePointerSize)[0])
+	}
+	return DefaultObjectQueryInitialSize
+}
+
+// queryVirtualMemory runs the sizing loop over NtQueryVirtualMemory
+func queryVirtualMemory(ctx context.Context, process handle.HANDLE, address uint64, class winx.MemoryInformationClass, o QueryOptions) ([]byte, error) {
+	if err := procNtQueryVirtualMemory.Find(); err != nil {
+		return nil, err
+	}
+	if uint64(uintptr(address)) != address {
+		return nil, winx.NewNTStatusError(winx.STATUS_INVALID_PARAMETER, fmt.Sprintf("address 0x%X is not addressable from this process", address))
+	}
+	name := fmt.Sprintf("NtQueryVirtualMemory(%s, 0x%X)", class, address)
+	return runQuery(ctx, name, o, func(buf []byte) (winx.NTSTATUS, uint32) {
+		var returnLen uintptr
+		ret := _NtQueryVirtualMemory(process, uintptr(address), class, unsafe.Pointer(&buf[0]), uintptr(len(buf)), &returnLen)
+		return winx.NTSTATUS(ret), uint32(returnLen)
+	})
+}
+
+// QueryMemoryBasicInformation returns the run of pages containing address
+// that share its state, type and protection.
+func QueryMemoryBasicInformation(process handle.HANDLE, address uint64) (MemoryBasicInfo, error) {
+	buf, err := QueryVirtualMemoryRaw(context.Background(), process, address, winx.MemoryBasicInformation, nil)
+	if err != nil {
+		return MemoryBasicInfo{}, err
+	}
+	return DecodeMemoryBasicInformation(buf, nativePointerSize)
+}
+
+// QueryMemoryMappedFileName returns the NT path of the image or data file
+// mapped at address. It fails with STATUS_FILE_INVALID or
+// STATUS_INVALID_ADDRESS for memory not backed by a file.
+func QueryMemoryMappedFileName(process handle.HANDLE, address uint64) (string, error) {
+	buf, err := QueryVirtualMemoryRaw(context.Background(), process, address, winx.MemoryMappedFilenameInformation, nil)
+	if err != nil {
+		return "", err
+	}
+	return DecodeMemoryMappedFilename(buf, uintptr(unsafe.Pointer(&buf[0])), nativePointerSize)
+}
+
+// QueryMemoryRegionInformation returns the allocation containing address with
+// its commit size. Requires Windows 10. Builds that only accept an older
+// version of the structure are retried with its size.
+func QueryMemoryRegionInformation(process handle.HANDLE, address uint64) (MemoryRegionInfo, error) {
+	var err error
+	for _, size := range memoryRegionInformationSizes(nativePointerSize) {
+		var buf []byte
+		buf, err = QueryVirtualMemoryRaw(context.Background(), process, address, winx.MemoryRegionInformation, &QueryOptions{InitialSize: uint32(size), MaxSize: uint32(size)})
+		if err == nil {
+			return DecodeMemoryRegionInformation(buf, nativePointerSize)
+		}
+		if !errors.Is(err, winx.STATUS_INFO_LENGTH_MISMATCH) {
+			break
+		}
+	}
+	return MemoryRegionInfo{}, err
+}
+
+// processMemoryQuerier queries the address space of a live process
+type processMemoryQuerier struct {
+	process handle.HANDLE
+}
+
+// ProcessMemoryQuerier returns a MemoryQuerier over the address space of
+// process, which needs the access described for QueryVirtualMemoryRaw.
+func ProcessMemoryQuerier(process handle.HANDLE) MemoryQuerier {
+	return processMemoryQuerier{process}
+}
+
+func (querier processMemoryQuerier) BasicInformation(address uint64) (MemoryBasicInfo, error) {
+	return QueryMemoryBasicInformation(querier.process, address)
+}
+
+func (querier processMemoryQuerier) MappedFileName(address uint64) (string, error) {
+	return QueryMemoryMappedFileName(querier.process, address)
+}
+
+// QueryMemoryMap returns every region of the address space of a process with
+// the files behind image and mapped regions, as VMMap shows it.
+func QueryMemoryMap(process handle.HANDLE) ([]MemoryRegion, error) {
+	return ReadMemoryMap(ProcessMemoryQuerier(process))
+}
+
+// QueryMemorySummary totals the address space of a process by region kind.
+func QueryMemorySummary(process handle.HANDLE) (MemorySummary, error) {
+	regions, err := QueryMemoryMap(process)
+	if err != nil {
+		return nil, err
+	}
+	return SummarizeMemory(regions), nil
+}
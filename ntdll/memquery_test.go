@@ -0,0 +1,51 @@
+//go:build windows
+
+package ntdll
+
+import (
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/handle"
+)
+
+// TestQueryMemoryMap tests the address space of the current process
+func TestQueryMemoryMap(t *testing.T) {
+	var local int
+	address := uint64(uintptr(unsafe.Pointer(&local)))
+	info, err := QueryMemoryBasicInformation(handle.CurrentProcess, address)
+	if err != nil {
+		t.Fatalf("QueryMemoryBasicInformation() error = %v", err)
+	}
+	if !info.Contains(address) || info.State != winx.MEM_COMMIT || !info.Protect.Writable() {
+		t.Errorf("QueryMemoryBasicInformation(&local) = %+v", info)
+	}
+
+	if region, err := QueryMemoryRegionInformation(handle.CurrentProcess, address); err != nil || region.AllocationBase != info.AllocationBase {
+		t.Errorf("QueryMemoryRegionInformation() = %+v, %v", region, err)
+	}
+
+	regions, err := QueryMemoryMap(handle.CurrentProcess)
+	if err != nil {
+		t.Fatalf("QueryMemoryMap() error = %v", err)
+	}
+	ntdll := false
+	for i, region := range regions {
+		if i > 0 && region.BaseAddress != regions[i-1].End() {
+			t.Errorf("region %d at 0x%X does not follow 0x%X", i, region.BaseAddress, regions[i-1].End())
+		}
+		if region.Kind() == MemoryKindImage && strings.HasSuffix(strings.ToLower(region.FileName), `\system32\ntdll.dll`) {
+			ntdll = true
+		}
+	}
+	if !ntdll {
+		t.Error("QueryMemoryMap() has no image region for ntdll.dll")
+	}
+
+	summary := SummarizeMemory(regions)
+	if summary[MemoryKindImage].Committed == 0 || summary[MemoryKindPrivate].Committed == 0 || summary.Total().Size == 0 {
+		t.Errorf("SummarizeMemory() = %+v", summary)
+	}
+}
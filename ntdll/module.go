@@ -0,0 +1,182 @@
+package ntdll
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/internal/layout"
+)
+
+// fullPathNameLength is the size of RTL_PROCESS_MODULE_INFORMATION.FullPathName
+const fullPathNameLength = 256
+
+// SystemModule is a decoded RTL_PROCESS_MODULE_INFORMATION entry describing a
+// loaded kernel image. The checksum, timestamp and default base are only
+// filled in by the SystemModuleInformationEx decoder.
+type SystemModule struct {
+	Name           string // file name, e.g. "ntoskrnl.exe"
+	FullPath       string // e.g. `\SystemRoot\system32\ntoskrnl.exe`
+	ImageBase      uint64
+	MappedBase     uint64
+	ImageSize      uint32
+	Flags          uint32
+	LoadOrderIndex uint16
+	InitOrderIndex uint16
+	LoadCount      uint16
+	ImageChecksum  uint32
+	TimeDateStamp  uint32
+	DefaultBase    uint64
+}
+
+// Contains reports whether address lies within the module image
+func (module *SystemModule) Contains(address uint64) bool {
+	return address >= module.ImageBase && address-module.ImageBase < uint64(module.ImageSize)
+}
+
+// SystemModules is a list of loaded kernel modules in load order
+type SystemModules []SystemModule
+
+// ByName returns the module whose file name or full path matches name,
+// ignoring case
+func (modules SystemModules) ByName(name string) (*SystemModule, bool) {
+	for i := range modules {
+		if strings.EqualFold(modules[i].Name, name) || strings.EqualFold(modules[i].FullPath, name) {
+			return &modules[i], true
+		}
+	}
+	return nil, false
+}
+
+// ByAddress returns the module whose image contains address
+func (modules SystemModules) ByAddress(address uint64) (*SystemModule, bool) {
+	for i := range modules {
+		if modules[i].Contains(address) {
+			return &modules[i], true
+		}
+	}
+	return nil, false
+}
+
+// ResolveAddress formats a kernel address as "module+0xoffset", e.g.
+// "ntoskrnl.exe+0x3A4F10". Addresses outside every module are returned as a
+// plain hexadecimal value.
+func (modules SystemModules) ResolveAddress(address uint64) string {
+	if module, ok := modules.ByAddress(address); ok {
+		return fmt.Sprintf("%s+0x%X", module.Name, address-module.ImageBase)
+	}
+	return fmt.Sprintf("0x%X", address)
+}
+
+// SortByAddress returns a copy of the modules ordered by image base
+func (modules SystemModules) SortByAddress() SystemModules {
+	sorted := append(SystemModules(nil), modules...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ImageBase < sorted[j].ImageBase })
+	return sorted
+}
+
+// DecodeSystemModuleInformation decodes the RTL_PROCESS_MODULES buffer
+// returned by NtQuerySystemInformation(SystemModuleInformation).
+//
+// Parameters:
+//   - buf: the returned buffer
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the modules in load order
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if the buffer is shorter
+//     than NumberOfModules entries
+func DecodeSystemModuleInformation(buf []byte, pointerSize int) (SystemModules, error) {
+	r := layout.NewReader(buf, 0, pointerSize)
+	count := r.Uint32()
+	r.Align(pointerSize)
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+	// every entry holds at least its FullPathName
+	if uint64(count)*fullPathNameLength > uint64(len(buf)) {
+		return nil, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("module list declares %d entries in a %d byte buffer", count, len(buf)))
+	}
+
+	modules := make(SystemModules, 0, count)
+	for i := uint32(0); i < count; i++ {
+		module := decodeSystemModule(r)
+		if err := r.Err(); err != nil {
+			return nil, err
+		}
+		modules = append(modules, module)
+	}
+	return modules, nil
+}
+
+// DecodeSystemModuleInformationEx decodes the RTL_PROCESS_MODULE_INFORMATION_EX
+// list returned by NtQuerySystemInformation(SystemModuleInformationEx) by
+// following the NextOffset chain.
+//
+// Parameters:
+//   - buf: the returned buffer
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the modules in load order, including checksum and timestamp
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if an entry is truncated
+//     or links outside buf, or STATUS_INVALID_PARAMETER if NextOffset is
+//     shorter than the entry
+func DecodeSystemModuleInformationEx(buf []byte, pointerSize int) (SystemModules, error) {
+	var modules SystemModules
+	offset := 0
+	for offset < len(buf) {
+		r := layout.NewReader(buf, offset, pointerSize)
+		next := r.Uint16()
+		r.Align(pointerSize)
+		module := decodeSystemModule(r)
+		module.ImageChecksum = r.Uint32()
+		module.TimeDateStamp = r.Uint32()
+		module.DefaultBase = r.Pointer()
+		if err := r.Err(); err != nil {
+			return nil, fmt.Errorf("module entry at offset %d: %w", offset, err)
+		}
+		modules = append(modules, module)
+
+		if next == 0 {
+			break
+		}
+		if size := r.Offset() - offset; int(next) < size {
+			return nil, winx.NewNTStatusError(winx.STATUS_INVALID_PARAMETER, fmt.Sprintf("module entry at offset %d: NextOffset %d overlaps the %d byte entry", offset, next, size))
+		}
+		if int(next) >= len(buf)-offset {
+			return nil, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("module entry at offset %d links outside the %d byte buffer", offset, len(buf)))
+		}
+		offset += int(next)
+	}
+	return modules, nil
+}
+
+// decodeSystemModule reads an RTL_PROCESS_MODULE_INFORMATION entry
+func decodeSystemModule(r *layout.Reader) SystemModule {
+	var m SystemModule
+	r.Pointer() // Section
+	m.MappedBase = r.Pointer()
+	m.ImageBase = r.Pointer()
+	m.ImageSize = r.Uint32()
+	m.Flags = r.Uint32()
+	m.LoadOrderIndex = r.Uint16()
+	m.InitOrderIndex = r.Uint16()
+	m.LoadCount = r.Uint16()
+	offsetToFileName := r.Uint16()
+	path := r.Bytes(fullPathNameLength)
+	r.Align(r.PointerSize())
+
+	if end := bytes.IndexByte(path, 0); end >= 0 {
+		path = path[:end]
+	}
+	m.FullPath = string(path)
+	if int(offsetToFileName) <= len(path) {
+		m.Name = string(path[offsetToFileName:])
+	} else {
+		m.Name = m.FullPath
+	}
+	return m
+}
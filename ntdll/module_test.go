@@ -0,0 +1,156 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+type testModule struct {
+	path      string
+	fileName  int
+	imageBase uint64
+	imageSize uint32
+}
+
+var testModules = []testModule{
+	{`\SystemRoot\system32\ntoskrnl.exe`, 21, 0xFFFFF80564000000, 0x1046000},
+	{`\SystemRoot\System32\drivers\winxdrv.sys`, 29, 0xFFFFF80570A00000, 0x9000},
+}
+
+// appendModuleEntry appends an RTL_PROCESS_MODULE_INFORMATION entry
+func appendModuleEntry(buf []byte, m testModule, index uint16, pointerSize int) []byte {
+	ptr := func(b []byte, v uint64) []byte {
+		if pointerSize == 4 {
+			return binary.LittleEndian.AppendUint32(b, uint32(v))
+		}
+		return binary.LittleEndian.AppendUint64(b, v)
+	}
+	buf = ptr(buf, 0)           // Section
+	buf = ptr(buf, 0)           // MappedBase
+	buf = ptr(buf, m.imageBase) // ImageBase
+	buf = binary.LittleEndian.AppendUint32(buf, m.imageSize)
+	buf = binary.LittleEndian.AppendUint32(buf, 0x08804000)
+	buf = binary.LittleEndian.AppendUint16(buf, index)
+	buf = binary.LittleEndian.AppendUint16(buf, 0)
+	buf = binary.LittleEndian.AppendUint16(buf, 1)
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(m.fileName))
+	path := make([]byte, fullPathNameLength)
+	copy(path, m.path)
+	buf = append(buf, path...)
+	for len(buf)%pointerSize != 0 {
+		buf = append(buf, 0)
+	}
+	return buf
+}
+
+// TestDecodeSystemModuleInformation tests RTL_PROCESS_MODULES decoding
+func TestDecodeSystemModuleInformation(t *testing.T) {
+	for _, pointerSize := range []int{4, 8} {
+		buf := binary.LittleEndian.AppendUint32(nil, uint32(len(testModules)))
+		for len(buf)%pointerSize != 0 {
+			buf = append(buf, 0)
+		}
+		for i, m := range testModules {
+			m.imageBase &= 1<<(8*pointerSize) - 1
+			buf = appendModuleEntry(buf, m, uint16(i), pointerSize)
+		}
+
+		modules, err := DecodeSystemModuleInformation(buf, pointerSize)
+		if err != nil {
+			t.Fatalf("pointerSize %d: DecodeSystemModuleInformation() error = %v", pointerSize, err)
+		}
+		if len(modules) != 2 {
+			t.Fatalf("pointerSize %d: got %d modules, want 2", pointerSize, len(modules))
+		}
+		driver := modules[1]
+		if driver.Name != "winxdrv.sys" || driver.FullPath != testModules[1].path || driver.LoadOrderIndex != 1 || driver.ImageSize != 0x9000 {
+			t.Errorf("pointerSize %d: module = %+v", pointerSize, driver)
+		}
+	}
+
+	buf := binary.LittleEndian.AppendUint32(nil, 3)
+	if _, err := DecodeSystemModuleInformation(append(buf, make([]byte, 300)...), 8); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("DecodeSystemModuleInformation(truncated) error = %v, want STATUS_BUFFER_TOO_SMALL", err)
+	}
+}
+
+// TestDecodeSystemModuleInformationEx tests the NextOffset chained variant
+func TestDecodeSystemModuleInformationEx(t *testing.T) {
+	var buf []byte
+	for i, m := range testModules {
+		start := len(buf)
+		buf = binary.LittleEndian.AppendUint16(buf, 0)
+		buf = append(buf, make([]byte, 6)...)
+		buf = appendModuleEntry(buf, m, uint16(i), 8)
+		buf = binary.LittleEndian.AppendUint32(buf, 0xABCD)
+		buf = binary.LittleEndian.AppendUint32(buf, 0x5F3E2A10)
+		buf = binary.LittleEndian.AppendUint64(buf, 0x140000000)
+		if i < len(testModules)-1 {
+			binary.LittleEndian.PutUint16(buf[start:], uint16(len(buf)-start))
+		}
+	}
+
+	modules, err := DecodeSystemModuleInformationEx(buf, 8)
+	if err != nil {
+		t.Fatalf("DecodeSystemModuleInformationEx() error = %v", err)
+	}
+	if len(modules) != 2 || modules[0].Name != "ntoskrnl.exe" || modules[1].TimeDateStamp != 0x5F3E2A10 || modules[1].DefaultBase != 0x140000000 {
+		t.Errorf("DecodeSystemModuleInformationEx() = %+v", modules)
+	}
+
+	binary.LittleEndian.PutUint16(buf, 0x200)
+	if _, err := DecodeSystemModuleInformationEx(buf, 8); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("DecodeSystemModuleInformationEx(bad link) error = %v, want STATUS_BUFFER_TOO_SMALL", err)
+	}
+
+	binary.LittleEndian.PutUint16(buf, uint16(len(buf)))
+	if _, err := DecodeSystemModuleInformationEx(buf, 8); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("DecodeSystemModuleInformationEx(link past the end) error = %v, want STATUS_BUFFER_TOO_SMALL", err)
+	}
+
+	binary.LittleEndian.PutUint16(buf, 16)
+	if _, err := DecodeSystemModuleInformationEx(buf, 8); !errors.Is(err, winx.STATUS_INVALID_PARAMETER) {
+		t.Errorf("DecodeSystemModuleInformationEx(overlapping link) error = %v, want STATUS_INVALID_PARAMETER", err)
+	}
+}
+
+// TestSystemModules_Lookup tests name and address lookups
+func TestSystemModules_Lookup(t *testing.T) {
+	modules := SystemModules{
+		{Name: "ntoskrnl.exe", FullPath: `\SystemRoot\system32\ntoskrnl.exe`, ImageBase: 0xFFFFF80564000000, ImageSize: 0x1046000},
+		{Name: "winxdrv.sys", FullPath: `\SystemRoot\System32\drivers\winxdrv.sys`, ImageBase: 0xFFFFF80570A00000, ImageSize: 0x9000},
+	}
+
+	if m, ok := modules.ByName("WINXDRV.SYS"); !ok || m.ImageBase != 0xFFFFF80570A00000 {
+		t.Errorf("ByName(WINXDRV.SYS) = %v, %v", m, ok)
+	}
+	if _, ok := modules.ByName(`\SystemRoot\system32\ntoskrnl.exe`); !ok {
+		t.Error("ByName(full path) not found")
+	}
+	if _, ok := modules.ByName("missing.sys"); ok {
+		t.Error("ByName(missing.sys) found")
+	}
+
+	tests := []struct {
+		address uint64
+		want    string
+	}{
+		{0xFFFFF805643A4F10, "ntoskrnl.exe+0x3A4F10"},
+		{0xFFFFF80570A00000, "winxdrv.sys+0x0"},
+		{0xFFFFF80570A09000, "0xFFFFF80570A09000"},
+		{0x1000, "0x1000"},
+	}
+	for _, tt := range tests {
+		if got := modules.ResolveAddress(tt.address); got != tt.want {
+			t.Errorf("ResolveAddress(0x%X) = %q, want %q", tt.address, got, tt.want)
+		}
+	}
+
+	sorted := SystemModules{modules[1], modules[0]}.SortByAddress()
+	if sorted[0].Name != "ntoskrnl.exe" {
+		t.Errorf("SortByAddress()[0] = %s", sorted[0].Name)
+	}
+}
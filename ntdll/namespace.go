@@ -0,0 +1,267 @@
+package ntdll
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// ObjectDirectoryEntry is a decoded OBJECT_DIRECTORY_INFORMATION, an object in
+// an object manager directory
+type ObjectDirectoryEntry struct {
+	Name     string
+	TypeName string // e.g. "Directory", "SymbolicLink", "Device"
+}
+
+// DecodeObjectDirectoryInformation decodes the buffer returned by
+// NtQueryDirectoryObject.
+//
+// Parameters:
+//   - buf: the returned buffer, an array of OBJECT_DIRECTORY_INFORMATION
+//     terminated by an empty entry, followed by the strings
+//   - base: the address buf was located at during the call
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the entries in the order returned
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if a name lies outside buf
+func DecodeObjectDirectoryInformation(buf []byte, base uintptr, pointerSize int) ([]ObjectDirectoryEntry, error) {
+	stringSize := winx.UnicodeStringSize(pointerSize)
+	var entries []ObjectDirectoryEntry
+	for offset := 0; offset+2*stringSize <= len(buf); offset += 2 * stringSize {
+		name, err := winx.DecodeUnicodeString(buf, offset, base, pointerSize)
+		if err != nil {
+			return nil, fmt.Errorf("OBJECT_DIRECTORY_INFORMATION %d name: %w", len(entries), err)
+		}
+		typeName, err := winx.DecodeUnicodeString(buf, offset+stringSize, base, pointerSize)
+		if err != nil {
+			return nil, fmt.Errorf("OBJECT_DIRECTORY_INFORMATION %d type: %w", len(entries), err)
+		}
+		if name == "" && typeName == "" {
+			break
+		}
+		entries = append(entries, ObjectDirectoryEntry{Name: name, TypeName: typeName})
+	}
+	return entries, nil
+}
+
+// NamespaceReader reads the object manager namespace. SystemNamespace reads
+// the namespace of the running system.
+type NamespaceReader interface {
+	// ListDirectory returns the objects in a directory, e.g. `\Device`
+	ListDirectory(path string) ([]ObjectDirectoryEntry, error)
+
+	// ReadSymbolicLink returns the target of a symbolic link, e.g.
+	// `\Device\HarddiskVolume3` for `\GLOBAL??\C:`
+	ReadSymbolicLink(path string) (string, error)
+}
+
+// DefaultNamespaceRoots are the object manager directories of interest when
+// looking for devices, drivers and named objects
+var DefaultNamespaceRoots = []string{`\Device`, `\Driver`, `\FileSystem`, `\GLOBAL??`, `\BaseNamedObjects`, `\KnownDlls`, `\RPC Control`}
+
+// NamespaceNode is an object in the object manager namespace
+type NamespaceNode struct {
+	Name     string // "" for the root directory
+	Path     string // full path, e.g. `\GLOBAL??\C:`
+	Type     string // object type name, e.g. "Directory", "SymbolicLink", "Device"
+	Target   string // the target of a symbolic link
+	Children []*NamespaceNode
+	Err      error // why the directory could not be listed or the link read
+}
+
+// IsDirectory reports whether the node is an object directory
+func (node *NamespaceNode) IsDirectory() bool {
+	return node.Type == "Directory"
+}
+
+// IsSymbolicLink reports whether the node is a symbolic link
+func (node *NamespaceNode) IsSymbolicLink() bool {
+	return node.Type == "SymbolicLink"
+}
+
+// Walk calls fn for the node and its descendants, parents before children
+func (node *NamespaceNode) Walk(fn func(*NamespaceNode)) {
+	fn(node)
+	for _, child := range node.Children {
+		child.Walk(fn)
+	}
+}
+
+// Find returns the descendant with the given path, matched
+// case-insensitively as the object manager does, or nil
+func (node *NamespaceNode) Find(path string) *NamespaceNode {
+	if strings.EqualFold(node.Path, path) {
+		return node
+	}
+	lower := strings.ToLower(path)
+	for _, child := range node.Children {
+		childPath := strings.ToLower(child.Path)
+		if lower == childPath || strings.HasPrefix(lower, childPath+`\`) {
+			return child.Find(path)
+		}
+	}
+	return nil
+}
+
+// joinNamespacePath appends name to the directory path dir
+func joinNamespacePath(dir, name string) string {
+	if strings.HasSuffix(dir, `\`) {
+		return dir + name
+	}
+	return dir + `\` + name
+}
+
+// WalkNamespace reads the object manager namespace below root into a tree.
+// Directories are descended up to maxDepth levels below root, or without
+// limit if maxDepth is 0, and symbolic link targets are read. Directories
+// and links that cannot be read, typically for lack of access, keep the error
+// in Err and the walk continues.
+//
+// Parameters:
+//   - reader: the namespace to read, usually SystemNamespace
+//   - root: the directory to start at, e.g. `\` or `\Device`
+//   - maxDepth: the number of directory levels to descend, 0 for all
+//
+// Returns:
+//   - the root node, with children sorted by name
+//   - the error listing root itself
+func WalkNamespace(reader NamespaceReader, root string, maxDepth int) (*NamespaceNode, error) {
+	node := &NamespaceNode{Name: root[strings.LastIndex(root, `\`)+1:], Path: root, Type: "Directory"}
+	walkNamespace(reader, node, 1, maxDepth)
+	if node.Err != nil {
+		return nil, node.Err
+	}
+	return node, nil
+}
+
+// walkNamespace fills in the children of the directory node
+func walkNamespace(reader NamespaceReader, node *NamespaceNode, depth, maxDepth int) {
+	entries, err := reader.ListDirectory(node.Path)
+	if err != nil {
+		node.Err = err
+		return
+	}
+	sort.Slice(entries, func(i, j int) bool { return strings.ToLower(entries[i].Name) < strings.ToLower(entries[j].Name) })
+
+	node.Children = make([]*NamespaceNode, 0, len(entries))
+	for _, entry := range entries {
+		child := &NamespaceNode{Name: entry.Name, Path: joinNamespacePath(node.Path, entry.Name), Type: entry.TypeName}
+		switch {
+		case child.IsSymbolicLink():
+			child.Target, child.Err = reader.ReadSymbolicLink(child.Path)
+		case child.IsDirectory() && (maxDepth == 0 || depth < maxDepth):
+			walkNamespace(reader, child, depth+1, maxDepth)
+		}
+		node.Children = append(node.Children, child)
+	}
+}
+
+// maxSymbolicLinkHops bounds ResolveNamespacePath on link cycles
+const maxSymbolicLinkHops = 32
+
+// ResolveNamespacePath follows the symbolic links in path, including those
+// in its parent components, and returns the path of the object it names,
+// e.g. `\Device\HarddiskVolume3\Windows` for `\??\C:\Windows`. A component
+// whose link target cannot be read is taken to be an ordinary object.
+//
+// Parameters:
+//   - reader: the namespace to resolve in, usually SystemNamespace
+//   - path: an absolute NT path
+//
+// Returns:
+//   - the resolved path
+//   - an NTStatusError with STATUS_INVALID_PARAMETER for relative paths, or
+//     with STATUS_TOO_MANY_LINKS if the links do not resolve within 32 hops
+func ResolveNamespacePath(reader NamespaceReader, path string) (string, error) {
+	if !strings.HasPrefix(path, `\`) {
+		return "", winx.NewNTStatusError(winx.STATUS_INVALID_PARAMETER, fmt.Sprintf("%q is not an absolute NT path", path))
+	}
+	for hop := 0; hop <= maxSymbolicLinkHops; hop++ {
+		components := strings.Split(strings.TrimPrefix(path, `\`), `\`)
+		resolved := true
+		for i := range components {
+			prefix := `\` + strings.Join(components[:i+1], `\`)
+			target, err := reader.ReadSymbolicLink(prefix)
+			if err != nil || target == "" {
+				continue
+			}
+			path = target
+			if rest := components[i+1:]; len(rest) > 0 {
+				path = joinNamespacePath(target, strings.Join(rest, `\`))
+			}
+			resolved = false
+			break
+		}
+		if resolved {
+			return path, nil
+		}
+	}
+	return "", winx.NewNTStatusError(winx.STATUS_TOO_MANY_LINKS, fmt.Sprintf("%q does not resolve within %d symbolic links", path, maxSymbolicLinkHops))
+}
+
+// DeviceObject is a device object with the DOS device links that name it
+type DeviceObject struct {
+	Path  string   // e.g. `\Device\Afd`
+	Links []string // e.g. `\GLOBAL??\Afd`, sorted
+}
+
+// Win32Path returns a path CreateFile accepts for the device: `\\.\` and
+// the first link name if the device has one, otherwise the device path
+// under `\\.\GLOBALROOT`.
+func (device DeviceObject) Win32Path() string {
+	if len(device.Links) > 0 {
+		return `\\.\` + device.Links[0][strings.LastIndex(device.Links[0], `\`)+1:]
+	}
+	return `\\.\GLOBALROOT` + device.Path
+}
+
+// DeviceObjects lists the device objects in a tree read from `\Device` and
+// attaches the symbolic links from a tree read from `\GLOBAL??` that target
+// them. links may be nil.
+func DeviceObjects(devices, links *NamespaceNode) []DeviceObject {
+	var objects []DeviceObject
+	index := make(map[string]int)
+	devices.Walk(func(node *NamespaceNode) {
+		if node.Type == "Device" {
+			index[strings.ToLower(node.Path)] = len(objects)
+			objects = append(objects, DeviceObject{Path: node.Path})
+		}
+	})
+	if links != nil {
+		links.Walk(func(node *NamespaceNode) {
+			if i, ok := index[strings.ToLower(node.Target)]; ok && node.IsSymbolicLink() {
+				objects[i].Links = append(objects[i].Links, node.Path)
+			}
+		})
+	}
+	for i := range objects {
+		sort.Strings(objects[i].Links)
+	}
+	return objects
+}
+
+// FindDriverDevices returns the devices whose name, or the name of a link to
+// them, is the driver name or starts with it, ignoring case. A driver's
+// device objects are not linked to its driver object in the namespace, so
+// this is how tools such as WinObj are used to find them.
+func FindDriverDevices(objects []DeviceObject, driverName string) []DeviceObject {
+	prefix := strings.ToLower(driverName)
+	matches := func(path string) bool {
+		return strings.HasPrefix(strings.ToLower(path[strings.LastIndex(path, `\`)+1:]), prefix)
+	}
+
+	var found []DeviceObject
+	for _, object := range objects {
+		match := matches(object.Path)
+		for _, link := range object.Links {
+			match = match || matches(link)
+		}
+		if match {
+			found = append(found, object)
+		}
+	}
+	return found
+}
@@ -0,0 +1,193 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// fakeNamespace is an object manager namespace held in maps
+type fakeNamespace struct {
+	directories map[string][]ObjectDirectoryEntry
+	links       map[string]string
+}
+
+func (namespace fakeNamespace) ListDirectory(path string) ([]ObjectDirectoryEntry, error) {
+	entries, ok := namespace.directories[path]
+	if !ok {
+		return nil, winx.NewNTStatusError(winx.STATUS_ACCESS_DENIED, path)
+	}
+	return append([]ObjectDirectoryEntry(nil), entries...), nil
+}
+
+func (namespace fakeNamespace) ReadSymbolicLink(path string) (string, error) {
+	for link, target := range namespace.links {
+		if strings.EqualFold(link, path) {
+			return target, nil
+		}
+	}
+	return "", winx.NewNTStatusError(winx.STATUS_OBJECT_TYPE_MISMATCH, path)
+}
+
+// testNamespace returns a small namespace with devices, links and a
+// directory that cannot be listed
+func testNamespace() fakeNamespace {
+	return fakeNamespace{
+		directories: map[string][]ObjectDirectoryEntry{
+			`\`: {{"GLOBAL??", "Directory"}, {"Device", "Directory"}, {"??", "SymbolicLink"}, {"Secret", "Directory"}},
+			`\Device`: {
+				{"Null", "Device"}, {"HarddiskVolume3", "Device"}, {"Harddisk0", "Directory"},
+				{"clfs", "Device"}, {"ClfsLog", "Device"}, {"Afd", "Device"},
+			},
+			`\Device\Harddisk0`: {{"DR0", "Device"}},
+			`\GLOBAL??`: {
+				{"C:", "SymbolicLink"}, {"NUL", "SymbolicLink"}, {"PhysicalDrive0", "SymbolicLink"},
+				{"CLFS", "SymbolicLink"}, {"Global", "SymbolicLink"}, {"Broken", "SymbolicLink"},
+			},
+		},
+		links: map[string]string{
+			`\??`:                      `\GLOBAL??`,
+			`\GLOBAL??\C:`:             `\Device\HarddiskVolume3`,
+			`\GLOBAL??\NUL`:            `\Device\Null`,
+			`\GLOBAL??\PhysicalDrive0`: `\Device\Harddisk0\DR0`,
+			`\GLOBAL??\CLFS`:           `\Device\clfs`,
+			`\GLOBAL??\Global`:         `\GLOBAL??`,
+			`\Loop1`:                   `\Loop2`,
+			`\Loop2`:                   `\Loop1`,
+		},
+	}
+}
+
+// TestDecodeObjectDirectoryInformation tests both layouts and the empty
+// terminating entry
+func TestDecodeObjectDirectoryInformation(t *testing.T) {
+	const base = 0x30000
+	want := []ObjectDirectoryEntry{{"Null", "Device"}, {"Harddisk0", "Directory"}}
+	for _, pointerSize := range []int{4, 8} {
+		stringSize := winx.UnicodeStringSize(pointerSize)
+		buf := make([]byte, (len(want)+1)*2*stringSize)
+		put := func(offset int, s string) {
+			chars := utf16.Encode([]rune(s))
+			binary.LittleEndian.PutUint16(buf[offset:], uint16(2*len(chars)))
+			binary.LittleEndian.PutUint16(buf[offset+2:], uint16(2*len(chars)+2))
+			address := uint64(base + len(buf))
+			if pointerSize == 4 {
+				binary.LittleEndian.PutUint32(buf[offset+4:], uint32(address))
+			} else {
+				binary.LittleEndian.PutUint64(buf[offset+8:], address)
+			}
+			for _, c := range chars {
+				buf = binary.LittleEndian.AppendUint16(buf, c)
+			}
+			buf = append(buf, 0, 0)
+		}
+		for i, entry := range want {
+			put(2*i*stringSize, entry.Name)
+			put((2*i+1)*stringSize, entry.TypeName)
+		}
+
+		entries, err := DecodeObjectDirectoryInformation(buf, base, pointerSize)
+		if err != nil || fmt.Sprint(entries) != fmt.Sprint(want) {
+			t.Errorf("pointerSize %d: got %v, %v, want %v", pointerSize, entries, err, want)
+		}
+		if _, err := DecodeObjectDirectoryInformation(buf[:len(buf)-4], base, pointerSize); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+			t.Errorf("pointerSize %d: truncated error = %v", pointerSize, err)
+		}
+	}
+}
+
+// TestWalkNamespace tests the tree built from a namespace
+func TestWalkNamespace(t *testing.T) {
+	namespace := testNamespace()
+	root, err := WalkNamespace(namespace, `\`, 0)
+	if err != nil {
+		t.Fatalf("WalkNamespace() error = %v", err)
+	}
+
+	var paths []string
+	root.Walk(func(node *NamespaceNode) { paths = append(paths, node.Path) })
+	if len(paths) != 18 || paths[0] != `\` || paths[1] != `\??` || paths[2] != `\Device` || paths[3] != `\Device\Afd` {
+		t.Errorf("Walk() visited %d nodes: %v", len(paths), paths)
+	}
+
+	if node := root.Find(`\global??\c:`); node == nil || !node.IsSymbolicLink() || node.Target != `\Device\HarddiskVolume3` {
+		t.Errorf("Find(C:) = %+v", node)
+	}
+	if node := root.Find(`\Device\Harddisk0\DR0`); node == nil || node.Type != "Device" {
+		t.Errorf("Find(DR0) = %+v", node)
+	}
+	if node := root.Find(`\GLOBAL??\Broken`); node == nil || !errors.Is(node.Err, winx.STATUS_OBJECT_TYPE_MISMATCH) {
+		t.Errorf("Find(Broken) = %+v", node)
+	}
+	if node := root.Find(`\Secret`); node == nil || !errors.Is(node.Err, winx.STATUS_ACCESS_DENIED) {
+		t.Errorf("Find(Secret) = %+v", node)
+	}
+	if node := root.Find(`\Device\Missing`); node != nil {
+		t.Errorf("Find(Missing) = %+v", node)
+	}
+
+	shallow, err := WalkNamespace(namespace, `\Device`, 1)
+	if err != nil || shallow.Name != "Device" || shallow.Find(`\Device\Harddisk0`).Children != nil {
+		t.Errorf("WalkNamespace(maxDepth 1) = %+v, %v", shallow, err)
+	}
+
+	if _, err := WalkNamespace(namespace, `\Secret`, 0); !errors.Is(err, winx.STATUS_ACCESS_DENIED) {
+		t.Errorf("unlistable root error = %v", err)
+	}
+}
+
+// TestResolveNamespacePath tests following links in paths
+func TestResolveNamespacePath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+		err  error
+	}{
+		{`\??\C:\Windows\System32`, `\Device\HarddiskVolume3\Windows\System32`, nil},
+		{`\GLOBAL??\Global\NUL`, `\Device\Null`, nil},
+		{`\Device\Null`, `\Device\Null`, nil},
+		{`\Loop1\x`, "", winx.STATUS_TOO_MANY_LINKS},
+		{`C:\Windows`, "", winx.STATUS_INVALID_PARAMETER},
+	}
+	for _, tt := range tests {
+		got, err := ResolveNamespacePath(testNamespace(), tt.path)
+		if got != tt.want || (tt.err == nil) != (err == nil) || tt.err != nil && !errors.Is(err, tt.err) {
+			t.Errorf("ResolveNamespacePath(%q) = %q, %v, want %q, %v", tt.path, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+// TestFindDriverDevices tests matching devices and links to a driver name
+func TestFindDriverDevices(t *testing.T) {
+	namespace := testNamespace()
+	devices, _ := WalkNamespace(namespace, `\Device`, 0)
+	links, _ := WalkNamespace(namespace, `\GLOBAL??`, 1)
+	objects := DeviceObjects(devices, links)
+	if len(objects) != 6 {
+		t.Fatalf("DeviceObjects() = %+v", objects)
+	}
+
+	tests := []struct {
+		driver string
+		want   []string
+	}{
+		{"CLFS", []string{`\\.\CLFS`, `\\.\GLOBALROOT\Device\ClfsLog`}},
+		{"afd", []string{`\\.\GLOBALROOT\Device\Afd`}},
+		{"PhysicalDrive", []string{`\\.\PhysicalDrive0`}},
+		{"Missing", nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, device := range FindDriverDevices(objects, tt.driver) {
+			got = append(got, device.Win32Path())
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("FindDriverDevices(%q) = %v, want %v", tt.driver, got, tt.want)
+		}
+	}
+}
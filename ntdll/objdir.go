@@ -0,0 +1,235 @@
+//go:build windows
+
+package ntdll
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/handle"
+)
+
+// _NtOpenDirectoryObject is the low-level wrapper for NtOpenDirectoryObject
+func _NtOpenDirectoryObject(
+	DirectoryHandle *handle.HANDLE,
+	DesiredAccess uint32,
+	ObjectAttributes *winx.OBJECT_ATTRIBUTES) uint32 {
+
+	ret_code, _, _ := procNtOpenDirectoryObject.Call(
+		winx.Call{API: "NtOpenDirectoryObject", Detail: ObjectAttributes.Name()},
+		winx.ReturnsNTSTATUS,
+		uintptr(unsafe.Pointer(DirectoryHandle)),
+		uintptr(DesiredAccess),
+		uintptr(unsafe.Pointer(ObjectAttributes)),
+	)
+	return uint32(ret_code)
+}
+
+// NtOpenDirectoryObject opens an object manager directory, e.g. `\Device`,
+// with the given DIRECTORY_* access rights. Close the handle with NtClose.
+func NtOpenDirectoryObject(path string, access uint32) (handle.HANDLE, error) {
+	oa, err := winx.NewObjectAttributes(path, winx.OBJ_CASE_INSENSITIVE, 0, nil)
+	if err != nil {
+		return 0, err
+	}
+	var h handle.HANDLE
+	status := winx.NTSTATUS(_NtOpenDirectoryObject(&h, access, oa))
+	runtime.KeepAlive(oa)
+	if status != winx.STATUS_SUCCESS {
+		return 0, winx.NewNTStatusError(status, fmt.Sprintf("NtOpenDirectoryObject(%s)", path))
+	}
+	return h, nil
+}
+
+// _NtQueryDirectoryObject is the low-level wrapper for NtQueryDirectoryObject
+func _NtQueryDirectoryObject(
+	DirectoryHandle handle.HANDLE,
+	Buffer unsafe.Pointer,
+	Length uint32,
+	ReturnSingleEntry bool,
+	RestartScan bool,
+	Context *uint32,
+	ReturnLength *uint32) uint32 {
+
+	ret_code, _, _ := procNtQueryDirectoryObject.Call(
+		winx.Call{API: "NtQueryDirectoryObject", OutputSize: int(Length)},
+		winx.ReturnsNTSTATUS,
+		uintptr(DirectoryHandle),
+		uintptr(Buffer),
+		uintptr(Length),
+		boolArg(ReturnSingleEntry),
+		boolArg(RestartScan),
+		uintptr(unsafe.Pointer(Context)),
+		uintptr(unsafe.Pointer(ReturnLength)),
+	)
+	return uint32(ret_code)
+}
+
+// boolArg converts a BOOLEAN argument
+func boolArg(b bool) uintptr {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// QueryDirectoryObject returns every object in a directory opened with
+// DIRECTORY_QUERY access, in the order the object manager returns them.
+func QueryDirectoryObject(directory handle.HANDLE) ([]ObjectDirectoryEntry, error) {
+	var entries []ObjectDirectoryEntry
+	var context uint32
+	buf := make([]byte, DefaultQueryInitialSize)
+	for restart := true; ; restart = false {
+		var returnLen uint32
+		status := winx.NTSTATUS(_NtQueryDirectoryObject(directory, unsafe.Pointer(&buf[0]), uint32(len(buf)), false, restart, &context, &returnLen))
+		switch status {
+		case winx.STATUS_SUCCESS, winx.STATUS_MORE_ENTRIES:
+			batch, err := DecodeObjectDirectoryInformation(buf, uintptr(unsafe.Pointer(&buf[0])), nativePointerSize)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, batch...)
+			if len(batch) == 0 {
+				return entries, nil
+			}
+		case winx.STATUS_NO_MORE_ENTRIES:
+			return entries, nil
+		case winx.STATUS_BUFFER_TOO_SMALL:
+			// A single entry does not fit; the context was not advanced
+			if len(buf) >= DefaultQueryMaxSize {
+				return nil, winx.NewNTStatusError(status, "NtQueryDirectoryObject: entry larger than the maximum buffer")
+			}
+			buf = make([]byte, 2*len(buf))
+		default:
+			return nil, winx.NewNTStatusError(status, "NtQueryDirectoryObject")
+		}
+	}
+}
+
+// _NtOpenSymbolicLinkObject is the low-level wrapper for NtOpenSymbolicLinkObject
+func _NtOpenSymbolicLinkObject(
+	LinkHandle *handle.HANDLE,
+	DesiredAccess uint32,
+	ObjectAttributes *winx.OBJECT_ATTRIBUTES) uint32 {
+
+	ret_code, _, _ := procNtOpenSymbolicLinkObject.Call(
+		winx.Call{API: "NtOpenSymbolicLinkObject", Detail: ObjectAttributes.Name()},
+		winx.ReturnsNTSTATUS,
+		uintptr(unsafe.Pointer(LinkHandle)),
+		uintptr(DesiredAccess),
+		uintptr(unsafe.Pointer(ObjectAttributes)),
+	)
+	return uint32(ret_code)
+}
+
+// NtOpenSymbolicLinkObject opens a symbolic link object, e.g. `\GLOBAL??\C:`,
+// with the given SYMBOLIC_LINK_* access rights. Close the handle with NtClose.
+func NtOpenSymbolicLinkObject(path string, access uint32) (handle.HANDLE, error) {
+	oa, err := winx.NewObjectAttributes(path, winx.OBJ_CASE_INSENSITIVE, 0, nil)
+	if err != nil {
+		return 0, err
+	}
+	var h handle.HANDLE
+	status := winx.NTSTATUS(_NtOpenSymbolicLinkObject(&h, access, oa))
+	runtime.KeepAlive(oa)
+	if status != winx.STATUS_SUCCESS {
+		return 0, winx.NewNTStatusError(status, fmt.Sprintf("NtOpenSymbolicLinkObject(%s)", path))
+	}
+	return h, nil
+}
+
+// _NtQuerySymbolicLinkObject is the low-level wrapper for NtQuerySymbolicLinkObject
+func _NtQuerySymbolicLinkObject(
+	LinkHandle handle.HANDLE,
+	LinkTarget *winx.UNICODE_STRING,
+	ReturnedLength *uint32) uint32 {
+
+	ret_code, _, _ := procNtQuerySymbolicLinkObject.Call(
+		winx.Call{API: "NtQuerySymbolicLinkObject", OutputSize: int(LinkTarget.MaximumLength)},
+		winx.ReturnsNTSTATUS,
+		uintptr(LinkHandle),
+		uintptr(unsafe.Pointer(LinkTarget)),
+		uintptr(unsafe.Pointer(ReturnedLength)),
+	)
+	return uint32(ret_code)
+}
+
+// QuerySymbolicLinkObject returns the target of a symbolic link opened with
+// SYMBOLIC_LINK_QUERY access.
+func QuerySymbolicLinkObject(link handle.HANDLE) (string, error) {
+	size := uint32(DefaultObjectQueryInitialSize)
+	for {
+		buf := make([]uint16, size/2)
+		target := winx.UNICODE_STRING{MaximumLength: uint16(size), Buffer: &buf[0]}
+		var returned uint32
+		status := winx.NTSTATUS(_NtQuerySymbolicLinkObject(link, &target, &returned))
+		switch {
+		case status == winx.STATUS_SUCCESS:
+			return target.String(), nil
+		case status == winx.STATUS_BUFFER_TOO_SMALL && returned > size && returned <= 0xFFFE:
+			size = returned
+		default:
+			return "", winx.NewNTStatusError(status, "NtQuerySymbolicLinkObject")
+		}
+	}
+}
+
+// systemNamespace reads the object manager namespace of the running system
+type systemNamespace struct{}
+
+// SystemNamespace is the object manager namespace of the running system, as
+// visible to the current process
+var SystemNamespace NamespaceReader = systemNamespace{}
+
+func (systemNamespace) ListDirectory(path string) ([]ObjectDirectoryEntry, error) {
+	h, err := NtOpenDirectoryObject(path, winx.DIRECTORY_QUERY)
+	if err != nil {
+		return nil, err
+	}
+	defer NtClose(h)
+	return QueryDirectoryObject(h)
+}
+
+func (systemNamespace) ReadSymbolicLink(path string) (string, error) {
+	h, err := NtOpenSymbolicLinkObject(path, winx.SYMBOLIC_LINK_QUERY)
+	if err != nil {
+		return "", err
+	}
+	defer NtClose(h)
+	return QuerySymbolicLinkObject(h)
+}
+
+// BrowseNamespace walks the system namespace below each of roots, or below
+// DefaultNamespaceRoots if none are given, descending maxDepth directory
+// levels (0 for all). A root that cannot be listed is returned as a node with
+// Err set.
+func BrowseNamespace(maxDepth int, roots ...string) []*NamespaceNode {
+	if len(roots) == 0 {
+		roots = DefaultNamespaceRoots
+	}
+	nodes := make([]*NamespaceNode, 0, len(roots))
+	for _, root := range roots {
+		node, err := WalkNamespace(SystemNamespace, root, maxDepth)
+		if err != nil {
+			node = &NamespaceNode{Path: root, Type: "Directory", Err: err}
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// QueryDeviceObjects returns every device object under `\Device` with the
+// links in `\GLOBAL??` that name it.
+func QueryDeviceObjects() ([]DeviceObject, error) {
+	devices, err := WalkNamespace(SystemNamespace, `\Device`, 0)
+	if err != nil {
+		return nil, err
+	}
+	links, err := WalkNamespace(SystemNamespace, `\GLOBAL??`, 1)
+	if err != nil {
+		return nil, err
+	}
+	return DeviceObjects(devices, links), nil
+}
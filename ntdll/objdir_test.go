@@ -0,0 +1,42 @@
+//go:build windows
+
+package ntdll
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestSystemNamespace tests directory listing and link resolution on the
+// running system
+func TestSystemNamespace(t *testing.T) {
+	root, err := WalkNamespace(SystemNamespace, `\`, 1)
+	if err != nil {
+		t.Fatalf("WalkNamespace() error = %v", err)
+	}
+	for _, path := range []string{`\Device`, `\Driver`, `\GLOBAL??`, `\KnownDlls`} {
+		if node := root.Find(path); node == nil || !node.IsDirectory() {
+			t.Errorf("Find(%s) = %+v", path, node)
+		}
+	}
+
+	if target, err := SystemNamespace.ReadSymbolicLink(`\GLOBAL??\NUL`); err != nil || target != `\Device\Null` {
+		t.Errorf("ReadSymbolicLink(NUL) = %q, %v", target, err)
+	}
+
+	windir := os.Getenv("SystemRoot") // C:\Windows
+	resolved, err := ResolveNamespacePath(SystemNamespace, `\??\`+windir)
+	if err != nil || !strings.HasPrefix(resolved, `\Device\`) || !strings.HasSuffix(resolved, windir[2:]) {
+		t.Errorf("ResolveNamespacePath(%s) = %q, %v", windir, resolved, err)
+	}
+
+	devices, err := QueryDeviceObjects()
+	if err != nil {
+		t.Fatalf("QueryDeviceObjects() error = %v", err)
+	}
+	null := FindDriverDevices(devices, "Null")
+	if len(null) == 0 || null[0].Path != `\Device\Null` || null[0].Win32Path() != `\\.\NUL` {
+		t.Errorf("FindDriverDevices(Null) = %+v", null)
+	}
+}
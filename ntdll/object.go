@@ -0,0 +1,258 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/internal/layout"
+)
+
+// ObjectBasicInfo is a decoded OBJECT_BASIC_INFORMATION
+type ObjectBasicInfo struct {
+	Attributes             uint32 // OBJ_* flags of the handle, e.g. OBJ_INHERIT
+	GrantedAccess          uint32
+	HandleCount            uint32
+	PointerCount           uint32
+	PagedPoolCharge        uint32
+	NonPagedPoolCharge     uint32
+	NameInfoSize           uint32
+	TypeInfoSize           uint32
+	SecurityDescriptorSize uint32
+	CreationTime           time.Time // only set for symbolic links
+}
+
+// objectBasicInformationSize is the size of OBJECT_BASIC_INFORMATION, which
+// does not depend on the pointer size
+const objectBasicInformationSize = 56
+
+// DecodeObjectBasicInformation decodes the buffer returned by
+// NtQueryObject(ObjectBasicInformation).
+//
+// Parameters:
+//   - buf: the returned buffer, 56 bytes
+//
+// Returns:
+//   - the decoded information
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeObjectBasicInformation(buf []byte) (ObjectBasicInfo, error) {
+	if err := checkBuffer(buf, objectBasicInformationSize, "OBJECT_BASIC_INFORMATION"); err != nil {
+		return ObjectBasicInfo{}, err
+	}
+	return ObjectBasicInfo{
+		Attributes:             binary.LittleEndian.Uint32(buf[0:]),
+		GrantedAccess:          binary.LittleEndian.Uint32(buf[4:]),
+		HandleCount:            binary.LittleEndian.Uint32(buf[8:]),
+		PointerCount:           binary.LittleEndian.Uint32(buf[12:]),
+		PagedPoolCharge:        binary.LittleEndian.Uint32(buf[16:]),
+		NonPagedPoolCharge:     binary.LittleEndian.Uint32(buf[20:]),
+		NameInfoSize:           binary.LittleEndian.Uint32(buf[36:]),
+		TypeInfoSize:           binary.LittleEndian.Uint32(buf[40:]),
+		SecurityDescriptorSize: binary.LittleEndian.Uint32(buf[44:]),
+		CreationTime:           fileTime(int64(binary.LittleEndian.Uint64(buf[48:]))),
+	}, nil
+}
+
+// DecodeObjectName decodes the buffer returned by
+// NtQueryObject(ObjectNameInformation).
+//
+// Parameters:
+//   - buf: the returned buffer, a UNICODE_STRING followed by its characters
+//   - base: the address buf was located at during the call
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the object name in NT form, e.g. \Device\HarddiskVolume3\Windows;
+//     "" for unnamed objects
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if the name lies outside buf
+func DecodeObjectName(buf []byte, base uintptr, pointerSize int) (string, error) {
+	return winx.DecodeUnicodeString(buf, 0, base, pointerSize)
+}
+
+// GenericMapping is a decoded GENERIC_MAPPING, the specific rights each
+// generic right maps to for an object type
+type GenericMapping struct {
+	GenericRead    uint32
+	GenericWrite   uint32
+	GenericExecute uint32
+	GenericAll     uint32
+}
+
+// ObjectType is a decoded OBJECT_TYPE_INFORMATION
+type ObjectType struct {
+	Name                      string // e.g. "File", "Key", "Process"
+	Index                     uint16 // the ObjectTypeIndex of handle table entries
+	Objects                   uint32
+	Handles                   uint32
+	PagedPoolUsage            uint32
+	NonPagedPoolUsage         uint32
+	NamePoolUsage             uint32
+	HandleTableUsage          uint32
+	HighWaterObjects          uint32
+	HighWaterHandles          uint32
+	InvalidAttributes         uint32
+	GenericMapping            GenericMapping
+	ValidAccessMask           uint32
+	SecurityRequired          bool
+	MaintainHandleCount       bool
+	PoolType                  uint32
+	DefaultPagedPoolCharge    uint32
+	DefaultNonPagedPoolCharge uint32
+}
+
+// objectTypeInformationSize returns the size of OBJECT_TYPE_INFORMATION
+// without the type name that follows it
+func objectTypeInformationSize(pointerSize int) int {
+	if pointerSize == 4 {
+		return 96
+	}
+	return 104
+}
+
+// decodeObjectType decodes the OBJECT_TYPE_INFORMATION at offset in buf
+func decodeObjectType(buf []byte, offset int, base uintptr, pointerSize int) (ObjectType, error) {
+	if offset+objectTypeInformationSize(pointerSize) > len(buf) {
+		return ObjectType{}, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("OBJECT_TYPE_INFORMATION at offset %d is outside the %d byte buffer", offset, len(buf)))
+	}
+	name, err := winx.DecodeUnicodeString(buf, offset, base, pointerSize)
+	if err != nil {
+		return ObjectType{}, fmt.Errorf("OBJECT_TYPE_INFORMATION.TypeName: %w", err)
+	}
+
+	r := layout.NewReader(buf, offset+winx.UnicodeStringSize(pointerSize), pointerSize)
+	t := ObjectType{Name: name}
+	t.Objects = r.Uint32()
+	t.Handles = r.Uint32()
+	t.PagedPoolUsage = r.Uint32()
+	t.NonPagedPoolUsage = r.Uint32()
+	t.NamePoolUsage = r.Uint32()
+	t.HandleTableUsage = r.Uint32()
+	t.HighWaterObjects = r.Uint32()
+	t.HighWaterHandles = r.Uint32()
+	r.Skip(16) // HighWaterPagedPoolUsage ... HighWaterHandleTableUsage
+	t.InvalidAttributes = r.Uint32()
+	t.GenericMapping = GenericMapping{r.Uint32(), r.Uint32(), r.Uint32(), r.Uint32()}
+	t.ValidAccessMask = r.Uint32()
+	t.SecurityRequired = r.Uint8() != 0
+	t.MaintainHandleCount = r.Uint8() != 0
+	t.Index = uint16(r.Uint8())
+	r.Uint8() // ReservedByte
+	t.PoolType = r.Uint32()
+	t.DefaultPagedPoolCharge = r.Uint32()
+	t.DefaultNonPagedPoolCharge = r.Uint32()
+	if err := r.Err(); err != nil {
+		return ObjectType{}, fmt.Errorf("OBJECT_TYPE_INFORMATION: %w", err)
+	}
+	return t, nil
+}
+
+// DecodeObjectTypeInformation decodes the buffer returned by
+// NtQueryObject(ObjectTypeInformation).
+//
+// Parameters:
+//   - buf: the returned buffer, an OBJECT_TYPE_INFORMATION followed by the
+//     type name
+//   - base: the address buf was located at during the call
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the type of the object; Index is 0 before Windows 8.1
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeObjectTypeInformation(buf []byte, base uintptr, pointerSize int) (ObjectType, error) {
+	return decodeObjectType(buf, 0, base, pointerSize)
+}
+
+// ObjectTypeTable maps the ObjectTypeIndex of handle table entries to the
+// object type
+type ObjectTypeTable map[uint16]ObjectType
+
+// Name returns the name of the type with the given index, e.g. "File", or
+// "ObjectType(n)" if the index is not in the table.
+func (table ObjectTypeTable) Name(index uint16) string {
+	if t, ok := table[index]; ok {
+		return t.Name
+	}
+	return fmt.Sprintf("ObjectType(%d)", index)
+}
+
+// ByName returns the type with the given name, matched case-insensitively
+func (table ObjectTypeTable) ByName(name string) (ObjectType, bool) {
+	for _, t := range table {
+		if strings.EqualFold(t.Name, name) {
+			return t, true
+		}
+	}
+	return ObjectType{}, false
+}
+
+// Sorted returns the types ordered by index
+func (table ObjectTypeTable) Sorted() []ObjectType {
+	types := make([]ObjectType, 0, len(table))
+	for _, t := range table {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Index < types[j].Index })
+	return types
+}
+
+// blockingFileAccess holds the granted access masks of File handles that are
+// usually synchronous named pipes, on which a name query blocks until the
+// pipe is read
+var blockingFileAccess = map[uint32]bool{
+	0x0012019F: true, // read, write and synchronize
+	0x001A019F: true,
+	0x00120189: true,
+	0x00100000: true, // synchronize only
+}
+
+// nameQueryMayBlock reports whether a name query on a File handle with the
+// given granted access may never return
+func nameQueryMayBlock(grantedAccess uint32) bool {
+	return blockingFileAccess[grantedAccess]
+}
+
+// DecodeObjectTypesInformation decodes the buffer returned by
+// NtQueryObject(ObjectTypesInformation), the statistics of every object type.
+//
+// Parameters:
+//   - buf: the returned buffer
+//   - base: the address buf was located at during the call
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the types by index. Before Windows 8.1 the structure has no TypeIndex
+//     and the index is derived from the position, starting at 2
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeObjectTypesInformation(buf []byte, base uintptr, pointerSize int) (ObjectTypeTable, error) {
+	if err := checkBuffer(buf, 4, "OBJECT_TYPES_INFORMATION"); err != nil {
+		return nil, err
+	}
+	count := int(binary.LittleEndian.Uint32(buf))
+	entrySize := objectTypeInformationSize(pointerSize)
+	if count > len(buf)/entrySize {
+		return nil, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("OBJECT_TYPES_INFORMATION declares %d types but the buffer holds at most %d", count, len(buf)/entrySize))
+	}
+
+	align := func(offset int) int {
+		return (offset + pointerSize - 1) &^ (pointerSize - 1)
+	}
+	table := make(ObjectTypeTable, count)
+	offset := align(4)
+	for i := 0; i < count; i++ {
+		t, err := decodeObjectType(buf, offset, base, pointerSize)
+		if err != nil {
+			return nil, fmt.Errorf("type %d: %w", i, err)
+		}
+		if t.Index == 0 {
+			t.Index = uint16(i + 2)
+		}
+		table[t.Index] = t
+
+		maximumLength := int(binary.LittleEndian.Uint16(buf[offset+2:]))
+		offset = align(offset + entrySize + maximumLength)
+	}
+	return table, nil
+}
@@ -0,0 +1,143 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// TestDecodeObjectBasicInformation tests OBJECT_BASIC_INFORMATION decoding
+func TestDecodeObjectBasicInformation(t *testing.T) {
+	buf := make([]byte, objectBasicInformationSize)
+	binary.LittleEndian.PutUint32(buf[0:], winx.OBJ_INHERIT)
+	binary.LittleEndian.PutUint32(buf[4:], 0x1F0003)
+	binary.LittleEndian.PutUint32(buf[8:], 3)
+	binary.LittleEndian.PutUint32(buf[12:], 65537)
+	binary.LittleEndian.PutUint32(buf[40:], 0x70)
+	created := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	binary.LittleEndian.PutUint64(buf[48:], uint64(created.UnixNano()/100+fileTimeEpochDelta))
+
+	info, err := DecodeObjectBasicInformation(buf)
+	if err != nil {
+		t.Fatalf("DecodeObjectBasicInformation() error = %v", err)
+	}
+	want := ObjectBasicInfo{Attributes: winx.OBJ_INHERIT, GrantedAccess: 0x1F0003, HandleCount: 3, PointerCount: 65537, TypeInfoSize: 0x70, CreationTime: created}
+	if info != want {
+		t.Errorf("DecodeObjectBasicInformation() = %+v, want %+v", info, want)
+	}
+	if _, err := DecodeObjectBasicInformation(buf[:55]); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("truncated error = %v", err)
+	}
+}
+
+// appendObjectType appends an OBJECT_TYPE_INFORMATION and its name to buf as
+// NtQueryObject(ObjectTypesInformation) lays them out
+func appendObjectType(buf []byte, base uintptr, pointerSize int, name string, index uint8, objects uint32) []byte {
+	chars := utf16.Encode([]rune(name))
+	entry := make([]byte, objectTypeInformationSize(pointerSize))
+	binary.LittleEndian.PutUint16(entry[0:], uint16(2*len(chars)))
+	binary.LittleEndian.PutUint16(entry[2:], uint16(2*len(chars)+2))
+	nameAddress := uint64(base) + uint64(len(buf)+len(entry))
+	if pointerSize == 4 {
+		binary.LittleEndian.PutUint32(entry[4:], uint32(nameAddress))
+	} else {
+		binary.LittleEndian.PutUint64(entry[8:], nameAddress)
+	}
+	fields := entry[winx.UnicodeStringSize(pointerSize):]
+	binary.LittleEndian.PutUint32(fields[0:], objects)
+	binary.LittleEndian.PutUint32(fields[4:], 2*objects)
+	binary.LittleEndian.PutUint32(fields[52:], 0x20000) // GENERIC_MAPPING.GenericRead
+	binary.LittleEndian.PutUint32(fields[68:], 0x1FFFFF)
+	fields[72] = 1
+	fields[74] = index
+	binary.LittleEndian.PutUint32(fields[76:], 1)
+
+	buf = append(buf, entry...)
+	for _, c := range chars {
+		buf = binary.LittleEndian.AppendUint16(buf, c)
+	}
+	buf = append(buf, 0, 0)
+	for len(buf)%pointerSize != 0 {
+		buf = append(buf, 0)
+	}
+	return buf
+}
+
+// TestDecodeObjectTypesInformation tests the type table for both layouts and
+// the positional index used before Windows 8.1
+func TestDecodeObjectTypesInformation(t *testing.T) {
+	const base = 0x20000
+	for _, pointerSize := range []int{4, 8} {
+		for _, indexed := range []bool{true, false} {
+			index := func(i uint8) uint8 {
+				if indexed {
+					return i
+				}
+				return 0
+			}
+			buf := make([]byte, pointerSize)
+			binary.LittleEndian.PutUint32(buf, 3)
+			buf = appendObjectType(buf, base, pointerSize, "Type", index(2), 70)
+			buf = appendObjectType(buf, base, pointerSize, "Directory", index(3), 120)
+			buf = appendObjectType(buf, base, pointerSize, "SymbolicLink", index(4), 300)
+
+			table, err := DecodeObjectTypesInformation(buf, base, pointerSize)
+			if err != nil {
+				t.Fatalf("pointerSize %d indexed %v: error = %v", pointerSize, indexed, err)
+			}
+			link, ok := table[4]
+			if len(table) != 3 || !ok || link.Name != "SymbolicLink" || link.Objects != 300 || link.Handles != 600 ||
+				link.GenericMapping.GenericRead != 0x20000 || link.ValidAccessMask != 0x1FFFFF || !link.SecurityRequired || link.PoolType != 1 {
+				t.Errorf("pointerSize %d indexed %v: table = %+v", pointerSize, indexed, table)
+			}
+			if table.Name(3) != "Directory" || table.Name(9) != "ObjectType(9)" {
+				t.Errorf("pointerSize %d indexed %v: Name(3) = %q, Name(9) = %q", pointerSize, indexed, table.Name(3), table.Name(9))
+			}
+
+			if _, err := DecodeObjectTypesInformation(buf[:len(buf)-64], base, pointerSize); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+				t.Errorf("pointerSize %d indexed %v: truncated error = %v", pointerSize, indexed, err)
+			}
+		}
+	}
+}
+
+// TestObjectTypeTable tests lookups in the type table
+func TestObjectTypeTable(t *testing.T) {
+	table := ObjectTypeTable{
+		37: {Name: "File", Index: 37},
+		7:  {Name: "Process", Index: 7},
+		44: {Name: "Key", Index: 44},
+	}
+	if file, ok := table.ByName("file"); !ok || file.Index != 37 {
+		t.Errorf("ByName(\"file\") = %+v, %v", file, ok)
+	}
+	if _, ok := table.ByName("Mutant"); ok {
+		t.Error("ByName(\"Mutant\") ok = true")
+	}
+	sorted := table.Sorted()
+	if len(sorted) != 3 || sorted[0].Name != "Process" || sorted[1].Name != "File" || sorted[2].Name != "Key" {
+		t.Errorf("Sorted() = %+v", sorted)
+	}
+}
+
+// TestNameQueryMayBlock tests the access masks of synchronous pipe handles
+func TestNameQueryMayBlock(t *testing.T) {
+	tests := []struct {
+		access uint32
+		want   bool
+	}{
+		{0x0012019F, true},
+		{0x00100000, true},
+		{0x00120089, false},
+		{0x001F01FF, false},
+	}
+	for _, tt := range tests {
+		if got := nameQueryMayBlock(tt.access); got != tt.want {
+			t.Errorf("nameQueryMayBlock(0x%08X) = %v, want %v", tt.access, got, tt.want)
+		}
+	}
+}
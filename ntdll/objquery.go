@@ -0,0 +1,198 @@
+//go:build windows
+
+package ntdll
+
+import (
+	"context"
+	"fmt"
+	"unsafe"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/handle"
+)
+
+// DefaultObjectQueryInitialSize is the first buffer size tried for object
+// names and types when QueryOptions.InitialSize is 0
+const DefaultObjectQueryInitialSize = 512
+
+// _NtQueryObject is the low-level wrapper for NtQueryObject
+func _NtQueryObject(
+	Handle handle.HANDLE,
+	ObjectInformationClass winx.ObjectInformationClass,
+	ObjectInformation unsafe.Pointer,
+	ObjectInformationLength uint32,
+	ReturnLength *uint32) uint32 {
+
+	ret_code, _, _ := procNtQueryObject.Call(
+		winx.Call{API: "NtQueryObject", Detail: ObjectInformationClass.String(), OutputSize: int(ObjectInformationLength)},
+		winx.ReturnsNTSTATUS,
+		uintptr(Handle),
+		uintptr(ObjectInformationClass),
+		uintptr(ObjectInformation),
+		uintptr(ObjectInformationLength),
+		uintptr(unsafe.Pointer(ReturnLength)),
+	)
+
+	return uint32(ret_code)
+}
+
+// NtQueryObject is a convenience wrapper around _NtQueryObject that
+// automatically allocates and resizes a buffer when STATUS_INFO_LENGTH_MISMATCH
+// is returned. It returns the filled byte slice and the NTSTATUS code. The
+// handle is ignored, and may be 0, for ObjectTypesInformation.
+func NtQueryObject(h handle.HANDLE, class winx.ObjectInformationClass, initialSize uint32) ([]byte, uint32) {
+	buf, err := QueryObjectRaw(context.Background(), h, class, &QueryOptions{InitialSize: initialSize})
+	return buf, ntStatusOf(err)
+}
+
+// QueryObjectRaw queries an object information class for a handle and
+// returns the output buffer, growing it as directed by opts. Errors are as
+// for QueryRaw.
+//
+// Querying the name of a file handle for a synchronous named pipe blocks
+// until the pipe is read; HandleNamer guards against this with a timeout.
+func QueryObjectRaw(ctx context.Context, h handle.HANDLE, class winx.ObjectInformationClass, opts *QueryOptions) ([]byte, error) {
+	return queryObjectInformation(ctx, h, class, opts.withDefaults(objectClassSize(class)))
+}
+
+// objectClassSize returns the initial buffer size for class
+func objectClassSize(class winx.ObjectInformationClass) uint32 {
+	switch class {
+	case winx.ObjectBasicInformation:
+		return objectBasicInformationSize
+	case winx.ObjectTypesInformation:
+		return DefaultQueryInitialSize
+	}
+	return DefaultObjectQueryInitialSize
+}
+
+// queryObjectInformation runs the sizing loop over NtQueryObject
+func queryObjectInformation(ctx context.Context, h handle.HANDLE, class winx.ObjectInformationClass, o QueryOptions) ([]byte, error) {
+	if err := procNtQueryObject.Find(); err != nil {
+		return nil, err
+	}
+	name := fmt.Sprintf("NtQueryObject(%s)", class)
+	return runQuery(ctx, name, o, func(buf []byte) (winx.NTSTATUS, uint32) {
+		var returnLen uint32
+		ret := _NtQueryObject(h, class, unsafe.Pointer(&buf[0]), uint32(len(buf)), &returnLen)
+		return winx.NTSTATUS(ret), returnLen
+	})
+}
+
+// queryObject queries class for h and decodes the output with decode
+func queryObject[T any](h handle.HANDLE, class winx.ObjectInformationClass, decode func([]byte, uintptr, int) (T, error)) (T, error) {
+	buf, err := QueryObjectRaw(context.Background(), h, class, nil)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return decode(buf, uintptr(unsafe.Pointer(&buf[0])), nativePointerSize)
+}
+
+// QueryObjectBasicInformation returns the granted access, attributes and
+// reference counts of a handle.
+func QueryObjectBasicInformation(h handle.HANDLE) (ObjectBasicInfo, error) {
+	return queryObject(h, winx.ObjectBasicInformation, fixedLayout(DecodeObjectBasicInformation))
+}
+
+// QueryObjectName returns the name of the object behind a handle in NT form,
+// or "" for unnamed objects. See QueryObjectRaw about named pipes.
+func QueryObjectName(h handle.HANDLE) (string, error) {
+	return queryObject(h, winx.ObjectNameInformation, DecodeObjectName)
+}
+
+// QueryObjectType returns the type of the object behind a handle.
+func QueryObjectType(h handle.HANDLE) (ObjectType, error) {
+	return queryObject(h, winx.ObjectTypeInformation, DecodeObjectTypeInformation)
+}
+
+// QueryObjectTypes returns every object type with its index and statistics,
+// for naming the ObjectTypeIndex of handle table entries.
+func QueryObjectTypes() (ObjectTypeTable, error) {
+	return queryObject(0, winx.ObjectTypesInformation, DecodeObjectTypesInformation)
+}
+
+// _NtClose is the low-level wrapper for NtClose
+func _NtClose(Handle handle.HANDLE) uint32 {
+	ret_code, _, _ := procNtClose.Call(winx.Call{API: "NtClose"}, winx.ReturnsNTSTATUS, uintptr(Handle))
+	return uint32(ret_code)
+}
+
+// NtClose closes a handle.
+func NtClose(h handle.HANDLE) error {
+	if status := winx.NTSTATUS(_NtClose(h)); status != winx.STATUS_SUCCESS {
+		return winx.NewNTStatusError(status, fmt.Sprintf("NtClose(0x%X)", uintptr(h)))
+	}
+	return nil
+}
+
+// clientID is a CLIENT_ID
+type clientID struct {
+	UniqueProcess uintptr
+	UniqueThread  uintptr
+}
+
+// _NtOpenProcess is the low-level wrapper for NtOpenProcess
+func _NtOpenProcess(
+	ProcessHandle *handle.HANDLE,
+	DesiredAccess uint32,
+	ObjectAttributes *winx.OBJECT_ATTRIBUTES,
+	ClientId *clientID) uint32 {
+
+	ret_code, _, _ := procNtOpenProcess.Call(
+		winx.Call{API: "NtOpenProcess", Detail: fmt.Sprintf("PID %d", ClientId.UniqueProcess)},
+		winx.ReturnsNTSTATUS,
+		uintptr(unsafe.Pointer(ProcessHandle)),
+		uintptr(DesiredAccess),
+		uintptr(unsafe.Pointer(ObjectAttributes)),
+		uintptr(unsafe.Pointer(ClientId)),
+	)
+	return uint32(ret_code)
+}
+
+// NtOpenProcess opens a process by ID with the given PROCESS_* access rights.
+// Close the handle with NtClose.
+func NtOpenProcess(pid uint64, access uint32) (handle.HANDLE, error) {
+	var h handle.HANDLE
+	client := clientID{UniqueProcess: uintptr(pid)}
+	if status := winx.NTSTATUS(_NtOpenProcess(&h, access, winx.InitializeObjectAttributes(nil, 0, 0, nil), &client)); status != winx.STATUS_SUCCESS {
+		return 0, winx.NewNTStatusError(status, fmt.Sprintf("NtOpenProcess(%d, 0x%X)", pid, access))
+	}
+	return h, nil
+}
+
+// _NtDuplicateObject is the low-level wrapper for NtDuplicateObject
+func _NtDuplicateObject(
+	SourceProcessHandle handle.HANDLE,
+	SourceHandle handle.HANDLE,
+	TargetProcessHandle handle.HANDLE,
+	TargetHandle *handle.HANDLE,
+	DesiredAccess uint32,
+	HandleAttributes uint32,
+	Options uint32) uint32 {
+
+	ret_code, _, _ := procNtDuplicateObject.Call(
+		winx.Call{API: "NtDuplicateObject"},
+		winx.ReturnsNTSTATUS,
+		uintptr(SourceProcessHandle),
+		uintptr(SourceHandle),
+		uintptr(TargetProcessHandle),
+		uintptr(unsafe.Pointer(TargetHandle)),
+		uintptr(DesiredAccess),
+		uintptr(HandleAttributes),
+		uintptr(Options),
+	)
+	return uint32(ret_code)
+}
+
+// NtDuplicateObject copies a handle of sourceProcess, which needs
+// PROCESS_DUP_HANDLE access, into the current process. options takes
+// DUPLICATE_* flags; with DUPLICATE_SAME_ACCESS access is ignored. Close the
+// copy with NtClose.
+func NtDuplicateObject(sourceProcess, source handle.HANDLE, access, options uint32) (handle.HANDLE, error) {
+	var h handle.HANDLE
+	if status := winx.NTSTATUS(_NtDuplicateObject(sourceProcess, source, handle.CurrentProcess, &h, access, 0, options)); status != winx.STATUS_SUCCESS {
+		return 0, winx.NewNTStatusError(status, fmt.Sprintf("NtDuplicateObject(0x%X)", uintptr(source)))
+	}
+	return h, nil
+}
@@ -0,0 +1,86 @@
+//go:build windows
+
+package ntdll
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/handle"
+)
+
+// TestQueryObject tests object queries on a handle to the test executable
+func TestQueryObject(t *testing.T) {
+	executable, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(executable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	h := handle.HANDLE(f.Fd())
+
+	name, err := QueryObjectName(h)
+	if err != nil || !strings.HasPrefix(name, `\Device\`) || !strings.HasSuffix(strings.ToLower(name), strings.ToLower(executable[2:])) {
+		t.Errorf("QueryObjectName() = %q, %v", name, err)
+	}
+	if typ, err := QueryObjectType(h); err != nil || typ.Name != "File" {
+		t.Errorf("QueryObjectType() = %+v, %v", typ, err)
+	}
+	if basic, err := QueryObjectBasicInformation(h); err != nil || basic.HandleCount == 0 {
+		t.Errorf("QueryObjectBasicInformation() = %+v, %v", basic, err)
+	}
+
+	types, err := QueryObjectTypes()
+	if err != nil {
+		t.Fatalf("QueryObjectTypes() error = %v", err)
+	}
+	for _, name := range []string{"File", "Key", "Process", "Thread", "Directory"} {
+		if _, ok := types.ByName(name); !ok {
+			t.Errorf("QueryObjectTypes() has no %s type", name)
+		}
+	}
+
+	if _, status := NtQueryObject(0, winx.ObjectTypesInformation, 0); status != 0 {
+		t.Errorf("NtQueryObject() status = 0x%08X", status)
+	}
+}
+
+// TestHandleNamer tests naming the handles of the current process
+func TestHandleNamer(t *testing.T) {
+	namer, err := NewHandleNamer()
+	if err != nil {
+		t.Fatalf("NewHandleNamer() error = %v", err)
+	}
+	defer namer.Close()
+
+	executable, _ := os.Executable()
+	f, err := os.Open(executable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	handles, err := QuerySystemHandles()
+	if err != nil {
+		t.Fatalf("QuerySystemHandles() error = %v", err)
+	}
+	var own []handle.SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX
+	for _, entry := range handles {
+		if entry.UniqueProcessId == uintptr(os.Getpid()) && entry.HandleValue == f.Fd() {
+			own = append(own, entry)
+		}
+	}
+	if len(own) != 1 {
+		t.Fatalf("found %d entries for the file handle", len(own))
+	}
+
+	named := handle.Enrich(own, namer)
+	if named[0].TypeName != "File" || named[0].NameErr != nil || !strings.HasPrefix(named[0].ObjectName, `\Device\`) {
+		t.Errorf("Enrich() = %+v", named[0])
+	}
+}
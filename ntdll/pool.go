@@ -0,0 +1,206 @@
+package ntdll
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/internal/layout"
+)
+
+// PoolTag is the four character tag passed to ExAllocatePoolWithTag.
+// Tag[0] is the first character as written in source, e.g. "Proc".
+type PoolTag [4]byte
+
+// protectedPoolBit is set in the last tag byte of PROTECTED_POOL allocations
+const protectedPoolBit = 0x80
+
+// ParsePoolTag converts a tag of up to four characters to a PoolTag, padding
+// short tags with spaces as pooltag.txt and poolmon do ("Io" becomes "Io  ").
+func ParsePoolTag(s string) (PoolTag, error) {
+	if len(s) == 0 || len(s) > 4 {
+		return PoolTag{}, fmt.Errorf("invalid pool tag %q: must be 1 to 4 characters", s)
+	}
+	tag := PoolTag{' ', ' ', ' ', ' '}
+	copy(tag[:], s)
+	return tag, nil
+}
+
+// String returns the tag characters, with non-printable bytes shown as '?'
+func (tag PoolTag) String() string {
+	b := tag.Unprotected()
+	for i, c := range b {
+		if c < 0x20 || c > 0x7E {
+			b[i] = '?'
+		}
+	}
+	return string(b[:])
+}
+
+// Unprotected returns the tag with the PROTECTED_POOL bit cleared
+func (tag PoolTag) Unprotected() PoolTag {
+	tag[3] &^= protectedPoolBit
+	return tag
+}
+
+// PoolTagUsage is a decoded SYSTEM_POOLTAG entry
+type PoolTagUsage struct {
+	Tag            PoolTag
+	PagedAllocs    uint32
+	PagedFrees     uint32
+	PagedUsed      uint64 // bytes
+	NonPagedAllocs uint32
+	NonPagedFrees  uint32
+	NonPagedUsed   uint64 // bytes
+}
+
+// BigPoolAllocation is a decoded SYSTEM_BIGPOOL_ENTRY, describing a single
+// allocation of a page or more
+type BigPoolAllocation struct {
+	Address  uint64
+	Size     uint64
+	NonPaged bool
+	Tag      PoolTag
+}
+
+// DecodeSystemPoolTagInformation decodes the buffer returned by
+// NtQuerySystemInformation(SystemPoolTagInformation).
+//
+// Parameters:
+//   - buf: the returned buffer
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - one entry per pool tag
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if the buffer is shorter
+//     than Count entries
+func DecodeSystemPoolTagInformation(buf []byte, pointerSize int) ([]PoolTagUsage, error) {
+	entrySize := 0x28
+	if pointerSize == 4 {
+		entrySize = 0x1C
+	}
+	r, count, err := readPoolCount(buf, pointerSize, entrySize)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]PoolTagUsage, count)
+	for i := range tags {
+		t := &tags[i]
+		copy(t.Tag[:], r.Bytes(4))
+		t.PagedAllocs = r.Uint32()
+		t.PagedFrees = r.Uint32()
+		t.PagedUsed = r.Pointer()
+		t.NonPagedAllocs = r.Uint32()
+		t.NonPagedFrees = r.Uint32()
+		t.NonPagedUsed = r.Pointer()
+	}
+	return tags, r.Err()
+}
+
+// DecodeSystemBigPoolInformation decodes the buffer returned by
+// NtQuerySystemInformation(SystemBigPoolInformation).
+//
+// Parameters:
+//   - buf: the returned buffer
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - one entry per allocation
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if the buffer is shorter
+//     than Count entries
+func DecodeSystemBigPoolInformation(buf []byte, pointerSize int) ([]BigPoolAllocation, error) {
+	entrySize := 0x18
+	if pointerSize == 4 {
+		entrySize = 0x0C
+	}
+	r, count, err := readPoolCount(buf, pointerSize, entrySize)
+	if err != nil {
+		return nil, err
+	}
+
+	allocations := make([]BigPoolAllocation, count)
+	for i := range allocations {
+		a := &allocations[i]
+		address := r.Pointer()
+		a.Address = address &^ 1
+		a.NonPaged = address&1 != 0
+		a.Size = r.Pointer()
+		copy(a.Tag[:], r.Bytes(4))
+		r.Align(pointerSize)
+	}
+	return allocations, r.Err()
+}
+
+// readPoolCount reads the ULONG Count header shared by both classes and checks
+// that count entries of entrySize bytes follow it
+func readPoolCount(buf []byte, pointerSize, entrySize int) (*layout.Reader, int, error) {
+	r := layout.NewReader(buf, 0, pointerSize)
+	count := uint64(r.Uint32())
+	r.Align(pointerSize)
+	if err := r.Err(); err != nil {
+		return nil, 0, err
+	}
+	if r.Offset() > len(buf) || count > uint64(len(buf)-r.Offset())/uint64(entrySize) {
+		return nil, 0, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("pool information declares %d entries in a %d byte buffer", count, len(buf)))
+	}
+	return r, int(count), nil
+}
+
+// PoolTagDelta is the change in usage of a pool tag between two snapshots
+type PoolTagDelta struct {
+	Tag                 PoolTag
+	PagedUsed           int64 // change in paged bytes
+	NonPagedUsed        int64 // change in nonpaged bytes
+	PagedOutstanding    int64 // change in paged allocations not yet freed
+	NonPagedOutstanding int64 // change in nonpaged allocations not yet freed
+}
+
+// Growth returns the total change in bytes across paged and nonpaged pool
+func (delta PoolTagDelta) Growth() int64 {
+	return delta.PagedUsed + delta.NonPagedUsed
+}
+
+// DiffPoolTags compares two pool tag snapshots and returns the tags whose
+// usage changed, ranked by Growth with the largest growth first. Tags present
+// in only one snapshot are compared against zero usage.
+func DiffPoolTags(before, after []PoolTagUsage) []PoolTagDelta {
+	previous := make(map[PoolTag]PoolTagUsage, len(before))
+	for _, usage := range before {
+		previous[usage.Tag] = usage
+	}
+
+	var deltas []PoolTagDelta
+	addDelta := func(old, current PoolTagUsage) {
+		delta := PoolTagDelta{
+			Tag:                 current.Tag,
+			PagedUsed:           int64(current.PagedUsed) - int64(old.PagedUsed),
+			NonPagedUsed:        int64(current.NonPagedUsed) - int64(old.NonPagedUsed),
+			PagedOutstanding:    outstanding(current.PagedAllocs, current.PagedFrees) - outstanding(old.PagedAllocs, old.PagedFrees),
+			NonPagedOutstanding: outstanding(current.NonPagedAllocs, current.NonPagedFrees) - outstanding(old.NonPagedAllocs, old.NonPagedFrees),
+		}
+		if delta != (PoolTagDelta{Tag: delta.Tag}) {
+			deltas = append(deltas, delta)
+		}
+	}
+
+	for _, usage := range after {
+		addDelta(previous[usage.Tag], usage)
+		delete(previous, usage.Tag)
+	}
+	for tag, usage := range previous {
+		addDelta(usage, PoolTagUsage{Tag: tag})
+	}
+
+	sort.Slice(deltas, func(i, j int) bool {
+		if gi, gj := deltas[i].Growth(), deltas[j].Growth(); gi != gj {
+			return gi > gj
+		}
+		return string(deltas[i].Tag[:]) < string(deltas[j].Tag[:])
+	})
+	return deltas
+}
+
+func outstanding(allocs, frees uint32) int64 {
+	return int64(allocs) - int64(frees)
+}
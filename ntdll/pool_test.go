@@ -0,0 +1,149 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+func appendPointer(buf []byte, v uint64, pointerSize int) []byte {
+	if pointerSize == 4 {
+		return binary.LittleEndian.AppendUint32(buf, uint32(v))
+	}
+	return binary.LittleEndian.AppendUint64(buf, v)
+}
+
+func appendPadding(buf []byte, align int) []byte {
+	for len(buf)%align != 0 {
+		buf = append(buf, 0)
+	}
+	return buf
+}
+
+// TestParsePoolTag tests padding and display of tags
+func TestParsePoolTag(t *testing.T) {
+	tag, err := ParsePoolTag("Io")
+	if err != nil || tag != (PoolTag{'I', 'o', ' ', ' '}) {
+		t.Errorf("ParsePoolTag(Io) = %q, %v", tag, err)
+	}
+	if _, err := ParsePoolTag("Toolong"); err == nil {
+		t.Error("ParsePoolTag(Toolong) error = nil")
+	}
+	if got := (PoolTag{'P', 'r', 'o', 'c' | protectedPoolBit}).String(); got != "Proc" {
+		t.Errorf("protected tag String() = %q, want Proc", got)
+	}
+	if got := (PoolTag{'A', 0, 1, 'B'}).String(); got != "A??B" {
+		t.Errorf("String() = %q, want A??B", got)
+	}
+}
+
+// TestDecodeSystemPoolTagInformation tests SYSTEM_POOLTAG decoding
+func TestDecodeSystemPoolTagInformation(t *testing.T) {
+	for _, pointerSize := range []int{4, 8} {
+		buf := binary.LittleEndian.AppendUint32(nil, 2)
+		buf = appendPadding(buf, pointerSize)
+		for i, tag := range []string{"Proc", "MyDr"} {
+			buf = append(buf, tag...)
+			buf = binary.LittleEndian.AppendUint32(buf, 10)
+			buf = binary.LittleEndian.AppendUint32(buf, 4)
+			buf = appendPadding(buf, pointerSize)
+			buf = appendPointer(buf, 0x1000*uint64(i+1), pointerSize)
+			buf = binary.LittleEndian.AppendUint32(buf, 20)
+			buf = binary.LittleEndian.AppendUint32(buf, 5)
+			buf = appendPointer(buf, 0x8000*uint64(i+1), pointerSize)
+		}
+
+		tags, err := DecodeSystemPoolTagInformation(buf, pointerSize)
+		if err != nil {
+			t.Fatalf("pointerSize %d: DecodeSystemPoolTagInformation() error = %v", pointerSize, err)
+		}
+		want := PoolTagUsage{PoolTag{'M', 'y', 'D', 'r'}, 10, 4, 0x2000, 20, 5, 0x10000}
+		if len(tags) != 2 || tags[1] != want {
+			t.Errorf("pointerSize %d: tags = %+v, want second %+v", pointerSize, tags, want)
+		}
+	}
+
+	buf := binary.LittleEndian.AppendUint32(nil, 100)
+	if _, err := DecodeSystemPoolTagInformation(append(buf, make([]byte, 80)...), 8); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("DecodeSystemPoolTagInformation(truncated) error = %v", err)
+	}
+}
+
+// TestDecodeSystemBigPoolInformation tests SYSTEM_BIGPOOL_ENTRY decoding
+func TestDecodeSystemBigPoolInformation(t *testing.T) {
+	for _, pointerSize := range []int{4, 8} {
+		base := uint64(0xFFFFB00012340000)
+		if pointerSize == 4 {
+			base = 0x85A00000
+		}
+		buf := binary.LittleEndian.AppendUint32(nil, 2)
+		buf = appendPadding(buf, pointerSize)
+		buf = appendPointer(buf, base, pointerSize)
+		buf = appendPointer(buf, 0x3000, pointerSize)
+		buf = append(buf, "Cont"...)
+		buf = appendPadding(buf, pointerSize)
+		buf = appendPointer(buf, base+0x10000|1, pointerSize)
+		buf = appendPointer(buf, 0x1000, pointerSize)
+		buf = append(buf, "MmCa"...)
+		buf = appendPadding(buf, pointerSize)
+
+		allocations, err := DecodeSystemBigPoolInformation(buf, pointerSize)
+		if err != nil {
+			t.Fatalf("pointerSize %d: DecodeSystemBigPoolInformation() error = %v", pointerSize, err)
+		}
+		if len(allocations) != 2 {
+			t.Fatalf("pointerSize %d: got %d allocations, want 2", pointerSize, len(allocations))
+		}
+		if a := allocations[0]; a.Address != base || a.NonPaged || a.Size != 0x3000 || a.Tag.String() != "Cont" {
+			t.Errorf("pointerSize %d: allocation 0 = %+v", pointerSize, a)
+		}
+		if a := allocations[1]; a.Address != base+0x10000 || !a.NonPaged || a.Tag.String() != "MmCa" {
+			t.Errorf("pointerSize %d: allocation 1 = %+v", pointerSize, a)
+		}
+	}
+}
+
+// TestDiffPoolTags tests ranking of tags by growth between snapshots
+func TestDiffPoolTags(t *testing.T) {
+	tag := func(s string) PoolTag {
+		tag, _ := ParsePoolTag(s)
+		return tag
+	}
+	before := []PoolTagUsage{
+		{Tag: tag("Same"), NonPagedAllocs: 5, NonPagedUsed: 500},
+		{Tag: tag("Leak"), NonPagedAllocs: 10, NonPagedFrees: 2, NonPagedUsed: 0x1000},
+		{Tag: tag("Gone"), PagedAllocs: 3, PagedUsed: 0x300},
+		{Tag: tag("Grow"), PagedAllocs: 1, PagedUsed: 0x100},
+	}
+	after := []PoolTagUsage{
+		{Tag: tag("Same"), NonPagedAllocs: 5, NonPagedUsed: 500},
+		{Tag: tag("Leak"), NonPagedAllocs: 110, NonPagedFrees: 2, NonPagedUsed: 0x65000},
+		{Tag: tag("Grow"), PagedAllocs: 2, PagedUsed: 0x200},
+		{Tag: tag("New"), PagedAllocs: 1, PagedUsed: 0x80},
+	}
+
+	deltas := DiffPoolTags(before, after)
+	var order []string
+	for _, d := range deltas {
+		order = append(order, d.Tag.String())
+	}
+	want := []string{"Leak", "Grow", "New ", "Gone"}
+	if len(order) != len(want) {
+		t.Fatalf("DiffPoolTags() order = %q, want %q", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("DiffPoolTags() order = %q, want %q", order, want)
+		}
+	}
+
+	leak := deltas[0]
+	if leak.NonPagedUsed != 0x64000 || leak.NonPagedOutstanding != 100 || leak.Growth() != 0x64000 {
+		t.Errorf("Leak delta = %+v", leak)
+	}
+	if gone := deltas[3]; gone.PagedUsed != -0x300 || gone.PagedOutstanding != -3 {
+		t.Errorf("Gone delta = %+v", gone)
+	}
+}
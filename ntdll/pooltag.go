@@ -0,0 +1,117 @@
+package ntdll
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"strings"
+)
+
+//go:embed data/pooltag.txt
+var defaultPoolTagTable string
+
+// defaultPoolTags is the database parsed from the embedded pooltag.txt
+var defaultPoolTags *PoolTagDatabase
+
+func init() {
+	var err error
+	defaultPoolTags, err = ParsePoolTagDatabase(strings.NewReader(defaultPoolTagTable))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// PoolTagInfo describes the owner of a pool tag
+type PoolTagInfo struct {
+	Tag         PoolTag
+	Driver      string // e.g. "ntfs.sys" or "nt!mm", empty if unknown
+	Description string
+}
+
+// PoolTagDatabase maps pool tags to their owning drivers
+type PoolTagDatabase struct {
+	tags map[PoolTag]PoolTagInfo
+}
+
+// DefaultPoolTagDatabase returns the database embedded in the package, which
+// covers common kernel and inbox driver tags.
+func DefaultPoolTagDatabase() *PoolTagDatabase {
+	return defaultPoolTags
+}
+
+// ParsePoolTagDatabase reads a database in pooltag.txt format, as shipped with
+// the Debugging Tools for Windows:
+//
+//	Proc - nt!ps         - Process objects
+//
+// Lines starting with "rem" or "//", and lines without a " - " separator, are
+// ignored. An owner of "<unknown>" is stored as an empty Driver. When a tag is
+// listed more than once the first entry wins.
+func ParsePoolTagDatabase(r io.Reader) (*PoolTagDatabase, error) {
+	db := &PoolTagDatabase{tags: make(map[PoolTag]PoolTagInfo)}
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		trimmed := strings.TrimSpace(line)
+		lower := strings.ToLower(trimmed)
+		if trimmed == "" || strings.HasPrefix(trimmed, "//") || lower == "rem" || strings.HasPrefix(lower, "rem ") {
+			continue
+		}
+
+		fields := strings.SplitN(line, " - ", 3)
+		if len(fields) < 2 {
+			continue
+		}
+		tag, err := ParsePoolTag(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return nil, fmt.Errorf("pooltag.txt:%d: %v", lineNumber, err)
+		}
+
+		info := PoolTagInfo{Tag: tag}
+		if len(fields) == 3 {
+			info.Driver = strings.TrimSpace(fields[1])
+			info.Description = strings.TrimSpace(fields[2])
+		} else {
+			info.Description = strings.TrimSpace(fields[1])
+		}
+		if info.Driver == "<unknown>" {
+			info.Driver = ""
+		}
+		if _, exists := db.tags[tag]; !exists {
+			db.tags[tag] = info
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// Len returns the number of tags in the database
+func (db *PoolTagDatabase) Len() int {
+	return len(db.tags)
+}
+
+// Lookup returns the owner of a pool tag. The PROTECTED_POOL bit is ignored.
+func (db *PoolTagDatabase) Lookup(tag PoolTag) (PoolTagInfo, bool) {
+	info, ok := db.tags[tag.Unprotected()]
+	return info, ok
+}
+
+// Merge returns a new database holding the entries of db and other. Entries
+// in other take precedence, so a full pooltag.txt or a file describing
+// in-house drivers can be layered over the embedded database.
+func (db *PoolTagDatabase) Merge(other *PoolTagDatabase) *PoolTagDatabase {
+	merged := &PoolTagDatabase{tags: make(map[PoolTag]PoolTagInfo, len(db.tags)+len(other.tags))}
+	for tag, info := range db.tags {
+		merged.tags[tag] = info
+	}
+	for tag, info := range other.tags {
+		merged.tags[tag] = info
+	}
+	return merged
+}
@@ -0,0 +1,72 @@
+package ntdll
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestDefaultPoolTagDatabase tests the embedded pooltag.txt
+func TestDefaultPoolTagDatabase(t *testing.T) {
+	db := DefaultPoolTagDatabase()
+	if db.Len() < 20 {
+		t.Errorf("DefaultPoolTagDatabase().Len() = %d, want at least 20", db.Len())
+	}
+
+	tests := []struct {
+		tag    string
+		driver string
+	}{
+		{"Proc", "nt!ps"},
+		{"Irp", ""},
+		{"TcpE", "tcpip.sys"},
+	}
+	for _, tt := range tests {
+		tag, _ := ParsePoolTag(tt.tag)
+		info, ok := db.Lookup(tag)
+		if !ok || info.Driver != tt.driver || info.Description == "" {
+			t.Errorf("Lookup(%q) = %+v, %v, want driver %q", tt.tag, info, ok, tt.driver)
+		}
+	}
+}
+
+// TestParsePoolTagDatabase tests parsing and layering of pooltag.txt files
+func TestParsePoolTagDatabase(t *testing.T) {
+	const custom = `rem In-house drivers
+// also a comment
+MyDr - mydriver.sys  - Request contexts
+Io   - mydriver.sys  - Overrides the default entry
+Xy   - Two field form
+not a pool tag line
+MyDr - other.sys     - Duplicate, ignored
+`
+	db, err := ParsePoolTagDatabase(strings.NewReader(custom))
+	if err != nil {
+		t.Fatalf("ParsePoolTagDatabase() error = %v", err)
+	}
+	if db.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", db.Len())
+	}
+
+	mydr, _ := ParsePoolTag("MyDr")
+	if info, ok := db.Lookup(mydr); !ok || info.Driver != "mydriver.sys" || info.Description != "Request contexts" {
+		t.Errorf("Lookup(MyDr) = %+v, %v", info, ok)
+	}
+	xy, _ := ParsePoolTag("Xy")
+	if info, ok := db.Lookup(xy); !ok || info.Driver != "" || info.Description != "Two field form" {
+		t.Errorf("Lookup(Xy) = %+v, %v", info, ok)
+	}
+
+	merged := DefaultPoolTagDatabase().Merge(db)
+	io, _ := ParsePoolTag("Io")
+	if info, _ := merged.Lookup(io); info.Driver != "mydriver.sys" {
+		t.Errorf("merged Lookup(Io) = %+v, want override", info)
+	}
+	proc, _ := ParsePoolTag("Proc")
+	if _, ok := merged.Lookup(proc); !ok {
+		t.Error("merged Lookup(Proc) not found")
+	}
+
+	if _, err := ParsePoolTagDatabase(strings.NewReader("TooLong - x.sys - bad\n")); err == nil {
+		t.Error("ParsePoolTagDatabase(bad tag) error = nil")
+	}
+}
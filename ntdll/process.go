@@ -0,0 +1,285 @@
+package ntdll
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/internal/layout"
+)
+
+// ThreadState is the KTHREAD_STATE of a thread
+type ThreadState uint32
+
+// Thread states
+const (
+	ThreadStateInitialized             ThreadState = 0
+	ThreadStateReady                   ThreadState = 1
+	ThreadStateRunning                 ThreadState = 2
+	ThreadStateStandby                 ThreadState = 3
+	ThreadStateTerminated              ThreadState = 4
+	ThreadStateWaiting                 ThreadState = 5
+	ThreadStateTransition              ThreadState = 6
+	ThreadStateDeferredReady           ThreadState = 7
+	ThreadStateGateWaitObsolete        ThreadState = 8
+	ThreadStateWaitingForProcessInSwap ThreadState = 9
+)
+
+var threadStateNames = [...]string{
+	"Initialized",
+	"Ready",
+	"Running",
+	"Standby",
+	"Terminated",
+	"Waiting",
+	"Transition",
+	"DeferredReady",
+	"GateWaitObsolete",
+	"WaitingForProcessInSwap",
+}
+
+// String returns the name of the thread state, e.g. "Waiting"
+func (state ThreadState) String() string {
+	if int(state) < len(threadStateNames) {
+		return threadStateNames[state]
+	}
+	return fmt.Sprintf("ThreadState(%d)", uint32(state))
+}
+
+// WaitReason is the KWAIT_REASON of a waiting thread
+type WaitReason uint32
+
+var waitReasonNames = [...]string{
+	"Executive",
+	"FreePage",
+	"PageIn",
+	"PoolAllocation",
+	"DelayExecution",
+	"Suspended",
+	"UserRequest",
+	"WrExecutive",
+	"WrFreePage",
+	"WrPageIn",
+	"WrPoolAllocation",
+	"WrDelayExecution",
+	"WrSuspended",
+	"WrUserRequest",
+	"WrEventPair",
+	"WrQueue",
+	"WrLpcReceive",
+	"WrLpcReply",
+	"WrVirtualMemory",
+	"WrPageOut",
+	"WrRendezvous",
+	"WrKeyedEvent",
+	"WrTerminated",
+	"WrProcessInSwap",
+	"WrCpuRateControl",
+	"WrCalloutStack",
+	"WrKernel",
+	"WrResource",
+	"WrPushLock",
+	"WrMutex",
+	"WrQuantumEnd",
+	"WrDispatchInt",
+	"WrPreempted",
+	"WrYieldExecution",
+	"WrFastMutex",
+	"WrGuardedMutex",
+	"WrRundown",
+	"WrAlertByThreadId",
+	"WrDeferredPreempt",
+	"WrPhysicalFault",
+	"WrIoRing",
+	"WrMdlCache",
+}
+
+// String returns the name of the wait reason, e.g. "WrQueue"
+func (reason WaitReason) String() string {
+	if int(reason) < len(waitReasonNames) {
+		return waitReasonNames[reason]
+	}
+	return fmt.Sprintf("WaitReason(%d)", uint32(reason))
+}
+
+// ProcessMemoryCounters holds the VM_COUNTERS part of a process entry.
+// Sizes are in bytes.
+type ProcessMemoryCounters struct {
+	PeakVirtualSize            uint64
+	VirtualSize                uint64
+	PageFaultCount             uint32
+	PeakWorkingSetSize         uint64
+	WorkingSetSize             uint64
+	QuotaPeakPagedPoolUsage    uint64
+	QuotaPagedPoolUsage        uint64
+	QuotaPeakNonPagedPoolUsage uint64
+	QuotaNonPagedPoolUsage     uint64
+	PagefileUsage              uint64
+	PeakPagefileUsage          uint64
+	PrivatePageCount           uint64
+}
+
+// ProcessIOCounters holds the IO_COUNTERS part of a process entry
+type ProcessIOCounters struct {
+	ReadOperationCount  uint64
+	WriteOperationCount uint64
+	OtherOperationCount uint64
+	ReadTransferCount   uint64
+	WriteTransferCount  uint64
+	OtherTransferCount  uint64
+}
+
+// SystemThread is a decoded SYSTEM_THREAD_INFORMATION entry
+type SystemThread struct {
+	KernelTime      time.Duration
+	UserTime        time.Duration
+	CreateTime      time.Time
+	WaitTime        uint32
+	StartAddress    uint64
+	ProcessID       uint64
+	ThreadID        uint64
+	Priority        int32
+	BasePriority    int32
+	ContextSwitches uint32
+	State           ThreadState
+	WaitReason      WaitReason
+}
+
+// SystemProcess is a decoded SYSTEM_PROCESS_INFORMATION entry and its threads.
+// The idle process (PID 0) has an empty ImageName.
+type SystemProcess struct {
+	ImageName             string
+	ProcessID             uint64
+	ParentProcessID       uint64
+	SessionID             uint32
+	BasePriority          int32
+	HandleCount           uint32
+	NumberOfThreads       uint32
+	WorkingSetPrivateSize uint64
+	HardFaultCount        uint32
+	CycleTime             uint64
+	CreateTime            time.Time
+	UserTime              time.Duration
+	KernelTime            time.Duration
+	Memory                ProcessMemoryCounters
+	IO                    ProcessIOCounters
+	Threads               []SystemThread
+}
+
+// DecodeSystemProcessInformation decodes the buffer returned by
+// NtQuerySystemInformation(SystemProcessInformation) by following the
+// NextEntryOffset chain.
+//
+// Parameters:
+//   - buf: the returned buffer
+//   - base: the address buf was located at during the call, used to resolve
+//     the image name pointers
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the processes in the order the kernel returned them
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if an entry is truncated
+//     or the chain points outside the buffer
+func DecodeSystemProcessInformation(buf []byte, base uintptr, pointerSize int) ([]SystemProcess, error) {
+	var processes []SystemProcess
+	offset := 0
+	for len(buf) > 0 {
+		r := layout.NewReader(buf, offset, pointerSize)
+		next := r.Uint32()
+		process := decodeSystemProcess(r, base)
+		if err := r.Err(); err != nil {
+			return nil, fmt.Errorf("process entry at offset %d: %w", offset, err)
+		}
+		processes = append(processes, process)
+
+		if next == 0 {
+			break
+		}
+		if int(next) > len(buf)-offset {
+			return nil, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("process entry at offset %d links outside the %d byte buffer", offset, len(buf)))
+		}
+		offset += int(next)
+	}
+	return processes, nil
+}
+
+// decodeSystemProcess reads a SYSTEM_PROCESS_INFORMATION entry, positioned
+// just after NextEntryOffset, followed by its thread array
+func decodeSystemProcess(r *layout.Reader, base uintptr) SystemProcess {
+	var p SystemProcess
+	p.NumberOfThreads = r.Uint32()
+	p.WorkingSetPrivateSize = r.Uint64()
+	p.HardFaultCount = r.Uint32()
+	r.Uint32() // NumberOfThreadsHighWatermark
+	p.CycleTime = r.Uint64()
+	p.CreateTime = fileTime(r.Int64())
+	p.UserTime = duration100ns(r.Int64())
+	p.KernelTime = duration100ns(r.Int64())
+	p.ImageName = r.UnicodeString(base)
+	p.BasePriority = r.Int32()
+	p.ProcessID = r.Pointer()
+	p.ParentProcessID = r.Pointer()
+	p.HandleCount = r.Uint32()
+	p.SessionID = r.Uint32()
+	r.Pointer() // UniqueProcessKey
+
+	p.Memory.PeakVirtualSize = r.Pointer()
+	p.Memory.VirtualSize = r.Pointer()
+	p.Memory.PageFaultCount = r.Uint32()
+	p.Memory.PeakWorkingSetSize = r.Pointer()
+	p.Memory.WorkingSetSize = r.Pointer()
+	p.Memory.QuotaPeakPagedPoolUsage = r.Pointer()
+	p.Memory.QuotaPagedPoolUsage = r.Pointer()
+	p.Memory.QuotaPeakNonPagedPoolUsage = r.Pointer()
+	p.Memory.QuotaNonPagedPoolUsage = r.Pointer()
+	p.Memory.PagefileUsage = r.Pointer()
+	p.Memory.PeakPagefileUsage = r.Pointer()
+	p.Memory.PrivatePageCount = r.Pointer()
+
+	p.IO.ReadOperationCount = r.Uint64()
+	p.IO.WriteOperationCount = r.Uint64()
+	p.IO.OtherOperationCount = r.Uint64()
+	p.IO.ReadTransferCount = r.Uint64()
+	p.IO.WriteTransferCount = r.Uint64()
+	p.IO.OtherTransferCount = r.Uint64()
+
+	for i := uint32(0); i < p.NumberOfThreads && r.Err() == nil; i++ {
+		p.Threads = append(p.Threads, decodeSystemThread(r))
+	}
+	return p
+}
+
+// decodeSystemThread reads a SYSTEM_THREAD_INFORMATION entry
+func decodeSystemThread(r *layout.Reader) SystemThread {
+	var t SystemThread
+	t.KernelTime = duration100ns(r.Int64())
+	t.UserTime = duration100ns(r.Int64())
+	t.CreateTime = fileTime(r.Int64())
+	t.WaitTime = r.Uint32()
+	t.StartAddress = r.Pointer()
+	t.ProcessID = r.Pointer()
+	t.ThreadID = r.Pointer()
+	t.Priority = r.Int32()
+	t.BasePriority = r.Int32()
+	t.ContextSwitches = r.Uint32()
+	t.State = ThreadState(r.Uint32())
+	t.WaitReason = WaitReason(r.Uint32())
+	r.Align(8)
+	return t
+}
+
+// fileTimeEpochDelta is the number of 100ns intervals between 1601-01-01 and 1970-01-01
+const fileTimeEpochDelta = 116444736000000000
+
+// fileTime converts a FILETIME value to a time.Time, returning the zero time for 0
+func fileTime(ft int64) time.Time {
+	if ft == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, (ft-fileTimeEpochDelta)*100).UTC()
+}
+
+// duration100ns converts a count of 100ns intervals to a time.Duration
+func duration100ns(v int64) time.Duration {
+	return time.Duration(v) * 100
+}
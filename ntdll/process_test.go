@@ -0,0 +1,186 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// processLayout holds the native offsets of the fields the tests fill in
+type processLayout struct {
+	pointerSize                                        int
+	processSize, threadSize                            int
+	imageName, pid, ppid, handleCount, sessionID       int
+	workingSet, privatePages, readTransfer, otherXfer  int
+	startAddress, threadID, contextSwitches, state, wr int
+}
+
+var processLayouts = []processLayout{
+	{4, 0xB8, 0x40, 0x38, 0x44, 0x48, 0x4C, 0x50, 0x68, 0x84, 0xA0, 0xB0, 0x1C, 0x24, 0x30, 0x34, 0x38},
+	{8, 0x100, 0x50, 0x38, 0x50, 0x58, 0x60, 0x64, 0x90, 0xC8, 0xE8, 0xF8, 0x20, 0x30, 0x40, 0x44, 0x48},
+}
+
+type testProcess struct {
+	name    string
+	pid     uint64
+	threads []uint64 // thread IDs
+}
+
+// buildProcessBuffer lays out SYSTEM_PROCESS_INFORMATION entries the way the
+// kernel does: each entry is followed by its threads and then its image name
+func buildProcessBuffer(l processLayout, base uint64, processes []testProcess) []byte {
+	putPtr := func(b []byte, v uint64) {
+		if l.pointerSize == 4 {
+			binary.LittleEndian.PutUint32(b, uint32(v))
+		} else {
+			binary.LittleEndian.PutUint64(b, v)
+		}
+	}
+
+	var buf []byte
+	for i, p := range processes {
+		start := len(buf)
+		entry := make([]byte, l.processSize+len(p.threads)*l.threadSize)
+		binary.LittleEndian.PutUint32(entry[4:], uint32(len(p.threads)))
+		binary.LittleEndian.PutUint64(entry[0x20:], 133000000000000000) // CreateTime
+		binary.LittleEndian.PutUint64(entry[0x30:], 5000000)            // KernelTime
+		putPtr(entry[l.pid:], p.pid)
+		putPtr(entry[l.ppid:], 4)
+		binary.LittleEndian.PutUint32(entry[l.handleCount:], 321)
+		binary.LittleEndian.PutUint32(entry[l.sessionID:], 1)
+		putPtr(entry[l.workingSet:], 0x200000)
+		putPtr(entry[l.privatePages:], 0x100000)
+		binary.LittleEndian.PutUint64(entry[l.readTransfer:], 4096)
+		binary.LittleEndian.PutUint64(entry[l.otherXfer:], 512)
+
+		for j, tid := range p.threads {
+			thread := entry[l.processSize+j*l.threadSize:]
+			putPtr(thread[l.startAddress:], 0x7FF800001000+uint64(j))
+			putPtr(thread[l.threadID:], tid)
+			binary.LittleEndian.PutUint32(thread[l.contextSwitches:], 77)
+			binary.LittleEndian.PutUint32(thread[l.state:], uint32(ThreadStateWaiting))
+			binary.LittleEndian.PutUint32(thread[l.wr:], 15) // WrQueue
+		}
+
+		if p.name != "" {
+			nameOffset := start + len(entry)
+			binary.LittleEndian.PutUint16(entry[l.imageName:], uint16(len(p.name)*2))
+			binary.LittleEndian.PutUint16(entry[l.imageName+2:], uint16(len(p.name)*2+2))
+			putPtr(entry[l.imageName+l.pointerSize:], base+uint64(nameOffset))
+			for _, c := range p.name + "\x00" {
+				entry = binary.LittleEndian.AppendUint16(entry, uint16(c))
+			}
+		}
+		for len(entry)%8 != 0 {
+			entry = append(entry, 0)
+		}
+		if i < len(processes)-1 {
+			binary.LittleEndian.PutUint32(entry[0:], uint32(len(entry)))
+		}
+		buf = append(buf, entry...)
+	}
+	return buf
+}
+
+// TestDecodeSystemProcessInformation tests decoding of 32-bit and 64-bit buffers
+func TestDecodeSystemProcessInformation(t *testing.T) {
+	processes := []testProcess{
+		{"", 0, []uint64{0}},
+		{"svchost.exe", 1234, []uint64{1240, 1244}},
+	}
+
+	for _, l := range processLayouts {
+		base := uint64(0x1D2C0A50000)
+		if l.pointerSize == 4 {
+			base = 0x00A50000
+		}
+		buf := buildProcessBuffer(l, base, processes)
+
+		got, err := DecodeSystemProcessInformation(buf, uintptr(base), l.pointerSize)
+		if err != nil {
+			t.Fatalf("pointerSize %d: DecodeSystemProcessInformation() error = %v", l.pointerSize, err)
+		}
+		if len(got) != 2 {
+			t.Fatalf("pointerSize %d: got %d processes, want 2", l.pointerSize, len(got))
+		}
+
+		idle, svchost := got[0], got[1]
+		if idle.ImageName != "" || idle.ProcessID != 0 || len(idle.Threads) != 1 {
+			t.Errorf("pointerSize %d: idle process = %+v", l.pointerSize, idle)
+		}
+		if svchost.ImageName != "svchost.exe" || svchost.ProcessID != 1234 || svchost.ParentProcessID != 4 {
+			t.Errorf("pointerSize %d: process = %q pid %d ppid %d", l.pointerSize, svchost.ImageName, svchost.ProcessID, svchost.ParentProcessID)
+		}
+		if svchost.HandleCount != 321 || svchost.SessionID != 1 {
+			t.Errorf("pointerSize %d: HandleCount = %d, SessionID = %d", l.pointerSize, svchost.HandleCount, svchost.SessionID)
+		}
+		if svchost.Memory.WorkingSetSize != 0x200000 || svchost.Memory.PrivatePageCount != 0x100000 {
+			t.Errorf("pointerSize %d: Memory = %+v", l.pointerSize, svchost.Memory)
+		}
+		if svchost.IO.ReadTransferCount != 4096 || svchost.IO.OtherTransferCount != 512 {
+			t.Errorf("pointerSize %d: IO = %+v", l.pointerSize, svchost.IO)
+		}
+		if svchost.KernelTime != 500*time.Millisecond || svchost.CreateTime.Year() != 2022 {
+			t.Errorf("pointerSize %d: KernelTime = %v, CreateTime = %v", l.pointerSize, svchost.KernelTime, svchost.CreateTime)
+		}
+
+		if len(svchost.Threads) != 2 {
+			t.Fatalf("pointerSize %d: got %d threads, want 2", l.pointerSize, len(svchost.Threads))
+		}
+		thread := svchost.Threads[1]
+		if thread.ThreadID != 1244 || thread.StartAddress != 0x7FF800001001&(1<<(8*l.pointerSize)-1) || thread.ContextSwitches != 77 {
+			t.Errorf("pointerSize %d: thread = %+v", l.pointerSize, thread)
+		}
+		if thread.State.String() != "Waiting" || thread.WaitReason.String() != "WrQueue" {
+			t.Errorf("pointerSize %d: State = %s, WaitReason = %s", l.pointerSize, thread.State, thread.WaitReason)
+		}
+	}
+}
+
+// TestDecodeSystemProcessInformation_Truncated tests rejection of short buffers
+func TestDecodeSystemProcessInformation_Truncated(t *testing.T) {
+	l := processLayouts[1]
+	buf := buildProcessBuffer(l, 0x10000, []testProcess{{"a.exe", 8, []uint64{12}}, {"b.exe", 16, nil}})
+
+	tests := []struct {
+		name string
+		buf  []byte
+	}{
+		{"header", buf[:0x40]},
+		{"threads", buf[:l.processSize+0x10]},
+		{"second entry", buf[:len(buf)-0x20]},
+	}
+
+	for _, tt := range tests {
+		if _, err := DecodeSystemProcessInformation(tt.buf, 0x10000, 8); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+			t.Errorf("%s: error = %v, want STATUS_BUFFER_TOO_SMALL", tt.name, err)
+		}
+	}
+
+	if got, err := DecodeSystemProcessInformation(nil, 0, 8); err != nil || got != nil {
+		t.Errorf("DecodeSystemProcessInformation(nil) = %v, %v", got, err)
+	}
+}
+
+// TestWaitReason_String tests wait reason and thread state names
+func TestWaitReason_String(t *testing.T) {
+	tests := []struct {
+		got, want string
+	}{
+		{WaitReason(0).String(), "Executive"},
+		{WaitReason(6).String(), "UserRequest"},
+		{WaitReason(37).String(), "WrAlertByThreadId"},
+		{WaitReason(99).String(), "WaitReason(99)"},
+		{ThreadStateRunning.String(), "Running"},
+		{ThreadState(42).String(), "ThreadState(42)"},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("String() = %q, want %q", tt.got, tt.want)
+		}
+	}
+}
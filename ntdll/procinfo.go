@@ -0,0 +1,411 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/internal/layout"
+)
+
+// ProcessBasicInfo is a decoded PROCESS_BASIC_INFORMATION
+type ProcessBasicInfo struct {
+	ExitStatus      winx.NTSTATUS // STATUS_PENDING while the process is running
+	PebBaseAddress  uint64
+	AffinityMask    uint64
+	BasePriority    int32
+	ProcessID       uint64
+	ParentProcessID uint64 // InheritedFromUniqueProcessId; the parent may have exited
+}
+
+// Running reports whether the process has not exited yet
+func (info ProcessBasicInfo) Running() bool {
+	return info.ExitStatus == winx.STATUS_PENDING
+}
+
+// processBasicInformationSize returns the size of PROCESS_BASIC_INFORMATION
+func processBasicInformationSize(pointerSize int) int {
+	return 6 * pointerSize
+}
+
+// DecodeProcessBasicInformation decodes the buffer returned by
+// NtQueryInformationProcess(ProcessBasicInformation).
+//
+// Parameters:
+//   - buf: the returned buffer
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the decoded information
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeProcessBasicInformation(buf []byte, pointerSize int) (ProcessBasicInfo, error) {
+	r := layout.NewReader(buf, 0, pointerSize)
+	var info ProcessBasicInfo
+	info.ExitStatus = winx.NTSTATUS(r.Uint32())
+	info.PebBaseAddress = r.Pointer()
+	info.AffinityMask = r.Pointer()
+	info.BasePriority = r.Int32()
+	info.ProcessID = r.Pointer()
+	info.ParentProcessID = r.Pointer()
+	if err := r.Err(); err != nil {
+		return ProcessBasicInfo{}, fmt.Errorf("PROCESS_BASIC_INFORMATION: %w", err)
+	}
+	return info, nil
+}
+
+// KernelUserTimes is a decoded KERNEL_USER_TIMES, returned for processes and
+// threads. ExitTime is zero while the process or thread is running.
+type KernelUserTimes struct {
+	CreateTime time.Time
+	ExitTime   time.Time
+	KernelTime time.Duration
+	UserTime   time.Duration
+}
+
+// DecodeKernelUserTimes decodes the buffer returned by
+// NtQueryInformationProcess(ProcessTimes) and NtQueryInformationThread(ThreadTimes).
+//
+// Parameters:
+//   - buf: the returned buffer, 32 bytes
+//
+// Returns:
+//   - the decoded times
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeKernelUserTimes(buf []byte) (KernelUserTimes, error) {
+	if err := checkBuffer(buf, 32, "KERNEL_USER_TIMES"); err != nil {
+		return KernelUserTimes{}, err
+	}
+	return KernelUserTimes{
+		CreateTime: fileTime(int64(binary.LittleEndian.Uint64(buf[0:]))),
+		ExitTime:   fileTime(int64(binary.LittleEndian.Uint64(buf[8:]))),
+		KernelTime: duration100ns(int64(binary.LittleEndian.Uint64(buf[16:]))),
+		UserTime:   duration100ns(int64(binary.LittleEndian.Uint64(buf[24:]))),
+	}, nil
+}
+
+// DecodeProcessUnicodeString decodes the UNICODE_STRING returned, followed by
+// its characters, by NtQueryInformationProcess(ProcessImageFileName),
+// (ProcessImageFileNameWin32) and (ProcessCommandLineInformation).
+//
+// Parameters:
+//   - buf: the returned buffer
+//   - base: the address buf was located at during the call
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the string, e.g. \Device\HarddiskVolume3\Windows\System32\cmd.exe
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if the string lies
+//     outside buf
+func DecodeProcessUnicodeString(buf []byte, base uintptr, pointerSize int) (string, error) {
+	return winx.DecodeUnicodeString(buf, 0, base, pointerSize)
+}
+
+// ProtectionType is the PS_PROTECTED_TYPE of a process
+type ProtectionType uint8
+
+// Protection types
+const (
+	ProtectionTypeNone           ProtectionType = 0
+	ProtectionTypeProtectedLight ProtectionType = 1
+	ProtectionTypeProtected      ProtectionType = 2
+)
+
+var protectionTypeNames = [...]string{"None", "ProtectedLight", "Protected"}
+
+// String returns the name of the type, e.g. "ProtectedLight"
+func (t ProtectionType) String() string {
+	if int(t) < len(protectionTypeNames) {
+		return protectionTypeNames[t]
+	}
+	return fmt.Sprintf("ProtectionType(%d)", uint8(t))
+}
+
+// ProtectionSigner is the PS_PROTECTED_SIGNER of a process
+type ProtectionSigner uint8
+
+// Protection signers, in increasing order of trust
+const (
+	ProtectionSignerNone         ProtectionSigner = 0
+	ProtectionSignerAuthenticode ProtectionSigner = 1
+	ProtectionSignerCodeGen      ProtectionSigner = 2
+	ProtectionSignerAntimalware  ProtectionSigner = 3
+	ProtectionSignerLsa          ProtectionSigner = 4
+	ProtectionSignerWindows      ProtectionSigner = 5
+	ProtectionSignerWinTcb       ProtectionSigner = 6
+	ProtectionSignerWinSystem    ProtectionSigner = 7
+	ProtectionSignerApp          ProtectionSigner = 8
+)
+
+var protectionSignerNames = [...]string{
+	"None",
+	"Authenticode",
+	"CodeGen",
+	"Antimalware",
+	"Lsa",
+	"Windows",
+	"WinTcb",
+	"WinSystem",
+	"App",
+}
+
+// String returns the name of the signer, e.g. "WinTcb"
+func (s ProtectionSigner) String() string {
+	if int(s) < len(protectionSignerNames) {
+		return protectionSignerNames[s]
+	}
+	return fmt.Sprintf("ProtectionSigner(%d)", uint8(s))
+}
+
+// ProcessProtection is a PS_PROTECTION byte: the type in bits 0-2, the audit
+// flag in bit 3 and the signer in bits 4-7
+type ProcessProtection uint8
+
+// Type returns the protection type
+func (p ProcessProtection) Type() ProtectionType {
+	return ProtectionType(p & 0x7)
+}
+
+// Audit reports whether the protection is in audit mode
+func (p ProcessProtection) Audit() bool {
+	return p&0x8 != 0
+}
+
+// Signer returns the signer level
+func (p ProcessProtection) Signer() ProtectionSigner {
+	return ProtectionSigner(p >> 4)
+}
+
+// Protected reports whether the process is a protected or protected light process
+func (p ProcessProtection) Protected() bool {
+	return p.Type() != ProtectionTypeNone
+}
+
+// String returns the type and signer, e.g. "ProtectedLight (Antimalware)", or
+// "None" for an unprotected process
+func (p ProcessProtection) String() string {
+	if !p.Protected() {
+		return "None"
+	}
+	s := fmt.Sprintf("%s (%s)", p.Type(), p.Signer())
+	if p.Audit() {
+		s += " audit"
+	}
+	return s
+}
+
+// DecodeProcessProtectionInformation decodes the buffer returned by
+// NtQueryInformationProcess(ProcessProtectionInformation).
+//
+// Parameters:
+//   - buf: the returned buffer, 1 byte
+//
+// Returns:
+//   - the protection level
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is empty
+func DecodeProcessProtectionInformation(buf []byte) (ProcessProtection, error) {
+	if err := checkBuffer(buf, 1, "PS_PROTECTION"); err != nil {
+		return 0, err
+	}
+	return ProcessProtection(buf[0]), nil
+}
+
+// DecodeProcessBreakOnTermination decodes the buffer returned by
+// NtQueryInformationProcess(ProcessBreakOnTermination).
+//
+// Parameters:
+//   - buf: the returned buffer, a 4 byte ULONG
+//
+// Returns:
+//   - true if the process is critical, so that its termination bug checks
+//     the system
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeProcessBreakOnTermination(buf []byte) (bool, error) {
+	if err := checkBuffer(buf, 4, "ProcessBreakOnTermination"); err != nil {
+		return false, err
+	}
+	return binary.LittleEndian.Uint32(buf) != 0, nil
+}
+
+// HandleCountInfo is a decoded PROCESS_HANDLE_INFORMATION
+type HandleCountInfo struct {
+	HandleCount              uint32
+	HandleCountHighWatermark uint32 // 0 if only the 4 byte count was returned
+}
+
+// DecodeProcessHandleCount decodes the buffer returned by
+// NtQueryInformationProcess(ProcessHandleCount).
+//
+// Parameters:
+//   - buf: the returned buffer, either a 4 byte ULONG or the 8 byte
+//     PROCESS_HANDLE_INFORMATION
+//
+// Returns:
+//   - the handle counts
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeProcessHandleCount(buf []byte) (HandleCountInfo, error) {
+	if err := checkBuffer(buf, 4, "ProcessHandleCount"); err != nil {
+		return HandleCountInfo{}, err
+	}
+	info := HandleCountInfo{HandleCount: binary.LittleEndian.Uint32(buf)}
+	if len(buf) >= 8 {
+		info.HandleCountHighWatermark = binary.LittleEndian.Uint32(buf[4:])
+	}
+	return info, nil
+}
+
+// MitigationPolicy is a PROCESS_MITIGATION_POLICY selector
+type MitigationPolicy int32
+
+// Mitigation policies. DEP and the options mask cannot be queried through
+// ProcessMitigationPolicy.
+const (
+	MitigationDEP                   MitigationPolicy = 0
+	MitigationASLR                  MitigationPolicy = 1
+	MitigationDynamicCode           MitigationPolicy = 2
+	MitigationStrictHandleCheck     MitigationPolicy = 3
+	MitigationSystemCallDisable     MitigationPolicy = 4
+	MitigationOptionsMask           MitigationPolicy = 5
+	MitigationExtensionPointDisable MitigationPolicy = 6
+	MitigationControlFlowGuard      MitigationPolicy = 7
+	MitigationSignature             MitigationPolicy = 8
+	MitigationFontDisable           MitigationPolicy = 9
+	MitigationImageLoad             MitigationPolicy = 10
+	MitigationSystemCallFilter      MitigationPolicy = 11
+	MitigationPayloadRestriction    MitigationPolicy = 12
+	MitigationChildProcess          MitigationPolicy = 13
+	MitigationSideChannelIsolation  MitigationPolicy = 14
+	MitigationUserShadowStack       MitigationPolicy = 15
+	MitigationRedirectionTrust      MitigationPolicy = 16
+	MitigationUserPointerAuth       MitigationPolicy = 17
+	MitigationSEHOP                 MitigationPolicy = 18
+)
+
+// mitigationPolicies holds the name and flag bit names of each policy,
+// indexed by policy
+var mitigationPolicies = [...]struct {
+	name  string
+	flags []string
+}{
+	{"DEP", []string{"Enable", "DisableAtlThunkEmulation", "Permanent"}},
+	{"ASLR", []string{"EnableBottomUpRandomization", "EnableForceRelocateImages", "EnableHighEntropy", "DisallowStrippedImages"}},
+	{"DynamicCode", []string{"ProhibitDynamicCode", "AllowThreadOptOut", "AllowRemoteDowngrade", "AuditProhibitDynamicCode"}},
+	{"StrictHandleCheck", []string{"RaiseExceptionOnInvalidHandleReference", "HandleExceptionsPermanentlyEnabled"}},
+	{"SystemCallDisable", []string{"DisallowWin32kSystemCalls", "AuditDisallowWin32kSystemCalls", "DisallowFsctlSystemCalls", "AuditDisallowFsctlSystemCalls"}},
+	{"OptionsMask", nil},
+	{"ExtensionPointDisable", []string{"DisableExtensionPoints"}},
+	{"ControlFlowGuard", []string{"EnableControlFlowGuard", "EnableExportSuppression", "StrictMode", "EnableXfg", "EnableXfgAuditMode"}},
+	{"Signature", []string{"MicrosoftSignedOnly", "StoreSignedOnly", "MitigationOptIn", "AuditMicrosoftSignedOnly", "AuditStoreSignedOnly"}},
+	{"FontDisable", []string{"DisableNonSystemFonts", "AuditNonSystemFontLoading"}},
+	{"ImageLoad", []string{"NoRemoteImages", "NoLowMandatoryLabelImages", "PreferSystem32Images", "AuditNoRemoteImages", "AuditNoLowMandatoryLabelImages"}},
+	{"SystemCallFilter", nil}, // a filter id, not flags
+	{"PayloadRestriction", []string{
+		"EnableExportAddressFilter", "AuditExportAddressFilter",
+		"EnableExportAddressFilterPlus", "AuditExportAddressFilterPlus",
+		"EnableImportAddressFilter", "AuditImportAddressFilter",
+		"EnableRopStackPivot", "AuditRopStackPivot",
+		"EnableRopCallerCheck", "AuditRopCallerCheck",
+		"EnableRopSimExec", "AuditRopSimExec",
+	}},
+	{"ChildProcess", []string{"NoChildProcessCreation", "AuditNoChildProcessCreation", "AllowSecureProcessCreation"}},
+	{"SideChannelIsolation", []string{"SmtBranchTargetIsolation", "IsolateSecurityDomain", "DisablePageCombine", "SpeculativeStoreBypassDisable", "RestrictCoreSharing"}},
+	{"UserShadowStack", []string{
+		"EnableUserShadowStack", "AuditUserShadowStack",
+		"SetContextIpValidation", "AuditSetContextIpValidation",
+		"EnableUserShadowStackStrictMode", "BlockNonCetBinaries",
+		"BlockNonCetBinariesNonEhcont", "AuditBlockNonCetBinaries",
+		"CetDynamicApisOutOfProcOnly", "SetContextIpValidationRelaxedMode",
+	}},
+	{"RedirectionTrust", []string{"EnforceRedirectionTrust", "AuditRedirectionTrust"}},
+	{"UserPointerAuth", []string{"EnablePointerAuthUserIp"}},
+	{"SEHOP", []string{"EnableSehop"}},
+}
+
+// String returns the name of the policy, e.g. "ControlFlowGuard"
+func (policy MitigationPolicy) String() string {
+	if policy >= 0 && int(policy) < len(mitigationPolicies) {
+		return mitigationPolicies[policy].name
+	}
+	return fmt.Sprintf("MitigationPolicy(%d)", int32(policy))
+}
+
+// QueryableMitigationPolicies returns the policies that can be queried through
+// ProcessMitigationPolicy, in ascending order.
+func QueryableMitigationPolicies() []MitigationPolicy {
+	var policies []MitigationPolicy
+	for policy := range mitigationPolicies {
+		if p := MitigationPolicy(policy); p != MitigationDEP && p != MitigationOptionsMask {
+			policies = append(policies, p)
+		}
+	}
+	return policies
+}
+
+// MitigationPolicyInfo is a decoded PROCESS_MITIGATION_POLICY_INFORMATION
+type MitigationPolicyInfo struct {
+	Policy MitigationPolicy
+	Flags  uint32 // the policy-specific PROCESS_MITIGATION_*_POLICY flags
+}
+
+// Enabled reports whether any flag of the policy is set
+func (info MitigationPolicyInfo) Enabled() bool {
+	return info.Flags != 0
+}
+
+// FlagNames returns the names of the set flags, e.g. ["EnableBottomUpRandomization",
+// "EnableHighEntropy"]. Unnamed bits are formatted as "Bit<n>".
+func (info MitigationPolicyInfo) FlagNames() []string {
+	var names []string
+	var known []string
+	if info.Policy >= 0 && int(info.Policy) < len(mitigationPolicies) {
+		known = mitigationPolicies[info.Policy].flags
+	}
+	for bit := 0; bit < 32; bit++ {
+		if info.Flags&(1<<bit) == 0 {
+			continue
+		}
+		if bit < len(known) {
+			names = append(names, known[bit])
+		} else {
+			names = append(names, fmt.Sprintf("Bit%d", bit))
+		}
+	}
+	return names
+}
+
+// String returns the policy and its set flags, e.g. "ASLR: EnableBottomUpRandomization|EnableHighEntropy"
+func (info MitigationPolicyInfo) String() string {
+	if info.Flags == 0 {
+		return info.Policy.String() + ": off"
+	}
+	return info.Policy.String() + ": " + strings.Join(info.FlagNames(), "|")
+}
+
+// mitigationPolicyInput returns the PROCESS_MITIGATION_POLICY_INFORMATION
+// that selects policy, which NtQueryInformationProcess reads from the output
+// buffer
+func mitigationPolicyInput(policy MitigationPolicy) []byte {
+	input := make([]byte, 8)
+	binary.LittleEndian.PutUint32(input, uint32(policy))
+	return input
+}
+
+// DecodeProcessMitigationPolicy decodes the buffer returned by
+// NtQueryInformationProcess(ProcessMitigationPolicy).
+//
+// Parameters:
+//   - buf: the returned buffer, 8 bytes
+//
+// Returns:
+//   - the policy and its flags
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeProcessMitigationPolicy(buf []byte) (MitigationPolicyInfo, error) {
+	if err := checkBuffer(buf, 8, "PROCESS_MITIGATION_POLICY_INFORMATION"); err != nil {
+		return MitigationPolicyInfo{}, err
+	}
+	return MitigationPolicyInfo{
+		Policy: MitigationPolicy(int32(binary.LittleEndian.Uint32(buf))),
+		Flags:  binary.LittleEndian.Uint32(buf[4:]),
+	}, nil
+}
@@ -0,0 +1,210 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// TestDecodeProcessBasicInformation tests the 32-bit and 64-bit layouts
+func TestDecodeProcessBasicInformation(t *testing.T) {
+	for _, pointerSize := range []int{4, 8} {
+		buf := make([]byte, processBasicInformationSize(pointerSize))
+		put := func(offset int, v uint64) {
+			if pointerSize == 4 {
+				binary.LittleEndian.PutUint32(buf[offset:], uint32(v))
+			} else {
+				binary.LittleEndian.PutUint64(buf[offset:], v)
+			}
+		}
+		binary.LittleEndian.PutUint32(buf[0:], uint32(winx.STATUS_PENDING))
+		put(pointerSize, 0x7FFDF000)
+		put(2*pointerSize, 0xF)
+		binary.LittleEndian.PutUint32(buf[3*pointerSize:], 8)
+		put(4*pointerSize, 1234)
+		put(5*pointerSize, 567)
+
+		info, err := DecodeProcessBasicInformation(buf, pointerSize)
+		if err != nil {
+			t.Fatalf("pointerSize %d: error = %v", pointerSize, err)
+		}
+		want := ProcessBasicInfo{ExitStatus: winx.STATUS_PENDING, PebBaseAddress: 0x7FFDF000, AffinityMask: 0xF, BasePriority: 8, ProcessID: 1234, ParentProcessID: 567}
+		if info != want || !info.Running() {
+			t.Errorf("pointerSize %d: got %+v, want %+v", pointerSize, info, want)
+		}
+
+		if _, err := DecodeProcessBasicInformation(buf[:len(buf)-1], pointerSize); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+			t.Errorf("pointerSize %d: truncated error = %v", pointerSize, err)
+		}
+	}
+}
+
+// TestDecodeKernelUserTimes tests KERNEL_USER_TIMES conversion
+func TestDecodeKernelUserTimes(t *testing.T) {
+	buf := make([]byte, 32)
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	binary.LittleEndian.PutUint64(buf[0:], uint64(created.UnixNano()/100+fileTimeEpochDelta))
+	binary.LittleEndian.PutUint64(buf[16:], 20_000_000)
+	binary.LittleEndian.PutUint64(buf[24:], 5_000_000)
+
+	times, err := DecodeKernelUserTimes(buf)
+	if err != nil {
+		t.Fatalf("DecodeKernelUserTimes() error = %v", err)
+	}
+	if !times.CreateTime.Equal(created) || !times.ExitTime.IsZero() || times.KernelTime != 2*time.Second || times.UserTime != 500*time.Millisecond {
+		t.Errorf("DecodeKernelUserTimes() = %+v", times)
+	}
+	if _, err := DecodeKernelUserTimes(buf[:31]); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("truncated error = %v", err)
+	}
+}
+
+// TestDecodeProcessUnicodeString tests the UNICODE_STRING output of name classes
+func TestDecodeProcessUnicodeString(t *testing.T) {
+	const base = 0x10000
+	name := `\Device\HarddiskVolume3\Windows\System32\cmd.exe`
+	chars := utf16.Encode([]rune(name))
+
+	buf := make([]byte, 16+2*len(chars))
+	binary.LittleEndian.PutUint16(buf[0:], uint16(2*len(chars)))
+	binary.LittleEndian.PutUint16(buf[2:], uint16(2*len(chars)))
+	binary.LittleEndian.PutUint64(buf[8:], base+16)
+	for i, c := range chars {
+		binary.LittleEndian.PutUint16(buf[16+2*i:], c)
+	}
+
+	got, err := DecodeProcessUnicodeString(buf, base, 8)
+	if err != nil || got != name {
+		t.Errorf("DecodeProcessUnicodeString() = %q, %v", got, err)
+	}
+	if _, err := DecodeProcessUnicodeString(buf[:20], base, 8); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("truncated error = %v", err)
+	}
+}
+
+// TestProcessProtection tests PS_PROTECTION bit fields and names
+func TestProcessProtection(t *testing.T) {
+	tests := []struct {
+		value     byte
+		typ       ProtectionType
+		signer    ProtectionSigner
+		audit     bool
+		protected bool
+		want      string
+	}{
+		{0x00, ProtectionTypeNone, ProtectionSignerNone, false, false, "None"},
+		{0x31, ProtectionTypeProtectedLight, ProtectionSignerAntimalware, false, true, "ProtectedLight (Antimalware)"},
+		{0x41, ProtectionTypeProtectedLight, ProtectionSignerLsa, false, true, "ProtectedLight (Lsa)"},
+		{0x62, ProtectionTypeProtected, ProtectionSignerWinTcb, false, true, "Protected (WinTcb)"},
+		{0x72, ProtectionTypeProtected, ProtectionSignerWinSystem, false, true, "Protected (WinSystem)"},
+		{0x39, ProtectionTypeProtectedLight, ProtectionSignerAntimalware, true, true, "ProtectedLight (Antimalware) audit"},
+	}
+	for _, tt := range tests {
+		p, err := DecodeProcessProtectionInformation([]byte{tt.value})
+		if err != nil {
+			t.Fatalf("DecodeProcessProtectionInformation(0x%02X) error = %v", tt.value, err)
+		}
+		if p.Type() != tt.typ || p.Signer() != tt.signer || p.Audit() != tt.audit || p.Protected() != tt.protected {
+			t.Errorf("0x%02X: type %v signer %v audit %v protected %v", tt.value, p.Type(), p.Signer(), p.Audit(), p.Protected())
+		}
+		if got := p.String(); got != tt.want {
+			t.Errorf("0x%02X: String() = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+	if _, err := DecodeProcessProtectionInformation(nil); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("empty buffer error = %v", err)
+	}
+}
+
+// TestDecodeProcessFlags tests the break-on-termination and handle count decoders
+func TestDecodeProcessFlags(t *testing.T) {
+	critical, err := DecodeProcessBreakOnTermination([]byte{1, 0, 0, 0})
+	if err != nil || !critical {
+		t.Errorf("DecodeProcessBreakOnTermination() = %v, %v", critical, err)
+	}
+	if _, err := DecodeProcessBreakOnTermination([]byte{1}); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("truncated error = %v", err)
+	}
+
+	counts, err := DecodeProcessHandleCount([]byte{0x10, 0, 0, 0, 0x20, 0, 0, 0})
+	if err != nil || counts != (HandleCountInfo{HandleCount: 0x10, HandleCountHighWatermark: 0x20}) {
+		t.Errorf("DecodeProcessHandleCount() = %+v, %v", counts, err)
+	}
+	counts, err = DecodeProcessHandleCount([]byte{0x10, 0, 0, 0})
+	if err != nil || counts != (HandleCountInfo{HandleCount: 0x10}) {
+		t.Errorf("DecodeProcessHandleCount(ULONG) = %+v, %v", counts, err)
+	}
+}
+
+// TestMitigationPolicy tests policy input, decoding and flag names
+func TestMitigationPolicy(t *testing.T) {
+	input := mitigationPolicyInput(MitigationASLR)
+	if len(input) != 8 || binary.LittleEndian.Uint32(input) != 1 {
+		t.Fatalf("mitigationPolicyInput() = %v", input)
+	}
+
+	buf := append([]byte(nil), input...)
+	binary.LittleEndian.PutUint32(buf[4:], 0x5)
+	info, err := DecodeProcessMitigationPolicy(buf)
+	if err != nil {
+		t.Fatalf("DecodeProcessMitigationPolicy() error = %v", err)
+	}
+	if info.Policy != MitigationASLR || !info.Enabled() {
+		t.Errorf("DecodeProcessMitigationPolicy() = %+v", info)
+	}
+	if got := info.String(); got != "ASLR: EnableBottomUpRandomization|EnableHighEntropy" {
+		t.Errorf("String() = %q", got)
+	}
+
+	info = MitigationPolicyInfo{Policy: MitigationSEHOP, Flags: 0x3}
+	if got := info.String(); got != "SEHOP: EnableSehop|Bit1" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := (MitigationPolicyInfo{Policy: MitigationChildProcess}).String(); got != "ChildProcess: off" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := MitigationPolicy(99).String(); got != "MitigationPolicy(99)" {
+		t.Errorf("String() = %q", got)
+	}
+
+	for _, policy := range QueryableMitigationPolicies() {
+		if policy == MitigationDEP || policy == MitigationOptionsMask {
+			t.Errorf("QueryableMitigationPolicies() includes %s", policy)
+		}
+	}
+
+	if _, err := DecodeProcessMitigationPolicy(buf[:4]); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("truncated error = %v", err)
+	}
+}
+
+// TestProcessClassDecoderRegistry tests the process decoder registry
+func TestProcessClassDecoderRegistry(t *testing.T) {
+	if _, err := LookupProcessClassDecoder[ProcessBasicInfo](winx.ProcessBasicInformation); err != nil {
+		t.Errorf("LookupProcessClassDecoder(ProcessBasicInformation) error = %v", err)
+	}
+	if _, err := LookupProcessClassDecoder[string](winx.ProcessBasicInformation); !errors.Is(err, winx.STATUS_INVALID_PARAMETER) {
+		t.Errorf("wrong type error = %v", err)
+	}
+	if _, err := LookupProcessClassDecoder[string](winx.ProcessDebugPort); !errors.Is(err, winx.STATUS_INVALID_INFO_CLASS) {
+		t.Errorf("unregistered class error = %v", err)
+	}
+
+	if got := processClassSize(winx.ProcessBasicInformation); got != uint32(processBasicInformationSize(nativePointerSize)) {
+		t.Errorf("processClassSize(ProcessBasicInformation) = %d", got)
+	}
+	if got := processClassSize(winx.ProcessImageFileName); got != DefaultProcessQueryInitialSize {
+		t.Errorf("processClassSize(ProcessImageFileName) = %d", got)
+	}
+
+	classes := RegisteredProcessClasses()
+	for i := 1; i < len(classes); i++ {
+		if classes[i-1] >= classes[i] {
+			t.Errorf("RegisteredProcessClasses() not sorted: %v", classes)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+//go:build windows
+
+package ntdll
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/handle"
+)
+
+// _NtQueryInformationProcess is the low-level wrapper for NtQueryInformationProcess
+func _NtQueryInformationProcess(
+	ProcessHandle handle.HANDLE,
+	ProcessInformationClass winx.ProcessInfoClass,
+	ProcessInformation unsafe.Pointer,
+	ProcessInformationLength uint32,
+	ReturnLength *uint32) uint32 {
+
+	ret_code, _, _ := procNtQueryInformationProcess.Call(
+		winx.Call{API: "NtQueryInformationProcess", Detail: ProcessInformationClass.String(), OutputSize: int(ProcessInformationLength)},
+		winx.ReturnsNTSTATUS,
+		uintptr(ProcessHandle),
+		uintptr(ProcessInformationClass),
+		uintptr(ProcessInformation),
+		uintptr(ProcessInformationLength),
+		uintptr(unsafe.Pointer(ReturnLength)),
+	)
+
+	return uint32(ret_code)
+}
+
+// NtQueryInformationProcess is a convenience wrapper around
+// _NtQueryInformationProcess that automatically allocates and resizes a
+// buffer when STATUS_INFO_LENGTH_MISMATCH is returned. It returns the filled
+// byte slice and the NTSTATUS code. Fixed-size classes without a registered
+// decoder need initialSize set to the exact structure size.
+func NtQueryInformationProcess(process handle.HANDLE, class winx.ProcessInfoClass, initialSize uint32) ([]byte, uint32) {
+	buf, err := QueryProcessRaw(context.Background(), process, class, &QueryOptions{InitialSize: initialSize})
+	return buf, ntStatusOf(err)
+}
+
+// QueryProcessRaw queries a process information class and returns the output
+// buffer, growing it as directed by opts. opts.Input, if set, is copied to the
+// start of the buffer before each call. Errors are as for QueryRaw.
+func QueryProcessRaw(ctx context.Context, process handle.HANDLE, class winx.ProcessInfoClass, opts *QueryOptions) ([]byte, error) {
+	return queryProcessInformation(ctx, process, class, opts.withDefaults(processClassSize(class)))
+}
+
+// QueryProcess queries a process information class and decodes the output
+// with the decoder registered for it, for example
+//
+//	info, err := QueryProcess[ProcessBasicInfo](ctx, handle.CurrentProcess, winx.ProcessBasicInformation, nil)
+//
+// The process handle needs PROCESS_QUERY_LIMITED_INFORMATION or
+// PROCESS_QUERY_INFORMATION access, depending on the class.
+func QueryProcess[T any](ctx context.Context, process handle.HANDLE, class winx.ProcessInfoClass, opts *QueryOptions) (T, error) {
+	var zero T
+	decoder, err := LookupProcessClassDecoder[T](class)
+	if err != nil {
+		return zero, err
+	}
+	o := opts.withDefaults(processClassSize(class))
+	buf, err := queryProcessInformation(ctx, process, class, o)
+	if err != nil {
+		return zero, err
+	}
+	defer o.Pool.Put(buf)
+	return decoder.Decode(buf, uintptr(unsafe.Pointer(&buf[0])), nativePointerSize)
+}
+
+// queryProcessInformation runs the sizing loop over NtQueryInformationProcess
+func queryProcessInformation(ctx context.Context, process handle.HANDLE, class winx.ProcessInfoClass, o QueryOptions) ([]byte, error) {
+	if err := procNtQueryInformationProcess.Find(); err != nil {
+		return nil, err
+	}
+	name := fmt.Sprintf("NtQueryInformationProcess(%s)", class)
+	return runQuery(ctx, name, o, func(buf []byte) (winx.NTSTATUS, uint32) {
+		var returnLen uint32
+		copy(buf, o.Input)
+		ret := _NtQueryInformationProcess(process, class, unsafe.Pointer(&buf[0]), uint32(len(buf)), &returnLen)
+		return winx.NTSTATUS(ret), returnLen
+	})
+}
+
+// QueryProcessBasicInformation returns the PEB address, parent process ID and
+// exit status of a process.
+func QueryProcessBasicInformation(process handle.HANDLE) (ProcessBasicInfo, error) {
+	return QueryProcess[ProcessBasicInfo](context.Background(), process, winx.ProcessBasicInformation, nil)
+}
+
+// QueryProcessTimes returns the creation and exit times and CPU usage of a process.
+func QueryProcessTimes(process handle.HANDLE) (KernelUserTimes, error) {
+	return QueryProcess[KernelUserTimes](context.Background(), process, winx.ProcessTimes, nil)
+}
+
+// QueryProcessImageFileName returns the image path of a process in NT form,
+// e.g. \Device\HarddiskVolume3\Windows\System32\cmd.exe
+func QueryProcessImageFileName(process handle.HANDLE) (string, error) {
+	return QueryProcess[string](context.Background(), process, winx.ProcessImageFileName, nil)
+}
+
+// QueryProcessImageFileNameWin32 returns the image path of a process in
+// Win32 form, e.g. C:\Windows\System32\cmd.exe
+func QueryProcessImageFileNameWin32(process handle.HANDLE) (string, error) {
+	return QueryProcess[string](context.Background(), process, winx.ProcessImageFileNameWin32, nil)
+}
+
+// QueryProcessCommandLine returns the command line of a process without
+// reading its PEB. Requires Windows 8.1.
+func QueryProcessCommandLine(process handle.HANDLE) (string, error) {
+	return QueryProcess[string](context.Background(), process, winx.ProcessCommandLineInformation, nil)
+}
+
+// QueryProcessProtection returns the protected process level of a process.
+// Requires Windows 8.1.
+func QueryProcessProtection(process handle.HANDLE) (ProcessProtection, error) {
+	return QueryProcess[ProcessProtection](context.Background(), process, winx.ProcessProtectionInformation, nil)
+}
+
+// QueryProcessBreakOnTermination reports whether a process is critical, so
+// that its termination bug checks the system.
+func QueryProcessBreakOnTermination(process handle.HANDLE) (bool, error) {
+	return QueryProcess[bool](context.Background(), process, winx.ProcessBreakOnTermination, nil)
+}
+
+// QueryProcessHandleCount returns the number of open handles of a process and
+// their high watermark.
+func QueryProcessHandleCount(process handle.HANDLE) (HandleCountInfo, error) {
+	return QueryProcess[HandleCountInfo](context.Background(), process, winx.ProcessHandleCount, nil)
+}
+
+// QueryProcessMitigationPolicy returns the flags of one mitigation policy of a
+// process. Requires Windows 8.
+func QueryProcessMitigationPolicy(process handle.HANDLE, policy MitigationPolicy) (MitigationPolicyInfo, error) {
+	return QueryProcess[MitigationPolicyInfo](context.Background(), process, winx.ProcessMitigationPolicy, &QueryOptions{Input: mitigationPolicyInput(policy)})
+}
+
+// QueryProcessMitigations returns every mitigation policy of a process that
+// the running Windows build can report. Policies the build does not know,
+// which fail with STATUS_INVALID_PARAMETER, are left out.
+func QueryProcessMitigations(process handle.HANDLE) ([]MitigationPolicyInfo, error) {
+	var policies []MitigationPolicyInfo
+	for _, policy := range QueryableMitigationPolicies() {
+		info, err := QueryProcessMitigationPolicy(process, policy)
+		if errors.Is(err, winx.STATUS_INVALID_PARAMETER) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s policy: %w", policy, err)
+		}
+		policies = append(policies, info)
+	}
+	return policies, nil
+}
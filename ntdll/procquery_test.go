@@ -0,0 +1,63 @@
+//go:build windows
+
+package ntdll
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/handle"
+)
+
+// TestQueryProcess tests process queries against the current process
+func TestQueryProcess(t *testing.T) {
+	basic, err := QueryProcessBasicInformation(handle.CurrentProcess)
+	if err != nil {
+		t.Fatalf("QueryProcessBasicInformation() error = %v", err)
+	}
+	if basic.ProcessID != uint64(os.Getpid()) || basic.ParentProcessID != uint64(os.Getppid()) || basic.PebBaseAddress == 0 || !basic.Running() {
+		t.Errorf("QueryProcessBasicInformation() = %+v", basic)
+	}
+
+	name, err := QueryProcessImageFileName(handle.CurrentProcess)
+	if err != nil || !strings.HasPrefix(name, `\Device\`) {
+		t.Errorf("QueryProcessImageFileName() = %q, %v", name, err)
+	}
+	executable, _ := os.Executable()
+	win32, err := QueryProcessImageFileNameWin32(handle.CurrentProcess)
+	if err != nil || !strings.EqualFold(win32, executable) {
+		t.Errorf("QueryProcessImageFileNameWin32() = %q, %v, want %q", win32, err, executable)
+	}
+
+	if cmdline, err := QueryProcessCommandLine(handle.CurrentProcess); err == nil && cmdline == "" {
+		t.Error("QueryProcessCommandLine() returned an empty command line")
+	}
+
+	counts, err := QueryProcessHandleCount(handle.CurrentProcess)
+	if err != nil || counts.HandleCount == 0 {
+		t.Errorf("QueryProcessHandleCount() = %+v, %v", counts, err)
+	}
+
+	critical, err := QueryProcessBreakOnTermination(handle.CurrentProcess)
+	if err == nil && critical {
+		t.Error("QueryProcessBreakOnTermination() = true for the test process")
+	}
+
+	if protection, err := QueryProcessProtection(handle.CurrentProcess); err == nil && protection.Protected() {
+		t.Errorf("QueryProcessProtection() = %v for the test process", protection)
+	}
+
+	mitigations, err := QueryProcessMitigations(handle.CurrentProcess)
+	if err != nil {
+		t.Errorf("QueryProcessMitigations() error = %v", err)
+	}
+	for _, m := range mitigations {
+		t.Log(m)
+	}
+
+	if _, status := NtQueryInformationProcess(handle.CurrentProcess, winx.ProcessBasicInformation, 0); status != 0 {
+		t.Errorf("NtQueryInformationProcess() status = 0x%08X", status)
+	}
+}
@@ -0,0 +1,36 @@
+//go:build windows
+
+package ntdll
+
+import "github.com/ArkaprabhaChakraborty/winx/internal/proc"
+
+var (
+	procNtQuerySystemInformation     = proc.NTDLL.Proc("NtQuerySystemInformation")
+	procNtQuerySystemInformationEx   = proc.NTDLL.Proc("NtQuerySystemInformationEx")
+	procNtQueryInformationProcess    = proc.NTDLL.Proc("NtQueryInformationProcess")
+	procNtQueryInformationThread     = proc.NTDLL.Proc("NtQueryInformationThread")
+	procNtReadVirtualMemory          = proc.NTDLL.Proc("NtReadVirtualMemory")
+	procNtQueryVirtualMemory         = proc.NTDLL.Proc("NtQueryVirtualMemory")
+	procNtQueryObject                = proc.NTDLL.Proc("NtQueryObject")
+	procNtDuplicateObject            = proc.NTDLL.Proc("NtDuplicateObject")
+	procNtOpenProcess                = proc.NTDLL.Proc("NtOpenProcess")
+	procNtClose                      = proc.NTDLL.Proc("NtClose")
+	procNtOpenDirectoryObject        = proc.NTDLL.Proc("NtOpenDirectoryObject")
+	procNtQueryDirectoryObject       = proc.NTDLL.Proc("NtQueryDirectoryObject")
+	procNtOpenSymbolicLinkObject     = proc.NTDLL.Proc("NtOpenSymbolicLinkObject")
+	procNtQuerySymbolicLinkObject    = proc.NTDLL.Proc("NtQuerySymbolicLinkObject")
+	procNtCreateFile                 = proc.NTDLL.Proc("NtCreateFile")
+	procNtOpenFile                   = proc.NTDLL.Proc("NtOpenFile")
+	procNtDeviceIoControlFile        = proc.NTDLL.Proc("NtDeviceIoControlFile")
+	procNtFsControlFile              = proc.NTDLL.Proc("NtFsControlFile")
+	procNtWaitForSingleObject        = proc.NTDLL.Proc("NtWaitForSingleObject")
+	procGetActiveProcessorGroupCount = proc.Kernel32.Proc("GetActiveProcessorGroupCount")
+)
+
+// Has reports whether ntdll.dll exports the named function on this system,
+// e.g. Has("NtQuerySystemInformationEx"). The export is resolved once and
+// shared with the wrappers in this package, which return an error matching
+// winx.STATUS_PROCEDURE_NOT_FOUND instead of panicking when it is missing.
+func Has(name string) bool {
+	return proc.NTDLL.Proc(name).Has()
+}
@@ -0,0 +1,449 @@
+package ntdll
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"unsafe"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/handle"
+)
+
+// Query defaults, used for zero fields of QueryOptions
+const (
+	DefaultQueryInitialSize = 64 * 1024
+	DefaultQueryMaxSize     = 256 * 1024 * 1024
+	DefaultQueryMaxAttempts = 8
+)
+
+// DefaultProcessQueryInitialSize is the first buffer size for variable-size
+// process and thread classes, whose output is usually a short string
+const DefaultProcessQueryInitialSize = 512
+
+// GrowthPolicy returns the next buffer size to try after a query failed with
+// a too-small buffer. current is the size just tried and required is the
+// length reported by the kernel, or 0 if none was reported. Results that do not
+// exceed current are replaced by current*2.
+type GrowthPolicy func(current, required uint32) uint32
+
+// GrowToRequired uses the length reported by the kernel plus 1/8 headroom,
+// since process and handle lists can grow between calls. Without a reported
+// length it doubles the buffer.
+func GrowToRequired(current, required uint32) uint32 {
+	if required == 0 {
+		return GrowDouble(current, required)
+	}
+	return saturate(uint64(required) + uint64(required)/8)
+}
+
+// GrowDouble doubles the buffer regardless of the reported length
+func GrowDouble(current, required uint32) uint32 {
+	return saturate(uint64(current) * 2)
+}
+
+func saturate(size uint64) uint32 {
+	if size > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint32(size)
+}
+
+// BufferPool recycles query buffers between calls and remembers the size
+// that last succeeded, so repeated polling of the same class settles on one
+// allocation and one system call per query. A BufferPool should only be shared
+// by queries of the same class. A nil *BufferPool allocates a new buffer every
+// time.
+type BufferPool struct {
+	pool sync.Pool
+	mu   sync.Mutex
+	hint uint32
+}
+
+// NewBufferPool creates an empty pool.
+func NewBufferPool() *BufferPool {
+	return &BufferPool{}
+}
+
+// Get returns a buffer of size bytes. Recycled buffers are not cleared.
+func (p *BufferPool) Get(size uint32) []byte {
+	if p != nil {
+		if v, ok := p.pool.Get().(*[]byte); ok && uint32(cap(*v)) >= size {
+			return (*v)[:size]
+		}
+	}
+	return make([]byte, size)
+}
+
+// Put returns a buffer to the pool. The caller must not use buf afterwards.
+func (p *BufferPool) Put(buf []byte) {
+	if p == nil || cap(buf) == 0 {
+		return
+	}
+	buf = buf[:cap(buf)]
+	p.pool.Put(&buf)
+}
+
+// SizeHint returns the buffer size of the last successful query, or 0
+func (p *BufferPool) SizeHint() uint32 {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.hint
+}
+
+func (p *BufferPool) setHint(size uint32) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.hint = size
+	p.mu.Unlock()
+}
+
+// QueryOptions controls buffer sizing for Query and QueryRaw. A nil
+// *QueryOptions, and zero fields, use the defaults.
+type QueryOptions struct {
+	InitialSize uint32       // first buffer size; defaults to the class size or DefaultQueryInitialSize
+	MaxSize     uint32       // largest buffer to try; defaults to DefaultQueryMaxSize
+	MaxAttempts int          // system calls before giving up; defaults to DefaultQueryMaxAttempts
+	Growth      GrowthPolicy // defaults to GrowToRequired
+	Pool        *BufferPool  // recycles buffers between calls; nil allocates every time
+	Input       []byte       // system classes: the NtQuerySystemInformationEx input buffer; process and thread classes: copied to the start of the output buffer
+}
+
+// withDefaults returns a copy of opts with zero fields filled in. fixedSize
+// is the size of fixed-size classes, or 0.
+func (opts *QueryOptions) withDefaults(fixedSize uint32) QueryOptions {
+	var o QueryOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.InitialSize == 0 {
+		o.InitialSize = fixedSize
+	}
+	if o.InitialSize == 0 {
+		o.InitialSize = DefaultQueryInitialSize
+	}
+	if hint := o.Pool.SizeHint(); hint > o.InitialSize {
+		o.InitialSize = hint
+	}
+	if o.MaxSize == 0 {
+		o.MaxSize = DefaultQueryMaxSize
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = DefaultQueryMaxAttempts
+	}
+	if o.Growth == nil {
+		o.Growth = GrowToRequired
+	}
+	return o
+}
+
+// nativePointerSize is the pointer size of the running process
+const nativePointerSize = int(unsafe.Sizeof(uintptr(0)))
+
+// queryCall performs one system call into buf and returns the status and the
+// length reported by the kernel
+type queryCall func(buf []byte) (status winx.NTSTATUS, returnLength uint32)
+
+// isBufferSizeStatus reports whether a status asks for a larger buffer
+func isBufferSizeStatus(status winx.NTSTATUS) bool {
+	return status == winx.STATUS_INFO_LENGTH_MISMATCH || status == winx.STATUS_BUFFER_TOO_SMALL || status == winx.STATUS_BUFFER_OVERFLOW
+}
+
+// checkBuffer returns a STATUS_BUFFER_TOO_SMALL error if buf is shorter than
+// the size bytes the structure name needs
+func checkBuffer(buf []byte, size int, name string) error {
+	if len(buf) < size {
+		return winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("%s needs %d bytes, got %d", name, size, len(buf)))
+	}
+	return nil
+}
+
+// runQuery calls call with growing buffers until it succeeds, fails with a
+// status other than a buffer size error, ctx is done, or the attempt or size
+// limits are reached. name describes the call in errors. On success the
+// buffer is trimmed to the reported length and still belongs to o.Pool.
+func runQuery(ctx context.Context, name string, o QueryOptions, call queryCall) ([]byte, error) {
+	size := o.InitialSize
+	if size > o.MaxSize {
+		size = o.MaxSize
+	}
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		buf := o.Pool.Get(size)
+		status, returnLength := call(buf)
+		if status == winx.STATUS_SUCCESS {
+			o.Pool.setHint(size)
+			if returnLength > 0 && returnLength <= size {
+				buf = buf[:returnLength]
+			}
+			return buf, nil
+		}
+		o.Pool.Put(buf)
+		if !isBufferSizeStatus(status) {
+			return nil, winx.NewNTStatusError(status, name)
+		}
+
+		if attempt >= o.MaxAttempts {
+			return nil, winx.NewNTStatusError(status, fmt.Sprintf("%s: buffer still too small after %d attempts (last size %d bytes)", name, attempt, size))
+		}
+		if size >= o.MaxSize {
+			return nil, winx.NewNTStatusError(status, fmt.Sprintf("%s: needs more than the %d byte maximum (kernel reported %d)", name, o.MaxSize, returnLength))
+		}
+		next := o.Growth(size, returnLength)
+		if next <= size {
+			next = GrowDouble(size, returnLength)
+		}
+		if next > o.MaxSize {
+			next = o.MaxSize
+		}
+		size = next
+	}
+}
+
+// infoClass is an information class enum of the root package
+type infoClass interface {
+	~uint32
+	fmt.Stringer
+}
+
+// decoderRegistry holds the decoders registered for one kind of information
+// class. Decoders are stored as any since each class produces its own type.
+type decoderRegistry[C infoClass] struct {
+	mu       sync.RWMutex
+	decoders map[C]any
+}
+
+func (r *decoderRegistry[C]) register(class C, decoder any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.decoders == nil {
+		r.decoders = make(map[C]any)
+	}
+	r.decoders[class] = decoder
+}
+
+func (r *decoderRegistry[C]) unregister(class C) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.decoders, class)
+}
+
+// classes returns the classes that have a decoder, in ascending order
+func (r *decoderRegistry[C]) classes() []C {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	classes := make([]C, 0, len(r.decoders))
+	for class := range r.decoders {
+		classes = append(classes, class)
+	}
+	sort.Slice(classes, func(i, j int) bool { return classes[i] < classes[j] })
+	return classes
+}
+
+// size returns the Size of the decoder registered for class, or fallback if
+// there is none or the class is variable-size
+func (r *decoderRegistry[C]) size(class C, fallback uint32) uint32 {
+	r.mu.RLock()
+	registered, ok := r.decoders[class].(interface{ size() uint32 })
+	r.mu.RUnlock()
+	if ok && registered.size() != 0 {
+		return registered.size()
+	}
+	return fallback
+}
+
+// lookupDecoder returns the decoder registered for class as a D producing T.
+// It returns an NTStatusError with STATUS_INVALID_INFO_CLASS if none is
+// registered, and with STATUS_INVALID_PARAMETER if it does not produce T.
+func lookupDecoder[D, T any, C infoClass](r *decoderRegistry[C], class C) (D, error) {
+	var zero D
+	r.mu.RLock()
+	registered, ok := r.decoders[class]
+	r.mu.RUnlock()
+	if !ok {
+		return zero, winx.NewNTStatusError(winx.STATUS_INVALID_INFO_CLASS, fmt.Sprintf("no decoder registered for %s", class))
+	}
+	decoder, ok := registered.(D)
+	if !ok {
+		var want T
+		return zero, winx.NewNTStatusError(winx.STATUS_INVALID_PARAMETER, fmt.Sprintf("decoder for %s does not produce %T", class, want))
+	}
+	return decoder, nil
+}
+
+// ClassDecoder converts the output of a system information class to T
+type ClassDecoder[T any] struct {
+	Class winx.SystemInformationClass
+
+	// Size is the exact output size of fixed-size classes, which many classes
+	// require as the buffer length, or 0 for variable-size classes
+	Size uint32
+
+	// Decode converts the output. base is the address buf was filled at, for
+	// resolving embedded pointers. Decode must not retain buf, which may be
+	// recycled.
+	Decode func(buf []byte, base uintptr, pointerSize int) (T, error)
+}
+
+var systemDecoders decoderRegistry[winx.SystemInformationClass]
+
+// RegisterClassDecoder makes a decoder available to Query, replacing any
+// decoder already registered for the class.
+func RegisterClassDecoder[T any](decoder ClassDecoder[T]) {
+	systemDecoders.register(decoder.Class, decoder)
+}
+
+// LookupClassDecoder returns the decoder registered for class. It returns an
+// NTStatusError with STATUS_INVALID_INFO_CLASS if none is registered, and with
+// STATUS_INVALID_PARAMETER if the registered decoder does not produce T.
+func LookupClassDecoder[T any](class winx.SystemInformationClass) (ClassDecoder[T], error) {
+	return lookupDecoder[ClassDecoder[T], T](&systemDecoders, class)
+}
+
+// RegisteredClasses returns the classes that have a decoder, in ascending order.
+func RegisteredClasses() []winx.SystemInformationClass {
+	return systemDecoders.classes()
+}
+
+// ProcessClassDecoder converts the output of a process information class to T
+type ProcessClassDecoder[T any] struct {
+	Class winx.ProcessInfoClass
+
+	// Size is the exact output size of fixed-size classes, which most process
+	// classes require as the buffer length, or 0 for variable-size classes
+	Size uint32
+
+	// Decode converts the output, as for ClassDecoder
+	Decode func(buf []byte, base uintptr, pointerSize int) (T, error)
+}
+
+func (decoder ProcessClassDecoder[T]) size() uint32 {
+	return decoder.Size
+}
+
+var processDecoders decoderRegistry[winx.ProcessInfoClass]
+
+// RegisterProcessClassDecoder makes a decoder available to QueryProcess,
+// replacing any decoder already registered for the class.
+func RegisterProcessClassDecoder[T any](decoder ProcessClassDecoder[T]) {
+	processDecoders.register(decoder.Class, decoder)
+}
+
+// LookupProcessClassDecoder returns the decoder registered for class, with the
+// same errors as LookupClassDecoder.
+func LookupProcessClassDecoder[T any](class winx.ProcessInfoClass) (ProcessClassDecoder[T], error) {
+	return lookupDecoder[ProcessClassDecoder[T], T](&processDecoders, class)
+}
+
+// RegisteredProcessClasses returns the process classes that have a decoder,
+// in ascending order.
+func RegisteredProcessClasses() []winx.ProcessInfoClass {
+	return processDecoders.classes()
+}
+
+// processClassSize returns the Size of the decoder registered for class, or
+// DefaultProcessQueryInitialSize if there is none or the class is variable-size
+func processClassSize(class winx.ProcessInfoClass) uint32 {
+	return processDecoders.size(class, DefaultProcessQueryInitialSize)
+}
+
+// ThreadClassDecoder converts the output of a thread information class to T
+type ThreadClassDecoder[T any] struct {
+	Class winx.ThreadInfoClass
+
+	// Size is the exact output size of fixed-size classes, which most thread
+	// classes require as the buffer length, or 0 for variable-size classes
+	Size uint32
+
+	// Decode converts the output, as for ClassDecoder
+	Decode func(buf []byte, base uintptr, pointerSize int) (T, error)
+}
+
+func (decoder ThreadClassDecoder[T]) size() uint32 {
+	return decoder.Size
+}
+
+var threadDecoders decoderRegistry[winx.ThreadInfoClass]
+
+// RegisterThreadClassDecoder makes a decoder available to QueryThread,
+// replacing any decoder already registered for the class.
+func RegisterThreadClassDecoder[T any](decoder ThreadClassDecoder[T]) {
+	threadDecoders.register(decoder.Class, decoder)
+}
+
+// LookupThreadClassDecoder returns the decoder registered for class, with the
+// same errors as LookupClassDecoder.
+func LookupThreadClassDecoder[T any](class winx.ThreadInfoClass) (ThreadClassDecoder[T], error) {
+	return lookupDecoder[ThreadClassDecoder[T], T](&threadDecoders, class)
+}
+
+// RegisteredThreadClasses returns the thread classes that have a decoder, in
+// ascending order.
+func RegisteredThreadClasses() []winx.ThreadInfoClass {
+	return threadDecoders.classes()
+}
+
+// threadClassSize returns the Size of the decoder registered for class, or
+// DefaultProcessQueryInitialSize if there is none or the class is variable-size
+func threadClassSize(class winx.ThreadInfoClass) uint32 {
+	return threadDecoders.size(class, DefaultProcessQueryInitialSize)
+}
+
+// withoutBase adapts a decoder that has no embedded pointers
+func withoutBase[T any](decode func([]byte, int) (T, error)) func([]byte, uintptr, int) (T, error) {
+	return func(buf []byte, _ uintptr, pointerSize int) (T, error) {
+		return decode(buf, pointerSize)
+	}
+}
+
+// fixedLayout adapts a decoder whose layout does not depend on pointer size
+func fixedLayout[T any](decode func([]byte) (T, error)) func([]byte, uintptr, int) (T, error) {
+	return func(buf []byte, _ uintptr, _ int) (T, error) {
+		return decode(buf)
+	}
+}
+
+func init() {
+	RegisterClassDecoder(ClassDecoder[[]SystemProcess]{Class: winx.SystemProcessInformation, Decode: DecodeSystemProcessInformation})
+	RegisterClassDecoder(ClassDecoder[SystemModules]{Class: winx.SystemModuleInformation, Decode: withoutBase(DecodeSystemModuleInformation)})
+	RegisterClassDecoder(ClassDecoder[SystemModules]{Class: winx.SystemModuleInformationEx, Decode: withoutBase(DecodeSystemModuleInformationEx)})
+	RegisterClassDecoder(ClassDecoder[[]handle.SYSTEM_HANDLE_TABLE_ENTRY_INFO]{Class: winx.SystemHandleInformation, Decode: withoutBase(handle.DecodeHandleInformation)})
+	RegisterClassDecoder(ClassDecoder[[]handle.SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX]{Class: winx.SystemExtendedHandleInformation, Decode: withoutBase(handle.DecodeHandleInformationEx)})
+	RegisterClassDecoder(ClassDecoder[[]PoolTagUsage]{Class: winx.SystemPoolTagInformation, Decode: withoutBase(DecodeSystemPoolTagInformation)})
+	RegisterClassDecoder(ClassDecoder[[]BigPoolAllocation]{Class: winx.SystemBigPoolInformation, Decode: withoutBase(DecodeSystemBigPoolInformation)})
+	RegisterClassDecoder(ClassDecoder[KernelDebuggerInfo]{Class: winx.SystemKernelDebuggerInformation, Size: 2, Decode: fixedLayout(DecodeKernelDebuggerInformation)})
+	RegisterClassDecoder(ClassDecoder[SecureBootInfo]{Class: winx.SystemSecureBootInformation, Size: 2, Decode: fixedLayout(DecodeSecureBootInformation)})
+	RegisterClassDecoder(ClassDecoder[BootEnvironment]{Class: winx.SystemBootEnvironmentInformation, Size: 32, Decode: fixedLayout(DecodeBootEnvironmentInformation)})
+	RegisterClassDecoder(ClassDecoder[HypervisorInfo]{Class: winx.SystemHypervisorInformation, Size: 16, Decode: fixedLayout(DecodeHypervisorInformation)})
+
+	RegisterProcessClassDecoder(ProcessClassDecoder[ProcessBasicInfo]{Class: winx.ProcessBasicInformation, Size: uint32(processBasicInformationSize(nativePointerSize)), Decode: withoutBase(DecodeProcessBasicInformation)})
+	RegisterProcessClassDecoder(ProcessClassDecoder[KernelUserTimes]{Class: winx.ProcessTimes, Size: 32, Decode: fixedLayout(DecodeKernelUserTimes)})
+	RegisterProcessClassDecoder(ProcessClassDecoder[string]{Class: winx.ProcessImageFileName, Decode: DecodeProcessUnicodeString})
+	RegisterProcessClassDecoder(ProcessClassDecoder[string]{Class: winx.ProcessImageFileNameWin32, Decode: DecodeProcessUnicodeString})
+	RegisterProcessClassDecoder(ProcessClassDecoder[string]{Class: winx.ProcessCommandLineInformation, Decode: DecodeProcessUnicodeString})
+	RegisterProcessClassDecoder(ProcessClassDecoder[ProcessProtection]{Class: winx.ProcessProtectionInformation, Size: 1, Decode: fixedLayout(DecodeProcessProtectionInformation)})
+	RegisterProcessClassDecoder(ProcessClassDecoder[bool]{Class: winx.ProcessBreakOnTermination, Size: 4, Decode: fixedLayout(DecodeProcessBreakOnTermination)})
+	RegisterProcessClassDecoder(ProcessClassDecoder[HandleCountInfo]{Class: winx.ProcessHandleCount, Size: 8, Decode: fixedLayout(DecodeProcessHandleCount)})
+	RegisterProcessClassDecoder(ProcessClassDecoder[MitigationPolicyInfo]{Class: winx.ProcessMitigationPolicy, Size: 8, Decode: fixedLayout(DecodeProcessMitigationPolicy)})
+
+	lastSystemCallFull, _ := lastSystemCallSize(nativePointerSize)
+	RegisterThreadClassDecoder(ThreadClassDecoder[ThreadBasicInfo]{Class: winx.ThreadBasicInformation, Size: uint32(threadBasicInformationSize(nativePointerSize)), Decode: withoutBase(DecodeThreadBasicInformation)})
+	RegisterThreadClassDecoder(ThreadClassDecoder[KernelUserTimes]{Class: winx.ThreadTimes, Size: 32, Decode: fixedLayout(DecodeKernelUserTimes)})
+	RegisterThreadClassDecoder(ThreadClassDecoder[uint64]{Class: winx.ThreadQuerySetWin32StartAddress, Size: uint32(nativePointerSize), Decode: withoutBase(DecodeThreadStartAddress)})
+	RegisterThreadClassDecoder(ThreadClassDecoder[LastSystemCall]{Class: winx.ThreadLastSystemCall, Size: uint32(lastSystemCallFull), Decode: withoutBase(DecodeThreadLastSystemCall)})
+	RegisterThreadClassDecoder(ThreadClassDecoder[ThreadCycleTime]{Class: winx.ThreadCycleTime, Size: 16, Decode: fixedLayout(DecodeThreadCycleTime)})
+	RegisterThreadClassDecoder(ThreadClassDecoder[IOPriorityHint]{Class: winx.ThreadIoPriority, Size: 4, Decode: fixedLayout(DecodeThreadIoPriority)})
+	RegisterThreadClassDecoder(ThreadClassDecoder[uint32]{Class: winx.ThreadSuspendCount, Size: 4, Decode: fixedLayout(DecodeThreadSuspendCount)})
+	RegisterThreadClassDecoder(ThreadClassDecoder[bool]{Class: winx.ThreadIsTerminated, Size: 4, Decode: fixedLayout(DecodeThreadIsTerminated)})
+	RegisterThreadClassDecoder(ThreadClassDecoder[string]{Class: winx.ThreadNameInformation, Decode: DecodeThreadName})
+}
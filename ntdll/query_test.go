@@ -0,0 +1,187 @@
+package ntdll
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// fakeQuery simulates a class whose output is need bytes, reporting the
+// required length when report is set
+func fakeQuery(need uint32, report bool, calls *[]uint32) queryCall {
+	return func(buf []byte) (winx.NTSTATUS, uint32) {
+		*calls = append(*calls, uint32(len(buf)))
+		if uint32(len(buf)) < need {
+			if report {
+				return winx.STATUS_INFO_LENGTH_MISMATCH, need
+			}
+			return winx.STATUS_INFO_LENGTH_MISMATCH, 0
+		}
+		for i := range buf[:need] {
+			buf[i] = 0xAB
+		}
+		return winx.STATUS_SUCCESS, need
+	}
+}
+
+// TestRunQuery tests buffer growth, limits and error reporting
+func TestRunQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      *QueryOptions
+		need      uint32
+		report    bool
+		wantCalls []uint32
+		wantErr   winx.NTSTATUS
+	}{
+		{
+			name:      "reported length with headroom",
+			opts:      &QueryOptions{InitialSize: 100},
+			need:      800,
+			report:    true,
+			wantCalls: []uint32{100, 900},
+		},
+		{
+			name:      "doubling without reported length",
+			opts:      &QueryOptions{InitialSize: 100},
+			need:      350,
+			wantCalls: []uint32{100, 200, 400},
+		},
+		{
+			name:      "GrowDouble ignores reported length",
+			opts:      &QueryOptions{InitialSize: 100, Growth: GrowDouble},
+			need:      300,
+			report:    true,
+			wantCalls: []uint32{100, 200, 400},
+		},
+		{
+			name:      "max attempts",
+			opts:      &QueryOptions{InitialSize: 100, MaxAttempts: 2},
+			need:      1000,
+			wantCalls: []uint32{100, 200},
+			wantErr:   winx.STATUS_INFO_LENGTH_MISMATCH,
+		},
+		{
+			name:      "max size",
+			opts:      &QueryOptions{InitialSize: 100, MaxSize: 300},
+			need:      1000,
+			wantCalls: []uint32{100, 200, 300},
+			wantErr:   winx.STATUS_INFO_LENGTH_MISMATCH,
+		},
+		{
+			name:      "defaults",
+			opts:      nil,
+			need:      100,
+			wantCalls: []uint32{DefaultQueryInitialSize},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []uint32
+			buf, err := runQuery(context.Background(), "test", tt.opts.withDefaults(0), fakeQuery(tt.need, tt.report, &calls))
+			if tt.wantErr != 0 {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("runQuery() error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("runQuery() error = %v", err)
+			} else if uint32(len(buf)) != tt.need {
+				t.Errorf("len(buf) = %d, want %d", len(buf), tt.need)
+			}
+			if len(calls) != len(tt.wantCalls) {
+				t.Fatalf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+			for i := range calls {
+				if calls[i] != tt.wantCalls[i] {
+					t.Errorf("calls = %v, want %v", calls, tt.wantCalls)
+					break
+				}
+			}
+		})
+	}
+}
+
+// TestRunQuery_Errors tests non-size failures and cancellation
+func TestRunQuery_Errors(t *testing.T) {
+	denied := func(buf []byte) (winx.NTSTATUS, uint32) { return winx.STATUS_ACCESS_DENIED, 0 }
+	if _, err := runQuery(context.Background(), "test", (*QueryOptions)(nil).withDefaults(0), denied); !errors.Is(err, winx.STATUS_ACCESS_DENIED) {
+		t.Errorf("runQuery() error = %v, want STATUS_ACCESS_DENIED", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls []uint32
+	call := func(buf []byte) (winx.NTSTATUS, uint32) {
+		cancel()
+		return fakeQuery(1<<20, false, &calls)(buf)
+	}
+	if _, err := runQuery(ctx, "test", (*QueryOptions)(nil).withDefaults(0), call); !errors.Is(err, context.Canceled) {
+		t.Errorf("runQuery() error = %v, want context.Canceled", err)
+	}
+	if len(calls) != 1 {
+		t.Errorf("made %d calls after cancellation, want 1", len(calls))
+	}
+}
+
+// TestBufferPool tests that polling reuses the size that last succeeded
+func TestBufferPool(t *testing.T) {
+	pool := NewBufferPool()
+	opts := &QueryOptions{InitialSize: 64, Pool: pool}
+
+	var calls []uint32
+	buf, err := runQuery(context.Background(), "test", opts.withDefaults(0), fakeQuery(500, true, &calls))
+	if err != nil {
+		t.Fatalf("runQuery() error = %v", err)
+	}
+	pool.Put(buf)
+	if pool.SizeHint() != 562 {
+		t.Errorf("SizeHint() = %d, want 562", pool.SizeHint())
+	}
+
+	calls = nil
+	if _, err := runQuery(context.Background(), "test", opts.withDefaults(0), fakeQuery(500, true, &calls)); err != nil {
+		t.Fatalf("runQuery() error = %v", err)
+	}
+	if len(calls) != 1 || calls[0] != 562 {
+		t.Errorf("second query calls = %v, want [562]", calls)
+	}
+
+	var nilPool *BufferPool
+	if got := nilPool.Get(16); len(got) != 16 {
+		t.Errorf("nil pool Get(16) returned %d bytes", len(got))
+	}
+	nilPool.Put(make([]byte, 16))
+}
+
+// TestClassDecoderRegistry tests lookup of registered decoders
+func TestClassDecoderRegistry(t *testing.T) {
+	decoder, err := LookupClassDecoder[KernelDebuggerInfo](winx.SystemKernelDebuggerInformation)
+	if err != nil {
+		t.Fatalf("LookupClassDecoder() error = %v", err)
+	}
+	if decoder.Size != 2 {
+		t.Errorf("Size = %d, want 2", decoder.Size)
+	}
+	info, err := decoder.Decode([]byte{1, 0}, 0, 8)
+	if err != nil || !info.Enabled {
+		t.Errorf("Decode() = %+v, %v", info, err)
+	}
+
+	if _, err := LookupClassDecoder[SystemModules](winx.SystemKernelDebuggerInformation); !errors.Is(err, winx.STATUS_INVALID_PARAMETER) {
+		t.Errorf("wrong type error = %v, want STATUS_INVALID_PARAMETER", err)
+	}
+	if _, err := LookupClassDecoder[[]byte](winx.SystemBasicInformation); !errors.Is(err, winx.STATUS_INVALID_INFO_CLASS) {
+		t.Errorf("unregistered class error = %v, want STATUS_INVALID_INFO_CLASS", err)
+	}
+
+	RegisterClassDecoder(ClassDecoder[[]byte]{
+		Class:  winx.SystemBasicInformation,
+		Decode: func(buf []byte, _ uintptr, _ int) ([]byte, error) { return append([]byte(nil), buf...), nil },
+	})
+	defer systemDecoders.unregister(winx.SystemBasicInformation)
+	classes := RegisteredClasses()
+	if len(classes) == 0 || classes[0] != winx.SystemBasicInformation {
+		t.Errorf("RegisteredClasses() = %v, want SystemBasicInformation first", classes)
+	}
+}
@@ -0,0 +1,272 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// CodeIntegrityOptions are the CODEINTEGRITY_OPTION_* flags returned by
+// SystemCodeIntegrityInformation
+type CodeIntegrityOptions uint32
+
+const (
+	CodeIntegrityEnabled            CodeIntegrityOptions = 0x0001
+	CodeIntegrityTestSign           CodeIntegrityOptions = 0x0002
+	CodeIntegrityUMCIEnabled        CodeIntegrityOptions = 0x0004
+	CodeIntegrityUMCIAuditMode      CodeIntegrityOptions = 0x0008
+	CodeIntegrityUMCIExclusionPaths CodeIntegrityOptions = 0x0010
+	CodeIntegrityTestBuild          CodeIntegrityOptions = 0x0020
+	CodeIntegrityPreproductionBuild CodeIntegrityOptions = 0x0040
+	CodeIntegrityDebugMode          CodeIntegrityOptions = 0x0080
+	CodeIntegrityFlightBuild        CodeIntegrityOptions = 0x0100
+	CodeIntegrityFlightingEnabled   CodeIntegrityOptions = 0x0200
+	CodeIntegrityHVCIKMCIEnabled    CodeIntegrityOptions = 0x0400
+	CodeIntegrityHVCIKMCIAuditMode  CodeIntegrityOptions = 0x0800
+	CodeIntegrityHVCIKMCIStrictMode CodeIntegrityOptions = 0x1000
+	CodeIntegrityHVCIIUMEnabled     CodeIntegrityOptions = 0x2000
+	CodeIntegrityWHQLEnforcement    CodeIntegrityOptions = 0x4000
+	CodeIntegrityWHQLAuditMode      CodeIntegrityOptions = 0x8000
+)
+
+var codeIntegrityOptionNames = []struct {
+	option CodeIntegrityOptions
+	name   string
+}{
+	{CodeIntegrityEnabled, "ENABLED"},
+	{CodeIntegrityTestSign, "TESTSIGN"},
+	{CodeIntegrityUMCIEnabled, "UMCI_ENABLED"},
+	{CodeIntegrityUMCIAuditMode, "UMCI_AUDITMODE_ENABLED"},
+	{CodeIntegrityUMCIExclusionPaths, "UMCI_EXCLUSIONPATHS_ENABLED"},
+	{CodeIntegrityTestBuild, "TEST_BUILD"},
+	{CodeIntegrityPreproductionBuild, "PREPRODUCTION_BUILD"},
+	{CodeIntegrityDebugMode, "DEBUGMODE_ENABLED"},
+	{CodeIntegrityFlightBuild, "FLIGHT_BUILD"},
+	{CodeIntegrityFlightingEnabled, "FLIGHTING_ENABLED"},
+	{CodeIntegrityHVCIKMCIEnabled, "HVCI_KMCI_ENABLED"},
+	{CodeIntegrityHVCIKMCIAuditMode, "HVCI_KMCI_AUDITMODE_ENABLED"},
+	{CodeIntegrityHVCIKMCIStrictMode, "HVCI_KMCI_STRICTMODE_ENABLED"},
+	{CodeIntegrityHVCIIUMEnabled, "HVCI_IUM_ENABLED"},
+	{CodeIntegrityWHQLEnforcement, "WHQL_ENFORCEMENT_ENABLED"},
+	{CodeIntegrityWHQLAuditMode, "WHQL_AUDITMODE_ENABLED"},
+}
+
+// Has reports whether every flag in option is set
+func (options CodeIntegrityOptions) Has(option CodeIntegrityOptions) bool {
+	return options&option == option
+}
+
+// String returns the set flags joined by '|', e.g. "ENABLED|TESTSIGN"
+func (options CodeIntegrityOptions) String() string {
+	if options == 0 {
+		return "0"
+	}
+	var names []string
+	remaining := options
+	for _, o := range codeIntegrityOptionNames {
+		if options&o.option != 0 {
+			names = append(names, o.name)
+			remaining &^= o.option
+		}
+	}
+	if remaining != 0 {
+		names = append(names, fmt.Sprintf("0x%X", uint32(remaining)))
+	}
+	return strings.Join(names, "|")
+}
+
+// KernelDebuggerInfo is a decoded SYSTEM_KERNEL_DEBUGGER_INFORMATION
+type KernelDebuggerInfo struct {
+	Enabled    bool // the system was booted with /DEBUG
+	NotPresent bool // no debugger is attached
+}
+
+// SecureBootInfo combines SYSTEM_SECUREBOOT_INFORMATION and
+// SYSTEM_SECUREBOOT_POLICY_INFORMATION
+type SecureBootInfo struct {
+	Enabled         bool
+	Capable         bool
+	PolicyPublisher string // GUID, empty if the policy could not be queried
+	PolicyVersion   uint32
+	PolicyOptions   uint32
+}
+
+// FirmwareType is the FIRMWARE_TYPE the system booted from
+type FirmwareType uint32
+
+const (
+	FirmwareTypeUnknown FirmwareType = 0
+	FirmwareTypeBIOS    FirmwareType = 1
+	FirmwareTypeUEFI    FirmwareType = 2
+)
+
+// String returns "BIOS", "UEFI" or "Unknown"
+func (firmwareType FirmwareType) String() string {
+	switch firmwareType {
+	case FirmwareTypeBIOS:
+		return "BIOS"
+	case FirmwareTypeUEFI:
+		return "UEFI"
+	}
+	return "Unknown"
+}
+
+// BootEnvironment is a decoded SYSTEM_BOOT_ENVIRONMENT_INFORMATION
+type BootEnvironment struct {
+	BootIdentifier string // GUID of the boot entry
+	FirmwareType   FirmwareType
+	BootFlags      uint64
+}
+
+// Boot environment flags
+const (
+	BootFlagMenuOsSelection       = 1 << 0
+	BootFlagHiberBoot             = 1 << 1 // fast startup resume
+	BootFlagSoftBoot              = 1 << 2
+	BootFlagMeasuredLaunch        = 1 << 3 // DRTM (System Guard Secure Launch)
+	BootFlagMeasuredLaunchCapable = 1 << 4
+)
+
+// HypervisorInfo is a decoded SYSTEM_HYPERVISOR_QUERY_INFORMATION
+type HypervisorInfo struct {
+	Connected             bool
+	DebuggingEnabled      bool
+	Present               bool
+	EnabledEnlightenments uint64
+}
+
+// SecurityPosture summarizes the code integrity, debugger, Secure Boot,
+// hypervisor and boot state of the system. A section is nil when its
+// information class could not be queried; the reason is kept in Errors.
+type SecurityPosture struct {
+	CodeIntegrity   *CodeIntegrityOptions
+	KernelDebugger  *KernelDebuggerInfo
+	SecureBoot      *SecureBootInfo
+	BootEnvironment *BootEnvironment
+	Hypervisor      *HypervisorInfo
+	Errors          map[winx.SystemInformationClass]error
+}
+
+// TestSigning reports whether test-signed kernel drivers can be loaded
+func (posture *SecurityPosture) TestSigning() bool {
+	return posture.CodeIntegrity != nil && posture.CodeIntegrity.Has(CodeIntegrityTestSign)
+}
+
+// HVCI reports whether hypervisor-enforced code integrity is enabled
+func (posture *SecurityPosture) HVCI() bool {
+	return posture.CodeIntegrity != nil && posture.CodeIntegrity.Has(CodeIntegrityHVCIKMCIEnabled)
+}
+
+// DebugMode reports whether the system was booted with the kernel debugger
+// enabled
+func (posture *SecurityPosture) DebugMode() bool {
+	if posture.KernelDebugger != nil && posture.KernelDebugger.Enabled {
+		return true
+	}
+	return posture.CodeIntegrity != nil && posture.CodeIntegrity.Has(CodeIntegrityDebugMode)
+}
+
+// SecureBootEnabled reports whether Secure Boot is on
+func (posture *SecurityPosture) SecureBootEnabled() bool {
+	return posture.SecureBoot != nil && posture.SecureBoot.Enabled
+}
+
+// RequireTestSigning returns nil if test-signed drivers can be loaded, and an
+// NTStatusError with STATUS_INVALID_IMAGE_HASH (the status a driver load would
+// fail with) otherwise. Call it before device.LoadDriver on test rigs. When
+// HVCI is also enabled, drivers must additionally be HVCI compatible.
+func (posture *SecurityPosture) RequireTestSigning() error {
+	if posture.CodeIntegrity == nil {
+		return winx.NewNTStatusError(winx.STATUS_INVALID_IMAGE_HASH, fmt.Sprintf("test signing state is unknown: %v", posture.Errors[winx.SystemCodeIntegrityInformation]))
+	}
+	if !posture.TestSigning() {
+		reason := "enable it with 'bcdedit /set testsigning on' and reboot"
+		if posture.SecureBootEnabled() {
+			reason = "Secure Boot is enabled and must be turned off first"
+		}
+		return winx.NewNTStatusError(winx.STATUS_INVALID_IMAGE_HASH, "test signing is disabled: "+reason)
+	}
+	return nil
+}
+
+// DecodeCodeIntegrityInformation decodes SYSTEM_CODEINTEGRITY_INFORMATION.
+//
+// Parameters:
+//   - buf: the returned buffer, a ULONG Length followed by a ULONG of options
+//
+// Returns:
+//   - the code integrity options
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is shorter than 8 bytes
+func DecodeCodeIntegrityInformation(buf []byte) (CodeIntegrityOptions, error) {
+	if err := checkBuffer(buf, 8, "SYSTEM_CODEINTEGRITY_INFORMATION"); err != nil {
+		return 0, err
+	}
+	return CodeIntegrityOptions(binary.LittleEndian.Uint32(buf[4:])), nil
+}
+
+// DecodeKernelDebuggerInformation decodes SYSTEM_KERNEL_DEBUGGER_INFORMATION
+func DecodeKernelDebuggerInformation(buf []byte) (KernelDebuggerInfo, error) {
+	if err := checkBuffer(buf, 2, "SYSTEM_KERNEL_DEBUGGER_INFORMATION"); err != nil {
+		return KernelDebuggerInfo{}, err
+	}
+	return KernelDebuggerInfo{Enabled: buf[0] != 0, NotPresent: buf[1] != 0}, nil
+}
+
+// DecodeSecureBootInformation decodes SYSTEM_SECUREBOOT_INFORMATION. The
+// policy fields of the result are left empty.
+func DecodeSecureBootInformation(buf []byte) (SecureBootInfo, error) {
+	if err := checkBuffer(buf, 2, "SYSTEM_SECUREBOOT_INFORMATION"); err != nil {
+		return SecureBootInfo{}, err
+	}
+	return SecureBootInfo{Enabled: buf[0] != 0, Capable: buf[1] != 0}, nil
+}
+
+// DecodeSecureBootPolicyInformation decodes SYSTEM_SECUREBOOT_POLICY_INFORMATION
+// into the policy fields of info
+func DecodeSecureBootPolicyInformation(buf []byte, info *SecureBootInfo) error {
+	if err := checkBuffer(buf, 24, "SYSTEM_SECUREBOOT_POLICY_INFORMATION"); err != nil {
+		return err
+	}
+	info.PolicyPublisher = formatGUID(buf[0:16])
+	info.PolicyVersion = binary.LittleEndian.Uint32(buf[16:])
+	info.PolicyOptions = binary.LittleEndian.Uint32(buf[20:])
+	return nil
+}
+
+// DecodeBootEnvironmentInformation decodes SYSTEM_BOOT_ENVIRONMENT_INFORMATION.
+// The layout is the same for 32-bit and 64-bit processes.
+func DecodeBootEnvironmentInformation(buf []byte) (BootEnvironment, error) {
+	if err := checkBuffer(buf, 32, "SYSTEM_BOOT_ENVIRONMENT_INFORMATION"); err != nil {
+		return BootEnvironment{}, err
+	}
+	return BootEnvironment{
+		BootIdentifier: formatGUID(buf[0:16]),
+		FirmwareType:   FirmwareType(binary.LittleEndian.Uint32(buf[16:])),
+		BootFlags:      binary.LittleEndian.Uint64(buf[24:]),
+	}, nil
+}
+
+// DecodeHypervisorInformation decodes SYSTEM_HYPERVISOR_QUERY_INFORMATION
+func DecodeHypervisorInformation(buf []byte) (HypervisorInfo, error) {
+	if err := checkBuffer(buf, 16, "SYSTEM_HYPERVISOR_QUERY_INFORMATION"); err != nil {
+		return HypervisorInfo{}, err
+	}
+	return HypervisorInfo{
+		Connected:             buf[0] != 0,
+		DebuggingEnabled:      buf[1] != 0,
+		Present:               buf[2] != 0,
+		EnabledEnlightenments: binary.LittleEndian.Uint64(buf[8:]),
+	}, nil
+}
+
+// formatGUID formats a GUID in registry format, e.g.
+// {77FA9ABD-0359-4D32-BD60-28F4E78F784B}
+func formatGUID(b []byte) string {
+	return fmt.Sprintf("{%08X-%04X-%04X-%X-%X}",
+		binary.LittleEndian.Uint32(b[0:]),
+		binary.LittleEndian.Uint16(b[4:]),
+		binary.LittleEndian.Uint16(b[6:]),
+		b[8:10], b[10:16])
+}
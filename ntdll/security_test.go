@@ -0,0 +1,130 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// TestCodeIntegrityOptions_String tests flag names and unknown bits
+func TestCodeIntegrityOptions_String(t *testing.T) {
+	tests := []struct {
+		options CodeIntegrityOptions
+		want    string
+	}{
+		{0, "0"},
+		{CodeIntegrityEnabled | CodeIntegrityTestSign, "ENABLED|TESTSIGN"},
+		{CodeIntegrityEnabled | CodeIntegrityHVCIKMCIEnabled, "ENABLED|HVCI_KMCI_ENABLED"},
+		{CodeIntegrityDebugMode | 0x10000, "DEBUGMODE_ENABLED|0x10000"},
+	}
+	for _, tt := range tests {
+		if got := tt.options.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+// TestDecodeSecurityInformation tests the fixed-size class decoders
+func TestDecodeSecurityInformation(t *testing.T) {
+	ci := make([]byte, 8)
+	binary.LittleEndian.PutUint32(ci[0:], 8)
+	binary.LittleEndian.PutUint32(ci[4:], uint32(CodeIntegrityEnabled|CodeIntegrityTestSign))
+	options, err := DecodeCodeIntegrityInformation(ci)
+	if err != nil || options != CodeIntegrityEnabled|CodeIntegrityTestSign {
+		t.Errorf("DecodeCodeIntegrityInformation() = %v, %v", options, err)
+	}
+
+	kd, err := DecodeKernelDebuggerInformation([]byte{1, 1})
+	if err != nil || kd != (KernelDebuggerInfo{Enabled: true, NotPresent: true}) {
+		t.Errorf("DecodeKernelDebuggerInformation() = %+v, %v", kd, err)
+	}
+
+	sb, err := DecodeSecureBootInformation([]byte{0, 1})
+	if err != nil || sb.Enabled || !sb.Capable {
+		t.Errorf("DecodeSecureBootInformation() = %+v, %v", sb, err)
+	}
+	policy := make([]byte, 24)
+	copy(policy, []byte{0xBD, 0x9A, 0xFA, 0x77, 0x59, 0x03, 0x32, 0x4D, 0xBD, 0x60, 0x28, 0xF4, 0xE7, 0x8F, 0x78, 0x4B})
+	binary.LittleEndian.PutUint32(policy[16:], 3)
+	if err := DecodeSecureBootPolicyInformation(policy, &sb); err != nil {
+		t.Fatalf("DecodeSecureBootPolicyInformation() error = %v", err)
+	}
+	if sb.PolicyPublisher != "{77FA9ABD-0359-4D32-BD60-28F4E78F784B}" || sb.PolicyVersion != 3 {
+		t.Errorf("policy = %q version %d", sb.PolicyPublisher, sb.PolicyVersion)
+	}
+
+	boot := make([]byte, 32)
+	binary.LittleEndian.PutUint32(boot[16:], uint32(FirmwareTypeUEFI))
+	binary.LittleEndian.PutUint64(boot[24:], BootFlagHiberBoot)
+	env, err := DecodeBootEnvironmentInformation(boot)
+	if err != nil || env.FirmwareType != FirmwareTypeUEFI || env.BootFlags != BootFlagHiberBoot {
+		t.Errorf("DecodeBootEnvironmentInformation() = %+v, %v", env, err)
+	}
+	if env.FirmwareType.String() != "UEFI" {
+		t.Errorf("FirmwareType.String() = %q", env.FirmwareType)
+	}
+
+	hv := make([]byte, 16)
+	hv[2] = 1
+	binary.LittleEndian.PutUint64(hv[8:], 0x2E)
+	info, err := DecodeHypervisorInformation(hv)
+	if err != nil || !info.Present || info.Connected || info.EnabledEnlightenments != 0x2E {
+		t.Errorf("DecodeHypervisorInformation() = %+v, %v", info, err)
+	}
+
+	if _, err := DecodeBootEnvironmentInformation(boot[:20]); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("short buffer error = %v, want STATUS_BUFFER_TOO_SMALL", err)
+	}
+}
+
+// TestSecurityPosture_RequireTestSigning tests the driver loading precondition
+func TestSecurityPosture_RequireTestSigning(t *testing.T) {
+	options := func(o CodeIntegrityOptions) *CodeIntegrityOptions { return &o }
+	tests := []struct {
+		name    string
+		posture SecurityPosture
+		wantErr string
+	}{
+		{
+			name:    "test signing on",
+			posture: SecurityPosture{CodeIntegrity: options(CodeIntegrityEnabled | CodeIntegrityTestSign)},
+		},
+		{
+			name:    "test signing off",
+			posture: SecurityPosture{CodeIntegrity: options(CodeIntegrityEnabled)},
+			wantErr: "bcdedit",
+		},
+		{
+			name: "secure boot on",
+			posture: SecurityPosture{
+				CodeIntegrity: options(CodeIntegrityEnabled),
+				SecureBoot:    &SecureBootInfo{Enabled: true, Capable: true},
+			},
+			wantErr: "Secure Boot",
+		},
+		{
+			name: "unknown",
+			posture: SecurityPosture{Errors: map[winx.SystemInformationClass]error{
+				winx.SystemCodeIntegrityInformation: winx.NewNTStatusError(winx.STATUS_ACCESS_DENIED, "query"),
+			}},
+			wantErr: "unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.posture.RequireTestSigning()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("RequireTestSigning() error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, winx.STATUS_INVALID_IMAGE_HASH) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("RequireTestSigning() error = %v, want STATUS_INVALID_IMAGE_HASH mentioning %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package ntdll
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/internal/layout"
+)
+
+// MemoryReader fills buf with the memory of a process at address
+type MemoryReader func(address uint64, buf []byte) error
+
+// maxLoaderEntries bounds the PEB loader list walk, which could otherwise
+// loop forever on a corrupt or concurrently modified list
+const maxLoaderEntries = 8192
+
+// Offsets in the PEB, PEB_LDR_DATA and LDR_DATA_TABLE_ENTRY
+func loaderOffsets(pointerSize int) (pebLdr, inLoadOrderList, entrySize int) {
+	if pointerSize == 4 {
+		return 0x0C, 0x0C, 0x34
+	}
+	return 0x18, 0x10, 0x68
+}
+
+// ReadProcessModules reads the user-mode modules of a process from the
+// loader list in its PEB.
+//
+// Parameters:
+//   - read: reads the memory of the process
+//   - peb: the PEB address, from QueryProcessBasicInformation
+//   - pointerSize: 8 for a 64-bit PEB, 4 for the 32-bit PEB of a WOW64 process
+//
+// Returns:
+//   - the modules in load order; the executable is first
+//   - the error from read, or an NTStatusError with STATUS_BUFFER_TOO_SMALL
+//     if the list is longer than 8192 entries
+func ReadProcessModules(read MemoryReader, peb uint64, pointerSize int) (SystemModules, error) {
+	if pointerSize != 4 && pointerSize != 8 {
+		return nil, winx.NewNTStatusError(winx.STATUS_INVALID_PARAMETER, fmt.Sprintf("unsupported pointer size %d", pointerSize))
+	}
+	pebLdr, inLoadOrderList, entrySize := loaderOffsets(pointerSize)
+
+	ldr, err := readPointer(read, peb+uint64(pebLdr), pointerSize)
+	if err != nil {
+		return nil, fmt.Errorf("reading PEB.Ldr: %w", err)
+	}
+	head := ldr + uint64(inLoadOrderList)
+	next, err := readPointer(read, head, pointerSize)
+	if err != nil {
+		return nil, fmt.Errorf("reading the loader list: %w", err)
+	}
+
+	var modules SystemModules
+	entry := make([]byte, entrySize)
+	for next != head && next != 0 {
+		if len(modules) == maxLoaderEntries {
+			return nil, winx.NewNTStatusError(winx.STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("loader list has more than %d entries", maxLoaderEntries))
+		}
+		if err := read(next, entry); err != nil {
+			return nil, fmt.Errorf("reading loader entry at 0x%X: %w", next, err)
+		}
+
+		r := layout.NewReader(entry, 0, pointerSize)
+		flink := r.Pointer()
+		r.Skip(5 * pointerSize) // InLoadOrderLinks.Blink, InMemoryOrderLinks, InInitializationOrderLinks
+		module := SystemModule{LoadOrderIndex: uint16(len(modules))}
+		module.ImageBase = r.Pointer()
+		r.Pointer() // EntryPoint
+		module.ImageSize = r.Uint32()
+		if module.FullPath, err = readRemoteUnicodeString(read, r); err != nil {
+			return nil, fmt.Errorf("reading FullDllName at 0x%X: %w", next, err)
+		}
+		if module.Name, err = readRemoteUnicodeString(read, r); err != nil {
+			return nil, fmt.Errorf("reading BaseDllName at 0x%X: %w", next, err)
+		}
+		module.MappedBase = module.ImageBase
+		modules = append(modules, module)
+		next = flink
+	}
+	return modules, nil
+}
+
+// readPointer reads one pointer of the given size
+func readPointer(read MemoryReader, address uint64, pointerSize int) (uint64, error) {
+	buf := make([]byte, pointerSize)
+	if err := read(address, buf); err != nil {
+		return 0, err
+	}
+	if pointerSize == 4 {
+		return uint64(binary.LittleEndian.Uint32(buf)), nil
+	}
+	return binary.LittleEndian.Uint64(buf), nil
+}
+
+// readRemoteUnicodeString reads a UNICODE_STRING from r whose characters are
+// in the memory of another process
+func readRemoteUnicodeString(read MemoryReader, r *layout.Reader) (string, error) {
+	r.Align(r.PointerSize())
+	length := r.Uint16()
+	r.Uint16() // MaximumLength
+	address := r.Pointer()
+	if err := r.Err(); err != nil {
+		return "", err
+	}
+	if length == 0 || address == 0 {
+		return "", nil
+	}
+	chars := make([]byte, length)
+	if err := read(address, chars); err != nil {
+		return "", err
+	}
+	return winx.DecodeUTF16(chars), nil
+}
+
+// ModuleExport is an entry of a module's export table
+type ModuleExport struct {
+	Name    string // "" for exports by ordinal only
+	Ordinal uint32
+	RVA     uint32
+}
+
+// PE limits applied to export tables read from another process
+const (
+	maxExports        = 65536
+	maxExportNameSize = 512
+)
+
+// ReadModuleExports reads the export table of a PE image mapped at base.
+// Forwarded exports are left out since they have no code in the image.
+//
+// Parameters:
+//   - read: reads the memory of the process the image is mapped in
+//   - base: the image base
+//
+// Returns:
+//   - the exports ordered by RVA; nil if the image exports nothing
+//   - the error from read, or an NTStatusError with STATUS_INVALID_IMAGE_FORMAT
+//     if the headers are malformed
+func ReadModuleExports(read MemoryReader, base uint64) ([]ModuleExport, error) {
+	dos := make([]byte, 0x40)
+	if err := read(base, dos); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(dos[:2], []byte("MZ")) {
+		return nil, invalidImage(base, "missing MZ signature")
+	}
+
+	ntHeaders := base + uint64(binary.LittleEndian.Uint32(dos[0x3C:]))
+	nt := make([]byte, 4+20+112+8)
+	if err := read(ntHeaders, nt); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(nt[:4], []byte("PE\x00\x00")) {
+		return nil, invalidImage(base, "missing PE signature")
+	}
+	optional := nt[24:]
+	sizeOfImage := binary.LittleEndian.Uint32(optional[56:])
+	var directories, directoryCount int
+	switch magic := binary.LittleEndian.Uint16(optional); magic {
+	case 0x10B: // PE32
+		directories, directoryCount = 96, int(binary.LittleEndian.Uint32(optional[92:]))
+	case 0x20B: // PE32+
+		directories, directoryCount = 112, int(binary.LittleEndian.Uint32(optional[108:]))
+	default:
+		return nil, invalidImage(base, fmt.Sprintf("unknown optional header magic 0x%X", magic))
+	}
+	if directoryCount == 0 {
+		return nil, nil
+	}
+	exportRVA := binary.LittleEndian.Uint32(optional[directories:])
+	exportSize := binary.LittleEndian.Uint32(optional[directories+4:])
+	if exportRVA == 0 || exportSize == 0 {
+		return nil, nil
+	}
+	if exportRVA >= sizeOfImage || sizeOfImage-exportRVA < 40 {
+		return nil, invalidImage(base, "export directory outside the image")
+	}
+
+	directory := make([]byte, 40)
+	if err := read(base+uint64(exportRVA), directory); err != nil {
+		return nil, err
+	}
+	ordinalBase := binary.LittleEndian.Uint32(directory[16:])
+	functionCount := binary.LittleEndian.Uint32(directory[20:])
+	nameCount := binary.LittleEndian.Uint32(directory[24:])
+	if functionCount > maxExports || nameCount > functionCount {
+		return nil, invalidImage(base, fmt.Sprintf("%d functions and %d names exported", functionCount, nameCount))
+	}
+
+	functions := make([]byte, 4*functionCount)
+	names := make([]byte, 4*nameCount)
+	ordinals := make([]byte, 2*nameCount)
+	for _, table := range []struct {
+		buf    []byte
+		offset int
+	}{{functions, 28}, {names, 32}, {ordinals, 36}} {
+		if len(table.buf) == 0 {
+			continue
+		}
+		if err := read(base+uint64(binary.LittleEndian.Uint32(directory[table.offset:])), table.buf); err != nil {
+			return nil, err
+		}
+	}
+
+	exports := make([]ModuleExport, functionCount)
+	for i := range exports {
+		exports[i] = ModuleExport{Ordinal: ordinalBase + uint32(i), RVA: binary.LittleEndian.Uint32(functions[4*i:])}
+	}
+	for i := uint32(0); i < nameCount; i++ {
+		index := binary.LittleEndian.Uint16(ordinals[2*i:])
+		if uint32(index) >= functionCount {
+			continue
+		}
+		nameRVA := binary.LittleEndian.Uint32(names[4*i:])
+		if nameRVA >= sizeOfImage {
+			continue
+		}
+		name := make([]byte, min(maxExportNameSize, sizeOfImage-nameRVA))
+		if err := read(base+uint64(nameRVA), name); err != nil {
+			return nil, err
+		}
+		if end := bytes.IndexByte(name, 0); end >= 0 {
+			name = name[:end]
+		}
+		exports[index].Name = string(name)
+	}
+
+	code := exports[:0]
+	for _, export := range exports {
+		forwarded := export.RVA >= exportRVA && export.RVA-exportRVA < exportSize
+		if export.RVA != 0 && !forwarded {
+			code = append(code, export)
+		}
+	}
+	sort.SliceStable(code, func(i, j int) bool { return code[i].RVA < code[j].RVA })
+	return code, nil
+}
+
+func invalidImage(base uint64, reason string) error {
+	return winx.NewNTStatusError(winx.STATUS_INVALID_IMAGE_FORMAT, fmt.Sprintf("image at 0x%X: %s", base, reason))
+}
+
+// SymbolResolver formats addresses in a process as "module!export+0xoffset"
+// using its module list and the export tables of the modules, which are read
+// on first use. Without debug symbols the nearest preceding export is used, so
+// addresses in internal functions resolve to an unrelated export plus a large
+// offset. A SymbolResolver is safe for concurrent use.
+type SymbolResolver struct {
+	Modules SystemModules
+
+	read    MemoryReader
+	mu      sync.Mutex
+	exports map[uint64][]ModuleExport
+}
+
+// NewSymbolResolver creates a resolver over modules. read reads the memory the
+// modules are mapped in; if it is nil addresses resolve to "module+0xoffset".
+func NewSymbolResolver(modules SystemModules, read MemoryReader) *SymbolResolver {
+	return &SymbolResolver{Modules: modules, read: read, exports: make(map[uint64][]ModuleExport)}
+}
+
+// Resolve formats address, e.g. "kernel32.dll!BaseThreadInitThunk+0x14".
+// Addresses before the first export of a module resolve to
+// "module+0xoffset", and addresses outside every module to plain hexadecimal.
+func (r *SymbolResolver) Resolve(address uint64) string {
+	module, ok := r.Modules.ByAddress(address)
+	if !ok {
+		return fmt.Sprintf("0x%X", address)
+	}
+	offset := address - module.ImageBase
+	exports := r.moduleExports(module)
+	i := sort.Search(len(exports), func(i int) bool { return uint64(exports[i].RVA) > offset }) - 1
+	if i < 0 {
+		return fmt.Sprintf("%s+0x%X", module.Name, offset)
+	}
+
+	export := exports[i]
+	name := export.Name
+	if name == "" {
+		name = fmt.Sprintf("#%d", export.Ordinal)
+	}
+	if rest := offset - uint64(export.RVA); rest != 0 {
+		return fmt.Sprintf("%s!%s+0x%X", module.Name, name, rest)
+	}
+	return fmt.Sprintf("%s!%s", module.Name, name)
+}
+
+// moduleExports returns the cached exports of module, reading them on first
+// use. Modules whose exports cannot be read are cached as having none.
+func (r *SymbolResolver) moduleExports(module *SystemModule) []ModuleExport {
+	if r.read == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	exports, ok := r.exports[module.ImageBase]
+	if !ok {
+		exports, _ = ReadModuleExports(r.read, module.ImageBase)
+		r.exports[module.ImageBase] = exports
+	}
+	return exports
+}
@@ -0,0 +1,208 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// fakeMemory is the sparse address space of a fake process
+type fakeMemory map[uint64][]byte
+
+// read implements MemoryReader, failing like a read across unmapped pages
+func (memory fakeMemory) read(address uint64, buf []byte) error {
+	for base, region := range memory {
+		if address >= base && address+uint64(len(buf)) <= base+uint64(len(region)) {
+			copy(buf, region[address-base:])
+			return nil
+		}
+	}
+	return winx.NewNTStatusError(winx.STATUS_PARTIAL_COPY, fmt.Sprintf("read of %d bytes at 0x%X", len(buf), address))
+}
+
+const (
+	fakePEB       = 0x1000
+	fakeLdr       = 0x2000
+	fakeEntries   = 0x3000
+	fakeStrings   = 0x4000
+	fakeImageBase = 0x10000
+)
+
+// addLoaderList maps a PEB whose loader list holds the named modules, each
+// 0x1000 bytes long starting at fakeImageBase
+func (memory fakeMemory) addLoaderList(pointerSize int, names ...string) {
+	put := func(buf []byte, offset int, v uint64) {
+		if pointerSize == 4 {
+			binary.LittleEndian.PutUint32(buf[offset:], uint32(v))
+		} else {
+			binary.LittleEndian.PutUint64(buf[offset:], v)
+		}
+	}
+	pebLdr, inLoadOrderList, entrySize := loaderOffsets(pointerSize)
+	head := uint64(fakeLdr + inLoadOrderList)
+
+	peb := make([]byte, 0x100)
+	put(peb, pebLdr, fakeLdr)
+	memory[fakePEB] = peb
+
+	ldr := make([]byte, 0x40)
+	put(ldr, inLoadOrderList, head)
+	memory[fakeLdr] = ldr
+
+	entries := make([]byte, entrySize*len(names))
+	var strings []byte
+	putString := func(entry []byte, offset int, s string) {
+		chars := utf16.Encode([]rune(s))
+		binary.LittleEndian.PutUint16(entry[offset:], uint16(2*len(chars)))
+		binary.LittleEndian.PutUint16(entry[offset+2:], uint16(2*len(chars)))
+		put(entry, offset+pointerSize, fakeStrings+uint64(len(strings)))
+		for _, c := range chars {
+			strings = binary.LittleEndian.AppendUint16(strings, c)
+		}
+	}
+	for i, name := range names {
+		entry := entries[i*entrySize:]
+		next := head
+		if i+1 < len(names) {
+			next = fakeEntries + uint64((i+1)*entrySize)
+		}
+		put(entry, 0, next)
+		put(entry, 6*pointerSize, fakeImageBase+uint64(i)*0x1000)
+		binary.LittleEndian.PutUint32(entry[8*pointerSize:], 0x1000)
+		fullDllName := (8*pointerSize + 4 + pointerSize - 1) &^ (pointerSize - 1)
+		putString(entry, fullDllName, `C:\Windows\System32\`+name)
+		putString(entry, fullDllName+2*pointerSize, name)
+	}
+	if len(names) > 0 {
+		put(ldr, inLoadOrderList, fakeEntries)
+	}
+	memory[fakeEntries] = entries
+	memory[fakeStrings] = strings
+}
+
+// addImage maps a PE32+ image at base exporting Beta, Alpha, a forwarder and
+// an export by ordinal only
+func (memory fakeMemory) addImage(base uint64) {
+	image := make([]byte, 0x1000)
+	copy(image, "MZ")
+	binary.LittleEndian.PutUint32(image[0x3C:], 0x80)
+	copy(image[0x80:], "PE\x00\x00")
+	optional := image[0x98:]
+	binary.LittleEndian.PutUint16(optional[0:], 0x20B)
+	binary.LittleEndian.PutUint32(optional[56:], 0x1000)
+	binary.LittleEndian.PutUint32(optional[108:], 16)
+	binary.LittleEndian.PutUint32(optional[112:], 0x200)
+	binary.LittleEndian.PutUint32(optional[116:], 0x100)
+
+	directory := image[0x200:]
+	binary.LittleEndian.PutUint32(directory[16:], 1)
+	binary.LittleEndian.PutUint32(directory[20:], 4)
+	binary.LittleEndian.PutUint32(directory[24:], 3)
+	binary.LittleEndian.PutUint32(directory[28:], 0x240)
+	binary.LittleEndian.PutUint32(directory[32:], 0x260)
+	binary.LittleEndian.PutUint32(directory[36:], 0x270)
+	for i, rva := range []uint32{0x500, 0x400, 0x280, 0x600} {
+		binary.LittleEndian.PutUint32(image[0x240+4*i:], rva)
+	}
+	for i, name := range []struct {
+		rva   uint32
+		index uint16
+		name  string
+	}{{0x2A0, 1, "Alpha"}, {0x2B0, 0, "Beta"}, {0x2C0, 2, "Fwd"}} {
+		binary.LittleEndian.PutUint32(image[0x260+4*i:], name.rva)
+		binary.LittleEndian.PutUint16(image[0x270+2*i:], name.index)
+		copy(image[name.rva:], name.name)
+	}
+	copy(image[0x280:], "other.Function")
+	memory[base] = image
+}
+
+// TestReadProcessModules tests the loader list walk for both PEB layouts
+func TestReadProcessModules(t *testing.T) {
+	for _, pointerSize := range []int{4, 8} {
+		memory := fakeMemory{}
+		memory.addLoaderList(pointerSize, "app.exe", "ntdll.dll")
+
+		modules, err := ReadProcessModules(memory.read, fakePEB, pointerSize)
+		if err != nil {
+			t.Fatalf("pointerSize %d: error = %v", pointerSize, err)
+		}
+		if len(modules) != 2 {
+			t.Fatalf("pointerSize %d: got %d modules, want 2", pointerSize, len(modules))
+		}
+		want := SystemModule{Name: "ntdll.dll", FullPath: `C:\Windows\System32\ntdll.dll`, ImageBase: fakeImageBase + 0x1000, MappedBase: fakeImageBase + 0x1000, ImageSize: 0x1000, LoadOrderIndex: 1}
+		if modules[0].Name != "app.exe" || modules[1] != want {
+			t.Errorf("pointerSize %d: got %+v", pointerSize, modules)
+		}
+
+		empty := fakeMemory{}
+		empty.addLoaderList(pointerSize)
+		if modules, err := ReadProcessModules(empty.read, fakePEB, pointerSize); err != nil || len(modules) != 0 {
+			t.Errorf("pointerSize %d: empty list = %+v, %v", pointerSize, modules, err)
+		}
+	}
+
+	if _, err := ReadProcessModules(fakeMemory{}.read, fakePEB, 8); !errors.Is(err, winx.STATUS_PARTIAL_COPY) {
+		t.Errorf("unreadable PEB error = %v", err)
+	}
+	if _, err := ReadProcessModules(fakeMemory{}.read, fakePEB, 2); !errors.Is(err, winx.STATUS_INVALID_PARAMETER) {
+		t.Errorf("bad pointer size error = %v", err)
+	}
+}
+
+// TestReadModuleExports tests export table parsing
+func TestReadModuleExports(t *testing.T) {
+	memory := fakeMemory{}
+	memory.addImage(fakeImageBase)
+
+	exports, err := ReadModuleExports(memory.read, fakeImageBase)
+	if err != nil {
+		t.Fatalf("ReadModuleExports() error = %v", err)
+	}
+	want := []ModuleExport{{"Alpha", 2, 0x400}, {"Beta", 1, 0x500}, {"", 4, 0x600}}
+	if fmt.Sprint(exports) != fmt.Sprint(want) {
+		t.Errorf("ReadModuleExports() = %v, want %v", exports, want)
+	}
+
+	memory[fakeImageBase][0] = 'X'
+	if _, err := ReadModuleExports(memory.read, fakeImageBase); !errors.Is(err, winx.STATUS_INVALID_IMAGE_FORMAT) {
+		t.Errorf("bad signature error = %v", err)
+	}
+}
+
+// TestSymbolResolver tests address formatting
+func TestSymbolResolver(t *testing.T) {
+	memory := fakeMemory{}
+	memory.addLoaderList(8, "test.dll")
+	memory.addImage(fakeImageBase)
+	modules, err := ReadProcessModules(memory.read, fakePEB, 8)
+	if err != nil {
+		t.Fatalf("ReadProcessModules() error = %v", err)
+	}
+
+	tests := []struct {
+		address uint64
+		want    string
+	}{
+		{fakeImageBase + 0x400, "test.dll!Alpha"},
+		{fakeImageBase + 0x410, "test.dll!Alpha+0x10"},
+		{fakeImageBase + 0x5FF, "test.dll!Beta+0xFF"},
+		{fakeImageBase + 0x604, "test.dll!#4+0x4"},
+		{fakeImageBase + 0x100, "test.dll+0x100"},
+		{0x99999, "0x99999"},
+	}
+	resolver := NewSymbolResolver(modules, memory.read)
+	for _, tt := range tests {
+		if got := resolver.Resolve(tt.address); got != tt.want {
+			t.Errorf("Resolve(0x%X) = %q, want %q", tt.address, got, tt.want)
+		}
+	}
+
+	if got := NewSymbolResolver(modules, nil).Resolve(fakeImageBase + 0x410); got != "test.dll+0x410" {
+		t.Errorf("Resolve() without a reader = %q", got)
+	}
+}
@@ -0,0 +1,254 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/internal/layout"
+)
+
+// ThreadBasicInfo is a decoded THREAD_BASIC_INFORMATION
+type ThreadBasicInfo struct {
+	ExitStatus     winx.NTSTATUS // STATUS_PENDING while the thread is running
+	TebBaseAddress uint64
+	ProcessID      uint64
+	ThreadID       uint64
+	AffinityMask   uint64
+	Priority       int32
+	BasePriority   int32
+}
+
+// Running reports whether the thread has not exited yet
+func (info ThreadBasicInfo) Running() bool {
+	return info.ExitStatus == winx.STATUS_PENDING
+}
+
+// threadBasicInformationSize returns the size of THREAD_BASIC_INFORMATION
+func threadBasicInformationSize(pointerSize int) int {
+	if pointerSize == 4 {
+		return 28
+	}
+	return 48
+}
+
+// DecodeThreadBasicInformation decodes the buffer returned by
+// NtQueryInformationThread(ThreadBasicInformation).
+//
+// Parameters:
+//   - buf: the returned buffer
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the decoded information
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeThreadBasicInformation(buf []byte, pointerSize int) (ThreadBasicInfo, error) {
+	r := layout.NewReader(buf, 0, pointerSize)
+	var info ThreadBasicInfo
+	info.ExitStatus = winx.NTSTATUS(r.Uint32())
+	info.TebBaseAddress = r.Pointer()
+	info.ProcessID = r.Pointer()
+	info.ThreadID = r.Pointer()
+	info.AffinityMask = r.Pointer()
+	info.Priority = r.Int32()
+	info.BasePriority = r.Int32()
+	if err := r.Err(); err != nil {
+		return ThreadBasicInfo{}, fmt.Errorf("THREAD_BASIC_INFORMATION: %w", err)
+	}
+	return info, nil
+}
+
+// DecodeThreadStartAddress decodes the buffer returned by
+// NtQueryInformationThread(ThreadQuerySetWin32StartAddress).
+//
+// Parameters:
+//   - buf: the returned buffer, one pointer
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the address passed to CreateThread, rather than the ntdll thunk every
+//     user thread starts in
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeThreadStartAddress(buf []byte, pointerSize int) (uint64, error) {
+	r := layout.NewReader(buf, 0, pointerSize)
+	address := r.Pointer()
+	if err := r.Err(); err != nil {
+		return 0, fmt.Errorf("ThreadQuerySetWin32StartAddress: %w", err)
+	}
+	return address, nil
+}
+
+// LastSystemCall is a decoded THREAD_LAST_SYSCALL_INFORMATION, describing the
+// system call a waiting thread is blocked in
+type LastSystemCall struct {
+	FirstArgument    uint64 // often the handle being waited on
+	SystemCallNumber uint16
+	WaitTime         uint64 // 0 before Windows 10 1703, which returns the short structure
+}
+
+// lastSystemCallSize returns the size of THREAD_LAST_SYSCALL_INFORMATION with
+// the WaitTime field, and without it as returned by older builds
+func lastSystemCallSize(pointerSize int) (full, short int) {
+	return 2*pointerSize + 8, 2 * pointerSize
+}
+
+// DecodeThreadLastSystemCall decodes the buffer returned by
+// NtQueryInformationThread(ThreadLastSystemCall).
+//
+// Parameters:
+//   - buf: the returned buffer, with or without the WaitTime field
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the decoded system call
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeThreadLastSystemCall(buf []byte, pointerSize int) (LastSystemCall, error) {
+	full, short := lastSystemCallSize(pointerSize)
+	if err := checkBuffer(buf, short, "THREAD_LAST_SYSCALL_INFORMATION"); err != nil {
+		return LastSystemCall{}, err
+	}
+	r := layout.NewReader(buf, 0, pointerSize)
+	var call LastSystemCall
+	call.FirstArgument = r.Pointer()
+	call.SystemCallNumber = r.Uint16()
+	if len(buf) >= full {
+		call.WaitTime = r.Uint64()
+	}
+	if err := r.Err(); err != nil {
+		return LastSystemCall{}, fmt.Errorf("THREAD_LAST_SYSCALL_INFORMATION: %w", err)
+	}
+	return call, nil
+}
+
+// ThreadCycleTime is a decoded THREAD_CYCLE_TIME_INFORMATION
+type ThreadCycleTime struct {
+	AccumulatedCycles uint64 // CPU cycles consumed by the thread
+	CurrentCycleCount uint64 // cycle counter when the thread was last scheduled
+}
+
+// DecodeThreadCycleTime decodes the buffer returned by
+// NtQueryInformationThread(ThreadCycleTime).
+//
+// Parameters:
+//   - buf: the returned buffer, 16 bytes
+//
+// Returns:
+//   - the cycle counts
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeThreadCycleTime(buf []byte) (ThreadCycleTime, error) {
+	if err := checkBuffer(buf, 16, "THREAD_CYCLE_TIME_INFORMATION"); err != nil {
+		return ThreadCycleTime{}, err
+	}
+	return ThreadCycleTime{
+		AccumulatedCycles: binary.LittleEndian.Uint64(buf[0:]),
+		CurrentCycleCount: binary.LittleEndian.Uint64(buf[8:]),
+	}, nil
+}
+
+// IOPriorityHint is the IO_PRIORITY_HINT of a thread or process
+type IOPriorityHint uint32
+
+// I/O priorities
+const (
+	IOPriorityVeryLow  IOPriorityHint = 0
+	IOPriorityLow      IOPriorityHint = 1
+	IOPriorityNormal   IOPriorityHint = 2
+	IOPriorityHigh     IOPriorityHint = 3
+	IOPriorityCritical IOPriorityHint = 4
+)
+
+var ioPriorityNames = [...]string{"VeryLow", "Low", "Normal", "High", "Critical"}
+
+// String returns the name of the priority, e.g. "Normal"
+func (hint IOPriorityHint) String() string {
+	if int(hint) < len(ioPriorityNames) {
+		return ioPriorityNames[hint]
+	}
+	return fmt.Sprintf("IOPriorityHint(%d)", uint32(hint))
+}
+
+// DecodeThreadIoPriority decodes the buffer returned by
+// NtQueryInformationThread(ThreadIoPriority).
+//
+// Parameters:
+//   - buf: the returned buffer, a 4 byte ULONG
+//
+// Returns:
+//   - the I/O priority
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeThreadIoPriority(buf []byte) (IOPriorityHint, error) {
+	value, err := decodeThreadULONG(buf, "ThreadIoPriority")
+	return IOPriorityHint(value), err
+}
+
+// DecodeThreadSuspendCount decodes the buffer returned by
+// NtQueryInformationThread(ThreadSuspendCount).
+//
+// Parameters:
+//   - buf: the returned buffer, a 4 byte ULONG
+//
+// Returns:
+//   - the number of outstanding suspensions; the thread is suspended if it
+//     is not 0
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeThreadSuspendCount(buf []byte) (uint32, error) {
+	return decodeThreadULONG(buf, "ThreadSuspendCount")
+}
+
+// DecodeThreadIsTerminated decodes the buffer returned by
+// NtQueryInformationThread(ThreadIsTerminated).
+//
+// Parameters:
+//   - buf: the returned buffer, a 4 byte ULONG
+//
+// Returns:
+//   - true if the thread has terminated
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if buf is truncated
+func DecodeThreadIsTerminated(buf []byte) (bool, error) {
+	value, err := decodeThreadULONG(buf, "ThreadIsTerminated")
+	return value != 0, err
+}
+
+// decodeThreadULONG decodes the single ULONG returned by many thread classes
+func decodeThreadULONG(buf []byte, name string) (uint32, error) {
+	if err := checkBuffer(buf, 4, name); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(buf), nil
+}
+
+// DecodeThreadName decodes the buffer returned by
+// NtQueryInformationThread(ThreadNameInformation), the description set with
+// SetThreadDescription.
+//
+// Parameters:
+//   - buf: the returned buffer, a UNICODE_STRING followed by its characters
+//   - base: the address buf was located at during the call
+//   - pointerSize: 8 for buffers produced by 64-bit processes, 4 for 32-bit
+//
+// Returns:
+//   - the thread name, "" if none was set
+//   - an NTStatusError with STATUS_BUFFER_TOO_SMALL if the name lies outside buf
+func DecodeThreadName(buf []byte, base uintptr, pointerSize int) (string, error) {
+	return winx.DecodeUnicodeString(buf, 0, base, pointerSize)
+}
+
+// ThreadDetails summarizes the state of a thread for hang diagnosis. A field
+// is nil when its information class could not be queried; the reason is kept
+// in Errors.
+type ThreadDetails struct {
+	Basic          *ThreadBasicInfo
+	StartAddress   *uint64
+	StartSymbol    string // StartAddress as "module!export+0xoffset", "" without a resolver
+	LastSystemCall *LastSystemCall
+	CycleTime      *ThreadCycleTime
+	Times          *KernelUserTimes
+	IoPriority     *IOPriorityHint
+	SuspendCount   *uint32
+	Errors         map[winx.ThreadInfoClass]error
+}
+
+// Suspended reports whether the thread has outstanding suspensions
+func (details *ThreadDetails) Suspended() bool {
+	return details.SuspendCount != nil && *details.SuspendCount > 0
+}
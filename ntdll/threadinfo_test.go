@@ -0,0 +1,117 @@
+package ntdll
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+)
+
+// TestDecodeThreadBasicInformation tests the 32-bit and 64-bit layouts
+func TestDecodeThreadBasicInformation(t *testing.T) {
+	for _, pointerSize := range []int{4, 8} {
+		buf := make([]byte, threadBasicInformationSize(pointerSize))
+		put := func(offset int, v uint64) {
+			if pointerSize == 4 {
+				binary.LittleEndian.PutUint32(buf[offset:], uint32(v))
+			} else {
+				binary.LittleEndian.PutUint64(buf[offset:], v)
+			}
+		}
+		binary.LittleEndian.PutUint32(buf[0:], uint32(winx.STATUS_PENDING))
+		put(pointerSize, 0x7FFDE000)
+		put(2*pointerSize, 1234)
+		put(3*pointerSize, 5678)
+		put(4*pointerSize, 0x3)
+		binary.LittleEndian.PutUint32(buf[5*pointerSize:], 10)
+		binary.LittleEndian.PutUint32(buf[5*pointerSize+4:], 8)
+
+		info, err := DecodeThreadBasicInformation(buf, pointerSize)
+		if err != nil {
+			t.Fatalf("pointerSize %d: error = %v", pointerSize, err)
+		}
+		want := ThreadBasicInfo{ExitStatus: winx.STATUS_PENDING, TebBaseAddress: 0x7FFDE000, ProcessID: 1234, ThreadID: 5678, AffinityMask: 0x3, Priority: 10, BasePriority: 8}
+		if info != want || !info.Running() {
+			t.Errorf("pointerSize %d: got %+v, want %+v", pointerSize, info, want)
+		}
+
+		if _, err := DecodeThreadBasicInformation(buf[:len(buf)-1], pointerSize); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+			t.Errorf("pointerSize %d: truncated error = %v", pointerSize, err)
+		}
+	}
+}
+
+// TestDecodeThreadLastSystemCall tests the structure with and without WaitTime
+func TestDecodeThreadLastSystemCall(t *testing.T) {
+	tests := []struct {
+		name        string
+		pointerSize int
+		withWait    bool
+		want        LastSystemCall
+	}{
+		{"64-bit", 8, true, LastSystemCall{FirstArgument: 0x1F4, SystemCallNumber: 4, WaitTime: 99}},
+		{"64-bit short", 8, false, LastSystemCall{FirstArgument: 0x1F4, SystemCallNumber: 4}},
+		{"32-bit", 4, true, LastSystemCall{FirstArgument: 0x1F4, SystemCallNumber: 4, WaitTime: 99}},
+		{"32-bit short", 4, false, LastSystemCall{FirstArgument: 0x1F4, SystemCallNumber: 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			full, short := lastSystemCallSize(tt.pointerSize)
+			buf := make([]byte, full)
+			if tt.pointerSize == 4 {
+				binary.LittleEndian.PutUint32(buf, 0x1F4)
+			} else {
+				binary.LittleEndian.PutUint64(buf, 0x1F4)
+			}
+			binary.LittleEndian.PutUint16(buf[tt.pointerSize:], 4)
+			binary.LittleEndian.PutUint64(buf[2*tt.pointerSize:], 99)
+			if !tt.withWait {
+				buf = buf[:short]
+			}
+
+			got, err := DecodeThreadLastSystemCall(buf, tt.pointerSize)
+			if err != nil || got != tt.want {
+				t.Errorf("DecodeThreadLastSystemCall() = %+v, %v, want %+v", got, err, tt.want)
+			}
+			if _, err := DecodeThreadLastSystemCall(buf[:short-1], tt.pointerSize); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+				t.Errorf("truncated error = %v", err)
+			}
+		})
+	}
+}
+
+// TestDecodeThreadScalars tests the classes returning a single value
+func TestDecodeThreadScalars(t *testing.T) {
+	ulong := func(v uint32) []byte {
+		return binary.LittleEndian.AppendUint32(nil, v)
+	}
+
+	if got, err := DecodeThreadIoPriority(ulong(2)); err != nil || got != IOPriorityNormal || got.String() != "Normal" {
+		t.Errorf("DecodeThreadIoPriority() = %v, %v", got, err)
+	}
+	if got := IOPriorityHint(9).String(); got != "IOPriorityHint(9)" {
+		t.Errorf("IOPriorityHint(9).String() = %q", got)
+	}
+	if got, err := DecodeThreadSuspendCount(ulong(3)); err != nil || got != 3 {
+		t.Errorf("DecodeThreadSuspendCount() = %d, %v", got, err)
+	}
+	if got, err := DecodeThreadIsTerminated(ulong(1)); err != nil || !got {
+		t.Errorf("DecodeThreadIsTerminated() = %v, %v", got, err)
+	}
+	if got, err := DecodeThreadStartAddress(binary.LittleEndian.AppendUint64(nil, 0x7FF812340000), 8); err != nil || got != 0x7FF812340000 {
+		t.Errorf("DecodeThreadStartAddress() = 0x%X, %v", got, err)
+	}
+
+	cycles := binary.LittleEndian.AppendUint64(binary.LittleEndian.AppendUint64(nil, 1000), 2000)
+	if got, err := DecodeThreadCycleTime(cycles); err != nil || got != (ThreadCycleTime{AccumulatedCycles: 1000, CurrentCycleCount: 2000}) {
+		t.Errorf("DecodeThreadCycleTime() = %+v, %v", got, err)
+	}
+
+	if _, err := DecodeThreadSuspendCount(ulong(3)[:3]); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("truncated ULONG error = %v", err)
+	}
+	if _, err := DecodeThreadCycleTime(cycles[:15]); !errors.Is(err, winx.STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("truncated cycle time error = %v", err)
+	}
+}
@@ -0,0 +1,276 @@
+//go:build windows
+
+package ntdll
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/handle"
+)
+
+// _NtQueryInformationThread is the low-level wrapper for NtQueryInformationThread
+func _NtQueryInformationThread(
+	ThreadHandle handle.HANDLE,
+	ThreadInformationClass winx.ThreadInfoClass,
+	ThreadInformation unsafe.Pointer,
+	ThreadInformationLength uint32,
+	ReturnLength *uint32) uint32 {
+
+	ret_code, _, _ := procNtQueryInformationThread.Call(
+		winx.Call{API: "NtQueryInformationThread", Detail: ThreadInformationClass.String(), OutputSize: int(ThreadInformationLength)},
+		winx.ReturnsNTSTATUS,
+		uintptr(ThreadHandle),
+		uintptr(ThreadInformationClass),
+		uintptr(ThreadInformation),
+		uintptr(ThreadInformationLength),
+		uintptr(unsafe.Pointer(ReturnLength)),
+	)
+
+	return uint32(ret_code)
+}
+
+// NtQueryInformationThread is a convenience wrapper around
+// _NtQueryInformationThread that automatically allocates and resizes a buffer
+// when STATUS_INFO_LENGTH_MISMATCH is returned. It returns the filled byte
+// slice and the NTSTATUS code. Fixed-size classes without a registered decoder
+// need initialSize set to the exact structure size.
+func NtQueryInformationThread(thread handle.HANDLE, class winx.ThreadInfoClass, initialSize uint32) ([]byte, uint32) {
+	buf, err := QueryThreadRaw(context.Background(), thread, class, &QueryOptions{InitialSize: initialSize})
+	return buf, ntStatusOf(err)
+}
+
+// QueryThreadRaw queries a thread information class and returns the output
+// buffer, growing it as directed by opts. opts.Input, if set, is copied to the
+// start of the buffer before each call. Errors are as for QueryRaw.
+func QueryThreadRaw(ctx context.Context, thread handle.HANDLE, class winx.ThreadInfoClass, opts *QueryOptions) ([]byte, error) {
+	return queryThreadInformation(ctx, thread, class, opts.withDefaults(threadClassSize(class)))
+}
+
+// QueryThread queries a thread information class and decodes the output with
+// the decoder registered for it, for example
+//
+//	info, err := QueryThread[ThreadBasicInfo](ctx, handle.CurrentThread, winx.ThreadBasicInformation, nil)
+//
+// The thread handle needs THREAD_QUERY_LIMITED_INFORMATION or
+// THREAD_QUERY_INFORMATION access, depending on the class.
+func QueryThread[T any](ctx context.Context, thread handle.HANDLE, class winx.ThreadInfoClass, opts *QueryOptions) (T, error) {
+	var zero T
+	decoder, err := LookupThreadClassDecoder[T](class)
+	if err != nil {
+		return zero, err
+	}
+	o := opts.withDefaults(threadClassSize(class))
+	buf, err := queryThreadInformation(ctx, thread, class, o)
+	if err != nil {
+		return zero, err
+	}
+	defer o.Pool.Put(buf)
+	return decoder.Decode(buf, uintptr(unsafe.Pointer(&buf[0])), nativePointerSize)
+}
+
+// queryThreadInformation runs the sizing loop over NtQueryInformationThread
+func queryThreadInformation(ctx context.Context, thread handle.HANDLE, class winx.ThreadInfoClass, o QueryOptions) ([]byte, error) {
+	if err := procNtQueryInformationThread.Find(); err != nil {
+		return nil, err
+	}
+	name := fmt.Sprintf("NtQueryInformationThread(%s)", class)
+	return runQuery(ctx, name, o, func(buf []byte) (winx.NTSTATUS, uint32) {
+		var returnLen uint32
+		copy(buf, o.Input)
+		ret := _NtQueryInformationThread(thread, class, unsafe.Pointer(&buf[0]), uint32(len(buf)), &returnLen)
+		return winx.NTSTATUS(ret), returnLen
+	})
+}
+
+// QueryThreadBasicInformation returns the TEB address, client ID, affinity
+// and priorities of a thread.
+func QueryThreadBasicInformation(thread handle.HANDLE) (ThreadBasicInfo, error) {
+	return QueryThread[ThreadBasicInfo](context.Background(), thread, winx.ThreadBasicInformation, nil)
+}
+
+// QueryThreadStartAddress returns the address a thread was created to run,
+// as passed to CreateThread.
+func QueryThreadStartAddress(thread handle.HANDLE) (uint64, error) {
+	return QueryThread[uint64](context.Background(), thread, winx.ThreadQuerySetWin32StartAddress, nil)
+}
+
+// QueryThreadLastSystemCall returns the system call a thread is blocked in.
+// It fails with STATUS_UNSUCCESSFUL unless the thread is waiting or
+// suspended. Builds before Windows 10 1703, which only accept the structure
+// without WaitTime, are retried with the short size.
+func QueryThreadLastSystemCall(thread handle.HANDLE) (LastSystemCall, error) {
+	full, short := lastSystemCallSize(nativePointerSize)
+	call, err := QueryThread[LastSystemCall](context.Background(), thread, winx.ThreadLastSystemCall, &QueryOptions{InitialSize: uint32(full), MaxSize: uint32(full)})
+	if errors.Is(err, winx.STATUS_INFO_LENGTH_MISMATCH) {
+		return QueryThread[LastSystemCall](context.Background(), thread, winx.ThreadLastSystemCall, &QueryOptions{InitialSize: uint32(short), MaxSize: uint32(short)})
+	}
+	return call, err
+}
+
+// QueryThreadCycleTime returns the CPU cycles consumed by a thread.
+func QueryThreadCycleTime(thread handle.HANDLE) (ThreadCycleTime, error) {
+	return QueryThread[ThreadCycleTime](context.Background(), thread, winx.ThreadCycleTime, nil)
+}
+
+// QueryThreadTimes returns the creation and exit times and CPU usage of a thread.
+func QueryThreadTimes(thread handle.HANDLE) (KernelUserTimes, error) {
+	return QueryThread[KernelUserTimes](context.Background(), thread, winx.ThreadTimes, nil)
+}
+
+// QueryThreadIoPriority returns the I/O priority of a thread.
+func QueryThreadIoPriority(thread handle.HANDLE) (IOPriorityHint, error) {
+	return QueryThread[IOPriorityHint](context.Background(), thread, winx.ThreadIoPriority, nil)
+}
+
+// QueryThreadSuspendCount returns the number of outstanding suspensions of a
+// thread. Requires Windows 8.1.
+func QueryThreadSuspendCount(thread handle.HANDLE) (uint32, error) {
+	return QueryThread[uint32](context.Background(), thread, winx.ThreadSuspendCount, nil)
+}
+
+// QueryThreadName returns the description of a thread set with
+// SetThreadDescription. Requires Windows 10 1607.
+func QueryThreadName(thread handle.HANDLE) (string, error) {
+	return QueryThread[string](context.Background(), thread, winx.ThreadNameInformation, nil)
+}
+
+// QueryThreadDetails queries the state of a thread needed for hang diagnosis.
+// If resolver is not nil the start address is resolved with it. Classes that
+// fail, such as ThreadLastSystemCall for a running thread, are recorded in
+// Errors and the rest are still queried.
+func QueryThreadDetails(thread handle.HANDLE, resolver *SymbolResolver) *ThreadDetails {
+	details := &ThreadDetails{Errors: make(map[winx.ThreadInfoClass]error)}
+	record := func(class winx.ThreadInfoClass, err error) bool {
+		if err != nil {
+			details.Errors[class] = err
+			return false
+		}
+		return true
+	}
+
+	if basic, err := QueryThreadBasicInformation(thread); record(winx.ThreadBasicInformation, err) {
+		details.Basic = &basic
+	}
+	if start, err := QueryThreadStartAddress(thread); record(winx.ThreadQuerySetWin32StartAddress, err) {
+		details.StartAddress = &start
+		if resolver != nil {
+			details.StartSymbol = resolver.Resolve(start)
+		}
+	}
+	if call, err := QueryThreadLastSystemCall(thread); record(winx.ThreadLastSystemCall, err) {
+		details.LastSystemCall = &call
+	}
+	if cycles, err := QueryThreadCycleTime(thread); record(winx.ThreadCycleTime, err) {
+		details.CycleTime = &cycles
+	}
+	if times, err := QueryThreadTimes(thread); record(winx.ThreadTimes, err) {
+		details.Times = &times
+	}
+	if priority, err := QueryThreadIoPriority(thread); record(winx.ThreadIoPriority, err) {
+		details.IoPriority = &priority
+	}
+	if count, err := QueryThreadSuspendCount(thread); record(winx.ThreadSuspendCount, err) {
+		details.SuspendCount = &count
+	}
+	return details
+}
+
+// _NtReadVirtualMemory is the low-level wrapper for NtReadVirtualMemory
+func _NtReadVirtualMemory(
+	ProcessHandle handle.HANDLE,
+	BaseAddress uintptr,
+	Buffer unsafe.Pointer,
+	NumberOfBytesToRead uintptr,
+	NumberOfBytesRead *uintptr) uint32 {
+
+	ret_code, _, _ := procNtReadVirtualMemory.Call(
+		winx.Call{API: "NtReadVirtualMemory", Detail: fmt.Sprintf("0x%X", BaseAddress), OutputSize: int(NumberOfBytesToRead)},
+		winx.ReturnsNTSTATUS,
+		uintptr(ProcessHandle),
+		BaseAddress,
+		uintptr(Buffer),
+		NumberOfBytesToRead,
+		uintptr(unsafe.Pointer(NumberOfBytesRead)),
+	)
+	return uint32(ret_code)
+}
+
+// ProcessMemoryReader returns a MemoryReader over the memory of process,
+// which needs PROCESS_VM_READ access. Partial reads fail with
+// STATUS_PARTIAL_COPY.
+func ProcessMemoryReader(process handle.HANDLE) MemoryReader {
+	return func(address uint64, buf []byte) error {
+		if len(buf) == 0 {
+			return nil
+		}
+		if uint64(uintptr(address)) != address {
+			return winx.NewNTStatusError(winx.STATUS_ACCESS_VIOLATION, fmt.Sprintf("address 0x%X is not addressable from this process", address))
+		}
+		var read uintptr
+		status := winx.NTSTATUS(_NtReadVirtualMemory(process, uintptr(address), unsafe.Pointer(&buf[0]), uintptr(len(buf)), &read))
+		if status != winx.STATUS_SUCCESS {
+			return winx.NewNTStatusError(status, fmt.Sprintf("NtReadVirtualMemory(0x%X, %d bytes)", address, len(buf)))
+		}
+		return nil
+	}
+}
+
+// NewProcessSymbolResolver reads the module list of a process from its PEB
+// and returns a resolver for addresses in it. For a 32-bit process under
+// WOW64 the 32-bit modules are included as well. The process handle needs
+// PROCESS_QUERY_LIMITED_INFORMATION and PROCESS_VM_READ access.
+//
+// Unlike RtlQueryProcessDebugInformation this does not create a thread in the
+// target, so it works on hung processes.
+func NewProcessSymbolResolver(process handle.HANDLE) (*SymbolResolver, error) {
+	basic, err := QueryProcessBasicInformation(process)
+	if err != nil {
+		return nil, err
+	}
+	read := ProcessMemoryReader(process)
+	modules, err := ReadProcessModules(read, basic.PebBaseAddress, nativePointerSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if nativePointerSize == 8 {
+		// ProcessWow64Information returns the address of the 32-bit PEB, or 0
+		buf, err := QueryProcessRaw(context.Background(), process, winx.ProcessWow64Information, &QueryOptions{InitialSize: 8, MaxSize: 8})
+		if err == nil && len(buf) == 8 {
+			if peb32 := binary.LittleEndian.Uint64(buf); peb32 != 0 {
+				modules32, err := ReadProcessModules(read, peb32, 4)
+				if err != nil {
+					return nil, fmt.Errorf("reading the WOW64 module list: %w", err)
+				}
+				modules = append(modules, modules32...)
+			}
+		}
+	}
+	return NewSymbolResolver(modules, read), nil
+}
+
+// ResolveThreadStartAddress formats the start address of a thread as
+// "module!export+0xoffset", e.g. "kernel32.dll!LoadLibraryW" for a thread
+// started on an export. Start addresses in internal functions resolve to the
+// nearest preceding export plus an offset, so a thread pool worker, which
+// starts in TppWorkerThread, shows as something like
+// "ntdll.dll!TpReleaseCleanupGroupMembers+0x450". process must be the process
+// the thread belongs to, opened as for NewProcessSymbolResolver.
+// To resolve many threads of one process, create the resolver once and use
+// QueryThreadDetails.
+func ResolveThreadStartAddress(process, thread handle.HANDLE) (string, error) {
+	start, err := QueryThreadStartAddress(thread)
+	if err != nil {
+		return "", err
+	}
+	resolver, err := NewProcessSymbolResolver(process)
+	if err != nil {
+		return "", err
+	}
+	return resolver.Resolve(start), nil
+}
@@ -0,0 +1,59 @@
+//go:build windows
+
+package ntdll
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ArkaprabhaChakraborty/winx"
+	"github.com/ArkaprabhaChakraborty/winx/handle"
+)
+
+// TestQueryThread tests thread queries against the current thread
+func TestQueryThread(t *testing.T) {
+	basic, err := QueryThreadBasicInformation(handle.CurrentThread)
+	if err != nil {
+		t.Fatalf("QueryThreadBasicInformation() error = %v", err)
+	}
+	if basic.ProcessID != uint64(os.Getpid()) || basic.ThreadID == 0 || basic.TebBaseAddress == 0 || !basic.Running() {
+		t.Errorf("QueryThreadBasicInformation() = %+v", basic)
+	}
+
+	if cycles, err := QueryThreadCycleTime(handle.CurrentThread); err != nil || cycles.AccumulatedCycles == 0 {
+		t.Errorf("QueryThreadCycleTime() = %+v, %v", cycles, err)
+	}
+	if priority, err := QueryThreadIoPriority(handle.CurrentThread); err != nil || priority != IOPriorityNormal {
+		t.Errorf("QueryThreadIoPriority() = %v, %v", priority, err)
+	}
+	if count, err := QueryThreadSuspendCount(handle.CurrentThread); err == nil && count != 0 {
+		t.Errorf("QueryThreadSuspendCount() = %d for the running thread", count)
+	}
+
+	details := QueryThreadDetails(handle.CurrentThread, nil)
+	if details.Basic == nil || details.StartAddress == nil || details.Suspended() {
+		t.Errorf("QueryThreadDetails() = %+v", details)
+	}
+
+	if _, status := NtQueryInformationThread(handle.CurrentThread, winx.ThreadBasicInformation, 0); status != 0 {
+		t.Errorf("NtQueryInformationThread() status = 0x%08X", status)
+	}
+}
+
+// TestResolveThreadStartAddress tests start address resolution in the current process
+func TestResolveThreadStartAddress(t *testing.T) {
+	resolver, err := NewProcessSymbolResolver(handle.CurrentProcess)
+	if err != nil {
+		t.Fatalf("NewProcessSymbolResolver() error = %v", err)
+	}
+	if _, ok := resolver.Modules.ByName("ntdll.dll"); !ok {
+		t.Errorf("module list has no ntdll.dll: %d modules", len(resolver.Modules))
+	}
+
+	symbol, err := ResolveThreadStartAddress(handle.CurrentProcess, handle.CurrentThread)
+	if err != nil || strings.HasPrefix(symbol, "0x") {
+		t.Errorf("ResolveThreadStartAddress() = %q, %v", symbol, err)
+	}
+	t.Log(symbol)
+}
@@ -2,7 +2,9 @@ package ntdll
 
 import "unsafe"
 
-// SYSTEM_PROCESS_INFORMATION represents process information from NtQuerySystemInformation
+// SYSTEM_PROCESS_INFORMATION represents process information from NtQuerySystemInformation.
+// It does not match the native layout; use DecodeSystemProcessInformation to
+// decode the returned buffer.
 type SYSTEM_PROCESS_INFORMATION struct {
 	NextEntryOffset              uint32
 	NumberOfThreads              uint32
@@ -0,0 +1,54 @@
+package winx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ObjectInformationClass identifies the kind of data requested from
+// NtQueryObject (OBJECT_INFORMATION_CLASS).
+type ObjectInformationClass uint32
+
+// objectInformationClassNames is indexed by class value
+var objectInformationClassNames = [...]string{
+	ObjectBasicInformation:         "ObjectBasicInformation",
+	ObjectNameInformation:          "ObjectNameInformation",
+	ObjectTypeInformation:          "ObjectTypeInformation",
+	ObjectTypesInformation:         "ObjectTypesInformation",
+	ObjectHandleFlagInformation:    "ObjectHandleFlagInformation",
+	ObjectSessionInformation:       "ObjectSessionInformation",
+	ObjectSessionObjectInformation: "ObjectSessionObjectInformation",
+}
+
+// String returns the name of the class (e.g. "ObjectNameInformation"), or
+// "ObjectInformationClass(0x..)" for unknown values.
+func (c ObjectInformationClass) String() string {
+	if c.IsKnown() {
+		return objectInformationClassNames[c]
+	}
+	return fmt.Sprintf("ObjectInformationClass(0x%X)", uint32(c))
+}
+
+// IsKnown reports whether the class is defined in this package.
+func (c ObjectInformationClass) IsKnown() bool {
+	return int(c) < len(objectInformationClassNames)
+}
+
+// ParseObjectInformationClass returns the class with the given name. Names
+// are matched case-insensitively and the "Object" prefix may be omitted, so
+// "ObjectNameInformation" and "NameInformation" are equivalent. Numeric
+// values such as "0x1" or "1" are also accepted.
+func ParseObjectInformationClass(name string) (ObjectInformationClass, error) {
+	name = strings.TrimSpace(name)
+	if value, err := strconv.ParseUint(name, 0, 32); err == nil {
+		return ObjectInformationClass(value), nil
+	}
+
+	for class, className := range objectInformationClassNames {
+		if strings.EqualFold(className, name) || strings.EqualFold(className, "Object"+name) {
+			return ObjectInformationClass(class), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown object information class %q", name)
+}
@@ -0,0 +1,30 @@
+package winx
+
+import "testing"
+
+// TestObjectInformationClass tests class names and parsing
+func TestObjectInformationClass(t *testing.T) {
+	tests := []struct {
+		class ObjectInformationClass
+		want  string
+	}{
+		{ObjectBasicInformation, "ObjectBasicInformation"},
+		{ObjectTypesInformation, "ObjectTypesInformation"},
+		{ObjectSessionObjectInformation, "ObjectSessionObjectInformation"},
+		{ObjectInformationClass(0x20), "ObjectInformationClass(0x20)"},
+	}
+	for _, tt := range tests {
+		if got := tt.class.String(); got != tt.want {
+			t.Errorf("ObjectInformationClass(0x%X).String() = %q, want %q", uint32(tt.class), got, tt.want)
+		}
+	}
+
+	for _, name := range []string{"ObjectNameInformation", "nameinformation", "0x1", "1"} {
+		if class, err := ParseObjectInformationClass(name); err != nil || class != ObjectNameInformation {
+			t.Errorf("ParseObjectInformationClass(%q) = %v, %v", name, class, err)
+		}
+	}
+	if _, err := ParseObjectInformationClass("Bogus"); err == nil {
+		t.Error("ParseObjectInformationClass(\"Bogus\") succeeded")
+	}
+}
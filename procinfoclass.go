@@ -0,0 +1,205 @@
+package winx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ProcessInfoClass identifies the kind of data requested from
+// NtQueryInformationProcess (PROCESSINFOCLASS).
+type ProcessInfoClass uint32
+
+// ProcessInfoClassInfo describes how a ProcessInfoClass is queried.
+type ProcessInfoClassInfo struct {
+	Class ProcessInfoClass
+	Name  string
+
+	// MinBuild is the first Windows build that supports the class, or 0 if it
+	// is available on every supported version
+	MinBuild uint32
+
+	// RequiresInput is set for classes whose output buffer must be
+	// initialized with an input, such as the policy selector of
+	// ProcessMitigationPolicy
+	RequiresInput bool
+
+	// VariableOutput is set for classes whose output size depends on the
+	// process (names, command line, handle lists, ...) rather than being a
+	// fixed-size structure
+	VariableOutput bool
+}
+
+// processInfoClasses is indexed by class value
+var processInfoClasses = [...]ProcessInfoClassInfo{
+	{Class: ProcessBasicInformation, Name: "ProcessBasicInformation"},
+	{Class: ProcessQuotaLimits, Name: "ProcessQuotaLimits"},
+	{Class: ProcessIoCounters, Name: "ProcessIoCounters"},
+	{Class: ProcessVmCounters, Name: "ProcessVmCounters"},
+	{Class: ProcessTimes, Name: "ProcessTimes"},
+	{Class: ProcessBasePriority, Name: "ProcessBasePriority"},
+	{Class: ProcessRaisePriority, Name: "ProcessRaisePriority"},
+	{Class: ProcessDebugPort, Name: "ProcessDebugPort"},
+	{Class: ProcessExceptionPort, Name: "ProcessExceptionPort"},
+	{Class: ProcessAccessToken, Name: "ProcessAccessToken"},
+	{Class: ProcessLdtInformation, Name: "ProcessLdtInformation", VariableOutput: true},
+	{Class: ProcessLdtSize, Name: "ProcessLdtSize"},
+	{Class: ProcessDefaultHardErrorMode, Name: "ProcessDefaultHardErrorMode"},
+	{Class: ProcessIoPortHandlers, Name: "ProcessIoPortHandlers"},
+	{Class: ProcessPooledUsageAndLimits, Name: "ProcessPooledUsageAndLimits"},
+	{Class: ProcessWorkingSetWatch, Name: "ProcessWorkingSetWatch", VariableOutput: true},
+	{Class: ProcessUserModeIOPL, Name: "ProcessUserModeIOPL"},
+	{Class: ProcessEnableAlignmentFaultFixup, Name: "ProcessEnableAlignmentFaultFixup"},
+	{Class: ProcessPriorityClass, Name: "ProcessPriorityClass"},
+	{Class: ProcessWx86Information, Name: "ProcessWx86Information"},
+	{Class: ProcessHandleCount, Name: "ProcessHandleCount"},
+	{Class: ProcessAffinityMask, Name: "ProcessAffinityMask"},
+	{Class: ProcessPriorityBoost, Name: "ProcessPriorityBoost"},
+	{Class: ProcessDeviceMap, Name: "ProcessDeviceMap"},
+	{Class: ProcessSessionInformation, Name: "ProcessSessionInformation"},
+	{Class: ProcessForegroundInformation, Name: "ProcessForegroundInformation"},
+	{Class: ProcessWow64Information, Name: "ProcessWow64Information"},
+	{Class: ProcessImageFileName, Name: "ProcessImageFileName", VariableOutput: true},
+	{Class: ProcessLUIDDeviceMapsEnabled, Name: "ProcessLUIDDeviceMapsEnabled"},
+	{Class: ProcessBreakOnTermination, Name: "ProcessBreakOnTermination"},
+	{Class: ProcessDebugObjectHandle, Name: "ProcessDebugObjectHandle"},
+	{Class: ProcessDebugFlags, Name: "ProcessDebugFlags"},
+	{Class: ProcessHandleTracing, Name: "ProcessHandleTracing", VariableOutput: true},
+	{Class: ProcessIoPriority, Name: "ProcessIoPriority", MinBuild: 6000},
+	{Class: ProcessExecuteFlags, Name: "ProcessExecuteFlags"},
+	{Class: ProcessTlsInformation, Name: "ProcessTlsInformation"},
+	{Class: ProcessCookie, Name: "ProcessCookie", MinBuild: 6000},
+	{Class: ProcessImageInformation, Name: "ProcessImageInformation", MinBuild: 6000},
+	{Class: ProcessCycleTime, Name: "ProcessCycleTime", MinBuild: 6000},
+	{Class: ProcessPagePriority, Name: "ProcessPagePriority", MinBuild: 6000},
+	{Class: ProcessInstrumentationCallback, Name: "ProcessInstrumentationCallback", MinBuild: 6000},
+	{Class: ProcessThreadStackAllocation, Name: "ProcessThreadStackAllocation", MinBuild: 6000},
+	{Class: ProcessWorkingSetWatchEx, Name: "ProcessWorkingSetWatchEx", MinBuild: 6000, VariableOutput: true},
+	{Class: ProcessImageFileNameWin32, Name: "ProcessImageFileNameWin32", MinBuild: 6000, VariableOutput: true},
+	{Class: ProcessImageFileMapping, Name: "ProcessImageFileMapping", MinBuild: 6000},
+	{Class: ProcessAffinityUpdateMode, Name: "ProcessAffinityUpdateMode", MinBuild: 6000},
+	{Class: ProcessMemoryAllocationMode, Name: "ProcessMemoryAllocationMode", MinBuild: 6000},
+	{Class: ProcessGroupInformation, Name: "ProcessGroupInformation", MinBuild: 7600},
+	{Class: ProcessTokenVirtualizationEnabled, Name: "ProcessTokenVirtualizationEnabled", MinBuild: 7600},
+	{Class: ProcessConsoleHostProcess, Name: "ProcessConsoleHostProcess", MinBuild: 7600},
+	{Class: ProcessWindowInformation, Name: "ProcessWindowInformation", MinBuild: 7600},
+	{Class: ProcessHandleInformation, Name: "ProcessHandleInformation", MinBuild: 9200, VariableOutput: true},
+	{Class: ProcessMitigationPolicy, Name: "ProcessMitigationPolicy", MinBuild: 9200, RequiresInput: true},
+	{Class: ProcessDynamicFunctionTableInformation, Name: "ProcessDynamicFunctionTableInformation", MinBuild: 9200},
+	{Class: ProcessHandleCheckingMode, Name: "ProcessHandleCheckingMode", MinBuild: 9200},
+	{Class: ProcessKeepAliveCount, Name: "ProcessKeepAliveCount", MinBuild: 9200},
+	{Class: ProcessRevokeFileHandles, Name: "ProcessRevokeFileHandles", MinBuild: 9200},
+	{Class: ProcessWorkingSetControl, Name: "ProcessWorkingSetControl", MinBuild: 9200},
+	{Class: ProcessHandleTable, Name: "ProcessHandleTable", MinBuild: 9600, VariableOutput: true},
+	{Class: ProcessCheckStackExtentsMode, Name: "ProcessCheckStackExtentsMode", MinBuild: 9600},
+	{Class: ProcessCommandLineInformation, Name: "ProcessCommandLineInformation", MinBuild: 9600, VariableOutput: true},
+	{Class: ProcessProtectionInformation, Name: "ProcessProtectionInformation", MinBuild: 9600},
+	{Class: ProcessMemoryExhaustion, Name: "ProcessMemoryExhaustion", MinBuild: 10240},
+	{Class: ProcessFaultInformation, Name: "ProcessFaultInformation", MinBuild: 10240},
+	{Class: ProcessTelemetryIdInformation, Name: "ProcessTelemetryIdInformation", MinBuild: 10240, VariableOutput: true},
+	{Class: ProcessCommitReleaseInformation, Name: "ProcessCommitReleaseInformation", MinBuild: 10240},
+	{Class: ProcessDefaultCpuSetsInformation, Name: "ProcessDefaultCpuSetsInformation", MinBuild: 10240, VariableOutput: true},
+	{Class: ProcessAllowedCpuSetsInformation, Name: "ProcessAllowedCpuSetsInformation", MinBuild: 10240, VariableOutput: true},
+	{Class: ProcessSubsystemProcess, Name: "ProcessSubsystemProcess", MinBuild: 10240},
+	{Class: ProcessJobMemoryInformation, Name: "ProcessJobMemoryInformation", MinBuild: 10240},
+	{Class: ProcessInPrivate, Name: "ProcessInPrivate", MinBuild: 10240},
+	{Class: ProcessRaiseUMExceptionOnInvalidHandleClose, Name: "ProcessRaiseUMExceptionOnInvalidHandleClose", MinBuild: 10240},
+	{Class: ProcessIumChallengeResponse, Name: "ProcessIumChallengeResponse", MinBuild: 10240},
+	{Class: ProcessChildProcessInformation, Name: "ProcessChildProcessInformation", MinBuild: 10240},
+	{Class: ProcessHighGraphicsPriorityInformation, Name: "ProcessHighGraphicsPriorityInformation", MinBuild: 10240},
+	{Class: ProcessSubsystemInformation, Name: "ProcessSubsystemInformation", MinBuild: 10240},
+	{Class: ProcessEnergyValues, Name: "ProcessEnergyValues", MinBuild: 14393},
+	{Class: ProcessPowerThrottlingState, Name: "ProcessPowerThrottlingState", MinBuild: 14393},
+	{Class: ProcessReserved3Information, Name: "ProcessReserved3Information", MinBuild: 14393},
+	{Class: ProcessWin32kSyscallFilterInformation, Name: "ProcessWin32kSyscallFilterInformation", MinBuild: 14393},
+	{Class: ProcessDisableSystemAllowedCpuSets, Name: "ProcessDisableSystemAllowedCpuSets", MinBuild: 14393},
+	{Class: ProcessWakeInformation, Name: "ProcessWakeInformation", MinBuild: 14393},
+	{Class: ProcessEnergyTrackingState, Name: "ProcessEnergyTrackingState", MinBuild: 14393},
+	{Class: ProcessManageWritesToExecutableMemory, Name: "ProcessManageWritesToExecutableMemory", MinBuild: 14393},
+	{Class: ProcessCaptureTrustletLiveDump, Name: "ProcessCaptureTrustletLiveDump", MinBuild: 14393},
+	{Class: ProcessTelemetryCoverage, Name: "ProcessTelemetryCoverage", MinBuild: 14393},
+	{Class: ProcessEnclaveInformation, Name: "ProcessEnclaveInformation", MinBuild: 14393},
+	{Class: ProcessEnableReadWriteVmLogging, Name: "ProcessEnableReadWriteVmLogging", MinBuild: 16299},
+	{Class: ProcessUptimeInformation, Name: "ProcessUptimeInformation", MinBuild: 16299},
+	{Class: ProcessImageSection, Name: "ProcessImageSection", MinBuild: 16299},
+	{Class: ProcessDebugAuthInformation, Name: "ProcessDebugAuthInformation", MinBuild: 16299},
+	{Class: ProcessSystemResourceManagement, Name: "ProcessSystemResourceManagement", MinBuild: 16299},
+	{Class: ProcessSequenceNumber, Name: "ProcessSequenceNumber", MinBuild: 16299},
+	{Class: ProcessLoaderDetour, Name: "ProcessLoaderDetour", MinBuild: 16299},
+	{Class: ProcessSecurityDomainInformation, Name: "ProcessSecurityDomainInformation", MinBuild: 16299},
+	{Class: ProcessCombineSecurityDomainsInformation, Name: "ProcessCombineSecurityDomainsInformation", MinBuild: 16299},
+	{Class: ProcessEnableLoggingInformation, Name: "ProcessEnableLoggingInformation", MinBuild: 16299},
+	{Class: ProcessLeapSecondInformation, Name: "ProcessLeapSecondInformation", MinBuild: 17763},
+	{Class: ProcessFiberShadowStackAllocation, Name: "ProcessFiberShadowStackAllocation", MinBuild: 17763},
+	{Class: ProcessFreeFiberShadowStackAllocation, Name: "ProcessFreeFiberShadowStackAllocation", MinBuild: 17763},
+	{Class: ProcessAltSystemCallInformation, Name: "ProcessAltSystemCallInformation", MinBuild: 18362},
+	{Class: ProcessDynamicEHContinuationTargets, Name: "ProcessDynamicEHContinuationTargets", MinBuild: 19041},
+	{Class: ProcessDynamicEnforcedCetCompatibleRanges, Name: "ProcessDynamicEnforcedCetCompatibleRanges", MinBuild: 19041},
+	{Class: ProcessCreateStateChange, Name: "ProcessCreateStateChange", MinBuild: 22000},
+	{Class: ProcessApplyStateChange, Name: "ProcessApplyStateChange", MinBuild: 22000},
+	{Class: ProcessEnableOptionalXStateFeatures, Name: "ProcessEnableOptionalXStateFeatures", MinBuild: 22000},
+	{Class: ProcessAltPrefetchParam, Name: "ProcessAltPrefetchParam", MinBuild: 22000},
+	{Class: ProcessAssignCpuPartitions, Name: "ProcessAssignCpuPartitions", MinBuild: 22000},
+	{Class: ProcessPriorityClassEx, Name: "ProcessPriorityClassEx", MinBuild: 22000},
+	{Class: ProcessMembershipInformation, Name: "ProcessMembershipInformation", MinBuild: 22000},
+	{Class: ProcessEffectiveIoPriority, Name: "ProcessEffectiveIoPriority", MinBuild: 22000},
+	{Class: ProcessEffectivePagePriority, Name: "ProcessEffectivePagePriority", MinBuild: 22000},
+	{Class: ProcessSchedulerSharedData, Name: "ProcessSchedulerSharedData", MinBuild: 22621},
+	{Class: ProcessSlistRollbackInformation, Name: "ProcessSlistRollbackInformation", MinBuild: 22621},
+	{Class: ProcessNetworkIoCounters, Name: "ProcessNetworkIoCounters", MinBuild: 22621},
+	{Class: ProcessFindFirstThreadByTebValue, Name: "ProcessFindFirstThreadByTebValue", MinBuild: 22621},
+}
+
+// String returns the name of the class (e.g. "ProcessBasicInformation"), or
+// "ProcessInfoClass(0x..)" for unknown values.
+func (c ProcessInfoClass) String() string {
+	if info, ok := c.Info(); ok {
+		return info.Name
+	}
+	return fmt.Sprintf("ProcessInfoClass(0x%X)", uint32(c))
+}
+
+// Info returns the metadata for the class. The boolean result is false if the
+// class is not known.
+func (c ProcessInfoClass) Info() (ProcessInfoClassInfo, bool) {
+	if int(c) >= len(processInfoClasses) {
+		return ProcessInfoClassInfo{}, false
+	}
+	return processInfoClasses[c], true
+}
+
+// IsKnown reports whether the class is defined in this package.
+func (c ProcessInfoClass) IsKnown() bool {
+	return int(c) < len(processInfoClasses)
+}
+
+// SupportedOn reports whether the class is available on the given Windows build.
+func (info ProcessInfoClassInfo) SupportedOn(build uint32) bool {
+	return build >= info.MinBuild
+}
+
+// ProcessInfoClasses returns the metadata of every known class, in ascending
+// order of class value.
+func ProcessInfoClasses() []ProcessInfoClassInfo {
+	result := make([]ProcessInfoClassInfo, len(processInfoClasses))
+	copy(result, processInfoClasses[:])
+	return result
+}
+
+// ParseProcessInfoClass returns the class with the given name. Names are
+// matched case-insensitively and the "Process" prefix may be omitted, so
+// "ProcessBasicInformation" and "BasicInformation" are equivalent. Numeric
+// values such as "0x1B" or "27" are also accepted.
+func ParseProcessInfoClass(name string) (ProcessInfoClass, error) {
+	name = strings.TrimSpace(name)
+	if value, err := strconv.ParseUint(name, 0, 32); err == nil {
+		return ProcessInfoClass(value), nil
+	}
+
+	for _, info := range processInfoClasses {
+		if strings.EqualFold(info.Name, name) || strings.EqualFold(info.Name, "Process"+name) {
+			return info.Class, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown process information class %q", name)
+}
@@ -0,0 +1,85 @@
+package winx
+
+import "testing"
+
+// TestProcessInfoClass_String tests class name resolution
+func TestProcessInfoClass_String(t *testing.T) {
+	tests := []struct {
+		class ProcessInfoClass
+		want  string
+	}{
+		{ProcessBasicInformation, "ProcessBasicInformation"},
+		{ProcessImageFileName, "ProcessImageFileName"},
+		{ProcessProtectionInformation, "ProcessProtectionInformation"},
+		{ProcessFindFirstThreadByTebValue, "ProcessFindFirstThreadByTebValue"},
+		{ProcessInfoClass(0xFFFF), "ProcessInfoClass(0xFFFF)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.class.String(); got != tt.want {
+			t.Errorf("ProcessInfoClass(0x%X).String() = %q, want %q", uint32(tt.class), got, tt.want)
+		}
+	}
+}
+
+// TestProcessInfoClasses tests that the metadata table is indexed by class value
+func TestProcessInfoClasses(t *testing.T) {
+	classes := ProcessInfoClasses()
+	if len(classes) != int(ProcessFindFirstThreadByTebValue)+1 {
+		t.Fatalf("ProcessInfoClasses() returned %d classes, want %d", len(classes), ProcessFindFirstThreadByTebValue+1)
+	}
+	for i, info := range classes {
+		if info.Class != ProcessInfoClass(i) {
+			t.Errorf("classes[%d].Class = 0x%X (%s)", i, uint32(info.Class), info.Name)
+		}
+	}
+}
+
+// TestProcessInfoClass_Info tests per-class metadata
+func TestProcessInfoClass_Info(t *testing.T) {
+	info, ok := ProcessCommandLineInformation.Info()
+	if !ok || !info.VariableOutput || !info.SupportedOn(9600) || info.SupportedOn(9200) {
+		t.Errorf("ProcessCommandLineInformation.Info() = %+v, %v", info, ok)
+	}
+
+	info, _ = ProcessMitigationPolicy.Info()
+	if !info.RequiresInput || info.VariableOutput {
+		t.Errorf("ProcessMitigationPolicy.Info() = %+v", info)
+	}
+
+	info, _ = ProcessBasicInformation.Info()
+	if info.MinBuild != 0 || info.VariableOutput {
+		t.Errorf("ProcessBasicInformation.Info() = %+v", info)
+	}
+
+	if _, ok := ProcessInfoClass(0xFFFF).Info(); ok {
+		t.Error("ProcessInfoClass(0xFFFF).Info() ok = true, want false")
+	}
+}
+
+// TestParseProcessInfoClass tests parsing class names and values
+func TestParseProcessInfoClass(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ProcessInfoClass
+		wantErr bool
+	}{
+		{"ProcessBasicInformation", ProcessBasicInformation, false},
+		{"processimagefilenamewin32", ProcessImageFileNameWin32, false},
+		{"BreakOnTermination", ProcessBreakOnTermination, false},
+		{"0x3C", ProcessCommandLineInformation, false},
+		{"20", ProcessHandleCount, false},
+		{"ProcessNoSuchInformation", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseProcessInfoClass(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseProcessInfoClass(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseProcessInfoClass(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
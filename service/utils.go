@@ -4,19 +4,20 @@ import (
 	"syscall"
 	"unsafe"
 
+	"github.com/ArkaprabhaChakraborty/winx"
 	"github.com/ArkaprabhaChakraborty/winx/handle"
+	"github.com/ArkaprabhaChakraborty/winx/internal/proc"
 )
 
 var (
-	advapi32                 = syscall.NewLazyDLL("advapi32.dll")
-	procOpenSCManagerW       = advapi32.NewProc("OpenSCManagerW")
-	procCreateServiceW       = advapi32.NewProc("CreateServiceW")
-	procOpenServiceW         = advapi32.NewProc("OpenServiceW")
-	procStartServiceW        = advapi32.NewProc("StartServiceW")
-	procControlService       = advapi32.NewProc("ControlService")
-	procDeleteService        = advapi32.NewProc("DeleteService")
-	procCloseServiceHandle   = advapi32.NewProc("CloseServiceHandle")
-	procQueryServiceStatus   = advapi32.NewProc("QueryServiceStatus")
+	procOpenSCManagerW       = proc.Advapi32.Proc("OpenSCManagerW")
+	procCreateServiceW       = proc.Advapi32.Proc("CreateServiceW")
+	procOpenServiceW         = proc.Advapi32.Proc("OpenServiceW")
+	procStartServiceW        = proc.Advapi32.Proc("StartServiceW")
+	procControlService       = proc.Advapi32.Proc("ControlService")
+	procDeleteService        = proc.Advapi32.Proc("DeleteService")
+	procCloseServiceHandle   = proc.Advapi32.Proc("CloseServiceHandle")
+	procQueryServiceStatus   = proc.Advapi32.Proc("QueryServiceStatus")
 )
 
 // Service Control Manager access rights
@@ -140,15 +141,16 @@ func OpenSCManager(machineName string, databaseName string, desiredAccess uint32
 		databaseNamePtr = uintptr(unsafe.Pointer(ptr))
 	}
 
-	ret, _, _ := syscall.SyscallN(
-		procOpenSCManagerW.Addr(),
+	ret, _, err := procOpenSCManagerW.Call(
+		winx.Call{API: "OpenSCManagerW", Detail: machineName},
+		winx.ReturnsPointer,
 		machineNamePtr,
 		databaseNamePtr,
 		uintptr(desiredAccess),
 	)
 
 	if ret == 0 {
-		return 0, syscall.GetLastError()
+		return 0, err
 	}
 
 	return handle.HANDLE(ret), nil
@@ -194,8 +196,9 @@ func CreateService(
 		return 0, err
 	}
 
-	ret, _, _ := syscall.SyscallN(
-		procCreateServiceW.Addr(),
+	ret, _, err := procCreateServiceW.Call(
+		winx.Call{API: "CreateServiceW", Detail: serviceName},
+		winx.ReturnsPointer,
 		uintptr(hSCManager),
 		uintptr(unsafe.Pointer(serviceNamePtr)),
 		uintptr(unsafe.Pointer(displayNamePtr)),
@@ -212,7 +215,7 @@ func CreateService(
 	)
 
 	if ret == 0 {
-		return 0, syscall.GetLastError()
+		return 0, err
 	}
 
 	return handle.HANDLE(ret), nil
@@ -233,15 +236,16 @@ func OpenService(hSCManager handle.HANDLE, serviceName string, desiredAccess uin
 		return 0, err
 	}
 
-	ret, _, _ := syscall.SyscallN(
-		procOpenServiceW.Addr(),
+	ret, _, err := procOpenServiceW.Call(
+		winx.Call{API: "OpenServiceW", Detail: serviceName},
+		winx.ReturnsPointer,
 		uintptr(hSCManager),
 		uintptr(unsafe.Pointer(serviceNamePtr)),
 		uintptr(desiredAccess),
 	)
 
 	if ret == 0 {
-		return 0, syscall.GetLastError()
+		return 0, err
 	}
 
 	return handle.HANDLE(ret), nil
@@ -273,15 +277,16 @@ func StartService(hService handle.HANDLE, args []string) (bool, error) {
 		argPtrs = uintptr(unsafe.Pointer(&utf16Args[0]))
 	}
 
-	ret, _, _ := syscall.SyscallN(
-		procStartServiceW.Addr(),
+	ret, _, err := procStartServiceW.Call(
+		winx.Call{API: "StartServiceW"},
+		winx.ReturnsBOOL,
 		uintptr(hService),
 		uintptr(numArgs),
 		argPtrs,
 	)
 
 	if ret == 0 {
-		return false, syscall.GetLastError()
+		return false, err
 	}
 
 	return true, nil
@@ -297,15 +302,16 @@ func StartService(hService handle.HANDLE, args []string) (bool, error) {
 // Returns:
 //   - true if successful, false otherwise
 func ControlService(hService handle.HANDLE, control uint32, serviceStatus *SERVICE_STATUS) (bool, error) {
-	ret, _, _ := syscall.SyscallN(
-		procControlService.Addr(),
+	ret, _, err := procControlService.Call(
+		winx.Call{API: "ControlService", Detail: winx.TraceDetail("control %d", control)},
+		winx.ReturnsBOOL,
 		uintptr(hService),
 		uintptr(control),
 		uintptr(unsafe.Pointer(serviceStatus)),
 	)
 
 	if ret == 0 {
-		return false, syscall.GetLastError()
+		return false, err
 	}
 
 	return true, nil
@@ -319,13 +325,14 @@ func ControlService(hService handle.HANDLE, control uint32, serviceStatus *SERVI
 // Returns:
 //   - true if successful, false otherwise
 func DeleteService(hService handle.HANDLE) (bool, error) {
-	ret, _, _ := syscall.SyscallN(
-		procDeleteService.Addr(),
+	ret, _, err := procDeleteService.Call(
+		winx.Call{API: "DeleteService"},
+		winx.ReturnsBOOL,
 		uintptr(hService),
 	)
 
 	if ret == 0 {
-		return false, syscall.GetLastError()
+		return false, err
 	}
 
 	return true, nil
@@ -339,8 +346,9 @@ func DeleteService(hService handle.HANDLE) (bool, error) {
 // Returns:
 //   - true if successful, false otherwise
 func CloseServiceHandle(hSCObject handle.HANDLE) bool {
-	ret, _, _ := syscall.SyscallN(
-		procCloseServiceHandle.Addr(),
+	ret, _, _ := procCloseServiceHandle.Call(
+		winx.Call{API: "CloseServiceHandle"},
+		winx.ReturnsBOOL,
 		uintptr(hSCObject),
 	)
 	return ret != 0
@@ -355,14 +363,15 @@ func CloseServiceHandle(hSCObject handle.HANDLE) bool {
 // Returns:
 //   - true if successful, false otherwise
 func QueryServiceStatus(hService handle.HANDLE, serviceStatus *SERVICE_STATUS) (bool, error) {
-	ret, _, _ := syscall.SyscallN(
-		procQueryServiceStatus.Addr(),
+	ret, _, err := procQueryServiceStatus.Call(
+		winx.Call{API: "QueryServiceStatus"},
+		winx.ReturnsBOOL,
 		uintptr(hService),
 		uintptr(unsafe.Pointer(serviceStatus)),
 	)
 
 	if ret == 0 {
-		return false, syscall.GetLastError()
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,28 @@
+//go:build windows
+
+package winx
+
+import (
+	"syscall"
+	"time"
+)
+
+// SyscallN calls the procedure at trap like syscall.SyscallN and reports the
+// call to the active tracer, if any. call names the API and its buffer sizes;
+// kind tells the tracer how to decode a failure from the return value.
+// Pointers converted to uintptr in the call expression stay valid until it
+// returns, as for syscall.SyscallN.
+//
+//go:uintptrescapes
+func SyscallN(call Call, kind ReturnKind, trap uintptr, args ...uintptr) (r1, r2 uintptr, err syscall.Errno) {
+	tracer := ActiveTracer()
+	if tracer == nil {
+		return syscall.SyscallN(trap, args...)
+	}
+
+	start := time.Now()
+	r1, r2, err = syscall.SyscallN(trap, args...)
+	call.Args = args
+	traceCall(tracer, &call, kind, start, r1, err)
+	return r1, r2, err
+}
@@ -0,0 +1,244 @@
+package winx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SystemInformationClass identifies the kind of data requested from
+// NtQuerySystemInformation and NtQuerySystemInformationEx.
+type SystemInformationClass uint32
+
+// SystemInformationClassInfo describes how a SystemInformationClass is queried.
+type SystemInformationClassInfo struct {
+	Class SystemInformationClass
+	Name  string
+
+	// MinBuild is the first Windows build that supports the class, or 0 if it
+	// is available on every supported version
+	MinBuild uint32
+
+	// RequiresExInput is set for classes that can only be queried through
+	// NtQuerySystemInformationEx with an input buffer
+	RequiresExInput bool
+
+	// PerProcessorGroup is set for classes that return data for a single
+	// processor group; NtQuerySystemInformationEx selects the group
+	PerProcessorGroup bool
+
+	// Privilege names the privilege the caller must hold, if any
+	Privilege string
+
+	// VariableOutput is set for classes whose output size depends on the
+	// system (process, module and handle lists, per-processor arrays, ...)
+	// rather than being a fixed-size structure
+	VariableOutput bool
+}
+
+// systemInformationClasses is indexed by class value
+var systemInformationClasses = [...]SystemInformationClassInfo{
+	{Class: SystemBasicInformation, Name: "SystemBasicInformation"},
+	{Class: SystemProcessorInformation, Name: "SystemProcessorInformation"},
+	{Class: SystemPerformanceInformation, Name: "SystemPerformanceInformation"},
+	{Class: SystemTimeOfDayInformation, Name: "SystemTimeOfDayInformation"},
+	{Class: SystemPathInformation, Name: "SystemPathInformation", VariableOutput: true},
+	{Class: SystemProcessInformation, Name: "SystemProcessInformation", VariableOutput: true},
+	{Class: SystemCallCountInformation, Name: "SystemCallCountInformation", VariableOutput: true},
+	{Class: SystemDeviceInformation, Name: "SystemDeviceInformation"},
+	{Class: SystemProcessorPerformanceInformation, Name: "SystemProcessorPerformanceInformation", PerProcessorGroup: true, VariableOutput: true},
+	{Class: SystemFlagsInformation, Name: "SystemFlagsInformation"},
+	{Class: SystemCallTimeInformation, Name: "SystemCallTimeInformation"},
+	{Class: SystemModuleInformation, Name: "SystemModuleInformation", VariableOutput: true},
+	{Class: SystemLocksInformation, Name: "SystemLocksInformation", VariableOutput: true},
+	{Class: SystemStackTraceInformation, Name: "SystemStackTraceInformation", VariableOutput: true},
+	{Class: SystemPagedPoolInformation, Name: "SystemPagedPoolInformation"},
+	{Class: SystemNonPagedPoolInformation, Name: "SystemNonPagedPoolInformation"},
+	{Class: SystemHandleInformation, Name: "SystemHandleInformation", VariableOutput: true},
+	{Class: SystemObjectInformation, Name: "SystemObjectInformation", VariableOutput: true},
+	{Class: SystemPageFileInformation, Name: "SystemPageFileInformation", VariableOutput: true},
+	{Class: SystemVdmInstemulInformation, Name: "SystemVdmInstemulInformation"},
+	{Class: SystemVdmBopInformation, Name: "SystemVdmBopInformation"},
+	{Class: SystemFileCacheInformation, Name: "SystemFileCacheInformation", Privilege: "SeIncreaseQuotaPrivilege"},
+	{Class: SystemPoolTagInformation, Name: "SystemPoolTagInformation", VariableOutput: true},
+	{Class: SystemInterruptInformation, Name: "SystemInterruptInformation", PerProcessorGroup: true, VariableOutput: true},
+	{Class: SystemDpcBehaviorInformation, Name: "SystemDpcBehaviorInformation"},
+	{Class: SystemFullMemoryInformation, Name: "SystemFullMemoryInformation"},
+	{Class: SystemLoadGdiDriverInformation, Name: "SystemLoadGdiDriverInformation", Privilege: "SeLoadDriverPrivilege"},
+	{Class: SystemUnloadGdiDriverInformation, Name: "SystemUnloadGdiDriverInformation", Privilege: "SeLoadDriverPrivilege"},
+	{Class: SystemTimeAdjustmentInformation, Name: "SystemTimeAdjustmentInformation", Privilege: "SeSystemtimePrivilege"},
+	{Class: SystemSummaryMemoryInformation, Name: "SystemSummaryMemoryInformation"},
+	{Class: SystemMirrorMemoryInformation, Name: "SystemMirrorMemoryInformation"},
+	{Class: SystemPerformanceTraceInformation, Name: "SystemPerformanceTraceInformation"},
+	{Class: SystemObsolete0, Name: "SystemObsolete0"},
+	{Class: SystemExceptionInformation, Name: "SystemExceptionInformation"},
+	{Class: SystemCrashDumpStateInformation, Name: "SystemCrashDumpStateInformation"},
+	{Class: SystemKernelDebuggerInformation, Name: "SystemKernelDebuggerInformation"},
+	{Class: SystemContextSwitchInformation, Name: "SystemContextSwitchInformation"},
+	{Class: SystemRegistryQuotaInformation, Name: "SystemRegistryQuotaInformation", Privilege: "SeIncreaseQuotaPrivilege"},
+	{Class: SystemExtendServiceTableInformation, Name: "SystemExtendServiceTableInformation", Privilege: "SeLoadDriverPrivilege"},
+	{Class: SystemPrioritySeparation, Name: "SystemPrioritySeparation"},
+	{Class: SystemVerifierAddDriverInformation, Name: "SystemVerifierAddDriverInformation", Privilege: "SeDebugPrivilege"},
+	{Class: SystemVerifierRemoveDriverInformation, Name: "SystemVerifierRemoveDriverInformation", Privilege: "SeDebugPrivilege"},
+	{Class: SystemProcessorIdleInformation, Name: "SystemProcessorIdleInformation", PerProcessorGroup: true, VariableOutput: true},
+	{Class: SystemLegacyDriverInformation, Name: "SystemLegacyDriverInformation"},
+	{Class: SystemCurrentTimeZoneInformation, Name: "SystemCurrentTimeZoneInformation"},
+	{Class: SystemLookasideInformation, Name: "SystemLookasideInformation", VariableOutput: true},
+	{Class: SystemTimeSlipNotification, Name: "SystemTimeSlipNotification"},
+	{Class: SystemSessionCreate, Name: "SystemSessionCreate"},
+	{Class: SystemSessionDetach, Name: "SystemSessionDetach"},
+	{Class: SystemSessionInformation, Name: "SystemSessionInformation"},
+	{Class: SystemRangeStartInformation, Name: "SystemRangeStartInformation"},
+	{Class: SystemVerifierInformation, Name: "SystemVerifierInformation", Privilege: "SeDebugPrivilege", VariableOutput: true},
+	{Class: SystemVerifierThunkExtend, Name: "SystemVerifierThunkExtend"},
+	{Class: SystemSessionProcessInformation, Name: "SystemSessionProcessInformation", VariableOutput: true},
+	{Class: SystemLoadGdiDriverInSystemSpace, Name: "SystemLoadGdiDriverInSystemSpace", Privilege: "SeLoadDriverPrivilege"},
+	{Class: SystemNumaProcessorMap, Name: "SystemNumaProcessorMap", VariableOutput: true},
+	{Class: SystemPrefetcherInformation, Name: "SystemPrefetcherInformation", Privilege: "SeProfileSingleProcessPrivilege"},
+	{Class: SystemExtendedProcessInformation, Name: "SystemExtendedProcessInformation", VariableOutput: true},
+	{Class: SystemRecommendedSharedDataAlignment, Name: "SystemRecommendedSharedDataAlignment"},
+	{Class: SystemComPlusPackage, Name: "SystemComPlusPackage"},
+	{Class: SystemNumaAvailableMemory, Name: "SystemNumaAvailableMemory", VariableOutput: true},
+	{Class: SystemProcessorPowerInformation, Name: "SystemProcessorPowerInformation", PerProcessorGroup: true, VariableOutput: true},
+	{Class: SystemEmulationBasicInformation, Name: "SystemEmulationBasicInformation"},
+	{Class: SystemEmulationProcessorInformation, Name: "SystemEmulationProcessorInformation"},
+	{Class: SystemExtendedHandleInformation, Name: "SystemExtendedHandleInformation", VariableOutput: true},
+	{Class: SystemLostDelayedWriteInformation, Name: "SystemLostDelayedWriteInformation"},
+	{Class: SystemBigPoolInformation, Name: "SystemBigPoolInformation", MinBuild: 3790, VariableOutput: true},
+	{Class: SystemSessionPoolTagInformation, Name: "SystemSessionPoolTagInformation", MinBuild: 3790, VariableOutput: true},
+	{Class: SystemSessionMappedViewInformation, Name: "SystemSessionMappedViewInformation", MinBuild: 3790, VariableOutput: true},
+	{Class: SystemHotpatchInformation, Name: "SystemHotpatchInformation", MinBuild: 3790},
+	{Class: SystemObjectSecurityMode, Name: "SystemObjectSecurityMode", MinBuild: 3790},
+	{Class: SystemWatchdogTimerHandler, Name: "SystemWatchdogTimerHandler", MinBuild: 3790},
+	{Class: SystemWatchdogTimerInformation, Name: "SystemWatchdogTimerInformation", MinBuild: 3790},
+	{Class: SystemLogicalProcessorInformation, Name: "SystemLogicalProcessorInformation", MinBuild: 3790, VariableOutput: true},
+	{Class: SystemWow64SharedInformationObsolete, Name: "SystemWow64SharedInformationObsolete", MinBuild: 3790},
+	{Class: SystemRegisterFirmwareTableInformationHandler, Name: "SystemRegisterFirmwareTableInformationHandler", MinBuild: 3790},
+	{Class: SystemFirmwareTableInformation, Name: "SystemFirmwareTableInformation", MinBuild: 3790, VariableOutput: true},
+	{Class: SystemModuleInformationEx, Name: "SystemModuleInformationEx", MinBuild: 6000, VariableOutput: true},
+	{Class: SystemVerifierTriageInformation, Name: "SystemVerifierTriageInformation", MinBuild: 6000},
+	{Class: SystemSuperfetchInformation, Name: "SystemSuperfetchInformation", MinBuild: 6000, Privilege: "SeProfileSingleProcessPrivilege"},
+	{Class: SystemMemoryListInformation, Name: "SystemMemoryListInformation", MinBuild: 6000, Privilege: "SeProfileSingleProcessPrivilege"},
+	{Class: SystemFileCacheInformationEx, Name: "SystemFileCacheInformationEx", MinBuild: 6000, Privilege: "SeIncreaseQuotaPrivilege"},
+	{Class: SystemThreadPriorityClientIdInformation, Name: "SystemThreadPriorityClientIdInformation", MinBuild: 6000},
+	{Class: SystemProcessorIdleCycleTimeInformation, Name: "SystemProcessorIdleCycleTimeInformation", MinBuild: 6000, PerProcessorGroup: true, VariableOutput: true},
+	{Class: SystemVerifierCancellationInformation, Name: "SystemVerifierCancellationInformation", MinBuild: 6000},
+	{Class: SystemProcessorPowerInformationEx, Name: "SystemProcessorPowerInformationEx", MinBuild: 6000, PerProcessorGroup: true, VariableOutput: true},
+	{Class: SystemRefTraceInformation, Name: "SystemRefTraceInformation", MinBuild: 6000},
+	{Class: SystemSpecialPoolInformation, Name: "SystemSpecialPoolInformation", MinBuild: 6000},
+	{Class: SystemProcessIdInformation, Name: "SystemProcessIdInformation", MinBuild: 6000, VariableOutput: true},
+	{Class: SystemErrorPortInformation, Name: "SystemErrorPortInformation", MinBuild: 6000},
+	{Class: SystemBootEnvironmentInformation, Name: "SystemBootEnvironmentInformation", MinBuild: 6000},
+	{Class: SystemHypervisorInformation, Name: "SystemHypervisorInformation", MinBuild: 6000},
+	{Class: SystemVerifierInformationEx, Name: "SystemVerifierInformationEx", MinBuild: 6000, Privilege: "SeDebugPrivilege", VariableOutput: true},
+	{Class: SystemTimeZoneInformation, Name: "SystemTimeZoneInformation", MinBuild: 6000},
+	{Class: SystemImageFileExecutionOptionsInformation, Name: "SystemImageFileExecutionOptionsInformation", MinBuild: 6000},
+	{Class: SystemCoverageInformation, Name: "SystemCoverageInformation", MinBuild: 6000},
+	{Class: SystemPrefetchPatchInformation, Name: "SystemPrefetchPatchInformation", MinBuild: 6000},
+	{Class: SystemVerifierFaultsInformation, Name: "SystemVerifierFaultsInformation", MinBuild: 6000},
+	{Class: SystemSystemPartitionInformation, Name: "SystemSystemPartitionInformation", MinBuild: 6000, VariableOutput: true},
+	{Class: SystemSystemDiskInformation, Name: "SystemSystemDiskInformation", MinBuild: 6000, VariableOutput: true},
+	{Class: SystemProcessorPerformanceDistribution, Name: "SystemProcessorPerformanceDistribution", MinBuild: 6000, PerProcessorGroup: true, VariableOutput: true},
+	{Class: SystemNumaProximityNodeInformation, Name: "SystemNumaProximityNodeInformation", MinBuild: 6000},
+	{Class: SystemDynamicTimeZoneInformation, Name: "SystemDynamicTimeZoneInformation", MinBuild: 6000},
+	{Class: SystemCodeIntegrityInformation, Name: "SystemCodeIntegrityInformation", MinBuild: 6000},
+	{Class: SystemProcessorMicrocodeUpdateInformation, Name: "SystemProcessorMicrocodeUpdateInformation", MinBuild: 7600},
+	{Class: SystemProcessorBrandString, Name: "SystemProcessorBrandString", MinBuild: 7600, VariableOutput: true},
+	{Class: SystemVirtualAddressInformation, Name: "SystemVirtualAddressInformation", MinBuild: 7600},
+	{Class: SystemLogicalProcessorAndGroupInformation, Name: "SystemLogicalProcessorAndGroupInformation", MinBuild: 7600, RequiresExInput: true, VariableOutput: true},
+	{Class: SystemProcessorCycleTimeInformation, Name: "SystemProcessorCycleTimeInformation", MinBuild: 7600, PerProcessorGroup: true, VariableOutput: true},
+	{Class: SystemStoreInformation, Name: "SystemStoreInformation", MinBuild: 7600},
+	{Class: SystemRegistryAppendString, Name: "SystemRegistryAppendString", MinBuild: 7600},
+	{Class: SystemAitSamplingValue, Name: "SystemAitSamplingValue", MinBuild: 7600},
+	{Class: SystemVhdBootInformation, Name: "SystemVhdBootInformation", MinBuild: 7600},
+	{Class: SystemCpuQuotaInformation, Name: "SystemCpuQuotaInformation", MinBuild: 7600},
+	{Class: SystemNativeBasicInformation, Name: "SystemNativeBasicInformation", MinBuild: 7600},
+	{Class: SystemErrorPortTimeouts, Name: "SystemErrorPortTimeouts", MinBuild: 7600},
+	{Class: SystemLowPriorityIoInformation, Name: "SystemLowPriorityIoInformation", MinBuild: 7600},
+	{Class: SystemBootEntropyInformation, Name: "SystemBootEntropyInformation", MinBuild: 7600},
+	{Class: SystemVerifierCountersInformation, Name: "SystemVerifierCountersInformation", MinBuild: 7600},
+	{Class: SystemPagedPoolInformationEx, Name: "SystemPagedPoolInformationEx", MinBuild: 7600},
+	{Class: SystemSystemPtesInformationEx, Name: "SystemSystemPtesInformationEx", MinBuild: 7600},
+	{Class: SystemNodeDistanceInformation, Name: "SystemNodeDistanceInformation", MinBuild: 7600, RequiresExInput: true},
+	{Class: SystemAcpiAuditInformation, Name: "SystemAcpiAuditInformation", MinBuild: 7600},
+	{Class: SystemBasicPerformanceInformation, Name: "SystemBasicPerformanceInformation", MinBuild: 7600},
+	{Class: SystemQueryPerformanceCounterInformation, Name: "SystemQueryPerformanceCounterInformation", MinBuild: 7600},
+	{Class: SystemSessionBigPoolInformation, Name: "SystemSessionBigPoolInformation", MinBuild: 9200, VariableOutput: true},
+	{Class: SystemBootGraphicsInformation, Name: "SystemBootGraphicsInformation", MinBuild: 9200},
+	{Class: SystemScrubPhysicalMemoryInformation, Name: "SystemScrubPhysicalMemoryInformation", MinBuild: 9200},
+	{Class: SystemBadPageInformation, Name: "SystemBadPageInformation", MinBuild: 9200, VariableOutput: true},
+	{Class: SystemProcessorProfileControlArea, Name: "SystemProcessorProfileControlArea", MinBuild: 9200, Privilege: "SeSystemProfilePrivilege"},
+	{Class: SystemCombinePhysicalMemoryInformation, Name: "SystemCombinePhysicalMemoryInformation", MinBuild: 9200, Privilege: "SeProfileSingleProcessPrivilege"},
+	{Class: SystemEntropyInterruptTimingInformation, Name: "SystemEntropyInterruptTimingInformation", MinBuild: 9200},
+	{Class: SystemConsoleInformation, Name: "SystemConsoleInformation", MinBuild: 9200},
+	{Class: SystemPlatformBinaryInformation, Name: "SystemPlatformBinaryInformation", MinBuild: 9200},
+	{Class: SystemPolicyInformation, Name: "SystemPolicyInformation", MinBuild: 9200},
+	{Class: SystemHypervisorProcessorCountInformation, Name: "SystemHypervisorProcessorCountInformation", MinBuild: 9200},
+	{Class: SystemDeviceDataInformation, Name: "SystemDeviceDataInformation", MinBuild: 9200, VariableOutput: true},
+	{Class: SystemDeviceDataEnumerationInformation, Name: "SystemDeviceDataEnumerationInformation", MinBuild: 9200, VariableOutput: true},
+	{Class: SystemMemoryTopologyInformation, Name: "SystemMemoryTopologyInformation", MinBuild: 9200, VariableOutput: true},
+	{Class: SystemMemoryChannelInformation, Name: "SystemMemoryChannelInformation", MinBuild: 9200, VariableOutput: true},
+	{Class: SystemBootLogoInformation, Name: "SystemBootLogoInformation", MinBuild: 9200},
+	{Class: SystemProcessorPerformanceInformationEx, Name: "SystemProcessorPerformanceInformationEx", MinBuild: 9200, PerProcessorGroup: true, VariableOutput: true},
+	{Class: SystemCriticalProcessErrorLogInformation, Name: "SystemCriticalProcessErrorLogInformation", MinBuild: 9200, VariableOutput: true},
+	{Class: SystemSecureBootPolicyInformation, Name: "SystemSecureBootPolicyInformation", MinBuild: 9600},
+	{Class: SystemPageFileInformationEx, Name: "SystemPageFileInformationEx", MinBuild: 9600, VariableOutput: true},
+	{Class: SystemSecureBootInformation, Name: "SystemSecureBootInformation", MinBuild: 9600},
+	{Class: SystemEntropyInterruptTimingRawInformation, Name: "SystemEntropyInterruptTimingRawInformation", MinBuild: 9600},
+	{Class: SystemPortableWorkspaceEfiLauncherInformation, Name: "SystemPortableWorkspaceEfiLauncherInformation", MinBuild: 9600},
+	{Class: SystemFullProcessInformation, Name: "SystemFullProcessInformation", MinBuild: 9600, VariableOutput: true},
+}
+
+// String returns the name of the class (e.g. "SystemHandleInformation"), or
+// "SystemInformationClass(0x..)" for unknown values.
+func (c SystemInformationClass) String() string {
+	if info, ok := c.Info(); ok {
+		return info.Name
+	}
+	return fmt.Sprintf("SystemInformationClass(0x%X)", uint32(c))
+}
+
+// Info returns the metadata for the class. The boolean result is false if the
+// class is not known.
+func (c SystemInformationClass) Info() (SystemInformationClassInfo, bool) {
+	if int(c) >= len(systemInformationClasses) {
+		return SystemInformationClassInfo{}, false
+	}
+	return systemInformationClasses[c], true
+}
+
+// IsKnown reports whether the class is defined in this package.
+func (c SystemInformationClass) IsKnown() bool {
+	return int(c) < len(systemInformationClasses)
+}
+
+// SupportedOn reports whether the class is available on the given Windows build.
+func (info SystemInformationClassInfo) SupportedOn(build uint32) bool {
+	return build >= info.MinBuild
+}
+
+// SystemInformationClasses returns the metadata of every known class, in
+// ascending order of class value.
+func SystemInformationClasses() []SystemInformationClassInfo {
+	result := make([]SystemInformationClassInfo, len(systemInformationClasses))
+	copy(result, systemInformationClasses[:])
+	return result
+}
+
+// ParseSystemInformationClass returns the class with the given name. Names are
+// matched case-insensitively and the "System" prefix may be omitted, so
+// "SystemHandleInformation", "systemhandleinformation" and "HandleInformation"
+// are equivalent. Numeric values such as "0x10" or "16" are also accepted.
+func ParseSystemInformationClass(name string) (SystemInformationClass, error) {
+	name = strings.TrimSpace(name)
+	if value, err := strconv.ParseUint(name, 0, 32); err == nil {
+		return SystemInformationClass(value), nil
+	}
+
+	for _, info := range systemInformationClasses {
+		if strings.EqualFold(info.Name, name) || strings.EqualFold(info.Name, "System"+name) {
+			return info.Class, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown system information class %q", name)
+}
@@ -0,0 +1,89 @@
+package winx
+
+import "testing"
+
+// TestSystemInformationClass_String tests class name resolution
+func TestSystemInformationClass_String(t *testing.T) {
+	tests := []struct {
+		class SystemInformationClass
+		want  string
+	}{
+		{SystemBasicInformation, "SystemBasicInformation"},
+		{SystemHandleInformation, "SystemHandleInformation"},
+		{SystemFullProcessInformation, "SystemFullProcessInformation"},
+		{SystemInformationClass(0xFFFF), "SystemInformationClass(0xFFFF)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.class.String(); got != tt.want {
+			t.Errorf("SystemInformationClass(0x%X).String() = %q, want %q", uint32(tt.class), got, tt.want)
+		}
+	}
+}
+
+// TestSystemInformationClasses tests that the metadata table is indexed by class value
+func TestSystemInformationClasses(t *testing.T) {
+	classes := SystemInformationClasses()
+	if len(classes) != int(SystemFullProcessInformation)+1 {
+		t.Fatalf("SystemInformationClasses() returned %d classes, want %d", len(classes), SystemFullProcessInformation+1)
+	}
+	for i, info := range classes {
+		if info.Class != SystemInformationClass(i) {
+			t.Errorf("classes[%d].Class = 0x%X (%s)", i, uint32(info.Class), info.Name)
+		}
+	}
+}
+
+// TestSystemInformationClass_Info tests per-class metadata
+func TestSystemInformationClass_Info(t *testing.T) {
+	info, ok := SystemExtendedHandleInformation.Info()
+	if !ok || !info.VariableOutput || info.RequiresExInput {
+		t.Errorf("SystemExtendedHandleInformation.Info() = %+v, %v", info, ok)
+	}
+
+	info, _ = SystemLogicalProcessorAndGroupInformation.Info()
+	if !info.RequiresExInput || !info.SupportedOn(7600) || info.SupportedOn(6002) {
+		t.Errorf("SystemLogicalProcessorAndGroupInformation.Info() = %+v", info)
+	}
+
+	info, _ = SystemProcessorPerformanceInformation.Info()
+	if !info.PerProcessorGroup {
+		t.Errorf("SystemProcessorPerformanceInformation.PerProcessorGroup = false, want true")
+	}
+
+	info, _ = SystemSuperfetchInformation.Info()
+	if info.Privilege != "SeProfileSingleProcessPrivilege" {
+		t.Errorf("SystemSuperfetchInformation.Privilege = %q", info.Privilege)
+	}
+
+	if _, ok := SystemInformationClass(0xFFFF).Info(); ok {
+		t.Error("SystemInformationClass(0xFFFF).Info() ok = true, want false")
+	}
+}
+
+// TestParseSystemInformationClass tests parsing class names and values
+func TestParseSystemInformationClass(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    SystemInformationClass
+		wantErr bool
+	}{
+		{"SystemHandleInformation", SystemHandleInformation, false},
+		{"systemprocessinformation", SystemProcessInformation, false},
+		{"ModuleInformation", SystemModuleInformation, false},
+		{"0x40", SystemExtendedHandleInformation, false},
+		{"16", SystemHandleInformation, false},
+		{"SystemNoSuchInformation", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSystemInformationClass(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSystemInformationClass(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSystemInformationClass(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
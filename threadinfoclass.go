@@ -0,0 +1,148 @@
+package winx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ThreadInfoClass identifies the kind of data requested from
+// NtQueryInformationThread (THREADINFOCLASS).
+type ThreadInfoClass uint32
+
+// ThreadInfoClassInfo describes how a ThreadInfoClass is queried.
+type ThreadInfoClassInfo struct {
+	Class ThreadInfoClass
+	Name  string
+
+	// MinBuild is the first Windows build that supports the class, or 0 if it
+	// is available on every supported version
+	MinBuild uint32
+
+	// RequiresInput is set for classes whose buffer must be initialized with
+	// an input, such as the THREAD_TEB_INFORMATION of ThreadTebInformation
+	RequiresInput bool
+
+	// VariableOutput is set for classes whose output size depends on the
+	// thread (its name, CPU set list, ...) rather than being a fixed-size
+	// structure
+	VariableOutput bool
+}
+
+// threadInfoClasses is indexed by class value
+var threadInfoClasses = [...]ThreadInfoClassInfo{
+	{Class: ThreadBasicInformation, Name: "ThreadBasicInformation"},
+	{Class: ThreadTimes, Name: "ThreadTimes"},
+	{Class: ThreadPriority, Name: "ThreadPriority"},
+	{Class: ThreadBasePriority, Name: "ThreadBasePriority"},
+	{Class: ThreadAffinityMask, Name: "ThreadAffinityMask"},
+	{Class: ThreadImpersonationToken, Name: "ThreadImpersonationToken"},
+	{Class: ThreadDescriptorTableEntry, Name: "ThreadDescriptorTableEntry"},
+	{Class: ThreadEnableAlignmentFaultFixup, Name: "ThreadEnableAlignmentFaultFixup"},
+	{Class: ThreadEventPair, Name: "ThreadEventPair"},
+	{Class: ThreadQuerySetWin32StartAddress, Name: "ThreadQuerySetWin32StartAddress"},
+	{Class: ThreadZeroTlsCell, Name: "ThreadZeroTlsCell"},
+	{Class: ThreadPerformanceCount, Name: "ThreadPerformanceCount"},
+	{Class: ThreadAmILastThread, Name: "ThreadAmILastThread"},
+	{Class: ThreadIdealProcessor, Name: "ThreadIdealProcessor"},
+	{Class: ThreadPriorityBoost, Name: "ThreadPriorityBoost"},
+	{Class: ThreadSetTlsArrayAddress, Name: "ThreadSetTlsArrayAddress"},
+	{Class: ThreadIsIoPending, Name: "ThreadIsIoPending"},
+	{Class: ThreadHideFromDebugger, Name: "ThreadHideFromDebugger"},
+	{Class: ThreadBreakOnTermination, Name: "ThreadBreakOnTermination"},
+	{Class: ThreadSwitchLegacyState, Name: "ThreadSwitchLegacyState"},
+	{Class: ThreadIsTerminated, Name: "ThreadIsTerminated"},
+	{Class: ThreadLastSystemCall, Name: "ThreadLastSystemCall"},
+	{Class: ThreadIoPriority, Name: "ThreadIoPriority", MinBuild: 6000},
+	{Class: ThreadCycleTime, Name: "ThreadCycleTime", MinBuild: 6000},
+	{Class: ThreadPagePriority, Name: "ThreadPagePriority", MinBuild: 6000},
+	{Class: ThreadActualBasePriority, Name: "ThreadActualBasePriority", MinBuild: 6000},
+	{Class: ThreadTebInformation, Name: "ThreadTebInformation", MinBuild: 6000, RequiresInput: true},
+	{Class: ThreadCSwitchMon, Name: "ThreadCSwitchMon", MinBuild: 6000},
+	{Class: ThreadCSwitchPmu, Name: "ThreadCSwitchPmu", MinBuild: 6000},
+	{Class: ThreadWow64Context, Name: "ThreadWow64Context", MinBuild: 7600, RequiresInput: true},
+	{Class: ThreadGroupInformation, Name: "ThreadGroupInformation", MinBuild: 7600},
+	{Class: ThreadUmsInformation, Name: "ThreadUmsInformation", MinBuild: 7600},
+	{Class: ThreadCounterProfiling, Name: "ThreadCounterProfiling", MinBuild: 7600},
+	{Class: ThreadIdealProcessorEx, Name: "ThreadIdealProcessorEx", MinBuild: 7600},
+	{Class: ThreadCpuAccountingInformation, Name: "ThreadCpuAccountingInformation", MinBuild: 9200},
+	{Class: ThreadSuspendCount, Name: "ThreadSuspendCount", MinBuild: 9600},
+	{Class: ThreadHeterogeneousCpuPolicy, Name: "ThreadHeterogeneousCpuPolicy", MinBuild: 10240},
+	{Class: ThreadContainerId, Name: "ThreadContainerId", MinBuild: 10240},
+	{Class: ThreadNameInformation, Name: "ThreadNameInformation", MinBuild: 14393, VariableOutput: true},
+	{Class: ThreadSelectedCpuSets, Name: "ThreadSelectedCpuSets", MinBuild: 10240, VariableOutput: true},
+	{Class: ThreadSystemThreadInformation, Name: "ThreadSystemThreadInformation", MinBuild: 10240},
+	{Class: ThreadActualGroupAffinity, Name: "ThreadActualGroupAffinity", MinBuild: 10240},
+	{Class: ThreadDynamicCodePolicyInfo, Name: "ThreadDynamicCodePolicyInfo", MinBuild: 10240},
+	{Class: ThreadExplicitCaseSensitivity, Name: "ThreadExplicitCaseSensitivity", MinBuild: 14393},
+	{Class: ThreadWorkOnBehalfTicket, Name: "ThreadWorkOnBehalfTicket", MinBuild: 14393},
+	{Class: ThreadSubsystemInformation, Name: "ThreadSubsystemInformation", MinBuild: 14393},
+	{Class: ThreadDbgkWerReportActive, Name: "ThreadDbgkWerReportActive", MinBuild: 14393},
+	{Class: ThreadAttachContainer, Name: "ThreadAttachContainer", MinBuild: 14393},
+	{Class: ThreadManageWritesToExecutableMemory, Name: "ThreadManageWritesToExecutableMemory", MinBuild: 15063},
+	{Class: ThreadPowerThrottlingState, Name: "ThreadPowerThrottlingState", MinBuild: 16299},
+	{Class: ThreadWorkloadClass, Name: "ThreadWorkloadClass", MinBuild: 17134},
+	{Class: ThreadCreateStateChange, Name: "ThreadCreateStateChange", MinBuild: 22000},
+	{Class: ThreadApplyStateChange, Name: "ThreadApplyStateChange", MinBuild: 22000},
+	{Class: ThreadStrongerBadHandleChecks, Name: "ThreadStrongerBadHandleChecks", MinBuild: 22000},
+	{Class: ThreadEffectiveIoPriority, Name: "ThreadEffectiveIoPriority", MinBuild: 22000},
+	{Class: ThreadEffectivePagePriority, Name: "ThreadEffectivePagePriority", MinBuild: 22000},
+	{Class: ThreadUpdateLockOwnership, Name: "ThreadUpdateLockOwnership", MinBuild: 22621},
+	{Class: ThreadSchedulerSharedDataSlot, Name: "ThreadSchedulerSharedDataSlot", MinBuild: 22621},
+	{Class: ThreadTebInformationAtomic, Name: "ThreadTebInformationAtomic", MinBuild: 22621, RequiresInput: true},
+	{Class: ThreadIndexInformation, Name: "ThreadIndexInformation", MinBuild: 22621},
+}
+
+// String returns the name of the class (e.g. "ThreadBasicInformation"), or
+// "ThreadInfoClass(0x..)" for unknown values.
+func (c ThreadInfoClass) String() string {
+	if info, ok := c.Info(); ok {
+		return info.Name
+	}
+	return fmt.Sprintf("ThreadInfoClass(0x%X)", uint32(c))
+}
+
+// Info returns the metadata for the class. The boolean result is false if the
+// class is not known.
+func (c ThreadInfoClass) Info() (ThreadInfoClassInfo, bool) {
+	if int(c) >= len(threadInfoClasses) {
+		return ThreadInfoClassInfo{}, false
+	}
+	return threadInfoClasses[c], true
+}
+
+// IsKnown reports whether the class is defined in this package.
+func (c ThreadInfoClass) IsKnown() bool {
+	return int(c) < len(threadInfoClasses)
+}
+
+// SupportedOn reports whether the class is available on the given Windows build.
+func (info ThreadInfoClassInfo) SupportedOn(build uint32) bool {
+	return build >= info.MinBuild
+}
+
+// ThreadInfoClasses returns the metadata of every known class, in ascending
+// order of class value.
+func ThreadInfoClasses() []ThreadInfoClassInfo {
+	result := make([]ThreadInfoClassInfo, len(threadInfoClasses))
+	copy(result, threadInfoClasses[:])
+	return result
+}
+
+// ParseThreadInfoClass returns the class with the given name. Names are
+// matched case-insensitively and the "Thread" prefix may be omitted, so
+// "ThreadLastSystemCall" and "LastSystemCall" are equivalent. Numeric values
+// such as "0x15" or "21" are also accepted.
+func ParseThreadInfoClass(name string) (ThreadInfoClass, error) {
+	name = strings.TrimSpace(name)
+	if value, err := strconv.ParseUint(name, 0, 32); err == nil {
+		return ThreadInfoClass(value), nil
+	}
+
+	for _, info := range threadInfoClasses {
+		if strings.EqualFold(info.Name, name) || strings.EqualFold(info.Name, "Thread"+name) {
+			return info.Class, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown thread information class %q", name)
+}
@@ -0,0 +1,85 @@
+package winx
+
+import "testing"
+
+// TestThreadInfoClass_String tests class name resolution
+func TestThreadInfoClass_String(t *testing.T) {
+	tests := []struct {
+		class ThreadInfoClass
+		want  string
+	}{
+		{ThreadBasicInformation, "ThreadBasicInformation"},
+		{ThreadQuerySetWin32StartAddress, "ThreadQuerySetWin32StartAddress"},
+		{ThreadSuspendCount, "ThreadSuspendCount"},
+		{ThreadIndexInformation, "ThreadIndexInformation"},
+		{ThreadInfoClass(0xFFFF), "ThreadInfoClass(0xFFFF)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.class.String(); got != tt.want {
+			t.Errorf("ThreadInfoClass(0x%X).String() = %q, want %q", uint32(tt.class), got, tt.want)
+		}
+	}
+}
+
+// TestThreadInfoClasses tests that the metadata table is indexed by class value
+func TestThreadInfoClasses(t *testing.T) {
+	classes := ThreadInfoClasses()
+	if len(classes) != int(ThreadIndexInformation)+1 {
+		t.Fatalf("ThreadInfoClasses() returned %d classes, want %d", len(classes), ThreadIndexInformation+1)
+	}
+	for i, info := range classes {
+		if info.Class != ThreadInfoClass(i) {
+			t.Errorf("classes[%d].Class = 0x%X (%s)", i, uint32(info.Class), info.Name)
+		}
+	}
+}
+
+// TestThreadInfoClass_Info tests per-class metadata
+func TestThreadInfoClass_Info(t *testing.T) {
+	info, ok := ThreadSuspendCount.Info()
+	if !ok || info.VariableOutput || !info.SupportedOn(9600) || info.SupportedOn(9200) {
+		t.Errorf("ThreadSuspendCount.Info() = %+v, %v", info, ok)
+	}
+
+	info, _ = ThreadNameInformation.Info()
+	if !info.VariableOutput || !info.SupportedOn(14393) {
+		t.Errorf("ThreadNameInformation.Info() = %+v", info)
+	}
+
+	info, _ = ThreadTebInformation.Info()
+	if !info.RequiresInput {
+		t.Errorf("ThreadTebInformation.RequiresInput = false, want true")
+	}
+
+	if _, ok := ThreadInfoClass(0xFFFF).Info(); ok {
+		t.Error("ThreadInfoClass(0xFFFF).Info() ok = true, want false")
+	}
+}
+
+// TestParseThreadInfoClass tests parsing class names and values
+func TestParseThreadInfoClass(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ThreadInfoClass
+		wantErr bool
+	}{
+		{"ThreadBasicInformation", ThreadBasicInformation, false},
+		{"threadcycletime", ThreadCycleTime, false},
+		{"LastSystemCall", ThreadLastSystemCall, false},
+		{"0x09", ThreadQuerySetWin32StartAddress, false},
+		{"35", ThreadSuspendCount, false},
+		{"ThreadNoSuchInformation", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseThreadInfoClass(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseThreadInfoClass(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseThreadInfoClass(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
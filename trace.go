@@ -0,0 +1,163 @@
+package winx
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// Call describes a completed native API call, as passed to a Tracer
+type Call struct {
+	API        string        // exported name, e.g. "NtQuerySystemInformation" or "DeviceIoControl"
+	Detail     string        // extra context such as the information class or IOCTL code
+	Args       []uintptr     // raw arguments
+	InputSize  int           // input buffer size in bytes, 0 if none
+	OutputSize int           // output buffer size in bytes, 0 if none
+	Return     uintptr       // raw return value
+	Duration   time.Duration // time spent in the call
+	Err        error         // failure decoded from Return or the last error, nil on success
+}
+
+// Tracer receives every native call made by winx packages while it is
+// installed with SetTracer. TraceCall is called on the calling goroutine after
+// the call returns and must not retain call.
+type Tracer interface {
+	TraceCall(call *Call)
+}
+
+// TracerFunc adapts a function to the Tracer interface
+type TracerFunc func(call *Call)
+
+// TraceCall calls f(call)
+func (f TracerFunc) TraceCall(call *Call) {
+	f(call)
+}
+
+var activeTracer atomic.Pointer[Tracer]
+
+// SetTracer installs the package-level tracer and returns the previous one.
+// Passing nil disables tracing, which is the default.
+func SetTracer(tracer Tracer) Tracer {
+	var previous *Tracer
+	if tracer == nil {
+		previous = activeTracer.Swap(nil)
+	} else {
+		previous = activeTracer.Swap(&tracer)
+	}
+	if previous == nil {
+		return nil
+	}
+	return *previous
+}
+
+// ActiveTracer returns the installed tracer, or nil if tracing is disabled
+func ActiveTracer() Tracer {
+	if tracer := activeTracer.Load(); tracer != nil {
+		return *tracer
+	}
+	return nil
+}
+
+// ReturnKind describes how a native function reports failure
+type ReturnKind int
+
+const (
+	ReturnsNTSTATUS ReturnKind = iota // an NTSTATUS; warnings and errors are failures
+	ReturnsBOOL                       // zero on failure, with the reason in GetLastError
+	ReturnsHANDLE                     // INVALID_HANDLE_VALUE or NULL on failure, with GetLastError
+	ReturnsPointer                    // NULL (or a zero count) on failure, with GetLastError
+	ReturnsVoid                       // nothing; the call cannot fail
+)
+
+// DecodeReturn converts a raw return value and last error to an error, or nil
+// if the call succeeded.
+func (kind ReturnKind) DecodeReturn(api string, r1 uintptr, lastError syscall.Errno) error {
+	failed := false
+	switch kind {
+	case ReturnsNTSTATUS:
+		status := NTSTATUS(uint32(r1))
+		if status.IsError() || status.IsWarning() {
+			return NewNTStatusError(status, api)
+		}
+		return nil
+	case ReturnsBOOL, ReturnsPointer:
+		failed = r1 == 0
+	case ReturnsHANDLE:
+		failed = r1 == 0 || r1 == ^uintptr(0)
+	}
+	if !failed {
+		return nil
+	}
+	if lastError == 0 {
+		return fmt.Errorf("%s failed without setting a last error", api)
+	}
+	return fmt.Errorf("%s: %w", api, lastError)
+}
+
+// traceCall fills in the result of call and passes it to tracer
+func traceCall(tracer Tracer, call *Call, kind ReturnKind, start time.Time, r1 uintptr, lastError syscall.Errno) {
+	call.Duration = time.Since(start)
+	call.Return = r1
+	call.Err = kind.DecodeReturn(call.API, r1, lastError)
+	tracer.TraceCall(call)
+}
+
+// SlogTracer is a Tracer that logs each call to a log/slog Logger with the
+// attributes api, detail, args, input_size, output_size, return, duration and
+// error. Successful calls are logged at Level and failed calls at ErrorLevel.
+type SlogTracer struct {
+	Logger     *slog.Logger
+	Level      slog.Level
+	ErrorLevel slog.Level
+}
+
+// NewSlogTracer creates a tracer logging successful calls at debug level and
+// failed calls at warning level.
+func NewSlogTracer(logger *slog.Logger) *SlogTracer {
+	return &SlogTracer{Logger: logger, Level: slog.LevelDebug, ErrorLevel: slog.LevelWarn}
+}
+
+// TraceCall logs call
+func (t *SlogTracer) TraceCall(call *Call) {
+	level, msg := t.Level, "native call"
+	if call.Err != nil {
+		level, msg = t.ErrorLevel, "native call failed"
+	}
+	ctx := context.Background()
+	if !t.Logger.Enabled(ctx, level) {
+		return
+	}
+
+	args := make([]string, len(call.Args))
+	for i, arg := range call.Args {
+		args[i] = fmt.Sprintf("0x%X", arg)
+	}
+	attrs := []slog.Attr{slog.String("api", call.API)}
+	if call.Detail != "" {
+		attrs = append(attrs, slog.String("detail", call.Detail))
+	}
+	attrs = append(attrs,
+		slog.String("args", strings.Join(args, ",")),
+		slog.Int("input_size", call.InputSize),
+		slog.Int("output_size", call.OutputSize),
+		slog.String("return", fmt.Sprintf("0x%X", call.Return)),
+		slog.Duration("duration", call.Duration),
+	)
+	if call.Err != nil {
+		attrs = append(attrs, slog.String("error", call.Err.Error()))
+	}
+	t.Logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// TraceDetail formats a Call.Detail only when a tracer is installed, so call
+// sites can describe arguments without paying for formatting otherwise.
+func TraceDetail(format string, a ...any) string {
+	if activeTracer.Load() == nil {
+		return ""
+	}
+	return fmt.Sprintf(format, a...)
+}
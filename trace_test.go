@@ -0,0 +1,117 @@
+package winx
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// TestReturnKind_DecodeReturn tests failure detection for each return convention
+func TestReturnKind_DecodeReturn(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    ReturnKind
+		r1      uintptr
+		errno   syscall.Errno
+		wantErr bool
+		target  error
+	}{
+		{"NTSTATUS success", ReturnsNTSTATUS, 0, 0, false, nil},
+		{"NTSTATUS pending", ReturnsNTSTATUS, uintptr(STATUS_PENDING), 0, false, nil},
+		{"NTSTATUS warning", ReturnsNTSTATUS, uintptr(STATUS_BUFFER_OVERFLOW), 0, true, STATUS_BUFFER_OVERFLOW},
+		{"NTSTATUS error", ReturnsNTSTATUS, uintptr(STATUS_ACCESS_DENIED), 0, true, STATUS_ACCESS_DENIED},
+		{"BOOL true", ReturnsBOOL, 1, 0, false, nil},
+		{"BOOL false", ReturnsBOOL, 0, 5, true, syscall.Errno(5)},
+		{"BOOL false without last error", ReturnsBOOL, 0, 0, true, nil},
+		{"HANDLE valid", ReturnsHANDLE, 0x1234, 0, false, nil},
+		{"HANDLE invalid", ReturnsHANDLE, ^uintptr(0), 2, true, syscall.Errno(2)},
+		{"pointer NULL", ReturnsPointer, 0, 8, true, syscall.Errno(8)},
+		{"void", ReturnsVoid, 0, 0, false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.kind.DecodeReturn("Api", tt.r1, tt.errno)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecodeReturn() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.target != nil && !errors.Is(err, tt.target) {
+				t.Errorf("DecodeReturn() error = %v, want %v", err, tt.target)
+			}
+			if err != nil && !strings.Contains(err.Error(), "Api") {
+				t.Errorf("error %q does not name the API", err)
+			}
+		})
+	}
+}
+
+// TestSetTracer tests installing, replacing and removing the tracer
+func TestSetTracer(t *testing.T) {
+	defer SetTracer(SetTracer(nil))
+
+	if ActiveTracer() != nil {
+		t.Fatal("ActiveTracer() != nil after SetTracer(nil)")
+	}
+	if TraceDetail("IOCTL 0x%08X", 0x222003) != "" {
+		t.Error("TraceDetail() formatted without a tracer")
+	}
+
+	var calls []Call
+	first := TracerFunc(func(call *Call) { calls = append(calls, *call) })
+	if previous := SetTracer(first); previous != nil {
+		t.Errorf("SetTracer() returned %v, want nil", previous)
+	}
+	if got := TraceDetail("IOCTL 0x%08X", 0x222003); got != "IOCTL 0x00222003" {
+		t.Errorf("TraceDetail() = %q", got)
+	}
+
+	traceCall(ActiveTracer(), &Call{API: "DeviceIoControl", InputSize: 4}, ReturnsBOOL, time.Now(), 0, 31)
+	if len(calls) != 1 || calls[0].API != "DeviceIoControl" || calls[0].InputSize != 4 || !errors.Is(calls[0].Err, syscall.Errno(31)) {
+		t.Errorf("traced calls = %+v", calls)
+	}
+
+	if previous := SetTracer(nil); previous == nil {
+		t.Error("SetTracer(nil) did not return the installed tracer")
+	}
+}
+
+// TestSlogTracer tests the attributes and levels of logged calls
+func TestSlogTracer(t *testing.T) {
+	var out bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&out, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	tracer := NewSlogTracer(logger)
+
+	tracer.TraceCall(&Call{API: "CloseHandle", Args: []uintptr{0x40}, Return: 1})
+	if out.Len() != 0 {
+		t.Errorf("successful call logged at debug level with an info handler: %s", out.String())
+	}
+
+	tracer.TraceCall(&Call{
+		API:        "NtQuerySystemInformation",
+		Detail:     "SystemProcessInformation",
+		Args:       []uintptr{0x05, 0x1000},
+		OutputSize: 65536,
+		Return:     uintptr(STATUS_INFO_LENGTH_MISMATCH),
+		Duration:   time.Millisecond,
+		Err:        NewNTStatusError(STATUS_INFO_LENGTH_MISMATCH, "NtQuerySystemInformation"),
+	})
+	line := out.String()
+	for _, want := range []string{
+		"level=WARN",
+		`msg="native call failed"`,
+		"api=NtQuerySystemInformation",
+		"detail=SystemProcessInformation",
+		"args=0x5,0x1000",
+		"output_size=65536",
+		"return=0xC0000004",
+		"duration=1ms",
+		"STATUS_INFO_LENGTH_MISMATCH",
+	} {
+		if !strings.Contains(line, want) {
+			t.Errorf("log line %q does not contain %q", line, want)
+		}
+	}
+}
@@ -1,6 +1,11 @@
 package winx
 
-import "unsafe"
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/ArkaprabhaChakraborty/winx/exitcodes"
+)
 
 // NTSTATUS represents an NT status code returned by NT Native API functions.
 type NTSTATUS uint32
@@ -33,6 +38,174 @@ func (status NTSTATUS) IsInformational() bool {
 	return (status >> 30) == STATUS_SEVERITY_INFORMATIONAL
 }
 
+// Severity returns the severity bits (31-30) of the NTSTATUS.
+// The result is one of the STATUS_SEVERITY_* constants.
+func (status NTSTATUS) Severity() uint32 {
+	return uint32(status >> 30)
+}
+
+// IsCustomer returns true if the customer bit (29) is set, meaning the code
+// was defined by a third party rather than by Microsoft.
+func (status NTSTATUS) IsCustomer() bool {
+	return (status>>29)&0x1 == 1
+}
+
+// Facility returns the facility bits (27-16) of the NTSTATUS.
+func (status NTSTATUS) Facility() Facility {
+	return Facility((status >> 16) & 0xFFF)
+}
+
+// Code returns the facility-specific code bits (15-0) of the NTSTATUS.
+func (status NTSTATUS) Code() uint16 {
+	return uint16(status & 0xFFFF)
+}
+
+// String returns the symbolic name of the NTSTATUS (e.g. "STATUS_ACCESS_DENIED"),
+// or its hexadecimal value if the code is not known.
+func (status NTSTATUS) String() string {
+	if name, err := exitcodes.GetNTStatusName(uint32(status)); err == nil {
+		return name
+	}
+	return fmt.Sprintf("0x%08X", uint32(status))
+}
+
+// Error implements the error interface so an NTSTATUS can be returned directly
+// and used as an errors.Is target.
+func (status NTSTATUS) Error() string {
+	return exitcodes.FormatNTStatus(uint32(status))
+}
+
+// NTStatus returns the raw status code. It lets the exitcodes package classify
+// NTSTATUS errors without importing winx.
+func (status NTSTATUS) NTStatus() uint32 {
+	return uint32(status)
+}
+
+// Facility identifies the subsystem that defined an NTSTATUS code.
+type Facility uint16
+
+// NTSTATUS facility codes
+const (
+	FACILITY_DEBUGGER            Facility = 0x1
+	FACILITY_RPC_RUNTIME         Facility = 0x2
+	FACILITY_RPC_STUBS           Facility = 0x3
+	FACILITY_IO_ERROR_CODE       Facility = 0x4
+	FACILITY_CODCLASS_ERROR_CODE Facility = 0x6
+	FACILITY_NTWIN32             Facility = 0x7
+	FACILITY_NTCERT              Facility = 0x8
+	FACILITY_NTSSPI              Facility = 0x9
+	FACILITY_TERMINAL_SERVER     Facility = 0xA
+	FACILITY_MUI_ERROR_CODE      Facility = 0xB
+	FACILITY_USB_ERROR_CODE      Facility = 0x10
+	FACILITY_HID_ERROR_CODE      Facility = 0x11
+	FACILITY_FIREWIRE_ERROR_CODE Facility = 0x12
+	FACILITY_CLUSTER_ERROR_CODE  Facility = 0x13
+	FACILITY_ACPI_ERROR_CODE     Facility = 0x14
+	FACILITY_SXS_ERROR_CODE      Facility = 0x15
+	FACILITY_TRANSACTION         Facility = 0x19
+	FACILITY_COMMONLOG           Facility = 0x1A
+	FACILITY_VIDEO               Facility = 0x1B
+	FACILITY_FILTER_MANAGER      Facility = 0x1C
+	FACILITY_MONITOR             Facility = 0x1D
+	FACILITY_GRAPHICS_KERNEL     Facility = 0x1E
+	FACILITY_DRIVER_FRAMEWORK    Facility = 0x20
+	FACILITY_FVE_ERROR_CODE      Facility = 0x21
+	FACILITY_FWP_ERROR_CODE      Facility = 0x22
+	FACILITY_NDIS_ERROR_CODE     Facility = 0x23
+	FACILITY_TPM                 Facility = 0x29
+	FACILITY_RTPM                Facility = 0x2A
+	FACILITY_HYPERVISOR          Facility = 0x35
+	FACILITY_IPSEC               Facility = 0x36
+	FACILITY_VIRTUALIZATION      Facility = 0x37
+	FACILITY_VOLMGR              Facility = 0x38
+	FACILITY_BCD_ERROR_CODE      Facility = 0x39
+	FACILITY_WIN32K_NTUSER       Facility = 0x3E
+	FACILITY_WIN32K_NTGDI        Facility = 0x3F
+	FACILITY_RESUME_KEY_FILTER   Facility = 0x40
+	FACILITY_RDBSS               Facility = 0x41
+	FACILITY_BTH_ATT             Facility = 0x42
+	FACILITY_SECUREBOOT          Facility = 0x43
+	FACILITY_AUDIO_KERNEL        Facility = 0x44
+	FACILITY_VSM                 Facility = 0x45
+	FACILITY_VOLSNAP             Facility = 0x50
+	FACILITY_SDBUS               Facility = 0x51
+	FACILITY_SHARED_VHDX         Facility = 0x5C
+	FACILITY_SMB                 Facility = 0x5D
+	FACILITY_INTERIX             Facility = 0x99
+	FACILITY_SPACES              Facility = 0xE7
+	FACILITY_SECURITY_CORE       Facility = 0xE8
+	FACILITY_SYSTEM_INTEGRITY    Facility = 0xE9
+	FACILITY_LICENSING           Facility = 0xEA
+	FACILITY_PLATFORM_MANIFEST   Facility = 0xEB
+	FACILITY_APP_EXEC            Facility = 0xEC
+)
+
+// facilityNames maps NTSTATUS facility codes to their symbolic names
+var facilityNames = map[Facility]string{
+	0:                            "FACILITY_NONE",
+	FACILITY_DEBUGGER:            "FACILITY_DEBUGGER",
+	FACILITY_RPC_RUNTIME:         "FACILITY_RPC_RUNTIME",
+	FACILITY_RPC_STUBS:           "FACILITY_RPC_STUBS",
+	FACILITY_IO_ERROR_CODE:       "FACILITY_IO_ERROR_CODE",
+	FACILITY_CODCLASS_ERROR_CODE: "FACILITY_CODCLASS_ERROR_CODE",
+	FACILITY_NTWIN32:             "FACILITY_NTWIN32",
+	FACILITY_NTCERT:              "FACILITY_NTCERT",
+	FACILITY_NTSSPI:              "FACILITY_NTSSPI",
+	FACILITY_TERMINAL_SERVER:     "FACILITY_TERMINAL_SERVER",
+	FACILITY_MUI_ERROR_CODE:      "FACILITY_MUI_ERROR_CODE",
+	FACILITY_USB_ERROR_CODE:      "FACILITY_USB_ERROR_CODE",
+	FACILITY_HID_ERROR_CODE:      "FACILITY_HID_ERROR_CODE",
+	FACILITY_FIREWIRE_ERROR_CODE: "FACILITY_FIREWIRE_ERROR_CODE",
+	FACILITY_CLUSTER_ERROR_CODE:  "FACILITY_CLUSTER_ERROR_CODE",
+	FACILITY_ACPI_ERROR_CODE:     "FACILITY_ACPI_ERROR_CODE",
+	FACILITY_SXS_ERROR_CODE:      "FACILITY_SXS_ERROR_CODE",
+	FACILITY_TRANSACTION:         "FACILITY_TRANSACTION",
+	FACILITY_COMMONLOG:           "FACILITY_COMMONLOG",
+	FACILITY_VIDEO:               "FACILITY_VIDEO",
+	FACILITY_FILTER_MANAGER:      "FACILITY_FILTER_MANAGER",
+	FACILITY_MONITOR:             "FACILITY_MONITOR",
+	FACILITY_GRAPHICS_KERNEL:     "FACILITY_GRAPHICS_KERNEL",
+	FACILITY_DRIVER_FRAMEWORK:    "FACILITY_DRIVER_FRAMEWORK",
+	FACILITY_FVE_ERROR_CODE:      "FACILITY_FVE_ERROR_CODE",
+	FACILITY_FWP_ERROR_CODE:      "FACILITY_FWP_ERROR_CODE",
+	FACILITY_NDIS_ERROR_CODE:     "FACILITY_NDIS_ERROR_CODE",
+	FACILITY_TPM:                 "FACILITY_TPM",
+	FACILITY_RTPM:                "FACILITY_RTPM",
+	FACILITY_HYPERVISOR:          "FACILITY_HYPERVISOR",
+	FACILITY_IPSEC:               "FACILITY_IPSEC",
+	FACILITY_VIRTUALIZATION:      "FACILITY_VIRTUALIZATION",
+	FACILITY_VOLMGR:              "FACILITY_VOLMGR",
+	FACILITY_BCD_ERROR_CODE:      "FACILITY_BCD_ERROR_CODE",
+	FACILITY_WIN32K_NTUSER:       "FACILITY_WIN32K_NTUSER",
+	FACILITY_WIN32K_NTGDI:        "FACILITY_WIN32K_NTGDI",
+	FACILITY_RESUME_KEY_FILTER:   "FACILITY_RESUME_KEY_FILTER",
+	FACILITY_RDBSS:               "FACILITY_RDBSS",
+	FACILITY_BTH_ATT:             "FACILITY_BTH_ATT",
+	FACILITY_SECUREBOOT:          "FACILITY_SECUREBOOT",
+	FACILITY_AUDIO_KERNEL:        "FACILITY_AUDIO_KERNEL",
+	FACILITY_VSM:                 "FACILITY_VSM",
+	FACILITY_VOLSNAP:             "FACILITY_VOLSNAP",
+	FACILITY_SDBUS:               "FACILITY_SDBUS",
+	FACILITY_SHARED_VHDX:         "FACILITY_SHARED_VHDX",
+	FACILITY_SMB:                 "FACILITY_SMB",
+	FACILITY_INTERIX:             "FACILITY_INTERIX",
+	FACILITY_SPACES:              "FACILITY_SPACES",
+	FACILITY_SECURITY_CORE:       "FACILITY_SECURITY_CORE",
+	FACILITY_SYSTEM_INTEGRITY:    "FACILITY_SYSTEM_INTEGRITY",
+	FACILITY_LICENSING:           "FACILITY_LICENSING",
+	FACILITY_PLATFORM_MANIFEST:   "FACILITY_PLATFORM_MANIFEST",
+	FACILITY_APP_EXEC:            "FACILITY_APP_EXEC",
+}
+
+// String returns the symbolic name of the facility (e.g. "FACILITY_NTWIN32"),
+// or its hexadecimal value if the facility is not known.
+func (facility Facility) String() string {
+	if name, ok := facilityNames[facility]; ok {
+		return name
+	}
+	return fmt.Sprintf("FACILITY_0x%03X", uint16(facility))
+}
+
 // UNICODE_STRING represents a Unicode string structure used by NT APIs.
 type UNICODE_STRING struct {
 	Length        uint16
@@ -0,0 +1,148 @@
+package winx
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unicode/utf16"
+	"unsafe"
+)
+
+// maxUnicodeStringBytes is the largest byte length a UNICODE_STRING can
+// describe while leaving room for a terminating NUL in MaximumLength.
+const maxUnicodeStringBytes = 0xFFFC
+
+// NewUnicodeString creates a UNICODE_STRING holding s.
+// The UTF-16 buffer is allocated by Go and NUL terminated; it stays alive for
+// as long as the returned UNICODE_STRING is reachable, so the structure can be
+// passed to NT APIs without additional bookkeeping.
+// It returns STATUS_NAME_TOO_LONG if s does not fit in a UNICODE_STRING.
+func NewUnicodeString(s string) (*UNICODE_STRING, error) {
+	buf := append(utf16.Encode([]rune(s)), 0)
+	length := (len(buf) - 1) * 2
+	if length > maxUnicodeStringBytes {
+		return nil, NewNTStatusError(STATUS_NAME_TOO_LONG, fmt.Sprintf("string of %d bytes does not fit in a UNICODE_STRING", length))
+	}
+	return &UNICODE_STRING{
+		Length:        uint16(length),
+		MaximumLength: uint16(length + 2),
+		Buffer:        &buf[0],
+	}, nil
+}
+
+// String returns the Go string held by the UNICODE_STRING.
+// Only Length bytes are read, capped at MaximumLength, so strings that are not
+// NUL terminated and buffers filled by the kernel are handled safely.
+// A nil Buffer yields the empty string.
+func (us UNICODE_STRING) String() string {
+	length := us.Length
+	if length > us.MaximumLength {
+		length = us.MaximumLength
+	}
+	if us.Buffer == nil || length < 2 {
+		return ""
+	}
+	return string(utf16.Decode(unsafe.Slice(us.Buffer, length/2)))
+}
+
+// InitializeObjectAttributes is the equivalent of the InitializeObjectAttributes
+// macro. It returns an OBJECT_ATTRIBUTES with its Length set and the given
+// object name, attributes (OBJ_* flags), root directory handle and security
+// descriptor. rootDirectory and securityDescriptor may be zero and nil.
+func InitializeObjectAttributes(name *UNICODE_STRING, attributes uint32, rootDirectory uintptr, securityDescriptor unsafe.Pointer) *OBJECT_ATTRIBUTES {
+	return &OBJECT_ATTRIBUTES{
+		Length:             uint32(unsafe.Sizeof(OBJECT_ATTRIBUTES{})),
+		RootDirectory:      rootDirectory,
+		ObjectName:         name,
+		Attributes:         attributes,
+		SecurityDescriptor: securityDescriptor,
+	}
+}
+
+// NewObjectAttributes creates the UNICODE_STRING for name and returns
+// OBJECT_ATTRIBUTES initialized as by InitializeObjectAttributes.
+// An empty name leaves ObjectName nil, which NT APIs treat as "no name".
+func NewObjectAttributes(name string, attributes uint32, rootDirectory uintptr, securityDescriptor unsafe.Pointer) (*OBJECT_ATTRIBUTES, error) {
+	var objectName *UNICODE_STRING
+	if name != "" {
+		us, err := NewUnicodeString(name)
+		if err != nil {
+			return nil, err
+		}
+		objectName = us
+	}
+	return InitializeObjectAttributes(objectName, attributes, rootDirectory, securityDescriptor), nil
+}
+
+// Name returns the object name of the attributes, or "" if none is set.
+func (oa *OBJECT_ATTRIBUTES) Name() string {
+	if oa == nil || oa.ObjectName == nil {
+		return ""
+	}
+	return oa.ObjectName.String()
+}
+
+// UnicodeStringSize returns the size in bytes of a UNICODE_STRING for the
+// given pointer size (4 for 32-bit, 8 for 64-bit layouts).
+func UnicodeStringSize(pointerSize int) int {
+	if pointerSize == 4 {
+		return 8
+	}
+	return 16
+}
+
+// DecodeUnicodeString reads a UNICODE_STRING stored at offset in buf and
+// returns the string it points to.
+//
+// NtQuery* functions return buffers whose embedded UNICODE_STRINGs point back
+// into the same buffer. base is the address buf was located at when the call
+// was made, and is used to translate the Buffer pointer into an offset in buf.
+// pointerSize selects the 32-bit (4) or 64-bit (8) structure layout.
+//
+// A zero Length decodes to "" regardless of Buffer. A string that does not
+// lie entirely within buf yields a STATUS_BUFFER_TOO_SMALL error.
+func DecodeUnicodeString(buf []byte, offset int, base uintptr, pointerSize int) (string, error) {
+	if pointerSize != 4 && pointerSize != 8 {
+		return "", NewNTStatusError(STATUS_INVALID_PARAMETER, fmt.Sprintf("unsupported pointer size %d", pointerSize))
+	}
+	if offset < 0 || offset+UnicodeStringSize(pointerSize) > len(buf) {
+		return "", NewNTStatusError(STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("UNICODE_STRING at offset %d is outside the %d byte buffer", offset, len(buf)))
+	}
+
+	length := int(binary.LittleEndian.Uint16(buf[offset:]))
+	var address uint64
+	if pointerSize == 4 {
+		address = uint64(binary.LittleEndian.Uint32(buf[offset+4:]))
+	} else {
+		address = binary.LittleEndian.Uint64(buf[offset+8:])
+	}
+	if length == 0 {
+		return "", nil
+	}
+
+	start := address - uint64(base)
+	if address < uint64(base) || start > uint64(len(buf)) || uint64(len(buf))-start < uint64(length) {
+		return "", NewNTStatusError(STATUS_BUFFER_TOO_SMALL, fmt.Sprintf("UNICODE_STRING buffer 0x%X (%d bytes) is outside the buffer at 0x%X", address, length, base))
+	}
+	return string(utf16.Decode(utf16Units(buf[start : start+uint64(length)]))), nil
+}
+
+// DecodeUTF16 converts little-endian UTF-16 bytes to a Go string.
+// A trailing odd byte is ignored, and decoding stops at the first NUL.
+func DecodeUTF16(b []byte) string {
+	chars := utf16Units(b)
+	for i, c := range chars {
+		if c == 0 {
+			chars = chars[:i]
+			break
+		}
+	}
+	return string(utf16.Decode(chars))
+}
+
+func utf16Units(b []byte) []uint16 {
+	chars := make([]uint16, len(b)/2)
+	for i := range chars {
+		chars[i] = binary.LittleEndian.Uint16(b[i*2:])
+	}
+	return chars
+}
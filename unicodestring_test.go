@@ -0,0 +1,141 @@
+package winx
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+// TestNewUnicodeString tests construction and round-tripping of UNICODE_STRING
+func TestNewUnicodeString(t *testing.T) {
+	tests := []string{"", `\Device\HarddiskVolume1`, "日本語", "emoji \U0001F600"}
+
+	for _, s := range tests {
+		us, err := NewUnicodeString(s)
+		if err != nil {
+			t.Fatalf("NewUnicodeString(%q) error = %v", s, err)
+		}
+		if us.MaximumLength != us.Length+2 {
+			t.Errorf("NewUnicodeString(%q) MaximumLength = %d, Length = %d", s, us.MaximumLength, us.Length)
+		}
+		if got := us.String(); got != s {
+			t.Errorf("NewUnicodeString(%q).String() = %q", s, got)
+		}
+	}
+
+	if _, err := NewUnicodeString(strings.Repeat("a", 0x8000)); !errors.Is(err, STATUS_NAME_TOO_LONG) {
+		t.Errorf("NewUnicodeString(too long) error = %v, want STATUS_NAME_TOO_LONG", err)
+	}
+}
+
+// TestUNICODE_STRING_String tests that Length and MaximumLength bound the result
+func TestUNICODE_STRING_String(t *testing.T) {
+	buf := []uint16{'a', 'b', 'c', 'd'}
+	tests := []struct {
+		name string
+		us   UNICODE_STRING
+		want string
+	}{
+		{"nil buffer", UNICODE_STRING{Length: 8, MaximumLength: 8}, ""},
+		{"not terminated", UNICODE_STRING{Length: 8, MaximumLength: 8, Buffer: &buf[0]}, "abcd"},
+		{"short length", UNICODE_STRING{Length: 4, MaximumLength: 8, Buffer: &buf[0]}, "ab"},
+		{"length over maximum", UNICODE_STRING{Length: 100, MaximumLength: 6, Buffer: &buf[0]}, "abc"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.us.String(); got != tt.want {
+			t.Errorf("%s: String() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestNewObjectAttributes tests OBJECT_ATTRIBUTES initialization
+func TestNewObjectAttributes(t *testing.T) {
+	oa, err := NewObjectAttributes(`\BaseNamedObjects`, OBJ_CASE_INSENSITIVE, 0x44, nil)
+	if err != nil {
+		t.Fatalf("NewObjectAttributes() error = %v", err)
+	}
+	if oa.Length != uint32(unsafe.Sizeof(OBJECT_ATTRIBUTES{})) {
+		t.Errorf("Length = %d, want %d", oa.Length, unsafe.Sizeof(OBJECT_ATTRIBUTES{}))
+	}
+	if oa.Attributes != OBJ_CASE_INSENSITIVE || oa.RootDirectory != 0x44 {
+		t.Errorf("Attributes = 0x%X, RootDirectory = 0x%X", oa.Attributes, oa.RootDirectory)
+	}
+	if got := oa.Name(); got != `\BaseNamedObjects` {
+		t.Errorf("Name() = %q", got)
+	}
+
+	oa, err = NewObjectAttributes("", 0, 0, nil)
+	if err != nil || oa.ObjectName != nil {
+		t.Errorf("NewObjectAttributes(\"\") = %+v, %v, want nil ObjectName", oa, err)
+	}
+}
+
+// unicodeStringBuffer builds a buffer holding a UNICODE_STRING at offset 0
+// whose Buffer points at the encoded text that follows it
+func unicodeStringBuffer(s string, base uint64, pointerSize int) []byte {
+	size := UnicodeStringSize(pointerSize)
+	buf := make([]byte, size, size+len(s)*2)
+	for _, c := range s {
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(c))
+	}
+	binary.LittleEndian.PutUint16(buf[0:], uint16(len(s)*2))
+	binary.LittleEndian.PutUint16(buf[2:], uint16(len(s)*2))
+	if pointerSize == 4 {
+		binary.LittleEndian.PutUint32(buf[4:], uint32(base)+uint32(size))
+	} else {
+		binary.LittleEndian.PutUint64(buf[8:], base+uint64(size))
+	}
+	return buf
+}
+
+// TestDecodeUnicodeString tests decoding of 32-bit and 64-bit layouts
+func TestDecodeUnicodeString(t *testing.T) {
+	tests := []struct {
+		pointerSize int
+		base        uint64
+	}{
+		{4, 0x00400000},
+		{8, 0x000001D2C0A50000},
+	}
+
+	for _, tt := range tests {
+		buf := unicodeStringBuffer("lsass.exe", tt.base, tt.pointerSize)
+		got, err := DecodeUnicodeString(buf, 0, uintptr(tt.base), tt.pointerSize)
+		if err != nil || got != "lsass.exe" {
+			t.Errorf("DecodeUnicodeString(pointerSize %d) = %q, %v", tt.pointerSize, got, err)
+		}
+	}
+}
+
+// TestDecodeUnicodeString_Errors tests rejection of malformed buffers
+func TestDecodeUnicodeString_Errors(t *testing.T) {
+	buf := unicodeStringBuffer("csrss.exe", 0x1000, 8)
+
+	empty := make([]byte, 16)
+	if got, err := DecodeUnicodeString(empty, 0, 0x1000, 8); err != nil || got != "" {
+		t.Errorf("DecodeUnicodeString(empty) = %q, %v", got, err)
+	}
+	if _, err := DecodeUnicodeString(buf, 0, 0x1000, 2); !errors.Is(err, STATUS_INVALID_PARAMETER) {
+		t.Errorf("DecodeUnicodeString(pointerSize 2) error = %v", err)
+	}
+	if _, err := DecodeUnicodeString(buf[:10], 0, 0x1000, 8); !errors.Is(err, STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("DecodeUnicodeString(truncated header) error = %v", err)
+	}
+	if _, err := DecodeUnicodeString(buf[:20], 0, 0x1000, 8); !errors.Is(err, STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("DecodeUnicodeString(truncated text) error = %v", err)
+	}
+	if _, err := DecodeUnicodeString(buf, 0, 0x2000, 8); !errors.Is(err, STATUS_BUFFER_TOO_SMALL) {
+		t.Errorf("DecodeUnicodeString(pointer below base) error = %v", err)
+	}
+}
+
+// TestDecodeUTF16 tests NUL handling for fixed-size name fields
+func TestDecodeUTF16(t *testing.T) {
+	b := []byte{'n', 0, 't', 0, 0, 0, 'x', 0, 'y'}
+	if got := DecodeUTF16(b); got != "nt" {
+		t.Errorf("DecodeUTF16() = %q, want %q", got, "nt")
+	}
+}